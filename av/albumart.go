@@ -0,0 +1,113 @@
+package av
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/huin/goupnp/av/didl"
+)
+
+// DefaultMaxAlbumArtSize is the size limit of FetchAlbumArt if none is given.
+const DefaultMaxAlbumArtSize = 8 << 20
+
+// ErrNoAlbumArt is returned for objects without album art.
+var ErrNoAlbumArt = errors.New("av: object has no album art")
+
+// AlbumArtURL returns the URL of the album art of o, resolved against base,
+// typically the Location of the MediaServer that o is from, as servers may
+// give relative URLs. If o has album art of several DLNA profiles, that of the
+// first of profiles that it has is chosen, e.g. "JPEG_TN" for thumbnails,
+// otherwise its first album art. ErrNoAlbumArt is returned if it has none.
+func AlbumArtURL(o *didl.Object, base *url.URL, profiles ...string) (*url.URL, error) {
+	art := o.AlbumArt
+	if len(art) == 0 && o.AlbumArtURI != "" {
+		art = []didl.AlbumArt{{URI: o.AlbumArtURI}}
+	}
+	if len(art) == 0 {
+		return nil, ErrNoAlbumArt
+	}
+	uri := art[0].URI
+profiles:
+	for _, profile := range profiles {
+		for _, a := range art {
+			if strings.EqualFold(a.Profile, profile) {
+				uri = a.URI
+				break profiles
+			}
+		}
+	}
+	u, err := url.Parse(uri)
+	if err != nil {
+		return nil, fmt.Errorf("av: malformed album art URI %q: %v", uri, err)
+	}
+	if base != nil {
+		u = base.ResolveReference(u)
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return nil, fmt.Errorf("av: album art URI %q is not an HTTP URL", uri)
+	}
+	return u, nil
+}
+
+// FetchAlbumArt downloads the image at u with client, http.DefaultClient if
+// nil, and returns it with its content type. It fails if the image is larger
+// than maxSize bytes, DefaultMaxAlbumArtSize if zero, or is not an image. The
+// content type is sniffed from the data if the server does not give an image
+// type.
+func FetchAlbumArt(ctx context.Context, client *http.Client, u *url.URL, maxSize int64) ([]byte, string, error) {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	if maxSize <= 0 {
+		maxSize = DefaultMaxAlbumArtSize
+	}
+	req, err := http.NewRequest("GET", u.String(), nil)
+	if err != nil {
+		return nil, "", err
+	}
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, "", fmt.Errorf("av: album art request to %s returned %s", u, resp.Status)
+	}
+	if resp.ContentLength > maxSize {
+		return nil, "", fmt.Errorf("av: album art of %d bytes exceeds the limit of %d", resp.ContentLength, maxSize)
+	}
+	data, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+	if err != nil {
+		return nil, "", err
+	}
+	if int64(len(data)) > maxSize {
+		return nil, "", fmt.Errorf("av: album art exceeds the limit of %d bytes", maxSize)
+	}
+
+	contentType, _, _ := mime.ParseMediaType(resp.Header.Get("Content-Type"))
+	if !strings.HasPrefix(contentType, "image/") {
+		// Servers often give application/octet-stream, or nothing.
+		contentType, _, _ = mime.ParseMediaType(http.DetectContentType(data))
+	}
+	if !strings.HasPrefix(contentType, "image/") {
+		return nil, "", fmt.Errorf("av: album art is of content type %q, not an image", contentType)
+	}
+	return data, contentType, nil
+}
+
+// AlbumArt downloads the album art of o, an object of s, of the first of
+// profiles that it has, as AlbumArtURL and FetchAlbumArt.
+func (s *Server) AlbumArt(ctx context.Context, o *didl.Object, maxSize int64, profiles ...string) ([]byte, string, error) {
+	u, err := AlbumArtURL(o, s.Location, profiles...)
+	if err != nil {
+		return nil, "", err
+	}
+	return FetchAlbumArt(ctx, nil, u, maxSize)
+}
@@ -0,0 +1,87 @@
+package av
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/huin/goupnp/av/didl"
+)
+
+var testPNG = []byte("\x89PNG\r\n\x1a\n\x00\x00\x00\rIHDR")
+
+func TestAlbumArtURL(t *testing.T) {
+	base, _ := url.Parse("http://10.0.0.1:8200/rootDesc.xml")
+	o := &didl.Object{AlbumArt: []didl.AlbumArt{
+		{URI: "/art/1.jpg", Profile: "JPEG_MED"},
+		{URI: "/art/1_tn.jpg", Profile: "JPEG_TN"},
+	}}
+	tests := []struct {
+		profiles []string
+		want     string
+	}{
+		{nil, "http://10.0.0.1:8200/art/1.jpg"},
+		{[]string{"PNG_TN", "JPEG_TN"}, "http://10.0.0.1:8200/art/1_tn.jpg"},
+		{[]string{"PNG_LRG"}, "http://10.0.0.1:8200/art/1.jpg"},
+	}
+	for _, test := range tests {
+		u, err := AlbumArtURL(o, base, test.profiles...)
+		if err != nil || u.String() != test.want {
+			t.Errorf("AlbumArtURL(%v) = %v, %v, want %s", test.profiles, u, err, test.want)
+		}
+	}
+
+	if _, err := AlbumArtURL(&didl.Object{}, base); err != ErrNoAlbumArt {
+		t.Errorf("AlbumArtURL() of an object without album art error = %v", err)
+	}
+	if _, err := AlbumArtURL(&didl.Object{AlbumArtURI: "file:///etc/passwd"}, base); err == nil {
+		t.Error("AlbumArtURL() accepted a file URL")
+	}
+}
+
+func TestFetchAlbumArt(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/sniffed":
+			w.Header().Set("Content-Type", "application/octet-stream")
+			w.Write(testPNG)
+		case "/jpeg":
+			w.Header().Set("Content-Type", "image/jpeg; charset=binary")
+			w.Write([]byte("jpeg data"))
+		case "/html":
+			w.Write([]byte("<html><body>Not found</body></html>"))
+		case "/large":
+			w.Header().Set("Content-Type", "image/png")
+			w.Write(bytes.Repeat([]byte{0}, 2048))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+	ctx := context.Background()
+	fetch := func(path string, maxSize int64) ([]byte, string, error) {
+		u, _ := url.Parse(srv.URL + path)
+		return FetchAlbumArt(ctx, nil, u, maxSize)
+	}
+
+	if data, ct, err := fetch("/sniffed", 0); err != nil || ct != "image/png" || !bytes.Equal(data, testPNG) {
+		t.Errorf("fetch of sniffed image = %q, %q, %v", data, ct, err)
+	}
+	if data, ct, err := fetch("/jpeg", 0); err != nil || ct != "image/jpeg" || string(data) != "jpeg data" {
+		t.Errorf("fetch of JPEG = %q, %q, %v", data, ct, err)
+	}
+	for _, path := range []string{"/html", "/missing"} {
+		if _, _, err := fetch(path, 0); err == nil {
+			t.Errorf("fetch of %s succeeded", path)
+		}
+	}
+	if _, _, err := fetch("/large", 1024); err == nil {
+		t.Error("fetch of an image over the size limit succeeded")
+	}
+	if _, _, err := fetch("/large", 4096); err != nil {
+		t.Error(err)
+	}
+}
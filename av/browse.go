@@ -0,0 +1,147 @@
+package av
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/huin/goupnp/av/didl"
+	"github.com/huin/goupnp/dcps/av1"
+)
+
+// browsePageSize is the RequestedCount of the Browse requests of a Browser.
+const browsePageSize = 100
+
+// Browser iterates over the children of a ContentDirectory container, browsing
+// them a page at a time. Use BrowseChildren to create one.
+//
+// The TotalMatches reported by devices is not relied upon, as some report 0
+// or a wrong count: pages are browsed until one is empty, or is short of the
+// requested count once TotalMatches is reached.
+//
+//	b := av.BrowseChildren(ctx, client, "0", "*", "")
+//	for b.Next() {
+//		if item := b.Item(); item != nil {
+//			...
+//		}
+//	}
+//	if err := b.Err(); err != nil {
+//		...
+//	}
+type Browser struct {
+	ctx          context.Context
+	client       av1.ContentDirectory1Client
+	objectID     string
+	filter       string
+	sortCriteria string
+
+	start        uint32
+	done         bool
+	err          error
+	firstID      string // ID of the first object of the last page.
+	containers   []didl.Container
+	items        []didl.Item
+	container    *didl.Container
+	item         *didl.Item
+	totalMatches uint32
+	updateID     uint32
+}
+
+// BrowseChildren returns a Browser of the children of the container objectID
+// on client, with the properties selected by filter, sorted by sortCriteria,
+// as given to Browse.
+func BrowseChildren(ctx context.Context, client av1.ContentDirectory1Client, objectID, filter, sortCriteria string) *Browser {
+	return &Browser{
+		ctx:          ctx,
+		client:       client,
+		objectID:     objectID,
+		filter:       filter,
+		sortCriteria: sortCriteria,
+	}
+}
+
+// Next advances to the next child, returning false when there are no more
+// children or browsing fails, as told by Err. The containers of each page
+// come before its items.
+func (b *Browser) Next() bool {
+	b.container, b.item = nil, nil
+	for len(b.containers) == 0 && len(b.items) == 0 {
+		if b.done || b.err != nil {
+			return false
+		}
+		b.fetch()
+	}
+	if len(b.containers) > 0 {
+		b.container = &b.containers[0]
+		b.containers = b.containers[1:]
+	} else {
+		b.item = &b.items[0]
+		b.items = b.items[1:]
+	}
+	return true
+}
+
+// Container returns the current child if it is a container, nil otherwise.
+func (b *Browser) Container() *didl.Container {
+	return b.container
+}
+
+// Item returns the current child if it is an item, nil otherwise.
+func (b *Browser) Item() *didl.Item {
+	return b.item
+}
+
+// Err returns the error that stopped browsing, if any.
+func (b *Browser) Err() error {
+	return b.err
+}
+
+// TotalMatches returns the TotalMatches of the last page, which may be 0 or
+// wrong.
+func (b *Browser) TotalMatches() uint32 {
+	return b.totalMatches
+}
+
+// UpdateID returns the UpdateID of the last page, which changes when the
+// container does.
+func (b *Browser) UpdateID() uint32 {
+	return b.updateID
+}
+
+func (b *Browser) fetch() {
+	result, _, total, updateID, err := b.client.BrowseCtx(b.ctx, b.objectID,
+		av1.ContentDirectory1BrowseFlag_BrowseDirectChildren, b.filter, b.start, browsePageSize, b.sortCriteria)
+	if err != nil {
+		b.err = err
+		return
+	}
+	doc, err := didl.Unmarshal(result)
+	if err != nil {
+		b.err = err
+		return
+	}
+	// NumberReturned is no more reliable than TotalMatches, so the objects are
+	// counted instead.
+	count := uint32(len(doc.Containers) + len(doc.Items))
+	b.totalMatches, b.updateID = total, updateID
+	if count == 0 {
+		b.done = true
+		return
+	}
+
+	var firstID string
+	if len(doc.Containers) > 0 {
+		firstID = doc.Containers[0].ID
+	} else {
+		firstID = doc.Items[0].ID
+	}
+	if b.start > 0 && firstID == b.firstID {
+		b.err = fmt.Errorf("av: device returned the same page of %q at index %d, ignoring StartingIndex", b.objectID, b.start)
+		return
+	}
+	b.firstID = firstID
+	b.containers, b.items = doc.Containers, doc.Items
+	b.start += count
+	if total > 0 && b.start >= total && count < browsePageSize {
+		b.done = true
+	}
+}
@@ -0,0 +1,78 @@
+package av
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/huin/goupnp/av/didl"
+	"github.com/huin/goupnp/dcps/av1"
+)
+
+// fakeContentDirectory serves a container of n items, returning at most
+// maxCount per page and reporting totalMatches.
+type fakeContentDirectory struct {
+	av1.ContentDirectory1Client
+	n, maxCount, totalMatches int
+	ignoreStart               bool
+	requests                  int
+}
+
+func (f *fakeContentDirectory) BrowseCtx(ctx context.Context, objectID string, flag av1.ContentDirectory1BrowseFlag, filter string,
+	start, count uint32, sortCriteria string) (string, uint32, uint32, uint32, error) {
+	f.requests++
+	if f.ignoreStart {
+		start = 0
+	}
+	if int(count) > f.maxCount {
+		count = uint32(f.maxCount)
+	}
+	var doc didl.Document
+	for i := int(start); i < f.n && i < int(start+count); i++ {
+		doc.Items = append(doc.Items, didl.Item{Object: didl.Object{
+			ID: fmt.Sprint(i), ParentID: objectID, Title: fmt.Sprint("Track ", i), Class: didl.ClassMusicTrack,
+		}})
+	}
+	result, err := didl.Marshal(&doc)
+	return result, uint32(len(doc.Items)), uint32(f.totalMatches), 7, err
+}
+
+func TestBrowseChildren(t *testing.T) {
+	tests := []struct {
+		name string
+		cd   fakeContentDirectory
+		// requests is the number of Browse requests expected.
+		requests int
+	}{
+		{"exact", fakeContentDirectory{n: 250, maxCount: 1000, totalMatches: 250}, 3},
+		{"full last page", fakeContentDirectory{n: 200, maxCount: 1000, totalMatches: 200}, 3},
+		{"no total", fakeContentDirectory{n: 250, maxCount: 1000}, 4},
+		{"short total", fakeContentDirectory{n: 250, maxCount: 1000, totalMatches: 120}, 3},
+		{"capped pages", fakeContentDirectory{n: 250, maxCount: 30, totalMatches: 250}, 9},
+	}
+	for _, test := range tests {
+		cd := test.cd
+		b := BrowseChildren(context.Background(), &cd, "1", "*", "")
+		n := 0
+		for b.Next() {
+			if b.Container() != nil || b.Item() == nil || b.Item().ID != fmt.Sprint(n) {
+				t.Errorf("%s: got child %+v at %d", test.name, b.Item(), n)
+			}
+			n++
+		}
+		if b.Err() != nil || n != cd.n || cd.requests != test.requests {
+			t.Errorf("%s: browsed %d children with %d requests, %v, want %d with %d", test.name, n, cd.requests, b.Err(), cd.n, test.requests)
+		}
+		if b.UpdateID() != 7 {
+			t.Errorf("%s: UpdateID() = %d", test.name, b.UpdateID())
+		}
+	}
+
+	cd := fakeContentDirectory{n: 250, maxCount: 1000, ignoreStart: true}
+	b := BrowseChildren(context.Background(), &cd, "1", "*", "")
+	for b.Next() {
+	}
+	if b.Err() == nil {
+		t.Error("browsed a device ignoring StartingIndex without an error")
+	}
+}
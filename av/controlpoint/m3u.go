@@ -0,0 +1,62 @@
+package controlpoint
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseM3U parses an M3U or extended M3U playlist, returning its media with
+// the titles and durations of their #EXTINF lines. Relative URLs are resolved
+// against base, the URL of the playlist, if it is not nil. The content formats
+// of the media are left empty, to be guessed from their URLs.
+func ParseM3U(r io.Reader, base *url.URL) ([]Media, error) {
+	var media []Media
+	var title string
+	var duration time.Duration
+	scanner := bufio.NewScanner(r)
+	for line := 1; scanner.Scan(); line++ {
+		s := strings.TrimSpace(scanner.Text())
+		if line == 1 {
+			s = strings.TrimPrefix(s, "\ufeff")
+		}
+		switch {
+		case s == "":
+		case strings.HasPrefix(s, "#EXTINF:"):
+			info := strings.TrimPrefix(s, "#EXTINF:")
+			comma := strings.IndexByte(info, ',')
+			if comma < 0 {
+				comma = len(info)
+			} else {
+				title = strings.TrimSpace(info[comma+1:])
+			}
+			// The duration may be followed by attributes, e.g.
+			// #EXTINF:-1 tvg-id="x",Title.
+			if fields := strings.Fields(info[:comma]); len(fields) > 0 {
+				if secs, err := strconv.ParseFloat(fields[0], 64); err == nil && secs > 0 {
+					duration = time.Duration(secs * float64(time.Second))
+				}
+			}
+		case strings.HasPrefix(s, "#"):
+			// Other directives and comments.
+		default:
+			u, err := url.Parse(s)
+			if err != nil {
+				return nil, fmt.Errorf("controlpoint: malformed URL on line %d of playlist: %v", line, err)
+			}
+			if base != nil {
+				u = base.ResolveReference(u)
+			}
+			media = append(media, Media{URI: u.String(), Title: title, Duration: duration})
+			title, duration = "", 0
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	return media, nil
+}
@@ -0,0 +1,33 @@
+package controlpoint
+
+import (
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestParseM3U(t *testing.T) {
+	const playlist = "\ufeff#EXTM3U\r\n" +
+		"#EXTINF:185,Artist - First\r\n" +
+		"first.mp3\r\n" +
+		"\r\n" +
+		"# A comment.\r\n" +
+		"#EXTINF:-1 tvg-id=\"radio\",Radio\r\n" +
+		"http://radio.example.com/stream\r\n" +
+		"/music/third.flac\r\n"
+	base, _ := url.Parse("http://10.0.0.2/lists/all.m3u")
+	media, err := ParseM3U(strings.NewReader(playlist), base)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Media{
+		{URI: "http://10.0.0.2/lists/first.mp3", Title: "Artist - First", Duration: 185 * time.Second},
+		{URI: "http://radio.example.com/stream", Title: "Radio"},
+		{URI: "http://10.0.0.2/music/third.flac"},
+	}
+	if !reflect.DeepEqual(media, want) {
+		t.Errorf("ParseM3U() =\n%+v\nwant\n%+v", media, want)
+	}
+}
@@ -0,0 +1,517 @@
+// Package controlpoint controls media renderers with their AVTransport and
+// ConnectionManager services, for the common workflow of casting media to a
+// renderer: loading it with its metadata, controlling its playback, and
+// following the state of the renderer through its events.
+package controlpoint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/huin/goupnp"
+	"github.com/huin/goupnp/av"
+	"github.com/huin/goupnp/av/didl"
+	"github.com/huin/goupnp/dcps/av1"
+	"github.com/huin/goupnp/gena"
+	"github.com/huin/goupnp/soap"
+	"github.com/huin/goupnp/upnperr"
+)
+
+// The values of the TransportState of AVTransport services.
+const (
+	TransportStopped       = "STOPPED"
+	TransportPlaying       = "PLAYING"
+	TransportPaused        = "PAUSED_PLAYBACK"
+	TransportTransitioning = "TRANSITIONING"
+	TransportNoMedia       = "NO_MEDIA_PRESENT"
+)
+
+// subscriptionTimeout is the duration of the event subscriptions requested by
+// a Player.
+const subscriptionTimeout = 30 * time.Minute
+
+// Media is media to be played by a Player.
+type Media struct {
+	URI string
+	// ProtocolInfo is how the media is served, e.g.
+	// didl.ParseProtocolInfo("http-get:*:audio/mpeg:*"). Its content format
+	// is checked against the formats accepted by the renderer.
+	ProtocolInfo didl.ProtocolInfo
+
+	// Properties of the metadata of the media. The Class is derived from the
+	// content format if it is empty.
+	Title       string
+	Class       didl.Class
+	Artist      string
+	Album       string
+	AlbumArtURI string
+	Duration    time.Duration
+	Size        uint64
+}
+
+// Metadata returns the DIDL-Lite metadata of m, as given with its URI to the
+// renderer.
+func (m *Media) Metadata() (string, error) {
+	item := didl.NewItem(m.URI, m.ProtocolInfo, m.Title)
+	if m.Class != "" {
+		item.Class = m.Class
+	}
+	item.Album = m.Album
+	item.AlbumArtURI = m.AlbumArtURI
+	if m.Artist != "" {
+		item.Artists = []didl.Artist{{Name: m.Artist}}
+	}
+	item.Resources[0].Duration = m.Duration
+	item.Resources[0].Size = m.Size
+	return item.Metadata()
+}
+
+// State is the state of a renderer, as last evented by its AVTransport
+// service.
+type State struct {
+	TransportState       string
+	TransportStatus      string
+	AVTransportURI       string
+	NextAVTransportURI   string
+	NumberOfTracks       uint32
+	CurrentTrack         uint32
+	CurrentTrackURI      string
+	CurrentTrackDuration time.Duration
+	// CurrentTrackMetadata is the decoded CurrentTrackMetaData, or nil if
+	// the renderer reported none.
+	CurrentTrackMetadata *didl.Item
+}
+
+// Position is the playback position of a renderer.
+type Position struct {
+	Track         uint32
+	TrackURI      string
+	TrackDuration time.Duration
+	// RelTime is the position within the track, and AbsTime within the whole
+	// media. They are zero if the renderer does not report them.
+	RelTime time.Duration
+	AbsTime time.Duration
+}
+
+// Connection is a connection to a renderer prepared with the
+// PrepareForConnection action of its ConnectionManager. AVTransportID and
+// RcsID are the InstanceIDs of the connection on the AVTransport and
+// RenderingControl services of the renderer, or -1 if it has none.
+type Connection struct {
+	ConnectionID  int32
+	AVTransportID int32
+	RcsID         int32
+}
+
+// Player controls a media renderer.
+type Player struct {
+	transport av1.AVTransport1Client
+	connMgr   av1.ConnectionManager1Client
+
+	lock       sync.Mutex // Protects all below.
+	instanceID uint32
+	conn       *Connection
+	noPrepare  bool // Whether PrepareForConnection is not implemented.
+	sinks      []didl.ProtocolInfo
+	haveSinks  bool
+	state      State
+	err        error
+	subscriber *gena.Subscriber
+	sub        *gena.Subscription
+	stop       chan struct{}
+	done       chan struct{}
+}
+
+// NewPlayer returns a Player of the renderer of transport. connMgr, which may
+// be nil, is the ConnectionManager of the renderer, used to check that it
+// accepts the content format of loaded media, and to prepare a connection for
+// it if the renderer implements PrepareForConnection.
+func NewPlayer(transport av1.AVTransport1Client, connMgr av1.ConnectionManager1Client) *Player {
+	return &Player{transport: transport, connMgr: connMgr}
+}
+
+// PlayerByURL returns a Player of the media renderer described at loc.
+func PlayerByURL(loc *url.URL) (*Player, error) {
+	root, err := goupnp.DeviceByURL(loc)
+	if err != nil {
+		return nil, err
+	}
+	transports, err := av1.NewAVTransport1ClientsFromRootDevice(root, loc)
+	if err != nil {
+		return nil, err
+	}
+	var connMgr av1.ConnectionManager1Client
+	if connMgrs, err := av1.NewConnectionManager1ClientsFromRootDevice(root, loc); err == nil {
+		connMgr = connMgrs[0]
+	}
+	return NewPlayer(transports[0], connMgr), nil
+}
+
+// Load sets the media of the renderer to m, replacing any queued media. It
+// fails if the renderer reports that it does not accept the content format of
+// m. If the renderer implements PrepareForConnection, a connection is prepared
+// for m, completing that of any previously loaded media, and the Player
+// controls the AVTransport instance of the connection.
+func (p *Player) Load(ctx context.Context, m Media) error {
+	if err := p.checkProtocolInfo(ctx, m.ProtocolInfo); err != nil {
+		return err
+	}
+	meta, err := m.Metadata()
+	if err != nil {
+		return err
+	}
+	if err := p.prepareConnection(ctx, m.ProtocolInfo); err != nil {
+		return err
+	}
+	return p.transport.SetAVTransportURICtx(ctx, p.instance(), m.URI, meta)
+}
+
+// Queue sets the media to play after the current media, for gapless playback
+// on renderers that support it.
+func (p *Player) Queue(ctx context.Context, m Media) error {
+	if err := p.checkProtocolInfo(ctx, m.ProtocolInfo); err != nil {
+		return err
+	}
+	meta, err := m.Metadata()
+	if err != nil {
+		return err
+	}
+	return p.transport.SetNextAVTransportURICtx(ctx, p.instance(), m.URI, meta)
+}
+
+// Play starts or resumes playback at normal speed.
+func (p *Player) Play(ctx context.Context) error {
+	return p.transport.PlayCtx(ctx, p.instance(), av1.AVTransport1TransportPlaySpeed_1)
+}
+
+// Pause pauses playback.
+func (p *Player) Pause(ctx context.Context) error {
+	return p.transport.PauseCtx(ctx, p.instance())
+}
+
+// Stop stops playback.
+func (p *Player) Stop(ctx context.Context) error {
+	return p.transport.StopCtx(ctx, p.instance())
+}
+
+// Seek seeks to position within the current track.
+func (p *Player) Seek(ctx context.Context, position time.Duration) error {
+	target := av.FormatTime(position.Truncate(time.Second))
+	return p.transport.SeekCtx(ctx, p.instance(), av1.AVTransport1SeekMode("REL_TIME"), target)
+}
+
+// TransportState returns the TransportState of the renderer, e.g.
+// TransportPlaying.
+func (p *Player) TransportState(ctx context.Context) (string, error) {
+	state, _, _, err := p.transport.GetTransportInfoCtx(ctx, p.instance())
+	return string(state), err
+}
+
+// Position returns the playback position of the renderer.
+func (p *Player) Position(ctx context.Context) (Position, error) {
+	track, trackDuration, _, trackURI, relTime, absTime, _, _, err := p.transport.GetPositionInfoCtx(ctx, p.instance())
+	if err != nil {
+		return Position{}, err
+	}
+	pos := Position{Track: track, TrackURI: trackURI}
+	// Values such as "NOT_IMPLEMENTED" are left as zero.
+	pos.TrackDuration = av.ParseTimeOrZero(trackDuration)
+	pos.RelTime = av.ParseTimeOrZero(relTime)
+	pos.AbsTime = av.ParseTimeOrZero(absTime)
+	return pos, nil
+}
+
+// Connection returns the connection prepared for the loaded media, or nil if
+// there is none, as the renderer does not implement PrepareForConnection. Its
+// RcsID is the InstanceID to control the volume of the media with.
+func (p *Player) Connection() *Connection {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.conn == nil {
+		return nil
+	}
+	conn := *p.conn
+	return &conn
+}
+
+// instance returns the InstanceID of the AVTransport controlled by p.
+func (p *Player) instance() uint32 {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.instanceID
+}
+
+// prepareConnection prepares a connection of the renderer for media of pi,
+// completing any previous connection, unless the renderer does not implement
+// PrepareForConnection.
+func (p *Player) prepareConnection(ctx context.Context, pi didl.ProtocolInfo) error {
+	if p.connMgr == nil {
+		return nil
+	}
+	p.lock.Lock()
+	noPrepare := p.noPrepare
+	p.lock.Unlock()
+	if noPrepare {
+		return nil
+	}
+	if err := p.completeConnection(ctx); err != nil {
+		return err
+	}
+	remote := pi.String()
+	if pi.ContentFormat == "" {
+		remote = "http-get:*:*:*"
+	}
+	connID, avtID, rcsID, err := p.connMgr.PrepareForConnectionCtx(ctx, remote, "", -1, av1.ConnectionManager1Direction_Input)
+	if isUPnPError(err, soap.ErrCodeInvalidAction, soap.ErrCodeOptionalActionNotImplemented) {
+		p.lock.Lock()
+		p.noPrepare = true
+		p.lock.Unlock()
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	p.conn = &Connection{ConnectionID: connID, AVTransportID: avtID, RcsID: rcsID}
+	p.instanceID = 0
+	if avtID > 0 {
+		p.instanceID = uint32(avtID)
+	}
+	return nil
+}
+
+// completeConnection completes the connection prepared by prepareConnection,
+// if any.
+func (p *Player) completeConnection(ctx context.Context) error {
+	p.lock.Lock()
+	conn := p.conn
+	p.conn, p.instanceID = nil, 0
+	p.lock.Unlock()
+	if conn == nil {
+		return nil
+	}
+	return p.connMgr.ConnectionCompleteCtx(ctx, conn.ConnectionID)
+}
+
+func isUPnPError(err error, codes ...int) bool {
+	got, ok := upnperr.FaultCode(err)
+	if !ok {
+		return false
+	}
+	for _, code := range codes {
+		if got == code {
+			return true
+		}
+	}
+	return false
+}
+
+// checkProtocolInfo checks that the renderer accepts media of pi, if it
+// reports the protocols that it accepts.
+func (p *Player) checkProtocolInfo(ctx context.Context, pi didl.ProtocolInfo) error {
+	if pi.ContentFormat == "" {
+		return nil
+	}
+	sinks, err := p.sinkProtocolInfo(ctx)
+	if err != nil || len(sinks) == 0 {
+		return err
+	}
+	for _, sink := range sinks {
+		if pi.Matches(sink) {
+			return nil
+		}
+	}
+	return fmt.Errorf("controlpoint: renderer does not accept %s over %s", pi.ContentFormat, pi.Protocol)
+}
+
+// BestResource returns the resource of resources, e.g. those of a
+// ContentDirectory item, that is best played by the renderer, as chosen by
+// didl.BestResource. If the renderer does not report the protocols that it
+// accepts, the first resource is returned.
+func (p *Player) BestResource(ctx context.Context, resources []didl.Resource) (*didl.Resource, error) {
+	if len(resources) == 0 {
+		return nil, errors.New("controlpoint: no resources")
+	}
+	sinks, err := p.sinkProtocolInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(sinks) == 0 {
+		return &resources[0], nil
+	}
+	if r := didl.BestResource(resources, sinks); r != nil {
+		return r, nil
+	}
+	return nil, errors.New("controlpoint: renderer accepts none of the resources")
+}
+
+// sinkProtocolInfo returns the Sink protocolInfo of the ConnectionManager of
+// the renderer, nil if it has none.
+func (p *Player) sinkProtocolInfo(ctx context.Context) ([]didl.ProtocolInfo, error) {
+	if p.connMgr == nil {
+		return nil, nil
+	}
+	p.lock.Lock()
+	sinks, haveSinks := p.sinks, p.haveSinks
+	p.lock.Unlock()
+	if haveSinks {
+		return sinks, nil
+	}
+	_, sink, err := p.connMgr.GetProtocolInfoCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sinks = didl.ParseProtocolInfoList(sink)
+	p.lock.Lock()
+	p.sinks, p.haveSinks = sinks, true
+	p.lock.Unlock()
+	return sinks, nil
+}
+
+// Subscribe subscribes to the events of the AVTransport service of the
+// renderer, and calls fn with its state on each change, from a single
+// goroutine at a time, until Close. The transport of p must have been created
+// from a *av1.AVTransport1.
+func (p *Player) Subscribe(fn func(State)) error {
+	client, ok := p.transport.(*av1.AVTransport1)
+	if !ok || !client.Service.EventSubURL.Ok {
+		return errors.New("controlpoint: AVTransport service cannot be subscribed to")
+	}
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	if p.subscriber != nil {
+		return errors.New("controlpoint: already subscribed")
+	}
+	var fnLock sync.Mutex
+	s, err := gena.NewSubscriber(gena.HandlerFunc(func(ev *gena.Event) {
+		value, ok := ev.Get("LastChange")
+		if !ok {
+			return
+		}
+		fnLock.Lock()
+		defer fnLock.Unlock()
+		if state, changed := p.applyLastChange(value); changed {
+			fn(state)
+		}
+	}))
+	if err != nil {
+		return err
+	}
+	eventURL := client.Service.EventSubURL.URL
+	sub, err := s.Subscribe(&eventURL, subscriptionTimeout)
+	if err != nil {
+		s.Close()
+		return err
+	}
+	p.subscriber, p.sub = s, sub
+	p.stop, p.done = make(chan struct{}), make(chan struct{})
+	go p.renew(sub, p.stop, p.done)
+	return nil
+}
+
+func (p *Player) renew(sub *gena.Subscription, stop, done chan struct{}) {
+	defer close(done)
+	for sub.Timeout > 0 {
+		select {
+		case <-stop:
+			return
+		case <-time.After(sub.Timeout / 2):
+		}
+		err := sub.Renew(subscriptionTimeout)
+		p.lock.Lock()
+		p.err = err
+		p.lock.Unlock()
+	}
+}
+
+// State returns the state of the renderer as last evented.
+func (p *Player) State() State {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.state
+}
+
+// Err returns the error of the last renewal of the event subscription, or nil
+// if it succeeded.
+func (p *Player) Err() error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.err
+}
+
+// Close unsubscribes from the events of the renderer, if subscribed, and
+// completes the connection prepared for the loaded media, if any.
+func (p *Player) Close() error {
+	p.lock.Lock()
+	s, sub, stop, done := p.subscriber, p.sub, p.stop, p.done
+	p.subscriber, p.sub = nil, nil
+	p.lock.Unlock()
+	var err error
+	if s != nil {
+		close(stop)
+		<-done
+		err = sub.Unsubscribe()
+		s.Close()
+	}
+	if cerr := p.completeConnection(context.Background()); err == nil {
+		err = cerr
+	}
+	return err
+}
+
+// applyLastChange applies a LastChange of the AVTransport service to the
+// state, returning the new state and whether it changed.
+func (p *Player) applyLastChange(value string) (State, bool) {
+	lc, err := av.ParseLastChange(value)
+	if err != nil {
+		return State{}, false
+	}
+	inst := lc.Instance(p.instance())
+	if inst == nil {
+		return State{}, false
+	}
+	changes, err := inst.AVTransport()
+	if err != nil {
+		return State{}, false
+	}
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	old := p.state
+	s := &p.state
+	setString := func(dst *string, v *string) {
+		if v != nil {
+			*dst = *v
+		}
+	}
+	setString(&s.TransportState, changes.TransportState)
+	setString(&s.TransportStatus, changes.TransportStatus)
+	setString(&s.AVTransportURI, changes.AVTransportURI)
+	setString(&s.NextAVTransportURI, changes.NextAVTransportURI)
+	setString(&s.CurrentTrackURI, changes.CurrentTrackURI)
+	if changes.NumberOfTracks != nil {
+		s.NumberOfTracks = *changes.NumberOfTracks
+	}
+	if changes.CurrentTrack != nil {
+		s.CurrentTrack = *changes.CurrentTrack
+	}
+	if changes.CurrentTrackDuration != nil {
+		s.CurrentTrackDuration = av.ParseTimeOrZero(*changes.CurrentTrackDuration)
+	}
+	changedMetadata := false
+	if changes.CurrentTrackMetaData != nil {
+		s.CurrentTrackMetadata = nil
+		if doc, err := didl.Unmarshal(*changes.CurrentTrackMetaData); err == nil && len(doc.Items) > 0 {
+			s.CurrentTrackMetadata = &doc.Items[0]
+		}
+		changedMetadata = s.CurrentTrackMetadata != nil || old.CurrentTrackMetadata != nil
+	}
+	oldCmp, newCmp := old, *s
+	oldCmp.CurrentTrackMetadata, newCmp.CurrentTrackMetadata = nil, nil
+	return *s, changedMetadata || oldCmp != newCmp
+}
@@ -0,0 +1,213 @@
+package controlpoint
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/huin/goupnp/av/didl"
+	"github.com/huin/goupnp/dcps/av1"
+	"github.com/huin/goupnp/soap"
+)
+
+type fakeTransport struct {
+	av1.AVTransport1Client
+	uri, meta, nextURI string
+	seekUnit           av1.AVTransport1SeekMode
+	seekTarget         string
+	instanceID         uint32
+	plays, stops       int
+	// noNext is whether SetNextAVTransportURI is not implemented.
+	noNext bool
+}
+
+func (f *fakeTransport) SetAVTransportURICtx(ctx context.Context, instanceID uint32, uri, meta string) error {
+	f.uri, f.meta, f.instanceID = uri, meta, instanceID
+	return nil
+}
+
+func (f *fakeTransport) SetNextAVTransportURICtx(ctx context.Context, instanceID uint32, uri, meta string) error {
+	if f.noNext {
+		return &soap.SOAPFaultError{UPnPError: soap.NewUPnPError(soap.ErrCodeOptionalActionNotImplemented, "Not Implemented")}
+	}
+	f.nextURI = uri
+	return nil
+}
+
+func (f *fakeTransport) PlayCtx(ctx context.Context, instanceID uint32, speed av1.AVTransport1TransportPlaySpeed) error {
+	f.plays++
+	return nil
+}
+
+func (f *fakeTransport) StopCtx(ctx context.Context, instanceID uint32) error {
+	f.stops++
+	return nil
+}
+
+func (f *fakeTransport) SeekCtx(ctx context.Context, instanceID uint32, unit av1.AVTransport1SeekMode, target string) error {
+	f.seekUnit, f.seekTarget = unit, target
+	return nil
+}
+
+func (f *fakeTransport) GetPositionInfoCtx(ctx context.Context, instanceID uint32) (uint32, string, string, string, string, string, int32, int32, error) {
+	return 1, "0:04:00", "", "http://10.0.0.2/a.mp3", "0:01:30.5", "NOT_IMPLEMENTED", 0, 0, nil
+}
+
+type fakeConnectionManager struct {
+	av1.ConnectionManager1Client
+	// prepare is whether PrepareForConnection is implemented.
+	prepare   bool
+	nextID    int32
+	remote    string
+	completed []int32
+}
+
+func (f *fakeConnectionManager) PrepareForConnectionCtx(ctx context.Context, remoteProtocolInfo, peerConnectionManager string, peerConnectionID int32, direction av1.ConnectionManager1Direction) (int32, int32, int32, error) {
+	if !f.prepare {
+		return 0, 0, 0, &soap.SOAPFaultError{UPnPError: soap.NewUPnPError(soap.ErrCodeInvalidAction, "Invalid Action")}
+	}
+	f.nextID++
+	f.remote = remoteProtocolInfo
+	return f.nextID, f.nextID + 10, f.nextID + 20, nil
+}
+
+func (f *fakeConnectionManager) ConnectionCompleteCtx(ctx context.Context, connectionID int32) error {
+	f.completed = append(f.completed, connectionID)
+	return nil
+}
+
+func (f *fakeConnectionManager) GetProtocolInfoCtx(ctx context.Context) (string, string, error) {
+	return "", "http-get:*:audio/mpeg:*,http-get:*:audio/flac:*", nil
+}
+
+func TestPlayer(t *testing.T) {
+	transport := &fakeTransport{}
+	p := NewPlayer(transport, &fakeConnectionManager{})
+	ctx := context.Background()
+
+	m := Media{
+		URI:          "http://10.0.0.2/a.mp3",
+		ProtocolInfo: didl.ParseProtocolInfo("http-get:*:audio/mpeg:*"),
+		Title:        "A",
+		Artist:       "Artist",
+		Duration:     4 * time.Minute,
+	}
+	if err := p.Load(ctx, m); err != nil {
+		t.Fatal(err)
+	}
+	doc, err := didl.Unmarshal(transport.meta)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if transport.uri != m.URI || len(doc.Items) != 1 || doc.Items[0].Class != didl.ClassMusicTrack ||
+		doc.Items[0].Artist() != "Artist" || doc.Items[0].Resources[0].URL != m.URI {
+		t.Errorf("loaded %q with metadata %+v", transport.uri, doc)
+	}
+
+	video := Media{URI: "http://10.0.0.2/v.mp4", ProtocolInfo: didl.ParseProtocolInfo("http-get:*:video/mp4:*")}
+	if err := p.Queue(ctx, video); err == nil {
+		t.Error("queued media of a format that the renderer does not accept")
+	}
+	m.URI = "http://10.0.0.2/b.mp3"
+	if err := p.Queue(ctx, m); err != nil || transport.nextURI != m.URI {
+		t.Errorf("Queue() = %v, queued %q", err, transport.nextURI)
+	}
+
+	if err := p.Seek(ctx, time.Hour+2*time.Minute+3500*time.Millisecond); err != nil {
+		t.Fatal(err)
+	}
+	if transport.seekUnit != "REL_TIME" || transport.seekTarget != "1:02:03" {
+		t.Errorf("sought %s %s", transport.seekUnit, transport.seekTarget)
+	}
+
+	pos, err := p.Position(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Position{Track: 1, TrackURI: "http://10.0.0.2/a.mp3", TrackDuration: 4 * time.Minute, RelTime: 90500 * time.Millisecond}
+	if pos != want {
+		t.Errorf("Position() = %+v, want %+v", pos, want)
+	}
+}
+
+func TestPlayerPrepareForConnection(t *testing.T) {
+	transport := &fakeTransport{}
+	connMgr := &fakeConnectionManager{prepare: true}
+	p := NewPlayer(transport, connMgr)
+	ctx := context.Background()
+
+	m := Media{URI: "http://10.0.0.2/a.mp3", ProtocolInfo: didl.ParseProtocolInfo("http-get:*:audio/mpeg:*")}
+	if err := p.Load(ctx, m); err != nil {
+		t.Fatal(err)
+	}
+	want := Connection{ConnectionID: 1, AVTransportID: 11, RcsID: 21}
+	if conn := p.Connection(); conn == nil || *conn != want {
+		t.Errorf("Connection() = %+v, want %+v", conn, want)
+	}
+	if transport.instanceID != 11 || connMgr.remote != "http-get:*:audio/mpeg:*" {
+		t.Errorf("loaded into instance %d for %q", transport.instanceID, connMgr.remote)
+	}
+
+	if err := p.Load(ctx, m); err != nil {
+		t.Fatal(err)
+	}
+	if transport.instanceID != 12 || len(connMgr.completed) != 1 || connMgr.completed[0] != 1 {
+		t.Errorf("reloaded into instance %d, completed %v", transport.instanceID, connMgr.completed)
+	}
+	if err := p.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if len(connMgr.completed) != 2 || p.Connection() != nil {
+		t.Errorf("completed %v on Close, connection %+v", connMgr.completed, p.Connection())
+	}
+
+	// Renderers not implementing PrepareForConnection use instance 0.
+	connMgr = &fakeConnectionManager{}
+	p = NewPlayer(transport, connMgr)
+	if err := p.Load(ctx, m); err != nil {
+		t.Fatal(err)
+	}
+	if transport.instanceID != 0 || p.Connection() != nil {
+		t.Errorf("loaded into instance %d with connection %+v", transport.instanceID, p.Connection())
+	}
+}
+
+func TestPlayerLastChange(t *testing.T) {
+	p := NewPlayer(&fakeTransport{}, nil)
+	state, changed := p.applyLastChange(`<Event xmlns="urn:schemas-upnp-org:metadata-1-0/AVT/"><InstanceID val="0">
+		<TransportState val="PLAYING"/>
+		<CurrentTrackURI val="http://10.0.0.2/a.mp3"/>
+		<CurrentTrackDuration val="0:04:00"/>
+		<CurrentTrackMetaData val="&lt;DIDL-Lite&gt;&lt;item id=&quot;0&quot;&gt;&lt;dc:title&gt;A&lt;/dc:title&gt;&lt;/item&gt;&lt;/DIDL-Lite&gt;"/>
+	</InstanceID></Event>`)
+	if !changed || state.TransportState != TransportPlaying || state.CurrentTrackDuration != 4*time.Minute ||
+		state.CurrentTrackMetadata == nil || state.CurrentTrackMetadata.Title != "A" {
+		t.Errorf("got state %+v, changed %t", state, changed)
+	}
+
+	state, changed = p.applyLastChange(`<Event><InstanceID val="0"><TransportState val="PLAYING"/></InstanceID></Event>`)
+	if changed || state.CurrentTrackURI != "http://10.0.0.2/a.mp3" {
+		t.Errorf("got state %+v, changed %t, want the unchanged state", state, changed)
+	}
+	if _, changed := p.applyLastChange(`<Event><InstanceID val="1"><TransportState val="STOPPED"/></InstanceID></Event>`); changed {
+		t.Error("applied the change of another instance")
+	}
+	if p.State().TransportState != TransportPlaying {
+		t.Errorf("State() = %+v", p.State())
+	}
+}
+
+func TestPlayerBestResource(t *testing.T) {
+	p := NewPlayer(&fakeTransport{}, &fakeConnectionManager{})
+	resources := []didl.Resource{
+		{URL: "http://10.0.0.2/a.wav", ProtocolInfo: didl.ParseProtocolInfo("http-get:*:audio/wav:*")},
+		{URL: "http://10.0.0.2/a.flac", ProtocolInfo: didl.ParseProtocolInfo("http-get:*:audio/flac:*")},
+	}
+	r, err := p.BestResource(context.Background(), resources)
+	if err != nil || r.URL != "http://10.0.0.2/a.flac" {
+		t.Errorf("BestResource() = %+v, %v, want the FLAC resource", r, err)
+	}
+	if _, err := p.BestResource(context.Background(), resources[:1]); err == nil {
+		t.Error("picked a resource that the renderer does not accept")
+	}
+}
@@ -0,0 +1,182 @@
+package controlpoint
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/huin/goupnp/soap"
+)
+
+// Playlist plays a list of media on a renderer, queueing each with the next
+// as it starts for gapless playback. Renderers without SetNextAVTransportURI
+// are loaded with the next media when they stop at the end of the current
+// media. Transitions are followed through the events of the renderer, so its
+// Player must be able to Subscribe.
+type Playlist struct {
+	player   *Player
+	media    []Media
+	onChange func(index int)
+
+	notifyLock sync.Mutex // Serializes calls of onChange.
+	lock       sync.Mutex // Protects all below.
+	ctx        context.Context
+	index      int
+	noNext     bool // Whether the renderer lacks SetNextAVTransportURI.
+	nextQueued bool
+	changed    bool // Whether index changed since onChange was called.
+	stopped    bool // Whether stopped by Stop.
+	lastState  string
+	err        error
+}
+
+// NewPlaylist returns a Playlist of media on the renderer of player. onChange,
+// which may be nil, is called with the index of the media whenever other media
+// starts, from a single goroutine at a time.
+func NewPlaylist(player *Player, media []Media, onChange func(index int)) *Playlist {
+	return &Playlist{player: player, media: media, onChange: onChange, index: -1}
+}
+
+// Start subscribes to the events of the renderer and plays the first media.
+// Actions on the renderer in response to its events are performed with ctx.
+func (pl *Playlist) Start(ctx context.Context) error {
+	if len(pl.media) == 0 {
+		return errors.New("controlpoint: empty playlist")
+	}
+	pl.lock.Lock()
+	pl.ctx = ctx
+	err := pl.play(ctx, 0)
+	pl.unlock()
+	if err != nil {
+		return err
+	}
+	return pl.player.Subscribe(pl.update)
+}
+
+// Index returns the index of the current media, or -1 before Start.
+func (pl *Playlist) Index() int {
+	pl.lock.Lock()
+	defer pl.lock.Unlock()
+	return pl.index
+}
+
+// Err returns the error of the last action on the renderer in response to its
+// events, or nil if it succeeded.
+func (pl *Playlist) Err() error {
+	pl.lock.Lock()
+	defer pl.lock.Unlock()
+	return pl.err
+}
+
+// Skip plays the media at index.
+func (pl *Playlist) Skip(ctx context.Context, index int) error {
+	if index < 0 || index >= len(pl.media) {
+		return fmt.Errorf("controlpoint: playlist index %d out of range [0,%d)", index, len(pl.media))
+	}
+	pl.lock.Lock()
+	defer pl.unlock()
+	return pl.play(ctx, index)
+}
+
+// Next plays the media after the current media.
+func (pl *Playlist) Next(ctx context.Context) error {
+	return pl.Skip(ctx, pl.Index()+1)
+}
+
+// Previous plays the media before the current media.
+func (pl *Playlist) Previous(ctx context.Context) error {
+	return pl.Skip(ctx, pl.Index()-1)
+}
+
+// Stop stops playback, without advancing to the next media.
+func (pl *Playlist) Stop(ctx context.Context) error {
+	pl.lock.Lock()
+	defer pl.lock.Unlock()
+	pl.stopped = true
+	return pl.player.Stop(ctx)
+}
+
+// Close stops following the renderer, closing its Player.
+func (pl *Playlist) Close() error {
+	return pl.player.Close()
+}
+
+// play loads and plays the media at index, and queues the media after it.
+// pl.lock must be held.
+func (pl *Playlist) play(ctx context.Context, index int) error {
+	if err := pl.player.Load(ctx, pl.media[index]); err != nil {
+		return err
+	}
+	if err := pl.player.Play(ctx); err != nil {
+		return err
+	}
+	pl.setIndex(index)
+	pl.stopped, pl.nextQueued = false, false
+	return pl.queueNext(ctx)
+}
+
+// queueNext queues the media after the current media, if the renderer
+// supports it. pl.lock must be held.
+func (pl *Playlist) queueNext(ctx context.Context) error {
+	if pl.noNext || pl.index+1 >= len(pl.media) {
+		return nil
+	}
+	err := pl.player.Queue(ctx, pl.media[pl.index+1])
+	if isUPnPError(err, soap.ErrCodeInvalidAction, soap.ErrCodeOptionalActionNotImplemented) {
+		pl.noNext = true
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	pl.nextQueued = true
+	return nil
+}
+
+// setIndex sets the index of the current media. pl.lock must be held.
+func (pl *Playlist) setIndex(index int) {
+	if pl.index != index {
+		pl.index, pl.changed = index, true
+	}
+}
+
+// unlock releases pl.lock, then calls onChange if the index changed while it
+// was held.
+func (pl *Playlist) unlock() {
+	changed, index := pl.changed, pl.index
+	pl.changed = false
+	if !changed || pl.onChange == nil {
+		pl.lock.Unlock()
+		return
+	}
+	pl.notifyLock.Lock()
+	pl.lock.Unlock()
+	defer pl.notifyLock.Unlock()
+	pl.onChange(index)
+}
+
+// update follows the state of the renderer, advancing the playlist when the
+// renderer moves to the queued media, or stops at the end of the current
+// media.
+func (pl *Playlist) update(s State) {
+	pl.lock.Lock()
+	defer pl.unlock()
+	lastState := pl.lastState
+	pl.lastState = s.TransportState
+
+	next := pl.index + 1
+	if next >= len(pl.media) {
+		return
+	}
+	uri := pl.media[next].URI
+	switch {
+	case pl.nextQueued && (s.CurrentTrackURI == uri || s.AVTransportURI == uri):
+		pl.setIndex(next)
+		pl.nextQueued = false
+		pl.err = pl.queueNext(pl.ctx)
+	case s.TransportState == TransportStopped && !pl.stopped &&
+		(lastState == TransportPlaying || lastState == TransportTransitioning):
+		pl.err = pl.play(pl.ctx, next)
+	}
+}
@@ -0,0 +1,77 @@
+package controlpoint
+
+import (
+	"context"
+	"reflect"
+	"testing"
+)
+
+var testPlaylist = []Media{
+	{URI: "http://10.0.0.2/1.mp3"},
+	{URI: "http://10.0.0.2/2.mp3"},
+	{URI: "http://10.0.0.2/3.mp3"},
+}
+
+func TestPlaylistGapless(t *testing.T) {
+	transport := &fakeTransport{}
+	var changes []int
+	pl := NewPlaylist(NewPlayer(transport, nil), testPlaylist, func(index int) { changes = append(changes, index) })
+	ctx := context.Background()
+	// Start as far as subscribing, which the fake transport does not support.
+	pl.lock.Lock()
+	pl.ctx = ctx
+	if err := pl.play(ctx, 0); err != nil {
+		t.Fatal(err)
+	}
+	pl.unlock()
+	if transport.uri != testPlaylist[0].URI || transport.nextURI != testPlaylist[1].URI || transport.plays != 1 {
+		t.Fatalf("started with %q, next %q, %d plays", transport.uri, transport.nextURI, transport.plays)
+	}
+
+	pl.update(State{TransportState: TransportPlaying, CurrentTrackURI: testPlaylist[0].URI})
+	pl.update(State{TransportState: TransportPlaying, CurrentTrackURI: testPlaylist[1].URI})
+	if pl.Index() != 1 || transport.nextURI != testPlaylist[2].URI || transport.plays != 1 {
+		t.Errorf("after transition: index %d, next %q, %d plays", pl.Index(), transport.nextURI, transport.plays)
+	}
+
+	if err := pl.Previous(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err := pl.Skip(ctx, 3); err == nil {
+		t.Error("skipped past the end of the playlist")
+	}
+	if !reflect.DeepEqual(changes, []int{0, 1, 0}) {
+		t.Errorf("got changes %v", changes)
+	}
+}
+
+func TestPlaylistWithoutNext(t *testing.T) {
+	transport := &fakeTransport{noNext: true}
+	pl := NewPlaylist(NewPlayer(transport, nil), testPlaylist, nil)
+	ctx := context.Background()
+	pl.lock.Lock()
+	pl.ctx = ctx
+	if err := pl.play(ctx, 0); err != nil {
+		t.Fatal(err)
+	}
+	pl.unlock()
+
+	pl.update(State{TransportState: TransportPlaying, CurrentTrackURI: testPlaylist[0].URI})
+	pl.update(State{TransportState: TransportStopped, CurrentTrackURI: testPlaylist[0].URI})
+	if err := pl.Err(); err != nil {
+		t.Fatal(err)
+	}
+	if pl.Index() != 1 || transport.uri != testPlaylist[1].URI || transport.plays != 2 {
+		t.Errorf("after the end of the media: index %d, loaded %q, %d plays", pl.Index(), transport.uri, transport.plays)
+	}
+
+	// Stopping does not advance.
+	pl.update(State{TransportState: TransportPlaying, CurrentTrackURI: testPlaylist[1].URI})
+	if err := pl.Stop(ctx); err != nil {
+		t.Fatal(err)
+	}
+	pl.update(State{TransportState: TransportStopped, CurrentTrackURI: testPlaylist[1].URI})
+	if pl.Index() != 1 || transport.plays != 2 {
+		t.Errorf("after Stop: index %d, %d plays", pl.Index(), transport.plays)
+	}
+}
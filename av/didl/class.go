@@ -0,0 +1,75 @@
+package didl
+
+import "strings"
+
+// Class is the upnp:class of an object, a dot separated path in the class
+// hierarchy of the ContentDirectory specification, e.g.
+// "object.item.audioItem.musicTrack". Vendors extend the hierarchy with
+// subclasses of their own.
+type Class string
+
+// The classes of the ContentDirectory:1 specification.
+const (
+	ClassObject = Class("object")
+
+	ClassItem           = Class("object.item")
+	ClassImageItem      = Class("object.item.imageItem")
+	ClassPhoto          = Class("object.item.imageItem.photo")
+	ClassAudioItem      = Class("object.item.audioItem")
+	ClassMusicTrack     = Class("object.item.audioItem.musicTrack")
+	ClassAudioBroadcast = Class("object.item.audioItem.audioBroadcast")
+	ClassAudioBook      = Class("object.item.audioItem.audioBook")
+	ClassVideoItem      = Class("object.item.videoItem")
+	ClassMovie          = Class("object.item.videoItem.movie")
+	ClassVideoBroadcast = Class("object.item.videoItem.videoBroadcast")
+	ClassMusicVideoClip = Class("object.item.videoItem.musicVideoClip")
+	ClassPlaylistItem   = Class("object.item.playlistItem")
+	ClassTextItem       = Class("object.item.textItem")
+
+	ClassContainer         = Class("object.container")
+	ClassPerson            = Class("object.container.person")
+	ClassMusicArtist       = Class("object.container.person.musicArtist")
+	ClassPlaylistContainer = Class("object.container.playlistContainer")
+	ClassAlbum             = Class("object.container.album")
+	ClassMusicAlbum        = Class("object.container.album.musicAlbum")
+	ClassPhotoAlbum        = Class("object.container.album.photoAlbum")
+	ClassGenre             = Class("object.container.genre")
+	ClassMusicGenre        = Class("object.container.genre.musicGenre")
+	ClassMovieGenre        = Class("object.container.genre.movieGenre")
+	ClassStorageSystem     = Class("object.container.storageSystem")
+	ClassStorageVolume     = Class("object.container.storageVolume")
+	ClassStorageFolder     = Class("object.container.storageFolder")
+)
+
+// IsA returns whether c is base or a subclass of it.
+func (c Class) IsA(base Class) bool {
+	return c == base || strings.HasPrefix(string(c), string(base)+".")
+}
+
+// Parent returns the class that c is a subclass of, or "" for ClassObject.
+func (c Class) Parent() Class {
+	if i := strings.LastIndexByte(string(c), '.'); i >= 0 {
+		return c[:i]
+	}
+	return ""
+}
+
+// IsContainer returns whether c is a container class.
+func (c Class) IsContainer() bool {
+	return c.IsA(ClassContainer)
+}
+
+// ItemClassOf returns the item class of content of the MIME type
+// contentFormat, e.g. ClassMusicTrack for "audio/mpeg", or ClassItem if it is
+// not audio, video or an image.
+func ItemClassOf(contentFormat string) Class {
+	switch {
+	case strings.HasPrefix(contentFormat, "audio/"):
+		return ClassMusicTrack
+	case strings.HasPrefix(contentFormat, "video/"):
+		return ClassVideoItem
+	case strings.HasPrefix(contentFormat, "image/"):
+		return ClassPhoto
+	}
+	return ClassItem
+}
@@ -0,0 +1,480 @@
+// Package didl marshals and unmarshals DIDL-Lite documents, the metadata of
+// ContentDirectory objects returned by Browse and Search, and of the media
+// given to and reported by AVTransport services.
+//
+// DIDL-Lite from real devices is often sloppy: namespaces are missing or
+// misdeclared, ampersands in URLs are left unescaped, and whole documents are
+// escaped twice. Unmarshal matches elements by their local names and
+// tolerates all of these, while Marshal writes documents as specified.
+package didl
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The namespaces of DIDL-Lite documents.
+const (
+	NamespaceDIDL = "urn:schemas-upnp-org:metadata-1-0/DIDL-Lite/"
+	NamespaceDC   = "http://purl.org/dc/elements/1.1/"
+	NamespaceUPnP = "urn:schemas-upnp-org:metadata-1-0/upnp/"
+	NamespaceDLNA = "urn:schemas-dlna-org:metadata-1-0/"
+)
+
+// Document is a DIDL-Lite document.
+type Document struct {
+	// Containers and Items are the objects of the document, each in
+	// document order.
+	Containers []Container
+	Items      []Item
+}
+
+// Object is the properties common to containers and items.
+type Object struct {
+	ID         string
+	ParentID   string
+	Restricted bool
+	Title      string
+	Class      Class
+
+	// Optional properties, left out of marshaled documents when empty.
+	Creator     string
+	Artists     []Artist
+	Album       string
+	Genre       string
+	AlbumArtURI string
+	// AlbumArt is each upnp:albumArtURI of the object, of which AlbumArtURI
+	// is the first. Marshal writes AlbumArt if it is not empty, and
+	// AlbumArtURI otherwise.
+	AlbumArt            []AlbumArt
+	Date                string
+	Description         string
+	OriginalTrackNumber int
+	Resources           []Resource
+}
+
+// Artist returns the name of the first of the artists of o, or "" if it has
+// none.
+func (o *Object) Artist() string {
+	if len(o.Artists) == 0 {
+		return ""
+	}
+	return o.Artists[0].Name
+}
+
+// Artist is an upnp:artist of an object.
+type Artist struct {
+	Name string
+	// Role is the role of the artist, e.g. "AlbumArtist" or "Composer", or
+	// "" for the performer.
+	Role string
+}
+
+// AlbumArt is an upnp:albumArtURI of an object.
+type AlbumArt struct {
+	URI string
+	// Profile is the DLNA media format profile of the image, of the
+	// dlna:profileID attribute, e.g. "JPEG_TN" or "PNG_SM".
+	Profile string
+}
+
+// Container is a container object, such as a folder or album.
+type Container struct {
+	Object
+	// ChildCount is the number of children of the container, zero if it is
+	// not known.
+	ChildCount int
+	// Searchable is whether Search may be performed on the container.
+	Searchable bool
+}
+
+// Item is an item object, such as a track or photo.
+type Item struct {
+	Object
+	// RefID is the ID of the item that the item is a reference to, if any.
+	RefID string
+}
+
+// Resource is a res element of an object, typically a URL from which its
+// content can be retrieved.
+type Resource struct {
+	URL          string
+	ProtocolInfo ProtocolInfo
+
+	// Optional properties, left out of marshaled documents when zero.
+	Size            uint64
+	Duration        time.Duration
+	Bitrate         uint32
+	SampleFrequency uint32
+	NrAudioChannels uint32
+	// Resolution is the resolution of images and videos, e.g. "1920x1080".
+	Resolution string
+}
+
+// xmlDocument and friends are the XML structures used to unmarshal documents.
+// Element and attribute names are matched regardless of namespace.
+type xmlDocument struct {
+	XMLName xml.Name
+	Objects []xmlObject `xml:",any"`
+}
+
+type xmlObject struct {
+	XMLName             xml.Name
+	ID                  string        `xml:"id,attr"`
+	ParentID            string        `xml:"parentID,attr"`
+	RefID               string        `xml:"refID,attr"`
+	Restricted          string        `xml:"restricted,attr"`
+	ChildCount          string        `xml:"childCount,attr"`
+	Searchable          string        `xml:"searchable,attr"`
+	Title               string        `xml:"title"`
+	Creator             string        `xml:"creator"`
+	Class               string        `xml:"class"`
+	Artists             []xmlArtist   `xml:"artist"`
+	Album               string        `xml:"album"`
+	Genres              []string      `xml:"genre"`
+	AlbumArtURIs        []xmlAlbumArt `xml:"albumArtURI"`
+	Date                string        `xml:"date"`
+	Description         string        `xml:"description"`
+	OriginalTrackNumber string        `xml:"originalTrackNumber"`
+	Resources           []xmlResource `xml:"res"`
+}
+
+type xmlArtist struct {
+	Role string `xml:"role,attr"`
+	Name string `xml:",chardata"`
+}
+
+type xmlAlbumArt struct {
+	Profile string `xml:"profileID,attr"`
+	URI     string `xml:",chardata"`
+}
+
+type xmlResource struct {
+	ProtocolInfo    string `xml:"protocolInfo,attr"`
+	Size            string `xml:"size,attr"`
+	Duration        string `xml:"duration,attr"`
+	Bitrate         string `xml:"bitrate,attr"`
+	SampleFrequency string `xml:"sampleFrequency,attr"`
+	NrAudioChannels string `xml:"nrAudioChannels,attr"`
+	Resolution      string `xml:"resolution,attr"`
+	URL             string `xml:",chardata"`
+}
+
+// Unmarshal decodes a DIDL-Lite document, such as the Result of Browse.
+// Documents that have been escaped twice are unescaped again before decoding,
+// and malformed values of optional properties are ignored.
+func Unmarshal(s string) (*Document, error) {
+	s = strings.TrimSpace(strings.TrimPrefix(s, "\ufeff"))
+	if strings.HasPrefix(s, "&lt;") {
+		s = html.UnescapeString(s)
+	}
+	if s == "" {
+		return &Document{}, nil
+	}
+
+	d := xml.NewDecoder(strings.NewReader(s))
+	d.Strict = false
+	d.Entity = xml.HTMLEntity
+	var doc xmlDocument
+	if err := d.Decode(&doc); err != nil {
+		return nil, fmt.Errorf("didl: error decoding DIDL-Lite: %v", err)
+	}
+	if doc.XMLName.Local != "DIDL-Lite" {
+		return nil, fmt.Errorf("didl: unexpected root element %q", doc.XMLName.Local)
+	}
+
+	result := new(Document)
+	for i := range doc.Objects {
+		xo := &doc.Objects[i]
+		switch xo.XMLName.Local {
+		case "container":
+			result.Containers = append(result.Containers, Container{
+				Object:     xo.object(),
+				ChildCount: int(parseUint(xo.ChildCount, 31)),
+				Searchable: parseBool(xo.Searchable),
+			})
+		case "item":
+			result.Items = append(result.Items, Item{
+				Object: xo.object(),
+				RefID:  strings.TrimSpace(xo.RefID),
+			})
+		}
+		// Other elements, such as desc, are skipped.
+	}
+	return result, nil
+}
+
+func (xo *xmlObject) object() Object {
+	o := Object{
+		ID:                  strings.TrimSpace(xo.ID),
+		ParentID:            strings.TrimSpace(xo.ParentID),
+		Restricted:          parseBool(xo.Restricted),
+		Title:               strings.TrimSpace(xo.Title),
+		Class:               Class(strings.TrimSpace(xo.Class)),
+		Creator:             strings.TrimSpace(xo.Creator),
+		Album:               strings.TrimSpace(xo.Album),
+		Date:                strings.TrimSpace(xo.Date),
+		Description:         strings.TrimSpace(xo.Description),
+		OriginalTrackNumber: int(parseUint(xo.OriginalTrackNumber, 31)),
+	}
+	for _, a := range xo.Artists {
+		o.Artists = append(o.Artists, Artist{Name: strings.TrimSpace(a.Name), Role: strings.TrimSpace(a.Role)})
+	}
+	if len(xo.Genres) > 0 {
+		o.Genre = strings.TrimSpace(xo.Genres[0])
+	}
+	for _, a := range xo.AlbumArtURIs {
+		o.AlbumArt = append(o.AlbumArt, AlbumArt{URI: strings.TrimSpace(a.URI), Profile: strings.TrimSpace(a.Profile)})
+	}
+	if len(o.AlbumArt) > 0 {
+		o.AlbumArtURI = o.AlbumArt[0].URI
+	}
+	for _, xr := range xo.Resources {
+		r := Resource{
+			URL:             strings.TrimSpace(xr.URL),
+			ProtocolInfo:    ParseProtocolInfo(xr.ProtocolInfo),
+			Size:            parseUint(xr.Size, 64),
+			Bitrate:         uint32(parseUint(xr.Bitrate, 32)),
+			SampleFrequency: uint32(parseUint(xr.SampleFrequency, 32)),
+			NrAudioChannels: uint32(parseUint(xr.NrAudioChannels, 32)),
+			Resolution:      strings.TrimSpace(xr.Resolution),
+		}
+		r.Duration, _ = ParseDuration(xr.Duration)
+		o.Resources = append(o.Resources, r)
+	}
+	return o
+}
+
+func parseBool(s string) bool {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "1", "true", "yes":
+		return true
+	}
+	return false
+}
+
+// parseUint parses an unsigned integer of the given bit size, returning zero
+// if s is not one.
+func parseUint(s string, bitSize int) uint64 {
+	v, err := strconv.ParseUint(strings.TrimSpace(s), 10, bitSize)
+	if err != nil {
+		return 0
+	}
+	return v
+}
+
+// ParseDuration parses a duration of the form [+-]H+:MM:SS[.F+] or
+// [+-]H+:MM:SS[.F0/F1], as used by res@duration and the AVTransport position
+// variables. Durations of only minutes and seconds, or only seconds, as sent
+// by some devices, are also accepted, as is "" for zero.
+func ParseDuration(s string) (time.Duration, error) {
+	orig := s
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return 0, nil
+	}
+	sign := time.Duration(1)
+	switch s[0] {
+	case '-':
+		sign = -1
+		fallthrough
+	case '+':
+		s = s[1:]
+	}
+	parts := strings.Split(s, ":")
+	if len(parts) > 3 {
+		return 0, fmt.Errorf("didl: malformed duration %q", orig)
+	}
+	for len(parts) < 3 {
+		parts = append([]string{"0"}, parts...)
+	}
+	secs, frac := parts[2], ""
+	if dot := strings.IndexByte(secs, '.'); dot >= 0 {
+		secs, frac = secs[:dot], secs[dot+1:]
+	}
+	var fields [3]uint64
+	for i, p := range []string{parts[0], parts[1], secs} {
+		v, err := strconv.ParseUint(p, 10, 32)
+		if err != nil {
+			return 0, fmt.Errorf("didl: malformed duration %q", orig)
+		}
+		fields[i] = v
+	}
+	d := time.Duration(fields[0])*time.Hour + time.Duration(fields[1])*time.Minute + time.Duration(fields[2])*time.Second
+	if frac == "" {
+		return sign * d, nil
+	}
+	if slash := strings.IndexByte(frac, '/'); slash >= 0 {
+		num, err1 := strconv.ParseUint(frac[:slash], 10, 32)
+		den, err2 := strconv.ParseUint(frac[slash+1:], 10, 32)
+		if err1 != nil || err2 != nil || den == 0 || num >= den {
+			return 0, fmt.Errorf("didl: malformed duration %q", orig)
+		}
+		return sign * (d + time.Duration(num)*time.Second/time.Duration(den)), nil
+	}
+	f, err := strconv.ParseFloat("0."+frac, 64)
+	if err != nil {
+		return 0, fmt.Errorf("didl: malformed duration %q", orig)
+	}
+	return sign * (d + time.Duration(f*float64(time.Second))), nil
+}
+
+// FormatDuration formats d in the H+:MM:SS.FFF form used by res@duration.
+func FormatDuration(d time.Duration) string {
+	ms := d / time.Millisecond
+	return fmt.Sprintf("%d:%02d:%02d.%03d", ms/3600000, ms/60000%60, ms/1000%60, ms%1000)
+}
+
+// marshalDocument and friends are the XML structures used to marshal
+// documents, with the prefixes of the namespaces declared on the root.
+type marshalDocument struct {
+	XMLName   xml.Name        `xml:"DIDL-Lite"`
+	Xmlns     string          `xml:"xmlns,attr"`
+	XmlnsDC   string          `xml:"xmlns:dc,attr"`
+	XmlnsUPnP string          `xml:"xmlns:upnp,attr"`
+	XmlnsDLNA string          `xml:"xmlns:dlna,attr,omitempty"`
+	Objects   []marshalObject `xml:",any"`
+}
+
+type marshalObject struct {
+	XMLName             xml.Name
+	ID                  string            `xml:"id,attr"`
+	ParentID            string            `xml:"parentID,attr"`
+	RefID               string            `xml:"refID,attr,omitempty"`
+	Restricted          string            `xml:"restricted,attr"`
+	ChildCount          string            `xml:"childCount,attr,omitempty"`
+	Searchable          string            `xml:"searchable,attr,omitempty"`
+	Title               string            `xml:"dc:title"`
+	Creator             string            `xml:"dc:creator,omitempty"`
+	Class               string            `xml:"upnp:class"`
+	Artists             []marshalArtist   `xml:"upnp:artist"`
+	Album               string            `xml:"upnp:album,omitempty"`
+	Genre               string            `xml:"upnp:genre,omitempty"`
+	AlbumArtURIs        []marshalAlbumArt `xml:"upnp:albumArtURI"`
+	Date                string            `xml:"dc:date,omitempty"`
+	Description         string            `xml:"dc:description,omitempty"`
+	OriginalTrackNumber string            `xml:"upnp:originalTrackNumber,omitempty"`
+	Resources           []marshalResource `xml:"res"`
+}
+
+type marshalArtist struct {
+	Role string `xml:"role,attr,omitempty"`
+	Name string `xml:",chardata"`
+}
+
+type marshalAlbumArt struct {
+	Profile string `xml:"dlna:profileID,attr,omitempty"`
+	URI     string `xml:",chardata"`
+}
+
+type marshalResource struct {
+	ProtocolInfo    string `xml:"protocolInfo,attr"`
+	Size            string `xml:"size,attr,omitempty"`
+	Duration        string `xml:"duration,attr,omitempty"`
+	Bitrate         string `xml:"bitrate,attr,omitempty"`
+	SampleFrequency string `xml:"sampleFrequency,attr,omitempty"`
+	NrAudioChannels string `xml:"nrAudioChannels,attr,omitempty"`
+	Resolution      string `xml:"resolution,attr,omitempty"`
+	URL             string `xml:",chardata"`
+}
+
+// Marshal encodes doc as a DIDL-Lite document, containers first.
+func Marshal(doc *Document) (string, error) {
+	md := marshalDocument{
+		Xmlns:     NamespaceDIDL,
+		XmlnsDC:   NamespaceDC,
+		XmlnsUPnP: NamespaceUPnP,
+	}
+	for i := range doc.Containers {
+		c := &doc.Containers[i]
+		mo := marshalObjectOf(&c.Object)
+		mo.XMLName.Local = "container"
+		mo.ChildCount = strconv.Itoa(c.ChildCount)
+		mo.Searchable = boolAttr(c.Searchable)
+		md.Objects = append(md.Objects, mo)
+	}
+	for i := range doc.Items {
+		it := &doc.Items[i]
+		mo := marshalObjectOf(&it.Object)
+		mo.XMLName.Local = "item"
+		mo.RefID = it.RefID
+		md.Objects = append(md.Objects, mo)
+	}
+	for _, mo := range md.Objects {
+		for _, a := range mo.AlbumArtURIs {
+			if a.Profile != "" {
+				md.XmlnsDLNA = NamespaceDLNA
+			}
+		}
+	}
+
+	buf := new(bytes.Buffer)
+	if err := xml.NewEncoder(buf).Encode(&md); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func marshalObjectOf(o *Object) marshalObject {
+	mo := marshalObject{
+		ID:          o.ID,
+		ParentID:    o.ParentID,
+		Restricted:  boolAttr(o.Restricted),
+		Title:       o.Title,
+		Creator:     o.Creator,
+		Class:       string(o.Class),
+		Album:       o.Album,
+		Genre:       o.Genre,
+		Date:        o.Date,
+		Description: o.Description,
+	}
+	if len(o.AlbumArt) > 0 {
+		for _, a := range o.AlbumArt {
+			mo.AlbumArtURIs = append(mo.AlbumArtURIs, marshalAlbumArt{Profile: a.Profile, URI: a.URI})
+		}
+	} else if o.AlbumArtURI != "" {
+		mo.AlbumArtURIs = []marshalAlbumArt{{URI: o.AlbumArtURI}}
+	}
+	if o.OriginalTrackNumber > 0 {
+		mo.OriginalTrackNumber = strconv.Itoa(o.OriginalTrackNumber)
+	}
+	for _, a := range o.Artists {
+		mo.Artists = append(mo.Artists, marshalArtist{Role: a.Role, Name: a.Name})
+	}
+	for _, r := range o.Resources {
+		mr := marshalResource{
+			ProtocolInfo: r.ProtocolInfo.String(),
+			Resolution:   r.Resolution,
+			URL:          r.URL,
+		}
+		if r.Size > 0 {
+			mr.Size = strconv.FormatUint(r.Size, 10)
+		}
+		if r.Duration > 0 {
+			mr.Duration = FormatDuration(r.Duration)
+		}
+		if r.Bitrate > 0 {
+			mr.Bitrate = strconv.FormatUint(uint64(r.Bitrate), 10)
+		}
+		if r.SampleFrequency > 0 {
+			mr.SampleFrequency = strconv.FormatUint(uint64(r.SampleFrequency), 10)
+		}
+		if r.NrAudioChannels > 0 {
+			mr.NrAudioChannels = strconv.FormatUint(uint64(r.NrAudioChannels), 10)
+		}
+		mo.Resources = append(mo.Resources, mr)
+	}
+	return mo
+}
+
+func boolAttr(b bool) string {
+	if b {
+		return "1"
+	}
+	return "0"
+}
@@ -0,0 +1,136 @@
+package didl
+
+import (
+	"html"
+	"reflect"
+	"testing"
+	"time"
+)
+
+// testSloppyDIDL declares no namespaces for its prefixes, and leaves the
+// ampersand of a URL unescaped.
+const testSloppyDIDL = `<DIDL-Lite xmlns="urn:schemas-upnp-org:metadata-1-0/DIDL-Lite/">
+<container id="1" parentID="0" restricted="1" childCount="12" searchable="true">
+	<dc:title>Music</dc:title>
+	<upnp:class>object.container.storageFolder</upnp:class>
+</container>
+<item id="1$2" parentID="1" restricted="0">
+	<dc:title> Track </dc:title>
+	<upnp:class>object.item.audioItem.musicTrack</upnp:class>
+	<upnp:artist>Performer</upnp:artist>
+	<upnp:artist role="Composer">Composer</upnp:artist>
+	<upnp:album>Album</upnp:album>
+	<upnp:albumArtURI dlna:profileID="JPEG_TN">http://10.0.0.1/art?id=2&size=small</upnp:albumArtURI>
+	<upnp:originalTrackNumber>3</upnp:originalTrackNumber>
+	<res protocolInfo="http-get:*:audio/mpeg:*" size="4096" duration="0:03:25.500" bitrate="bogus">http://10.0.0.1/2.mp3</res>
+</item>
+<desc id="vendor">ignored</desc>
+</DIDL-Lite>`
+
+func TestUnmarshal(t *testing.T) {
+	doc, err := Unmarshal(testSloppyDIDL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &Document{
+		Containers: []Container{{
+			Object: Object{
+				ID: "1", ParentID: "0", Restricted: true,
+				Title: "Music", Class: ClassStorageFolder,
+			},
+			ChildCount: 12,
+			Searchable: true,
+		}},
+		Items: []Item{{Object: Object{
+			ID: "1$2", ParentID: "1",
+			Title:               "Track",
+			Class:               ClassMusicTrack,
+			Artists:             []Artist{{Name: "Performer"}, {Name: "Composer", Role: "Composer"}},
+			Album:               "Album",
+			AlbumArtURI:         "http://10.0.0.1/art?id=2&size=small",
+			AlbumArt:            []AlbumArt{{URI: "http://10.0.0.1/art?id=2&size=small", Profile: "JPEG_TN"}},
+			OriginalTrackNumber: 3,
+			Resources: []Resource{{
+				URL:          "http://10.0.0.1/2.mp3",
+				ProtocolInfo: ProtocolInfo{"http-get", "*", "audio/mpeg", "*"},
+				Size:         4096,
+				Duration:     3*time.Minute + 25500*time.Millisecond,
+			}},
+		}}},
+	}
+	if !reflect.DeepEqual(doc, want) {
+		t.Errorf("Unmarshal() =\n%+v\nwant\n%+v", doc, want)
+	}
+	if doc.Items[0].Artist() != "Performer" {
+		t.Errorf("Artist() = %q", doc.Items[0].Artist())
+	}
+
+	if _, err := Unmarshal("<Event/>"); err == nil {
+		t.Error("unmarshaled a document that is not DIDL-Lite")
+	}
+}
+
+func TestMarshalRoundTrip(t *testing.T) {
+	doc, err := Unmarshal(testSloppyDIDL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := Marshal(doc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Documents escaped twice, as sent by some renderers, are also accepted.
+	for _, s := range []string{s, html.EscapeString(s)} {
+		got, err := Unmarshal(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(got, doc) {
+			t.Errorf("round trip of\n%s\n=\n%+v\nwant\n%+v", s, got, doc)
+		}
+	}
+}
+
+func TestParseDuration(t *testing.T) {
+	tests := []struct {
+		s    string
+		want time.Duration
+	}{
+		{"", 0},
+		{"1:02:03", time.Hour + 2*time.Minute + 3*time.Second},
+		{"0:00:01.25", 1250 * time.Millisecond},
+		{"0:00:01.1/4", 1250 * time.Millisecond},
+		{"03:25", 3*time.Minute + 25*time.Second},
+		{"12.5", 12500 * time.Millisecond},
+		{"-0:00:02", -2 * time.Second},
+		{"+1:00:00", time.Hour},
+	}
+	for _, test := range tests {
+		if got, err := ParseDuration(test.s); err != nil || got != test.want {
+			t.Errorf("ParseDuration(%q) = %v, %v, want %v", test.s, got, err, test.want)
+		}
+	}
+	for _, s := range []string{"bogus", "1:2:3:4", "0:00:01.3/2", "NOT_IMPLEMENTED", "1:-2:03"} {
+		if _, err := ParseDuration(s); err == nil {
+			t.Errorf("ParseDuration(%q) succeeded", s)
+		}
+	}
+	if got := FormatDuration(time.Hour + 1250*time.Millisecond); got != "1:00:01.250" {
+		t.Errorf("FormatDuration() = %q", got)
+	}
+}
+
+func TestClass(t *testing.T) {
+	if !ClassMusicTrack.IsA(ClassAudioItem) || !ClassMusicTrack.IsA(ClassItem) || ClassMusicTrack.IsA(ClassContainer) {
+		t.Error("IsA() does not follow the class hierarchy")
+	}
+	if Class("object.itemx").IsA(ClassItem) {
+		t.Error("IsA() matched a class by a prefix of its name")
+	}
+	if ClassMusicAlbum.Parent() != ClassAlbum || ClassObject.Parent() != "" {
+		t.Error("Parent() does not follow the class hierarchy")
+	}
+	if !Class("object.container.album.musicAlbum.vendorAlbum").IsContainer() || ClassPhoto.IsContainer() {
+		t.Error("IsContainer() does not follow the class hierarchy")
+	}
+}
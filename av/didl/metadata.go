@@ -0,0 +1,66 @@
+package didl
+
+import (
+	"mime"
+	"net/url"
+	"path"
+	"strings"
+)
+
+// NewItem returns a minimal item of the media at uri, served as pi, as given
+// as the metadata of the media to AVTransport SetAVTransportURI: many
+// renderers refuse media without metadata, or whose metadata lacks a title,
+// class or protocolInfo.
+//
+// If pi has no content format, it is guessed from the extension of uri,
+// and is "*" if unknown. An empty title is replaced by the file name of uri.
+// The class of the item is derived from the content format. The other
+// properties of the item may be set before marshaling it.
+func NewItem(uri string, pi ProtocolInfo, title string) Item {
+	if pi.Protocol == "" {
+		pi.Protocol = "http-get"
+	}
+	if pi.Network == "" {
+		pi.Network = "*"
+	}
+	if pi.ContentFormat == "" {
+		pi.ContentFormat = "*"
+		if u, err := url.Parse(uri); err == nil {
+			if t, _, err := mime.ParseMediaType(mime.TypeByExtension(path.Ext(u.Path))); err == nil {
+				pi.ContentFormat = t
+			}
+		}
+	}
+	if pi.AdditionalInfo == "" {
+		pi.AdditionalInfo = "*"
+	}
+	if title == "" {
+		title = uri
+		if u, err := url.Parse(uri); err == nil {
+			if name, err := url.PathUnescape(path.Base(u.Path)); err == nil && strings.Trim(name, "/.") != "" {
+				title = name
+			}
+		}
+	}
+	return Item{Object: Object{
+		ID:         "0",
+		ParentID:   "-1",
+		Restricted: true,
+		Title:      title,
+		Class:      ItemClassOf(pi.ContentFormat),
+		Resources:  []Resource{{URL: uri, ProtocolInfo: pi}},
+	}}
+}
+
+// Metadata returns the DIDL-Lite metadata of the single item, as given to
+// SetAVTransportURI.
+func (item *Item) Metadata() (string, error) {
+	return Marshal(&Document{Items: []Item{*item}})
+}
+
+// MetadataFor returns the metadata of the minimal item of the media at uri, as
+// NewItem.
+func MetadataFor(uri string, pi ProtocolInfo, title string) (string, error) {
+	item := NewItem(uri, pi, title)
+	return item.Metadata()
+}
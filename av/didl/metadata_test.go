@@ -0,0 +1,46 @@
+package didl
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestNewItem(t *testing.T) {
+	item := NewItem("http://10.0.0.2/music/My%20Song.mp3?x=1", ProtocolInfo{}, "")
+	if item.Title != "My Song.mp3" || item.Class != ClassMusicTrack {
+		t.Errorf("got title %q, class %q", item.Title, item.Class)
+	}
+	if pi := item.Resources[0].ProtocolInfo.String(); pi != "http-get:*:audio/mpeg:*" {
+		t.Errorf("got protocolInfo %q", pi)
+	}
+
+	item = NewItem("http://10.0.0.2/stream", ParseProtocolInfo("http-get:*:video/mp4:DLNA.ORG_PN=AVC_MP4_BL_CIF15_AAC_520"), "Film")
+	if item.Title != "Film" || item.Class != ClassVideoItem ||
+		item.Resources[0].ProtocolInfo.AdditionalInfo != "DLNA.ORG_PN=AVC_MP4_BL_CIF15_AAC_520" {
+		t.Errorf("got item %+v", item)
+	}
+
+	item = NewItem("http://10.0.0.2/", ProtocolInfo{}, "")
+	if item.Title != "http://10.0.0.2/" || item.Class != ClassItem || item.Resources[0].ProtocolInfo.ContentFormat != "*" {
+		t.Errorf("got item %+v", item)
+	}
+}
+
+func TestMetadataFor(t *testing.T) {
+	s, err := MetadataFor("http://10.0.0.2/a.flac?id=1&t=2", ProtocolInfo{}, "A & B")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"<dc:title>A &amp; B</dc:title>", "<upnp:class>object.item.audioItem.musicTrack</upnp:class>", "a.flac?id=1&amp;t=2</res>"} {
+		if !strings.Contains(s, want) {
+			t.Errorf("metadata %s lacks %s", s, want)
+		}
+	}
+	doc, err := Unmarshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(doc.Items) != 1 || doc.Items[0].Resources[0].URL != "http://10.0.0.2/a.flac?id=1&t=2" {
+		t.Errorf("got document %+v", doc)
+	}
+}
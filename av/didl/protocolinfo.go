@@ -0,0 +1,187 @@
+package didl
+
+import (
+	"strconv"
+	"strings"
+)
+
+// ProtocolInfo is the protocolInfo of a resource, of the form
+// "<protocol>:<network>:<contentFormat>:<additionalInfo>", e.g.
+// "http-get:*:audio/mpeg:*". It is also the form of the entries of the
+// Source and Sink of ConnectionManager GetProtocolInfo, where "*" matches any
+// value of a field.
+type ProtocolInfo struct {
+	Protocol       string
+	Network        string
+	ContentFormat  string
+	AdditionalInfo string
+}
+
+// ParseProtocolInfo parses a protocolInfo value. Fields missing from
+// malformed values are left empty.
+func ParseProtocolInfo(s string) ProtocolInfo {
+	fields := strings.SplitN(strings.TrimSpace(s), ":", 4)
+	for len(fields) < 4 {
+		fields = append(fields, "")
+	}
+	return ProtocolInfo{fields[0], fields[1], fields[2], fields[3]}
+}
+
+// ParseProtocolInfoList parses a comma separated list of protocolInfo
+// values, as returned by GetProtocolInfo. The commas of DLNA.ORG_PS play
+// speed lists within the values, which devices do not escape, are told apart
+// from those separating values.
+func ParseProtocolInfoList(s string) []ProtocolInfo {
+	var values []string
+	for _, v := range strings.Split(s, ",") {
+		if strings.TrimSpace(v) == "" {
+			continue
+		}
+		if strings.Count(v, ":") < 3 && len(values) > 0 {
+			values[len(values)-1] += "," + v
+			continue
+		}
+		values = append(values, v)
+	}
+	list := make([]ProtocolInfo, len(values))
+	for i, v := range values {
+		list[i] = ParseProtocolInfo(v)
+	}
+	return list
+}
+
+func (pi ProtocolInfo) String() string {
+	return pi.Protocol + ":" + pi.Network + ":" + pi.ContentFormat + ":" + pi.AdditionalInfo
+}
+
+// DLNAFlags are the primary flags of DLNA.ORG_FLAGS.
+type DLNAFlags uint32
+
+const (
+	FlagSenderPaced         DLNAFlags = 1 << 31
+	FlagTimeBasedSeek       DLNAFlags = 1 << 30
+	FlagByteBasedSeek       DLNAFlags = 1 << 29
+	FlagPlayContainer       DLNAFlags = 1 << 28
+	FlagS0Increase          DLNAFlags = 1 << 27
+	FlagSNIncrease          DLNAFlags = 1 << 26
+	FlagRTSPPause           DLNAFlags = 1 << 25
+	FlagStreamingTransfer   DLNAFlags = 1 << 24
+	FlagInteractiveTransfer DLNAFlags = 1 << 23
+	FlagBackgroundTransfer  DLNAFlags = 1 << 22
+	FlagConnectionStall     DLNAFlags = 1 << 21
+	FlagDLNAV15             DLNAFlags = 1 << 20
+)
+
+// DLNAInfo is the DLNA parameters of the additional info of a protocolInfo,
+// e.g. "DLNA.ORG_PN=MP3;DLNA.ORG_OP=01;DLNA.ORG_FLAGS=01700000000000000000000000000000".
+type DLNAInfo struct {
+	// Profile is the media format profile of DLNA.ORG_PN, e.g. "MP3" or
+	// "AVC_MP4_BL_CIF15_AAC_520".
+	Profile string
+	// TimeSeek and RangeSeek are the operations of DLNA.ORG_OP: seeking by
+	// TimeSeekRange.dlna.org and by HTTP Range headers.
+	TimeSeek  bool
+	RangeSeek bool
+	// PlaySpeeds are the speeds of DLNA.ORG_PS other than normal, e.g.
+	// "-2" or "1/2".
+	PlaySpeeds []string
+	// Converted is DLNA.ORG_CI, whether the content is transcoded.
+	Converted bool
+	Flags     DLNAFlags
+}
+
+// DLNA parses the DLNA parameters of the additional info of pi. Malformed
+// parameters are left zero.
+func (pi ProtocolInfo) DLNA() DLNAInfo {
+	var info DLNAInfo
+	for _, param := range strings.Split(pi.AdditionalInfo, ";") {
+		eq := strings.IndexByte(param, '=')
+		if eq < 0 {
+			continue
+		}
+		name, value := strings.TrimSpace(param[:eq]), strings.TrimSpace(param[eq+1:])
+		switch name {
+		case "DLNA.ORG_PN":
+			info.Profile = value
+		case "DLNA.ORG_OP":
+			if len(value) == 2 {
+				info.TimeSeek, info.RangeSeek = value[0] == '1', value[1] == '1'
+			}
+		case "DLNA.ORG_PS":
+			for _, speed := range strings.Split(value, ",") {
+				if speed = strings.TrimSpace(speed); speed != "" {
+					info.PlaySpeeds = append(info.PlaySpeeds, speed)
+				}
+			}
+		case "DLNA.ORG_CI":
+			info.Converted = value == "1"
+		case "DLNA.ORG_FLAGS":
+			if len(value) >= 8 {
+				if flags, err := strconv.ParseUint(value[:8], 16, 32); err == nil {
+					info.Flags = DLNAFlags(flags)
+				}
+			}
+		}
+	}
+	return info
+}
+
+// Matches returns whether a resource of pi can be played by a renderer
+// accepting sink, an entry of its GetProtocolInfo Sink. A sink restricted to
+// a DLNA profile does not match resources of other profiles, but does match
+// resources of no stated profile.
+func (pi ProtocolInfo) Matches(sink ProtocolInfo) bool {
+	return protocolInfoScore(pi, sink) > 0
+}
+
+// protocolInfoScore scores how well a resource of pi matches sink: 0 if it
+// does not, 1 for a sink of any content format, 2 for a sink of the content
+// format of pi, and 3 for a sink of its DLNA profile.
+func protocolInfoScore(pi, sink ProtocolInfo) int {
+	if !fieldMatches(sink.Protocol, pi.Protocol) || !fieldMatches(sink.Network, pi.Network) ||
+		!fieldMatches(sink.ContentFormat, pi.ContentFormat) {
+		return 0
+	}
+	sinkProfile, profile := sink.DLNA().Profile, pi.DLNA().Profile
+	switch {
+	case sinkProfile != "" && profile != "" && sinkProfile != profile:
+		return 0
+	case sinkProfile != "" && sinkProfile == profile:
+		return 3
+	case sink.ContentFormat != "*":
+		return 2
+	}
+	return 1
+}
+
+func fieldMatches(sink, field string) bool {
+	return sink == "*" || field == "*" || strings.EqualFold(sink, field)
+}
+
+// BestResource returns the resource of resources that is best played by a
+// renderer accepting sinks, or nil if it can play none of them. Resources
+// matching a sink of their DLNA profile are preferred to those matching a
+// sink of their content format, which are preferred to those matching a sink
+// of any content format, and resources that are not transcoded are then
+// preferred, and then the earlier.
+func BestResource(resources []Resource, sinks []ProtocolInfo) *Resource {
+	var best *Resource
+	bestScore, bestConverted := 0, false
+	for i := range resources {
+		r := &resources[i]
+		score := 0
+		for _, sink := range sinks {
+			if s := protocolInfoScore(r.ProtocolInfo, sink); s > score {
+				score = s
+			}
+		}
+		if score == 0 {
+			continue
+		}
+		converted := r.ProtocolInfo.DLNA().Converted
+		if score > bestScore || (score == bestScore && bestConverted && !converted) {
+			best, bestScore, bestConverted = r, score, converted
+		}
+	}
+	return best
+}
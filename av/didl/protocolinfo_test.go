@@ -0,0 +1,61 @@
+package didl
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseProtocolInfoList(t *testing.T) {
+	list := ParseProtocolInfoList("http-get:*:audio/mpeg:*, http-get:*:video/mpeg:DLNA.ORG_PN=MPEG_PS_PAL;DLNA.ORG_PS=-2,-1/2,1/2,2;DLNA.ORG_OP=10,,rtsp-rtp-udp:*:video/mpeg:*")
+	if len(list) != 3 {
+		t.Fatalf("got %d values %+v, want 3", len(list), list)
+	}
+	info := list[1].DLNA()
+	want := DLNAInfo{Profile: "MPEG_PS_PAL", TimeSeek: true, PlaySpeeds: []string{"-2", "-1/2", "1/2", "2"}}
+	if !reflect.DeepEqual(info, want) {
+		t.Errorf("DLNA() = %+v, want %+v", info, want)
+	}
+	if list[2].Protocol != "rtsp-rtp-udp" {
+		t.Errorf("got last value %+v", list[2])
+	}
+}
+
+func TestDLNAFlags(t *testing.T) {
+	info := ParseProtocolInfo("http-get:*:audio/mpeg:DLNA.ORG_PN=MP3;DLNA.ORG_OP=01;DLNA.ORG_CI=1;DLNA.ORG_FLAGS=01700000000000000000000000000000").DLNA()
+	if !info.RangeSeek || info.TimeSeek || !info.Converted {
+		t.Errorf("got %+v", info)
+	}
+	if want := FlagStreamingTransfer | FlagBackgroundTransfer | FlagConnectionStall | FlagDLNAV15; info.Flags != want {
+		t.Errorf("got flags %08x, want %08x", info.Flags, want)
+	}
+}
+
+func TestBestResource(t *testing.T) {
+	resources := []Resource{
+		{URL: "flac", ProtocolInfo: ParseProtocolInfo("http-get:*:audio/flac:*")},
+		{URL: "transcoded-mp3", ProtocolInfo: ParseProtocolInfo("http-get:*:audio/mpeg:DLNA.ORG_PN=MP3;DLNA.ORG_CI=1")},
+		{URL: "mp3", ProtocolInfo: ParseProtocolInfo("http-get:*:audio/mpeg:DLNA.ORG_PN=MP3")},
+		{URL: "wav", ProtocolInfo: ParseProtocolInfo("http-get:*:audio/wav:*")},
+	}
+	tests := []struct {
+		sinks string
+		want  string
+	}{
+		{"http-get:*:audio/mpeg:DLNA.ORG_PN=MP3,http-get:*:audio/flac:*", "mp3"},
+		{"http-get:*:audio/flac:*,http-get:*:audio/mpeg:*", "flac"},
+		{"http-get:*:*:*", "flac"},
+		{"http-get:*:audio/mpeg:DLNA.ORG_PN=MP3X", ""},
+		{"rtsp-rtp-udp:*:*:*", ""},
+		{"http-get:*:audio/L16:*,http-get:*:AUDIO/WAV:*", "wav"},
+	}
+	for _, test := range tests {
+		r := BestResource(resources, ParseProtocolInfoList(test.sinks))
+		got := ""
+		if r != nil {
+			got = r.URL
+		}
+		if got != test.want {
+			t.Errorf("BestResource(%q) = %q, want %q", test.sinks, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,257 @@
+package av
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"strings"
+
+	"github.com/huin/goupnp"
+	"github.com/huin/goupnp/dcps/av1"
+	"github.com/huin/goupnp/internal/logging"
+	"github.com/huin/goupnp/soap"
+)
+
+const (
+	URN_MediaRenderer_1 = "urn:schemas-upnp-org:device:MediaRenderer:1"
+	URN_MediaServer_1   = "urn:schemas-upnp-org:device:MediaServer:1"
+)
+
+// Renderer is a MediaRenderer device, with clients of its services. Use
+// controlpoint.NewPlayer with AVTransport and ConnectionManager to cast media
+// to it, and NewVolumeControl with RenderingControl to change its volume.
+type Renderer struct {
+	Device       *goupnp.Device
+	Location     *url.URL
+	FriendlyName string
+	Icons        []goupnp.Icon
+
+	AVTransport *av1.AVTransport1
+	// RenderingControl and ConnectionManager are nil if the device lacks
+	// them.
+	RenderingControl  *av1.RenderingControl1
+	ConnectionManager *av1.ConnectionManager1
+}
+
+// VolumeControl returns a VolumeControl of the RenderingControl of r, with the
+// volume range that it describes.
+func (r *Renderer) VolumeControl() (*VolumeControl, error) {
+	if r.RenderingControl == nil {
+		return nil, fmt.Errorf("av: renderer %q has no RenderingControl service", r.FriendlyName)
+	}
+	vr, err := GetVolumeRange(r.RenderingControl)
+	if err != nil {
+		return nil, err
+	}
+	return NewVolumeControl(r.RenderingControl, vr), nil
+}
+
+// Server is a MediaServer device, with clients of its services.
+type Server struct {
+	Device       *goupnp.Device
+	Location     *url.URL
+	FriendlyName string
+	Icons        []goupnp.Icon
+
+	ContentDirectory *av1.ContentDirectory1
+	// ConnectionManager is nil if the device lacks it.
+	ConnectionManager *av1.ConnectionManager1
+}
+
+// BrowseChildren returns a Browser of the children of the container objectID
+// of s, as BrowseChildren.
+func (s *Server) BrowseChildren(ctx context.Context, objectID, filter, sortCriteria string) *Browser {
+	return BrowseChildren(ctx, s.ContentDirectory, objectID, filter, sortCriteria)
+}
+
+// DiscoverRenderers discovers the MediaRenderer devices on the network.
+// Devices that fail to describe themselves, or lack an AVTransport service,
+// are skipped.
+func DiscoverRenderers(ctx context.Context) ([]*Renderer, error) {
+	devices, err := discoverDevices(ctx, URN_MediaRenderer_1)
+	if err != nil {
+		return nil, err
+	}
+	var renderers []*Renderer
+	seen := make(map[string]bool)
+	for _, maybe := range devices {
+		if maybe.Err != nil {
+			logSkippedDevice(maybe)
+			continue
+		}
+		for _, r := range RenderersFromRootDevice(maybe.Root, maybe.Location) {
+			if !seen[r.Device.UDN] {
+				seen[r.Device.UDN] = true
+				renderers = append(renderers, r)
+			}
+		}
+	}
+	return renderers, nil
+}
+
+// DiscoverServers discovers the MediaServer devices on the network. Devices
+// that fail to describe themselves, or lack a ContentDirectory service, are
+// skipped.
+func DiscoverServers(ctx context.Context) ([]*Server, error) {
+	devices, err := discoverDevices(ctx, URN_MediaServer_1)
+	if err != nil {
+		return nil, err
+	}
+	var servers []*Server
+	seen := make(map[string]bool)
+	for _, maybe := range devices {
+		if maybe.Err != nil {
+			logSkippedDevice(maybe)
+			continue
+		}
+		for _, s := range ServersFromRootDevice(maybe.Root, maybe.Location) {
+			if !seen[s.Device.UDN] {
+				seen[s.Device.UDN] = true
+				servers = append(servers, s)
+			}
+		}
+	}
+	return servers, nil
+}
+
+// RendererByURL returns the first MediaRenderer device within the root device
+// whose device description is at loc.
+func RendererByURL(loc *url.URL) (*Renderer, error) {
+	root, err := goupnp.DeviceByURL(loc)
+	if err != nil {
+		return nil, err
+	}
+	renderers := RenderersFromRootDevice(root, loc)
+	if len(renderers) == 0 {
+		return nil, fmt.Errorf("av: no MediaRenderer found within device %q", root.Device.FriendlyName)
+	}
+	return renderers[0], nil
+}
+
+// ServerByURL returns the first MediaServer device within the root device
+// whose device description is at loc.
+func ServerByURL(loc *url.URL) (*Server, error) {
+	root, err := goupnp.DeviceByURL(loc)
+	if err != nil {
+		return nil, err
+	}
+	servers := ServersFromRootDevice(root, loc)
+	if len(servers) == 0 {
+		return nil, fmt.Errorf("av: no MediaServer found within device %q", root.Device.FriendlyName)
+	}
+	return servers[0], nil
+}
+
+// RenderersFromRootDevice returns the MediaRenderer devices within root, of
+// any version, that have an AVTransport service. loc is assigned to the
+// Location of their service clients.
+func RenderersFromRootDevice(root *goupnp.RootDevice, loc *url.URL) []*Renderer {
+	var renderers []*Renderer
+	root.Device.VisitDevices(func(d *goupnp.Device) {
+		if !isDeviceType(d, "MediaRenderer") {
+			return
+		}
+		sc := deviceServiceClient(root, loc, d, av1.URN_AVTransport_1)
+		if sc == nil {
+			return
+		}
+		r := &Renderer{
+			Device:       d,
+			Location:     loc,
+			FriendlyName: d.FriendlyName,
+			Icons:        d.Icons,
+			AVTransport:  &av1.AVTransport1{ServiceClient: *sc},
+		}
+		if sc := deviceServiceClient(root, loc, d, av1.URN_RenderingControl_1); sc != nil {
+			r.RenderingControl = &av1.RenderingControl1{ServiceClient: *sc}
+		}
+		if sc := deviceServiceClient(root, loc, d, av1.URN_ConnectionManager_1); sc != nil {
+			r.ConnectionManager = &av1.ConnectionManager1{ServiceClient: *sc}
+		}
+		renderers = append(renderers, r)
+	})
+	return renderers
+}
+
+// ServersFromRootDevice returns the MediaServer devices within root, of any
+// version, that have a ContentDirectory service. loc is assigned to the
+// Location of their service clients.
+func ServersFromRootDevice(root *goupnp.RootDevice, loc *url.URL) []*Server {
+	var servers []*Server
+	root.Device.VisitDevices(func(d *goupnp.Device) {
+		if !isDeviceType(d, "MediaServer") {
+			return
+		}
+		sc := deviceServiceClient(root, loc, d, av1.URN_ContentDirectory_1)
+		if sc == nil {
+			return
+		}
+		s := &Server{
+			Device:           d,
+			Location:         loc,
+			FriendlyName:     d.FriendlyName,
+			Icons:            d.Icons,
+			ContentDirectory: &av1.ContentDirectory1{ServiceClient: *sc},
+		}
+		if sc := deviceServiceClient(root, loc, d, av1.URN_ConnectionManager_1); sc != nil {
+			s.ConnectionManager = &av1.ConnectionManager1{ServiceClient: *sc}
+		}
+		servers = append(servers, s)
+	})
+	return servers
+}
+
+// isDeviceType returns whether d is a standard device of the given type, of
+// any version.
+func isDeviceType(d *goupnp.Device, deviceType string) bool {
+	return strings.HasPrefix(d.DeviceType, "urn:schemas-upnp-org:device:"+deviceType+":")
+}
+
+// deviceServiceClient returns a client of the first service of d itself, not
+// of its embedded devices, whose type is serviceType or a later version of it,
+// or nil if there is none. Later versions are backwards compatible, so can be
+// controlled through the clients of version 1.
+func deviceServiceClient(root *goupnp.RootDevice, loc *url.URL, d *goupnp.Device, serviceType string) *goupnp.ServiceClient {
+	prefix := strings.TrimSuffix(serviceType, "1")
+	for i := range d.Services {
+		srv := &d.Services[i]
+		if strings.HasPrefix(srv.ServiceType, prefix) {
+			return &goupnp.ServiceClient{
+				SOAPClient: srv.NewSOAPClient(soap.WithCompat(root.Quirks().SOAPCompat())),
+				RootDevice: root,
+				Location:   loc,
+				Service:    srv,
+			}
+		}
+	}
+	return nil
+}
+
+func logSkippedDevice(maybe goupnp.MaybeRootDevice) {
+	var loc string
+	if maybe.Location != nil {
+		loc = maybe.Location.String()
+	}
+	logging.Default().Debug("av: skipping device", slog.String(logging.KeyLocation, loc), logging.Err(maybe.Err))
+}
+
+// discoverDevices is goupnp.DiscoverDevices returning when ctx is done,
+// leaving the discovery to finish in the background.
+func discoverDevices(ctx context.Context, searchTarget string) ([]goupnp.MaybeRootDevice, error) {
+	type result struct {
+		devices []goupnp.MaybeRootDevice
+		err     error
+	}
+	done := make(chan result, 1)
+	go func() {
+		devices, err := goupnp.DiscoverDevicesCtx(ctx, searchTarget)
+		done <- result{devices, err}
+	}()
+	select {
+	case r := <-done:
+		return r.devices, r.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
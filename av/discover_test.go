@@ -0,0 +1,66 @@
+package av
+
+import (
+	"testing"
+
+	"github.com/huin/goupnp"
+	"github.com/huin/goupnp/dcps/av1"
+)
+
+func TestRenderersAndServersFromRootDevice(t *testing.T) {
+	root := &goupnp.RootDevice{Device: goupnp.Device{
+		DeviceType:   "urn:schemas-upnp-org:device:MediaServer:2",
+		UDN:          "uuid:server",
+		FriendlyName: "NAS",
+		Icons:        []goupnp.Icon{{Mimetype: "image/png", Width: 48, Height: 48}},
+		Services: []goupnp.Service{
+			{ServiceType: av1.URN_ContentDirectory_2},
+			{ServiceType: av1.URN_ConnectionManager_1},
+		},
+		Devices: []goupnp.Device{
+			{
+				DeviceType:   URN_MediaRenderer_1,
+				UDN:          "uuid:renderer",
+				FriendlyName: "Living Room",
+				Services: []goupnp.Service{
+					{ServiceType: av1.URN_AVTransport_1},
+					{ServiceType: av1.URN_RenderingControl_1},
+				},
+			},
+			{
+				// Lacks an AVTransport.
+				DeviceType: URN_MediaRenderer_1,
+				UDN:        "uuid:broken",
+				Services:   []goupnp.Service{{ServiceType: av1.URN_RenderingControl_1}},
+			},
+		},
+	}}
+
+	renderers := RenderersFromRootDevice(root, nil)
+	if len(renderers) != 1 {
+		t.Fatalf("got %d renderers, want 1", len(renderers))
+	}
+	r := renderers[0]
+	if r.FriendlyName != "Living Room" || r.AVTransport == nil || r.RenderingControl == nil || r.ConnectionManager != nil {
+		t.Errorf("got renderer %+v, want Living Room with AVTransport and RenderingControl only", r)
+	}
+	if r.AVTransport.Service.ServiceType != av1.URN_AVTransport_1 {
+		t.Errorf("got AVTransport %q", r.AVTransport.Service.ServiceType)
+	}
+
+	servers := ServersFromRootDevice(root, nil)
+	if len(servers) != 1 {
+		t.Fatalf("got %d servers, want 1", len(servers))
+	}
+	s := servers[0]
+	if s.FriendlyName != "NAS" || len(s.Icons) != 1 || s.ConnectionManager == nil {
+		t.Errorf("got server %+v, want NAS with an icon and ConnectionManager", s)
+	}
+	if s.ContentDirectory.Service.ServiceType != av1.URN_ContentDirectory_2 {
+		t.Errorf("got ContentDirectory %q, want version 2", s.ContentDirectory.Service.ServiceType)
+	}
+
+	if _, err := (&Renderer{FriendlyName: "TV"}).VolumeControl(); err == nil {
+		t.Error("VolumeControl() of a renderer without RenderingControl succeeded")
+	}
+}
@@ -0,0 +1,267 @@
+// av contains helpers for working with the UPnP AV services (MediaServer and
+// MediaRenderer) that go beyond the generated clients in
+// github.com/huin/goupnp/dcps/av1.
+package av
+
+import (
+	"encoding/xml"
+	"fmt"
+	"html"
+	"strconv"
+	"strings"
+
+	"github.com/huin/goupnp/soap"
+)
+
+const (
+	// LastChangeNSAVT is the namespace of LastChange events sent by
+	// AVTransport services.
+	LastChangeNSAVT = "urn:schemas-upnp-org:metadata-1-0/AVT/"
+	// LastChangeNSRCS is the namespace of LastChange events sent by
+	// RenderingControl services.
+	LastChangeNSRCS = "urn:schemas-upnp-org:metadata-1-0/RCS/"
+)
+
+// LastChange is the decoded form of the LastChange state variable evented by
+// AVTransport and RenderingControl services. See section 2.3.1 "LastChange"
+// of the AVTransport:1 and RenderingControl:1 service specifications.
+type LastChange struct {
+	// Namespace of the Event element, typically LastChangeNSAVT or
+	// LastChangeNSRCS.
+	Namespace string
+	// Instances contains the changes for each InstanceID reported in the
+	// event, in document order.
+	Instances []InstanceChanges
+}
+
+// Instance returns the changes for the given InstanceID, or nil if the event
+// did not include that instance.
+func (lc *LastChange) Instance(instanceID uint32) *InstanceChanges {
+	for i := range lc.Instances {
+		if lc.Instances[i].InstanceID == instanceID {
+			return &lc.Instances[i]
+		}
+	}
+	return nil
+}
+
+// InstanceChanges is the set of state variable changes for a single
+// InstanceID.
+type InstanceChanges struct {
+	InstanceID uint32
+	Changes    []StateChange
+}
+
+// Get returns the value of the named state variable, ignoring any channel
+// attribute. ok is false if the variable is not present in the change set.
+func (ic *InstanceChanges) Get(name string) (value string, ok bool) {
+	for _, c := range ic.Changes {
+		if c.Name == name {
+			return c.Value, true
+		}
+	}
+	return "", false
+}
+
+// GetChannel returns the value of the named state variable for the given
+// channel (e.g "Master"), as used by RenderingControl variables such as
+// Volume and Mute.
+func (ic *InstanceChanges) GetChannel(name, channel string) (value string, ok bool) {
+	for _, c := range ic.Changes {
+		if c.Name == name && c.Channel == channel {
+			return c.Value, true
+		}
+	}
+	return "", false
+}
+
+// StateChange is a single changed state variable within a LastChange event.
+type StateChange struct {
+	// Name of the state variable (e.g "TransportState").
+	Name string
+	// Value is the new value of the state variable, taken from the "val"
+	// attribute.
+	Value string
+	// Channel is set for channel-specific RenderingControl variables, and is
+	// empty otherwise.
+	Channel string
+}
+
+// lastChangeEvent and friends are the XML structures used to decode the
+// LastChange document. Element names are matched regardless of namespace, as
+// devices are inconsistent about how the namespace is declared.
+type lastChangeEvent struct {
+	XMLName   xml.Name             `xml:"Event"`
+	Instances []lastChangeInstance `xml:"InstanceID"`
+}
+
+type lastChangeInstance struct {
+	Val     string              `xml:"val,attr"`
+	Changes []lastChangeElement `xml:",any"`
+}
+
+type lastChangeElement struct {
+	XMLName xml.Name
+	Val     string `xml:"val,attr"`
+	Channel string `xml:"channel,attr"`
+}
+
+// ParseLastChange decodes the value of a LastChange state variable. The value
+// should already have been unescaped from the event property set (as is done
+// by encoding/xml). Values that have been escaped twice, which some devices
+// send, are unescaped again before parsing.
+func ParseLastChange(value string) (*LastChange, error) {
+	value = strings.TrimSpace(value)
+	if strings.HasPrefix(value, "&lt;") {
+		value = html.UnescapeString(value)
+	}
+
+	var event lastChangeEvent
+	if err := xml.Unmarshal([]byte(value), &event); err != nil {
+		return nil, fmt.Errorf("av: error decoding LastChange: %v", err)
+	}
+
+	result := &LastChange{
+		Namespace: event.XMLName.Space,
+		Instances: make([]InstanceChanges, 0, len(event.Instances)),
+	}
+	for _, inst := range event.Instances {
+		id, err := strconv.ParseUint(strings.TrimSpace(inst.Val), 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("av: bad LastChange InstanceID %q: %v", inst.Val, err)
+		}
+		changes := make([]StateChange, len(inst.Changes))
+		for i, c := range inst.Changes {
+			changes[i] = StateChange{
+				Name:    c.XMLName.Local,
+				Value:   c.Val,
+				Channel: c.Channel,
+			}
+		}
+		result.Instances = append(result.Instances, InstanceChanges{
+			InstanceID: uint32(id),
+			Changes:    changes,
+		})
+	}
+	return result, nil
+}
+
+// AVTransportChanges is a typed view of the commonly used AVTransport state
+// variables within an InstanceChanges. A nil field means that the variable
+// was not part of the change set.
+type AVTransportChanges struct {
+	TransportState             *string
+	TransportStatus            *string
+	CurrentPlayMode            *string
+	TransportPlaySpeed         *string
+	NumberOfTracks             *uint32
+	CurrentTrack               *uint32
+	CurrentTrackDuration       *string
+	CurrentMediaDuration       *string
+	CurrentTrackMetaData       *string
+	CurrentTrackURI            *string
+	AVTransportURI             *string
+	AVTransportURIMetaData     *string
+	NextAVTransportURI         *string
+	NextAVTransportURIMetaData *string
+	CurrentTransportActions    *string
+}
+
+// AVTransport decodes the AVTransport state variables in the change set.
+func (ic *InstanceChanges) AVTransport() (*AVTransportChanges, error) {
+	result := new(AVTransportChanges)
+	strs := map[string]**string{
+		"TransportState":             &result.TransportState,
+		"TransportStatus":            &result.TransportStatus,
+		"CurrentPlayMode":            &result.CurrentPlayMode,
+		"TransportPlaySpeed":         &result.TransportPlaySpeed,
+		"CurrentTrackDuration":       &result.CurrentTrackDuration,
+		"CurrentMediaDuration":       &result.CurrentMediaDuration,
+		"CurrentTrackMetaData":       &result.CurrentTrackMetaData,
+		"CurrentTrackURI":            &result.CurrentTrackURI,
+		"AVTransportURI":             &result.AVTransportURI,
+		"AVTransportURIMetaData":     &result.AVTransportURIMetaData,
+		"NextAVTransportURI":         &result.NextAVTransportURI,
+		"NextAVTransportURIMetaData": &result.NextAVTransportURIMetaData,
+		"CurrentTransportActions":    &result.CurrentTransportActions,
+	}
+	ui4s := map[string]**uint32{
+		"NumberOfTracks": &result.NumberOfTracks,
+		"CurrentTrack":   &result.CurrentTrack,
+	}
+	for _, c := range ic.Changes {
+		if dst, ok := strs[c.Name]; ok {
+			v := c.Value
+			*dst = &v
+		} else if dst, ok := ui4s[c.Name]; ok {
+			v, err := soap.UnmarshalUi4(c.Value)
+			if err != nil {
+				return nil, fmt.Errorf("av: bad %s value %q: %v", c.Name, c.Value, err)
+			}
+			*dst = &v
+		}
+	}
+	return result, nil
+}
+
+// RenderingControlChanges is a typed view of the commonly used
+// RenderingControl state variables within an InstanceChanges. The
+// channel-specific variables are keyed by channel name (e.g "Master"), and
+// are nil if no such variable was part of the change set.
+type RenderingControlChanges struct {
+	Volume         map[string]uint16
+	VolumeDB       map[string]int16
+	Mute           map[string]bool
+	Loudness       map[string]bool
+	PresetNameList *string
+}
+
+// RenderingControl decodes the RenderingControl state variables in the change
+// set.
+func (ic *InstanceChanges) RenderingControl() (*RenderingControlChanges, error) {
+	result := new(RenderingControlChanges)
+	for _, c := range ic.Changes {
+		var err error
+		switch c.Name {
+		case "Volume":
+			var v uint16
+			if v, err = soap.UnmarshalUi2(c.Value); err == nil {
+				if result.Volume == nil {
+					result.Volume = make(map[string]uint16)
+				}
+				result.Volume[c.Channel] = v
+			}
+		case "VolumeDB":
+			var v int16
+			if v, err = soap.UnmarshalI2(c.Value); err == nil {
+				if result.VolumeDB == nil {
+					result.VolumeDB = make(map[string]int16)
+				}
+				result.VolumeDB[c.Channel] = v
+			}
+		case "Mute":
+			var v bool
+			if v, err = soap.UnmarshalBoolean(c.Value); err == nil {
+				if result.Mute == nil {
+					result.Mute = make(map[string]bool)
+				}
+				result.Mute[c.Channel] = v
+			}
+		case "Loudness":
+			var v bool
+			if v, err = soap.UnmarshalBoolean(c.Value); err == nil {
+				if result.Loudness == nil {
+					result.Loudness = make(map[string]bool)
+				}
+				result.Loudness[c.Channel] = v
+			}
+		case "PresetNameList":
+			v := c.Value
+			result.PresetNameList = &v
+		}
+		if err != nil {
+			return nil, fmt.Errorf("av: bad %s value %q: %v", c.Name, c.Value, err)
+		}
+	}
+	return result, nil
+}
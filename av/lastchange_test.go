@@ -0,0 +1,106 @@
+package av
+
+import (
+	"reflect"
+	"testing"
+)
+
+const testAVTLastChange = `<Event xmlns="urn:schemas-upnp-org:metadata-1-0/AVT/">
+	<InstanceID val="0">
+		<TransportState val="PLAYING"/>
+		<CurrentTrack val="3"/>
+		<CurrentTrackURI val="http://example.com/a.mp3"/>
+	</InstanceID>
+	<InstanceID val="1">
+		<TransportState val="STOPPED"/>
+	</InstanceID>
+</Event>`
+
+func TestParseLastChangeAVT(t *testing.T) {
+	lc, err := ParseLastChange(testAVTLastChange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lc.Namespace != LastChangeNSAVT {
+		t.Errorf("Bad namespace\nwant: %q\n got: %q", LastChangeNSAVT, lc.Namespace)
+	}
+	if len(lc.Instances) != 2 {
+		t.Fatalf("want 2 instances, got %d", len(lc.Instances))
+	}
+
+	inst := lc.Instance(0)
+	if inst == nil {
+		t.Fatal("missing InstanceID 0")
+	}
+	want := []StateChange{
+		{Name: "TransportState", Value: "PLAYING"},
+		{Name: "CurrentTrack", Value: "3"},
+		{Name: "CurrentTrackURI", Value: "http://example.com/a.mp3"},
+	}
+	if !reflect.DeepEqual(want, inst.Changes) {
+		t.Errorf("Bad changes\nwant: %+v\n got: %+v", want, inst.Changes)
+	}
+
+	avt, err := inst.AVTransport()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if avt.TransportState == nil || *avt.TransportState != "PLAYING" {
+		t.Errorf("Bad TransportState: %v", avt.TransportState)
+	}
+	if avt.CurrentTrack == nil || *avt.CurrentTrack != 3 {
+		t.Errorf("Bad CurrentTrack: %v", avt.CurrentTrack)
+	}
+	if avt.CurrentPlayMode != nil {
+		t.Errorf("CurrentPlayMode unexpectedly set to %q", *avt.CurrentPlayMode)
+	}
+
+	if v, ok := lc.Instance(1).Get("TransportState"); !ok || v != "STOPPED" {
+		t.Errorf("Bad InstanceID 1 TransportState: %q, %t", v, ok)
+	}
+	if lc.Instance(2) != nil {
+		t.Error("unexpected InstanceID 2")
+	}
+}
+
+func TestParseLastChangeRCS(t *testing.T) {
+	// Doubly escaped, as sent by some renderers.
+	lc, err := ParseLastChange(`&lt;Event xmlns="urn:schemas-upnp-org:metadata-1-0/RCS/"&gt;` +
+		`&lt;InstanceID val="0"&gt;` +
+		`&lt;Volume channel="Master" val="42"/&gt;` +
+		`&lt;Mute channel="Master" val="0"/&gt;` +
+		`&lt;/InstanceID&gt;&lt;/Event&gt;`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lc.Namespace != LastChangeNSRCS {
+		t.Errorf("Bad namespace\nwant: %q\n got: %q", LastChangeNSRCS, lc.Namespace)
+	}
+	inst := lc.Instance(0)
+	if v, ok := inst.GetChannel("Volume", "Master"); !ok || v != "42" {
+		t.Errorf("Bad Master volume: %q, %t", v, ok)
+	}
+
+	rcs, err := inst.RenderingControl()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := map[string]uint16{"Master": 42}; !reflect.DeepEqual(want, rcs.Volume) {
+		t.Errorf("Bad Volume\nwant: %v\n got: %v", want, rcs.Volume)
+	}
+	if want := map[string]bool{"Master": false}; !reflect.DeepEqual(want, rcs.Mute) {
+		t.Errorf("Bad Mute\nwant: %v\n got: %v", want, rcs.Mute)
+	}
+}
+
+func TestParseLastChangeErrors(t *testing.T) {
+	for _, s := range []string{
+		"",
+		"not xml",
+		`<Event><InstanceID val="x"/></Event>`,
+	} {
+		if _, err := ParseLastChange(s); err == nil {
+			t.Errorf("ParseLastChange(%q): want error, got nil", s)
+		}
+	}
+}
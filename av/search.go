@@ -0,0 +1,221 @@
+package av
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/huin/goupnp/dcps/av1"
+)
+
+// SearchCriteria is a SearchCriteria expression of ContentDirectory Search,
+// built from the relations of a Property, And and Or. See section 2.5.5
+// "SearchCriteria" of the ContentDirectory:1 service specification. The zero
+// value is SearchAll.
+type SearchCriteria struct {
+	expr string
+	// op is the logical operator of expr, "and" or "or", if it is not a
+	// single relation.
+	op    string
+	props []string
+}
+
+// SearchAll matches all objects.
+var SearchAll = SearchCriteria{}
+
+// String returns the expression, as given to Search.
+func (c SearchCriteria) String() string {
+	if c.expr == "" {
+		return "*"
+	}
+	return c.expr
+}
+
+// Properties returns the properties that c is a relation of, in order of
+// appearance and with duplicates.
+func (c SearchCriteria) Properties() []string {
+	return c.props
+}
+
+// And returns criteria matching the objects matched by all of criteria.
+func And(criteria ...SearchCriteria) SearchCriteria {
+	return combine("and", criteria)
+}
+
+// Or returns criteria matching the objects matched by any of criteria.
+func Or(criteria ...SearchCriteria) SearchCriteria {
+	for _, c := range criteria {
+		if c.expr == "" {
+			return SearchAll
+		}
+	}
+	return combine("or", criteria)
+}
+
+func combine(op string, criteria []SearchCriteria) SearchCriteria {
+	var result SearchCriteria
+	var exprs []string
+	for _, c := range criteria {
+		if c.expr == "" {
+			continue
+		}
+		expr := c.expr
+		if c.op != "" && c.op != op {
+			expr = "(" + expr + ")"
+		}
+		exprs = append(exprs, expr)
+		result.props = append(result.props, c.props...)
+	}
+	switch len(exprs) {
+	case 0:
+		return SearchAll
+	case 1:
+		// The only criteria, unparenthesized.
+		for _, c := range criteria {
+			if c.expr != "" {
+				return c
+			}
+		}
+	}
+	result.expr = strings.Join(exprs, " "+op+" ")
+	result.op = op
+	return result
+}
+
+// Property is a property of a SearchCriteria relation, e.g. "dc:title",
+// "upnp:class" or "res@size".
+type Property string
+
+func (p Property) relation(op, value string) SearchCriteria {
+	return SearchCriteria{
+		expr:  string(p) + " " + op + " " + quoteSearchValue(value),
+		props: []string{string(p)},
+	}
+}
+
+// Equals matches objects whose property is value.
+func (p Property) Equals(value string) SearchCriteria {
+	return p.relation("=", value)
+}
+
+// NotEquals matches objects whose property is not value.
+func (p Property) NotEquals(value string) SearchCriteria {
+	return p.relation("!=", value)
+}
+
+// Less matches objects whose property is less than value.
+func (p Property) Less(value string) SearchCriteria {
+	return p.relation("<", value)
+}
+
+// LessOrEqual matches objects whose property is at most value.
+func (p Property) LessOrEqual(value string) SearchCriteria {
+	return p.relation("<=", value)
+}
+
+// Greater matches objects whose property is greater than value.
+func (p Property) Greater(value string) SearchCriteria {
+	return p.relation(">", value)
+}
+
+// GreaterOrEqual matches objects whose property is at least value.
+func (p Property) GreaterOrEqual(value string) SearchCriteria {
+	return p.relation(">=", value)
+}
+
+// Contains matches objects whose property contains value.
+func (p Property) Contains(value string) SearchCriteria {
+	return p.relation("contains", value)
+}
+
+// DoesNotContain matches objects whose property does not contain value.
+func (p Property) DoesNotContain(value string) SearchCriteria {
+	return p.relation("doesNotContain", value)
+}
+
+// DerivedFrom matches objects whose property, typically upnp:class, is class
+// or a subclass of it.
+func (p Property) DerivedFrom(class string) SearchCriteria {
+	return p.relation("derivedfrom", class)
+}
+
+// Exists matches objects that have the property if exists is true, or that
+// do not otherwise.
+func (p Property) Exists(exists bool) SearchCriteria {
+	return SearchCriteria{
+		expr:  fmt.Sprintf("%s exists %t", p, exists),
+		props: []string{string(p)},
+	}
+}
+
+// quoteSearchValue quotes value as a quotedVal, escaping backslashes and
+// double quotes.
+func quoteSearchValue(value string) string {
+	value = strings.Replace(value, `\`, `\\`, -1)
+	value = strings.Replace(value, `"`, `\"`, -1)
+	return `"` + value + `"`
+}
+
+// SearchCapabilities is the SearchCaps of a ContentDirectory service, the
+// properties that it can search on.
+type SearchCapabilities struct {
+	all   bool
+	props map[string]bool
+}
+
+// ParseSearchCapabilities parses a SearchCaps value, a comma separated list of
+// properties, "*" for all properties, or "" if Search is not supported.
+func ParseSearchCapabilities(s string) *SearchCapabilities {
+	sc := &SearchCapabilities{props: make(map[string]bool)}
+	for _, prop := range strings.Split(s, ",") {
+		prop = strings.TrimSpace(prop)
+		switch prop {
+		case "":
+		case "*":
+			sc.all = true
+		default:
+			sc.props[prop] = true
+		}
+	}
+	return sc
+}
+
+// GetSearchCapabilities returns the search capabilities of client.
+func GetSearchCapabilities(ctx context.Context, client av1.ContentDirectory1Client) (*SearchCapabilities, error) {
+	caps, err := client.GetSearchCapabilitiesCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return ParseSearchCapabilities(caps), nil
+}
+
+// Supported returns whether Search is supported at all.
+func (sc *SearchCapabilities) Supported() bool {
+	return sc.all || len(sc.props) > 0
+}
+
+// Supports returns whether prop can be searched on.
+func (sc *SearchCapabilities) Supports(prop string) bool {
+	return sc.all || sc.props[prop]
+}
+
+// Check returns an error naming the properties of c that cannot be searched
+// on, or nil if there are none.
+func (sc *SearchCapabilities) Check(c SearchCriteria) error {
+	if !sc.Supported() {
+		return errors.New("av: search is not supported")
+	}
+	var unsupported []string
+	seen := make(map[string]bool)
+	for _, prop := range c.props {
+		if !sc.Supports(prop) && !seen[prop] {
+			unsupported = append(unsupported, prop)
+			seen[prop] = true
+		}
+	}
+	if len(unsupported) > 0 {
+		return fmt.Errorf("av: search on %s is not supported", strings.Join(unsupported, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,51 @@
+package av
+
+import (
+	"testing"
+)
+
+func TestSearchCriteria(t *testing.T) {
+	tests := []struct {
+		c    SearchCriteria
+		want string
+	}{
+		{SearchAll, "*"},
+		{Property("dc:title").Contains(`say "hi" \o/`), `dc:title contains "say \"hi\" \\o/"`},
+		{Property("res@size").Exists(true), "res@size exists true"},
+		{
+			And(Property("upnp:class").DerivedFrom("object.item.audioItem"), Property("upnp:artist").Equals("A")),
+			`upnp:class derivedfrom "object.item.audioItem" and upnp:artist = "A"`,
+		},
+		{
+			And(Property("upnp:class").DerivedFrom("object.item"), Or(Property("dc:title").Contains("x"), Property("dc:creator").Contains("x"))),
+			`upnp:class derivedfrom "object.item" and (dc:title contains "x" or dc:creator contains "x")`,
+		},
+		{And(SearchAll, Property("dc:date").GreaterOrEqual("2020")), `dc:date >= "2020"`},
+		{Or(SearchAll, Property("dc:date").Less("2020")), "*"},
+		{And(), "*"},
+	}
+	for _, test := range tests {
+		if got := test.c.String(); got != test.want {
+			t.Errorf("got %s, want %s", got, test.want)
+		}
+	}
+}
+
+func TestSearchCapabilities(t *testing.T) {
+	c := And(Property("upnp:class").DerivedFrom("object.item"), Property("dc:title").Contains("x"), Property("upnp:genre").Equals("Jazz"))
+	caps := ParseSearchCapabilities("upnp:class, dc:title,dc:creator")
+	if err := caps.Check(c); err == nil {
+		t.Error("Check() accepted an unsupported property")
+	} else if want := "av: search on upnp:genre is not supported"; err.Error() != want {
+		t.Errorf("Check() = %v, want %s", err, want)
+	}
+	if err := caps.Check(And(Property("dc:title").Contains("x"))); err != nil {
+		t.Errorf("Check() = %v", err)
+	}
+	if err := ParseSearchCapabilities("*").Check(c); err != nil {
+		t.Errorf("Check() with all capabilities = %v", err)
+	}
+	if ParseSearchCapabilities("").Supported() {
+		t.Error("empty SearchCaps supports search")
+	}
+}
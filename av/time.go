@@ -0,0 +1,60 @@
+package av
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/huin/goupnp/av/didl"
+)
+
+// NotImplemented is the value of AVTransport time position and duration
+// variables, such as RelTime and TrackDuration, that a device does not
+// implement.
+const NotImplemented = "NOT_IMPLEMENTED"
+
+// ErrNotImplemented is returned by ParseTime for values that are
+// NotImplemented or empty.
+var ErrNotImplemented = errors.New("av: time not implemented by device")
+
+// ParseTime parses an AVTransport time position or duration of the form
+// H+:MM:SS[.F+], such as RelTime, AbsTime or TrackDuration. Values without a
+// fraction, or of only minutes and seconds, as sent by some devices, are
+// accepted. ErrNotImplemented is returned for NotImplemented or an empty
+// value.
+func ParseTime(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if s == "" || strings.EqualFold(s, NotImplemented) {
+		return 0, ErrNotImplemented
+	}
+	d, err := didl.ParseDuration(s)
+	if err != nil {
+		return 0, fmt.Errorf("av: malformed time %q", s)
+	}
+	return d, nil
+}
+
+// ParseTimeOrZero is ParseTime, but returns 0 for values that are not
+// implemented or malformed.
+func ParseTimeOrZero(s string) time.Duration {
+	d, _ := ParseTime(s)
+	return d
+}
+
+// FormatTime formats d as an AVTransport time of the form H+:MM:SS, as given
+// to Seek with REL_TIME or ABS_TIME, with a millisecond fraction if d is not a
+// whole number of seconds. Negative durations are formatted with a leading
+// "-".
+func FormatTime(d time.Duration) string {
+	sign := ""
+	if d < 0 {
+		sign, d = "-", -d
+	}
+	s := d / time.Second
+	t := fmt.Sprintf("%s%d:%02d:%02d", sign, s/3600, s/60%60, s%60)
+	if ms := d % time.Second / time.Millisecond; ms != 0 {
+		t += fmt.Sprintf(".%03d", ms)
+	}
+	return t
+}
@@ -0,0 +1,68 @@
+package av
+
+import (
+	"testing"
+	"time"
+)
+
+func TestParseTime(t *testing.T) {
+	tests := []struct {
+		s    string
+		want time.Duration
+	}{
+		{"0:00:00", 0},
+		{"1:02:03", time.Hour + 2*time.Minute + 3*time.Second},
+		{"01:02:03.500", time.Hour + 2*time.Minute + 3500*time.Millisecond},
+		{"100:00:00", 100 * time.Hour},
+		{"0:00:01.1/4", 1250 * time.Millisecond},
+		{"02:03", 2*time.Minute + 3*time.Second},
+		{" 0:00:07 ", 7 * time.Second},
+	}
+	for _, test := range tests {
+		got, err := ParseTime(test.s)
+		if err != nil {
+			t.Errorf("ParseTime(%q) failed: %v", test.s, err)
+			continue
+		}
+		if got != test.want {
+			t.Errorf("ParseTime(%q) = %v, want %v", test.s, got, test.want)
+		}
+	}
+
+	for _, s := range []string{"", NotImplemented, "not_implemented"} {
+		if _, err := ParseTime(s); err != ErrNotImplemented {
+			t.Errorf("ParseTime(%q) error = %v, want ErrNotImplemented", s, err)
+		}
+	}
+	for _, s := range []string{"bogus", "1:2:3:4", "0:xx:00"} {
+		if _, err := ParseTime(s); err == nil || err == ErrNotImplemented {
+			t.Errorf("ParseTime(%q) error = %v, want malformed", s, err)
+		}
+	}
+	if got := ParseTimeOrZero(NotImplemented); got != 0 {
+		t.Errorf("ParseTimeOrZero(NOT_IMPLEMENTED) = %v, want 0", got)
+	}
+}
+
+func TestFormatTime(t *testing.T) {
+	tests := []struct {
+		d    time.Duration
+		want string
+	}{
+		{0, "0:00:00"},
+		{time.Hour + 2*time.Minute + 3*time.Second, "1:02:03"},
+		{3500 * time.Millisecond, "0:00:03.500"},
+		{125 * time.Hour, "125:00:00"},
+		{-90 * time.Second, "-0:01:30"},
+	}
+	for _, test := range tests {
+		got := FormatTime(test.d)
+		if got != test.want {
+			t.Errorf("FormatTime(%v) = %q, want %q", test.d, got, test.want)
+			continue
+		}
+		if back, err := ParseTime(got); err != nil || back != test.d {
+			t.Errorf("ParseTime(%q) = %v, %v, want %v", got, back, err, test.d)
+		}
+	}
+}
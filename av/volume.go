@@ -0,0 +1,138 @@
+package av
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/huin/goupnp/dcps/av1"
+)
+
+// MasterChannel is the channel controlling all the others of a
+// RenderingControl service.
+const MasterChannel = string(av1.RenderingControl1Channel_Master)
+
+// VolumeRange is the allowed range of the Volume of a RenderingControl
+// service.
+type VolumeRange struct {
+	Min, Max uint16
+	// Step is the increment between allowed values from Min, 1 if any value
+	// is allowed.
+	Step uint16
+}
+
+// DefaultVolumeRange is the range of services whose range is not described.
+var DefaultVolumeRange = VolumeRange{Min: 0, Max: 100, Step: 1}
+
+// Clamp returns the allowed volume nearest to volume.
+func (r VolumeRange) Clamp(volume int) uint16 {
+	if volume <= int(r.Min) {
+		return r.Min
+	}
+	if volume >= int(r.Max) {
+		return r.Max
+	}
+	if r.Step > 1 {
+		steps := (volume - int(r.Min) + int(r.Step)/2) / int(r.Step)
+		volume = int(r.Min) + steps*int(r.Step)
+		if volume > int(r.Max) {
+			volume -= int(r.Step)
+		}
+	}
+	return uint16(volume)
+}
+
+// GetVolumeRange returns the allowed range of the Volume of client, read from
+// the allowedValueRange of the Volume state variable of its SCPD, or
+// DefaultVolumeRange if it has none.
+func GetVolumeRange(client *av1.RenderingControl1) (VolumeRange, error) {
+	s, err := client.Service.RequestSCDP()
+	if err != nil {
+		return VolumeRange{}, err
+	}
+	r := DefaultVolumeRange
+	sv := s.GetStateVariable("Volume")
+	if sv == nil || sv.AllowedValueRange == nil {
+		return r, nil
+	}
+	if v, err := strconv.ParseUint(sv.AllowedValueRange.Minimum, 10, 16); err == nil {
+		r.Min = uint16(v)
+	}
+	if v, err := strconv.ParseUint(sv.AllowedValueRange.Maximum, 10, 16); err == nil && uint16(v) >= r.Min {
+		r.Max = uint16(v)
+	}
+	if v, err := strconv.ParseUint(sv.AllowedValueRange.Step, 10, 16); err == nil && v > 0 {
+		r.Step = uint16(v)
+	}
+	return r, nil
+}
+
+// VolumeControl changes the volume and mute of the channels of a
+// RenderingControl service, keeping the volume within its allowed range, as
+// some renderers silently ignore volumes out of it. Channels are named as in
+// the Channel argument of RenderingControl, e.g. MasterChannel, "LF" or "RF".
+type VolumeControl struct {
+	client av1.RenderingControl1Client
+	Range  VolumeRange
+}
+
+// NewVolumeControl returns a VolumeControl of client, whose volume range is r,
+// e.g. from GetVolumeRange.
+func NewVolumeControl(client av1.RenderingControl1Client, r VolumeRange) *VolumeControl {
+	return &VolumeControl{client: client, Range: r}
+}
+
+// Volume returns the volume of channel.
+func (vc *VolumeControl) Volume(ctx context.Context, channel string) (uint16, error) {
+	return vc.client.GetVolumeCtx(ctx, 0, av1.RenderingControl1Channel(channel))
+}
+
+// SetVolume sets the volume of channel to the allowed volume nearest to
+// volume, and returns it.
+func (vc *VolumeControl) SetVolume(ctx context.Context, channel string, volume int) (uint16, error) {
+	v := vc.Range.Clamp(volume)
+	if err := vc.client.SetVolumeCtx(ctx, 0, av1.RenderingControl1Channel(channel), v); err != nil {
+		return 0, err
+	}
+	return v, nil
+}
+
+// AdjustVolume changes the volume of channel by delta, and returns the new
+// volume. A delta smaller than the Step of the range is rounded away from zero
+// to a step, so it always changes the volume unless at the end of the range.
+func (vc *VolumeControl) AdjustVolume(ctx context.Context, channel string, delta int) (uint16, error) {
+	current, err := vc.Volume(ctx, channel)
+	if err != nil {
+		return 0, err
+	}
+	if step := int(vc.Range.Step); step > 1 && delta != 0 && delta > -step && delta < step {
+		if delta > 0 {
+			delta = step
+		} else {
+			delta = -step
+		}
+	}
+	return vc.SetVolume(ctx, channel, int(current)+delta)
+}
+
+// Mute returns whether channel is muted.
+func (vc *VolumeControl) Mute(ctx context.Context, channel string) (bool, error) {
+	return vc.client.GetMuteCtx(ctx, 0, av1.RenderingControl1Channel(channel))
+}
+
+// SetMute mutes or unmutes channel.
+func (vc *VolumeControl) SetMute(ctx context.Context, channel string, mute bool) error {
+	return vc.client.SetMuteCtx(ctx, 0, av1.RenderingControl1Channel(channel), mute)
+}
+
+// ToggleMute unmutes channel if it is muted and mutes it otherwise, and
+// returns whether it is now muted.
+func (vc *VolumeControl) ToggleMute(ctx context.Context, channel string) (bool, error) {
+	muted, err := vc.Mute(ctx, channel)
+	if err != nil {
+		return false, err
+	}
+	if err := vc.SetMute(ctx, channel, !muted); err != nil {
+		return false, err
+	}
+	return !muted, nil
+}
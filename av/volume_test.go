@@ -0,0 +1,100 @@
+package av
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/huin/goupnp"
+	"github.com/huin/goupnp/dcps/av1"
+)
+
+type fakeRenderingControl struct {
+	av1.RenderingControl1Client
+	volume map[av1.RenderingControl1Channel]uint16
+	mute   bool
+}
+
+func (f *fakeRenderingControl) GetVolumeCtx(ctx context.Context, instanceID uint32, channel av1.RenderingControl1Channel) (uint16, error) {
+	return f.volume[channel], nil
+}
+
+func (f *fakeRenderingControl) SetVolumeCtx(ctx context.Context, instanceID uint32, channel av1.RenderingControl1Channel, volume uint16) error {
+	f.volume[channel] = volume
+	return nil
+}
+
+func (f *fakeRenderingControl) GetMuteCtx(ctx context.Context, instanceID uint32, channel av1.RenderingControl1Channel) (bool, error) {
+	return f.mute, nil
+}
+
+func (f *fakeRenderingControl) SetMuteCtx(ctx context.Context, instanceID uint32, channel av1.RenderingControl1Channel, mute bool) error {
+	f.mute = mute
+	return nil
+}
+
+func TestVolumeRangeClamp(t *testing.T) {
+	r := VolumeRange{Min: 10, Max: 95, Step: 5}
+	tests := []struct {
+		volume int
+		want   uint16
+	}{
+		{-5, 10}, {10, 10}, {12, 10}, {13, 15}, {94, 95}, {200, 95},
+	}
+	for _, test := range tests {
+		if got := r.Clamp(test.volume); got != test.want {
+			t.Errorf("Clamp(%d) = %d, want %d", test.volume, got, test.want)
+		}
+	}
+	if got := (VolumeRange{Min: 0, Max: 12, Step: 5}).Clamp(12); got != 12 {
+		t.Errorf("Clamp(12) = %d, want the maximum", got)
+	}
+}
+
+func TestVolumeControl(t *testing.T) {
+	rc := &fakeRenderingControl{volume: map[av1.RenderingControl1Channel]uint16{"Master": 28, "LF": 50}}
+	vc := NewVolumeControl(rc, VolumeRange{Min: 0, Max: 30, Step: 2})
+	ctx := context.Background()
+
+	if v, err := vc.AdjustVolume(ctx, MasterChannel, 5); err != nil || v != 30 {
+		t.Errorf("AdjustVolume(+5) = %d, %v, want the maximum", v, err)
+	}
+	if v, err := vc.AdjustVolume(ctx, MasterChannel, -1); err != nil || v != 28 {
+		t.Errorf("AdjustVolume(-1) = %d, %v, want a step down", v, err)
+	}
+	if v, err := vc.SetVolume(ctx, "LF", 7); err != nil || v != 8 || rc.volume["LF"] != 8 {
+		t.Errorf("SetVolume(LF, 7) = %d, %v, want 8", v, err)
+	}
+	if muted, err := vc.ToggleMute(ctx, MasterChannel); err != nil || !muted || !rc.mute {
+		t.Errorf("ToggleMute() = %t, %v, want muted", muted, err)
+	}
+}
+
+func TestGetVolumeRange(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", `text/xml; charset="utf-8"`)
+		w.Write([]byte(`<?xml version="1.0"?>
+<scpd xmlns="urn:schemas-upnp-org:service-1-0">
+<specVersion><major>1</major><minor>0</minor></specVersion>
+<serviceStateTable>
+<stateVariable sendEvents="no"><name>Volume</name><dataType>ui2</dataType>
+<allowedValueRange><minimum>0</minimum><maximum>60</maximum><step>1</step></allowedValueRange>
+</stateVariable>
+</serviceStateTable>
+</scpd>`))
+	}))
+	defer srv.Close()
+	u, _ := url.Parse(srv.URL + "/rc.xml")
+	client := &av1.RenderingControl1{ServiceClient: goupnp.ServiceClient{
+		Service: &goupnp.Service{SCPDURL: goupnp.URLField{URL: *u, Ok: true}},
+	}}
+	r, err := GetVolumeRange(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := (VolumeRange{Min: 0, Max: 60, Step: 1}); r != want {
+		t.Errorf("GetVolumeRange() = %+v, want %+v", r, want)
+	}
+}
@@ -0,0 +1,79 @@
+package goupnp
+
+import (
+	"context"
+	"fmt"
+	"sync"
+)
+
+// BatchError is returned by Batch when some of the actions failed. It holds
+// the error of each action at the index of the action, nil for the actions
+// that succeeded.
+type BatchError []error
+
+func (errs BatchError) Error() string {
+	failed := 0
+	var first error
+	for _, err := range errs {
+		if err != nil {
+			if first == nil {
+				first = err
+			}
+			failed++
+		}
+	}
+	return fmt.Sprintf("goupnp: %d of %d actions failed, first error: %v", failed, len(errs), first)
+}
+
+// Batch calls the actions concurrently with ctx, at most parallelism of them
+// at a time, or all at once if parallelism is not positive. It is meant for
+// independent actions against a device, such as the Get* calls of a status
+// page, which each store their results, e.g.:
+//
+//	var ip string
+//	var status internetgateway1.WANIPConnection1ConnectionStatus
+//	err := goupnp.Batch(ctx, 4,
+//		func(ctx context.Context) (err error) {
+//			ip, err = client.GetExternalIPAddressCtx(ctx)
+//			return
+//		},
+//		func(ctx context.Context) (err error) {
+//			status, _, _, err = client.GetStatusInfoCtx(ctx)
+//			return
+//		},
+//	)
+//
+// The failure of an action does not stop the others. Batch returns once all
+// the actions that were started have returned. If any failed, it returns a
+// BatchError; actions not started before ctx was done fail with its error.
+func Batch(ctx context.Context, parallelism int, actions ...func(ctx context.Context) error) error {
+	if parallelism <= 0 || parallelism > len(actions) {
+		parallelism = len(actions)
+	}
+	errs := make(BatchError, len(actions))
+	sem := make(chan struct{}, parallelism)
+	var wg sync.WaitGroup
+	for i, action := range actions {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if err := ctx.Err(); err != nil {
+			errs[i] = err
+			continue
+		}
+		wg.Add(1)
+		go func(i int, action func(context.Context) error) {
+			defer wg.Done()
+			defer func() { <-sem }()
+			errs[i] = action(ctx)
+		}(i, action)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return errs
+		}
+	}
+	return nil
+}
@@ -0,0 +1,67 @@
+package goupnp
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestBatch(t *testing.T) {
+	var running, maxRunning int32
+	action := func(err error) func(context.Context) error {
+		return func(ctx context.Context) error {
+			n := atomic.AddInt32(&running, 1)
+			defer atomic.AddInt32(&running, -1)
+			for {
+				max := atomic.LoadInt32(&maxRunning)
+				if n <= max || atomic.CompareAndSwapInt32(&maxRunning, max, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			return err
+		}
+	}
+	errFailed := errors.New("failed")
+	err := Batch(context.Background(), 2, action(nil), action(errFailed), action(nil), action(nil), action(nil))
+	batchErr, ok := err.(BatchError)
+	if !ok {
+		t.Fatalf("Batch() = %v, want a BatchError", err)
+	}
+	for i, err := range batchErr {
+		var want error
+		if i == 1 {
+			want = errFailed
+		}
+		if err != want {
+			t.Errorf("error of action %d = %v, want %v", i, err, want)
+		}
+	}
+	if maxRunning != 2 {
+		t.Errorf("ran up to %d actions at a time, want 2", maxRunning)
+	}
+
+	if err := Batch(context.Background(), 0, action(nil), action(nil)); err != nil {
+		t.Errorf("Batch() = %v, want nil", err)
+	}
+}
+
+func TestBatchCanceled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	err := Batch(ctx, 1,
+		func(ctx context.Context) error {
+			cancel()
+			return nil
+		},
+		func(ctx context.Context) error {
+			t.Error("action started after the context was canceled")
+			return nil
+		},
+	)
+	batchErr, ok := err.(BatchError)
+	if !ok || batchErr[0] != nil || batchErr[1] != context.Canceled {
+		t.Errorf("Batch() = %v, want the second action to fail with context.Canceled", err)
+	}
+}
@@ -0,0 +1,59 @@
+// Package clock is the source of time of the timeouts, cache expiries, lease
+// and subscription renewals and advertisement intervals of goupnp and its
+// subpackages. Components with a Clock field use Real if it is nil; tests can
+// set a Fake instead, to drive time without sleeping.
+package clock
+
+import "time"
+
+// Clock tells the time and schedules timers, like the functions of the time
+// package.
+type Clock interface {
+	Now() time.Time
+	NewTimer(d time.Duration) Timer
+	// AfterFunc calls f in its own goroutine after d, unless the returned
+	// Timer is stopped first. The C of the Timer is not used.
+	AfterFunc(d time.Duration, f func()) Timer
+}
+
+// Timer is a timer of a Clock, like time.Timer.
+type Timer interface {
+	// C returns the channel on which the time is delivered when the timer
+	// fires.
+	C() <-chan time.Time
+	Reset(d time.Duration) bool
+	Stop() bool
+}
+
+// Real is the Clock of the time package.
+var Real Clock = realClock{}
+
+// Or returns c if it is not nil, and Real otherwise.
+func Or(c Clock) Clock {
+	if c != nil {
+		return c
+	}
+	return Real
+}
+
+type realClock struct{}
+
+func (realClock) Now() time.Time {
+	return time.Now()
+}
+
+func (realClock) NewTimer(d time.Duration) Timer {
+	return realTimer{time.NewTimer(d)}
+}
+
+func (realClock) AfterFunc(d time.Duration, f func()) Timer {
+	return realTimer{time.AfterFunc(d, f)}
+}
+
+type realTimer struct {
+	*time.Timer
+}
+
+func (t realTimer) C() <-chan time.Time {
+	return t.Timer.C
+}
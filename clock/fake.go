@@ -0,0 +1,137 @@
+package clock
+
+import (
+	"sort"
+	"sync"
+	"time"
+)
+
+// Fake is a Clock whose time only passes on Advance, for tests.
+type Fake struct {
+	lock   sync.Mutex
+	now    time.Time
+	timers []*fakeTimer
+}
+
+var _ Clock = new(Fake)
+
+// NewFake returns a Fake whose time is now.
+func NewFake(now time.Time) *Fake {
+	return &Fake{now: now}
+}
+
+type fakeTimer struct {
+	clock  *Fake
+	c      chan time.Time
+	f      func()
+	when   time.Time
+	active bool
+}
+
+// Now implements Clock.
+func (fc *Fake) Now() time.Time {
+	fc.lock.Lock()
+	defer fc.lock.Unlock()
+	return fc.now
+}
+
+// NewTimer implements Clock.
+func (fc *Fake) NewTimer(d time.Duration) Timer {
+	return fc.addTimer(d, nil)
+}
+
+// AfterFunc implements Clock. f is called by Advance, rather than in its own
+// goroutine, so that it has returned when Advance does.
+func (fc *Fake) AfterFunc(d time.Duration, f func()) Timer {
+	return fc.addTimer(d, f)
+}
+
+func (fc *Fake) addTimer(d time.Duration, f func()) *fakeTimer {
+	fc.lock.Lock()
+	defer fc.lock.Unlock()
+	t := &fakeTimer{clock: fc, c: make(chan time.Time, 1), f: f, when: fc.now.Add(d), active: true}
+	fc.timers = append(fc.timers, t)
+	return t
+}
+
+// Advance moves the time forward by d, firing the timers due in order of
+// their times, including those that the functions of fired timers schedule
+// within d.
+func (fc *Fake) Advance(d time.Duration) {
+	fc.lock.Lock()
+	end := fc.now.Add(d)
+	for {
+		t := fc.nextDue(end)
+		if t == nil {
+			break
+		}
+		fc.now = t.when
+		t.active = false
+		if t.f == nil {
+			select {
+			case t.c <- fc.now:
+			default:
+			}
+			continue
+		}
+		fc.lock.Unlock()
+		t.f()
+		fc.lock.Lock()
+	}
+	fc.now = end
+	fc.lock.Unlock()
+}
+
+// Pending returns the number of timers that have not fired or been stopped.
+func (fc *Fake) Pending() int {
+	fc.lock.Lock()
+	defer fc.lock.Unlock()
+	fc.prune()
+	return len(fc.timers)
+}
+
+// nextDue returns the earliest active timer due by end, or nil if there is
+// none.
+func (fc *Fake) nextDue(end time.Time) *fakeTimer {
+	fc.prune()
+	sort.SliceStable(fc.timers, func(i, j int) bool { return fc.timers[i].when.Before(fc.timers[j].when) })
+	if len(fc.timers) > 0 && !fc.timers[0].when.After(end) {
+		return fc.timers[0]
+	}
+	return nil
+}
+
+// prune removes the inactive timers.
+func (fc *Fake) prune() {
+	active := fc.timers[:0]
+	for _, t := range fc.timers {
+		if t.active {
+			active = append(active, t)
+		}
+	}
+	fc.timers = active
+}
+
+func (t *fakeTimer) C() <-chan time.Time {
+	return t.c
+}
+
+func (t *fakeTimer) Reset(d time.Duration) bool {
+	t.clock.lock.Lock()
+	defer t.clock.lock.Unlock()
+	wasActive := t.active
+	t.when = t.clock.now.Add(d)
+	if !t.active {
+		t.active = true
+		t.clock.timers = append(t.clock.timers, t)
+	}
+	return wasActive
+}
+
+func (t *fakeTimer) Stop() bool {
+	t.clock.lock.Lock()
+	defer t.clock.lock.Unlock()
+	wasActive := t.active
+	t.active = false
+	return wasActive
+}
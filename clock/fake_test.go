@@ -0,0 +1,60 @@
+package clock
+
+import (
+	"testing"
+	"time"
+)
+
+func TestFake(t *testing.T) {
+	start := time.Unix(1000, 0)
+	fc := NewFake(start)
+
+	timer := fc.NewTimer(10 * time.Second)
+	var fired []time.Time
+	fc.AfterFunc(5*time.Second, func() {
+		fired = append(fired, fc.Now())
+		// Fires within the same Advance.
+		fc.AfterFunc(3*time.Second, func() { fired = append(fired, fc.Now()) })
+	})
+	stopped := fc.AfterFunc(time.Second, func() { t.Error("stopped timer fired") })
+	if !stopped.Stop() {
+		t.Error("Stop of an active timer returned false")
+	}
+
+	fc.Advance(9 * time.Second)
+	if want := []time.Time{start.Add(5 * time.Second), start.Add(8 * time.Second)}; len(fired) != 2 ||
+		!fired[0].Equal(want[0]) || !fired[1].Equal(want[1]) {
+		t.Errorf("got functions called at %v, want %v", fired, want)
+	}
+	if got := fc.Now(); !got.Equal(start.Add(9 * time.Second)) {
+		t.Errorf("got Now() %v after Advance", got)
+	}
+	select {
+	case <-timer.C():
+		t.Fatal("timer fired early")
+	default:
+	}
+
+	fc.Advance(time.Second)
+	select {
+	case got := <-timer.C():
+		if !got.Equal(start.Add(10 * time.Second)) {
+			t.Errorf("timer fired with %v", got)
+		}
+	default:
+		t.Fatal("timer did not fire")
+	}
+	if fc.Pending() != 0 {
+		t.Errorf("got %d pending timers, want 0", fc.Pending())
+	}
+
+	if timer.Reset(time.Second) {
+		t.Error("Reset of a fired timer returned true")
+	}
+	fc.Advance(time.Second)
+	select {
+	case <-timer.C():
+	default:
+		t.Fatal("reset timer did not fire")
+	}
+}
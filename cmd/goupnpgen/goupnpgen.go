@@ -0,0 +1,78 @@
+// goupnpgen generates a Go package of typed UPnP clients and server stubs
+// from device descriptions and service descriptions (SCPDs), such as those
+// of vendor-specific services retrieved from a device.
+//
+// Usage:
+//
+//	goupnpgen -name <package> -out <dir> [flags] <file>...
+//
+// Each file is a device description, an SCPD, or a ZIP file of UPnP Forum
+// test files. The service type of an SCPD is taken from the device
+// description whose SCPDURL has the same base name, or may be given
+// explicitly as "<service type>=<file>", e.g
+//
+//	goupnpgen -name sonos -out ./sonos device_description.xml \
+//		urn:schemas-sonos-com:service:Queue:1=Queue.xml
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/huin/goupnp/dcpgen"
+)
+
+func main() {
+	name := flag.String("name", "", "Name of the generated Go package (required).")
+	outDir := flag.String("out", "", "Directory to write the package to, <name> if empty.")
+	officialName := flag.String("official_name", "", "Name of the DCP for the package documentation, <name> if empty.")
+	docURL := flag.String("doc_url", "", "Optional URL of documentation about the DCP.")
+	interfaces := flag.Bool("interfaces", false, "Generate an interface per service client, for fakes and mocks.")
+	timeout := flag.Duration("timeout", 0, "Default timeout of every action, none if zero.")
+	noGofmt := flag.Bool("nogofmt", false, "Disable passing the output through gofmt.")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s -name <package> [flags] [<service type>=]<file>...\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	if *name == "" || flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if *outDir == "" {
+		*outDir = *name
+	}
+	if *officialName == "" {
+		*officialName = *name
+	}
+
+	metadata := dcpgen.Metadata{
+		Name:             *name,
+		OfficialName:     *officialName,
+		DocURL:           *docURL,
+		ClientInterfaces: *interfaces,
+	}
+	if *timeout > 0 {
+		metadata.ActionTimeouts = map[string]time.Duration{"": *timeout}
+	}
+	dcp := dcpgen.NewDCP(metadata)
+	for _, arg := range flag.Args() {
+		var err error
+		if i := strings.LastIndex(arg, "="); i >= 0 && strings.HasPrefix(arg, "urn:") {
+			err = dcp.AddSCPDFile(arg[i+1:], arg[:i])
+		} else {
+			err = dcp.AddFile(arg)
+		}
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+	if err := dcp.WritePackage(filepath.Clean(*outDir), !*noGofmt); err != nil {
+		log.Fatal(err)
+	}
+}
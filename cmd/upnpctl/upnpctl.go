@@ -0,0 +1,501 @@
+// upnpctl discovers and controls UPnP devices from the command line.
+//
+// Usage:
+//
+//	upnpctl [-timeout <duration>] <command> [arguments]
+//
+// The commands are:
+//
+//	discover [<search target>]
+//		List the devices responding to a search, ssdp:all by default.
+//	describe [-json] <location>
+//		Print the devices and services of the description at location, or
+//		as JSON, with the actions and state variables of the services.
+//	actions <location> <service>
+//		List the actions of a service and their arguments.
+//	doc [-html] <location> <service>
+//		Print the documentation of a service, its actions, their arguments
+//		and its state variables, as Markdown or HTML.
+//	check <location>
+//		Check the description at location and the SCPDs of its services for
+//		compliance with UPnP, printing the findings.
+//	invoke <location> <service> <action> [<name>=<value>...]
+//		Invoke an action, printing its output arguments.
+//	events <location> <service>
+//		Subscribe to the events of a service, printing them until interrupted.
+//	portmap [-url <location>] list
+//	portmap [-url <location>] add [-lease <duration>] <protocol> <external port> [<internal client>:]<internal port> [<description>]
+//	portmap [-url <location>] delete <protocol> <external port>
+//		List, add or delete the port mappings of the gateway at location, or
+//		of the best gateway discovered.
+//
+// A service is given by its service type or ID, or by the end of either, e.g
+// "WANIPConnection:1" or "WANIPConn1".
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net"
+	"net/url"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/huin/goupnp"
+	"github.com/huin/goupnp/gena"
+	"github.com/huin/goupnp/igd"
+	"github.com/huin/goupnp/soap"
+)
+
+var errUsage = errors.New("usage")
+
+type command struct {
+	run   func(ctx context.Context, out io.Writer, args []string) error
+	usage string
+}
+
+var commands = map[string]command{
+	"discover": {discover, "discover [<search target>]"},
+	"describe": {describe, "describe [-json] <location>"},
+	"actions":  {actions, "actions <location> <service>"},
+	"doc":      {doc, "doc [-html] <location> <service>"},
+	"check":    {check, "check <location>"},
+	"invoke":   {invoke, "invoke <location> <service> <action> [<name>=<value>...]"},
+	"events":   {events, "events <location> <service>"},
+	"portmap":  {portmap, "portmap [-url <location>] list|add|delete ..."},
+}
+
+// timeout is the time limit of each command other than events.
+var timeout = 10 * time.Second
+
+func main() {
+	flag.DurationVar(&timeout, "timeout", timeout, "Time limit of each command other than events.")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] <command> [arguments]\n\nCommands:\n", os.Args[0])
+		for _, name := range []string{"discover", "describe", "actions", "doc", "check", "invoke", "events", "portmap"} {
+			fmt.Fprintf(flag.CommandLine.Output(), "  %s\n", commands[name].usage)
+		}
+		fmt.Fprintf(flag.CommandLine.Output(), "\nFlags:\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	err := run(ctx, os.Stdout, flag.Args())
+	if err == errUsage {
+		flag.Usage()
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "upnpctl: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+// run runs the command of args, writing its output to out.
+func run(ctx context.Context, out io.Writer, args []string) error {
+	if len(args) == 0 {
+		return errUsage
+	}
+	cmd, ok := commands[args[0]]
+	if !ok {
+		return errUsage
+	}
+	if args[0] != "events" {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+	err := cmd.run(ctx, out, args[1:])
+	if err == errUsage {
+		return fmt.Errorf("usage: upnpctl %s", cmd.usage)
+	}
+	return err
+}
+
+func discover(ctx context.Context, out io.Writer, args []string) error {
+	if len(args) > 1 {
+		return errUsage
+	}
+	searchTarget := "ssdp:all"
+	if len(args) == 1 {
+		searchTarget = args[0]
+	}
+	devices, err := goupnp.DiscoverDevicesCtx(ctx, searchTarget)
+	if err != nil {
+		return err
+	}
+	for _, d := range devices {
+		if d.Err != nil {
+			fmt.Fprintf(out, "%s\terror: %v\n", d.Location, d.Err)
+			continue
+		}
+		fmt.Fprintf(out, "%s\t%s\t%s\t%s\n", d.Location, d.Root.Device.UDN, d.Root.Device.DeviceType, d.Root.Device.FriendlyName)
+	}
+	return nil
+}
+
+func describe(ctx context.Context, out io.Writer, args []string) error {
+	fs := flag.NewFlagSet("describe", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	asJSON := fs.Bool("json", false, "Print the description as JSON, with the SCPDs of the services.")
+	if err := fs.Parse(args); err != nil || fs.NArg() != 1 {
+		return errUsage
+	}
+	root, err := rootDevice(ctx, fs.Arg(0))
+	if err != nil {
+		return err
+	}
+	if !*asJSON {
+		printDevice(out, &root.Device, "")
+		return nil
+	}
+	loc, err := url.Parse(fs.Arg(0))
+	if err != nil || loc.Scheme == "" {
+		loc = nil
+	}
+	e := goupnp.NewDeviceExport(root, loc)
+	if err := e.FetchSCPDs(ctx); err != nil {
+		return err
+	}
+	enc := json.NewEncoder(out)
+	enc.SetIndent("", "  ")
+	return enc.Encode(e)
+}
+
+func printDevice(out io.Writer, d *goupnp.Device, indent string) {
+	fmt.Fprintf(out, "%s%s (%s)\n", indent, d.FriendlyName, d.DeviceType)
+	fmt.Fprintf(out, "%s  UDN: %s\n", indent, d.UDN)
+	if d.Manufacturer != "" || d.ModelName != "" {
+		fmt.Fprintf(out, "%s  Model: %s %s %s\n", indent, d.Manufacturer, d.ModelName, d.ModelNumber)
+	}
+	for i := range d.Services {
+		s := &d.Services[i]
+		fmt.Fprintf(out, "%s  Service %s (%s)\n", indent, s.ServiceType, s.ServiceId)
+		fmt.Fprintf(out, "%s    Control: %s\n", indent, s.ControlURL.URL.String())
+		if s.EventSubURL.Str != "" {
+			fmt.Fprintf(out, "%s    Events: %s\n", indent, s.EventSubURL.URL.String())
+		}
+	}
+	for i := range d.Devices {
+		printDevice(out, &d.Devices[i], indent+"  ")
+	}
+}
+
+func actions(ctx context.Context, out io.Writer, args []string) error {
+	if len(args) != 2 {
+		return errUsage
+	}
+	srv, err := findService(ctx, args[0], args[1])
+	if err != nil {
+		return err
+	}
+	s, err := srv.RequestSCDPCtx(ctx)
+	if err != nil {
+		return err
+	}
+	for i := range s.Actions {
+		action := &s.Actions[i]
+		fmt.Fprintln(out, action.Name)
+		for _, arg := range action.Arguments {
+			var dataType string
+			if sv := s.GetStateVariable(arg.RelatedStateVariable); sv != nil {
+				dataType = " " + sv.DataType.Name
+				if len(sv.AllowedValues) > 0 {
+					dataType += " {" + strings.Join(sv.AllowedValues, ", ") + "}"
+				}
+			}
+			fmt.Fprintf(out, "  %-3s %s%s\n", arg.Direction, arg.Name, dataType)
+		}
+	}
+	return nil
+}
+
+func doc(ctx context.Context, out io.Writer, args []string) error {
+	fs := flag.NewFlagSet("doc", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	asHTML := fs.Bool("html", false, "Print the documentation as HTML rather than Markdown.")
+	if err := fs.Parse(args); err != nil || fs.NArg() != 2 {
+		return errUsage
+	}
+	srv, err := findService(ctx, fs.Arg(0), fs.Arg(1))
+	if err != nil {
+		return err
+	}
+	s, err := srv.RequestSCDPCtx(ctx)
+	if err != nil {
+		return err
+	}
+	if *asHTML {
+		return s.WriteHTML(out, srv.ServiceType)
+	}
+	return s.WriteMarkdown(out, srv.ServiceType)
+}
+
+func check(ctx context.Context, out io.Writer, args []string) error {
+	if len(args) != 1 {
+		return errUsage
+	}
+	root, err := rootDevice(ctx, args[0])
+	if err != nil {
+		return err
+	}
+	for _, f := range goupnp.FetchCompliance(ctx, root) {
+		fmt.Fprintln(out, f)
+	}
+	return nil
+}
+
+func invoke(ctx context.Context, out io.Writer, args []string) error {
+	if len(args) < 3 {
+		return errUsage
+	}
+	srv, err := findService(ctx, args[0], args[1])
+	if err != nil {
+		return err
+	}
+	var in []soap.Arg
+	for _, arg := range args[3:] {
+		eq := strings.IndexByte(arg, '=')
+		if eq < 0 {
+			return fmt.Errorf("argument %q is not of the form <name>=<value>", arg)
+		}
+		in = append(in, soap.Arg{Name: arg[:eq], Value: arg[eq+1:]})
+	}
+	result, err := srv.NewSOAPClient().PerformActionArgs(ctx, srv.ServiceType, args[2], in)
+	if err != nil {
+		return err
+	}
+	for _, arg := range result {
+		fmt.Fprintf(out, "%s=%s\n", arg.Name, arg.Value)
+	}
+	return nil
+}
+
+func events(ctx context.Context, out io.Writer, args []string) error {
+	if len(args) != 2 {
+		return errUsage
+	}
+	reqCtx, cancel := context.WithTimeout(ctx, timeout)
+	srv, err := findService(reqCtx, args[0], args[1])
+	cancel()
+	if err != nil {
+		return err
+	}
+	if !srv.EventSubURL.Ok || srv.EventSubURL.Str == "" {
+		return fmt.Errorf("service %s has no event subscription URL", srv.ServiceType)
+	}
+
+	handler := gena.NewChanHandler(16, gena.DropOldest)
+	subscriber, err := gena.NewSubscriber(handler)
+	if err != nil {
+		return err
+	}
+	defer subscriber.Close()
+	sub, err := subscriber.SubscribeCtx(ctx, &srv.EventSubURL.URL, 0)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), timeout)
+		defer cancel()
+		sub.UnsubscribeCtx(ctx)
+	}()
+
+	renew := time.NewTimer(renewInterval(sub))
+	defer renew.Stop()
+	for {
+		select {
+		case ev := <-handler.Events():
+			fmt.Fprintf(out, "SEQ %d from %s\n", ev.Seq, ev.RemoteAddr)
+			for _, p := range ev.Properties {
+				fmt.Fprintf(out, "  %s=%s\n", p.Name, p.Value)
+			}
+		case <-renew.C:
+			if err := sub.RenewCtx(ctx, 0); err != nil {
+				return err
+			}
+			renew.Reset(renewInterval(sub))
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
+
+// renewInterval returns how long to wait before renewing sub, half of its
+// timeout.
+func renewInterval(sub *gena.Subscription) time.Duration {
+	if sub.Timeout <= 0 {
+		return gena.DefaultTimeout / 2
+	}
+	return sub.Timeout / 2
+}
+
+func portmap(ctx context.Context, out io.Writer, args []string) error {
+	fs := flag.NewFlagSet("portmap", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	location := fs.String("url", "", "Location of the description of the gateway.")
+	if err := fs.Parse(args); err != nil || fs.NArg() == 0 {
+		return errUsage
+	}
+	conn, err := gateway(ctx, *location)
+	if err != nil {
+		return err
+	}
+	args = fs.Args()
+	switch args[0] {
+	case "list":
+		mappings, err := conn.ListPortMappings(ctx)
+		if err != nil {
+			return err
+		}
+		for _, m := range mappings {
+			remote := m.RemoteHost
+			if remote == "" {
+				remote = "*"
+			}
+			lease := "permanent"
+			if m.Lease > 0 {
+				lease = m.Lease.String()
+			}
+			fmt.Fprintf(out, "%s\t%s:%d\t-> %s:%d\t%s\t%t\t%q\n", m.Protocol, remote, m.ExternalPort,
+				m.InternalClient, m.InternalPort, lease, m.Enabled, m.Description)
+		}
+		return nil
+	case "add":
+		return addPortMapping(ctx, out, conn, args[1:])
+	case "delete":
+		if len(args) != 3 {
+			return errUsage
+		}
+		protocol, port, err := protocolPort(args[1], args[2])
+		if err != nil {
+			return err
+		}
+		return conn.DeletePortMapping(ctx, "", port, protocol)
+	}
+	return errUsage
+}
+
+func addPortMapping(ctx context.Context, out io.Writer, conn *igd.Connection, args []string) error {
+	fs := flag.NewFlagSet("add", flag.ContinueOnError)
+	fs.SetOutput(io.Discard)
+	lease := fs.Duration("lease", 0, "Lease of the mapping, permanent if zero.")
+	if err := fs.Parse(args); err != nil || fs.NArg() < 3 || fs.NArg() > 4 {
+		return errUsage
+	}
+	args = fs.Args()
+	protocol, externalPort, err := protocolPort(args[0], args[1])
+	if err != nil {
+		return err
+	}
+	client, port := "", args[2]
+	if i := strings.LastIndex(args[2], ":"); i >= 0 {
+		client, port = args[2][:i], args[2][i+1:]
+	}
+	internalPort, err := strconv.ParseUint(port, 10, 16)
+	if err != nil {
+		return fmt.Errorf("bad internal port %q", port)
+	}
+	if client == "" {
+		// The address of this host on the network of the gateway.
+		ip, err := gena.LocalIPFor(&conn.ServiceClient().Service.ControlURL.URL)
+		if err != nil {
+			return err
+		}
+		client = ip.String()
+	}
+	m := igd.Mapping{
+		ExternalPort:   externalPort,
+		Protocol:       protocol,
+		InternalPort:   uint16(internalPort),
+		InternalClient: client,
+		Enabled:        true,
+		Lease:          *lease,
+	}
+	if len(args) == 4 {
+		m.Description = args[3]
+	}
+	if err := conn.AddPortMapping(ctx, m); err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "%s %d -> %s:%d\n", m.Protocol, m.ExternalPort, m.InternalClient, m.InternalPort)
+	return nil
+}
+
+func protocolPort(protocol, port string) (igd.Protocol, uint16, error) {
+	p := igd.Protocol(strings.ToUpper(protocol))
+	if p != igd.TCP && p != igd.UDP {
+		return "", 0, fmt.Errorf("bad protocol %q, want TCP or UDP", protocol)
+	}
+	n, err := strconv.ParseUint(port, 10, 16)
+	if err != nil {
+		return "", 0, fmt.Errorf("bad port %q", port)
+	}
+	return p, uint16(n), nil
+}
+
+// gateway returns the best WAN connection service of the gateway at
+// location, or of the gateways discovered if location is empty.
+func gateway(ctx context.Context, location string) (*igd.Connection, error) {
+	if location == "" {
+		return igd.PickRouterClient(ctx)
+	}
+	loc, err := url.Parse(location)
+	if err != nil {
+		return nil, err
+	}
+	conns, err := igd.ConnectionsByURL(loc)
+	if err != nil {
+		return nil, err
+	}
+	if len(conns) == 0 {
+		return nil, fmt.Errorf("no WAN connection service at %s", location)
+	}
+	return conns[0], nil
+}
+
+// rootDevice fetches the root device description at location.
+func rootDevice(ctx context.Context, location string) (*goupnp.RootDevice, error) {
+	loc, err := url.Parse(location)
+	if err != nil {
+		return nil, err
+	}
+	if loc.Scheme == "" {
+		// A bare host and port, as of a LOCATION without its path.
+		if _, _, err := net.SplitHostPort(location); err != nil {
+			return nil, fmt.Errorf("bad location %q", location)
+		}
+		loc = &url.URL{Scheme: "http", Host: location, Path: "/"}
+	}
+	return goupnp.DeviceByURLCtx(ctx, loc)
+}
+
+// findService returns the service of the device at location whose type or ID
+// is name, or ends with it.
+func findService(ctx context.Context, location, name string) (*goupnp.Service, error) {
+	root, err := rootDevice(ctx, location)
+	if err != nil {
+		return nil, err
+	}
+	var found []*goupnp.Service
+	root.Device.VisitServices(func(s *goupnp.Service) {
+		if s.ServiceType == name || s.ServiceId == name {
+			found = append([]*goupnp.Service{s}, found...)
+		} else if strings.HasSuffix(s.ServiceType, ":"+name) || strings.HasSuffix(s.ServiceId, ":"+name) {
+			found = append(found, s)
+		}
+	})
+	if len(found) == 0 {
+		return nil, fmt.Errorf("no service %q at %s", name, location)
+	}
+	return found[0], nil
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/huin/goupnp/goupnptest"
+)
+
+const testDescription = `<?xml version="1.0"?>
+<root xmlns="urn:schemas-upnp-org:device-1-0">
+  <specVersion><major>1</major><minor>0</minor></specVersion>
+  <device>
+    <deviceType>urn:schemas-upnp-org:device:WANConnectionDevice:1</deviceType>
+    <friendlyName>Fake connection</friendlyName>
+    <UDN>uuid:00000000-0000-0000-0000-000000000001</UDN>
+    <serviceList>
+      <service>
+        <serviceType>urn:schemas-upnp-org:service:WANIPConnection:1</serviceType>
+        <serviceId>urn:upnp-org:serviceId:WANIPConn1</serviceId>
+        <SCPDURL>/scpd/IPConn.xml</SCPDURL>
+        <controlURL>/ctl/IPConn</controlURL>
+        <eventSubURL>/evt/IPConn</eventSubURL>
+      </service>
+    </serviceList>
+  </device>
+</root>`
+
+const testSCPD = `<?xml version="1.0"?>
+<scpd xmlns="urn:schemas-upnp-org:service-1-0">
+  <actionList>
+    <action>
+      <name>DeletePortMapping</name>
+      <argumentList>
+        <argument><name>NewRemoteHost</name><direction>in</direction><relatedStateVariable>RemoteHost</relatedStateVariable></argument>
+        <argument><name>NewExternalPort</name><direction>in</direction><relatedStateVariable>ExternalPort</relatedStateVariable></argument>
+        <argument><name>NewProtocol</name><direction>in</direction><relatedStateVariable>PortMappingProtocol</relatedStateVariable></argument>
+      </argumentList>
+    </action>
+  </actionList>
+  <serviceStateTable>
+    <stateVariable sendEvents="no"><name>RemoteHost</name><dataType>string</dataType></stateVariable>
+    <stateVariable sendEvents="no"><name>ExternalPort</name><dataType>ui2</dataType></stateVariable>
+    <stateVariable sendEvents="no"><name>PortMappingProtocol</name><dataType>string</dataType>
+      <allowedValueList><allowedValue>TCP</allowedValue><allowedValue>UDP</allowedValue></allowedValueList></stateVariable>
+  </serviceStateTable>
+</scpd>`
+
+func TestCommands(t *testing.T) {
+	d := goupnptest.NewFakeDevice(testDescription)
+	defer d.Close()
+	d.Serve("/scpd/IPConn.xml", testSCPD)
+	d.ScriptAction("/ctl/IPConn", "GetExternalIPAddress", goupnptest.Respond("NewExternalIPAddress", "203.0.113.1"))
+	d.ScriptAction("/ctl/IPConn", "DeletePortMapping", goupnptest.Respond())
+	d.ScriptAction("/ctl/IPConn", "GetGenericPortMappingEntry",
+		goupnptest.Respond("NewRemoteHost", "", "NewExternalPort", "8080", "NewProtocol", "TCP",
+			"NewInternalPort", "80", "NewInternalClient", "192.168.1.2", "NewEnabled", "1",
+			"NewPortMappingDescription", "web", "NewLeaseDuration", "0"),
+		goupnptest.Fault(713, "SpecifiedArrayIndexInvalid"))
+	loc := d.Location().String()
+
+	for _, test := range []struct {
+		args []string
+		want []string
+	}{
+		{[]string{"describe", loc}, []string{"Fake connection (urn:schemas-upnp-org:device:WANConnectionDevice:1)",
+			"Service urn:schemas-upnp-org:service:WANIPConnection:1 (urn:upnp-org:serviceId:WANIPConn1)"}},
+		{[]string{"describe", "-json", loc}, []string{`"controlURL": "` + d.URL("/ctl/IPConn").String() + `"`, `"name": "DeletePortMapping"`}},
+		{[]string{"actions", loc, "WANIPConn1"}, []string{"DeletePortMapping\n", "in  NewProtocol string {TCP, UDP}"}},
+		{[]string{"doc", loc, "WANIPConn1"}, []string{"### DeletePortMapping\n",
+			"| NewProtocol | in | PortMappingProtocol | string | TCP, UDP |\n", "| ExternalPort | ui2 | no |  |  |\n"}},
+		{[]string{"doc", "-html", loc, "WANIPConn1"}, []string{"<h3>DeletePortMapping</h3>", "<td>TCP, UDP</td>"}},
+		{[]string{"check", loc}, []string{"[required-field]: manufacturer is empty\n", "action GetExternalIPAddress required of"}},
+		{[]string{"invoke", loc, "WANIPConnection:1", "GetExternalIPAddress"}, []string{"NewExternalIPAddress=203.0.113.1\n"}},
+		{[]string{"invoke", loc, "WANIPConnection:1", "DeletePortMapping", "NewRemoteHost=", "NewExternalPort=8080", "NewProtocol=TCP"}, nil},
+		{[]string{"portmap", "-url", loc, "list"}, []string{"TCP\t*:8080\t-> 192.168.1.2:80\tpermanent\ttrue\t\"web\"\n"}},
+	} {
+		var out bytes.Buffer
+		if err := run(context.Background(), &out, test.args); err != nil {
+			t.Errorf("%q: %v", test.args, err)
+			continue
+		}
+		for _, want := range test.want {
+			if !strings.Contains(out.String(), want) {
+				t.Errorf("%q: output %q does not contain %q", test.args, out.String(), want)
+			}
+		}
+	}
+
+	calls := d.Calls()
+	if len(calls) < 2 || calls[1].Action != "DeletePortMapping" || calls[1].Arg("NewExternalPort") != "8080" {
+		t.Errorf("calls = %+v, want DeletePortMapping of port 8080 second", calls)
+	}
+	if err := run(context.Background(), &bytes.Buffer{}, []string{"invoke", loc, "NoSuchService", "X"}); err == nil {
+		t.Error("invoke of an unknown service: want error, got nil")
+	}
+}
+
+func TestDiscover(t *testing.T) {
+	d := goupnptest.NewFakeDevice(testDescription)
+	defer d.Close()
+	shim, err := goupnptest.NewSSDPShim()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer shim.Close()
+	if err := shim.AdvertiseDevice(d); err != nil {
+		t.Fatal(err)
+	}
+
+	var out bytes.Buffer
+	if err := run(context.Background(), &out, []string{"discover"}); err != nil {
+		t.Fatal(err)
+	}
+	want := d.Location().String() + "\tuuid:00000000-0000-0000-0000-000000000001\turn:schemas-upnp-org:device:WANConnectionDevice:1\tFake connection\n"
+	if !strings.Contains(out.String(), want) {
+		t.Errorf("output %q does not contain %q", out.String(), want)
+	}
+}
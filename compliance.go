@@ -0,0 +1,334 @@
+package goupnp
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/huin/goupnp/scpd"
+)
+
+// Severity is how serious a Finding is.
+type Severity int
+
+const (
+	// SeverityWarning is for deviations that control points usually
+	// tolerate.
+	SeverityWarning Severity = iota
+	// SeverityError is for violations of UPnP that break control points.
+	SeverityError
+)
+
+func (s Severity) String() string {
+	if s == SeverityError {
+		return "error"
+	}
+	return "warning"
+}
+
+// Finding is a violation of UPnP found by CheckCompliance.
+type Finding struct {
+	Severity Severity
+	// Rule identifies the rule violated, e.g. "required-action", so that
+	// findings can be filtered.
+	Rule string
+	// UDN is that of the device of the finding, and ServiceID that of its
+	// service, if any.
+	UDN       string
+	ServiceID string
+	Message   string
+}
+
+func (f Finding) String() string {
+	where := f.UDN
+	if f.ServiceID != "" {
+		where += " " + f.ServiceID
+	}
+	return fmt.Sprintf("%s: %s [%s]: %s", f.Severity, where, f.Rule, f.Message)
+}
+
+var (
+	requiredActionsLock sync.RWMutex // Protects requiredActions.
+	// requiredActions are the actions required of the versions of standard
+	// services, and those added with RegisterRequiredActions.
+	requiredActions = []serviceRequirement{
+		{"urn:schemas-upnp-org:service:WANIPConnection:1", []string{"GetConnectionTypeInfo", "GetStatusInfo", "GetNATRSIPStatus",
+			"GetGenericPortMappingEntry", "GetSpecificPortMappingEntry", "AddPortMapping", "DeletePortMapping", "GetExternalIPAddress"}},
+		{"urn:schemas-upnp-org:service:WANIPConnection:2", []string{"DeletePortMappingRange", "GetListOfPortMappings", "AddAnyPortMapping"}},
+		{"urn:schemas-upnp-org:service:WANPPPConnection:1", []string{"GetConnectionTypeInfo", "GetStatusInfo", "GetNATRSIPStatus",
+			"GetGenericPortMappingEntry", "GetSpecificPortMappingEntry", "AddPortMapping", "DeletePortMapping", "GetExternalIPAddress"}},
+		{"urn:schemas-upnp-org:service:WANCommonInterfaceConfig:1", []string{"GetCommonLinkProperties"}},
+		{"urn:schemas-upnp-org:service:Layer3Forwarding:1", []string{"SetDefaultConnectionService", "GetDefaultConnectionService"}},
+		{"urn:schemas-upnp-org:service:ContentDirectory:1", []string{"GetSearchCapabilities", "GetSortCapabilities", "GetSystemUpdateID", "Browse"}},
+		{"urn:schemas-upnp-org:service:ConnectionManager:1", []string{"GetProtocolInfo", "GetCurrentConnectionIDs", "GetCurrentConnectionInfo"}},
+		{"urn:schemas-upnp-org:service:AVTransport:1", []string{"SetAVTransportURI", "GetMediaInfo", "GetTransportInfo", "GetPositionInfo",
+			"GetDeviceCapabilities", "GetTransportSettings", "Stop", "Play", "Seek", "Next", "Previous"}},
+		{"urn:schemas-upnp-org:service:RenderingControl:1", []string{"ListPresets", "SelectPreset"}},
+		{"urn:schemas-upnp-org:service:SwitchPower:1", []string{"SetTarget", "GetTarget", "GetStatus"}},
+		{"urn:schemas-upnp-org:service:Dimming:1", []string{"SetLoadLevelTarget", "GetLoadLevelTarget", "GetLoadLevelStatus"}},
+	}
+)
+
+// serviceRequirement are the actions required of a version of a service, and
+// so of its later versions.
+type serviceRequirement struct {
+	serviceType string
+	actions     []string
+}
+
+// RegisterRequiredActions adds actions to those that CheckCompliance requires
+// of services of serviceType, such as vendor services, and of later versions
+// of it.
+func RegisterRequiredActions(serviceType string, actions ...string) {
+	requiredActionsLock.Lock()
+	defer requiredActionsLock.Unlock()
+	requiredActions = append(requiredActions, serviceRequirement{serviceType, actions})
+}
+
+// RequiredActions returns the actions required of services of serviceType,
+// including those of its earlier versions.
+func RequiredActions(serviceType string) []string {
+	base, version, ok := splitURNVersion(serviceType)
+	if !ok {
+		return nil
+	}
+	requiredActionsLock.RLock()
+	defer requiredActionsLock.RUnlock()
+	var actions []string
+	for _, r := range requiredActions {
+		if rBase, rVersion, ok := splitURNVersion(r.serviceType); ok && rBase == base && rVersion <= version {
+			actions = append(actions, r.actions...)
+		}
+	}
+	return actions
+}
+
+// splitURNVersion splits the version from the end of a URN such as
+// urn:schemas-upnp-org:service:WANIPConnection:1.
+func splitURNVersion(urn string) (base string, version int, ok bool) {
+	i := strings.LastIndexByte(urn, ':')
+	if i < 0 {
+		return "", 0, false
+	}
+	version, err := strconv.Atoi(urn[i+1:])
+	if err != nil || version < 1 {
+		return "", 0, false
+	}
+	return urn[:i], version, true
+}
+
+// validURN returns whether urn is of the form urn:<domain>:<kind>:<type>,
+// with a version if versioned.
+func validURN(urn, kind string, versioned bool) bool {
+	parts := strings.Split(urn, ":")
+	if versioned {
+		if _, _, ok := splitURNVersion(urn); !ok {
+			return false
+		}
+		return len(parts) == 5 && parts[0] == "urn" && parts[1] != "" && parts[2] == kind && parts[3] != ""
+	}
+	return len(parts) == 4 && parts[0] == "urn" && parts[1] != "" && parts[2] == kind && parts[3] != ""
+}
+
+// dataTypes are the data types of state variables defined by UPnP.
+var dataTypes = map[string]bool{
+	"ui1": true, "ui2": true, "ui4": true, "ui8": true, "i1": true, "i2": true, "i4": true, "i8": true,
+	"int": true, "r4": true, "r8": true, "number": true, "fixed.14.4": true, "float": true,
+	"char": true, "string": true, "date": true, "dateTime": true, "dateTime.tz": true, "time": true, "time.tz": true,
+	"boolean": true, "bin.base64": true, "bin.hex": true, "uri": true, "uuid": true,
+}
+
+// numericDataTypes are the data types that allowed ranges apply to.
+var numericDataTypes = map[string]bool{
+	"ui1": true, "ui2": true, "ui4": true, "ui8": true, "i1": true, "i2": true, "i4": true, "i8": true,
+	"int": true, "r4": true, "r8": true, "number": true, "fixed.14.4": true, "float": true,
+}
+
+// CheckCompliance checks the description of root, and the SCPDs of its
+// services as returned by scpdOf for each service and its device, against the
+// rules of UPnP and of the DCPs of its services: that the description has the
+// required fields, that the services have the actions required of the
+// versions that they claim, and that the arguments and state variables of
+// their SCPDs are consistent and of valid types. It returns what it finds,
+// none if root complies. Services whose SCPD cannot be had from scpdOf are
+// reported as such.
+func CheckCompliance(root *RootDevice, scpdOf func(d *Device, srv *Service) (*scpd.SCPD, error)) []Finding {
+	c := &complianceChecker{scpdOf: scpdOf, udns: make(map[string]bool)}
+	if root.SpecVersion.Major != 1 {
+		c.add(SeverityError, "spec-version", &root.Device, nil, "spec version %d.%d is not 1.x", root.SpecVersion.Major, root.SpecVersion.Minor)
+	}
+	c.checkDevice(&root.Device)
+	return c.findings
+}
+
+// FetchCompliance is CheckCompliance with the SCPDs of the services of root
+// fetched with ctx.
+func FetchCompliance(ctx context.Context, root *RootDevice) []Finding {
+	return CheckCompliance(root, func(d *Device, srv *Service) (*scpd.SCPD, error) {
+		return srv.RequestSCDPCtx(ctx)
+	})
+}
+
+type complianceChecker struct {
+	scpdOf   func(d *Device, srv *Service) (*scpd.SCPD, error)
+	udns     map[string]bool
+	findings []Finding
+}
+
+func (c *complianceChecker) add(severity Severity, rule string, d *Device, srv *Service, format string, args ...interface{}) {
+	f := Finding{Severity: severity, Rule: rule, UDN: d.UDN, Message: fmt.Sprintf(format, args...)}
+	if srv != nil {
+		f.ServiceID = srv.ServiceId
+	}
+	c.findings = append(c.findings, f)
+}
+
+func (c *complianceChecker) checkDevice(d *Device) {
+	if !validURN(d.DeviceType, "device", true) {
+		c.add(SeverityError, "device-type", d, nil, "device type %q is not a versioned device URN", d.DeviceType)
+	}
+	if !strings.HasPrefix(d.UDN, "uuid:") {
+		c.add(SeverityError, "udn", d, nil, "UDN %q does not start with uuid:", d.UDN)
+	}
+	if c.udns[d.UDN] {
+		c.add(SeverityError, "duplicate-udn", d, nil, "UDN %q is that of another device of the description", d.UDN)
+	}
+	c.udns[d.UDN] = true
+	for _, field := range []struct{ name, value string }{
+		{"friendlyName", d.FriendlyName},
+		{"manufacturer", d.Manufacturer},
+		{"modelName", d.ModelName},
+	} {
+		if strings.TrimSpace(field.value) == "" {
+			c.add(SeverityError, "required-field", d, nil, "%s is empty", field.name)
+		}
+	}
+
+	serviceIDs := make(map[string]bool)
+	for i := range d.Services {
+		srv := &d.Services[i]
+		if serviceIDs[srv.ServiceId] {
+			c.add(SeverityError, "duplicate-service-id", d, srv, "service ID is that of another service of the device")
+		}
+		serviceIDs[srv.ServiceId] = true
+		c.checkService(d, srv)
+	}
+	for i := range d.Devices {
+		c.checkDevice(&d.Devices[i])
+	}
+}
+
+func (c *complianceChecker) checkService(d *Device, srv *Service) {
+	if !validURN(srv.ServiceType, "service", true) {
+		c.add(SeverityError, "service-type", d, srv, "service type %q is not a versioned service URN", srv.ServiceType)
+	}
+	if !validURN(srv.ServiceId, "serviceId", false) {
+		c.add(SeverityWarning, "service-id", d, srv, "service ID %q is not a service ID URN", srv.ServiceId)
+	}
+	for _, field := range []struct{ name, value string }{
+		{"SCPDURL", srv.SCPDURL.Str},
+		{"controlURL", srv.ControlURL.Str},
+	} {
+		if strings.TrimSpace(field.value) == "" {
+			c.add(SeverityError, "required-field", d, srv, "%s is empty", field.name)
+		}
+	}
+
+	doc, err := c.scpdOf(d, srv)
+	if err != nil {
+		c.add(SeverityError, "scpd-unavailable", d, srv, "SCPD: %v", err)
+		return
+	}
+	c.checkSCPD(d, srv, doc)
+}
+
+func (c *complianceChecker) checkSCPD(d *Device, srv *Service, doc *scpd.SCPD) {
+	actions := make(map[string]bool)
+	for i := range doc.Actions {
+		action := &doc.Actions[i]
+		if actions[action.Name] {
+			c.add(SeverityError, "duplicate-action", d, srv, "action %s is defined more than once", action.Name)
+		}
+		actions[action.Name] = true
+		c.checkAction(d, srv, doc, action)
+	}
+	for _, name := range RequiredActions(srv.ServiceType) {
+		if !actions[name] {
+			c.add(SeverityError, "required-action", d, srv, "action %s required of %s is missing", name, srv.ServiceType)
+		}
+	}
+
+	variables := make(map[string]bool)
+	for i := range doc.StateVariables {
+		sv := &doc.StateVariables[i]
+		if variables[sv.Name] {
+			c.add(SeverityError, "duplicate-state-variable", d, srv, "state variable %s is defined more than once", sv.Name)
+		}
+		variables[sv.Name] = true
+		c.checkStateVariable(d, srv, sv)
+	}
+}
+
+func (c *complianceChecker) checkAction(d *Device, srv *Service, doc *scpd.SCPD, action *scpd.Action) {
+	seenOut := false
+	for i := range action.Arguments {
+		arg := &action.Arguments[i]
+		switch {
+		case arg.IsInput():
+			if seenOut {
+				c.add(SeverityWarning, "argument-order", d, srv, "input argument %s of action %s follows output arguments", arg.Name, action.Name)
+			}
+		case arg.IsOutput():
+			seenOut = true
+		default:
+			c.add(SeverityError, "argument-direction", d, srv, "argument %s of action %s has direction %q, not in or out", arg.Name, action.Name, arg.Direction)
+		}
+		if doc.GetStateVariable(arg.RelatedStateVariable) == nil {
+			c.add(SeverityError, "related-state-variable", d, srv, "argument %s of action %s refers to undefined state variable %q", arg.Name, action.Name, arg.RelatedStateVariable)
+		}
+	}
+}
+
+func (c *complianceChecker) checkStateVariable(d *Device, srv *Service, sv *scpd.StateVariable) {
+	dataType := sv.DataType.Name
+	if !dataTypes[dataType] {
+		c.add(SeverityError, "data-type", d, srv, "state variable %s has unknown data type %q", sv.Name, dataType)
+		return
+	}
+	if len(sv.AllowedValues) > 0 && dataType != "string" {
+		c.add(SeverityError, "allowed-values", d, srv, "state variable %s of type %s has an allowed value list, which only strings may have", sv.Name, dataType)
+	}
+	var rng *scpd.NumericRange
+	if r := sv.AllowedValueRange; r != nil {
+		if !numericDataTypes[dataType] {
+			c.add(SeverityError, "allowed-range", d, srv, "state variable %s of type %s has an allowed range, which only numbers may have", sv.Name, dataType)
+		} else if nr, err := r.Parse(); err != nil {
+			c.add(SeverityError, "allowed-range", d, srv, "state variable %s: %v", sv.Name, err)
+		} else {
+			rng = &nr
+		}
+	}
+
+	if sv.DefaultValue == "" {
+		return
+	}
+	if len(sv.AllowedValues) > 0 && !containsString(sv.AllowedValues, sv.DefaultValue) {
+		c.add(SeverityError, "default-value", d, srv, "default value %q of state variable %s is not one of its allowed values", sv.DefaultValue, sv.Name)
+	}
+	if rng != nil {
+		if f, err := strconv.ParseFloat(sv.DefaultValue, 64); err != nil || !rng.Contains(f) {
+			c.add(SeverityError, "default-value", d, srv, "default value %q of state variable %s is not within its allowed range", sv.DefaultValue, sv.Name)
+		}
+	}
+}
+
+func containsString(values []string, s string) bool {
+	for _, v := range values {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,78 @@
+package goupnp
+
+import (
+	"errors"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/huin/goupnp/scpd"
+)
+
+func TestCheckCompliance(t *testing.T) {
+	root := &RootDevice{
+		SpecVersion: SpecVersion{Major: 1},
+		Device: Device{
+			DeviceType:   "urn:schemas-upnp-org:device:BinaryLight:1",
+			FriendlyName: "Light",
+			ModelName:    "L1",
+			UDN:          "uuid:light",
+			Services: []Service{{
+				ServiceType: "urn:schemas-upnp-org:service:SwitchPower:1",
+				ServiceId:   "urn:upnp-org:serviceId:SwitchPower",
+				SCPDURL:     URLField{Str: "/scpd.xml"},
+				ControlURL:  URLField{Str: "/ctl"},
+			}},
+			Devices: []Device{{
+				DeviceType:   "BinaryLight",
+				FriendlyName: "Embedded",
+				Manufacturer: "goupnp",
+				ModelName:    "L2",
+				UDN:          "uuid:light",
+				Services: []Service{{
+					ServiceType: "urn:example-com:service:Vendor:1",
+					ServiceId:   "urn:example-com:serviceId:Vendor",
+				}},
+			}},
+		},
+	}
+	switchPower := &scpd.SCPD{
+		Actions: []scpd.Action{
+			{Name: "SetTarget", Arguments: []scpd.Argument{{Name: "newTargetValue", Direction: "in", RelatedStateVariable: "Target"}}},
+			{Name: "GetTarget", Arguments: []scpd.Argument{
+				{Name: "RetTargetValue", Direction: "out", RelatedStateVariable: "Target"},
+				{Name: "Extra", Direction: "in", RelatedStateVariable: "Missing"},
+			}},
+		},
+		StateVariables: []scpd.StateVariable{
+			{Name: "Target", DataType: scpd.DataType{Name: "boolean"}, DefaultValue: "0"},
+			{Name: "Level", DataType: scpd.DataType{Name: "ui1"}, DefaultValue: "200",
+				AllowedValueRange: &scpd.AllowedValueRange{Minimum: "0", Maximum: "100"}},
+			{Name: "Mode", DataType: scpd.DataType{Name: "integer"}},
+		},
+	}
+	findings := CheckCompliance(root, func(d *Device, srv *Service) (*scpd.SCPD, error) {
+		if srv.ServiceId == "urn:upnp-org:serviceId:SwitchPower" {
+			return switchPower, nil
+		}
+		return nil, errors.New("not found")
+	})
+
+	var got []string
+	for _, f := range findings {
+		got = append(got, f.Rule)
+		if f.Rule == "required-action" && (!strings.Contains(f.Message, "GetStatus") || f.Severity != SeverityError) {
+			t.Errorf("unexpected finding %v", f)
+		}
+	}
+	sort.Strings(got)
+	want := []string{"argument-order", "data-type", "default-value", "device-type", "duplicate-udn",
+		"related-state-variable", "required-action", "required-field", "required-field", "required-field", "scpd-unavailable"}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("got findings:\n%v\nwant rules %v", findings, want)
+	}
+
+	if actions := RequiredActions("urn:schemas-upnp-org:service:WANIPConnection:2"); len(actions) != 11 {
+		t.Errorf("got %d actions required of WANIPConnection:2, want those of versions 1 and 2", len(actions))
+	}
+}
@@ -0,0 +1,223 @@
+package dcpgen
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+
+	"github.com/huin/goupnp/scpd"
+)
+
+// SCPDWithURN is a service of a DCP.
+type SCPDWithURN struct {
+	*URNParts
+	SCPD *scpd.SCPD
+}
+
+// Ident returns the Go identifier of the service client, e.g
+// "WANIPConnection1".
+func (s *SCPDWithURN) Ident() string {
+	return s.Name + s.Version
+}
+
+// Enums returns a Go enumeration type for every string state variable of the
+// service that has a list of allowed values.
+func (s *SCPDWithURN) Enums() []*enum {
+	var enums []*enum
+	for i := range s.SCPD.StateVariables {
+		if e := s.enum(&s.SCPD.StateVariables[i]); e != nil {
+			enums = append(enums, e)
+		}
+	}
+	return enums
+}
+
+// enum returns the enumeration type of the state variable, or nil if it does
+// not have one.
+func (s *SCPDWithURN) enum(sv *scpd.StateVariable) *enum {
+	if sv.DataType.Name != "string" || len(sv.AllowedValues) == 0 {
+		return nil
+	}
+	e := &enum{
+		Type:          s.Ident() + identifier(strings.TrimPrefix(sv.Name, "A_ARG_TYPE_")),
+		StateVariable: sv.Name,
+		Default:       sv.DefaultValue,
+	}
+	consts := make(map[string]bool)
+	for _, value := range sv.AllowedValues {
+		name := e.Type + "_" + identifier(value)
+		for i := 2; consts[name]; i++ {
+			name = fmt.Sprintf("%s_%s%d", e.Type, identifier(value), i)
+		}
+		consts[name] = true
+		e.Values = append(e.Values, enumValue{Const: name, Value: value})
+	}
+	return e
+}
+
+// identifier replaces characters of s that cannot appear in a Go identifier
+// with underscores.
+func identifier(s string) string {
+	if s == "" {
+		return "Empty"
+	}
+	return strings.Map(func(r rune) rune {
+		if r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r) {
+			return r
+		}
+		return '_'
+	}, s)
+}
+
+// enum is a string type with constants for the allowed values of a state
+// variable.
+type enum struct {
+	Type          string
+	StateVariable string
+	Default       string // Default value of the state variable, if any.
+	Values        []enumValue
+}
+
+type enumValue struct {
+	Const string
+	Value string
+}
+
+// WrapArguments wraps the arguments of an action, for use by the templates.
+func (s *SCPDWithURN) WrapArguments(args []*scpd.Argument) (argumentWrapperList, error) {
+	wrappedArgs := make(argumentWrapperList, len(args))
+	for i, arg := range args {
+		wa, err := s.wrapArgument(arg)
+		if err != nil {
+			return nil, err
+		}
+		wrappedArgs[i] = wa
+	}
+	return wrappedArgs, nil
+}
+
+func (s *SCPDWithURN) wrapArgument(arg *scpd.Argument) (*argumentWrapper, error) {
+	relVar := s.SCPD.GetStateVariable(arg.RelatedStateVariable)
+	if relVar == nil {
+		return nil, fmt.Errorf("no such state variable: %q, for argument %q", arg.RelatedStateVariable, arg.Name)
+	}
+	cnv, ok := typeConvs[relVar.DataType.Name]
+	if !ok {
+		return nil, fmt.Errorf("unknown data type: %q, for state variable %q, for argument %q", relVar.DataType.Name, arg.RelatedStateVariable, arg.Name)
+	}
+	return &argumentWrapper{
+		Argument: *arg,
+		relVar:   relVar,
+		conv:     cnv,
+		enum:     s.enum(relVar),
+	}, nil
+}
+
+type argumentWrapper struct {
+	scpd.Argument
+	relVar *scpd.StateVariable
+	conv   conv
+	enum   *enum
+}
+
+func (arg *argumentWrapper) AsParameter() string {
+	return fmt.Sprintf("%s %s", arg.Name, arg.GoType())
+}
+
+// IsEnum returns whether the argument has an enumeration type, which is
+// converted to and from a string rather than marshalled.
+func (arg *argumentWrapper) IsEnum() bool {
+	return arg.enum != nil
+}
+
+// Document describes the argument for the doc comment of its action method:
+// its direction, related state variable, and any allowed values and default
+// value of the state variable.
+func (arg *argumentWrapper) Document() string {
+	relVar := arg.relVar
+	doc := fmt.Sprintf("(%s, state variable %s)", arg.Direction, relVar.Name)
+	var parts []string
+	if rng := relVar.AllowedValueRange; rng != nil {
+		var rngParts []string
+		if rng.Minimum != "" {
+			rngParts = append(rngParts, "minimum="+rng.Minimum)
+		}
+		if rng.Maximum != "" {
+			rngParts = append(rngParts, "maximum="+rng.Maximum)
+		}
+		if rng.Step != "" {
+			rngParts = append(rngParts, "step="+rng.Step)
+		}
+		if len(rngParts) > 0 {
+			parts = append(parts, "allowed range "+strings.Join(rngParts, ", "))
+		}
+	}
+	if len(relVar.AllowedValues) != 0 {
+		parts = append(parts, "allowed values "+strings.Join(relVar.AllowedValues, ", "))
+	}
+	if relVar.DefaultValue != "" {
+		parts = append(parts, "default value "+relVar.DefaultValue)
+	}
+	if len(parts) == 0 {
+		return doc
+	}
+	return doc + ": " + strings.Join(parts, "; ")
+}
+
+func (arg *argumentWrapper) GoType() string {
+	if arg.enum != nil {
+		return arg.enum.Type
+	}
+	return arg.conv.ExtType
+}
+
+func (arg *argumentWrapper) Marshal() string {
+	if arg.enum != nil {
+		return fmt.Sprintf("soap.Marshal%s(string(%s))", arg.conv.FuncSuffix, arg.Name)
+	}
+	return fmt.Sprintf("soap.Marshal%s(%s)", arg.conv.FuncSuffix, arg.Name)
+}
+
+func (arg *argumentWrapper) Unmarshal(objVar string) string {
+	return fmt.Sprintf("soap.Unmarshal%s(%s.%s)", arg.conv.FuncSuffix, objVar, arg.Name)
+}
+
+// UnmarshalExpr returns an expression that unmarshals the argument from expr.
+func (arg *argumentWrapper) UnmarshalExpr(expr string) string {
+	return fmt.Sprintf("soap.Unmarshal%s(%s)", arg.conv.FuncSuffix, expr)
+}
+
+type argumentWrapperList []*argumentWrapper
+
+type conv struct {
+	FuncSuffix string
+	ExtType    string
+}
+
+// typeConvs maps from a SOAP type (e.g "fixed.14.4") to the function name
+// suffix inside the soap module (e.g "Fixed14_4") and the Go type.
+var typeConvs = map[string]conv{
+	"ui1":         {"Ui1", "uint8"},
+	"ui2":         {"Ui2", "uint16"},
+	"ui4":         {"Ui4", "uint32"},
+	"i1":          {"I1", "int8"},
+	"i2":          {"I2", "int16"},
+	"i4":          {"I4", "int32"},
+	"int":         {"Int", "int64"},
+	"r4":          {"R4", "float32"},
+	"r8":          {"R8", "float64"},
+	"number":      {"R8", "float64"}, // Alias for r8.
+	"fixed.14.4":  {"Fixed14_4", "float64"},
+	"float":       {"R8", "float64"},
+	"char":        {"Char", "rune"},
+	"string":      {"String", "string"},
+	"date":        {"Date", "time.Time"},
+	"dateTime":    {"DateTime", "time.Time"},
+	"dateTime.tz": {"DateTimeTz", "time.Time"},
+	"time":        {"TimeOfDay", "soap.TimeOfDay"},
+	"time.tz":     {"TimeOfDayTz", "soap.TimeOfDay"},
+	"boolean":     {"Boolean", "bool"},
+	"bin.base64":  {"BinBase64", "[]byte"},
+	"bin.hex":     {"BinHex", "[]byte"},
+	"uri":         {"URI", "*url.URL"},
+}
@@ -0,0 +1,456 @@
+// dcpgen generates Go source code for UPnP Device Control Protocols (DCPs)
+// from device descriptions and service descriptions (SCPDs). It generates
+// the packages under github.com/huin/goupnp/dcps, and can equally generate
+// typed clients and server stubs for vendor-specific services, given their
+// XML descriptions as retrieved from a device.
+//
+// Each generated package contains URN constants for every device and service
+// type, a client type per service with a method per action, and a handler
+// interface per service, for hosting the service with the device package.
+// The goupnpgen command is a command line interface to this package.
+//
+// NOTE: the interface for this is experimental and may change, or go away
+// entirely.
+package dcpgen
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"go/format"
+	"go/token"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/huin/goupnp"
+	"github.com/huin/goupnp/scpd"
+)
+
+// Metadata describes the package generated for a DCP.
+type Metadata struct {
+	Name         string // What to name the Go DCP package.
+	OfficialName string // Official name for the DCP.
+	DocURL       string // Optional - URL for futher documentation about the DCP.
+	// ClientInterfaces enables generating an interface per service, named
+	// <Service>Client, with a method per action as implemented by the client.
+	ClientInterfaces bool
+	// ActionDocs are optional descriptions of actions, from the specification
+	// of their service, for the doc comments of the action methods. They are
+	// keyed by the client type and action, e.g.
+	// "WANIPConnection1.AddPortMapping". Paragraphs are separated by blank
+	// lines.
+	ActionDocs map[string]string
+	// ActionTimeouts are the default timeouts of actions. They are keyed by
+	// the client type and action, e.g. "ContentDirectory1.Search", by the
+	// client type alone for the other actions of the service, or by "" for
+	// the other actions of all services.
+	ActionTimeouts map[string]time.Duration
+}
+
+// DCP collects together information about a UPnP Device Control Protocol.
+type DCP struct {
+	Metadata     Metadata
+	DeviceTypes  map[string]*URNParts
+	ServiceTypes map[string]*URNParts
+	Services     []SCPDWithURN
+
+	// scpdURLs maps the base names of the SCPDURLs in added device
+	// descriptions to their service types.
+	scpdURLs map[string]string
+	// pending holds SCPDs whose service type is not yet known, in the order
+	// added.
+	pending []pendingSCPD
+}
+
+type pendingSCPD struct {
+	filename string
+	index    int // Index into Services.
+}
+
+// NewDCP creates an empty DCP, to which descriptions are added.
+func NewDCP(metadata Metadata) *DCP {
+	return &DCP{
+		Metadata:     metadata,
+		DeviceTypes:  make(map[string]*URNParts),
+		ServiceTypes: make(map[string]*URNParts),
+		scpdURLs:     make(map[string]string),
+	}
+}
+
+// AddFile adds a device description, an SCPD, or a ZIP file of them, as
+// determined by its content. The service type of an SCPD is determined as for
+// AddSCPDFile.
+func (dcp *DCP) AddFile(filename string) error {
+	if strings.EqualFold(filepath.Ext(filename), ".zip") {
+		return dcp.AddZipFile(filename)
+	}
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	root, err := rootElement(f)
+	if err != nil {
+		return fmt.Errorf("dcpgen: error reading %q: %v", filename, err)
+	}
+	if _, err := f.Seek(0, io.SeekStart); err != nil {
+		return err
+	}
+	switch root {
+	case "root":
+		return dcp.addDevice(filename, f)
+	case "scpd":
+		return dcp.addSCPD(filename, "", f)
+	}
+	return fmt.Errorf("dcpgen: %q is neither a device description nor an SCPD", filename)
+}
+
+// rootElement returns the local name of the root element of an XML document.
+func rootElement(r io.Reader) (string, error) {
+	decoder := xml.NewDecoder(r)
+	for {
+		t, err := decoder.Token()
+		if err != nil {
+			return "", err
+		}
+		if start, ok := t.(xml.StartElement); ok {
+			return start.Name.Local, nil
+		}
+	}
+}
+
+// AddZipFile adds the device descriptions and SCPDs within a ZIP file laid
+// out as the test files of the UPnP Forum specifications, with device
+// descriptions in "*/device/*.xml" and SCPDs in "*/service/<Name><Version>.xml".
+func (dcp *DCP) AddZipFile(filename string) error {
+	archive, err := zip.OpenReader(filename)
+	if err != nil {
+		return fmt.Errorf("dcpgen: error reading zip file %q: %v", filename, err)
+	}
+	defer archive.Close()
+	for _, deviceFile := range globFiles("*/device/*.xml", archive) {
+		if err := dcp.addZipMember(deviceFile, dcp.addDevice); err != nil {
+			return err
+		}
+	}
+	for _, scpdFile := range globFiles("*/service/*.xml", archive) {
+		urnParts, err := urnPartsFromSCPDFilename(scpdFile.Name)
+		if err != nil {
+			return fmt.Errorf("dcpgen: could not recognize SCPD filename %q: %v", scpdFile.Name, err)
+		}
+		err = dcp.addZipMember(scpdFile, func(name string, r io.Reader) error {
+			return dcp.addSCPD(name, urnParts.URN, r)
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (dcp *DCP) addZipMember(file *zip.File, add func(name string, r io.Reader) error) error {
+	r, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer r.Close()
+	return add(file.Name, r)
+}
+
+// AddDeviceFile adds the device and service types within a device
+// description file.
+func (dcp *DCP) AddDeviceFile(filename string) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return dcp.addDevice(filename, f)
+}
+
+// AddDevice adds the device and service types within a device description.
+func (dcp *DCP) AddDevice(r io.Reader) error {
+	return dcp.addDevice("device description", r)
+}
+
+func (dcp *DCP) addDevice(filename string, r io.Reader) error {
+	// Both complete descriptions and bare device elements are accepted.
+	data, err := ioutil.ReadAll(r)
+	if err != nil {
+		return err
+	}
+	var device goupnp.Device
+	if root, _ := rootElement(bytes.NewReader(data)); root == "root" {
+		var rootDevice goupnp.RootDevice
+		err = xml.Unmarshal(data, &rootDevice)
+		device = rootDevice.Device
+	} else {
+		err = xml.Unmarshal(data, &device)
+	}
+	if err != nil {
+		return fmt.Errorf("dcpgen: error decoding device XML from file %q: %v", filename, err)
+	}
+	var mainErr error
+	device.VisitDevices(func(d *goupnp.Device) {
+		t := strings.TrimSpace(d.DeviceType)
+		if t != "" {
+			u, err := ParseURN(t, "device")
+			if err != nil {
+				mainErr = err
+				return
+			}
+			dcp.DeviceTypes[t] = u
+		}
+	})
+	device.VisitServices(func(s *goupnp.Service) {
+		t := strings.TrimSpace(s.ServiceType)
+		u, err := ParseURN(t, "service")
+		if err != nil {
+			mainErr = err
+			return
+		}
+		dcp.ServiceTypes[t] = u
+		if s.SCPDURL.Str != "" {
+			dcp.scpdURLs[path.Base(strings.TrimSpace(s.SCPDURL.Str))] = t
+		}
+	})
+	return mainErr
+}
+
+// AddSCPDFile adds a service described by an SCPD file. If serviceType is
+// empty, it is the service type whose SCPDURL in an added device description
+// has the same base name as the file, or otherwise is determined from a file
+// name of the form "<Name><Version>.xml" as a standard UPnP Forum service. As
+// device descriptions may be added after the SCPD, an unknown service type
+// is only reported when the package is written.
+func (dcp *DCP) AddSCPDFile(filename, serviceType string) error {
+	f, err := os.Open(filename)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	return dcp.addSCPD(filename, serviceType, f)
+}
+
+// AddSCPD adds a service of the given type, described by an SCPD.
+func (dcp *DCP) AddSCPD(serviceType string, r io.Reader) error {
+	if serviceType == "" {
+		return errors.New("dcpgen: AddSCPD requires a service type")
+	}
+	return dcp.addSCPD("SCPD for "+serviceType, serviceType, r)
+}
+
+func (dcp *DCP) addSCPD(filename, serviceType string, r io.Reader) error {
+	s := new(scpd.SCPD)
+	if err := xml.NewDecoder(r).Decode(s); err != nil {
+		return fmt.Errorf("dcpgen: error decoding SCPD XML from file %q: %v", filename, err)
+	}
+	s.Clean()
+	if serviceType == "" {
+		dcp.pending = append(dcp.pending, pendingSCPD{filename: filename, index: len(dcp.Services)})
+		dcp.Services = append(dcp.Services, SCPDWithURN{SCPD: s})
+		return nil
+	}
+	urnParts, err := ParseURN(serviceType, "service")
+	if err != nil {
+		return err
+	}
+	dcp.ServiceTypes[serviceType] = urnParts
+	dcp.Services = append(dcp.Services, SCPDWithURN{URNParts: urnParts, SCPD: s})
+	return nil
+}
+
+// actionTimeout is a default timeout for the templates.
+type actionTimeout struct {
+	Action string // Empty for the other actions of the service.
+	Expr   string // Go expression of the timeout, e.g. "30 * time.Second".
+}
+
+// Timeouts returns the default timeouts of the actions of the service with the
+// given client type, sorted by action.
+func (dcp *DCP) Timeouts(srvIdent string) []actionTimeout {
+	var timeouts []actionTimeout
+	for key, d := range dcp.Metadata.ActionTimeouts {
+		switch {
+		case key == srvIdent:
+			timeouts = append(timeouts, actionTimeout{Expr: durationExpr(d)})
+		case strings.HasPrefix(key, srvIdent+"."):
+			timeouts = append(timeouts, actionTimeout{Action: key[len(srvIdent)+1:], Expr: durationExpr(d)})
+		}
+	}
+	if _, ok := dcp.Metadata.ActionTimeouts[srvIdent]; !ok {
+		if d, ok := dcp.Metadata.ActionTimeouts[""]; ok {
+			timeouts = append(timeouts, actionTimeout{Expr: durationExpr(d)})
+		}
+	}
+	sort.Slice(timeouts, func(i, j int) bool { return timeouts[i].Action < timeouts[j].Action })
+	return timeouts
+}
+
+func durationExpr(d time.Duration) string {
+	switch {
+	case d%time.Minute == 0:
+		return fmt.Sprintf("%d * time.Minute", d/time.Minute)
+	case d%time.Second == 0:
+		return fmt.Sprintf("%d * time.Second", d/time.Second)
+	case d%time.Millisecond == 0:
+		return fmt.Sprintf("%d * time.Millisecond", d/time.Millisecond)
+	}
+	return fmt.Sprintf("%d", d)
+}
+
+// ResolveServiceTypes determines the service types of the SCPDs added without
+// one, from the device descriptions added so far. It is called when writing
+// the package; call it before inspecting Services, whose URNParts are nil
+// until then.
+func (dcp *DCP) ResolveServiceTypes() error {
+	for _, p := range dcp.pending {
+		serviceType := dcp.scpdURLs[filepath.Base(p.filename)]
+		var urnParts *URNParts
+		var err error
+		if serviceType != "" {
+			urnParts, err = ParseURN(serviceType, "service")
+		} else {
+			urnParts, err = urnPartsFromSCPDFilename(p.filename)
+		}
+		if err != nil {
+			return fmt.Errorf("dcpgen: cannot determine service type of SCPD %q, "+
+				"it is not referred to by a device description: %v", p.filename, err)
+		}
+		dcp.ServiceTypes[urnParts.URN] = urnParts
+		dcp.Services[p.index].URNParts = urnParts
+	}
+	dcp.pending = nil
+	return nil
+}
+
+// WritePackage writes the generated package source files into dir, creating
+// it if necessary: <name>.go containing the clients, and <name>_server.go
+// containing the handler interfaces. If useGofmt is false, the output is not
+// passed through gofmt, which helps when debugging code output problems.
+func (dcp *DCP) WritePackage(dir string, useGofmt bool) error {
+	if err := dcp.ResolveServiceTypes(); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+		return err
+	}
+	name := dcp.Metadata.Name
+	if err := dcp.writeFile(filepath.Join(dir, name+".go"), packageTmpl, useGofmt); err != nil {
+		return err
+	}
+	return dcp.writeFile(filepath.Join(dir, name+"_server.go"), serverTmpl, useGofmt)
+}
+
+// WriteClient writes the generated source file containing the clients.
+func (dcp *DCP) WriteClient(w io.Writer, useGofmt bool) error {
+	return dcp.write(w, packageTmpl, useGofmt)
+}
+
+// WriteServer writes the generated source file containing the handler
+// interfaces.
+func (dcp *DCP) WriteServer(w io.Writer, useGofmt bool) error {
+	return dcp.write(w, serverTmpl, useGofmt)
+}
+
+func (dcp *DCP) writeFile(filename string, tmpl *template.Template, useGofmt bool) error {
+	var buf bytes.Buffer
+	if err := dcp.write(&buf, tmpl, useGofmt); err != nil {
+		return fmt.Errorf("dcpgen: error generating %q: %v", filename, err)
+	}
+	return ioutil.WriteFile(filename, buf.Bytes(), 0644)
+}
+
+func (dcp *DCP) write(w io.Writer, tmpl *template.Template, useGofmt bool) error {
+	if err := dcp.ResolveServiceTypes(); err != nil {
+		return err
+	}
+	if !token.IsIdentifier(dcp.Metadata.Name) {
+		return fmt.Errorf("dcpgen: package name %q is not a Go identifier", dcp.Metadata.Name)
+	}
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, dcp); err != nil {
+		return err
+	}
+	src := buf.Bytes()
+	if useGofmt {
+		var err error
+		if src, err = format.Source(src); err != nil {
+			return err
+		}
+	}
+	_, err := w.Write(src)
+	return err
+}
+
+func globFiles(pattern string, archive *zip.ReadCloser) []*zip.File {
+	var files []*zip.File
+	for _, f := range archive.File {
+		if matched, err := path.Match(pattern, f.Name); err != nil {
+			// This shouldn't happen - all patterns are hard-coded, errors in them
+			// are a programming error.
+			panic(err)
+		} else if matched {
+			files = append(files, f)
+		}
+	}
+	return files
+}
+
+// URNParts is a device or service type URN, split into its parts.
+type URNParts struct {
+	URN string
+	// Name is the name of the type, with any characters that cannot be
+	// part of a Go identifier, such as the hyphen of "X_AVM-DE_OnTel",
+	// replaced by underscores.
+	Name    string
+	Version string
+}
+
+// Const returns the name of the constant for the URN.
+func (u *URNParts) Const() string {
+	return fmt.Sprintf("URN_%s_%s", u.Name, u.Version)
+}
+
+// ParseURN parses a device or service type URN of the form
+// "urn:<domain>:<kind>:<name>:<version>", where kind is "device" or
+// "service". The domain is "schemas-upnp-org" for standard types, and the
+// domain name of the vendor for vendor-specific types.
+func ParseURN(urn, kind string) (*URNParts, error) {
+	parts := strings.Split(urn, ":")
+	if len(parts) != 5 || parts[0] != "urn" || parts[2] != kind {
+		return nil, fmt.Errorf("dcpgen: %q is not a %s type URN", urn, kind)
+	}
+	name, version := identifier(parts[3]), parts[4]
+	u := &URNParts{urn, name, version}
+	if !token.IsIdentifier(name) || !token.IsIdentifier(u.Const()) {
+		return nil, fmt.Errorf("dcpgen: %s type %q does not have a name and version usable in Go identifiers", kind, urn)
+	}
+	return u, nil
+}
+
+var scpdFilenameRe = regexp.MustCompile(
+	`(?:.*/)?([a-zA-Z0-9]+)([0-9]+)\.xml$`)
+
+func urnPartsFromSCPDFilename(filename string) (*URNParts, error) {
+	parts := scpdFilenameRe.FindStringSubmatch(filepath.ToSlash(filename))
+	if len(parts) != 3 {
+		return nil, fmt.Errorf("SCPD filename %q does not have expected number of parts", filename)
+	}
+	name, version := parts[1], parts[2]
+	return &URNParts{
+		URN:     "urn:schemas-upnp-org:service:" + name + ":" + version,
+		Name:    name,
+		Version: version,
+	}, nil
+}
@@ -0,0 +1,194 @@
+package dcpgen
+
+import (
+	"bytes"
+	"go/parser"
+	"go/token"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testDevice = `<?xml version="1.0"?>
+<root xmlns="urn:schemas-upnp-org:device-1-0">
+  <specVersion><major>1</major><minor>0</minor></specVersion>
+  <device>
+    <deviceType>urn:schemas-example-com:device:Speaker:1</deviceType>
+    <UDN>uuid:00000000-0000-0000-0000-000000000001</UDN>
+    <serviceList>
+      <service>
+        <serviceType>urn:schemas-example-com:service:Queue:1</serviceType>
+        <serviceId>urn:example-com:serviceId:Queue</serviceId>
+        <SCPDURL>/xml/queue-scpd.xml</SCPDURL>
+        <controlURL>/Queue/Control</controlURL>
+        <eventSubURL>/Queue/Event</eventSubURL>
+      </service>
+    </serviceList>
+  </device>
+</root>`
+
+const testSCPD = `<?xml version="1.0"?>
+<scpd xmlns="urn:schemas-upnp-org:service-1-0">
+  <specVersion><major>1</major><minor>0</minor></specVersion>
+  <actionList>
+    <action>
+      <name>AddURI</name>
+      <argumentList>
+        <argument><name>URI</name><direction>in</direction><relatedStateVariable>A_ARG_TYPE_URI</relatedStateVariable></argument>
+        <argument><name>Position</name><direction>out</direction><relatedStateVariable>A_ARG_TYPE_Position</relatedStateVariable></argument>
+      </argumentList>
+    </action>
+    <action>
+      <name>SetPlayMode</name>
+      <argumentList>
+        <argument><name>PlayMode</name><direction>in</direction><relatedStateVariable>A_ARG_TYPE_PlayMode</relatedStateVariable></argument>
+      </argumentList>
+    </action>
+  </actionList>
+  <serviceStateTable>
+    <stateVariable sendEvents="no"><name>A_ARG_TYPE_URI</name><dataType>string</dataType></stateVariable>
+    <stateVariable sendEvents="no"><name>A_ARG_TYPE_Position</name><dataType>ui4</dataType></stateVariable>
+    <stateVariable sendEvents="no"><name>A_ARG_TYPE_PlayMode</name><dataType>string</dataType>
+      <defaultValue>NORMAL</defaultValue>
+      <allowedValueList><allowedValue>NORMAL</allowedValue><allowedValue>SHUFFLE-NOREPEAT</allowedValue></allowedValueList>
+    </stateVariable>
+  </serviceStateTable>
+</scpd>`
+
+func writeTestFiles(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "dcpgen")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, content := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestVendorPackage(t *testing.T) {
+	dir := writeTestFiles(t, map[string]string{
+		"description.xml": testDevice,
+		"queue-scpd.xml":  testSCPD,
+	})
+	defer os.RemoveAll(dir)
+
+	dcp := NewDCP(Metadata{Name: "speaker", OfficialName: "Example Speaker", ClientInterfaces: true,
+		ActionDocs:     map[string]string{"Queue1.AddURI": "Adds URI to the end of the queue."},
+		ActionTimeouts: map[string]time.Duration{"": 10 * time.Second, "Queue1.AddURI": 90 * time.Second}})
+	// The SCPD is added first, its service type is found once the device
+	// description is added.
+	for _, name := range []string{"queue-scpd.xml", "description.xml"} {
+		if err := dcp.AddFile(filepath.Join(dir, name)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	outDir := filepath.Join(dir, "speaker")
+	if err := dcp.WritePackage(outDir, true); err != nil {
+		t.Fatal(err)
+	}
+
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, outDir, nil, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pkg := pkgs["speaker"]
+	if pkg == nil || len(pkg.Files) != 2 {
+		t.Fatalf("got packages %v, want package speaker of two files", pkgs)
+	}
+	client, err := ioutil.ReadFile(filepath.Join(outDir, "speaker.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`URN_Speaker_1 = "urn:schemas-example-com:device:Speaker:1"`,
+		`URN_Queue_1 = "urn:schemas-example-com:service:Queue:1"`,
+		"func (client *Queue1) AddURI(URI string) (Position uint32, err error)",
+		"type Queue1AddURIRequest struct {\n\tURI string\n}",
+		"type Queue1AddURIResponse struct {\n\tPosition string\n}",
+		"func (client *Queue1) PerformAction(ctx context.Context, actionName string, request, response interface{}) error",
+		"func (client *Queue1) AddURICtx(ctx context.Context, URI string) (Position uint32, err error)",
+		"type Queue1Client interface {\n" +
+			"\tAddURI(URI string) (Position uint32, err error)\n" +
+			"\tAddURICtx(ctx context.Context, URI string) (Position uint32, err error)\n",
+		"type Queue1PlayMode string",
+		`Queue1PlayMode_SHUFFLE_NOREPEAT Queue1PlayMode = "SHUFFLE-NOREPEAT"`,
+		"func (v Queue1PlayMode) Valid() bool",
+		"func (client *Queue1) SetPlayMode(PlayMode Queue1PlayMode) (err error)",
+		"// AddURI performs the AddURI action of the service.\n" +
+			"//\n" +
+			"// Adds URI to the end of the queue.\n" +
+			"//\n" +
+			"// Arguments:\n" +
+			"//   - URI (in, state variable A_ARG_TYPE_URI)\n" +
+			"//\n" +
+			"// Return values:\n" +
+			"//   - Position (out, state variable A_ARG_TYPE_Position)\n" +
+			"func (client *Queue1) AddURI(",
+		"//   - PlayMode (in, state variable A_ARG_TYPE_PlayMode): allowed values NORMAL, SHUFFLE-NOREPEAT; default value NORMAL\n",
+		"var Queue1Timeouts = map[string]time.Duration{\n" +
+			"\t\"\":       10 * time.Second,\n" +
+			"\t\"AddURI\": 90 * time.Second,\n}",
+		"ctx, cancel := client.ActionContext(ctx, actionName, Queue1Timeouts)",
+		"// Queue1PlayMode is a value of the state variable A_ARG_TYPE_PlayMode of\n// Queue1. Its default value is NORMAL.\n",
+	} {
+		if !bytes.Contains(client, []byte(want)) {
+			t.Errorf("generated client does not contain %q", want)
+		}
+	}
+}
+
+func TestUnknownServiceType(t *testing.T) {
+	dir := writeTestFiles(t, map[string]string{"queue-scpd.xml": testSCPD})
+	defer os.RemoveAll(dir)
+
+	dcp := NewDCP(Metadata{Name: "speaker"})
+	if err := dcp.AddFile(filepath.Join(dir, "queue-scpd.xml")); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := dcp.WriteClient(&buf, true); err == nil || !strings.Contains(err.Error(), "queue-scpd.xml") {
+		t.Errorf("WriteClient() error = %v, want error naming the SCPD", err)
+	}
+
+	dcp = NewDCP(Metadata{Name: "speaker"})
+	err := dcp.AddSCPDFile(filepath.Join(dir, "queue-scpd.xml"), "urn:schemas-example-com:service:Queue:1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := dcp.WriteServer(&buf, true); err != nil {
+		t.Errorf("WriteServer() with explicit service type: %v", err)
+	}
+}
+
+func TestParseURN(t *testing.T) {
+	u, err := ParseURN("urn:schemas-upnp-org:service:WANIPConnection:2", "service")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Name != "WANIPConnection" || u.Version != "2" || u.Const() != "URN_WANIPConnection_2" {
+		t.Errorf("ParseURN() = %+v", u)
+	}
+	u, err = ParseURN("urn:dslforum-org:service:X_AVM-DE_OnTel:1", "service")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Name != "X_AVM_DE_OnTel" || u.Const() != "URN_X_AVM_DE_OnTel_1" {
+		t.Errorf("ParseURN() = %+v, want hyphen replaced in name", u)
+	}
+	for _, urn := range []string{
+		"urn:schemas-upnp-org:device:WANDevice:1",
+		"urn:schemas-upnp-org:service:WANIPConnection",
+		"urn:schemas-upnp-org:service:1BadName:1",
+	} {
+		if _, err := ParseURN(urn, "service"); err == nil {
+			t.Errorf("ParseURN(%q): got nil error", urn)
+		}
+	}
+}
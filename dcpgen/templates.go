@@ -0,0 +1,341 @@
+package dcpgen
+
+import (
+	"strings"
+	"text/template"
+)
+
+var packageTmpl = template.Must(template.New("package").Funcs(template.FuncMap{
+	"comment": docComment,
+}).Parse(`{{$name := .Metadata.Name}}
+// Client for UPnP Device Control Protocol {{.Metadata.OfficialName}}.
+// {{if .Metadata.DocURL}}
+// This DCP is documented in detail at: {{.Metadata.DocURL}}{{end}}
+//
+// Typically, use one of the New* functions to create clients for services.
+package {{$name}}
+
+// Generated file - do not edit by hand. See README.md
+
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/huin/goupnp"
+	"github.com/huin/goupnp/soap"
+)
+
+// Hack to avoid Go complaining if time isn't used.
+var _ time.Time
+
+// Device URNs:
+const ({{range .DeviceTypes}}
+	{{.Const}} = "{{.URN}}"{{end}}
+)
+
+// Service URNs:
+const ({{range .ServiceTypes}}
+	{{.Const}} = "{{.URN}}"{{end}}
+)
+
+{{range .Services}}
+{{$srv := .}}
+{{$srvIdent := printf "%s%s" .Name .Version}}
+
+// {{$srvIdent}} is a client for UPnP SOAP service with URN "{{.URN}}". See
+// goupnp.ServiceClient, which contains RootDevice and Service attributes which
+// are provided for informational value.
+type {{$srvIdent}} struct {
+	goupnp.ServiceClient
+}
+{{if $.Metadata.ClientInterfaces}}
+// {{$srvIdent}}Client is the interface of the actions of {{$srvIdent}}, for
+// substituting fakes or mocks for the service in tests.
+type {{$srvIdent}}Client interface {
+{{- range .SCPD.Actions}}
+{{- $winargs := $srv.WrapArguments .InputArguments}}
+{{- $woutargs := $srv.WrapArguments .OutputArguments}}
+	{{.Name}}({{range $winargs}}{{/*
+*/}}{{.AsParameter}}, {{end}}{{/*
+*/}}) ({{range $woutargs}}{{/*
+*/}}{{.AsParameter}}, {{end}} err error)
+	{{.Name}}Ctx(ctx context.Context, {{range $winargs}}{{/*
+*/}}{{.AsParameter}}, {{end}}{{/*
+*/}}) ({{range $woutargs}}{{/*
+*/}}{{.AsParameter}}, {{end}} err error)
+{{- end}}
+}
+
+var _ {{$srvIdent}}Client = new({{$srvIdent}})
+{{end}}
+{{range .Enums}}{{$enum := .}}
+// {{.Type}} is a value of the state variable {{.StateVariable}} of
+// {{$srvIdent}}.{{if .Default}} Its default value is {{.Default}}.{{end}}
+type {{.Type}} string
+
+// Allowed values of {{.Type}}.
+const ({{range .Values}}
+	{{.Const}} {{$enum.Type}} = {{printf "%q" .Value}}{{end}}
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v {{.Type}}) Valid() bool {
+	switch v {
+	case {{range $i, $v := .Values}}{{if $i}},
+		{{end}}{{.Const}}{{end}}:
+		return true
+	}
+	return false
+}
+{{end}}{{/* range .Enums */}}
+
+// New{{$srvIdent}}Clients discovers instances of the service on the network,
+// and returns clients to any that are found. errors will contain an error for
+// any devices that replied but which could not be queried, and err will be set
+// if the discovery process failed outright.
+//
+// This is a typical entry calling point into this package.
+func New{{$srvIdent}}Clients() (clients []*{{$srvIdent}}, errors []error, err error) {
+	var genericClients []goupnp.ServiceClient
+	if genericClients, errors, err = goupnp.NewServiceClients({{$srv.Const}}); err != nil {
+		return
+	}
+	clients = new{{$srvIdent}}ClientsFromGenericClients(genericClients)
+	return
+}
+
+// New{{$srvIdent}}ClientsByURL discovers instances of the service at the given
+// URL, and returns clients to any that are found. An error is returned if
+// there was an error probing the service.
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered service URL.
+func New{{$srvIdent}}ClientsByURL(loc *url.URL) ([]*{{$srvIdent}}, error) {
+	genericClients, err := goupnp.NewServiceClientsByURL(loc, {{$srv.Const}})
+	if err != nil {
+		return nil, err
+	}
+	return new{{$srvIdent}}ClientsFromGenericClients(genericClients), nil
+}
+
+// New{{$srvIdent}}ClientsFromRootDevice discovers instances of the service in
+// a given root device, and returns clients to any that are found. An error is
+// returned if there was not at least one instance of the service within the
+// device. The location parameter is simply assigned to the Location attribute
+// of the wrapped ServiceClient(s).
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered root device.
+func New{{$srvIdent}}ClientsFromRootDevice(rootDevice *goupnp.RootDevice, loc *url.URL) ([]*{{$srvIdent}}, error) {
+	genericClients, err := goupnp.NewServiceClientsFromRootDevice(rootDevice, loc, {{$srv.Const}})
+	if err != nil {
+		return nil, err
+	}
+	return new{{$srvIdent}}ClientsFromGenericClients(genericClients), nil
+}
+
+func new{{$srvIdent}}ClientsFromGenericClients(genericClients []goupnp.ServiceClient) []*{{$srvIdent}} {
+	clients := make([]*{{$srvIdent}}, len(genericClients))
+	for i := range genericClients {
+		clients[i] = &{{$srvIdent}}{genericClients[i]}
+	}
+	return clients
+}
+
+// {{$srvIdent}}Timeouts are the default timeouts of the actions of
+// {{$srvIdent}}, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var {{$srvIdent}}Timeouts = map[string]time.Duration{{"{"}}{{range $.Timeouts $srvIdent}}
+	{{printf "%q" .Action}}: {{.Expr}},{{end}}
+}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *{{$srvIdent}}) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, {{$srvIdent}}Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, {{$srv.URNParts.Const}}, actionName, request, response)
+}
+
+{{range .SCPD.Actions}}{{/* loops over *SCPDWithURN values */}}
+
+{{$winargs := $srv.WrapArguments .InputArguments}}
+{{$woutargs := $srv.WrapArguments .OutputArguments}}
+{{if $winargs}}
+// {{$srvIdent}}{{.Name}}Request is the request of {{.Name}}, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type {{$srvIdent}}{{.Name}}Request {{template "argstruct" $winargs}}
+{{end}}
+{{if $woutargs}}
+// {{$srvIdent}}{{.Name}}Response is the response of {{.Name}}, with each
+// argument in its SOAP string form.
+type {{$srvIdent}}{{.Name}}Response {{template "argstruct" $woutargs}}
+{{end}}
+
+// {{.Name}} performs the {{.Name}} action of the service.{{if .IsOptional}}
+// The action is optional, so devices may not implement it.{{end}}{{/*
+*/}}{{with index $.Metadata.ActionDocs (printf "%s.%s" $srvIdent .Name)}}
+//
+{{comment .}}{{end}}{{if $winargs}}
+//
+// Arguments:{{range $winargs}}
+//   - {{.Name}} {{.Document}}{{end}}{{end}}{{if $woutargs}}
+//
+// Return values:{{range $woutargs}}
+//   - {{.Name}} {{.Document}}{{end}}{{end}}
+func (client *{{$srvIdent}}) {{.Name}}({{range $winargs}}{{/*
+*/}}{{.AsParameter}}, {{end}}{{/*
+*/}}) ({{range $woutargs}}{{/*
+*/}}{{.AsParameter}}, {{end}} err error) {
+	return client.{{.Name}}Ctx(context.Background(), {{range $winargs}}{{.Name}}, {{end}})
+}
+
+// {{.Name}}Ctx is {{.Name}} with a context, to cancel or time out the call.
+func (client *{{$srvIdent}}) {{.Name}}Ctx(ctx context.Context, {{range $winargs}}{{/*
+*/}}{{.AsParameter}}, {{end}}{{/*
+*/}}) ({{range $woutargs}}{{/*
+*/}}{{.AsParameter}}, {{end}} err error) {
+	// Request structure.
+	request := {{if $winargs}}&{{$srvIdent}}{{.Name}}Request{{"{}"}}{{else}}{{"interface{}(nil)"}}{{end}}
+	// BEGIN Marshal arguments into request.
+{{range $winargs}}
+	if request.{{.Name}}, err = {{.Marshal}}; err != nil {
+		return
+	}{{end}}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := {{if $woutargs}}&{{$srvIdent}}{{.Name}}Response{{"{}"}}{{else}}{{"interface{}(nil)"}}{{end}}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "{{.Name}}", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+{{range $woutargs}}{{if .IsEnum}}
+	{{.Name}} = {{.GoType}}(response.{{.Name}}){{else}}
+	if {{.Name}}, err = {{.Unmarshal "response"}}; err != nil {
+		return
+	}{{end}}{{end}}
+	// END Unmarshal arguments from response.
+	return
+}
+{{end}}{{/* range .SCPD.Actions */}}
+{{end}}{{/* range .Services */}}
+
+{{define "argstruct"}}struct {{"{"}}{{range .}}
+	{{.Name}} string{{end}}
+{{"}"}}{{end}}
+`))
+
+var serverTmpl = template.Must(template.New("server").Parse(`{{$name := .Metadata.Name}}
+package {{$name}}
+
+// Generated file - do not edit by hand. See README.md
+
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/huin/goupnp/device"
+	"github.com/huin/goupnp/soap"
+)
+
+// Hack to avoid Go complaining if url or time aren't used.
+var _ *url.URL
+var _ time.Time
+
+{{range .Services}}
+{{$srv := .}}
+{{$srvIdent := printf "%s%s" .Name .Version}}
+
+// {{$srvIdent}}Handler implements the actions of a hosted UPnP SOAP service
+// with URN "{{.URN}}". See Register{{$srvIdent}}Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type {{$srvIdent}}Handler interface {
+{{range .SCPD.Actions}}
+{{$winargs := $srv.WrapArguments .InputArguments}}
+{{$woutargs := $srv.WrapArguments .OutputArguments}}
+	{{.Name}}(ctx context.Context, {{range $winargs}}{{/*
+*/}}{{.AsParameter}}, {{end}}{{/*
+*/}}) ({{range $woutargs}}{{/*
+*/}}{{.AsParameter}}, {{end}} err error)
+{{end}}
+}
+
+// Register{{$srvIdent}}Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type {{$srv.Const}}.
+func Register{{$srvIdent}}Handler(svc *device.Service, handler {{$srvIdent}}Handler) {{"{"}}{{range .SCPD.Actions}}
+	svc.HandleFunc("{{.Name}}", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serve{{$srvIdent}}{{.Name}}(ctx, handler, in)
+	}){{end}}
+}
+
+{{range .SCPD.Actions}}
+{{$winargs := $srv.WrapArguments .InputArguments}}
+{{$woutargs := $srv.WrapArguments .OutputArguments}}
+func serve{{$srvIdent}}{{.Name}}(ctx context.Context, handler {{$srvIdent}}Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+{{if $winargs}}	var value string{{end}}
+{{range $winargs}}
+	var {{.AsParameter}}
+	if value, err = soap.FindArg(in, "{{.Name}}"); err != nil {
+		return
+	}{{if .IsEnum}}
+	{{.Name}} = {{.GoType}}(value){{else}}
+	if {{.Name}}, err = {{.UnmarshalExpr "value"}}; err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument {{.Name}}: " + err.Error())
+	}{{end}}{{end}}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+{{range $woutargs}}
+	var {{.AsParameter}}{{end}}
+	if {{range $woutargs}}{{.Name}}, {{end}}err = handler.{{.Name}}(ctx, {{range $winargs}}{{.Name}}, {{end}}); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, {{len $woutargs}})
+{{range $index, $arg := $woutargs}}
+	out[{{$index}}].Name = "{{$arg.Name}}"
+	if out[{{$index}}].Value, err = {{$arg.Marshal}}; err != nil {
+		return
+	}{{end}}
+	// END Marshal arguments into response.
+	return
+}
+{{end}}{{/* range .SCPD.Actions */}}
+{{end}}{{/* range .Services */}}
+`))
+
+// docComment formats text as the lines of a Go comment, wrapping each
+// paragraph at about 77 columns.
+func docComment(text string) string {
+	var lines []string
+	for i, para := range strings.Split(strings.TrimSpace(text), "\n\n") {
+		if i > 0 {
+			lines = append(lines, "//")
+		}
+		line := "//"
+		for _, word := range strings.Fields(para) {
+			if len(line) > 2 && len(line)+1+len(word) > 77 {
+				lines = append(lines, line)
+				line = "//"
+			}
+			line += " " + word
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
@@ -8,6 +8,7 @@ package av1
 // Generated file - do not edit by hand. See README.md
 
 import (
+	"context"
 	"net/url"
 	"time"
 
@@ -30,6 +31,7 @@ const (
 	URN_ContentDirectory_1   = "urn:schemas-upnp-org:service:ContentDirectory:1"
 	URN_ContentDirectory_2   = "urn:schemas-upnp-org:service:ContentDirectory:2"
 	URN_ContentDirectory_3   = "urn:schemas-upnp-org:service:ContentDirectory:3"
+	URN_ContentSync_1        = "urn:schemas-upnp-org:service:ContentSync:1"
 	URN_RenderingControl_1   = "urn:schemas-upnp-org:service:RenderingControl:1"
 	URN_RenderingControl_2   = "urn:schemas-upnp-org:service:RenderingControl:2"
 	URN_ScheduledRecording_1 = "urn:schemas-upnp-org:service:ScheduledRecording:1"
@@ -43,6 +45,141 @@ type AVTransport1 struct {
 	goupnp.ServiceClient
 }
 
+// AVTransport1Client is the interface of the actions of AVTransport1, for
+// substituting fakes or mocks for the service in tests.
+type AVTransport1Client interface {
+	SetAVTransportURI(InstanceID uint32, CurrentURI string, CurrentURIMetaData string) (err error)
+	SetAVTransportURICtx(ctx context.Context, InstanceID uint32, CurrentURI string, CurrentURIMetaData string) (err error)
+	SetNextAVTransportURI(InstanceID uint32, NextURI string, NextURIMetaData string) (err error)
+	SetNextAVTransportURICtx(ctx context.Context, InstanceID uint32, NextURI string, NextURIMetaData string) (err error)
+	GetMediaInfo(InstanceID uint32) (NrTracks uint32, MediaDuration string, CurrentURI string, CurrentURIMetaData string, NextURI string, NextURIMetaData string, PlayMedium string, RecordMedium string, WriteStatus string, err error)
+	GetMediaInfoCtx(ctx context.Context, InstanceID uint32) (NrTracks uint32, MediaDuration string, CurrentURI string, CurrentURIMetaData string, NextURI string, NextURIMetaData string, PlayMedium string, RecordMedium string, WriteStatus string, err error)
+	GetTransportInfo(InstanceID uint32) (CurrentTransportState AVTransport1TransportState, CurrentTransportStatus AVTransport1TransportStatus, CurrentSpeed AVTransport1TransportPlaySpeed, err error)
+	GetTransportInfoCtx(ctx context.Context, InstanceID uint32) (CurrentTransportState AVTransport1TransportState, CurrentTransportStatus AVTransport1TransportStatus, CurrentSpeed AVTransport1TransportPlaySpeed, err error)
+	GetPositionInfo(InstanceID uint32) (Track uint32, TrackDuration string, TrackMetaData string, TrackURI string, RelTime string, AbsTime string, RelCount int32, AbsCount int32, err error)
+	GetPositionInfoCtx(ctx context.Context, InstanceID uint32) (Track uint32, TrackDuration string, TrackMetaData string, TrackURI string, RelTime string, AbsTime string, RelCount int32, AbsCount int32, err error)
+	GetDeviceCapabilities(InstanceID uint32) (PlayMedia string, RecMedia string, RecQualityModes string, err error)
+	GetDeviceCapabilitiesCtx(ctx context.Context, InstanceID uint32) (PlayMedia string, RecMedia string, RecQualityModes string, err error)
+	GetTransportSettings(InstanceID uint32) (PlayMode AVTransport1CurrentPlayMode, RecQualityMode string, err error)
+	GetTransportSettingsCtx(ctx context.Context, InstanceID uint32) (PlayMode AVTransport1CurrentPlayMode, RecQualityMode string, err error)
+	Stop(InstanceID uint32) (err error)
+	StopCtx(ctx context.Context, InstanceID uint32) (err error)
+	Play(InstanceID uint32, Speed AVTransport1TransportPlaySpeed) (err error)
+	PlayCtx(ctx context.Context, InstanceID uint32, Speed AVTransport1TransportPlaySpeed) (err error)
+	Pause(InstanceID uint32) (err error)
+	PauseCtx(ctx context.Context, InstanceID uint32) (err error)
+	Record(InstanceID uint32) (err error)
+	RecordCtx(ctx context.Context, InstanceID uint32) (err error)
+	Seek(InstanceID uint32, Unit AVTransport1SeekMode, Target string) (err error)
+	SeekCtx(ctx context.Context, InstanceID uint32, Unit AVTransport1SeekMode, Target string) (err error)
+	Next(InstanceID uint32) (err error)
+	NextCtx(ctx context.Context, InstanceID uint32) (err error)
+	Previous(InstanceID uint32) (err error)
+	PreviousCtx(ctx context.Context, InstanceID uint32) (err error)
+	SetPlayMode(InstanceID uint32, NewPlayMode AVTransport1CurrentPlayMode) (err error)
+	SetPlayModeCtx(ctx context.Context, InstanceID uint32, NewPlayMode AVTransport1CurrentPlayMode) (err error)
+	SetRecordQualityMode(InstanceID uint32, NewRecordQualityMode string) (err error)
+	SetRecordQualityModeCtx(ctx context.Context, InstanceID uint32, NewRecordQualityMode string) (err error)
+	GetCurrentTransportActions(InstanceID uint32) (Actions string, err error)
+	GetCurrentTransportActionsCtx(ctx context.Context, InstanceID uint32) (Actions string, err error)
+}
+
+var _ AVTransport1Client = new(AVTransport1)
+
+// AVTransport1TransportState is a value of the state variable TransportState of
+// AVTransport1.
+type AVTransport1TransportState string
+
+// Allowed values of AVTransport1TransportState.
+const (
+	AVTransport1TransportState_STOPPED AVTransport1TransportState = "STOPPED"
+	AVTransport1TransportState_PLAYING AVTransport1TransportState = "PLAYING"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v AVTransport1TransportState) Valid() bool {
+	switch v {
+	case AVTransport1TransportState_STOPPED,
+		AVTransport1TransportState_PLAYING:
+		return true
+	}
+	return false
+}
+
+// AVTransport1TransportStatus is a value of the state variable TransportStatus of
+// AVTransport1.
+type AVTransport1TransportStatus string
+
+// Allowed values of AVTransport1TransportStatus.
+const (
+	AVTransport1TransportStatus_OK             AVTransport1TransportStatus = "OK"
+	AVTransport1TransportStatus_ERROR_OCCURRED AVTransport1TransportStatus = "ERROR_OCCURRED"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v AVTransport1TransportStatus) Valid() bool {
+	switch v {
+	case AVTransport1TransportStatus_OK,
+		AVTransport1TransportStatus_ERROR_OCCURRED:
+		return true
+	}
+	return false
+}
+
+// AVTransport1TransportPlaySpeed is a value of the state variable TransportPlaySpeed of
+// AVTransport1.
+type AVTransport1TransportPlaySpeed string
+
+// Allowed values of AVTransport1TransportPlaySpeed.
+const (
+	AVTransport1TransportPlaySpeed_1 AVTransport1TransportPlaySpeed = "1"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v AVTransport1TransportPlaySpeed) Valid() bool {
+	switch v {
+	case AVTransport1TransportPlaySpeed_1:
+		return true
+	}
+	return false
+}
+
+// AVTransport1CurrentPlayMode is a value of the state variable CurrentPlayMode of
+// AVTransport1.
+type AVTransport1CurrentPlayMode string
+
+// Allowed values of AVTransport1CurrentPlayMode.
+const (
+	AVTransport1CurrentPlayMode_NORMAL AVTransport1CurrentPlayMode = "NORMAL"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v AVTransport1CurrentPlayMode) Valid() bool {
+	switch v {
+	case AVTransport1CurrentPlayMode_NORMAL:
+		return true
+	}
+	return false
+}
+
+// AVTransport1SeekMode is a value of the state variable A_ARG_TYPE_SeekMode of
+// AVTransport1.
+type AVTransport1SeekMode string
+
+// Allowed values of AVTransport1SeekMode.
+const (
+	AVTransport1SeekMode_TRACK_NR AVTransport1SeekMode = "TRACK_NR"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v AVTransport1SeekMode) Valid() bool {
+	switch v {
+	case AVTransport1SeekMode_TRACK_NR:
+		return true
+	}
+	return false
+}
+
 // NewAVTransport1Clients discovers instances of the service on the network,
 // and returns clients to any that are found. errors will contain an error for
 // any devices that replied but which could not be queried, and err will be set
@@ -96,15 +233,48 @@ func newAVTransport1ClientsFromGenericClients(genericClients []goupnp.ServiceCli
 	return clients
 }
 
-func (client *AVTransport1) SetAVTransportURI(InstanceID uint32, CurrentURI string, CurrentURIMetaData string) (err error) {
-	// Request structure.
-	request := &struct {
-		InstanceID string
+// AVTransport1Timeouts are the default timeouts of the actions of
+// AVTransport1, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var AVTransport1Timeouts = map[string]time.Duration{
+	"":                      10 * time.Second,
+	"SetAVTransportURI":     30 * time.Second,
+	"SetNextAVTransportURI": 30 * time.Second,
+}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *AVTransport1) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, AVTransport1Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_AVTransport_1, actionName, request, response)
+}
+
+// AVTransport1SetAVTransportURIRequest is the request of SetAVTransportURI, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport1SetAVTransportURIRequest struct {
+	InstanceID         string
+	CurrentURI         string
+	CurrentURIMetaData string
+}
 
-		CurrentURI string
+// SetAVTransportURI performs the SetAVTransportURI action of the service.
+//
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_SetAVTransportURI_InstanceID)
+//   - CurrentURI (in, state variable A_ARG_TYPE_SetAVTransportURI_CurrentURI)
+//   - CurrentURIMetaData (in, state variable A_ARG_TYPE_SetAVTransportURI_CurrentURIMetaData)
+func (client *AVTransport1) SetAVTransportURI(InstanceID uint32, CurrentURI string, CurrentURIMetaData string) (err error) {
+	return client.SetAVTransportURICtx(context.Background(), InstanceID, CurrentURI, CurrentURIMetaData)
+}
 
-		CurrentURIMetaData string
-	}{}
+// SetAVTransportURICtx is SetAVTransportURI with a context, to cancel or time out the call.
+func (client *AVTransport1) SetAVTransportURICtx(ctx context.Context, InstanceID uint32, CurrentURI string, CurrentURIMetaData string) (err error) {
+	// Request structure.
+	request := &AVTransport1SetAVTransportURIRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -122,7 +292,7 @@ func (client *AVTransport1) SetAVTransportURI(InstanceID uint32, CurrentURI stri
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_1, "SetAVTransportURI", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetAVTransportURI", request, response); err != nil {
 		return
 	}
 
@@ -132,15 +302,28 @@ func (client *AVTransport1) SetAVTransportURI(InstanceID uint32, CurrentURI stri
 	return
 }
 
-func (client *AVTransport1) SetNextAVTransportURI(InstanceID uint32, NextURI string, NextURIMetaData string) (err error) {
-	// Request structure.
-	request := &struct {
-		InstanceID string
+// AVTransport1SetNextAVTransportURIRequest is the request of SetNextAVTransportURI, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport1SetNextAVTransportURIRequest struct {
+	InstanceID      string
+	NextURI         string
+	NextURIMetaData string
+}
 
-		NextURI string
+// SetNextAVTransportURI performs the SetNextAVTransportURI action of the service.
+//
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_SetNextAVTransportURI_InstanceID)
+//   - NextURI (in, state variable A_ARG_TYPE_SetNextAVTransportURI_NextURI)
+//   - NextURIMetaData (in, state variable A_ARG_TYPE_SetNextAVTransportURI_NextURIMetaData)
+func (client *AVTransport1) SetNextAVTransportURI(InstanceID uint32, NextURI string, NextURIMetaData string) (err error) {
+	return client.SetNextAVTransportURICtx(context.Background(), InstanceID, NextURI, NextURIMetaData)
+}
 
-		NextURIMetaData string
-	}{}
+// SetNextAVTransportURICtx is SetNextAVTransportURI with a context, to cancel or time out the call.
+func (client *AVTransport1) SetNextAVTransportURICtx(ctx context.Context, InstanceID uint32, NextURI string, NextURIMetaData string) (err error) {
+	// Request structure.
+	request := &AVTransport1SetNextAVTransportURIRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -158,7 +341,7 @@ func (client *AVTransport1) SetNextAVTransportURI(InstanceID uint32, NextURI str
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_1, "SetNextAVTransportURI", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetNextAVTransportURI", request, response); err != nil {
 		return
 	}
 
@@ -168,15 +351,49 @@ func (client *AVTransport1) SetNextAVTransportURI(InstanceID uint32, NextURI str
 	return
 }
 
+// AVTransport1GetMediaInfoRequest is the request of GetMediaInfo, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport1GetMediaInfoRequest struct {
+	InstanceID string
+}
+
+// AVTransport1GetMediaInfoResponse is the response of GetMediaInfo, with each
+// argument in its SOAP string form.
+type AVTransport1GetMediaInfoResponse struct {
+	NrTracks           string
+	MediaDuration      string
+	CurrentURI         string
+	CurrentURIMetaData string
+	NextURI            string
+	NextURIMetaData    string
+	PlayMedium         string
+	RecordMedium       string
+	WriteStatus        string
+}
+
+// GetMediaInfo performs the GetMediaInfo action of the service.
 //
-// Return values:
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetMediaInfo_InstanceID)
 //
-// * NrTracks: allowed value range: minimum=0
+// Return values:
+//   - NrTracks (out, state variable A_ARG_TYPE_GetMediaInfo_NrTracks): allowed range minimum=0
+//   - MediaDuration (out, state variable A_ARG_TYPE_GetMediaInfo_MediaDuration)
+//   - CurrentURI (out, state variable A_ARG_TYPE_GetMediaInfo_CurrentURI)
+//   - CurrentURIMetaData (out, state variable A_ARG_TYPE_GetMediaInfo_CurrentURIMetaData)
+//   - NextURI (out, state variable A_ARG_TYPE_GetMediaInfo_NextURI)
+//   - NextURIMetaData (out, state variable A_ARG_TYPE_GetMediaInfo_NextURIMetaData)
+//   - PlayMedium (out, state variable A_ARG_TYPE_GetMediaInfo_PlayMedium)
+//   - RecordMedium (out, state variable A_ARG_TYPE_GetMediaInfo_RecordMedium)
+//   - WriteStatus (out, state variable A_ARG_TYPE_GetMediaInfo_WriteStatus)
 func (client *AVTransport1) GetMediaInfo(InstanceID uint32) (NrTracks uint32, MediaDuration string, CurrentURI string, CurrentURIMetaData string, NextURI string, NextURIMetaData string, PlayMedium string, RecordMedium string, WriteStatus string, err error) {
+	return client.GetMediaInfoCtx(context.Background(), InstanceID)
+}
+
+// GetMediaInfoCtx is GetMediaInfo with a context, to cancel or time out the call.
+func (client *AVTransport1) GetMediaInfoCtx(ctx context.Context, InstanceID uint32) (NrTracks uint32, MediaDuration string, CurrentURI string, CurrentURIMetaData string, NextURI string, NextURIMetaData string, PlayMedium string, RecordMedium string, WriteStatus string, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &AVTransport1GetMediaInfoRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -185,28 +402,10 @@ func (client *AVTransport1) GetMediaInfo(InstanceID uint32) (NrTracks uint32, Me
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NrTracks string
-
-		MediaDuration string
-
-		CurrentURI string
-
-		CurrentURIMetaData string
-
-		NextURI string
-
-		NextURIMetaData string
-
-		PlayMedium string
-
-		RecordMedium string
-
-		WriteStatus string
-	}{}
+	response := &AVTransport1GetMediaInfoResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_1, "GetMediaInfo", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetMediaInfo", request, response); err != nil {
 		return
 	}
 
@@ -243,19 +442,37 @@ func (client *AVTransport1) GetMediaInfo(InstanceID uint32) (NrTracks uint32, Me
 	return
 }
 
+// AVTransport1GetTransportInfoRequest is the request of GetTransportInfo, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport1GetTransportInfoRequest struct {
+	InstanceID string
+}
+
+// AVTransport1GetTransportInfoResponse is the response of GetTransportInfo, with each
+// argument in its SOAP string form.
+type AVTransport1GetTransportInfoResponse struct {
+	CurrentTransportState  string
+	CurrentTransportStatus string
+	CurrentSpeed           string
+}
+
+// GetTransportInfo performs the GetTransportInfo action of the service.
 //
-// Return values:
-//
-// * CurrentTransportState: allowed values: STOPPED, PLAYING
-//
-// * CurrentTransportStatus: allowed values: OK, ERROR_OCCURRED
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetTransportInfo_InstanceID)
 //
-// * CurrentSpeed: allowed values: 1
-func (client *AVTransport1) GetTransportInfo(InstanceID uint32) (CurrentTransportState string, CurrentTransportStatus string, CurrentSpeed string, err error) {
+// Return values:
+//   - CurrentTransportState (out, state variable TransportState): allowed values STOPPED, PLAYING
+//   - CurrentTransportStatus (out, state variable TransportStatus): allowed values OK, ERROR_OCCURRED
+//   - CurrentSpeed (out, state variable TransportPlaySpeed): allowed values 1
+func (client *AVTransport1) GetTransportInfo(InstanceID uint32) (CurrentTransportState AVTransport1TransportState, CurrentTransportStatus AVTransport1TransportStatus, CurrentSpeed AVTransport1TransportPlaySpeed, err error) {
+	return client.GetTransportInfoCtx(context.Background(), InstanceID)
+}
+
+// GetTransportInfoCtx is GetTransportInfo with a context, to cancel or time out the call.
+func (client *AVTransport1) GetTransportInfoCtx(ctx context.Context, InstanceID uint32) (CurrentTransportState AVTransport1TransportState, CurrentTransportStatus AVTransport1TransportStatus, CurrentSpeed AVTransport1TransportPlaySpeed, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &AVTransport1GetTransportInfoRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -264,43 +481,63 @@ func (client *AVTransport1) GetTransportInfo(InstanceID uint32) (CurrentTranspor
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentTransportState string
-
-		CurrentTransportStatus string
-
-		CurrentSpeed string
-	}{}
+	response := &AVTransport1GetTransportInfoResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_1, "GetTransportInfo", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetTransportInfo", request, response); err != nil {
 		return
 	}
 
 	// BEGIN Unmarshal arguments from response.
 
-	if CurrentTransportState, err = soap.UnmarshalString(response.CurrentTransportState); err != nil {
-		return
-	}
-	if CurrentTransportStatus, err = soap.UnmarshalString(response.CurrentTransportStatus); err != nil {
-		return
-	}
-	if CurrentSpeed, err = soap.UnmarshalString(response.CurrentSpeed); err != nil {
-		return
-	}
+	CurrentTransportState = AVTransport1TransportState(response.CurrentTransportState)
+	CurrentTransportStatus = AVTransport1TransportStatus(response.CurrentTransportStatus)
+	CurrentSpeed = AVTransport1TransportPlaySpeed(response.CurrentSpeed)
 	// END Unmarshal arguments from response.
 	return
 }
 
+// AVTransport1GetPositionInfoRequest is the request of GetPositionInfo, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport1GetPositionInfoRequest struct {
+	InstanceID string
+}
+
+// AVTransport1GetPositionInfoResponse is the response of GetPositionInfo, with each
+// argument in its SOAP string form.
+type AVTransport1GetPositionInfoResponse struct {
+	Track         string
+	TrackDuration string
+	TrackMetaData string
+	TrackURI      string
+	RelTime       string
+	AbsTime       string
+	RelCount      string
+	AbsCount      string
+}
+
+// GetPositionInfo performs the GetPositionInfo action of the service.
 //
-// Return values:
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetPositionInfo_InstanceID)
 //
-// * Track: allowed value range: minimum=0, step=1
+// Return values:
+//   - Track (out, state variable A_ARG_TYPE_GetPositionInfo_Track): allowed range minimum=0, step=1
+//   - TrackDuration (out, state variable A_ARG_TYPE_GetPositionInfo_TrackDuration)
+//   - TrackMetaData (out, state variable A_ARG_TYPE_GetPositionInfo_TrackMetaData)
+//   - TrackURI (out, state variable A_ARG_TYPE_GetPositionInfo_TrackURI)
+//   - RelTime (out, state variable A_ARG_TYPE_GetPositionInfo_RelTime)
+//   - AbsTime (out, state variable A_ARG_TYPE_GetPositionInfo_AbsTime)
+//   - RelCount (out, state variable A_ARG_TYPE_GetPositionInfo_RelCount)
+//   - AbsCount (out, state variable A_ARG_TYPE_GetPositionInfo_AbsCount)
 func (client *AVTransport1) GetPositionInfo(InstanceID uint32) (Track uint32, TrackDuration string, TrackMetaData string, TrackURI string, RelTime string, AbsTime string, RelCount int32, AbsCount int32, err error) {
+	return client.GetPositionInfoCtx(context.Background(), InstanceID)
+}
+
+// GetPositionInfoCtx is GetPositionInfo with a context, to cancel or time out the call.
+func (client *AVTransport1) GetPositionInfoCtx(ctx context.Context, InstanceID uint32) (Track uint32, TrackDuration string, TrackMetaData string, TrackURI string, RelTime string, AbsTime string, RelCount int32, AbsCount int32, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &AVTransport1GetPositionInfoRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -309,26 +546,10 @@ func (client *AVTransport1) GetPositionInfo(InstanceID uint32) (Track uint32, Tr
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		Track string
-
-		TrackDuration string
-
-		TrackMetaData string
-
-		TrackURI string
-
-		RelTime string
-
-		AbsTime string
-
-		RelCount string
-
-		AbsCount string
-	}{}
+	response := &AVTransport1GetPositionInfoResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_1, "GetPositionInfo", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetPositionInfo", request, response); err != nil {
 		return
 	}
 
@@ -362,11 +583,37 @@ func (client *AVTransport1) GetPositionInfo(InstanceID uint32) (Track uint32, Tr
 	return
 }
 
+// AVTransport1GetDeviceCapabilitiesRequest is the request of GetDeviceCapabilities, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport1GetDeviceCapabilitiesRequest struct {
+	InstanceID string
+}
+
+// AVTransport1GetDeviceCapabilitiesResponse is the response of GetDeviceCapabilities, with each
+// argument in its SOAP string form.
+type AVTransport1GetDeviceCapabilitiesResponse struct {
+	PlayMedia       string
+	RecMedia        string
+	RecQualityModes string
+}
+
+// GetDeviceCapabilities performs the GetDeviceCapabilities action of the service.
+//
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetDeviceCapabilities_InstanceID)
+//
+// Return values:
+//   - PlayMedia (out, state variable A_ARG_TYPE_GetDeviceCapabilities_PlayMedia)
+//   - RecMedia (out, state variable A_ARG_TYPE_GetDeviceCapabilities_RecMedia)
+//   - RecQualityModes (out, state variable A_ARG_TYPE_GetDeviceCapabilities_RecQualityModes)
 func (client *AVTransport1) GetDeviceCapabilities(InstanceID uint32) (PlayMedia string, RecMedia string, RecQualityModes string, err error) {
+	return client.GetDeviceCapabilitiesCtx(context.Background(), InstanceID)
+}
+
+// GetDeviceCapabilitiesCtx is GetDeviceCapabilities with a context, to cancel or time out the call.
+func (client *AVTransport1) GetDeviceCapabilitiesCtx(ctx context.Context, InstanceID uint32) (PlayMedia string, RecMedia string, RecQualityModes string, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &AVTransport1GetDeviceCapabilitiesRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -375,16 +622,10 @@ func (client *AVTransport1) GetDeviceCapabilities(InstanceID uint32) (PlayMedia
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		PlayMedia string
-
-		RecMedia string
-
-		RecQualityModes string
-	}{}
+	response := &AVTransport1GetDeviceCapabilitiesResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_1, "GetDeviceCapabilities", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetDeviceCapabilities", request, response); err != nil {
 		return
 	}
 
@@ -403,15 +644,35 @@ func (client *AVTransport1) GetDeviceCapabilities(InstanceID uint32) (PlayMedia
 	return
 }
 
+// AVTransport1GetTransportSettingsRequest is the request of GetTransportSettings, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport1GetTransportSettingsRequest struct {
+	InstanceID string
+}
+
+// AVTransport1GetTransportSettingsResponse is the response of GetTransportSettings, with each
+// argument in its SOAP string form.
+type AVTransport1GetTransportSettingsResponse struct {
+	PlayMode       string
+	RecQualityMode string
+}
+
+// GetTransportSettings performs the GetTransportSettings action of the service.
 //
-// Return values:
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetTransportSettings_InstanceID)
 //
-// * PlayMode: allowed values: NORMAL
-func (client *AVTransport1) GetTransportSettings(InstanceID uint32) (PlayMode string, RecQualityMode string, err error) {
+// Return values:
+//   - PlayMode (out, state variable CurrentPlayMode): allowed values NORMAL
+//   - RecQualityMode (out, state variable A_ARG_TYPE_GetTransportSettings_RecQualityMode)
+func (client *AVTransport1) GetTransportSettings(InstanceID uint32) (PlayMode AVTransport1CurrentPlayMode, RecQualityMode string, err error) {
+	return client.GetTransportSettingsCtx(context.Background(), InstanceID)
+}
+
+// GetTransportSettingsCtx is GetTransportSettings with a context, to cancel or time out the call.
+func (client *AVTransport1) GetTransportSettingsCtx(ctx context.Context, InstanceID uint32) (PlayMode AVTransport1CurrentPlayMode, RecQualityMode string, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &AVTransport1GetTransportSettingsRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -420,22 +681,16 @@ func (client *AVTransport1) GetTransportSettings(InstanceID uint32) (PlayMode st
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		PlayMode string
-
-		RecQualityMode string
-	}{}
+	response := &AVTransport1GetTransportSettingsResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_1, "GetTransportSettings", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetTransportSettings", request, response); err != nil {
 		return
 	}
 
 	// BEGIN Unmarshal arguments from response.
 
-	if PlayMode, err = soap.UnmarshalString(response.PlayMode); err != nil {
-		return
-	}
+	PlayMode = AVTransport1CurrentPlayMode(response.PlayMode)
 	if RecQualityMode, err = soap.UnmarshalString(response.RecQualityMode); err != nil {
 		return
 	}
@@ -443,11 +698,24 @@ func (client *AVTransport1) GetTransportSettings(InstanceID uint32) (PlayMode st
 	return
 }
 
+// AVTransport1StopRequest is the request of Stop, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport1StopRequest struct {
+	InstanceID string
+}
+
+// Stop performs the Stop action of the service.
+//
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_Stop_InstanceID)
 func (client *AVTransport1) Stop(InstanceID uint32) (err error) {
+	return client.StopCtx(context.Background(), InstanceID)
+}
+
+// StopCtx is Stop with a context, to cancel or time out the call.
+func (client *AVTransport1) StopCtx(ctx context.Context, InstanceID uint32) (err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &AVTransport1StopRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -459,7 +727,7 @@ func (client *AVTransport1) Stop(InstanceID uint32) (err error) {
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_1, "Stop", request, response); err != nil {
+	if err = client.PerformAction(ctx, "Stop", request, response); err != nil {
 		return
 	}
 
@@ -469,24 +737,32 @@ func (client *AVTransport1) Stop(InstanceID uint32) (err error) {
 	return
 }
 
+// AVTransport1PlayRequest is the request of Play, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport1PlayRequest struct {
+	InstanceID string
+	Speed      string
+}
+
+// Play performs the Play action of the service.
 //
 // Arguments:
-//
-// * Speed: allowed values: 1
+//   - InstanceID (in, state variable A_ARG_TYPE_Play_InstanceID)
+//   - Speed (in, state variable TransportPlaySpeed): allowed values 1
+func (client *AVTransport1) Play(InstanceID uint32, Speed AVTransport1TransportPlaySpeed) (err error) {
+	return client.PlayCtx(context.Background(), InstanceID, Speed)
+}
 
-func (client *AVTransport1) Play(InstanceID uint32, Speed string) (err error) {
+// PlayCtx is Play with a context, to cancel or time out the call.
+func (client *AVTransport1) PlayCtx(ctx context.Context, InstanceID uint32, Speed AVTransport1TransportPlaySpeed) (err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-
-		Speed string
-	}{}
+	request := &AVTransport1PlayRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
 		return
 	}
-	if request.Speed, err = soap.MarshalString(Speed); err != nil {
+	if request.Speed, err = soap.MarshalString(string(Speed)); err != nil {
 		return
 	}
 	// END Marshal arguments into request.
@@ -495,7 +771,7 @@ func (client *AVTransport1) Play(InstanceID uint32, Speed string) (err error) {
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_1, "Play", request, response); err != nil {
+	if err = client.PerformAction(ctx, "Play", request, response); err != nil {
 		return
 	}
 
@@ -505,11 +781,24 @@ func (client *AVTransport1) Play(InstanceID uint32, Speed string) (err error) {
 	return
 }
 
+// AVTransport1PauseRequest is the request of Pause, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport1PauseRequest struct {
+	InstanceID string
+}
+
+// Pause performs the Pause action of the service.
+//
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_Pause_InstanceID)
 func (client *AVTransport1) Pause(InstanceID uint32) (err error) {
+	return client.PauseCtx(context.Background(), InstanceID)
+}
+
+// PauseCtx is Pause with a context, to cancel or time out the call.
+func (client *AVTransport1) PauseCtx(ctx context.Context, InstanceID uint32) (err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &AVTransport1PauseRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -521,7 +810,7 @@ func (client *AVTransport1) Pause(InstanceID uint32) (err error) {
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_1, "Pause", request, response); err != nil {
+	if err = client.PerformAction(ctx, "Pause", request, response); err != nil {
 		return
 	}
 
@@ -531,11 +820,24 @@ func (client *AVTransport1) Pause(InstanceID uint32) (err error) {
 	return
 }
 
+// AVTransport1RecordRequest is the request of Record, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport1RecordRequest struct {
+	InstanceID string
+}
+
+// Record performs the Record action of the service.
+//
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_Record_InstanceID)
 func (client *AVTransport1) Record(InstanceID uint32) (err error) {
+	return client.RecordCtx(context.Background(), InstanceID)
+}
+
+// RecordCtx is Record with a context, to cancel or time out the call.
+func (client *AVTransport1) RecordCtx(ctx context.Context, InstanceID uint32) (err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &AVTransport1RecordRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -547,7 +849,7 @@ func (client *AVTransport1) Record(InstanceID uint32) (err error) {
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_1, "Record", request, response); err != nil {
+	if err = client.PerformAction(ctx, "Record", request, response); err != nil {
 		return
 	}
 
@@ -557,26 +859,34 @@ func (client *AVTransport1) Record(InstanceID uint32) (err error) {
 	return
 }
 
+// AVTransport1SeekRequest is the request of Seek, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport1SeekRequest struct {
+	InstanceID string
+	Unit       string
+	Target     string
+}
+
+// Seek performs the Seek action of the service.
 //
 // Arguments:
-//
-// * Unit: allowed values: TRACK_NR
+//   - InstanceID (in, state variable A_ARG_TYPE_Seek_InstanceID)
+//   - Unit (in, state variable A_ARG_TYPE_SeekMode): allowed values TRACK_NR
+//   - Target (in, state variable A_ARG_TYPE_Seek_Target)
+func (client *AVTransport1) Seek(InstanceID uint32, Unit AVTransport1SeekMode, Target string) (err error) {
+	return client.SeekCtx(context.Background(), InstanceID, Unit, Target)
+}
 
-func (client *AVTransport1) Seek(InstanceID uint32, Unit string, Target string) (err error) {
+// SeekCtx is Seek with a context, to cancel or time out the call.
+func (client *AVTransport1) SeekCtx(ctx context.Context, InstanceID uint32, Unit AVTransport1SeekMode, Target string) (err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-
-		Unit string
-
-		Target string
-	}{}
+	request := &AVTransport1SeekRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
 		return
 	}
-	if request.Unit, err = soap.MarshalString(Unit); err != nil {
+	if request.Unit, err = soap.MarshalString(string(Unit)); err != nil {
 		return
 	}
 	if request.Target, err = soap.MarshalString(Target); err != nil {
@@ -588,7 +898,7 @@ func (client *AVTransport1) Seek(InstanceID uint32, Unit string, Target string)
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_1, "Seek", request, response); err != nil {
+	if err = client.PerformAction(ctx, "Seek", request, response); err != nil {
 		return
 	}
 
@@ -598,11 +908,24 @@ func (client *AVTransport1) Seek(InstanceID uint32, Unit string, Target string)
 	return
 }
 
+// AVTransport1NextRequest is the request of Next, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport1NextRequest struct {
+	InstanceID string
+}
+
+// Next performs the Next action of the service.
+//
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_Next_InstanceID)
 func (client *AVTransport1) Next(InstanceID uint32) (err error) {
+	return client.NextCtx(context.Background(), InstanceID)
+}
+
+// NextCtx is Next with a context, to cancel or time out the call.
+func (client *AVTransport1) NextCtx(ctx context.Context, InstanceID uint32) (err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &AVTransport1NextRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -614,7 +937,7 @@ func (client *AVTransport1) Next(InstanceID uint32) (err error) {
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_1, "Next", request, response); err != nil {
+	if err = client.PerformAction(ctx, "Next", request, response); err != nil {
 		return
 	}
 
@@ -624,11 +947,24 @@ func (client *AVTransport1) Next(InstanceID uint32) (err error) {
 	return
 }
 
+// AVTransport1PreviousRequest is the request of Previous, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport1PreviousRequest struct {
+	InstanceID string
+}
+
+// Previous performs the Previous action of the service.
+//
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_Previous_InstanceID)
 func (client *AVTransport1) Previous(InstanceID uint32) (err error) {
+	return client.PreviousCtx(context.Background(), InstanceID)
+}
+
+// PreviousCtx is Previous with a context, to cancel or time out the call.
+func (client *AVTransport1) PreviousCtx(ctx context.Context, InstanceID uint32) (err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &AVTransport1PreviousRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -640,7 +976,7 @@ func (client *AVTransport1) Previous(InstanceID uint32) (err error) {
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_1, "Previous", request, response); err != nil {
+	if err = client.PerformAction(ctx, "Previous", request, response); err != nil {
 		return
 	}
 
@@ -650,24 +986,32 @@ func (client *AVTransport1) Previous(InstanceID uint32) (err error) {
 	return
 }
 
+// AVTransport1SetPlayModeRequest is the request of SetPlayMode, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport1SetPlayModeRequest struct {
+	InstanceID  string
+	NewPlayMode string
+}
+
+// SetPlayMode performs the SetPlayMode action of the service.
 //
 // Arguments:
-//
-// * NewPlayMode: allowed values: NORMAL
+//   - InstanceID (in, state variable A_ARG_TYPE_SetPlayMode_InstanceID)
+//   - NewPlayMode (in, state variable CurrentPlayMode): allowed values NORMAL
+func (client *AVTransport1) SetPlayMode(InstanceID uint32, NewPlayMode AVTransport1CurrentPlayMode) (err error) {
+	return client.SetPlayModeCtx(context.Background(), InstanceID, NewPlayMode)
+}
 
-func (client *AVTransport1) SetPlayMode(InstanceID uint32, NewPlayMode string) (err error) {
+// SetPlayModeCtx is SetPlayMode with a context, to cancel or time out the call.
+func (client *AVTransport1) SetPlayModeCtx(ctx context.Context, InstanceID uint32, NewPlayMode AVTransport1CurrentPlayMode) (err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-
-		NewPlayMode string
-	}{}
+	request := &AVTransport1SetPlayModeRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
 		return
 	}
-	if request.NewPlayMode, err = soap.MarshalString(NewPlayMode); err != nil {
+	if request.NewPlayMode, err = soap.MarshalString(string(NewPlayMode)); err != nil {
 		return
 	}
 	// END Marshal arguments into request.
@@ -676,7 +1020,7 @@ func (client *AVTransport1) SetPlayMode(InstanceID uint32, NewPlayMode string) (
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_1, "SetPlayMode", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetPlayMode", request, response); err != nil {
 		return
 	}
 
@@ -686,13 +1030,26 @@ func (client *AVTransport1) SetPlayMode(InstanceID uint32, NewPlayMode string) (
 	return
 }
 
+// AVTransport1SetRecordQualityModeRequest is the request of SetRecordQualityMode, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport1SetRecordQualityModeRequest struct {
+	InstanceID           string
+	NewRecordQualityMode string
+}
+
+// SetRecordQualityMode performs the SetRecordQualityMode action of the service.
+//
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_SetRecordQualityMode_InstanceID)
+//   - NewRecordQualityMode (in, state variable A_ARG_TYPE_SetRecordQualityMode_NewRecordQualityMode)
 func (client *AVTransport1) SetRecordQualityMode(InstanceID uint32, NewRecordQualityMode string) (err error) {
-	// Request structure.
-	request := &struct {
-		InstanceID string
+	return client.SetRecordQualityModeCtx(context.Background(), InstanceID, NewRecordQualityMode)
+}
 
-		NewRecordQualityMode string
-	}{}
+// SetRecordQualityModeCtx is SetRecordQualityMode with a context, to cancel or time out the call.
+func (client *AVTransport1) SetRecordQualityModeCtx(ctx context.Context, InstanceID uint32, NewRecordQualityMode string) (err error) {
+	// Request structure.
+	request := &AVTransport1SetRecordQualityModeRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -707,7 +1064,7 @@ func (client *AVTransport1) SetRecordQualityMode(InstanceID uint32, NewRecordQua
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_1, "SetRecordQualityMode", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetRecordQualityMode", request, response); err != nil {
 		return
 	}
 
@@ -717,11 +1074,33 @@ func (client *AVTransport1) SetRecordQualityMode(InstanceID uint32, NewRecordQua
 	return
 }
 
+// AVTransport1GetCurrentTransportActionsRequest is the request of GetCurrentTransportActions, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport1GetCurrentTransportActionsRequest struct {
+	InstanceID string
+}
+
+// AVTransport1GetCurrentTransportActionsResponse is the response of GetCurrentTransportActions, with each
+// argument in its SOAP string form.
+type AVTransport1GetCurrentTransportActionsResponse struct {
+	Actions string
+}
+
+// GetCurrentTransportActions performs the GetCurrentTransportActions action of the service.
+//
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetCurrentTransportActions_InstanceID)
+//
+// Return values:
+//   - Actions (out, state variable A_ARG_TYPE_GetCurrentTransportActions_Actions)
 func (client *AVTransport1) GetCurrentTransportActions(InstanceID uint32) (Actions string, err error) {
+	return client.GetCurrentTransportActionsCtx(context.Background(), InstanceID)
+}
+
+// GetCurrentTransportActionsCtx is GetCurrentTransportActions with a context, to cancel or time out the call.
+func (client *AVTransport1) GetCurrentTransportActionsCtx(ctx context.Context, InstanceID uint32) (Actions string, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &AVTransport1GetCurrentTransportActionsRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -730,12 +1109,10 @@ func (client *AVTransport1) GetCurrentTransportActions(InstanceID uint32) (Actio
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		Actions string
-	}{}
+	response := &AVTransport1GetCurrentTransportActionsResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_1, "GetCurrentTransportActions", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetCurrentTransportActions", request, response); err != nil {
 		return
 	}
 
@@ -755,6 +1132,189 @@ type AVTransport2 struct {
 	goupnp.ServiceClient
 }
 
+// AVTransport2Client is the interface of the actions of AVTransport2, for
+// substituting fakes or mocks for the service in tests.
+type AVTransport2Client interface {
+	SetAVTransportURI(InstanceID uint32, CurrentURI string, CurrentURIMetaData string) (err error)
+	SetAVTransportURICtx(ctx context.Context, InstanceID uint32, CurrentURI string, CurrentURIMetaData string) (err error)
+	SetNextAVTransportURI(InstanceID uint32, NextURI string, NextURIMetaData string) (err error)
+	SetNextAVTransportURICtx(ctx context.Context, InstanceID uint32, NextURI string, NextURIMetaData string) (err error)
+	GetMediaInfo(InstanceID uint32) (NrTracks uint32, MediaDuration string, CurrentURI string, CurrentURIMetaData string, NextURI string, NextURIMetaData string, PlayMedium string, RecordMedium string, WriteStatus string, err error)
+	GetMediaInfoCtx(ctx context.Context, InstanceID uint32) (NrTracks uint32, MediaDuration string, CurrentURI string, CurrentURIMetaData string, NextURI string, NextURIMetaData string, PlayMedium string, RecordMedium string, WriteStatus string, err error)
+	GetMediaInfo_Ext(InstanceID uint32) (CurrentType AVTransport2CurrentMediaCategory, NrTracks uint32, MediaDuration string, CurrentURI string, CurrentURIMetaData string, NextURI string, NextURIMetaData string, PlayMedium string, RecordMedium string, WriteStatus string, err error)
+	GetMediaInfo_ExtCtx(ctx context.Context, InstanceID uint32) (CurrentType AVTransport2CurrentMediaCategory, NrTracks uint32, MediaDuration string, CurrentURI string, CurrentURIMetaData string, NextURI string, NextURIMetaData string, PlayMedium string, RecordMedium string, WriteStatus string, err error)
+	GetTransportInfo(InstanceID uint32) (CurrentTransportState AVTransport2TransportState, CurrentTransportStatus AVTransport2TransportStatus, CurrentSpeed AVTransport2TransportPlaySpeed, err error)
+	GetTransportInfoCtx(ctx context.Context, InstanceID uint32) (CurrentTransportState AVTransport2TransportState, CurrentTransportStatus AVTransport2TransportStatus, CurrentSpeed AVTransport2TransportPlaySpeed, err error)
+	GetPositionInfo(InstanceID uint32) (Track uint32, TrackDuration string, TrackMetaData string, TrackURI string, RelTime string, AbsTime string, RelCount int32, AbsCount int32, err error)
+	GetPositionInfoCtx(ctx context.Context, InstanceID uint32) (Track uint32, TrackDuration string, TrackMetaData string, TrackURI string, RelTime string, AbsTime string, RelCount int32, AbsCount int32, err error)
+	GetDeviceCapabilities(InstanceID uint32) (PlayMedia string, RecMedia string, RecQualityModes string, err error)
+	GetDeviceCapabilitiesCtx(ctx context.Context, InstanceID uint32) (PlayMedia string, RecMedia string, RecQualityModes string, err error)
+	GetTransportSettings(InstanceID uint32) (PlayMode AVTransport2CurrentPlayMode, RecQualityMode string, err error)
+	GetTransportSettingsCtx(ctx context.Context, InstanceID uint32) (PlayMode AVTransport2CurrentPlayMode, RecQualityMode string, err error)
+	Stop(InstanceID uint32) (err error)
+	StopCtx(ctx context.Context, InstanceID uint32) (err error)
+	Play(InstanceID uint32, Speed AVTransport2TransportPlaySpeed) (err error)
+	PlayCtx(ctx context.Context, InstanceID uint32, Speed AVTransport2TransportPlaySpeed) (err error)
+	Pause(InstanceID uint32) (err error)
+	PauseCtx(ctx context.Context, InstanceID uint32) (err error)
+	Record(InstanceID uint32) (err error)
+	RecordCtx(ctx context.Context, InstanceID uint32) (err error)
+	Seek(InstanceID uint32, Unit AVTransport2SeekMode, Target string) (err error)
+	SeekCtx(ctx context.Context, InstanceID uint32, Unit AVTransport2SeekMode, Target string) (err error)
+	Next(InstanceID uint32) (err error)
+	NextCtx(ctx context.Context, InstanceID uint32) (err error)
+	Previous(InstanceID uint32) (err error)
+	PreviousCtx(ctx context.Context, InstanceID uint32) (err error)
+	SetPlayMode(InstanceID uint32, NewPlayMode AVTransport2CurrentPlayMode) (err error)
+	SetPlayModeCtx(ctx context.Context, InstanceID uint32, NewPlayMode AVTransport2CurrentPlayMode) (err error)
+	SetRecordQualityMode(InstanceID uint32, NewRecordQualityMode string) (err error)
+	SetRecordQualityModeCtx(ctx context.Context, InstanceID uint32, NewRecordQualityMode string) (err error)
+	GetCurrentTransportActions(InstanceID uint32) (Actions string, err error)
+	GetCurrentTransportActionsCtx(ctx context.Context, InstanceID uint32) (Actions string, err error)
+	GetDRMState(InstanceID uint32) (CurrentDRMState AVTransport2DRMState, err error)
+	GetDRMStateCtx(ctx context.Context, InstanceID uint32) (CurrentDRMState AVTransport2DRMState, err error)
+	GetStateVariables(InstanceID uint32, StateVariableList string) (StateVariableValuePairs string, err error)
+	GetStateVariablesCtx(ctx context.Context, InstanceID uint32, StateVariableList string) (StateVariableValuePairs string, err error)
+	SetStateVariables(InstanceID uint32, AVTransportUDN string, ServiceType string, ServiceId string, StateVariableValuePairs string) (StateVariableList string, err error)
+	SetStateVariablesCtx(ctx context.Context, InstanceID uint32, AVTransportUDN string, ServiceType string, ServiceId string, StateVariableValuePairs string) (StateVariableList string, err error)
+}
+
+var _ AVTransport2Client = new(AVTransport2)
+
+// AVTransport2CurrentMediaCategory is a value of the state variable CurrentMediaCategory of
+// AVTransport2.
+type AVTransport2CurrentMediaCategory string
+
+// Allowed values of AVTransport2CurrentMediaCategory.
+const (
+	AVTransport2CurrentMediaCategory_NO_MEDIA      AVTransport2CurrentMediaCategory = "NO_MEDIA"
+	AVTransport2CurrentMediaCategory_TRACK_AWARE   AVTransport2CurrentMediaCategory = "TRACK_AWARE"
+	AVTransport2CurrentMediaCategory_TRACK_UNAWARE AVTransport2CurrentMediaCategory = "TRACK_UNAWARE"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v AVTransport2CurrentMediaCategory) Valid() bool {
+	switch v {
+	case AVTransport2CurrentMediaCategory_NO_MEDIA,
+		AVTransport2CurrentMediaCategory_TRACK_AWARE,
+		AVTransport2CurrentMediaCategory_TRACK_UNAWARE:
+		return true
+	}
+	return false
+}
+
+// AVTransport2TransportState is a value of the state variable TransportState of
+// AVTransport2.
+type AVTransport2TransportState string
+
+// Allowed values of AVTransport2TransportState.
+const (
+	AVTransport2TransportState_STOPPED AVTransport2TransportState = "STOPPED"
+	AVTransport2TransportState_PLAYING AVTransport2TransportState = "PLAYING"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v AVTransport2TransportState) Valid() bool {
+	switch v {
+	case AVTransport2TransportState_STOPPED,
+		AVTransport2TransportState_PLAYING:
+		return true
+	}
+	return false
+}
+
+// AVTransport2TransportStatus is a value of the state variable TransportStatus of
+// AVTransport2.
+type AVTransport2TransportStatus string
+
+// Allowed values of AVTransport2TransportStatus.
+const (
+	AVTransport2TransportStatus_OK             AVTransport2TransportStatus = "OK"
+	AVTransport2TransportStatus_ERROR_OCCURRED AVTransport2TransportStatus = "ERROR_OCCURRED"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v AVTransport2TransportStatus) Valid() bool {
+	switch v {
+	case AVTransport2TransportStatus_OK,
+		AVTransport2TransportStatus_ERROR_OCCURRED:
+		return true
+	}
+	return false
+}
+
+// AVTransport2TransportPlaySpeed is a value of the state variable TransportPlaySpeed of
+// AVTransport2.
+type AVTransport2TransportPlaySpeed string
+
+// Allowed values of AVTransport2TransportPlaySpeed.
+const (
+	AVTransport2TransportPlaySpeed_1 AVTransport2TransportPlaySpeed = "1"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v AVTransport2TransportPlaySpeed) Valid() bool {
+	switch v {
+	case AVTransport2TransportPlaySpeed_1:
+		return true
+	}
+	return false
+}
+
+// AVTransport2CurrentPlayMode is a value of the state variable CurrentPlayMode of
+// AVTransport2.
+type AVTransport2CurrentPlayMode string
+
+// Allowed values of AVTransport2CurrentPlayMode.
+const (
+	AVTransport2CurrentPlayMode_NORMAL AVTransport2CurrentPlayMode = "NORMAL"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v AVTransport2CurrentPlayMode) Valid() bool {
+	switch v {
+	case AVTransport2CurrentPlayMode_NORMAL:
+		return true
+	}
+	return false
+}
+
+// AVTransport2SeekMode is a value of the state variable A_ARG_TYPE_SeekMode of
+// AVTransport2.
+type AVTransport2SeekMode string
+
+// Allowed values of AVTransport2SeekMode.
+const (
+	AVTransport2SeekMode_TRACK_NR AVTransport2SeekMode = "TRACK_NR"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v AVTransport2SeekMode) Valid() bool {
+	switch v {
+	case AVTransport2SeekMode_TRACK_NR:
+		return true
+	}
+	return false
+}
+
+// AVTransport2DRMState is a value of the state variable DRMState of
+// AVTransport2.
+type AVTransport2DRMState string
+
+// Allowed values of AVTransport2DRMState.
+const (
+	AVTransport2DRMState_OK AVTransport2DRMState = "OK"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v AVTransport2DRMState) Valid() bool {
+	switch v {
+	case AVTransport2DRMState_OK:
+		return true
+	}
+	return false
+}
+
 // NewAVTransport2Clients discovers instances of the service on the network,
 // and returns clients to any that are found. errors will contain an error for
 // any devices that replied but which could not be queried, and err will be set
@@ -808,15 +1368,48 @@ func newAVTransport2ClientsFromGenericClients(genericClients []goupnp.ServiceCli
 	return clients
 }
 
-func (client *AVTransport2) SetAVTransportURI(InstanceID uint32, CurrentURI string, CurrentURIMetaData string) (err error) {
-	// Request structure.
-	request := &struct {
-		InstanceID string
+// AVTransport2Timeouts are the default timeouts of the actions of
+// AVTransport2, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var AVTransport2Timeouts = map[string]time.Duration{
+	"":                      10 * time.Second,
+	"SetAVTransportURI":     30 * time.Second,
+	"SetNextAVTransportURI": 30 * time.Second,
+}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *AVTransport2) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, AVTransport2Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_AVTransport_2, actionName, request, response)
+}
+
+// AVTransport2SetAVTransportURIRequest is the request of SetAVTransportURI, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport2SetAVTransportURIRequest struct {
+	InstanceID         string
+	CurrentURI         string
+	CurrentURIMetaData string
+}
 
-		CurrentURI string
+// SetAVTransportURI performs the SetAVTransportURI action of the service.
+//
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_SetAVTransportURI_InstanceID)
+//   - CurrentURI (in, state variable A_ARG_TYPE_SetAVTransportURI_CurrentURI)
+//   - CurrentURIMetaData (in, state variable A_ARG_TYPE_SetAVTransportURI_CurrentURIMetaData)
+func (client *AVTransport2) SetAVTransportURI(InstanceID uint32, CurrentURI string, CurrentURIMetaData string) (err error) {
+	return client.SetAVTransportURICtx(context.Background(), InstanceID, CurrentURI, CurrentURIMetaData)
+}
 
-		CurrentURIMetaData string
-	}{}
+// SetAVTransportURICtx is SetAVTransportURI with a context, to cancel or time out the call.
+func (client *AVTransport2) SetAVTransportURICtx(ctx context.Context, InstanceID uint32, CurrentURI string, CurrentURIMetaData string) (err error) {
+	// Request structure.
+	request := &AVTransport2SetAVTransportURIRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -834,7 +1427,7 @@ func (client *AVTransport2) SetAVTransportURI(InstanceID uint32, CurrentURI stri
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_2, "SetAVTransportURI", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetAVTransportURI", request, response); err != nil {
 		return
 	}
 
@@ -844,15 +1437,28 @@ func (client *AVTransport2) SetAVTransportURI(InstanceID uint32, CurrentURI stri
 	return
 }
 
-func (client *AVTransport2) SetNextAVTransportURI(InstanceID uint32, NextURI string, NextURIMetaData string) (err error) {
-	// Request structure.
-	request := &struct {
-		InstanceID string
+// AVTransport2SetNextAVTransportURIRequest is the request of SetNextAVTransportURI, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport2SetNextAVTransportURIRequest struct {
+	InstanceID      string
+	NextURI         string
+	NextURIMetaData string
+}
 
-		NextURI string
+// SetNextAVTransportURI performs the SetNextAVTransportURI action of the service.
+//
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_SetNextAVTransportURI_InstanceID)
+//   - NextURI (in, state variable A_ARG_TYPE_SetNextAVTransportURI_NextURI)
+//   - NextURIMetaData (in, state variable A_ARG_TYPE_SetNextAVTransportURI_NextURIMetaData)
+func (client *AVTransport2) SetNextAVTransportURI(InstanceID uint32, NextURI string, NextURIMetaData string) (err error) {
+	return client.SetNextAVTransportURICtx(context.Background(), InstanceID, NextURI, NextURIMetaData)
+}
 
-		NextURIMetaData string
-	}{}
+// SetNextAVTransportURICtx is SetNextAVTransportURI with a context, to cancel or time out the call.
+func (client *AVTransport2) SetNextAVTransportURICtx(ctx context.Context, InstanceID uint32, NextURI string, NextURIMetaData string) (err error) {
+	// Request structure.
+	request := &AVTransport2SetNextAVTransportURIRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -870,7 +1476,7 @@ func (client *AVTransport2) SetNextAVTransportURI(InstanceID uint32, NextURI str
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_2, "SetNextAVTransportURI", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetNextAVTransportURI", request, response); err != nil {
 		return
 	}
 
@@ -880,15 +1486,49 @@ func (client *AVTransport2) SetNextAVTransportURI(InstanceID uint32, NextURI str
 	return
 }
 
+// AVTransport2GetMediaInfoRequest is the request of GetMediaInfo, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport2GetMediaInfoRequest struct {
+	InstanceID string
+}
+
+// AVTransport2GetMediaInfoResponse is the response of GetMediaInfo, with each
+// argument in its SOAP string form.
+type AVTransport2GetMediaInfoResponse struct {
+	NrTracks           string
+	MediaDuration      string
+	CurrentURI         string
+	CurrentURIMetaData string
+	NextURI            string
+	NextURIMetaData    string
+	PlayMedium         string
+	RecordMedium       string
+	WriteStatus        string
+}
+
+// GetMediaInfo performs the GetMediaInfo action of the service.
 //
-// Return values:
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetMediaInfo_InstanceID)
 //
-// * NrTracks: allowed value range: minimum=0
+// Return values:
+//   - NrTracks (out, state variable A_ARG_TYPE_GetMediaInfo_NrTracks): allowed range minimum=0
+//   - MediaDuration (out, state variable A_ARG_TYPE_GetMediaInfo_MediaDuration)
+//   - CurrentURI (out, state variable A_ARG_TYPE_GetMediaInfo_CurrentURI)
+//   - CurrentURIMetaData (out, state variable A_ARG_TYPE_GetMediaInfo_CurrentURIMetaData)
+//   - NextURI (out, state variable A_ARG_TYPE_GetMediaInfo_NextURI)
+//   - NextURIMetaData (out, state variable A_ARG_TYPE_GetMediaInfo_NextURIMetaData)
+//   - PlayMedium (out, state variable A_ARG_TYPE_GetMediaInfo_PlayMedium)
+//   - RecordMedium (out, state variable A_ARG_TYPE_GetMediaInfo_RecordMedium)
+//   - WriteStatus (out, state variable A_ARG_TYPE_GetMediaInfo_WriteStatus)
 func (client *AVTransport2) GetMediaInfo(InstanceID uint32) (NrTracks uint32, MediaDuration string, CurrentURI string, CurrentURIMetaData string, NextURI string, NextURIMetaData string, PlayMedium string, RecordMedium string, WriteStatus string, err error) {
+	return client.GetMediaInfoCtx(context.Background(), InstanceID)
+}
+
+// GetMediaInfoCtx is GetMediaInfo with a context, to cancel or time out the call.
+func (client *AVTransport2) GetMediaInfoCtx(ctx context.Context, InstanceID uint32) (NrTracks uint32, MediaDuration string, CurrentURI string, CurrentURIMetaData string, NextURI string, NextURIMetaData string, PlayMedium string, RecordMedium string, WriteStatus string, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &AVTransport2GetMediaInfoRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -897,28 +1537,10 @@ func (client *AVTransport2) GetMediaInfo(InstanceID uint32) (NrTracks uint32, Me
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NrTracks string
-
-		MediaDuration string
-
-		CurrentURI string
-
-		CurrentURIMetaData string
-
-		NextURI string
-
-		NextURIMetaData string
-
-		PlayMedium string
-
-		RecordMedium string
-
-		WriteStatus string
-	}{}
+	response := &AVTransport2GetMediaInfoResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_2, "GetMediaInfo", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetMediaInfo", request, response); err != nil {
 		return
 	}
 
@@ -955,17 +1577,51 @@ func (client *AVTransport2) GetMediaInfo(InstanceID uint32) (NrTracks uint32, Me
 	return
 }
 
+// AVTransport2GetMediaInfo_ExtRequest is the request of GetMediaInfo_Ext, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport2GetMediaInfo_ExtRequest struct {
+	InstanceID string
+}
+
+// AVTransport2GetMediaInfo_ExtResponse is the response of GetMediaInfo_Ext, with each
+// argument in its SOAP string form.
+type AVTransport2GetMediaInfo_ExtResponse struct {
+	CurrentType        string
+	NrTracks           string
+	MediaDuration      string
+	CurrentURI         string
+	CurrentURIMetaData string
+	NextURI            string
+	NextURIMetaData    string
+	PlayMedium         string
+	RecordMedium       string
+	WriteStatus        string
+}
+
+// GetMediaInfo_Ext performs the GetMediaInfo_Ext action of the service.
 //
-// Return values:
-//
-// * CurrentType: allowed values: NO_MEDIA, TRACK_AWARE, TRACK_UNAWARE
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetMediaInfo_Ext_InstanceID)
 //
-// * NrTracks: allowed value range: minimum=0
-func (client *AVTransport2) GetMediaInfo_Ext(InstanceID uint32) (CurrentType string, NrTracks uint32, MediaDuration string, CurrentURI string, CurrentURIMetaData string, NextURI string, NextURIMetaData string, PlayMedium string, RecordMedium string, WriteStatus string, err error) {
+// Return values:
+//   - CurrentType (out, state variable CurrentMediaCategory): allowed values NO_MEDIA, TRACK_AWARE, TRACK_UNAWARE
+//   - NrTracks (out, state variable A_ARG_TYPE_GetMediaInfo_Ext_NrTracks): allowed range minimum=0
+//   - MediaDuration (out, state variable A_ARG_TYPE_GetMediaInfo_Ext_MediaDuration)
+//   - CurrentURI (out, state variable A_ARG_TYPE_GetMediaInfo_Ext_CurrentURI)
+//   - CurrentURIMetaData (out, state variable A_ARG_TYPE_GetMediaInfo_Ext_CurrentURIMetaData)
+//   - NextURI (out, state variable A_ARG_TYPE_GetMediaInfo_Ext_NextURI)
+//   - NextURIMetaData (out, state variable A_ARG_TYPE_GetMediaInfo_Ext_NextURIMetaData)
+//   - PlayMedium (out, state variable A_ARG_TYPE_GetMediaInfo_Ext_PlayMedium)
+//   - RecordMedium (out, state variable A_ARG_TYPE_GetMediaInfo_Ext_RecordMedium)
+//   - WriteStatus (out, state variable A_ARG_TYPE_GetMediaInfo_Ext_WriteStatus)
+func (client *AVTransport2) GetMediaInfo_Ext(InstanceID uint32) (CurrentType AVTransport2CurrentMediaCategory, NrTracks uint32, MediaDuration string, CurrentURI string, CurrentURIMetaData string, NextURI string, NextURIMetaData string, PlayMedium string, RecordMedium string, WriteStatus string, err error) {
+	return client.GetMediaInfo_ExtCtx(context.Background(), InstanceID)
+}
+
+// GetMediaInfo_ExtCtx is GetMediaInfo_Ext with a context, to cancel or time out the call.
+func (client *AVTransport2) GetMediaInfo_ExtCtx(ctx context.Context, InstanceID uint32) (CurrentType AVTransport2CurrentMediaCategory, NrTracks uint32, MediaDuration string, CurrentURI string, CurrentURIMetaData string, NextURI string, NextURIMetaData string, PlayMedium string, RecordMedium string, WriteStatus string, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &AVTransport2GetMediaInfo_ExtRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -974,38 +1630,16 @@ func (client *AVTransport2) GetMediaInfo_Ext(InstanceID uint32) (CurrentType str
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentType string
-
-		NrTracks string
-
-		MediaDuration string
-
-		CurrentURI string
-
-		CurrentURIMetaData string
-
-		NextURI string
-
-		NextURIMetaData string
-
-		PlayMedium string
-
-		RecordMedium string
-
-		WriteStatus string
-	}{}
+	response := &AVTransport2GetMediaInfo_ExtResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_2, "GetMediaInfo_Ext", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetMediaInfo_Ext", request, response); err != nil {
 		return
 	}
 
 	// BEGIN Unmarshal arguments from response.
 
-	if CurrentType, err = soap.UnmarshalString(response.CurrentType); err != nil {
-		return
-	}
+	CurrentType = AVTransport2CurrentMediaCategory(response.CurrentType)
 	if NrTracks, err = soap.UnmarshalUi4(response.NrTracks); err != nil {
 		return
 	}
@@ -1037,19 +1671,37 @@ func (client *AVTransport2) GetMediaInfo_Ext(InstanceID uint32) (CurrentType str
 	return
 }
 
+// AVTransport2GetTransportInfoRequest is the request of GetTransportInfo, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport2GetTransportInfoRequest struct {
+	InstanceID string
+}
+
+// AVTransport2GetTransportInfoResponse is the response of GetTransportInfo, with each
+// argument in its SOAP string form.
+type AVTransport2GetTransportInfoResponse struct {
+	CurrentTransportState  string
+	CurrentTransportStatus string
+	CurrentSpeed           string
+}
+
+// GetTransportInfo performs the GetTransportInfo action of the service.
 //
-// Return values:
-//
-// * CurrentTransportState: allowed values: STOPPED, PLAYING
-//
-// * CurrentTransportStatus: allowed values: OK, ERROR_OCCURRED
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetTransportInfo_InstanceID)
 //
-// * CurrentSpeed: allowed values: 1
-func (client *AVTransport2) GetTransportInfo(InstanceID uint32) (CurrentTransportState string, CurrentTransportStatus string, CurrentSpeed string, err error) {
+// Return values:
+//   - CurrentTransportState (out, state variable TransportState): allowed values STOPPED, PLAYING
+//   - CurrentTransportStatus (out, state variable TransportStatus): allowed values OK, ERROR_OCCURRED
+//   - CurrentSpeed (out, state variable TransportPlaySpeed): allowed values 1
+func (client *AVTransport2) GetTransportInfo(InstanceID uint32) (CurrentTransportState AVTransport2TransportState, CurrentTransportStatus AVTransport2TransportStatus, CurrentSpeed AVTransport2TransportPlaySpeed, err error) {
+	return client.GetTransportInfoCtx(context.Background(), InstanceID)
+}
+
+// GetTransportInfoCtx is GetTransportInfo with a context, to cancel or time out the call.
+func (client *AVTransport2) GetTransportInfoCtx(ctx context.Context, InstanceID uint32) (CurrentTransportState AVTransport2TransportState, CurrentTransportStatus AVTransport2TransportStatus, CurrentSpeed AVTransport2TransportPlaySpeed, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &AVTransport2GetTransportInfoRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -1058,43 +1710,63 @@ func (client *AVTransport2) GetTransportInfo(InstanceID uint32) (CurrentTranspor
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentTransportState string
-
-		CurrentTransportStatus string
-
-		CurrentSpeed string
-	}{}
+	response := &AVTransport2GetTransportInfoResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_2, "GetTransportInfo", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetTransportInfo", request, response); err != nil {
 		return
 	}
 
 	// BEGIN Unmarshal arguments from response.
 
-	if CurrentTransportState, err = soap.UnmarshalString(response.CurrentTransportState); err != nil {
-		return
-	}
-	if CurrentTransportStatus, err = soap.UnmarshalString(response.CurrentTransportStatus); err != nil {
-		return
-	}
-	if CurrentSpeed, err = soap.UnmarshalString(response.CurrentSpeed); err != nil {
-		return
-	}
+	CurrentTransportState = AVTransport2TransportState(response.CurrentTransportState)
+	CurrentTransportStatus = AVTransport2TransportStatus(response.CurrentTransportStatus)
+	CurrentSpeed = AVTransport2TransportPlaySpeed(response.CurrentSpeed)
 	// END Unmarshal arguments from response.
 	return
 }
 
+// AVTransport2GetPositionInfoRequest is the request of GetPositionInfo, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport2GetPositionInfoRequest struct {
+	InstanceID string
+}
+
+// AVTransport2GetPositionInfoResponse is the response of GetPositionInfo, with each
+// argument in its SOAP string form.
+type AVTransport2GetPositionInfoResponse struct {
+	Track         string
+	TrackDuration string
+	TrackMetaData string
+	TrackURI      string
+	RelTime       string
+	AbsTime       string
+	RelCount      string
+	AbsCount      string
+}
+
+// GetPositionInfo performs the GetPositionInfo action of the service.
 //
-// Return values:
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetPositionInfo_InstanceID)
 //
-// * Track: allowed value range: minimum=0, step=1
+// Return values:
+//   - Track (out, state variable A_ARG_TYPE_GetPositionInfo_Track): allowed range minimum=0, step=1
+//   - TrackDuration (out, state variable A_ARG_TYPE_GetPositionInfo_TrackDuration)
+//   - TrackMetaData (out, state variable A_ARG_TYPE_GetPositionInfo_TrackMetaData)
+//   - TrackURI (out, state variable A_ARG_TYPE_GetPositionInfo_TrackURI)
+//   - RelTime (out, state variable A_ARG_TYPE_GetPositionInfo_RelTime)
+//   - AbsTime (out, state variable A_ARG_TYPE_GetPositionInfo_AbsTime)
+//   - RelCount (out, state variable A_ARG_TYPE_GetPositionInfo_RelCount)
+//   - AbsCount (out, state variable A_ARG_TYPE_GetPositionInfo_AbsCount)
 func (client *AVTransport2) GetPositionInfo(InstanceID uint32) (Track uint32, TrackDuration string, TrackMetaData string, TrackURI string, RelTime string, AbsTime string, RelCount int32, AbsCount int32, err error) {
+	return client.GetPositionInfoCtx(context.Background(), InstanceID)
+}
+
+// GetPositionInfoCtx is GetPositionInfo with a context, to cancel or time out the call.
+func (client *AVTransport2) GetPositionInfoCtx(ctx context.Context, InstanceID uint32) (Track uint32, TrackDuration string, TrackMetaData string, TrackURI string, RelTime string, AbsTime string, RelCount int32, AbsCount int32, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &AVTransport2GetPositionInfoRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -1103,26 +1775,10 @@ func (client *AVTransport2) GetPositionInfo(InstanceID uint32) (Track uint32, Tr
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		Track string
-
-		TrackDuration string
-
-		TrackMetaData string
-
-		TrackURI string
-
-		RelTime string
-
-		AbsTime string
-
-		RelCount string
-
-		AbsCount string
-	}{}
+	response := &AVTransport2GetPositionInfoResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_2, "GetPositionInfo", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetPositionInfo", request, response); err != nil {
 		return
 	}
 
@@ -1156,11 +1812,37 @@ func (client *AVTransport2) GetPositionInfo(InstanceID uint32) (Track uint32, Tr
 	return
 }
 
+// AVTransport2GetDeviceCapabilitiesRequest is the request of GetDeviceCapabilities, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport2GetDeviceCapabilitiesRequest struct {
+	InstanceID string
+}
+
+// AVTransport2GetDeviceCapabilitiesResponse is the response of GetDeviceCapabilities, with each
+// argument in its SOAP string form.
+type AVTransport2GetDeviceCapabilitiesResponse struct {
+	PlayMedia       string
+	RecMedia        string
+	RecQualityModes string
+}
+
+// GetDeviceCapabilities performs the GetDeviceCapabilities action of the service.
+//
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetDeviceCapabilities_InstanceID)
+//
+// Return values:
+//   - PlayMedia (out, state variable A_ARG_TYPE_GetDeviceCapabilities_PlayMedia)
+//   - RecMedia (out, state variable A_ARG_TYPE_GetDeviceCapabilities_RecMedia)
+//   - RecQualityModes (out, state variable A_ARG_TYPE_GetDeviceCapabilities_RecQualityModes)
 func (client *AVTransport2) GetDeviceCapabilities(InstanceID uint32) (PlayMedia string, RecMedia string, RecQualityModes string, err error) {
+	return client.GetDeviceCapabilitiesCtx(context.Background(), InstanceID)
+}
+
+// GetDeviceCapabilitiesCtx is GetDeviceCapabilities with a context, to cancel or time out the call.
+func (client *AVTransport2) GetDeviceCapabilitiesCtx(ctx context.Context, InstanceID uint32) (PlayMedia string, RecMedia string, RecQualityModes string, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &AVTransport2GetDeviceCapabilitiesRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -1169,16 +1851,10 @@ func (client *AVTransport2) GetDeviceCapabilities(InstanceID uint32) (PlayMedia
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		PlayMedia string
-
-		RecMedia string
-
-		RecQualityModes string
-	}{}
+	response := &AVTransport2GetDeviceCapabilitiesResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_2, "GetDeviceCapabilities", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetDeviceCapabilities", request, response); err != nil {
 		return
 	}
 
@@ -1197,15 +1873,35 @@ func (client *AVTransport2) GetDeviceCapabilities(InstanceID uint32) (PlayMedia
 	return
 }
 
+// AVTransport2GetTransportSettingsRequest is the request of GetTransportSettings, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport2GetTransportSettingsRequest struct {
+	InstanceID string
+}
+
+// AVTransport2GetTransportSettingsResponse is the response of GetTransportSettings, with each
+// argument in its SOAP string form.
+type AVTransport2GetTransportSettingsResponse struct {
+	PlayMode       string
+	RecQualityMode string
+}
+
+// GetTransportSettings performs the GetTransportSettings action of the service.
 //
-// Return values:
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetTransportSettings_InstanceID)
 //
-// * PlayMode: allowed values: NORMAL
-func (client *AVTransport2) GetTransportSettings(InstanceID uint32) (PlayMode string, RecQualityMode string, err error) {
+// Return values:
+//   - PlayMode (out, state variable CurrentPlayMode): allowed values NORMAL
+//   - RecQualityMode (out, state variable A_ARG_TYPE_GetTransportSettings_RecQualityMode)
+func (client *AVTransport2) GetTransportSettings(InstanceID uint32) (PlayMode AVTransport2CurrentPlayMode, RecQualityMode string, err error) {
+	return client.GetTransportSettingsCtx(context.Background(), InstanceID)
+}
+
+// GetTransportSettingsCtx is GetTransportSettings with a context, to cancel or time out the call.
+func (client *AVTransport2) GetTransportSettingsCtx(ctx context.Context, InstanceID uint32) (PlayMode AVTransport2CurrentPlayMode, RecQualityMode string, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &AVTransport2GetTransportSettingsRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -1214,22 +1910,16 @@ func (client *AVTransport2) GetTransportSettings(InstanceID uint32) (PlayMode st
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		PlayMode string
-
-		RecQualityMode string
-	}{}
+	response := &AVTransport2GetTransportSettingsResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_2, "GetTransportSettings", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetTransportSettings", request, response); err != nil {
 		return
 	}
 
 	// BEGIN Unmarshal arguments from response.
 
-	if PlayMode, err = soap.UnmarshalString(response.PlayMode); err != nil {
-		return
-	}
+	PlayMode = AVTransport2CurrentPlayMode(response.PlayMode)
 	if RecQualityMode, err = soap.UnmarshalString(response.RecQualityMode); err != nil {
 		return
 	}
@@ -1237,11 +1927,24 @@ func (client *AVTransport2) GetTransportSettings(InstanceID uint32) (PlayMode st
 	return
 }
 
+// AVTransport2StopRequest is the request of Stop, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport2StopRequest struct {
+	InstanceID string
+}
+
+// Stop performs the Stop action of the service.
+//
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_Stop_InstanceID)
 func (client *AVTransport2) Stop(InstanceID uint32) (err error) {
+	return client.StopCtx(context.Background(), InstanceID)
+}
+
+// StopCtx is Stop with a context, to cancel or time out the call.
+func (client *AVTransport2) StopCtx(ctx context.Context, InstanceID uint32) (err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &AVTransport2StopRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -1253,7 +1956,7 @@ func (client *AVTransport2) Stop(InstanceID uint32) (err error) {
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_2, "Stop", request, response); err != nil {
+	if err = client.PerformAction(ctx, "Stop", request, response); err != nil {
 		return
 	}
 
@@ -1263,24 +1966,32 @@ func (client *AVTransport2) Stop(InstanceID uint32) (err error) {
 	return
 }
 
+// AVTransport2PlayRequest is the request of Play, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport2PlayRequest struct {
+	InstanceID string
+	Speed      string
+}
+
+// Play performs the Play action of the service.
 //
 // Arguments:
-//
-// * Speed: allowed values: 1
+//   - InstanceID (in, state variable A_ARG_TYPE_Play_InstanceID)
+//   - Speed (in, state variable TransportPlaySpeed): allowed values 1
+func (client *AVTransport2) Play(InstanceID uint32, Speed AVTransport2TransportPlaySpeed) (err error) {
+	return client.PlayCtx(context.Background(), InstanceID, Speed)
+}
 
-func (client *AVTransport2) Play(InstanceID uint32, Speed string) (err error) {
+// PlayCtx is Play with a context, to cancel or time out the call.
+func (client *AVTransport2) PlayCtx(ctx context.Context, InstanceID uint32, Speed AVTransport2TransportPlaySpeed) (err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-
-		Speed string
-	}{}
+	request := &AVTransport2PlayRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
 		return
 	}
-	if request.Speed, err = soap.MarshalString(Speed); err != nil {
+	if request.Speed, err = soap.MarshalString(string(Speed)); err != nil {
 		return
 	}
 	// END Marshal arguments into request.
@@ -1289,7 +2000,7 @@ func (client *AVTransport2) Play(InstanceID uint32, Speed string) (err error) {
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_2, "Play", request, response); err != nil {
+	if err = client.PerformAction(ctx, "Play", request, response); err != nil {
 		return
 	}
 
@@ -1299,11 +2010,24 @@ func (client *AVTransport2) Play(InstanceID uint32, Speed string) (err error) {
 	return
 }
 
+// AVTransport2PauseRequest is the request of Pause, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport2PauseRequest struct {
+	InstanceID string
+}
+
+// Pause performs the Pause action of the service.
+//
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_Pause_InstanceID)
 func (client *AVTransport2) Pause(InstanceID uint32) (err error) {
+	return client.PauseCtx(context.Background(), InstanceID)
+}
+
+// PauseCtx is Pause with a context, to cancel or time out the call.
+func (client *AVTransport2) PauseCtx(ctx context.Context, InstanceID uint32) (err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &AVTransport2PauseRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -1315,7 +2039,7 @@ func (client *AVTransport2) Pause(InstanceID uint32) (err error) {
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_2, "Pause", request, response); err != nil {
+	if err = client.PerformAction(ctx, "Pause", request, response); err != nil {
 		return
 	}
 
@@ -1325,11 +2049,24 @@ func (client *AVTransport2) Pause(InstanceID uint32) (err error) {
 	return
 }
 
+// AVTransport2RecordRequest is the request of Record, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport2RecordRequest struct {
+	InstanceID string
+}
+
+// Record performs the Record action of the service.
+//
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_Record_InstanceID)
 func (client *AVTransport2) Record(InstanceID uint32) (err error) {
+	return client.RecordCtx(context.Background(), InstanceID)
+}
+
+// RecordCtx is Record with a context, to cancel or time out the call.
+func (client *AVTransport2) RecordCtx(ctx context.Context, InstanceID uint32) (err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &AVTransport2RecordRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -1341,7 +2078,7 @@ func (client *AVTransport2) Record(InstanceID uint32) (err error) {
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_2, "Record", request, response); err != nil {
+	if err = client.PerformAction(ctx, "Record", request, response); err != nil {
 		return
 	}
 
@@ -1351,26 +2088,34 @@ func (client *AVTransport2) Record(InstanceID uint32) (err error) {
 	return
 }
 
+// AVTransport2SeekRequest is the request of Seek, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport2SeekRequest struct {
+	InstanceID string
+	Unit       string
+	Target     string
+}
+
+// Seek performs the Seek action of the service.
 //
 // Arguments:
-//
-// * Unit: allowed values: TRACK_NR
+//   - InstanceID (in, state variable A_ARG_TYPE_Seek_InstanceID)
+//   - Unit (in, state variable A_ARG_TYPE_SeekMode): allowed values TRACK_NR
+//   - Target (in, state variable A_ARG_TYPE_Seek_Target)
+func (client *AVTransport2) Seek(InstanceID uint32, Unit AVTransport2SeekMode, Target string) (err error) {
+	return client.SeekCtx(context.Background(), InstanceID, Unit, Target)
+}
 
-func (client *AVTransport2) Seek(InstanceID uint32, Unit string, Target string) (err error) {
+// SeekCtx is Seek with a context, to cancel or time out the call.
+func (client *AVTransport2) SeekCtx(ctx context.Context, InstanceID uint32, Unit AVTransport2SeekMode, Target string) (err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-
-		Unit string
-
-		Target string
-	}{}
+	request := &AVTransport2SeekRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
 		return
 	}
-	if request.Unit, err = soap.MarshalString(Unit); err != nil {
+	if request.Unit, err = soap.MarshalString(string(Unit)); err != nil {
 		return
 	}
 	if request.Target, err = soap.MarshalString(Target); err != nil {
@@ -1382,7 +2127,7 @@ func (client *AVTransport2) Seek(InstanceID uint32, Unit string, Target string)
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_2, "Seek", request, response); err != nil {
+	if err = client.PerformAction(ctx, "Seek", request, response); err != nil {
 		return
 	}
 
@@ -1392,11 +2137,24 @@ func (client *AVTransport2) Seek(InstanceID uint32, Unit string, Target string)
 	return
 }
 
+// AVTransport2NextRequest is the request of Next, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport2NextRequest struct {
+	InstanceID string
+}
+
+// Next performs the Next action of the service.
+//
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_Next_InstanceID)
 func (client *AVTransport2) Next(InstanceID uint32) (err error) {
+	return client.NextCtx(context.Background(), InstanceID)
+}
+
+// NextCtx is Next with a context, to cancel or time out the call.
+func (client *AVTransport2) NextCtx(ctx context.Context, InstanceID uint32) (err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &AVTransport2NextRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -1408,7 +2166,7 @@ func (client *AVTransport2) Next(InstanceID uint32) (err error) {
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_2, "Next", request, response); err != nil {
+	if err = client.PerformAction(ctx, "Next", request, response); err != nil {
 		return
 	}
 
@@ -1418,11 +2176,24 @@ func (client *AVTransport2) Next(InstanceID uint32) (err error) {
 	return
 }
 
+// AVTransport2PreviousRequest is the request of Previous, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport2PreviousRequest struct {
+	InstanceID string
+}
+
+// Previous performs the Previous action of the service.
+//
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_Previous_InstanceID)
 func (client *AVTransport2) Previous(InstanceID uint32) (err error) {
+	return client.PreviousCtx(context.Background(), InstanceID)
+}
+
+// PreviousCtx is Previous with a context, to cancel or time out the call.
+func (client *AVTransport2) PreviousCtx(ctx context.Context, InstanceID uint32) (err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &AVTransport2PreviousRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -1434,7 +2205,7 @@ func (client *AVTransport2) Previous(InstanceID uint32) (err error) {
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_2, "Previous", request, response); err != nil {
+	if err = client.PerformAction(ctx, "Previous", request, response); err != nil {
 		return
 	}
 
@@ -1444,24 +2215,32 @@ func (client *AVTransport2) Previous(InstanceID uint32) (err error) {
 	return
 }
 
+// AVTransport2SetPlayModeRequest is the request of SetPlayMode, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport2SetPlayModeRequest struct {
+	InstanceID  string
+	NewPlayMode string
+}
+
+// SetPlayMode performs the SetPlayMode action of the service.
 //
 // Arguments:
-//
-// * NewPlayMode: allowed values: NORMAL
+//   - InstanceID (in, state variable A_ARG_TYPE_SetPlayMode_InstanceID)
+//   - NewPlayMode (in, state variable CurrentPlayMode): allowed values NORMAL
+func (client *AVTransport2) SetPlayMode(InstanceID uint32, NewPlayMode AVTransport2CurrentPlayMode) (err error) {
+	return client.SetPlayModeCtx(context.Background(), InstanceID, NewPlayMode)
+}
 
-func (client *AVTransport2) SetPlayMode(InstanceID uint32, NewPlayMode string) (err error) {
+// SetPlayModeCtx is SetPlayMode with a context, to cancel or time out the call.
+func (client *AVTransport2) SetPlayModeCtx(ctx context.Context, InstanceID uint32, NewPlayMode AVTransport2CurrentPlayMode) (err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-
-		NewPlayMode string
-	}{}
+	request := &AVTransport2SetPlayModeRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
 		return
 	}
-	if request.NewPlayMode, err = soap.MarshalString(NewPlayMode); err != nil {
+	if request.NewPlayMode, err = soap.MarshalString(string(NewPlayMode)); err != nil {
 		return
 	}
 	// END Marshal arguments into request.
@@ -1470,7 +2249,7 @@ func (client *AVTransport2) SetPlayMode(InstanceID uint32, NewPlayMode string) (
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_2, "SetPlayMode", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetPlayMode", request, response); err != nil {
 		return
 	}
 
@@ -1480,13 +2259,26 @@ func (client *AVTransport2) SetPlayMode(InstanceID uint32, NewPlayMode string) (
 	return
 }
 
+// AVTransport2SetRecordQualityModeRequest is the request of SetRecordQualityMode, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport2SetRecordQualityModeRequest struct {
+	InstanceID           string
+	NewRecordQualityMode string
+}
+
+// SetRecordQualityMode performs the SetRecordQualityMode action of the service.
+//
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_SetRecordQualityMode_InstanceID)
+//   - NewRecordQualityMode (in, state variable A_ARG_TYPE_SetRecordQualityMode_NewRecordQualityMode)
 func (client *AVTransport2) SetRecordQualityMode(InstanceID uint32, NewRecordQualityMode string) (err error) {
-	// Request structure.
-	request := &struct {
-		InstanceID string
+	return client.SetRecordQualityModeCtx(context.Background(), InstanceID, NewRecordQualityMode)
+}
 
-		NewRecordQualityMode string
-	}{}
+// SetRecordQualityModeCtx is SetRecordQualityMode with a context, to cancel or time out the call.
+func (client *AVTransport2) SetRecordQualityModeCtx(ctx context.Context, InstanceID uint32, NewRecordQualityMode string) (err error) {
+	// Request structure.
+	request := &AVTransport2SetRecordQualityModeRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -1501,7 +2293,7 @@ func (client *AVTransport2) SetRecordQualityMode(InstanceID uint32, NewRecordQua
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_2, "SetRecordQualityMode", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetRecordQualityMode", request, response); err != nil {
 		return
 	}
 
@@ -1511,11 +2303,33 @@ func (client *AVTransport2) SetRecordQualityMode(InstanceID uint32, NewRecordQua
 	return
 }
 
+// AVTransport2GetCurrentTransportActionsRequest is the request of GetCurrentTransportActions, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport2GetCurrentTransportActionsRequest struct {
+	InstanceID string
+}
+
+// AVTransport2GetCurrentTransportActionsResponse is the response of GetCurrentTransportActions, with each
+// argument in its SOAP string form.
+type AVTransport2GetCurrentTransportActionsResponse struct {
+	Actions string
+}
+
+// GetCurrentTransportActions performs the GetCurrentTransportActions action of the service.
+//
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetCurrentTransportActions_InstanceID)
+//
+// Return values:
+//   - Actions (out, state variable A_ARG_TYPE_GetCurrentTransportActions_Actions)
 func (client *AVTransport2) GetCurrentTransportActions(InstanceID uint32) (Actions string, err error) {
+	return client.GetCurrentTransportActionsCtx(context.Background(), InstanceID)
+}
+
+// GetCurrentTransportActionsCtx is GetCurrentTransportActions with a context, to cancel or time out the call.
+func (client *AVTransport2) GetCurrentTransportActionsCtx(ctx context.Context, InstanceID uint32) (Actions string, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &AVTransport2GetCurrentTransportActionsRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -1524,12 +2338,10 @@ func (client *AVTransport2) GetCurrentTransportActions(InstanceID uint32) (Actio
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		Actions string
-	}{}
+	response := &AVTransport2GetCurrentTransportActionsResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_2, "GetCurrentTransportActions", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetCurrentTransportActions", request, response); err != nil {
 		return
 	}
 
@@ -1542,15 +2354,33 @@ func (client *AVTransport2) GetCurrentTransportActions(InstanceID uint32) (Actio
 	return
 }
 
+// AVTransport2GetDRMStateRequest is the request of GetDRMState, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport2GetDRMStateRequest struct {
+	InstanceID string
+}
+
+// AVTransport2GetDRMStateResponse is the response of GetDRMState, with each
+// argument in its SOAP string form.
+type AVTransport2GetDRMStateResponse struct {
+	CurrentDRMState string
+}
+
+// GetDRMState performs the GetDRMState action of the service.
 //
-// Return values:
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetDRMState_InstanceID)
 //
-// * CurrentDRMState: allowed values: OK
-func (client *AVTransport2) GetDRMState(InstanceID uint32) (CurrentDRMState string, err error) {
+// Return values:
+//   - CurrentDRMState (out, state variable DRMState): allowed values OK
+func (client *AVTransport2) GetDRMState(InstanceID uint32) (CurrentDRMState AVTransport2DRMState, err error) {
+	return client.GetDRMStateCtx(context.Background(), InstanceID)
+}
+
+// GetDRMStateCtx is GetDRMState with a context, to cancel or time out the call.
+func (client *AVTransport2) GetDRMStateCtx(ctx context.Context, InstanceID uint32) (CurrentDRMState AVTransport2DRMState, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &AVTransport2GetDRMStateRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -1559,31 +2389,49 @@ func (client *AVTransport2) GetDRMState(InstanceID uint32) (CurrentDRMState stri
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentDRMState string
-	}{}
+	response := &AVTransport2GetDRMStateResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_2, "GetDRMState", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetDRMState", request, response); err != nil {
 		return
 	}
 
 	// BEGIN Unmarshal arguments from response.
 
-	if CurrentDRMState, err = soap.UnmarshalString(response.CurrentDRMState); err != nil {
-		return
-	}
+	CurrentDRMState = AVTransport2DRMState(response.CurrentDRMState)
 	// END Unmarshal arguments from response.
 	return
 }
 
+// AVTransport2GetStateVariablesRequest is the request of GetStateVariables, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport2GetStateVariablesRequest struct {
+	InstanceID        string
+	StateVariableList string
+}
+
+// AVTransport2GetStateVariablesResponse is the response of GetStateVariables, with each
+// argument in its SOAP string form.
+type AVTransport2GetStateVariablesResponse struct {
+	StateVariableValuePairs string
+}
+
+// GetStateVariables performs the GetStateVariables action of the service.
+//
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetStateVariables_InstanceID)
+//   - StateVariableList (in, state variable A_ARG_TYPE_GetStateVariables_StateVariableList)
+//
+// Return values:
+//   - StateVariableValuePairs (out, state variable A_ARG_TYPE_GetStateVariables_StateVariableValuePairs)
 func (client *AVTransport2) GetStateVariables(InstanceID uint32, StateVariableList string) (StateVariableValuePairs string, err error) {
-	// Request structure.
-	request := &struct {
-		InstanceID string
+	return client.GetStateVariablesCtx(context.Background(), InstanceID, StateVariableList)
+}
 
-		StateVariableList string
-	}{}
+// GetStateVariablesCtx is GetStateVariables with a context, to cancel or time out the call.
+func (client *AVTransport2) GetStateVariablesCtx(ctx context.Context, InstanceID uint32, StateVariableList string) (StateVariableValuePairs string, err error) {
+	// Request structure.
+	request := &AVTransport2GetStateVariablesRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -1595,12 +2443,10 @@ func (client *AVTransport2) GetStateVariables(InstanceID uint32, StateVariableLi
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		StateVariableValuePairs string
-	}{}
+	response := &AVTransport2GetStateVariablesResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_2, "GetStateVariables", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetStateVariables", request, response); err != nil {
 		return
 	}
 
@@ -1613,19 +2459,41 @@ func (client *AVTransport2) GetStateVariables(InstanceID uint32, StateVariableLi
 	return
 }
 
-func (client *AVTransport2) SetStateVariables(InstanceID uint32, AVTransportUDN string, ServiceType string, ServiceId string, StateVariableValuePairs string) (StateVariableList string, err error) {
-	// Request structure.
-	request := &struct {
-		InstanceID string
-
-		AVTransportUDN string
+// AVTransport2SetStateVariablesRequest is the request of SetStateVariables, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AVTransport2SetStateVariablesRequest struct {
+	InstanceID              string
+	AVTransportUDN          string
+	ServiceType             string
+	ServiceId               string
+	StateVariableValuePairs string
+}
 
-		ServiceType string
+// AVTransport2SetStateVariablesResponse is the response of SetStateVariables, with each
+// argument in its SOAP string form.
+type AVTransport2SetStateVariablesResponse struct {
+	StateVariableList string
+}
 
-		ServiceId string
+// SetStateVariables performs the SetStateVariables action of the service.
+//
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_SetStateVariables_InstanceID)
+//   - AVTransportUDN (in, state variable A_ARG_TYPE_SetStateVariables_AVTransportUDN)
+//   - ServiceType (in, state variable A_ARG_TYPE_SetStateVariables_ServiceType)
+//   - ServiceId (in, state variable A_ARG_TYPE_SetStateVariables_ServiceId)
+//   - StateVariableValuePairs (in, state variable A_ARG_TYPE_SetStateVariables_StateVariableValuePairs)
+//
+// Return values:
+//   - StateVariableList (out, state variable A_ARG_TYPE_SetStateVariables_StateVariableList)
+func (client *AVTransport2) SetStateVariables(InstanceID uint32, AVTransportUDN string, ServiceType string, ServiceId string, StateVariableValuePairs string) (StateVariableList string, err error) {
+	return client.SetStateVariablesCtx(context.Background(), InstanceID, AVTransportUDN, ServiceType, ServiceId, StateVariableValuePairs)
+}
 
-		StateVariableValuePairs string
-	}{}
+// SetStateVariablesCtx is SetStateVariables with a context, to cancel or time out the call.
+func (client *AVTransport2) SetStateVariablesCtx(ctx context.Context, InstanceID uint32, AVTransportUDN string, ServiceType string, ServiceId string, StateVariableValuePairs string) (StateVariableList string, err error) {
+	// Request structure.
+	request := &AVTransport2SetStateVariablesRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -1646,12 +2514,10 @@ func (client *AVTransport2) SetStateVariables(InstanceID uint32, AVTransportUDN
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		StateVariableList string
-	}{}
+	response := &AVTransport2SetStateVariablesResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_AVTransport_2, "SetStateVariables", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetStateVariables", request, response); err != nil {
 		return
 	}
 
@@ -1671,6 +2537,69 @@ type ConnectionManager1 struct {
 	goupnp.ServiceClient
 }
 
+// ConnectionManager1Client is the interface of the actions of ConnectionManager1, for
+// substituting fakes or mocks for the service in tests.
+type ConnectionManager1Client interface {
+	GetProtocolInfo() (Source string, Sink string, err error)
+	GetProtocolInfoCtx(ctx context.Context) (Source string, Sink string, err error)
+	PrepareForConnection(RemoteProtocolInfo string, PeerConnectionManager string, PeerConnectionID int32, Direction ConnectionManager1Direction) (ConnectionID int32, AVTransportID int32, RcsID int32, err error)
+	PrepareForConnectionCtx(ctx context.Context, RemoteProtocolInfo string, PeerConnectionManager string, PeerConnectionID int32, Direction ConnectionManager1Direction) (ConnectionID int32, AVTransportID int32, RcsID int32, err error)
+	ConnectionComplete(ConnectionID int32) (err error)
+	ConnectionCompleteCtx(ctx context.Context, ConnectionID int32) (err error)
+	GetCurrentConnectionIDs() (ConnectionIDs string, err error)
+	GetCurrentConnectionIDsCtx(ctx context.Context) (ConnectionIDs string, err error)
+	GetCurrentConnectionInfo(ConnectionID int32) (RcsID int32, AVTransportID int32, ProtocolInfo string, PeerConnectionManager string, PeerConnectionID int32, Direction ConnectionManager1Direction, Status ConnectionManager1ConnectionStatus, err error)
+	GetCurrentConnectionInfoCtx(ctx context.Context, ConnectionID int32) (RcsID int32, AVTransportID int32, ProtocolInfo string, PeerConnectionManager string, PeerConnectionID int32, Direction ConnectionManager1Direction, Status ConnectionManager1ConnectionStatus, err error)
+}
+
+var _ ConnectionManager1Client = new(ConnectionManager1)
+
+// ConnectionManager1Direction is a value of the state variable A_ARG_TYPE_Direction of
+// ConnectionManager1.
+type ConnectionManager1Direction string
+
+// Allowed values of ConnectionManager1Direction.
+const (
+	ConnectionManager1Direction_Input  ConnectionManager1Direction = "Input"
+	ConnectionManager1Direction_Output ConnectionManager1Direction = "Output"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v ConnectionManager1Direction) Valid() bool {
+	switch v {
+	case ConnectionManager1Direction_Input,
+		ConnectionManager1Direction_Output:
+		return true
+	}
+	return false
+}
+
+// ConnectionManager1ConnectionStatus is a value of the state variable A_ARG_TYPE_ConnectionStatus of
+// ConnectionManager1.
+type ConnectionManager1ConnectionStatus string
+
+// Allowed values of ConnectionManager1ConnectionStatus.
+const (
+	ConnectionManager1ConnectionStatus_OK                    ConnectionManager1ConnectionStatus = "OK"
+	ConnectionManager1ConnectionStatus_ContentFormatMismatch ConnectionManager1ConnectionStatus = "ContentFormatMismatch"
+	ConnectionManager1ConnectionStatus_InsufficientBandwidth ConnectionManager1ConnectionStatus = "InsufficientBandwidth"
+	ConnectionManager1ConnectionStatus_UnreliableChannel     ConnectionManager1ConnectionStatus = "UnreliableChannel"
+	ConnectionManager1ConnectionStatus_Unknown               ConnectionManager1ConnectionStatus = "Unknown"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v ConnectionManager1ConnectionStatus) Valid() bool {
+	switch v {
+	case ConnectionManager1ConnectionStatus_OK,
+		ConnectionManager1ConnectionStatus_ContentFormatMismatch,
+		ConnectionManager1ConnectionStatus_InsufficientBandwidth,
+		ConnectionManager1ConnectionStatus_UnreliableChannel,
+		ConnectionManager1ConnectionStatus_Unknown:
+		return true
+	}
+	return false
+}
+
 // NewConnectionManager1Clients discovers instances of the service on the network,
 // and returns clients to any that are found. errors will contain an error for
 // any devices that replied but which could not be queried, and err will be set
@@ -1724,7 +2653,42 @@ func newConnectionManager1ClientsFromGenericClients(genericClients []goupnp.Serv
 	return clients
 }
 
+// ConnectionManager1Timeouts are the default timeouts of the actions of
+// ConnectionManager1, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var ConnectionManager1Timeouts = map[string]time.Duration{
+	"": 10 * time.Second,
+}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *ConnectionManager1) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, ConnectionManager1Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_ConnectionManager_1, actionName, request, response)
+}
+
+// ConnectionManager1GetProtocolInfoResponse is the response of GetProtocolInfo, with each
+// argument in its SOAP string form.
+type ConnectionManager1GetProtocolInfoResponse struct {
+	Source string
+	Sink   string
+}
+
+// GetProtocolInfo performs the GetProtocolInfo action of the service.
+//
+// Return values:
+//   - Source (out, state variable A_ARG_TYPE_GetProtocolInfo_Source)
+//   - Sink (out, state variable A_ARG_TYPE_GetProtocolInfo_Sink)
 func (client *ConnectionManager1) GetProtocolInfo() (Source string, Sink string, err error) {
+	return client.GetProtocolInfoCtx(context.Background())
+}
+
+// GetProtocolInfoCtx is GetProtocolInfo with a context, to cancel or time out the call.
+func (client *ConnectionManager1) GetProtocolInfoCtx(ctx context.Context) (Source string, Sink string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -1732,14 +2696,10 @@ func (client *ConnectionManager1) GetProtocolInfo() (Source string, Sink string,
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		Source string
-
-		Sink string
-	}{}
+	response := &ConnectionManager1GetProtocolInfoResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ConnectionManager_1, "GetProtocolInfo", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetProtocolInfo", request, response); err != nil {
 		return
 	}
 
@@ -1755,22 +2715,43 @@ func (client *ConnectionManager1) GetProtocolInfo() (Source string, Sink string,
 	return
 }
 
+// ConnectionManager1PrepareForConnectionRequest is the request of PrepareForConnection, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ConnectionManager1PrepareForConnectionRequest struct {
+	RemoteProtocolInfo    string
+	PeerConnectionManager string
+	PeerConnectionID      string
+	Direction             string
+}
+
+// ConnectionManager1PrepareForConnectionResponse is the response of PrepareForConnection, with each
+// argument in its SOAP string form.
+type ConnectionManager1PrepareForConnectionResponse struct {
+	ConnectionID  string
+	AVTransportID string
+	RcsID         string
+}
+
+// PrepareForConnection performs the PrepareForConnection action of the service.
 //
 // Arguments:
+//   - RemoteProtocolInfo (in, state variable A_ARG_TYPE_PrepareForConnection_RemoteProtocolInfo)
+//   - PeerConnectionManager (in, state variable A_ARG_TYPE_PrepareForConnection_PeerConnectionManager)
+//   - PeerConnectionID (in, state variable A_ARG_TYPE_PrepareForConnection_PeerConnectionID)
+//   - Direction (in, state variable A_ARG_TYPE_Direction): allowed values Input, Output
 //
-// * Direction: allowed values: Input, Output
+// Return values:
+//   - ConnectionID (out, state variable A_ARG_TYPE_PrepareForConnection_ConnectionID)
+//   - AVTransportID (out, state variable A_ARG_TYPE_PrepareForConnection_AVTransportID)
+//   - RcsID (out, state variable A_ARG_TYPE_PrepareForConnection_RcsID)
+func (client *ConnectionManager1) PrepareForConnection(RemoteProtocolInfo string, PeerConnectionManager string, PeerConnectionID int32, Direction ConnectionManager1Direction) (ConnectionID int32, AVTransportID int32, RcsID int32, err error) {
+	return client.PrepareForConnectionCtx(context.Background(), RemoteProtocolInfo, PeerConnectionManager, PeerConnectionID, Direction)
+}
 
-func (client *ConnectionManager1) PrepareForConnection(RemoteProtocolInfo string, PeerConnectionManager string, PeerConnectionID int32, Direction string) (ConnectionID int32, AVTransportID int32, RcsID int32, err error) {
+// PrepareForConnectionCtx is PrepareForConnection with a context, to cancel or time out the call.
+func (client *ConnectionManager1) PrepareForConnectionCtx(ctx context.Context, RemoteProtocolInfo string, PeerConnectionManager string, PeerConnectionID int32, Direction ConnectionManager1Direction) (ConnectionID int32, AVTransportID int32, RcsID int32, err error) {
 	// Request structure.
-	request := &struct {
-		RemoteProtocolInfo string
-
-		PeerConnectionManager string
-
-		PeerConnectionID string
-
-		Direction string
-	}{}
+	request := &ConnectionManager1PrepareForConnectionRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.RemoteProtocolInfo, err = soap.MarshalString(RemoteProtocolInfo); err != nil {
@@ -1782,22 +2763,16 @@ func (client *ConnectionManager1) PrepareForConnection(RemoteProtocolInfo string
 	if request.PeerConnectionID, err = soap.MarshalI4(PeerConnectionID); err != nil {
 		return
 	}
-	if request.Direction, err = soap.MarshalString(Direction); err != nil {
+	if request.Direction, err = soap.MarshalString(string(Direction)); err != nil {
 		return
 	}
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		ConnectionID string
-
-		AVTransportID string
-
-		RcsID string
-	}{}
+	response := &ConnectionManager1PrepareForConnectionResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ConnectionManager_1, "PrepareForConnection", request, response); err != nil {
+	if err = client.PerformAction(ctx, "PrepareForConnection", request, response); err != nil {
 		return
 	}
 
@@ -1816,11 +2791,24 @@ func (client *ConnectionManager1) PrepareForConnection(RemoteProtocolInfo string
 	return
 }
 
+// ConnectionManager1ConnectionCompleteRequest is the request of ConnectionComplete, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ConnectionManager1ConnectionCompleteRequest struct {
+	ConnectionID string
+}
+
+// ConnectionComplete performs the ConnectionComplete action of the service.
+//
+// Arguments:
+//   - ConnectionID (in, state variable A_ARG_TYPE_ConnectionComplete_ConnectionID)
 func (client *ConnectionManager1) ConnectionComplete(ConnectionID int32) (err error) {
+	return client.ConnectionCompleteCtx(context.Background(), ConnectionID)
+}
+
+// ConnectionCompleteCtx is ConnectionComplete with a context, to cancel or time out the call.
+func (client *ConnectionManager1) ConnectionCompleteCtx(ctx context.Context, ConnectionID int32) (err error) {
 	// Request structure.
-	request := &struct {
-		ConnectionID string
-	}{}
+	request := &ConnectionManager1ConnectionCompleteRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.ConnectionID, err = soap.MarshalI4(ConnectionID); err != nil {
@@ -1832,7 +2820,7 @@ func (client *ConnectionManager1) ConnectionComplete(ConnectionID int32) (err er
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ConnectionManager_1, "ConnectionComplete", request, response); err != nil {
+	if err = client.PerformAction(ctx, "ConnectionComplete", request, response); err != nil {
 		return
 	}
 
@@ -1842,7 +2830,22 @@ func (client *ConnectionManager1) ConnectionComplete(ConnectionID int32) (err er
 	return
 }
 
+// ConnectionManager1GetCurrentConnectionIDsResponse is the response of GetCurrentConnectionIDs, with each
+// argument in its SOAP string form.
+type ConnectionManager1GetCurrentConnectionIDsResponse struct {
+	ConnectionIDs string
+}
+
+// GetCurrentConnectionIDs performs the GetCurrentConnectionIDs action of the service.
+//
+// Return values:
+//   - ConnectionIDs (out, state variable A_ARG_TYPE_GetCurrentConnectionIDs_ConnectionIDs)
 func (client *ConnectionManager1) GetCurrentConnectionIDs() (ConnectionIDs string, err error) {
+	return client.GetCurrentConnectionIDsCtx(context.Background())
+}
+
+// GetCurrentConnectionIDsCtx is GetCurrentConnectionIDs with a context, to cancel or time out the call.
+func (client *ConnectionManager1) GetCurrentConnectionIDsCtx(ctx context.Context) (ConnectionIDs string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -1850,12 +2853,10 @@ func (client *ConnectionManager1) GetCurrentConnectionIDs() (ConnectionIDs strin
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		ConnectionIDs string
-	}{}
+	response := &ConnectionManager1GetCurrentConnectionIDsResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ConnectionManager_1, "GetCurrentConnectionIDs", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetCurrentConnectionIDs", request, response); err != nil {
 		return
 	}
 
@@ -1868,17 +2869,45 @@ func (client *ConnectionManager1) GetCurrentConnectionIDs() (ConnectionIDs strin
 	return
 }
 
+// ConnectionManager1GetCurrentConnectionInfoRequest is the request of GetCurrentConnectionInfo, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ConnectionManager1GetCurrentConnectionInfoRequest struct {
+	ConnectionID string
+}
+
+// ConnectionManager1GetCurrentConnectionInfoResponse is the response of GetCurrentConnectionInfo, with each
+// argument in its SOAP string form.
+type ConnectionManager1GetCurrentConnectionInfoResponse struct {
+	RcsID                 string
+	AVTransportID         string
+	ProtocolInfo          string
+	PeerConnectionManager string
+	PeerConnectionID      string
+	Direction             string
+	Status                string
+}
+
+// GetCurrentConnectionInfo performs the GetCurrentConnectionInfo action of the service.
 //
-// Return values:
+// Arguments:
+//   - ConnectionID (in, state variable A_ARG_TYPE_GetCurrentConnectionInfo_ConnectionID)
 //
-// * Direction: allowed values: Input, Output
-//
-// * Status: allowed values: OK, ContentFormatMismatch, InsufficientBandwidth, UnreliableChannel, Unknown
-func (client *ConnectionManager1) GetCurrentConnectionInfo(ConnectionID int32) (RcsID int32, AVTransportID int32, ProtocolInfo string, PeerConnectionManager string, PeerConnectionID int32, Direction string, Status string, err error) {
+// Return values:
+//   - RcsID (out, state variable A_ARG_TYPE_GetCurrentConnectionInfo_RcsID)
+//   - AVTransportID (out, state variable A_ARG_TYPE_GetCurrentConnectionInfo_AVTransportID)
+//   - ProtocolInfo (out, state variable A_ARG_TYPE_GetCurrentConnectionInfo_ProtocolInfo)
+//   - PeerConnectionManager (out, state variable A_ARG_TYPE_GetCurrentConnectionInfo_PeerConnectionManager)
+//   - PeerConnectionID (out, state variable A_ARG_TYPE_GetCurrentConnectionInfo_PeerConnectionID)
+//   - Direction (out, state variable A_ARG_TYPE_Direction): allowed values Input, Output
+//   - Status (out, state variable A_ARG_TYPE_ConnectionStatus): allowed values OK, ContentFormatMismatch, InsufficientBandwidth, UnreliableChannel, Unknown
+func (client *ConnectionManager1) GetCurrentConnectionInfo(ConnectionID int32) (RcsID int32, AVTransportID int32, ProtocolInfo string, PeerConnectionManager string, PeerConnectionID int32, Direction ConnectionManager1Direction, Status ConnectionManager1ConnectionStatus, err error) {
+	return client.GetCurrentConnectionInfoCtx(context.Background(), ConnectionID)
+}
+
+// GetCurrentConnectionInfoCtx is GetCurrentConnectionInfo with a context, to cancel or time out the call.
+func (client *ConnectionManager1) GetCurrentConnectionInfoCtx(ctx context.Context, ConnectionID int32) (RcsID int32, AVTransportID int32, ProtocolInfo string, PeerConnectionManager string, PeerConnectionID int32, Direction ConnectionManager1Direction, Status ConnectionManager1ConnectionStatus, err error) {
 	// Request structure.
-	request := &struct {
-		ConnectionID string
-	}{}
+	request := &ConnectionManager1GetCurrentConnectionInfoRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.ConnectionID, err = soap.MarshalI4(ConnectionID); err != nil {
@@ -1887,24 +2916,10 @@ func (client *ConnectionManager1) GetCurrentConnectionInfo(ConnectionID int32) (
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		RcsID string
-
-		AVTransportID string
-
-		ProtocolInfo string
-
-		PeerConnectionManager string
-
-		PeerConnectionID string
-
-		Direction string
-
-		Status string
-	}{}
+	response := &ConnectionManager1GetCurrentConnectionInfoResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ConnectionManager_1, "GetCurrentConnectionInfo", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetCurrentConnectionInfo", request, response); err != nil {
 		return
 	}
 
@@ -1925,12 +2940,8 @@ func (client *ConnectionManager1) GetCurrentConnectionInfo(ConnectionID int32) (
 	if PeerConnectionID, err = soap.UnmarshalI4(response.PeerConnectionID); err != nil {
 		return
 	}
-	if Direction, err = soap.UnmarshalString(response.Direction); err != nil {
-		return
-	}
-	if Status, err = soap.UnmarshalString(response.Status); err != nil {
-		return
-	}
+	Direction = ConnectionManager1Direction(response.Direction)
+	Status = ConnectionManager1ConnectionStatus(response.Status)
 	// END Unmarshal arguments from response.
 	return
 }
@@ -1942,6 +2953,69 @@ type ConnectionManager2 struct {
 	goupnp.ServiceClient
 }
 
+// ConnectionManager2Client is the interface of the actions of ConnectionManager2, for
+// substituting fakes or mocks for the service in tests.
+type ConnectionManager2Client interface {
+	GetProtocolInfo() (Source string, Sink string, err error)
+	GetProtocolInfoCtx(ctx context.Context) (Source string, Sink string, err error)
+	PrepareForConnection(RemoteProtocolInfo string, PeerConnectionManager string, PeerConnectionID int32, Direction ConnectionManager2Direction) (ConnectionID int32, AVTransportID int32, RcsID int32, err error)
+	PrepareForConnectionCtx(ctx context.Context, RemoteProtocolInfo string, PeerConnectionManager string, PeerConnectionID int32, Direction ConnectionManager2Direction) (ConnectionID int32, AVTransportID int32, RcsID int32, err error)
+	ConnectionComplete(ConnectionID int32) (err error)
+	ConnectionCompleteCtx(ctx context.Context, ConnectionID int32) (err error)
+	GetCurrentConnectionIDs() (ConnectionIDs string, err error)
+	GetCurrentConnectionIDsCtx(ctx context.Context) (ConnectionIDs string, err error)
+	GetCurrentConnectionInfo(ConnectionID int32) (RcsID int32, AVTransportID int32, ProtocolInfo string, PeerConnectionManager string, PeerConnectionID int32, Direction ConnectionManager2Direction, Status ConnectionManager2ConnectionStatus, err error)
+	GetCurrentConnectionInfoCtx(ctx context.Context, ConnectionID int32) (RcsID int32, AVTransportID int32, ProtocolInfo string, PeerConnectionManager string, PeerConnectionID int32, Direction ConnectionManager2Direction, Status ConnectionManager2ConnectionStatus, err error)
+}
+
+var _ ConnectionManager2Client = new(ConnectionManager2)
+
+// ConnectionManager2Direction is a value of the state variable A_ARG_TYPE_Direction of
+// ConnectionManager2.
+type ConnectionManager2Direction string
+
+// Allowed values of ConnectionManager2Direction.
+const (
+	ConnectionManager2Direction_Input  ConnectionManager2Direction = "Input"
+	ConnectionManager2Direction_Output ConnectionManager2Direction = "Output"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v ConnectionManager2Direction) Valid() bool {
+	switch v {
+	case ConnectionManager2Direction_Input,
+		ConnectionManager2Direction_Output:
+		return true
+	}
+	return false
+}
+
+// ConnectionManager2ConnectionStatus is a value of the state variable A_ARG_TYPE_ConnectionStatus of
+// ConnectionManager2.
+type ConnectionManager2ConnectionStatus string
+
+// Allowed values of ConnectionManager2ConnectionStatus.
+const (
+	ConnectionManager2ConnectionStatus_OK                    ConnectionManager2ConnectionStatus = "OK"
+	ConnectionManager2ConnectionStatus_ContentFormatMismatch ConnectionManager2ConnectionStatus = "ContentFormatMismatch"
+	ConnectionManager2ConnectionStatus_InsufficientBandwidth ConnectionManager2ConnectionStatus = "InsufficientBandwidth"
+	ConnectionManager2ConnectionStatus_UnreliableChannel     ConnectionManager2ConnectionStatus = "UnreliableChannel"
+	ConnectionManager2ConnectionStatus_Unknown               ConnectionManager2ConnectionStatus = "Unknown"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v ConnectionManager2ConnectionStatus) Valid() bool {
+	switch v {
+	case ConnectionManager2ConnectionStatus_OK,
+		ConnectionManager2ConnectionStatus_ContentFormatMismatch,
+		ConnectionManager2ConnectionStatus_InsufficientBandwidth,
+		ConnectionManager2ConnectionStatus_UnreliableChannel,
+		ConnectionManager2ConnectionStatus_Unknown:
+		return true
+	}
+	return false
+}
+
 // NewConnectionManager2Clients discovers instances of the service on the network,
 // and returns clients to any that are found. errors will contain an error for
 // any devices that replied but which could not be queried, and err will be set
@@ -1995,7 +3069,42 @@ func newConnectionManager2ClientsFromGenericClients(genericClients []goupnp.Serv
 	return clients
 }
 
+// ConnectionManager2Timeouts are the default timeouts of the actions of
+// ConnectionManager2, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var ConnectionManager2Timeouts = map[string]time.Duration{
+	"": 10 * time.Second,
+}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *ConnectionManager2) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, ConnectionManager2Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_ConnectionManager_2, actionName, request, response)
+}
+
+// ConnectionManager2GetProtocolInfoResponse is the response of GetProtocolInfo, with each
+// argument in its SOAP string form.
+type ConnectionManager2GetProtocolInfoResponse struct {
+	Source string
+	Sink   string
+}
+
+// GetProtocolInfo performs the GetProtocolInfo action of the service.
+//
+// Return values:
+//   - Source (out, state variable A_ARG_TYPE_GetProtocolInfo_Source)
+//   - Sink (out, state variable A_ARG_TYPE_GetProtocolInfo_Sink)
 func (client *ConnectionManager2) GetProtocolInfo() (Source string, Sink string, err error) {
+	return client.GetProtocolInfoCtx(context.Background())
+}
+
+// GetProtocolInfoCtx is GetProtocolInfo with a context, to cancel or time out the call.
+func (client *ConnectionManager2) GetProtocolInfoCtx(ctx context.Context) (Source string, Sink string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -2003,14 +3112,10 @@ func (client *ConnectionManager2) GetProtocolInfo() (Source string, Sink string,
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		Source string
-
-		Sink string
-	}{}
+	response := &ConnectionManager2GetProtocolInfoResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ConnectionManager_2, "GetProtocolInfo", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetProtocolInfo", request, response); err != nil {
 		return
 	}
 
@@ -2026,22 +3131,43 @@ func (client *ConnectionManager2) GetProtocolInfo() (Source string, Sink string,
 	return
 }
 
+// ConnectionManager2PrepareForConnectionRequest is the request of PrepareForConnection, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ConnectionManager2PrepareForConnectionRequest struct {
+	RemoteProtocolInfo    string
+	PeerConnectionManager string
+	PeerConnectionID      string
+	Direction             string
+}
+
+// ConnectionManager2PrepareForConnectionResponse is the response of PrepareForConnection, with each
+// argument in its SOAP string form.
+type ConnectionManager2PrepareForConnectionResponse struct {
+	ConnectionID  string
+	AVTransportID string
+	RcsID         string
+}
+
+// PrepareForConnection performs the PrepareForConnection action of the service.
 //
 // Arguments:
+//   - RemoteProtocolInfo (in, state variable A_ARG_TYPE_PrepareForConnection_RemoteProtocolInfo)
+//   - PeerConnectionManager (in, state variable A_ARG_TYPE_PrepareForConnection_PeerConnectionManager)
+//   - PeerConnectionID (in, state variable A_ARG_TYPE_PrepareForConnection_PeerConnectionID)
+//   - Direction (in, state variable A_ARG_TYPE_Direction): allowed values Input, Output
 //
-// * Direction: allowed values: Input, Output
+// Return values:
+//   - ConnectionID (out, state variable A_ARG_TYPE_PrepareForConnection_ConnectionID)
+//   - AVTransportID (out, state variable A_ARG_TYPE_PrepareForConnection_AVTransportID)
+//   - RcsID (out, state variable A_ARG_TYPE_PrepareForConnection_RcsID)
+func (client *ConnectionManager2) PrepareForConnection(RemoteProtocolInfo string, PeerConnectionManager string, PeerConnectionID int32, Direction ConnectionManager2Direction) (ConnectionID int32, AVTransportID int32, RcsID int32, err error) {
+	return client.PrepareForConnectionCtx(context.Background(), RemoteProtocolInfo, PeerConnectionManager, PeerConnectionID, Direction)
+}
 
-func (client *ConnectionManager2) PrepareForConnection(RemoteProtocolInfo string, PeerConnectionManager string, PeerConnectionID int32, Direction string) (ConnectionID int32, AVTransportID int32, RcsID int32, err error) {
+// PrepareForConnectionCtx is PrepareForConnection with a context, to cancel or time out the call.
+func (client *ConnectionManager2) PrepareForConnectionCtx(ctx context.Context, RemoteProtocolInfo string, PeerConnectionManager string, PeerConnectionID int32, Direction ConnectionManager2Direction) (ConnectionID int32, AVTransportID int32, RcsID int32, err error) {
 	// Request structure.
-	request := &struct {
-		RemoteProtocolInfo string
-
-		PeerConnectionManager string
-
-		PeerConnectionID string
-
-		Direction string
-	}{}
+	request := &ConnectionManager2PrepareForConnectionRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.RemoteProtocolInfo, err = soap.MarshalString(RemoteProtocolInfo); err != nil {
@@ -2053,22 +3179,16 @@ func (client *ConnectionManager2) PrepareForConnection(RemoteProtocolInfo string
 	if request.PeerConnectionID, err = soap.MarshalI4(PeerConnectionID); err != nil {
 		return
 	}
-	if request.Direction, err = soap.MarshalString(Direction); err != nil {
+	if request.Direction, err = soap.MarshalString(string(Direction)); err != nil {
 		return
 	}
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		ConnectionID string
-
-		AVTransportID string
-
-		RcsID string
-	}{}
+	response := &ConnectionManager2PrepareForConnectionResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ConnectionManager_2, "PrepareForConnection", request, response); err != nil {
+	if err = client.PerformAction(ctx, "PrepareForConnection", request, response); err != nil {
 		return
 	}
 
@@ -2087,11 +3207,24 @@ func (client *ConnectionManager2) PrepareForConnection(RemoteProtocolInfo string
 	return
 }
 
+// ConnectionManager2ConnectionCompleteRequest is the request of ConnectionComplete, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ConnectionManager2ConnectionCompleteRequest struct {
+	ConnectionID string
+}
+
+// ConnectionComplete performs the ConnectionComplete action of the service.
+//
+// Arguments:
+//   - ConnectionID (in, state variable A_ARG_TYPE_ConnectionComplete_ConnectionID)
 func (client *ConnectionManager2) ConnectionComplete(ConnectionID int32) (err error) {
+	return client.ConnectionCompleteCtx(context.Background(), ConnectionID)
+}
+
+// ConnectionCompleteCtx is ConnectionComplete with a context, to cancel or time out the call.
+func (client *ConnectionManager2) ConnectionCompleteCtx(ctx context.Context, ConnectionID int32) (err error) {
 	// Request structure.
-	request := &struct {
-		ConnectionID string
-	}{}
+	request := &ConnectionManager2ConnectionCompleteRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.ConnectionID, err = soap.MarshalI4(ConnectionID); err != nil {
@@ -2103,7 +3236,7 @@ func (client *ConnectionManager2) ConnectionComplete(ConnectionID int32) (err er
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ConnectionManager_2, "ConnectionComplete", request, response); err != nil {
+	if err = client.PerformAction(ctx, "ConnectionComplete", request, response); err != nil {
 		return
 	}
 
@@ -2113,7 +3246,22 @@ func (client *ConnectionManager2) ConnectionComplete(ConnectionID int32) (err er
 	return
 }
 
+// ConnectionManager2GetCurrentConnectionIDsResponse is the response of GetCurrentConnectionIDs, with each
+// argument in its SOAP string form.
+type ConnectionManager2GetCurrentConnectionIDsResponse struct {
+	ConnectionIDs string
+}
+
+// GetCurrentConnectionIDs performs the GetCurrentConnectionIDs action of the service.
+//
+// Return values:
+//   - ConnectionIDs (out, state variable A_ARG_TYPE_GetCurrentConnectionIDs_ConnectionIDs)
 func (client *ConnectionManager2) GetCurrentConnectionIDs() (ConnectionIDs string, err error) {
+	return client.GetCurrentConnectionIDsCtx(context.Background())
+}
+
+// GetCurrentConnectionIDsCtx is GetCurrentConnectionIDs with a context, to cancel or time out the call.
+func (client *ConnectionManager2) GetCurrentConnectionIDsCtx(ctx context.Context) (ConnectionIDs string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -2121,12 +3269,10 @@ func (client *ConnectionManager2) GetCurrentConnectionIDs() (ConnectionIDs strin
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		ConnectionIDs string
-	}{}
+	response := &ConnectionManager2GetCurrentConnectionIDsResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ConnectionManager_2, "GetCurrentConnectionIDs", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetCurrentConnectionIDs", request, response); err != nil {
 		return
 	}
 
@@ -2139,17 +3285,45 @@ func (client *ConnectionManager2) GetCurrentConnectionIDs() (ConnectionIDs strin
 	return
 }
 
+// ConnectionManager2GetCurrentConnectionInfoRequest is the request of GetCurrentConnectionInfo, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ConnectionManager2GetCurrentConnectionInfoRequest struct {
+	ConnectionID string
+}
+
+// ConnectionManager2GetCurrentConnectionInfoResponse is the response of GetCurrentConnectionInfo, with each
+// argument in its SOAP string form.
+type ConnectionManager2GetCurrentConnectionInfoResponse struct {
+	RcsID                 string
+	AVTransportID         string
+	ProtocolInfo          string
+	PeerConnectionManager string
+	PeerConnectionID      string
+	Direction             string
+	Status                string
+}
+
+// GetCurrentConnectionInfo performs the GetCurrentConnectionInfo action of the service.
 //
-// Return values:
-//
-// * Direction: allowed values: Input, Output
+// Arguments:
+//   - ConnectionID (in, state variable A_ARG_TYPE_GetCurrentConnectionInfo_ConnectionID)
 //
-// * Status: allowed values: OK, ContentFormatMismatch, InsufficientBandwidth, UnreliableChannel, Unknown
-func (client *ConnectionManager2) GetCurrentConnectionInfo(ConnectionID int32) (RcsID int32, AVTransportID int32, ProtocolInfo string, PeerConnectionManager string, PeerConnectionID int32, Direction string, Status string, err error) {
+// Return values:
+//   - RcsID (out, state variable A_ARG_TYPE_GetCurrentConnectionInfo_RcsID)
+//   - AVTransportID (out, state variable A_ARG_TYPE_GetCurrentConnectionInfo_AVTransportID)
+//   - ProtocolInfo (out, state variable A_ARG_TYPE_GetCurrentConnectionInfo_ProtocolInfo)
+//   - PeerConnectionManager (out, state variable A_ARG_TYPE_GetCurrentConnectionInfo_PeerConnectionManager)
+//   - PeerConnectionID (out, state variable A_ARG_TYPE_GetCurrentConnectionInfo_PeerConnectionID)
+//   - Direction (out, state variable A_ARG_TYPE_Direction): allowed values Input, Output
+//   - Status (out, state variable A_ARG_TYPE_ConnectionStatus): allowed values OK, ContentFormatMismatch, InsufficientBandwidth, UnreliableChannel, Unknown
+func (client *ConnectionManager2) GetCurrentConnectionInfo(ConnectionID int32) (RcsID int32, AVTransportID int32, ProtocolInfo string, PeerConnectionManager string, PeerConnectionID int32, Direction ConnectionManager2Direction, Status ConnectionManager2ConnectionStatus, err error) {
+	return client.GetCurrentConnectionInfoCtx(context.Background(), ConnectionID)
+}
+
+// GetCurrentConnectionInfoCtx is GetCurrentConnectionInfo with a context, to cancel or time out the call.
+func (client *ConnectionManager2) GetCurrentConnectionInfoCtx(ctx context.Context, ConnectionID int32) (RcsID int32, AVTransportID int32, ProtocolInfo string, PeerConnectionManager string, PeerConnectionID int32, Direction ConnectionManager2Direction, Status ConnectionManager2ConnectionStatus, err error) {
 	// Request structure.
-	request := &struct {
-		ConnectionID string
-	}{}
+	request := &ConnectionManager2GetCurrentConnectionInfoRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.ConnectionID, err = soap.MarshalI4(ConnectionID); err != nil {
@@ -2158,24 +3332,10 @@ func (client *ConnectionManager2) GetCurrentConnectionInfo(ConnectionID int32) (
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		RcsID string
-
-		AVTransportID string
-
-		ProtocolInfo string
-
-		PeerConnectionManager string
-
-		PeerConnectionID string
-
-		Direction string
-
-		Status string
-	}{}
+	response := &ConnectionManager2GetCurrentConnectionInfoResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ConnectionManager_2, "GetCurrentConnectionInfo", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetCurrentConnectionInfo", request, response); err != nil {
 		return
 	}
 
@@ -2196,12 +3356,8 @@ func (client *ConnectionManager2) GetCurrentConnectionInfo(ConnectionID int32) (
 	if PeerConnectionID, err = soap.UnmarshalI4(response.PeerConnectionID); err != nil {
 		return
 	}
-	if Direction, err = soap.UnmarshalString(response.Direction); err != nil {
-		return
-	}
-	if Status, err = soap.UnmarshalString(response.Status); err != nil {
-		return
-	}
+	Direction = ConnectionManager2Direction(response.Direction)
+	Status = ConnectionManager2ConnectionStatus(response.Status)
 	// END Unmarshal arguments from response.
 	return
 }
@@ -2213,6 +3369,85 @@ type ContentDirectory1 struct {
 	goupnp.ServiceClient
 }
 
+// ContentDirectory1Client is the interface of the actions of ContentDirectory1, for
+// substituting fakes or mocks for the service in tests.
+type ContentDirectory1Client interface {
+	GetSearchCapabilities() (SearchCaps string, err error)
+	GetSearchCapabilitiesCtx(ctx context.Context) (SearchCaps string, err error)
+	GetSortCapabilities() (SortCaps string, err error)
+	GetSortCapabilitiesCtx(ctx context.Context) (SortCaps string, err error)
+	GetSystemUpdateID() (Id uint32, err error)
+	GetSystemUpdateIDCtx(ctx context.Context) (Id uint32, err error)
+	Browse(ObjectID string, BrowseFlag ContentDirectory1BrowseFlag, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error)
+	BrowseCtx(ctx context.Context, ObjectID string, BrowseFlag ContentDirectory1BrowseFlag, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error)
+	Search(ContainerID string, SearchCriteria string, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error)
+	SearchCtx(ctx context.Context, ContainerID string, SearchCriteria string, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error)
+	CreateObject(ContainerID string, Elements string) (ObjectID string, Result string, err error)
+	CreateObjectCtx(ctx context.Context, ContainerID string, Elements string) (ObjectID string, Result string, err error)
+	DestroyObject(ObjectID string) (err error)
+	DestroyObjectCtx(ctx context.Context, ObjectID string) (err error)
+	UpdateObject(ObjectID string, CurrentTagValue string, NewTagValue string) (err error)
+	UpdateObjectCtx(ctx context.Context, ObjectID string, CurrentTagValue string, NewTagValue string) (err error)
+	ImportResource(SourceURI *url.URL, DestinationURI *url.URL) (TransferID uint32, err error)
+	ImportResourceCtx(ctx context.Context, SourceURI *url.URL, DestinationURI *url.URL) (TransferID uint32, err error)
+	ExportResource(SourceURI *url.URL, DestinationURI *url.URL) (TransferID uint32, err error)
+	ExportResourceCtx(ctx context.Context, SourceURI *url.URL, DestinationURI *url.URL) (TransferID uint32, err error)
+	StopTransferResource(TransferID uint32) (err error)
+	StopTransferResourceCtx(ctx context.Context, TransferID uint32) (err error)
+	GetTransferProgress(TransferID uint32) (TransferStatus ContentDirectory1TransferStatus, TransferLength string, TransferTotal string, err error)
+	GetTransferProgressCtx(ctx context.Context, TransferID uint32) (TransferStatus ContentDirectory1TransferStatus, TransferLength string, TransferTotal string, err error)
+	DeleteResource(ResourceURI *url.URL) (err error)
+	DeleteResourceCtx(ctx context.Context, ResourceURI *url.URL) (err error)
+	CreateReference(ContainerID string, ObjectID string) (NewID string, err error)
+	CreateReferenceCtx(ctx context.Context, ContainerID string, ObjectID string) (NewID string, err error)
+}
+
+var _ ContentDirectory1Client = new(ContentDirectory1)
+
+// ContentDirectory1BrowseFlag is a value of the state variable A_ARG_TYPE_BrowseFlag of
+// ContentDirectory1.
+type ContentDirectory1BrowseFlag string
+
+// Allowed values of ContentDirectory1BrowseFlag.
+const (
+	ContentDirectory1BrowseFlag_BrowseMetadata       ContentDirectory1BrowseFlag = "BrowseMetadata"
+	ContentDirectory1BrowseFlag_BrowseDirectChildren ContentDirectory1BrowseFlag = "BrowseDirectChildren"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v ContentDirectory1BrowseFlag) Valid() bool {
+	switch v {
+	case ContentDirectory1BrowseFlag_BrowseMetadata,
+		ContentDirectory1BrowseFlag_BrowseDirectChildren:
+		return true
+	}
+	return false
+}
+
+// ContentDirectory1TransferStatus is a value of the state variable A_ARG_TYPE_TransferStatus of
+// ContentDirectory1.
+type ContentDirectory1TransferStatus string
+
+// Allowed values of ContentDirectory1TransferStatus.
+const (
+	ContentDirectory1TransferStatus_COMPLETED   ContentDirectory1TransferStatus = "COMPLETED"
+	ContentDirectory1TransferStatus_ERROR       ContentDirectory1TransferStatus = "ERROR"
+	ContentDirectory1TransferStatus_IN_PROGRESS ContentDirectory1TransferStatus = "IN_PROGRESS"
+	ContentDirectory1TransferStatus_STOPPED     ContentDirectory1TransferStatus = "STOPPED"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v ContentDirectory1TransferStatus) Valid() bool {
+	switch v {
+	case ContentDirectory1TransferStatus_COMPLETED,
+		ContentDirectory1TransferStatus_ERROR,
+		ContentDirectory1TransferStatus_IN_PROGRESS,
+		ContentDirectory1TransferStatus_STOPPED:
+		return true
+	}
+	return false
+}
+
 // NewContentDirectory1Clients discovers instances of the service on the network,
 // and returns clients to any that are found. errors will contain an error for
 // any devices that replied but which could not be queried, and err will be set
@@ -2266,7 +3501,42 @@ func newContentDirectory1ClientsFromGenericClients(genericClients []goupnp.Servi
 	return clients
 }
 
+// ContentDirectory1Timeouts are the default timeouts of the actions of
+// ContentDirectory1, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var ContentDirectory1Timeouts = map[string]time.Duration{
+	"":       10 * time.Second,
+	"Browse": 1 * time.Minute,
+	"Search": 2 * time.Minute,
+}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *ContentDirectory1) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, ContentDirectory1Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_ContentDirectory_1, actionName, request, response)
+}
+
+// ContentDirectory1GetSearchCapabilitiesResponse is the response of GetSearchCapabilities, with each
+// argument in its SOAP string form.
+type ContentDirectory1GetSearchCapabilitiesResponse struct {
+	SearchCaps string
+}
+
+// GetSearchCapabilities performs the GetSearchCapabilities action of the service.
+//
+// Return values:
+//   - SearchCaps (out, state variable A_ARG_TYPE_GetSearchCapabilities_SearchCaps)
 func (client *ContentDirectory1) GetSearchCapabilities() (SearchCaps string, err error) {
+	return client.GetSearchCapabilitiesCtx(context.Background())
+}
+
+// GetSearchCapabilitiesCtx is GetSearchCapabilities with a context, to cancel or time out the call.
+func (client *ContentDirectory1) GetSearchCapabilitiesCtx(ctx context.Context) (SearchCaps string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -2274,12 +3544,10 @@ func (client *ContentDirectory1) GetSearchCapabilities() (SearchCaps string, err
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		SearchCaps string
-	}{}
+	response := &ContentDirectory1GetSearchCapabilitiesResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_1, "GetSearchCapabilities", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetSearchCapabilities", request, response); err != nil {
 		return
 	}
 
@@ -2292,7 +3560,22 @@ func (client *ContentDirectory1) GetSearchCapabilities() (SearchCaps string, err
 	return
 }
 
+// ContentDirectory1GetSortCapabilitiesResponse is the response of GetSortCapabilities, with each
+// argument in its SOAP string form.
+type ContentDirectory1GetSortCapabilitiesResponse struct {
+	SortCaps string
+}
+
+// GetSortCapabilities performs the GetSortCapabilities action of the service.
+//
+// Return values:
+//   - SortCaps (out, state variable A_ARG_TYPE_GetSortCapabilities_SortCaps)
 func (client *ContentDirectory1) GetSortCapabilities() (SortCaps string, err error) {
+	return client.GetSortCapabilitiesCtx(context.Background())
+}
+
+// GetSortCapabilitiesCtx is GetSortCapabilities with a context, to cancel or time out the call.
+func (client *ContentDirectory1) GetSortCapabilitiesCtx(ctx context.Context) (SortCaps string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -2300,12 +3583,10 @@ func (client *ContentDirectory1) GetSortCapabilities() (SortCaps string, err err
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		SortCaps string
-	}{}
+	response := &ContentDirectory1GetSortCapabilitiesResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_1, "GetSortCapabilities", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetSortCapabilities", request, response); err != nil {
 		return
 	}
 
@@ -2318,7 +3599,22 @@ func (client *ContentDirectory1) GetSortCapabilities() (SortCaps string, err err
 	return
 }
 
+// ContentDirectory1GetSystemUpdateIDResponse is the response of GetSystemUpdateID, with each
+// argument in its SOAP string form.
+type ContentDirectory1GetSystemUpdateIDResponse struct {
+	Id string
+}
+
+// GetSystemUpdateID performs the GetSystemUpdateID action of the service.
+//
+// Return values:
+//   - Id (out, state variable A_ARG_TYPE_GetSystemUpdateID_Id)
 func (client *ContentDirectory1) GetSystemUpdateID() (Id uint32, err error) {
+	return client.GetSystemUpdateIDCtx(context.Background())
+}
+
+// GetSystemUpdateIDCtx is GetSystemUpdateID with a context, to cancel or time out the call.
+func (client *ContentDirectory1) GetSystemUpdateIDCtx(ctx context.Context) (Id uint32, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -2326,12 +3622,10 @@ func (client *ContentDirectory1) GetSystemUpdateID() (Id uint32, err error) {
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		Id string
-	}{}
+	response := &ContentDirectory1GetSystemUpdateIDResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_1, "GetSystemUpdateID", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetSystemUpdateID", request, response); err != nil {
 		return
 	}
 
@@ -2344,32 +3638,55 @@ func (client *ContentDirectory1) GetSystemUpdateID() (Id uint32, err error) {
 	return
 }
 
+// ContentDirectory1BrowseRequest is the request of Browse, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentDirectory1BrowseRequest struct {
+	ObjectID       string
+	BrowseFlag     string
+	Filter         string
+	StartingIndex  string
+	RequestedCount string
+	SortCriteria   string
+}
+
+// ContentDirectory1BrowseResponse is the response of Browse, with each
+// argument in its SOAP string form.
+type ContentDirectory1BrowseResponse struct {
+	Result         string
+	NumberReturned string
+	TotalMatches   string
+	UpdateID       string
+}
+
+// Browse performs the Browse action of the service.
 //
 // Arguments:
+//   - ObjectID (in, state variable A_ARG_TYPE_Browse_ObjectID)
+//   - BrowseFlag (in, state variable A_ARG_TYPE_BrowseFlag): allowed values BrowseMetadata, BrowseDirectChildren
+//   - Filter (in, state variable A_ARG_TYPE_Browse_Filter)
+//   - StartingIndex (in, state variable A_ARG_TYPE_Browse_StartingIndex)
+//   - RequestedCount (in, state variable A_ARG_TYPE_Browse_RequestedCount)
+//   - SortCriteria (in, state variable A_ARG_TYPE_Browse_SortCriteria)
 //
-// * BrowseFlag: allowed values: BrowseMetadata, BrowseDirectChildren
+// Return values:
+//   - Result (out, state variable A_ARG_TYPE_Browse_Result)
+//   - NumberReturned (out, state variable A_ARG_TYPE_Browse_NumberReturned)
+//   - TotalMatches (out, state variable A_ARG_TYPE_Browse_TotalMatches)
+//   - UpdateID (out, state variable A_ARG_TYPE_Browse_UpdateID)
+func (client *ContentDirectory1) Browse(ObjectID string, BrowseFlag ContentDirectory1BrowseFlag, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error) {
+	return client.BrowseCtx(context.Background(), ObjectID, BrowseFlag, Filter, StartingIndex, RequestedCount, SortCriteria)
+}
 
-func (client *ContentDirectory1) Browse(ObjectID string, BrowseFlag string, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error) {
+// BrowseCtx is Browse with a context, to cancel or time out the call.
+func (client *ContentDirectory1) BrowseCtx(ctx context.Context, ObjectID string, BrowseFlag ContentDirectory1BrowseFlag, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error) {
 	// Request structure.
-	request := &struct {
-		ObjectID string
-
-		BrowseFlag string
-
-		Filter string
-
-		StartingIndex string
-
-		RequestedCount string
-
-		SortCriteria string
-	}{}
+	request := &ContentDirectory1BrowseRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.ObjectID, err = soap.MarshalString(ObjectID); err != nil {
 		return
 	}
-	if request.BrowseFlag, err = soap.MarshalString(BrowseFlag); err != nil {
+	if request.BrowseFlag, err = soap.MarshalString(string(BrowseFlag)); err != nil {
 		return
 	}
 	if request.Filter, err = soap.MarshalString(Filter); err != nil {
@@ -2387,18 +3704,10 @@ func (client *ContentDirectory1) Browse(ObjectID string, BrowseFlag string, Filt
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		Result string
-
-		NumberReturned string
-
-		TotalMatches string
-
-		UpdateID string
-	}{}
+	response := &ContentDirectory1BrowseResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_1, "Browse", request, response); err != nil {
+	if err = client.PerformAction(ctx, "Browse", request, response); err != nil {
 		return
 	}
 
@@ -2420,21 +3729,49 @@ func (client *ContentDirectory1) Browse(ObjectID string, BrowseFlag string, Filt
 	return
 }
 
-func (client *ContentDirectory1) Search(ContainerID string, SearchCriteria string, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error) {
-	// Request structure.
-	request := &struct {
-		ContainerID string
-
-		SearchCriteria string
-
-		Filter string
+// ContentDirectory1SearchRequest is the request of Search, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentDirectory1SearchRequest struct {
+	ContainerID    string
+	SearchCriteria string
+	Filter         string
+	StartingIndex  string
+	RequestedCount string
+	SortCriteria   string
+}
 
-		StartingIndex string
+// ContentDirectory1SearchResponse is the response of Search, with each
+// argument in its SOAP string form.
+type ContentDirectory1SearchResponse struct {
+	Result         string
+	NumberReturned string
+	TotalMatches   string
+	UpdateID       string
+}
 
-		RequestedCount string
+// Search performs the Search action of the service.
+//
+// Arguments:
+//   - ContainerID (in, state variable A_ARG_TYPE_Search_ContainerID)
+//   - SearchCriteria (in, state variable A_ARG_TYPE_Search_SearchCriteria)
+//   - Filter (in, state variable A_ARG_TYPE_Search_Filter)
+//   - StartingIndex (in, state variable A_ARG_TYPE_Search_StartingIndex)
+//   - RequestedCount (in, state variable A_ARG_TYPE_Search_RequestedCount)
+//   - SortCriteria (in, state variable A_ARG_TYPE_Search_SortCriteria)
+//
+// Return values:
+//   - Result (out, state variable A_ARG_TYPE_Search_Result)
+//   - NumberReturned (out, state variable A_ARG_TYPE_Search_NumberReturned)
+//   - TotalMatches (out, state variable A_ARG_TYPE_Search_TotalMatches)
+//   - UpdateID (out, state variable A_ARG_TYPE_Search_UpdateID)
+func (client *ContentDirectory1) Search(ContainerID string, SearchCriteria string, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error) {
+	return client.SearchCtx(context.Background(), ContainerID, SearchCriteria, Filter, StartingIndex, RequestedCount, SortCriteria)
+}
 
-		SortCriteria string
-	}{}
+// SearchCtx is Search with a context, to cancel or time out the call.
+func (client *ContentDirectory1) SearchCtx(ctx context.Context, ContainerID string, SearchCriteria string, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error) {
+	// Request structure.
+	request := &ContentDirectory1SearchRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.ContainerID, err = soap.MarshalString(ContainerID); err != nil {
@@ -2458,18 +3795,10 @@ func (client *ContentDirectory1) Search(ContainerID string, SearchCriteria strin
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		Result string
-
-		NumberReturned string
-
-		TotalMatches string
-
-		UpdateID string
-	}{}
+	response := &ContentDirectory1SearchResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_1, "Search", request, response); err != nil {
+	if err = client.PerformAction(ctx, "Search", request, response); err != nil {
 		return
 	}
 
@@ -2491,13 +3820,37 @@ func (client *ContentDirectory1) Search(ContainerID string, SearchCriteria strin
 	return
 }
 
+// ContentDirectory1CreateObjectRequest is the request of CreateObject, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentDirectory1CreateObjectRequest struct {
+	ContainerID string
+	Elements    string
+}
+
+// ContentDirectory1CreateObjectResponse is the response of CreateObject, with each
+// argument in its SOAP string form.
+type ContentDirectory1CreateObjectResponse struct {
+	ObjectID string
+	Result   string
+}
+
+// CreateObject performs the CreateObject action of the service.
+//
+// Arguments:
+//   - ContainerID (in, state variable A_ARG_TYPE_CreateObject_ContainerID)
+//   - Elements (in, state variable A_ARG_TYPE_CreateObject_Elements)
+//
+// Return values:
+//   - ObjectID (out, state variable A_ARG_TYPE_CreateObject_ObjectID)
+//   - Result (out, state variable A_ARG_TYPE_CreateObject_Result)
 func (client *ContentDirectory1) CreateObject(ContainerID string, Elements string) (ObjectID string, Result string, err error) {
-	// Request structure.
-	request := &struct {
-		ContainerID string
+	return client.CreateObjectCtx(context.Background(), ContainerID, Elements)
+}
 
-		Elements string
-	}{}
+// CreateObjectCtx is CreateObject with a context, to cancel or time out the call.
+func (client *ContentDirectory1) CreateObjectCtx(ctx context.Context, ContainerID string, Elements string) (ObjectID string, Result string, err error) {
+	// Request structure.
+	request := &ContentDirectory1CreateObjectRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.ContainerID, err = soap.MarshalString(ContainerID); err != nil {
@@ -2509,14 +3862,10 @@ func (client *ContentDirectory1) CreateObject(ContainerID string, Elements strin
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		ObjectID string
-
-		Result string
-	}{}
+	response := &ContentDirectory1CreateObjectResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_1, "CreateObject", request, response); err != nil {
+	if err = client.PerformAction(ctx, "CreateObject", request, response); err != nil {
 		return
 	}
 
@@ -2532,11 +3881,24 @@ func (client *ContentDirectory1) CreateObject(ContainerID string, Elements strin
 	return
 }
 
+// ContentDirectory1DestroyObjectRequest is the request of DestroyObject, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentDirectory1DestroyObjectRequest struct {
+	ObjectID string
+}
+
+// DestroyObject performs the DestroyObject action of the service.
+//
+// Arguments:
+//   - ObjectID (in, state variable A_ARG_TYPE_DestroyObject_ObjectID)
 func (client *ContentDirectory1) DestroyObject(ObjectID string) (err error) {
+	return client.DestroyObjectCtx(context.Background(), ObjectID)
+}
+
+// DestroyObjectCtx is DestroyObject with a context, to cancel or time out the call.
+func (client *ContentDirectory1) DestroyObjectCtx(ctx context.Context, ObjectID string) (err error) {
 	// Request structure.
-	request := &struct {
-		ObjectID string
-	}{}
+	request := &ContentDirectory1DestroyObjectRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.ObjectID, err = soap.MarshalString(ObjectID); err != nil {
@@ -2548,7 +3910,7 @@ func (client *ContentDirectory1) DestroyObject(ObjectID string) (err error) {
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_1, "DestroyObject", request, response); err != nil {
+	if err = client.PerformAction(ctx, "DestroyObject", request, response); err != nil {
 		return
 	}
 
@@ -2558,15 +3920,28 @@ func (client *ContentDirectory1) DestroyObject(ObjectID string) (err error) {
 	return
 }
 
-func (client *ContentDirectory1) UpdateObject(ObjectID string, CurrentTagValue string, NewTagValue string) (err error) {
-	// Request structure.
-	request := &struct {
-		ObjectID string
+// ContentDirectory1UpdateObjectRequest is the request of UpdateObject, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentDirectory1UpdateObjectRequest struct {
+	ObjectID        string
+	CurrentTagValue string
+	NewTagValue     string
+}
 
-		CurrentTagValue string
+// UpdateObject performs the UpdateObject action of the service.
+//
+// Arguments:
+//   - ObjectID (in, state variable A_ARG_TYPE_UpdateObject_ObjectID)
+//   - CurrentTagValue (in, state variable A_ARG_TYPE_UpdateObject_CurrentTagValue)
+//   - NewTagValue (in, state variable A_ARG_TYPE_UpdateObject_NewTagValue)
+func (client *ContentDirectory1) UpdateObject(ObjectID string, CurrentTagValue string, NewTagValue string) (err error) {
+	return client.UpdateObjectCtx(context.Background(), ObjectID, CurrentTagValue, NewTagValue)
+}
 
-		NewTagValue string
-	}{}
+// UpdateObjectCtx is UpdateObject with a context, to cancel or time out the call.
+func (client *ContentDirectory1) UpdateObjectCtx(ctx context.Context, ObjectID string, CurrentTagValue string, NewTagValue string) (err error) {
+	// Request structure.
+	request := &ContentDirectory1UpdateObjectRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.ObjectID, err = soap.MarshalString(ObjectID); err != nil {
@@ -2584,7 +3959,7 @@ func (client *ContentDirectory1) UpdateObject(ObjectID string, CurrentTagValue s
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_1, "UpdateObject", request, response); err != nil {
+	if err = client.PerformAction(ctx, "UpdateObject", request, response); err != nil {
 		return
 	}
 
@@ -2594,13 +3969,35 @@ func (client *ContentDirectory1) UpdateObject(ObjectID string, CurrentTagValue s
 	return
 }
 
+// ContentDirectory1ImportResourceRequest is the request of ImportResource, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentDirectory1ImportResourceRequest struct {
+	SourceURI      string
+	DestinationURI string
+}
+
+// ContentDirectory1ImportResourceResponse is the response of ImportResource, with each
+// argument in its SOAP string form.
+type ContentDirectory1ImportResourceResponse struct {
+	TransferID string
+}
+
+// ImportResource performs the ImportResource action of the service.
+//
+// Arguments:
+//   - SourceURI (in, state variable A_ARG_TYPE_ImportResource_SourceURI)
+//   - DestinationURI (in, state variable A_ARG_TYPE_ImportResource_DestinationURI)
+//
+// Return values:
+//   - TransferID (out, state variable A_ARG_TYPE_ImportResource_TransferID)
 func (client *ContentDirectory1) ImportResource(SourceURI *url.URL, DestinationURI *url.URL) (TransferID uint32, err error) {
-	// Request structure.
-	request := &struct {
-		SourceURI string
+	return client.ImportResourceCtx(context.Background(), SourceURI, DestinationURI)
+}
 
-		DestinationURI string
-	}{}
+// ImportResourceCtx is ImportResource with a context, to cancel or time out the call.
+func (client *ContentDirectory1) ImportResourceCtx(ctx context.Context, SourceURI *url.URL, DestinationURI *url.URL) (TransferID uint32, err error) {
+	// Request structure.
+	request := &ContentDirectory1ImportResourceRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.SourceURI, err = soap.MarshalURI(SourceURI); err != nil {
@@ -2612,12 +4009,10 @@ func (client *ContentDirectory1) ImportResource(SourceURI *url.URL, DestinationU
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		TransferID string
-	}{}
+	response := &ContentDirectory1ImportResourceResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_1, "ImportResource", request, response); err != nil {
+	if err = client.PerformAction(ctx, "ImportResource", request, response); err != nil {
 		return
 	}
 
@@ -2630,13 +4025,35 @@ func (client *ContentDirectory1) ImportResource(SourceURI *url.URL, DestinationU
 	return
 }
 
+// ContentDirectory1ExportResourceRequest is the request of ExportResource, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentDirectory1ExportResourceRequest struct {
+	SourceURI      string
+	DestinationURI string
+}
+
+// ContentDirectory1ExportResourceResponse is the response of ExportResource, with each
+// argument in its SOAP string form.
+type ContentDirectory1ExportResourceResponse struct {
+	TransferID string
+}
+
+// ExportResource performs the ExportResource action of the service.
+//
+// Arguments:
+//   - SourceURI (in, state variable A_ARG_TYPE_ExportResource_SourceURI)
+//   - DestinationURI (in, state variable A_ARG_TYPE_ExportResource_DestinationURI)
+//
+// Return values:
+//   - TransferID (out, state variable A_ARG_TYPE_ExportResource_TransferID)
 func (client *ContentDirectory1) ExportResource(SourceURI *url.URL, DestinationURI *url.URL) (TransferID uint32, err error) {
-	// Request structure.
-	request := &struct {
-		SourceURI string
+	return client.ExportResourceCtx(context.Background(), SourceURI, DestinationURI)
+}
 
-		DestinationURI string
-	}{}
+// ExportResourceCtx is ExportResource with a context, to cancel or time out the call.
+func (client *ContentDirectory1) ExportResourceCtx(ctx context.Context, SourceURI *url.URL, DestinationURI *url.URL) (TransferID uint32, err error) {
+	// Request structure.
+	request := &ContentDirectory1ExportResourceRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.SourceURI, err = soap.MarshalURI(SourceURI); err != nil {
@@ -2648,12 +4065,10 @@ func (client *ContentDirectory1) ExportResource(SourceURI *url.URL, DestinationU
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		TransferID string
-	}{}
+	response := &ContentDirectory1ExportResourceResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_1, "ExportResource", request, response); err != nil {
+	if err = client.PerformAction(ctx, "ExportResource", request, response); err != nil {
 		return
 	}
 
@@ -2666,11 +4081,24 @@ func (client *ContentDirectory1) ExportResource(SourceURI *url.URL, DestinationU
 	return
 }
 
+// ContentDirectory1StopTransferResourceRequest is the request of StopTransferResource, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentDirectory1StopTransferResourceRequest struct {
+	TransferID string
+}
+
+// StopTransferResource performs the StopTransferResource action of the service.
+//
+// Arguments:
+//   - TransferID (in, state variable A_ARG_TYPE_StopTransferResource_TransferID)
 func (client *ContentDirectory1) StopTransferResource(TransferID uint32) (err error) {
+	return client.StopTransferResourceCtx(context.Background(), TransferID)
+}
+
+// StopTransferResourceCtx is StopTransferResource with a context, to cancel or time out the call.
+func (client *ContentDirectory1) StopTransferResourceCtx(ctx context.Context, TransferID uint32) (err error) {
 	// Request structure.
-	request := &struct {
-		TransferID string
-	}{}
+	request := &ContentDirectory1StopTransferResourceRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.TransferID, err = soap.MarshalUi4(TransferID); err != nil {
@@ -2682,7 +4110,7 @@ func (client *ContentDirectory1) StopTransferResource(TransferID uint32) (err er
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_1, "StopTransferResource", request, response); err != nil {
+	if err = client.PerformAction(ctx, "StopTransferResource", request, response); err != nil {
 		return
 	}
 
@@ -2692,15 +4120,37 @@ func (client *ContentDirectory1) StopTransferResource(TransferID uint32) (err er
 	return
 }
 
+// ContentDirectory1GetTransferProgressRequest is the request of GetTransferProgress, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentDirectory1GetTransferProgressRequest struct {
+	TransferID string
+}
+
+// ContentDirectory1GetTransferProgressResponse is the response of GetTransferProgress, with each
+// argument in its SOAP string form.
+type ContentDirectory1GetTransferProgressResponse struct {
+	TransferStatus string
+	TransferLength string
+	TransferTotal  string
+}
+
+// GetTransferProgress performs the GetTransferProgress action of the service.
 //
-// Return values:
+// Arguments:
+//   - TransferID (in, state variable A_ARG_TYPE_GetTransferProgress_TransferID)
 //
-// * TransferStatus: allowed values: COMPLETED, ERROR, IN_PROGRESS, STOPPED
-func (client *ContentDirectory1) GetTransferProgress(TransferID uint32) (TransferStatus string, TransferLength string, TransferTotal string, err error) {
+// Return values:
+//   - TransferStatus (out, state variable A_ARG_TYPE_TransferStatus): allowed values COMPLETED, ERROR, IN_PROGRESS, STOPPED
+//   - TransferLength (out, state variable A_ARG_TYPE_GetTransferProgress_TransferLength)
+//   - TransferTotal (out, state variable A_ARG_TYPE_GetTransferProgress_TransferTotal)
+func (client *ContentDirectory1) GetTransferProgress(TransferID uint32) (TransferStatus ContentDirectory1TransferStatus, TransferLength string, TransferTotal string, err error) {
+	return client.GetTransferProgressCtx(context.Background(), TransferID)
+}
+
+// GetTransferProgressCtx is GetTransferProgress with a context, to cancel or time out the call.
+func (client *ContentDirectory1) GetTransferProgressCtx(ctx context.Context, TransferID uint32) (TransferStatus ContentDirectory1TransferStatus, TransferLength string, TransferTotal string, err error) {
 	// Request structure.
-	request := &struct {
-		TransferID string
-	}{}
+	request := &ContentDirectory1GetTransferProgressRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.TransferID, err = soap.MarshalUi4(TransferID); err != nil {
@@ -2709,24 +4159,16 @@ func (client *ContentDirectory1) GetTransferProgress(TransferID uint32) (Transfe
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		TransferStatus string
-
-		TransferLength string
-
-		TransferTotal string
-	}{}
+	response := &ContentDirectory1GetTransferProgressResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_1, "GetTransferProgress", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetTransferProgress", request, response); err != nil {
 		return
 	}
 
 	// BEGIN Unmarshal arguments from response.
 
-	if TransferStatus, err = soap.UnmarshalString(response.TransferStatus); err != nil {
-		return
-	}
+	TransferStatus = ContentDirectory1TransferStatus(response.TransferStatus)
 	if TransferLength, err = soap.UnmarshalString(response.TransferLength); err != nil {
 		return
 	}
@@ -2737,11 +4179,24 @@ func (client *ContentDirectory1) GetTransferProgress(TransferID uint32) (Transfe
 	return
 }
 
+// ContentDirectory1DeleteResourceRequest is the request of DeleteResource, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentDirectory1DeleteResourceRequest struct {
+	ResourceURI string
+}
+
+// DeleteResource performs the DeleteResource action of the service.
+//
+// Arguments:
+//   - ResourceURI (in, state variable A_ARG_TYPE_DeleteResource_ResourceURI)
 func (client *ContentDirectory1) DeleteResource(ResourceURI *url.URL) (err error) {
+	return client.DeleteResourceCtx(context.Background(), ResourceURI)
+}
+
+// DeleteResourceCtx is DeleteResource with a context, to cancel or time out the call.
+func (client *ContentDirectory1) DeleteResourceCtx(ctx context.Context, ResourceURI *url.URL) (err error) {
 	// Request structure.
-	request := &struct {
-		ResourceURI string
-	}{}
+	request := &ContentDirectory1DeleteResourceRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.ResourceURI, err = soap.MarshalURI(ResourceURI); err != nil {
@@ -2753,7 +4208,7 @@ func (client *ContentDirectory1) DeleteResource(ResourceURI *url.URL) (err error
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_1, "DeleteResource", request, response); err != nil {
+	if err = client.PerformAction(ctx, "DeleteResource", request, response); err != nil {
 		return
 	}
 
@@ -2763,13 +4218,35 @@ func (client *ContentDirectory1) DeleteResource(ResourceURI *url.URL) (err error
 	return
 }
 
+// ContentDirectory1CreateReferenceRequest is the request of CreateReference, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentDirectory1CreateReferenceRequest struct {
+	ContainerID string
+	ObjectID    string
+}
+
+// ContentDirectory1CreateReferenceResponse is the response of CreateReference, with each
+// argument in its SOAP string form.
+type ContentDirectory1CreateReferenceResponse struct {
+	NewID string
+}
+
+// CreateReference performs the CreateReference action of the service.
+//
+// Arguments:
+//   - ContainerID (in, state variable A_ARG_TYPE_CreateReference_ContainerID)
+//   - ObjectID (in, state variable A_ARG_TYPE_CreateReference_ObjectID)
+//
+// Return values:
+//   - NewID (out, state variable A_ARG_TYPE_CreateReference_NewID)
 func (client *ContentDirectory1) CreateReference(ContainerID string, ObjectID string) (NewID string, err error) {
-	// Request structure.
-	request := &struct {
-		ContainerID string
+	return client.CreateReferenceCtx(context.Background(), ContainerID, ObjectID)
+}
 
-		ObjectID string
-	}{}
+// CreateReferenceCtx is CreateReference with a context, to cancel or time out the call.
+func (client *ContentDirectory1) CreateReferenceCtx(ctx context.Context, ContainerID string, ObjectID string) (NewID string, err error) {
+	// Request structure.
+	request := &ContentDirectory1CreateReferenceRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.ContainerID, err = soap.MarshalString(ContainerID); err != nil {
@@ -2781,12 +4258,10 @@ func (client *ContentDirectory1) CreateReference(ContainerID string, ObjectID st
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewID string
-	}{}
+	response := &ContentDirectory1CreateReferenceResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_1, "CreateReference", request, response); err != nil {
+	if err = client.PerformAction(ctx, "CreateReference", request, response); err != nil {
 		return
 	}
 
@@ -2806,6 +4281,91 @@ type ContentDirectory2 struct {
 	goupnp.ServiceClient
 }
 
+// ContentDirectory2Client is the interface of the actions of ContentDirectory2, for
+// substituting fakes or mocks for the service in tests.
+type ContentDirectory2Client interface {
+	GetSearchCapabilities() (SearchCaps string, err error)
+	GetSearchCapabilitiesCtx(ctx context.Context) (SearchCaps string, err error)
+	GetSortCapabilities() (SortCaps string, err error)
+	GetSortCapabilitiesCtx(ctx context.Context) (SortCaps string, err error)
+	GetSortExtensionCapabilities() (SortExtensionCaps string, err error)
+	GetSortExtensionCapabilitiesCtx(ctx context.Context) (SortExtensionCaps string, err error)
+	GetFeatureList() (FeatureList string, err error)
+	GetFeatureListCtx(ctx context.Context) (FeatureList string, err error)
+	GetSystemUpdateID() (Id uint32, err error)
+	GetSystemUpdateIDCtx(ctx context.Context) (Id uint32, err error)
+	Browse(ObjectID string, BrowseFlag ContentDirectory2BrowseFlag, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error)
+	BrowseCtx(ctx context.Context, ObjectID string, BrowseFlag ContentDirectory2BrowseFlag, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error)
+	Search(ContainerID string, SearchCriteria string, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error)
+	SearchCtx(ctx context.Context, ContainerID string, SearchCriteria string, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error)
+	CreateObject(ContainerID string, Elements string) (ObjectID string, Result string, err error)
+	CreateObjectCtx(ctx context.Context, ContainerID string, Elements string) (ObjectID string, Result string, err error)
+	DestroyObject(ObjectID string) (err error)
+	DestroyObjectCtx(ctx context.Context, ObjectID string) (err error)
+	UpdateObject(ObjectID string, CurrentTagValue string, NewTagValue string) (err error)
+	UpdateObjectCtx(ctx context.Context, ObjectID string, CurrentTagValue string, NewTagValue string) (err error)
+	MoveObject(ObjectID string, NewParentID string) (NewObjectID string, err error)
+	MoveObjectCtx(ctx context.Context, ObjectID string, NewParentID string) (NewObjectID string, err error)
+	ImportResource(SourceURI *url.URL, DestinationURI *url.URL) (TransferID uint32, err error)
+	ImportResourceCtx(ctx context.Context, SourceURI *url.URL, DestinationURI *url.URL) (TransferID uint32, err error)
+	ExportResource(SourceURI *url.URL, DestinationURI *url.URL) (TransferID uint32, err error)
+	ExportResourceCtx(ctx context.Context, SourceURI *url.URL, DestinationURI *url.URL) (TransferID uint32, err error)
+	DeleteResource(ResourceURI *url.URL) (err error)
+	DeleteResourceCtx(ctx context.Context, ResourceURI *url.URL) (err error)
+	StopTransferResource(TransferID uint32) (err error)
+	StopTransferResourceCtx(ctx context.Context, TransferID uint32) (err error)
+	GetTransferProgress(TransferID uint32) (TransferStatus ContentDirectory2TransferStatus, TransferLength string, TransferTotal string, err error)
+	GetTransferProgressCtx(ctx context.Context, TransferID uint32) (TransferStatus ContentDirectory2TransferStatus, TransferLength string, TransferTotal string, err error)
+	CreateReference(ContainerID string, ObjectID string) (NewID string, err error)
+	CreateReferenceCtx(ctx context.Context, ContainerID string, ObjectID string) (NewID string, err error)
+}
+
+var _ ContentDirectory2Client = new(ContentDirectory2)
+
+// ContentDirectory2BrowseFlag is a value of the state variable A_ARG_TYPE_BrowseFlag of
+// ContentDirectory2.
+type ContentDirectory2BrowseFlag string
+
+// Allowed values of ContentDirectory2BrowseFlag.
+const (
+	ContentDirectory2BrowseFlag_BrowseMetadata       ContentDirectory2BrowseFlag = "BrowseMetadata"
+	ContentDirectory2BrowseFlag_BrowseDirectChildren ContentDirectory2BrowseFlag = "BrowseDirectChildren"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v ContentDirectory2BrowseFlag) Valid() bool {
+	switch v {
+	case ContentDirectory2BrowseFlag_BrowseMetadata,
+		ContentDirectory2BrowseFlag_BrowseDirectChildren:
+		return true
+	}
+	return false
+}
+
+// ContentDirectory2TransferStatus is a value of the state variable A_ARG_TYPE_TransferStatus of
+// ContentDirectory2.
+type ContentDirectory2TransferStatus string
+
+// Allowed values of ContentDirectory2TransferStatus.
+const (
+	ContentDirectory2TransferStatus_COMPLETED   ContentDirectory2TransferStatus = "COMPLETED"
+	ContentDirectory2TransferStatus_ERROR       ContentDirectory2TransferStatus = "ERROR"
+	ContentDirectory2TransferStatus_IN_PROGRESS ContentDirectory2TransferStatus = "IN_PROGRESS"
+	ContentDirectory2TransferStatus_STOPPED     ContentDirectory2TransferStatus = "STOPPED"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v ContentDirectory2TransferStatus) Valid() bool {
+	switch v {
+	case ContentDirectory2TransferStatus_COMPLETED,
+		ContentDirectory2TransferStatus_ERROR,
+		ContentDirectory2TransferStatus_IN_PROGRESS,
+		ContentDirectory2TransferStatus_STOPPED:
+		return true
+	}
+	return false
+}
+
 // NewContentDirectory2Clients discovers instances of the service on the network,
 // and returns clients to any that are found. errors will contain an error for
 // any devices that replied but which could not be queried, and err will be set
@@ -2859,20 +4419,53 @@ func newContentDirectory2ClientsFromGenericClients(genericClients []goupnp.Servi
 	return clients
 }
 
-func (client *ContentDirectory2) GetSearchCapabilities() (SearchCaps string, err error) {
-	// Request structure.
-	request := interface{}(nil)
-	// BEGIN Marshal arguments into request.
+// ContentDirectory2Timeouts are the default timeouts of the actions of
+// ContentDirectory2, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var ContentDirectory2Timeouts = map[string]time.Duration{
+	"":       10 * time.Second,
+	"Browse": 1 * time.Minute,
+	"Search": 2 * time.Minute,
+}
 
-	// END Marshal arguments into request.
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *ContentDirectory2) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, ContentDirectory2Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_ContentDirectory_2, actionName, request, response)
+}
 
-	// Response structure.
-	response := &struct {
-		SearchCaps string
-	}{}
+// ContentDirectory2GetSearchCapabilitiesResponse is the response of GetSearchCapabilities, with each
+// argument in its SOAP string form.
+type ContentDirectory2GetSearchCapabilitiesResponse struct {
+	SearchCaps string
+}
+
+// GetSearchCapabilities performs the GetSearchCapabilities action of the service.
+//
+// Return values:
+//   - SearchCaps (out, state variable A_ARG_TYPE_GetSearchCapabilities_SearchCaps)
+func (client *ContentDirectory2) GetSearchCapabilities() (SearchCaps string, err error) {
+	return client.GetSearchCapabilitiesCtx(context.Background())
+}
+
+// GetSearchCapabilitiesCtx is GetSearchCapabilities with a context, to cancel or time out the call.
+func (client *ContentDirectory2) GetSearchCapabilitiesCtx(ctx context.Context) (SearchCaps string, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &ContentDirectory2GetSearchCapabilitiesResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_2, "GetSearchCapabilities", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetSearchCapabilities", request, response); err != nil {
 		return
 	}
 
@@ -2885,7 +4478,22 @@ func (client *ContentDirectory2) GetSearchCapabilities() (SearchCaps string, err
 	return
 }
 
+// ContentDirectory2GetSortCapabilitiesResponse is the response of GetSortCapabilities, with each
+// argument in its SOAP string form.
+type ContentDirectory2GetSortCapabilitiesResponse struct {
+	SortCaps string
+}
+
+// GetSortCapabilities performs the GetSortCapabilities action of the service.
+//
+// Return values:
+//   - SortCaps (out, state variable A_ARG_TYPE_GetSortCapabilities_SortCaps)
 func (client *ContentDirectory2) GetSortCapabilities() (SortCaps string, err error) {
+	return client.GetSortCapabilitiesCtx(context.Background())
+}
+
+// GetSortCapabilitiesCtx is GetSortCapabilities with a context, to cancel or time out the call.
+func (client *ContentDirectory2) GetSortCapabilitiesCtx(ctx context.Context) (SortCaps string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -2893,12 +4501,10 @@ func (client *ContentDirectory2) GetSortCapabilities() (SortCaps string, err err
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		SortCaps string
-	}{}
+	response := &ContentDirectory2GetSortCapabilitiesResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_2, "GetSortCapabilities", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetSortCapabilities", request, response); err != nil {
 		return
 	}
 
@@ -2911,7 +4517,22 @@ func (client *ContentDirectory2) GetSortCapabilities() (SortCaps string, err err
 	return
 }
 
+// ContentDirectory2GetSortExtensionCapabilitiesResponse is the response of GetSortExtensionCapabilities, with each
+// argument in its SOAP string form.
+type ContentDirectory2GetSortExtensionCapabilitiesResponse struct {
+	SortExtensionCaps string
+}
+
+// GetSortExtensionCapabilities performs the GetSortExtensionCapabilities action of the service.
+//
+// Return values:
+//   - SortExtensionCaps (out, state variable A_ARG_TYPE_GetSortExtensionCapabilities_SortExtensionCaps)
 func (client *ContentDirectory2) GetSortExtensionCapabilities() (SortExtensionCaps string, err error) {
+	return client.GetSortExtensionCapabilitiesCtx(context.Background())
+}
+
+// GetSortExtensionCapabilitiesCtx is GetSortExtensionCapabilities with a context, to cancel or time out the call.
+func (client *ContentDirectory2) GetSortExtensionCapabilitiesCtx(ctx context.Context) (SortExtensionCaps string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -2919,12 +4540,10 @@ func (client *ContentDirectory2) GetSortExtensionCapabilities() (SortExtensionCa
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		SortExtensionCaps string
-	}{}
+	response := &ContentDirectory2GetSortExtensionCapabilitiesResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_2, "GetSortExtensionCapabilities", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetSortExtensionCapabilities", request, response); err != nil {
 		return
 	}
 
@@ -2937,7 +4556,22 @@ func (client *ContentDirectory2) GetSortExtensionCapabilities() (SortExtensionCa
 	return
 }
 
+// ContentDirectory2GetFeatureListResponse is the response of GetFeatureList, with each
+// argument in its SOAP string form.
+type ContentDirectory2GetFeatureListResponse struct {
+	FeatureList string
+}
+
+// GetFeatureList performs the GetFeatureList action of the service.
+//
+// Return values:
+//   - FeatureList (out, state variable A_ARG_TYPE_GetFeatureList_FeatureList)
 func (client *ContentDirectory2) GetFeatureList() (FeatureList string, err error) {
+	return client.GetFeatureListCtx(context.Background())
+}
+
+// GetFeatureListCtx is GetFeatureList with a context, to cancel or time out the call.
+func (client *ContentDirectory2) GetFeatureListCtx(ctx context.Context) (FeatureList string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -2945,12 +4579,10 @@ func (client *ContentDirectory2) GetFeatureList() (FeatureList string, err error
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		FeatureList string
-	}{}
+	response := &ContentDirectory2GetFeatureListResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_2, "GetFeatureList", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetFeatureList", request, response); err != nil {
 		return
 	}
 
@@ -2963,7 +4595,22 @@ func (client *ContentDirectory2) GetFeatureList() (FeatureList string, err error
 	return
 }
 
+// ContentDirectory2GetSystemUpdateIDResponse is the response of GetSystemUpdateID, with each
+// argument in its SOAP string form.
+type ContentDirectory2GetSystemUpdateIDResponse struct {
+	Id string
+}
+
+// GetSystemUpdateID performs the GetSystemUpdateID action of the service.
+//
+// Return values:
+//   - Id (out, state variable A_ARG_TYPE_GetSystemUpdateID_Id)
 func (client *ContentDirectory2) GetSystemUpdateID() (Id uint32, err error) {
+	return client.GetSystemUpdateIDCtx(context.Background())
+}
+
+// GetSystemUpdateIDCtx is GetSystemUpdateID with a context, to cancel or time out the call.
+func (client *ContentDirectory2) GetSystemUpdateIDCtx(ctx context.Context) (Id uint32, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -2971,12 +4618,10 @@ func (client *ContentDirectory2) GetSystemUpdateID() (Id uint32, err error) {
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		Id string
-	}{}
+	response := &ContentDirectory2GetSystemUpdateIDResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_2, "GetSystemUpdateID", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetSystemUpdateID", request, response); err != nil {
 		return
 	}
 
@@ -2989,32 +4634,55 @@ func (client *ContentDirectory2) GetSystemUpdateID() (Id uint32, err error) {
 	return
 }
 
+// ContentDirectory2BrowseRequest is the request of Browse, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentDirectory2BrowseRequest struct {
+	ObjectID       string
+	BrowseFlag     string
+	Filter         string
+	StartingIndex  string
+	RequestedCount string
+	SortCriteria   string
+}
+
+// ContentDirectory2BrowseResponse is the response of Browse, with each
+// argument in its SOAP string form.
+type ContentDirectory2BrowseResponse struct {
+	Result         string
+	NumberReturned string
+	TotalMatches   string
+	UpdateID       string
+}
+
+// Browse performs the Browse action of the service.
 //
 // Arguments:
+//   - ObjectID (in, state variable A_ARG_TYPE_Browse_ObjectID)
+//   - BrowseFlag (in, state variable A_ARG_TYPE_BrowseFlag): allowed values BrowseMetadata, BrowseDirectChildren
+//   - Filter (in, state variable A_ARG_TYPE_Browse_Filter)
+//   - StartingIndex (in, state variable A_ARG_TYPE_Browse_StartingIndex)
+//   - RequestedCount (in, state variable A_ARG_TYPE_Browse_RequestedCount)
+//   - SortCriteria (in, state variable A_ARG_TYPE_Browse_SortCriteria)
 //
-// * BrowseFlag: allowed values: BrowseMetadata, BrowseDirectChildren
+// Return values:
+//   - Result (out, state variable A_ARG_TYPE_Browse_Result)
+//   - NumberReturned (out, state variable A_ARG_TYPE_Browse_NumberReturned)
+//   - TotalMatches (out, state variable A_ARG_TYPE_Browse_TotalMatches)
+//   - UpdateID (out, state variable A_ARG_TYPE_Browse_UpdateID)
+func (client *ContentDirectory2) Browse(ObjectID string, BrowseFlag ContentDirectory2BrowseFlag, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error) {
+	return client.BrowseCtx(context.Background(), ObjectID, BrowseFlag, Filter, StartingIndex, RequestedCount, SortCriteria)
+}
 
-func (client *ContentDirectory2) Browse(ObjectID string, BrowseFlag string, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error) {
+// BrowseCtx is Browse with a context, to cancel or time out the call.
+func (client *ContentDirectory2) BrowseCtx(ctx context.Context, ObjectID string, BrowseFlag ContentDirectory2BrowseFlag, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error) {
 	// Request structure.
-	request := &struct {
-		ObjectID string
-
-		BrowseFlag string
-
-		Filter string
-
-		StartingIndex string
-
-		RequestedCount string
-
-		SortCriteria string
-	}{}
+	request := &ContentDirectory2BrowseRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.ObjectID, err = soap.MarshalString(ObjectID); err != nil {
 		return
 	}
-	if request.BrowseFlag, err = soap.MarshalString(BrowseFlag); err != nil {
+	if request.BrowseFlag, err = soap.MarshalString(string(BrowseFlag)); err != nil {
 		return
 	}
 	if request.Filter, err = soap.MarshalString(Filter); err != nil {
@@ -3032,18 +4700,10 @@ func (client *ContentDirectory2) Browse(ObjectID string, BrowseFlag string, Filt
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		Result string
-
-		NumberReturned string
-
-		TotalMatches string
-
-		UpdateID string
-	}{}
+	response := &ContentDirectory2BrowseResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_2, "Browse", request, response); err != nil {
+	if err = client.PerformAction(ctx, "Browse", request, response); err != nil {
 		return
 	}
 
@@ -3065,21 +4725,49 @@ func (client *ContentDirectory2) Browse(ObjectID string, BrowseFlag string, Filt
 	return
 }
 
-func (client *ContentDirectory2) Search(ContainerID string, SearchCriteria string, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error) {
-	// Request structure.
-	request := &struct {
-		ContainerID string
-
-		SearchCriteria string
-
-		Filter string
+// ContentDirectory2SearchRequest is the request of Search, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentDirectory2SearchRequest struct {
+	ContainerID    string
+	SearchCriteria string
+	Filter         string
+	StartingIndex  string
+	RequestedCount string
+	SortCriteria   string
+}
 
-		StartingIndex string
+// ContentDirectory2SearchResponse is the response of Search, with each
+// argument in its SOAP string form.
+type ContentDirectory2SearchResponse struct {
+	Result         string
+	NumberReturned string
+	TotalMatches   string
+	UpdateID       string
+}
 
-		RequestedCount string
+// Search performs the Search action of the service.
+//
+// Arguments:
+//   - ContainerID (in, state variable A_ARG_TYPE_Search_ContainerID)
+//   - SearchCriteria (in, state variable A_ARG_TYPE_Search_SearchCriteria)
+//   - Filter (in, state variable A_ARG_TYPE_Search_Filter)
+//   - StartingIndex (in, state variable A_ARG_TYPE_Search_StartingIndex)
+//   - RequestedCount (in, state variable A_ARG_TYPE_Search_RequestedCount)
+//   - SortCriteria (in, state variable A_ARG_TYPE_Search_SortCriteria)
+//
+// Return values:
+//   - Result (out, state variable A_ARG_TYPE_Search_Result)
+//   - NumberReturned (out, state variable A_ARG_TYPE_Search_NumberReturned)
+//   - TotalMatches (out, state variable A_ARG_TYPE_Search_TotalMatches)
+//   - UpdateID (out, state variable A_ARG_TYPE_Search_UpdateID)
+func (client *ContentDirectory2) Search(ContainerID string, SearchCriteria string, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error) {
+	return client.SearchCtx(context.Background(), ContainerID, SearchCriteria, Filter, StartingIndex, RequestedCount, SortCriteria)
+}
 
-		SortCriteria string
-	}{}
+// SearchCtx is Search with a context, to cancel or time out the call.
+func (client *ContentDirectory2) SearchCtx(ctx context.Context, ContainerID string, SearchCriteria string, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error) {
+	// Request structure.
+	request := &ContentDirectory2SearchRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.ContainerID, err = soap.MarshalString(ContainerID); err != nil {
@@ -3103,18 +4791,10 @@ func (client *ContentDirectory2) Search(ContainerID string, SearchCriteria strin
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		Result string
-
-		NumberReturned string
-
-		TotalMatches string
-
-		UpdateID string
-	}{}
+	response := &ContentDirectory2SearchResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_2, "Search", request, response); err != nil {
+	if err = client.PerformAction(ctx, "Search", request, response); err != nil {
 		return
 	}
 
@@ -3136,13 +4816,37 @@ func (client *ContentDirectory2) Search(ContainerID string, SearchCriteria strin
 	return
 }
 
+// ContentDirectory2CreateObjectRequest is the request of CreateObject, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentDirectory2CreateObjectRequest struct {
+	ContainerID string
+	Elements    string
+}
+
+// ContentDirectory2CreateObjectResponse is the response of CreateObject, with each
+// argument in its SOAP string form.
+type ContentDirectory2CreateObjectResponse struct {
+	ObjectID string
+	Result   string
+}
+
+// CreateObject performs the CreateObject action of the service.
+//
+// Arguments:
+//   - ContainerID (in, state variable A_ARG_TYPE_CreateObject_ContainerID)
+//   - Elements (in, state variable A_ARG_TYPE_CreateObject_Elements)
+//
+// Return values:
+//   - ObjectID (out, state variable A_ARG_TYPE_CreateObject_ObjectID)
+//   - Result (out, state variable A_ARG_TYPE_CreateObject_Result)
 func (client *ContentDirectory2) CreateObject(ContainerID string, Elements string) (ObjectID string, Result string, err error) {
-	// Request structure.
-	request := &struct {
-		ContainerID string
+	return client.CreateObjectCtx(context.Background(), ContainerID, Elements)
+}
 
-		Elements string
-	}{}
+// CreateObjectCtx is CreateObject with a context, to cancel or time out the call.
+func (client *ContentDirectory2) CreateObjectCtx(ctx context.Context, ContainerID string, Elements string) (ObjectID string, Result string, err error) {
+	// Request structure.
+	request := &ContentDirectory2CreateObjectRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.ContainerID, err = soap.MarshalString(ContainerID); err != nil {
@@ -3154,14 +4858,10 @@ func (client *ContentDirectory2) CreateObject(ContainerID string, Elements strin
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		ObjectID string
-
-		Result string
-	}{}
+	response := &ContentDirectory2CreateObjectResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_2, "CreateObject", request, response); err != nil {
+	if err = client.PerformAction(ctx, "CreateObject", request, response); err != nil {
 		return
 	}
 
@@ -3177,11 +4877,24 @@ func (client *ContentDirectory2) CreateObject(ContainerID string, Elements strin
 	return
 }
 
+// ContentDirectory2DestroyObjectRequest is the request of DestroyObject, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentDirectory2DestroyObjectRequest struct {
+	ObjectID string
+}
+
+// DestroyObject performs the DestroyObject action of the service.
+//
+// Arguments:
+//   - ObjectID (in, state variable A_ARG_TYPE_DestroyObject_ObjectID)
 func (client *ContentDirectory2) DestroyObject(ObjectID string) (err error) {
+	return client.DestroyObjectCtx(context.Background(), ObjectID)
+}
+
+// DestroyObjectCtx is DestroyObject with a context, to cancel or time out the call.
+func (client *ContentDirectory2) DestroyObjectCtx(ctx context.Context, ObjectID string) (err error) {
 	// Request structure.
-	request := &struct {
-		ObjectID string
-	}{}
+	request := &ContentDirectory2DestroyObjectRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.ObjectID, err = soap.MarshalString(ObjectID); err != nil {
@@ -3193,7 +4906,7 @@ func (client *ContentDirectory2) DestroyObject(ObjectID string) (err error) {
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_2, "DestroyObject", request, response); err != nil {
+	if err = client.PerformAction(ctx, "DestroyObject", request, response); err != nil {
 		return
 	}
 
@@ -3203,15 +4916,28 @@ func (client *ContentDirectory2) DestroyObject(ObjectID string) (err error) {
 	return
 }
 
-func (client *ContentDirectory2) UpdateObject(ObjectID string, CurrentTagValue string, NewTagValue string) (err error) {
-	// Request structure.
-	request := &struct {
-		ObjectID string
+// ContentDirectory2UpdateObjectRequest is the request of UpdateObject, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentDirectory2UpdateObjectRequest struct {
+	ObjectID        string
+	CurrentTagValue string
+	NewTagValue     string
+}
 
-		CurrentTagValue string
+// UpdateObject performs the UpdateObject action of the service.
+//
+// Arguments:
+//   - ObjectID (in, state variable A_ARG_TYPE_UpdateObject_ObjectID)
+//   - CurrentTagValue (in, state variable A_ARG_TYPE_UpdateObject_CurrentTagValue)
+//   - NewTagValue (in, state variable A_ARG_TYPE_UpdateObject_NewTagValue)
+func (client *ContentDirectory2) UpdateObject(ObjectID string, CurrentTagValue string, NewTagValue string) (err error) {
+	return client.UpdateObjectCtx(context.Background(), ObjectID, CurrentTagValue, NewTagValue)
+}
 
-		NewTagValue string
-	}{}
+// UpdateObjectCtx is UpdateObject with a context, to cancel or time out the call.
+func (client *ContentDirectory2) UpdateObjectCtx(ctx context.Context, ObjectID string, CurrentTagValue string, NewTagValue string) (err error) {
+	// Request structure.
+	request := &ContentDirectory2UpdateObjectRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.ObjectID, err = soap.MarshalString(ObjectID); err != nil {
@@ -3229,7 +4955,7 @@ func (client *ContentDirectory2) UpdateObject(ObjectID string, CurrentTagValue s
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_2, "UpdateObject", request, response); err != nil {
+	if err = client.PerformAction(ctx, "UpdateObject", request, response); err != nil {
 		return
 	}
 
@@ -3239,13 +4965,35 @@ func (client *ContentDirectory2) UpdateObject(ObjectID string, CurrentTagValue s
 	return
 }
 
+// ContentDirectory2MoveObjectRequest is the request of MoveObject, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentDirectory2MoveObjectRequest struct {
+	ObjectID    string
+	NewParentID string
+}
+
+// ContentDirectory2MoveObjectResponse is the response of MoveObject, with each
+// argument in its SOAP string form.
+type ContentDirectory2MoveObjectResponse struct {
+	NewObjectID string
+}
+
+// MoveObject performs the MoveObject action of the service.
+//
+// Arguments:
+//   - ObjectID (in, state variable A_ARG_TYPE_MoveObject_ObjectID)
+//   - NewParentID (in, state variable A_ARG_TYPE_MoveObject_NewParentID)
+//
+// Return values:
+//   - NewObjectID (out, state variable A_ARG_TYPE_MoveObject_NewObjectID)
 func (client *ContentDirectory2) MoveObject(ObjectID string, NewParentID string) (NewObjectID string, err error) {
-	// Request structure.
-	request := &struct {
-		ObjectID string
+	return client.MoveObjectCtx(context.Background(), ObjectID, NewParentID)
+}
 
-		NewParentID string
-	}{}
+// MoveObjectCtx is MoveObject with a context, to cancel or time out the call.
+func (client *ContentDirectory2) MoveObjectCtx(ctx context.Context, ObjectID string, NewParentID string) (NewObjectID string, err error) {
+	// Request structure.
+	request := &ContentDirectory2MoveObjectRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.ObjectID, err = soap.MarshalString(ObjectID); err != nil {
@@ -3257,12 +5005,10 @@ func (client *ContentDirectory2) MoveObject(ObjectID string, NewParentID string)
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewObjectID string
-	}{}
+	response := &ContentDirectory2MoveObjectResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_2, "MoveObject", request, response); err != nil {
+	if err = client.PerformAction(ctx, "MoveObject", request, response); err != nil {
 		return
 	}
 
@@ -3275,13 +5021,35 @@ func (client *ContentDirectory2) MoveObject(ObjectID string, NewParentID string)
 	return
 }
 
+// ContentDirectory2ImportResourceRequest is the request of ImportResource, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentDirectory2ImportResourceRequest struct {
+	SourceURI      string
+	DestinationURI string
+}
+
+// ContentDirectory2ImportResourceResponse is the response of ImportResource, with each
+// argument in its SOAP string form.
+type ContentDirectory2ImportResourceResponse struct {
+	TransferID string
+}
+
+// ImportResource performs the ImportResource action of the service.
+//
+// Arguments:
+//   - SourceURI (in, state variable A_ARG_TYPE_ImportResource_SourceURI)
+//   - DestinationURI (in, state variable A_ARG_TYPE_ImportResource_DestinationURI)
+//
+// Return values:
+//   - TransferID (out, state variable A_ARG_TYPE_ImportResource_TransferID)
 func (client *ContentDirectory2) ImportResource(SourceURI *url.URL, DestinationURI *url.URL) (TransferID uint32, err error) {
-	// Request structure.
-	request := &struct {
-		SourceURI string
+	return client.ImportResourceCtx(context.Background(), SourceURI, DestinationURI)
+}
 
-		DestinationURI string
-	}{}
+// ImportResourceCtx is ImportResource with a context, to cancel or time out the call.
+func (client *ContentDirectory2) ImportResourceCtx(ctx context.Context, SourceURI *url.URL, DestinationURI *url.URL) (TransferID uint32, err error) {
+	// Request structure.
+	request := &ContentDirectory2ImportResourceRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.SourceURI, err = soap.MarshalURI(SourceURI); err != nil {
@@ -3293,12 +5061,10 @@ func (client *ContentDirectory2) ImportResource(SourceURI *url.URL, DestinationU
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		TransferID string
-	}{}
+	response := &ContentDirectory2ImportResourceResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_2, "ImportResource", request, response); err != nil {
+	if err = client.PerformAction(ctx, "ImportResource", request, response); err != nil {
 		return
 	}
 
@@ -3311,13 +5077,35 @@ func (client *ContentDirectory2) ImportResource(SourceURI *url.URL, DestinationU
 	return
 }
 
+// ContentDirectory2ExportResourceRequest is the request of ExportResource, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentDirectory2ExportResourceRequest struct {
+	SourceURI      string
+	DestinationURI string
+}
+
+// ContentDirectory2ExportResourceResponse is the response of ExportResource, with each
+// argument in its SOAP string form.
+type ContentDirectory2ExportResourceResponse struct {
+	TransferID string
+}
+
+// ExportResource performs the ExportResource action of the service.
+//
+// Arguments:
+//   - SourceURI (in, state variable A_ARG_TYPE_ExportResource_SourceURI)
+//   - DestinationURI (in, state variable A_ARG_TYPE_ExportResource_DestinationURI)
+//
+// Return values:
+//   - TransferID (out, state variable A_ARG_TYPE_ExportResource_TransferID)
 func (client *ContentDirectory2) ExportResource(SourceURI *url.URL, DestinationURI *url.URL) (TransferID uint32, err error) {
-	// Request structure.
-	request := &struct {
-		SourceURI string
+	return client.ExportResourceCtx(context.Background(), SourceURI, DestinationURI)
+}
 
-		DestinationURI string
-	}{}
+// ExportResourceCtx is ExportResource with a context, to cancel or time out the call.
+func (client *ContentDirectory2) ExportResourceCtx(ctx context.Context, SourceURI *url.URL, DestinationURI *url.URL) (TransferID uint32, err error) {
+	// Request structure.
+	request := &ContentDirectory2ExportResourceRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.SourceURI, err = soap.MarshalURI(SourceURI); err != nil {
@@ -3329,12 +5117,10 @@ func (client *ContentDirectory2) ExportResource(SourceURI *url.URL, DestinationU
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		TransferID string
-	}{}
+	response := &ContentDirectory2ExportResourceResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_2, "ExportResource", request, response); err != nil {
+	if err = client.PerformAction(ctx, "ExportResource", request, response); err != nil {
 		return
 	}
 
@@ -3347,11 +5133,24 @@ func (client *ContentDirectory2) ExportResource(SourceURI *url.URL, DestinationU
 	return
 }
 
+// ContentDirectory2DeleteResourceRequest is the request of DeleteResource, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentDirectory2DeleteResourceRequest struct {
+	ResourceURI string
+}
+
+// DeleteResource performs the DeleteResource action of the service.
+//
+// Arguments:
+//   - ResourceURI (in, state variable A_ARG_TYPE_DeleteResource_ResourceURI)
 func (client *ContentDirectory2) DeleteResource(ResourceURI *url.URL) (err error) {
+	return client.DeleteResourceCtx(context.Background(), ResourceURI)
+}
+
+// DeleteResourceCtx is DeleteResource with a context, to cancel or time out the call.
+func (client *ContentDirectory2) DeleteResourceCtx(ctx context.Context, ResourceURI *url.URL) (err error) {
 	// Request structure.
-	request := &struct {
-		ResourceURI string
-	}{}
+	request := &ContentDirectory2DeleteResourceRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.ResourceURI, err = soap.MarshalURI(ResourceURI); err != nil {
@@ -3363,7 +5162,7 @@ func (client *ContentDirectory2) DeleteResource(ResourceURI *url.URL) (err error
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_2, "DeleteResource", request, response); err != nil {
+	if err = client.PerformAction(ctx, "DeleteResource", request, response); err != nil {
 		return
 	}
 
@@ -3373,11 +5172,24 @@ func (client *ContentDirectory2) DeleteResource(ResourceURI *url.URL) (err error
 	return
 }
 
+// ContentDirectory2StopTransferResourceRequest is the request of StopTransferResource, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentDirectory2StopTransferResourceRequest struct {
+	TransferID string
+}
+
+// StopTransferResource performs the StopTransferResource action of the service.
+//
+// Arguments:
+//   - TransferID (in, state variable A_ARG_TYPE_StopTransferResource_TransferID)
 func (client *ContentDirectory2) StopTransferResource(TransferID uint32) (err error) {
+	return client.StopTransferResourceCtx(context.Background(), TransferID)
+}
+
+// StopTransferResourceCtx is StopTransferResource with a context, to cancel or time out the call.
+func (client *ContentDirectory2) StopTransferResourceCtx(ctx context.Context, TransferID uint32) (err error) {
 	// Request structure.
-	request := &struct {
-		TransferID string
-	}{}
+	request := &ContentDirectory2StopTransferResourceRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.TransferID, err = soap.MarshalUi4(TransferID); err != nil {
@@ -3389,7 +5201,7 @@ func (client *ContentDirectory2) StopTransferResource(TransferID uint32) (err er
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_2, "StopTransferResource", request, response); err != nil {
+	if err = client.PerformAction(ctx, "StopTransferResource", request, response); err != nil {
 		return
 	}
 
@@ -3399,15 +5211,37 @@ func (client *ContentDirectory2) StopTransferResource(TransferID uint32) (err er
 	return
 }
 
+// ContentDirectory2GetTransferProgressRequest is the request of GetTransferProgress, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentDirectory2GetTransferProgressRequest struct {
+	TransferID string
+}
+
+// ContentDirectory2GetTransferProgressResponse is the response of GetTransferProgress, with each
+// argument in its SOAP string form.
+type ContentDirectory2GetTransferProgressResponse struct {
+	TransferStatus string
+	TransferLength string
+	TransferTotal  string
+}
+
+// GetTransferProgress performs the GetTransferProgress action of the service.
 //
-// Return values:
+// Arguments:
+//   - TransferID (in, state variable A_ARG_TYPE_GetTransferProgress_TransferID)
 //
-// * TransferStatus: allowed values: COMPLETED, ERROR, IN_PROGRESS, STOPPED
-func (client *ContentDirectory2) GetTransferProgress(TransferID uint32) (TransferStatus string, TransferLength string, TransferTotal string, err error) {
+// Return values:
+//   - TransferStatus (out, state variable A_ARG_TYPE_TransferStatus): allowed values COMPLETED, ERROR, IN_PROGRESS, STOPPED
+//   - TransferLength (out, state variable A_ARG_TYPE_GetTransferProgress_TransferLength)
+//   - TransferTotal (out, state variable A_ARG_TYPE_GetTransferProgress_TransferTotal)
+func (client *ContentDirectory2) GetTransferProgress(TransferID uint32) (TransferStatus ContentDirectory2TransferStatus, TransferLength string, TransferTotal string, err error) {
+	return client.GetTransferProgressCtx(context.Background(), TransferID)
+}
+
+// GetTransferProgressCtx is GetTransferProgress with a context, to cancel or time out the call.
+func (client *ContentDirectory2) GetTransferProgressCtx(ctx context.Context, TransferID uint32) (TransferStatus ContentDirectory2TransferStatus, TransferLength string, TransferTotal string, err error) {
 	// Request structure.
-	request := &struct {
-		TransferID string
-	}{}
+	request := &ContentDirectory2GetTransferProgressRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.TransferID, err = soap.MarshalUi4(TransferID); err != nil {
@@ -3416,24 +5250,16 @@ func (client *ContentDirectory2) GetTransferProgress(TransferID uint32) (Transfe
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		TransferStatus string
-
-		TransferLength string
-
-		TransferTotal string
-	}{}
+	response := &ContentDirectory2GetTransferProgressResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_2, "GetTransferProgress", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetTransferProgress", request, response); err != nil {
 		return
 	}
 
 	// BEGIN Unmarshal arguments from response.
 
-	if TransferStatus, err = soap.UnmarshalString(response.TransferStatus); err != nil {
-		return
-	}
+	TransferStatus = ContentDirectory2TransferStatus(response.TransferStatus)
 	if TransferLength, err = soap.UnmarshalString(response.TransferLength); err != nil {
 		return
 	}
@@ -3444,13 +5270,35 @@ func (client *ContentDirectory2) GetTransferProgress(TransferID uint32) (Transfe
 	return
 }
 
+// ContentDirectory2CreateReferenceRequest is the request of CreateReference, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentDirectory2CreateReferenceRequest struct {
+	ContainerID string
+	ObjectID    string
+}
+
+// ContentDirectory2CreateReferenceResponse is the response of CreateReference, with each
+// argument in its SOAP string form.
+type ContentDirectory2CreateReferenceResponse struct {
+	NewID string
+}
+
+// CreateReference performs the CreateReference action of the service.
+//
+// Arguments:
+//   - ContainerID (in, state variable A_ARG_TYPE_CreateReference_ContainerID)
+//   - ObjectID (in, state variable A_ARG_TYPE_CreateReference_ObjectID)
+//
+// Return values:
+//   - NewID (out, state variable A_ARG_TYPE_CreateReference_NewID)
 func (client *ContentDirectory2) CreateReference(ContainerID string, ObjectID string) (NewID string, err error) {
-	// Request structure.
-	request := &struct {
-		ContainerID string
+	return client.CreateReferenceCtx(context.Background(), ContainerID, ObjectID)
+}
 
-		ObjectID string
-	}{}
+// CreateReferenceCtx is CreateReference with a context, to cancel or time out the call.
+func (client *ContentDirectory2) CreateReferenceCtx(ctx context.Context, ContainerID string, ObjectID string) (NewID string, err error) {
+	// Request structure.
+	request := &ContentDirectory2CreateReferenceRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.ContainerID, err = soap.MarshalString(ContainerID); err != nil {
@@ -3462,12 +5310,10 @@ func (client *ContentDirectory2) CreateReference(ContainerID string, ObjectID st
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewID string
-	}{}
+	response := &ContentDirectory2CreateReferenceResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_2, "CreateReference", request, response); err != nil {
+	if err = client.PerformAction(ctx, "CreateReference", request, response); err != nil {
 		return
 	}
 
@@ -3487,6 +5333,97 @@ type ContentDirectory3 struct {
 	goupnp.ServiceClient
 }
 
+// ContentDirectory3Client is the interface of the actions of ContentDirectory3, for
+// substituting fakes or mocks for the service in tests.
+type ContentDirectory3Client interface {
+	GetSearchCapabilities() (SearchCaps string, err error)
+	GetSearchCapabilitiesCtx(ctx context.Context) (SearchCaps string, err error)
+	GetSortCapabilities() (SortCaps string, err error)
+	GetSortCapabilitiesCtx(ctx context.Context) (SortCaps string, err error)
+	GetSortExtensionCapabilities() (SortExtensionCaps string, err error)
+	GetSortExtensionCapabilitiesCtx(ctx context.Context) (SortExtensionCaps string, err error)
+	GetFeatureList() (FeatureList string, err error)
+	GetFeatureListCtx(ctx context.Context) (FeatureList string, err error)
+	GetSystemUpdateID() (Id uint32, err error)
+	GetSystemUpdateIDCtx(ctx context.Context) (Id uint32, err error)
+	GetServiceResetToken() (ResetToken string, err error)
+	GetServiceResetTokenCtx(ctx context.Context) (ResetToken string, err error)
+	Browse(ObjectID string, BrowseFlag ContentDirectory3BrowseFlag, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error)
+	BrowseCtx(ctx context.Context, ObjectID string, BrowseFlag ContentDirectory3BrowseFlag, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error)
+	Search(ContainerID string, SearchCriteria string, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error)
+	SearchCtx(ctx context.Context, ContainerID string, SearchCriteria string, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error)
+	CreateObject(ContainerID string, Elements string) (ObjectID string, Result string, err error)
+	CreateObjectCtx(ctx context.Context, ContainerID string, Elements string) (ObjectID string, Result string, err error)
+	DestroyObject(ObjectID string) (err error)
+	DestroyObjectCtx(ctx context.Context, ObjectID string) (err error)
+	UpdateObject(ObjectID string, CurrentTagValue string, NewTagValue string) (err error)
+	UpdateObjectCtx(ctx context.Context, ObjectID string, CurrentTagValue string, NewTagValue string) (err error)
+	MoveObject(ObjectID string, NewParentID string) (NewObjectID string, err error)
+	MoveObjectCtx(ctx context.Context, ObjectID string, NewParentID string) (NewObjectID string, err error)
+	ImportResource(SourceURI *url.URL, DestinationURI *url.URL) (TransferID uint32, err error)
+	ImportResourceCtx(ctx context.Context, SourceURI *url.URL, DestinationURI *url.URL) (TransferID uint32, err error)
+	ExportResource(SourceURI *url.URL, DestinationURI *url.URL) (TransferID uint32, err error)
+	ExportResourceCtx(ctx context.Context, SourceURI *url.URL, DestinationURI *url.URL) (TransferID uint32, err error)
+	DeleteResource(ResourceURI *url.URL) (err error)
+	DeleteResourceCtx(ctx context.Context, ResourceURI *url.URL) (err error)
+	StopTransferResource(TransferID uint32) (err error)
+	StopTransferResourceCtx(ctx context.Context, TransferID uint32) (err error)
+	GetTransferProgress(TransferID uint32) (TransferStatus ContentDirectory3TransferStatus, TransferLength string, TransferTotal string, err error)
+	GetTransferProgressCtx(ctx context.Context, TransferID uint32) (TransferStatus ContentDirectory3TransferStatus, TransferLength string, TransferTotal string, err error)
+	CreateReference(ContainerID string, ObjectID string) (NewID string, err error)
+	CreateReferenceCtx(ctx context.Context, ContainerID string, ObjectID string) (NewID string, err error)
+	FreeFormQuery(ContainerID string, CDSView uint32, QueryRequest string) (QueryResult string, UpdateID uint32, err error)
+	FreeFormQueryCtx(ctx context.Context, ContainerID string, CDSView uint32, QueryRequest string) (QueryResult string, UpdateID uint32, err error)
+	GetFreeFormQueryCapabilities() (FFQCapabilities string, err error)
+	GetFreeFormQueryCapabilitiesCtx(ctx context.Context) (FFQCapabilities string, err error)
+}
+
+var _ ContentDirectory3Client = new(ContentDirectory3)
+
+// ContentDirectory3BrowseFlag is a value of the state variable A_ARG_TYPE_BrowseFlag of
+// ContentDirectory3.
+type ContentDirectory3BrowseFlag string
+
+// Allowed values of ContentDirectory3BrowseFlag.
+const (
+	ContentDirectory3BrowseFlag_BrowseMetadata       ContentDirectory3BrowseFlag = "BrowseMetadata"
+	ContentDirectory3BrowseFlag_BrowseDirectChildren ContentDirectory3BrowseFlag = "BrowseDirectChildren"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v ContentDirectory3BrowseFlag) Valid() bool {
+	switch v {
+	case ContentDirectory3BrowseFlag_BrowseMetadata,
+		ContentDirectory3BrowseFlag_BrowseDirectChildren:
+		return true
+	}
+	return false
+}
+
+// ContentDirectory3TransferStatus is a value of the state variable A_ARG_TYPE_TransferStatus of
+// ContentDirectory3.
+type ContentDirectory3TransferStatus string
+
+// Allowed values of ContentDirectory3TransferStatus.
+const (
+	ContentDirectory3TransferStatus_COMPLETED   ContentDirectory3TransferStatus = "COMPLETED"
+	ContentDirectory3TransferStatus_ERROR       ContentDirectory3TransferStatus = "ERROR"
+	ContentDirectory3TransferStatus_IN_PROGRESS ContentDirectory3TransferStatus = "IN_PROGRESS"
+	ContentDirectory3TransferStatus_STOPPED     ContentDirectory3TransferStatus = "STOPPED"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v ContentDirectory3TransferStatus) Valid() bool {
+	switch v {
+	case ContentDirectory3TransferStatus_COMPLETED,
+		ContentDirectory3TransferStatus_ERROR,
+		ContentDirectory3TransferStatus_IN_PROGRESS,
+		ContentDirectory3TransferStatus_STOPPED:
+		return true
+	}
+	return false
+}
+
 // NewContentDirectory3Clients discovers instances of the service on the network,
 // and returns clients to any that are found. errors will contain an error for
 // any devices that replied but which could not be queried, and err will be set
@@ -3540,7 +5477,42 @@ func newContentDirectory3ClientsFromGenericClients(genericClients []goupnp.Servi
 	return clients
 }
 
+// ContentDirectory3Timeouts are the default timeouts of the actions of
+// ContentDirectory3, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var ContentDirectory3Timeouts = map[string]time.Duration{
+	"":       10 * time.Second,
+	"Browse": 1 * time.Minute,
+	"Search": 2 * time.Minute,
+}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *ContentDirectory3) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, ContentDirectory3Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_ContentDirectory_3, actionName, request, response)
+}
+
+// ContentDirectory3GetSearchCapabilitiesResponse is the response of GetSearchCapabilities, with each
+// argument in its SOAP string form.
+type ContentDirectory3GetSearchCapabilitiesResponse struct {
+	SearchCaps string
+}
+
+// GetSearchCapabilities performs the GetSearchCapabilities action of the service.
+//
+// Return values:
+//   - SearchCaps (out, state variable A_ARG_TYPE_GetSearchCapabilities_SearchCaps)
 func (client *ContentDirectory3) GetSearchCapabilities() (SearchCaps string, err error) {
+	return client.GetSearchCapabilitiesCtx(context.Background())
+}
+
+// GetSearchCapabilitiesCtx is GetSearchCapabilities with a context, to cancel or time out the call.
+func (client *ContentDirectory3) GetSearchCapabilitiesCtx(ctx context.Context) (SearchCaps string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -3548,12 +5520,10 @@ func (client *ContentDirectory3) GetSearchCapabilities() (SearchCaps string, err
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		SearchCaps string
-	}{}
+	response := &ContentDirectory3GetSearchCapabilitiesResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_3, "GetSearchCapabilities", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetSearchCapabilities", request, response); err != nil {
 		return
 	}
 
@@ -3566,7 +5536,22 @@ func (client *ContentDirectory3) GetSearchCapabilities() (SearchCaps string, err
 	return
 }
 
+// ContentDirectory3GetSortCapabilitiesResponse is the response of GetSortCapabilities, with each
+// argument in its SOAP string form.
+type ContentDirectory3GetSortCapabilitiesResponse struct {
+	SortCaps string
+}
+
+// GetSortCapabilities performs the GetSortCapabilities action of the service.
+//
+// Return values:
+//   - SortCaps (out, state variable A_ARG_TYPE_GetSortCapabilities_SortCaps)
 func (client *ContentDirectory3) GetSortCapabilities() (SortCaps string, err error) {
+	return client.GetSortCapabilitiesCtx(context.Background())
+}
+
+// GetSortCapabilitiesCtx is GetSortCapabilities with a context, to cancel or time out the call.
+func (client *ContentDirectory3) GetSortCapabilitiesCtx(ctx context.Context) (SortCaps string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -3574,12 +5559,10 @@ func (client *ContentDirectory3) GetSortCapabilities() (SortCaps string, err err
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		SortCaps string
-	}{}
+	response := &ContentDirectory3GetSortCapabilitiesResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_3, "GetSortCapabilities", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetSortCapabilities", request, response); err != nil {
 		return
 	}
 
@@ -3592,7 +5575,22 @@ func (client *ContentDirectory3) GetSortCapabilities() (SortCaps string, err err
 	return
 }
 
+// ContentDirectory3GetSortExtensionCapabilitiesResponse is the response of GetSortExtensionCapabilities, with each
+// argument in its SOAP string form.
+type ContentDirectory3GetSortExtensionCapabilitiesResponse struct {
+	SortExtensionCaps string
+}
+
+// GetSortExtensionCapabilities performs the GetSortExtensionCapabilities action of the service.
+//
+// Return values:
+//   - SortExtensionCaps (out, state variable A_ARG_TYPE_GetSortExtensionCapabilities_SortExtensionCaps)
 func (client *ContentDirectory3) GetSortExtensionCapabilities() (SortExtensionCaps string, err error) {
+	return client.GetSortExtensionCapabilitiesCtx(context.Background())
+}
+
+// GetSortExtensionCapabilitiesCtx is GetSortExtensionCapabilities with a context, to cancel or time out the call.
+func (client *ContentDirectory3) GetSortExtensionCapabilitiesCtx(ctx context.Context) (SortExtensionCaps string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -3600,12 +5598,10 @@ func (client *ContentDirectory3) GetSortExtensionCapabilities() (SortExtensionCa
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		SortExtensionCaps string
-	}{}
+	response := &ContentDirectory3GetSortExtensionCapabilitiesResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_3, "GetSortExtensionCapabilities", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetSortExtensionCapabilities", request, response); err != nil {
 		return
 	}
 
@@ -3618,7 +5614,22 @@ func (client *ContentDirectory3) GetSortExtensionCapabilities() (SortExtensionCa
 	return
 }
 
+// ContentDirectory3GetFeatureListResponse is the response of GetFeatureList, with each
+// argument in its SOAP string form.
+type ContentDirectory3GetFeatureListResponse struct {
+	FeatureList string
+}
+
+// GetFeatureList performs the GetFeatureList action of the service.
+//
+// Return values:
+//   - FeatureList (out, state variable A_ARG_TYPE_GetFeatureList_FeatureList)
 func (client *ContentDirectory3) GetFeatureList() (FeatureList string, err error) {
+	return client.GetFeatureListCtx(context.Background())
+}
+
+// GetFeatureListCtx is GetFeatureList with a context, to cancel or time out the call.
+func (client *ContentDirectory3) GetFeatureListCtx(ctx context.Context) (FeatureList string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -3626,12 +5637,10 @@ func (client *ContentDirectory3) GetFeatureList() (FeatureList string, err error
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		FeatureList string
-	}{}
+	response := &ContentDirectory3GetFeatureListResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_3, "GetFeatureList", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetFeatureList", request, response); err != nil {
 		return
 	}
 
@@ -3644,7 +5653,22 @@ func (client *ContentDirectory3) GetFeatureList() (FeatureList string, err error
 	return
 }
 
+// ContentDirectory3GetSystemUpdateIDResponse is the response of GetSystemUpdateID, with each
+// argument in its SOAP string form.
+type ContentDirectory3GetSystemUpdateIDResponse struct {
+	Id string
+}
+
+// GetSystemUpdateID performs the GetSystemUpdateID action of the service.
+//
+// Return values:
+//   - Id (out, state variable A_ARG_TYPE_GetSystemUpdateID_Id)
 func (client *ContentDirectory3) GetSystemUpdateID() (Id uint32, err error) {
+	return client.GetSystemUpdateIDCtx(context.Background())
+}
+
+// GetSystemUpdateIDCtx is GetSystemUpdateID with a context, to cancel or time out the call.
+func (client *ContentDirectory3) GetSystemUpdateIDCtx(ctx context.Context) (Id uint32, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -3652,12 +5676,10 @@ func (client *ContentDirectory3) GetSystemUpdateID() (Id uint32, err error) {
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		Id string
-	}{}
+	response := &ContentDirectory3GetSystemUpdateIDResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_3, "GetSystemUpdateID", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetSystemUpdateID", request, response); err != nil {
 		return
 	}
 
@@ -3670,7 +5692,22 @@ func (client *ContentDirectory3) GetSystemUpdateID() (Id uint32, err error) {
 	return
 }
 
+// ContentDirectory3GetServiceResetTokenResponse is the response of GetServiceResetToken, with each
+// argument in its SOAP string form.
+type ContentDirectory3GetServiceResetTokenResponse struct {
+	ResetToken string
+}
+
+// GetServiceResetToken performs the GetServiceResetToken action of the service.
+//
+// Return values:
+//   - ResetToken (out, state variable A_ARG_TYPE_GetServiceResetToken_ResetToken)
 func (client *ContentDirectory3) GetServiceResetToken() (ResetToken string, err error) {
+	return client.GetServiceResetTokenCtx(context.Background())
+}
+
+// GetServiceResetTokenCtx is GetServiceResetToken with a context, to cancel or time out the call.
+func (client *ContentDirectory3) GetServiceResetTokenCtx(ctx context.Context) (ResetToken string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -3678,12 +5715,10 @@ func (client *ContentDirectory3) GetServiceResetToken() (ResetToken string, err
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		ResetToken string
-	}{}
+	response := &ContentDirectory3GetServiceResetTokenResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_3, "GetServiceResetToken", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetServiceResetToken", request, response); err != nil {
 		return
 	}
 
@@ -3696,32 +5731,55 @@ func (client *ContentDirectory3) GetServiceResetToken() (ResetToken string, err
 	return
 }
 
+// ContentDirectory3BrowseRequest is the request of Browse, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentDirectory3BrowseRequest struct {
+	ObjectID       string
+	BrowseFlag     string
+	Filter         string
+	StartingIndex  string
+	RequestedCount string
+	SortCriteria   string
+}
+
+// ContentDirectory3BrowseResponse is the response of Browse, with each
+// argument in its SOAP string form.
+type ContentDirectory3BrowseResponse struct {
+	Result         string
+	NumberReturned string
+	TotalMatches   string
+	UpdateID       string
+}
+
+// Browse performs the Browse action of the service.
 //
 // Arguments:
+//   - ObjectID (in, state variable A_ARG_TYPE_Browse_ObjectID)
+//   - BrowseFlag (in, state variable A_ARG_TYPE_BrowseFlag): allowed values BrowseMetadata, BrowseDirectChildren
+//   - Filter (in, state variable A_ARG_TYPE_Browse_Filter)
+//   - StartingIndex (in, state variable A_ARG_TYPE_Browse_StartingIndex)
+//   - RequestedCount (in, state variable A_ARG_TYPE_Browse_RequestedCount)
+//   - SortCriteria (in, state variable A_ARG_TYPE_Browse_SortCriteria)
 //
-// * BrowseFlag: allowed values: BrowseMetadata, BrowseDirectChildren
+// Return values:
+//   - Result (out, state variable A_ARG_TYPE_Browse_Result)
+//   - NumberReturned (out, state variable A_ARG_TYPE_Browse_NumberReturned)
+//   - TotalMatches (out, state variable A_ARG_TYPE_Browse_TotalMatches)
+//   - UpdateID (out, state variable A_ARG_TYPE_Browse_UpdateID)
+func (client *ContentDirectory3) Browse(ObjectID string, BrowseFlag ContentDirectory3BrowseFlag, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error) {
+	return client.BrowseCtx(context.Background(), ObjectID, BrowseFlag, Filter, StartingIndex, RequestedCount, SortCriteria)
+}
 
-func (client *ContentDirectory3) Browse(ObjectID string, BrowseFlag string, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error) {
+// BrowseCtx is Browse with a context, to cancel or time out the call.
+func (client *ContentDirectory3) BrowseCtx(ctx context.Context, ObjectID string, BrowseFlag ContentDirectory3BrowseFlag, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error) {
 	// Request structure.
-	request := &struct {
-		ObjectID string
-
-		BrowseFlag string
-
-		Filter string
-
-		StartingIndex string
-
-		RequestedCount string
-
-		SortCriteria string
-	}{}
+	request := &ContentDirectory3BrowseRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.ObjectID, err = soap.MarshalString(ObjectID); err != nil {
 		return
 	}
-	if request.BrowseFlag, err = soap.MarshalString(BrowseFlag); err != nil {
+	if request.BrowseFlag, err = soap.MarshalString(string(BrowseFlag)); err != nil {
 		return
 	}
 	if request.Filter, err = soap.MarshalString(Filter); err != nil {
@@ -3739,18 +5797,10 @@ func (client *ContentDirectory3) Browse(ObjectID string, BrowseFlag string, Filt
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		Result string
-
-		NumberReturned string
-
-		TotalMatches string
-
-		UpdateID string
-	}{}
+	response := &ContentDirectory3BrowseResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_3, "Browse", request, response); err != nil {
+	if err = client.PerformAction(ctx, "Browse", request, response); err != nil {
 		return
 	}
 
@@ -3772,21 +5822,49 @@ func (client *ContentDirectory3) Browse(ObjectID string, BrowseFlag string, Filt
 	return
 }
 
-func (client *ContentDirectory3) Search(ContainerID string, SearchCriteria string, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error) {
-	// Request structure.
-	request := &struct {
-		ContainerID string
-
-		SearchCriteria string
-
-		Filter string
+// ContentDirectory3SearchRequest is the request of Search, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentDirectory3SearchRequest struct {
+	ContainerID    string
+	SearchCriteria string
+	Filter         string
+	StartingIndex  string
+	RequestedCount string
+	SortCriteria   string
+}
 
-		StartingIndex string
+// ContentDirectory3SearchResponse is the response of Search, with each
+// argument in its SOAP string form.
+type ContentDirectory3SearchResponse struct {
+	Result         string
+	NumberReturned string
+	TotalMatches   string
+	UpdateID       string
+}
 
-		RequestedCount string
+// Search performs the Search action of the service.
+//
+// Arguments:
+//   - ContainerID (in, state variable A_ARG_TYPE_Search_ContainerID)
+//   - SearchCriteria (in, state variable A_ARG_TYPE_Search_SearchCriteria)
+//   - Filter (in, state variable A_ARG_TYPE_Search_Filter)
+//   - StartingIndex (in, state variable A_ARG_TYPE_Search_StartingIndex)
+//   - RequestedCount (in, state variable A_ARG_TYPE_Search_RequestedCount)
+//   - SortCriteria (in, state variable A_ARG_TYPE_Search_SortCriteria)
+//
+// Return values:
+//   - Result (out, state variable A_ARG_TYPE_Search_Result)
+//   - NumberReturned (out, state variable A_ARG_TYPE_Search_NumberReturned)
+//   - TotalMatches (out, state variable A_ARG_TYPE_Search_TotalMatches)
+//   - UpdateID (out, state variable A_ARG_TYPE_Search_UpdateID)
+func (client *ContentDirectory3) Search(ContainerID string, SearchCriteria string, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error) {
+	return client.SearchCtx(context.Background(), ContainerID, SearchCriteria, Filter, StartingIndex, RequestedCount, SortCriteria)
+}
 
-		SortCriteria string
-	}{}
+// SearchCtx is Search with a context, to cancel or time out the call.
+func (client *ContentDirectory3) SearchCtx(ctx context.Context, ContainerID string, SearchCriteria string, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error) {
+	// Request structure.
+	request := &ContentDirectory3SearchRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.ContainerID, err = soap.MarshalString(ContainerID); err != nil {
@@ -3810,18 +5888,10 @@ func (client *ContentDirectory3) Search(ContainerID string, SearchCriteria strin
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		Result string
-
-		NumberReturned string
-
-		TotalMatches string
-
-		UpdateID string
-	}{}
+	response := &ContentDirectory3SearchResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_3, "Search", request, response); err != nil {
+	if err = client.PerformAction(ctx, "Search", request, response); err != nil {
 		return
 	}
 
@@ -3843,13 +5913,37 @@ func (client *ContentDirectory3) Search(ContainerID string, SearchCriteria strin
 	return
 }
 
+// ContentDirectory3CreateObjectRequest is the request of CreateObject, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentDirectory3CreateObjectRequest struct {
+	ContainerID string
+	Elements    string
+}
+
+// ContentDirectory3CreateObjectResponse is the response of CreateObject, with each
+// argument in its SOAP string form.
+type ContentDirectory3CreateObjectResponse struct {
+	ObjectID string
+	Result   string
+}
+
+// CreateObject performs the CreateObject action of the service.
+//
+// Arguments:
+//   - ContainerID (in, state variable A_ARG_TYPE_CreateObject_ContainerID)
+//   - Elements (in, state variable A_ARG_TYPE_CreateObject_Elements)
+//
+// Return values:
+//   - ObjectID (out, state variable A_ARG_TYPE_CreateObject_ObjectID)
+//   - Result (out, state variable A_ARG_TYPE_CreateObject_Result)
 func (client *ContentDirectory3) CreateObject(ContainerID string, Elements string) (ObjectID string, Result string, err error) {
-	// Request structure.
-	request := &struct {
-		ContainerID string
+	return client.CreateObjectCtx(context.Background(), ContainerID, Elements)
+}
 
-		Elements string
-	}{}
+// CreateObjectCtx is CreateObject with a context, to cancel or time out the call.
+func (client *ContentDirectory3) CreateObjectCtx(ctx context.Context, ContainerID string, Elements string) (ObjectID string, Result string, err error) {
+	// Request structure.
+	request := &ContentDirectory3CreateObjectRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.ContainerID, err = soap.MarshalString(ContainerID); err != nil {
@@ -3861,14 +5955,10 @@ func (client *ContentDirectory3) CreateObject(ContainerID string, Elements strin
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		ObjectID string
-
-		Result string
-	}{}
+	response := &ContentDirectory3CreateObjectResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_3, "CreateObject", request, response); err != nil {
+	if err = client.PerformAction(ctx, "CreateObject", request, response); err != nil {
 		return
 	}
 
@@ -3884,11 +5974,24 @@ func (client *ContentDirectory3) CreateObject(ContainerID string, Elements strin
 	return
 }
 
+// ContentDirectory3DestroyObjectRequest is the request of DestroyObject, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentDirectory3DestroyObjectRequest struct {
+	ObjectID string
+}
+
+// DestroyObject performs the DestroyObject action of the service.
+//
+// Arguments:
+//   - ObjectID (in, state variable A_ARG_TYPE_DestroyObject_ObjectID)
 func (client *ContentDirectory3) DestroyObject(ObjectID string) (err error) {
+	return client.DestroyObjectCtx(context.Background(), ObjectID)
+}
+
+// DestroyObjectCtx is DestroyObject with a context, to cancel or time out the call.
+func (client *ContentDirectory3) DestroyObjectCtx(ctx context.Context, ObjectID string) (err error) {
 	// Request structure.
-	request := &struct {
-		ObjectID string
-	}{}
+	request := &ContentDirectory3DestroyObjectRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.ObjectID, err = soap.MarshalString(ObjectID); err != nil {
@@ -3900,7 +6003,7 @@ func (client *ContentDirectory3) DestroyObject(ObjectID string) (err error) {
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_3, "DestroyObject", request, response); err != nil {
+	if err = client.PerformAction(ctx, "DestroyObject", request, response); err != nil {
 		return
 	}
 
@@ -3910,15 +6013,28 @@ func (client *ContentDirectory3) DestroyObject(ObjectID string) (err error) {
 	return
 }
 
-func (client *ContentDirectory3) UpdateObject(ObjectID string, CurrentTagValue string, NewTagValue string) (err error) {
-	// Request structure.
-	request := &struct {
-		ObjectID string
+// ContentDirectory3UpdateObjectRequest is the request of UpdateObject, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentDirectory3UpdateObjectRequest struct {
+	ObjectID        string
+	CurrentTagValue string
+	NewTagValue     string
+}
 
-		CurrentTagValue string
+// UpdateObject performs the UpdateObject action of the service.
+//
+// Arguments:
+//   - ObjectID (in, state variable A_ARG_TYPE_UpdateObject_ObjectID)
+//   - CurrentTagValue (in, state variable A_ARG_TYPE_UpdateObject_CurrentTagValue)
+//   - NewTagValue (in, state variable A_ARG_TYPE_UpdateObject_NewTagValue)
+func (client *ContentDirectory3) UpdateObject(ObjectID string, CurrentTagValue string, NewTagValue string) (err error) {
+	return client.UpdateObjectCtx(context.Background(), ObjectID, CurrentTagValue, NewTagValue)
+}
 
-		NewTagValue string
-	}{}
+// UpdateObjectCtx is UpdateObject with a context, to cancel or time out the call.
+func (client *ContentDirectory3) UpdateObjectCtx(ctx context.Context, ObjectID string, CurrentTagValue string, NewTagValue string) (err error) {
+	// Request structure.
+	request := &ContentDirectory3UpdateObjectRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.ObjectID, err = soap.MarshalString(ObjectID); err != nil {
@@ -3936,7 +6052,7 @@ func (client *ContentDirectory3) UpdateObject(ObjectID string, CurrentTagValue s
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_3, "UpdateObject", request, response); err != nil {
+	if err = client.PerformAction(ctx, "UpdateObject", request, response); err != nil {
 		return
 	}
 
@@ -3946,13 +6062,35 @@ func (client *ContentDirectory3) UpdateObject(ObjectID string, CurrentTagValue s
 	return
 }
 
+// ContentDirectory3MoveObjectRequest is the request of MoveObject, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentDirectory3MoveObjectRequest struct {
+	ObjectID    string
+	NewParentID string
+}
+
+// ContentDirectory3MoveObjectResponse is the response of MoveObject, with each
+// argument in its SOAP string form.
+type ContentDirectory3MoveObjectResponse struct {
+	NewObjectID string
+}
+
+// MoveObject performs the MoveObject action of the service.
+//
+// Arguments:
+//   - ObjectID (in, state variable A_ARG_TYPE_MoveObject_ObjectID)
+//   - NewParentID (in, state variable A_ARG_TYPE_MoveObject_NewParentID)
+//
+// Return values:
+//   - NewObjectID (out, state variable A_ARG_TYPE_MoveObject_NewObjectID)
 func (client *ContentDirectory3) MoveObject(ObjectID string, NewParentID string) (NewObjectID string, err error) {
-	// Request structure.
-	request := &struct {
-		ObjectID string
+	return client.MoveObjectCtx(context.Background(), ObjectID, NewParentID)
+}
 
-		NewParentID string
-	}{}
+// MoveObjectCtx is MoveObject with a context, to cancel or time out the call.
+func (client *ContentDirectory3) MoveObjectCtx(ctx context.Context, ObjectID string, NewParentID string) (NewObjectID string, err error) {
+	// Request structure.
+	request := &ContentDirectory3MoveObjectRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.ObjectID, err = soap.MarshalString(ObjectID); err != nil {
@@ -3964,12 +6102,10 @@ func (client *ContentDirectory3) MoveObject(ObjectID string, NewParentID string)
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewObjectID string
-	}{}
+	response := &ContentDirectory3MoveObjectResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_3, "MoveObject", request, response); err != nil {
+	if err = client.PerformAction(ctx, "MoveObject", request, response); err != nil {
 		return
 	}
 
@@ -3982,13 +6118,35 @@ func (client *ContentDirectory3) MoveObject(ObjectID string, NewParentID string)
 	return
 }
 
+// ContentDirectory3ImportResourceRequest is the request of ImportResource, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentDirectory3ImportResourceRequest struct {
+	SourceURI      string
+	DestinationURI string
+}
+
+// ContentDirectory3ImportResourceResponse is the response of ImportResource, with each
+// argument in its SOAP string form.
+type ContentDirectory3ImportResourceResponse struct {
+	TransferID string
+}
+
+// ImportResource performs the ImportResource action of the service.
+//
+// Arguments:
+//   - SourceURI (in, state variable A_ARG_TYPE_ImportResource_SourceURI)
+//   - DestinationURI (in, state variable A_ARG_TYPE_ImportResource_DestinationURI)
+//
+// Return values:
+//   - TransferID (out, state variable A_ARG_TYPE_ImportResource_TransferID)
 func (client *ContentDirectory3) ImportResource(SourceURI *url.URL, DestinationURI *url.URL) (TransferID uint32, err error) {
-	// Request structure.
-	request := &struct {
-		SourceURI string
+	return client.ImportResourceCtx(context.Background(), SourceURI, DestinationURI)
+}
 
-		DestinationURI string
-	}{}
+// ImportResourceCtx is ImportResource with a context, to cancel or time out the call.
+func (client *ContentDirectory3) ImportResourceCtx(ctx context.Context, SourceURI *url.URL, DestinationURI *url.URL) (TransferID uint32, err error) {
+	// Request structure.
+	request := &ContentDirectory3ImportResourceRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.SourceURI, err = soap.MarshalURI(SourceURI); err != nil {
@@ -4000,12 +6158,10 @@ func (client *ContentDirectory3) ImportResource(SourceURI *url.URL, DestinationU
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		TransferID string
-	}{}
+	response := &ContentDirectory3ImportResourceResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_3, "ImportResource", request, response); err != nil {
+	if err = client.PerformAction(ctx, "ImportResource", request, response); err != nil {
 		return
 	}
 
@@ -4018,13 +6174,35 @@ func (client *ContentDirectory3) ImportResource(SourceURI *url.URL, DestinationU
 	return
 }
 
+// ContentDirectory3ExportResourceRequest is the request of ExportResource, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentDirectory3ExportResourceRequest struct {
+	SourceURI      string
+	DestinationURI string
+}
+
+// ContentDirectory3ExportResourceResponse is the response of ExportResource, with each
+// argument in its SOAP string form.
+type ContentDirectory3ExportResourceResponse struct {
+	TransferID string
+}
+
+// ExportResource performs the ExportResource action of the service.
+//
+// Arguments:
+//   - SourceURI (in, state variable A_ARG_TYPE_ExportResource_SourceURI)
+//   - DestinationURI (in, state variable A_ARG_TYPE_ExportResource_DestinationURI)
+//
+// Return values:
+//   - TransferID (out, state variable A_ARG_TYPE_ExportResource_TransferID)
 func (client *ContentDirectory3) ExportResource(SourceURI *url.URL, DestinationURI *url.URL) (TransferID uint32, err error) {
-	// Request structure.
-	request := &struct {
-		SourceURI string
+	return client.ExportResourceCtx(context.Background(), SourceURI, DestinationURI)
+}
 
-		DestinationURI string
-	}{}
+// ExportResourceCtx is ExportResource with a context, to cancel or time out the call.
+func (client *ContentDirectory3) ExportResourceCtx(ctx context.Context, SourceURI *url.URL, DestinationURI *url.URL) (TransferID uint32, err error) {
+	// Request structure.
+	request := &ContentDirectory3ExportResourceRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.SourceURI, err = soap.MarshalURI(SourceURI); err != nil {
@@ -4036,12 +6214,10 @@ func (client *ContentDirectory3) ExportResource(SourceURI *url.URL, DestinationU
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		TransferID string
-	}{}
+	response := &ContentDirectory3ExportResourceResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_3, "ExportResource", request, response); err != nil {
+	if err = client.PerformAction(ctx, "ExportResource", request, response); err != nil {
 		return
 	}
 
@@ -4054,11 +6230,24 @@ func (client *ContentDirectory3) ExportResource(SourceURI *url.URL, DestinationU
 	return
 }
 
+// ContentDirectory3DeleteResourceRequest is the request of DeleteResource, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentDirectory3DeleteResourceRequest struct {
+	ResourceURI string
+}
+
+// DeleteResource performs the DeleteResource action of the service.
+//
+// Arguments:
+//   - ResourceURI (in, state variable A_ARG_TYPE_DeleteResource_ResourceURI)
 func (client *ContentDirectory3) DeleteResource(ResourceURI *url.URL) (err error) {
+	return client.DeleteResourceCtx(context.Background(), ResourceURI)
+}
+
+// DeleteResourceCtx is DeleteResource with a context, to cancel or time out the call.
+func (client *ContentDirectory3) DeleteResourceCtx(ctx context.Context, ResourceURI *url.URL) (err error) {
 	// Request structure.
-	request := &struct {
-		ResourceURI string
-	}{}
+	request := &ContentDirectory3DeleteResourceRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.ResourceURI, err = soap.MarshalURI(ResourceURI); err != nil {
@@ -4070,7 +6259,7 @@ func (client *ContentDirectory3) DeleteResource(ResourceURI *url.URL) (err error
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_3, "DeleteResource", request, response); err != nil {
+	if err = client.PerformAction(ctx, "DeleteResource", request, response); err != nil {
 		return
 	}
 
@@ -4080,11 +6269,24 @@ func (client *ContentDirectory3) DeleteResource(ResourceURI *url.URL) (err error
 	return
 }
 
+// ContentDirectory3StopTransferResourceRequest is the request of StopTransferResource, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentDirectory3StopTransferResourceRequest struct {
+	TransferID string
+}
+
+// StopTransferResource performs the StopTransferResource action of the service.
+//
+// Arguments:
+//   - TransferID (in, state variable A_ARG_TYPE_StopTransferResource_TransferID)
 func (client *ContentDirectory3) StopTransferResource(TransferID uint32) (err error) {
+	return client.StopTransferResourceCtx(context.Background(), TransferID)
+}
+
+// StopTransferResourceCtx is StopTransferResource with a context, to cancel or time out the call.
+func (client *ContentDirectory3) StopTransferResourceCtx(ctx context.Context, TransferID uint32) (err error) {
 	// Request structure.
-	request := &struct {
-		TransferID string
-	}{}
+	request := &ContentDirectory3StopTransferResourceRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.TransferID, err = soap.MarshalUi4(TransferID); err != nil {
@@ -4096,7 +6298,7 @@ func (client *ContentDirectory3) StopTransferResource(TransferID uint32) (err er
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_3, "StopTransferResource", request, response); err != nil {
+	if err = client.PerformAction(ctx, "StopTransferResource", request, response); err != nil {
 		return
 	}
 
@@ -4106,15 +6308,37 @@ func (client *ContentDirectory3) StopTransferResource(TransferID uint32) (err er
 	return
 }
 
+// ContentDirectory3GetTransferProgressRequest is the request of GetTransferProgress, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentDirectory3GetTransferProgressRequest struct {
+	TransferID string
+}
+
+// ContentDirectory3GetTransferProgressResponse is the response of GetTransferProgress, with each
+// argument in its SOAP string form.
+type ContentDirectory3GetTransferProgressResponse struct {
+	TransferStatus string
+	TransferLength string
+	TransferTotal  string
+}
+
+// GetTransferProgress performs the GetTransferProgress action of the service.
 //
-// Return values:
+// Arguments:
+//   - TransferID (in, state variable A_ARG_TYPE_GetTransferProgress_TransferID)
 //
-// * TransferStatus: allowed values: COMPLETED, ERROR, IN_PROGRESS, STOPPED
-func (client *ContentDirectory3) GetTransferProgress(TransferID uint32) (TransferStatus string, TransferLength string, TransferTotal string, err error) {
+// Return values:
+//   - TransferStatus (out, state variable A_ARG_TYPE_TransferStatus): allowed values COMPLETED, ERROR, IN_PROGRESS, STOPPED
+//   - TransferLength (out, state variable A_ARG_TYPE_GetTransferProgress_TransferLength)
+//   - TransferTotal (out, state variable A_ARG_TYPE_GetTransferProgress_TransferTotal)
+func (client *ContentDirectory3) GetTransferProgress(TransferID uint32) (TransferStatus ContentDirectory3TransferStatus, TransferLength string, TransferTotal string, err error) {
+	return client.GetTransferProgressCtx(context.Background(), TransferID)
+}
+
+// GetTransferProgressCtx is GetTransferProgress with a context, to cancel or time out the call.
+func (client *ContentDirectory3) GetTransferProgressCtx(ctx context.Context, TransferID uint32) (TransferStatus ContentDirectory3TransferStatus, TransferLength string, TransferTotal string, err error) {
 	// Request structure.
-	request := &struct {
-		TransferID string
-	}{}
+	request := &ContentDirectory3GetTransferProgressRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.TransferID, err = soap.MarshalUi4(TransferID); err != nil {
@@ -4123,24 +6347,16 @@ func (client *ContentDirectory3) GetTransferProgress(TransferID uint32) (Transfe
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		TransferStatus string
-
-		TransferLength string
-
-		TransferTotal string
-	}{}
+	response := &ContentDirectory3GetTransferProgressResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_3, "GetTransferProgress", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetTransferProgress", request, response); err != nil {
 		return
 	}
 
 	// BEGIN Unmarshal arguments from response.
 
-	if TransferStatus, err = soap.UnmarshalString(response.TransferStatus); err != nil {
-		return
-	}
+	TransferStatus = ContentDirectory3TransferStatus(response.TransferStatus)
 	if TransferLength, err = soap.UnmarshalString(response.TransferLength); err != nil {
 		return
 	}
@@ -4151,13 +6367,35 @@ func (client *ContentDirectory3) GetTransferProgress(TransferID uint32) (Transfe
 	return
 }
 
+// ContentDirectory3CreateReferenceRequest is the request of CreateReference, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentDirectory3CreateReferenceRequest struct {
+	ContainerID string
+	ObjectID    string
+}
+
+// ContentDirectory3CreateReferenceResponse is the response of CreateReference, with each
+// argument in its SOAP string form.
+type ContentDirectory3CreateReferenceResponse struct {
+	NewID string
+}
+
+// CreateReference performs the CreateReference action of the service.
+//
+// Arguments:
+//   - ContainerID (in, state variable A_ARG_TYPE_CreateReference_ContainerID)
+//   - ObjectID (in, state variable A_ARG_TYPE_CreateReference_ObjectID)
+//
+// Return values:
+//   - NewID (out, state variable A_ARG_TYPE_CreateReference_NewID)
 func (client *ContentDirectory3) CreateReference(ContainerID string, ObjectID string) (NewID string, err error) {
-	// Request structure.
-	request := &struct {
-		ContainerID string
+	return client.CreateReferenceCtx(context.Background(), ContainerID, ObjectID)
+}
 
-		ObjectID string
-	}{}
+// CreateReferenceCtx is CreateReference with a context, to cancel or time out the call.
+func (client *ContentDirectory3) CreateReferenceCtx(ctx context.Context, ContainerID string, ObjectID string) (NewID string, err error) {
+	// Request structure.
+	request := &ContentDirectory3CreateReferenceRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.ContainerID, err = soap.MarshalString(ContainerID); err != nil {
@@ -4169,12 +6407,10 @@ func (client *ContentDirectory3) CreateReference(ContainerID string, ObjectID st
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewID string
-	}{}
+	response := &ContentDirectory3CreateReferenceResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_3, "CreateReference", request, response); err != nil {
+	if err = client.PerformAction(ctx, "CreateReference", request, response); err != nil {
 		return
 	}
 
@@ -4187,15 +6423,39 @@ func (client *ContentDirectory3) CreateReference(ContainerID string, ObjectID st
 	return
 }
 
-func (client *ContentDirectory3) FreeFormQuery(ContainerID string, CDSView uint32, QueryRequest string) (QueryResult string, UpdateID uint32, err error) {
-	// Request structure.
-	request := &struct {
-		ContainerID string
+// ContentDirectory3FreeFormQueryRequest is the request of FreeFormQuery, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentDirectory3FreeFormQueryRequest struct {
+	ContainerID  string
+	CDSView      string
+	QueryRequest string
+}
+
+// ContentDirectory3FreeFormQueryResponse is the response of FreeFormQuery, with each
+// argument in its SOAP string form.
+type ContentDirectory3FreeFormQueryResponse struct {
+	QueryResult string
+	UpdateID    string
+}
 
-		CDSView string
+// FreeFormQuery performs the FreeFormQuery action of the service.
+//
+// Arguments:
+//   - ContainerID (in, state variable A_ARG_TYPE_FreeFormQuery_ContainerID)
+//   - CDSView (in, state variable A_ARG_TYPE_FreeFormQuery_CDSView)
+//   - QueryRequest (in, state variable A_ARG_TYPE_FreeFormQuery_QueryRequest)
+//
+// Return values:
+//   - QueryResult (out, state variable A_ARG_TYPE_FreeFormQuery_QueryResult)
+//   - UpdateID (out, state variable A_ARG_TYPE_FreeFormQuery_UpdateID)
+func (client *ContentDirectory3) FreeFormQuery(ContainerID string, CDSView uint32, QueryRequest string) (QueryResult string, UpdateID uint32, err error) {
+	return client.FreeFormQueryCtx(context.Background(), ContainerID, CDSView, QueryRequest)
+}
 
-		QueryRequest string
-	}{}
+// FreeFormQueryCtx is FreeFormQuery with a context, to cancel or time out the call.
+func (client *ContentDirectory3) FreeFormQueryCtx(ctx context.Context, ContainerID string, CDSView uint32, QueryRequest string) (QueryResult string, UpdateID uint32, err error) {
+	// Request structure.
+	request := &ContentDirectory3FreeFormQueryRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.ContainerID, err = soap.MarshalString(ContainerID); err != nil {
@@ -4210,14 +6470,10 @@ func (client *ContentDirectory3) FreeFormQuery(ContainerID string, CDSView uint3
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		QueryResult string
-
-		UpdateID string
-	}{}
+	response := &ContentDirectory3FreeFormQueryResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_3, "FreeFormQuery", request, response); err != nil {
+	if err = client.PerformAction(ctx, "FreeFormQuery", request, response); err != nil {
 		return
 	}
 
@@ -4233,7 +6489,22 @@ func (client *ContentDirectory3) FreeFormQuery(ContainerID string, CDSView uint3
 	return
 }
 
+// ContentDirectory3GetFreeFormQueryCapabilitiesResponse is the response of GetFreeFormQueryCapabilities, with each
+// argument in its SOAP string form.
+type ContentDirectory3GetFreeFormQueryCapabilitiesResponse struct {
+	FFQCapabilities string
+}
+
+// GetFreeFormQueryCapabilities performs the GetFreeFormQueryCapabilities action of the service.
+//
+// Return values:
+//   - FFQCapabilities (out, state variable A_ARG_TYPE_GetFreeFormQueryCapabilities_FFQCapabilities)
 func (client *ContentDirectory3) GetFreeFormQueryCapabilities() (FFQCapabilities string, err error) {
+	return client.GetFreeFormQueryCapabilitiesCtx(context.Background())
+}
+
+// GetFreeFormQueryCapabilitiesCtx is GetFreeFormQueryCapabilities with a context, to cancel or time out the call.
+func (client *ContentDirectory3) GetFreeFormQueryCapabilitiesCtx(ctx context.Context) (FFQCapabilities string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -4241,12 +6512,10 @@ func (client *ContentDirectory3) GetFreeFormQueryCapabilities() (FFQCapabilities
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		FFQCapabilities string
-	}{}
+	response := &ContentDirectory3GetFreeFormQueryCapabilitiesResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ContentDirectory_3, "GetFreeFormQueryCapabilities", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetFreeFormQueryCapabilities", request, response); err != nil {
 		return
 	}
 
@@ -4266,6 +6535,119 @@ type RenderingControl1 struct {
 	goupnp.ServiceClient
 }
 
+// RenderingControl1Client is the interface of the actions of RenderingControl1, for
+// substituting fakes or mocks for the service in tests.
+type RenderingControl1Client interface {
+	ListPresets(InstanceID uint32) (CurrentPresetNameList string, err error)
+	ListPresetsCtx(ctx context.Context, InstanceID uint32) (CurrentPresetNameList string, err error)
+	SelectPreset(InstanceID uint32, PresetName RenderingControl1PresetName) (err error)
+	SelectPresetCtx(ctx context.Context, InstanceID uint32, PresetName RenderingControl1PresetName) (err error)
+	GetBrightness(InstanceID uint32) (CurrentBrightness uint16, err error)
+	GetBrightnessCtx(ctx context.Context, InstanceID uint32) (CurrentBrightness uint16, err error)
+	SetBrightness(InstanceID uint32, DesiredBrightness uint16) (err error)
+	SetBrightnessCtx(ctx context.Context, InstanceID uint32, DesiredBrightness uint16) (err error)
+	GetContrast(InstanceID uint32) (CurrentContrast uint16, err error)
+	GetContrastCtx(ctx context.Context, InstanceID uint32) (CurrentContrast uint16, err error)
+	SetContrast(InstanceID uint32, DesiredContrast uint16) (err error)
+	SetContrastCtx(ctx context.Context, InstanceID uint32, DesiredContrast uint16) (err error)
+	GetSharpness(InstanceID uint32) (CurrentSharpness uint16, err error)
+	GetSharpnessCtx(ctx context.Context, InstanceID uint32) (CurrentSharpness uint16, err error)
+	SetSharpness(InstanceID uint32, DesiredSharpness uint16) (err error)
+	SetSharpnessCtx(ctx context.Context, InstanceID uint32, DesiredSharpness uint16) (err error)
+	GetRedVideoGain(InstanceID uint32) (CurrentRedVideoGain uint16, err error)
+	GetRedVideoGainCtx(ctx context.Context, InstanceID uint32) (CurrentRedVideoGain uint16, err error)
+	SetRedVideoGain(InstanceID uint32, DesiredRedVideoGain uint16) (err error)
+	SetRedVideoGainCtx(ctx context.Context, InstanceID uint32, DesiredRedVideoGain uint16) (err error)
+	GetGreenVideoGain(InstanceID uint32) (CurrentGreenVideoGain uint16, err error)
+	GetGreenVideoGainCtx(ctx context.Context, InstanceID uint32) (CurrentGreenVideoGain uint16, err error)
+	SetGreenVideoGain(InstanceID uint32, DesiredGreenVideoGain uint16) (err error)
+	SetGreenVideoGainCtx(ctx context.Context, InstanceID uint32, DesiredGreenVideoGain uint16) (err error)
+	GetBlueVideoGain(InstanceID uint32) (CurrentBlueVideoGain uint16, err error)
+	GetBlueVideoGainCtx(ctx context.Context, InstanceID uint32) (CurrentBlueVideoGain uint16, err error)
+	SetBlueVideoGain(InstanceID uint32, DesiredBlueVideoGain uint16) (err error)
+	SetBlueVideoGainCtx(ctx context.Context, InstanceID uint32, DesiredBlueVideoGain uint16) (err error)
+	GetRedVideoBlackLevel(InstanceID uint32) (CurrentRedVideoBlackLevel uint16, err error)
+	GetRedVideoBlackLevelCtx(ctx context.Context, InstanceID uint32) (CurrentRedVideoBlackLevel uint16, err error)
+	SetRedVideoBlackLevel(InstanceID uint32, DesiredRedVideoBlackLevel uint16) (err error)
+	SetRedVideoBlackLevelCtx(ctx context.Context, InstanceID uint32, DesiredRedVideoBlackLevel uint16) (err error)
+	GetGreenVideoBlackLevel(InstanceID uint32) (CurrentGreenVideoBlackLevel uint16, err error)
+	GetGreenVideoBlackLevelCtx(ctx context.Context, InstanceID uint32) (CurrentGreenVideoBlackLevel uint16, err error)
+	SetGreenVideoBlackLevel(InstanceID uint32, DesiredGreenVideoBlackLevel uint16) (err error)
+	SetGreenVideoBlackLevelCtx(ctx context.Context, InstanceID uint32, DesiredGreenVideoBlackLevel uint16) (err error)
+	GetBlueVideoBlackLevel(InstanceID uint32) (CurrentBlueVideoBlackLevel uint16, err error)
+	GetBlueVideoBlackLevelCtx(ctx context.Context, InstanceID uint32) (CurrentBlueVideoBlackLevel uint16, err error)
+	SetBlueVideoBlackLevel(InstanceID uint32, DesiredBlueVideoBlackLevel uint16) (err error)
+	SetBlueVideoBlackLevelCtx(ctx context.Context, InstanceID uint32, DesiredBlueVideoBlackLevel uint16) (err error)
+	GetColorTemperature(InstanceID uint32) (CurrentColorTemperature uint16, err error)
+	GetColorTemperatureCtx(ctx context.Context, InstanceID uint32) (CurrentColorTemperature uint16, err error)
+	SetColorTemperature(InstanceID uint32, DesiredColorTemperature uint16) (err error)
+	SetColorTemperatureCtx(ctx context.Context, InstanceID uint32, DesiredColorTemperature uint16) (err error)
+	GetHorizontalKeystone(InstanceID uint32) (CurrentHorizontalKeystone int16, err error)
+	GetHorizontalKeystoneCtx(ctx context.Context, InstanceID uint32) (CurrentHorizontalKeystone int16, err error)
+	SetHorizontalKeystone(InstanceID uint32, DesiredHorizontalKeystone int16) (err error)
+	SetHorizontalKeystoneCtx(ctx context.Context, InstanceID uint32, DesiredHorizontalKeystone int16) (err error)
+	GetVerticalKeystone(InstanceID uint32) (CurrentVerticalKeystone int16, err error)
+	GetVerticalKeystoneCtx(ctx context.Context, InstanceID uint32) (CurrentVerticalKeystone int16, err error)
+	SetVerticalKeystone(InstanceID uint32, DesiredVerticalKeystone int16) (err error)
+	SetVerticalKeystoneCtx(ctx context.Context, InstanceID uint32, DesiredVerticalKeystone int16) (err error)
+	GetMute(InstanceID uint32, Channel RenderingControl1Channel) (CurrentMute bool, err error)
+	GetMuteCtx(ctx context.Context, InstanceID uint32, Channel RenderingControl1Channel) (CurrentMute bool, err error)
+	SetMute(InstanceID uint32, Channel RenderingControl1Channel, DesiredMute bool) (err error)
+	SetMuteCtx(ctx context.Context, InstanceID uint32, Channel RenderingControl1Channel, DesiredMute bool) (err error)
+	GetVolume(InstanceID uint32, Channel RenderingControl1Channel) (CurrentVolume uint16, err error)
+	GetVolumeCtx(ctx context.Context, InstanceID uint32, Channel RenderingControl1Channel) (CurrentVolume uint16, err error)
+	SetVolume(InstanceID uint32, Channel RenderingControl1Channel, DesiredVolume uint16) (err error)
+	SetVolumeCtx(ctx context.Context, InstanceID uint32, Channel RenderingControl1Channel, DesiredVolume uint16) (err error)
+	GetVolumeDB(InstanceID uint32, Channel RenderingControl1Channel) (CurrentVolume int16, err error)
+	GetVolumeDBCtx(ctx context.Context, InstanceID uint32, Channel RenderingControl1Channel) (CurrentVolume int16, err error)
+	SetVolumeDB(InstanceID uint32, Channel RenderingControl1Channel, DesiredVolume int16) (err error)
+	SetVolumeDBCtx(ctx context.Context, InstanceID uint32, Channel RenderingControl1Channel, DesiredVolume int16) (err error)
+	GetVolumeDBRange(InstanceID uint32, Channel RenderingControl1Channel) (MinValue int16, MaxValue int16, err error)
+	GetVolumeDBRangeCtx(ctx context.Context, InstanceID uint32, Channel RenderingControl1Channel) (MinValue int16, MaxValue int16, err error)
+	GetLoudness(InstanceID uint32, Channel RenderingControl1Channel) (CurrentLoudness bool, err error)
+	GetLoudnessCtx(ctx context.Context, InstanceID uint32, Channel RenderingControl1Channel) (CurrentLoudness bool, err error)
+	SetLoudness(InstanceID uint32, Channel RenderingControl1Channel, DesiredLoudness bool) (err error)
+	SetLoudnessCtx(ctx context.Context, InstanceID uint32, Channel RenderingControl1Channel, DesiredLoudness bool) (err error)
+}
+
+var _ RenderingControl1Client = new(RenderingControl1)
+
+// RenderingControl1PresetName is a value of the state variable A_ARG_TYPE_PresetName of
+// RenderingControl1.
+type RenderingControl1PresetName string
+
+// Allowed values of RenderingControl1PresetName.
+const (
+	RenderingControl1PresetName_FactoryDefaults RenderingControl1PresetName = "FactoryDefaults"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v RenderingControl1PresetName) Valid() bool {
+	switch v {
+	case RenderingControl1PresetName_FactoryDefaults:
+		return true
+	}
+	return false
+}
+
+// RenderingControl1Channel is a value of the state variable A_ARG_TYPE_Channel of
+// RenderingControl1.
+type RenderingControl1Channel string
+
+// Allowed values of RenderingControl1Channel.
+const (
+	RenderingControl1Channel_Master RenderingControl1Channel = "Master"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v RenderingControl1Channel) Valid() bool {
+	switch v {
+	case RenderingControl1Channel_Master:
+		return true
+	}
+	return false
+}
+
 // NewRenderingControl1Clients discovers instances of the service on the network,
 // and returns clients to any that are found. errors will contain an error for
 // any devices that replied but which could not be queried, and err will be set
@@ -4319,11 +6701,51 @@ func newRenderingControl1ClientsFromGenericClients(genericClients []goupnp.Servi
 	return clients
 }
 
+// RenderingControl1Timeouts are the default timeouts of the actions of
+// RenderingControl1, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var RenderingControl1Timeouts = map[string]time.Duration{
+	"": 10 * time.Second,
+}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *RenderingControl1) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, RenderingControl1Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_RenderingControl_1, actionName, request, response)
+}
+
+// RenderingControl1ListPresetsRequest is the request of ListPresets, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl1ListPresetsRequest struct {
+	InstanceID string
+}
+
+// RenderingControl1ListPresetsResponse is the response of ListPresets, with each
+// argument in its SOAP string form.
+type RenderingControl1ListPresetsResponse struct {
+	CurrentPresetNameList string
+}
+
+// ListPresets performs the ListPresets action of the service.
+//
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_ListPresets_InstanceID)
+//
+// Return values:
+//   - CurrentPresetNameList (out, state variable A_ARG_TYPE_ListPresets_CurrentPresetNameList)
 func (client *RenderingControl1) ListPresets(InstanceID uint32) (CurrentPresetNameList string, err error) {
+	return client.ListPresetsCtx(context.Background(), InstanceID)
+}
+
+// ListPresetsCtx is ListPresets with a context, to cancel or time out the call.
+func (client *RenderingControl1) ListPresetsCtx(ctx context.Context, InstanceID uint32) (CurrentPresetNameList string, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &RenderingControl1ListPresetsRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -4332,12 +6754,10 @@ func (client *RenderingControl1) ListPresets(InstanceID uint32) (CurrentPresetNa
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentPresetNameList string
-	}{}
+	response := &RenderingControl1ListPresetsResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_1, "ListPresets", request, response); err != nil {
+	if err = client.PerformAction(ctx, "ListPresets", request, response); err != nil {
 		return
 	}
 
@@ -4350,24 +6770,32 @@ func (client *RenderingControl1) ListPresets(InstanceID uint32) (CurrentPresetNa
 	return
 }
 
+// RenderingControl1SelectPresetRequest is the request of SelectPreset, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl1SelectPresetRequest struct {
+	InstanceID string
+	PresetName string
+}
+
+// SelectPreset performs the SelectPreset action of the service.
 //
 // Arguments:
-//
-// * PresetName: allowed values: FactoryDefaults
+//   - InstanceID (in, state variable A_ARG_TYPE_SelectPreset_InstanceID)
+//   - PresetName (in, state variable A_ARG_TYPE_PresetName): allowed values FactoryDefaults
+func (client *RenderingControl1) SelectPreset(InstanceID uint32, PresetName RenderingControl1PresetName) (err error) {
+	return client.SelectPresetCtx(context.Background(), InstanceID, PresetName)
+}
 
-func (client *RenderingControl1) SelectPreset(InstanceID uint32, PresetName string) (err error) {
+// SelectPresetCtx is SelectPreset with a context, to cancel or time out the call.
+func (client *RenderingControl1) SelectPresetCtx(ctx context.Context, InstanceID uint32, PresetName RenderingControl1PresetName) (err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-
-		PresetName string
-	}{}
+	request := &RenderingControl1SelectPresetRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
 		return
 	}
-	if request.PresetName, err = soap.MarshalString(PresetName); err != nil {
+	if request.PresetName, err = soap.MarshalString(string(PresetName)); err != nil {
 		return
 	}
 	// END Marshal arguments into request.
@@ -4376,7 +6804,7 @@ func (client *RenderingControl1) SelectPreset(InstanceID uint32, PresetName stri
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_1, "SelectPreset", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SelectPreset", request, response); err != nil {
 		return
 	}
 
@@ -4386,15 +6814,33 @@ func (client *RenderingControl1) SelectPreset(InstanceID uint32, PresetName stri
 	return
 }
 
+// RenderingControl1GetBrightnessRequest is the request of GetBrightness, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl1GetBrightnessRequest struct {
+	InstanceID string
+}
+
+// RenderingControl1GetBrightnessResponse is the response of GetBrightness, with each
+// argument in its SOAP string form.
+type RenderingControl1GetBrightnessResponse struct {
+	CurrentBrightness string
+}
+
+// GetBrightness performs the GetBrightness action of the service.
 //
-// Return values:
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetBrightness_InstanceID)
 //
-// * CurrentBrightness: allowed value range: minimum=0, step=1
+// Return values:
+//   - CurrentBrightness (out, state variable A_ARG_TYPE_GetBrightness_CurrentBrightness): allowed range minimum=0, step=1
 func (client *RenderingControl1) GetBrightness(InstanceID uint32) (CurrentBrightness uint16, err error) {
+	return client.GetBrightnessCtx(context.Background(), InstanceID)
+}
+
+// GetBrightnessCtx is GetBrightness with a context, to cancel or time out the call.
+func (client *RenderingControl1) GetBrightnessCtx(ctx context.Context, InstanceID uint32) (CurrentBrightness uint16, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &RenderingControl1GetBrightnessRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -4403,12 +6849,10 @@ func (client *RenderingControl1) GetBrightness(InstanceID uint32) (CurrentBright
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentBrightness string
-	}{}
+	response := &RenderingControl1GetBrightnessResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_1, "GetBrightness", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetBrightness", request, response); err != nil {
 		return
 	}
 
@@ -4421,18 +6865,26 @@ func (client *RenderingControl1) GetBrightness(InstanceID uint32) (CurrentBright
 	return
 }
 
+// RenderingControl1SetBrightnessRequest is the request of SetBrightness, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl1SetBrightnessRequest struct {
+	InstanceID        string
+	DesiredBrightness string
+}
+
+// SetBrightness performs the SetBrightness action of the service.
 //
 // Arguments:
-//
-// * DesiredBrightness: allowed value range: minimum=0, step=1
-
+//   - InstanceID (in, state variable A_ARG_TYPE_SetBrightness_InstanceID)
+//   - DesiredBrightness (in, state variable A_ARG_TYPE_SetBrightness_DesiredBrightness): allowed range minimum=0, step=1
 func (client *RenderingControl1) SetBrightness(InstanceID uint32, DesiredBrightness uint16) (err error) {
-	// Request structure.
-	request := &struct {
-		InstanceID string
+	return client.SetBrightnessCtx(context.Background(), InstanceID, DesiredBrightness)
+}
 
-		DesiredBrightness string
-	}{}
+// SetBrightnessCtx is SetBrightness with a context, to cancel or time out the call.
+func (client *RenderingControl1) SetBrightnessCtx(ctx context.Context, InstanceID uint32, DesiredBrightness uint16) (err error) {
+	// Request structure.
+	request := &RenderingControl1SetBrightnessRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -4447,7 +6899,7 @@ func (client *RenderingControl1) SetBrightness(InstanceID uint32, DesiredBrightn
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_1, "SetBrightness", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetBrightness", request, response); err != nil {
 		return
 	}
 
@@ -4457,15 +6909,33 @@ func (client *RenderingControl1) SetBrightness(InstanceID uint32, DesiredBrightn
 	return
 }
 
+// RenderingControl1GetContrastRequest is the request of GetContrast, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl1GetContrastRequest struct {
+	InstanceID string
+}
+
+// RenderingControl1GetContrastResponse is the response of GetContrast, with each
+// argument in its SOAP string form.
+type RenderingControl1GetContrastResponse struct {
+	CurrentContrast string
+}
+
+// GetContrast performs the GetContrast action of the service.
 //
-// Return values:
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetContrast_InstanceID)
 //
-// * CurrentContrast: allowed value range: minimum=0, step=1
+// Return values:
+//   - CurrentContrast (out, state variable A_ARG_TYPE_GetContrast_CurrentContrast): allowed range minimum=0, step=1
 func (client *RenderingControl1) GetContrast(InstanceID uint32) (CurrentContrast uint16, err error) {
+	return client.GetContrastCtx(context.Background(), InstanceID)
+}
+
+// GetContrastCtx is GetContrast with a context, to cancel or time out the call.
+func (client *RenderingControl1) GetContrastCtx(ctx context.Context, InstanceID uint32) (CurrentContrast uint16, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &RenderingControl1GetContrastRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -4474,12 +6944,10 @@ func (client *RenderingControl1) GetContrast(InstanceID uint32) (CurrentContrast
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentContrast string
-	}{}
+	response := &RenderingControl1GetContrastResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_1, "GetContrast", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetContrast", request, response); err != nil {
 		return
 	}
 
@@ -4492,18 +6960,26 @@ func (client *RenderingControl1) GetContrast(InstanceID uint32) (CurrentContrast
 	return
 }
 
+// RenderingControl1SetContrastRequest is the request of SetContrast, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl1SetContrastRequest struct {
+	InstanceID      string
+	DesiredContrast string
+}
+
+// SetContrast performs the SetContrast action of the service.
 //
 // Arguments:
-//
-// * DesiredContrast: allowed value range: minimum=0, step=1
-
+//   - InstanceID (in, state variable A_ARG_TYPE_SetContrast_InstanceID)
+//   - DesiredContrast (in, state variable A_ARG_TYPE_SetContrast_DesiredContrast): allowed range minimum=0, step=1
 func (client *RenderingControl1) SetContrast(InstanceID uint32, DesiredContrast uint16) (err error) {
-	// Request structure.
-	request := &struct {
-		InstanceID string
+	return client.SetContrastCtx(context.Background(), InstanceID, DesiredContrast)
+}
 
-		DesiredContrast string
-	}{}
+// SetContrastCtx is SetContrast with a context, to cancel or time out the call.
+func (client *RenderingControl1) SetContrastCtx(ctx context.Context, InstanceID uint32, DesiredContrast uint16) (err error) {
+	// Request structure.
+	request := &RenderingControl1SetContrastRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -4518,7 +6994,7 @@ func (client *RenderingControl1) SetContrast(InstanceID uint32, DesiredContrast
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_1, "SetContrast", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetContrast", request, response); err != nil {
 		return
 	}
 
@@ -4528,15 +7004,33 @@ func (client *RenderingControl1) SetContrast(InstanceID uint32, DesiredContrast
 	return
 }
 
+// RenderingControl1GetSharpnessRequest is the request of GetSharpness, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl1GetSharpnessRequest struct {
+	InstanceID string
+}
+
+// RenderingControl1GetSharpnessResponse is the response of GetSharpness, with each
+// argument in its SOAP string form.
+type RenderingControl1GetSharpnessResponse struct {
+	CurrentSharpness string
+}
+
+// GetSharpness performs the GetSharpness action of the service.
 //
-// Return values:
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetSharpness_InstanceID)
 //
-// * CurrentSharpness: allowed value range: minimum=0, step=1
+// Return values:
+//   - CurrentSharpness (out, state variable A_ARG_TYPE_GetSharpness_CurrentSharpness): allowed range minimum=0, step=1
 func (client *RenderingControl1) GetSharpness(InstanceID uint32) (CurrentSharpness uint16, err error) {
+	return client.GetSharpnessCtx(context.Background(), InstanceID)
+}
+
+// GetSharpnessCtx is GetSharpness with a context, to cancel or time out the call.
+func (client *RenderingControl1) GetSharpnessCtx(ctx context.Context, InstanceID uint32) (CurrentSharpness uint16, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &RenderingControl1GetSharpnessRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -4545,12 +7039,10 @@ func (client *RenderingControl1) GetSharpness(InstanceID uint32) (CurrentSharpne
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentSharpness string
-	}{}
+	response := &RenderingControl1GetSharpnessResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_1, "GetSharpness", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetSharpness", request, response); err != nil {
 		return
 	}
 
@@ -4563,18 +7055,26 @@ func (client *RenderingControl1) GetSharpness(InstanceID uint32) (CurrentSharpne
 	return
 }
 
+// RenderingControl1SetSharpnessRequest is the request of SetSharpness, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl1SetSharpnessRequest struct {
+	InstanceID       string
+	DesiredSharpness string
+}
+
+// SetSharpness performs the SetSharpness action of the service.
 //
 // Arguments:
-//
-// * DesiredSharpness: allowed value range: minimum=0, step=1
-
+//   - InstanceID (in, state variable A_ARG_TYPE_SetSharpness_InstanceID)
+//   - DesiredSharpness (in, state variable A_ARG_TYPE_SetSharpness_DesiredSharpness): allowed range minimum=0, step=1
 func (client *RenderingControl1) SetSharpness(InstanceID uint32, DesiredSharpness uint16) (err error) {
-	// Request structure.
-	request := &struct {
-		InstanceID string
+	return client.SetSharpnessCtx(context.Background(), InstanceID, DesiredSharpness)
+}
 
-		DesiredSharpness string
-	}{}
+// SetSharpnessCtx is SetSharpness with a context, to cancel or time out the call.
+func (client *RenderingControl1) SetSharpnessCtx(ctx context.Context, InstanceID uint32, DesiredSharpness uint16) (err error) {
+	// Request structure.
+	request := &RenderingControl1SetSharpnessRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -4589,7 +7089,7 @@ func (client *RenderingControl1) SetSharpness(InstanceID uint32, DesiredSharpnes
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_1, "SetSharpness", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetSharpness", request, response); err != nil {
 		return
 	}
 
@@ -4599,11 +7099,33 @@ func (client *RenderingControl1) SetSharpness(InstanceID uint32, DesiredSharpnes
 	return
 }
 
+// RenderingControl1GetRedVideoGainRequest is the request of GetRedVideoGain, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl1GetRedVideoGainRequest struct {
+	InstanceID string
+}
+
+// RenderingControl1GetRedVideoGainResponse is the response of GetRedVideoGain, with each
+// argument in its SOAP string form.
+type RenderingControl1GetRedVideoGainResponse struct {
+	CurrentRedVideoGain string
+}
+
+// GetRedVideoGain performs the GetRedVideoGain action of the service.
+//
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetRedVideoGain_InstanceID)
+//
+// Return values:
+//   - CurrentRedVideoGain (out, state variable A_ARG_TYPE_GetRedVideoGain_CurrentRedVideoGain)
 func (client *RenderingControl1) GetRedVideoGain(InstanceID uint32) (CurrentRedVideoGain uint16, err error) {
+	return client.GetRedVideoGainCtx(context.Background(), InstanceID)
+}
+
+// GetRedVideoGainCtx is GetRedVideoGain with a context, to cancel or time out the call.
+func (client *RenderingControl1) GetRedVideoGainCtx(ctx context.Context, InstanceID uint32) (CurrentRedVideoGain uint16, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &RenderingControl1GetRedVideoGainRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -4612,12 +7134,10 @@ func (client *RenderingControl1) GetRedVideoGain(InstanceID uint32) (CurrentRedV
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentRedVideoGain string
-	}{}
+	response := &RenderingControl1GetRedVideoGainResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_1, "GetRedVideoGain", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetRedVideoGain", request, response); err != nil {
 		return
 	}
 
@@ -4630,13 +7150,26 @@ func (client *RenderingControl1) GetRedVideoGain(InstanceID uint32) (CurrentRedV
 	return
 }
 
+// RenderingControl1SetRedVideoGainRequest is the request of SetRedVideoGain, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl1SetRedVideoGainRequest struct {
+	InstanceID          string
+	DesiredRedVideoGain string
+}
+
+// SetRedVideoGain performs the SetRedVideoGain action of the service.
+//
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_SetRedVideoGain_InstanceID)
+//   - DesiredRedVideoGain (in, state variable A_ARG_TYPE_SetRedVideoGain_DesiredRedVideoGain)
 func (client *RenderingControl1) SetRedVideoGain(InstanceID uint32, DesiredRedVideoGain uint16) (err error) {
-	// Request structure.
-	request := &struct {
-		InstanceID string
+	return client.SetRedVideoGainCtx(context.Background(), InstanceID, DesiredRedVideoGain)
+}
 
-		DesiredRedVideoGain string
-	}{}
+// SetRedVideoGainCtx is SetRedVideoGain with a context, to cancel or time out the call.
+func (client *RenderingControl1) SetRedVideoGainCtx(ctx context.Context, InstanceID uint32, DesiredRedVideoGain uint16) (err error) {
+	// Request structure.
+	request := &RenderingControl1SetRedVideoGainRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -4651,7 +7184,7 @@ func (client *RenderingControl1) SetRedVideoGain(InstanceID uint32, DesiredRedVi
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_1, "SetRedVideoGain", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetRedVideoGain", request, response); err != nil {
 		return
 	}
 
@@ -4661,15 +7194,33 @@ func (client *RenderingControl1) SetRedVideoGain(InstanceID uint32, DesiredRedVi
 	return
 }
 
+// RenderingControl1GetGreenVideoGainRequest is the request of GetGreenVideoGain, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl1GetGreenVideoGainRequest struct {
+	InstanceID string
+}
+
+// RenderingControl1GetGreenVideoGainResponse is the response of GetGreenVideoGain, with each
+// argument in its SOAP string form.
+type RenderingControl1GetGreenVideoGainResponse struct {
+	CurrentGreenVideoGain string
+}
+
+// GetGreenVideoGain performs the GetGreenVideoGain action of the service.
 //
-// Return values:
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetGreenVideoGain_InstanceID)
 //
-// * CurrentGreenVideoGain: allowed value range: minimum=0, step=1
+// Return values:
+//   - CurrentGreenVideoGain (out, state variable A_ARG_TYPE_GetGreenVideoGain_CurrentGreenVideoGain): allowed range minimum=0, step=1
 func (client *RenderingControl1) GetGreenVideoGain(InstanceID uint32) (CurrentGreenVideoGain uint16, err error) {
+	return client.GetGreenVideoGainCtx(context.Background(), InstanceID)
+}
+
+// GetGreenVideoGainCtx is GetGreenVideoGain with a context, to cancel or time out the call.
+func (client *RenderingControl1) GetGreenVideoGainCtx(ctx context.Context, InstanceID uint32) (CurrentGreenVideoGain uint16, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &RenderingControl1GetGreenVideoGainRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -4678,12 +7229,10 @@ func (client *RenderingControl1) GetGreenVideoGain(InstanceID uint32) (CurrentGr
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentGreenVideoGain string
-	}{}
+	response := &RenderingControl1GetGreenVideoGainResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_1, "GetGreenVideoGain", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetGreenVideoGain", request, response); err != nil {
 		return
 	}
 
@@ -4696,18 +7245,26 @@ func (client *RenderingControl1) GetGreenVideoGain(InstanceID uint32) (CurrentGr
 	return
 }
 
+// RenderingControl1SetGreenVideoGainRequest is the request of SetGreenVideoGain, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl1SetGreenVideoGainRequest struct {
+	InstanceID            string
+	DesiredGreenVideoGain string
+}
+
+// SetGreenVideoGain performs the SetGreenVideoGain action of the service.
 //
 // Arguments:
-//
-// * DesiredGreenVideoGain: allowed value range: minimum=0, step=1
-
+//   - InstanceID (in, state variable A_ARG_TYPE_SetGreenVideoGain_InstanceID)
+//   - DesiredGreenVideoGain (in, state variable A_ARG_TYPE_SetGreenVideoGain_DesiredGreenVideoGain): allowed range minimum=0, step=1
 func (client *RenderingControl1) SetGreenVideoGain(InstanceID uint32, DesiredGreenVideoGain uint16) (err error) {
-	// Request structure.
-	request := &struct {
-		InstanceID string
+	return client.SetGreenVideoGainCtx(context.Background(), InstanceID, DesiredGreenVideoGain)
+}
 
-		DesiredGreenVideoGain string
-	}{}
+// SetGreenVideoGainCtx is SetGreenVideoGain with a context, to cancel or time out the call.
+func (client *RenderingControl1) SetGreenVideoGainCtx(ctx context.Context, InstanceID uint32, DesiredGreenVideoGain uint16) (err error) {
+	// Request structure.
+	request := &RenderingControl1SetGreenVideoGainRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -4722,7 +7279,7 @@ func (client *RenderingControl1) SetGreenVideoGain(InstanceID uint32, DesiredGre
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_1, "SetGreenVideoGain", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetGreenVideoGain", request, response); err != nil {
 		return
 	}
 
@@ -4732,15 +7289,33 @@ func (client *RenderingControl1) SetGreenVideoGain(InstanceID uint32, DesiredGre
 	return
 }
 
+// RenderingControl1GetBlueVideoGainRequest is the request of GetBlueVideoGain, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl1GetBlueVideoGainRequest struct {
+	InstanceID string
+}
+
+// RenderingControl1GetBlueVideoGainResponse is the response of GetBlueVideoGain, with each
+// argument in its SOAP string form.
+type RenderingControl1GetBlueVideoGainResponse struct {
+	CurrentBlueVideoGain string
+}
+
+// GetBlueVideoGain performs the GetBlueVideoGain action of the service.
 //
-// Return values:
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetBlueVideoGain_InstanceID)
 //
-// * CurrentBlueVideoGain: allowed value range: minimum=0, step=1
+// Return values:
+//   - CurrentBlueVideoGain (out, state variable A_ARG_TYPE_GetBlueVideoGain_CurrentBlueVideoGain): allowed range minimum=0, step=1
 func (client *RenderingControl1) GetBlueVideoGain(InstanceID uint32) (CurrentBlueVideoGain uint16, err error) {
+	return client.GetBlueVideoGainCtx(context.Background(), InstanceID)
+}
+
+// GetBlueVideoGainCtx is GetBlueVideoGain with a context, to cancel or time out the call.
+func (client *RenderingControl1) GetBlueVideoGainCtx(ctx context.Context, InstanceID uint32) (CurrentBlueVideoGain uint16, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &RenderingControl1GetBlueVideoGainRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -4749,12 +7324,10 @@ func (client *RenderingControl1) GetBlueVideoGain(InstanceID uint32) (CurrentBlu
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentBlueVideoGain string
-	}{}
+	response := &RenderingControl1GetBlueVideoGainResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_1, "GetBlueVideoGain", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetBlueVideoGain", request, response); err != nil {
 		return
 	}
 
@@ -4767,18 +7340,26 @@ func (client *RenderingControl1) GetBlueVideoGain(InstanceID uint32) (CurrentBlu
 	return
 }
 
+// RenderingControl1SetBlueVideoGainRequest is the request of SetBlueVideoGain, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl1SetBlueVideoGainRequest struct {
+	InstanceID           string
+	DesiredBlueVideoGain string
+}
+
+// SetBlueVideoGain performs the SetBlueVideoGain action of the service.
 //
 // Arguments:
-//
-// * DesiredBlueVideoGain: allowed value range: minimum=0, step=1
-
+//   - InstanceID (in, state variable A_ARG_TYPE_SetBlueVideoGain_InstanceID)
+//   - DesiredBlueVideoGain (in, state variable A_ARG_TYPE_SetBlueVideoGain_DesiredBlueVideoGain): allowed range minimum=0, step=1
 func (client *RenderingControl1) SetBlueVideoGain(InstanceID uint32, DesiredBlueVideoGain uint16) (err error) {
-	// Request structure.
-	request := &struct {
-		InstanceID string
+	return client.SetBlueVideoGainCtx(context.Background(), InstanceID, DesiredBlueVideoGain)
+}
 
-		DesiredBlueVideoGain string
-	}{}
+// SetBlueVideoGainCtx is SetBlueVideoGain with a context, to cancel or time out the call.
+func (client *RenderingControl1) SetBlueVideoGainCtx(ctx context.Context, InstanceID uint32, DesiredBlueVideoGain uint16) (err error) {
+	// Request structure.
+	request := &RenderingControl1SetBlueVideoGainRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -4793,7 +7374,7 @@ func (client *RenderingControl1) SetBlueVideoGain(InstanceID uint32, DesiredBlue
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_1, "SetBlueVideoGain", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetBlueVideoGain", request, response); err != nil {
 		return
 	}
 
@@ -4803,15 +7384,33 @@ func (client *RenderingControl1) SetBlueVideoGain(InstanceID uint32, DesiredBlue
 	return
 }
 
+// RenderingControl1GetRedVideoBlackLevelRequest is the request of GetRedVideoBlackLevel, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl1GetRedVideoBlackLevelRequest struct {
+	InstanceID string
+}
+
+// RenderingControl1GetRedVideoBlackLevelResponse is the response of GetRedVideoBlackLevel, with each
+// argument in its SOAP string form.
+type RenderingControl1GetRedVideoBlackLevelResponse struct {
+	CurrentRedVideoBlackLevel string
+}
+
+// GetRedVideoBlackLevel performs the GetRedVideoBlackLevel action of the service.
 //
-// Return values:
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetRedVideoBlackLevel_InstanceID)
 //
-// * CurrentRedVideoBlackLevel: allowed value range: minimum=0, step=1
+// Return values:
+//   - CurrentRedVideoBlackLevel (out, state variable A_ARG_TYPE_GetRedVideoBlackLevel_CurrentRedVideoBlackLevel): allowed range minimum=0, step=1
 func (client *RenderingControl1) GetRedVideoBlackLevel(InstanceID uint32) (CurrentRedVideoBlackLevel uint16, err error) {
+	return client.GetRedVideoBlackLevelCtx(context.Background(), InstanceID)
+}
+
+// GetRedVideoBlackLevelCtx is GetRedVideoBlackLevel with a context, to cancel or time out the call.
+func (client *RenderingControl1) GetRedVideoBlackLevelCtx(ctx context.Context, InstanceID uint32) (CurrentRedVideoBlackLevel uint16, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &RenderingControl1GetRedVideoBlackLevelRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -4820,12 +7419,10 @@ func (client *RenderingControl1) GetRedVideoBlackLevel(InstanceID uint32) (Curre
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentRedVideoBlackLevel string
-	}{}
+	response := &RenderingControl1GetRedVideoBlackLevelResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_1, "GetRedVideoBlackLevel", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetRedVideoBlackLevel", request, response); err != nil {
 		return
 	}
 
@@ -4838,18 +7435,26 @@ func (client *RenderingControl1) GetRedVideoBlackLevel(InstanceID uint32) (Curre
 	return
 }
 
+// RenderingControl1SetRedVideoBlackLevelRequest is the request of SetRedVideoBlackLevel, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl1SetRedVideoBlackLevelRequest struct {
+	InstanceID                string
+	DesiredRedVideoBlackLevel string
+}
+
+// SetRedVideoBlackLevel performs the SetRedVideoBlackLevel action of the service.
 //
 // Arguments:
-//
-// * DesiredRedVideoBlackLevel: allowed value range: minimum=0, step=1
-
+//   - InstanceID (in, state variable A_ARG_TYPE_SetRedVideoBlackLevel_InstanceID)
+//   - DesiredRedVideoBlackLevel (in, state variable A_ARG_TYPE_SetRedVideoBlackLevel_DesiredRedVideoBlackLevel): allowed range minimum=0, step=1
 func (client *RenderingControl1) SetRedVideoBlackLevel(InstanceID uint32, DesiredRedVideoBlackLevel uint16) (err error) {
-	// Request structure.
-	request := &struct {
-		InstanceID string
+	return client.SetRedVideoBlackLevelCtx(context.Background(), InstanceID, DesiredRedVideoBlackLevel)
+}
 
-		DesiredRedVideoBlackLevel string
-	}{}
+// SetRedVideoBlackLevelCtx is SetRedVideoBlackLevel with a context, to cancel or time out the call.
+func (client *RenderingControl1) SetRedVideoBlackLevelCtx(ctx context.Context, InstanceID uint32, DesiredRedVideoBlackLevel uint16) (err error) {
+	// Request structure.
+	request := &RenderingControl1SetRedVideoBlackLevelRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -4864,7 +7469,7 @@ func (client *RenderingControl1) SetRedVideoBlackLevel(InstanceID uint32, Desire
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_1, "SetRedVideoBlackLevel", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetRedVideoBlackLevel", request, response); err != nil {
 		return
 	}
 
@@ -4874,15 +7479,33 @@ func (client *RenderingControl1) SetRedVideoBlackLevel(InstanceID uint32, Desire
 	return
 }
 
+// RenderingControl1GetGreenVideoBlackLevelRequest is the request of GetGreenVideoBlackLevel, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl1GetGreenVideoBlackLevelRequest struct {
+	InstanceID string
+}
+
+// RenderingControl1GetGreenVideoBlackLevelResponse is the response of GetGreenVideoBlackLevel, with each
+// argument in its SOAP string form.
+type RenderingControl1GetGreenVideoBlackLevelResponse struct {
+	CurrentGreenVideoBlackLevel string
+}
+
+// GetGreenVideoBlackLevel performs the GetGreenVideoBlackLevel action of the service.
 //
-// Return values:
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetGreenVideoBlackLevel_InstanceID)
 //
-// * CurrentGreenVideoBlackLevel: allowed value range: minimum=0, step=1
+// Return values:
+//   - CurrentGreenVideoBlackLevel (out, state variable A_ARG_TYPE_GetGreenVideoBlackLevel_CurrentGreenVideoBlackLevel): allowed range minimum=0, step=1
 func (client *RenderingControl1) GetGreenVideoBlackLevel(InstanceID uint32) (CurrentGreenVideoBlackLevel uint16, err error) {
-	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	return client.GetGreenVideoBlackLevelCtx(context.Background(), InstanceID)
+}
+
+// GetGreenVideoBlackLevelCtx is GetGreenVideoBlackLevel with a context, to cancel or time out the call.
+func (client *RenderingControl1) GetGreenVideoBlackLevelCtx(ctx context.Context, InstanceID uint32) (CurrentGreenVideoBlackLevel uint16, err error) {
+	// Request structure.
+	request := &RenderingControl1GetGreenVideoBlackLevelRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -4891,12 +7514,10 @@ func (client *RenderingControl1) GetGreenVideoBlackLevel(InstanceID uint32) (Cur
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentGreenVideoBlackLevel string
-	}{}
+	response := &RenderingControl1GetGreenVideoBlackLevelResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_1, "GetGreenVideoBlackLevel", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetGreenVideoBlackLevel", request, response); err != nil {
 		return
 	}
 
@@ -4909,18 +7530,26 @@ func (client *RenderingControl1) GetGreenVideoBlackLevel(InstanceID uint32) (Cur
 	return
 }
 
+// RenderingControl1SetGreenVideoBlackLevelRequest is the request of SetGreenVideoBlackLevel, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl1SetGreenVideoBlackLevelRequest struct {
+	InstanceID                  string
+	DesiredGreenVideoBlackLevel string
+}
+
+// SetGreenVideoBlackLevel performs the SetGreenVideoBlackLevel action of the service.
 //
 // Arguments:
-//
-// * DesiredGreenVideoBlackLevel: allowed value range: minimum=0, step=1
-
+//   - InstanceID (in, state variable A_ARG_TYPE_SetGreenVideoBlackLevel_InstanceID)
+//   - DesiredGreenVideoBlackLevel (in, state variable A_ARG_TYPE_SetGreenVideoBlackLevel_DesiredGreenVideoBlackLevel): allowed range minimum=0, step=1
 func (client *RenderingControl1) SetGreenVideoBlackLevel(InstanceID uint32, DesiredGreenVideoBlackLevel uint16) (err error) {
-	// Request structure.
-	request := &struct {
-		InstanceID string
+	return client.SetGreenVideoBlackLevelCtx(context.Background(), InstanceID, DesiredGreenVideoBlackLevel)
+}
 
-		DesiredGreenVideoBlackLevel string
-	}{}
+// SetGreenVideoBlackLevelCtx is SetGreenVideoBlackLevel with a context, to cancel or time out the call.
+func (client *RenderingControl1) SetGreenVideoBlackLevelCtx(ctx context.Context, InstanceID uint32, DesiredGreenVideoBlackLevel uint16) (err error) {
+	// Request structure.
+	request := &RenderingControl1SetGreenVideoBlackLevelRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -4935,7 +7564,7 @@ func (client *RenderingControl1) SetGreenVideoBlackLevel(InstanceID uint32, Desi
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_1, "SetGreenVideoBlackLevel", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetGreenVideoBlackLevel", request, response); err != nil {
 		return
 	}
 
@@ -4945,15 +7574,33 @@ func (client *RenderingControl1) SetGreenVideoBlackLevel(InstanceID uint32, Desi
 	return
 }
 
+// RenderingControl1GetBlueVideoBlackLevelRequest is the request of GetBlueVideoBlackLevel, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl1GetBlueVideoBlackLevelRequest struct {
+	InstanceID string
+}
+
+// RenderingControl1GetBlueVideoBlackLevelResponse is the response of GetBlueVideoBlackLevel, with each
+// argument in its SOAP string form.
+type RenderingControl1GetBlueVideoBlackLevelResponse struct {
+	CurrentBlueVideoBlackLevel string
+}
+
+// GetBlueVideoBlackLevel performs the GetBlueVideoBlackLevel action of the service.
 //
-// Return values:
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetBlueVideoBlackLevel_InstanceID)
 //
-// * CurrentBlueVideoBlackLevel: allowed value range: minimum=0, step=1
+// Return values:
+//   - CurrentBlueVideoBlackLevel (out, state variable A_ARG_TYPE_GetBlueVideoBlackLevel_CurrentBlueVideoBlackLevel): allowed range minimum=0, step=1
 func (client *RenderingControl1) GetBlueVideoBlackLevel(InstanceID uint32) (CurrentBlueVideoBlackLevel uint16, err error) {
+	return client.GetBlueVideoBlackLevelCtx(context.Background(), InstanceID)
+}
+
+// GetBlueVideoBlackLevelCtx is GetBlueVideoBlackLevel with a context, to cancel or time out the call.
+func (client *RenderingControl1) GetBlueVideoBlackLevelCtx(ctx context.Context, InstanceID uint32) (CurrentBlueVideoBlackLevel uint16, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &RenderingControl1GetBlueVideoBlackLevelRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -4962,12 +7609,10 @@ func (client *RenderingControl1) GetBlueVideoBlackLevel(InstanceID uint32) (Curr
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentBlueVideoBlackLevel string
-	}{}
+	response := &RenderingControl1GetBlueVideoBlackLevelResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_1, "GetBlueVideoBlackLevel", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetBlueVideoBlackLevel", request, response); err != nil {
 		return
 	}
 
@@ -4980,18 +7625,26 @@ func (client *RenderingControl1) GetBlueVideoBlackLevel(InstanceID uint32) (Curr
 	return
 }
 
+// RenderingControl1SetBlueVideoBlackLevelRequest is the request of SetBlueVideoBlackLevel, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl1SetBlueVideoBlackLevelRequest struct {
+	InstanceID                 string
+	DesiredBlueVideoBlackLevel string
+}
+
+// SetBlueVideoBlackLevel performs the SetBlueVideoBlackLevel action of the service.
 //
 // Arguments:
-//
-// * DesiredBlueVideoBlackLevel: allowed value range: minimum=0, step=1
-
+//   - InstanceID (in, state variable A_ARG_TYPE_SetBlueVideoBlackLevel_InstanceID)
+//   - DesiredBlueVideoBlackLevel (in, state variable A_ARG_TYPE_SetBlueVideoBlackLevel_DesiredBlueVideoBlackLevel): allowed range minimum=0, step=1
 func (client *RenderingControl1) SetBlueVideoBlackLevel(InstanceID uint32, DesiredBlueVideoBlackLevel uint16) (err error) {
-	// Request structure.
-	request := &struct {
-		InstanceID string
+	return client.SetBlueVideoBlackLevelCtx(context.Background(), InstanceID, DesiredBlueVideoBlackLevel)
+}
 
-		DesiredBlueVideoBlackLevel string
-	}{}
+// SetBlueVideoBlackLevelCtx is SetBlueVideoBlackLevel with a context, to cancel or time out the call.
+func (client *RenderingControl1) SetBlueVideoBlackLevelCtx(ctx context.Context, InstanceID uint32, DesiredBlueVideoBlackLevel uint16) (err error) {
+	// Request structure.
+	request := &RenderingControl1SetBlueVideoBlackLevelRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -5006,7 +7659,7 @@ func (client *RenderingControl1) SetBlueVideoBlackLevel(InstanceID uint32, Desir
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_1, "SetBlueVideoBlackLevel", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetBlueVideoBlackLevel", request, response); err != nil {
 		return
 	}
 
@@ -5016,15 +7669,33 @@ func (client *RenderingControl1) SetBlueVideoBlackLevel(InstanceID uint32, Desir
 	return
 }
 
+// RenderingControl1GetColorTemperatureRequest is the request of GetColorTemperature, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl1GetColorTemperatureRequest struct {
+	InstanceID string
+}
+
+// RenderingControl1GetColorTemperatureResponse is the response of GetColorTemperature, with each
+// argument in its SOAP string form.
+type RenderingControl1GetColorTemperatureResponse struct {
+	CurrentColorTemperature string
+}
+
+// GetColorTemperature performs the GetColorTemperature action of the service.
 //
-// Return values:
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetColorTemperature_InstanceID)
 //
-// * CurrentColorTemperature: allowed value range: minimum=0, step=1
+// Return values:
+//   - CurrentColorTemperature (out, state variable A_ARG_TYPE_GetColorTemperature_CurrentColorTemperature): allowed range minimum=0, step=1
 func (client *RenderingControl1) GetColorTemperature(InstanceID uint32) (CurrentColorTemperature uint16, err error) {
+	return client.GetColorTemperatureCtx(context.Background(), InstanceID)
+}
+
+// GetColorTemperatureCtx is GetColorTemperature with a context, to cancel or time out the call.
+func (client *RenderingControl1) GetColorTemperatureCtx(ctx context.Context, InstanceID uint32) (CurrentColorTemperature uint16, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &RenderingControl1GetColorTemperatureRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -5033,12 +7704,10 @@ func (client *RenderingControl1) GetColorTemperature(InstanceID uint32) (Current
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentColorTemperature string
-	}{}
+	response := &RenderingControl1GetColorTemperatureResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_1, "GetColorTemperature", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetColorTemperature", request, response); err != nil {
 		return
 	}
 
@@ -5051,18 +7720,26 @@ func (client *RenderingControl1) GetColorTemperature(InstanceID uint32) (Current
 	return
 }
 
+// RenderingControl1SetColorTemperatureRequest is the request of SetColorTemperature, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl1SetColorTemperatureRequest struct {
+	InstanceID              string
+	DesiredColorTemperature string
+}
+
+// SetColorTemperature performs the SetColorTemperature action of the service.
 //
 // Arguments:
-//
-// * DesiredColorTemperature: allowed value range: minimum=0, step=1
-
+//   - InstanceID (in, state variable A_ARG_TYPE_SetColorTemperature_InstanceID)
+//   - DesiredColorTemperature (in, state variable A_ARG_TYPE_SetColorTemperature_DesiredColorTemperature): allowed range minimum=0, step=1
 func (client *RenderingControl1) SetColorTemperature(InstanceID uint32, DesiredColorTemperature uint16) (err error) {
-	// Request structure.
-	request := &struct {
-		InstanceID string
+	return client.SetColorTemperatureCtx(context.Background(), InstanceID, DesiredColorTemperature)
+}
 
-		DesiredColorTemperature string
-	}{}
+// SetColorTemperatureCtx is SetColorTemperature with a context, to cancel or time out the call.
+func (client *RenderingControl1) SetColorTemperatureCtx(ctx context.Context, InstanceID uint32, DesiredColorTemperature uint16) (err error) {
+	// Request structure.
+	request := &RenderingControl1SetColorTemperatureRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -5077,7 +7754,7 @@ func (client *RenderingControl1) SetColorTemperature(InstanceID uint32, DesiredC
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_1, "SetColorTemperature", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetColorTemperature", request, response); err != nil {
 		return
 	}
 
@@ -5087,15 +7764,33 @@ func (client *RenderingControl1) SetColorTemperature(InstanceID uint32, DesiredC
 	return
 }
 
+// RenderingControl1GetHorizontalKeystoneRequest is the request of GetHorizontalKeystone, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl1GetHorizontalKeystoneRequest struct {
+	InstanceID string
+}
+
+// RenderingControl1GetHorizontalKeystoneResponse is the response of GetHorizontalKeystone, with each
+// argument in its SOAP string form.
+type RenderingControl1GetHorizontalKeystoneResponse struct {
+	CurrentHorizontalKeystone string
+}
+
+// GetHorizontalKeystone performs the GetHorizontalKeystone action of the service.
 //
-// Return values:
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetHorizontalKeystone_InstanceID)
 //
-// * CurrentHorizontalKeystone: allowed value range: step=1
+// Return values:
+//   - CurrentHorizontalKeystone (out, state variable A_ARG_TYPE_GetHorizontalKeystone_CurrentHorizontalKeystone): allowed range step=1
 func (client *RenderingControl1) GetHorizontalKeystone(InstanceID uint32) (CurrentHorizontalKeystone int16, err error) {
+	return client.GetHorizontalKeystoneCtx(context.Background(), InstanceID)
+}
+
+// GetHorizontalKeystoneCtx is GetHorizontalKeystone with a context, to cancel or time out the call.
+func (client *RenderingControl1) GetHorizontalKeystoneCtx(ctx context.Context, InstanceID uint32) (CurrentHorizontalKeystone int16, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &RenderingControl1GetHorizontalKeystoneRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -5104,12 +7799,10 @@ func (client *RenderingControl1) GetHorizontalKeystone(InstanceID uint32) (Curre
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentHorizontalKeystone string
-	}{}
+	response := &RenderingControl1GetHorizontalKeystoneResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_1, "GetHorizontalKeystone", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetHorizontalKeystone", request, response); err != nil {
 		return
 	}
 
@@ -5122,18 +7815,26 @@ func (client *RenderingControl1) GetHorizontalKeystone(InstanceID uint32) (Curre
 	return
 }
 
+// RenderingControl1SetHorizontalKeystoneRequest is the request of SetHorizontalKeystone, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl1SetHorizontalKeystoneRequest struct {
+	InstanceID                string
+	DesiredHorizontalKeystone string
+}
+
+// SetHorizontalKeystone performs the SetHorizontalKeystone action of the service.
 //
 // Arguments:
-//
-// * DesiredHorizontalKeystone: allowed value range: step=1
-
+//   - InstanceID (in, state variable A_ARG_TYPE_SetHorizontalKeystone_InstanceID)
+//   - DesiredHorizontalKeystone (in, state variable A_ARG_TYPE_SetHorizontalKeystone_DesiredHorizontalKeystone): allowed range step=1
 func (client *RenderingControl1) SetHorizontalKeystone(InstanceID uint32, DesiredHorizontalKeystone int16) (err error) {
-	// Request structure.
-	request := &struct {
-		InstanceID string
+	return client.SetHorizontalKeystoneCtx(context.Background(), InstanceID, DesiredHorizontalKeystone)
+}
 
-		DesiredHorizontalKeystone string
-	}{}
+// SetHorizontalKeystoneCtx is SetHorizontalKeystone with a context, to cancel or time out the call.
+func (client *RenderingControl1) SetHorizontalKeystoneCtx(ctx context.Context, InstanceID uint32, DesiredHorizontalKeystone int16) (err error) {
+	// Request structure.
+	request := &RenderingControl1SetHorizontalKeystoneRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -5148,7 +7849,7 @@ func (client *RenderingControl1) SetHorizontalKeystone(InstanceID uint32, Desire
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_1, "SetHorizontalKeystone", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetHorizontalKeystone", request, response); err != nil {
 		return
 	}
 
@@ -5158,15 +7859,33 @@ func (client *RenderingControl1) SetHorizontalKeystone(InstanceID uint32, Desire
 	return
 }
 
+// RenderingControl1GetVerticalKeystoneRequest is the request of GetVerticalKeystone, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl1GetVerticalKeystoneRequest struct {
+	InstanceID string
+}
+
+// RenderingControl1GetVerticalKeystoneResponse is the response of GetVerticalKeystone, with each
+// argument in its SOAP string form.
+type RenderingControl1GetVerticalKeystoneResponse struct {
+	CurrentVerticalKeystone string
+}
+
+// GetVerticalKeystone performs the GetVerticalKeystone action of the service.
 //
-// Return values:
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetVerticalKeystone_InstanceID)
 //
-// * CurrentVerticalKeystone: allowed value range: step=1
+// Return values:
+//   - CurrentVerticalKeystone (out, state variable A_ARG_TYPE_GetVerticalKeystone_CurrentVerticalKeystone): allowed range step=1
 func (client *RenderingControl1) GetVerticalKeystone(InstanceID uint32) (CurrentVerticalKeystone int16, err error) {
+	return client.GetVerticalKeystoneCtx(context.Background(), InstanceID)
+}
+
+// GetVerticalKeystoneCtx is GetVerticalKeystone with a context, to cancel or time out the call.
+func (client *RenderingControl1) GetVerticalKeystoneCtx(ctx context.Context, InstanceID uint32) (CurrentVerticalKeystone int16, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &RenderingControl1GetVerticalKeystoneRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -5175,12 +7894,10 @@ func (client *RenderingControl1) GetVerticalKeystone(InstanceID uint32) (Current
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentVerticalKeystone string
-	}{}
+	response := &RenderingControl1GetVerticalKeystoneResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_1, "GetVerticalKeystone", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetVerticalKeystone", request, response); err != nil {
 		return
 	}
 
@@ -5193,18 +7910,26 @@ func (client *RenderingControl1) GetVerticalKeystone(InstanceID uint32) (Current
 	return
 }
 
+// RenderingControl1SetVerticalKeystoneRequest is the request of SetVerticalKeystone, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl1SetVerticalKeystoneRequest struct {
+	InstanceID              string
+	DesiredVerticalKeystone string
+}
+
+// SetVerticalKeystone performs the SetVerticalKeystone action of the service.
 //
 // Arguments:
-//
-// * DesiredVerticalKeystone: allowed value range: step=1
-
+//   - InstanceID (in, state variable A_ARG_TYPE_SetVerticalKeystone_InstanceID)
+//   - DesiredVerticalKeystone (in, state variable A_ARG_TYPE_SetVerticalKeystone_DesiredVerticalKeystone): allowed range step=1
 func (client *RenderingControl1) SetVerticalKeystone(InstanceID uint32, DesiredVerticalKeystone int16) (err error) {
-	// Request structure.
-	request := &struct {
-		InstanceID string
+	return client.SetVerticalKeystoneCtx(context.Background(), InstanceID, DesiredVerticalKeystone)
+}
 
-		DesiredVerticalKeystone string
-	}{}
+// SetVerticalKeystoneCtx is SetVerticalKeystone with a context, to cancel or time out the call.
+func (client *RenderingControl1) SetVerticalKeystoneCtx(ctx context.Context, InstanceID uint32, DesiredVerticalKeystone int16) (err error) {
+	// Request structure.
+	request := &RenderingControl1SetVerticalKeystoneRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -5219,7 +7944,7 @@ func (client *RenderingControl1) SetVerticalKeystone(InstanceID uint32, DesiredV
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_1, "SetVerticalKeystone", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetVerticalKeystone", request, response); err != nil {
 		return
 	}
 
@@ -5229,35 +7954,50 @@ func (client *RenderingControl1) SetVerticalKeystone(InstanceID uint32, DesiredV
 	return
 }
 
+// RenderingControl1GetMuteRequest is the request of GetMute, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl1GetMuteRequest struct {
+	InstanceID string
+	Channel    string
+}
+
+// RenderingControl1GetMuteResponse is the response of GetMute, with each
+// argument in its SOAP string form.
+type RenderingControl1GetMuteResponse struct {
+	CurrentMute string
+}
+
+// GetMute performs the GetMute action of the service.
 //
 // Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetMute_InstanceID)
+//   - Channel (in, state variable A_ARG_TYPE_Channel): allowed values Master
 //
-// * Channel: allowed values: Master
+// Return values:
+//   - CurrentMute (out, state variable A_ARG_TYPE_GetMute_CurrentMute)
+func (client *RenderingControl1) GetMute(InstanceID uint32, Channel RenderingControl1Channel) (CurrentMute bool, err error) {
+	return client.GetMuteCtx(context.Background(), InstanceID, Channel)
+}
 
-func (client *RenderingControl1) GetMute(InstanceID uint32, Channel string) (CurrentMute bool, err error) {
+// GetMuteCtx is GetMute with a context, to cancel or time out the call.
+func (client *RenderingControl1) GetMuteCtx(ctx context.Context, InstanceID uint32, Channel RenderingControl1Channel) (CurrentMute bool, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-
-		Channel string
-	}{}
+	request := &RenderingControl1GetMuteRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
 		return
 	}
-	if request.Channel, err = soap.MarshalString(Channel); err != nil {
+	if request.Channel, err = soap.MarshalString(string(Channel)); err != nil {
 		return
 	}
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentMute string
-	}{}
+	response := &RenderingControl1GetMuteResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_1, "GetMute", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetMute", request, response); err != nil {
 		return
 	}
 
@@ -5270,26 +8010,34 @@ func (client *RenderingControl1) GetMute(InstanceID uint32, Channel string) (Cur
 	return
 }
 
+// RenderingControl1SetMuteRequest is the request of SetMute, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl1SetMuteRequest struct {
+	InstanceID  string
+	Channel     string
+	DesiredMute string
+}
+
+// SetMute performs the SetMute action of the service.
 //
 // Arguments:
-//
-// * Channel: allowed values: Master
+//   - InstanceID (in, state variable A_ARG_TYPE_SetMute_InstanceID)
+//   - Channel (in, state variable A_ARG_TYPE_Channel): allowed values Master
+//   - DesiredMute (in, state variable A_ARG_TYPE_SetMute_DesiredMute)
+func (client *RenderingControl1) SetMute(InstanceID uint32, Channel RenderingControl1Channel, DesiredMute bool) (err error) {
+	return client.SetMuteCtx(context.Background(), InstanceID, Channel, DesiredMute)
+}
 
-func (client *RenderingControl1) SetMute(InstanceID uint32, Channel string, DesiredMute bool) (err error) {
+// SetMuteCtx is SetMute with a context, to cancel or time out the call.
+func (client *RenderingControl1) SetMuteCtx(ctx context.Context, InstanceID uint32, Channel RenderingControl1Channel, DesiredMute bool) (err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-
-		Channel string
-
-		DesiredMute string
-	}{}
+	request := &RenderingControl1SetMuteRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
 		return
 	}
-	if request.Channel, err = soap.MarshalString(Channel); err != nil {
+	if request.Channel, err = soap.MarshalString(string(Channel)); err != nil {
 		return
 	}
 	if request.DesiredMute, err = soap.MarshalBoolean(DesiredMute); err != nil {
@@ -5301,7 +8049,7 @@ func (client *RenderingControl1) SetMute(InstanceID uint32, Channel string, Desi
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_1, "SetMute", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetMute", request, response); err != nil {
 		return
 	}
 
@@ -5311,39 +8059,50 @@ func (client *RenderingControl1) SetMute(InstanceID uint32, Channel string, Desi
 	return
 }
 
+// RenderingControl1GetVolumeRequest is the request of GetVolume, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl1GetVolumeRequest struct {
+	InstanceID string
+	Channel    string
+}
+
+// RenderingControl1GetVolumeResponse is the response of GetVolume, with each
+// argument in its SOAP string form.
+type RenderingControl1GetVolumeResponse struct {
+	CurrentVolume string
+}
+
+// GetVolume performs the GetVolume action of the service.
 //
 // Arguments:
-//
-// * Channel: allowed values: Master
-
+//   - InstanceID (in, state variable A_ARG_TYPE_GetVolume_InstanceID)
+//   - Channel (in, state variable A_ARG_TYPE_Channel): allowed values Master
 //
 // Return values:
-//
-// * CurrentVolume: allowed value range: minimum=0, step=1
-func (client *RenderingControl1) GetVolume(InstanceID uint32, Channel string) (CurrentVolume uint16, err error) {
-	// Request structure.
-	request := &struct {
-		InstanceID string
+//   - CurrentVolume (out, state variable A_ARG_TYPE_GetVolume_CurrentVolume): allowed range minimum=0, step=1
+func (client *RenderingControl1) GetVolume(InstanceID uint32, Channel RenderingControl1Channel) (CurrentVolume uint16, err error) {
+	return client.GetVolumeCtx(context.Background(), InstanceID, Channel)
+}
 
-		Channel string
-	}{}
+// GetVolumeCtx is GetVolume with a context, to cancel or time out the call.
+func (client *RenderingControl1) GetVolumeCtx(ctx context.Context, InstanceID uint32, Channel RenderingControl1Channel) (CurrentVolume uint16, err error) {
+	// Request structure.
+	request := &RenderingControl1GetVolumeRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
 		return
 	}
-	if request.Channel, err = soap.MarshalString(Channel); err != nil {
+	if request.Channel, err = soap.MarshalString(string(Channel)); err != nil {
 		return
 	}
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentVolume string
-	}{}
+	response := &RenderingControl1GetVolumeResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_1, "GetVolume", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetVolume", request, response); err != nil {
 		return
 	}
 
@@ -5356,28 +8115,34 @@ func (client *RenderingControl1) GetVolume(InstanceID uint32, Channel string) (C
 	return
 }
 
+// RenderingControl1SetVolumeRequest is the request of SetVolume, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl1SetVolumeRequest struct {
+	InstanceID    string
+	Channel       string
+	DesiredVolume string
+}
+
+// SetVolume performs the SetVolume action of the service.
 //
 // Arguments:
-//
-// * Channel: allowed values: Master
-//
-// * DesiredVolume: allowed value range: minimum=0, step=1
+//   - InstanceID (in, state variable A_ARG_TYPE_SetVolume_InstanceID)
+//   - Channel (in, state variable A_ARG_TYPE_Channel): allowed values Master
+//   - DesiredVolume (in, state variable A_ARG_TYPE_SetVolume_DesiredVolume): allowed range minimum=0, step=1
+func (client *RenderingControl1) SetVolume(InstanceID uint32, Channel RenderingControl1Channel, DesiredVolume uint16) (err error) {
+	return client.SetVolumeCtx(context.Background(), InstanceID, Channel, DesiredVolume)
+}
 
-func (client *RenderingControl1) SetVolume(InstanceID uint32, Channel string, DesiredVolume uint16) (err error) {
+// SetVolumeCtx is SetVolume with a context, to cancel or time out the call.
+func (client *RenderingControl1) SetVolumeCtx(ctx context.Context, InstanceID uint32, Channel RenderingControl1Channel, DesiredVolume uint16) (err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-
-		Channel string
-
-		DesiredVolume string
-	}{}
+	request := &RenderingControl1SetVolumeRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
 		return
 	}
-	if request.Channel, err = soap.MarshalString(Channel); err != nil {
+	if request.Channel, err = soap.MarshalString(string(Channel)); err != nil {
 		return
 	}
 	if request.DesiredVolume, err = soap.MarshalUi2(DesiredVolume); err != nil {
@@ -5389,7 +8154,7 @@ func (client *RenderingControl1) SetVolume(InstanceID uint32, Channel string, De
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_1, "SetVolume", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetVolume", request, response); err != nil {
 		return
 	}
 
@@ -5399,35 +8164,50 @@ func (client *RenderingControl1) SetVolume(InstanceID uint32, Channel string, De
 	return
 }
 
+// RenderingControl1GetVolumeDBRequest is the request of GetVolumeDB, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl1GetVolumeDBRequest struct {
+	InstanceID string
+	Channel    string
+}
+
+// RenderingControl1GetVolumeDBResponse is the response of GetVolumeDB, with each
+// argument in its SOAP string form.
+type RenderingControl1GetVolumeDBResponse struct {
+	CurrentVolume string
+}
+
+// GetVolumeDB performs the GetVolumeDB action of the service.
 //
 // Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetVolumeDB_InstanceID)
+//   - Channel (in, state variable A_ARG_TYPE_Channel): allowed values Master
 //
-// * Channel: allowed values: Master
+// Return values:
+//   - CurrentVolume (out, state variable A_ARG_TYPE_GetVolumeDB_CurrentVolume)
+func (client *RenderingControl1) GetVolumeDB(InstanceID uint32, Channel RenderingControl1Channel) (CurrentVolume int16, err error) {
+	return client.GetVolumeDBCtx(context.Background(), InstanceID, Channel)
+}
 
-func (client *RenderingControl1) GetVolumeDB(InstanceID uint32, Channel string) (CurrentVolume int16, err error) {
+// GetVolumeDBCtx is GetVolumeDB with a context, to cancel or time out the call.
+func (client *RenderingControl1) GetVolumeDBCtx(ctx context.Context, InstanceID uint32, Channel RenderingControl1Channel) (CurrentVolume int16, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-
-		Channel string
-	}{}
+	request := &RenderingControl1GetVolumeDBRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
 		return
 	}
-	if request.Channel, err = soap.MarshalString(Channel); err != nil {
+	if request.Channel, err = soap.MarshalString(string(Channel)); err != nil {
 		return
 	}
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentVolume string
-	}{}
+	response := &RenderingControl1GetVolumeDBResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_1, "GetVolumeDB", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetVolumeDB", request, response); err != nil {
 		return
 	}
 
@@ -5440,26 +8220,34 @@ func (client *RenderingControl1) GetVolumeDB(InstanceID uint32, Channel string)
 	return
 }
 
+// RenderingControl1SetVolumeDBRequest is the request of SetVolumeDB, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl1SetVolumeDBRequest struct {
+	InstanceID    string
+	Channel       string
+	DesiredVolume string
+}
+
+// SetVolumeDB performs the SetVolumeDB action of the service.
 //
 // Arguments:
-//
-// * Channel: allowed values: Master
+//   - InstanceID (in, state variable A_ARG_TYPE_SetVolumeDB_InstanceID)
+//   - Channel (in, state variable A_ARG_TYPE_Channel): allowed values Master
+//   - DesiredVolume (in, state variable A_ARG_TYPE_SetVolumeDB_DesiredVolume)
+func (client *RenderingControl1) SetVolumeDB(InstanceID uint32, Channel RenderingControl1Channel, DesiredVolume int16) (err error) {
+	return client.SetVolumeDBCtx(context.Background(), InstanceID, Channel, DesiredVolume)
+}
 
-func (client *RenderingControl1) SetVolumeDB(InstanceID uint32, Channel string, DesiredVolume int16) (err error) {
+// SetVolumeDBCtx is SetVolumeDB with a context, to cancel or time out the call.
+func (client *RenderingControl1) SetVolumeDBCtx(ctx context.Context, InstanceID uint32, Channel RenderingControl1Channel, DesiredVolume int16) (err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-
-		Channel string
-
-		DesiredVolume string
-	}{}
+	request := &RenderingControl1SetVolumeDBRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
 		return
 	}
-	if request.Channel, err = soap.MarshalString(Channel); err != nil {
+	if request.Channel, err = soap.MarshalString(string(Channel)); err != nil {
 		return
 	}
 	if request.DesiredVolume, err = soap.MarshalI2(DesiredVolume); err != nil {
@@ -5471,7 +8259,7 @@ func (client *RenderingControl1) SetVolumeDB(InstanceID uint32, Channel string,
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_1, "SetVolumeDB", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetVolumeDB", request, response); err != nil {
 		return
 	}
 
@@ -5481,37 +8269,52 @@ func (client *RenderingControl1) SetVolumeDB(InstanceID uint32, Channel string,
 	return
 }
 
+// RenderingControl1GetVolumeDBRangeRequest is the request of GetVolumeDBRange, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl1GetVolumeDBRangeRequest struct {
+	InstanceID string
+	Channel    string
+}
+
+// RenderingControl1GetVolumeDBRangeResponse is the response of GetVolumeDBRange, with each
+// argument in its SOAP string form.
+type RenderingControl1GetVolumeDBRangeResponse struct {
+	MinValue string
+	MaxValue string
+}
+
+// GetVolumeDBRange performs the GetVolumeDBRange action of the service.
 //
 // Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetVolumeDBRange_InstanceID)
+//   - Channel (in, state variable A_ARG_TYPE_Channel): allowed values Master
 //
-// * Channel: allowed values: Master
+// Return values:
+//   - MinValue (out, state variable A_ARG_TYPE_GetVolumeDBRange_MinValue)
+//   - MaxValue (out, state variable A_ARG_TYPE_GetVolumeDBRange_MaxValue)
+func (client *RenderingControl1) GetVolumeDBRange(InstanceID uint32, Channel RenderingControl1Channel) (MinValue int16, MaxValue int16, err error) {
+	return client.GetVolumeDBRangeCtx(context.Background(), InstanceID, Channel)
+}
 
-func (client *RenderingControl1) GetVolumeDBRange(InstanceID uint32, Channel string) (MinValue int16, MaxValue int16, err error) {
+// GetVolumeDBRangeCtx is GetVolumeDBRange with a context, to cancel or time out the call.
+func (client *RenderingControl1) GetVolumeDBRangeCtx(ctx context.Context, InstanceID uint32, Channel RenderingControl1Channel) (MinValue int16, MaxValue int16, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-
-		Channel string
-	}{}
+	request := &RenderingControl1GetVolumeDBRangeRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
 		return
 	}
-	if request.Channel, err = soap.MarshalString(Channel); err != nil {
+	if request.Channel, err = soap.MarshalString(string(Channel)); err != nil {
 		return
 	}
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		MinValue string
-
-		MaxValue string
-	}{}
+	response := &RenderingControl1GetVolumeDBRangeResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_1, "GetVolumeDBRange", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetVolumeDBRange", request, response); err != nil {
 		return
 	}
 
@@ -5527,35 +8330,50 @@ func (client *RenderingControl1) GetVolumeDBRange(InstanceID uint32, Channel str
 	return
 }
 
+// RenderingControl1GetLoudnessRequest is the request of GetLoudness, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl1GetLoudnessRequest struct {
+	InstanceID string
+	Channel    string
+}
+
+// RenderingControl1GetLoudnessResponse is the response of GetLoudness, with each
+// argument in its SOAP string form.
+type RenderingControl1GetLoudnessResponse struct {
+	CurrentLoudness string
+}
+
+// GetLoudness performs the GetLoudness action of the service.
 //
 // Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetLoudness_InstanceID)
+//   - Channel (in, state variable A_ARG_TYPE_Channel): allowed values Master
 //
-// * Channel: allowed values: Master
+// Return values:
+//   - CurrentLoudness (out, state variable A_ARG_TYPE_GetLoudness_CurrentLoudness)
+func (client *RenderingControl1) GetLoudness(InstanceID uint32, Channel RenderingControl1Channel) (CurrentLoudness bool, err error) {
+	return client.GetLoudnessCtx(context.Background(), InstanceID, Channel)
+}
 
-func (client *RenderingControl1) GetLoudness(InstanceID uint32, Channel string) (CurrentLoudness bool, err error) {
+// GetLoudnessCtx is GetLoudness with a context, to cancel or time out the call.
+func (client *RenderingControl1) GetLoudnessCtx(ctx context.Context, InstanceID uint32, Channel RenderingControl1Channel) (CurrentLoudness bool, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-
-		Channel string
-	}{}
+	request := &RenderingControl1GetLoudnessRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
 		return
 	}
-	if request.Channel, err = soap.MarshalString(Channel); err != nil {
+	if request.Channel, err = soap.MarshalString(string(Channel)); err != nil {
 		return
 	}
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentLoudness string
-	}{}
+	response := &RenderingControl1GetLoudnessResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_1, "GetLoudness", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetLoudness", request, response); err != nil {
 		return
 	}
 
@@ -5568,26 +8386,34 @@ func (client *RenderingControl1) GetLoudness(InstanceID uint32, Channel string)
 	return
 }
 
+// RenderingControl1SetLoudnessRequest is the request of SetLoudness, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl1SetLoudnessRequest struct {
+	InstanceID      string
+	Channel         string
+	DesiredLoudness string
+}
+
+// SetLoudness performs the SetLoudness action of the service.
 //
 // Arguments:
-//
-// * Channel: allowed values: Master
+//   - InstanceID (in, state variable A_ARG_TYPE_SetLoudness_InstanceID)
+//   - Channel (in, state variable A_ARG_TYPE_Channel): allowed values Master
+//   - DesiredLoudness (in, state variable A_ARG_TYPE_SetLoudness_DesiredLoudness)
+func (client *RenderingControl1) SetLoudness(InstanceID uint32, Channel RenderingControl1Channel, DesiredLoudness bool) (err error) {
+	return client.SetLoudnessCtx(context.Background(), InstanceID, Channel, DesiredLoudness)
+}
 
-func (client *RenderingControl1) SetLoudness(InstanceID uint32, Channel string, DesiredLoudness bool) (err error) {
+// SetLoudnessCtx is SetLoudness with a context, to cancel or time out the call.
+func (client *RenderingControl1) SetLoudnessCtx(ctx context.Context, InstanceID uint32, Channel RenderingControl1Channel, DesiredLoudness bool) (err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-
-		Channel string
-
-		DesiredLoudness string
-	}{}
+	request := &RenderingControl1SetLoudnessRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
 		return
 	}
-	if request.Channel, err = soap.MarshalString(Channel); err != nil {
+	if request.Channel, err = soap.MarshalString(string(Channel)); err != nil {
 		return
 	}
 	if request.DesiredLoudness, err = soap.MarshalBoolean(DesiredLoudness); err != nil {
@@ -5599,7 +8425,7 @@ func (client *RenderingControl1) SetLoudness(InstanceID uint32, Channel string,
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_1, "SetLoudness", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetLoudness", request, response); err != nil {
 		return
 	}
 
@@ -5616,6 +8442,123 @@ type RenderingControl2 struct {
 	goupnp.ServiceClient
 }
 
+// RenderingControl2Client is the interface of the actions of RenderingControl2, for
+// substituting fakes or mocks for the service in tests.
+type RenderingControl2Client interface {
+	ListPresets(InstanceID uint32) (CurrentPresetNameList string, err error)
+	ListPresetsCtx(ctx context.Context, InstanceID uint32) (CurrentPresetNameList string, err error)
+	SelectPreset(InstanceID uint32, PresetName RenderingControl2PresetName) (err error)
+	SelectPresetCtx(ctx context.Context, InstanceID uint32, PresetName RenderingControl2PresetName) (err error)
+	GetBrightness(InstanceID uint32) (CurrentBrightness uint16, err error)
+	GetBrightnessCtx(ctx context.Context, InstanceID uint32) (CurrentBrightness uint16, err error)
+	SetBrightness(InstanceID uint32, DesiredBrightness uint16) (err error)
+	SetBrightnessCtx(ctx context.Context, InstanceID uint32, DesiredBrightness uint16) (err error)
+	GetContrast(InstanceID uint32) (CurrentContrast uint16, err error)
+	GetContrastCtx(ctx context.Context, InstanceID uint32) (CurrentContrast uint16, err error)
+	SetContrast(InstanceID uint32, DesiredContrast uint16) (err error)
+	SetContrastCtx(ctx context.Context, InstanceID uint32, DesiredContrast uint16) (err error)
+	GetSharpness(InstanceID uint32) (CurrentSharpness uint16, err error)
+	GetSharpnessCtx(ctx context.Context, InstanceID uint32) (CurrentSharpness uint16, err error)
+	SetSharpness(InstanceID uint32, DesiredSharpness uint16) (err error)
+	SetSharpnessCtx(ctx context.Context, InstanceID uint32, DesiredSharpness uint16) (err error)
+	GetRedVideoGain(InstanceID uint32) (CurrentRedVideoGain uint16, err error)
+	GetRedVideoGainCtx(ctx context.Context, InstanceID uint32) (CurrentRedVideoGain uint16, err error)
+	SetRedVideoGain(InstanceID uint32, DesiredRedVideoGain uint16) (err error)
+	SetRedVideoGainCtx(ctx context.Context, InstanceID uint32, DesiredRedVideoGain uint16) (err error)
+	GetGreenVideoGain(InstanceID uint32) (CurrentGreenVideoGain uint16, err error)
+	GetGreenVideoGainCtx(ctx context.Context, InstanceID uint32) (CurrentGreenVideoGain uint16, err error)
+	SetGreenVideoGain(InstanceID uint32, DesiredGreenVideoGain uint16) (err error)
+	SetGreenVideoGainCtx(ctx context.Context, InstanceID uint32, DesiredGreenVideoGain uint16) (err error)
+	GetBlueVideoGain(InstanceID uint32) (CurrentBlueVideoGain uint16, err error)
+	GetBlueVideoGainCtx(ctx context.Context, InstanceID uint32) (CurrentBlueVideoGain uint16, err error)
+	SetBlueVideoGain(InstanceID uint32, DesiredBlueVideoGain uint16) (err error)
+	SetBlueVideoGainCtx(ctx context.Context, InstanceID uint32, DesiredBlueVideoGain uint16) (err error)
+	GetRedVideoBlackLevel(InstanceID uint32) (CurrentRedVideoBlackLevel uint16, err error)
+	GetRedVideoBlackLevelCtx(ctx context.Context, InstanceID uint32) (CurrentRedVideoBlackLevel uint16, err error)
+	SetRedVideoBlackLevel(InstanceID uint32, DesiredRedVideoBlackLevel uint16) (err error)
+	SetRedVideoBlackLevelCtx(ctx context.Context, InstanceID uint32, DesiredRedVideoBlackLevel uint16) (err error)
+	GetGreenVideoBlackLevel(InstanceID uint32) (CurrentGreenVideoBlackLevel uint16, err error)
+	GetGreenVideoBlackLevelCtx(ctx context.Context, InstanceID uint32) (CurrentGreenVideoBlackLevel uint16, err error)
+	SetGreenVideoBlackLevel(InstanceID uint32, DesiredGreenVideoBlackLevel uint16) (err error)
+	SetGreenVideoBlackLevelCtx(ctx context.Context, InstanceID uint32, DesiredGreenVideoBlackLevel uint16) (err error)
+	GetBlueVideoBlackLevel(InstanceID uint32) (CurrentBlueVideoBlackLevel uint16, err error)
+	GetBlueVideoBlackLevelCtx(ctx context.Context, InstanceID uint32) (CurrentBlueVideoBlackLevel uint16, err error)
+	SetBlueVideoBlackLevel(InstanceID uint32, DesiredBlueVideoBlackLevel uint16) (err error)
+	SetBlueVideoBlackLevelCtx(ctx context.Context, InstanceID uint32, DesiredBlueVideoBlackLevel uint16) (err error)
+	GetColorTemperature(InstanceID uint32) (CurrentColorTemperature uint16, err error)
+	GetColorTemperatureCtx(ctx context.Context, InstanceID uint32) (CurrentColorTemperature uint16, err error)
+	SetColorTemperature(InstanceID uint32, DesiredColorTemperature uint16) (err error)
+	SetColorTemperatureCtx(ctx context.Context, InstanceID uint32, DesiredColorTemperature uint16) (err error)
+	GetHorizontalKeystone(InstanceID uint32) (CurrentHorizontalKeystone int16, err error)
+	GetHorizontalKeystoneCtx(ctx context.Context, InstanceID uint32) (CurrentHorizontalKeystone int16, err error)
+	SetHorizontalKeystone(InstanceID uint32, DesiredHorizontalKeystone int16) (err error)
+	SetHorizontalKeystoneCtx(ctx context.Context, InstanceID uint32, DesiredHorizontalKeystone int16) (err error)
+	GetVerticalKeystone(InstanceID uint32) (CurrentVerticalKeystone int16, err error)
+	GetVerticalKeystoneCtx(ctx context.Context, InstanceID uint32) (CurrentVerticalKeystone int16, err error)
+	SetVerticalKeystone(InstanceID uint32, DesiredVerticalKeystone int16) (err error)
+	SetVerticalKeystoneCtx(ctx context.Context, InstanceID uint32, DesiredVerticalKeystone int16) (err error)
+	GetMute(InstanceID uint32, Channel RenderingControl2Channel) (CurrentMute bool, err error)
+	GetMuteCtx(ctx context.Context, InstanceID uint32, Channel RenderingControl2Channel) (CurrentMute bool, err error)
+	SetMute(InstanceID uint32, Channel RenderingControl2Channel, DesiredMute bool) (err error)
+	SetMuteCtx(ctx context.Context, InstanceID uint32, Channel RenderingControl2Channel, DesiredMute bool) (err error)
+	GetVolume(InstanceID uint32, Channel RenderingControl2Channel) (CurrentVolume uint16, err error)
+	GetVolumeCtx(ctx context.Context, InstanceID uint32, Channel RenderingControl2Channel) (CurrentVolume uint16, err error)
+	SetVolume(InstanceID uint32, Channel RenderingControl2Channel, DesiredVolume uint16) (err error)
+	SetVolumeCtx(ctx context.Context, InstanceID uint32, Channel RenderingControl2Channel, DesiredVolume uint16) (err error)
+	GetVolumeDB(InstanceID uint32, Channel RenderingControl2Channel) (CurrentVolume int16, err error)
+	GetVolumeDBCtx(ctx context.Context, InstanceID uint32, Channel RenderingControl2Channel) (CurrentVolume int16, err error)
+	SetVolumeDB(InstanceID uint32, Channel RenderingControl2Channel, DesiredVolume int16) (err error)
+	SetVolumeDBCtx(ctx context.Context, InstanceID uint32, Channel RenderingControl2Channel, DesiredVolume int16) (err error)
+	GetVolumeDBRange(InstanceID uint32, Channel RenderingControl2Channel) (MinValue int16, MaxValue int16, err error)
+	GetVolumeDBRangeCtx(ctx context.Context, InstanceID uint32, Channel RenderingControl2Channel) (MinValue int16, MaxValue int16, err error)
+	GetLoudness(InstanceID uint32, Channel RenderingControl2Channel) (CurrentLoudness bool, err error)
+	GetLoudnessCtx(ctx context.Context, InstanceID uint32, Channel RenderingControl2Channel) (CurrentLoudness bool, err error)
+	SetLoudness(InstanceID uint32, Channel RenderingControl2Channel, DesiredLoudness bool) (err error)
+	SetLoudnessCtx(ctx context.Context, InstanceID uint32, Channel RenderingControl2Channel, DesiredLoudness bool) (err error)
+	GetStateVariables(InstanceID uint32, StateVariableList string) (StateVariableValuePairs string, err error)
+	GetStateVariablesCtx(ctx context.Context, InstanceID uint32, StateVariableList string) (StateVariableValuePairs string, err error)
+	SetStateVariables(InstanceID uint32, RenderingControlUDN string, ServiceType string, ServiceId string, StateVariableValuePairs string) (StateVariableList string, err error)
+	SetStateVariablesCtx(ctx context.Context, InstanceID uint32, RenderingControlUDN string, ServiceType string, ServiceId string, StateVariableValuePairs string) (StateVariableList string, err error)
+}
+
+var _ RenderingControl2Client = new(RenderingControl2)
+
+// RenderingControl2PresetName is a value of the state variable A_ARG_TYPE_PresetName of
+// RenderingControl2.
+type RenderingControl2PresetName string
+
+// Allowed values of RenderingControl2PresetName.
+const (
+	RenderingControl2PresetName_FactoryDefaults RenderingControl2PresetName = "FactoryDefaults"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v RenderingControl2PresetName) Valid() bool {
+	switch v {
+	case RenderingControl2PresetName_FactoryDefaults:
+		return true
+	}
+	return false
+}
+
+// RenderingControl2Channel is a value of the state variable A_ARG_TYPE_Channel of
+// RenderingControl2.
+type RenderingControl2Channel string
+
+// Allowed values of RenderingControl2Channel.
+const (
+	RenderingControl2Channel_Master RenderingControl2Channel = "Master"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v RenderingControl2Channel) Valid() bool {
+	switch v {
+	case RenderingControl2Channel_Master:
+		return true
+	}
+	return false
+}
+
 // NewRenderingControl2Clients discovers instances of the service on the network,
 // and returns clients to any that are found. errors will contain an error for
 // any devices that replied but which could not be queried, and err will be set
@@ -5669,11 +8612,51 @@ func newRenderingControl2ClientsFromGenericClients(genericClients []goupnp.Servi
 	return clients
 }
 
+// RenderingControl2Timeouts are the default timeouts of the actions of
+// RenderingControl2, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var RenderingControl2Timeouts = map[string]time.Duration{
+	"": 10 * time.Second,
+}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *RenderingControl2) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, RenderingControl2Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_RenderingControl_2, actionName, request, response)
+}
+
+// RenderingControl2ListPresetsRequest is the request of ListPresets, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl2ListPresetsRequest struct {
+	InstanceID string
+}
+
+// RenderingControl2ListPresetsResponse is the response of ListPresets, with each
+// argument in its SOAP string form.
+type RenderingControl2ListPresetsResponse struct {
+	CurrentPresetNameList string
+}
+
+// ListPresets performs the ListPresets action of the service.
+//
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_ListPresets_InstanceID)
+//
+// Return values:
+//   - CurrentPresetNameList (out, state variable A_ARG_TYPE_ListPresets_CurrentPresetNameList)
 func (client *RenderingControl2) ListPresets(InstanceID uint32) (CurrentPresetNameList string, err error) {
+	return client.ListPresetsCtx(context.Background(), InstanceID)
+}
+
+// ListPresetsCtx is ListPresets with a context, to cancel or time out the call.
+func (client *RenderingControl2) ListPresetsCtx(ctx context.Context, InstanceID uint32) (CurrentPresetNameList string, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &RenderingControl2ListPresetsRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -5682,12 +8665,10 @@ func (client *RenderingControl2) ListPresets(InstanceID uint32) (CurrentPresetNa
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentPresetNameList string
-	}{}
+	response := &RenderingControl2ListPresetsResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_2, "ListPresets", request, response); err != nil {
+	if err = client.PerformAction(ctx, "ListPresets", request, response); err != nil {
 		return
 	}
 
@@ -5700,24 +8681,32 @@ func (client *RenderingControl2) ListPresets(InstanceID uint32) (CurrentPresetNa
 	return
 }
 
+// RenderingControl2SelectPresetRequest is the request of SelectPreset, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl2SelectPresetRequest struct {
+	InstanceID string
+	PresetName string
+}
+
+// SelectPreset performs the SelectPreset action of the service.
 //
 // Arguments:
-//
-// * PresetName: allowed values: FactoryDefaults
+//   - InstanceID (in, state variable A_ARG_TYPE_SelectPreset_InstanceID)
+//   - PresetName (in, state variable A_ARG_TYPE_PresetName): allowed values FactoryDefaults
+func (client *RenderingControl2) SelectPreset(InstanceID uint32, PresetName RenderingControl2PresetName) (err error) {
+	return client.SelectPresetCtx(context.Background(), InstanceID, PresetName)
+}
 
-func (client *RenderingControl2) SelectPreset(InstanceID uint32, PresetName string) (err error) {
+// SelectPresetCtx is SelectPreset with a context, to cancel or time out the call.
+func (client *RenderingControl2) SelectPresetCtx(ctx context.Context, InstanceID uint32, PresetName RenderingControl2PresetName) (err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-
-		PresetName string
-	}{}
+	request := &RenderingControl2SelectPresetRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
 		return
 	}
-	if request.PresetName, err = soap.MarshalString(PresetName); err != nil {
+	if request.PresetName, err = soap.MarshalString(string(PresetName)); err != nil {
 		return
 	}
 	// END Marshal arguments into request.
@@ -5726,7 +8715,7 @@ func (client *RenderingControl2) SelectPreset(InstanceID uint32, PresetName stri
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_2, "SelectPreset", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SelectPreset", request, response); err != nil {
 		return
 	}
 
@@ -5736,15 +8725,33 @@ func (client *RenderingControl2) SelectPreset(InstanceID uint32, PresetName stri
 	return
 }
 
+// RenderingControl2GetBrightnessRequest is the request of GetBrightness, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl2GetBrightnessRequest struct {
+	InstanceID string
+}
+
+// RenderingControl2GetBrightnessResponse is the response of GetBrightness, with each
+// argument in its SOAP string form.
+type RenderingControl2GetBrightnessResponse struct {
+	CurrentBrightness string
+}
+
+// GetBrightness performs the GetBrightness action of the service.
 //
-// Return values:
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetBrightness_InstanceID)
 //
-// * CurrentBrightness: allowed value range: minimum=0, step=1
+// Return values:
+//   - CurrentBrightness (out, state variable A_ARG_TYPE_GetBrightness_CurrentBrightness): allowed range minimum=0, step=1
 func (client *RenderingControl2) GetBrightness(InstanceID uint32) (CurrentBrightness uint16, err error) {
+	return client.GetBrightnessCtx(context.Background(), InstanceID)
+}
+
+// GetBrightnessCtx is GetBrightness with a context, to cancel or time out the call.
+func (client *RenderingControl2) GetBrightnessCtx(ctx context.Context, InstanceID uint32) (CurrentBrightness uint16, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &RenderingControl2GetBrightnessRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -5753,12 +8760,10 @@ func (client *RenderingControl2) GetBrightness(InstanceID uint32) (CurrentBright
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentBrightness string
-	}{}
+	response := &RenderingControl2GetBrightnessResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_2, "GetBrightness", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetBrightness", request, response); err != nil {
 		return
 	}
 
@@ -5771,18 +8776,26 @@ func (client *RenderingControl2) GetBrightness(InstanceID uint32) (CurrentBright
 	return
 }
 
+// RenderingControl2SetBrightnessRequest is the request of SetBrightness, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl2SetBrightnessRequest struct {
+	InstanceID        string
+	DesiredBrightness string
+}
+
+// SetBrightness performs the SetBrightness action of the service.
 //
 // Arguments:
-//
-// * DesiredBrightness: allowed value range: minimum=0, step=1
-
+//   - InstanceID (in, state variable A_ARG_TYPE_SetBrightness_InstanceID)
+//   - DesiredBrightness (in, state variable A_ARG_TYPE_SetBrightness_DesiredBrightness): allowed range minimum=0, step=1
 func (client *RenderingControl2) SetBrightness(InstanceID uint32, DesiredBrightness uint16) (err error) {
-	// Request structure.
-	request := &struct {
-		InstanceID string
+	return client.SetBrightnessCtx(context.Background(), InstanceID, DesiredBrightness)
+}
 
-		DesiredBrightness string
-	}{}
+// SetBrightnessCtx is SetBrightness with a context, to cancel or time out the call.
+func (client *RenderingControl2) SetBrightnessCtx(ctx context.Context, InstanceID uint32, DesiredBrightness uint16) (err error) {
+	// Request structure.
+	request := &RenderingControl2SetBrightnessRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -5797,7 +8810,7 @@ func (client *RenderingControl2) SetBrightness(InstanceID uint32, DesiredBrightn
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_2, "SetBrightness", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetBrightness", request, response); err != nil {
 		return
 	}
 
@@ -5807,15 +8820,33 @@ func (client *RenderingControl2) SetBrightness(InstanceID uint32, DesiredBrightn
 	return
 }
 
+// RenderingControl2GetContrastRequest is the request of GetContrast, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl2GetContrastRequest struct {
+	InstanceID string
+}
+
+// RenderingControl2GetContrastResponse is the response of GetContrast, with each
+// argument in its SOAP string form.
+type RenderingControl2GetContrastResponse struct {
+	CurrentContrast string
+}
+
+// GetContrast performs the GetContrast action of the service.
 //
-// Return values:
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetContrast_InstanceID)
 //
-// * CurrentContrast: allowed value range: minimum=0, step=1
+// Return values:
+//   - CurrentContrast (out, state variable A_ARG_TYPE_GetContrast_CurrentContrast): allowed range minimum=0, step=1
 func (client *RenderingControl2) GetContrast(InstanceID uint32) (CurrentContrast uint16, err error) {
+	return client.GetContrastCtx(context.Background(), InstanceID)
+}
+
+// GetContrastCtx is GetContrast with a context, to cancel or time out the call.
+func (client *RenderingControl2) GetContrastCtx(ctx context.Context, InstanceID uint32) (CurrentContrast uint16, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &RenderingControl2GetContrastRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -5824,12 +8855,10 @@ func (client *RenderingControl2) GetContrast(InstanceID uint32) (CurrentContrast
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentContrast string
-	}{}
+	response := &RenderingControl2GetContrastResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_2, "GetContrast", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetContrast", request, response); err != nil {
 		return
 	}
 
@@ -5842,18 +8871,26 @@ func (client *RenderingControl2) GetContrast(InstanceID uint32) (CurrentContrast
 	return
 }
 
+// RenderingControl2SetContrastRequest is the request of SetContrast, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl2SetContrastRequest struct {
+	InstanceID      string
+	DesiredContrast string
+}
+
+// SetContrast performs the SetContrast action of the service.
 //
 // Arguments:
-//
-// * DesiredContrast: allowed value range: minimum=0, step=1
-
+//   - InstanceID (in, state variable A_ARG_TYPE_SetContrast_InstanceID)
+//   - DesiredContrast (in, state variable A_ARG_TYPE_SetContrast_DesiredContrast): allowed range minimum=0, step=1
 func (client *RenderingControl2) SetContrast(InstanceID uint32, DesiredContrast uint16) (err error) {
-	// Request structure.
-	request := &struct {
-		InstanceID string
+	return client.SetContrastCtx(context.Background(), InstanceID, DesiredContrast)
+}
 
-		DesiredContrast string
-	}{}
+// SetContrastCtx is SetContrast with a context, to cancel or time out the call.
+func (client *RenderingControl2) SetContrastCtx(ctx context.Context, InstanceID uint32, DesiredContrast uint16) (err error) {
+	// Request structure.
+	request := &RenderingControl2SetContrastRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -5868,7 +8905,7 @@ func (client *RenderingControl2) SetContrast(InstanceID uint32, DesiredContrast
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_2, "SetContrast", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetContrast", request, response); err != nil {
 		return
 	}
 
@@ -5878,15 +8915,33 @@ func (client *RenderingControl2) SetContrast(InstanceID uint32, DesiredContrast
 	return
 }
 
+// RenderingControl2GetSharpnessRequest is the request of GetSharpness, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl2GetSharpnessRequest struct {
+	InstanceID string
+}
+
+// RenderingControl2GetSharpnessResponse is the response of GetSharpness, with each
+// argument in its SOAP string form.
+type RenderingControl2GetSharpnessResponse struct {
+	CurrentSharpness string
+}
+
+// GetSharpness performs the GetSharpness action of the service.
 //
-// Return values:
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetSharpness_InstanceID)
 //
-// * CurrentSharpness: allowed value range: minimum=0, step=1
+// Return values:
+//   - CurrentSharpness (out, state variable A_ARG_TYPE_GetSharpness_CurrentSharpness): allowed range minimum=0, step=1
 func (client *RenderingControl2) GetSharpness(InstanceID uint32) (CurrentSharpness uint16, err error) {
+	return client.GetSharpnessCtx(context.Background(), InstanceID)
+}
+
+// GetSharpnessCtx is GetSharpness with a context, to cancel or time out the call.
+func (client *RenderingControl2) GetSharpnessCtx(ctx context.Context, InstanceID uint32) (CurrentSharpness uint16, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &RenderingControl2GetSharpnessRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -5895,12 +8950,10 @@ func (client *RenderingControl2) GetSharpness(InstanceID uint32) (CurrentSharpne
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentSharpness string
-	}{}
+	response := &RenderingControl2GetSharpnessResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_2, "GetSharpness", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetSharpness", request, response); err != nil {
 		return
 	}
 
@@ -5913,18 +8966,26 @@ func (client *RenderingControl2) GetSharpness(InstanceID uint32) (CurrentSharpne
 	return
 }
 
+// RenderingControl2SetSharpnessRequest is the request of SetSharpness, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl2SetSharpnessRequest struct {
+	InstanceID       string
+	DesiredSharpness string
+}
+
+// SetSharpness performs the SetSharpness action of the service.
 //
 // Arguments:
-//
-// * DesiredSharpness: allowed value range: minimum=0, step=1
-
+//   - InstanceID (in, state variable A_ARG_TYPE_SetSharpness_InstanceID)
+//   - DesiredSharpness (in, state variable A_ARG_TYPE_SetSharpness_DesiredSharpness): allowed range minimum=0, step=1
 func (client *RenderingControl2) SetSharpness(InstanceID uint32, DesiredSharpness uint16) (err error) {
-	// Request structure.
-	request := &struct {
-		InstanceID string
+	return client.SetSharpnessCtx(context.Background(), InstanceID, DesiredSharpness)
+}
 
-		DesiredSharpness string
-	}{}
+// SetSharpnessCtx is SetSharpness with a context, to cancel or time out the call.
+func (client *RenderingControl2) SetSharpnessCtx(ctx context.Context, InstanceID uint32, DesiredSharpness uint16) (err error) {
+	// Request structure.
+	request := &RenderingControl2SetSharpnessRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -5939,7 +9000,7 @@ func (client *RenderingControl2) SetSharpness(InstanceID uint32, DesiredSharpnes
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_2, "SetSharpness", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetSharpness", request, response); err != nil {
 		return
 	}
 
@@ -5949,15 +9010,33 @@ func (client *RenderingControl2) SetSharpness(InstanceID uint32, DesiredSharpnes
 	return
 }
 
+// RenderingControl2GetRedVideoGainRequest is the request of GetRedVideoGain, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl2GetRedVideoGainRequest struct {
+	InstanceID string
+}
+
+// RenderingControl2GetRedVideoGainResponse is the response of GetRedVideoGain, with each
+// argument in its SOAP string form.
+type RenderingControl2GetRedVideoGainResponse struct {
+	CurrentRedVideoGain string
+}
+
+// GetRedVideoGain performs the GetRedVideoGain action of the service.
 //
-// Return values:
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetRedVideoGain_InstanceID)
 //
-// * CurrentRedVideoGain: allowed value range: minimum=0, step=1
+// Return values:
+//   - CurrentRedVideoGain (out, state variable A_ARG_TYPE_GetRedVideoGain_CurrentRedVideoGain): allowed range minimum=0, step=1
 func (client *RenderingControl2) GetRedVideoGain(InstanceID uint32) (CurrentRedVideoGain uint16, err error) {
+	return client.GetRedVideoGainCtx(context.Background(), InstanceID)
+}
+
+// GetRedVideoGainCtx is GetRedVideoGain with a context, to cancel or time out the call.
+func (client *RenderingControl2) GetRedVideoGainCtx(ctx context.Context, InstanceID uint32) (CurrentRedVideoGain uint16, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &RenderingControl2GetRedVideoGainRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -5966,12 +9045,10 @@ func (client *RenderingControl2) GetRedVideoGain(InstanceID uint32) (CurrentRedV
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentRedVideoGain string
-	}{}
+	response := &RenderingControl2GetRedVideoGainResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_2, "GetRedVideoGain", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetRedVideoGain", request, response); err != nil {
 		return
 	}
 
@@ -5984,18 +9061,26 @@ func (client *RenderingControl2) GetRedVideoGain(InstanceID uint32) (CurrentRedV
 	return
 }
 
+// RenderingControl2SetRedVideoGainRequest is the request of SetRedVideoGain, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl2SetRedVideoGainRequest struct {
+	InstanceID          string
+	DesiredRedVideoGain string
+}
+
+// SetRedVideoGain performs the SetRedVideoGain action of the service.
 //
 // Arguments:
-//
-// * DesiredRedVideoGain: allowed value range: minimum=0, step=1
-
+//   - InstanceID (in, state variable A_ARG_TYPE_SetRedVideoGain_InstanceID)
+//   - DesiredRedVideoGain (in, state variable A_ARG_TYPE_SetRedVideoGain_DesiredRedVideoGain): allowed range minimum=0, step=1
 func (client *RenderingControl2) SetRedVideoGain(InstanceID uint32, DesiredRedVideoGain uint16) (err error) {
-	// Request structure.
-	request := &struct {
-		InstanceID string
+	return client.SetRedVideoGainCtx(context.Background(), InstanceID, DesiredRedVideoGain)
+}
 
-		DesiredRedVideoGain string
-	}{}
+// SetRedVideoGainCtx is SetRedVideoGain with a context, to cancel or time out the call.
+func (client *RenderingControl2) SetRedVideoGainCtx(ctx context.Context, InstanceID uint32, DesiredRedVideoGain uint16) (err error) {
+	// Request structure.
+	request := &RenderingControl2SetRedVideoGainRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -6010,7 +9095,7 @@ func (client *RenderingControl2) SetRedVideoGain(InstanceID uint32, DesiredRedVi
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_2, "SetRedVideoGain", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetRedVideoGain", request, response); err != nil {
 		return
 	}
 
@@ -6020,15 +9105,33 @@ func (client *RenderingControl2) SetRedVideoGain(InstanceID uint32, DesiredRedVi
 	return
 }
 
+// RenderingControl2GetGreenVideoGainRequest is the request of GetGreenVideoGain, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl2GetGreenVideoGainRequest struct {
+	InstanceID string
+}
+
+// RenderingControl2GetGreenVideoGainResponse is the response of GetGreenVideoGain, with each
+// argument in its SOAP string form.
+type RenderingControl2GetGreenVideoGainResponse struct {
+	CurrentGreenVideoGain string
+}
+
+// GetGreenVideoGain performs the GetGreenVideoGain action of the service.
 //
-// Return values:
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetGreenVideoGain_InstanceID)
 //
-// * CurrentGreenVideoGain: allowed value range: minimum=0, step=1
+// Return values:
+//   - CurrentGreenVideoGain (out, state variable A_ARG_TYPE_GetGreenVideoGain_CurrentGreenVideoGain): allowed range minimum=0, step=1
 func (client *RenderingControl2) GetGreenVideoGain(InstanceID uint32) (CurrentGreenVideoGain uint16, err error) {
+	return client.GetGreenVideoGainCtx(context.Background(), InstanceID)
+}
+
+// GetGreenVideoGainCtx is GetGreenVideoGain with a context, to cancel or time out the call.
+func (client *RenderingControl2) GetGreenVideoGainCtx(ctx context.Context, InstanceID uint32) (CurrentGreenVideoGain uint16, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &RenderingControl2GetGreenVideoGainRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -6037,12 +9140,10 @@ func (client *RenderingControl2) GetGreenVideoGain(InstanceID uint32) (CurrentGr
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentGreenVideoGain string
-	}{}
+	response := &RenderingControl2GetGreenVideoGainResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_2, "GetGreenVideoGain", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetGreenVideoGain", request, response); err != nil {
 		return
 	}
 
@@ -6055,18 +9156,26 @@ func (client *RenderingControl2) GetGreenVideoGain(InstanceID uint32) (CurrentGr
 	return
 }
 
+// RenderingControl2SetGreenVideoGainRequest is the request of SetGreenVideoGain, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl2SetGreenVideoGainRequest struct {
+	InstanceID            string
+	DesiredGreenVideoGain string
+}
+
+// SetGreenVideoGain performs the SetGreenVideoGain action of the service.
 //
 // Arguments:
-//
-// * DesiredGreenVideoGain: allowed value range: minimum=0, step=1
-
+//   - InstanceID (in, state variable A_ARG_TYPE_SetGreenVideoGain_InstanceID)
+//   - DesiredGreenVideoGain (in, state variable A_ARG_TYPE_SetGreenVideoGain_DesiredGreenVideoGain): allowed range minimum=0, step=1
 func (client *RenderingControl2) SetGreenVideoGain(InstanceID uint32, DesiredGreenVideoGain uint16) (err error) {
-	// Request structure.
-	request := &struct {
-		InstanceID string
+	return client.SetGreenVideoGainCtx(context.Background(), InstanceID, DesiredGreenVideoGain)
+}
 
-		DesiredGreenVideoGain string
-	}{}
+// SetGreenVideoGainCtx is SetGreenVideoGain with a context, to cancel or time out the call.
+func (client *RenderingControl2) SetGreenVideoGainCtx(ctx context.Context, InstanceID uint32, DesiredGreenVideoGain uint16) (err error) {
+	// Request structure.
+	request := &RenderingControl2SetGreenVideoGainRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -6081,7 +9190,7 @@ func (client *RenderingControl2) SetGreenVideoGain(InstanceID uint32, DesiredGre
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_2, "SetGreenVideoGain", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetGreenVideoGain", request, response); err != nil {
 		return
 	}
 
@@ -6091,15 +9200,33 @@ func (client *RenderingControl2) SetGreenVideoGain(InstanceID uint32, DesiredGre
 	return
 }
 
+// RenderingControl2GetBlueVideoGainRequest is the request of GetBlueVideoGain, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl2GetBlueVideoGainRequest struct {
+	InstanceID string
+}
+
+// RenderingControl2GetBlueVideoGainResponse is the response of GetBlueVideoGain, with each
+// argument in its SOAP string form.
+type RenderingControl2GetBlueVideoGainResponse struct {
+	CurrentBlueVideoGain string
+}
+
+// GetBlueVideoGain performs the GetBlueVideoGain action of the service.
 //
-// Return values:
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetBlueVideoGain_InstanceID)
 //
-// * CurrentBlueVideoGain: allowed value range: minimum=0, step=1
+// Return values:
+//   - CurrentBlueVideoGain (out, state variable A_ARG_TYPE_GetBlueVideoGain_CurrentBlueVideoGain): allowed range minimum=0, step=1
 func (client *RenderingControl2) GetBlueVideoGain(InstanceID uint32) (CurrentBlueVideoGain uint16, err error) {
+	return client.GetBlueVideoGainCtx(context.Background(), InstanceID)
+}
+
+// GetBlueVideoGainCtx is GetBlueVideoGain with a context, to cancel or time out the call.
+func (client *RenderingControl2) GetBlueVideoGainCtx(ctx context.Context, InstanceID uint32) (CurrentBlueVideoGain uint16, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &RenderingControl2GetBlueVideoGainRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -6108,12 +9235,10 @@ func (client *RenderingControl2) GetBlueVideoGain(InstanceID uint32) (CurrentBlu
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentBlueVideoGain string
-	}{}
+	response := &RenderingControl2GetBlueVideoGainResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_2, "GetBlueVideoGain", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetBlueVideoGain", request, response); err != nil {
 		return
 	}
 
@@ -6126,18 +9251,26 @@ func (client *RenderingControl2) GetBlueVideoGain(InstanceID uint32) (CurrentBlu
 	return
 }
 
+// RenderingControl2SetBlueVideoGainRequest is the request of SetBlueVideoGain, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl2SetBlueVideoGainRequest struct {
+	InstanceID           string
+	DesiredBlueVideoGain string
+}
+
+// SetBlueVideoGain performs the SetBlueVideoGain action of the service.
 //
 // Arguments:
-//
-// * DesiredBlueVideoGain: allowed value range: minimum=0, step=1
-
+//   - InstanceID (in, state variable A_ARG_TYPE_SetBlueVideoGain_InstanceID)
+//   - DesiredBlueVideoGain (in, state variable A_ARG_TYPE_SetBlueVideoGain_DesiredBlueVideoGain): allowed range minimum=0, step=1
 func (client *RenderingControl2) SetBlueVideoGain(InstanceID uint32, DesiredBlueVideoGain uint16) (err error) {
-	// Request structure.
-	request := &struct {
-		InstanceID string
+	return client.SetBlueVideoGainCtx(context.Background(), InstanceID, DesiredBlueVideoGain)
+}
 
-		DesiredBlueVideoGain string
-	}{}
+// SetBlueVideoGainCtx is SetBlueVideoGain with a context, to cancel or time out the call.
+func (client *RenderingControl2) SetBlueVideoGainCtx(ctx context.Context, InstanceID uint32, DesiredBlueVideoGain uint16) (err error) {
+	// Request structure.
+	request := &RenderingControl2SetBlueVideoGainRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -6152,7 +9285,7 @@ func (client *RenderingControl2) SetBlueVideoGain(InstanceID uint32, DesiredBlue
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_2, "SetBlueVideoGain", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetBlueVideoGain", request, response); err != nil {
 		return
 	}
 
@@ -6162,15 +9295,33 @@ func (client *RenderingControl2) SetBlueVideoGain(InstanceID uint32, DesiredBlue
 	return
 }
 
+// RenderingControl2GetRedVideoBlackLevelRequest is the request of GetRedVideoBlackLevel, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl2GetRedVideoBlackLevelRequest struct {
+	InstanceID string
+}
+
+// RenderingControl2GetRedVideoBlackLevelResponse is the response of GetRedVideoBlackLevel, with each
+// argument in its SOAP string form.
+type RenderingControl2GetRedVideoBlackLevelResponse struct {
+	CurrentRedVideoBlackLevel string
+}
+
+// GetRedVideoBlackLevel performs the GetRedVideoBlackLevel action of the service.
 //
-// Return values:
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetRedVideoBlackLevel_InstanceID)
 //
-// * CurrentRedVideoBlackLevel: allowed value range: minimum=0, step=1
+// Return values:
+//   - CurrentRedVideoBlackLevel (out, state variable A_ARG_TYPE_GetRedVideoBlackLevel_CurrentRedVideoBlackLevel): allowed range minimum=0, step=1
 func (client *RenderingControl2) GetRedVideoBlackLevel(InstanceID uint32) (CurrentRedVideoBlackLevel uint16, err error) {
+	return client.GetRedVideoBlackLevelCtx(context.Background(), InstanceID)
+}
+
+// GetRedVideoBlackLevelCtx is GetRedVideoBlackLevel with a context, to cancel or time out the call.
+func (client *RenderingControl2) GetRedVideoBlackLevelCtx(ctx context.Context, InstanceID uint32) (CurrentRedVideoBlackLevel uint16, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &RenderingControl2GetRedVideoBlackLevelRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -6179,12 +9330,10 @@ func (client *RenderingControl2) GetRedVideoBlackLevel(InstanceID uint32) (Curre
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentRedVideoBlackLevel string
-	}{}
+	response := &RenderingControl2GetRedVideoBlackLevelResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_2, "GetRedVideoBlackLevel", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetRedVideoBlackLevel", request, response); err != nil {
 		return
 	}
 
@@ -6197,18 +9346,26 @@ func (client *RenderingControl2) GetRedVideoBlackLevel(InstanceID uint32) (Curre
 	return
 }
 
+// RenderingControl2SetRedVideoBlackLevelRequest is the request of SetRedVideoBlackLevel, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl2SetRedVideoBlackLevelRequest struct {
+	InstanceID                string
+	DesiredRedVideoBlackLevel string
+}
+
+// SetRedVideoBlackLevel performs the SetRedVideoBlackLevel action of the service.
 //
 // Arguments:
-//
-// * DesiredRedVideoBlackLevel: allowed value range: minimum=0, step=1
-
+//   - InstanceID (in, state variable A_ARG_TYPE_SetRedVideoBlackLevel_InstanceID)
+//   - DesiredRedVideoBlackLevel (in, state variable A_ARG_TYPE_SetRedVideoBlackLevel_DesiredRedVideoBlackLevel): allowed range minimum=0, step=1
 func (client *RenderingControl2) SetRedVideoBlackLevel(InstanceID uint32, DesiredRedVideoBlackLevel uint16) (err error) {
-	// Request structure.
-	request := &struct {
-		InstanceID string
+	return client.SetRedVideoBlackLevelCtx(context.Background(), InstanceID, DesiredRedVideoBlackLevel)
+}
 
-		DesiredRedVideoBlackLevel string
-	}{}
+// SetRedVideoBlackLevelCtx is SetRedVideoBlackLevel with a context, to cancel or time out the call.
+func (client *RenderingControl2) SetRedVideoBlackLevelCtx(ctx context.Context, InstanceID uint32, DesiredRedVideoBlackLevel uint16) (err error) {
+	// Request structure.
+	request := &RenderingControl2SetRedVideoBlackLevelRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -6223,7 +9380,7 @@ func (client *RenderingControl2) SetRedVideoBlackLevel(InstanceID uint32, Desire
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_2, "SetRedVideoBlackLevel", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetRedVideoBlackLevel", request, response); err != nil {
 		return
 	}
 
@@ -6233,15 +9390,33 @@ func (client *RenderingControl2) SetRedVideoBlackLevel(InstanceID uint32, Desire
 	return
 }
 
+// RenderingControl2GetGreenVideoBlackLevelRequest is the request of GetGreenVideoBlackLevel, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl2GetGreenVideoBlackLevelRequest struct {
+	InstanceID string
+}
+
+// RenderingControl2GetGreenVideoBlackLevelResponse is the response of GetGreenVideoBlackLevel, with each
+// argument in its SOAP string form.
+type RenderingControl2GetGreenVideoBlackLevelResponse struct {
+	CurrentGreenVideoBlackLevel string
+}
+
+// GetGreenVideoBlackLevel performs the GetGreenVideoBlackLevel action of the service.
 //
-// Return values:
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetGreenVideoBlackLevel_InstanceID)
 //
-// * CurrentGreenVideoBlackLevel: allowed value range: minimum=0, step=1
+// Return values:
+//   - CurrentGreenVideoBlackLevel (out, state variable A_ARG_TYPE_GetGreenVideoBlackLevel_CurrentGreenVideoBlackLevel): allowed range minimum=0, step=1
 func (client *RenderingControl2) GetGreenVideoBlackLevel(InstanceID uint32) (CurrentGreenVideoBlackLevel uint16, err error) {
+	return client.GetGreenVideoBlackLevelCtx(context.Background(), InstanceID)
+}
+
+// GetGreenVideoBlackLevelCtx is GetGreenVideoBlackLevel with a context, to cancel or time out the call.
+func (client *RenderingControl2) GetGreenVideoBlackLevelCtx(ctx context.Context, InstanceID uint32) (CurrentGreenVideoBlackLevel uint16, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &RenderingControl2GetGreenVideoBlackLevelRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -6250,12 +9425,10 @@ func (client *RenderingControl2) GetGreenVideoBlackLevel(InstanceID uint32) (Cur
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentGreenVideoBlackLevel string
-	}{}
+	response := &RenderingControl2GetGreenVideoBlackLevelResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_2, "GetGreenVideoBlackLevel", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetGreenVideoBlackLevel", request, response); err != nil {
 		return
 	}
 
@@ -6268,18 +9441,26 @@ func (client *RenderingControl2) GetGreenVideoBlackLevel(InstanceID uint32) (Cur
 	return
 }
 
+// RenderingControl2SetGreenVideoBlackLevelRequest is the request of SetGreenVideoBlackLevel, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl2SetGreenVideoBlackLevelRequest struct {
+	InstanceID                  string
+	DesiredGreenVideoBlackLevel string
+}
+
+// SetGreenVideoBlackLevel performs the SetGreenVideoBlackLevel action of the service.
 //
 // Arguments:
-//
-// * DesiredGreenVideoBlackLevel: allowed value range: minimum=0, step=1
-
+//   - InstanceID (in, state variable A_ARG_TYPE_SetGreenVideoBlackLevel_InstanceID)
+//   - DesiredGreenVideoBlackLevel (in, state variable A_ARG_TYPE_SetGreenVideoBlackLevel_DesiredGreenVideoBlackLevel): allowed range minimum=0, step=1
 func (client *RenderingControl2) SetGreenVideoBlackLevel(InstanceID uint32, DesiredGreenVideoBlackLevel uint16) (err error) {
-	// Request structure.
-	request := &struct {
-		InstanceID string
+	return client.SetGreenVideoBlackLevelCtx(context.Background(), InstanceID, DesiredGreenVideoBlackLevel)
+}
 
-		DesiredGreenVideoBlackLevel string
-	}{}
+// SetGreenVideoBlackLevelCtx is SetGreenVideoBlackLevel with a context, to cancel or time out the call.
+func (client *RenderingControl2) SetGreenVideoBlackLevelCtx(ctx context.Context, InstanceID uint32, DesiredGreenVideoBlackLevel uint16) (err error) {
+	// Request structure.
+	request := &RenderingControl2SetGreenVideoBlackLevelRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -6294,7 +9475,7 @@ func (client *RenderingControl2) SetGreenVideoBlackLevel(InstanceID uint32, Desi
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_2, "SetGreenVideoBlackLevel", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetGreenVideoBlackLevel", request, response); err != nil {
 		return
 	}
 
@@ -6304,15 +9485,33 @@ func (client *RenderingControl2) SetGreenVideoBlackLevel(InstanceID uint32, Desi
 	return
 }
 
+// RenderingControl2GetBlueVideoBlackLevelRequest is the request of GetBlueVideoBlackLevel, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl2GetBlueVideoBlackLevelRequest struct {
+	InstanceID string
+}
+
+// RenderingControl2GetBlueVideoBlackLevelResponse is the response of GetBlueVideoBlackLevel, with each
+// argument in its SOAP string form.
+type RenderingControl2GetBlueVideoBlackLevelResponse struct {
+	CurrentBlueVideoBlackLevel string
+}
+
+// GetBlueVideoBlackLevel performs the GetBlueVideoBlackLevel action of the service.
 //
-// Return values:
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetBlueVideoBlackLevel_InstanceID)
 //
-// * CurrentBlueVideoBlackLevel: allowed value range: minimum=0, step=1
+// Return values:
+//   - CurrentBlueVideoBlackLevel (out, state variable A_ARG_TYPE_GetBlueVideoBlackLevel_CurrentBlueVideoBlackLevel): allowed range minimum=0, step=1
 func (client *RenderingControl2) GetBlueVideoBlackLevel(InstanceID uint32) (CurrentBlueVideoBlackLevel uint16, err error) {
+	return client.GetBlueVideoBlackLevelCtx(context.Background(), InstanceID)
+}
+
+// GetBlueVideoBlackLevelCtx is GetBlueVideoBlackLevel with a context, to cancel or time out the call.
+func (client *RenderingControl2) GetBlueVideoBlackLevelCtx(ctx context.Context, InstanceID uint32) (CurrentBlueVideoBlackLevel uint16, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &RenderingControl2GetBlueVideoBlackLevelRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -6321,12 +9520,10 @@ func (client *RenderingControl2) GetBlueVideoBlackLevel(InstanceID uint32) (Curr
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentBlueVideoBlackLevel string
-	}{}
+	response := &RenderingControl2GetBlueVideoBlackLevelResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_2, "GetBlueVideoBlackLevel", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetBlueVideoBlackLevel", request, response); err != nil {
 		return
 	}
 
@@ -6339,18 +9536,26 @@ func (client *RenderingControl2) GetBlueVideoBlackLevel(InstanceID uint32) (Curr
 	return
 }
 
+// RenderingControl2SetBlueVideoBlackLevelRequest is the request of SetBlueVideoBlackLevel, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl2SetBlueVideoBlackLevelRequest struct {
+	InstanceID                 string
+	DesiredBlueVideoBlackLevel string
+}
+
+// SetBlueVideoBlackLevel performs the SetBlueVideoBlackLevel action of the service.
 //
 // Arguments:
-//
-// * DesiredBlueVideoBlackLevel: allowed value range: minimum=0, step=1
-
+//   - InstanceID (in, state variable A_ARG_TYPE_SetBlueVideoBlackLevel_InstanceID)
+//   - DesiredBlueVideoBlackLevel (in, state variable A_ARG_TYPE_SetBlueVideoBlackLevel_DesiredBlueVideoBlackLevel): allowed range minimum=0, step=1
 func (client *RenderingControl2) SetBlueVideoBlackLevel(InstanceID uint32, DesiredBlueVideoBlackLevel uint16) (err error) {
-	// Request structure.
-	request := &struct {
-		InstanceID string
+	return client.SetBlueVideoBlackLevelCtx(context.Background(), InstanceID, DesiredBlueVideoBlackLevel)
+}
 
-		DesiredBlueVideoBlackLevel string
-	}{}
+// SetBlueVideoBlackLevelCtx is SetBlueVideoBlackLevel with a context, to cancel or time out the call.
+func (client *RenderingControl2) SetBlueVideoBlackLevelCtx(ctx context.Context, InstanceID uint32, DesiredBlueVideoBlackLevel uint16) (err error) {
+	// Request structure.
+	request := &RenderingControl2SetBlueVideoBlackLevelRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -6365,7 +9570,7 @@ func (client *RenderingControl2) SetBlueVideoBlackLevel(InstanceID uint32, Desir
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_2, "SetBlueVideoBlackLevel", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetBlueVideoBlackLevel", request, response); err != nil {
 		return
 	}
 
@@ -6375,15 +9580,33 @@ func (client *RenderingControl2) SetBlueVideoBlackLevel(InstanceID uint32, Desir
 	return
 }
 
+// RenderingControl2GetColorTemperatureRequest is the request of GetColorTemperature, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl2GetColorTemperatureRequest struct {
+	InstanceID string
+}
+
+// RenderingControl2GetColorTemperatureResponse is the response of GetColorTemperature, with each
+// argument in its SOAP string form.
+type RenderingControl2GetColorTemperatureResponse struct {
+	CurrentColorTemperature string
+}
+
+// GetColorTemperature performs the GetColorTemperature action of the service.
 //
-// Return values:
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetColorTemperature_InstanceID)
 //
-// * CurrentColorTemperature: allowed value range: minimum=0, step=1
+// Return values:
+//   - CurrentColorTemperature (out, state variable A_ARG_TYPE_GetColorTemperature_CurrentColorTemperature): allowed range minimum=0, step=1
 func (client *RenderingControl2) GetColorTemperature(InstanceID uint32) (CurrentColorTemperature uint16, err error) {
+	return client.GetColorTemperatureCtx(context.Background(), InstanceID)
+}
+
+// GetColorTemperatureCtx is GetColorTemperature with a context, to cancel or time out the call.
+func (client *RenderingControl2) GetColorTemperatureCtx(ctx context.Context, InstanceID uint32) (CurrentColorTemperature uint16, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &RenderingControl2GetColorTemperatureRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -6392,12 +9615,10 @@ func (client *RenderingControl2) GetColorTemperature(InstanceID uint32) (Current
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentColorTemperature string
-	}{}
+	response := &RenderingControl2GetColorTemperatureResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_2, "GetColorTemperature", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetColorTemperature", request, response); err != nil {
 		return
 	}
 
@@ -6410,18 +9631,26 @@ func (client *RenderingControl2) GetColorTemperature(InstanceID uint32) (Current
 	return
 }
 
+// RenderingControl2SetColorTemperatureRequest is the request of SetColorTemperature, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl2SetColorTemperatureRequest struct {
+	InstanceID              string
+	DesiredColorTemperature string
+}
+
+// SetColorTemperature performs the SetColorTemperature action of the service.
 //
 // Arguments:
-//
-// * DesiredColorTemperature: allowed value range: minimum=0, step=1
-
+//   - InstanceID (in, state variable A_ARG_TYPE_SetColorTemperature_InstanceID)
+//   - DesiredColorTemperature (in, state variable A_ARG_TYPE_SetColorTemperature_DesiredColorTemperature): allowed range minimum=0, step=1
 func (client *RenderingControl2) SetColorTemperature(InstanceID uint32, DesiredColorTemperature uint16) (err error) {
-	// Request structure.
-	request := &struct {
-		InstanceID string
+	return client.SetColorTemperatureCtx(context.Background(), InstanceID, DesiredColorTemperature)
+}
 
-		DesiredColorTemperature string
-	}{}
+// SetColorTemperatureCtx is SetColorTemperature with a context, to cancel or time out the call.
+func (client *RenderingControl2) SetColorTemperatureCtx(ctx context.Context, InstanceID uint32, DesiredColorTemperature uint16) (err error) {
+	// Request structure.
+	request := &RenderingControl2SetColorTemperatureRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -6436,7 +9665,7 @@ func (client *RenderingControl2) SetColorTemperature(InstanceID uint32, DesiredC
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_2, "SetColorTemperature", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetColorTemperature", request, response); err != nil {
 		return
 	}
 
@@ -6446,15 +9675,33 @@ func (client *RenderingControl2) SetColorTemperature(InstanceID uint32, DesiredC
 	return
 }
 
+// RenderingControl2GetHorizontalKeystoneRequest is the request of GetHorizontalKeystone, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl2GetHorizontalKeystoneRequest struct {
+	InstanceID string
+}
+
+// RenderingControl2GetHorizontalKeystoneResponse is the response of GetHorizontalKeystone, with each
+// argument in its SOAP string form.
+type RenderingControl2GetHorizontalKeystoneResponse struct {
+	CurrentHorizontalKeystone string
+}
+
+// GetHorizontalKeystone performs the GetHorizontalKeystone action of the service.
 //
-// Return values:
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetHorizontalKeystone_InstanceID)
 //
-// * CurrentHorizontalKeystone: allowed value range: step=1
+// Return values:
+//   - CurrentHorizontalKeystone (out, state variable A_ARG_TYPE_GetHorizontalKeystone_CurrentHorizontalKeystone): allowed range step=1
 func (client *RenderingControl2) GetHorizontalKeystone(InstanceID uint32) (CurrentHorizontalKeystone int16, err error) {
+	return client.GetHorizontalKeystoneCtx(context.Background(), InstanceID)
+}
+
+// GetHorizontalKeystoneCtx is GetHorizontalKeystone with a context, to cancel or time out the call.
+func (client *RenderingControl2) GetHorizontalKeystoneCtx(ctx context.Context, InstanceID uint32) (CurrentHorizontalKeystone int16, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &RenderingControl2GetHorizontalKeystoneRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -6463,12 +9710,10 @@ func (client *RenderingControl2) GetHorizontalKeystone(InstanceID uint32) (Curre
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentHorizontalKeystone string
-	}{}
+	response := &RenderingControl2GetHorizontalKeystoneResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_2, "GetHorizontalKeystone", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetHorizontalKeystone", request, response); err != nil {
 		return
 	}
 
@@ -6481,18 +9726,26 @@ func (client *RenderingControl2) GetHorizontalKeystone(InstanceID uint32) (Curre
 	return
 }
 
+// RenderingControl2SetHorizontalKeystoneRequest is the request of SetHorizontalKeystone, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl2SetHorizontalKeystoneRequest struct {
+	InstanceID                string
+	DesiredHorizontalKeystone string
+}
+
+// SetHorizontalKeystone performs the SetHorizontalKeystone action of the service.
 //
 // Arguments:
-//
-// * DesiredHorizontalKeystone: allowed value range: step=1
-
+//   - InstanceID (in, state variable A_ARG_TYPE_SetHorizontalKeystone_InstanceID)
+//   - DesiredHorizontalKeystone (in, state variable A_ARG_TYPE_SetHorizontalKeystone_DesiredHorizontalKeystone): allowed range step=1
 func (client *RenderingControl2) SetHorizontalKeystone(InstanceID uint32, DesiredHorizontalKeystone int16) (err error) {
-	// Request structure.
-	request := &struct {
-		InstanceID string
+	return client.SetHorizontalKeystoneCtx(context.Background(), InstanceID, DesiredHorizontalKeystone)
+}
 
-		DesiredHorizontalKeystone string
-	}{}
+// SetHorizontalKeystoneCtx is SetHorizontalKeystone with a context, to cancel or time out the call.
+func (client *RenderingControl2) SetHorizontalKeystoneCtx(ctx context.Context, InstanceID uint32, DesiredHorizontalKeystone int16) (err error) {
+	// Request structure.
+	request := &RenderingControl2SetHorizontalKeystoneRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -6507,7 +9760,7 @@ func (client *RenderingControl2) SetHorizontalKeystone(InstanceID uint32, Desire
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_2, "SetHorizontalKeystone", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetHorizontalKeystone", request, response); err != nil {
 		return
 	}
 
@@ -6517,15 +9770,33 @@ func (client *RenderingControl2) SetHorizontalKeystone(InstanceID uint32, Desire
 	return
 }
 
+// RenderingControl2GetVerticalKeystoneRequest is the request of GetVerticalKeystone, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl2GetVerticalKeystoneRequest struct {
+	InstanceID string
+}
+
+// RenderingControl2GetVerticalKeystoneResponse is the response of GetVerticalKeystone, with each
+// argument in its SOAP string form.
+type RenderingControl2GetVerticalKeystoneResponse struct {
+	CurrentVerticalKeystone string
+}
+
+// GetVerticalKeystone performs the GetVerticalKeystone action of the service.
 //
-// Return values:
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetVerticalKeystone_InstanceID)
 //
-// * CurrentVerticalKeystone: allowed value range: step=1
+// Return values:
+//   - CurrentVerticalKeystone (out, state variable A_ARG_TYPE_GetVerticalKeystone_CurrentVerticalKeystone): allowed range step=1
 func (client *RenderingControl2) GetVerticalKeystone(InstanceID uint32) (CurrentVerticalKeystone int16, err error) {
+	return client.GetVerticalKeystoneCtx(context.Background(), InstanceID)
+}
+
+// GetVerticalKeystoneCtx is GetVerticalKeystone with a context, to cancel or time out the call.
+func (client *RenderingControl2) GetVerticalKeystoneCtx(ctx context.Context, InstanceID uint32) (CurrentVerticalKeystone int16, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-	}{}
+	request := &RenderingControl2GetVerticalKeystoneRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -6534,12 +9805,10 @@ func (client *RenderingControl2) GetVerticalKeystone(InstanceID uint32) (Current
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentVerticalKeystone string
-	}{}
+	response := &RenderingControl2GetVerticalKeystoneResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_2, "GetVerticalKeystone", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetVerticalKeystone", request, response); err != nil {
 		return
 	}
 
@@ -6552,18 +9821,26 @@ func (client *RenderingControl2) GetVerticalKeystone(InstanceID uint32) (Current
 	return
 }
 
+// RenderingControl2SetVerticalKeystoneRequest is the request of SetVerticalKeystone, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl2SetVerticalKeystoneRequest struct {
+	InstanceID              string
+	DesiredVerticalKeystone string
+}
+
+// SetVerticalKeystone performs the SetVerticalKeystone action of the service.
 //
 // Arguments:
-//
-// * DesiredVerticalKeystone: allowed value range: step=1
-
+//   - InstanceID (in, state variable A_ARG_TYPE_SetVerticalKeystone_InstanceID)
+//   - DesiredVerticalKeystone (in, state variable A_ARG_TYPE_SetVerticalKeystone_DesiredVerticalKeystone): allowed range step=1
 func (client *RenderingControl2) SetVerticalKeystone(InstanceID uint32, DesiredVerticalKeystone int16) (err error) {
-	// Request structure.
-	request := &struct {
-		InstanceID string
+	return client.SetVerticalKeystoneCtx(context.Background(), InstanceID, DesiredVerticalKeystone)
+}
 
-		DesiredVerticalKeystone string
-	}{}
+// SetVerticalKeystoneCtx is SetVerticalKeystone with a context, to cancel or time out the call.
+func (client *RenderingControl2) SetVerticalKeystoneCtx(ctx context.Context, InstanceID uint32, DesiredVerticalKeystone int16) (err error) {
+	// Request structure.
+	request := &RenderingControl2SetVerticalKeystoneRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -6578,7 +9855,7 @@ func (client *RenderingControl2) SetVerticalKeystone(InstanceID uint32, DesiredV
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_2, "SetVerticalKeystone", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetVerticalKeystone", request, response); err != nil {
 		return
 	}
 
@@ -6588,35 +9865,50 @@ func (client *RenderingControl2) SetVerticalKeystone(InstanceID uint32, DesiredV
 	return
 }
 
+// RenderingControl2GetMuteRequest is the request of GetMute, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl2GetMuteRequest struct {
+	InstanceID string
+	Channel    string
+}
+
+// RenderingControl2GetMuteResponse is the response of GetMute, with each
+// argument in its SOAP string form.
+type RenderingControl2GetMuteResponse struct {
+	CurrentMute string
+}
+
+// GetMute performs the GetMute action of the service.
 //
 // Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetMute_InstanceID)
+//   - Channel (in, state variable A_ARG_TYPE_Channel): allowed values Master
 //
-// * Channel: allowed values: Master
+// Return values:
+//   - CurrentMute (out, state variable A_ARG_TYPE_GetMute_CurrentMute)
+func (client *RenderingControl2) GetMute(InstanceID uint32, Channel RenderingControl2Channel) (CurrentMute bool, err error) {
+	return client.GetMuteCtx(context.Background(), InstanceID, Channel)
+}
 
-func (client *RenderingControl2) GetMute(InstanceID uint32, Channel string) (CurrentMute bool, err error) {
+// GetMuteCtx is GetMute with a context, to cancel or time out the call.
+func (client *RenderingControl2) GetMuteCtx(ctx context.Context, InstanceID uint32, Channel RenderingControl2Channel) (CurrentMute bool, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-
-		Channel string
-	}{}
+	request := &RenderingControl2GetMuteRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
 		return
 	}
-	if request.Channel, err = soap.MarshalString(Channel); err != nil {
+	if request.Channel, err = soap.MarshalString(string(Channel)); err != nil {
 		return
 	}
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentMute string
-	}{}
+	response := &RenderingControl2GetMuteResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_2, "GetMute", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetMute", request, response); err != nil {
 		return
 	}
 
@@ -6629,26 +9921,34 @@ func (client *RenderingControl2) GetMute(InstanceID uint32, Channel string) (Cur
 	return
 }
 
+// RenderingControl2SetMuteRequest is the request of SetMute, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl2SetMuteRequest struct {
+	InstanceID  string
+	Channel     string
+	DesiredMute string
+}
+
+// SetMute performs the SetMute action of the service.
 //
 // Arguments:
-//
-// * Channel: allowed values: Master
+//   - InstanceID (in, state variable A_ARG_TYPE_SetMute_InstanceID)
+//   - Channel (in, state variable A_ARG_TYPE_Channel): allowed values Master
+//   - DesiredMute (in, state variable A_ARG_TYPE_SetMute_DesiredMute)
+func (client *RenderingControl2) SetMute(InstanceID uint32, Channel RenderingControl2Channel, DesiredMute bool) (err error) {
+	return client.SetMuteCtx(context.Background(), InstanceID, Channel, DesiredMute)
+}
 
-func (client *RenderingControl2) SetMute(InstanceID uint32, Channel string, DesiredMute bool) (err error) {
+// SetMuteCtx is SetMute with a context, to cancel or time out the call.
+func (client *RenderingControl2) SetMuteCtx(ctx context.Context, InstanceID uint32, Channel RenderingControl2Channel, DesiredMute bool) (err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-
-		Channel string
-
-		DesiredMute string
-	}{}
+	request := &RenderingControl2SetMuteRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
 		return
 	}
-	if request.Channel, err = soap.MarshalString(Channel); err != nil {
+	if request.Channel, err = soap.MarshalString(string(Channel)); err != nil {
 		return
 	}
 	if request.DesiredMute, err = soap.MarshalBoolean(DesiredMute); err != nil {
@@ -6660,7 +9960,7 @@ func (client *RenderingControl2) SetMute(InstanceID uint32, Channel string, Desi
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_2, "SetMute", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetMute", request, response); err != nil {
 		return
 	}
 
@@ -6670,39 +9970,50 @@ func (client *RenderingControl2) SetMute(InstanceID uint32, Channel string, Desi
 	return
 }
 
+// RenderingControl2GetVolumeRequest is the request of GetVolume, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl2GetVolumeRequest struct {
+	InstanceID string
+	Channel    string
+}
+
+// RenderingControl2GetVolumeResponse is the response of GetVolume, with each
+// argument in its SOAP string form.
+type RenderingControl2GetVolumeResponse struct {
+	CurrentVolume string
+}
+
+// GetVolume performs the GetVolume action of the service.
 //
 // Arguments:
-//
-// * Channel: allowed values: Master
-
+//   - InstanceID (in, state variable A_ARG_TYPE_GetVolume_InstanceID)
+//   - Channel (in, state variable A_ARG_TYPE_Channel): allowed values Master
 //
 // Return values:
-//
-// * CurrentVolume: allowed value range: minimum=0, step=1
-func (client *RenderingControl2) GetVolume(InstanceID uint32, Channel string) (CurrentVolume uint16, err error) {
-	// Request structure.
-	request := &struct {
-		InstanceID string
+//   - CurrentVolume (out, state variable A_ARG_TYPE_GetVolume_CurrentVolume): allowed range minimum=0, step=1
+func (client *RenderingControl2) GetVolume(InstanceID uint32, Channel RenderingControl2Channel) (CurrentVolume uint16, err error) {
+	return client.GetVolumeCtx(context.Background(), InstanceID, Channel)
+}
 
-		Channel string
-	}{}
+// GetVolumeCtx is GetVolume with a context, to cancel or time out the call.
+func (client *RenderingControl2) GetVolumeCtx(ctx context.Context, InstanceID uint32, Channel RenderingControl2Channel) (CurrentVolume uint16, err error) {
+	// Request structure.
+	request := &RenderingControl2GetVolumeRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
 		return
 	}
-	if request.Channel, err = soap.MarshalString(Channel); err != nil {
+	if request.Channel, err = soap.MarshalString(string(Channel)); err != nil {
 		return
 	}
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentVolume string
-	}{}
+	response := &RenderingControl2GetVolumeResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_2, "GetVolume", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetVolume", request, response); err != nil {
 		return
 	}
 
@@ -6715,28 +10026,34 @@ func (client *RenderingControl2) GetVolume(InstanceID uint32, Channel string) (C
 	return
 }
 
+// RenderingControl2SetVolumeRequest is the request of SetVolume, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl2SetVolumeRequest struct {
+	InstanceID    string
+	Channel       string
+	DesiredVolume string
+}
+
+// SetVolume performs the SetVolume action of the service.
 //
 // Arguments:
-//
-// * Channel: allowed values: Master
-//
-// * DesiredVolume: allowed value range: minimum=0, step=1
+//   - InstanceID (in, state variable A_ARG_TYPE_SetVolume_InstanceID)
+//   - Channel (in, state variable A_ARG_TYPE_Channel): allowed values Master
+//   - DesiredVolume (in, state variable A_ARG_TYPE_SetVolume_DesiredVolume): allowed range minimum=0, step=1
+func (client *RenderingControl2) SetVolume(InstanceID uint32, Channel RenderingControl2Channel, DesiredVolume uint16) (err error) {
+	return client.SetVolumeCtx(context.Background(), InstanceID, Channel, DesiredVolume)
+}
 
-func (client *RenderingControl2) SetVolume(InstanceID uint32, Channel string, DesiredVolume uint16) (err error) {
+// SetVolumeCtx is SetVolume with a context, to cancel or time out the call.
+func (client *RenderingControl2) SetVolumeCtx(ctx context.Context, InstanceID uint32, Channel RenderingControl2Channel, DesiredVolume uint16) (err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-
-		Channel string
-
-		DesiredVolume string
-	}{}
+	request := &RenderingControl2SetVolumeRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
 		return
 	}
-	if request.Channel, err = soap.MarshalString(Channel); err != nil {
+	if request.Channel, err = soap.MarshalString(string(Channel)); err != nil {
 		return
 	}
 	if request.DesiredVolume, err = soap.MarshalUi2(DesiredVolume); err != nil {
@@ -6748,7 +10065,7 @@ func (client *RenderingControl2) SetVolume(InstanceID uint32, Channel string, De
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_2, "SetVolume", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetVolume", request, response); err != nil {
 		return
 	}
 
@@ -6758,35 +10075,50 @@ func (client *RenderingControl2) SetVolume(InstanceID uint32, Channel string, De
 	return
 }
 
+// RenderingControl2GetVolumeDBRequest is the request of GetVolumeDB, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl2GetVolumeDBRequest struct {
+	InstanceID string
+	Channel    string
+}
+
+// RenderingControl2GetVolumeDBResponse is the response of GetVolumeDB, with each
+// argument in its SOAP string form.
+type RenderingControl2GetVolumeDBResponse struct {
+	CurrentVolume string
+}
+
+// GetVolumeDB performs the GetVolumeDB action of the service.
 //
 // Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetVolumeDB_InstanceID)
+//   - Channel (in, state variable A_ARG_TYPE_Channel): allowed values Master
 //
-// * Channel: allowed values: Master
+// Return values:
+//   - CurrentVolume (out, state variable A_ARG_TYPE_GetVolumeDB_CurrentVolume)
+func (client *RenderingControl2) GetVolumeDB(InstanceID uint32, Channel RenderingControl2Channel) (CurrentVolume int16, err error) {
+	return client.GetVolumeDBCtx(context.Background(), InstanceID, Channel)
+}
 
-func (client *RenderingControl2) GetVolumeDB(InstanceID uint32, Channel string) (CurrentVolume int16, err error) {
+// GetVolumeDBCtx is GetVolumeDB with a context, to cancel or time out the call.
+func (client *RenderingControl2) GetVolumeDBCtx(ctx context.Context, InstanceID uint32, Channel RenderingControl2Channel) (CurrentVolume int16, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-
-		Channel string
-	}{}
+	request := &RenderingControl2GetVolumeDBRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
 		return
 	}
-	if request.Channel, err = soap.MarshalString(Channel); err != nil {
+	if request.Channel, err = soap.MarshalString(string(Channel)); err != nil {
 		return
 	}
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentVolume string
-	}{}
+	response := &RenderingControl2GetVolumeDBResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_2, "GetVolumeDB", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetVolumeDB", request, response); err != nil {
 		return
 	}
 
@@ -6799,26 +10131,34 @@ func (client *RenderingControl2) GetVolumeDB(InstanceID uint32, Channel string)
 	return
 }
 
+// RenderingControl2SetVolumeDBRequest is the request of SetVolumeDB, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl2SetVolumeDBRequest struct {
+	InstanceID    string
+	Channel       string
+	DesiredVolume string
+}
+
+// SetVolumeDB performs the SetVolumeDB action of the service.
 //
 // Arguments:
-//
-// * Channel: allowed values: Master
+//   - InstanceID (in, state variable A_ARG_TYPE_SetVolumeDB_InstanceID)
+//   - Channel (in, state variable A_ARG_TYPE_Channel): allowed values Master
+//   - DesiredVolume (in, state variable A_ARG_TYPE_SetVolumeDB_DesiredVolume)
+func (client *RenderingControl2) SetVolumeDB(InstanceID uint32, Channel RenderingControl2Channel, DesiredVolume int16) (err error) {
+	return client.SetVolumeDBCtx(context.Background(), InstanceID, Channel, DesiredVolume)
+}
 
-func (client *RenderingControl2) SetVolumeDB(InstanceID uint32, Channel string, DesiredVolume int16) (err error) {
+// SetVolumeDBCtx is SetVolumeDB with a context, to cancel or time out the call.
+func (client *RenderingControl2) SetVolumeDBCtx(ctx context.Context, InstanceID uint32, Channel RenderingControl2Channel, DesiredVolume int16) (err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-
-		Channel string
-
-		DesiredVolume string
-	}{}
+	request := &RenderingControl2SetVolumeDBRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
 		return
 	}
-	if request.Channel, err = soap.MarshalString(Channel); err != nil {
+	if request.Channel, err = soap.MarshalString(string(Channel)); err != nil {
 		return
 	}
 	if request.DesiredVolume, err = soap.MarshalI2(DesiredVolume); err != nil {
@@ -6830,7 +10170,7 @@ func (client *RenderingControl2) SetVolumeDB(InstanceID uint32, Channel string,
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_2, "SetVolumeDB", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetVolumeDB", request, response); err != nil {
 		return
 	}
 
@@ -6840,37 +10180,52 @@ func (client *RenderingControl2) SetVolumeDB(InstanceID uint32, Channel string,
 	return
 }
 
+// RenderingControl2GetVolumeDBRangeRequest is the request of GetVolumeDBRange, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl2GetVolumeDBRangeRequest struct {
+	InstanceID string
+	Channel    string
+}
+
+// RenderingControl2GetVolumeDBRangeResponse is the response of GetVolumeDBRange, with each
+// argument in its SOAP string form.
+type RenderingControl2GetVolumeDBRangeResponse struct {
+	MinValue string
+	MaxValue string
+}
+
+// GetVolumeDBRange performs the GetVolumeDBRange action of the service.
 //
 // Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetVolumeDBRange_InstanceID)
+//   - Channel (in, state variable A_ARG_TYPE_Channel): allowed values Master
 //
-// * Channel: allowed values: Master
+// Return values:
+//   - MinValue (out, state variable A_ARG_TYPE_GetVolumeDBRange_MinValue)
+//   - MaxValue (out, state variable A_ARG_TYPE_GetVolumeDBRange_MaxValue)
+func (client *RenderingControl2) GetVolumeDBRange(InstanceID uint32, Channel RenderingControl2Channel) (MinValue int16, MaxValue int16, err error) {
+	return client.GetVolumeDBRangeCtx(context.Background(), InstanceID, Channel)
+}
 
-func (client *RenderingControl2) GetVolumeDBRange(InstanceID uint32, Channel string) (MinValue int16, MaxValue int16, err error) {
+// GetVolumeDBRangeCtx is GetVolumeDBRange with a context, to cancel or time out the call.
+func (client *RenderingControl2) GetVolumeDBRangeCtx(ctx context.Context, InstanceID uint32, Channel RenderingControl2Channel) (MinValue int16, MaxValue int16, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-
-		Channel string
-	}{}
+	request := &RenderingControl2GetVolumeDBRangeRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
 		return
 	}
-	if request.Channel, err = soap.MarshalString(Channel); err != nil {
+	if request.Channel, err = soap.MarshalString(string(Channel)); err != nil {
 		return
 	}
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		MinValue string
-
-		MaxValue string
-	}{}
+	response := &RenderingControl2GetVolumeDBRangeResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_2, "GetVolumeDBRange", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetVolumeDBRange", request, response); err != nil {
 		return
 	}
 
@@ -6886,35 +10241,50 @@ func (client *RenderingControl2) GetVolumeDBRange(InstanceID uint32, Channel str
 	return
 }
 
+// RenderingControl2GetLoudnessRequest is the request of GetLoudness, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl2GetLoudnessRequest struct {
+	InstanceID string
+	Channel    string
+}
+
+// RenderingControl2GetLoudnessResponse is the response of GetLoudness, with each
+// argument in its SOAP string form.
+type RenderingControl2GetLoudnessResponse struct {
+	CurrentLoudness string
+}
+
+// GetLoudness performs the GetLoudness action of the service.
 //
 // Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetLoudness_InstanceID)
+//   - Channel (in, state variable A_ARG_TYPE_Channel): allowed values Master
 //
-// * Channel: allowed values: Master
+// Return values:
+//   - CurrentLoudness (out, state variable A_ARG_TYPE_GetLoudness_CurrentLoudness)
+func (client *RenderingControl2) GetLoudness(InstanceID uint32, Channel RenderingControl2Channel) (CurrentLoudness bool, err error) {
+	return client.GetLoudnessCtx(context.Background(), InstanceID, Channel)
+}
 
-func (client *RenderingControl2) GetLoudness(InstanceID uint32, Channel string) (CurrentLoudness bool, err error) {
+// GetLoudnessCtx is GetLoudness with a context, to cancel or time out the call.
+func (client *RenderingControl2) GetLoudnessCtx(ctx context.Context, InstanceID uint32, Channel RenderingControl2Channel) (CurrentLoudness bool, err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-
-		Channel string
-	}{}
+	request := &RenderingControl2GetLoudnessRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
 		return
 	}
-	if request.Channel, err = soap.MarshalString(Channel); err != nil {
+	if request.Channel, err = soap.MarshalString(string(Channel)); err != nil {
 		return
 	}
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		CurrentLoudness string
-	}{}
+	response := &RenderingControl2GetLoudnessResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_2, "GetLoudness", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetLoudness", request, response); err != nil {
 		return
 	}
 
@@ -6927,26 +10297,34 @@ func (client *RenderingControl2) GetLoudness(InstanceID uint32, Channel string)
 	return
 }
 
+// RenderingControl2SetLoudnessRequest is the request of SetLoudness, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl2SetLoudnessRequest struct {
+	InstanceID      string
+	Channel         string
+	DesiredLoudness string
+}
+
+// SetLoudness performs the SetLoudness action of the service.
 //
 // Arguments:
-//
-// * Channel: allowed values: Master
+//   - InstanceID (in, state variable A_ARG_TYPE_SetLoudness_InstanceID)
+//   - Channel (in, state variable A_ARG_TYPE_Channel): allowed values Master
+//   - DesiredLoudness (in, state variable A_ARG_TYPE_SetLoudness_DesiredLoudness)
+func (client *RenderingControl2) SetLoudness(InstanceID uint32, Channel RenderingControl2Channel, DesiredLoudness bool) (err error) {
+	return client.SetLoudnessCtx(context.Background(), InstanceID, Channel, DesiredLoudness)
+}
 
-func (client *RenderingControl2) SetLoudness(InstanceID uint32, Channel string, DesiredLoudness bool) (err error) {
+// SetLoudnessCtx is SetLoudness with a context, to cancel or time out the call.
+func (client *RenderingControl2) SetLoudnessCtx(ctx context.Context, InstanceID uint32, Channel RenderingControl2Channel, DesiredLoudness bool) (err error) {
 	// Request structure.
-	request := &struct {
-		InstanceID string
-
-		Channel string
-
-		DesiredLoudness string
-	}{}
+	request := &RenderingControl2SetLoudnessRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
 		return
 	}
-	if request.Channel, err = soap.MarshalString(Channel); err != nil {
+	if request.Channel, err = soap.MarshalString(string(Channel)); err != nil {
 		return
 	}
 	if request.DesiredLoudness, err = soap.MarshalBoolean(DesiredLoudness); err != nil {
@@ -6958,7 +10336,7 @@ func (client *RenderingControl2) SetLoudness(InstanceID uint32, Channel string,
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_2, "SetLoudness", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetLoudness", request, response); err != nil {
 		return
 	}
 
@@ -6968,13 +10346,35 @@ func (client *RenderingControl2) SetLoudness(InstanceID uint32, Channel string,
 	return
 }
 
+// RenderingControl2GetStateVariablesRequest is the request of GetStateVariables, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl2GetStateVariablesRequest struct {
+	InstanceID        string
+	StateVariableList string
+}
+
+// RenderingControl2GetStateVariablesResponse is the response of GetStateVariables, with each
+// argument in its SOAP string form.
+type RenderingControl2GetStateVariablesResponse struct {
+	StateVariableValuePairs string
+}
+
+// GetStateVariables performs the GetStateVariables action of the service.
+//
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_GetStateVariables_InstanceID)
+//   - StateVariableList (in, state variable A_ARG_TYPE_GetStateVariables_StateVariableList)
+//
+// Return values:
+//   - StateVariableValuePairs (out, state variable A_ARG_TYPE_GetStateVariables_StateVariableValuePairs)
 func (client *RenderingControl2) GetStateVariables(InstanceID uint32, StateVariableList string) (StateVariableValuePairs string, err error) {
-	// Request structure.
-	request := &struct {
-		InstanceID string
+	return client.GetStateVariablesCtx(context.Background(), InstanceID, StateVariableList)
+}
 
-		StateVariableList string
-	}{}
+// GetStateVariablesCtx is GetStateVariables with a context, to cancel or time out the call.
+func (client *RenderingControl2) GetStateVariablesCtx(ctx context.Context, InstanceID uint32, StateVariableList string) (StateVariableValuePairs string, err error) {
+	// Request structure.
+	request := &RenderingControl2GetStateVariablesRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -6986,12 +10386,10 @@ func (client *RenderingControl2) GetStateVariables(InstanceID uint32, StateVaria
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		StateVariableValuePairs string
-	}{}
+	response := &RenderingControl2GetStateVariablesResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_2, "GetStateVariables", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetStateVariables", request, response); err != nil {
 		return
 	}
 
@@ -7004,19 +10402,41 @@ func (client *RenderingControl2) GetStateVariables(InstanceID uint32, StateVaria
 	return
 }
 
-func (client *RenderingControl2) SetStateVariables(InstanceID uint32, RenderingControlUDN string, ServiceType string, ServiceId string, StateVariableValuePairs string) (StateVariableList string, err error) {
-	// Request structure.
-	request := &struct {
-		InstanceID string
-
-		RenderingControlUDN string
+// RenderingControl2SetStateVariablesRequest is the request of SetStateVariables, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type RenderingControl2SetStateVariablesRequest struct {
+	InstanceID              string
+	RenderingControlUDN     string
+	ServiceType             string
+	ServiceId               string
+	StateVariableValuePairs string
+}
 
-		ServiceType string
+// RenderingControl2SetStateVariablesResponse is the response of SetStateVariables, with each
+// argument in its SOAP string form.
+type RenderingControl2SetStateVariablesResponse struct {
+	StateVariableList string
+}
 
-		ServiceId string
+// SetStateVariables performs the SetStateVariables action of the service.
+//
+// Arguments:
+//   - InstanceID (in, state variable A_ARG_TYPE_SetStateVariables_InstanceID)
+//   - RenderingControlUDN (in, state variable A_ARG_TYPE_SetStateVariables_RenderingControlUDN)
+//   - ServiceType (in, state variable A_ARG_TYPE_SetStateVariables_ServiceType)
+//   - ServiceId (in, state variable A_ARG_TYPE_SetStateVariables_ServiceId)
+//   - StateVariableValuePairs (in, state variable A_ARG_TYPE_SetStateVariables_StateVariableValuePairs)
+//
+// Return values:
+//   - StateVariableList (out, state variable A_ARG_TYPE_SetStateVariables_StateVariableList)
+func (client *RenderingControl2) SetStateVariables(InstanceID uint32, RenderingControlUDN string, ServiceType string, ServiceId string, StateVariableValuePairs string) (StateVariableList string, err error) {
+	return client.SetStateVariablesCtx(context.Background(), InstanceID, RenderingControlUDN, ServiceType, ServiceId, StateVariableValuePairs)
+}
 
-		StateVariableValuePairs string
-	}{}
+// SetStateVariablesCtx is SetStateVariables with a context, to cancel or time out the call.
+func (client *RenderingControl2) SetStateVariablesCtx(ctx context.Context, InstanceID uint32, RenderingControlUDN string, ServiceType string, ServiceId string, StateVariableValuePairs string) (StateVariableList string, err error) {
+	// Request structure.
+	request := &RenderingControl2SetStateVariablesRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.InstanceID, err = soap.MarshalUi4(InstanceID); err != nil {
@@ -7037,12 +10457,10 @@ func (client *RenderingControl2) SetStateVariables(InstanceID uint32, RenderingC
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		StateVariableList string
-	}{}
+	response := &RenderingControl2SetStateVariablesResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_RenderingControl_2, "SetStateVariables", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetStateVariables", request, response); err != nil {
 		return
 	}
 
@@ -7062,6 +10480,71 @@ type ScheduledRecording1 struct {
 	goupnp.ServiceClient
 }
 
+// ScheduledRecording1Client is the interface of the actions of ScheduledRecording1, for
+// substituting fakes or mocks for the service in tests.
+type ScheduledRecording1Client interface {
+	GetSortCapabilities() (SortCaps string, SortLevelCap uint32, err error)
+	GetSortCapabilitiesCtx(ctx context.Context) (SortCaps string, SortLevelCap uint32, err error)
+	GetPropertyList(DataTypeID ScheduledRecording1DataTypeID) (PropertyList string, err error)
+	GetPropertyListCtx(ctx context.Context, DataTypeID ScheduledRecording1DataTypeID) (PropertyList string, err error)
+	GetAllowedValues(DataTypeID ScheduledRecording1DataTypeID, Filter string) (PropertyInfo string, err error)
+	GetAllowedValuesCtx(ctx context.Context, DataTypeID ScheduledRecording1DataTypeID, Filter string) (PropertyInfo string, err error)
+	GetStateUpdateID() (Id uint32, err error)
+	GetStateUpdateIDCtx(ctx context.Context) (Id uint32, err error)
+	BrowseRecordSchedules(Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error)
+	BrowseRecordSchedulesCtx(ctx context.Context, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error)
+	BrowseRecordTasks(RecordScheduleID string, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error)
+	BrowseRecordTasksCtx(ctx context.Context, RecordScheduleID string, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error)
+	CreateRecordSchedule(Elements string) (RecordScheduleID string, Result string, UpdateID uint32, err error)
+	CreateRecordScheduleCtx(ctx context.Context, Elements string) (RecordScheduleID string, Result string, UpdateID uint32, err error)
+	DeleteRecordSchedule(RecordScheduleID string) (err error)
+	DeleteRecordScheduleCtx(ctx context.Context, RecordScheduleID string) (err error)
+	GetRecordSchedule(RecordScheduleID string, Filter string) (Result string, UpdateID uint32, err error)
+	GetRecordScheduleCtx(ctx context.Context, RecordScheduleID string, Filter string) (Result string, UpdateID uint32, err error)
+	EnableRecordSchedule(RecordScheduleID string) (err error)
+	EnableRecordScheduleCtx(ctx context.Context, RecordScheduleID string) (err error)
+	DisableRecordSchedule(RecordScheduleID string) (err error)
+	DisableRecordScheduleCtx(ctx context.Context, RecordScheduleID string) (err error)
+	DeleteRecordTask(RecordTaskID string) (err error)
+	DeleteRecordTaskCtx(ctx context.Context, RecordTaskID string) (err error)
+	GetRecordTask(RecordTaskID string, Filter string) (Result string, UpdateID uint32, err error)
+	GetRecordTaskCtx(ctx context.Context, RecordTaskID string, Filter string) (Result string, UpdateID uint32, err error)
+	EnableRecordTask(RecordTaskID string) (err error)
+	EnableRecordTaskCtx(ctx context.Context, RecordTaskID string) (err error)
+	DisableRecordTask(RecordTaskID string) (err error)
+	DisableRecordTaskCtx(ctx context.Context, RecordTaskID string) (err error)
+	ResetRecordTask(RecordTaskID string) (err error)
+	ResetRecordTaskCtx(ctx context.Context, RecordTaskID string) (err error)
+	GetRecordScheduleConflicts(RecordScheduleID string) (RecordScheduleConflictIDList string, UpdateID uint32, err error)
+	GetRecordScheduleConflictsCtx(ctx context.Context, RecordScheduleID string) (RecordScheduleConflictIDList string, UpdateID uint32, err error)
+	GetRecordTaskConflicts(RecordTaskID string) (RecordTaskConflictIDList string, UpdateID uint32, err error)
+	GetRecordTaskConflictsCtx(ctx context.Context, RecordTaskID string) (RecordTaskConflictIDList string, UpdateID uint32, err error)
+}
+
+var _ ScheduledRecording1Client = new(ScheduledRecording1)
+
+// ScheduledRecording1DataTypeID is a value of the state variable A_ARG_TYPE_DataTypeID of
+// ScheduledRecording1.
+type ScheduledRecording1DataTypeID string
+
+// Allowed values of ScheduledRecording1DataTypeID.
+const (
+	ScheduledRecording1DataTypeID_A_ARG_TYPE_RecordSchedule      ScheduledRecording1DataTypeID = "A_ARG_TYPE_RecordSchedule"
+	ScheduledRecording1DataTypeID_A_ARG_TYPE_RecordTask          ScheduledRecording1DataTypeID = "A_ARG_TYPE_RecordTask"
+	ScheduledRecording1DataTypeID_A_ARG_TYPE_RecordScheduleParts ScheduledRecording1DataTypeID = "A_ARG_TYPE_RecordScheduleParts"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v ScheduledRecording1DataTypeID) Valid() bool {
+	switch v {
+	case ScheduledRecording1DataTypeID_A_ARG_TYPE_RecordSchedule,
+		ScheduledRecording1DataTypeID_A_ARG_TYPE_RecordTask,
+		ScheduledRecording1DataTypeID_A_ARG_TYPE_RecordScheduleParts:
+		return true
+	}
+	return false
+}
+
 // NewScheduledRecording1Clients discovers instances of the service on the network,
 // and returns clients to any that are found. errors will contain an error for
 // any devices that replied but which could not be queried, and err will be set
@@ -7115,7 +10598,42 @@ func newScheduledRecording1ClientsFromGenericClients(genericClients []goupnp.Ser
 	return clients
 }
 
+// ScheduledRecording1Timeouts are the default timeouts of the actions of
+// ScheduledRecording1, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var ScheduledRecording1Timeouts = map[string]time.Duration{
+	"": 10 * time.Second,
+}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *ScheduledRecording1) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, ScheduledRecording1Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_ScheduledRecording_1, actionName, request, response)
+}
+
+// ScheduledRecording1GetSortCapabilitiesResponse is the response of GetSortCapabilities, with each
+// argument in its SOAP string form.
+type ScheduledRecording1GetSortCapabilitiesResponse struct {
+	SortCaps     string
+	SortLevelCap string
+}
+
+// GetSortCapabilities performs the GetSortCapabilities action of the service.
+//
+// Return values:
+//   - SortCaps (out, state variable A_ARG_TYPE_GetSortCapabilities_SortCaps)
+//   - SortLevelCap (out, state variable A_ARG_TYPE_GetSortCapabilities_SortLevelCap)
 func (client *ScheduledRecording1) GetSortCapabilities() (SortCaps string, SortLevelCap uint32, err error) {
+	return client.GetSortCapabilitiesCtx(context.Background())
+}
+
+// GetSortCapabilitiesCtx is GetSortCapabilities with a context, to cancel or time out the call.
+func (client *ScheduledRecording1) GetSortCapabilitiesCtx(ctx context.Context) (SortCaps string, SortLevelCap uint32, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -7123,14 +10641,10 @@ func (client *ScheduledRecording1) GetSortCapabilities() (SortCaps string, SortL
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		SortCaps string
-
-		SortLevelCap string
-	}{}
+	response := &ScheduledRecording1GetSortCapabilitiesResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ScheduledRecording_1, "GetSortCapabilities", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetSortCapabilities", request, response); err != nil {
 		return
 	}
 
@@ -7146,30 +10660,45 @@ func (client *ScheduledRecording1) GetSortCapabilities() (SortCaps string, SortL
 	return
 }
 
+// ScheduledRecording1GetPropertyListRequest is the request of GetPropertyList, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ScheduledRecording1GetPropertyListRequest struct {
+	DataTypeID string
+}
+
+// ScheduledRecording1GetPropertyListResponse is the response of GetPropertyList, with each
+// argument in its SOAP string form.
+type ScheduledRecording1GetPropertyListResponse struct {
+	PropertyList string
+}
+
+// GetPropertyList performs the GetPropertyList action of the service.
 //
 // Arguments:
+//   - DataTypeID (in, state variable A_ARG_TYPE_DataTypeID): allowed values A_ARG_TYPE_RecordSchedule, A_ARG_TYPE_RecordTask, A_ARG_TYPE_RecordScheduleParts
 //
-// * DataTypeID: allowed values: A_ARG_TYPE_RecordSchedule, A_ARG_TYPE_RecordTask, A_ARG_TYPE_RecordScheduleParts
+// Return values:
+//   - PropertyList (out, state variable A_ARG_TYPE_GetPropertyList_PropertyList)
+func (client *ScheduledRecording1) GetPropertyList(DataTypeID ScheduledRecording1DataTypeID) (PropertyList string, err error) {
+	return client.GetPropertyListCtx(context.Background(), DataTypeID)
+}
 
-func (client *ScheduledRecording1) GetPropertyList(DataTypeID string) (PropertyList string, err error) {
+// GetPropertyListCtx is GetPropertyList with a context, to cancel or time out the call.
+func (client *ScheduledRecording1) GetPropertyListCtx(ctx context.Context, DataTypeID ScheduledRecording1DataTypeID) (PropertyList string, err error) {
 	// Request structure.
-	request := &struct {
-		DataTypeID string
-	}{}
+	request := &ScheduledRecording1GetPropertyListRequest{}
 	// BEGIN Marshal arguments into request.
 
-	if request.DataTypeID, err = soap.MarshalString(DataTypeID); err != nil {
+	if request.DataTypeID, err = soap.MarshalString(string(DataTypeID)); err != nil {
 		return
 	}
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		PropertyList string
-	}{}
+	response := &ScheduledRecording1GetPropertyListResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ScheduledRecording_1, "GetPropertyList", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetPropertyList", request, response); err != nil {
 		return
 	}
 
@@ -7182,21 +10711,38 @@ func (client *ScheduledRecording1) GetPropertyList(DataTypeID string) (PropertyL
 	return
 }
 
+// ScheduledRecording1GetAllowedValuesRequest is the request of GetAllowedValues, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ScheduledRecording1GetAllowedValuesRequest struct {
+	DataTypeID string
+	Filter     string
+}
+
+// ScheduledRecording1GetAllowedValuesResponse is the response of GetAllowedValues, with each
+// argument in its SOAP string form.
+type ScheduledRecording1GetAllowedValuesResponse struct {
+	PropertyInfo string
+}
+
+// GetAllowedValues performs the GetAllowedValues action of the service.
 //
 // Arguments:
+//   - DataTypeID (in, state variable A_ARG_TYPE_DataTypeID): allowed values A_ARG_TYPE_RecordSchedule, A_ARG_TYPE_RecordTask, A_ARG_TYPE_RecordScheduleParts
+//   - Filter (in, state variable A_ARG_TYPE_GetAllowedValues_Filter)
 //
-// * DataTypeID: allowed values: A_ARG_TYPE_RecordSchedule, A_ARG_TYPE_RecordTask, A_ARG_TYPE_RecordScheduleParts
+// Return values:
+//   - PropertyInfo (out, state variable A_ARG_TYPE_GetAllowedValues_PropertyInfo)
+func (client *ScheduledRecording1) GetAllowedValues(DataTypeID ScheduledRecording1DataTypeID, Filter string) (PropertyInfo string, err error) {
+	return client.GetAllowedValuesCtx(context.Background(), DataTypeID, Filter)
+}
 
-func (client *ScheduledRecording1) GetAllowedValues(DataTypeID string, Filter string) (PropertyInfo string, err error) {
+// GetAllowedValuesCtx is GetAllowedValues with a context, to cancel or time out the call.
+func (client *ScheduledRecording1) GetAllowedValuesCtx(ctx context.Context, DataTypeID ScheduledRecording1DataTypeID, Filter string) (PropertyInfo string, err error) {
 	// Request structure.
-	request := &struct {
-		DataTypeID string
-
-		Filter string
-	}{}
+	request := &ScheduledRecording1GetAllowedValuesRequest{}
 	// BEGIN Marshal arguments into request.
 
-	if request.DataTypeID, err = soap.MarshalString(DataTypeID); err != nil {
+	if request.DataTypeID, err = soap.MarshalString(string(DataTypeID)); err != nil {
 		return
 	}
 	if request.Filter, err = soap.MarshalString(Filter); err != nil {
@@ -7205,12 +10751,10 @@ func (client *ScheduledRecording1) GetAllowedValues(DataTypeID string, Filter st
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		PropertyInfo string
-	}{}
+	response := &ScheduledRecording1GetAllowedValuesResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ScheduledRecording_1, "GetAllowedValues", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetAllowedValues", request, response); err != nil {
 		return
 	}
 
@@ -7223,7 +10767,22 @@ func (client *ScheduledRecording1) GetAllowedValues(DataTypeID string, Filter st
 	return
 }
 
+// ScheduledRecording1GetStateUpdateIDResponse is the response of GetStateUpdateID, with each
+// argument in its SOAP string form.
+type ScheduledRecording1GetStateUpdateIDResponse struct {
+	Id string
+}
+
+// GetStateUpdateID performs the GetStateUpdateID action of the service.
+//
+// Return values:
+//   - Id (out, state variable A_ARG_TYPE_GetStateUpdateID_Id)
 func (client *ScheduledRecording1) GetStateUpdateID() (Id uint32, err error) {
+	return client.GetStateUpdateIDCtx(context.Background())
+}
+
+// GetStateUpdateIDCtx is GetStateUpdateID with a context, to cancel or time out the call.
+func (client *ScheduledRecording1) GetStateUpdateIDCtx(ctx context.Context) (Id uint32, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -7231,12 +10790,10 @@ func (client *ScheduledRecording1) GetStateUpdateID() (Id uint32, err error) {
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		Id string
-	}{}
+	response := &ScheduledRecording1GetStateUpdateIDResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ScheduledRecording_1, "GetStateUpdateID", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetStateUpdateID", request, response); err != nil {
 		return
 	}
 
@@ -7249,17 +10806,45 @@ func (client *ScheduledRecording1) GetStateUpdateID() (Id uint32, err error) {
 	return
 }
 
-func (client *ScheduledRecording1) BrowseRecordSchedules(Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error) {
-	// Request structure.
-	request := &struct {
-		Filter string
+// ScheduledRecording1BrowseRecordSchedulesRequest is the request of BrowseRecordSchedules, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ScheduledRecording1BrowseRecordSchedulesRequest struct {
+	Filter         string
+	StartingIndex  string
+	RequestedCount string
+	SortCriteria   string
+}
 
-		StartingIndex string
+// ScheduledRecording1BrowseRecordSchedulesResponse is the response of BrowseRecordSchedules, with each
+// argument in its SOAP string form.
+type ScheduledRecording1BrowseRecordSchedulesResponse struct {
+	Result         string
+	NumberReturned string
+	TotalMatches   string
+	UpdateID       string
+}
 
-		RequestedCount string
+// BrowseRecordSchedules performs the BrowseRecordSchedules action of the service.
+//
+// Arguments:
+//   - Filter (in, state variable A_ARG_TYPE_BrowseRecordSchedules_Filter)
+//   - StartingIndex (in, state variable A_ARG_TYPE_BrowseRecordSchedules_StartingIndex)
+//   - RequestedCount (in, state variable A_ARG_TYPE_BrowseRecordSchedules_RequestedCount)
+//   - SortCriteria (in, state variable A_ARG_TYPE_BrowseRecordSchedules_SortCriteria)
+//
+// Return values:
+//   - Result (out, state variable A_ARG_TYPE_BrowseRecordSchedules_Result)
+//   - NumberReturned (out, state variable A_ARG_TYPE_BrowseRecordSchedules_NumberReturned)
+//   - TotalMatches (out, state variable A_ARG_TYPE_BrowseRecordSchedules_TotalMatches)
+//   - UpdateID (out, state variable A_ARG_TYPE_BrowseRecordSchedules_UpdateID)
+func (client *ScheduledRecording1) BrowseRecordSchedules(Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error) {
+	return client.BrowseRecordSchedulesCtx(context.Background(), Filter, StartingIndex, RequestedCount, SortCriteria)
+}
 
-		SortCriteria string
-	}{}
+// BrowseRecordSchedulesCtx is BrowseRecordSchedules with a context, to cancel or time out the call.
+func (client *ScheduledRecording1) BrowseRecordSchedulesCtx(ctx context.Context, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error) {
+	// Request structure.
+	request := &ScheduledRecording1BrowseRecordSchedulesRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.Filter, err = soap.MarshalString(Filter); err != nil {
@@ -7277,18 +10862,10 @@ func (client *ScheduledRecording1) BrowseRecordSchedules(Filter string, Starting
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		Result string
-
-		NumberReturned string
-
-		TotalMatches string
-
-		UpdateID string
-	}{}
+	response := &ScheduledRecording1BrowseRecordSchedulesResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ScheduledRecording_1, "BrowseRecordSchedules", request, response); err != nil {
+	if err = client.PerformAction(ctx, "BrowseRecordSchedules", request, response); err != nil {
 		return
 	}
 
@@ -7310,19 +10887,47 @@ func (client *ScheduledRecording1) BrowseRecordSchedules(Filter string, Starting
 	return
 }
 
-func (client *ScheduledRecording1) BrowseRecordTasks(RecordScheduleID string, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error) {
-	// Request structure.
-	request := &struct {
-		RecordScheduleID string
-
-		Filter string
+// ScheduledRecording1BrowseRecordTasksRequest is the request of BrowseRecordTasks, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ScheduledRecording1BrowseRecordTasksRequest struct {
+	RecordScheduleID string
+	Filter           string
+	StartingIndex    string
+	RequestedCount   string
+	SortCriteria     string
+}
 
-		StartingIndex string
+// ScheduledRecording1BrowseRecordTasksResponse is the response of BrowseRecordTasks, with each
+// argument in its SOAP string form.
+type ScheduledRecording1BrowseRecordTasksResponse struct {
+	Result         string
+	NumberReturned string
+	TotalMatches   string
+	UpdateID       string
+}
 
-		RequestedCount string
+// BrowseRecordTasks performs the BrowseRecordTasks action of the service.
+//
+// Arguments:
+//   - RecordScheduleID (in, state variable A_ARG_TYPE_BrowseRecordTasks_RecordScheduleID)
+//   - Filter (in, state variable A_ARG_TYPE_BrowseRecordTasks_Filter)
+//   - StartingIndex (in, state variable A_ARG_TYPE_BrowseRecordTasks_StartingIndex)
+//   - RequestedCount (in, state variable A_ARG_TYPE_BrowseRecordTasks_RequestedCount)
+//   - SortCriteria (in, state variable A_ARG_TYPE_BrowseRecordTasks_SortCriteria)
+//
+// Return values:
+//   - Result (out, state variable A_ARG_TYPE_BrowseRecordTasks_Result)
+//   - NumberReturned (out, state variable A_ARG_TYPE_BrowseRecordTasks_NumberReturned)
+//   - TotalMatches (out, state variable A_ARG_TYPE_BrowseRecordTasks_TotalMatches)
+//   - UpdateID (out, state variable A_ARG_TYPE_BrowseRecordTasks_UpdateID)
+func (client *ScheduledRecording1) BrowseRecordTasks(RecordScheduleID string, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error) {
+	return client.BrowseRecordTasksCtx(context.Background(), RecordScheduleID, Filter, StartingIndex, RequestedCount, SortCriteria)
+}
 
-		SortCriteria string
-	}{}
+// BrowseRecordTasksCtx is BrowseRecordTasks with a context, to cancel or time out the call.
+func (client *ScheduledRecording1) BrowseRecordTasksCtx(ctx context.Context, RecordScheduleID string, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error) {
+	// Request structure.
+	request := &ScheduledRecording1BrowseRecordTasksRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.RecordScheduleID, err = soap.MarshalString(RecordScheduleID); err != nil {
@@ -7343,18 +10948,10 @@ func (client *ScheduledRecording1) BrowseRecordTasks(RecordScheduleID string, Fi
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		Result string
-
-		NumberReturned string
-
-		TotalMatches string
-
-		UpdateID string
-	}{}
+	response := &ScheduledRecording1BrowseRecordTasksResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ScheduledRecording_1, "BrowseRecordTasks", request, response); err != nil {
+	if err = client.PerformAction(ctx, "BrowseRecordTasks", request, response); err != nil {
 		return
 	}
 
@@ -7376,11 +10973,37 @@ func (client *ScheduledRecording1) BrowseRecordTasks(RecordScheduleID string, Fi
 	return
 }
 
+// ScheduledRecording1CreateRecordScheduleRequest is the request of CreateRecordSchedule, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ScheduledRecording1CreateRecordScheduleRequest struct {
+	Elements string
+}
+
+// ScheduledRecording1CreateRecordScheduleResponse is the response of CreateRecordSchedule, with each
+// argument in its SOAP string form.
+type ScheduledRecording1CreateRecordScheduleResponse struct {
+	RecordScheduleID string
+	Result           string
+	UpdateID         string
+}
+
+// CreateRecordSchedule performs the CreateRecordSchedule action of the service.
+//
+// Arguments:
+//   - Elements (in, state variable A_ARG_TYPE_CreateRecordSchedule_Elements)
+//
+// Return values:
+//   - RecordScheduleID (out, state variable A_ARG_TYPE_CreateRecordSchedule_RecordScheduleID)
+//   - Result (out, state variable A_ARG_TYPE_CreateRecordSchedule_Result)
+//   - UpdateID (out, state variable A_ARG_TYPE_CreateRecordSchedule_UpdateID)
 func (client *ScheduledRecording1) CreateRecordSchedule(Elements string) (RecordScheduleID string, Result string, UpdateID uint32, err error) {
+	return client.CreateRecordScheduleCtx(context.Background(), Elements)
+}
+
+// CreateRecordScheduleCtx is CreateRecordSchedule with a context, to cancel or time out the call.
+func (client *ScheduledRecording1) CreateRecordScheduleCtx(ctx context.Context, Elements string) (RecordScheduleID string, Result string, UpdateID uint32, err error) {
 	// Request structure.
-	request := &struct {
-		Elements string
-	}{}
+	request := &ScheduledRecording1CreateRecordScheduleRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.Elements, err = soap.MarshalString(Elements); err != nil {
@@ -7389,16 +11012,10 @@ func (client *ScheduledRecording1) CreateRecordSchedule(Elements string) (Record
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		RecordScheduleID string
-
-		Result string
-
-		UpdateID string
-	}{}
+	response := &ScheduledRecording1CreateRecordScheduleResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ScheduledRecording_1, "CreateRecordSchedule", request, response); err != nil {
+	if err = client.PerformAction(ctx, "CreateRecordSchedule", request, response); err != nil {
 		return
 	}
 
@@ -7417,11 +11034,24 @@ func (client *ScheduledRecording1) CreateRecordSchedule(Elements string) (Record
 	return
 }
 
+// ScheduledRecording1DeleteRecordScheduleRequest is the request of DeleteRecordSchedule, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ScheduledRecording1DeleteRecordScheduleRequest struct {
+	RecordScheduleID string
+}
+
+// DeleteRecordSchedule performs the DeleteRecordSchedule action of the service.
+//
+// Arguments:
+//   - RecordScheduleID (in, state variable A_ARG_TYPE_DeleteRecordSchedule_RecordScheduleID)
 func (client *ScheduledRecording1) DeleteRecordSchedule(RecordScheduleID string) (err error) {
+	return client.DeleteRecordScheduleCtx(context.Background(), RecordScheduleID)
+}
+
+// DeleteRecordScheduleCtx is DeleteRecordSchedule with a context, to cancel or time out the call.
+func (client *ScheduledRecording1) DeleteRecordScheduleCtx(ctx context.Context, RecordScheduleID string) (err error) {
 	// Request structure.
-	request := &struct {
-		RecordScheduleID string
-	}{}
+	request := &ScheduledRecording1DeleteRecordScheduleRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.RecordScheduleID, err = soap.MarshalString(RecordScheduleID); err != nil {
@@ -7433,7 +11063,7 @@ func (client *ScheduledRecording1) DeleteRecordSchedule(RecordScheduleID string)
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ScheduledRecording_1, "DeleteRecordSchedule", request, response); err != nil {
+	if err = client.PerformAction(ctx, "DeleteRecordSchedule", request, response); err != nil {
 		return
 	}
 
@@ -7443,13 +11073,37 @@ func (client *ScheduledRecording1) DeleteRecordSchedule(RecordScheduleID string)
 	return
 }
 
+// ScheduledRecording1GetRecordScheduleRequest is the request of GetRecordSchedule, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ScheduledRecording1GetRecordScheduleRequest struct {
+	RecordScheduleID string
+	Filter           string
+}
+
+// ScheduledRecording1GetRecordScheduleResponse is the response of GetRecordSchedule, with each
+// argument in its SOAP string form.
+type ScheduledRecording1GetRecordScheduleResponse struct {
+	Result   string
+	UpdateID string
+}
+
+// GetRecordSchedule performs the GetRecordSchedule action of the service.
+//
+// Arguments:
+//   - RecordScheduleID (in, state variable A_ARG_TYPE_GetRecordSchedule_RecordScheduleID)
+//   - Filter (in, state variable A_ARG_TYPE_GetRecordSchedule_Filter)
+//
+// Return values:
+//   - Result (out, state variable A_ARG_TYPE_GetRecordSchedule_Result)
+//   - UpdateID (out, state variable A_ARG_TYPE_GetRecordSchedule_UpdateID)
 func (client *ScheduledRecording1) GetRecordSchedule(RecordScheduleID string, Filter string) (Result string, UpdateID uint32, err error) {
-	// Request structure.
-	request := &struct {
-		RecordScheduleID string
+	return client.GetRecordScheduleCtx(context.Background(), RecordScheduleID, Filter)
+}
 
-		Filter string
-	}{}
+// GetRecordScheduleCtx is GetRecordSchedule with a context, to cancel or time out the call.
+func (client *ScheduledRecording1) GetRecordScheduleCtx(ctx context.Context, RecordScheduleID string, Filter string) (Result string, UpdateID uint32, err error) {
+	// Request structure.
+	request := &ScheduledRecording1GetRecordScheduleRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.RecordScheduleID, err = soap.MarshalString(RecordScheduleID); err != nil {
@@ -7461,14 +11115,10 @@ func (client *ScheduledRecording1) GetRecordSchedule(RecordScheduleID string, Fi
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		Result string
-
-		UpdateID string
-	}{}
+	response := &ScheduledRecording1GetRecordScheduleResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ScheduledRecording_1, "GetRecordSchedule", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetRecordSchedule", request, response); err != nil {
 		return
 	}
 
@@ -7484,11 +11134,24 @@ func (client *ScheduledRecording1) GetRecordSchedule(RecordScheduleID string, Fi
 	return
 }
 
+// ScheduledRecording1EnableRecordScheduleRequest is the request of EnableRecordSchedule, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ScheduledRecording1EnableRecordScheduleRequest struct {
+	RecordScheduleID string
+}
+
+// EnableRecordSchedule performs the EnableRecordSchedule action of the service.
+//
+// Arguments:
+//   - RecordScheduleID (in, state variable A_ARG_TYPE_EnableRecordSchedule_RecordScheduleID)
 func (client *ScheduledRecording1) EnableRecordSchedule(RecordScheduleID string) (err error) {
+	return client.EnableRecordScheduleCtx(context.Background(), RecordScheduleID)
+}
+
+// EnableRecordScheduleCtx is EnableRecordSchedule with a context, to cancel or time out the call.
+func (client *ScheduledRecording1) EnableRecordScheduleCtx(ctx context.Context, RecordScheduleID string) (err error) {
 	// Request structure.
-	request := &struct {
-		RecordScheduleID string
-	}{}
+	request := &ScheduledRecording1EnableRecordScheduleRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.RecordScheduleID, err = soap.MarshalString(RecordScheduleID); err != nil {
@@ -7500,7 +11163,7 @@ func (client *ScheduledRecording1) EnableRecordSchedule(RecordScheduleID string)
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ScheduledRecording_1, "EnableRecordSchedule", request, response); err != nil {
+	if err = client.PerformAction(ctx, "EnableRecordSchedule", request, response); err != nil {
 		return
 	}
 
@@ -7510,11 +11173,24 @@ func (client *ScheduledRecording1) EnableRecordSchedule(RecordScheduleID string)
 	return
 }
 
+// ScheduledRecording1DisableRecordScheduleRequest is the request of DisableRecordSchedule, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ScheduledRecording1DisableRecordScheduleRequest struct {
+	RecordScheduleID string
+}
+
+// DisableRecordSchedule performs the DisableRecordSchedule action of the service.
+//
+// Arguments:
+//   - RecordScheduleID (in, state variable A_ARG_TYPE_DisableRecordSchedule_RecordScheduleID)
 func (client *ScheduledRecording1) DisableRecordSchedule(RecordScheduleID string) (err error) {
+	return client.DisableRecordScheduleCtx(context.Background(), RecordScheduleID)
+}
+
+// DisableRecordScheduleCtx is DisableRecordSchedule with a context, to cancel or time out the call.
+func (client *ScheduledRecording1) DisableRecordScheduleCtx(ctx context.Context, RecordScheduleID string) (err error) {
 	// Request structure.
-	request := &struct {
-		RecordScheduleID string
-	}{}
+	request := &ScheduledRecording1DisableRecordScheduleRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.RecordScheduleID, err = soap.MarshalString(RecordScheduleID); err != nil {
@@ -7526,7 +11202,7 @@ func (client *ScheduledRecording1) DisableRecordSchedule(RecordScheduleID string
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ScheduledRecording_1, "DisableRecordSchedule", request, response); err != nil {
+	if err = client.PerformAction(ctx, "DisableRecordSchedule", request, response); err != nil {
 		return
 	}
 
@@ -7536,11 +11212,24 @@ func (client *ScheduledRecording1) DisableRecordSchedule(RecordScheduleID string
 	return
 }
 
+// ScheduledRecording1DeleteRecordTaskRequest is the request of DeleteRecordTask, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ScheduledRecording1DeleteRecordTaskRequest struct {
+	RecordTaskID string
+}
+
+// DeleteRecordTask performs the DeleteRecordTask action of the service.
+//
+// Arguments:
+//   - RecordTaskID (in, state variable A_ARG_TYPE_DeleteRecordTask_RecordTaskID)
 func (client *ScheduledRecording1) DeleteRecordTask(RecordTaskID string) (err error) {
+	return client.DeleteRecordTaskCtx(context.Background(), RecordTaskID)
+}
+
+// DeleteRecordTaskCtx is DeleteRecordTask with a context, to cancel or time out the call.
+func (client *ScheduledRecording1) DeleteRecordTaskCtx(ctx context.Context, RecordTaskID string) (err error) {
 	// Request structure.
-	request := &struct {
-		RecordTaskID string
-	}{}
+	request := &ScheduledRecording1DeleteRecordTaskRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.RecordTaskID, err = soap.MarshalString(RecordTaskID); err != nil {
@@ -7552,7 +11241,7 @@ func (client *ScheduledRecording1) DeleteRecordTask(RecordTaskID string) (err er
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ScheduledRecording_1, "DeleteRecordTask", request, response); err != nil {
+	if err = client.PerformAction(ctx, "DeleteRecordTask", request, response); err != nil {
 		return
 	}
 
@@ -7562,13 +11251,37 @@ func (client *ScheduledRecording1) DeleteRecordTask(RecordTaskID string) (err er
 	return
 }
 
+// ScheduledRecording1GetRecordTaskRequest is the request of GetRecordTask, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ScheduledRecording1GetRecordTaskRequest struct {
+	RecordTaskID string
+	Filter       string
+}
+
+// ScheduledRecording1GetRecordTaskResponse is the response of GetRecordTask, with each
+// argument in its SOAP string form.
+type ScheduledRecording1GetRecordTaskResponse struct {
+	Result   string
+	UpdateID string
+}
+
+// GetRecordTask performs the GetRecordTask action of the service.
+//
+// Arguments:
+//   - RecordTaskID (in, state variable A_ARG_TYPE_GetRecordTask_RecordTaskID)
+//   - Filter (in, state variable A_ARG_TYPE_GetRecordTask_Filter)
+//
+// Return values:
+//   - Result (out, state variable A_ARG_TYPE_GetRecordTask_Result)
+//   - UpdateID (out, state variable A_ARG_TYPE_GetRecordTask_UpdateID)
 func (client *ScheduledRecording1) GetRecordTask(RecordTaskID string, Filter string) (Result string, UpdateID uint32, err error) {
-	// Request structure.
-	request := &struct {
-		RecordTaskID string
+	return client.GetRecordTaskCtx(context.Background(), RecordTaskID, Filter)
+}
 
-		Filter string
-	}{}
+// GetRecordTaskCtx is GetRecordTask with a context, to cancel or time out the call.
+func (client *ScheduledRecording1) GetRecordTaskCtx(ctx context.Context, RecordTaskID string, Filter string) (Result string, UpdateID uint32, err error) {
+	// Request structure.
+	request := &ScheduledRecording1GetRecordTaskRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.RecordTaskID, err = soap.MarshalString(RecordTaskID); err != nil {
@@ -7580,14 +11293,10 @@ func (client *ScheduledRecording1) GetRecordTask(RecordTaskID string, Filter str
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		Result string
-
-		UpdateID string
-	}{}
+	response := &ScheduledRecording1GetRecordTaskResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ScheduledRecording_1, "GetRecordTask", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetRecordTask", request, response); err != nil {
 		return
 	}
 
@@ -7603,11 +11312,24 @@ func (client *ScheduledRecording1) GetRecordTask(RecordTaskID string, Filter str
 	return
 }
 
+// ScheduledRecording1EnableRecordTaskRequest is the request of EnableRecordTask, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ScheduledRecording1EnableRecordTaskRequest struct {
+	RecordTaskID string
+}
+
+// EnableRecordTask performs the EnableRecordTask action of the service.
+//
+// Arguments:
+//   - RecordTaskID (in, state variable A_ARG_TYPE_EnableRecordTask_RecordTaskID)
 func (client *ScheduledRecording1) EnableRecordTask(RecordTaskID string) (err error) {
+	return client.EnableRecordTaskCtx(context.Background(), RecordTaskID)
+}
+
+// EnableRecordTaskCtx is EnableRecordTask with a context, to cancel or time out the call.
+func (client *ScheduledRecording1) EnableRecordTaskCtx(ctx context.Context, RecordTaskID string) (err error) {
 	// Request structure.
-	request := &struct {
-		RecordTaskID string
-	}{}
+	request := &ScheduledRecording1EnableRecordTaskRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.RecordTaskID, err = soap.MarshalString(RecordTaskID); err != nil {
@@ -7619,7 +11341,7 @@ func (client *ScheduledRecording1) EnableRecordTask(RecordTaskID string) (err er
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ScheduledRecording_1, "EnableRecordTask", request, response); err != nil {
+	if err = client.PerformAction(ctx, "EnableRecordTask", request, response); err != nil {
 		return
 	}
 
@@ -7629,11 +11351,24 @@ func (client *ScheduledRecording1) EnableRecordTask(RecordTaskID string) (err er
 	return
 }
 
+// ScheduledRecording1DisableRecordTaskRequest is the request of DisableRecordTask, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ScheduledRecording1DisableRecordTaskRequest struct {
+	RecordTaskID string
+}
+
+// DisableRecordTask performs the DisableRecordTask action of the service.
+//
+// Arguments:
+//   - RecordTaskID (in, state variable A_ARG_TYPE_DisableRecordTask_RecordTaskID)
 func (client *ScheduledRecording1) DisableRecordTask(RecordTaskID string) (err error) {
+	return client.DisableRecordTaskCtx(context.Background(), RecordTaskID)
+}
+
+// DisableRecordTaskCtx is DisableRecordTask with a context, to cancel or time out the call.
+func (client *ScheduledRecording1) DisableRecordTaskCtx(ctx context.Context, RecordTaskID string) (err error) {
 	// Request structure.
-	request := &struct {
-		RecordTaskID string
-	}{}
+	request := &ScheduledRecording1DisableRecordTaskRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.RecordTaskID, err = soap.MarshalString(RecordTaskID); err != nil {
@@ -7645,7 +11380,7 @@ func (client *ScheduledRecording1) DisableRecordTask(RecordTaskID string) (err e
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ScheduledRecording_1, "DisableRecordTask", request, response); err != nil {
+	if err = client.PerformAction(ctx, "DisableRecordTask", request, response); err != nil {
 		return
 	}
 
@@ -7655,11 +11390,24 @@ func (client *ScheduledRecording1) DisableRecordTask(RecordTaskID string) (err e
 	return
 }
 
+// ScheduledRecording1ResetRecordTaskRequest is the request of ResetRecordTask, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ScheduledRecording1ResetRecordTaskRequest struct {
+	RecordTaskID string
+}
+
+// ResetRecordTask performs the ResetRecordTask action of the service.
+//
+// Arguments:
+//   - RecordTaskID (in, state variable A_ARG_TYPE_ResetRecordTask_RecordTaskID)
 func (client *ScheduledRecording1) ResetRecordTask(RecordTaskID string) (err error) {
+	return client.ResetRecordTaskCtx(context.Background(), RecordTaskID)
+}
+
+// ResetRecordTaskCtx is ResetRecordTask with a context, to cancel or time out the call.
+func (client *ScheduledRecording1) ResetRecordTaskCtx(ctx context.Context, RecordTaskID string) (err error) {
 	// Request structure.
-	request := &struct {
-		RecordTaskID string
-	}{}
+	request := &ScheduledRecording1ResetRecordTaskRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.RecordTaskID, err = soap.MarshalString(RecordTaskID); err != nil {
@@ -7671,7 +11419,7 @@ func (client *ScheduledRecording1) ResetRecordTask(RecordTaskID string) (err err
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ScheduledRecording_1, "ResetRecordTask", request, response); err != nil {
+	if err = client.PerformAction(ctx, "ResetRecordTask", request, response); err != nil {
 		return
 	}
 
@@ -7681,11 +11429,35 @@ func (client *ScheduledRecording1) ResetRecordTask(RecordTaskID string) (err err
 	return
 }
 
+// ScheduledRecording1GetRecordScheduleConflictsRequest is the request of GetRecordScheduleConflicts, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ScheduledRecording1GetRecordScheduleConflictsRequest struct {
+	RecordScheduleID string
+}
+
+// ScheduledRecording1GetRecordScheduleConflictsResponse is the response of GetRecordScheduleConflicts, with each
+// argument in its SOAP string form.
+type ScheduledRecording1GetRecordScheduleConflictsResponse struct {
+	RecordScheduleConflictIDList string
+	UpdateID                     string
+}
+
+// GetRecordScheduleConflicts performs the GetRecordScheduleConflicts action of the service.
+//
+// Arguments:
+//   - RecordScheduleID (in, state variable A_ARG_TYPE_GetRecordScheduleConflicts_RecordScheduleID)
+//
+// Return values:
+//   - RecordScheduleConflictIDList (out, state variable A_ARG_TYPE_GetRecordScheduleConflicts_RecordScheduleConflictIDList)
+//   - UpdateID (out, state variable A_ARG_TYPE_GetRecordScheduleConflicts_UpdateID)
 func (client *ScheduledRecording1) GetRecordScheduleConflicts(RecordScheduleID string) (RecordScheduleConflictIDList string, UpdateID uint32, err error) {
+	return client.GetRecordScheduleConflictsCtx(context.Background(), RecordScheduleID)
+}
+
+// GetRecordScheduleConflictsCtx is GetRecordScheduleConflicts with a context, to cancel or time out the call.
+func (client *ScheduledRecording1) GetRecordScheduleConflictsCtx(ctx context.Context, RecordScheduleID string) (RecordScheduleConflictIDList string, UpdateID uint32, err error) {
 	// Request structure.
-	request := &struct {
-		RecordScheduleID string
-	}{}
+	request := &ScheduledRecording1GetRecordScheduleConflictsRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.RecordScheduleID, err = soap.MarshalString(RecordScheduleID); err != nil {
@@ -7694,14 +11466,10 @@ func (client *ScheduledRecording1) GetRecordScheduleConflicts(RecordScheduleID s
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		RecordScheduleConflictIDList string
-
-		UpdateID string
-	}{}
+	response := &ScheduledRecording1GetRecordScheduleConflictsResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ScheduledRecording_1, "GetRecordScheduleConflicts", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetRecordScheduleConflicts", request, response); err != nil {
 		return
 	}
 
@@ -7717,11 +11485,35 @@ func (client *ScheduledRecording1) GetRecordScheduleConflicts(RecordScheduleID s
 	return
 }
 
+// ScheduledRecording1GetRecordTaskConflictsRequest is the request of GetRecordTaskConflicts, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ScheduledRecording1GetRecordTaskConflictsRequest struct {
+	RecordTaskID string
+}
+
+// ScheduledRecording1GetRecordTaskConflictsResponse is the response of GetRecordTaskConflicts, with each
+// argument in its SOAP string form.
+type ScheduledRecording1GetRecordTaskConflictsResponse struct {
+	RecordTaskConflictIDList string
+	UpdateID                 string
+}
+
+// GetRecordTaskConflicts performs the GetRecordTaskConflicts action of the service.
+//
+// Arguments:
+//   - RecordTaskID (in, state variable A_ARG_TYPE_GetRecordTaskConflicts_RecordTaskID)
+//
+// Return values:
+//   - RecordTaskConflictIDList (out, state variable A_ARG_TYPE_GetRecordTaskConflicts_RecordTaskConflictIDList)
+//   - UpdateID (out, state variable A_ARG_TYPE_GetRecordTaskConflicts_UpdateID)
 func (client *ScheduledRecording1) GetRecordTaskConflicts(RecordTaskID string) (RecordTaskConflictIDList string, UpdateID uint32, err error) {
+	return client.GetRecordTaskConflictsCtx(context.Background(), RecordTaskID)
+}
+
+// GetRecordTaskConflictsCtx is GetRecordTaskConflicts with a context, to cancel or time out the call.
+func (client *ScheduledRecording1) GetRecordTaskConflictsCtx(ctx context.Context, RecordTaskID string) (RecordTaskConflictIDList string, UpdateID uint32, err error) {
 	// Request structure.
-	request := &struct {
-		RecordTaskID string
-	}{}
+	request := &ScheduledRecording1GetRecordTaskConflictsRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.RecordTaskID, err = soap.MarshalString(RecordTaskID); err != nil {
@@ -7730,14 +11522,10 @@ func (client *ScheduledRecording1) GetRecordTaskConflicts(RecordTaskID string) (
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		RecordTaskConflictIDList string
-
-		UpdateID string
-	}{}
+	response := &ScheduledRecording1GetRecordTaskConflictsResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ScheduledRecording_1, "GetRecordTaskConflicts", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetRecordTaskConflicts", request, response); err != nil {
 		return
 	}
 
@@ -7760,6 +11548,71 @@ type ScheduledRecording2 struct {
 	goupnp.ServiceClient
 }
 
+// ScheduledRecording2Client is the interface of the actions of ScheduledRecording2, for
+// substituting fakes or mocks for the service in tests.
+type ScheduledRecording2Client interface {
+	GetSortCapabilities() (SortCaps string, SortLevelCap uint32, err error)
+	GetSortCapabilitiesCtx(ctx context.Context) (SortCaps string, SortLevelCap uint32, err error)
+	GetPropertyList(DataTypeID ScheduledRecording2DataTypeID) (PropertyList string, err error)
+	GetPropertyListCtx(ctx context.Context, DataTypeID ScheduledRecording2DataTypeID) (PropertyList string, err error)
+	GetAllowedValues(DataTypeID ScheduledRecording2DataTypeID, Filter string) (PropertyInfo string, err error)
+	GetAllowedValuesCtx(ctx context.Context, DataTypeID ScheduledRecording2DataTypeID, Filter string) (PropertyInfo string, err error)
+	GetStateUpdateID() (Id uint32, err error)
+	GetStateUpdateIDCtx(ctx context.Context) (Id uint32, err error)
+	BrowseRecordSchedules(Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error)
+	BrowseRecordSchedulesCtx(ctx context.Context, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error)
+	BrowseRecordTasks(RecordScheduleID string, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error)
+	BrowseRecordTasksCtx(ctx context.Context, RecordScheduleID string, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error)
+	CreateRecordSchedule(Elements string) (RecordScheduleID string, Result string, UpdateID uint32, err error)
+	CreateRecordScheduleCtx(ctx context.Context, Elements string) (RecordScheduleID string, Result string, UpdateID uint32, err error)
+	DeleteRecordSchedule(RecordScheduleID string) (err error)
+	DeleteRecordScheduleCtx(ctx context.Context, RecordScheduleID string) (err error)
+	GetRecordSchedule(RecordScheduleID string, Filter string) (Result string, UpdateID uint32, err error)
+	GetRecordScheduleCtx(ctx context.Context, RecordScheduleID string, Filter string) (Result string, UpdateID uint32, err error)
+	EnableRecordSchedule(RecordScheduleID string) (err error)
+	EnableRecordScheduleCtx(ctx context.Context, RecordScheduleID string) (err error)
+	DisableRecordSchedule(RecordScheduleID string) (err error)
+	DisableRecordScheduleCtx(ctx context.Context, RecordScheduleID string) (err error)
+	DeleteRecordTask(RecordTaskID string) (err error)
+	DeleteRecordTaskCtx(ctx context.Context, RecordTaskID string) (err error)
+	GetRecordTask(RecordTaskID string, Filter string) (Result string, UpdateID uint32, err error)
+	GetRecordTaskCtx(ctx context.Context, RecordTaskID string, Filter string) (Result string, UpdateID uint32, err error)
+	EnableRecordTask(RecordTaskID string) (err error)
+	EnableRecordTaskCtx(ctx context.Context, RecordTaskID string) (err error)
+	DisableRecordTask(RecordTaskID string) (err error)
+	DisableRecordTaskCtx(ctx context.Context, RecordTaskID string) (err error)
+	ResetRecordTask(RecordTaskID string) (err error)
+	ResetRecordTaskCtx(ctx context.Context, RecordTaskID string) (err error)
+	GetRecordScheduleConflicts(RecordScheduleID string) (RecordScheduleConflictIDList string, UpdateID uint32, err error)
+	GetRecordScheduleConflictsCtx(ctx context.Context, RecordScheduleID string) (RecordScheduleConflictIDList string, UpdateID uint32, err error)
+	GetRecordTaskConflicts(RecordTaskID string) (RecordTaskConflictIDList string, UpdateID uint32, err error)
+	GetRecordTaskConflictsCtx(ctx context.Context, RecordTaskID string) (RecordTaskConflictIDList string, UpdateID uint32, err error)
+}
+
+var _ ScheduledRecording2Client = new(ScheduledRecording2)
+
+// ScheduledRecording2DataTypeID is a value of the state variable A_ARG_TYPE_DataTypeID of
+// ScheduledRecording2.
+type ScheduledRecording2DataTypeID string
+
+// Allowed values of ScheduledRecording2DataTypeID.
+const (
+	ScheduledRecording2DataTypeID_A_ARG_TYPE_RecordSchedule      ScheduledRecording2DataTypeID = "A_ARG_TYPE_RecordSchedule"
+	ScheduledRecording2DataTypeID_A_ARG_TYPE_RecordTask          ScheduledRecording2DataTypeID = "A_ARG_TYPE_RecordTask"
+	ScheduledRecording2DataTypeID_A_ARG_TYPE_RecordScheduleParts ScheduledRecording2DataTypeID = "A_ARG_TYPE_RecordScheduleParts"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v ScheduledRecording2DataTypeID) Valid() bool {
+	switch v {
+	case ScheduledRecording2DataTypeID_A_ARG_TYPE_RecordSchedule,
+		ScheduledRecording2DataTypeID_A_ARG_TYPE_RecordTask,
+		ScheduledRecording2DataTypeID_A_ARG_TYPE_RecordScheduleParts:
+		return true
+	}
+	return false
+}
+
 // NewScheduledRecording2Clients discovers instances of the service on the network,
 // and returns clients to any that are found. errors will contain an error for
 // any devices that replied but which could not be queried, and err will be set
@@ -7813,7 +11666,42 @@ func newScheduledRecording2ClientsFromGenericClients(genericClients []goupnp.Ser
 	return clients
 }
 
+// ScheduledRecording2Timeouts are the default timeouts of the actions of
+// ScheduledRecording2, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var ScheduledRecording2Timeouts = map[string]time.Duration{
+	"": 10 * time.Second,
+}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *ScheduledRecording2) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, ScheduledRecording2Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_ScheduledRecording_2, actionName, request, response)
+}
+
+// ScheduledRecording2GetSortCapabilitiesResponse is the response of GetSortCapabilities, with each
+// argument in its SOAP string form.
+type ScheduledRecording2GetSortCapabilitiesResponse struct {
+	SortCaps     string
+	SortLevelCap string
+}
+
+// GetSortCapabilities performs the GetSortCapabilities action of the service.
+//
+// Return values:
+//   - SortCaps (out, state variable A_ARG_TYPE_GetSortCapabilities_SortCaps)
+//   - SortLevelCap (out, state variable A_ARG_TYPE_GetSortCapabilities_SortLevelCap)
 func (client *ScheduledRecording2) GetSortCapabilities() (SortCaps string, SortLevelCap uint32, err error) {
+	return client.GetSortCapabilitiesCtx(context.Background())
+}
+
+// GetSortCapabilitiesCtx is GetSortCapabilities with a context, to cancel or time out the call.
+func (client *ScheduledRecording2) GetSortCapabilitiesCtx(ctx context.Context) (SortCaps string, SortLevelCap uint32, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -7821,14 +11709,10 @@ func (client *ScheduledRecording2) GetSortCapabilities() (SortCaps string, SortL
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		SortCaps string
-
-		SortLevelCap string
-	}{}
+	response := &ScheduledRecording2GetSortCapabilitiesResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ScheduledRecording_2, "GetSortCapabilities", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetSortCapabilities", request, response); err != nil {
 		return
 	}
 
@@ -7844,30 +11728,45 @@ func (client *ScheduledRecording2) GetSortCapabilities() (SortCaps string, SortL
 	return
 }
 
+// ScheduledRecording2GetPropertyListRequest is the request of GetPropertyList, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ScheduledRecording2GetPropertyListRequest struct {
+	DataTypeID string
+}
+
+// ScheduledRecording2GetPropertyListResponse is the response of GetPropertyList, with each
+// argument in its SOAP string form.
+type ScheduledRecording2GetPropertyListResponse struct {
+	PropertyList string
+}
+
+// GetPropertyList performs the GetPropertyList action of the service.
 //
 // Arguments:
+//   - DataTypeID (in, state variable A_ARG_TYPE_DataTypeID): allowed values A_ARG_TYPE_RecordSchedule, A_ARG_TYPE_RecordTask, A_ARG_TYPE_RecordScheduleParts
 //
-// * DataTypeID: allowed values: A_ARG_TYPE_RecordSchedule, A_ARG_TYPE_RecordTask, A_ARG_TYPE_RecordScheduleParts
+// Return values:
+//   - PropertyList (out, state variable A_ARG_TYPE_GetPropertyList_PropertyList)
+func (client *ScheduledRecording2) GetPropertyList(DataTypeID ScheduledRecording2DataTypeID) (PropertyList string, err error) {
+	return client.GetPropertyListCtx(context.Background(), DataTypeID)
+}
 
-func (client *ScheduledRecording2) GetPropertyList(DataTypeID string) (PropertyList string, err error) {
+// GetPropertyListCtx is GetPropertyList with a context, to cancel or time out the call.
+func (client *ScheduledRecording2) GetPropertyListCtx(ctx context.Context, DataTypeID ScheduledRecording2DataTypeID) (PropertyList string, err error) {
 	// Request structure.
-	request := &struct {
-		DataTypeID string
-	}{}
+	request := &ScheduledRecording2GetPropertyListRequest{}
 	// BEGIN Marshal arguments into request.
 
-	if request.DataTypeID, err = soap.MarshalString(DataTypeID); err != nil {
+	if request.DataTypeID, err = soap.MarshalString(string(DataTypeID)); err != nil {
 		return
 	}
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		PropertyList string
-	}{}
+	response := &ScheduledRecording2GetPropertyListResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ScheduledRecording_2, "GetPropertyList", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetPropertyList", request, response); err != nil {
 		return
 	}
 
@@ -7880,21 +11779,38 @@ func (client *ScheduledRecording2) GetPropertyList(DataTypeID string) (PropertyL
 	return
 }
 
+// ScheduledRecording2GetAllowedValuesRequest is the request of GetAllowedValues, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ScheduledRecording2GetAllowedValuesRequest struct {
+	DataTypeID string
+	Filter     string
+}
+
+// ScheduledRecording2GetAllowedValuesResponse is the response of GetAllowedValues, with each
+// argument in its SOAP string form.
+type ScheduledRecording2GetAllowedValuesResponse struct {
+	PropertyInfo string
+}
+
+// GetAllowedValues performs the GetAllowedValues action of the service.
 //
 // Arguments:
+//   - DataTypeID (in, state variable A_ARG_TYPE_DataTypeID): allowed values A_ARG_TYPE_RecordSchedule, A_ARG_TYPE_RecordTask, A_ARG_TYPE_RecordScheduleParts
+//   - Filter (in, state variable A_ARG_TYPE_GetAllowedValues_Filter)
 //
-// * DataTypeID: allowed values: A_ARG_TYPE_RecordSchedule, A_ARG_TYPE_RecordTask, A_ARG_TYPE_RecordScheduleParts
+// Return values:
+//   - PropertyInfo (out, state variable A_ARG_TYPE_GetAllowedValues_PropertyInfo)
+func (client *ScheduledRecording2) GetAllowedValues(DataTypeID ScheduledRecording2DataTypeID, Filter string) (PropertyInfo string, err error) {
+	return client.GetAllowedValuesCtx(context.Background(), DataTypeID, Filter)
+}
 
-func (client *ScheduledRecording2) GetAllowedValues(DataTypeID string, Filter string) (PropertyInfo string, err error) {
+// GetAllowedValuesCtx is GetAllowedValues with a context, to cancel or time out the call.
+func (client *ScheduledRecording2) GetAllowedValuesCtx(ctx context.Context, DataTypeID ScheduledRecording2DataTypeID, Filter string) (PropertyInfo string, err error) {
 	// Request structure.
-	request := &struct {
-		DataTypeID string
-
-		Filter string
-	}{}
+	request := &ScheduledRecording2GetAllowedValuesRequest{}
 	// BEGIN Marshal arguments into request.
 
-	if request.DataTypeID, err = soap.MarshalString(DataTypeID); err != nil {
+	if request.DataTypeID, err = soap.MarshalString(string(DataTypeID)); err != nil {
 		return
 	}
 	if request.Filter, err = soap.MarshalString(Filter); err != nil {
@@ -7903,12 +11819,10 @@ func (client *ScheduledRecording2) GetAllowedValues(DataTypeID string, Filter st
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		PropertyInfo string
-	}{}
+	response := &ScheduledRecording2GetAllowedValuesResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ScheduledRecording_2, "GetAllowedValues", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetAllowedValues", request, response); err != nil {
 		return
 	}
 
@@ -7921,7 +11835,22 @@ func (client *ScheduledRecording2) GetAllowedValues(DataTypeID string, Filter st
 	return
 }
 
+// ScheduledRecording2GetStateUpdateIDResponse is the response of GetStateUpdateID, with each
+// argument in its SOAP string form.
+type ScheduledRecording2GetStateUpdateIDResponse struct {
+	Id string
+}
+
+// GetStateUpdateID performs the GetStateUpdateID action of the service.
+//
+// Return values:
+//   - Id (out, state variable A_ARG_TYPE_GetStateUpdateID_Id)
 func (client *ScheduledRecording2) GetStateUpdateID() (Id uint32, err error) {
+	return client.GetStateUpdateIDCtx(context.Background())
+}
+
+// GetStateUpdateIDCtx is GetStateUpdateID with a context, to cancel or time out the call.
+func (client *ScheduledRecording2) GetStateUpdateIDCtx(ctx context.Context) (Id uint32, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -7929,12 +11858,10 @@ func (client *ScheduledRecording2) GetStateUpdateID() (Id uint32, err error) {
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		Id string
-	}{}
+	response := &ScheduledRecording2GetStateUpdateIDResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ScheduledRecording_2, "GetStateUpdateID", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetStateUpdateID", request, response); err != nil {
 		return
 	}
 
@@ -7947,17 +11874,45 @@ func (client *ScheduledRecording2) GetStateUpdateID() (Id uint32, err error) {
 	return
 }
 
-func (client *ScheduledRecording2) BrowseRecordSchedules(Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error) {
-	// Request structure.
-	request := &struct {
-		Filter string
+// ScheduledRecording2BrowseRecordSchedulesRequest is the request of BrowseRecordSchedules, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ScheduledRecording2BrowseRecordSchedulesRequest struct {
+	Filter         string
+	StartingIndex  string
+	RequestedCount string
+	SortCriteria   string
+}
 
-		StartingIndex string
+// ScheduledRecording2BrowseRecordSchedulesResponse is the response of BrowseRecordSchedules, with each
+// argument in its SOAP string form.
+type ScheduledRecording2BrowseRecordSchedulesResponse struct {
+	Result         string
+	NumberReturned string
+	TotalMatches   string
+	UpdateID       string
+}
 
-		RequestedCount string
+// BrowseRecordSchedules performs the BrowseRecordSchedules action of the service.
+//
+// Arguments:
+//   - Filter (in, state variable A_ARG_TYPE_BrowseRecordSchedules_Filter)
+//   - StartingIndex (in, state variable A_ARG_TYPE_BrowseRecordSchedules_StartingIndex)
+//   - RequestedCount (in, state variable A_ARG_TYPE_BrowseRecordSchedules_RequestedCount)
+//   - SortCriteria (in, state variable A_ARG_TYPE_BrowseRecordSchedules_SortCriteria)
+//
+// Return values:
+//   - Result (out, state variable A_ARG_TYPE_BrowseRecordSchedules_Result)
+//   - NumberReturned (out, state variable A_ARG_TYPE_BrowseRecordSchedules_NumberReturned)
+//   - TotalMatches (out, state variable A_ARG_TYPE_BrowseRecordSchedules_TotalMatches)
+//   - UpdateID (out, state variable A_ARG_TYPE_BrowseRecordSchedules_UpdateID)
+func (client *ScheduledRecording2) BrowseRecordSchedules(Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error) {
+	return client.BrowseRecordSchedulesCtx(context.Background(), Filter, StartingIndex, RequestedCount, SortCriteria)
+}
 
-		SortCriteria string
-	}{}
+// BrowseRecordSchedulesCtx is BrowseRecordSchedules with a context, to cancel or time out the call.
+func (client *ScheduledRecording2) BrowseRecordSchedulesCtx(ctx context.Context, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error) {
+	// Request structure.
+	request := &ScheduledRecording2BrowseRecordSchedulesRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.Filter, err = soap.MarshalString(Filter); err != nil {
@@ -7975,18 +11930,10 @@ func (client *ScheduledRecording2) BrowseRecordSchedules(Filter string, Starting
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		Result string
-
-		NumberReturned string
-
-		TotalMatches string
-
-		UpdateID string
-	}{}
+	response := &ScheduledRecording2BrowseRecordSchedulesResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ScheduledRecording_2, "BrowseRecordSchedules", request, response); err != nil {
+	if err = client.PerformAction(ctx, "BrowseRecordSchedules", request, response); err != nil {
 		return
 	}
 
@@ -8008,19 +11955,47 @@ func (client *ScheduledRecording2) BrowseRecordSchedules(Filter string, Starting
 	return
 }
 
-func (client *ScheduledRecording2) BrowseRecordTasks(RecordScheduleID string, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error) {
-	// Request structure.
-	request := &struct {
-		RecordScheduleID string
-
-		Filter string
+// ScheduledRecording2BrowseRecordTasksRequest is the request of BrowseRecordTasks, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ScheduledRecording2BrowseRecordTasksRequest struct {
+	RecordScheduleID string
+	Filter           string
+	StartingIndex    string
+	RequestedCount   string
+	SortCriteria     string
+}
 
-		StartingIndex string
+// ScheduledRecording2BrowseRecordTasksResponse is the response of BrowseRecordTasks, with each
+// argument in its SOAP string form.
+type ScheduledRecording2BrowseRecordTasksResponse struct {
+	Result         string
+	NumberReturned string
+	TotalMatches   string
+	UpdateID       string
+}
 
-		RequestedCount string
+// BrowseRecordTasks performs the BrowseRecordTasks action of the service.
+//
+// Arguments:
+//   - RecordScheduleID (in, state variable A_ARG_TYPE_BrowseRecordTasks_RecordScheduleID)
+//   - Filter (in, state variable A_ARG_TYPE_BrowseRecordTasks_Filter)
+//   - StartingIndex (in, state variable A_ARG_TYPE_BrowseRecordTasks_StartingIndex)
+//   - RequestedCount (in, state variable A_ARG_TYPE_BrowseRecordTasks_RequestedCount)
+//   - SortCriteria (in, state variable A_ARG_TYPE_BrowseRecordTasks_SortCriteria)
+//
+// Return values:
+//   - Result (out, state variable A_ARG_TYPE_BrowseRecordTasks_Result)
+//   - NumberReturned (out, state variable A_ARG_TYPE_BrowseRecordTasks_NumberReturned)
+//   - TotalMatches (out, state variable A_ARG_TYPE_BrowseRecordTasks_TotalMatches)
+//   - UpdateID (out, state variable A_ARG_TYPE_BrowseRecordTasks_UpdateID)
+func (client *ScheduledRecording2) BrowseRecordTasks(RecordScheduleID string, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error) {
+	return client.BrowseRecordTasksCtx(context.Background(), RecordScheduleID, Filter, StartingIndex, RequestedCount, SortCriteria)
+}
 
-		SortCriteria string
-	}{}
+// BrowseRecordTasksCtx is BrowseRecordTasks with a context, to cancel or time out the call.
+func (client *ScheduledRecording2) BrowseRecordTasksCtx(ctx context.Context, RecordScheduleID string, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error) {
+	// Request structure.
+	request := &ScheduledRecording2BrowseRecordTasksRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.RecordScheduleID, err = soap.MarshalString(RecordScheduleID); err != nil {
@@ -8041,18 +12016,10 @@ func (client *ScheduledRecording2) BrowseRecordTasks(RecordScheduleID string, Fi
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		Result string
-
-		NumberReturned string
-
-		TotalMatches string
-
-		UpdateID string
-	}{}
+	response := &ScheduledRecording2BrowseRecordTasksResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ScheduledRecording_2, "BrowseRecordTasks", request, response); err != nil {
+	if err = client.PerformAction(ctx, "BrowseRecordTasks", request, response); err != nil {
 		return
 	}
 
@@ -8074,11 +12041,37 @@ func (client *ScheduledRecording2) BrowseRecordTasks(RecordScheduleID string, Fi
 	return
 }
 
+// ScheduledRecording2CreateRecordScheduleRequest is the request of CreateRecordSchedule, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ScheduledRecording2CreateRecordScheduleRequest struct {
+	Elements string
+}
+
+// ScheduledRecording2CreateRecordScheduleResponse is the response of CreateRecordSchedule, with each
+// argument in its SOAP string form.
+type ScheduledRecording2CreateRecordScheduleResponse struct {
+	RecordScheduleID string
+	Result           string
+	UpdateID         string
+}
+
+// CreateRecordSchedule performs the CreateRecordSchedule action of the service.
+//
+// Arguments:
+//   - Elements (in, state variable A_ARG_TYPE_CreateRecordSchedule_Elements)
+//
+// Return values:
+//   - RecordScheduleID (out, state variable A_ARG_TYPE_CreateRecordSchedule_RecordScheduleID)
+//   - Result (out, state variable A_ARG_TYPE_CreateRecordSchedule_Result)
+//   - UpdateID (out, state variable A_ARG_TYPE_CreateRecordSchedule_UpdateID)
 func (client *ScheduledRecording2) CreateRecordSchedule(Elements string) (RecordScheduleID string, Result string, UpdateID uint32, err error) {
+	return client.CreateRecordScheduleCtx(context.Background(), Elements)
+}
+
+// CreateRecordScheduleCtx is CreateRecordSchedule with a context, to cancel or time out the call.
+func (client *ScheduledRecording2) CreateRecordScheduleCtx(ctx context.Context, Elements string) (RecordScheduleID string, Result string, UpdateID uint32, err error) {
 	// Request structure.
-	request := &struct {
-		Elements string
-	}{}
+	request := &ScheduledRecording2CreateRecordScheduleRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.Elements, err = soap.MarshalString(Elements); err != nil {
@@ -8087,16 +12080,10 @@ func (client *ScheduledRecording2) CreateRecordSchedule(Elements string) (Record
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		RecordScheduleID string
-
-		Result string
-
-		UpdateID string
-	}{}
+	response := &ScheduledRecording2CreateRecordScheduleResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ScheduledRecording_2, "CreateRecordSchedule", request, response); err != nil {
+	if err = client.PerformAction(ctx, "CreateRecordSchedule", request, response); err != nil {
 		return
 	}
 
@@ -8115,11 +12102,24 @@ func (client *ScheduledRecording2) CreateRecordSchedule(Elements string) (Record
 	return
 }
 
+// ScheduledRecording2DeleteRecordScheduleRequest is the request of DeleteRecordSchedule, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ScheduledRecording2DeleteRecordScheduleRequest struct {
+	RecordScheduleID string
+}
+
+// DeleteRecordSchedule performs the DeleteRecordSchedule action of the service.
+//
+// Arguments:
+//   - RecordScheduleID (in, state variable A_ARG_TYPE_DeleteRecordSchedule_RecordScheduleID)
 func (client *ScheduledRecording2) DeleteRecordSchedule(RecordScheduleID string) (err error) {
+	return client.DeleteRecordScheduleCtx(context.Background(), RecordScheduleID)
+}
+
+// DeleteRecordScheduleCtx is DeleteRecordSchedule with a context, to cancel or time out the call.
+func (client *ScheduledRecording2) DeleteRecordScheduleCtx(ctx context.Context, RecordScheduleID string) (err error) {
 	// Request structure.
-	request := &struct {
-		RecordScheduleID string
-	}{}
+	request := &ScheduledRecording2DeleteRecordScheduleRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.RecordScheduleID, err = soap.MarshalString(RecordScheduleID); err != nil {
@@ -8131,7 +12131,7 @@ func (client *ScheduledRecording2) DeleteRecordSchedule(RecordScheduleID string)
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ScheduledRecording_2, "DeleteRecordSchedule", request, response); err != nil {
+	if err = client.PerformAction(ctx, "DeleteRecordSchedule", request, response); err != nil {
 		return
 	}
 
@@ -8141,13 +12141,37 @@ func (client *ScheduledRecording2) DeleteRecordSchedule(RecordScheduleID string)
 	return
 }
 
+// ScheduledRecording2GetRecordScheduleRequest is the request of GetRecordSchedule, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ScheduledRecording2GetRecordScheduleRequest struct {
+	RecordScheduleID string
+	Filter           string
+}
+
+// ScheduledRecording2GetRecordScheduleResponse is the response of GetRecordSchedule, with each
+// argument in its SOAP string form.
+type ScheduledRecording2GetRecordScheduleResponse struct {
+	Result   string
+	UpdateID string
+}
+
+// GetRecordSchedule performs the GetRecordSchedule action of the service.
+//
+// Arguments:
+//   - RecordScheduleID (in, state variable A_ARG_TYPE_GetRecordSchedule_RecordScheduleID)
+//   - Filter (in, state variable A_ARG_TYPE_GetRecordSchedule_Filter)
+//
+// Return values:
+//   - Result (out, state variable A_ARG_TYPE_GetRecordSchedule_Result)
+//   - UpdateID (out, state variable A_ARG_TYPE_GetRecordSchedule_UpdateID)
 func (client *ScheduledRecording2) GetRecordSchedule(RecordScheduleID string, Filter string) (Result string, UpdateID uint32, err error) {
-	// Request structure.
-	request := &struct {
-		RecordScheduleID string
+	return client.GetRecordScheduleCtx(context.Background(), RecordScheduleID, Filter)
+}
 
-		Filter string
-	}{}
+// GetRecordScheduleCtx is GetRecordSchedule with a context, to cancel or time out the call.
+func (client *ScheduledRecording2) GetRecordScheduleCtx(ctx context.Context, RecordScheduleID string, Filter string) (Result string, UpdateID uint32, err error) {
+	// Request structure.
+	request := &ScheduledRecording2GetRecordScheduleRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.RecordScheduleID, err = soap.MarshalString(RecordScheduleID); err != nil {
@@ -8159,14 +12183,10 @@ func (client *ScheduledRecording2) GetRecordSchedule(RecordScheduleID string, Fi
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		Result string
-
-		UpdateID string
-	}{}
+	response := &ScheduledRecording2GetRecordScheduleResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ScheduledRecording_2, "GetRecordSchedule", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetRecordSchedule", request, response); err != nil {
 		return
 	}
 
@@ -8182,11 +12202,24 @@ func (client *ScheduledRecording2) GetRecordSchedule(RecordScheduleID string, Fi
 	return
 }
 
+// ScheduledRecording2EnableRecordScheduleRequest is the request of EnableRecordSchedule, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ScheduledRecording2EnableRecordScheduleRequest struct {
+	RecordScheduleID string
+}
+
+// EnableRecordSchedule performs the EnableRecordSchedule action of the service.
+//
+// Arguments:
+//   - RecordScheduleID (in, state variable A_ARG_TYPE_EnableRecordSchedule_RecordScheduleID)
 func (client *ScheduledRecording2) EnableRecordSchedule(RecordScheduleID string) (err error) {
+	return client.EnableRecordScheduleCtx(context.Background(), RecordScheduleID)
+}
+
+// EnableRecordScheduleCtx is EnableRecordSchedule with a context, to cancel or time out the call.
+func (client *ScheduledRecording2) EnableRecordScheduleCtx(ctx context.Context, RecordScheduleID string) (err error) {
 	// Request structure.
-	request := &struct {
-		RecordScheduleID string
-	}{}
+	request := &ScheduledRecording2EnableRecordScheduleRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.RecordScheduleID, err = soap.MarshalString(RecordScheduleID); err != nil {
@@ -8198,7 +12231,7 @@ func (client *ScheduledRecording2) EnableRecordSchedule(RecordScheduleID string)
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ScheduledRecording_2, "EnableRecordSchedule", request, response); err != nil {
+	if err = client.PerformAction(ctx, "EnableRecordSchedule", request, response); err != nil {
 		return
 	}
 
@@ -8208,11 +12241,24 @@ func (client *ScheduledRecording2) EnableRecordSchedule(RecordScheduleID string)
 	return
 }
 
+// ScheduledRecording2DisableRecordScheduleRequest is the request of DisableRecordSchedule, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ScheduledRecording2DisableRecordScheduleRequest struct {
+	RecordScheduleID string
+}
+
+// DisableRecordSchedule performs the DisableRecordSchedule action of the service.
+//
+// Arguments:
+//   - RecordScheduleID (in, state variable A_ARG_TYPE_DisableRecordSchedule_RecordScheduleID)
 func (client *ScheduledRecording2) DisableRecordSchedule(RecordScheduleID string) (err error) {
+	return client.DisableRecordScheduleCtx(context.Background(), RecordScheduleID)
+}
+
+// DisableRecordScheduleCtx is DisableRecordSchedule with a context, to cancel or time out the call.
+func (client *ScheduledRecording2) DisableRecordScheduleCtx(ctx context.Context, RecordScheduleID string) (err error) {
 	// Request structure.
-	request := &struct {
-		RecordScheduleID string
-	}{}
+	request := &ScheduledRecording2DisableRecordScheduleRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.RecordScheduleID, err = soap.MarshalString(RecordScheduleID); err != nil {
@@ -8224,7 +12270,7 @@ func (client *ScheduledRecording2) DisableRecordSchedule(RecordScheduleID string
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ScheduledRecording_2, "DisableRecordSchedule", request, response); err != nil {
+	if err = client.PerformAction(ctx, "DisableRecordSchedule", request, response); err != nil {
 		return
 	}
 
@@ -8234,11 +12280,24 @@ func (client *ScheduledRecording2) DisableRecordSchedule(RecordScheduleID string
 	return
 }
 
+// ScheduledRecording2DeleteRecordTaskRequest is the request of DeleteRecordTask, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ScheduledRecording2DeleteRecordTaskRequest struct {
+	RecordTaskID string
+}
+
+// DeleteRecordTask performs the DeleteRecordTask action of the service.
+//
+// Arguments:
+//   - RecordTaskID (in, state variable A_ARG_TYPE_DeleteRecordTask_RecordTaskID)
 func (client *ScheduledRecording2) DeleteRecordTask(RecordTaskID string) (err error) {
+	return client.DeleteRecordTaskCtx(context.Background(), RecordTaskID)
+}
+
+// DeleteRecordTaskCtx is DeleteRecordTask with a context, to cancel or time out the call.
+func (client *ScheduledRecording2) DeleteRecordTaskCtx(ctx context.Context, RecordTaskID string) (err error) {
 	// Request structure.
-	request := &struct {
-		RecordTaskID string
-	}{}
+	request := &ScheduledRecording2DeleteRecordTaskRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.RecordTaskID, err = soap.MarshalString(RecordTaskID); err != nil {
@@ -8250,7 +12309,7 @@ func (client *ScheduledRecording2) DeleteRecordTask(RecordTaskID string) (err er
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ScheduledRecording_2, "DeleteRecordTask", request, response); err != nil {
+	if err = client.PerformAction(ctx, "DeleteRecordTask", request, response); err != nil {
 		return
 	}
 
@@ -8260,13 +12319,37 @@ func (client *ScheduledRecording2) DeleteRecordTask(RecordTaskID string) (err er
 	return
 }
 
+// ScheduledRecording2GetRecordTaskRequest is the request of GetRecordTask, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ScheduledRecording2GetRecordTaskRequest struct {
+	RecordTaskID string
+	Filter       string
+}
+
+// ScheduledRecording2GetRecordTaskResponse is the response of GetRecordTask, with each
+// argument in its SOAP string form.
+type ScheduledRecording2GetRecordTaskResponse struct {
+	Result   string
+	UpdateID string
+}
+
+// GetRecordTask performs the GetRecordTask action of the service.
+//
+// Arguments:
+//   - RecordTaskID (in, state variable A_ARG_TYPE_GetRecordTask_RecordTaskID)
+//   - Filter (in, state variable A_ARG_TYPE_GetRecordTask_Filter)
+//
+// Return values:
+//   - Result (out, state variable A_ARG_TYPE_GetRecordTask_Result)
+//   - UpdateID (out, state variable A_ARG_TYPE_GetRecordTask_UpdateID)
 func (client *ScheduledRecording2) GetRecordTask(RecordTaskID string, Filter string) (Result string, UpdateID uint32, err error) {
-	// Request structure.
-	request := &struct {
-		RecordTaskID string
+	return client.GetRecordTaskCtx(context.Background(), RecordTaskID, Filter)
+}
 
-		Filter string
-	}{}
+// GetRecordTaskCtx is GetRecordTask with a context, to cancel or time out the call.
+func (client *ScheduledRecording2) GetRecordTaskCtx(ctx context.Context, RecordTaskID string, Filter string) (Result string, UpdateID uint32, err error) {
+	// Request structure.
+	request := &ScheduledRecording2GetRecordTaskRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.RecordTaskID, err = soap.MarshalString(RecordTaskID); err != nil {
@@ -8278,14 +12361,10 @@ func (client *ScheduledRecording2) GetRecordTask(RecordTaskID string, Filter str
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		Result string
-
-		UpdateID string
-	}{}
+	response := &ScheduledRecording2GetRecordTaskResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ScheduledRecording_2, "GetRecordTask", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetRecordTask", request, response); err != nil {
 		return
 	}
 
@@ -8301,11 +12380,24 @@ func (client *ScheduledRecording2) GetRecordTask(RecordTaskID string, Filter str
 	return
 }
 
+// ScheduledRecording2EnableRecordTaskRequest is the request of EnableRecordTask, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ScheduledRecording2EnableRecordTaskRequest struct {
+	RecordTaskID string
+}
+
+// EnableRecordTask performs the EnableRecordTask action of the service.
+//
+// Arguments:
+//   - RecordTaskID (in, state variable A_ARG_TYPE_EnableRecordTask_RecordTaskID)
 func (client *ScheduledRecording2) EnableRecordTask(RecordTaskID string) (err error) {
+	return client.EnableRecordTaskCtx(context.Background(), RecordTaskID)
+}
+
+// EnableRecordTaskCtx is EnableRecordTask with a context, to cancel or time out the call.
+func (client *ScheduledRecording2) EnableRecordTaskCtx(ctx context.Context, RecordTaskID string) (err error) {
 	// Request structure.
-	request := &struct {
-		RecordTaskID string
-	}{}
+	request := &ScheduledRecording2EnableRecordTaskRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.RecordTaskID, err = soap.MarshalString(RecordTaskID); err != nil {
@@ -8317,7 +12409,7 @@ func (client *ScheduledRecording2) EnableRecordTask(RecordTaskID string) (err er
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ScheduledRecording_2, "EnableRecordTask", request, response); err != nil {
+	if err = client.PerformAction(ctx, "EnableRecordTask", request, response); err != nil {
 		return
 	}
 
@@ -8327,11 +12419,24 @@ func (client *ScheduledRecording2) EnableRecordTask(RecordTaskID string) (err er
 	return
 }
 
+// ScheduledRecording2DisableRecordTaskRequest is the request of DisableRecordTask, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ScheduledRecording2DisableRecordTaskRequest struct {
+	RecordTaskID string
+}
+
+// DisableRecordTask performs the DisableRecordTask action of the service.
+//
+// Arguments:
+//   - RecordTaskID (in, state variable A_ARG_TYPE_DisableRecordTask_RecordTaskID)
 func (client *ScheduledRecording2) DisableRecordTask(RecordTaskID string) (err error) {
+	return client.DisableRecordTaskCtx(context.Background(), RecordTaskID)
+}
+
+// DisableRecordTaskCtx is DisableRecordTask with a context, to cancel or time out the call.
+func (client *ScheduledRecording2) DisableRecordTaskCtx(ctx context.Context, RecordTaskID string) (err error) {
 	// Request structure.
-	request := &struct {
-		RecordTaskID string
-	}{}
+	request := &ScheduledRecording2DisableRecordTaskRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.RecordTaskID, err = soap.MarshalString(RecordTaskID); err != nil {
@@ -8343,7 +12448,7 @@ func (client *ScheduledRecording2) DisableRecordTask(RecordTaskID string) (err e
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ScheduledRecording_2, "DisableRecordTask", request, response); err != nil {
+	if err = client.PerformAction(ctx, "DisableRecordTask", request, response); err != nil {
 		return
 	}
 
@@ -8353,11 +12458,24 @@ func (client *ScheduledRecording2) DisableRecordTask(RecordTaskID string) (err e
 	return
 }
 
+// ScheduledRecording2ResetRecordTaskRequest is the request of ResetRecordTask, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ScheduledRecording2ResetRecordTaskRequest struct {
+	RecordTaskID string
+}
+
+// ResetRecordTask performs the ResetRecordTask action of the service.
+//
+// Arguments:
+//   - RecordTaskID (in, state variable A_ARG_TYPE_ResetRecordTask_RecordTaskID)
 func (client *ScheduledRecording2) ResetRecordTask(RecordTaskID string) (err error) {
+	return client.ResetRecordTaskCtx(context.Background(), RecordTaskID)
+}
+
+// ResetRecordTaskCtx is ResetRecordTask with a context, to cancel or time out the call.
+func (client *ScheduledRecording2) ResetRecordTaskCtx(ctx context.Context, RecordTaskID string) (err error) {
 	// Request structure.
-	request := &struct {
-		RecordTaskID string
-	}{}
+	request := &ScheduledRecording2ResetRecordTaskRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.RecordTaskID, err = soap.MarshalString(RecordTaskID); err != nil {
@@ -8369,7 +12487,7 @@ func (client *ScheduledRecording2) ResetRecordTask(RecordTaskID string) (err err
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ScheduledRecording_2, "ResetRecordTask", request, response); err != nil {
+	if err = client.PerformAction(ctx, "ResetRecordTask", request, response); err != nil {
 		return
 	}
 
@@ -8379,11 +12497,35 @@ func (client *ScheduledRecording2) ResetRecordTask(RecordTaskID string) (err err
 	return
 }
 
+// ScheduledRecording2GetRecordScheduleConflictsRequest is the request of GetRecordScheduleConflicts, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ScheduledRecording2GetRecordScheduleConflictsRequest struct {
+	RecordScheduleID string
+}
+
+// ScheduledRecording2GetRecordScheduleConflictsResponse is the response of GetRecordScheduleConflicts, with each
+// argument in its SOAP string form.
+type ScheduledRecording2GetRecordScheduleConflictsResponse struct {
+	RecordScheduleConflictIDList string
+	UpdateID                     string
+}
+
+// GetRecordScheduleConflicts performs the GetRecordScheduleConflicts action of the service.
+//
+// Arguments:
+//   - RecordScheduleID (in, state variable A_ARG_TYPE_GetRecordScheduleConflicts_RecordScheduleID)
+//
+// Return values:
+//   - RecordScheduleConflictIDList (out, state variable A_ARG_TYPE_GetRecordScheduleConflicts_RecordScheduleConflictIDList)
+//   - UpdateID (out, state variable A_ARG_TYPE_GetRecordScheduleConflicts_UpdateID)
 func (client *ScheduledRecording2) GetRecordScheduleConflicts(RecordScheduleID string) (RecordScheduleConflictIDList string, UpdateID uint32, err error) {
+	return client.GetRecordScheduleConflictsCtx(context.Background(), RecordScheduleID)
+}
+
+// GetRecordScheduleConflictsCtx is GetRecordScheduleConflicts with a context, to cancel or time out the call.
+func (client *ScheduledRecording2) GetRecordScheduleConflictsCtx(ctx context.Context, RecordScheduleID string) (RecordScheduleConflictIDList string, UpdateID uint32, err error) {
 	// Request structure.
-	request := &struct {
-		RecordScheduleID string
-	}{}
+	request := &ScheduledRecording2GetRecordScheduleConflictsRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.RecordScheduleID, err = soap.MarshalString(RecordScheduleID); err != nil {
@@ -8392,14 +12534,10 @@ func (client *ScheduledRecording2) GetRecordScheduleConflicts(RecordScheduleID s
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		RecordScheduleConflictIDList string
-
-		UpdateID string
-	}{}
+	response := &ScheduledRecording2GetRecordScheduleConflictsResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ScheduledRecording_2, "GetRecordScheduleConflicts", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetRecordScheduleConflicts", request, response); err != nil {
 		return
 	}
 
@@ -8415,11 +12553,35 @@ func (client *ScheduledRecording2) GetRecordScheduleConflicts(RecordScheduleID s
 	return
 }
 
+// ScheduledRecording2GetRecordTaskConflictsRequest is the request of GetRecordTaskConflicts, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ScheduledRecording2GetRecordTaskConflictsRequest struct {
+	RecordTaskID string
+}
+
+// ScheduledRecording2GetRecordTaskConflictsResponse is the response of GetRecordTaskConflicts, with each
+// argument in its SOAP string form.
+type ScheduledRecording2GetRecordTaskConflictsResponse struct {
+	RecordTaskConflictIDList string
+	UpdateID                 string
+}
+
+// GetRecordTaskConflicts performs the GetRecordTaskConflicts action of the service.
+//
+// Arguments:
+//   - RecordTaskID (in, state variable A_ARG_TYPE_GetRecordTaskConflicts_RecordTaskID)
+//
+// Return values:
+//   - RecordTaskConflictIDList (out, state variable A_ARG_TYPE_GetRecordTaskConflicts_RecordTaskConflictIDList)
+//   - UpdateID (out, state variable A_ARG_TYPE_GetRecordTaskConflicts_UpdateID)
 func (client *ScheduledRecording2) GetRecordTaskConflicts(RecordTaskID string) (RecordTaskConflictIDList string, UpdateID uint32, err error) {
+	return client.GetRecordTaskConflictsCtx(context.Background(), RecordTaskID)
+}
+
+// GetRecordTaskConflictsCtx is GetRecordTaskConflicts with a context, to cancel or time out the call.
+func (client *ScheduledRecording2) GetRecordTaskConflictsCtx(ctx context.Context, RecordTaskID string) (RecordTaskConflictIDList string, UpdateID uint32, err error) {
 	// Request structure.
-	request := &struct {
-		RecordTaskID string
-	}{}
+	request := &ScheduledRecording2GetRecordTaskConflictsRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.RecordTaskID, err = soap.MarshalString(RecordTaskID); err != nil {
@@ -8428,14 +12590,10 @@ func (client *ScheduledRecording2) GetRecordTaskConflicts(RecordTaskID string) (
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		RecordTaskConflictIDList string
-
-		UpdateID string
-	}{}
+	response := &ScheduledRecording2GetRecordTaskConflictsResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_ScheduledRecording_2, "GetRecordTaskConflicts", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetRecordTaskConflicts", request, response); err != nil {
 		return
 	}
 
@@ -8450,3 +12608,817 @@ func (client *ScheduledRecording2) GetRecordTaskConflicts(RecordTaskID string) (
 	// END Unmarshal arguments from response.
 	return
 }
+
+// ContentSync1 is a client for UPnP SOAP service with URN "urn:schemas-upnp-org:service:ContentSync:1". See
+// goupnp.ServiceClient, which contains RootDevice and Service attributes which
+// are provided for informational value.
+type ContentSync1 struct {
+	goupnp.ServiceClient
+}
+
+// ContentSync1Client is the interface of the actions of ContentSync1, for
+// substituting fakes or mocks for the service in tests.
+type ContentSync1Client interface {
+	AddSyncData(ActionCaller string, SyncData string) (SyncID string, err error)
+	AddSyncDataCtx(ctx context.Context, ActionCaller string, SyncData string) (SyncID string, err error)
+	ModifySyncData(ActionCaller string, SyncID string, SyncData string) (err error)
+	ModifySyncDataCtx(ctx context.Context, ActionCaller string, SyncID string, SyncData string) (err error)
+	DeleteSyncData(ActionCaller string, SyncID string) (err error)
+	DeleteSyncDataCtx(ctx context.Context, ActionCaller string, SyncID string) (err error)
+	GetSyncData(SyncID string) (SyncData string, err error)
+	GetSyncDataCtx(ctx context.Context, SyncID string) (SyncData string, err error)
+	ExchangeSyncData(ActionCaller string, LocalSyncData string) (RemoteSyncData string, err error)
+	ExchangeSyncDataCtx(ctx context.Context, ActionCaller string, LocalSyncData string) (RemoteSyncData string, err error)
+	AddSyncPair(ActionCaller string, ObjectID string, SyncPair string) (err error)
+	AddSyncPairCtx(ctx context.Context, ActionCaller string, ObjectID string, SyncPair string) (err error)
+	ModifySyncPair(ActionCaller string, ObjectID string, SyncPair string) (err error)
+	ModifySyncPairCtx(ctx context.Context, ActionCaller string, ObjectID string, SyncPair string) (err error)
+	DeleteSyncPair(ActionCaller string, ObjectID string, SyncPair string) (err error)
+	DeleteSyncPairCtx(ctx context.Context, ActionCaller string, ObjectID string, SyncPair string) (err error)
+	StartSync(ActionCaller string, SyncID string) (err error)
+	StartSyncCtx(ctx context.Context, ActionCaller string, SyncID string) (err error)
+	AbortSync(ActionCaller string, SyncID string) (err error)
+	AbortSyncCtx(ctx context.Context, ActionCaller string, SyncID string) (err error)
+	GetChangeLog(SyncID string, StartingIndex uint32, RequestedCount uint32) (ChangeLog string, NumberReturned uint32, TotalMatches uint32, err error)
+	GetChangeLogCtx(ctx context.Context, SyncID string, StartingIndex uint32, RequestedCount uint32) (ChangeLog string, NumberReturned uint32, TotalMatches uint32, err error)
+	ResetChangeLog(SyncID string, ObjectIDs string) (err error)
+	ResetChangeLogCtx(ctx context.Context, SyncID string, ObjectIDs string) (err error)
+	ResetStatus(SyncID string, ObjectIDs string) (err error)
+	ResetStatusCtx(ctx context.Context, SyncID string, ObjectIDs string) (err error)
+	GetSyncProgress(SyncID string) (SyncProgress string, err error)
+	GetSyncProgressCtx(ctx context.Context, SyncID string) (SyncProgress string, err error)
+}
+
+var _ ContentSync1Client = new(ContentSync1)
+
+// NewContentSync1Clients discovers instances of the service on the network,
+// and returns clients to any that are found. errors will contain an error for
+// any devices that replied but which could not be queried, and err will be set
+// if the discovery process failed outright.
+//
+// This is a typical entry calling point into this package.
+func NewContentSync1Clients() (clients []*ContentSync1, errors []error, err error) {
+	var genericClients []goupnp.ServiceClient
+	if genericClients, errors, err = goupnp.NewServiceClients(URN_ContentSync_1); err != nil {
+		return
+	}
+	clients = newContentSync1ClientsFromGenericClients(genericClients)
+	return
+}
+
+// NewContentSync1ClientsByURL discovers instances of the service at the given
+// URL, and returns clients to any that are found. An error is returned if
+// there was an error probing the service.
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered service URL.
+func NewContentSync1ClientsByURL(loc *url.URL) ([]*ContentSync1, error) {
+	genericClients, err := goupnp.NewServiceClientsByURL(loc, URN_ContentSync_1)
+	if err != nil {
+		return nil, err
+	}
+	return newContentSync1ClientsFromGenericClients(genericClients), nil
+}
+
+// NewContentSync1ClientsFromRootDevice discovers instances of the service in
+// a given root device, and returns clients to any that are found. An error is
+// returned if there was not at least one instance of the service within the
+// device. The location parameter is simply assigned to the Location attribute
+// of the wrapped ServiceClient(s).
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered root device.
+func NewContentSync1ClientsFromRootDevice(rootDevice *goupnp.RootDevice, loc *url.URL) ([]*ContentSync1, error) {
+	genericClients, err := goupnp.NewServiceClientsFromRootDevice(rootDevice, loc, URN_ContentSync_1)
+	if err != nil {
+		return nil, err
+	}
+	return newContentSync1ClientsFromGenericClients(genericClients), nil
+}
+
+func newContentSync1ClientsFromGenericClients(genericClients []goupnp.ServiceClient) []*ContentSync1 {
+	clients := make([]*ContentSync1, len(genericClients))
+	for i := range genericClients {
+		clients[i] = &ContentSync1{genericClients[i]}
+	}
+	return clients
+}
+
+// ContentSync1Timeouts are the default timeouts of the actions of
+// ContentSync1, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var ContentSync1Timeouts = map[string]time.Duration{
+	"": 10 * time.Second,
+}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *ContentSync1) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, ContentSync1Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_ContentSync_1, actionName, request, response)
+}
+
+// ContentSync1AddSyncDataRequest is the request of AddSyncData, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentSync1AddSyncDataRequest struct {
+	ActionCaller string
+	SyncData     string
+}
+
+// ContentSync1AddSyncDataResponse is the response of AddSyncData, with each
+// argument in its SOAP string form.
+type ContentSync1AddSyncDataResponse struct {
+	SyncID string
+}
+
+// AddSyncData performs the AddSyncData action of the service.
+//
+// Arguments:
+//   - ActionCaller (in, state variable A_ARG_TYPE_ActionCaller)
+//   - SyncData (in, state variable A_ARG_TYPE_SyncData)
+//
+// Return values:
+//   - SyncID (out, state variable A_ARG_TYPE_SyncID)
+func (client *ContentSync1) AddSyncData(ActionCaller string, SyncData string) (SyncID string, err error) {
+	return client.AddSyncDataCtx(context.Background(), ActionCaller, SyncData)
+}
+
+// AddSyncDataCtx is AddSyncData with a context, to cancel or time out the call.
+func (client *ContentSync1) AddSyncDataCtx(ctx context.Context, ActionCaller string, SyncData string) (SyncID string, err error) {
+	// Request structure.
+	request := &ContentSync1AddSyncDataRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.ActionCaller, err = soap.MarshalString(ActionCaller); err != nil {
+		return
+	}
+	if request.SyncData, err = soap.MarshalString(SyncData); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &ContentSync1AddSyncDataResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "AddSyncData", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if SyncID, err = soap.UnmarshalString(response.SyncID); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// ContentSync1ModifySyncDataRequest is the request of ModifySyncData, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentSync1ModifySyncDataRequest struct {
+	ActionCaller string
+	SyncID       string
+	SyncData     string
+}
+
+// ModifySyncData performs the ModifySyncData action of the service.
+//
+// Arguments:
+//   - ActionCaller (in, state variable A_ARG_TYPE_ActionCaller)
+//   - SyncID (in, state variable A_ARG_TYPE_SyncID)
+//   - SyncData (in, state variable A_ARG_TYPE_SyncData)
+func (client *ContentSync1) ModifySyncData(ActionCaller string, SyncID string, SyncData string) (err error) {
+	return client.ModifySyncDataCtx(context.Background(), ActionCaller, SyncID, SyncData)
+}
+
+// ModifySyncDataCtx is ModifySyncData with a context, to cancel or time out the call.
+func (client *ContentSync1) ModifySyncDataCtx(ctx context.Context, ActionCaller string, SyncID string, SyncData string) (err error) {
+	// Request structure.
+	request := &ContentSync1ModifySyncDataRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.ActionCaller, err = soap.MarshalString(ActionCaller); err != nil {
+		return
+	}
+	if request.SyncID, err = soap.MarshalString(SyncID); err != nil {
+		return
+	}
+	if request.SyncData, err = soap.MarshalString(SyncData); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "ModifySyncData", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// ContentSync1DeleteSyncDataRequest is the request of DeleteSyncData, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentSync1DeleteSyncDataRequest struct {
+	ActionCaller string
+	SyncID       string
+}
+
+// DeleteSyncData performs the DeleteSyncData action of the service.
+//
+// Arguments:
+//   - ActionCaller (in, state variable A_ARG_TYPE_ActionCaller)
+//   - SyncID (in, state variable A_ARG_TYPE_SyncID)
+func (client *ContentSync1) DeleteSyncData(ActionCaller string, SyncID string) (err error) {
+	return client.DeleteSyncDataCtx(context.Background(), ActionCaller, SyncID)
+}
+
+// DeleteSyncDataCtx is DeleteSyncData with a context, to cancel or time out the call.
+func (client *ContentSync1) DeleteSyncDataCtx(ctx context.Context, ActionCaller string, SyncID string) (err error) {
+	// Request structure.
+	request := &ContentSync1DeleteSyncDataRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.ActionCaller, err = soap.MarshalString(ActionCaller); err != nil {
+		return
+	}
+	if request.SyncID, err = soap.MarshalString(SyncID); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "DeleteSyncData", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// ContentSync1GetSyncDataRequest is the request of GetSyncData, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentSync1GetSyncDataRequest struct {
+	SyncID string
+}
+
+// ContentSync1GetSyncDataResponse is the response of GetSyncData, with each
+// argument in its SOAP string form.
+type ContentSync1GetSyncDataResponse struct {
+	SyncData string
+}
+
+// GetSyncData performs the GetSyncData action of the service.
+//
+// Arguments:
+//   - SyncID (in, state variable A_ARG_TYPE_SyncID)
+//
+// Return values:
+//   - SyncData (out, state variable A_ARG_TYPE_SyncData)
+func (client *ContentSync1) GetSyncData(SyncID string) (SyncData string, err error) {
+	return client.GetSyncDataCtx(context.Background(), SyncID)
+}
+
+// GetSyncDataCtx is GetSyncData with a context, to cancel or time out the call.
+func (client *ContentSync1) GetSyncDataCtx(ctx context.Context, SyncID string) (SyncData string, err error) {
+	// Request structure.
+	request := &ContentSync1GetSyncDataRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.SyncID, err = soap.MarshalString(SyncID); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &ContentSync1GetSyncDataResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetSyncData", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if SyncData, err = soap.UnmarshalString(response.SyncData); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// ContentSync1ExchangeSyncDataRequest is the request of ExchangeSyncData, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentSync1ExchangeSyncDataRequest struct {
+	ActionCaller  string
+	LocalSyncData string
+}
+
+// ContentSync1ExchangeSyncDataResponse is the response of ExchangeSyncData, with each
+// argument in its SOAP string form.
+type ContentSync1ExchangeSyncDataResponse struct {
+	RemoteSyncData string
+}
+
+// ExchangeSyncData performs the ExchangeSyncData action of the service.
+//
+// Arguments:
+//   - ActionCaller (in, state variable A_ARG_TYPE_ActionCaller)
+//   - LocalSyncData (in, state variable A_ARG_TYPE_SyncData)
+//
+// Return values:
+//   - RemoteSyncData (out, state variable A_ARG_TYPE_SyncData)
+func (client *ContentSync1) ExchangeSyncData(ActionCaller string, LocalSyncData string) (RemoteSyncData string, err error) {
+	return client.ExchangeSyncDataCtx(context.Background(), ActionCaller, LocalSyncData)
+}
+
+// ExchangeSyncDataCtx is ExchangeSyncData with a context, to cancel or time out the call.
+func (client *ContentSync1) ExchangeSyncDataCtx(ctx context.Context, ActionCaller string, LocalSyncData string) (RemoteSyncData string, err error) {
+	// Request structure.
+	request := &ContentSync1ExchangeSyncDataRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.ActionCaller, err = soap.MarshalString(ActionCaller); err != nil {
+		return
+	}
+	if request.LocalSyncData, err = soap.MarshalString(LocalSyncData); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &ContentSync1ExchangeSyncDataResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "ExchangeSyncData", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if RemoteSyncData, err = soap.UnmarshalString(response.RemoteSyncData); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// ContentSync1AddSyncPairRequest is the request of AddSyncPair, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentSync1AddSyncPairRequest struct {
+	ActionCaller string
+	ObjectID     string
+	SyncPair     string
+}
+
+// AddSyncPair performs the AddSyncPair action of the service.
+//
+// Arguments:
+//   - ActionCaller (in, state variable A_ARG_TYPE_ActionCaller)
+//   - ObjectID (in, state variable A_ARG_TYPE_ObjectID)
+//   - SyncPair (in, state variable A_ARG_TYPE_SyncPair)
+func (client *ContentSync1) AddSyncPair(ActionCaller string, ObjectID string, SyncPair string) (err error) {
+	return client.AddSyncPairCtx(context.Background(), ActionCaller, ObjectID, SyncPair)
+}
+
+// AddSyncPairCtx is AddSyncPair with a context, to cancel or time out the call.
+func (client *ContentSync1) AddSyncPairCtx(ctx context.Context, ActionCaller string, ObjectID string, SyncPair string) (err error) {
+	// Request structure.
+	request := &ContentSync1AddSyncPairRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.ActionCaller, err = soap.MarshalString(ActionCaller); err != nil {
+		return
+	}
+	if request.ObjectID, err = soap.MarshalString(ObjectID); err != nil {
+		return
+	}
+	if request.SyncPair, err = soap.MarshalString(SyncPair); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "AddSyncPair", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// ContentSync1ModifySyncPairRequest is the request of ModifySyncPair, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentSync1ModifySyncPairRequest struct {
+	ActionCaller string
+	ObjectID     string
+	SyncPair     string
+}
+
+// ModifySyncPair performs the ModifySyncPair action of the service.
+//
+// Arguments:
+//   - ActionCaller (in, state variable A_ARG_TYPE_ActionCaller)
+//   - ObjectID (in, state variable A_ARG_TYPE_ObjectID)
+//   - SyncPair (in, state variable A_ARG_TYPE_SyncPair)
+func (client *ContentSync1) ModifySyncPair(ActionCaller string, ObjectID string, SyncPair string) (err error) {
+	return client.ModifySyncPairCtx(context.Background(), ActionCaller, ObjectID, SyncPair)
+}
+
+// ModifySyncPairCtx is ModifySyncPair with a context, to cancel or time out the call.
+func (client *ContentSync1) ModifySyncPairCtx(ctx context.Context, ActionCaller string, ObjectID string, SyncPair string) (err error) {
+	// Request structure.
+	request := &ContentSync1ModifySyncPairRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.ActionCaller, err = soap.MarshalString(ActionCaller); err != nil {
+		return
+	}
+	if request.ObjectID, err = soap.MarshalString(ObjectID); err != nil {
+		return
+	}
+	if request.SyncPair, err = soap.MarshalString(SyncPair); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "ModifySyncPair", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// ContentSync1DeleteSyncPairRequest is the request of DeleteSyncPair, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentSync1DeleteSyncPairRequest struct {
+	ActionCaller string
+	ObjectID     string
+	SyncPair     string
+}
+
+// DeleteSyncPair performs the DeleteSyncPair action of the service.
+//
+// Arguments:
+//   - ActionCaller (in, state variable A_ARG_TYPE_ActionCaller)
+//   - ObjectID (in, state variable A_ARG_TYPE_ObjectID)
+//   - SyncPair (in, state variable A_ARG_TYPE_SyncPair)
+func (client *ContentSync1) DeleteSyncPair(ActionCaller string, ObjectID string, SyncPair string) (err error) {
+	return client.DeleteSyncPairCtx(context.Background(), ActionCaller, ObjectID, SyncPair)
+}
+
+// DeleteSyncPairCtx is DeleteSyncPair with a context, to cancel or time out the call.
+func (client *ContentSync1) DeleteSyncPairCtx(ctx context.Context, ActionCaller string, ObjectID string, SyncPair string) (err error) {
+	// Request structure.
+	request := &ContentSync1DeleteSyncPairRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.ActionCaller, err = soap.MarshalString(ActionCaller); err != nil {
+		return
+	}
+	if request.ObjectID, err = soap.MarshalString(ObjectID); err != nil {
+		return
+	}
+	if request.SyncPair, err = soap.MarshalString(SyncPair); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "DeleteSyncPair", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// ContentSync1StartSyncRequest is the request of StartSync, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentSync1StartSyncRequest struct {
+	ActionCaller string
+	SyncID       string
+}
+
+// StartSync performs the StartSync action of the service.
+//
+// Arguments:
+//   - ActionCaller (in, state variable A_ARG_TYPE_ActionCaller)
+//   - SyncID (in, state variable A_ARG_TYPE_SyncID)
+func (client *ContentSync1) StartSync(ActionCaller string, SyncID string) (err error) {
+	return client.StartSyncCtx(context.Background(), ActionCaller, SyncID)
+}
+
+// StartSyncCtx is StartSync with a context, to cancel or time out the call.
+func (client *ContentSync1) StartSyncCtx(ctx context.Context, ActionCaller string, SyncID string) (err error) {
+	// Request structure.
+	request := &ContentSync1StartSyncRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.ActionCaller, err = soap.MarshalString(ActionCaller); err != nil {
+		return
+	}
+	if request.SyncID, err = soap.MarshalString(SyncID); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "StartSync", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// ContentSync1AbortSyncRequest is the request of AbortSync, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentSync1AbortSyncRequest struct {
+	ActionCaller string
+	SyncID       string
+}
+
+// AbortSync performs the AbortSync action of the service.
+//
+// Arguments:
+//   - ActionCaller (in, state variable A_ARG_TYPE_ActionCaller)
+//   - SyncID (in, state variable A_ARG_TYPE_SyncID)
+func (client *ContentSync1) AbortSync(ActionCaller string, SyncID string) (err error) {
+	return client.AbortSyncCtx(context.Background(), ActionCaller, SyncID)
+}
+
+// AbortSyncCtx is AbortSync with a context, to cancel or time out the call.
+func (client *ContentSync1) AbortSyncCtx(ctx context.Context, ActionCaller string, SyncID string) (err error) {
+	// Request structure.
+	request := &ContentSync1AbortSyncRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.ActionCaller, err = soap.MarshalString(ActionCaller); err != nil {
+		return
+	}
+	if request.SyncID, err = soap.MarshalString(SyncID); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "AbortSync", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// ContentSync1GetChangeLogRequest is the request of GetChangeLog, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentSync1GetChangeLogRequest struct {
+	SyncID         string
+	StartingIndex  string
+	RequestedCount string
+}
+
+// ContentSync1GetChangeLogResponse is the response of GetChangeLog, with each
+// argument in its SOAP string form.
+type ContentSync1GetChangeLogResponse struct {
+	ChangeLog      string
+	NumberReturned string
+	TotalMatches   string
+}
+
+// GetChangeLog performs the GetChangeLog action of the service.
+//
+// Arguments:
+//   - SyncID (in, state variable A_ARG_TYPE_SyncID)
+//   - StartingIndex (in, state variable A_ARG_TYPE_Index)
+//   - RequestedCount (in, state variable A_ARG_TYPE_Count)
+//
+// Return values:
+//   - ChangeLog (out, state variable A_ARG_TYPE_ChangeLog)
+//   - NumberReturned (out, state variable A_ARG_TYPE_Count)
+//   - TotalMatches (out, state variable A_ARG_TYPE_Count)
+func (client *ContentSync1) GetChangeLog(SyncID string, StartingIndex uint32, RequestedCount uint32) (ChangeLog string, NumberReturned uint32, TotalMatches uint32, err error) {
+	return client.GetChangeLogCtx(context.Background(), SyncID, StartingIndex, RequestedCount)
+}
+
+// GetChangeLogCtx is GetChangeLog with a context, to cancel or time out the call.
+func (client *ContentSync1) GetChangeLogCtx(ctx context.Context, SyncID string, StartingIndex uint32, RequestedCount uint32) (ChangeLog string, NumberReturned uint32, TotalMatches uint32, err error) {
+	// Request structure.
+	request := &ContentSync1GetChangeLogRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.SyncID, err = soap.MarshalString(SyncID); err != nil {
+		return
+	}
+	if request.StartingIndex, err = soap.MarshalUi4(StartingIndex); err != nil {
+		return
+	}
+	if request.RequestedCount, err = soap.MarshalUi4(RequestedCount); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &ContentSync1GetChangeLogResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetChangeLog", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if ChangeLog, err = soap.UnmarshalString(response.ChangeLog); err != nil {
+		return
+	}
+	if NumberReturned, err = soap.UnmarshalUi4(response.NumberReturned); err != nil {
+		return
+	}
+	if TotalMatches, err = soap.UnmarshalUi4(response.TotalMatches); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// ContentSync1ResetChangeLogRequest is the request of ResetChangeLog, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentSync1ResetChangeLogRequest struct {
+	SyncID    string
+	ObjectIDs string
+}
+
+// ResetChangeLog performs the ResetChangeLog action of the service.
+//
+// Arguments:
+//   - SyncID (in, state variable A_ARG_TYPE_SyncID)
+//   - ObjectIDs (in, state variable A_ARG_TYPE_ResetObjectList)
+func (client *ContentSync1) ResetChangeLog(SyncID string, ObjectIDs string) (err error) {
+	return client.ResetChangeLogCtx(context.Background(), SyncID, ObjectIDs)
+}
+
+// ResetChangeLogCtx is ResetChangeLog with a context, to cancel or time out the call.
+func (client *ContentSync1) ResetChangeLogCtx(ctx context.Context, SyncID string, ObjectIDs string) (err error) {
+	// Request structure.
+	request := &ContentSync1ResetChangeLogRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.SyncID, err = soap.MarshalString(SyncID); err != nil {
+		return
+	}
+	if request.ObjectIDs, err = soap.MarshalString(ObjectIDs); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "ResetChangeLog", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// ContentSync1ResetStatusRequest is the request of ResetStatus, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentSync1ResetStatusRequest struct {
+	SyncID    string
+	ObjectIDs string
+}
+
+// ResetStatus performs the ResetStatus action of the service.
+//
+// Arguments:
+//   - SyncID (in, state variable A_ARG_TYPE_SyncID)
+//   - ObjectIDs (in, state variable A_ARG_TYPE_ResetObjectList)
+func (client *ContentSync1) ResetStatus(SyncID string, ObjectIDs string) (err error) {
+	return client.ResetStatusCtx(context.Background(), SyncID, ObjectIDs)
+}
+
+// ResetStatusCtx is ResetStatus with a context, to cancel or time out the call.
+func (client *ContentSync1) ResetStatusCtx(ctx context.Context, SyncID string, ObjectIDs string) (err error) {
+	// Request structure.
+	request := &ContentSync1ResetStatusRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.SyncID, err = soap.MarshalString(SyncID); err != nil {
+		return
+	}
+	if request.ObjectIDs, err = soap.MarshalString(ObjectIDs); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "ResetStatus", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// ContentSync1GetSyncProgressRequest is the request of GetSyncProgress, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ContentSync1GetSyncProgressRequest struct {
+	SyncID string
+}
+
+// ContentSync1GetSyncProgressResponse is the response of GetSyncProgress, with each
+// argument in its SOAP string form.
+type ContentSync1GetSyncProgressResponse struct {
+	SyncProgress string
+}
+
+// GetSyncProgress performs the GetSyncProgress action of the service.
+//
+// Arguments:
+//   - SyncID (in, state variable A_ARG_TYPE_SyncID)
+//
+// Return values:
+//   - SyncProgress (out, state variable A_ARG_TYPE_SyncProgress)
+func (client *ContentSync1) GetSyncProgress(SyncID string) (SyncProgress string, err error) {
+	return client.GetSyncProgressCtx(context.Background(), SyncID)
+}
+
+// GetSyncProgressCtx is GetSyncProgress with a context, to cancel or time out the call.
+func (client *ContentSync1) GetSyncProgressCtx(ctx context.Context, SyncID string) (SyncProgress string, err error) {
+	// Request structure.
+	request := &ContentSync1GetSyncProgressRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.SyncID, err = soap.MarshalString(SyncID); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &ContentSync1GetSyncProgressResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetSyncProgress", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if SyncProgress, err = soap.UnmarshalString(response.SyncProgress); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
@@ -0,0 +1,9247 @@
+package av1
+
+// Generated file - do not edit by hand. See README.md
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/huin/goupnp/device"
+	"github.com/huin/goupnp/soap"
+)
+
+// Hack to avoid Go complaining if url or time aren't used.
+var _ *url.URL
+var _ time.Time
+
+// AVTransport1Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:schemas-upnp-org:service:AVTransport:1". See RegisterAVTransport1Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type AVTransport1Handler interface {
+	SetAVTransportURI(ctx context.Context, InstanceID uint32, CurrentURI string, CurrentURIMetaData string) (err error)
+
+	SetNextAVTransportURI(ctx context.Context, InstanceID uint32, NextURI string, NextURIMetaData string) (err error)
+
+	GetMediaInfo(ctx context.Context, InstanceID uint32) (NrTracks uint32, MediaDuration string, CurrentURI string, CurrentURIMetaData string, NextURI string, NextURIMetaData string, PlayMedium string, RecordMedium string, WriteStatus string, err error)
+
+	GetTransportInfo(ctx context.Context, InstanceID uint32) (CurrentTransportState AVTransport1TransportState, CurrentTransportStatus AVTransport1TransportStatus, CurrentSpeed AVTransport1TransportPlaySpeed, err error)
+
+	GetPositionInfo(ctx context.Context, InstanceID uint32) (Track uint32, TrackDuration string, TrackMetaData string, TrackURI string, RelTime string, AbsTime string, RelCount int32, AbsCount int32, err error)
+
+	GetDeviceCapabilities(ctx context.Context, InstanceID uint32) (PlayMedia string, RecMedia string, RecQualityModes string, err error)
+
+	GetTransportSettings(ctx context.Context, InstanceID uint32) (PlayMode AVTransport1CurrentPlayMode, RecQualityMode string, err error)
+
+	Stop(ctx context.Context, InstanceID uint32) (err error)
+
+	Play(ctx context.Context, InstanceID uint32, Speed AVTransport1TransportPlaySpeed) (err error)
+
+	Pause(ctx context.Context, InstanceID uint32) (err error)
+
+	Record(ctx context.Context, InstanceID uint32) (err error)
+
+	Seek(ctx context.Context, InstanceID uint32, Unit AVTransport1SeekMode, Target string) (err error)
+
+	Next(ctx context.Context, InstanceID uint32) (err error)
+
+	Previous(ctx context.Context, InstanceID uint32) (err error)
+
+	SetPlayMode(ctx context.Context, InstanceID uint32, NewPlayMode AVTransport1CurrentPlayMode) (err error)
+
+	SetRecordQualityMode(ctx context.Context, InstanceID uint32, NewRecordQualityMode string) (err error)
+
+	GetCurrentTransportActions(ctx context.Context, InstanceID uint32) (Actions string, err error)
+}
+
+// RegisterAVTransport1Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_AVTransport_1.
+func RegisterAVTransport1Handler(svc *device.Service, handler AVTransport1Handler) {
+	svc.HandleFunc("SetAVTransportURI", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport1SetAVTransportURI(ctx, handler, in)
+	})
+	svc.HandleFunc("SetNextAVTransportURI", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport1SetNextAVTransportURI(ctx, handler, in)
+	})
+	svc.HandleFunc("GetMediaInfo", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport1GetMediaInfo(ctx, handler, in)
+	})
+	svc.HandleFunc("GetTransportInfo", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport1GetTransportInfo(ctx, handler, in)
+	})
+	svc.HandleFunc("GetPositionInfo", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport1GetPositionInfo(ctx, handler, in)
+	})
+	svc.HandleFunc("GetDeviceCapabilities", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport1GetDeviceCapabilities(ctx, handler, in)
+	})
+	svc.HandleFunc("GetTransportSettings", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport1GetTransportSettings(ctx, handler, in)
+	})
+	svc.HandleFunc("Stop", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport1Stop(ctx, handler, in)
+	})
+	svc.HandleFunc("Play", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport1Play(ctx, handler, in)
+	})
+	svc.HandleFunc("Pause", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport1Pause(ctx, handler, in)
+	})
+	svc.HandleFunc("Record", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport1Record(ctx, handler, in)
+	})
+	svc.HandleFunc("Seek", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport1Seek(ctx, handler, in)
+	})
+	svc.HandleFunc("Next", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport1Next(ctx, handler, in)
+	})
+	svc.HandleFunc("Previous", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport1Previous(ctx, handler, in)
+	})
+	svc.HandleFunc("SetPlayMode", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport1SetPlayMode(ctx, handler, in)
+	})
+	svc.HandleFunc("SetRecordQualityMode", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport1SetRecordQualityMode(ctx, handler, in)
+	})
+	svc.HandleFunc("GetCurrentTransportActions", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport1GetCurrentTransportActions(ctx, handler, in)
+	})
+}
+
+func serveAVTransport1SetAVTransportURI(ctx context.Context, handler AVTransport1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var CurrentURI string
+	if value, err = soap.FindArg(in, "CurrentURI"); err != nil {
+		return
+	}
+	if CurrentURI, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument CurrentURI: "+err.Error())
+	}
+	var CurrentURIMetaData string
+	if value, err = soap.FindArg(in, "CurrentURIMetaData"); err != nil {
+		return
+	}
+	if CurrentURIMetaData, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument CurrentURIMetaData: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetAVTransportURI(ctx, InstanceID, CurrentURI, CurrentURIMetaData); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAVTransport1SetNextAVTransportURI(ctx context.Context, handler AVTransport1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var NextURI string
+	if value, err = soap.FindArg(in, "NextURI"); err != nil {
+		return
+	}
+	if NextURI, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NextURI: "+err.Error())
+	}
+	var NextURIMetaData string
+	if value, err = soap.FindArg(in, "NextURIMetaData"); err != nil {
+		return
+	}
+	if NextURIMetaData, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NextURIMetaData: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetNextAVTransportURI(ctx, InstanceID, NextURI, NextURIMetaData); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAVTransport1GetMediaInfo(ctx context.Context, handler AVTransport1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NrTracks uint32
+	var MediaDuration string
+	var CurrentURI string
+	var CurrentURIMetaData string
+	var NextURI string
+	var NextURIMetaData string
+	var PlayMedium string
+	var RecordMedium string
+	var WriteStatus string
+	if NrTracks, MediaDuration, CurrentURI, CurrentURIMetaData, NextURI, NextURIMetaData, PlayMedium, RecordMedium, WriteStatus, err = handler.GetMediaInfo(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 9)
+
+	out[0].Name = "NrTracks"
+	if out[0].Value, err = soap.MarshalUi4(NrTracks); err != nil {
+		return
+	}
+	out[1].Name = "MediaDuration"
+	if out[1].Value, err = soap.MarshalString(MediaDuration); err != nil {
+		return
+	}
+	out[2].Name = "CurrentURI"
+	if out[2].Value, err = soap.MarshalString(CurrentURI); err != nil {
+		return
+	}
+	out[3].Name = "CurrentURIMetaData"
+	if out[3].Value, err = soap.MarshalString(CurrentURIMetaData); err != nil {
+		return
+	}
+	out[4].Name = "NextURI"
+	if out[4].Value, err = soap.MarshalString(NextURI); err != nil {
+		return
+	}
+	out[5].Name = "NextURIMetaData"
+	if out[5].Value, err = soap.MarshalString(NextURIMetaData); err != nil {
+		return
+	}
+	out[6].Name = "PlayMedium"
+	if out[6].Value, err = soap.MarshalString(PlayMedium); err != nil {
+		return
+	}
+	out[7].Name = "RecordMedium"
+	if out[7].Value, err = soap.MarshalString(RecordMedium); err != nil {
+		return
+	}
+	out[8].Name = "WriteStatus"
+	if out[8].Value, err = soap.MarshalString(WriteStatus); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAVTransport1GetTransportInfo(ctx context.Context, handler AVTransport1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentTransportState AVTransport1TransportState
+	var CurrentTransportStatus AVTransport1TransportStatus
+	var CurrentSpeed AVTransport1TransportPlaySpeed
+	if CurrentTransportState, CurrentTransportStatus, CurrentSpeed, err = handler.GetTransportInfo(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 3)
+
+	out[0].Name = "CurrentTransportState"
+	if out[0].Value, err = soap.MarshalString(string(CurrentTransportState)); err != nil {
+		return
+	}
+	out[1].Name = "CurrentTransportStatus"
+	if out[1].Value, err = soap.MarshalString(string(CurrentTransportStatus)); err != nil {
+		return
+	}
+	out[2].Name = "CurrentSpeed"
+	if out[2].Value, err = soap.MarshalString(string(CurrentSpeed)); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAVTransport1GetPositionInfo(ctx context.Context, handler AVTransport1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var Track uint32
+	var TrackDuration string
+	var TrackMetaData string
+	var TrackURI string
+	var RelTime string
+	var AbsTime string
+	var RelCount int32
+	var AbsCount int32
+	if Track, TrackDuration, TrackMetaData, TrackURI, RelTime, AbsTime, RelCount, AbsCount, err = handler.GetPositionInfo(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 8)
+
+	out[0].Name = "Track"
+	if out[0].Value, err = soap.MarshalUi4(Track); err != nil {
+		return
+	}
+	out[1].Name = "TrackDuration"
+	if out[1].Value, err = soap.MarshalString(TrackDuration); err != nil {
+		return
+	}
+	out[2].Name = "TrackMetaData"
+	if out[2].Value, err = soap.MarshalString(TrackMetaData); err != nil {
+		return
+	}
+	out[3].Name = "TrackURI"
+	if out[3].Value, err = soap.MarshalString(TrackURI); err != nil {
+		return
+	}
+	out[4].Name = "RelTime"
+	if out[4].Value, err = soap.MarshalString(RelTime); err != nil {
+		return
+	}
+	out[5].Name = "AbsTime"
+	if out[5].Value, err = soap.MarshalString(AbsTime); err != nil {
+		return
+	}
+	out[6].Name = "RelCount"
+	if out[6].Value, err = soap.MarshalI4(RelCount); err != nil {
+		return
+	}
+	out[7].Name = "AbsCount"
+	if out[7].Value, err = soap.MarshalI4(AbsCount); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAVTransport1GetDeviceCapabilities(ctx context.Context, handler AVTransport1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var PlayMedia string
+	var RecMedia string
+	var RecQualityModes string
+	if PlayMedia, RecMedia, RecQualityModes, err = handler.GetDeviceCapabilities(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 3)
+
+	out[0].Name = "PlayMedia"
+	if out[0].Value, err = soap.MarshalString(PlayMedia); err != nil {
+		return
+	}
+	out[1].Name = "RecMedia"
+	if out[1].Value, err = soap.MarshalString(RecMedia); err != nil {
+		return
+	}
+	out[2].Name = "RecQualityModes"
+	if out[2].Value, err = soap.MarshalString(RecQualityModes); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAVTransport1GetTransportSettings(ctx context.Context, handler AVTransport1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var PlayMode AVTransport1CurrentPlayMode
+	var RecQualityMode string
+	if PlayMode, RecQualityMode, err = handler.GetTransportSettings(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "PlayMode"
+	if out[0].Value, err = soap.MarshalString(string(PlayMode)); err != nil {
+		return
+	}
+	out[1].Name = "RecQualityMode"
+	if out[1].Value, err = soap.MarshalString(RecQualityMode); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAVTransport1Stop(ctx context.Context, handler AVTransport1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.Stop(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAVTransport1Play(ctx context.Context, handler AVTransport1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var Speed AVTransport1TransportPlaySpeed
+	if value, err = soap.FindArg(in, "Speed"); err != nil {
+		return
+	}
+	Speed = AVTransport1TransportPlaySpeed(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.Play(ctx, InstanceID, Speed); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAVTransport1Pause(ctx context.Context, handler AVTransport1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.Pause(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAVTransport1Record(ctx context.Context, handler AVTransport1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.Record(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAVTransport1Seek(ctx context.Context, handler AVTransport1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var Unit AVTransport1SeekMode
+	if value, err = soap.FindArg(in, "Unit"); err != nil {
+		return
+	}
+	Unit = AVTransport1SeekMode(value)
+	var Target string
+	if value, err = soap.FindArg(in, "Target"); err != nil {
+		return
+	}
+	if Target, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Target: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.Seek(ctx, InstanceID, Unit, Target); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAVTransport1Next(ctx context.Context, handler AVTransport1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.Next(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAVTransport1Previous(ctx context.Context, handler AVTransport1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.Previous(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAVTransport1SetPlayMode(ctx context.Context, handler AVTransport1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var NewPlayMode AVTransport1CurrentPlayMode
+	if value, err = soap.FindArg(in, "NewPlayMode"); err != nil {
+		return
+	}
+	NewPlayMode = AVTransport1CurrentPlayMode(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetPlayMode(ctx, InstanceID, NewPlayMode); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAVTransport1SetRecordQualityMode(ctx context.Context, handler AVTransport1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var NewRecordQualityMode string
+	if value, err = soap.FindArg(in, "NewRecordQualityMode"); err != nil {
+		return
+	}
+	if NewRecordQualityMode, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewRecordQualityMode: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetRecordQualityMode(ctx, InstanceID, NewRecordQualityMode); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAVTransport1GetCurrentTransportActions(ctx context.Context, handler AVTransport1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var Actions string
+	if Actions, err = handler.GetCurrentTransportActions(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "Actions"
+	if out[0].Value, err = soap.MarshalString(Actions); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+// AVTransport2Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:schemas-upnp-org:service:AVTransport:2". See RegisterAVTransport2Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type AVTransport2Handler interface {
+	SetAVTransportURI(ctx context.Context, InstanceID uint32, CurrentURI string, CurrentURIMetaData string) (err error)
+
+	SetNextAVTransportURI(ctx context.Context, InstanceID uint32, NextURI string, NextURIMetaData string) (err error)
+
+	GetMediaInfo(ctx context.Context, InstanceID uint32) (NrTracks uint32, MediaDuration string, CurrentURI string, CurrentURIMetaData string, NextURI string, NextURIMetaData string, PlayMedium string, RecordMedium string, WriteStatus string, err error)
+
+	GetMediaInfo_Ext(ctx context.Context, InstanceID uint32) (CurrentType AVTransport2CurrentMediaCategory, NrTracks uint32, MediaDuration string, CurrentURI string, CurrentURIMetaData string, NextURI string, NextURIMetaData string, PlayMedium string, RecordMedium string, WriteStatus string, err error)
+
+	GetTransportInfo(ctx context.Context, InstanceID uint32) (CurrentTransportState AVTransport2TransportState, CurrentTransportStatus AVTransport2TransportStatus, CurrentSpeed AVTransport2TransportPlaySpeed, err error)
+
+	GetPositionInfo(ctx context.Context, InstanceID uint32) (Track uint32, TrackDuration string, TrackMetaData string, TrackURI string, RelTime string, AbsTime string, RelCount int32, AbsCount int32, err error)
+
+	GetDeviceCapabilities(ctx context.Context, InstanceID uint32) (PlayMedia string, RecMedia string, RecQualityModes string, err error)
+
+	GetTransportSettings(ctx context.Context, InstanceID uint32) (PlayMode AVTransport2CurrentPlayMode, RecQualityMode string, err error)
+
+	Stop(ctx context.Context, InstanceID uint32) (err error)
+
+	Play(ctx context.Context, InstanceID uint32, Speed AVTransport2TransportPlaySpeed) (err error)
+
+	Pause(ctx context.Context, InstanceID uint32) (err error)
+
+	Record(ctx context.Context, InstanceID uint32) (err error)
+
+	Seek(ctx context.Context, InstanceID uint32, Unit AVTransport2SeekMode, Target string) (err error)
+
+	Next(ctx context.Context, InstanceID uint32) (err error)
+
+	Previous(ctx context.Context, InstanceID uint32) (err error)
+
+	SetPlayMode(ctx context.Context, InstanceID uint32, NewPlayMode AVTransport2CurrentPlayMode) (err error)
+
+	SetRecordQualityMode(ctx context.Context, InstanceID uint32, NewRecordQualityMode string) (err error)
+
+	GetCurrentTransportActions(ctx context.Context, InstanceID uint32) (Actions string, err error)
+
+	GetDRMState(ctx context.Context, InstanceID uint32) (CurrentDRMState AVTransport2DRMState, err error)
+
+	GetStateVariables(ctx context.Context, InstanceID uint32, StateVariableList string) (StateVariableValuePairs string, err error)
+
+	SetStateVariables(ctx context.Context, InstanceID uint32, AVTransportUDN string, ServiceType string, ServiceId string, StateVariableValuePairs string) (StateVariableList string, err error)
+}
+
+// RegisterAVTransport2Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_AVTransport_2.
+func RegisterAVTransport2Handler(svc *device.Service, handler AVTransport2Handler) {
+	svc.HandleFunc("SetAVTransportURI", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport2SetAVTransportURI(ctx, handler, in)
+	})
+	svc.HandleFunc("SetNextAVTransportURI", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport2SetNextAVTransportURI(ctx, handler, in)
+	})
+	svc.HandleFunc("GetMediaInfo", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport2GetMediaInfo(ctx, handler, in)
+	})
+	svc.HandleFunc("GetMediaInfo_Ext", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport2GetMediaInfo_Ext(ctx, handler, in)
+	})
+	svc.HandleFunc("GetTransportInfo", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport2GetTransportInfo(ctx, handler, in)
+	})
+	svc.HandleFunc("GetPositionInfo", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport2GetPositionInfo(ctx, handler, in)
+	})
+	svc.HandleFunc("GetDeviceCapabilities", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport2GetDeviceCapabilities(ctx, handler, in)
+	})
+	svc.HandleFunc("GetTransportSettings", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport2GetTransportSettings(ctx, handler, in)
+	})
+	svc.HandleFunc("Stop", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport2Stop(ctx, handler, in)
+	})
+	svc.HandleFunc("Play", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport2Play(ctx, handler, in)
+	})
+	svc.HandleFunc("Pause", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport2Pause(ctx, handler, in)
+	})
+	svc.HandleFunc("Record", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport2Record(ctx, handler, in)
+	})
+	svc.HandleFunc("Seek", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport2Seek(ctx, handler, in)
+	})
+	svc.HandleFunc("Next", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport2Next(ctx, handler, in)
+	})
+	svc.HandleFunc("Previous", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport2Previous(ctx, handler, in)
+	})
+	svc.HandleFunc("SetPlayMode", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport2SetPlayMode(ctx, handler, in)
+	})
+	svc.HandleFunc("SetRecordQualityMode", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport2SetRecordQualityMode(ctx, handler, in)
+	})
+	svc.HandleFunc("GetCurrentTransportActions", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport2GetCurrentTransportActions(ctx, handler, in)
+	})
+	svc.HandleFunc("GetDRMState", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport2GetDRMState(ctx, handler, in)
+	})
+	svc.HandleFunc("GetStateVariables", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport2GetStateVariables(ctx, handler, in)
+	})
+	svc.HandleFunc("SetStateVariables", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAVTransport2SetStateVariables(ctx, handler, in)
+	})
+}
+
+func serveAVTransport2SetAVTransportURI(ctx context.Context, handler AVTransport2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var CurrentURI string
+	if value, err = soap.FindArg(in, "CurrentURI"); err != nil {
+		return
+	}
+	if CurrentURI, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument CurrentURI: "+err.Error())
+	}
+	var CurrentURIMetaData string
+	if value, err = soap.FindArg(in, "CurrentURIMetaData"); err != nil {
+		return
+	}
+	if CurrentURIMetaData, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument CurrentURIMetaData: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetAVTransportURI(ctx, InstanceID, CurrentURI, CurrentURIMetaData); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAVTransport2SetNextAVTransportURI(ctx context.Context, handler AVTransport2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var NextURI string
+	if value, err = soap.FindArg(in, "NextURI"); err != nil {
+		return
+	}
+	if NextURI, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NextURI: "+err.Error())
+	}
+	var NextURIMetaData string
+	if value, err = soap.FindArg(in, "NextURIMetaData"); err != nil {
+		return
+	}
+	if NextURIMetaData, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NextURIMetaData: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetNextAVTransportURI(ctx, InstanceID, NextURI, NextURIMetaData); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAVTransport2GetMediaInfo(ctx context.Context, handler AVTransport2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NrTracks uint32
+	var MediaDuration string
+	var CurrentURI string
+	var CurrentURIMetaData string
+	var NextURI string
+	var NextURIMetaData string
+	var PlayMedium string
+	var RecordMedium string
+	var WriteStatus string
+	if NrTracks, MediaDuration, CurrentURI, CurrentURIMetaData, NextURI, NextURIMetaData, PlayMedium, RecordMedium, WriteStatus, err = handler.GetMediaInfo(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 9)
+
+	out[0].Name = "NrTracks"
+	if out[0].Value, err = soap.MarshalUi4(NrTracks); err != nil {
+		return
+	}
+	out[1].Name = "MediaDuration"
+	if out[1].Value, err = soap.MarshalString(MediaDuration); err != nil {
+		return
+	}
+	out[2].Name = "CurrentURI"
+	if out[2].Value, err = soap.MarshalString(CurrentURI); err != nil {
+		return
+	}
+	out[3].Name = "CurrentURIMetaData"
+	if out[3].Value, err = soap.MarshalString(CurrentURIMetaData); err != nil {
+		return
+	}
+	out[4].Name = "NextURI"
+	if out[4].Value, err = soap.MarshalString(NextURI); err != nil {
+		return
+	}
+	out[5].Name = "NextURIMetaData"
+	if out[5].Value, err = soap.MarshalString(NextURIMetaData); err != nil {
+		return
+	}
+	out[6].Name = "PlayMedium"
+	if out[6].Value, err = soap.MarshalString(PlayMedium); err != nil {
+		return
+	}
+	out[7].Name = "RecordMedium"
+	if out[7].Value, err = soap.MarshalString(RecordMedium); err != nil {
+		return
+	}
+	out[8].Name = "WriteStatus"
+	if out[8].Value, err = soap.MarshalString(WriteStatus); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAVTransport2GetMediaInfo_Ext(ctx context.Context, handler AVTransport2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentType AVTransport2CurrentMediaCategory
+	var NrTracks uint32
+	var MediaDuration string
+	var CurrentURI string
+	var CurrentURIMetaData string
+	var NextURI string
+	var NextURIMetaData string
+	var PlayMedium string
+	var RecordMedium string
+	var WriteStatus string
+	if CurrentType, NrTracks, MediaDuration, CurrentURI, CurrentURIMetaData, NextURI, NextURIMetaData, PlayMedium, RecordMedium, WriteStatus, err = handler.GetMediaInfo_Ext(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 10)
+
+	out[0].Name = "CurrentType"
+	if out[0].Value, err = soap.MarshalString(string(CurrentType)); err != nil {
+		return
+	}
+	out[1].Name = "NrTracks"
+	if out[1].Value, err = soap.MarshalUi4(NrTracks); err != nil {
+		return
+	}
+	out[2].Name = "MediaDuration"
+	if out[2].Value, err = soap.MarshalString(MediaDuration); err != nil {
+		return
+	}
+	out[3].Name = "CurrentURI"
+	if out[3].Value, err = soap.MarshalString(CurrentURI); err != nil {
+		return
+	}
+	out[4].Name = "CurrentURIMetaData"
+	if out[4].Value, err = soap.MarshalString(CurrentURIMetaData); err != nil {
+		return
+	}
+	out[5].Name = "NextURI"
+	if out[5].Value, err = soap.MarshalString(NextURI); err != nil {
+		return
+	}
+	out[6].Name = "NextURIMetaData"
+	if out[6].Value, err = soap.MarshalString(NextURIMetaData); err != nil {
+		return
+	}
+	out[7].Name = "PlayMedium"
+	if out[7].Value, err = soap.MarshalString(PlayMedium); err != nil {
+		return
+	}
+	out[8].Name = "RecordMedium"
+	if out[8].Value, err = soap.MarshalString(RecordMedium); err != nil {
+		return
+	}
+	out[9].Name = "WriteStatus"
+	if out[9].Value, err = soap.MarshalString(WriteStatus); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAVTransport2GetTransportInfo(ctx context.Context, handler AVTransport2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentTransportState AVTransport2TransportState
+	var CurrentTransportStatus AVTransport2TransportStatus
+	var CurrentSpeed AVTransport2TransportPlaySpeed
+	if CurrentTransportState, CurrentTransportStatus, CurrentSpeed, err = handler.GetTransportInfo(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 3)
+
+	out[0].Name = "CurrentTransportState"
+	if out[0].Value, err = soap.MarshalString(string(CurrentTransportState)); err != nil {
+		return
+	}
+	out[1].Name = "CurrentTransportStatus"
+	if out[1].Value, err = soap.MarshalString(string(CurrentTransportStatus)); err != nil {
+		return
+	}
+	out[2].Name = "CurrentSpeed"
+	if out[2].Value, err = soap.MarshalString(string(CurrentSpeed)); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAVTransport2GetPositionInfo(ctx context.Context, handler AVTransport2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var Track uint32
+	var TrackDuration string
+	var TrackMetaData string
+	var TrackURI string
+	var RelTime string
+	var AbsTime string
+	var RelCount int32
+	var AbsCount int32
+	if Track, TrackDuration, TrackMetaData, TrackURI, RelTime, AbsTime, RelCount, AbsCount, err = handler.GetPositionInfo(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 8)
+
+	out[0].Name = "Track"
+	if out[0].Value, err = soap.MarshalUi4(Track); err != nil {
+		return
+	}
+	out[1].Name = "TrackDuration"
+	if out[1].Value, err = soap.MarshalString(TrackDuration); err != nil {
+		return
+	}
+	out[2].Name = "TrackMetaData"
+	if out[2].Value, err = soap.MarshalString(TrackMetaData); err != nil {
+		return
+	}
+	out[3].Name = "TrackURI"
+	if out[3].Value, err = soap.MarshalString(TrackURI); err != nil {
+		return
+	}
+	out[4].Name = "RelTime"
+	if out[4].Value, err = soap.MarshalString(RelTime); err != nil {
+		return
+	}
+	out[5].Name = "AbsTime"
+	if out[5].Value, err = soap.MarshalString(AbsTime); err != nil {
+		return
+	}
+	out[6].Name = "RelCount"
+	if out[6].Value, err = soap.MarshalI4(RelCount); err != nil {
+		return
+	}
+	out[7].Name = "AbsCount"
+	if out[7].Value, err = soap.MarshalI4(AbsCount); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAVTransport2GetDeviceCapabilities(ctx context.Context, handler AVTransport2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var PlayMedia string
+	var RecMedia string
+	var RecQualityModes string
+	if PlayMedia, RecMedia, RecQualityModes, err = handler.GetDeviceCapabilities(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 3)
+
+	out[0].Name = "PlayMedia"
+	if out[0].Value, err = soap.MarshalString(PlayMedia); err != nil {
+		return
+	}
+	out[1].Name = "RecMedia"
+	if out[1].Value, err = soap.MarshalString(RecMedia); err != nil {
+		return
+	}
+	out[2].Name = "RecQualityModes"
+	if out[2].Value, err = soap.MarshalString(RecQualityModes); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAVTransport2GetTransportSettings(ctx context.Context, handler AVTransport2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var PlayMode AVTransport2CurrentPlayMode
+	var RecQualityMode string
+	if PlayMode, RecQualityMode, err = handler.GetTransportSettings(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "PlayMode"
+	if out[0].Value, err = soap.MarshalString(string(PlayMode)); err != nil {
+		return
+	}
+	out[1].Name = "RecQualityMode"
+	if out[1].Value, err = soap.MarshalString(RecQualityMode); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAVTransport2Stop(ctx context.Context, handler AVTransport2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.Stop(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAVTransport2Play(ctx context.Context, handler AVTransport2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var Speed AVTransport2TransportPlaySpeed
+	if value, err = soap.FindArg(in, "Speed"); err != nil {
+		return
+	}
+	Speed = AVTransport2TransportPlaySpeed(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.Play(ctx, InstanceID, Speed); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAVTransport2Pause(ctx context.Context, handler AVTransport2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.Pause(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAVTransport2Record(ctx context.Context, handler AVTransport2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.Record(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAVTransport2Seek(ctx context.Context, handler AVTransport2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var Unit AVTransport2SeekMode
+	if value, err = soap.FindArg(in, "Unit"); err != nil {
+		return
+	}
+	Unit = AVTransport2SeekMode(value)
+	var Target string
+	if value, err = soap.FindArg(in, "Target"); err != nil {
+		return
+	}
+	if Target, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Target: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.Seek(ctx, InstanceID, Unit, Target); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAVTransport2Next(ctx context.Context, handler AVTransport2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.Next(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAVTransport2Previous(ctx context.Context, handler AVTransport2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.Previous(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAVTransport2SetPlayMode(ctx context.Context, handler AVTransport2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var NewPlayMode AVTransport2CurrentPlayMode
+	if value, err = soap.FindArg(in, "NewPlayMode"); err != nil {
+		return
+	}
+	NewPlayMode = AVTransport2CurrentPlayMode(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetPlayMode(ctx, InstanceID, NewPlayMode); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAVTransport2SetRecordQualityMode(ctx context.Context, handler AVTransport2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var NewRecordQualityMode string
+	if value, err = soap.FindArg(in, "NewRecordQualityMode"); err != nil {
+		return
+	}
+	if NewRecordQualityMode, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewRecordQualityMode: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetRecordQualityMode(ctx, InstanceID, NewRecordQualityMode); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAVTransport2GetCurrentTransportActions(ctx context.Context, handler AVTransport2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var Actions string
+	if Actions, err = handler.GetCurrentTransportActions(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "Actions"
+	if out[0].Value, err = soap.MarshalString(Actions); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAVTransport2GetDRMState(ctx context.Context, handler AVTransport2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentDRMState AVTransport2DRMState
+	if CurrentDRMState, err = handler.GetDRMState(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentDRMState"
+	if out[0].Value, err = soap.MarshalString(string(CurrentDRMState)); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAVTransport2GetStateVariables(ctx context.Context, handler AVTransport2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var StateVariableList string
+	if value, err = soap.FindArg(in, "StateVariableList"); err != nil {
+		return
+	}
+	if StateVariableList, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument StateVariableList: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var StateVariableValuePairs string
+	if StateVariableValuePairs, err = handler.GetStateVariables(ctx, InstanceID, StateVariableList); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "StateVariableValuePairs"
+	if out[0].Value, err = soap.MarshalString(StateVariableValuePairs); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAVTransport2SetStateVariables(ctx context.Context, handler AVTransport2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var AVTransportUDN string
+	if value, err = soap.FindArg(in, "AVTransportUDN"); err != nil {
+		return
+	}
+	if AVTransportUDN, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument AVTransportUDN: "+err.Error())
+	}
+	var ServiceType string
+	if value, err = soap.FindArg(in, "ServiceType"); err != nil {
+		return
+	}
+	if ServiceType, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ServiceType: "+err.Error())
+	}
+	var ServiceId string
+	if value, err = soap.FindArg(in, "ServiceId"); err != nil {
+		return
+	}
+	if ServiceId, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ServiceId: "+err.Error())
+	}
+	var StateVariableValuePairs string
+	if value, err = soap.FindArg(in, "StateVariableValuePairs"); err != nil {
+		return
+	}
+	if StateVariableValuePairs, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument StateVariableValuePairs: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var StateVariableList string
+	if StateVariableList, err = handler.SetStateVariables(ctx, InstanceID, AVTransportUDN, ServiceType, ServiceId, StateVariableValuePairs); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "StateVariableList"
+	if out[0].Value, err = soap.MarshalString(StateVariableList); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+// ConnectionManager1Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:schemas-upnp-org:service:ConnectionManager:1". See RegisterConnectionManager1Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type ConnectionManager1Handler interface {
+	GetProtocolInfo(ctx context.Context) (Source string, Sink string, err error)
+
+	PrepareForConnection(ctx context.Context, RemoteProtocolInfo string, PeerConnectionManager string, PeerConnectionID int32, Direction ConnectionManager1Direction) (ConnectionID int32, AVTransportID int32, RcsID int32, err error)
+
+	ConnectionComplete(ctx context.Context, ConnectionID int32) (err error)
+
+	GetCurrentConnectionIDs(ctx context.Context) (ConnectionIDs string, err error)
+
+	GetCurrentConnectionInfo(ctx context.Context, ConnectionID int32) (RcsID int32, AVTransportID int32, ProtocolInfo string, PeerConnectionManager string, PeerConnectionID int32, Direction ConnectionManager1Direction, Status ConnectionManager1ConnectionStatus, err error)
+}
+
+// RegisterConnectionManager1Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_ConnectionManager_1.
+func RegisterConnectionManager1Handler(svc *device.Service, handler ConnectionManager1Handler) {
+	svc.HandleFunc("GetProtocolInfo", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveConnectionManager1GetProtocolInfo(ctx, handler, in)
+	})
+	svc.HandleFunc("PrepareForConnection", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveConnectionManager1PrepareForConnection(ctx, handler, in)
+	})
+	svc.HandleFunc("ConnectionComplete", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveConnectionManager1ConnectionComplete(ctx, handler, in)
+	})
+	svc.HandleFunc("GetCurrentConnectionIDs", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveConnectionManager1GetCurrentConnectionIDs(ctx, handler, in)
+	})
+	svc.HandleFunc("GetCurrentConnectionInfo", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveConnectionManager1GetCurrentConnectionInfo(ctx, handler, in)
+	})
+}
+
+func serveConnectionManager1GetProtocolInfo(ctx context.Context, handler ConnectionManager1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var Source string
+	var Sink string
+	if Source, Sink, err = handler.GetProtocolInfo(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "Source"
+	if out[0].Value, err = soap.MarshalString(Source); err != nil {
+		return
+	}
+	out[1].Name = "Sink"
+	if out[1].Value, err = soap.MarshalString(Sink); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveConnectionManager1PrepareForConnection(ctx context.Context, handler ConnectionManager1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var RemoteProtocolInfo string
+	if value, err = soap.FindArg(in, "RemoteProtocolInfo"); err != nil {
+		return
+	}
+	if RemoteProtocolInfo, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RemoteProtocolInfo: "+err.Error())
+	}
+	var PeerConnectionManager string
+	if value, err = soap.FindArg(in, "PeerConnectionManager"); err != nil {
+		return
+	}
+	if PeerConnectionManager, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument PeerConnectionManager: "+err.Error())
+	}
+	var PeerConnectionID int32
+	if value, err = soap.FindArg(in, "PeerConnectionID"); err != nil {
+		return
+	}
+	if PeerConnectionID, err = soap.UnmarshalI4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument PeerConnectionID: "+err.Error())
+	}
+	var Direction ConnectionManager1Direction
+	if value, err = soap.FindArg(in, "Direction"); err != nil {
+		return
+	}
+	Direction = ConnectionManager1Direction(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var ConnectionID int32
+	var AVTransportID int32
+	var RcsID int32
+	if ConnectionID, AVTransportID, RcsID, err = handler.PrepareForConnection(ctx, RemoteProtocolInfo, PeerConnectionManager, PeerConnectionID, Direction); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 3)
+
+	out[0].Name = "ConnectionID"
+	if out[0].Value, err = soap.MarshalI4(ConnectionID); err != nil {
+		return
+	}
+	out[1].Name = "AVTransportID"
+	if out[1].Value, err = soap.MarshalI4(AVTransportID); err != nil {
+		return
+	}
+	out[2].Name = "RcsID"
+	if out[2].Value, err = soap.MarshalI4(RcsID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveConnectionManager1ConnectionComplete(ctx context.Context, handler ConnectionManager1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ConnectionID int32
+	if value, err = soap.FindArg(in, "ConnectionID"); err != nil {
+		return
+	}
+	if ConnectionID, err = soap.UnmarshalI4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ConnectionID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.ConnectionComplete(ctx, ConnectionID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveConnectionManager1GetCurrentConnectionIDs(ctx context.Context, handler ConnectionManager1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var ConnectionIDs string
+	if ConnectionIDs, err = handler.GetCurrentConnectionIDs(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "ConnectionIDs"
+	if out[0].Value, err = soap.MarshalString(ConnectionIDs); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveConnectionManager1GetCurrentConnectionInfo(ctx context.Context, handler ConnectionManager1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ConnectionID int32
+	if value, err = soap.FindArg(in, "ConnectionID"); err != nil {
+		return
+	}
+	if ConnectionID, err = soap.UnmarshalI4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ConnectionID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var RcsID int32
+	var AVTransportID int32
+	var ProtocolInfo string
+	var PeerConnectionManager string
+	var PeerConnectionID int32
+	var Direction ConnectionManager1Direction
+	var Status ConnectionManager1ConnectionStatus
+	if RcsID, AVTransportID, ProtocolInfo, PeerConnectionManager, PeerConnectionID, Direction, Status, err = handler.GetCurrentConnectionInfo(ctx, ConnectionID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 7)
+
+	out[0].Name = "RcsID"
+	if out[0].Value, err = soap.MarshalI4(RcsID); err != nil {
+		return
+	}
+	out[1].Name = "AVTransportID"
+	if out[1].Value, err = soap.MarshalI4(AVTransportID); err != nil {
+		return
+	}
+	out[2].Name = "ProtocolInfo"
+	if out[2].Value, err = soap.MarshalString(ProtocolInfo); err != nil {
+		return
+	}
+	out[3].Name = "PeerConnectionManager"
+	if out[3].Value, err = soap.MarshalString(PeerConnectionManager); err != nil {
+		return
+	}
+	out[4].Name = "PeerConnectionID"
+	if out[4].Value, err = soap.MarshalI4(PeerConnectionID); err != nil {
+		return
+	}
+	out[5].Name = "Direction"
+	if out[5].Value, err = soap.MarshalString(string(Direction)); err != nil {
+		return
+	}
+	out[6].Name = "Status"
+	if out[6].Value, err = soap.MarshalString(string(Status)); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+// ConnectionManager2Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:schemas-upnp-org:service:ConnectionManager:2". See RegisterConnectionManager2Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type ConnectionManager2Handler interface {
+	GetProtocolInfo(ctx context.Context) (Source string, Sink string, err error)
+
+	PrepareForConnection(ctx context.Context, RemoteProtocolInfo string, PeerConnectionManager string, PeerConnectionID int32, Direction ConnectionManager2Direction) (ConnectionID int32, AVTransportID int32, RcsID int32, err error)
+
+	ConnectionComplete(ctx context.Context, ConnectionID int32) (err error)
+
+	GetCurrentConnectionIDs(ctx context.Context) (ConnectionIDs string, err error)
+
+	GetCurrentConnectionInfo(ctx context.Context, ConnectionID int32) (RcsID int32, AVTransportID int32, ProtocolInfo string, PeerConnectionManager string, PeerConnectionID int32, Direction ConnectionManager2Direction, Status ConnectionManager2ConnectionStatus, err error)
+}
+
+// RegisterConnectionManager2Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_ConnectionManager_2.
+func RegisterConnectionManager2Handler(svc *device.Service, handler ConnectionManager2Handler) {
+	svc.HandleFunc("GetProtocolInfo", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveConnectionManager2GetProtocolInfo(ctx, handler, in)
+	})
+	svc.HandleFunc("PrepareForConnection", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveConnectionManager2PrepareForConnection(ctx, handler, in)
+	})
+	svc.HandleFunc("ConnectionComplete", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveConnectionManager2ConnectionComplete(ctx, handler, in)
+	})
+	svc.HandleFunc("GetCurrentConnectionIDs", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveConnectionManager2GetCurrentConnectionIDs(ctx, handler, in)
+	})
+	svc.HandleFunc("GetCurrentConnectionInfo", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveConnectionManager2GetCurrentConnectionInfo(ctx, handler, in)
+	})
+}
+
+func serveConnectionManager2GetProtocolInfo(ctx context.Context, handler ConnectionManager2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var Source string
+	var Sink string
+	if Source, Sink, err = handler.GetProtocolInfo(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "Source"
+	if out[0].Value, err = soap.MarshalString(Source); err != nil {
+		return
+	}
+	out[1].Name = "Sink"
+	if out[1].Value, err = soap.MarshalString(Sink); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveConnectionManager2PrepareForConnection(ctx context.Context, handler ConnectionManager2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var RemoteProtocolInfo string
+	if value, err = soap.FindArg(in, "RemoteProtocolInfo"); err != nil {
+		return
+	}
+	if RemoteProtocolInfo, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RemoteProtocolInfo: "+err.Error())
+	}
+	var PeerConnectionManager string
+	if value, err = soap.FindArg(in, "PeerConnectionManager"); err != nil {
+		return
+	}
+	if PeerConnectionManager, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument PeerConnectionManager: "+err.Error())
+	}
+	var PeerConnectionID int32
+	if value, err = soap.FindArg(in, "PeerConnectionID"); err != nil {
+		return
+	}
+	if PeerConnectionID, err = soap.UnmarshalI4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument PeerConnectionID: "+err.Error())
+	}
+	var Direction ConnectionManager2Direction
+	if value, err = soap.FindArg(in, "Direction"); err != nil {
+		return
+	}
+	Direction = ConnectionManager2Direction(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var ConnectionID int32
+	var AVTransportID int32
+	var RcsID int32
+	if ConnectionID, AVTransportID, RcsID, err = handler.PrepareForConnection(ctx, RemoteProtocolInfo, PeerConnectionManager, PeerConnectionID, Direction); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 3)
+
+	out[0].Name = "ConnectionID"
+	if out[0].Value, err = soap.MarshalI4(ConnectionID); err != nil {
+		return
+	}
+	out[1].Name = "AVTransportID"
+	if out[1].Value, err = soap.MarshalI4(AVTransportID); err != nil {
+		return
+	}
+	out[2].Name = "RcsID"
+	if out[2].Value, err = soap.MarshalI4(RcsID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveConnectionManager2ConnectionComplete(ctx context.Context, handler ConnectionManager2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ConnectionID int32
+	if value, err = soap.FindArg(in, "ConnectionID"); err != nil {
+		return
+	}
+	if ConnectionID, err = soap.UnmarshalI4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ConnectionID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.ConnectionComplete(ctx, ConnectionID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveConnectionManager2GetCurrentConnectionIDs(ctx context.Context, handler ConnectionManager2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var ConnectionIDs string
+	if ConnectionIDs, err = handler.GetCurrentConnectionIDs(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "ConnectionIDs"
+	if out[0].Value, err = soap.MarshalString(ConnectionIDs); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveConnectionManager2GetCurrentConnectionInfo(ctx context.Context, handler ConnectionManager2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ConnectionID int32
+	if value, err = soap.FindArg(in, "ConnectionID"); err != nil {
+		return
+	}
+	if ConnectionID, err = soap.UnmarshalI4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ConnectionID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var RcsID int32
+	var AVTransportID int32
+	var ProtocolInfo string
+	var PeerConnectionManager string
+	var PeerConnectionID int32
+	var Direction ConnectionManager2Direction
+	var Status ConnectionManager2ConnectionStatus
+	if RcsID, AVTransportID, ProtocolInfo, PeerConnectionManager, PeerConnectionID, Direction, Status, err = handler.GetCurrentConnectionInfo(ctx, ConnectionID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 7)
+
+	out[0].Name = "RcsID"
+	if out[0].Value, err = soap.MarshalI4(RcsID); err != nil {
+		return
+	}
+	out[1].Name = "AVTransportID"
+	if out[1].Value, err = soap.MarshalI4(AVTransportID); err != nil {
+		return
+	}
+	out[2].Name = "ProtocolInfo"
+	if out[2].Value, err = soap.MarshalString(ProtocolInfo); err != nil {
+		return
+	}
+	out[3].Name = "PeerConnectionManager"
+	if out[3].Value, err = soap.MarshalString(PeerConnectionManager); err != nil {
+		return
+	}
+	out[4].Name = "PeerConnectionID"
+	if out[4].Value, err = soap.MarshalI4(PeerConnectionID); err != nil {
+		return
+	}
+	out[5].Name = "Direction"
+	if out[5].Value, err = soap.MarshalString(string(Direction)); err != nil {
+		return
+	}
+	out[6].Name = "Status"
+	if out[6].Value, err = soap.MarshalString(string(Status)); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+// ContentDirectory1Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:schemas-upnp-org:service:ContentDirectory:1". See RegisterContentDirectory1Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type ContentDirectory1Handler interface {
+	GetSearchCapabilities(ctx context.Context) (SearchCaps string, err error)
+
+	GetSortCapabilities(ctx context.Context) (SortCaps string, err error)
+
+	GetSystemUpdateID(ctx context.Context) (Id uint32, err error)
+
+	Browse(ctx context.Context, ObjectID string, BrowseFlag ContentDirectory1BrowseFlag, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error)
+
+	Search(ctx context.Context, ContainerID string, SearchCriteria string, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error)
+
+	CreateObject(ctx context.Context, ContainerID string, Elements string) (ObjectID string, Result string, err error)
+
+	DestroyObject(ctx context.Context, ObjectID string) (err error)
+
+	UpdateObject(ctx context.Context, ObjectID string, CurrentTagValue string, NewTagValue string) (err error)
+
+	ImportResource(ctx context.Context, SourceURI *url.URL, DestinationURI *url.URL) (TransferID uint32, err error)
+
+	ExportResource(ctx context.Context, SourceURI *url.URL, DestinationURI *url.URL) (TransferID uint32, err error)
+
+	StopTransferResource(ctx context.Context, TransferID uint32) (err error)
+
+	GetTransferProgress(ctx context.Context, TransferID uint32) (TransferStatus ContentDirectory1TransferStatus, TransferLength string, TransferTotal string, err error)
+
+	DeleteResource(ctx context.Context, ResourceURI *url.URL) (err error)
+
+	CreateReference(ctx context.Context, ContainerID string, ObjectID string) (NewID string, err error)
+}
+
+// RegisterContentDirectory1Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_ContentDirectory_1.
+func RegisterContentDirectory1Handler(svc *device.Service, handler ContentDirectory1Handler) {
+	svc.HandleFunc("GetSearchCapabilities", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory1GetSearchCapabilities(ctx, handler, in)
+	})
+	svc.HandleFunc("GetSortCapabilities", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory1GetSortCapabilities(ctx, handler, in)
+	})
+	svc.HandleFunc("GetSystemUpdateID", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory1GetSystemUpdateID(ctx, handler, in)
+	})
+	svc.HandleFunc("Browse", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory1Browse(ctx, handler, in)
+	})
+	svc.HandleFunc("Search", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory1Search(ctx, handler, in)
+	})
+	svc.HandleFunc("CreateObject", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory1CreateObject(ctx, handler, in)
+	})
+	svc.HandleFunc("DestroyObject", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory1DestroyObject(ctx, handler, in)
+	})
+	svc.HandleFunc("UpdateObject", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory1UpdateObject(ctx, handler, in)
+	})
+	svc.HandleFunc("ImportResource", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory1ImportResource(ctx, handler, in)
+	})
+	svc.HandleFunc("ExportResource", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory1ExportResource(ctx, handler, in)
+	})
+	svc.HandleFunc("StopTransferResource", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory1StopTransferResource(ctx, handler, in)
+	})
+	svc.HandleFunc("GetTransferProgress", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory1GetTransferProgress(ctx, handler, in)
+	})
+	svc.HandleFunc("DeleteResource", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory1DeleteResource(ctx, handler, in)
+	})
+	svc.HandleFunc("CreateReference", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory1CreateReference(ctx, handler, in)
+	})
+}
+
+func serveContentDirectory1GetSearchCapabilities(ctx context.Context, handler ContentDirectory1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var SearchCaps string
+	if SearchCaps, err = handler.GetSearchCapabilities(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "SearchCaps"
+	if out[0].Value, err = soap.MarshalString(SearchCaps); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory1GetSortCapabilities(ctx context.Context, handler ContentDirectory1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var SortCaps string
+	if SortCaps, err = handler.GetSortCapabilities(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "SortCaps"
+	if out[0].Value, err = soap.MarshalString(SortCaps); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory1GetSystemUpdateID(ctx context.Context, handler ContentDirectory1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var Id uint32
+	if Id, err = handler.GetSystemUpdateID(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "Id"
+	if out[0].Value, err = soap.MarshalUi4(Id); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory1Browse(ctx context.Context, handler ContentDirectory1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ObjectID string
+	if value, err = soap.FindArg(in, "ObjectID"); err != nil {
+		return
+	}
+	if ObjectID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ObjectID: "+err.Error())
+	}
+	var BrowseFlag ContentDirectory1BrowseFlag
+	if value, err = soap.FindArg(in, "BrowseFlag"); err != nil {
+		return
+	}
+	BrowseFlag = ContentDirectory1BrowseFlag(value)
+	var Filter string
+	if value, err = soap.FindArg(in, "Filter"); err != nil {
+		return
+	}
+	if Filter, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Filter: "+err.Error())
+	}
+	var StartingIndex uint32
+	if value, err = soap.FindArg(in, "StartingIndex"); err != nil {
+		return
+	}
+	if StartingIndex, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument StartingIndex: "+err.Error())
+	}
+	var RequestedCount uint32
+	if value, err = soap.FindArg(in, "RequestedCount"); err != nil {
+		return
+	}
+	if RequestedCount, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RequestedCount: "+err.Error())
+	}
+	var SortCriteria string
+	if value, err = soap.FindArg(in, "SortCriteria"); err != nil {
+		return
+	}
+	if SortCriteria, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument SortCriteria: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var Result string
+	var NumberReturned uint32
+	var TotalMatches uint32
+	var UpdateID uint32
+	if Result, NumberReturned, TotalMatches, UpdateID, err = handler.Browse(ctx, ObjectID, BrowseFlag, Filter, StartingIndex, RequestedCount, SortCriteria); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 4)
+
+	out[0].Name = "Result"
+	if out[0].Value, err = soap.MarshalString(Result); err != nil {
+		return
+	}
+	out[1].Name = "NumberReturned"
+	if out[1].Value, err = soap.MarshalUi4(NumberReturned); err != nil {
+		return
+	}
+	out[2].Name = "TotalMatches"
+	if out[2].Value, err = soap.MarshalUi4(TotalMatches); err != nil {
+		return
+	}
+	out[3].Name = "UpdateID"
+	if out[3].Value, err = soap.MarshalUi4(UpdateID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory1Search(ctx context.Context, handler ContentDirectory1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ContainerID string
+	if value, err = soap.FindArg(in, "ContainerID"); err != nil {
+		return
+	}
+	if ContainerID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ContainerID: "+err.Error())
+	}
+	var SearchCriteria string
+	if value, err = soap.FindArg(in, "SearchCriteria"); err != nil {
+		return
+	}
+	if SearchCriteria, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument SearchCriteria: "+err.Error())
+	}
+	var Filter string
+	if value, err = soap.FindArg(in, "Filter"); err != nil {
+		return
+	}
+	if Filter, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Filter: "+err.Error())
+	}
+	var StartingIndex uint32
+	if value, err = soap.FindArg(in, "StartingIndex"); err != nil {
+		return
+	}
+	if StartingIndex, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument StartingIndex: "+err.Error())
+	}
+	var RequestedCount uint32
+	if value, err = soap.FindArg(in, "RequestedCount"); err != nil {
+		return
+	}
+	if RequestedCount, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RequestedCount: "+err.Error())
+	}
+	var SortCriteria string
+	if value, err = soap.FindArg(in, "SortCriteria"); err != nil {
+		return
+	}
+	if SortCriteria, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument SortCriteria: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var Result string
+	var NumberReturned uint32
+	var TotalMatches uint32
+	var UpdateID uint32
+	if Result, NumberReturned, TotalMatches, UpdateID, err = handler.Search(ctx, ContainerID, SearchCriteria, Filter, StartingIndex, RequestedCount, SortCriteria); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 4)
+
+	out[0].Name = "Result"
+	if out[0].Value, err = soap.MarshalString(Result); err != nil {
+		return
+	}
+	out[1].Name = "NumberReturned"
+	if out[1].Value, err = soap.MarshalUi4(NumberReturned); err != nil {
+		return
+	}
+	out[2].Name = "TotalMatches"
+	if out[2].Value, err = soap.MarshalUi4(TotalMatches); err != nil {
+		return
+	}
+	out[3].Name = "UpdateID"
+	if out[3].Value, err = soap.MarshalUi4(UpdateID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory1CreateObject(ctx context.Context, handler ContentDirectory1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ContainerID string
+	if value, err = soap.FindArg(in, "ContainerID"); err != nil {
+		return
+	}
+	if ContainerID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ContainerID: "+err.Error())
+	}
+	var Elements string
+	if value, err = soap.FindArg(in, "Elements"); err != nil {
+		return
+	}
+	if Elements, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Elements: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var ObjectID string
+	var Result string
+	if ObjectID, Result, err = handler.CreateObject(ctx, ContainerID, Elements); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "ObjectID"
+	if out[0].Value, err = soap.MarshalString(ObjectID); err != nil {
+		return
+	}
+	out[1].Name = "Result"
+	if out[1].Value, err = soap.MarshalString(Result); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory1DestroyObject(ctx context.Context, handler ContentDirectory1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ObjectID string
+	if value, err = soap.FindArg(in, "ObjectID"); err != nil {
+		return
+	}
+	if ObjectID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ObjectID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.DestroyObject(ctx, ObjectID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory1UpdateObject(ctx context.Context, handler ContentDirectory1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ObjectID string
+	if value, err = soap.FindArg(in, "ObjectID"); err != nil {
+		return
+	}
+	if ObjectID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ObjectID: "+err.Error())
+	}
+	var CurrentTagValue string
+	if value, err = soap.FindArg(in, "CurrentTagValue"); err != nil {
+		return
+	}
+	if CurrentTagValue, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument CurrentTagValue: "+err.Error())
+	}
+	var NewTagValue string
+	if value, err = soap.FindArg(in, "NewTagValue"); err != nil {
+		return
+	}
+	if NewTagValue, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewTagValue: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.UpdateObject(ctx, ObjectID, CurrentTagValue, NewTagValue); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory1ImportResource(ctx context.Context, handler ContentDirectory1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var SourceURI *url.URL
+	if value, err = soap.FindArg(in, "SourceURI"); err != nil {
+		return
+	}
+	if SourceURI, err = soap.UnmarshalURI(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument SourceURI: "+err.Error())
+	}
+	var DestinationURI *url.URL
+	if value, err = soap.FindArg(in, "DestinationURI"); err != nil {
+		return
+	}
+	if DestinationURI, err = soap.UnmarshalURI(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DestinationURI: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var TransferID uint32
+	if TransferID, err = handler.ImportResource(ctx, SourceURI, DestinationURI); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "TransferID"
+	if out[0].Value, err = soap.MarshalUi4(TransferID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory1ExportResource(ctx context.Context, handler ContentDirectory1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var SourceURI *url.URL
+	if value, err = soap.FindArg(in, "SourceURI"); err != nil {
+		return
+	}
+	if SourceURI, err = soap.UnmarshalURI(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument SourceURI: "+err.Error())
+	}
+	var DestinationURI *url.URL
+	if value, err = soap.FindArg(in, "DestinationURI"); err != nil {
+		return
+	}
+	if DestinationURI, err = soap.UnmarshalURI(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DestinationURI: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var TransferID uint32
+	if TransferID, err = handler.ExportResource(ctx, SourceURI, DestinationURI); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "TransferID"
+	if out[0].Value, err = soap.MarshalUi4(TransferID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory1StopTransferResource(ctx context.Context, handler ContentDirectory1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var TransferID uint32
+	if value, err = soap.FindArg(in, "TransferID"); err != nil {
+		return
+	}
+	if TransferID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument TransferID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.StopTransferResource(ctx, TransferID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory1GetTransferProgress(ctx context.Context, handler ContentDirectory1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var TransferID uint32
+	if value, err = soap.FindArg(in, "TransferID"); err != nil {
+		return
+	}
+	if TransferID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument TransferID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var TransferStatus ContentDirectory1TransferStatus
+	var TransferLength string
+	var TransferTotal string
+	if TransferStatus, TransferLength, TransferTotal, err = handler.GetTransferProgress(ctx, TransferID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 3)
+
+	out[0].Name = "TransferStatus"
+	if out[0].Value, err = soap.MarshalString(string(TransferStatus)); err != nil {
+		return
+	}
+	out[1].Name = "TransferLength"
+	if out[1].Value, err = soap.MarshalString(TransferLength); err != nil {
+		return
+	}
+	out[2].Name = "TransferTotal"
+	if out[2].Value, err = soap.MarshalString(TransferTotal); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory1DeleteResource(ctx context.Context, handler ContentDirectory1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ResourceURI *url.URL
+	if value, err = soap.FindArg(in, "ResourceURI"); err != nil {
+		return
+	}
+	if ResourceURI, err = soap.UnmarshalURI(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ResourceURI: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.DeleteResource(ctx, ResourceURI); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory1CreateReference(ctx context.Context, handler ContentDirectory1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ContainerID string
+	if value, err = soap.FindArg(in, "ContainerID"); err != nil {
+		return
+	}
+	if ContainerID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ContainerID: "+err.Error())
+	}
+	var ObjectID string
+	if value, err = soap.FindArg(in, "ObjectID"); err != nil {
+		return
+	}
+	if ObjectID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ObjectID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewID string
+	if NewID, err = handler.CreateReference(ctx, ContainerID, ObjectID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewID"
+	if out[0].Value, err = soap.MarshalString(NewID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+// ContentDirectory2Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:schemas-upnp-org:service:ContentDirectory:2". See RegisterContentDirectory2Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type ContentDirectory2Handler interface {
+	GetSearchCapabilities(ctx context.Context) (SearchCaps string, err error)
+
+	GetSortCapabilities(ctx context.Context) (SortCaps string, err error)
+
+	GetSortExtensionCapabilities(ctx context.Context) (SortExtensionCaps string, err error)
+
+	GetFeatureList(ctx context.Context) (FeatureList string, err error)
+
+	GetSystemUpdateID(ctx context.Context) (Id uint32, err error)
+
+	Browse(ctx context.Context, ObjectID string, BrowseFlag ContentDirectory2BrowseFlag, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error)
+
+	Search(ctx context.Context, ContainerID string, SearchCriteria string, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error)
+
+	CreateObject(ctx context.Context, ContainerID string, Elements string) (ObjectID string, Result string, err error)
+
+	DestroyObject(ctx context.Context, ObjectID string) (err error)
+
+	UpdateObject(ctx context.Context, ObjectID string, CurrentTagValue string, NewTagValue string) (err error)
+
+	MoveObject(ctx context.Context, ObjectID string, NewParentID string) (NewObjectID string, err error)
+
+	ImportResource(ctx context.Context, SourceURI *url.URL, DestinationURI *url.URL) (TransferID uint32, err error)
+
+	ExportResource(ctx context.Context, SourceURI *url.URL, DestinationURI *url.URL) (TransferID uint32, err error)
+
+	DeleteResource(ctx context.Context, ResourceURI *url.URL) (err error)
+
+	StopTransferResource(ctx context.Context, TransferID uint32) (err error)
+
+	GetTransferProgress(ctx context.Context, TransferID uint32) (TransferStatus ContentDirectory2TransferStatus, TransferLength string, TransferTotal string, err error)
+
+	CreateReference(ctx context.Context, ContainerID string, ObjectID string) (NewID string, err error)
+}
+
+// RegisterContentDirectory2Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_ContentDirectory_2.
+func RegisterContentDirectory2Handler(svc *device.Service, handler ContentDirectory2Handler) {
+	svc.HandleFunc("GetSearchCapabilities", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory2GetSearchCapabilities(ctx, handler, in)
+	})
+	svc.HandleFunc("GetSortCapabilities", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory2GetSortCapabilities(ctx, handler, in)
+	})
+	svc.HandleFunc("GetSortExtensionCapabilities", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory2GetSortExtensionCapabilities(ctx, handler, in)
+	})
+	svc.HandleFunc("GetFeatureList", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory2GetFeatureList(ctx, handler, in)
+	})
+	svc.HandleFunc("GetSystemUpdateID", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory2GetSystemUpdateID(ctx, handler, in)
+	})
+	svc.HandleFunc("Browse", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory2Browse(ctx, handler, in)
+	})
+	svc.HandleFunc("Search", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory2Search(ctx, handler, in)
+	})
+	svc.HandleFunc("CreateObject", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory2CreateObject(ctx, handler, in)
+	})
+	svc.HandleFunc("DestroyObject", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory2DestroyObject(ctx, handler, in)
+	})
+	svc.HandleFunc("UpdateObject", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory2UpdateObject(ctx, handler, in)
+	})
+	svc.HandleFunc("MoveObject", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory2MoveObject(ctx, handler, in)
+	})
+	svc.HandleFunc("ImportResource", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory2ImportResource(ctx, handler, in)
+	})
+	svc.HandleFunc("ExportResource", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory2ExportResource(ctx, handler, in)
+	})
+	svc.HandleFunc("DeleteResource", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory2DeleteResource(ctx, handler, in)
+	})
+	svc.HandleFunc("StopTransferResource", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory2StopTransferResource(ctx, handler, in)
+	})
+	svc.HandleFunc("GetTransferProgress", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory2GetTransferProgress(ctx, handler, in)
+	})
+	svc.HandleFunc("CreateReference", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory2CreateReference(ctx, handler, in)
+	})
+}
+
+func serveContentDirectory2GetSearchCapabilities(ctx context.Context, handler ContentDirectory2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var SearchCaps string
+	if SearchCaps, err = handler.GetSearchCapabilities(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "SearchCaps"
+	if out[0].Value, err = soap.MarshalString(SearchCaps); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory2GetSortCapabilities(ctx context.Context, handler ContentDirectory2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var SortCaps string
+	if SortCaps, err = handler.GetSortCapabilities(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "SortCaps"
+	if out[0].Value, err = soap.MarshalString(SortCaps); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory2GetSortExtensionCapabilities(ctx context.Context, handler ContentDirectory2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var SortExtensionCaps string
+	if SortExtensionCaps, err = handler.GetSortExtensionCapabilities(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "SortExtensionCaps"
+	if out[0].Value, err = soap.MarshalString(SortExtensionCaps); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory2GetFeatureList(ctx context.Context, handler ContentDirectory2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var FeatureList string
+	if FeatureList, err = handler.GetFeatureList(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "FeatureList"
+	if out[0].Value, err = soap.MarshalString(FeatureList); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory2GetSystemUpdateID(ctx context.Context, handler ContentDirectory2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var Id uint32
+	if Id, err = handler.GetSystemUpdateID(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "Id"
+	if out[0].Value, err = soap.MarshalUi4(Id); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory2Browse(ctx context.Context, handler ContentDirectory2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ObjectID string
+	if value, err = soap.FindArg(in, "ObjectID"); err != nil {
+		return
+	}
+	if ObjectID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ObjectID: "+err.Error())
+	}
+	var BrowseFlag ContentDirectory2BrowseFlag
+	if value, err = soap.FindArg(in, "BrowseFlag"); err != nil {
+		return
+	}
+	BrowseFlag = ContentDirectory2BrowseFlag(value)
+	var Filter string
+	if value, err = soap.FindArg(in, "Filter"); err != nil {
+		return
+	}
+	if Filter, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Filter: "+err.Error())
+	}
+	var StartingIndex uint32
+	if value, err = soap.FindArg(in, "StartingIndex"); err != nil {
+		return
+	}
+	if StartingIndex, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument StartingIndex: "+err.Error())
+	}
+	var RequestedCount uint32
+	if value, err = soap.FindArg(in, "RequestedCount"); err != nil {
+		return
+	}
+	if RequestedCount, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RequestedCount: "+err.Error())
+	}
+	var SortCriteria string
+	if value, err = soap.FindArg(in, "SortCriteria"); err != nil {
+		return
+	}
+	if SortCriteria, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument SortCriteria: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var Result string
+	var NumberReturned uint32
+	var TotalMatches uint32
+	var UpdateID uint32
+	if Result, NumberReturned, TotalMatches, UpdateID, err = handler.Browse(ctx, ObjectID, BrowseFlag, Filter, StartingIndex, RequestedCount, SortCriteria); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 4)
+
+	out[0].Name = "Result"
+	if out[0].Value, err = soap.MarshalString(Result); err != nil {
+		return
+	}
+	out[1].Name = "NumberReturned"
+	if out[1].Value, err = soap.MarshalUi4(NumberReturned); err != nil {
+		return
+	}
+	out[2].Name = "TotalMatches"
+	if out[2].Value, err = soap.MarshalUi4(TotalMatches); err != nil {
+		return
+	}
+	out[3].Name = "UpdateID"
+	if out[3].Value, err = soap.MarshalUi4(UpdateID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory2Search(ctx context.Context, handler ContentDirectory2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ContainerID string
+	if value, err = soap.FindArg(in, "ContainerID"); err != nil {
+		return
+	}
+	if ContainerID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ContainerID: "+err.Error())
+	}
+	var SearchCriteria string
+	if value, err = soap.FindArg(in, "SearchCriteria"); err != nil {
+		return
+	}
+	if SearchCriteria, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument SearchCriteria: "+err.Error())
+	}
+	var Filter string
+	if value, err = soap.FindArg(in, "Filter"); err != nil {
+		return
+	}
+	if Filter, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Filter: "+err.Error())
+	}
+	var StartingIndex uint32
+	if value, err = soap.FindArg(in, "StartingIndex"); err != nil {
+		return
+	}
+	if StartingIndex, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument StartingIndex: "+err.Error())
+	}
+	var RequestedCount uint32
+	if value, err = soap.FindArg(in, "RequestedCount"); err != nil {
+		return
+	}
+	if RequestedCount, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RequestedCount: "+err.Error())
+	}
+	var SortCriteria string
+	if value, err = soap.FindArg(in, "SortCriteria"); err != nil {
+		return
+	}
+	if SortCriteria, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument SortCriteria: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var Result string
+	var NumberReturned uint32
+	var TotalMatches uint32
+	var UpdateID uint32
+	if Result, NumberReturned, TotalMatches, UpdateID, err = handler.Search(ctx, ContainerID, SearchCriteria, Filter, StartingIndex, RequestedCount, SortCriteria); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 4)
+
+	out[0].Name = "Result"
+	if out[0].Value, err = soap.MarshalString(Result); err != nil {
+		return
+	}
+	out[1].Name = "NumberReturned"
+	if out[1].Value, err = soap.MarshalUi4(NumberReturned); err != nil {
+		return
+	}
+	out[2].Name = "TotalMatches"
+	if out[2].Value, err = soap.MarshalUi4(TotalMatches); err != nil {
+		return
+	}
+	out[3].Name = "UpdateID"
+	if out[3].Value, err = soap.MarshalUi4(UpdateID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory2CreateObject(ctx context.Context, handler ContentDirectory2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ContainerID string
+	if value, err = soap.FindArg(in, "ContainerID"); err != nil {
+		return
+	}
+	if ContainerID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ContainerID: "+err.Error())
+	}
+	var Elements string
+	if value, err = soap.FindArg(in, "Elements"); err != nil {
+		return
+	}
+	if Elements, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Elements: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var ObjectID string
+	var Result string
+	if ObjectID, Result, err = handler.CreateObject(ctx, ContainerID, Elements); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "ObjectID"
+	if out[0].Value, err = soap.MarshalString(ObjectID); err != nil {
+		return
+	}
+	out[1].Name = "Result"
+	if out[1].Value, err = soap.MarshalString(Result); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory2DestroyObject(ctx context.Context, handler ContentDirectory2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ObjectID string
+	if value, err = soap.FindArg(in, "ObjectID"); err != nil {
+		return
+	}
+	if ObjectID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ObjectID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.DestroyObject(ctx, ObjectID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory2UpdateObject(ctx context.Context, handler ContentDirectory2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ObjectID string
+	if value, err = soap.FindArg(in, "ObjectID"); err != nil {
+		return
+	}
+	if ObjectID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ObjectID: "+err.Error())
+	}
+	var CurrentTagValue string
+	if value, err = soap.FindArg(in, "CurrentTagValue"); err != nil {
+		return
+	}
+	if CurrentTagValue, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument CurrentTagValue: "+err.Error())
+	}
+	var NewTagValue string
+	if value, err = soap.FindArg(in, "NewTagValue"); err != nil {
+		return
+	}
+	if NewTagValue, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewTagValue: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.UpdateObject(ctx, ObjectID, CurrentTagValue, NewTagValue); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory2MoveObject(ctx context.Context, handler ContentDirectory2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ObjectID string
+	if value, err = soap.FindArg(in, "ObjectID"); err != nil {
+		return
+	}
+	if ObjectID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ObjectID: "+err.Error())
+	}
+	var NewParentID string
+	if value, err = soap.FindArg(in, "NewParentID"); err != nil {
+		return
+	}
+	if NewParentID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewParentID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewObjectID string
+	if NewObjectID, err = handler.MoveObject(ctx, ObjectID, NewParentID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewObjectID"
+	if out[0].Value, err = soap.MarshalString(NewObjectID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory2ImportResource(ctx context.Context, handler ContentDirectory2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var SourceURI *url.URL
+	if value, err = soap.FindArg(in, "SourceURI"); err != nil {
+		return
+	}
+	if SourceURI, err = soap.UnmarshalURI(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument SourceURI: "+err.Error())
+	}
+	var DestinationURI *url.URL
+	if value, err = soap.FindArg(in, "DestinationURI"); err != nil {
+		return
+	}
+	if DestinationURI, err = soap.UnmarshalURI(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DestinationURI: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var TransferID uint32
+	if TransferID, err = handler.ImportResource(ctx, SourceURI, DestinationURI); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "TransferID"
+	if out[0].Value, err = soap.MarshalUi4(TransferID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory2ExportResource(ctx context.Context, handler ContentDirectory2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var SourceURI *url.URL
+	if value, err = soap.FindArg(in, "SourceURI"); err != nil {
+		return
+	}
+	if SourceURI, err = soap.UnmarshalURI(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument SourceURI: "+err.Error())
+	}
+	var DestinationURI *url.URL
+	if value, err = soap.FindArg(in, "DestinationURI"); err != nil {
+		return
+	}
+	if DestinationURI, err = soap.UnmarshalURI(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DestinationURI: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var TransferID uint32
+	if TransferID, err = handler.ExportResource(ctx, SourceURI, DestinationURI); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "TransferID"
+	if out[0].Value, err = soap.MarshalUi4(TransferID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory2DeleteResource(ctx context.Context, handler ContentDirectory2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ResourceURI *url.URL
+	if value, err = soap.FindArg(in, "ResourceURI"); err != nil {
+		return
+	}
+	if ResourceURI, err = soap.UnmarshalURI(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ResourceURI: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.DeleteResource(ctx, ResourceURI); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory2StopTransferResource(ctx context.Context, handler ContentDirectory2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var TransferID uint32
+	if value, err = soap.FindArg(in, "TransferID"); err != nil {
+		return
+	}
+	if TransferID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument TransferID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.StopTransferResource(ctx, TransferID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory2GetTransferProgress(ctx context.Context, handler ContentDirectory2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var TransferID uint32
+	if value, err = soap.FindArg(in, "TransferID"); err != nil {
+		return
+	}
+	if TransferID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument TransferID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var TransferStatus ContentDirectory2TransferStatus
+	var TransferLength string
+	var TransferTotal string
+	if TransferStatus, TransferLength, TransferTotal, err = handler.GetTransferProgress(ctx, TransferID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 3)
+
+	out[0].Name = "TransferStatus"
+	if out[0].Value, err = soap.MarshalString(string(TransferStatus)); err != nil {
+		return
+	}
+	out[1].Name = "TransferLength"
+	if out[1].Value, err = soap.MarshalString(TransferLength); err != nil {
+		return
+	}
+	out[2].Name = "TransferTotal"
+	if out[2].Value, err = soap.MarshalString(TransferTotal); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory2CreateReference(ctx context.Context, handler ContentDirectory2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ContainerID string
+	if value, err = soap.FindArg(in, "ContainerID"); err != nil {
+		return
+	}
+	if ContainerID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ContainerID: "+err.Error())
+	}
+	var ObjectID string
+	if value, err = soap.FindArg(in, "ObjectID"); err != nil {
+		return
+	}
+	if ObjectID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ObjectID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewID string
+	if NewID, err = handler.CreateReference(ctx, ContainerID, ObjectID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewID"
+	if out[0].Value, err = soap.MarshalString(NewID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+// ContentDirectory3Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:schemas-upnp-org:service:ContentDirectory:3". See RegisterContentDirectory3Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type ContentDirectory3Handler interface {
+	GetSearchCapabilities(ctx context.Context) (SearchCaps string, err error)
+
+	GetSortCapabilities(ctx context.Context) (SortCaps string, err error)
+
+	GetSortExtensionCapabilities(ctx context.Context) (SortExtensionCaps string, err error)
+
+	GetFeatureList(ctx context.Context) (FeatureList string, err error)
+
+	GetSystemUpdateID(ctx context.Context) (Id uint32, err error)
+
+	GetServiceResetToken(ctx context.Context) (ResetToken string, err error)
+
+	Browse(ctx context.Context, ObjectID string, BrowseFlag ContentDirectory3BrowseFlag, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error)
+
+	Search(ctx context.Context, ContainerID string, SearchCriteria string, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error)
+
+	CreateObject(ctx context.Context, ContainerID string, Elements string) (ObjectID string, Result string, err error)
+
+	DestroyObject(ctx context.Context, ObjectID string) (err error)
+
+	UpdateObject(ctx context.Context, ObjectID string, CurrentTagValue string, NewTagValue string) (err error)
+
+	MoveObject(ctx context.Context, ObjectID string, NewParentID string) (NewObjectID string, err error)
+
+	ImportResource(ctx context.Context, SourceURI *url.URL, DestinationURI *url.URL) (TransferID uint32, err error)
+
+	ExportResource(ctx context.Context, SourceURI *url.URL, DestinationURI *url.URL) (TransferID uint32, err error)
+
+	DeleteResource(ctx context.Context, ResourceURI *url.URL) (err error)
+
+	StopTransferResource(ctx context.Context, TransferID uint32) (err error)
+
+	GetTransferProgress(ctx context.Context, TransferID uint32) (TransferStatus ContentDirectory3TransferStatus, TransferLength string, TransferTotal string, err error)
+
+	CreateReference(ctx context.Context, ContainerID string, ObjectID string) (NewID string, err error)
+
+	FreeFormQuery(ctx context.Context, ContainerID string, CDSView uint32, QueryRequest string) (QueryResult string, UpdateID uint32, err error)
+
+	GetFreeFormQueryCapabilities(ctx context.Context) (FFQCapabilities string, err error)
+}
+
+// RegisterContentDirectory3Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_ContentDirectory_3.
+func RegisterContentDirectory3Handler(svc *device.Service, handler ContentDirectory3Handler) {
+	svc.HandleFunc("GetSearchCapabilities", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory3GetSearchCapabilities(ctx, handler, in)
+	})
+	svc.HandleFunc("GetSortCapabilities", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory3GetSortCapabilities(ctx, handler, in)
+	})
+	svc.HandleFunc("GetSortExtensionCapabilities", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory3GetSortExtensionCapabilities(ctx, handler, in)
+	})
+	svc.HandleFunc("GetFeatureList", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory3GetFeatureList(ctx, handler, in)
+	})
+	svc.HandleFunc("GetSystemUpdateID", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory3GetSystemUpdateID(ctx, handler, in)
+	})
+	svc.HandleFunc("GetServiceResetToken", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory3GetServiceResetToken(ctx, handler, in)
+	})
+	svc.HandleFunc("Browse", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory3Browse(ctx, handler, in)
+	})
+	svc.HandleFunc("Search", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory3Search(ctx, handler, in)
+	})
+	svc.HandleFunc("CreateObject", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory3CreateObject(ctx, handler, in)
+	})
+	svc.HandleFunc("DestroyObject", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory3DestroyObject(ctx, handler, in)
+	})
+	svc.HandleFunc("UpdateObject", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory3UpdateObject(ctx, handler, in)
+	})
+	svc.HandleFunc("MoveObject", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory3MoveObject(ctx, handler, in)
+	})
+	svc.HandleFunc("ImportResource", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory3ImportResource(ctx, handler, in)
+	})
+	svc.HandleFunc("ExportResource", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory3ExportResource(ctx, handler, in)
+	})
+	svc.HandleFunc("DeleteResource", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory3DeleteResource(ctx, handler, in)
+	})
+	svc.HandleFunc("StopTransferResource", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory3StopTransferResource(ctx, handler, in)
+	})
+	svc.HandleFunc("GetTransferProgress", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory3GetTransferProgress(ctx, handler, in)
+	})
+	svc.HandleFunc("CreateReference", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory3CreateReference(ctx, handler, in)
+	})
+	svc.HandleFunc("FreeFormQuery", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory3FreeFormQuery(ctx, handler, in)
+	})
+	svc.HandleFunc("GetFreeFormQueryCapabilities", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentDirectory3GetFreeFormQueryCapabilities(ctx, handler, in)
+	})
+}
+
+func serveContentDirectory3GetSearchCapabilities(ctx context.Context, handler ContentDirectory3Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var SearchCaps string
+	if SearchCaps, err = handler.GetSearchCapabilities(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "SearchCaps"
+	if out[0].Value, err = soap.MarshalString(SearchCaps); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory3GetSortCapabilities(ctx context.Context, handler ContentDirectory3Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var SortCaps string
+	if SortCaps, err = handler.GetSortCapabilities(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "SortCaps"
+	if out[0].Value, err = soap.MarshalString(SortCaps); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory3GetSortExtensionCapabilities(ctx context.Context, handler ContentDirectory3Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var SortExtensionCaps string
+	if SortExtensionCaps, err = handler.GetSortExtensionCapabilities(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "SortExtensionCaps"
+	if out[0].Value, err = soap.MarshalString(SortExtensionCaps); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory3GetFeatureList(ctx context.Context, handler ContentDirectory3Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var FeatureList string
+	if FeatureList, err = handler.GetFeatureList(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "FeatureList"
+	if out[0].Value, err = soap.MarshalString(FeatureList); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory3GetSystemUpdateID(ctx context.Context, handler ContentDirectory3Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var Id uint32
+	if Id, err = handler.GetSystemUpdateID(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "Id"
+	if out[0].Value, err = soap.MarshalUi4(Id); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory3GetServiceResetToken(ctx context.Context, handler ContentDirectory3Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var ResetToken string
+	if ResetToken, err = handler.GetServiceResetToken(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "ResetToken"
+	if out[0].Value, err = soap.MarshalString(ResetToken); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory3Browse(ctx context.Context, handler ContentDirectory3Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ObjectID string
+	if value, err = soap.FindArg(in, "ObjectID"); err != nil {
+		return
+	}
+	if ObjectID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ObjectID: "+err.Error())
+	}
+	var BrowseFlag ContentDirectory3BrowseFlag
+	if value, err = soap.FindArg(in, "BrowseFlag"); err != nil {
+		return
+	}
+	BrowseFlag = ContentDirectory3BrowseFlag(value)
+	var Filter string
+	if value, err = soap.FindArg(in, "Filter"); err != nil {
+		return
+	}
+	if Filter, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Filter: "+err.Error())
+	}
+	var StartingIndex uint32
+	if value, err = soap.FindArg(in, "StartingIndex"); err != nil {
+		return
+	}
+	if StartingIndex, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument StartingIndex: "+err.Error())
+	}
+	var RequestedCount uint32
+	if value, err = soap.FindArg(in, "RequestedCount"); err != nil {
+		return
+	}
+	if RequestedCount, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RequestedCount: "+err.Error())
+	}
+	var SortCriteria string
+	if value, err = soap.FindArg(in, "SortCriteria"); err != nil {
+		return
+	}
+	if SortCriteria, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument SortCriteria: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var Result string
+	var NumberReturned uint32
+	var TotalMatches uint32
+	var UpdateID uint32
+	if Result, NumberReturned, TotalMatches, UpdateID, err = handler.Browse(ctx, ObjectID, BrowseFlag, Filter, StartingIndex, RequestedCount, SortCriteria); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 4)
+
+	out[0].Name = "Result"
+	if out[0].Value, err = soap.MarshalString(Result); err != nil {
+		return
+	}
+	out[1].Name = "NumberReturned"
+	if out[1].Value, err = soap.MarshalUi4(NumberReturned); err != nil {
+		return
+	}
+	out[2].Name = "TotalMatches"
+	if out[2].Value, err = soap.MarshalUi4(TotalMatches); err != nil {
+		return
+	}
+	out[3].Name = "UpdateID"
+	if out[3].Value, err = soap.MarshalUi4(UpdateID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory3Search(ctx context.Context, handler ContentDirectory3Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ContainerID string
+	if value, err = soap.FindArg(in, "ContainerID"); err != nil {
+		return
+	}
+	if ContainerID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ContainerID: "+err.Error())
+	}
+	var SearchCriteria string
+	if value, err = soap.FindArg(in, "SearchCriteria"); err != nil {
+		return
+	}
+	if SearchCriteria, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument SearchCriteria: "+err.Error())
+	}
+	var Filter string
+	if value, err = soap.FindArg(in, "Filter"); err != nil {
+		return
+	}
+	if Filter, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Filter: "+err.Error())
+	}
+	var StartingIndex uint32
+	if value, err = soap.FindArg(in, "StartingIndex"); err != nil {
+		return
+	}
+	if StartingIndex, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument StartingIndex: "+err.Error())
+	}
+	var RequestedCount uint32
+	if value, err = soap.FindArg(in, "RequestedCount"); err != nil {
+		return
+	}
+	if RequestedCount, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RequestedCount: "+err.Error())
+	}
+	var SortCriteria string
+	if value, err = soap.FindArg(in, "SortCriteria"); err != nil {
+		return
+	}
+	if SortCriteria, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument SortCriteria: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var Result string
+	var NumberReturned uint32
+	var TotalMatches uint32
+	var UpdateID uint32
+	if Result, NumberReturned, TotalMatches, UpdateID, err = handler.Search(ctx, ContainerID, SearchCriteria, Filter, StartingIndex, RequestedCount, SortCriteria); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 4)
+
+	out[0].Name = "Result"
+	if out[0].Value, err = soap.MarshalString(Result); err != nil {
+		return
+	}
+	out[1].Name = "NumberReturned"
+	if out[1].Value, err = soap.MarshalUi4(NumberReturned); err != nil {
+		return
+	}
+	out[2].Name = "TotalMatches"
+	if out[2].Value, err = soap.MarshalUi4(TotalMatches); err != nil {
+		return
+	}
+	out[3].Name = "UpdateID"
+	if out[3].Value, err = soap.MarshalUi4(UpdateID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory3CreateObject(ctx context.Context, handler ContentDirectory3Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ContainerID string
+	if value, err = soap.FindArg(in, "ContainerID"); err != nil {
+		return
+	}
+	if ContainerID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ContainerID: "+err.Error())
+	}
+	var Elements string
+	if value, err = soap.FindArg(in, "Elements"); err != nil {
+		return
+	}
+	if Elements, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Elements: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var ObjectID string
+	var Result string
+	if ObjectID, Result, err = handler.CreateObject(ctx, ContainerID, Elements); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "ObjectID"
+	if out[0].Value, err = soap.MarshalString(ObjectID); err != nil {
+		return
+	}
+	out[1].Name = "Result"
+	if out[1].Value, err = soap.MarshalString(Result); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory3DestroyObject(ctx context.Context, handler ContentDirectory3Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ObjectID string
+	if value, err = soap.FindArg(in, "ObjectID"); err != nil {
+		return
+	}
+	if ObjectID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ObjectID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.DestroyObject(ctx, ObjectID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory3UpdateObject(ctx context.Context, handler ContentDirectory3Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ObjectID string
+	if value, err = soap.FindArg(in, "ObjectID"); err != nil {
+		return
+	}
+	if ObjectID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ObjectID: "+err.Error())
+	}
+	var CurrentTagValue string
+	if value, err = soap.FindArg(in, "CurrentTagValue"); err != nil {
+		return
+	}
+	if CurrentTagValue, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument CurrentTagValue: "+err.Error())
+	}
+	var NewTagValue string
+	if value, err = soap.FindArg(in, "NewTagValue"); err != nil {
+		return
+	}
+	if NewTagValue, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewTagValue: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.UpdateObject(ctx, ObjectID, CurrentTagValue, NewTagValue); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory3MoveObject(ctx context.Context, handler ContentDirectory3Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ObjectID string
+	if value, err = soap.FindArg(in, "ObjectID"); err != nil {
+		return
+	}
+	if ObjectID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ObjectID: "+err.Error())
+	}
+	var NewParentID string
+	if value, err = soap.FindArg(in, "NewParentID"); err != nil {
+		return
+	}
+	if NewParentID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewParentID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewObjectID string
+	if NewObjectID, err = handler.MoveObject(ctx, ObjectID, NewParentID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewObjectID"
+	if out[0].Value, err = soap.MarshalString(NewObjectID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory3ImportResource(ctx context.Context, handler ContentDirectory3Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var SourceURI *url.URL
+	if value, err = soap.FindArg(in, "SourceURI"); err != nil {
+		return
+	}
+	if SourceURI, err = soap.UnmarshalURI(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument SourceURI: "+err.Error())
+	}
+	var DestinationURI *url.URL
+	if value, err = soap.FindArg(in, "DestinationURI"); err != nil {
+		return
+	}
+	if DestinationURI, err = soap.UnmarshalURI(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DestinationURI: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var TransferID uint32
+	if TransferID, err = handler.ImportResource(ctx, SourceURI, DestinationURI); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "TransferID"
+	if out[0].Value, err = soap.MarshalUi4(TransferID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory3ExportResource(ctx context.Context, handler ContentDirectory3Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var SourceURI *url.URL
+	if value, err = soap.FindArg(in, "SourceURI"); err != nil {
+		return
+	}
+	if SourceURI, err = soap.UnmarshalURI(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument SourceURI: "+err.Error())
+	}
+	var DestinationURI *url.URL
+	if value, err = soap.FindArg(in, "DestinationURI"); err != nil {
+		return
+	}
+	if DestinationURI, err = soap.UnmarshalURI(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DestinationURI: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var TransferID uint32
+	if TransferID, err = handler.ExportResource(ctx, SourceURI, DestinationURI); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "TransferID"
+	if out[0].Value, err = soap.MarshalUi4(TransferID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory3DeleteResource(ctx context.Context, handler ContentDirectory3Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ResourceURI *url.URL
+	if value, err = soap.FindArg(in, "ResourceURI"); err != nil {
+		return
+	}
+	if ResourceURI, err = soap.UnmarshalURI(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ResourceURI: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.DeleteResource(ctx, ResourceURI); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory3StopTransferResource(ctx context.Context, handler ContentDirectory3Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var TransferID uint32
+	if value, err = soap.FindArg(in, "TransferID"); err != nil {
+		return
+	}
+	if TransferID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument TransferID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.StopTransferResource(ctx, TransferID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory3GetTransferProgress(ctx context.Context, handler ContentDirectory3Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var TransferID uint32
+	if value, err = soap.FindArg(in, "TransferID"); err != nil {
+		return
+	}
+	if TransferID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument TransferID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var TransferStatus ContentDirectory3TransferStatus
+	var TransferLength string
+	var TransferTotal string
+	if TransferStatus, TransferLength, TransferTotal, err = handler.GetTransferProgress(ctx, TransferID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 3)
+
+	out[0].Name = "TransferStatus"
+	if out[0].Value, err = soap.MarshalString(string(TransferStatus)); err != nil {
+		return
+	}
+	out[1].Name = "TransferLength"
+	if out[1].Value, err = soap.MarshalString(TransferLength); err != nil {
+		return
+	}
+	out[2].Name = "TransferTotal"
+	if out[2].Value, err = soap.MarshalString(TransferTotal); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory3CreateReference(ctx context.Context, handler ContentDirectory3Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ContainerID string
+	if value, err = soap.FindArg(in, "ContainerID"); err != nil {
+		return
+	}
+	if ContainerID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ContainerID: "+err.Error())
+	}
+	var ObjectID string
+	if value, err = soap.FindArg(in, "ObjectID"); err != nil {
+		return
+	}
+	if ObjectID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ObjectID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewID string
+	if NewID, err = handler.CreateReference(ctx, ContainerID, ObjectID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewID"
+	if out[0].Value, err = soap.MarshalString(NewID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory3FreeFormQuery(ctx context.Context, handler ContentDirectory3Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ContainerID string
+	if value, err = soap.FindArg(in, "ContainerID"); err != nil {
+		return
+	}
+	if ContainerID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ContainerID: "+err.Error())
+	}
+	var CDSView uint32
+	if value, err = soap.FindArg(in, "CDSView"); err != nil {
+		return
+	}
+	if CDSView, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument CDSView: "+err.Error())
+	}
+	var QueryRequest string
+	if value, err = soap.FindArg(in, "QueryRequest"); err != nil {
+		return
+	}
+	if QueryRequest, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument QueryRequest: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var QueryResult string
+	var UpdateID uint32
+	if QueryResult, UpdateID, err = handler.FreeFormQuery(ctx, ContainerID, CDSView, QueryRequest); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "QueryResult"
+	if out[0].Value, err = soap.MarshalString(QueryResult); err != nil {
+		return
+	}
+	out[1].Name = "UpdateID"
+	if out[1].Value, err = soap.MarshalUi4(UpdateID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentDirectory3GetFreeFormQueryCapabilities(ctx context.Context, handler ContentDirectory3Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var FFQCapabilities string
+	if FFQCapabilities, err = handler.GetFreeFormQueryCapabilities(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "FFQCapabilities"
+	if out[0].Value, err = soap.MarshalString(FFQCapabilities); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+// RenderingControl1Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:schemas-upnp-org:service:RenderingControl:1". See RegisterRenderingControl1Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type RenderingControl1Handler interface {
+	ListPresets(ctx context.Context, InstanceID uint32) (CurrentPresetNameList string, err error)
+
+	SelectPreset(ctx context.Context, InstanceID uint32, PresetName RenderingControl1PresetName) (err error)
+
+	GetBrightness(ctx context.Context, InstanceID uint32) (CurrentBrightness uint16, err error)
+
+	SetBrightness(ctx context.Context, InstanceID uint32, DesiredBrightness uint16) (err error)
+
+	GetContrast(ctx context.Context, InstanceID uint32) (CurrentContrast uint16, err error)
+
+	SetContrast(ctx context.Context, InstanceID uint32, DesiredContrast uint16) (err error)
+
+	GetSharpness(ctx context.Context, InstanceID uint32) (CurrentSharpness uint16, err error)
+
+	SetSharpness(ctx context.Context, InstanceID uint32, DesiredSharpness uint16) (err error)
+
+	GetRedVideoGain(ctx context.Context, InstanceID uint32) (CurrentRedVideoGain uint16, err error)
+
+	SetRedVideoGain(ctx context.Context, InstanceID uint32, DesiredRedVideoGain uint16) (err error)
+
+	GetGreenVideoGain(ctx context.Context, InstanceID uint32) (CurrentGreenVideoGain uint16, err error)
+
+	SetGreenVideoGain(ctx context.Context, InstanceID uint32, DesiredGreenVideoGain uint16) (err error)
+
+	GetBlueVideoGain(ctx context.Context, InstanceID uint32) (CurrentBlueVideoGain uint16, err error)
+
+	SetBlueVideoGain(ctx context.Context, InstanceID uint32, DesiredBlueVideoGain uint16) (err error)
+
+	GetRedVideoBlackLevel(ctx context.Context, InstanceID uint32) (CurrentRedVideoBlackLevel uint16, err error)
+
+	SetRedVideoBlackLevel(ctx context.Context, InstanceID uint32, DesiredRedVideoBlackLevel uint16) (err error)
+
+	GetGreenVideoBlackLevel(ctx context.Context, InstanceID uint32) (CurrentGreenVideoBlackLevel uint16, err error)
+
+	SetGreenVideoBlackLevel(ctx context.Context, InstanceID uint32, DesiredGreenVideoBlackLevel uint16) (err error)
+
+	GetBlueVideoBlackLevel(ctx context.Context, InstanceID uint32) (CurrentBlueVideoBlackLevel uint16, err error)
+
+	SetBlueVideoBlackLevel(ctx context.Context, InstanceID uint32, DesiredBlueVideoBlackLevel uint16) (err error)
+
+	GetColorTemperature(ctx context.Context, InstanceID uint32) (CurrentColorTemperature uint16, err error)
+
+	SetColorTemperature(ctx context.Context, InstanceID uint32, DesiredColorTemperature uint16) (err error)
+
+	GetHorizontalKeystone(ctx context.Context, InstanceID uint32) (CurrentHorizontalKeystone int16, err error)
+
+	SetHorizontalKeystone(ctx context.Context, InstanceID uint32, DesiredHorizontalKeystone int16) (err error)
+
+	GetVerticalKeystone(ctx context.Context, InstanceID uint32) (CurrentVerticalKeystone int16, err error)
+
+	SetVerticalKeystone(ctx context.Context, InstanceID uint32, DesiredVerticalKeystone int16) (err error)
+
+	GetMute(ctx context.Context, InstanceID uint32, Channel RenderingControl1Channel) (CurrentMute bool, err error)
+
+	SetMute(ctx context.Context, InstanceID uint32, Channel RenderingControl1Channel, DesiredMute bool) (err error)
+
+	GetVolume(ctx context.Context, InstanceID uint32, Channel RenderingControl1Channel) (CurrentVolume uint16, err error)
+
+	SetVolume(ctx context.Context, InstanceID uint32, Channel RenderingControl1Channel, DesiredVolume uint16) (err error)
+
+	GetVolumeDB(ctx context.Context, InstanceID uint32, Channel RenderingControl1Channel) (CurrentVolume int16, err error)
+
+	SetVolumeDB(ctx context.Context, InstanceID uint32, Channel RenderingControl1Channel, DesiredVolume int16) (err error)
+
+	GetVolumeDBRange(ctx context.Context, InstanceID uint32, Channel RenderingControl1Channel) (MinValue int16, MaxValue int16, err error)
+
+	GetLoudness(ctx context.Context, InstanceID uint32, Channel RenderingControl1Channel) (CurrentLoudness bool, err error)
+
+	SetLoudness(ctx context.Context, InstanceID uint32, Channel RenderingControl1Channel, DesiredLoudness bool) (err error)
+}
+
+// RegisterRenderingControl1Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_RenderingControl_1.
+func RegisterRenderingControl1Handler(svc *device.Service, handler RenderingControl1Handler) {
+	svc.HandleFunc("ListPresets", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl1ListPresets(ctx, handler, in)
+	})
+	svc.HandleFunc("SelectPreset", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl1SelectPreset(ctx, handler, in)
+	})
+	svc.HandleFunc("GetBrightness", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl1GetBrightness(ctx, handler, in)
+	})
+	svc.HandleFunc("SetBrightness", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl1SetBrightness(ctx, handler, in)
+	})
+	svc.HandleFunc("GetContrast", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl1GetContrast(ctx, handler, in)
+	})
+	svc.HandleFunc("SetContrast", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl1SetContrast(ctx, handler, in)
+	})
+	svc.HandleFunc("GetSharpness", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl1GetSharpness(ctx, handler, in)
+	})
+	svc.HandleFunc("SetSharpness", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl1SetSharpness(ctx, handler, in)
+	})
+	svc.HandleFunc("GetRedVideoGain", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl1GetRedVideoGain(ctx, handler, in)
+	})
+	svc.HandleFunc("SetRedVideoGain", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl1SetRedVideoGain(ctx, handler, in)
+	})
+	svc.HandleFunc("GetGreenVideoGain", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl1GetGreenVideoGain(ctx, handler, in)
+	})
+	svc.HandleFunc("SetGreenVideoGain", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl1SetGreenVideoGain(ctx, handler, in)
+	})
+	svc.HandleFunc("GetBlueVideoGain", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl1GetBlueVideoGain(ctx, handler, in)
+	})
+	svc.HandleFunc("SetBlueVideoGain", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl1SetBlueVideoGain(ctx, handler, in)
+	})
+	svc.HandleFunc("GetRedVideoBlackLevel", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl1GetRedVideoBlackLevel(ctx, handler, in)
+	})
+	svc.HandleFunc("SetRedVideoBlackLevel", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl1SetRedVideoBlackLevel(ctx, handler, in)
+	})
+	svc.HandleFunc("GetGreenVideoBlackLevel", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl1GetGreenVideoBlackLevel(ctx, handler, in)
+	})
+	svc.HandleFunc("SetGreenVideoBlackLevel", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl1SetGreenVideoBlackLevel(ctx, handler, in)
+	})
+	svc.HandleFunc("GetBlueVideoBlackLevel", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl1GetBlueVideoBlackLevel(ctx, handler, in)
+	})
+	svc.HandleFunc("SetBlueVideoBlackLevel", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl1SetBlueVideoBlackLevel(ctx, handler, in)
+	})
+	svc.HandleFunc("GetColorTemperature", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl1GetColorTemperature(ctx, handler, in)
+	})
+	svc.HandleFunc("SetColorTemperature", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl1SetColorTemperature(ctx, handler, in)
+	})
+	svc.HandleFunc("GetHorizontalKeystone", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl1GetHorizontalKeystone(ctx, handler, in)
+	})
+	svc.HandleFunc("SetHorizontalKeystone", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl1SetHorizontalKeystone(ctx, handler, in)
+	})
+	svc.HandleFunc("GetVerticalKeystone", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl1GetVerticalKeystone(ctx, handler, in)
+	})
+	svc.HandleFunc("SetVerticalKeystone", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl1SetVerticalKeystone(ctx, handler, in)
+	})
+	svc.HandleFunc("GetMute", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl1GetMute(ctx, handler, in)
+	})
+	svc.HandleFunc("SetMute", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl1SetMute(ctx, handler, in)
+	})
+	svc.HandleFunc("GetVolume", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl1GetVolume(ctx, handler, in)
+	})
+	svc.HandleFunc("SetVolume", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl1SetVolume(ctx, handler, in)
+	})
+	svc.HandleFunc("GetVolumeDB", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl1GetVolumeDB(ctx, handler, in)
+	})
+	svc.HandleFunc("SetVolumeDB", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl1SetVolumeDB(ctx, handler, in)
+	})
+	svc.HandleFunc("GetVolumeDBRange", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl1GetVolumeDBRange(ctx, handler, in)
+	})
+	svc.HandleFunc("GetLoudness", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl1GetLoudness(ctx, handler, in)
+	})
+	svc.HandleFunc("SetLoudness", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl1SetLoudness(ctx, handler, in)
+	})
+}
+
+func serveRenderingControl1ListPresets(ctx context.Context, handler RenderingControl1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentPresetNameList string
+	if CurrentPresetNameList, err = handler.ListPresets(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentPresetNameList"
+	if out[0].Value, err = soap.MarshalString(CurrentPresetNameList); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl1SelectPreset(ctx context.Context, handler RenderingControl1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var PresetName RenderingControl1PresetName
+	if value, err = soap.FindArg(in, "PresetName"); err != nil {
+		return
+	}
+	PresetName = RenderingControl1PresetName(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SelectPreset(ctx, InstanceID, PresetName); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl1GetBrightness(ctx context.Context, handler RenderingControl1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentBrightness uint16
+	if CurrentBrightness, err = handler.GetBrightness(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentBrightness"
+	if out[0].Value, err = soap.MarshalUi2(CurrentBrightness); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl1SetBrightness(ctx context.Context, handler RenderingControl1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var DesiredBrightness uint16
+	if value, err = soap.FindArg(in, "DesiredBrightness"); err != nil {
+		return
+	}
+	if DesiredBrightness, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredBrightness: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetBrightness(ctx, InstanceID, DesiredBrightness); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl1GetContrast(ctx context.Context, handler RenderingControl1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentContrast uint16
+	if CurrentContrast, err = handler.GetContrast(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentContrast"
+	if out[0].Value, err = soap.MarshalUi2(CurrentContrast); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl1SetContrast(ctx context.Context, handler RenderingControl1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var DesiredContrast uint16
+	if value, err = soap.FindArg(in, "DesiredContrast"); err != nil {
+		return
+	}
+	if DesiredContrast, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredContrast: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetContrast(ctx, InstanceID, DesiredContrast); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl1GetSharpness(ctx context.Context, handler RenderingControl1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentSharpness uint16
+	if CurrentSharpness, err = handler.GetSharpness(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentSharpness"
+	if out[0].Value, err = soap.MarshalUi2(CurrentSharpness); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl1SetSharpness(ctx context.Context, handler RenderingControl1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var DesiredSharpness uint16
+	if value, err = soap.FindArg(in, "DesiredSharpness"); err != nil {
+		return
+	}
+	if DesiredSharpness, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredSharpness: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetSharpness(ctx, InstanceID, DesiredSharpness); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl1GetRedVideoGain(ctx context.Context, handler RenderingControl1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentRedVideoGain uint16
+	if CurrentRedVideoGain, err = handler.GetRedVideoGain(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentRedVideoGain"
+	if out[0].Value, err = soap.MarshalUi2(CurrentRedVideoGain); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl1SetRedVideoGain(ctx context.Context, handler RenderingControl1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var DesiredRedVideoGain uint16
+	if value, err = soap.FindArg(in, "DesiredRedVideoGain"); err != nil {
+		return
+	}
+	if DesiredRedVideoGain, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredRedVideoGain: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetRedVideoGain(ctx, InstanceID, DesiredRedVideoGain); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl1GetGreenVideoGain(ctx context.Context, handler RenderingControl1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentGreenVideoGain uint16
+	if CurrentGreenVideoGain, err = handler.GetGreenVideoGain(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentGreenVideoGain"
+	if out[0].Value, err = soap.MarshalUi2(CurrentGreenVideoGain); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl1SetGreenVideoGain(ctx context.Context, handler RenderingControl1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var DesiredGreenVideoGain uint16
+	if value, err = soap.FindArg(in, "DesiredGreenVideoGain"); err != nil {
+		return
+	}
+	if DesiredGreenVideoGain, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredGreenVideoGain: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetGreenVideoGain(ctx, InstanceID, DesiredGreenVideoGain); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl1GetBlueVideoGain(ctx context.Context, handler RenderingControl1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentBlueVideoGain uint16
+	if CurrentBlueVideoGain, err = handler.GetBlueVideoGain(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentBlueVideoGain"
+	if out[0].Value, err = soap.MarshalUi2(CurrentBlueVideoGain); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl1SetBlueVideoGain(ctx context.Context, handler RenderingControl1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var DesiredBlueVideoGain uint16
+	if value, err = soap.FindArg(in, "DesiredBlueVideoGain"); err != nil {
+		return
+	}
+	if DesiredBlueVideoGain, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredBlueVideoGain: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetBlueVideoGain(ctx, InstanceID, DesiredBlueVideoGain); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl1GetRedVideoBlackLevel(ctx context.Context, handler RenderingControl1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentRedVideoBlackLevel uint16
+	if CurrentRedVideoBlackLevel, err = handler.GetRedVideoBlackLevel(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentRedVideoBlackLevel"
+	if out[0].Value, err = soap.MarshalUi2(CurrentRedVideoBlackLevel); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl1SetRedVideoBlackLevel(ctx context.Context, handler RenderingControl1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var DesiredRedVideoBlackLevel uint16
+	if value, err = soap.FindArg(in, "DesiredRedVideoBlackLevel"); err != nil {
+		return
+	}
+	if DesiredRedVideoBlackLevel, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredRedVideoBlackLevel: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetRedVideoBlackLevel(ctx, InstanceID, DesiredRedVideoBlackLevel); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl1GetGreenVideoBlackLevel(ctx context.Context, handler RenderingControl1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentGreenVideoBlackLevel uint16
+	if CurrentGreenVideoBlackLevel, err = handler.GetGreenVideoBlackLevel(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentGreenVideoBlackLevel"
+	if out[0].Value, err = soap.MarshalUi2(CurrentGreenVideoBlackLevel); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl1SetGreenVideoBlackLevel(ctx context.Context, handler RenderingControl1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var DesiredGreenVideoBlackLevel uint16
+	if value, err = soap.FindArg(in, "DesiredGreenVideoBlackLevel"); err != nil {
+		return
+	}
+	if DesiredGreenVideoBlackLevel, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredGreenVideoBlackLevel: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetGreenVideoBlackLevel(ctx, InstanceID, DesiredGreenVideoBlackLevel); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl1GetBlueVideoBlackLevel(ctx context.Context, handler RenderingControl1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentBlueVideoBlackLevel uint16
+	if CurrentBlueVideoBlackLevel, err = handler.GetBlueVideoBlackLevel(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentBlueVideoBlackLevel"
+	if out[0].Value, err = soap.MarshalUi2(CurrentBlueVideoBlackLevel); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl1SetBlueVideoBlackLevel(ctx context.Context, handler RenderingControl1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var DesiredBlueVideoBlackLevel uint16
+	if value, err = soap.FindArg(in, "DesiredBlueVideoBlackLevel"); err != nil {
+		return
+	}
+	if DesiredBlueVideoBlackLevel, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredBlueVideoBlackLevel: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetBlueVideoBlackLevel(ctx, InstanceID, DesiredBlueVideoBlackLevel); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl1GetColorTemperature(ctx context.Context, handler RenderingControl1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentColorTemperature uint16
+	if CurrentColorTemperature, err = handler.GetColorTemperature(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentColorTemperature"
+	if out[0].Value, err = soap.MarshalUi2(CurrentColorTemperature); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl1SetColorTemperature(ctx context.Context, handler RenderingControl1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var DesiredColorTemperature uint16
+	if value, err = soap.FindArg(in, "DesiredColorTemperature"); err != nil {
+		return
+	}
+	if DesiredColorTemperature, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredColorTemperature: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetColorTemperature(ctx, InstanceID, DesiredColorTemperature); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl1GetHorizontalKeystone(ctx context.Context, handler RenderingControl1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentHorizontalKeystone int16
+	if CurrentHorizontalKeystone, err = handler.GetHorizontalKeystone(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentHorizontalKeystone"
+	if out[0].Value, err = soap.MarshalI2(CurrentHorizontalKeystone); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl1SetHorizontalKeystone(ctx context.Context, handler RenderingControl1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var DesiredHorizontalKeystone int16
+	if value, err = soap.FindArg(in, "DesiredHorizontalKeystone"); err != nil {
+		return
+	}
+	if DesiredHorizontalKeystone, err = soap.UnmarshalI2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredHorizontalKeystone: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetHorizontalKeystone(ctx, InstanceID, DesiredHorizontalKeystone); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl1GetVerticalKeystone(ctx context.Context, handler RenderingControl1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentVerticalKeystone int16
+	if CurrentVerticalKeystone, err = handler.GetVerticalKeystone(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentVerticalKeystone"
+	if out[0].Value, err = soap.MarshalI2(CurrentVerticalKeystone); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl1SetVerticalKeystone(ctx context.Context, handler RenderingControl1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var DesiredVerticalKeystone int16
+	if value, err = soap.FindArg(in, "DesiredVerticalKeystone"); err != nil {
+		return
+	}
+	if DesiredVerticalKeystone, err = soap.UnmarshalI2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredVerticalKeystone: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetVerticalKeystone(ctx, InstanceID, DesiredVerticalKeystone); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl1GetMute(ctx context.Context, handler RenderingControl1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var Channel RenderingControl1Channel
+	if value, err = soap.FindArg(in, "Channel"); err != nil {
+		return
+	}
+	Channel = RenderingControl1Channel(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentMute bool
+	if CurrentMute, err = handler.GetMute(ctx, InstanceID, Channel); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentMute"
+	if out[0].Value, err = soap.MarshalBoolean(CurrentMute); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl1SetMute(ctx context.Context, handler RenderingControl1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var Channel RenderingControl1Channel
+	if value, err = soap.FindArg(in, "Channel"); err != nil {
+		return
+	}
+	Channel = RenderingControl1Channel(value)
+	var DesiredMute bool
+	if value, err = soap.FindArg(in, "DesiredMute"); err != nil {
+		return
+	}
+	if DesiredMute, err = soap.UnmarshalBoolean(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredMute: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetMute(ctx, InstanceID, Channel, DesiredMute); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl1GetVolume(ctx context.Context, handler RenderingControl1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var Channel RenderingControl1Channel
+	if value, err = soap.FindArg(in, "Channel"); err != nil {
+		return
+	}
+	Channel = RenderingControl1Channel(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentVolume uint16
+	if CurrentVolume, err = handler.GetVolume(ctx, InstanceID, Channel); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentVolume"
+	if out[0].Value, err = soap.MarshalUi2(CurrentVolume); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl1SetVolume(ctx context.Context, handler RenderingControl1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var Channel RenderingControl1Channel
+	if value, err = soap.FindArg(in, "Channel"); err != nil {
+		return
+	}
+	Channel = RenderingControl1Channel(value)
+	var DesiredVolume uint16
+	if value, err = soap.FindArg(in, "DesiredVolume"); err != nil {
+		return
+	}
+	if DesiredVolume, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredVolume: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetVolume(ctx, InstanceID, Channel, DesiredVolume); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl1GetVolumeDB(ctx context.Context, handler RenderingControl1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var Channel RenderingControl1Channel
+	if value, err = soap.FindArg(in, "Channel"); err != nil {
+		return
+	}
+	Channel = RenderingControl1Channel(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentVolume int16
+	if CurrentVolume, err = handler.GetVolumeDB(ctx, InstanceID, Channel); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentVolume"
+	if out[0].Value, err = soap.MarshalI2(CurrentVolume); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl1SetVolumeDB(ctx context.Context, handler RenderingControl1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var Channel RenderingControl1Channel
+	if value, err = soap.FindArg(in, "Channel"); err != nil {
+		return
+	}
+	Channel = RenderingControl1Channel(value)
+	var DesiredVolume int16
+	if value, err = soap.FindArg(in, "DesiredVolume"); err != nil {
+		return
+	}
+	if DesiredVolume, err = soap.UnmarshalI2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredVolume: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetVolumeDB(ctx, InstanceID, Channel, DesiredVolume); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl1GetVolumeDBRange(ctx context.Context, handler RenderingControl1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var Channel RenderingControl1Channel
+	if value, err = soap.FindArg(in, "Channel"); err != nil {
+		return
+	}
+	Channel = RenderingControl1Channel(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var MinValue int16
+	var MaxValue int16
+	if MinValue, MaxValue, err = handler.GetVolumeDBRange(ctx, InstanceID, Channel); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "MinValue"
+	if out[0].Value, err = soap.MarshalI2(MinValue); err != nil {
+		return
+	}
+	out[1].Name = "MaxValue"
+	if out[1].Value, err = soap.MarshalI2(MaxValue); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl1GetLoudness(ctx context.Context, handler RenderingControl1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var Channel RenderingControl1Channel
+	if value, err = soap.FindArg(in, "Channel"); err != nil {
+		return
+	}
+	Channel = RenderingControl1Channel(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentLoudness bool
+	if CurrentLoudness, err = handler.GetLoudness(ctx, InstanceID, Channel); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentLoudness"
+	if out[0].Value, err = soap.MarshalBoolean(CurrentLoudness); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl1SetLoudness(ctx context.Context, handler RenderingControl1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var Channel RenderingControl1Channel
+	if value, err = soap.FindArg(in, "Channel"); err != nil {
+		return
+	}
+	Channel = RenderingControl1Channel(value)
+	var DesiredLoudness bool
+	if value, err = soap.FindArg(in, "DesiredLoudness"); err != nil {
+		return
+	}
+	if DesiredLoudness, err = soap.UnmarshalBoolean(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredLoudness: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetLoudness(ctx, InstanceID, Channel, DesiredLoudness); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+// RenderingControl2Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:schemas-upnp-org:service:RenderingControl:2". See RegisterRenderingControl2Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type RenderingControl2Handler interface {
+	ListPresets(ctx context.Context, InstanceID uint32) (CurrentPresetNameList string, err error)
+
+	SelectPreset(ctx context.Context, InstanceID uint32, PresetName RenderingControl2PresetName) (err error)
+
+	GetBrightness(ctx context.Context, InstanceID uint32) (CurrentBrightness uint16, err error)
+
+	SetBrightness(ctx context.Context, InstanceID uint32, DesiredBrightness uint16) (err error)
+
+	GetContrast(ctx context.Context, InstanceID uint32) (CurrentContrast uint16, err error)
+
+	SetContrast(ctx context.Context, InstanceID uint32, DesiredContrast uint16) (err error)
+
+	GetSharpness(ctx context.Context, InstanceID uint32) (CurrentSharpness uint16, err error)
+
+	SetSharpness(ctx context.Context, InstanceID uint32, DesiredSharpness uint16) (err error)
+
+	GetRedVideoGain(ctx context.Context, InstanceID uint32) (CurrentRedVideoGain uint16, err error)
+
+	SetRedVideoGain(ctx context.Context, InstanceID uint32, DesiredRedVideoGain uint16) (err error)
+
+	GetGreenVideoGain(ctx context.Context, InstanceID uint32) (CurrentGreenVideoGain uint16, err error)
+
+	SetGreenVideoGain(ctx context.Context, InstanceID uint32, DesiredGreenVideoGain uint16) (err error)
+
+	GetBlueVideoGain(ctx context.Context, InstanceID uint32) (CurrentBlueVideoGain uint16, err error)
+
+	SetBlueVideoGain(ctx context.Context, InstanceID uint32, DesiredBlueVideoGain uint16) (err error)
+
+	GetRedVideoBlackLevel(ctx context.Context, InstanceID uint32) (CurrentRedVideoBlackLevel uint16, err error)
+
+	SetRedVideoBlackLevel(ctx context.Context, InstanceID uint32, DesiredRedVideoBlackLevel uint16) (err error)
+
+	GetGreenVideoBlackLevel(ctx context.Context, InstanceID uint32) (CurrentGreenVideoBlackLevel uint16, err error)
+
+	SetGreenVideoBlackLevel(ctx context.Context, InstanceID uint32, DesiredGreenVideoBlackLevel uint16) (err error)
+
+	GetBlueVideoBlackLevel(ctx context.Context, InstanceID uint32) (CurrentBlueVideoBlackLevel uint16, err error)
+
+	SetBlueVideoBlackLevel(ctx context.Context, InstanceID uint32, DesiredBlueVideoBlackLevel uint16) (err error)
+
+	GetColorTemperature(ctx context.Context, InstanceID uint32) (CurrentColorTemperature uint16, err error)
+
+	SetColorTemperature(ctx context.Context, InstanceID uint32, DesiredColorTemperature uint16) (err error)
+
+	GetHorizontalKeystone(ctx context.Context, InstanceID uint32) (CurrentHorizontalKeystone int16, err error)
+
+	SetHorizontalKeystone(ctx context.Context, InstanceID uint32, DesiredHorizontalKeystone int16) (err error)
+
+	GetVerticalKeystone(ctx context.Context, InstanceID uint32) (CurrentVerticalKeystone int16, err error)
+
+	SetVerticalKeystone(ctx context.Context, InstanceID uint32, DesiredVerticalKeystone int16) (err error)
+
+	GetMute(ctx context.Context, InstanceID uint32, Channel RenderingControl2Channel) (CurrentMute bool, err error)
+
+	SetMute(ctx context.Context, InstanceID uint32, Channel RenderingControl2Channel, DesiredMute bool) (err error)
+
+	GetVolume(ctx context.Context, InstanceID uint32, Channel RenderingControl2Channel) (CurrentVolume uint16, err error)
+
+	SetVolume(ctx context.Context, InstanceID uint32, Channel RenderingControl2Channel, DesiredVolume uint16) (err error)
+
+	GetVolumeDB(ctx context.Context, InstanceID uint32, Channel RenderingControl2Channel) (CurrentVolume int16, err error)
+
+	SetVolumeDB(ctx context.Context, InstanceID uint32, Channel RenderingControl2Channel, DesiredVolume int16) (err error)
+
+	GetVolumeDBRange(ctx context.Context, InstanceID uint32, Channel RenderingControl2Channel) (MinValue int16, MaxValue int16, err error)
+
+	GetLoudness(ctx context.Context, InstanceID uint32, Channel RenderingControl2Channel) (CurrentLoudness bool, err error)
+
+	SetLoudness(ctx context.Context, InstanceID uint32, Channel RenderingControl2Channel, DesiredLoudness bool) (err error)
+
+	GetStateVariables(ctx context.Context, InstanceID uint32, StateVariableList string) (StateVariableValuePairs string, err error)
+
+	SetStateVariables(ctx context.Context, InstanceID uint32, RenderingControlUDN string, ServiceType string, ServiceId string, StateVariableValuePairs string) (StateVariableList string, err error)
+}
+
+// RegisterRenderingControl2Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_RenderingControl_2.
+func RegisterRenderingControl2Handler(svc *device.Service, handler RenderingControl2Handler) {
+	svc.HandleFunc("ListPresets", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl2ListPresets(ctx, handler, in)
+	})
+	svc.HandleFunc("SelectPreset", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl2SelectPreset(ctx, handler, in)
+	})
+	svc.HandleFunc("GetBrightness", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl2GetBrightness(ctx, handler, in)
+	})
+	svc.HandleFunc("SetBrightness", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl2SetBrightness(ctx, handler, in)
+	})
+	svc.HandleFunc("GetContrast", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl2GetContrast(ctx, handler, in)
+	})
+	svc.HandleFunc("SetContrast", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl2SetContrast(ctx, handler, in)
+	})
+	svc.HandleFunc("GetSharpness", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl2GetSharpness(ctx, handler, in)
+	})
+	svc.HandleFunc("SetSharpness", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl2SetSharpness(ctx, handler, in)
+	})
+	svc.HandleFunc("GetRedVideoGain", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl2GetRedVideoGain(ctx, handler, in)
+	})
+	svc.HandleFunc("SetRedVideoGain", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl2SetRedVideoGain(ctx, handler, in)
+	})
+	svc.HandleFunc("GetGreenVideoGain", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl2GetGreenVideoGain(ctx, handler, in)
+	})
+	svc.HandleFunc("SetGreenVideoGain", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl2SetGreenVideoGain(ctx, handler, in)
+	})
+	svc.HandleFunc("GetBlueVideoGain", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl2GetBlueVideoGain(ctx, handler, in)
+	})
+	svc.HandleFunc("SetBlueVideoGain", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl2SetBlueVideoGain(ctx, handler, in)
+	})
+	svc.HandleFunc("GetRedVideoBlackLevel", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl2GetRedVideoBlackLevel(ctx, handler, in)
+	})
+	svc.HandleFunc("SetRedVideoBlackLevel", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl2SetRedVideoBlackLevel(ctx, handler, in)
+	})
+	svc.HandleFunc("GetGreenVideoBlackLevel", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl2GetGreenVideoBlackLevel(ctx, handler, in)
+	})
+	svc.HandleFunc("SetGreenVideoBlackLevel", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl2SetGreenVideoBlackLevel(ctx, handler, in)
+	})
+	svc.HandleFunc("GetBlueVideoBlackLevel", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl2GetBlueVideoBlackLevel(ctx, handler, in)
+	})
+	svc.HandleFunc("SetBlueVideoBlackLevel", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl2SetBlueVideoBlackLevel(ctx, handler, in)
+	})
+	svc.HandleFunc("GetColorTemperature", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl2GetColorTemperature(ctx, handler, in)
+	})
+	svc.HandleFunc("SetColorTemperature", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl2SetColorTemperature(ctx, handler, in)
+	})
+	svc.HandleFunc("GetHorizontalKeystone", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl2GetHorizontalKeystone(ctx, handler, in)
+	})
+	svc.HandleFunc("SetHorizontalKeystone", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl2SetHorizontalKeystone(ctx, handler, in)
+	})
+	svc.HandleFunc("GetVerticalKeystone", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl2GetVerticalKeystone(ctx, handler, in)
+	})
+	svc.HandleFunc("SetVerticalKeystone", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl2SetVerticalKeystone(ctx, handler, in)
+	})
+	svc.HandleFunc("GetMute", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl2GetMute(ctx, handler, in)
+	})
+	svc.HandleFunc("SetMute", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl2SetMute(ctx, handler, in)
+	})
+	svc.HandleFunc("GetVolume", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl2GetVolume(ctx, handler, in)
+	})
+	svc.HandleFunc("SetVolume", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl2SetVolume(ctx, handler, in)
+	})
+	svc.HandleFunc("GetVolumeDB", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl2GetVolumeDB(ctx, handler, in)
+	})
+	svc.HandleFunc("SetVolumeDB", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl2SetVolumeDB(ctx, handler, in)
+	})
+	svc.HandleFunc("GetVolumeDBRange", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl2GetVolumeDBRange(ctx, handler, in)
+	})
+	svc.HandleFunc("GetLoudness", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl2GetLoudness(ctx, handler, in)
+	})
+	svc.HandleFunc("SetLoudness", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl2SetLoudness(ctx, handler, in)
+	})
+	svc.HandleFunc("GetStateVariables", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl2GetStateVariables(ctx, handler, in)
+	})
+	svc.HandleFunc("SetStateVariables", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveRenderingControl2SetStateVariables(ctx, handler, in)
+	})
+}
+
+func serveRenderingControl2ListPresets(ctx context.Context, handler RenderingControl2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentPresetNameList string
+	if CurrentPresetNameList, err = handler.ListPresets(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentPresetNameList"
+	if out[0].Value, err = soap.MarshalString(CurrentPresetNameList); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl2SelectPreset(ctx context.Context, handler RenderingControl2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var PresetName RenderingControl2PresetName
+	if value, err = soap.FindArg(in, "PresetName"); err != nil {
+		return
+	}
+	PresetName = RenderingControl2PresetName(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SelectPreset(ctx, InstanceID, PresetName); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl2GetBrightness(ctx context.Context, handler RenderingControl2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentBrightness uint16
+	if CurrentBrightness, err = handler.GetBrightness(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentBrightness"
+	if out[0].Value, err = soap.MarshalUi2(CurrentBrightness); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl2SetBrightness(ctx context.Context, handler RenderingControl2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var DesiredBrightness uint16
+	if value, err = soap.FindArg(in, "DesiredBrightness"); err != nil {
+		return
+	}
+	if DesiredBrightness, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredBrightness: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetBrightness(ctx, InstanceID, DesiredBrightness); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl2GetContrast(ctx context.Context, handler RenderingControl2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentContrast uint16
+	if CurrentContrast, err = handler.GetContrast(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentContrast"
+	if out[0].Value, err = soap.MarshalUi2(CurrentContrast); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl2SetContrast(ctx context.Context, handler RenderingControl2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var DesiredContrast uint16
+	if value, err = soap.FindArg(in, "DesiredContrast"); err != nil {
+		return
+	}
+	if DesiredContrast, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredContrast: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetContrast(ctx, InstanceID, DesiredContrast); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl2GetSharpness(ctx context.Context, handler RenderingControl2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentSharpness uint16
+	if CurrentSharpness, err = handler.GetSharpness(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentSharpness"
+	if out[0].Value, err = soap.MarshalUi2(CurrentSharpness); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl2SetSharpness(ctx context.Context, handler RenderingControl2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var DesiredSharpness uint16
+	if value, err = soap.FindArg(in, "DesiredSharpness"); err != nil {
+		return
+	}
+	if DesiredSharpness, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredSharpness: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetSharpness(ctx, InstanceID, DesiredSharpness); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl2GetRedVideoGain(ctx context.Context, handler RenderingControl2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentRedVideoGain uint16
+	if CurrentRedVideoGain, err = handler.GetRedVideoGain(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentRedVideoGain"
+	if out[0].Value, err = soap.MarshalUi2(CurrentRedVideoGain); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl2SetRedVideoGain(ctx context.Context, handler RenderingControl2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var DesiredRedVideoGain uint16
+	if value, err = soap.FindArg(in, "DesiredRedVideoGain"); err != nil {
+		return
+	}
+	if DesiredRedVideoGain, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredRedVideoGain: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetRedVideoGain(ctx, InstanceID, DesiredRedVideoGain); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl2GetGreenVideoGain(ctx context.Context, handler RenderingControl2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentGreenVideoGain uint16
+	if CurrentGreenVideoGain, err = handler.GetGreenVideoGain(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentGreenVideoGain"
+	if out[0].Value, err = soap.MarshalUi2(CurrentGreenVideoGain); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl2SetGreenVideoGain(ctx context.Context, handler RenderingControl2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var DesiredGreenVideoGain uint16
+	if value, err = soap.FindArg(in, "DesiredGreenVideoGain"); err != nil {
+		return
+	}
+	if DesiredGreenVideoGain, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredGreenVideoGain: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetGreenVideoGain(ctx, InstanceID, DesiredGreenVideoGain); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl2GetBlueVideoGain(ctx context.Context, handler RenderingControl2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentBlueVideoGain uint16
+	if CurrentBlueVideoGain, err = handler.GetBlueVideoGain(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentBlueVideoGain"
+	if out[0].Value, err = soap.MarshalUi2(CurrentBlueVideoGain); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl2SetBlueVideoGain(ctx context.Context, handler RenderingControl2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var DesiredBlueVideoGain uint16
+	if value, err = soap.FindArg(in, "DesiredBlueVideoGain"); err != nil {
+		return
+	}
+	if DesiredBlueVideoGain, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredBlueVideoGain: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetBlueVideoGain(ctx, InstanceID, DesiredBlueVideoGain); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl2GetRedVideoBlackLevel(ctx context.Context, handler RenderingControl2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentRedVideoBlackLevel uint16
+	if CurrentRedVideoBlackLevel, err = handler.GetRedVideoBlackLevel(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentRedVideoBlackLevel"
+	if out[0].Value, err = soap.MarshalUi2(CurrentRedVideoBlackLevel); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl2SetRedVideoBlackLevel(ctx context.Context, handler RenderingControl2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var DesiredRedVideoBlackLevel uint16
+	if value, err = soap.FindArg(in, "DesiredRedVideoBlackLevel"); err != nil {
+		return
+	}
+	if DesiredRedVideoBlackLevel, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredRedVideoBlackLevel: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetRedVideoBlackLevel(ctx, InstanceID, DesiredRedVideoBlackLevel); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl2GetGreenVideoBlackLevel(ctx context.Context, handler RenderingControl2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentGreenVideoBlackLevel uint16
+	if CurrentGreenVideoBlackLevel, err = handler.GetGreenVideoBlackLevel(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentGreenVideoBlackLevel"
+	if out[0].Value, err = soap.MarshalUi2(CurrentGreenVideoBlackLevel); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl2SetGreenVideoBlackLevel(ctx context.Context, handler RenderingControl2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var DesiredGreenVideoBlackLevel uint16
+	if value, err = soap.FindArg(in, "DesiredGreenVideoBlackLevel"); err != nil {
+		return
+	}
+	if DesiredGreenVideoBlackLevel, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredGreenVideoBlackLevel: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetGreenVideoBlackLevel(ctx, InstanceID, DesiredGreenVideoBlackLevel); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl2GetBlueVideoBlackLevel(ctx context.Context, handler RenderingControl2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentBlueVideoBlackLevel uint16
+	if CurrentBlueVideoBlackLevel, err = handler.GetBlueVideoBlackLevel(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentBlueVideoBlackLevel"
+	if out[0].Value, err = soap.MarshalUi2(CurrentBlueVideoBlackLevel); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl2SetBlueVideoBlackLevel(ctx context.Context, handler RenderingControl2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var DesiredBlueVideoBlackLevel uint16
+	if value, err = soap.FindArg(in, "DesiredBlueVideoBlackLevel"); err != nil {
+		return
+	}
+	if DesiredBlueVideoBlackLevel, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredBlueVideoBlackLevel: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetBlueVideoBlackLevel(ctx, InstanceID, DesiredBlueVideoBlackLevel); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl2GetColorTemperature(ctx context.Context, handler RenderingControl2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentColorTemperature uint16
+	if CurrentColorTemperature, err = handler.GetColorTemperature(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentColorTemperature"
+	if out[0].Value, err = soap.MarshalUi2(CurrentColorTemperature); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl2SetColorTemperature(ctx context.Context, handler RenderingControl2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var DesiredColorTemperature uint16
+	if value, err = soap.FindArg(in, "DesiredColorTemperature"); err != nil {
+		return
+	}
+	if DesiredColorTemperature, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredColorTemperature: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetColorTemperature(ctx, InstanceID, DesiredColorTemperature); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl2GetHorizontalKeystone(ctx context.Context, handler RenderingControl2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentHorizontalKeystone int16
+	if CurrentHorizontalKeystone, err = handler.GetHorizontalKeystone(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentHorizontalKeystone"
+	if out[0].Value, err = soap.MarshalI2(CurrentHorizontalKeystone); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl2SetHorizontalKeystone(ctx context.Context, handler RenderingControl2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var DesiredHorizontalKeystone int16
+	if value, err = soap.FindArg(in, "DesiredHorizontalKeystone"); err != nil {
+		return
+	}
+	if DesiredHorizontalKeystone, err = soap.UnmarshalI2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredHorizontalKeystone: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetHorizontalKeystone(ctx, InstanceID, DesiredHorizontalKeystone); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl2GetVerticalKeystone(ctx context.Context, handler RenderingControl2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentVerticalKeystone int16
+	if CurrentVerticalKeystone, err = handler.GetVerticalKeystone(ctx, InstanceID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentVerticalKeystone"
+	if out[0].Value, err = soap.MarshalI2(CurrentVerticalKeystone); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl2SetVerticalKeystone(ctx context.Context, handler RenderingControl2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var DesiredVerticalKeystone int16
+	if value, err = soap.FindArg(in, "DesiredVerticalKeystone"); err != nil {
+		return
+	}
+	if DesiredVerticalKeystone, err = soap.UnmarshalI2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredVerticalKeystone: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetVerticalKeystone(ctx, InstanceID, DesiredVerticalKeystone); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl2GetMute(ctx context.Context, handler RenderingControl2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var Channel RenderingControl2Channel
+	if value, err = soap.FindArg(in, "Channel"); err != nil {
+		return
+	}
+	Channel = RenderingControl2Channel(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentMute bool
+	if CurrentMute, err = handler.GetMute(ctx, InstanceID, Channel); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentMute"
+	if out[0].Value, err = soap.MarshalBoolean(CurrentMute); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl2SetMute(ctx context.Context, handler RenderingControl2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var Channel RenderingControl2Channel
+	if value, err = soap.FindArg(in, "Channel"); err != nil {
+		return
+	}
+	Channel = RenderingControl2Channel(value)
+	var DesiredMute bool
+	if value, err = soap.FindArg(in, "DesiredMute"); err != nil {
+		return
+	}
+	if DesiredMute, err = soap.UnmarshalBoolean(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredMute: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetMute(ctx, InstanceID, Channel, DesiredMute); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl2GetVolume(ctx context.Context, handler RenderingControl2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var Channel RenderingControl2Channel
+	if value, err = soap.FindArg(in, "Channel"); err != nil {
+		return
+	}
+	Channel = RenderingControl2Channel(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentVolume uint16
+	if CurrentVolume, err = handler.GetVolume(ctx, InstanceID, Channel); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentVolume"
+	if out[0].Value, err = soap.MarshalUi2(CurrentVolume); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl2SetVolume(ctx context.Context, handler RenderingControl2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var Channel RenderingControl2Channel
+	if value, err = soap.FindArg(in, "Channel"); err != nil {
+		return
+	}
+	Channel = RenderingControl2Channel(value)
+	var DesiredVolume uint16
+	if value, err = soap.FindArg(in, "DesiredVolume"); err != nil {
+		return
+	}
+	if DesiredVolume, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredVolume: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetVolume(ctx, InstanceID, Channel, DesiredVolume); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl2GetVolumeDB(ctx context.Context, handler RenderingControl2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var Channel RenderingControl2Channel
+	if value, err = soap.FindArg(in, "Channel"); err != nil {
+		return
+	}
+	Channel = RenderingControl2Channel(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentVolume int16
+	if CurrentVolume, err = handler.GetVolumeDB(ctx, InstanceID, Channel); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentVolume"
+	if out[0].Value, err = soap.MarshalI2(CurrentVolume); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl2SetVolumeDB(ctx context.Context, handler RenderingControl2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var Channel RenderingControl2Channel
+	if value, err = soap.FindArg(in, "Channel"); err != nil {
+		return
+	}
+	Channel = RenderingControl2Channel(value)
+	var DesiredVolume int16
+	if value, err = soap.FindArg(in, "DesiredVolume"); err != nil {
+		return
+	}
+	if DesiredVolume, err = soap.UnmarshalI2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredVolume: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetVolumeDB(ctx, InstanceID, Channel, DesiredVolume); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl2GetVolumeDBRange(ctx context.Context, handler RenderingControl2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var Channel RenderingControl2Channel
+	if value, err = soap.FindArg(in, "Channel"); err != nil {
+		return
+	}
+	Channel = RenderingControl2Channel(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var MinValue int16
+	var MaxValue int16
+	if MinValue, MaxValue, err = handler.GetVolumeDBRange(ctx, InstanceID, Channel); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "MinValue"
+	if out[0].Value, err = soap.MarshalI2(MinValue); err != nil {
+		return
+	}
+	out[1].Name = "MaxValue"
+	if out[1].Value, err = soap.MarshalI2(MaxValue); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl2GetLoudness(ctx context.Context, handler RenderingControl2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var Channel RenderingControl2Channel
+	if value, err = soap.FindArg(in, "Channel"); err != nil {
+		return
+	}
+	Channel = RenderingControl2Channel(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentLoudness bool
+	if CurrentLoudness, err = handler.GetLoudness(ctx, InstanceID, Channel); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentLoudness"
+	if out[0].Value, err = soap.MarshalBoolean(CurrentLoudness); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl2SetLoudness(ctx context.Context, handler RenderingControl2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var Channel RenderingControl2Channel
+	if value, err = soap.FindArg(in, "Channel"); err != nil {
+		return
+	}
+	Channel = RenderingControl2Channel(value)
+	var DesiredLoudness bool
+	if value, err = soap.FindArg(in, "DesiredLoudness"); err != nil {
+		return
+	}
+	if DesiredLoudness, err = soap.UnmarshalBoolean(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredLoudness: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetLoudness(ctx, InstanceID, Channel, DesiredLoudness); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl2GetStateVariables(ctx context.Context, handler RenderingControl2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var StateVariableList string
+	if value, err = soap.FindArg(in, "StateVariableList"); err != nil {
+		return
+	}
+	if StateVariableList, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument StateVariableList: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var StateVariableValuePairs string
+	if StateVariableValuePairs, err = handler.GetStateVariables(ctx, InstanceID, StateVariableList); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "StateVariableValuePairs"
+	if out[0].Value, err = soap.MarshalString(StateVariableValuePairs); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveRenderingControl2SetStateVariables(ctx context.Context, handler RenderingControl2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var InstanceID uint32
+	if value, err = soap.FindArg(in, "InstanceID"); err != nil {
+		return
+	}
+	if InstanceID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InstanceID: "+err.Error())
+	}
+	var RenderingControlUDN string
+	if value, err = soap.FindArg(in, "RenderingControlUDN"); err != nil {
+		return
+	}
+	if RenderingControlUDN, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RenderingControlUDN: "+err.Error())
+	}
+	var ServiceType string
+	if value, err = soap.FindArg(in, "ServiceType"); err != nil {
+		return
+	}
+	if ServiceType, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ServiceType: "+err.Error())
+	}
+	var ServiceId string
+	if value, err = soap.FindArg(in, "ServiceId"); err != nil {
+		return
+	}
+	if ServiceId, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ServiceId: "+err.Error())
+	}
+	var StateVariableValuePairs string
+	if value, err = soap.FindArg(in, "StateVariableValuePairs"); err != nil {
+		return
+	}
+	if StateVariableValuePairs, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument StateVariableValuePairs: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var StateVariableList string
+	if StateVariableList, err = handler.SetStateVariables(ctx, InstanceID, RenderingControlUDN, ServiceType, ServiceId, StateVariableValuePairs); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "StateVariableList"
+	if out[0].Value, err = soap.MarshalString(StateVariableList); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+// ScheduledRecording1Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:schemas-upnp-org:service:ScheduledRecording:1". See RegisterScheduledRecording1Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type ScheduledRecording1Handler interface {
+	GetSortCapabilities(ctx context.Context) (SortCaps string, SortLevelCap uint32, err error)
+
+	GetPropertyList(ctx context.Context, DataTypeID ScheduledRecording1DataTypeID) (PropertyList string, err error)
+
+	GetAllowedValues(ctx context.Context, DataTypeID ScheduledRecording1DataTypeID, Filter string) (PropertyInfo string, err error)
+
+	GetStateUpdateID(ctx context.Context) (Id uint32, err error)
+
+	BrowseRecordSchedules(ctx context.Context, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error)
+
+	BrowseRecordTasks(ctx context.Context, RecordScheduleID string, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error)
+
+	CreateRecordSchedule(ctx context.Context, Elements string) (RecordScheduleID string, Result string, UpdateID uint32, err error)
+
+	DeleteRecordSchedule(ctx context.Context, RecordScheduleID string) (err error)
+
+	GetRecordSchedule(ctx context.Context, RecordScheduleID string, Filter string) (Result string, UpdateID uint32, err error)
+
+	EnableRecordSchedule(ctx context.Context, RecordScheduleID string) (err error)
+
+	DisableRecordSchedule(ctx context.Context, RecordScheduleID string) (err error)
+
+	DeleteRecordTask(ctx context.Context, RecordTaskID string) (err error)
+
+	GetRecordTask(ctx context.Context, RecordTaskID string, Filter string) (Result string, UpdateID uint32, err error)
+
+	EnableRecordTask(ctx context.Context, RecordTaskID string) (err error)
+
+	DisableRecordTask(ctx context.Context, RecordTaskID string) (err error)
+
+	ResetRecordTask(ctx context.Context, RecordTaskID string) (err error)
+
+	GetRecordScheduleConflicts(ctx context.Context, RecordScheduleID string) (RecordScheduleConflictIDList string, UpdateID uint32, err error)
+
+	GetRecordTaskConflicts(ctx context.Context, RecordTaskID string) (RecordTaskConflictIDList string, UpdateID uint32, err error)
+}
+
+// RegisterScheduledRecording1Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_ScheduledRecording_1.
+func RegisterScheduledRecording1Handler(svc *device.Service, handler ScheduledRecording1Handler) {
+	svc.HandleFunc("GetSortCapabilities", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveScheduledRecording1GetSortCapabilities(ctx, handler, in)
+	})
+	svc.HandleFunc("GetPropertyList", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveScheduledRecording1GetPropertyList(ctx, handler, in)
+	})
+	svc.HandleFunc("GetAllowedValues", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveScheduledRecording1GetAllowedValues(ctx, handler, in)
+	})
+	svc.HandleFunc("GetStateUpdateID", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveScheduledRecording1GetStateUpdateID(ctx, handler, in)
+	})
+	svc.HandleFunc("BrowseRecordSchedules", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveScheduledRecording1BrowseRecordSchedules(ctx, handler, in)
+	})
+	svc.HandleFunc("BrowseRecordTasks", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveScheduledRecording1BrowseRecordTasks(ctx, handler, in)
+	})
+	svc.HandleFunc("CreateRecordSchedule", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveScheduledRecording1CreateRecordSchedule(ctx, handler, in)
+	})
+	svc.HandleFunc("DeleteRecordSchedule", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveScheduledRecording1DeleteRecordSchedule(ctx, handler, in)
+	})
+	svc.HandleFunc("GetRecordSchedule", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveScheduledRecording1GetRecordSchedule(ctx, handler, in)
+	})
+	svc.HandleFunc("EnableRecordSchedule", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveScheduledRecording1EnableRecordSchedule(ctx, handler, in)
+	})
+	svc.HandleFunc("DisableRecordSchedule", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveScheduledRecording1DisableRecordSchedule(ctx, handler, in)
+	})
+	svc.HandleFunc("DeleteRecordTask", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveScheduledRecording1DeleteRecordTask(ctx, handler, in)
+	})
+	svc.HandleFunc("GetRecordTask", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveScheduledRecording1GetRecordTask(ctx, handler, in)
+	})
+	svc.HandleFunc("EnableRecordTask", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveScheduledRecording1EnableRecordTask(ctx, handler, in)
+	})
+	svc.HandleFunc("DisableRecordTask", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveScheduledRecording1DisableRecordTask(ctx, handler, in)
+	})
+	svc.HandleFunc("ResetRecordTask", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveScheduledRecording1ResetRecordTask(ctx, handler, in)
+	})
+	svc.HandleFunc("GetRecordScheduleConflicts", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveScheduledRecording1GetRecordScheduleConflicts(ctx, handler, in)
+	})
+	svc.HandleFunc("GetRecordTaskConflicts", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveScheduledRecording1GetRecordTaskConflicts(ctx, handler, in)
+	})
+}
+
+func serveScheduledRecording1GetSortCapabilities(ctx context.Context, handler ScheduledRecording1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var SortCaps string
+	var SortLevelCap uint32
+	if SortCaps, SortLevelCap, err = handler.GetSortCapabilities(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "SortCaps"
+	if out[0].Value, err = soap.MarshalString(SortCaps); err != nil {
+		return
+	}
+	out[1].Name = "SortLevelCap"
+	if out[1].Value, err = soap.MarshalUi4(SortLevelCap); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveScheduledRecording1GetPropertyList(ctx context.Context, handler ScheduledRecording1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var DataTypeID ScheduledRecording1DataTypeID
+	if value, err = soap.FindArg(in, "DataTypeID"); err != nil {
+		return
+	}
+	DataTypeID = ScheduledRecording1DataTypeID(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var PropertyList string
+	if PropertyList, err = handler.GetPropertyList(ctx, DataTypeID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "PropertyList"
+	if out[0].Value, err = soap.MarshalString(PropertyList); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveScheduledRecording1GetAllowedValues(ctx context.Context, handler ScheduledRecording1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var DataTypeID ScheduledRecording1DataTypeID
+	if value, err = soap.FindArg(in, "DataTypeID"); err != nil {
+		return
+	}
+	DataTypeID = ScheduledRecording1DataTypeID(value)
+	var Filter string
+	if value, err = soap.FindArg(in, "Filter"); err != nil {
+		return
+	}
+	if Filter, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Filter: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var PropertyInfo string
+	if PropertyInfo, err = handler.GetAllowedValues(ctx, DataTypeID, Filter); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "PropertyInfo"
+	if out[0].Value, err = soap.MarshalString(PropertyInfo); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveScheduledRecording1GetStateUpdateID(ctx context.Context, handler ScheduledRecording1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var Id uint32
+	if Id, err = handler.GetStateUpdateID(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "Id"
+	if out[0].Value, err = soap.MarshalUi4(Id); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveScheduledRecording1BrowseRecordSchedules(ctx context.Context, handler ScheduledRecording1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var Filter string
+	if value, err = soap.FindArg(in, "Filter"); err != nil {
+		return
+	}
+	if Filter, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Filter: "+err.Error())
+	}
+	var StartingIndex uint32
+	if value, err = soap.FindArg(in, "StartingIndex"); err != nil {
+		return
+	}
+	if StartingIndex, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument StartingIndex: "+err.Error())
+	}
+	var RequestedCount uint32
+	if value, err = soap.FindArg(in, "RequestedCount"); err != nil {
+		return
+	}
+	if RequestedCount, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RequestedCount: "+err.Error())
+	}
+	var SortCriteria string
+	if value, err = soap.FindArg(in, "SortCriteria"); err != nil {
+		return
+	}
+	if SortCriteria, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument SortCriteria: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var Result string
+	var NumberReturned uint32
+	var TotalMatches uint32
+	var UpdateID uint32
+	if Result, NumberReturned, TotalMatches, UpdateID, err = handler.BrowseRecordSchedules(ctx, Filter, StartingIndex, RequestedCount, SortCriteria); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 4)
+
+	out[0].Name = "Result"
+	if out[0].Value, err = soap.MarshalString(Result); err != nil {
+		return
+	}
+	out[1].Name = "NumberReturned"
+	if out[1].Value, err = soap.MarshalUi4(NumberReturned); err != nil {
+		return
+	}
+	out[2].Name = "TotalMatches"
+	if out[2].Value, err = soap.MarshalUi4(TotalMatches); err != nil {
+		return
+	}
+	out[3].Name = "UpdateID"
+	if out[3].Value, err = soap.MarshalUi4(UpdateID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveScheduledRecording1BrowseRecordTasks(ctx context.Context, handler ScheduledRecording1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var RecordScheduleID string
+	if value, err = soap.FindArg(in, "RecordScheduleID"); err != nil {
+		return
+	}
+	if RecordScheduleID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RecordScheduleID: "+err.Error())
+	}
+	var Filter string
+	if value, err = soap.FindArg(in, "Filter"); err != nil {
+		return
+	}
+	if Filter, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Filter: "+err.Error())
+	}
+	var StartingIndex uint32
+	if value, err = soap.FindArg(in, "StartingIndex"); err != nil {
+		return
+	}
+	if StartingIndex, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument StartingIndex: "+err.Error())
+	}
+	var RequestedCount uint32
+	if value, err = soap.FindArg(in, "RequestedCount"); err != nil {
+		return
+	}
+	if RequestedCount, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RequestedCount: "+err.Error())
+	}
+	var SortCriteria string
+	if value, err = soap.FindArg(in, "SortCriteria"); err != nil {
+		return
+	}
+	if SortCriteria, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument SortCriteria: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var Result string
+	var NumberReturned uint32
+	var TotalMatches uint32
+	var UpdateID uint32
+	if Result, NumberReturned, TotalMatches, UpdateID, err = handler.BrowseRecordTasks(ctx, RecordScheduleID, Filter, StartingIndex, RequestedCount, SortCriteria); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 4)
+
+	out[0].Name = "Result"
+	if out[0].Value, err = soap.MarshalString(Result); err != nil {
+		return
+	}
+	out[1].Name = "NumberReturned"
+	if out[1].Value, err = soap.MarshalUi4(NumberReturned); err != nil {
+		return
+	}
+	out[2].Name = "TotalMatches"
+	if out[2].Value, err = soap.MarshalUi4(TotalMatches); err != nil {
+		return
+	}
+	out[3].Name = "UpdateID"
+	if out[3].Value, err = soap.MarshalUi4(UpdateID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveScheduledRecording1CreateRecordSchedule(ctx context.Context, handler ScheduledRecording1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var Elements string
+	if value, err = soap.FindArg(in, "Elements"); err != nil {
+		return
+	}
+	if Elements, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Elements: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var RecordScheduleID string
+	var Result string
+	var UpdateID uint32
+	if RecordScheduleID, Result, UpdateID, err = handler.CreateRecordSchedule(ctx, Elements); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 3)
+
+	out[0].Name = "RecordScheduleID"
+	if out[0].Value, err = soap.MarshalString(RecordScheduleID); err != nil {
+		return
+	}
+	out[1].Name = "Result"
+	if out[1].Value, err = soap.MarshalString(Result); err != nil {
+		return
+	}
+	out[2].Name = "UpdateID"
+	if out[2].Value, err = soap.MarshalUi4(UpdateID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveScheduledRecording1DeleteRecordSchedule(ctx context.Context, handler ScheduledRecording1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var RecordScheduleID string
+	if value, err = soap.FindArg(in, "RecordScheduleID"); err != nil {
+		return
+	}
+	if RecordScheduleID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RecordScheduleID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.DeleteRecordSchedule(ctx, RecordScheduleID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveScheduledRecording1GetRecordSchedule(ctx context.Context, handler ScheduledRecording1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var RecordScheduleID string
+	if value, err = soap.FindArg(in, "RecordScheduleID"); err != nil {
+		return
+	}
+	if RecordScheduleID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RecordScheduleID: "+err.Error())
+	}
+	var Filter string
+	if value, err = soap.FindArg(in, "Filter"); err != nil {
+		return
+	}
+	if Filter, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Filter: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var Result string
+	var UpdateID uint32
+	if Result, UpdateID, err = handler.GetRecordSchedule(ctx, RecordScheduleID, Filter); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "Result"
+	if out[0].Value, err = soap.MarshalString(Result); err != nil {
+		return
+	}
+	out[1].Name = "UpdateID"
+	if out[1].Value, err = soap.MarshalUi4(UpdateID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveScheduledRecording1EnableRecordSchedule(ctx context.Context, handler ScheduledRecording1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var RecordScheduleID string
+	if value, err = soap.FindArg(in, "RecordScheduleID"); err != nil {
+		return
+	}
+	if RecordScheduleID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RecordScheduleID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.EnableRecordSchedule(ctx, RecordScheduleID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveScheduledRecording1DisableRecordSchedule(ctx context.Context, handler ScheduledRecording1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var RecordScheduleID string
+	if value, err = soap.FindArg(in, "RecordScheduleID"); err != nil {
+		return
+	}
+	if RecordScheduleID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RecordScheduleID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.DisableRecordSchedule(ctx, RecordScheduleID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveScheduledRecording1DeleteRecordTask(ctx context.Context, handler ScheduledRecording1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var RecordTaskID string
+	if value, err = soap.FindArg(in, "RecordTaskID"); err != nil {
+		return
+	}
+	if RecordTaskID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RecordTaskID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.DeleteRecordTask(ctx, RecordTaskID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveScheduledRecording1GetRecordTask(ctx context.Context, handler ScheduledRecording1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var RecordTaskID string
+	if value, err = soap.FindArg(in, "RecordTaskID"); err != nil {
+		return
+	}
+	if RecordTaskID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RecordTaskID: "+err.Error())
+	}
+	var Filter string
+	if value, err = soap.FindArg(in, "Filter"); err != nil {
+		return
+	}
+	if Filter, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Filter: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var Result string
+	var UpdateID uint32
+	if Result, UpdateID, err = handler.GetRecordTask(ctx, RecordTaskID, Filter); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "Result"
+	if out[0].Value, err = soap.MarshalString(Result); err != nil {
+		return
+	}
+	out[1].Name = "UpdateID"
+	if out[1].Value, err = soap.MarshalUi4(UpdateID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveScheduledRecording1EnableRecordTask(ctx context.Context, handler ScheduledRecording1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var RecordTaskID string
+	if value, err = soap.FindArg(in, "RecordTaskID"); err != nil {
+		return
+	}
+	if RecordTaskID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RecordTaskID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.EnableRecordTask(ctx, RecordTaskID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveScheduledRecording1DisableRecordTask(ctx context.Context, handler ScheduledRecording1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var RecordTaskID string
+	if value, err = soap.FindArg(in, "RecordTaskID"); err != nil {
+		return
+	}
+	if RecordTaskID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RecordTaskID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.DisableRecordTask(ctx, RecordTaskID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveScheduledRecording1ResetRecordTask(ctx context.Context, handler ScheduledRecording1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var RecordTaskID string
+	if value, err = soap.FindArg(in, "RecordTaskID"); err != nil {
+		return
+	}
+	if RecordTaskID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RecordTaskID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.ResetRecordTask(ctx, RecordTaskID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveScheduledRecording1GetRecordScheduleConflicts(ctx context.Context, handler ScheduledRecording1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var RecordScheduleID string
+	if value, err = soap.FindArg(in, "RecordScheduleID"); err != nil {
+		return
+	}
+	if RecordScheduleID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RecordScheduleID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var RecordScheduleConflictIDList string
+	var UpdateID uint32
+	if RecordScheduleConflictIDList, UpdateID, err = handler.GetRecordScheduleConflicts(ctx, RecordScheduleID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "RecordScheduleConflictIDList"
+	if out[0].Value, err = soap.MarshalString(RecordScheduleConflictIDList); err != nil {
+		return
+	}
+	out[1].Name = "UpdateID"
+	if out[1].Value, err = soap.MarshalUi4(UpdateID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveScheduledRecording1GetRecordTaskConflicts(ctx context.Context, handler ScheduledRecording1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var RecordTaskID string
+	if value, err = soap.FindArg(in, "RecordTaskID"); err != nil {
+		return
+	}
+	if RecordTaskID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RecordTaskID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var RecordTaskConflictIDList string
+	var UpdateID uint32
+	if RecordTaskConflictIDList, UpdateID, err = handler.GetRecordTaskConflicts(ctx, RecordTaskID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "RecordTaskConflictIDList"
+	if out[0].Value, err = soap.MarshalString(RecordTaskConflictIDList); err != nil {
+		return
+	}
+	out[1].Name = "UpdateID"
+	if out[1].Value, err = soap.MarshalUi4(UpdateID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+// ScheduledRecording2Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:schemas-upnp-org:service:ScheduledRecording:2". See RegisterScheduledRecording2Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type ScheduledRecording2Handler interface {
+	GetSortCapabilities(ctx context.Context) (SortCaps string, SortLevelCap uint32, err error)
+
+	GetPropertyList(ctx context.Context, DataTypeID ScheduledRecording2DataTypeID) (PropertyList string, err error)
+
+	GetAllowedValues(ctx context.Context, DataTypeID ScheduledRecording2DataTypeID, Filter string) (PropertyInfo string, err error)
+
+	GetStateUpdateID(ctx context.Context) (Id uint32, err error)
+
+	BrowseRecordSchedules(ctx context.Context, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error)
+
+	BrowseRecordTasks(ctx context.Context, RecordScheduleID string, Filter string, StartingIndex uint32, RequestedCount uint32, SortCriteria string) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error)
+
+	CreateRecordSchedule(ctx context.Context, Elements string) (RecordScheduleID string, Result string, UpdateID uint32, err error)
+
+	DeleteRecordSchedule(ctx context.Context, RecordScheduleID string) (err error)
+
+	GetRecordSchedule(ctx context.Context, RecordScheduleID string, Filter string) (Result string, UpdateID uint32, err error)
+
+	EnableRecordSchedule(ctx context.Context, RecordScheduleID string) (err error)
+
+	DisableRecordSchedule(ctx context.Context, RecordScheduleID string) (err error)
+
+	DeleteRecordTask(ctx context.Context, RecordTaskID string) (err error)
+
+	GetRecordTask(ctx context.Context, RecordTaskID string, Filter string) (Result string, UpdateID uint32, err error)
+
+	EnableRecordTask(ctx context.Context, RecordTaskID string) (err error)
+
+	DisableRecordTask(ctx context.Context, RecordTaskID string) (err error)
+
+	ResetRecordTask(ctx context.Context, RecordTaskID string) (err error)
+
+	GetRecordScheduleConflicts(ctx context.Context, RecordScheduleID string) (RecordScheduleConflictIDList string, UpdateID uint32, err error)
+
+	GetRecordTaskConflicts(ctx context.Context, RecordTaskID string) (RecordTaskConflictIDList string, UpdateID uint32, err error)
+}
+
+// RegisterScheduledRecording2Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_ScheduledRecording_2.
+func RegisterScheduledRecording2Handler(svc *device.Service, handler ScheduledRecording2Handler) {
+	svc.HandleFunc("GetSortCapabilities", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveScheduledRecording2GetSortCapabilities(ctx, handler, in)
+	})
+	svc.HandleFunc("GetPropertyList", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveScheduledRecording2GetPropertyList(ctx, handler, in)
+	})
+	svc.HandleFunc("GetAllowedValues", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveScheduledRecording2GetAllowedValues(ctx, handler, in)
+	})
+	svc.HandleFunc("GetStateUpdateID", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveScheduledRecording2GetStateUpdateID(ctx, handler, in)
+	})
+	svc.HandleFunc("BrowseRecordSchedules", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveScheduledRecording2BrowseRecordSchedules(ctx, handler, in)
+	})
+	svc.HandleFunc("BrowseRecordTasks", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveScheduledRecording2BrowseRecordTasks(ctx, handler, in)
+	})
+	svc.HandleFunc("CreateRecordSchedule", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveScheduledRecording2CreateRecordSchedule(ctx, handler, in)
+	})
+	svc.HandleFunc("DeleteRecordSchedule", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveScheduledRecording2DeleteRecordSchedule(ctx, handler, in)
+	})
+	svc.HandleFunc("GetRecordSchedule", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveScheduledRecording2GetRecordSchedule(ctx, handler, in)
+	})
+	svc.HandleFunc("EnableRecordSchedule", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveScheduledRecording2EnableRecordSchedule(ctx, handler, in)
+	})
+	svc.HandleFunc("DisableRecordSchedule", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveScheduledRecording2DisableRecordSchedule(ctx, handler, in)
+	})
+	svc.HandleFunc("DeleteRecordTask", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveScheduledRecording2DeleteRecordTask(ctx, handler, in)
+	})
+	svc.HandleFunc("GetRecordTask", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveScheduledRecording2GetRecordTask(ctx, handler, in)
+	})
+	svc.HandleFunc("EnableRecordTask", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveScheduledRecording2EnableRecordTask(ctx, handler, in)
+	})
+	svc.HandleFunc("DisableRecordTask", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveScheduledRecording2DisableRecordTask(ctx, handler, in)
+	})
+	svc.HandleFunc("ResetRecordTask", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveScheduledRecording2ResetRecordTask(ctx, handler, in)
+	})
+	svc.HandleFunc("GetRecordScheduleConflicts", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveScheduledRecording2GetRecordScheduleConflicts(ctx, handler, in)
+	})
+	svc.HandleFunc("GetRecordTaskConflicts", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveScheduledRecording2GetRecordTaskConflicts(ctx, handler, in)
+	})
+}
+
+func serveScheduledRecording2GetSortCapabilities(ctx context.Context, handler ScheduledRecording2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var SortCaps string
+	var SortLevelCap uint32
+	if SortCaps, SortLevelCap, err = handler.GetSortCapabilities(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "SortCaps"
+	if out[0].Value, err = soap.MarshalString(SortCaps); err != nil {
+		return
+	}
+	out[1].Name = "SortLevelCap"
+	if out[1].Value, err = soap.MarshalUi4(SortLevelCap); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveScheduledRecording2GetPropertyList(ctx context.Context, handler ScheduledRecording2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var DataTypeID ScheduledRecording2DataTypeID
+	if value, err = soap.FindArg(in, "DataTypeID"); err != nil {
+		return
+	}
+	DataTypeID = ScheduledRecording2DataTypeID(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var PropertyList string
+	if PropertyList, err = handler.GetPropertyList(ctx, DataTypeID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "PropertyList"
+	if out[0].Value, err = soap.MarshalString(PropertyList); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveScheduledRecording2GetAllowedValues(ctx context.Context, handler ScheduledRecording2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var DataTypeID ScheduledRecording2DataTypeID
+	if value, err = soap.FindArg(in, "DataTypeID"); err != nil {
+		return
+	}
+	DataTypeID = ScheduledRecording2DataTypeID(value)
+	var Filter string
+	if value, err = soap.FindArg(in, "Filter"); err != nil {
+		return
+	}
+	if Filter, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Filter: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var PropertyInfo string
+	if PropertyInfo, err = handler.GetAllowedValues(ctx, DataTypeID, Filter); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "PropertyInfo"
+	if out[0].Value, err = soap.MarshalString(PropertyInfo); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveScheduledRecording2GetStateUpdateID(ctx context.Context, handler ScheduledRecording2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var Id uint32
+	if Id, err = handler.GetStateUpdateID(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "Id"
+	if out[0].Value, err = soap.MarshalUi4(Id); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveScheduledRecording2BrowseRecordSchedules(ctx context.Context, handler ScheduledRecording2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var Filter string
+	if value, err = soap.FindArg(in, "Filter"); err != nil {
+		return
+	}
+	if Filter, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Filter: "+err.Error())
+	}
+	var StartingIndex uint32
+	if value, err = soap.FindArg(in, "StartingIndex"); err != nil {
+		return
+	}
+	if StartingIndex, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument StartingIndex: "+err.Error())
+	}
+	var RequestedCount uint32
+	if value, err = soap.FindArg(in, "RequestedCount"); err != nil {
+		return
+	}
+	if RequestedCount, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RequestedCount: "+err.Error())
+	}
+	var SortCriteria string
+	if value, err = soap.FindArg(in, "SortCriteria"); err != nil {
+		return
+	}
+	if SortCriteria, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument SortCriteria: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var Result string
+	var NumberReturned uint32
+	var TotalMatches uint32
+	var UpdateID uint32
+	if Result, NumberReturned, TotalMatches, UpdateID, err = handler.BrowseRecordSchedules(ctx, Filter, StartingIndex, RequestedCount, SortCriteria); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 4)
+
+	out[0].Name = "Result"
+	if out[0].Value, err = soap.MarshalString(Result); err != nil {
+		return
+	}
+	out[1].Name = "NumberReturned"
+	if out[1].Value, err = soap.MarshalUi4(NumberReturned); err != nil {
+		return
+	}
+	out[2].Name = "TotalMatches"
+	if out[2].Value, err = soap.MarshalUi4(TotalMatches); err != nil {
+		return
+	}
+	out[3].Name = "UpdateID"
+	if out[3].Value, err = soap.MarshalUi4(UpdateID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveScheduledRecording2BrowseRecordTasks(ctx context.Context, handler ScheduledRecording2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var RecordScheduleID string
+	if value, err = soap.FindArg(in, "RecordScheduleID"); err != nil {
+		return
+	}
+	if RecordScheduleID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RecordScheduleID: "+err.Error())
+	}
+	var Filter string
+	if value, err = soap.FindArg(in, "Filter"); err != nil {
+		return
+	}
+	if Filter, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Filter: "+err.Error())
+	}
+	var StartingIndex uint32
+	if value, err = soap.FindArg(in, "StartingIndex"); err != nil {
+		return
+	}
+	if StartingIndex, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument StartingIndex: "+err.Error())
+	}
+	var RequestedCount uint32
+	if value, err = soap.FindArg(in, "RequestedCount"); err != nil {
+		return
+	}
+	if RequestedCount, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RequestedCount: "+err.Error())
+	}
+	var SortCriteria string
+	if value, err = soap.FindArg(in, "SortCriteria"); err != nil {
+		return
+	}
+	if SortCriteria, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument SortCriteria: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var Result string
+	var NumberReturned uint32
+	var TotalMatches uint32
+	var UpdateID uint32
+	if Result, NumberReturned, TotalMatches, UpdateID, err = handler.BrowseRecordTasks(ctx, RecordScheduleID, Filter, StartingIndex, RequestedCount, SortCriteria); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 4)
+
+	out[0].Name = "Result"
+	if out[0].Value, err = soap.MarshalString(Result); err != nil {
+		return
+	}
+	out[1].Name = "NumberReturned"
+	if out[1].Value, err = soap.MarshalUi4(NumberReturned); err != nil {
+		return
+	}
+	out[2].Name = "TotalMatches"
+	if out[2].Value, err = soap.MarshalUi4(TotalMatches); err != nil {
+		return
+	}
+	out[3].Name = "UpdateID"
+	if out[3].Value, err = soap.MarshalUi4(UpdateID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveScheduledRecording2CreateRecordSchedule(ctx context.Context, handler ScheduledRecording2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var Elements string
+	if value, err = soap.FindArg(in, "Elements"); err != nil {
+		return
+	}
+	if Elements, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Elements: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var RecordScheduleID string
+	var Result string
+	var UpdateID uint32
+	if RecordScheduleID, Result, UpdateID, err = handler.CreateRecordSchedule(ctx, Elements); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 3)
+
+	out[0].Name = "RecordScheduleID"
+	if out[0].Value, err = soap.MarshalString(RecordScheduleID); err != nil {
+		return
+	}
+	out[1].Name = "Result"
+	if out[1].Value, err = soap.MarshalString(Result); err != nil {
+		return
+	}
+	out[2].Name = "UpdateID"
+	if out[2].Value, err = soap.MarshalUi4(UpdateID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveScheduledRecording2DeleteRecordSchedule(ctx context.Context, handler ScheduledRecording2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var RecordScheduleID string
+	if value, err = soap.FindArg(in, "RecordScheduleID"); err != nil {
+		return
+	}
+	if RecordScheduleID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RecordScheduleID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.DeleteRecordSchedule(ctx, RecordScheduleID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveScheduledRecording2GetRecordSchedule(ctx context.Context, handler ScheduledRecording2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var RecordScheduleID string
+	if value, err = soap.FindArg(in, "RecordScheduleID"); err != nil {
+		return
+	}
+	if RecordScheduleID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RecordScheduleID: "+err.Error())
+	}
+	var Filter string
+	if value, err = soap.FindArg(in, "Filter"); err != nil {
+		return
+	}
+	if Filter, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Filter: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var Result string
+	var UpdateID uint32
+	if Result, UpdateID, err = handler.GetRecordSchedule(ctx, RecordScheduleID, Filter); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "Result"
+	if out[0].Value, err = soap.MarshalString(Result); err != nil {
+		return
+	}
+	out[1].Name = "UpdateID"
+	if out[1].Value, err = soap.MarshalUi4(UpdateID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveScheduledRecording2EnableRecordSchedule(ctx context.Context, handler ScheduledRecording2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var RecordScheduleID string
+	if value, err = soap.FindArg(in, "RecordScheduleID"); err != nil {
+		return
+	}
+	if RecordScheduleID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RecordScheduleID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.EnableRecordSchedule(ctx, RecordScheduleID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveScheduledRecording2DisableRecordSchedule(ctx context.Context, handler ScheduledRecording2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var RecordScheduleID string
+	if value, err = soap.FindArg(in, "RecordScheduleID"); err != nil {
+		return
+	}
+	if RecordScheduleID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RecordScheduleID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.DisableRecordSchedule(ctx, RecordScheduleID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveScheduledRecording2DeleteRecordTask(ctx context.Context, handler ScheduledRecording2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var RecordTaskID string
+	if value, err = soap.FindArg(in, "RecordTaskID"); err != nil {
+		return
+	}
+	if RecordTaskID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RecordTaskID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.DeleteRecordTask(ctx, RecordTaskID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveScheduledRecording2GetRecordTask(ctx context.Context, handler ScheduledRecording2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var RecordTaskID string
+	if value, err = soap.FindArg(in, "RecordTaskID"); err != nil {
+		return
+	}
+	if RecordTaskID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RecordTaskID: "+err.Error())
+	}
+	var Filter string
+	if value, err = soap.FindArg(in, "Filter"); err != nil {
+		return
+	}
+	if Filter, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Filter: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var Result string
+	var UpdateID uint32
+	if Result, UpdateID, err = handler.GetRecordTask(ctx, RecordTaskID, Filter); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "Result"
+	if out[0].Value, err = soap.MarshalString(Result); err != nil {
+		return
+	}
+	out[1].Name = "UpdateID"
+	if out[1].Value, err = soap.MarshalUi4(UpdateID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveScheduledRecording2EnableRecordTask(ctx context.Context, handler ScheduledRecording2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var RecordTaskID string
+	if value, err = soap.FindArg(in, "RecordTaskID"); err != nil {
+		return
+	}
+	if RecordTaskID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RecordTaskID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.EnableRecordTask(ctx, RecordTaskID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveScheduledRecording2DisableRecordTask(ctx context.Context, handler ScheduledRecording2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var RecordTaskID string
+	if value, err = soap.FindArg(in, "RecordTaskID"); err != nil {
+		return
+	}
+	if RecordTaskID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RecordTaskID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.DisableRecordTask(ctx, RecordTaskID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveScheduledRecording2ResetRecordTask(ctx context.Context, handler ScheduledRecording2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var RecordTaskID string
+	if value, err = soap.FindArg(in, "RecordTaskID"); err != nil {
+		return
+	}
+	if RecordTaskID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RecordTaskID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.ResetRecordTask(ctx, RecordTaskID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveScheduledRecording2GetRecordScheduleConflicts(ctx context.Context, handler ScheduledRecording2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var RecordScheduleID string
+	if value, err = soap.FindArg(in, "RecordScheduleID"); err != nil {
+		return
+	}
+	if RecordScheduleID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RecordScheduleID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var RecordScheduleConflictIDList string
+	var UpdateID uint32
+	if RecordScheduleConflictIDList, UpdateID, err = handler.GetRecordScheduleConflicts(ctx, RecordScheduleID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "RecordScheduleConflictIDList"
+	if out[0].Value, err = soap.MarshalString(RecordScheduleConflictIDList); err != nil {
+		return
+	}
+	out[1].Name = "UpdateID"
+	if out[1].Value, err = soap.MarshalUi4(UpdateID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveScheduledRecording2GetRecordTaskConflicts(ctx context.Context, handler ScheduledRecording2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var RecordTaskID string
+	if value, err = soap.FindArg(in, "RecordTaskID"); err != nil {
+		return
+	}
+	if RecordTaskID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RecordTaskID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var RecordTaskConflictIDList string
+	var UpdateID uint32
+	if RecordTaskConflictIDList, UpdateID, err = handler.GetRecordTaskConflicts(ctx, RecordTaskID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "RecordTaskConflictIDList"
+	if out[0].Value, err = soap.MarshalString(RecordTaskConflictIDList); err != nil {
+		return
+	}
+	out[1].Name = "UpdateID"
+	if out[1].Value, err = soap.MarshalUi4(UpdateID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+// ContentSync1Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:schemas-upnp-org:service:ContentSync:1". See RegisterContentSync1Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type ContentSync1Handler interface {
+	AddSyncData(ctx context.Context, ActionCaller string, SyncData string) (SyncID string, err error)
+
+	ModifySyncData(ctx context.Context, ActionCaller string, SyncID string, SyncData string) (err error)
+
+	DeleteSyncData(ctx context.Context, ActionCaller string, SyncID string) (err error)
+
+	GetSyncData(ctx context.Context, SyncID string) (SyncData string, err error)
+
+	ExchangeSyncData(ctx context.Context, ActionCaller string, LocalSyncData string) (RemoteSyncData string, err error)
+
+	AddSyncPair(ctx context.Context, ActionCaller string, ObjectID string, SyncPair string) (err error)
+
+	ModifySyncPair(ctx context.Context, ActionCaller string, ObjectID string, SyncPair string) (err error)
+
+	DeleteSyncPair(ctx context.Context, ActionCaller string, ObjectID string, SyncPair string) (err error)
+
+	StartSync(ctx context.Context, ActionCaller string, SyncID string) (err error)
+
+	AbortSync(ctx context.Context, ActionCaller string, SyncID string) (err error)
+
+	GetChangeLog(ctx context.Context, SyncID string, StartingIndex uint32, RequestedCount uint32) (ChangeLog string, NumberReturned uint32, TotalMatches uint32, err error)
+
+	ResetChangeLog(ctx context.Context, SyncID string, ObjectIDs string) (err error)
+
+	ResetStatus(ctx context.Context, SyncID string, ObjectIDs string) (err error)
+
+	GetSyncProgress(ctx context.Context, SyncID string) (SyncProgress string, err error)
+}
+
+// RegisterContentSync1Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_ContentSync_1.
+func RegisterContentSync1Handler(svc *device.Service, handler ContentSync1Handler) {
+	svc.HandleFunc("AddSyncData", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentSync1AddSyncData(ctx, handler, in)
+	})
+	svc.HandleFunc("ModifySyncData", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentSync1ModifySyncData(ctx, handler, in)
+	})
+	svc.HandleFunc("DeleteSyncData", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentSync1DeleteSyncData(ctx, handler, in)
+	})
+	svc.HandleFunc("GetSyncData", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentSync1GetSyncData(ctx, handler, in)
+	})
+	svc.HandleFunc("ExchangeSyncData", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentSync1ExchangeSyncData(ctx, handler, in)
+	})
+	svc.HandleFunc("AddSyncPair", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentSync1AddSyncPair(ctx, handler, in)
+	})
+	svc.HandleFunc("ModifySyncPair", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentSync1ModifySyncPair(ctx, handler, in)
+	})
+	svc.HandleFunc("DeleteSyncPair", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentSync1DeleteSyncPair(ctx, handler, in)
+	})
+	svc.HandleFunc("StartSync", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentSync1StartSync(ctx, handler, in)
+	})
+	svc.HandleFunc("AbortSync", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentSync1AbortSync(ctx, handler, in)
+	})
+	svc.HandleFunc("GetChangeLog", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentSync1GetChangeLog(ctx, handler, in)
+	})
+	svc.HandleFunc("ResetChangeLog", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentSync1ResetChangeLog(ctx, handler, in)
+	})
+	svc.HandleFunc("ResetStatus", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentSync1ResetStatus(ctx, handler, in)
+	})
+	svc.HandleFunc("GetSyncProgress", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveContentSync1GetSyncProgress(ctx, handler, in)
+	})
+}
+
+func serveContentSync1AddSyncData(ctx context.Context, handler ContentSync1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ActionCaller string
+	if value, err = soap.FindArg(in, "ActionCaller"); err != nil {
+		return
+	}
+	if ActionCaller, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ActionCaller: "+err.Error())
+	}
+	var SyncData string
+	if value, err = soap.FindArg(in, "SyncData"); err != nil {
+		return
+	}
+	if SyncData, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument SyncData: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var SyncID string
+	if SyncID, err = handler.AddSyncData(ctx, ActionCaller, SyncData); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "SyncID"
+	if out[0].Value, err = soap.MarshalString(SyncID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentSync1ModifySyncData(ctx context.Context, handler ContentSync1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ActionCaller string
+	if value, err = soap.FindArg(in, "ActionCaller"); err != nil {
+		return
+	}
+	if ActionCaller, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ActionCaller: "+err.Error())
+	}
+	var SyncID string
+	if value, err = soap.FindArg(in, "SyncID"); err != nil {
+		return
+	}
+	if SyncID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument SyncID: "+err.Error())
+	}
+	var SyncData string
+	if value, err = soap.FindArg(in, "SyncData"); err != nil {
+		return
+	}
+	if SyncData, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument SyncData: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.ModifySyncData(ctx, ActionCaller, SyncID, SyncData); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentSync1DeleteSyncData(ctx context.Context, handler ContentSync1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ActionCaller string
+	if value, err = soap.FindArg(in, "ActionCaller"); err != nil {
+		return
+	}
+	if ActionCaller, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ActionCaller: "+err.Error())
+	}
+	var SyncID string
+	if value, err = soap.FindArg(in, "SyncID"); err != nil {
+		return
+	}
+	if SyncID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument SyncID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.DeleteSyncData(ctx, ActionCaller, SyncID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentSync1GetSyncData(ctx context.Context, handler ContentSync1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var SyncID string
+	if value, err = soap.FindArg(in, "SyncID"); err != nil {
+		return
+	}
+	if SyncID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument SyncID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var SyncData string
+	if SyncData, err = handler.GetSyncData(ctx, SyncID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "SyncData"
+	if out[0].Value, err = soap.MarshalString(SyncData); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentSync1ExchangeSyncData(ctx context.Context, handler ContentSync1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ActionCaller string
+	if value, err = soap.FindArg(in, "ActionCaller"); err != nil {
+		return
+	}
+	if ActionCaller, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ActionCaller: "+err.Error())
+	}
+	var LocalSyncData string
+	if value, err = soap.FindArg(in, "LocalSyncData"); err != nil {
+		return
+	}
+	if LocalSyncData, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument LocalSyncData: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var RemoteSyncData string
+	if RemoteSyncData, err = handler.ExchangeSyncData(ctx, ActionCaller, LocalSyncData); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "RemoteSyncData"
+	if out[0].Value, err = soap.MarshalString(RemoteSyncData); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentSync1AddSyncPair(ctx context.Context, handler ContentSync1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ActionCaller string
+	if value, err = soap.FindArg(in, "ActionCaller"); err != nil {
+		return
+	}
+	if ActionCaller, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ActionCaller: "+err.Error())
+	}
+	var ObjectID string
+	if value, err = soap.FindArg(in, "ObjectID"); err != nil {
+		return
+	}
+	if ObjectID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ObjectID: "+err.Error())
+	}
+	var SyncPair string
+	if value, err = soap.FindArg(in, "SyncPair"); err != nil {
+		return
+	}
+	if SyncPair, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument SyncPair: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.AddSyncPair(ctx, ActionCaller, ObjectID, SyncPair); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentSync1ModifySyncPair(ctx context.Context, handler ContentSync1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ActionCaller string
+	if value, err = soap.FindArg(in, "ActionCaller"); err != nil {
+		return
+	}
+	if ActionCaller, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ActionCaller: "+err.Error())
+	}
+	var ObjectID string
+	if value, err = soap.FindArg(in, "ObjectID"); err != nil {
+		return
+	}
+	if ObjectID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ObjectID: "+err.Error())
+	}
+	var SyncPair string
+	if value, err = soap.FindArg(in, "SyncPair"); err != nil {
+		return
+	}
+	if SyncPair, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument SyncPair: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.ModifySyncPair(ctx, ActionCaller, ObjectID, SyncPair); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentSync1DeleteSyncPair(ctx context.Context, handler ContentSync1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ActionCaller string
+	if value, err = soap.FindArg(in, "ActionCaller"); err != nil {
+		return
+	}
+	if ActionCaller, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ActionCaller: "+err.Error())
+	}
+	var ObjectID string
+	if value, err = soap.FindArg(in, "ObjectID"); err != nil {
+		return
+	}
+	if ObjectID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ObjectID: "+err.Error())
+	}
+	var SyncPair string
+	if value, err = soap.FindArg(in, "SyncPair"); err != nil {
+		return
+	}
+	if SyncPair, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument SyncPair: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.DeleteSyncPair(ctx, ActionCaller, ObjectID, SyncPair); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentSync1StartSync(ctx context.Context, handler ContentSync1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ActionCaller string
+	if value, err = soap.FindArg(in, "ActionCaller"); err != nil {
+		return
+	}
+	if ActionCaller, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ActionCaller: "+err.Error())
+	}
+	var SyncID string
+	if value, err = soap.FindArg(in, "SyncID"); err != nil {
+		return
+	}
+	if SyncID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument SyncID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.StartSync(ctx, ActionCaller, SyncID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentSync1AbortSync(ctx context.Context, handler ContentSync1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ActionCaller string
+	if value, err = soap.FindArg(in, "ActionCaller"); err != nil {
+		return
+	}
+	if ActionCaller, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ActionCaller: "+err.Error())
+	}
+	var SyncID string
+	if value, err = soap.FindArg(in, "SyncID"); err != nil {
+		return
+	}
+	if SyncID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument SyncID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.AbortSync(ctx, ActionCaller, SyncID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentSync1GetChangeLog(ctx context.Context, handler ContentSync1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var SyncID string
+	if value, err = soap.FindArg(in, "SyncID"); err != nil {
+		return
+	}
+	if SyncID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument SyncID: "+err.Error())
+	}
+	var StartingIndex uint32
+	if value, err = soap.FindArg(in, "StartingIndex"); err != nil {
+		return
+	}
+	if StartingIndex, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument StartingIndex: "+err.Error())
+	}
+	var RequestedCount uint32
+	if value, err = soap.FindArg(in, "RequestedCount"); err != nil {
+		return
+	}
+	if RequestedCount, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RequestedCount: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var ChangeLog string
+	var NumberReturned uint32
+	var TotalMatches uint32
+	if ChangeLog, NumberReturned, TotalMatches, err = handler.GetChangeLog(ctx, SyncID, StartingIndex, RequestedCount); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 3)
+
+	out[0].Name = "ChangeLog"
+	if out[0].Value, err = soap.MarshalString(ChangeLog); err != nil {
+		return
+	}
+	out[1].Name = "NumberReturned"
+	if out[1].Value, err = soap.MarshalUi4(NumberReturned); err != nil {
+		return
+	}
+	out[2].Name = "TotalMatches"
+	if out[2].Value, err = soap.MarshalUi4(TotalMatches); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentSync1ResetChangeLog(ctx context.Context, handler ContentSync1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var SyncID string
+	if value, err = soap.FindArg(in, "SyncID"); err != nil {
+		return
+	}
+	if SyncID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument SyncID: "+err.Error())
+	}
+	var ObjectIDs string
+	if value, err = soap.FindArg(in, "ObjectIDs"); err != nil {
+		return
+	}
+	if ObjectIDs, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ObjectIDs: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.ResetChangeLog(ctx, SyncID, ObjectIDs); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentSync1ResetStatus(ctx context.Context, handler ContentSync1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var SyncID string
+	if value, err = soap.FindArg(in, "SyncID"); err != nil {
+		return
+	}
+	if SyncID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument SyncID: "+err.Error())
+	}
+	var ObjectIDs string
+	if value, err = soap.FindArg(in, "ObjectIDs"); err != nil {
+		return
+	}
+	if ObjectIDs, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ObjectIDs: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.ResetStatus(ctx, SyncID, ObjectIDs); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveContentSync1GetSyncProgress(ctx context.Context, handler ContentSync1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var SyncID string
+	if value, err = soap.FindArg(in, "SyncID"); err != nil {
+		return
+	}
+	if SyncID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument SyncID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var SyncProgress string
+	if SyncProgress, err = handler.GetSyncProgress(ctx, SyncID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "SyncProgress"
+	if out[0].Value, err = soap.MarshalString(SyncProgress); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
@@ -0,0 +1,209 @@
+package avm
+
+import (
+	"bytes"
+	"crypto/md5"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/huin/goupnp"
+)
+
+// DigestTransport is an http.RoundTripper that answers the HTTP digest
+// authentication challenges of a FRITZ!Box. Most TR-064 actions require
+// authentication; the device and service descriptions do not.
+//
+// The challenge of the last response is kept, so that later requests are
+// authorized without first being rejected.
+type DigestTransport struct {
+	Username string
+	Password string
+	// Transport makes the requests. If nil, http.DefaultTransport is used.
+	Transport http.RoundTripper
+
+	mu        sync.Mutex
+	challenge *digestChallenge
+	nc        uint32
+}
+
+// UseDigestAuth makes the SOAP requests of sc authenticate as username with
+// password, wrapping the transport sc already uses. To also protect the
+// password in transit, first move sc to the TLS port returned by
+// DeviceInfo1.GetSecurityPort.
+func UseDigestAuth(sc *goupnp.ServiceClient, username, password string) {
+	sc.SOAPClient.HTTPClient.Transport = &DigestTransport{
+		Username:  username,
+		Password:  password,
+		Transport: sc.SOAPClient.HTTPClient.Transport,
+	}
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *DigestTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	t.mu.Lock()
+	c := t.challenge
+	t.mu.Unlock()
+	resp, err := t.send(req, body, c)
+	if err != nil || resp.StatusCode != http.StatusUnauthorized {
+		return resp, err
+	}
+
+	c, err = parseDigestChallenge(resp.Header.Get("WWW-Authenticate"))
+	if err != nil {
+		// Not a challenge that can be answered; leave the 401 to the caller.
+		return resp, nil
+	}
+	io.Copy(ioutil.Discard, resp.Body)
+	resp.Body.Close()
+	t.mu.Lock()
+	t.challenge = c
+	t.nc = 0
+	t.mu.Unlock()
+	return t.send(req, body, c)
+}
+
+func (t *DigestTransport) send(req *http.Request, body []byte, c *digestChallenge) (*http.Response, error) {
+	r := req.Clone(req.Context())
+	if req.Body != nil {
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+	if c != nil {
+		t.mu.Lock()
+		t.nc++
+		nc := t.nc
+		t.mu.Unlock()
+		auth, err := c.authorization(t.Username, t.Password, r.Method, r.URL.RequestURI(), nc)
+		if err != nil {
+			return nil, err
+		}
+		r.Header.Set("Authorization", auth)
+	}
+	transport := t.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+	return transport.RoundTrip(r)
+}
+
+type digestChallenge struct {
+	realm, nonce, opaque string
+	qopAuth              bool
+}
+
+// parseDigestChallenge parses the value of a WWW-Authenticate header with a
+// Digest challenge, e.g.:
+//
+//	Digest realm="F!Box SOAP-Auth",nonce="0F4B7E2D",algorithm=MD5,qop="auth"
+func parseDigestChallenge(header string) (*digestChallenge, error) {
+	const prefix = "Digest "
+	if len(header) < len(prefix) || !strings.EqualFold(header[:len(prefix)], prefix) {
+		return nil, fmt.Errorf("goupnp: not a digest challenge: %q", header)
+	}
+	params := parseAuthParams(header[len(prefix):])
+	if alg := params["algorithm"]; alg != "" && !strings.EqualFold(alg, "MD5") {
+		return nil, fmt.Errorf("goupnp: unsupported digest algorithm %q", alg)
+	}
+	c := &digestChallenge{
+		realm:  params["realm"],
+		nonce:  params["nonce"],
+		opaque: params["opaque"],
+	}
+	if c.nonce == "" {
+		return nil, fmt.Errorf("goupnp: digest challenge without nonce: %q", header)
+	}
+	if qop, ok := params["qop"]; ok {
+		for _, q := range strings.Split(qop, ",") {
+			if strings.TrimSpace(q) == "auth" {
+				c.qopAuth = true
+			}
+		}
+		if !c.qopAuth {
+			return nil, fmt.Errorf("goupnp: unsupported digest qop %q", qop)
+		}
+	}
+	return c, nil
+}
+
+// parseAuthParams parses comma separated key=value pairs, whose values may be
+// quoted strings.
+func parseAuthParams(s string) map[string]string {
+	params := make(map[string]string)
+	for {
+		s = strings.TrimLeft(s, " \t,")
+		eq := strings.IndexByte(s, '=')
+		if eq < 0 {
+			return params
+		}
+		key := strings.ToLower(strings.TrimSpace(s[:eq]))
+		s = strings.TrimLeft(s[eq+1:], " \t")
+		var value string
+		if strings.HasPrefix(s, `"`) {
+			var b strings.Builder
+			i := 1
+			for ; i < len(s) && s[i] != '"'; i++ {
+				if s[i] == '\\' && i+1 < len(s) {
+					i++
+				}
+				b.WriteByte(s[i])
+			}
+			value = b.String()
+			if i < len(s) {
+				i++
+			}
+			s = s[i:]
+		} else {
+			end := strings.IndexByte(s, ',')
+			if end < 0 {
+				end = len(s)
+			}
+			value = strings.TrimSpace(s[:end])
+			s = s[end:]
+		}
+		params[key] = value
+	}
+}
+
+func (c *digestChallenge) authorization(username, password, method, uri string, nc uint32) (string, error) {
+	ha1 := md5Hex(username + ":" + c.realm + ":" + password)
+	ha2 := md5Hex(method + ":" + uri)
+	var b strings.Builder
+	fmt.Fprintf(&b, `Digest username=%q, realm=%q, nonce=%q, uri=%q, algorithm=MD5`,
+		username, c.realm, c.nonce, uri)
+	if c.qopAuth {
+		var cnonce [8]byte
+		if _, err := rand.Read(cnonce[:]); err != nil {
+			return "", err
+		}
+		cn := hex.EncodeToString(cnonce[:])
+		ncs := fmt.Sprintf("%08x", nc)
+		fmt.Fprintf(&b, `, qop=auth, nc=%s, cnonce=%q, response=%q`,
+			ncs, cn, md5Hex(ha1+":"+c.nonce+":"+ncs+":"+cn+":auth:"+ha2))
+	} else {
+		fmt.Fprintf(&b, `, response=%q`, md5Hex(ha1+":"+c.nonce+":"+ha2))
+	}
+	if c.opaque != "" {
+		fmt.Fprintf(&b, `, opaque=%q`, c.opaque)
+	}
+	return b.String(), nil
+}
+
+func md5Hex(s string) string {
+	sum := md5.Sum([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
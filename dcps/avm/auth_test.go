@@ -0,0 +1,69 @@
+package avm
+
+import (
+	"crypto/md5"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func hexMD5(s string) string {
+	sum := md5.Sum([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+func TestDigestTransport(t *testing.T) {
+	const realm, nonce = "F!Box SOAP-Auth", "0F4B7E2D9A"
+	challenges := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		p := parseAuthParams(strings.TrimPrefix(r.Header.Get("Authorization"), "Digest "))
+		want := hexMD5(hexMD5("admin:"+realm+":secret") + ":" + nonce + ":" + p["nc"] + ":" + p["cnonce"] +
+			":auth:" + hexMD5(r.Method+":"+r.URL.RequestURI()))
+		if p["response"] != want || p["uri"] != r.URL.RequestURI() {
+			challenges++
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Digest realm="%s",nonce="%s",algorithm=MD5,qop="auth"`, realm, nonce))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		w.Write(body)
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &DigestTransport{Username: "admin", Password: "secret"}}
+	for i := 0; i < 2; i++ {
+		resp, err := client.Post(server.URL+"/upnp/control/deviceinfo", "text/xml", strings.NewReader("ping"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK || string(body) != "ping" {
+			t.Fatalf("request %d: got %s %q, want 200 \"ping\"", i, resp.Status, body)
+		}
+	}
+	if challenges != 1 {
+		t.Errorf("server challenged %d times, want 1", challenges)
+	}
+}
+
+func TestParseDigestChallenge(t *testing.T) {
+	c, err := parseDigestChallenge(`Digest realm="a \"b\", c", nonce="n1", opaque=xyz, qop="auth,auth-int"`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := digestChallenge{realm: `a "b", c`, nonce: "n1", opaque: "xyz", qopAuth: true}
+	if *c != want {
+		t.Errorf("got %+v, want %+v", *c, want)
+	}
+	if _, err := parseDigestChallenge(`Basic realm="x"`); err == nil {
+		t.Error("parsed a Basic challenge")
+	}
+	if _, err := parseDigestChallenge(`Digest realm="x", nonce="n", algorithm=SHA-256`); err == nil {
+		t.Error("parsed a SHA-256 challenge")
+	}
+}
@@ -0,0 +1,2426 @@
+// Client for UPnP Device Control Protocol AVM FRITZ!Box TR-064.
+//
+// Typically, use one of the New* functions to create clients for services.
+package avm
+
+// Generated file - do not edit by hand. See README.md
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/huin/goupnp"
+	"github.com/huin/goupnp/soap"
+)
+
+// Hack to avoid Go complaining if time isn't used.
+var _ time.Time
+
+// Device URNs:
+const (
+	URN_InternetGatewayDevice_1 = "urn:dslforum-org:device:InternetGatewayDevice:1"
+	URN_LANDevice_1             = "urn:dslforum-org:device:LANDevice:1"
+)
+
+// Service URNs:
+const (
+	URN_DeviceInfo_1        = "urn:dslforum-org:service:DeviceInfo:1"
+	URN_WLANConfiguration_1 = "urn:dslforum-org:service:WLANConfiguration:1"
+	URN_X_AVM_DE_Homeauto_1 = "urn:dslforum-org:service:X_AVM-DE_Homeauto:1"
+	URN_X_AVM_DE_OnTel_1    = "urn:dslforum-org:service:X_AVM-DE_OnTel:1"
+)
+
+// DeviceInfo1 is a client for UPnP SOAP service with URN "urn:dslforum-org:service:DeviceInfo:1". See
+// goupnp.ServiceClient, which contains RootDevice and Service attributes which
+// are provided for informational value.
+type DeviceInfo1 struct {
+	goupnp.ServiceClient
+}
+
+// DeviceInfo1Client is the interface of the actions of DeviceInfo1, for
+// substituting fakes or mocks for the service in tests.
+type DeviceInfo1Client interface {
+	GetInfo() (NewManufacturerName string, NewManufacturerOUI string, NewModelName string, NewDescription string, NewProductClass string, NewSerialNumber string, NewSoftwareVersion string, NewHardwareVersion string, NewSpecVersion string, NewProvisioningCode string, NewUpTime uint32, NewDeviceLog string, err error)
+	GetInfoCtx(ctx context.Context) (NewManufacturerName string, NewManufacturerOUI string, NewModelName string, NewDescription string, NewProductClass string, NewSerialNumber string, NewSoftwareVersion string, NewHardwareVersion string, NewSpecVersion string, NewProvisioningCode string, NewUpTime uint32, NewDeviceLog string, err error)
+	SetProvisioningCode(NewProvisioningCode string) (err error)
+	SetProvisioningCodeCtx(ctx context.Context, NewProvisioningCode string) (err error)
+	GetDeviceLog() (NewDeviceLog string, err error)
+	GetDeviceLogCtx(ctx context.Context) (NewDeviceLog string, err error)
+	GetSecurityPort() (NewSecurityPort uint16, err error)
+	GetSecurityPortCtx(ctx context.Context) (NewSecurityPort uint16, err error)
+}
+
+var _ DeviceInfo1Client = new(DeviceInfo1)
+
+// NewDeviceInfo1Clients discovers instances of the service on the network,
+// and returns clients to any that are found. errors will contain an error for
+// any devices that replied but which could not be queried, and err will be set
+// if the discovery process failed outright.
+//
+// This is a typical entry calling point into this package.
+func NewDeviceInfo1Clients() (clients []*DeviceInfo1, errors []error, err error) {
+	var genericClients []goupnp.ServiceClient
+	if genericClients, errors, err = goupnp.NewServiceClients(URN_DeviceInfo_1); err != nil {
+		return
+	}
+	clients = newDeviceInfo1ClientsFromGenericClients(genericClients)
+	return
+}
+
+// NewDeviceInfo1ClientsByURL discovers instances of the service at the given
+// URL, and returns clients to any that are found. An error is returned if
+// there was an error probing the service.
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered service URL.
+func NewDeviceInfo1ClientsByURL(loc *url.URL) ([]*DeviceInfo1, error) {
+	genericClients, err := goupnp.NewServiceClientsByURL(loc, URN_DeviceInfo_1)
+	if err != nil {
+		return nil, err
+	}
+	return newDeviceInfo1ClientsFromGenericClients(genericClients), nil
+}
+
+// NewDeviceInfo1ClientsFromRootDevice discovers instances of the service in
+// a given root device, and returns clients to any that are found. An error is
+// returned if there was not at least one instance of the service within the
+// device. The location parameter is simply assigned to the Location attribute
+// of the wrapped ServiceClient(s).
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered root device.
+func NewDeviceInfo1ClientsFromRootDevice(rootDevice *goupnp.RootDevice, loc *url.URL) ([]*DeviceInfo1, error) {
+	genericClients, err := goupnp.NewServiceClientsFromRootDevice(rootDevice, loc, URN_DeviceInfo_1)
+	if err != nil {
+		return nil, err
+	}
+	return newDeviceInfo1ClientsFromGenericClients(genericClients), nil
+}
+
+func newDeviceInfo1ClientsFromGenericClients(genericClients []goupnp.ServiceClient) []*DeviceInfo1 {
+	clients := make([]*DeviceInfo1, len(genericClients))
+	for i := range genericClients {
+		clients[i] = &DeviceInfo1{genericClients[i]}
+	}
+	return clients
+}
+
+// DeviceInfo1Timeouts are the default timeouts of the actions of
+// DeviceInfo1, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var DeviceInfo1Timeouts = map[string]time.Duration{}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *DeviceInfo1) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, DeviceInfo1Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_DeviceInfo_1, actionName, request, response)
+}
+
+// DeviceInfo1GetInfoResponse is the response of GetInfo, with each
+// argument in its SOAP string form.
+type DeviceInfo1GetInfoResponse struct {
+	NewManufacturerName string
+	NewManufacturerOUI  string
+	NewModelName        string
+	NewDescription      string
+	NewProductClass     string
+	NewSerialNumber     string
+	NewSoftwareVersion  string
+	NewHardwareVersion  string
+	NewSpecVersion      string
+	NewProvisioningCode string
+	NewUpTime           string
+	NewDeviceLog        string
+}
+
+// GetInfo performs the GetInfo action of the service.
+//
+// Return values:
+//   - NewManufacturerName (out, state variable ManufacturerName)
+//   - NewManufacturerOUI (out, state variable ManufacturerOUI)
+//   - NewModelName (out, state variable ModelName)
+//   - NewDescription (out, state variable Description)
+//   - NewProductClass (out, state variable ProductClass)
+//   - NewSerialNumber (out, state variable SerialNumber)
+//   - NewSoftwareVersion (out, state variable SoftwareVersion)
+//   - NewHardwareVersion (out, state variable HardwareVersion)
+//   - NewSpecVersion (out, state variable SpecVersion)
+//   - NewProvisioningCode (out, state variable ProvisioningCode)
+//   - NewUpTime (out, state variable UpTime)
+//   - NewDeviceLog (out, state variable DeviceLog)
+func (client *DeviceInfo1) GetInfo() (NewManufacturerName string, NewManufacturerOUI string, NewModelName string, NewDescription string, NewProductClass string, NewSerialNumber string, NewSoftwareVersion string, NewHardwareVersion string, NewSpecVersion string, NewProvisioningCode string, NewUpTime uint32, NewDeviceLog string, err error) {
+	return client.GetInfoCtx(context.Background())
+}
+
+// GetInfoCtx is GetInfo with a context, to cancel or time out the call.
+func (client *DeviceInfo1) GetInfoCtx(ctx context.Context) (NewManufacturerName string, NewManufacturerOUI string, NewModelName string, NewDescription string, NewProductClass string, NewSerialNumber string, NewSoftwareVersion string, NewHardwareVersion string, NewSpecVersion string, NewProvisioningCode string, NewUpTime uint32, NewDeviceLog string, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &DeviceInfo1GetInfoResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetInfo", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if NewManufacturerName, err = soap.UnmarshalString(response.NewManufacturerName); err != nil {
+		return
+	}
+	if NewManufacturerOUI, err = soap.UnmarshalString(response.NewManufacturerOUI); err != nil {
+		return
+	}
+	if NewModelName, err = soap.UnmarshalString(response.NewModelName); err != nil {
+		return
+	}
+	if NewDescription, err = soap.UnmarshalString(response.NewDescription); err != nil {
+		return
+	}
+	if NewProductClass, err = soap.UnmarshalString(response.NewProductClass); err != nil {
+		return
+	}
+	if NewSerialNumber, err = soap.UnmarshalString(response.NewSerialNumber); err != nil {
+		return
+	}
+	if NewSoftwareVersion, err = soap.UnmarshalString(response.NewSoftwareVersion); err != nil {
+		return
+	}
+	if NewHardwareVersion, err = soap.UnmarshalString(response.NewHardwareVersion); err != nil {
+		return
+	}
+	if NewSpecVersion, err = soap.UnmarshalString(response.NewSpecVersion); err != nil {
+		return
+	}
+	if NewProvisioningCode, err = soap.UnmarshalString(response.NewProvisioningCode); err != nil {
+		return
+	}
+	if NewUpTime, err = soap.UnmarshalUi4(response.NewUpTime); err != nil {
+		return
+	}
+	if NewDeviceLog, err = soap.UnmarshalString(response.NewDeviceLog); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// DeviceInfo1SetProvisioningCodeRequest is the request of SetProvisioningCode, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type DeviceInfo1SetProvisioningCodeRequest struct {
+	NewProvisioningCode string
+}
+
+// SetProvisioningCode performs the SetProvisioningCode action of the service.
+//
+// Arguments:
+//   - NewProvisioningCode (in, state variable ProvisioningCode)
+func (client *DeviceInfo1) SetProvisioningCode(NewProvisioningCode string) (err error) {
+	return client.SetProvisioningCodeCtx(context.Background(), NewProvisioningCode)
+}
+
+// SetProvisioningCodeCtx is SetProvisioningCode with a context, to cancel or time out the call.
+func (client *DeviceInfo1) SetProvisioningCodeCtx(ctx context.Context, NewProvisioningCode string) (err error) {
+	// Request structure.
+	request := &DeviceInfo1SetProvisioningCodeRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewProvisioningCode, err = soap.MarshalString(NewProvisioningCode); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SetProvisioningCode", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// DeviceInfo1GetDeviceLogResponse is the response of GetDeviceLog, with each
+// argument in its SOAP string form.
+type DeviceInfo1GetDeviceLogResponse struct {
+	NewDeviceLog string
+}
+
+// GetDeviceLog performs the GetDeviceLog action of the service.
+//
+// Return values:
+//   - NewDeviceLog (out, state variable DeviceLog)
+func (client *DeviceInfo1) GetDeviceLog() (NewDeviceLog string, err error) {
+	return client.GetDeviceLogCtx(context.Background())
+}
+
+// GetDeviceLogCtx is GetDeviceLog with a context, to cancel or time out the call.
+func (client *DeviceInfo1) GetDeviceLogCtx(ctx context.Context) (NewDeviceLog string, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &DeviceInfo1GetDeviceLogResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetDeviceLog", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if NewDeviceLog, err = soap.UnmarshalString(response.NewDeviceLog); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// DeviceInfo1GetSecurityPortResponse is the response of GetSecurityPort, with each
+// argument in its SOAP string form.
+type DeviceInfo1GetSecurityPortResponse struct {
+	NewSecurityPort string
+}
+
+// GetSecurityPort performs the GetSecurityPort action of the service.
+//
+// Return values:
+//   - NewSecurityPort (out, state variable SecurityPort)
+func (client *DeviceInfo1) GetSecurityPort() (NewSecurityPort uint16, err error) {
+	return client.GetSecurityPortCtx(context.Background())
+}
+
+// GetSecurityPortCtx is GetSecurityPort with a context, to cancel or time out the call.
+func (client *DeviceInfo1) GetSecurityPortCtx(ctx context.Context) (NewSecurityPort uint16, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &DeviceInfo1GetSecurityPortResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetSecurityPort", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if NewSecurityPort, err = soap.UnmarshalUi2(response.NewSecurityPort); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// WLANConfiguration1 is a client for UPnP SOAP service with URN "urn:dslforum-org:service:WLANConfiguration:1". See
+// goupnp.ServiceClient, which contains RootDevice and Service attributes which
+// are provided for informational value.
+type WLANConfiguration1 struct {
+	goupnp.ServiceClient
+}
+
+// WLANConfiguration1Client is the interface of the actions of WLANConfiguration1, for
+// substituting fakes or mocks for the service in tests.
+type WLANConfiguration1Client interface {
+	SetEnable(NewEnable bool) (err error)
+	SetEnableCtx(ctx context.Context, NewEnable bool) (err error)
+	GetInfo() (NewEnable bool, NewStatus WLANConfiguration1Status, NewMaxBitRate string, NewChannel uint8, NewSSID string, NewBeaconType WLANConfiguration1BeaconType, NewMACAddressControlEnabled bool, NewStandard string, NewBSSID string, NewBasicEncryptionModes WLANConfiguration1BasicEncryptionModes, NewBasicAuthenticationMode WLANConfiguration1BasicAuthenticationMode, err error)
+	GetInfoCtx(ctx context.Context) (NewEnable bool, NewStatus WLANConfiguration1Status, NewMaxBitRate string, NewChannel uint8, NewSSID string, NewBeaconType WLANConfiguration1BeaconType, NewMACAddressControlEnabled bool, NewStandard string, NewBSSID string, NewBasicEncryptionModes WLANConfiguration1BasicEncryptionModes, NewBasicAuthenticationMode WLANConfiguration1BasicAuthenticationMode, err error)
+	GetSSID() (NewSSID string, err error)
+	GetSSIDCtx(ctx context.Context) (NewSSID string, err error)
+	SetSSID(NewSSID string) (err error)
+	SetSSIDCtx(ctx context.Context, NewSSID string) (err error)
+	GetBSSID() (NewBSSID string, err error)
+	GetBSSIDCtx(ctx context.Context) (NewBSSID string, err error)
+	GetChannelInfo() (NewChannel uint8, NewPossibleChannels string, err error)
+	GetChannelInfoCtx(ctx context.Context) (NewChannel uint8, NewPossibleChannels string, err error)
+	SetChannel(NewChannel uint8) (err error)
+	SetChannelCtx(ctx context.Context, NewChannel uint8) (err error)
+	GetBeaconType() (NewBeaconType WLANConfiguration1BeaconType, err error)
+	GetBeaconTypeCtx(ctx context.Context) (NewBeaconType WLANConfiguration1BeaconType, err error)
+	SetBeaconType(NewBeaconType WLANConfiguration1BeaconType) (err error)
+	SetBeaconTypeCtx(ctx context.Context, NewBeaconType WLANConfiguration1BeaconType) (err error)
+	GetSecurityKeys() (NewWEPKey0 string, NewWEPKey1 string, NewWEPKey2 string, NewWEPKey3 string, NewPreSharedKey string, NewKeyPassphrase string, err error)
+	GetSecurityKeysCtx(ctx context.Context) (NewWEPKey0 string, NewWEPKey1 string, NewWEPKey2 string, NewWEPKey3 string, NewPreSharedKey string, NewKeyPassphrase string, err error)
+	SetSecurityKeys(NewWEPKey0 string, NewWEPKey1 string, NewWEPKey2 string, NewWEPKey3 string, NewPreSharedKey string, NewKeyPassphrase string) (err error)
+	SetSecurityKeysCtx(ctx context.Context, NewWEPKey0 string, NewWEPKey1 string, NewWEPKey2 string, NewWEPKey3 string, NewPreSharedKey string, NewKeyPassphrase string) (err error)
+	GetTotalAssociations() (NewTotalAssociations uint16, err error)
+	GetTotalAssociationsCtx(ctx context.Context) (NewTotalAssociations uint16, err error)
+	GetGenericAssociatedDeviceInfo(NewAssociatedDeviceIndex uint16) (NewAssociatedDeviceMACAddress string, NewAssociatedDeviceIPAddress string, NewAssociatedDeviceAuthState bool, err error)
+	GetGenericAssociatedDeviceInfoCtx(ctx context.Context, NewAssociatedDeviceIndex uint16) (NewAssociatedDeviceMACAddress string, NewAssociatedDeviceIPAddress string, NewAssociatedDeviceAuthState bool, err error)
+	GetSpecificAssociatedDeviceInfo(NewAssociatedDeviceMACAddress string) (NewAssociatedDeviceIPAddress string, NewAssociatedDeviceAuthState bool, err error)
+	GetSpecificAssociatedDeviceInfoCtx(ctx context.Context, NewAssociatedDeviceMACAddress string) (NewAssociatedDeviceIPAddress string, NewAssociatedDeviceAuthState bool, err error)
+	GetStatistics() (NewTotalPacketsSent uint32, NewTotalPacketsReceived uint32, err error)
+	GetStatisticsCtx(ctx context.Context) (NewTotalPacketsSent uint32, NewTotalPacketsReceived uint32, err error)
+}
+
+var _ WLANConfiguration1Client = new(WLANConfiguration1)
+
+// WLANConfiguration1Status is a value of the state variable Status of
+// WLANConfiguration1.
+type WLANConfiguration1Status string
+
+// Allowed values of WLANConfiguration1Status.
+const (
+	WLANConfiguration1Status_Up       WLANConfiguration1Status = "Up"
+	WLANConfiguration1Status_Error    WLANConfiguration1Status = "Error"
+	WLANConfiguration1Status_Disabled WLANConfiguration1Status = "Disabled"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v WLANConfiguration1Status) Valid() bool {
+	switch v {
+	case WLANConfiguration1Status_Up,
+		WLANConfiguration1Status_Error,
+		WLANConfiguration1Status_Disabled:
+		return true
+	}
+	return false
+}
+
+// WLANConfiguration1BeaconType is a value of the state variable BeaconType of
+// WLANConfiguration1.
+type WLANConfiguration1BeaconType string
+
+// Allowed values of WLANConfiguration1BeaconType.
+const (
+	WLANConfiguration1BeaconType_None      WLANConfiguration1BeaconType = "None"
+	WLANConfiguration1BeaconType_Basic     WLANConfiguration1BeaconType = "Basic"
+	WLANConfiguration1BeaconType_WPA       WLANConfiguration1BeaconType = "WPA"
+	WLANConfiguration1BeaconType_11i       WLANConfiguration1BeaconType = "11i"
+	WLANConfiguration1BeaconType_WPAand11i WLANConfiguration1BeaconType = "WPAand11i"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v WLANConfiguration1BeaconType) Valid() bool {
+	switch v {
+	case WLANConfiguration1BeaconType_None,
+		WLANConfiguration1BeaconType_Basic,
+		WLANConfiguration1BeaconType_WPA,
+		WLANConfiguration1BeaconType_11i,
+		WLANConfiguration1BeaconType_WPAand11i:
+		return true
+	}
+	return false
+}
+
+// WLANConfiguration1BasicEncryptionModes is a value of the state variable BasicEncryptionModes of
+// WLANConfiguration1.
+type WLANConfiguration1BasicEncryptionModes string
+
+// Allowed values of WLANConfiguration1BasicEncryptionModes.
+const (
+	WLANConfiguration1BasicEncryptionModes_None          WLANConfiguration1BasicEncryptionModes = "None"
+	WLANConfiguration1BasicEncryptionModes_WEPEncryption WLANConfiguration1BasicEncryptionModes = "WEPEncryption"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v WLANConfiguration1BasicEncryptionModes) Valid() bool {
+	switch v {
+	case WLANConfiguration1BasicEncryptionModes_None,
+		WLANConfiguration1BasicEncryptionModes_WEPEncryption:
+		return true
+	}
+	return false
+}
+
+// WLANConfiguration1BasicAuthenticationMode is a value of the state variable BasicAuthenticationMode of
+// WLANConfiguration1.
+type WLANConfiguration1BasicAuthenticationMode string
+
+// Allowed values of WLANConfiguration1BasicAuthenticationMode.
+const (
+	WLANConfiguration1BasicAuthenticationMode_None                 WLANConfiguration1BasicAuthenticationMode = "None"
+	WLANConfiguration1BasicAuthenticationMode_SharedAuthentication WLANConfiguration1BasicAuthenticationMode = "SharedAuthentication"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v WLANConfiguration1BasicAuthenticationMode) Valid() bool {
+	switch v {
+	case WLANConfiguration1BasicAuthenticationMode_None,
+		WLANConfiguration1BasicAuthenticationMode_SharedAuthentication:
+		return true
+	}
+	return false
+}
+
+// NewWLANConfiguration1Clients discovers instances of the service on the network,
+// and returns clients to any that are found. errors will contain an error for
+// any devices that replied but which could not be queried, and err will be set
+// if the discovery process failed outright.
+//
+// This is a typical entry calling point into this package.
+func NewWLANConfiguration1Clients() (clients []*WLANConfiguration1, errors []error, err error) {
+	var genericClients []goupnp.ServiceClient
+	if genericClients, errors, err = goupnp.NewServiceClients(URN_WLANConfiguration_1); err != nil {
+		return
+	}
+	clients = newWLANConfiguration1ClientsFromGenericClients(genericClients)
+	return
+}
+
+// NewWLANConfiguration1ClientsByURL discovers instances of the service at the given
+// URL, and returns clients to any that are found. An error is returned if
+// there was an error probing the service.
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered service URL.
+func NewWLANConfiguration1ClientsByURL(loc *url.URL) ([]*WLANConfiguration1, error) {
+	genericClients, err := goupnp.NewServiceClientsByURL(loc, URN_WLANConfiguration_1)
+	if err != nil {
+		return nil, err
+	}
+	return newWLANConfiguration1ClientsFromGenericClients(genericClients), nil
+}
+
+// NewWLANConfiguration1ClientsFromRootDevice discovers instances of the service in
+// a given root device, and returns clients to any that are found. An error is
+// returned if there was not at least one instance of the service within the
+// device. The location parameter is simply assigned to the Location attribute
+// of the wrapped ServiceClient(s).
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered root device.
+func NewWLANConfiguration1ClientsFromRootDevice(rootDevice *goupnp.RootDevice, loc *url.URL) ([]*WLANConfiguration1, error) {
+	genericClients, err := goupnp.NewServiceClientsFromRootDevice(rootDevice, loc, URN_WLANConfiguration_1)
+	if err != nil {
+		return nil, err
+	}
+	return newWLANConfiguration1ClientsFromGenericClients(genericClients), nil
+}
+
+func newWLANConfiguration1ClientsFromGenericClients(genericClients []goupnp.ServiceClient) []*WLANConfiguration1 {
+	clients := make([]*WLANConfiguration1, len(genericClients))
+	for i := range genericClients {
+		clients[i] = &WLANConfiguration1{genericClients[i]}
+	}
+	return clients
+}
+
+// WLANConfiguration1Timeouts are the default timeouts of the actions of
+// WLANConfiguration1, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var WLANConfiguration1Timeouts = map[string]time.Duration{}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *WLANConfiguration1) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, WLANConfiguration1Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_WLANConfiguration_1, actionName, request, response)
+}
+
+// WLANConfiguration1SetEnableRequest is the request of SetEnable, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WLANConfiguration1SetEnableRequest struct {
+	NewEnable string
+}
+
+// SetEnable performs the SetEnable action of the service.
+//
+// Arguments:
+//   - NewEnable (in, state variable Enable)
+func (client *WLANConfiguration1) SetEnable(NewEnable bool) (err error) {
+	return client.SetEnableCtx(context.Background(), NewEnable)
+}
+
+// SetEnableCtx is SetEnable with a context, to cancel or time out the call.
+func (client *WLANConfiguration1) SetEnableCtx(ctx context.Context, NewEnable bool) (err error) {
+	// Request structure.
+	request := &WLANConfiguration1SetEnableRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewEnable, err = soap.MarshalBoolean(NewEnable); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SetEnable", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// WLANConfiguration1GetInfoResponse is the response of GetInfo, with each
+// argument in its SOAP string form.
+type WLANConfiguration1GetInfoResponse struct {
+	NewEnable                   string
+	NewStatus                   string
+	NewMaxBitRate               string
+	NewChannel                  string
+	NewSSID                     string
+	NewBeaconType               string
+	NewMACAddressControlEnabled string
+	NewStandard                 string
+	NewBSSID                    string
+	NewBasicEncryptionModes     string
+	NewBasicAuthenticationMode  string
+}
+
+// GetInfo performs the GetInfo action of the service.
+//
+// Return values:
+//   - NewEnable (out, state variable Enable)
+//   - NewStatus (out, state variable Status): allowed values Up, Error, Disabled
+//   - NewMaxBitRate (out, state variable MaxBitRate)
+//   - NewChannel (out, state variable Channel)
+//   - NewSSID (out, state variable SSID)
+//   - NewBeaconType (out, state variable BeaconType): allowed values None, Basic, WPA, 11i, WPAand11i
+//   - NewMACAddressControlEnabled (out, state variable MACAddressControlEnabled)
+//   - NewStandard (out, state variable Standard)
+//   - NewBSSID (out, state variable BSSID)
+//   - NewBasicEncryptionModes (out, state variable BasicEncryptionModes): allowed values None, WEPEncryption
+//   - NewBasicAuthenticationMode (out, state variable BasicAuthenticationMode): allowed values None, SharedAuthentication
+func (client *WLANConfiguration1) GetInfo() (NewEnable bool, NewStatus WLANConfiguration1Status, NewMaxBitRate string, NewChannel uint8, NewSSID string, NewBeaconType WLANConfiguration1BeaconType, NewMACAddressControlEnabled bool, NewStandard string, NewBSSID string, NewBasicEncryptionModes WLANConfiguration1BasicEncryptionModes, NewBasicAuthenticationMode WLANConfiguration1BasicAuthenticationMode, err error) {
+	return client.GetInfoCtx(context.Background())
+}
+
+// GetInfoCtx is GetInfo with a context, to cancel or time out the call.
+func (client *WLANConfiguration1) GetInfoCtx(ctx context.Context) (NewEnable bool, NewStatus WLANConfiguration1Status, NewMaxBitRate string, NewChannel uint8, NewSSID string, NewBeaconType WLANConfiguration1BeaconType, NewMACAddressControlEnabled bool, NewStandard string, NewBSSID string, NewBasicEncryptionModes WLANConfiguration1BasicEncryptionModes, NewBasicAuthenticationMode WLANConfiguration1BasicAuthenticationMode, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &WLANConfiguration1GetInfoResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetInfo", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if NewEnable, err = soap.UnmarshalBoolean(response.NewEnable); err != nil {
+		return
+	}
+	NewStatus = WLANConfiguration1Status(response.NewStatus)
+	if NewMaxBitRate, err = soap.UnmarshalString(response.NewMaxBitRate); err != nil {
+		return
+	}
+	if NewChannel, err = soap.UnmarshalUi1(response.NewChannel); err != nil {
+		return
+	}
+	if NewSSID, err = soap.UnmarshalString(response.NewSSID); err != nil {
+		return
+	}
+	NewBeaconType = WLANConfiguration1BeaconType(response.NewBeaconType)
+	if NewMACAddressControlEnabled, err = soap.UnmarshalBoolean(response.NewMACAddressControlEnabled); err != nil {
+		return
+	}
+	if NewStandard, err = soap.UnmarshalString(response.NewStandard); err != nil {
+		return
+	}
+	if NewBSSID, err = soap.UnmarshalString(response.NewBSSID); err != nil {
+		return
+	}
+	NewBasicEncryptionModes = WLANConfiguration1BasicEncryptionModes(response.NewBasicEncryptionModes)
+	NewBasicAuthenticationMode = WLANConfiguration1BasicAuthenticationMode(response.NewBasicAuthenticationMode)
+	// END Unmarshal arguments from response.
+	return
+}
+
+// WLANConfiguration1GetSSIDResponse is the response of GetSSID, with each
+// argument in its SOAP string form.
+type WLANConfiguration1GetSSIDResponse struct {
+	NewSSID string
+}
+
+// GetSSID performs the GetSSID action of the service.
+//
+// Return values:
+//   - NewSSID (out, state variable SSID)
+func (client *WLANConfiguration1) GetSSID() (NewSSID string, err error) {
+	return client.GetSSIDCtx(context.Background())
+}
+
+// GetSSIDCtx is GetSSID with a context, to cancel or time out the call.
+func (client *WLANConfiguration1) GetSSIDCtx(ctx context.Context) (NewSSID string, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &WLANConfiguration1GetSSIDResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetSSID", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if NewSSID, err = soap.UnmarshalString(response.NewSSID); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// WLANConfiguration1SetSSIDRequest is the request of SetSSID, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WLANConfiguration1SetSSIDRequest struct {
+	NewSSID string
+}
+
+// SetSSID performs the SetSSID action of the service.
+//
+// Arguments:
+//   - NewSSID (in, state variable SSID)
+func (client *WLANConfiguration1) SetSSID(NewSSID string) (err error) {
+	return client.SetSSIDCtx(context.Background(), NewSSID)
+}
+
+// SetSSIDCtx is SetSSID with a context, to cancel or time out the call.
+func (client *WLANConfiguration1) SetSSIDCtx(ctx context.Context, NewSSID string) (err error) {
+	// Request structure.
+	request := &WLANConfiguration1SetSSIDRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewSSID, err = soap.MarshalString(NewSSID); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SetSSID", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// WLANConfiguration1GetBSSIDResponse is the response of GetBSSID, with each
+// argument in its SOAP string form.
+type WLANConfiguration1GetBSSIDResponse struct {
+	NewBSSID string
+}
+
+// GetBSSID performs the GetBSSID action of the service.
+//
+// Return values:
+//   - NewBSSID (out, state variable BSSID)
+func (client *WLANConfiguration1) GetBSSID() (NewBSSID string, err error) {
+	return client.GetBSSIDCtx(context.Background())
+}
+
+// GetBSSIDCtx is GetBSSID with a context, to cancel or time out the call.
+func (client *WLANConfiguration1) GetBSSIDCtx(ctx context.Context) (NewBSSID string, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &WLANConfiguration1GetBSSIDResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetBSSID", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if NewBSSID, err = soap.UnmarshalString(response.NewBSSID); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// WLANConfiguration1GetChannelInfoResponse is the response of GetChannelInfo, with each
+// argument in its SOAP string form.
+type WLANConfiguration1GetChannelInfoResponse struct {
+	NewChannel          string
+	NewPossibleChannels string
+}
+
+// GetChannelInfo performs the GetChannelInfo action of the service.
+//
+// Return values:
+//   - NewChannel (out, state variable Channel)
+//   - NewPossibleChannels (out, state variable PossibleChannels)
+func (client *WLANConfiguration1) GetChannelInfo() (NewChannel uint8, NewPossibleChannels string, err error) {
+	return client.GetChannelInfoCtx(context.Background())
+}
+
+// GetChannelInfoCtx is GetChannelInfo with a context, to cancel or time out the call.
+func (client *WLANConfiguration1) GetChannelInfoCtx(ctx context.Context) (NewChannel uint8, NewPossibleChannels string, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &WLANConfiguration1GetChannelInfoResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetChannelInfo", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if NewChannel, err = soap.UnmarshalUi1(response.NewChannel); err != nil {
+		return
+	}
+	if NewPossibleChannels, err = soap.UnmarshalString(response.NewPossibleChannels); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// WLANConfiguration1SetChannelRequest is the request of SetChannel, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WLANConfiguration1SetChannelRequest struct {
+	NewChannel string
+}
+
+// SetChannel performs the SetChannel action of the service.
+//
+// Arguments:
+//   - NewChannel (in, state variable Channel)
+func (client *WLANConfiguration1) SetChannel(NewChannel uint8) (err error) {
+	return client.SetChannelCtx(context.Background(), NewChannel)
+}
+
+// SetChannelCtx is SetChannel with a context, to cancel or time out the call.
+func (client *WLANConfiguration1) SetChannelCtx(ctx context.Context, NewChannel uint8) (err error) {
+	// Request structure.
+	request := &WLANConfiguration1SetChannelRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewChannel, err = soap.MarshalUi1(NewChannel); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SetChannel", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// WLANConfiguration1GetBeaconTypeResponse is the response of GetBeaconType, with each
+// argument in its SOAP string form.
+type WLANConfiguration1GetBeaconTypeResponse struct {
+	NewBeaconType string
+}
+
+// GetBeaconType performs the GetBeaconType action of the service.
+//
+// Return values:
+//   - NewBeaconType (out, state variable BeaconType): allowed values None, Basic, WPA, 11i, WPAand11i
+func (client *WLANConfiguration1) GetBeaconType() (NewBeaconType WLANConfiguration1BeaconType, err error) {
+	return client.GetBeaconTypeCtx(context.Background())
+}
+
+// GetBeaconTypeCtx is GetBeaconType with a context, to cancel or time out the call.
+func (client *WLANConfiguration1) GetBeaconTypeCtx(ctx context.Context) (NewBeaconType WLANConfiguration1BeaconType, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &WLANConfiguration1GetBeaconTypeResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetBeaconType", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	NewBeaconType = WLANConfiguration1BeaconType(response.NewBeaconType)
+	// END Unmarshal arguments from response.
+	return
+}
+
+// WLANConfiguration1SetBeaconTypeRequest is the request of SetBeaconType, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WLANConfiguration1SetBeaconTypeRequest struct {
+	NewBeaconType string
+}
+
+// SetBeaconType performs the SetBeaconType action of the service.
+//
+// Arguments:
+//   - NewBeaconType (in, state variable BeaconType): allowed values None, Basic, WPA, 11i, WPAand11i
+func (client *WLANConfiguration1) SetBeaconType(NewBeaconType WLANConfiguration1BeaconType) (err error) {
+	return client.SetBeaconTypeCtx(context.Background(), NewBeaconType)
+}
+
+// SetBeaconTypeCtx is SetBeaconType with a context, to cancel or time out the call.
+func (client *WLANConfiguration1) SetBeaconTypeCtx(ctx context.Context, NewBeaconType WLANConfiguration1BeaconType) (err error) {
+	// Request structure.
+	request := &WLANConfiguration1SetBeaconTypeRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewBeaconType, err = soap.MarshalString(string(NewBeaconType)); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SetBeaconType", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// WLANConfiguration1GetSecurityKeysResponse is the response of GetSecurityKeys, with each
+// argument in its SOAP string form.
+type WLANConfiguration1GetSecurityKeysResponse struct {
+	NewWEPKey0       string
+	NewWEPKey1       string
+	NewWEPKey2       string
+	NewWEPKey3       string
+	NewPreSharedKey  string
+	NewKeyPassphrase string
+}
+
+// GetSecurityKeys performs the GetSecurityKeys action of the service.
+//
+// Return values:
+//   - NewWEPKey0 (out, state variable WEPKey)
+//   - NewWEPKey1 (out, state variable WEPKey)
+//   - NewWEPKey2 (out, state variable WEPKey)
+//   - NewWEPKey3 (out, state variable WEPKey)
+//   - NewPreSharedKey (out, state variable PreSharedKey)
+//   - NewKeyPassphrase (out, state variable KeyPassphrase)
+func (client *WLANConfiguration1) GetSecurityKeys() (NewWEPKey0 string, NewWEPKey1 string, NewWEPKey2 string, NewWEPKey3 string, NewPreSharedKey string, NewKeyPassphrase string, err error) {
+	return client.GetSecurityKeysCtx(context.Background())
+}
+
+// GetSecurityKeysCtx is GetSecurityKeys with a context, to cancel or time out the call.
+func (client *WLANConfiguration1) GetSecurityKeysCtx(ctx context.Context) (NewWEPKey0 string, NewWEPKey1 string, NewWEPKey2 string, NewWEPKey3 string, NewPreSharedKey string, NewKeyPassphrase string, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &WLANConfiguration1GetSecurityKeysResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetSecurityKeys", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if NewWEPKey0, err = soap.UnmarshalString(response.NewWEPKey0); err != nil {
+		return
+	}
+	if NewWEPKey1, err = soap.UnmarshalString(response.NewWEPKey1); err != nil {
+		return
+	}
+	if NewWEPKey2, err = soap.UnmarshalString(response.NewWEPKey2); err != nil {
+		return
+	}
+	if NewWEPKey3, err = soap.UnmarshalString(response.NewWEPKey3); err != nil {
+		return
+	}
+	if NewPreSharedKey, err = soap.UnmarshalString(response.NewPreSharedKey); err != nil {
+		return
+	}
+	if NewKeyPassphrase, err = soap.UnmarshalString(response.NewKeyPassphrase); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// WLANConfiguration1SetSecurityKeysRequest is the request of SetSecurityKeys, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WLANConfiguration1SetSecurityKeysRequest struct {
+	NewWEPKey0       string
+	NewWEPKey1       string
+	NewWEPKey2       string
+	NewWEPKey3       string
+	NewPreSharedKey  string
+	NewKeyPassphrase string
+}
+
+// SetSecurityKeys performs the SetSecurityKeys action of the service.
+//
+// Arguments:
+//   - NewWEPKey0 (in, state variable WEPKey)
+//   - NewWEPKey1 (in, state variable WEPKey)
+//   - NewWEPKey2 (in, state variable WEPKey)
+//   - NewWEPKey3 (in, state variable WEPKey)
+//   - NewPreSharedKey (in, state variable PreSharedKey)
+//   - NewKeyPassphrase (in, state variable KeyPassphrase)
+func (client *WLANConfiguration1) SetSecurityKeys(NewWEPKey0 string, NewWEPKey1 string, NewWEPKey2 string, NewWEPKey3 string, NewPreSharedKey string, NewKeyPassphrase string) (err error) {
+	return client.SetSecurityKeysCtx(context.Background(), NewWEPKey0, NewWEPKey1, NewWEPKey2, NewWEPKey3, NewPreSharedKey, NewKeyPassphrase)
+}
+
+// SetSecurityKeysCtx is SetSecurityKeys with a context, to cancel or time out the call.
+func (client *WLANConfiguration1) SetSecurityKeysCtx(ctx context.Context, NewWEPKey0 string, NewWEPKey1 string, NewWEPKey2 string, NewWEPKey3 string, NewPreSharedKey string, NewKeyPassphrase string) (err error) {
+	// Request structure.
+	request := &WLANConfiguration1SetSecurityKeysRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewWEPKey0, err = soap.MarshalString(NewWEPKey0); err != nil {
+		return
+	}
+	if request.NewWEPKey1, err = soap.MarshalString(NewWEPKey1); err != nil {
+		return
+	}
+	if request.NewWEPKey2, err = soap.MarshalString(NewWEPKey2); err != nil {
+		return
+	}
+	if request.NewWEPKey3, err = soap.MarshalString(NewWEPKey3); err != nil {
+		return
+	}
+	if request.NewPreSharedKey, err = soap.MarshalString(NewPreSharedKey); err != nil {
+		return
+	}
+	if request.NewKeyPassphrase, err = soap.MarshalString(NewKeyPassphrase); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SetSecurityKeys", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// WLANConfiguration1GetTotalAssociationsResponse is the response of GetTotalAssociations, with each
+// argument in its SOAP string form.
+type WLANConfiguration1GetTotalAssociationsResponse struct {
+	NewTotalAssociations string
+}
+
+// GetTotalAssociations performs the GetTotalAssociations action of the service.
+//
+// Return values:
+//   - NewTotalAssociations (out, state variable TotalAssociations)
+func (client *WLANConfiguration1) GetTotalAssociations() (NewTotalAssociations uint16, err error) {
+	return client.GetTotalAssociationsCtx(context.Background())
+}
+
+// GetTotalAssociationsCtx is GetTotalAssociations with a context, to cancel or time out the call.
+func (client *WLANConfiguration1) GetTotalAssociationsCtx(ctx context.Context) (NewTotalAssociations uint16, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &WLANConfiguration1GetTotalAssociationsResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetTotalAssociations", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if NewTotalAssociations, err = soap.UnmarshalUi2(response.NewTotalAssociations); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// WLANConfiguration1GetGenericAssociatedDeviceInfoRequest is the request of GetGenericAssociatedDeviceInfo, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WLANConfiguration1GetGenericAssociatedDeviceInfoRequest struct {
+	NewAssociatedDeviceIndex string
+}
+
+// WLANConfiguration1GetGenericAssociatedDeviceInfoResponse is the response of GetGenericAssociatedDeviceInfo, with each
+// argument in its SOAP string form.
+type WLANConfiguration1GetGenericAssociatedDeviceInfoResponse struct {
+	NewAssociatedDeviceMACAddress string
+	NewAssociatedDeviceIPAddress  string
+	NewAssociatedDeviceAuthState  string
+}
+
+// GetGenericAssociatedDeviceInfo performs the GetGenericAssociatedDeviceInfo action of the service.
+//
+// Arguments:
+//   - NewAssociatedDeviceIndex (in, state variable AssociatedDeviceIndex)
+//
+// Return values:
+//   - NewAssociatedDeviceMACAddress (out, state variable AssociatedDeviceMACAddress)
+//   - NewAssociatedDeviceIPAddress (out, state variable AssociatedDeviceIPAddress)
+//   - NewAssociatedDeviceAuthState (out, state variable AssociatedDeviceAuthState)
+func (client *WLANConfiguration1) GetGenericAssociatedDeviceInfo(NewAssociatedDeviceIndex uint16) (NewAssociatedDeviceMACAddress string, NewAssociatedDeviceIPAddress string, NewAssociatedDeviceAuthState bool, err error) {
+	return client.GetGenericAssociatedDeviceInfoCtx(context.Background(), NewAssociatedDeviceIndex)
+}
+
+// GetGenericAssociatedDeviceInfoCtx is GetGenericAssociatedDeviceInfo with a context, to cancel or time out the call.
+func (client *WLANConfiguration1) GetGenericAssociatedDeviceInfoCtx(ctx context.Context, NewAssociatedDeviceIndex uint16) (NewAssociatedDeviceMACAddress string, NewAssociatedDeviceIPAddress string, NewAssociatedDeviceAuthState bool, err error) {
+	// Request structure.
+	request := &WLANConfiguration1GetGenericAssociatedDeviceInfoRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewAssociatedDeviceIndex, err = soap.MarshalUi2(NewAssociatedDeviceIndex); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &WLANConfiguration1GetGenericAssociatedDeviceInfoResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetGenericAssociatedDeviceInfo", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if NewAssociatedDeviceMACAddress, err = soap.UnmarshalString(response.NewAssociatedDeviceMACAddress); err != nil {
+		return
+	}
+	if NewAssociatedDeviceIPAddress, err = soap.UnmarshalString(response.NewAssociatedDeviceIPAddress); err != nil {
+		return
+	}
+	if NewAssociatedDeviceAuthState, err = soap.UnmarshalBoolean(response.NewAssociatedDeviceAuthState); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// WLANConfiguration1GetSpecificAssociatedDeviceInfoRequest is the request of GetSpecificAssociatedDeviceInfo, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WLANConfiguration1GetSpecificAssociatedDeviceInfoRequest struct {
+	NewAssociatedDeviceMACAddress string
+}
+
+// WLANConfiguration1GetSpecificAssociatedDeviceInfoResponse is the response of GetSpecificAssociatedDeviceInfo, with each
+// argument in its SOAP string form.
+type WLANConfiguration1GetSpecificAssociatedDeviceInfoResponse struct {
+	NewAssociatedDeviceIPAddress string
+	NewAssociatedDeviceAuthState string
+}
+
+// GetSpecificAssociatedDeviceInfo performs the GetSpecificAssociatedDeviceInfo action of the service.
+//
+// Arguments:
+//   - NewAssociatedDeviceMACAddress (in, state variable AssociatedDeviceMACAddress)
+//
+// Return values:
+//   - NewAssociatedDeviceIPAddress (out, state variable AssociatedDeviceIPAddress)
+//   - NewAssociatedDeviceAuthState (out, state variable AssociatedDeviceAuthState)
+func (client *WLANConfiguration1) GetSpecificAssociatedDeviceInfo(NewAssociatedDeviceMACAddress string) (NewAssociatedDeviceIPAddress string, NewAssociatedDeviceAuthState bool, err error) {
+	return client.GetSpecificAssociatedDeviceInfoCtx(context.Background(), NewAssociatedDeviceMACAddress)
+}
+
+// GetSpecificAssociatedDeviceInfoCtx is GetSpecificAssociatedDeviceInfo with a context, to cancel or time out the call.
+func (client *WLANConfiguration1) GetSpecificAssociatedDeviceInfoCtx(ctx context.Context, NewAssociatedDeviceMACAddress string) (NewAssociatedDeviceIPAddress string, NewAssociatedDeviceAuthState bool, err error) {
+	// Request structure.
+	request := &WLANConfiguration1GetSpecificAssociatedDeviceInfoRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewAssociatedDeviceMACAddress, err = soap.MarshalString(NewAssociatedDeviceMACAddress); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &WLANConfiguration1GetSpecificAssociatedDeviceInfoResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetSpecificAssociatedDeviceInfo", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if NewAssociatedDeviceIPAddress, err = soap.UnmarshalString(response.NewAssociatedDeviceIPAddress); err != nil {
+		return
+	}
+	if NewAssociatedDeviceAuthState, err = soap.UnmarshalBoolean(response.NewAssociatedDeviceAuthState); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// WLANConfiguration1GetStatisticsResponse is the response of GetStatistics, with each
+// argument in its SOAP string form.
+type WLANConfiguration1GetStatisticsResponse struct {
+	NewTotalPacketsSent     string
+	NewTotalPacketsReceived string
+}
+
+// GetStatistics performs the GetStatistics action of the service.
+//
+// Return values:
+//   - NewTotalPacketsSent (out, state variable TotalPacketsSent)
+//   - NewTotalPacketsReceived (out, state variable TotalPacketsReceived)
+func (client *WLANConfiguration1) GetStatistics() (NewTotalPacketsSent uint32, NewTotalPacketsReceived uint32, err error) {
+	return client.GetStatisticsCtx(context.Background())
+}
+
+// GetStatisticsCtx is GetStatistics with a context, to cancel or time out the call.
+func (client *WLANConfiguration1) GetStatisticsCtx(ctx context.Context) (NewTotalPacketsSent uint32, NewTotalPacketsReceived uint32, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &WLANConfiguration1GetStatisticsResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetStatistics", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if NewTotalPacketsSent, err = soap.UnmarshalUi4(response.NewTotalPacketsSent); err != nil {
+		return
+	}
+	if NewTotalPacketsReceived, err = soap.UnmarshalUi4(response.NewTotalPacketsReceived); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// X_AVM_DE_OnTel1 is a client for UPnP SOAP service with URN "urn:dslforum-org:service:X_AVM-DE_OnTel:1". See
+// goupnp.ServiceClient, which contains RootDevice and Service attributes which
+// are provided for informational value.
+type X_AVM_DE_OnTel1 struct {
+	goupnp.ServiceClient
+}
+
+// X_AVM_DE_OnTel1Client is the interface of the actions of X_AVM_DE_OnTel1, for
+// substituting fakes or mocks for the service in tests.
+type X_AVM_DE_OnTel1Client interface {
+	GetCallList() (NewCallListURL string, err error)
+	GetCallListCtx(ctx context.Context) (NewCallListURL string, err error)
+	GetPhonebookList() (NewPhonebookList string, err error)
+	GetPhonebookListCtx(ctx context.Context) (NewPhonebookList string, err error)
+	GetPhonebook(NewPhonebookID uint16) (NewPhonebookName string, NewPhonebookExtraID string, NewPhonebookURL string, err error)
+	GetPhonebookCtx(ctx context.Context, NewPhonebookID uint16) (NewPhonebookName string, NewPhonebookExtraID string, NewPhonebookURL string, err error)
+	GetPhonebookEntry(NewPhonebookID uint16, NewPhonebookEntryID uint32) (NewPhonebookEntryData string, err error)
+	GetPhonebookEntryCtx(ctx context.Context, NewPhonebookID uint16, NewPhonebookEntryID uint32) (NewPhonebookEntryData string, err error)
+	SetPhonebookEntry(NewPhonebookID uint16, NewPhonebookEntryID string, NewPhonebookEntryData string) (err error)
+	SetPhonebookEntryCtx(ctx context.Context, NewPhonebookID uint16, NewPhonebookEntryID string, NewPhonebookEntryData string) (err error)
+	DeletePhonebookEntry(NewPhonebookID uint16, NewPhonebookEntryID uint32) (err error)
+	DeletePhonebookEntryCtx(ctx context.Context, NewPhonebookID uint16, NewPhonebookEntryID uint32) (err error)
+	GetDECTHandsetList() (NewDectIDList string, err error)
+	GetDECTHandsetListCtx(ctx context.Context) (NewDectIDList string, err error)
+	GetNumberOfDeflections() (NewNumberOfDeflections uint16, err error)
+	GetNumberOfDeflectionsCtx(ctx context.Context) (NewNumberOfDeflections uint16, err error)
+	GetDeflections() (NewDeflectionList string, err error)
+	GetDeflectionsCtx(ctx context.Context) (NewDeflectionList string, err error)
+	SetDeflectionEnable(NewDeflectionId uint16, NewEnable bool) (err error)
+	SetDeflectionEnableCtx(ctx context.Context, NewDeflectionId uint16, NewEnable bool) (err error)
+}
+
+var _ X_AVM_DE_OnTel1Client = new(X_AVM_DE_OnTel1)
+
+// NewX_AVM_DE_OnTel1Clients discovers instances of the service on the network,
+// and returns clients to any that are found. errors will contain an error for
+// any devices that replied but which could not be queried, and err will be set
+// if the discovery process failed outright.
+//
+// This is a typical entry calling point into this package.
+func NewX_AVM_DE_OnTel1Clients() (clients []*X_AVM_DE_OnTel1, errors []error, err error) {
+	var genericClients []goupnp.ServiceClient
+	if genericClients, errors, err = goupnp.NewServiceClients(URN_X_AVM_DE_OnTel_1); err != nil {
+		return
+	}
+	clients = newX_AVM_DE_OnTel1ClientsFromGenericClients(genericClients)
+	return
+}
+
+// NewX_AVM_DE_OnTel1ClientsByURL discovers instances of the service at the given
+// URL, and returns clients to any that are found. An error is returned if
+// there was an error probing the service.
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered service URL.
+func NewX_AVM_DE_OnTel1ClientsByURL(loc *url.URL) ([]*X_AVM_DE_OnTel1, error) {
+	genericClients, err := goupnp.NewServiceClientsByURL(loc, URN_X_AVM_DE_OnTel_1)
+	if err != nil {
+		return nil, err
+	}
+	return newX_AVM_DE_OnTel1ClientsFromGenericClients(genericClients), nil
+}
+
+// NewX_AVM_DE_OnTel1ClientsFromRootDevice discovers instances of the service in
+// a given root device, and returns clients to any that are found. An error is
+// returned if there was not at least one instance of the service within the
+// device. The location parameter is simply assigned to the Location attribute
+// of the wrapped ServiceClient(s).
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered root device.
+func NewX_AVM_DE_OnTel1ClientsFromRootDevice(rootDevice *goupnp.RootDevice, loc *url.URL) ([]*X_AVM_DE_OnTel1, error) {
+	genericClients, err := goupnp.NewServiceClientsFromRootDevice(rootDevice, loc, URN_X_AVM_DE_OnTel_1)
+	if err != nil {
+		return nil, err
+	}
+	return newX_AVM_DE_OnTel1ClientsFromGenericClients(genericClients), nil
+}
+
+func newX_AVM_DE_OnTel1ClientsFromGenericClients(genericClients []goupnp.ServiceClient) []*X_AVM_DE_OnTel1 {
+	clients := make([]*X_AVM_DE_OnTel1, len(genericClients))
+	for i := range genericClients {
+		clients[i] = &X_AVM_DE_OnTel1{genericClients[i]}
+	}
+	return clients
+}
+
+// X_AVM_DE_OnTel1Timeouts are the default timeouts of the actions of
+// X_AVM_DE_OnTel1, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var X_AVM_DE_OnTel1Timeouts = map[string]time.Duration{}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *X_AVM_DE_OnTel1) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, X_AVM_DE_OnTel1Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_X_AVM_DE_OnTel_1, actionName, request, response)
+}
+
+// X_AVM_DE_OnTel1GetCallListResponse is the response of GetCallList, with each
+// argument in its SOAP string form.
+type X_AVM_DE_OnTel1GetCallListResponse struct {
+	NewCallListURL string
+}
+
+// GetCallList performs the GetCallList action of the service.
+//
+// Return values:
+//   - NewCallListURL (out, state variable CallListURL)
+func (client *X_AVM_DE_OnTel1) GetCallList() (NewCallListURL string, err error) {
+	return client.GetCallListCtx(context.Background())
+}
+
+// GetCallListCtx is GetCallList with a context, to cancel or time out the call.
+func (client *X_AVM_DE_OnTel1) GetCallListCtx(ctx context.Context) (NewCallListURL string, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &X_AVM_DE_OnTel1GetCallListResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetCallList", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if NewCallListURL, err = soap.UnmarshalString(response.NewCallListURL); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// X_AVM_DE_OnTel1GetPhonebookListResponse is the response of GetPhonebookList, with each
+// argument in its SOAP string form.
+type X_AVM_DE_OnTel1GetPhonebookListResponse struct {
+	NewPhonebookList string
+}
+
+// GetPhonebookList performs the GetPhonebookList action of the service.
+//
+// Return values:
+//   - NewPhonebookList (out, state variable PhonebookList)
+func (client *X_AVM_DE_OnTel1) GetPhonebookList() (NewPhonebookList string, err error) {
+	return client.GetPhonebookListCtx(context.Background())
+}
+
+// GetPhonebookListCtx is GetPhonebookList with a context, to cancel or time out the call.
+func (client *X_AVM_DE_OnTel1) GetPhonebookListCtx(ctx context.Context) (NewPhonebookList string, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &X_AVM_DE_OnTel1GetPhonebookListResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetPhonebookList", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if NewPhonebookList, err = soap.UnmarshalString(response.NewPhonebookList); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// X_AVM_DE_OnTel1GetPhonebookRequest is the request of GetPhonebook, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type X_AVM_DE_OnTel1GetPhonebookRequest struct {
+	NewPhonebookID string
+}
+
+// X_AVM_DE_OnTel1GetPhonebookResponse is the response of GetPhonebook, with each
+// argument in its SOAP string form.
+type X_AVM_DE_OnTel1GetPhonebookResponse struct {
+	NewPhonebookName    string
+	NewPhonebookExtraID string
+	NewPhonebookURL     string
+}
+
+// GetPhonebook performs the GetPhonebook action of the service.
+//
+// Arguments:
+//   - NewPhonebookID (in, state variable PhonebookID)
+//
+// Return values:
+//   - NewPhonebookName (out, state variable PhonebookName)
+//   - NewPhonebookExtraID (out, state variable PhonebookExtraID)
+//   - NewPhonebookURL (out, state variable PhonebookURL)
+func (client *X_AVM_DE_OnTel1) GetPhonebook(NewPhonebookID uint16) (NewPhonebookName string, NewPhonebookExtraID string, NewPhonebookURL string, err error) {
+	return client.GetPhonebookCtx(context.Background(), NewPhonebookID)
+}
+
+// GetPhonebookCtx is GetPhonebook with a context, to cancel or time out the call.
+func (client *X_AVM_DE_OnTel1) GetPhonebookCtx(ctx context.Context, NewPhonebookID uint16) (NewPhonebookName string, NewPhonebookExtraID string, NewPhonebookURL string, err error) {
+	// Request structure.
+	request := &X_AVM_DE_OnTel1GetPhonebookRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewPhonebookID, err = soap.MarshalUi2(NewPhonebookID); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &X_AVM_DE_OnTel1GetPhonebookResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetPhonebook", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if NewPhonebookName, err = soap.UnmarshalString(response.NewPhonebookName); err != nil {
+		return
+	}
+	if NewPhonebookExtraID, err = soap.UnmarshalString(response.NewPhonebookExtraID); err != nil {
+		return
+	}
+	if NewPhonebookURL, err = soap.UnmarshalString(response.NewPhonebookURL); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// X_AVM_DE_OnTel1GetPhonebookEntryRequest is the request of GetPhonebookEntry, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type X_AVM_DE_OnTel1GetPhonebookEntryRequest struct {
+	NewPhonebookID      string
+	NewPhonebookEntryID string
+}
+
+// X_AVM_DE_OnTel1GetPhonebookEntryResponse is the response of GetPhonebookEntry, with each
+// argument in its SOAP string form.
+type X_AVM_DE_OnTel1GetPhonebookEntryResponse struct {
+	NewPhonebookEntryData string
+}
+
+// GetPhonebookEntry performs the GetPhonebookEntry action of the service.
+//
+// Arguments:
+//   - NewPhonebookID (in, state variable PhonebookID)
+//   - NewPhonebookEntryID (in, state variable PhonebookEntryID)
+//
+// Return values:
+//   - NewPhonebookEntryData (out, state variable PhonebookEntryData)
+func (client *X_AVM_DE_OnTel1) GetPhonebookEntry(NewPhonebookID uint16, NewPhonebookEntryID uint32) (NewPhonebookEntryData string, err error) {
+	return client.GetPhonebookEntryCtx(context.Background(), NewPhonebookID, NewPhonebookEntryID)
+}
+
+// GetPhonebookEntryCtx is GetPhonebookEntry with a context, to cancel or time out the call.
+func (client *X_AVM_DE_OnTel1) GetPhonebookEntryCtx(ctx context.Context, NewPhonebookID uint16, NewPhonebookEntryID uint32) (NewPhonebookEntryData string, err error) {
+	// Request structure.
+	request := &X_AVM_DE_OnTel1GetPhonebookEntryRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewPhonebookID, err = soap.MarshalUi2(NewPhonebookID); err != nil {
+		return
+	}
+	if request.NewPhonebookEntryID, err = soap.MarshalUi4(NewPhonebookEntryID); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &X_AVM_DE_OnTel1GetPhonebookEntryResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetPhonebookEntry", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if NewPhonebookEntryData, err = soap.UnmarshalString(response.NewPhonebookEntryData); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// X_AVM_DE_OnTel1SetPhonebookEntryRequest is the request of SetPhonebookEntry, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type X_AVM_DE_OnTel1SetPhonebookEntryRequest struct {
+	NewPhonebookID        string
+	NewPhonebookEntryID   string
+	NewPhonebookEntryData string
+}
+
+// SetPhonebookEntry performs the SetPhonebookEntry action of the service.
+//
+// Arguments:
+//   - NewPhonebookID (in, state variable PhonebookID)
+//   - NewPhonebookEntryID (in, state variable OptionalPhonebookEntryID)
+//   - NewPhonebookEntryData (in, state variable PhonebookEntryData)
+func (client *X_AVM_DE_OnTel1) SetPhonebookEntry(NewPhonebookID uint16, NewPhonebookEntryID string, NewPhonebookEntryData string) (err error) {
+	return client.SetPhonebookEntryCtx(context.Background(), NewPhonebookID, NewPhonebookEntryID, NewPhonebookEntryData)
+}
+
+// SetPhonebookEntryCtx is SetPhonebookEntry with a context, to cancel or time out the call.
+func (client *X_AVM_DE_OnTel1) SetPhonebookEntryCtx(ctx context.Context, NewPhonebookID uint16, NewPhonebookEntryID string, NewPhonebookEntryData string) (err error) {
+	// Request structure.
+	request := &X_AVM_DE_OnTel1SetPhonebookEntryRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewPhonebookID, err = soap.MarshalUi2(NewPhonebookID); err != nil {
+		return
+	}
+	if request.NewPhonebookEntryID, err = soap.MarshalString(NewPhonebookEntryID); err != nil {
+		return
+	}
+	if request.NewPhonebookEntryData, err = soap.MarshalString(NewPhonebookEntryData); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SetPhonebookEntry", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// X_AVM_DE_OnTel1DeletePhonebookEntryRequest is the request of DeletePhonebookEntry, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type X_AVM_DE_OnTel1DeletePhonebookEntryRequest struct {
+	NewPhonebookID      string
+	NewPhonebookEntryID string
+}
+
+// DeletePhonebookEntry performs the DeletePhonebookEntry action of the service.
+//
+// Arguments:
+//   - NewPhonebookID (in, state variable PhonebookID)
+//   - NewPhonebookEntryID (in, state variable PhonebookEntryID)
+func (client *X_AVM_DE_OnTel1) DeletePhonebookEntry(NewPhonebookID uint16, NewPhonebookEntryID uint32) (err error) {
+	return client.DeletePhonebookEntryCtx(context.Background(), NewPhonebookID, NewPhonebookEntryID)
+}
+
+// DeletePhonebookEntryCtx is DeletePhonebookEntry with a context, to cancel or time out the call.
+func (client *X_AVM_DE_OnTel1) DeletePhonebookEntryCtx(ctx context.Context, NewPhonebookID uint16, NewPhonebookEntryID uint32) (err error) {
+	// Request structure.
+	request := &X_AVM_DE_OnTel1DeletePhonebookEntryRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewPhonebookID, err = soap.MarshalUi2(NewPhonebookID); err != nil {
+		return
+	}
+	if request.NewPhonebookEntryID, err = soap.MarshalUi4(NewPhonebookEntryID); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "DeletePhonebookEntry", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// X_AVM_DE_OnTel1GetDECTHandsetListResponse is the response of GetDECTHandsetList, with each
+// argument in its SOAP string form.
+type X_AVM_DE_OnTel1GetDECTHandsetListResponse struct {
+	NewDectIDList string
+}
+
+// GetDECTHandsetList performs the GetDECTHandsetList action of the service.
+//
+// Return values:
+//   - NewDectIDList (out, state variable DectIDList)
+func (client *X_AVM_DE_OnTel1) GetDECTHandsetList() (NewDectIDList string, err error) {
+	return client.GetDECTHandsetListCtx(context.Background())
+}
+
+// GetDECTHandsetListCtx is GetDECTHandsetList with a context, to cancel or time out the call.
+func (client *X_AVM_DE_OnTel1) GetDECTHandsetListCtx(ctx context.Context) (NewDectIDList string, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &X_AVM_DE_OnTel1GetDECTHandsetListResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetDECTHandsetList", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if NewDectIDList, err = soap.UnmarshalString(response.NewDectIDList); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// X_AVM_DE_OnTel1GetNumberOfDeflectionsResponse is the response of GetNumberOfDeflections, with each
+// argument in its SOAP string form.
+type X_AVM_DE_OnTel1GetNumberOfDeflectionsResponse struct {
+	NewNumberOfDeflections string
+}
+
+// GetNumberOfDeflections performs the GetNumberOfDeflections action of the service.
+//
+// Return values:
+//   - NewNumberOfDeflections (out, state variable NumberOfDeflections)
+func (client *X_AVM_DE_OnTel1) GetNumberOfDeflections() (NewNumberOfDeflections uint16, err error) {
+	return client.GetNumberOfDeflectionsCtx(context.Background())
+}
+
+// GetNumberOfDeflectionsCtx is GetNumberOfDeflections with a context, to cancel or time out the call.
+func (client *X_AVM_DE_OnTel1) GetNumberOfDeflectionsCtx(ctx context.Context) (NewNumberOfDeflections uint16, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &X_AVM_DE_OnTel1GetNumberOfDeflectionsResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetNumberOfDeflections", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if NewNumberOfDeflections, err = soap.UnmarshalUi2(response.NewNumberOfDeflections); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// X_AVM_DE_OnTel1GetDeflectionsResponse is the response of GetDeflections, with each
+// argument in its SOAP string form.
+type X_AVM_DE_OnTel1GetDeflectionsResponse struct {
+	NewDeflectionList string
+}
+
+// GetDeflections performs the GetDeflections action of the service.
+//
+// Return values:
+//   - NewDeflectionList (out, state variable DeflectionList)
+func (client *X_AVM_DE_OnTel1) GetDeflections() (NewDeflectionList string, err error) {
+	return client.GetDeflectionsCtx(context.Background())
+}
+
+// GetDeflectionsCtx is GetDeflections with a context, to cancel or time out the call.
+func (client *X_AVM_DE_OnTel1) GetDeflectionsCtx(ctx context.Context) (NewDeflectionList string, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &X_AVM_DE_OnTel1GetDeflectionsResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetDeflections", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if NewDeflectionList, err = soap.UnmarshalString(response.NewDeflectionList); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// X_AVM_DE_OnTel1SetDeflectionEnableRequest is the request of SetDeflectionEnable, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type X_AVM_DE_OnTel1SetDeflectionEnableRequest struct {
+	NewDeflectionId string
+	NewEnable       string
+}
+
+// SetDeflectionEnable performs the SetDeflectionEnable action of the service.
+//
+// Arguments:
+//   - NewDeflectionId (in, state variable DeflectionId)
+//   - NewEnable (in, state variable Enable)
+func (client *X_AVM_DE_OnTel1) SetDeflectionEnable(NewDeflectionId uint16, NewEnable bool) (err error) {
+	return client.SetDeflectionEnableCtx(context.Background(), NewDeflectionId, NewEnable)
+}
+
+// SetDeflectionEnableCtx is SetDeflectionEnable with a context, to cancel or time out the call.
+func (client *X_AVM_DE_OnTel1) SetDeflectionEnableCtx(ctx context.Context, NewDeflectionId uint16, NewEnable bool) (err error) {
+	// Request structure.
+	request := &X_AVM_DE_OnTel1SetDeflectionEnableRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewDeflectionId, err = soap.MarshalUi2(NewDeflectionId); err != nil {
+		return
+	}
+	if request.NewEnable, err = soap.MarshalBoolean(NewEnable); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SetDeflectionEnable", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// X_AVM_DE_Homeauto1 is a client for UPnP SOAP service with URN "urn:dslforum-org:service:X_AVM-DE_Homeauto:1". See
+// goupnp.ServiceClient, which contains RootDevice and Service attributes which
+// are provided for informational value.
+type X_AVM_DE_Homeauto1 struct {
+	goupnp.ServiceClient
+}
+
+// X_AVM_DE_Homeauto1Client is the interface of the actions of X_AVM_DE_Homeauto1, for
+// substituting fakes or mocks for the service in tests.
+type X_AVM_DE_Homeauto1Client interface {
+	GetInfo() (NewAllowedCharsAIN string, NewMaxCharsAIN uint16, NewMinCharsAIN uint16, NewMaxCharsDeviceName uint16, NewMinCharsDeviceName uint16, err error)
+	GetInfoCtx(ctx context.Context) (NewAllowedCharsAIN string, NewMaxCharsAIN uint16, NewMinCharsAIN uint16, NewMaxCharsDeviceName uint16, NewMinCharsDeviceName uint16, err error)
+	GetGenericDeviceInfos(NewIndex uint16) (NewAIN string, NewDeviceId uint16, NewFunctionBitMask uint16, NewFirmwareVersion string, NewManufacturer string, NewProductName string, NewDeviceName string, NewPresent X_AVM_DE_Homeauto1PresentEnum, NewMultimeterIsEnabled X_AVM_DE_Homeauto1EnabledEnum, NewMultimeterIsValid X_AVM_DE_Homeauto1ValidEnum, NewMultimeterPower uint32, NewMultimeterEnergy uint32, NewTemperatureIsEnabled X_AVM_DE_Homeauto1EnabledEnum, NewTemperatureIsValid X_AVM_DE_Homeauto1ValidEnum, NewTemperatureCelsius int32, NewTemperatureOffset int32, NewSwitchIsEnabled X_AVM_DE_Homeauto1EnabledEnum, NewSwitchIsValid X_AVM_DE_Homeauto1ValidEnum, NewSwitchState X_AVM_DE_Homeauto1SwStateEnum, NewSwitchMode X_AVM_DE_Homeauto1SwModeEnum, NewSwitchLock bool, err error)
+	GetGenericDeviceInfosCtx(ctx context.Context, NewIndex uint16) (NewAIN string, NewDeviceId uint16, NewFunctionBitMask uint16, NewFirmwareVersion string, NewManufacturer string, NewProductName string, NewDeviceName string, NewPresent X_AVM_DE_Homeauto1PresentEnum, NewMultimeterIsEnabled X_AVM_DE_Homeauto1EnabledEnum, NewMultimeterIsValid X_AVM_DE_Homeauto1ValidEnum, NewMultimeterPower uint32, NewMultimeterEnergy uint32, NewTemperatureIsEnabled X_AVM_DE_Homeauto1EnabledEnum, NewTemperatureIsValid X_AVM_DE_Homeauto1ValidEnum, NewTemperatureCelsius int32, NewTemperatureOffset int32, NewSwitchIsEnabled X_AVM_DE_Homeauto1EnabledEnum, NewSwitchIsValid X_AVM_DE_Homeauto1ValidEnum, NewSwitchState X_AVM_DE_Homeauto1SwStateEnum, NewSwitchMode X_AVM_DE_Homeauto1SwModeEnum, NewSwitchLock bool, err error)
+	GetSpecificDeviceInfos(NewAIN string) (NewDeviceId uint16, NewFunctionBitMask uint16, NewFirmwareVersion string, NewManufacturer string, NewProductName string, NewDeviceName string, NewPresent X_AVM_DE_Homeauto1PresentEnum, NewMultimeterIsEnabled X_AVM_DE_Homeauto1EnabledEnum, NewMultimeterIsValid X_AVM_DE_Homeauto1ValidEnum, NewMultimeterPower uint32, NewMultimeterEnergy uint32, NewTemperatureIsEnabled X_AVM_DE_Homeauto1EnabledEnum, NewTemperatureIsValid X_AVM_DE_Homeauto1ValidEnum, NewTemperatureCelsius int32, NewTemperatureOffset int32, NewSwitchIsEnabled X_AVM_DE_Homeauto1EnabledEnum, NewSwitchIsValid X_AVM_DE_Homeauto1ValidEnum, NewSwitchState X_AVM_DE_Homeauto1SwStateEnum, NewSwitchMode X_AVM_DE_Homeauto1SwModeEnum, NewSwitchLock bool, err error)
+	GetSpecificDeviceInfosCtx(ctx context.Context, NewAIN string) (NewDeviceId uint16, NewFunctionBitMask uint16, NewFirmwareVersion string, NewManufacturer string, NewProductName string, NewDeviceName string, NewPresent X_AVM_DE_Homeauto1PresentEnum, NewMultimeterIsEnabled X_AVM_DE_Homeauto1EnabledEnum, NewMultimeterIsValid X_AVM_DE_Homeauto1ValidEnum, NewMultimeterPower uint32, NewMultimeterEnergy uint32, NewTemperatureIsEnabled X_AVM_DE_Homeauto1EnabledEnum, NewTemperatureIsValid X_AVM_DE_Homeauto1ValidEnum, NewTemperatureCelsius int32, NewTemperatureOffset int32, NewSwitchIsEnabled X_AVM_DE_Homeauto1EnabledEnum, NewSwitchIsValid X_AVM_DE_Homeauto1ValidEnum, NewSwitchState X_AVM_DE_Homeauto1SwStateEnum, NewSwitchMode X_AVM_DE_Homeauto1SwModeEnum, NewSwitchLock bool, err error)
+	SetSwitch(NewAIN string, NewSwitchState X_AVM_DE_Homeauto1SwStateEnum) (err error)
+	SetSwitchCtx(ctx context.Context, NewAIN string, NewSwitchState X_AVM_DE_Homeauto1SwStateEnum) (err error)
+	SetDeviceName(NewAIN string, NewDeviceName string) (err error)
+	SetDeviceNameCtx(ctx context.Context, NewAIN string, NewDeviceName string) (err error)
+}
+
+var _ X_AVM_DE_Homeauto1Client = new(X_AVM_DE_Homeauto1)
+
+// X_AVM_DE_Homeauto1PresentEnum is a value of the state variable PresentEnum of
+// X_AVM_DE_Homeauto1.
+type X_AVM_DE_Homeauto1PresentEnum string
+
+// Allowed values of X_AVM_DE_Homeauto1PresentEnum.
+const (
+	X_AVM_DE_Homeauto1PresentEnum_DISCONNECTED X_AVM_DE_Homeauto1PresentEnum = "DISCONNECTED"
+	X_AVM_DE_Homeauto1PresentEnum_REGISTERED   X_AVM_DE_Homeauto1PresentEnum = "REGISTERED"
+	X_AVM_DE_Homeauto1PresentEnum_CONNECTED    X_AVM_DE_Homeauto1PresentEnum = "CONNECTED"
+	X_AVM_DE_Homeauto1PresentEnum_UNKNOWN      X_AVM_DE_Homeauto1PresentEnum = "UNKNOWN"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v X_AVM_DE_Homeauto1PresentEnum) Valid() bool {
+	switch v {
+	case X_AVM_DE_Homeauto1PresentEnum_DISCONNECTED,
+		X_AVM_DE_Homeauto1PresentEnum_REGISTERED,
+		X_AVM_DE_Homeauto1PresentEnum_CONNECTED,
+		X_AVM_DE_Homeauto1PresentEnum_UNKNOWN:
+		return true
+	}
+	return false
+}
+
+// X_AVM_DE_Homeauto1EnabledEnum is a value of the state variable EnabledEnum of
+// X_AVM_DE_Homeauto1.
+type X_AVM_DE_Homeauto1EnabledEnum string
+
+// Allowed values of X_AVM_DE_Homeauto1EnabledEnum.
+const (
+	X_AVM_DE_Homeauto1EnabledEnum_DISABLED  X_AVM_DE_Homeauto1EnabledEnum = "DISABLED"
+	X_AVM_DE_Homeauto1EnabledEnum_ENABLED   X_AVM_DE_Homeauto1EnabledEnum = "ENABLED"
+	X_AVM_DE_Homeauto1EnabledEnum_UNDEFINED X_AVM_DE_Homeauto1EnabledEnum = "UNDEFINED"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v X_AVM_DE_Homeauto1EnabledEnum) Valid() bool {
+	switch v {
+	case X_AVM_DE_Homeauto1EnabledEnum_DISABLED,
+		X_AVM_DE_Homeauto1EnabledEnum_ENABLED,
+		X_AVM_DE_Homeauto1EnabledEnum_UNDEFINED:
+		return true
+	}
+	return false
+}
+
+// X_AVM_DE_Homeauto1ValidEnum is a value of the state variable ValidEnum of
+// X_AVM_DE_Homeauto1.
+type X_AVM_DE_Homeauto1ValidEnum string
+
+// Allowed values of X_AVM_DE_Homeauto1ValidEnum.
+const (
+	X_AVM_DE_Homeauto1ValidEnum_INVALID   X_AVM_DE_Homeauto1ValidEnum = "INVALID"
+	X_AVM_DE_Homeauto1ValidEnum_VALID     X_AVM_DE_Homeauto1ValidEnum = "VALID"
+	X_AVM_DE_Homeauto1ValidEnum_UNDEFINED X_AVM_DE_Homeauto1ValidEnum = "UNDEFINED"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v X_AVM_DE_Homeauto1ValidEnum) Valid() bool {
+	switch v {
+	case X_AVM_DE_Homeauto1ValidEnum_INVALID,
+		X_AVM_DE_Homeauto1ValidEnum_VALID,
+		X_AVM_DE_Homeauto1ValidEnum_UNDEFINED:
+		return true
+	}
+	return false
+}
+
+// X_AVM_DE_Homeauto1SwStateEnum is a value of the state variable SwStateEnum of
+// X_AVM_DE_Homeauto1.
+type X_AVM_DE_Homeauto1SwStateEnum string
+
+// Allowed values of X_AVM_DE_Homeauto1SwStateEnum.
+const (
+	X_AVM_DE_Homeauto1SwStateEnum_OFF       X_AVM_DE_Homeauto1SwStateEnum = "OFF"
+	X_AVM_DE_Homeauto1SwStateEnum_ON        X_AVM_DE_Homeauto1SwStateEnum = "ON"
+	X_AVM_DE_Homeauto1SwStateEnum_TOGGLE    X_AVM_DE_Homeauto1SwStateEnum = "TOGGLE"
+	X_AVM_DE_Homeauto1SwStateEnum_UNDEFINED X_AVM_DE_Homeauto1SwStateEnum = "UNDEFINED"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v X_AVM_DE_Homeauto1SwStateEnum) Valid() bool {
+	switch v {
+	case X_AVM_DE_Homeauto1SwStateEnum_OFF,
+		X_AVM_DE_Homeauto1SwStateEnum_ON,
+		X_AVM_DE_Homeauto1SwStateEnum_TOGGLE,
+		X_AVM_DE_Homeauto1SwStateEnum_UNDEFINED:
+		return true
+	}
+	return false
+}
+
+// X_AVM_DE_Homeauto1SwModeEnum is a value of the state variable SwModeEnum of
+// X_AVM_DE_Homeauto1.
+type X_AVM_DE_Homeauto1SwModeEnum string
+
+// Allowed values of X_AVM_DE_Homeauto1SwModeEnum.
+const (
+	X_AVM_DE_Homeauto1SwModeEnum_AUTO      X_AVM_DE_Homeauto1SwModeEnum = "AUTO"
+	X_AVM_DE_Homeauto1SwModeEnum_MANUAL    X_AVM_DE_Homeauto1SwModeEnum = "MANUAL"
+	X_AVM_DE_Homeauto1SwModeEnum_UNDEFINED X_AVM_DE_Homeauto1SwModeEnum = "UNDEFINED"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v X_AVM_DE_Homeauto1SwModeEnum) Valid() bool {
+	switch v {
+	case X_AVM_DE_Homeauto1SwModeEnum_AUTO,
+		X_AVM_DE_Homeauto1SwModeEnum_MANUAL,
+		X_AVM_DE_Homeauto1SwModeEnum_UNDEFINED:
+		return true
+	}
+	return false
+}
+
+// NewX_AVM_DE_Homeauto1Clients discovers instances of the service on the network,
+// and returns clients to any that are found. errors will contain an error for
+// any devices that replied but which could not be queried, and err will be set
+// if the discovery process failed outright.
+//
+// This is a typical entry calling point into this package.
+func NewX_AVM_DE_Homeauto1Clients() (clients []*X_AVM_DE_Homeauto1, errors []error, err error) {
+	var genericClients []goupnp.ServiceClient
+	if genericClients, errors, err = goupnp.NewServiceClients(URN_X_AVM_DE_Homeauto_1); err != nil {
+		return
+	}
+	clients = newX_AVM_DE_Homeauto1ClientsFromGenericClients(genericClients)
+	return
+}
+
+// NewX_AVM_DE_Homeauto1ClientsByURL discovers instances of the service at the given
+// URL, and returns clients to any that are found. An error is returned if
+// there was an error probing the service.
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered service URL.
+func NewX_AVM_DE_Homeauto1ClientsByURL(loc *url.URL) ([]*X_AVM_DE_Homeauto1, error) {
+	genericClients, err := goupnp.NewServiceClientsByURL(loc, URN_X_AVM_DE_Homeauto_1)
+	if err != nil {
+		return nil, err
+	}
+	return newX_AVM_DE_Homeauto1ClientsFromGenericClients(genericClients), nil
+}
+
+// NewX_AVM_DE_Homeauto1ClientsFromRootDevice discovers instances of the service in
+// a given root device, and returns clients to any that are found. An error is
+// returned if there was not at least one instance of the service within the
+// device. The location parameter is simply assigned to the Location attribute
+// of the wrapped ServiceClient(s).
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered root device.
+func NewX_AVM_DE_Homeauto1ClientsFromRootDevice(rootDevice *goupnp.RootDevice, loc *url.URL) ([]*X_AVM_DE_Homeauto1, error) {
+	genericClients, err := goupnp.NewServiceClientsFromRootDevice(rootDevice, loc, URN_X_AVM_DE_Homeauto_1)
+	if err != nil {
+		return nil, err
+	}
+	return newX_AVM_DE_Homeauto1ClientsFromGenericClients(genericClients), nil
+}
+
+func newX_AVM_DE_Homeauto1ClientsFromGenericClients(genericClients []goupnp.ServiceClient) []*X_AVM_DE_Homeauto1 {
+	clients := make([]*X_AVM_DE_Homeauto1, len(genericClients))
+	for i := range genericClients {
+		clients[i] = &X_AVM_DE_Homeauto1{genericClients[i]}
+	}
+	return clients
+}
+
+// X_AVM_DE_Homeauto1Timeouts are the default timeouts of the actions of
+// X_AVM_DE_Homeauto1, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var X_AVM_DE_Homeauto1Timeouts = map[string]time.Duration{}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *X_AVM_DE_Homeauto1) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, X_AVM_DE_Homeauto1Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_X_AVM_DE_Homeauto_1, actionName, request, response)
+}
+
+// X_AVM_DE_Homeauto1GetInfoResponse is the response of GetInfo, with each
+// argument in its SOAP string form.
+type X_AVM_DE_Homeauto1GetInfoResponse struct {
+	NewAllowedCharsAIN    string
+	NewMaxCharsAIN        string
+	NewMinCharsAIN        string
+	NewMaxCharsDeviceName string
+	NewMinCharsDeviceName string
+}
+
+// GetInfo performs the GetInfo action of the service.
+//
+// Return values:
+//   - NewAllowedCharsAIN (out, state variable AllowedCharsAIN)
+//   - NewMaxCharsAIN (out, state variable MaxCharsAIN)
+//   - NewMinCharsAIN (out, state variable MinCharsAIN)
+//   - NewMaxCharsDeviceName (out, state variable MaxCharsDeviceName)
+//   - NewMinCharsDeviceName (out, state variable MinCharsDeviceName)
+func (client *X_AVM_DE_Homeauto1) GetInfo() (NewAllowedCharsAIN string, NewMaxCharsAIN uint16, NewMinCharsAIN uint16, NewMaxCharsDeviceName uint16, NewMinCharsDeviceName uint16, err error) {
+	return client.GetInfoCtx(context.Background())
+}
+
+// GetInfoCtx is GetInfo with a context, to cancel or time out the call.
+func (client *X_AVM_DE_Homeauto1) GetInfoCtx(ctx context.Context) (NewAllowedCharsAIN string, NewMaxCharsAIN uint16, NewMinCharsAIN uint16, NewMaxCharsDeviceName uint16, NewMinCharsDeviceName uint16, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &X_AVM_DE_Homeauto1GetInfoResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetInfo", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if NewAllowedCharsAIN, err = soap.UnmarshalString(response.NewAllowedCharsAIN); err != nil {
+		return
+	}
+	if NewMaxCharsAIN, err = soap.UnmarshalUi2(response.NewMaxCharsAIN); err != nil {
+		return
+	}
+	if NewMinCharsAIN, err = soap.UnmarshalUi2(response.NewMinCharsAIN); err != nil {
+		return
+	}
+	if NewMaxCharsDeviceName, err = soap.UnmarshalUi2(response.NewMaxCharsDeviceName); err != nil {
+		return
+	}
+	if NewMinCharsDeviceName, err = soap.UnmarshalUi2(response.NewMinCharsDeviceName); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// X_AVM_DE_Homeauto1GetGenericDeviceInfosRequest is the request of GetGenericDeviceInfos, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type X_AVM_DE_Homeauto1GetGenericDeviceInfosRequest struct {
+	NewIndex string
+}
+
+// X_AVM_DE_Homeauto1GetGenericDeviceInfosResponse is the response of GetGenericDeviceInfos, with each
+// argument in its SOAP string form.
+type X_AVM_DE_Homeauto1GetGenericDeviceInfosResponse struct {
+	NewAIN                  string
+	NewDeviceId             string
+	NewFunctionBitMask      string
+	NewFirmwareVersion      string
+	NewManufacturer         string
+	NewProductName          string
+	NewDeviceName           string
+	NewPresent              string
+	NewMultimeterIsEnabled  string
+	NewMultimeterIsValid    string
+	NewMultimeterPower      string
+	NewMultimeterEnergy     string
+	NewTemperatureIsEnabled string
+	NewTemperatureIsValid   string
+	NewTemperatureCelsius   string
+	NewTemperatureOffset    string
+	NewSwitchIsEnabled      string
+	NewSwitchIsValid        string
+	NewSwitchState          string
+	NewSwitchMode           string
+	NewSwitchLock           string
+}
+
+// GetGenericDeviceInfos performs the GetGenericDeviceInfos action of the service.
+//
+// Arguments:
+//   - NewIndex (in, state variable Index)
+//
+// Return values:
+//   - NewAIN (out, state variable AIN)
+//   - NewDeviceId (out, state variable DeviceId)
+//   - NewFunctionBitMask (out, state variable FunctionBitMask)
+//   - NewFirmwareVersion (out, state variable FirmwareVersion)
+//   - NewManufacturer (out, state variable Manufacturer)
+//   - NewProductName (out, state variable ProductName)
+//   - NewDeviceName (out, state variable DeviceName)
+//   - NewPresent (out, state variable PresentEnum): allowed values DISCONNECTED, REGISTERED, CONNECTED, UNKNOWN
+//   - NewMultimeterIsEnabled (out, state variable EnabledEnum): allowed values DISABLED, ENABLED, UNDEFINED
+//   - NewMultimeterIsValid (out, state variable ValidEnum): allowed values INVALID, VALID, UNDEFINED
+//   - NewMultimeterPower (out, state variable MultimeterPower)
+//   - NewMultimeterEnergy (out, state variable MultimeterEnergy)
+//   - NewTemperatureIsEnabled (out, state variable EnabledEnum): allowed values DISABLED, ENABLED, UNDEFINED
+//   - NewTemperatureIsValid (out, state variable ValidEnum): allowed values INVALID, VALID, UNDEFINED
+//   - NewTemperatureCelsius (out, state variable TemperatureCelsius)
+//   - NewTemperatureOffset (out, state variable TemperatureOffset)
+//   - NewSwitchIsEnabled (out, state variable EnabledEnum): allowed values DISABLED, ENABLED, UNDEFINED
+//   - NewSwitchIsValid (out, state variable ValidEnum): allowed values INVALID, VALID, UNDEFINED
+//   - NewSwitchState (out, state variable SwStateEnum): allowed values OFF, ON, TOGGLE, UNDEFINED
+//   - NewSwitchMode (out, state variable SwModeEnum): allowed values AUTO, MANUAL, UNDEFINED
+//   - NewSwitchLock (out, state variable SwitchLock)
+func (client *X_AVM_DE_Homeauto1) GetGenericDeviceInfos(NewIndex uint16) (NewAIN string, NewDeviceId uint16, NewFunctionBitMask uint16, NewFirmwareVersion string, NewManufacturer string, NewProductName string, NewDeviceName string, NewPresent X_AVM_DE_Homeauto1PresentEnum, NewMultimeterIsEnabled X_AVM_DE_Homeauto1EnabledEnum, NewMultimeterIsValid X_AVM_DE_Homeauto1ValidEnum, NewMultimeterPower uint32, NewMultimeterEnergy uint32, NewTemperatureIsEnabled X_AVM_DE_Homeauto1EnabledEnum, NewTemperatureIsValid X_AVM_DE_Homeauto1ValidEnum, NewTemperatureCelsius int32, NewTemperatureOffset int32, NewSwitchIsEnabled X_AVM_DE_Homeauto1EnabledEnum, NewSwitchIsValid X_AVM_DE_Homeauto1ValidEnum, NewSwitchState X_AVM_DE_Homeauto1SwStateEnum, NewSwitchMode X_AVM_DE_Homeauto1SwModeEnum, NewSwitchLock bool, err error) {
+	return client.GetGenericDeviceInfosCtx(context.Background(), NewIndex)
+}
+
+// GetGenericDeviceInfosCtx is GetGenericDeviceInfos with a context, to cancel or time out the call.
+func (client *X_AVM_DE_Homeauto1) GetGenericDeviceInfosCtx(ctx context.Context, NewIndex uint16) (NewAIN string, NewDeviceId uint16, NewFunctionBitMask uint16, NewFirmwareVersion string, NewManufacturer string, NewProductName string, NewDeviceName string, NewPresent X_AVM_DE_Homeauto1PresentEnum, NewMultimeterIsEnabled X_AVM_DE_Homeauto1EnabledEnum, NewMultimeterIsValid X_AVM_DE_Homeauto1ValidEnum, NewMultimeterPower uint32, NewMultimeterEnergy uint32, NewTemperatureIsEnabled X_AVM_DE_Homeauto1EnabledEnum, NewTemperatureIsValid X_AVM_DE_Homeauto1ValidEnum, NewTemperatureCelsius int32, NewTemperatureOffset int32, NewSwitchIsEnabled X_AVM_DE_Homeauto1EnabledEnum, NewSwitchIsValid X_AVM_DE_Homeauto1ValidEnum, NewSwitchState X_AVM_DE_Homeauto1SwStateEnum, NewSwitchMode X_AVM_DE_Homeauto1SwModeEnum, NewSwitchLock bool, err error) {
+	// Request structure.
+	request := &X_AVM_DE_Homeauto1GetGenericDeviceInfosRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewIndex, err = soap.MarshalUi2(NewIndex); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &X_AVM_DE_Homeauto1GetGenericDeviceInfosResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetGenericDeviceInfos", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if NewAIN, err = soap.UnmarshalString(response.NewAIN); err != nil {
+		return
+	}
+	if NewDeviceId, err = soap.UnmarshalUi2(response.NewDeviceId); err != nil {
+		return
+	}
+	if NewFunctionBitMask, err = soap.UnmarshalUi2(response.NewFunctionBitMask); err != nil {
+		return
+	}
+	if NewFirmwareVersion, err = soap.UnmarshalString(response.NewFirmwareVersion); err != nil {
+		return
+	}
+	if NewManufacturer, err = soap.UnmarshalString(response.NewManufacturer); err != nil {
+		return
+	}
+	if NewProductName, err = soap.UnmarshalString(response.NewProductName); err != nil {
+		return
+	}
+	if NewDeviceName, err = soap.UnmarshalString(response.NewDeviceName); err != nil {
+		return
+	}
+	NewPresent = X_AVM_DE_Homeauto1PresentEnum(response.NewPresent)
+	NewMultimeterIsEnabled = X_AVM_DE_Homeauto1EnabledEnum(response.NewMultimeterIsEnabled)
+	NewMultimeterIsValid = X_AVM_DE_Homeauto1ValidEnum(response.NewMultimeterIsValid)
+	if NewMultimeterPower, err = soap.UnmarshalUi4(response.NewMultimeterPower); err != nil {
+		return
+	}
+	if NewMultimeterEnergy, err = soap.UnmarshalUi4(response.NewMultimeterEnergy); err != nil {
+		return
+	}
+	NewTemperatureIsEnabled = X_AVM_DE_Homeauto1EnabledEnum(response.NewTemperatureIsEnabled)
+	NewTemperatureIsValid = X_AVM_DE_Homeauto1ValidEnum(response.NewTemperatureIsValid)
+	if NewTemperatureCelsius, err = soap.UnmarshalI4(response.NewTemperatureCelsius); err != nil {
+		return
+	}
+	if NewTemperatureOffset, err = soap.UnmarshalI4(response.NewTemperatureOffset); err != nil {
+		return
+	}
+	NewSwitchIsEnabled = X_AVM_DE_Homeauto1EnabledEnum(response.NewSwitchIsEnabled)
+	NewSwitchIsValid = X_AVM_DE_Homeauto1ValidEnum(response.NewSwitchIsValid)
+	NewSwitchState = X_AVM_DE_Homeauto1SwStateEnum(response.NewSwitchState)
+	NewSwitchMode = X_AVM_DE_Homeauto1SwModeEnum(response.NewSwitchMode)
+	if NewSwitchLock, err = soap.UnmarshalBoolean(response.NewSwitchLock); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// X_AVM_DE_Homeauto1GetSpecificDeviceInfosRequest is the request of GetSpecificDeviceInfos, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type X_AVM_DE_Homeauto1GetSpecificDeviceInfosRequest struct {
+	NewAIN string
+}
+
+// X_AVM_DE_Homeauto1GetSpecificDeviceInfosResponse is the response of GetSpecificDeviceInfos, with each
+// argument in its SOAP string form.
+type X_AVM_DE_Homeauto1GetSpecificDeviceInfosResponse struct {
+	NewDeviceId             string
+	NewFunctionBitMask      string
+	NewFirmwareVersion      string
+	NewManufacturer         string
+	NewProductName          string
+	NewDeviceName           string
+	NewPresent              string
+	NewMultimeterIsEnabled  string
+	NewMultimeterIsValid    string
+	NewMultimeterPower      string
+	NewMultimeterEnergy     string
+	NewTemperatureIsEnabled string
+	NewTemperatureIsValid   string
+	NewTemperatureCelsius   string
+	NewTemperatureOffset    string
+	NewSwitchIsEnabled      string
+	NewSwitchIsValid        string
+	NewSwitchState          string
+	NewSwitchMode           string
+	NewSwitchLock           string
+}
+
+// GetSpecificDeviceInfos performs the GetSpecificDeviceInfos action of the service.
+//
+// Arguments:
+//   - NewAIN (in, state variable AIN)
+//
+// Return values:
+//   - NewDeviceId (out, state variable DeviceId)
+//   - NewFunctionBitMask (out, state variable FunctionBitMask)
+//   - NewFirmwareVersion (out, state variable FirmwareVersion)
+//   - NewManufacturer (out, state variable Manufacturer)
+//   - NewProductName (out, state variable ProductName)
+//   - NewDeviceName (out, state variable DeviceName)
+//   - NewPresent (out, state variable PresentEnum): allowed values DISCONNECTED, REGISTERED, CONNECTED, UNKNOWN
+//   - NewMultimeterIsEnabled (out, state variable EnabledEnum): allowed values DISABLED, ENABLED, UNDEFINED
+//   - NewMultimeterIsValid (out, state variable ValidEnum): allowed values INVALID, VALID, UNDEFINED
+//   - NewMultimeterPower (out, state variable MultimeterPower)
+//   - NewMultimeterEnergy (out, state variable MultimeterEnergy)
+//   - NewTemperatureIsEnabled (out, state variable EnabledEnum): allowed values DISABLED, ENABLED, UNDEFINED
+//   - NewTemperatureIsValid (out, state variable ValidEnum): allowed values INVALID, VALID, UNDEFINED
+//   - NewTemperatureCelsius (out, state variable TemperatureCelsius)
+//   - NewTemperatureOffset (out, state variable TemperatureOffset)
+//   - NewSwitchIsEnabled (out, state variable EnabledEnum): allowed values DISABLED, ENABLED, UNDEFINED
+//   - NewSwitchIsValid (out, state variable ValidEnum): allowed values INVALID, VALID, UNDEFINED
+//   - NewSwitchState (out, state variable SwStateEnum): allowed values OFF, ON, TOGGLE, UNDEFINED
+//   - NewSwitchMode (out, state variable SwModeEnum): allowed values AUTO, MANUAL, UNDEFINED
+//   - NewSwitchLock (out, state variable SwitchLock)
+func (client *X_AVM_DE_Homeauto1) GetSpecificDeviceInfos(NewAIN string) (NewDeviceId uint16, NewFunctionBitMask uint16, NewFirmwareVersion string, NewManufacturer string, NewProductName string, NewDeviceName string, NewPresent X_AVM_DE_Homeauto1PresentEnum, NewMultimeterIsEnabled X_AVM_DE_Homeauto1EnabledEnum, NewMultimeterIsValid X_AVM_DE_Homeauto1ValidEnum, NewMultimeterPower uint32, NewMultimeterEnergy uint32, NewTemperatureIsEnabled X_AVM_DE_Homeauto1EnabledEnum, NewTemperatureIsValid X_AVM_DE_Homeauto1ValidEnum, NewTemperatureCelsius int32, NewTemperatureOffset int32, NewSwitchIsEnabled X_AVM_DE_Homeauto1EnabledEnum, NewSwitchIsValid X_AVM_DE_Homeauto1ValidEnum, NewSwitchState X_AVM_DE_Homeauto1SwStateEnum, NewSwitchMode X_AVM_DE_Homeauto1SwModeEnum, NewSwitchLock bool, err error) {
+	return client.GetSpecificDeviceInfosCtx(context.Background(), NewAIN)
+}
+
+// GetSpecificDeviceInfosCtx is GetSpecificDeviceInfos with a context, to cancel or time out the call.
+func (client *X_AVM_DE_Homeauto1) GetSpecificDeviceInfosCtx(ctx context.Context, NewAIN string) (NewDeviceId uint16, NewFunctionBitMask uint16, NewFirmwareVersion string, NewManufacturer string, NewProductName string, NewDeviceName string, NewPresent X_AVM_DE_Homeauto1PresentEnum, NewMultimeterIsEnabled X_AVM_DE_Homeauto1EnabledEnum, NewMultimeterIsValid X_AVM_DE_Homeauto1ValidEnum, NewMultimeterPower uint32, NewMultimeterEnergy uint32, NewTemperatureIsEnabled X_AVM_DE_Homeauto1EnabledEnum, NewTemperatureIsValid X_AVM_DE_Homeauto1ValidEnum, NewTemperatureCelsius int32, NewTemperatureOffset int32, NewSwitchIsEnabled X_AVM_DE_Homeauto1EnabledEnum, NewSwitchIsValid X_AVM_DE_Homeauto1ValidEnum, NewSwitchState X_AVM_DE_Homeauto1SwStateEnum, NewSwitchMode X_AVM_DE_Homeauto1SwModeEnum, NewSwitchLock bool, err error) {
+	// Request structure.
+	request := &X_AVM_DE_Homeauto1GetSpecificDeviceInfosRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewAIN, err = soap.MarshalString(NewAIN); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &X_AVM_DE_Homeauto1GetSpecificDeviceInfosResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetSpecificDeviceInfos", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if NewDeviceId, err = soap.UnmarshalUi2(response.NewDeviceId); err != nil {
+		return
+	}
+	if NewFunctionBitMask, err = soap.UnmarshalUi2(response.NewFunctionBitMask); err != nil {
+		return
+	}
+	if NewFirmwareVersion, err = soap.UnmarshalString(response.NewFirmwareVersion); err != nil {
+		return
+	}
+	if NewManufacturer, err = soap.UnmarshalString(response.NewManufacturer); err != nil {
+		return
+	}
+	if NewProductName, err = soap.UnmarshalString(response.NewProductName); err != nil {
+		return
+	}
+	if NewDeviceName, err = soap.UnmarshalString(response.NewDeviceName); err != nil {
+		return
+	}
+	NewPresent = X_AVM_DE_Homeauto1PresentEnum(response.NewPresent)
+	NewMultimeterIsEnabled = X_AVM_DE_Homeauto1EnabledEnum(response.NewMultimeterIsEnabled)
+	NewMultimeterIsValid = X_AVM_DE_Homeauto1ValidEnum(response.NewMultimeterIsValid)
+	if NewMultimeterPower, err = soap.UnmarshalUi4(response.NewMultimeterPower); err != nil {
+		return
+	}
+	if NewMultimeterEnergy, err = soap.UnmarshalUi4(response.NewMultimeterEnergy); err != nil {
+		return
+	}
+	NewTemperatureIsEnabled = X_AVM_DE_Homeauto1EnabledEnum(response.NewTemperatureIsEnabled)
+	NewTemperatureIsValid = X_AVM_DE_Homeauto1ValidEnum(response.NewTemperatureIsValid)
+	if NewTemperatureCelsius, err = soap.UnmarshalI4(response.NewTemperatureCelsius); err != nil {
+		return
+	}
+	if NewTemperatureOffset, err = soap.UnmarshalI4(response.NewTemperatureOffset); err != nil {
+		return
+	}
+	NewSwitchIsEnabled = X_AVM_DE_Homeauto1EnabledEnum(response.NewSwitchIsEnabled)
+	NewSwitchIsValid = X_AVM_DE_Homeauto1ValidEnum(response.NewSwitchIsValid)
+	NewSwitchState = X_AVM_DE_Homeauto1SwStateEnum(response.NewSwitchState)
+	NewSwitchMode = X_AVM_DE_Homeauto1SwModeEnum(response.NewSwitchMode)
+	if NewSwitchLock, err = soap.UnmarshalBoolean(response.NewSwitchLock); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// X_AVM_DE_Homeauto1SetSwitchRequest is the request of SetSwitch, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type X_AVM_DE_Homeauto1SetSwitchRequest struct {
+	NewAIN         string
+	NewSwitchState string
+}
+
+// SetSwitch performs the SetSwitch action of the service.
+//
+// Arguments:
+//   - NewAIN (in, state variable AIN)
+//   - NewSwitchState (in, state variable SwStateEnum): allowed values OFF, ON, TOGGLE, UNDEFINED
+func (client *X_AVM_DE_Homeauto1) SetSwitch(NewAIN string, NewSwitchState X_AVM_DE_Homeauto1SwStateEnum) (err error) {
+	return client.SetSwitchCtx(context.Background(), NewAIN, NewSwitchState)
+}
+
+// SetSwitchCtx is SetSwitch with a context, to cancel or time out the call.
+func (client *X_AVM_DE_Homeauto1) SetSwitchCtx(ctx context.Context, NewAIN string, NewSwitchState X_AVM_DE_Homeauto1SwStateEnum) (err error) {
+	// Request structure.
+	request := &X_AVM_DE_Homeauto1SetSwitchRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewAIN, err = soap.MarshalString(NewAIN); err != nil {
+		return
+	}
+	if request.NewSwitchState, err = soap.MarshalString(string(NewSwitchState)); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SetSwitch", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// X_AVM_DE_Homeauto1SetDeviceNameRequest is the request of SetDeviceName, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type X_AVM_DE_Homeauto1SetDeviceNameRequest struct {
+	NewAIN        string
+	NewDeviceName string
+}
+
+// SetDeviceName performs the SetDeviceName action of the service.
+//
+// Arguments:
+//   - NewAIN (in, state variable AIN)
+//   - NewDeviceName (in, state variable DeviceName)
+func (client *X_AVM_DE_Homeauto1) SetDeviceName(NewAIN string, NewDeviceName string) (err error) {
+	return client.SetDeviceNameCtx(context.Background(), NewAIN, NewDeviceName)
+}
+
+// SetDeviceNameCtx is SetDeviceName with a context, to cancel or time out the call.
+func (client *X_AVM_DE_Homeauto1) SetDeviceNameCtx(ctx context.Context, NewAIN string, NewDeviceName string) (err error) {
+	// Request structure.
+	request := &X_AVM_DE_Homeauto1SetDeviceNameRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewAIN, err = soap.MarshalString(NewAIN); err != nil {
+		return
+	}
+	if request.NewDeviceName, err = soap.MarshalString(NewDeviceName); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SetDeviceName", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
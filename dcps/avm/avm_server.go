@@ -0,0 +1,1557 @@
+package avm
+
+// Generated file - do not edit by hand. See README.md
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/huin/goupnp/device"
+	"github.com/huin/goupnp/soap"
+)
+
+// Hack to avoid Go complaining if url or time aren't used.
+var _ *url.URL
+var _ time.Time
+
+// DeviceInfo1Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:dslforum-org:service:DeviceInfo:1". See RegisterDeviceInfo1Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type DeviceInfo1Handler interface {
+	GetInfo(ctx context.Context) (NewManufacturerName string, NewManufacturerOUI string, NewModelName string, NewDescription string, NewProductClass string, NewSerialNumber string, NewSoftwareVersion string, NewHardwareVersion string, NewSpecVersion string, NewProvisioningCode string, NewUpTime uint32, NewDeviceLog string, err error)
+
+	SetProvisioningCode(ctx context.Context, NewProvisioningCode string) (err error)
+
+	GetDeviceLog(ctx context.Context) (NewDeviceLog string, err error)
+
+	GetSecurityPort(ctx context.Context) (NewSecurityPort uint16, err error)
+}
+
+// RegisterDeviceInfo1Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_DeviceInfo_1.
+func RegisterDeviceInfo1Handler(svc *device.Service, handler DeviceInfo1Handler) {
+	svc.HandleFunc("GetInfo", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveDeviceInfo1GetInfo(ctx, handler, in)
+	})
+	svc.HandleFunc("SetProvisioningCode", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveDeviceInfo1SetProvisioningCode(ctx, handler, in)
+	})
+	svc.HandleFunc("GetDeviceLog", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveDeviceInfo1GetDeviceLog(ctx, handler, in)
+	})
+	svc.HandleFunc("GetSecurityPort", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveDeviceInfo1GetSecurityPort(ctx, handler, in)
+	})
+}
+
+func serveDeviceInfo1GetInfo(ctx context.Context, handler DeviceInfo1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewManufacturerName string
+	var NewManufacturerOUI string
+	var NewModelName string
+	var NewDescription string
+	var NewProductClass string
+	var NewSerialNumber string
+	var NewSoftwareVersion string
+	var NewHardwareVersion string
+	var NewSpecVersion string
+	var NewProvisioningCode string
+	var NewUpTime uint32
+	var NewDeviceLog string
+	if NewManufacturerName, NewManufacturerOUI, NewModelName, NewDescription, NewProductClass, NewSerialNumber, NewSoftwareVersion, NewHardwareVersion, NewSpecVersion, NewProvisioningCode, NewUpTime, NewDeviceLog, err = handler.GetInfo(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 12)
+
+	out[0].Name = "NewManufacturerName"
+	if out[0].Value, err = soap.MarshalString(NewManufacturerName); err != nil {
+		return
+	}
+	out[1].Name = "NewManufacturerOUI"
+	if out[1].Value, err = soap.MarshalString(NewManufacturerOUI); err != nil {
+		return
+	}
+	out[2].Name = "NewModelName"
+	if out[2].Value, err = soap.MarshalString(NewModelName); err != nil {
+		return
+	}
+	out[3].Name = "NewDescription"
+	if out[3].Value, err = soap.MarshalString(NewDescription); err != nil {
+		return
+	}
+	out[4].Name = "NewProductClass"
+	if out[4].Value, err = soap.MarshalString(NewProductClass); err != nil {
+		return
+	}
+	out[5].Name = "NewSerialNumber"
+	if out[5].Value, err = soap.MarshalString(NewSerialNumber); err != nil {
+		return
+	}
+	out[6].Name = "NewSoftwareVersion"
+	if out[6].Value, err = soap.MarshalString(NewSoftwareVersion); err != nil {
+		return
+	}
+	out[7].Name = "NewHardwareVersion"
+	if out[7].Value, err = soap.MarshalString(NewHardwareVersion); err != nil {
+		return
+	}
+	out[8].Name = "NewSpecVersion"
+	if out[8].Value, err = soap.MarshalString(NewSpecVersion); err != nil {
+		return
+	}
+	out[9].Name = "NewProvisioningCode"
+	if out[9].Value, err = soap.MarshalString(NewProvisioningCode); err != nil {
+		return
+	}
+	out[10].Name = "NewUpTime"
+	if out[10].Value, err = soap.MarshalUi4(NewUpTime); err != nil {
+		return
+	}
+	out[11].Name = "NewDeviceLog"
+	if out[11].Value, err = soap.MarshalString(NewDeviceLog); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveDeviceInfo1SetProvisioningCode(ctx context.Context, handler DeviceInfo1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewProvisioningCode string
+	if value, err = soap.FindArg(in, "NewProvisioningCode"); err != nil {
+		return
+	}
+	if NewProvisioningCode, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewProvisioningCode: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetProvisioningCode(ctx, NewProvisioningCode); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveDeviceInfo1GetDeviceLog(ctx context.Context, handler DeviceInfo1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewDeviceLog string
+	if NewDeviceLog, err = handler.GetDeviceLog(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewDeviceLog"
+	if out[0].Value, err = soap.MarshalString(NewDeviceLog); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveDeviceInfo1GetSecurityPort(ctx context.Context, handler DeviceInfo1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewSecurityPort uint16
+	if NewSecurityPort, err = handler.GetSecurityPort(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewSecurityPort"
+	if out[0].Value, err = soap.MarshalUi2(NewSecurityPort); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+// WLANConfiguration1Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:dslforum-org:service:WLANConfiguration:1". See RegisterWLANConfiguration1Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type WLANConfiguration1Handler interface {
+	SetEnable(ctx context.Context, NewEnable bool) (err error)
+
+	GetInfo(ctx context.Context) (NewEnable bool, NewStatus WLANConfiguration1Status, NewMaxBitRate string, NewChannel uint8, NewSSID string, NewBeaconType WLANConfiguration1BeaconType, NewMACAddressControlEnabled bool, NewStandard string, NewBSSID string, NewBasicEncryptionModes WLANConfiguration1BasicEncryptionModes, NewBasicAuthenticationMode WLANConfiguration1BasicAuthenticationMode, err error)
+
+	GetSSID(ctx context.Context) (NewSSID string, err error)
+
+	SetSSID(ctx context.Context, NewSSID string) (err error)
+
+	GetBSSID(ctx context.Context) (NewBSSID string, err error)
+
+	GetChannelInfo(ctx context.Context) (NewChannel uint8, NewPossibleChannels string, err error)
+
+	SetChannel(ctx context.Context, NewChannel uint8) (err error)
+
+	GetBeaconType(ctx context.Context) (NewBeaconType WLANConfiguration1BeaconType, err error)
+
+	SetBeaconType(ctx context.Context, NewBeaconType WLANConfiguration1BeaconType) (err error)
+
+	GetSecurityKeys(ctx context.Context) (NewWEPKey0 string, NewWEPKey1 string, NewWEPKey2 string, NewWEPKey3 string, NewPreSharedKey string, NewKeyPassphrase string, err error)
+
+	SetSecurityKeys(ctx context.Context, NewWEPKey0 string, NewWEPKey1 string, NewWEPKey2 string, NewWEPKey3 string, NewPreSharedKey string, NewKeyPassphrase string) (err error)
+
+	GetTotalAssociations(ctx context.Context) (NewTotalAssociations uint16, err error)
+
+	GetGenericAssociatedDeviceInfo(ctx context.Context, NewAssociatedDeviceIndex uint16) (NewAssociatedDeviceMACAddress string, NewAssociatedDeviceIPAddress string, NewAssociatedDeviceAuthState bool, err error)
+
+	GetSpecificAssociatedDeviceInfo(ctx context.Context, NewAssociatedDeviceMACAddress string) (NewAssociatedDeviceIPAddress string, NewAssociatedDeviceAuthState bool, err error)
+
+	GetStatistics(ctx context.Context) (NewTotalPacketsSent uint32, NewTotalPacketsReceived uint32, err error)
+}
+
+// RegisterWLANConfiguration1Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_WLANConfiguration_1.
+func RegisterWLANConfiguration1Handler(svc *device.Service, handler WLANConfiguration1Handler) {
+	svc.HandleFunc("SetEnable", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWLANConfiguration1SetEnable(ctx, handler, in)
+	})
+	svc.HandleFunc("GetInfo", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWLANConfiguration1GetInfo(ctx, handler, in)
+	})
+	svc.HandleFunc("GetSSID", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWLANConfiguration1GetSSID(ctx, handler, in)
+	})
+	svc.HandleFunc("SetSSID", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWLANConfiguration1SetSSID(ctx, handler, in)
+	})
+	svc.HandleFunc("GetBSSID", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWLANConfiguration1GetBSSID(ctx, handler, in)
+	})
+	svc.HandleFunc("GetChannelInfo", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWLANConfiguration1GetChannelInfo(ctx, handler, in)
+	})
+	svc.HandleFunc("SetChannel", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWLANConfiguration1SetChannel(ctx, handler, in)
+	})
+	svc.HandleFunc("GetBeaconType", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWLANConfiguration1GetBeaconType(ctx, handler, in)
+	})
+	svc.HandleFunc("SetBeaconType", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWLANConfiguration1SetBeaconType(ctx, handler, in)
+	})
+	svc.HandleFunc("GetSecurityKeys", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWLANConfiguration1GetSecurityKeys(ctx, handler, in)
+	})
+	svc.HandleFunc("SetSecurityKeys", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWLANConfiguration1SetSecurityKeys(ctx, handler, in)
+	})
+	svc.HandleFunc("GetTotalAssociations", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWLANConfiguration1GetTotalAssociations(ctx, handler, in)
+	})
+	svc.HandleFunc("GetGenericAssociatedDeviceInfo", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWLANConfiguration1GetGenericAssociatedDeviceInfo(ctx, handler, in)
+	})
+	svc.HandleFunc("GetSpecificAssociatedDeviceInfo", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWLANConfiguration1GetSpecificAssociatedDeviceInfo(ctx, handler, in)
+	})
+	svc.HandleFunc("GetStatistics", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWLANConfiguration1GetStatistics(ctx, handler, in)
+	})
+}
+
+func serveWLANConfiguration1SetEnable(ctx context.Context, handler WLANConfiguration1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewEnable bool
+	if value, err = soap.FindArg(in, "NewEnable"); err != nil {
+		return
+	}
+	if NewEnable, err = soap.UnmarshalBoolean(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewEnable: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetEnable(ctx, NewEnable); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWLANConfiguration1GetInfo(ctx context.Context, handler WLANConfiguration1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewEnable bool
+	var NewStatus WLANConfiguration1Status
+	var NewMaxBitRate string
+	var NewChannel uint8
+	var NewSSID string
+	var NewBeaconType WLANConfiguration1BeaconType
+	var NewMACAddressControlEnabled bool
+	var NewStandard string
+	var NewBSSID string
+	var NewBasicEncryptionModes WLANConfiguration1BasicEncryptionModes
+	var NewBasicAuthenticationMode WLANConfiguration1BasicAuthenticationMode
+	if NewEnable, NewStatus, NewMaxBitRate, NewChannel, NewSSID, NewBeaconType, NewMACAddressControlEnabled, NewStandard, NewBSSID, NewBasicEncryptionModes, NewBasicAuthenticationMode, err = handler.GetInfo(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 11)
+
+	out[0].Name = "NewEnable"
+	if out[0].Value, err = soap.MarshalBoolean(NewEnable); err != nil {
+		return
+	}
+	out[1].Name = "NewStatus"
+	if out[1].Value, err = soap.MarshalString(string(NewStatus)); err != nil {
+		return
+	}
+	out[2].Name = "NewMaxBitRate"
+	if out[2].Value, err = soap.MarshalString(NewMaxBitRate); err != nil {
+		return
+	}
+	out[3].Name = "NewChannel"
+	if out[3].Value, err = soap.MarshalUi1(NewChannel); err != nil {
+		return
+	}
+	out[4].Name = "NewSSID"
+	if out[4].Value, err = soap.MarshalString(NewSSID); err != nil {
+		return
+	}
+	out[5].Name = "NewBeaconType"
+	if out[5].Value, err = soap.MarshalString(string(NewBeaconType)); err != nil {
+		return
+	}
+	out[6].Name = "NewMACAddressControlEnabled"
+	if out[6].Value, err = soap.MarshalBoolean(NewMACAddressControlEnabled); err != nil {
+		return
+	}
+	out[7].Name = "NewStandard"
+	if out[7].Value, err = soap.MarshalString(NewStandard); err != nil {
+		return
+	}
+	out[8].Name = "NewBSSID"
+	if out[8].Value, err = soap.MarshalString(NewBSSID); err != nil {
+		return
+	}
+	out[9].Name = "NewBasicEncryptionModes"
+	if out[9].Value, err = soap.MarshalString(string(NewBasicEncryptionModes)); err != nil {
+		return
+	}
+	out[10].Name = "NewBasicAuthenticationMode"
+	if out[10].Value, err = soap.MarshalString(string(NewBasicAuthenticationMode)); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWLANConfiguration1GetSSID(ctx context.Context, handler WLANConfiguration1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewSSID string
+	if NewSSID, err = handler.GetSSID(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewSSID"
+	if out[0].Value, err = soap.MarshalString(NewSSID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWLANConfiguration1SetSSID(ctx context.Context, handler WLANConfiguration1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewSSID string
+	if value, err = soap.FindArg(in, "NewSSID"); err != nil {
+		return
+	}
+	if NewSSID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewSSID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetSSID(ctx, NewSSID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWLANConfiguration1GetBSSID(ctx context.Context, handler WLANConfiguration1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewBSSID string
+	if NewBSSID, err = handler.GetBSSID(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewBSSID"
+	if out[0].Value, err = soap.MarshalString(NewBSSID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWLANConfiguration1GetChannelInfo(ctx context.Context, handler WLANConfiguration1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewChannel uint8
+	var NewPossibleChannels string
+	if NewChannel, NewPossibleChannels, err = handler.GetChannelInfo(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "NewChannel"
+	if out[0].Value, err = soap.MarshalUi1(NewChannel); err != nil {
+		return
+	}
+	out[1].Name = "NewPossibleChannels"
+	if out[1].Value, err = soap.MarshalString(NewPossibleChannels); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWLANConfiguration1SetChannel(ctx context.Context, handler WLANConfiguration1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewChannel uint8
+	if value, err = soap.FindArg(in, "NewChannel"); err != nil {
+		return
+	}
+	if NewChannel, err = soap.UnmarshalUi1(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewChannel: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetChannel(ctx, NewChannel); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWLANConfiguration1GetBeaconType(ctx context.Context, handler WLANConfiguration1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewBeaconType WLANConfiguration1BeaconType
+	if NewBeaconType, err = handler.GetBeaconType(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewBeaconType"
+	if out[0].Value, err = soap.MarshalString(string(NewBeaconType)); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWLANConfiguration1SetBeaconType(ctx context.Context, handler WLANConfiguration1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewBeaconType WLANConfiguration1BeaconType
+	if value, err = soap.FindArg(in, "NewBeaconType"); err != nil {
+		return
+	}
+	NewBeaconType = WLANConfiguration1BeaconType(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetBeaconType(ctx, NewBeaconType); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWLANConfiguration1GetSecurityKeys(ctx context.Context, handler WLANConfiguration1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewWEPKey0 string
+	var NewWEPKey1 string
+	var NewWEPKey2 string
+	var NewWEPKey3 string
+	var NewPreSharedKey string
+	var NewKeyPassphrase string
+	if NewWEPKey0, NewWEPKey1, NewWEPKey2, NewWEPKey3, NewPreSharedKey, NewKeyPassphrase, err = handler.GetSecurityKeys(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 6)
+
+	out[0].Name = "NewWEPKey0"
+	if out[0].Value, err = soap.MarshalString(NewWEPKey0); err != nil {
+		return
+	}
+	out[1].Name = "NewWEPKey1"
+	if out[1].Value, err = soap.MarshalString(NewWEPKey1); err != nil {
+		return
+	}
+	out[2].Name = "NewWEPKey2"
+	if out[2].Value, err = soap.MarshalString(NewWEPKey2); err != nil {
+		return
+	}
+	out[3].Name = "NewWEPKey3"
+	if out[3].Value, err = soap.MarshalString(NewWEPKey3); err != nil {
+		return
+	}
+	out[4].Name = "NewPreSharedKey"
+	if out[4].Value, err = soap.MarshalString(NewPreSharedKey); err != nil {
+		return
+	}
+	out[5].Name = "NewKeyPassphrase"
+	if out[5].Value, err = soap.MarshalString(NewKeyPassphrase); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWLANConfiguration1SetSecurityKeys(ctx context.Context, handler WLANConfiguration1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewWEPKey0 string
+	if value, err = soap.FindArg(in, "NewWEPKey0"); err != nil {
+		return
+	}
+	if NewWEPKey0, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewWEPKey0: "+err.Error())
+	}
+	var NewWEPKey1 string
+	if value, err = soap.FindArg(in, "NewWEPKey1"); err != nil {
+		return
+	}
+	if NewWEPKey1, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewWEPKey1: "+err.Error())
+	}
+	var NewWEPKey2 string
+	if value, err = soap.FindArg(in, "NewWEPKey2"); err != nil {
+		return
+	}
+	if NewWEPKey2, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewWEPKey2: "+err.Error())
+	}
+	var NewWEPKey3 string
+	if value, err = soap.FindArg(in, "NewWEPKey3"); err != nil {
+		return
+	}
+	if NewWEPKey3, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewWEPKey3: "+err.Error())
+	}
+	var NewPreSharedKey string
+	if value, err = soap.FindArg(in, "NewPreSharedKey"); err != nil {
+		return
+	}
+	if NewPreSharedKey, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewPreSharedKey: "+err.Error())
+	}
+	var NewKeyPassphrase string
+	if value, err = soap.FindArg(in, "NewKeyPassphrase"); err != nil {
+		return
+	}
+	if NewKeyPassphrase, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewKeyPassphrase: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetSecurityKeys(ctx, NewWEPKey0, NewWEPKey1, NewWEPKey2, NewWEPKey3, NewPreSharedKey, NewKeyPassphrase); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWLANConfiguration1GetTotalAssociations(ctx context.Context, handler WLANConfiguration1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewTotalAssociations uint16
+	if NewTotalAssociations, err = handler.GetTotalAssociations(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewTotalAssociations"
+	if out[0].Value, err = soap.MarshalUi2(NewTotalAssociations); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWLANConfiguration1GetGenericAssociatedDeviceInfo(ctx context.Context, handler WLANConfiguration1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewAssociatedDeviceIndex uint16
+	if value, err = soap.FindArg(in, "NewAssociatedDeviceIndex"); err != nil {
+		return
+	}
+	if NewAssociatedDeviceIndex, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewAssociatedDeviceIndex: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewAssociatedDeviceMACAddress string
+	var NewAssociatedDeviceIPAddress string
+	var NewAssociatedDeviceAuthState bool
+	if NewAssociatedDeviceMACAddress, NewAssociatedDeviceIPAddress, NewAssociatedDeviceAuthState, err = handler.GetGenericAssociatedDeviceInfo(ctx, NewAssociatedDeviceIndex); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 3)
+
+	out[0].Name = "NewAssociatedDeviceMACAddress"
+	if out[0].Value, err = soap.MarshalString(NewAssociatedDeviceMACAddress); err != nil {
+		return
+	}
+	out[1].Name = "NewAssociatedDeviceIPAddress"
+	if out[1].Value, err = soap.MarshalString(NewAssociatedDeviceIPAddress); err != nil {
+		return
+	}
+	out[2].Name = "NewAssociatedDeviceAuthState"
+	if out[2].Value, err = soap.MarshalBoolean(NewAssociatedDeviceAuthState); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWLANConfiguration1GetSpecificAssociatedDeviceInfo(ctx context.Context, handler WLANConfiguration1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewAssociatedDeviceMACAddress string
+	if value, err = soap.FindArg(in, "NewAssociatedDeviceMACAddress"); err != nil {
+		return
+	}
+	if NewAssociatedDeviceMACAddress, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewAssociatedDeviceMACAddress: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewAssociatedDeviceIPAddress string
+	var NewAssociatedDeviceAuthState bool
+	if NewAssociatedDeviceIPAddress, NewAssociatedDeviceAuthState, err = handler.GetSpecificAssociatedDeviceInfo(ctx, NewAssociatedDeviceMACAddress); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "NewAssociatedDeviceIPAddress"
+	if out[0].Value, err = soap.MarshalString(NewAssociatedDeviceIPAddress); err != nil {
+		return
+	}
+	out[1].Name = "NewAssociatedDeviceAuthState"
+	if out[1].Value, err = soap.MarshalBoolean(NewAssociatedDeviceAuthState); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWLANConfiguration1GetStatistics(ctx context.Context, handler WLANConfiguration1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewTotalPacketsSent uint32
+	var NewTotalPacketsReceived uint32
+	if NewTotalPacketsSent, NewTotalPacketsReceived, err = handler.GetStatistics(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "NewTotalPacketsSent"
+	if out[0].Value, err = soap.MarshalUi4(NewTotalPacketsSent); err != nil {
+		return
+	}
+	out[1].Name = "NewTotalPacketsReceived"
+	if out[1].Value, err = soap.MarshalUi4(NewTotalPacketsReceived); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+// X_AVM_DE_OnTel1Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:dslforum-org:service:X_AVM-DE_OnTel:1". See RegisterX_AVM_DE_OnTel1Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type X_AVM_DE_OnTel1Handler interface {
+	GetCallList(ctx context.Context) (NewCallListURL string, err error)
+
+	GetPhonebookList(ctx context.Context) (NewPhonebookList string, err error)
+
+	GetPhonebook(ctx context.Context, NewPhonebookID uint16) (NewPhonebookName string, NewPhonebookExtraID string, NewPhonebookURL string, err error)
+
+	GetPhonebookEntry(ctx context.Context, NewPhonebookID uint16, NewPhonebookEntryID uint32) (NewPhonebookEntryData string, err error)
+
+	SetPhonebookEntry(ctx context.Context, NewPhonebookID uint16, NewPhonebookEntryID string, NewPhonebookEntryData string) (err error)
+
+	DeletePhonebookEntry(ctx context.Context, NewPhonebookID uint16, NewPhonebookEntryID uint32) (err error)
+
+	GetDECTHandsetList(ctx context.Context) (NewDectIDList string, err error)
+
+	GetNumberOfDeflections(ctx context.Context) (NewNumberOfDeflections uint16, err error)
+
+	GetDeflections(ctx context.Context) (NewDeflectionList string, err error)
+
+	SetDeflectionEnable(ctx context.Context, NewDeflectionId uint16, NewEnable bool) (err error)
+}
+
+// RegisterX_AVM_DE_OnTel1Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_X_AVM_DE_OnTel_1.
+func RegisterX_AVM_DE_OnTel1Handler(svc *device.Service, handler X_AVM_DE_OnTel1Handler) {
+	svc.HandleFunc("GetCallList", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveX_AVM_DE_OnTel1GetCallList(ctx, handler, in)
+	})
+	svc.HandleFunc("GetPhonebookList", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveX_AVM_DE_OnTel1GetPhonebookList(ctx, handler, in)
+	})
+	svc.HandleFunc("GetPhonebook", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveX_AVM_DE_OnTel1GetPhonebook(ctx, handler, in)
+	})
+	svc.HandleFunc("GetPhonebookEntry", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveX_AVM_DE_OnTel1GetPhonebookEntry(ctx, handler, in)
+	})
+	svc.HandleFunc("SetPhonebookEntry", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveX_AVM_DE_OnTel1SetPhonebookEntry(ctx, handler, in)
+	})
+	svc.HandleFunc("DeletePhonebookEntry", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveX_AVM_DE_OnTel1DeletePhonebookEntry(ctx, handler, in)
+	})
+	svc.HandleFunc("GetDECTHandsetList", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveX_AVM_DE_OnTel1GetDECTHandsetList(ctx, handler, in)
+	})
+	svc.HandleFunc("GetNumberOfDeflections", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveX_AVM_DE_OnTel1GetNumberOfDeflections(ctx, handler, in)
+	})
+	svc.HandleFunc("GetDeflections", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveX_AVM_DE_OnTel1GetDeflections(ctx, handler, in)
+	})
+	svc.HandleFunc("SetDeflectionEnable", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveX_AVM_DE_OnTel1SetDeflectionEnable(ctx, handler, in)
+	})
+}
+
+func serveX_AVM_DE_OnTel1GetCallList(ctx context.Context, handler X_AVM_DE_OnTel1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewCallListURL string
+	if NewCallListURL, err = handler.GetCallList(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewCallListURL"
+	if out[0].Value, err = soap.MarshalString(NewCallListURL); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveX_AVM_DE_OnTel1GetPhonebookList(ctx context.Context, handler X_AVM_DE_OnTel1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewPhonebookList string
+	if NewPhonebookList, err = handler.GetPhonebookList(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewPhonebookList"
+	if out[0].Value, err = soap.MarshalString(NewPhonebookList); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveX_AVM_DE_OnTel1GetPhonebook(ctx context.Context, handler X_AVM_DE_OnTel1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewPhonebookID uint16
+	if value, err = soap.FindArg(in, "NewPhonebookID"); err != nil {
+		return
+	}
+	if NewPhonebookID, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewPhonebookID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewPhonebookName string
+	var NewPhonebookExtraID string
+	var NewPhonebookURL string
+	if NewPhonebookName, NewPhonebookExtraID, NewPhonebookURL, err = handler.GetPhonebook(ctx, NewPhonebookID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 3)
+
+	out[0].Name = "NewPhonebookName"
+	if out[0].Value, err = soap.MarshalString(NewPhonebookName); err != nil {
+		return
+	}
+	out[1].Name = "NewPhonebookExtraID"
+	if out[1].Value, err = soap.MarshalString(NewPhonebookExtraID); err != nil {
+		return
+	}
+	out[2].Name = "NewPhonebookURL"
+	if out[2].Value, err = soap.MarshalString(NewPhonebookURL); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveX_AVM_DE_OnTel1GetPhonebookEntry(ctx context.Context, handler X_AVM_DE_OnTel1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewPhonebookID uint16
+	if value, err = soap.FindArg(in, "NewPhonebookID"); err != nil {
+		return
+	}
+	if NewPhonebookID, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewPhonebookID: "+err.Error())
+	}
+	var NewPhonebookEntryID uint32
+	if value, err = soap.FindArg(in, "NewPhonebookEntryID"); err != nil {
+		return
+	}
+	if NewPhonebookEntryID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewPhonebookEntryID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewPhonebookEntryData string
+	if NewPhonebookEntryData, err = handler.GetPhonebookEntry(ctx, NewPhonebookID, NewPhonebookEntryID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewPhonebookEntryData"
+	if out[0].Value, err = soap.MarshalString(NewPhonebookEntryData); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveX_AVM_DE_OnTel1SetPhonebookEntry(ctx context.Context, handler X_AVM_DE_OnTel1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewPhonebookID uint16
+	if value, err = soap.FindArg(in, "NewPhonebookID"); err != nil {
+		return
+	}
+	if NewPhonebookID, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewPhonebookID: "+err.Error())
+	}
+	var NewPhonebookEntryID string
+	if value, err = soap.FindArg(in, "NewPhonebookEntryID"); err != nil {
+		return
+	}
+	if NewPhonebookEntryID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewPhonebookEntryID: "+err.Error())
+	}
+	var NewPhonebookEntryData string
+	if value, err = soap.FindArg(in, "NewPhonebookEntryData"); err != nil {
+		return
+	}
+	if NewPhonebookEntryData, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewPhonebookEntryData: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetPhonebookEntry(ctx, NewPhonebookID, NewPhonebookEntryID, NewPhonebookEntryData); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveX_AVM_DE_OnTel1DeletePhonebookEntry(ctx context.Context, handler X_AVM_DE_OnTel1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewPhonebookID uint16
+	if value, err = soap.FindArg(in, "NewPhonebookID"); err != nil {
+		return
+	}
+	if NewPhonebookID, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewPhonebookID: "+err.Error())
+	}
+	var NewPhonebookEntryID uint32
+	if value, err = soap.FindArg(in, "NewPhonebookEntryID"); err != nil {
+		return
+	}
+	if NewPhonebookEntryID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewPhonebookEntryID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.DeletePhonebookEntry(ctx, NewPhonebookID, NewPhonebookEntryID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveX_AVM_DE_OnTel1GetDECTHandsetList(ctx context.Context, handler X_AVM_DE_OnTel1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewDectIDList string
+	if NewDectIDList, err = handler.GetDECTHandsetList(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewDectIDList"
+	if out[0].Value, err = soap.MarshalString(NewDectIDList); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveX_AVM_DE_OnTel1GetNumberOfDeflections(ctx context.Context, handler X_AVM_DE_OnTel1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewNumberOfDeflections uint16
+	if NewNumberOfDeflections, err = handler.GetNumberOfDeflections(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewNumberOfDeflections"
+	if out[0].Value, err = soap.MarshalUi2(NewNumberOfDeflections); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveX_AVM_DE_OnTel1GetDeflections(ctx context.Context, handler X_AVM_DE_OnTel1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewDeflectionList string
+	if NewDeflectionList, err = handler.GetDeflections(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewDeflectionList"
+	if out[0].Value, err = soap.MarshalString(NewDeflectionList); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveX_AVM_DE_OnTel1SetDeflectionEnable(ctx context.Context, handler X_AVM_DE_OnTel1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewDeflectionId uint16
+	if value, err = soap.FindArg(in, "NewDeflectionId"); err != nil {
+		return
+	}
+	if NewDeflectionId, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewDeflectionId: "+err.Error())
+	}
+	var NewEnable bool
+	if value, err = soap.FindArg(in, "NewEnable"); err != nil {
+		return
+	}
+	if NewEnable, err = soap.UnmarshalBoolean(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewEnable: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetDeflectionEnable(ctx, NewDeflectionId, NewEnable); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+// X_AVM_DE_Homeauto1Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:dslforum-org:service:X_AVM-DE_Homeauto:1". See RegisterX_AVM_DE_Homeauto1Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type X_AVM_DE_Homeauto1Handler interface {
+	GetInfo(ctx context.Context) (NewAllowedCharsAIN string, NewMaxCharsAIN uint16, NewMinCharsAIN uint16, NewMaxCharsDeviceName uint16, NewMinCharsDeviceName uint16, err error)
+
+	GetGenericDeviceInfos(ctx context.Context, NewIndex uint16) (NewAIN string, NewDeviceId uint16, NewFunctionBitMask uint16, NewFirmwareVersion string, NewManufacturer string, NewProductName string, NewDeviceName string, NewPresent X_AVM_DE_Homeauto1PresentEnum, NewMultimeterIsEnabled X_AVM_DE_Homeauto1EnabledEnum, NewMultimeterIsValid X_AVM_DE_Homeauto1ValidEnum, NewMultimeterPower uint32, NewMultimeterEnergy uint32, NewTemperatureIsEnabled X_AVM_DE_Homeauto1EnabledEnum, NewTemperatureIsValid X_AVM_DE_Homeauto1ValidEnum, NewTemperatureCelsius int32, NewTemperatureOffset int32, NewSwitchIsEnabled X_AVM_DE_Homeauto1EnabledEnum, NewSwitchIsValid X_AVM_DE_Homeauto1ValidEnum, NewSwitchState X_AVM_DE_Homeauto1SwStateEnum, NewSwitchMode X_AVM_DE_Homeauto1SwModeEnum, NewSwitchLock bool, err error)
+
+	GetSpecificDeviceInfos(ctx context.Context, NewAIN string) (NewDeviceId uint16, NewFunctionBitMask uint16, NewFirmwareVersion string, NewManufacturer string, NewProductName string, NewDeviceName string, NewPresent X_AVM_DE_Homeauto1PresentEnum, NewMultimeterIsEnabled X_AVM_DE_Homeauto1EnabledEnum, NewMultimeterIsValid X_AVM_DE_Homeauto1ValidEnum, NewMultimeterPower uint32, NewMultimeterEnergy uint32, NewTemperatureIsEnabled X_AVM_DE_Homeauto1EnabledEnum, NewTemperatureIsValid X_AVM_DE_Homeauto1ValidEnum, NewTemperatureCelsius int32, NewTemperatureOffset int32, NewSwitchIsEnabled X_AVM_DE_Homeauto1EnabledEnum, NewSwitchIsValid X_AVM_DE_Homeauto1ValidEnum, NewSwitchState X_AVM_DE_Homeauto1SwStateEnum, NewSwitchMode X_AVM_DE_Homeauto1SwModeEnum, NewSwitchLock bool, err error)
+
+	SetSwitch(ctx context.Context, NewAIN string, NewSwitchState X_AVM_DE_Homeauto1SwStateEnum) (err error)
+
+	SetDeviceName(ctx context.Context, NewAIN string, NewDeviceName string) (err error)
+}
+
+// RegisterX_AVM_DE_Homeauto1Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_X_AVM_DE_Homeauto_1.
+func RegisterX_AVM_DE_Homeauto1Handler(svc *device.Service, handler X_AVM_DE_Homeauto1Handler) {
+	svc.HandleFunc("GetInfo", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveX_AVM_DE_Homeauto1GetInfo(ctx, handler, in)
+	})
+	svc.HandleFunc("GetGenericDeviceInfos", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveX_AVM_DE_Homeauto1GetGenericDeviceInfos(ctx, handler, in)
+	})
+	svc.HandleFunc("GetSpecificDeviceInfos", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveX_AVM_DE_Homeauto1GetSpecificDeviceInfos(ctx, handler, in)
+	})
+	svc.HandleFunc("SetSwitch", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveX_AVM_DE_Homeauto1SetSwitch(ctx, handler, in)
+	})
+	svc.HandleFunc("SetDeviceName", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveX_AVM_DE_Homeauto1SetDeviceName(ctx, handler, in)
+	})
+}
+
+func serveX_AVM_DE_Homeauto1GetInfo(ctx context.Context, handler X_AVM_DE_Homeauto1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewAllowedCharsAIN string
+	var NewMaxCharsAIN uint16
+	var NewMinCharsAIN uint16
+	var NewMaxCharsDeviceName uint16
+	var NewMinCharsDeviceName uint16
+	if NewAllowedCharsAIN, NewMaxCharsAIN, NewMinCharsAIN, NewMaxCharsDeviceName, NewMinCharsDeviceName, err = handler.GetInfo(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 5)
+
+	out[0].Name = "NewAllowedCharsAIN"
+	if out[0].Value, err = soap.MarshalString(NewAllowedCharsAIN); err != nil {
+		return
+	}
+	out[1].Name = "NewMaxCharsAIN"
+	if out[1].Value, err = soap.MarshalUi2(NewMaxCharsAIN); err != nil {
+		return
+	}
+	out[2].Name = "NewMinCharsAIN"
+	if out[2].Value, err = soap.MarshalUi2(NewMinCharsAIN); err != nil {
+		return
+	}
+	out[3].Name = "NewMaxCharsDeviceName"
+	if out[3].Value, err = soap.MarshalUi2(NewMaxCharsDeviceName); err != nil {
+		return
+	}
+	out[4].Name = "NewMinCharsDeviceName"
+	if out[4].Value, err = soap.MarshalUi2(NewMinCharsDeviceName); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveX_AVM_DE_Homeauto1GetGenericDeviceInfos(ctx context.Context, handler X_AVM_DE_Homeauto1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewIndex uint16
+	if value, err = soap.FindArg(in, "NewIndex"); err != nil {
+		return
+	}
+	if NewIndex, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewIndex: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewAIN string
+	var NewDeviceId uint16
+	var NewFunctionBitMask uint16
+	var NewFirmwareVersion string
+	var NewManufacturer string
+	var NewProductName string
+	var NewDeviceName string
+	var NewPresent X_AVM_DE_Homeauto1PresentEnum
+	var NewMultimeterIsEnabled X_AVM_DE_Homeauto1EnabledEnum
+	var NewMultimeterIsValid X_AVM_DE_Homeauto1ValidEnum
+	var NewMultimeterPower uint32
+	var NewMultimeterEnergy uint32
+	var NewTemperatureIsEnabled X_AVM_DE_Homeauto1EnabledEnum
+	var NewTemperatureIsValid X_AVM_DE_Homeauto1ValidEnum
+	var NewTemperatureCelsius int32
+	var NewTemperatureOffset int32
+	var NewSwitchIsEnabled X_AVM_DE_Homeauto1EnabledEnum
+	var NewSwitchIsValid X_AVM_DE_Homeauto1ValidEnum
+	var NewSwitchState X_AVM_DE_Homeauto1SwStateEnum
+	var NewSwitchMode X_AVM_DE_Homeauto1SwModeEnum
+	var NewSwitchLock bool
+	if NewAIN, NewDeviceId, NewFunctionBitMask, NewFirmwareVersion, NewManufacturer, NewProductName, NewDeviceName, NewPresent, NewMultimeterIsEnabled, NewMultimeterIsValid, NewMultimeterPower, NewMultimeterEnergy, NewTemperatureIsEnabled, NewTemperatureIsValid, NewTemperatureCelsius, NewTemperatureOffset, NewSwitchIsEnabled, NewSwitchIsValid, NewSwitchState, NewSwitchMode, NewSwitchLock, err = handler.GetGenericDeviceInfos(ctx, NewIndex); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 21)
+
+	out[0].Name = "NewAIN"
+	if out[0].Value, err = soap.MarshalString(NewAIN); err != nil {
+		return
+	}
+	out[1].Name = "NewDeviceId"
+	if out[1].Value, err = soap.MarshalUi2(NewDeviceId); err != nil {
+		return
+	}
+	out[2].Name = "NewFunctionBitMask"
+	if out[2].Value, err = soap.MarshalUi2(NewFunctionBitMask); err != nil {
+		return
+	}
+	out[3].Name = "NewFirmwareVersion"
+	if out[3].Value, err = soap.MarshalString(NewFirmwareVersion); err != nil {
+		return
+	}
+	out[4].Name = "NewManufacturer"
+	if out[4].Value, err = soap.MarshalString(NewManufacturer); err != nil {
+		return
+	}
+	out[5].Name = "NewProductName"
+	if out[5].Value, err = soap.MarshalString(NewProductName); err != nil {
+		return
+	}
+	out[6].Name = "NewDeviceName"
+	if out[6].Value, err = soap.MarshalString(NewDeviceName); err != nil {
+		return
+	}
+	out[7].Name = "NewPresent"
+	if out[7].Value, err = soap.MarshalString(string(NewPresent)); err != nil {
+		return
+	}
+	out[8].Name = "NewMultimeterIsEnabled"
+	if out[8].Value, err = soap.MarshalString(string(NewMultimeterIsEnabled)); err != nil {
+		return
+	}
+	out[9].Name = "NewMultimeterIsValid"
+	if out[9].Value, err = soap.MarshalString(string(NewMultimeterIsValid)); err != nil {
+		return
+	}
+	out[10].Name = "NewMultimeterPower"
+	if out[10].Value, err = soap.MarshalUi4(NewMultimeterPower); err != nil {
+		return
+	}
+	out[11].Name = "NewMultimeterEnergy"
+	if out[11].Value, err = soap.MarshalUi4(NewMultimeterEnergy); err != nil {
+		return
+	}
+	out[12].Name = "NewTemperatureIsEnabled"
+	if out[12].Value, err = soap.MarshalString(string(NewTemperatureIsEnabled)); err != nil {
+		return
+	}
+	out[13].Name = "NewTemperatureIsValid"
+	if out[13].Value, err = soap.MarshalString(string(NewTemperatureIsValid)); err != nil {
+		return
+	}
+	out[14].Name = "NewTemperatureCelsius"
+	if out[14].Value, err = soap.MarshalI4(NewTemperatureCelsius); err != nil {
+		return
+	}
+	out[15].Name = "NewTemperatureOffset"
+	if out[15].Value, err = soap.MarshalI4(NewTemperatureOffset); err != nil {
+		return
+	}
+	out[16].Name = "NewSwitchIsEnabled"
+	if out[16].Value, err = soap.MarshalString(string(NewSwitchIsEnabled)); err != nil {
+		return
+	}
+	out[17].Name = "NewSwitchIsValid"
+	if out[17].Value, err = soap.MarshalString(string(NewSwitchIsValid)); err != nil {
+		return
+	}
+	out[18].Name = "NewSwitchState"
+	if out[18].Value, err = soap.MarshalString(string(NewSwitchState)); err != nil {
+		return
+	}
+	out[19].Name = "NewSwitchMode"
+	if out[19].Value, err = soap.MarshalString(string(NewSwitchMode)); err != nil {
+		return
+	}
+	out[20].Name = "NewSwitchLock"
+	if out[20].Value, err = soap.MarshalBoolean(NewSwitchLock); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveX_AVM_DE_Homeauto1GetSpecificDeviceInfos(ctx context.Context, handler X_AVM_DE_Homeauto1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewAIN string
+	if value, err = soap.FindArg(in, "NewAIN"); err != nil {
+		return
+	}
+	if NewAIN, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewAIN: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewDeviceId uint16
+	var NewFunctionBitMask uint16
+	var NewFirmwareVersion string
+	var NewManufacturer string
+	var NewProductName string
+	var NewDeviceName string
+	var NewPresent X_AVM_DE_Homeauto1PresentEnum
+	var NewMultimeterIsEnabled X_AVM_DE_Homeauto1EnabledEnum
+	var NewMultimeterIsValid X_AVM_DE_Homeauto1ValidEnum
+	var NewMultimeterPower uint32
+	var NewMultimeterEnergy uint32
+	var NewTemperatureIsEnabled X_AVM_DE_Homeauto1EnabledEnum
+	var NewTemperatureIsValid X_AVM_DE_Homeauto1ValidEnum
+	var NewTemperatureCelsius int32
+	var NewTemperatureOffset int32
+	var NewSwitchIsEnabled X_AVM_DE_Homeauto1EnabledEnum
+	var NewSwitchIsValid X_AVM_DE_Homeauto1ValidEnum
+	var NewSwitchState X_AVM_DE_Homeauto1SwStateEnum
+	var NewSwitchMode X_AVM_DE_Homeauto1SwModeEnum
+	var NewSwitchLock bool
+	if NewDeviceId, NewFunctionBitMask, NewFirmwareVersion, NewManufacturer, NewProductName, NewDeviceName, NewPresent, NewMultimeterIsEnabled, NewMultimeterIsValid, NewMultimeterPower, NewMultimeterEnergy, NewTemperatureIsEnabled, NewTemperatureIsValid, NewTemperatureCelsius, NewTemperatureOffset, NewSwitchIsEnabled, NewSwitchIsValid, NewSwitchState, NewSwitchMode, NewSwitchLock, err = handler.GetSpecificDeviceInfos(ctx, NewAIN); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 20)
+
+	out[0].Name = "NewDeviceId"
+	if out[0].Value, err = soap.MarshalUi2(NewDeviceId); err != nil {
+		return
+	}
+	out[1].Name = "NewFunctionBitMask"
+	if out[1].Value, err = soap.MarshalUi2(NewFunctionBitMask); err != nil {
+		return
+	}
+	out[2].Name = "NewFirmwareVersion"
+	if out[2].Value, err = soap.MarshalString(NewFirmwareVersion); err != nil {
+		return
+	}
+	out[3].Name = "NewManufacturer"
+	if out[3].Value, err = soap.MarshalString(NewManufacturer); err != nil {
+		return
+	}
+	out[4].Name = "NewProductName"
+	if out[4].Value, err = soap.MarshalString(NewProductName); err != nil {
+		return
+	}
+	out[5].Name = "NewDeviceName"
+	if out[5].Value, err = soap.MarshalString(NewDeviceName); err != nil {
+		return
+	}
+	out[6].Name = "NewPresent"
+	if out[6].Value, err = soap.MarshalString(string(NewPresent)); err != nil {
+		return
+	}
+	out[7].Name = "NewMultimeterIsEnabled"
+	if out[7].Value, err = soap.MarshalString(string(NewMultimeterIsEnabled)); err != nil {
+		return
+	}
+	out[8].Name = "NewMultimeterIsValid"
+	if out[8].Value, err = soap.MarshalString(string(NewMultimeterIsValid)); err != nil {
+		return
+	}
+	out[9].Name = "NewMultimeterPower"
+	if out[9].Value, err = soap.MarshalUi4(NewMultimeterPower); err != nil {
+		return
+	}
+	out[10].Name = "NewMultimeterEnergy"
+	if out[10].Value, err = soap.MarshalUi4(NewMultimeterEnergy); err != nil {
+		return
+	}
+	out[11].Name = "NewTemperatureIsEnabled"
+	if out[11].Value, err = soap.MarshalString(string(NewTemperatureIsEnabled)); err != nil {
+		return
+	}
+	out[12].Name = "NewTemperatureIsValid"
+	if out[12].Value, err = soap.MarshalString(string(NewTemperatureIsValid)); err != nil {
+		return
+	}
+	out[13].Name = "NewTemperatureCelsius"
+	if out[13].Value, err = soap.MarshalI4(NewTemperatureCelsius); err != nil {
+		return
+	}
+	out[14].Name = "NewTemperatureOffset"
+	if out[14].Value, err = soap.MarshalI4(NewTemperatureOffset); err != nil {
+		return
+	}
+	out[15].Name = "NewSwitchIsEnabled"
+	if out[15].Value, err = soap.MarshalString(string(NewSwitchIsEnabled)); err != nil {
+		return
+	}
+	out[16].Name = "NewSwitchIsValid"
+	if out[16].Value, err = soap.MarshalString(string(NewSwitchIsValid)); err != nil {
+		return
+	}
+	out[17].Name = "NewSwitchState"
+	if out[17].Value, err = soap.MarshalString(string(NewSwitchState)); err != nil {
+		return
+	}
+	out[18].Name = "NewSwitchMode"
+	if out[18].Value, err = soap.MarshalString(string(NewSwitchMode)); err != nil {
+		return
+	}
+	out[19].Name = "NewSwitchLock"
+	if out[19].Value, err = soap.MarshalBoolean(NewSwitchLock); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveX_AVM_DE_Homeauto1SetSwitch(ctx context.Context, handler X_AVM_DE_Homeauto1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewAIN string
+	if value, err = soap.FindArg(in, "NewAIN"); err != nil {
+		return
+	}
+	if NewAIN, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewAIN: "+err.Error())
+	}
+	var NewSwitchState X_AVM_DE_Homeauto1SwStateEnum
+	if value, err = soap.FindArg(in, "NewSwitchState"); err != nil {
+		return
+	}
+	NewSwitchState = X_AVM_DE_Homeauto1SwStateEnum(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetSwitch(ctx, NewAIN, NewSwitchState); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveX_AVM_DE_Homeauto1SetDeviceName(ctx context.Context, handler X_AVM_DE_Homeauto1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewAIN string
+	if value, err = soap.FindArg(in, "NewAIN"); err != nil {
+		return
+	}
+	if NewAIN, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewAIN: "+err.Error())
+	}
+	var NewDeviceName string
+	if value, err = soap.FindArg(in, "NewDeviceName"); err != nil {
+		return
+	}
+	if NewDeviceName, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewDeviceName: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetDeviceName(ctx, NewAIN, NewDeviceName); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
@@ -0,0 +1,1020 @@
+// Client for UPnP Device Control Protocol Device Management v2.
+//
+// Typically, use one of the New* functions to create clients for services.
+package devicemanagement2
+
+// Generated file - do not edit by hand. See README.md
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/huin/goupnp"
+	"github.com/huin/goupnp/soap"
+)
+
+// Hack to avoid Go complaining if time isn't used.
+var _ time.Time
+
+// Device URNs:
+const ()
+
+// Service URNs:
+const (
+	URN_BasicManagement_2 = "urn:schemas-upnp-org:service:BasicManagement:2"
+)
+
+// BasicManagement2 is a client for UPnP SOAP service with URN "urn:schemas-upnp-org:service:BasicManagement:2". See
+// goupnp.ServiceClient, which contains RootDevice and Service attributes which
+// are provided for informational value.
+type BasicManagement2 struct {
+	goupnp.ServiceClient
+}
+
+// BasicManagement2Client is the interface of the actions of BasicManagement2, for
+// substituting fakes or mocks for the service in tests.
+type BasicManagement2Client interface {
+	GetDeviceStatus() (DeviceStatus string, err error)
+	GetDeviceStatusCtx(ctx context.Context) (DeviceStatus string, err error)
+	SetSequenceMode(NewSequenceMode bool) (err error)
+	SetSequenceModeCtx(ctx context.Context, NewSequenceMode bool) (err error)
+	GetSequenceMode() (SequenceMode bool, err error)
+	GetSequenceModeCtx(ctx context.Context) (SequenceMode bool, err error)
+	Reboot() (err error)
+	RebootCtx(ctx context.Context) (err error)
+	BaselineReset() (err error)
+	BaselineResetCtx(ctx context.Context) (err error)
+	StartPing(Host string, NumberOfRepetitions uint32, Timeout uint32, DataBlockSize uint16, DSCP uint8) (TestID uint32, err error)
+	StartPingCtx(ctx context.Context, Host string, NumberOfRepetitions uint32, Timeout uint32, DataBlockSize uint16, DSCP uint8) (TestID uint32, err error)
+	GetPingResult(TestID uint32) (Status BasicManagement2PingStatus, AdditionalInfo string, SuccessCount uint32, FailureCount uint32, AverageResponseTime uint32, MinimumResponseTime uint32, MaximumResponseTime uint32, err error)
+	GetPingResultCtx(ctx context.Context, TestID uint32) (Status BasicManagement2PingStatus, AdditionalInfo string, SuccessCount uint32, FailureCount uint32, AverageResponseTime uint32, MinimumResponseTime uint32, MaximumResponseTime uint32, err error)
+	StartNSLookup(HostName string, DNSServer string, NumberOfRepetitions uint32, Timeout uint32) (TestID uint32, err error)
+	StartNSLookupCtx(ctx context.Context, HostName string, DNSServer string, NumberOfRepetitions uint32, Timeout uint32) (TestID uint32, err error)
+	GetNSLookupResult(TestID uint32) (Status BasicManagement2NSLookupStatus, AdditionalInfo string, SuccessCount uint32, Result string, err error)
+	GetNSLookupResultCtx(ctx context.Context, TestID uint32) (Status BasicManagement2NSLookupStatus, AdditionalInfo string, SuccessCount uint32, Result string, err error)
+	StartTraceroute(Host string, Timeout uint32, DataBlockSize uint16, MaxHopCount uint8, DSCP uint8) (TestID uint32, err error)
+	StartTracerouteCtx(ctx context.Context, Host string, Timeout uint32, DataBlockSize uint16, MaxHopCount uint8, DSCP uint8) (TestID uint32, err error)
+	GetTracerouteResult(TestID uint32) (Status BasicManagement2TracerouteStatus, AdditionalInfo string, ResponseTime uint32, HopHosts string, err error)
+	GetTracerouteResultCtx(ctx context.Context, TestID uint32) (Status BasicManagement2TracerouteStatus, AdditionalInfo string, ResponseTime uint32, HopHosts string, err error)
+	GetTestIDs() (TestIDs string, err error)
+	GetTestIDsCtx(ctx context.Context) (TestIDs string, err error)
+	GetActiveTestIDs() (TestIDs string, err error)
+	GetActiveTestIDsCtx(ctx context.Context) (TestIDs string, err error)
+	GetTestInfo(TestID uint32) (Type BasicManagement2TestType, State BasicManagement2TestState, err error)
+	GetTestInfoCtx(ctx context.Context, TestID uint32) (Type BasicManagement2TestType, State BasicManagement2TestState, err error)
+	CancelTest(TestID uint32) (err error)
+	CancelTestCtx(ctx context.Context, TestID uint32) (err error)
+}
+
+var _ BasicManagement2Client = new(BasicManagement2)
+
+// BasicManagement2TestType is a value of the state variable A_ARG_TYPE_TestType of
+// BasicManagement2.
+type BasicManagement2TestType string
+
+// Allowed values of BasicManagement2TestType.
+const (
+	BasicManagement2TestType_NSLookup       BasicManagement2TestType = "NSLookup"
+	BasicManagement2TestType_Ping           BasicManagement2TestType = "Ping"
+	BasicManagement2TestType_Traceroute     BasicManagement2TestType = "Traceroute"
+	BasicManagement2TestType_BandwidthTest  BasicManagement2TestType = "BandwidthTest"
+	BasicManagement2TestType_InterfaceReset BasicManagement2TestType = "InterfaceReset"
+	BasicManagement2TestType_SelfTest       BasicManagement2TestType = "SelfTest"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v BasicManagement2TestType) Valid() bool {
+	switch v {
+	case BasicManagement2TestType_NSLookup,
+		BasicManagement2TestType_Ping,
+		BasicManagement2TestType_Traceroute,
+		BasicManagement2TestType_BandwidthTest,
+		BasicManagement2TestType_InterfaceReset,
+		BasicManagement2TestType_SelfTest:
+		return true
+	}
+	return false
+}
+
+// BasicManagement2TestState is a value of the state variable A_ARG_TYPE_TestState of
+// BasicManagement2.
+type BasicManagement2TestState string
+
+// Allowed values of BasicManagement2TestState.
+const (
+	BasicManagement2TestState_Requested  BasicManagement2TestState = "Requested"
+	BasicManagement2TestState_InProgress BasicManagement2TestState = "InProgress"
+	BasicManagement2TestState_Canceled   BasicManagement2TestState = "Canceled"
+	BasicManagement2TestState_Completed  BasicManagement2TestState = "Completed"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v BasicManagement2TestState) Valid() bool {
+	switch v {
+	case BasicManagement2TestState_Requested,
+		BasicManagement2TestState_InProgress,
+		BasicManagement2TestState_Canceled,
+		BasicManagement2TestState_Completed:
+		return true
+	}
+	return false
+}
+
+// BasicManagement2PingStatus is a value of the state variable A_ARG_TYPE_PingStatus of
+// BasicManagement2.
+type BasicManagement2PingStatus string
+
+// Allowed values of BasicManagement2PingStatus.
+const (
+	BasicManagement2PingStatus_Success                     BasicManagement2PingStatus = "Success"
+	BasicManagement2PingStatus_Error_CannotResolveHostName BasicManagement2PingStatus = "Error_CannotResolveHostName"
+	BasicManagement2PingStatus_Error_Internal              BasicManagement2PingStatus = "Error_Internal"
+	BasicManagement2PingStatus_Error_Other                 BasicManagement2PingStatus = "Error_Other"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v BasicManagement2PingStatus) Valid() bool {
+	switch v {
+	case BasicManagement2PingStatus_Success,
+		BasicManagement2PingStatus_Error_CannotResolveHostName,
+		BasicManagement2PingStatus_Error_Internal,
+		BasicManagement2PingStatus_Error_Other:
+		return true
+	}
+	return false
+}
+
+// BasicManagement2NSLookupStatus is a value of the state variable A_ARG_TYPE_NSLookupStatus of
+// BasicManagement2.
+type BasicManagement2NSLookupStatus string
+
+// Allowed values of BasicManagement2NSLookupStatus.
+const (
+	BasicManagement2NSLookupStatus_Success                    BasicManagement2NSLookupStatus = "Success"
+	BasicManagement2NSLookupStatus_Error_DNSServerNotResolved BasicManagement2NSLookupStatus = "Error_DNSServerNotResolved"
+	BasicManagement2NSLookupStatus_Error_Internal             BasicManagement2NSLookupStatus = "Error_Internal"
+	BasicManagement2NSLookupStatus_Error_Other                BasicManagement2NSLookupStatus = "Error_Other"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v BasicManagement2NSLookupStatus) Valid() bool {
+	switch v {
+	case BasicManagement2NSLookupStatus_Success,
+		BasicManagement2NSLookupStatus_Error_DNSServerNotResolved,
+		BasicManagement2NSLookupStatus_Error_Internal,
+		BasicManagement2NSLookupStatus_Error_Other:
+		return true
+	}
+	return false
+}
+
+// BasicManagement2TracerouteStatus is a value of the state variable A_ARG_TYPE_TracerouteStatus of
+// BasicManagement2.
+type BasicManagement2TracerouteStatus string
+
+// Allowed values of BasicManagement2TracerouteStatus.
+const (
+	BasicManagement2TracerouteStatus_Success                     BasicManagement2TracerouteStatus = "Success"
+	BasicManagement2TracerouteStatus_Error_CannotResolveHostName BasicManagement2TracerouteStatus = "Error_CannotResolveHostName"
+	BasicManagement2TracerouteStatus_Error_MaxHopCountExceeded   BasicManagement2TracerouteStatus = "Error_MaxHopCountExceeded"
+	BasicManagement2TracerouteStatus_Error_Internal              BasicManagement2TracerouteStatus = "Error_Internal"
+	BasicManagement2TracerouteStatus_Error_Other                 BasicManagement2TracerouteStatus = "Error_Other"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v BasicManagement2TracerouteStatus) Valid() bool {
+	switch v {
+	case BasicManagement2TracerouteStatus_Success,
+		BasicManagement2TracerouteStatus_Error_CannotResolveHostName,
+		BasicManagement2TracerouteStatus_Error_MaxHopCountExceeded,
+		BasicManagement2TracerouteStatus_Error_Internal,
+		BasicManagement2TracerouteStatus_Error_Other:
+		return true
+	}
+	return false
+}
+
+// NewBasicManagement2Clients discovers instances of the service on the network,
+// and returns clients to any that are found. errors will contain an error for
+// any devices that replied but which could not be queried, and err will be set
+// if the discovery process failed outright.
+//
+// This is a typical entry calling point into this package.
+func NewBasicManagement2Clients() (clients []*BasicManagement2, errors []error, err error) {
+	var genericClients []goupnp.ServiceClient
+	if genericClients, errors, err = goupnp.NewServiceClients(URN_BasicManagement_2); err != nil {
+		return
+	}
+	clients = newBasicManagement2ClientsFromGenericClients(genericClients)
+	return
+}
+
+// NewBasicManagement2ClientsByURL discovers instances of the service at the given
+// URL, and returns clients to any that are found. An error is returned if
+// there was an error probing the service.
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered service URL.
+func NewBasicManagement2ClientsByURL(loc *url.URL) ([]*BasicManagement2, error) {
+	genericClients, err := goupnp.NewServiceClientsByURL(loc, URN_BasicManagement_2)
+	if err != nil {
+		return nil, err
+	}
+	return newBasicManagement2ClientsFromGenericClients(genericClients), nil
+}
+
+// NewBasicManagement2ClientsFromRootDevice discovers instances of the service in
+// a given root device, and returns clients to any that are found. An error is
+// returned if there was not at least one instance of the service within the
+// device. The location parameter is simply assigned to the Location attribute
+// of the wrapped ServiceClient(s).
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered root device.
+func NewBasicManagement2ClientsFromRootDevice(rootDevice *goupnp.RootDevice, loc *url.URL) ([]*BasicManagement2, error) {
+	genericClients, err := goupnp.NewServiceClientsFromRootDevice(rootDevice, loc, URN_BasicManagement_2)
+	if err != nil {
+		return nil, err
+	}
+	return newBasicManagement2ClientsFromGenericClients(genericClients), nil
+}
+
+func newBasicManagement2ClientsFromGenericClients(genericClients []goupnp.ServiceClient) []*BasicManagement2 {
+	clients := make([]*BasicManagement2, len(genericClients))
+	for i := range genericClients {
+		clients[i] = &BasicManagement2{genericClients[i]}
+	}
+	return clients
+}
+
+// BasicManagement2Timeouts are the default timeouts of the actions of
+// BasicManagement2, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var BasicManagement2Timeouts = map[string]time.Duration{}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *BasicManagement2) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, BasicManagement2Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_BasicManagement_2, actionName, request, response)
+}
+
+// BasicManagement2GetDeviceStatusResponse is the response of GetDeviceStatus, with each
+// argument in its SOAP string form.
+type BasicManagement2GetDeviceStatusResponse struct {
+	DeviceStatus string
+}
+
+// GetDeviceStatus performs the GetDeviceStatus action of the service.
+//
+// Return values:
+//   - DeviceStatus (out, state variable DeviceStatus)
+func (client *BasicManagement2) GetDeviceStatus() (DeviceStatus string, err error) {
+	return client.GetDeviceStatusCtx(context.Background())
+}
+
+// GetDeviceStatusCtx is GetDeviceStatus with a context, to cancel or time out the call.
+func (client *BasicManagement2) GetDeviceStatusCtx(ctx context.Context) (DeviceStatus string, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &BasicManagement2GetDeviceStatusResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetDeviceStatus", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if DeviceStatus, err = soap.UnmarshalString(response.DeviceStatus); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// BasicManagement2SetSequenceModeRequest is the request of SetSequenceMode, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type BasicManagement2SetSequenceModeRequest struct {
+	NewSequenceMode string
+}
+
+// SetSequenceMode performs the SetSequenceMode action of the service.
+//
+// Arguments:
+//   - NewSequenceMode (in, state variable SequenceMode)
+func (client *BasicManagement2) SetSequenceMode(NewSequenceMode bool) (err error) {
+	return client.SetSequenceModeCtx(context.Background(), NewSequenceMode)
+}
+
+// SetSequenceModeCtx is SetSequenceMode with a context, to cancel or time out the call.
+func (client *BasicManagement2) SetSequenceModeCtx(ctx context.Context, NewSequenceMode bool) (err error) {
+	// Request structure.
+	request := &BasicManagement2SetSequenceModeRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewSequenceMode, err = soap.MarshalBoolean(NewSequenceMode); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SetSequenceMode", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// BasicManagement2GetSequenceModeResponse is the response of GetSequenceMode, with each
+// argument in its SOAP string form.
+type BasicManagement2GetSequenceModeResponse struct {
+	SequenceMode string
+}
+
+// GetSequenceMode performs the GetSequenceMode action of the service.
+//
+// Return values:
+//   - SequenceMode (out, state variable SequenceMode)
+func (client *BasicManagement2) GetSequenceMode() (SequenceMode bool, err error) {
+	return client.GetSequenceModeCtx(context.Background())
+}
+
+// GetSequenceModeCtx is GetSequenceMode with a context, to cancel or time out the call.
+func (client *BasicManagement2) GetSequenceModeCtx(ctx context.Context) (SequenceMode bool, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &BasicManagement2GetSequenceModeResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetSequenceMode", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if SequenceMode, err = soap.UnmarshalBoolean(response.SequenceMode); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// Reboot performs the Reboot action of the service.
+func (client *BasicManagement2) Reboot() (err error) {
+	return client.RebootCtx(context.Background())
+}
+
+// RebootCtx is Reboot with a context, to cancel or time out the call.
+func (client *BasicManagement2) RebootCtx(ctx context.Context) (err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "Reboot", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// BaselineReset performs the BaselineReset action of the service.
+func (client *BasicManagement2) BaselineReset() (err error) {
+	return client.BaselineResetCtx(context.Background())
+}
+
+// BaselineResetCtx is BaselineReset with a context, to cancel or time out the call.
+func (client *BasicManagement2) BaselineResetCtx(ctx context.Context) (err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "BaselineReset", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// BasicManagement2StartPingRequest is the request of StartPing, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type BasicManagement2StartPingRequest struct {
+	Host                string
+	NumberOfRepetitions string
+	Timeout             string
+	DataBlockSize       string
+	DSCP                string
+}
+
+// BasicManagement2StartPingResponse is the response of StartPing, with each
+// argument in its SOAP string form.
+type BasicManagement2StartPingResponse struct {
+	TestID string
+}
+
+// StartPing performs the StartPing action of the service.
+//
+// Arguments:
+//   - Host (in, state variable A_ARG_TYPE_String)
+//   - NumberOfRepetitions (in, state variable A_ARG_TYPE_UInt)
+//   - Timeout (in, state variable A_ARG_TYPE_MSecs)
+//   - DataBlockSize (in, state variable A_ARG_TYPE_UShort)
+//   - DSCP (in, state variable A_ARG_TYPE_DSCP): allowed range minimum=0, maximum=63
+//
+// Return values:
+//   - TestID (out, state variable A_ARG_TYPE_TestID)
+func (client *BasicManagement2) StartPing(Host string, NumberOfRepetitions uint32, Timeout uint32, DataBlockSize uint16, DSCP uint8) (TestID uint32, err error) {
+	return client.StartPingCtx(context.Background(), Host, NumberOfRepetitions, Timeout, DataBlockSize, DSCP)
+}
+
+// StartPingCtx is StartPing with a context, to cancel or time out the call.
+func (client *BasicManagement2) StartPingCtx(ctx context.Context, Host string, NumberOfRepetitions uint32, Timeout uint32, DataBlockSize uint16, DSCP uint8) (TestID uint32, err error) {
+	// Request structure.
+	request := &BasicManagement2StartPingRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.Host, err = soap.MarshalString(Host); err != nil {
+		return
+	}
+	if request.NumberOfRepetitions, err = soap.MarshalUi4(NumberOfRepetitions); err != nil {
+		return
+	}
+	if request.Timeout, err = soap.MarshalUi4(Timeout); err != nil {
+		return
+	}
+	if request.DataBlockSize, err = soap.MarshalUi2(DataBlockSize); err != nil {
+		return
+	}
+	if request.DSCP, err = soap.MarshalUi1(DSCP); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &BasicManagement2StartPingResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "StartPing", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if TestID, err = soap.UnmarshalUi4(response.TestID); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// BasicManagement2GetPingResultRequest is the request of GetPingResult, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type BasicManagement2GetPingResultRequest struct {
+	TestID string
+}
+
+// BasicManagement2GetPingResultResponse is the response of GetPingResult, with each
+// argument in its SOAP string form.
+type BasicManagement2GetPingResultResponse struct {
+	Status              string
+	AdditionalInfo      string
+	SuccessCount        string
+	FailureCount        string
+	AverageResponseTime string
+	MinimumResponseTime string
+	MaximumResponseTime string
+}
+
+// GetPingResult performs the GetPingResult action of the service.
+//
+// Arguments:
+//   - TestID (in, state variable A_ARG_TYPE_TestID)
+//
+// Return values:
+//   - Status (out, state variable A_ARG_TYPE_PingStatus): allowed values Success, Error_CannotResolveHostName, Error_Internal, Error_Other
+//   - AdditionalInfo (out, state variable A_ARG_TYPE_String)
+//   - SuccessCount (out, state variable A_ARG_TYPE_UInt)
+//   - FailureCount (out, state variable A_ARG_TYPE_UInt)
+//   - AverageResponseTime (out, state variable A_ARG_TYPE_MSecs)
+//   - MinimumResponseTime (out, state variable A_ARG_TYPE_MSecs)
+//   - MaximumResponseTime (out, state variable A_ARG_TYPE_MSecs)
+func (client *BasicManagement2) GetPingResult(TestID uint32) (Status BasicManagement2PingStatus, AdditionalInfo string, SuccessCount uint32, FailureCount uint32, AverageResponseTime uint32, MinimumResponseTime uint32, MaximumResponseTime uint32, err error) {
+	return client.GetPingResultCtx(context.Background(), TestID)
+}
+
+// GetPingResultCtx is GetPingResult with a context, to cancel or time out the call.
+func (client *BasicManagement2) GetPingResultCtx(ctx context.Context, TestID uint32) (Status BasicManagement2PingStatus, AdditionalInfo string, SuccessCount uint32, FailureCount uint32, AverageResponseTime uint32, MinimumResponseTime uint32, MaximumResponseTime uint32, err error) {
+	// Request structure.
+	request := &BasicManagement2GetPingResultRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.TestID, err = soap.MarshalUi4(TestID); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &BasicManagement2GetPingResultResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetPingResult", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	Status = BasicManagement2PingStatus(response.Status)
+	if AdditionalInfo, err = soap.UnmarshalString(response.AdditionalInfo); err != nil {
+		return
+	}
+	if SuccessCount, err = soap.UnmarshalUi4(response.SuccessCount); err != nil {
+		return
+	}
+	if FailureCount, err = soap.UnmarshalUi4(response.FailureCount); err != nil {
+		return
+	}
+	if AverageResponseTime, err = soap.UnmarshalUi4(response.AverageResponseTime); err != nil {
+		return
+	}
+	if MinimumResponseTime, err = soap.UnmarshalUi4(response.MinimumResponseTime); err != nil {
+		return
+	}
+	if MaximumResponseTime, err = soap.UnmarshalUi4(response.MaximumResponseTime); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// BasicManagement2StartNSLookupRequest is the request of StartNSLookup, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type BasicManagement2StartNSLookupRequest struct {
+	HostName            string
+	DNSServer           string
+	NumberOfRepetitions string
+	Timeout             string
+}
+
+// BasicManagement2StartNSLookupResponse is the response of StartNSLookup, with each
+// argument in its SOAP string form.
+type BasicManagement2StartNSLookupResponse struct {
+	TestID string
+}
+
+// StartNSLookup performs the StartNSLookup action of the service.
+//
+// Arguments:
+//   - HostName (in, state variable A_ARG_TYPE_String)
+//   - DNSServer (in, state variable A_ARG_TYPE_String)
+//   - NumberOfRepetitions (in, state variable A_ARG_TYPE_UInt)
+//   - Timeout (in, state variable A_ARG_TYPE_MSecs)
+//
+// Return values:
+//   - TestID (out, state variable A_ARG_TYPE_TestID)
+func (client *BasicManagement2) StartNSLookup(HostName string, DNSServer string, NumberOfRepetitions uint32, Timeout uint32) (TestID uint32, err error) {
+	return client.StartNSLookupCtx(context.Background(), HostName, DNSServer, NumberOfRepetitions, Timeout)
+}
+
+// StartNSLookupCtx is StartNSLookup with a context, to cancel or time out the call.
+func (client *BasicManagement2) StartNSLookupCtx(ctx context.Context, HostName string, DNSServer string, NumberOfRepetitions uint32, Timeout uint32) (TestID uint32, err error) {
+	// Request structure.
+	request := &BasicManagement2StartNSLookupRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.HostName, err = soap.MarshalString(HostName); err != nil {
+		return
+	}
+	if request.DNSServer, err = soap.MarshalString(DNSServer); err != nil {
+		return
+	}
+	if request.NumberOfRepetitions, err = soap.MarshalUi4(NumberOfRepetitions); err != nil {
+		return
+	}
+	if request.Timeout, err = soap.MarshalUi4(Timeout); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &BasicManagement2StartNSLookupResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "StartNSLookup", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if TestID, err = soap.UnmarshalUi4(response.TestID); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// BasicManagement2GetNSLookupResultRequest is the request of GetNSLookupResult, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type BasicManagement2GetNSLookupResultRequest struct {
+	TestID string
+}
+
+// BasicManagement2GetNSLookupResultResponse is the response of GetNSLookupResult, with each
+// argument in its SOAP string form.
+type BasicManagement2GetNSLookupResultResponse struct {
+	Status         string
+	AdditionalInfo string
+	SuccessCount   string
+	Result         string
+}
+
+// GetNSLookupResult performs the GetNSLookupResult action of the service.
+//
+// Arguments:
+//   - TestID (in, state variable A_ARG_TYPE_TestID)
+//
+// Return values:
+//   - Status (out, state variable A_ARG_TYPE_NSLookupStatus): allowed values Success, Error_DNSServerNotResolved, Error_Internal, Error_Other
+//   - AdditionalInfo (out, state variable A_ARG_TYPE_String)
+//   - SuccessCount (out, state variable A_ARG_TYPE_UInt)
+//   - Result (out, state variable A_ARG_TYPE_NSLookupResult)
+func (client *BasicManagement2) GetNSLookupResult(TestID uint32) (Status BasicManagement2NSLookupStatus, AdditionalInfo string, SuccessCount uint32, Result string, err error) {
+	return client.GetNSLookupResultCtx(context.Background(), TestID)
+}
+
+// GetNSLookupResultCtx is GetNSLookupResult with a context, to cancel or time out the call.
+func (client *BasicManagement2) GetNSLookupResultCtx(ctx context.Context, TestID uint32) (Status BasicManagement2NSLookupStatus, AdditionalInfo string, SuccessCount uint32, Result string, err error) {
+	// Request structure.
+	request := &BasicManagement2GetNSLookupResultRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.TestID, err = soap.MarshalUi4(TestID); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &BasicManagement2GetNSLookupResultResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetNSLookupResult", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	Status = BasicManagement2NSLookupStatus(response.Status)
+	if AdditionalInfo, err = soap.UnmarshalString(response.AdditionalInfo); err != nil {
+		return
+	}
+	if SuccessCount, err = soap.UnmarshalUi4(response.SuccessCount); err != nil {
+		return
+	}
+	if Result, err = soap.UnmarshalString(response.Result); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// BasicManagement2StartTracerouteRequest is the request of StartTraceroute, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type BasicManagement2StartTracerouteRequest struct {
+	Host          string
+	Timeout       string
+	DataBlockSize string
+	MaxHopCount   string
+	DSCP          string
+}
+
+// BasicManagement2StartTracerouteResponse is the response of StartTraceroute, with each
+// argument in its SOAP string form.
+type BasicManagement2StartTracerouteResponse struct {
+	TestID string
+}
+
+// StartTraceroute performs the StartTraceroute action of the service.
+//
+// Arguments:
+//   - Host (in, state variable A_ARG_TYPE_String)
+//   - Timeout (in, state variable A_ARG_TYPE_MSecs)
+//   - DataBlockSize (in, state variable A_ARG_TYPE_UShort)
+//   - MaxHopCount (in, state variable A_ARG_TYPE_HopCount): allowed range minimum=1, maximum=64
+//   - DSCP (in, state variable A_ARG_TYPE_DSCP): allowed range minimum=0, maximum=63
+//
+// Return values:
+//   - TestID (out, state variable A_ARG_TYPE_TestID)
+func (client *BasicManagement2) StartTraceroute(Host string, Timeout uint32, DataBlockSize uint16, MaxHopCount uint8, DSCP uint8) (TestID uint32, err error) {
+	return client.StartTracerouteCtx(context.Background(), Host, Timeout, DataBlockSize, MaxHopCount, DSCP)
+}
+
+// StartTracerouteCtx is StartTraceroute with a context, to cancel or time out the call.
+func (client *BasicManagement2) StartTracerouteCtx(ctx context.Context, Host string, Timeout uint32, DataBlockSize uint16, MaxHopCount uint8, DSCP uint8) (TestID uint32, err error) {
+	// Request structure.
+	request := &BasicManagement2StartTracerouteRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.Host, err = soap.MarshalString(Host); err != nil {
+		return
+	}
+	if request.Timeout, err = soap.MarshalUi4(Timeout); err != nil {
+		return
+	}
+	if request.DataBlockSize, err = soap.MarshalUi2(DataBlockSize); err != nil {
+		return
+	}
+	if request.MaxHopCount, err = soap.MarshalUi1(MaxHopCount); err != nil {
+		return
+	}
+	if request.DSCP, err = soap.MarshalUi1(DSCP); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &BasicManagement2StartTracerouteResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "StartTraceroute", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if TestID, err = soap.UnmarshalUi4(response.TestID); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// BasicManagement2GetTracerouteResultRequest is the request of GetTracerouteResult, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type BasicManagement2GetTracerouteResultRequest struct {
+	TestID string
+}
+
+// BasicManagement2GetTracerouteResultResponse is the response of GetTracerouteResult, with each
+// argument in its SOAP string form.
+type BasicManagement2GetTracerouteResultResponse struct {
+	Status         string
+	AdditionalInfo string
+	ResponseTime   string
+	HopHosts       string
+}
+
+// GetTracerouteResult performs the GetTracerouteResult action of the service.
+//
+// Arguments:
+//   - TestID (in, state variable A_ARG_TYPE_TestID)
+//
+// Return values:
+//   - Status (out, state variable A_ARG_TYPE_TracerouteStatus): allowed values Success, Error_CannotResolveHostName, Error_MaxHopCountExceeded, Error_Internal, Error_Other
+//   - AdditionalInfo (out, state variable A_ARG_TYPE_String)
+//   - ResponseTime (out, state variable A_ARG_TYPE_MSecs)
+//   - HopHosts (out, state variable A_ARG_TYPE_HopHosts)
+func (client *BasicManagement2) GetTracerouteResult(TestID uint32) (Status BasicManagement2TracerouteStatus, AdditionalInfo string, ResponseTime uint32, HopHosts string, err error) {
+	return client.GetTracerouteResultCtx(context.Background(), TestID)
+}
+
+// GetTracerouteResultCtx is GetTracerouteResult with a context, to cancel or time out the call.
+func (client *BasicManagement2) GetTracerouteResultCtx(ctx context.Context, TestID uint32) (Status BasicManagement2TracerouteStatus, AdditionalInfo string, ResponseTime uint32, HopHosts string, err error) {
+	// Request structure.
+	request := &BasicManagement2GetTracerouteResultRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.TestID, err = soap.MarshalUi4(TestID); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &BasicManagement2GetTracerouteResultResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetTracerouteResult", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	Status = BasicManagement2TracerouteStatus(response.Status)
+	if AdditionalInfo, err = soap.UnmarshalString(response.AdditionalInfo); err != nil {
+		return
+	}
+	if ResponseTime, err = soap.UnmarshalUi4(response.ResponseTime); err != nil {
+		return
+	}
+	if HopHosts, err = soap.UnmarshalString(response.HopHosts); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// BasicManagement2GetTestIDsResponse is the response of GetTestIDs, with each
+// argument in its SOAP string form.
+type BasicManagement2GetTestIDsResponse struct {
+	TestIDs string
+}
+
+// GetTestIDs performs the GetTestIDs action of the service.
+//
+// Return values:
+//   - TestIDs (out, state variable TestIDs)
+func (client *BasicManagement2) GetTestIDs() (TestIDs string, err error) {
+	return client.GetTestIDsCtx(context.Background())
+}
+
+// GetTestIDsCtx is GetTestIDs with a context, to cancel or time out the call.
+func (client *BasicManagement2) GetTestIDsCtx(ctx context.Context) (TestIDs string, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &BasicManagement2GetTestIDsResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetTestIDs", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if TestIDs, err = soap.UnmarshalString(response.TestIDs); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// BasicManagement2GetActiveTestIDsResponse is the response of GetActiveTestIDs, with each
+// argument in its SOAP string form.
+type BasicManagement2GetActiveTestIDsResponse struct {
+	TestIDs string
+}
+
+// GetActiveTestIDs performs the GetActiveTestIDs action of the service.
+//
+// Return values:
+//   - TestIDs (out, state variable ActiveTestIDs)
+func (client *BasicManagement2) GetActiveTestIDs() (TestIDs string, err error) {
+	return client.GetActiveTestIDsCtx(context.Background())
+}
+
+// GetActiveTestIDsCtx is GetActiveTestIDs with a context, to cancel or time out the call.
+func (client *BasicManagement2) GetActiveTestIDsCtx(ctx context.Context) (TestIDs string, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &BasicManagement2GetActiveTestIDsResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetActiveTestIDs", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if TestIDs, err = soap.UnmarshalString(response.TestIDs); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// BasicManagement2GetTestInfoRequest is the request of GetTestInfo, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type BasicManagement2GetTestInfoRequest struct {
+	TestID string
+}
+
+// BasicManagement2GetTestInfoResponse is the response of GetTestInfo, with each
+// argument in its SOAP string form.
+type BasicManagement2GetTestInfoResponse struct {
+	Type  string
+	State string
+}
+
+// GetTestInfo performs the GetTestInfo action of the service.
+//
+// Arguments:
+//   - TestID (in, state variable A_ARG_TYPE_TestID)
+//
+// Return values:
+//   - Type (out, state variable A_ARG_TYPE_TestType): allowed values NSLookup, Ping, Traceroute, BandwidthTest, InterfaceReset, SelfTest
+//   - State (out, state variable A_ARG_TYPE_TestState): allowed values Requested, InProgress, Canceled, Completed
+func (client *BasicManagement2) GetTestInfo(TestID uint32) (Type BasicManagement2TestType, State BasicManagement2TestState, err error) {
+	return client.GetTestInfoCtx(context.Background(), TestID)
+}
+
+// GetTestInfoCtx is GetTestInfo with a context, to cancel or time out the call.
+func (client *BasicManagement2) GetTestInfoCtx(ctx context.Context, TestID uint32) (Type BasicManagement2TestType, State BasicManagement2TestState, err error) {
+	// Request structure.
+	request := &BasicManagement2GetTestInfoRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.TestID, err = soap.MarshalUi4(TestID); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &BasicManagement2GetTestInfoResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetTestInfo", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	Type = BasicManagement2TestType(response.Type)
+	State = BasicManagement2TestState(response.State)
+	// END Unmarshal arguments from response.
+	return
+}
+
+// BasicManagement2CancelTestRequest is the request of CancelTest, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type BasicManagement2CancelTestRequest struct {
+	TestID string
+}
+
+// CancelTest performs the CancelTest action of the service.
+//
+// Arguments:
+//   - TestID (in, state variable A_ARG_TYPE_TestID)
+func (client *BasicManagement2) CancelTest(TestID uint32) (err error) {
+	return client.CancelTestCtx(context.Background(), TestID)
+}
+
+// CancelTestCtx is CancelTest with a context, to cancel or time out the call.
+func (client *BasicManagement2) CancelTestCtx(ctx context.Context, TestID uint32) (err error) {
+	// Request structure.
+	request := &BasicManagement2CancelTestRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.TestID, err = soap.MarshalUi4(TestID); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "CancelTest", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
@@ -0,0 +1,642 @@
+package devicemanagement2
+
+// Generated file - do not edit by hand. See README.md
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/huin/goupnp/device"
+	"github.com/huin/goupnp/soap"
+)
+
+// Hack to avoid Go complaining if url or time aren't used.
+var _ *url.URL
+var _ time.Time
+
+// BasicManagement2Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:schemas-upnp-org:service:BasicManagement:2". See RegisterBasicManagement2Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type BasicManagement2Handler interface {
+	GetDeviceStatus(ctx context.Context) (DeviceStatus string, err error)
+
+	SetSequenceMode(ctx context.Context, NewSequenceMode bool) (err error)
+
+	GetSequenceMode(ctx context.Context) (SequenceMode bool, err error)
+
+	Reboot(ctx context.Context) (err error)
+
+	BaselineReset(ctx context.Context) (err error)
+
+	StartPing(ctx context.Context, Host string, NumberOfRepetitions uint32, Timeout uint32, DataBlockSize uint16, DSCP uint8) (TestID uint32, err error)
+
+	GetPingResult(ctx context.Context, TestID uint32) (Status BasicManagement2PingStatus, AdditionalInfo string, SuccessCount uint32, FailureCount uint32, AverageResponseTime uint32, MinimumResponseTime uint32, MaximumResponseTime uint32, err error)
+
+	StartNSLookup(ctx context.Context, HostName string, DNSServer string, NumberOfRepetitions uint32, Timeout uint32) (TestID uint32, err error)
+
+	GetNSLookupResult(ctx context.Context, TestID uint32) (Status BasicManagement2NSLookupStatus, AdditionalInfo string, SuccessCount uint32, Result string, err error)
+
+	StartTraceroute(ctx context.Context, Host string, Timeout uint32, DataBlockSize uint16, MaxHopCount uint8, DSCP uint8) (TestID uint32, err error)
+
+	GetTracerouteResult(ctx context.Context, TestID uint32) (Status BasicManagement2TracerouteStatus, AdditionalInfo string, ResponseTime uint32, HopHosts string, err error)
+
+	GetTestIDs(ctx context.Context) (TestIDs string, err error)
+
+	GetActiveTestIDs(ctx context.Context) (TestIDs string, err error)
+
+	GetTestInfo(ctx context.Context, TestID uint32) (Type BasicManagement2TestType, State BasicManagement2TestState, err error)
+
+	CancelTest(ctx context.Context, TestID uint32) (err error)
+}
+
+// RegisterBasicManagement2Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_BasicManagement_2.
+func RegisterBasicManagement2Handler(svc *device.Service, handler BasicManagement2Handler) {
+	svc.HandleFunc("GetDeviceStatus", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveBasicManagement2GetDeviceStatus(ctx, handler, in)
+	})
+	svc.HandleFunc("SetSequenceMode", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveBasicManagement2SetSequenceMode(ctx, handler, in)
+	})
+	svc.HandleFunc("GetSequenceMode", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveBasicManagement2GetSequenceMode(ctx, handler, in)
+	})
+	svc.HandleFunc("Reboot", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveBasicManagement2Reboot(ctx, handler, in)
+	})
+	svc.HandleFunc("BaselineReset", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveBasicManagement2BaselineReset(ctx, handler, in)
+	})
+	svc.HandleFunc("StartPing", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveBasicManagement2StartPing(ctx, handler, in)
+	})
+	svc.HandleFunc("GetPingResult", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveBasicManagement2GetPingResult(ctx, handler, in)
+	})
+	svc.HandleFunc("StartNSLookup", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveBasicManagement2StartNSLookup(ctx, handler, in)
+	})
+	svc.HandleFunc("GetNSLookupResult", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveBasicManagement2GetNSLookupResult(ctx, handler, in)
+	})
+	svc.HandleFunc("StartTraceroute", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveBasicManagement2StartTraceroute(ctx, handler, in)
+	})
+	svc.HandleFunc("GetTracerouteResult", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveBasicManagement2GetTracerouteResult(ctx, handler, in)
+	})
+	svc.HandleFunc("GetTestIDs", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveBasicManagement2GetTestIDs(ctx, handler, in)
+	})
+	svc.HandleFunc("GetActiveTestIDs", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveBasicManagement2GetActiveTestIDs(ctx, handler, in)
+	})
+	svc.HandleFunc("GetTestInfo", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveBasicManagement2GetTestInfo(ctx, handler, in)
+	})
+	svc.HandleFunc("CancelTest", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveBasicManagement2CancelTest(ctx, handler, in)
+	})
+}
+
+func serveBasicManagement2GetDeviceStatus(ctx context.Context, handler BasicManagement2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var DeviceStatus string
+	if DeviceStatus, err = handler.GetDeviceStatus(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "DeviceStatus"
+	if out[0].Value, err = soap.MarshalString(DeviceStatus); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveBasicManagement2SetSequenceMode(ctx context.Context, handler BasicManagement2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewSequenceMode bool
+	if value, err = soap.FindArg(in, "NewSequenceMode"); err != nil {
+		return
+	}
+	if NewSequenceMode, err = soap.UnmarshalBoolean(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewSequenceMode: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetSequenceMode(ctx, NewSequenceMode); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveBasicManagement2GetSequenceMode(ctx context.Context, handler BasicManagement2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var SequenceMode bool
+	if SequenceMode, err = handler.GetSequenceMode(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "SequenceMode"
+	if out[0].Value, err = soap.MarshalBoolean(SequenceMode); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveBasicManagement2Reboot(ctx context.Context, handler BasicManagement2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.Reboot(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveBasicManagement2BaselineReset(ctx context.Context, handler BasicManagement2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.BaselineReset(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveBasicManagement2StartPing(ctx context.Context, handler BasicManagement2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var Host string
+	if value, err = soap.FindArg(in, "Host"); err != nil {
+		return
+	}
+	if Host, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Host: "+err.Error())
+	}
+	var NumberOfRepetitions uint32
+	if value, err = soap.FindArg(in, "NumberOfRepetitions"); err != nil {
+		return
+	}
+	if NumberOfRepetitions, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NumberOfRepetitions: "+err.Error())
+	}
+	var Timeout uint32
+	if value, err = soap.FindArg(in, "Timeout"); err != nil {
+		return
+	}
+	if Timeout, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Timeout: "+err.Error())
+	}
+	var DataBlockSize uint16
+	if value, err = soap.FindArg(in, "DataBlockSize"); err != nil {
+		return
+	}
+	if DataBlockSize, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DataBlockSize: "+err.Error())
+	}
+	var DSCP uint8
+	if value, err = soap.FindArg(in, "DSCP"); err != nil {
+		return
+	}
+	if DSCP, err = soap.UnmarshalUi1(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DSCP: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var TestID uint32
+	if TestID, err = handler.StartPing(ctx, Host, NumberOfRepetitions, Timeout, DataBlockSize, DSCP); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "TestID"
+	if out[0].Value, err = soap.MarshalUi4(TestID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveBasicManagement2GetPingResult(ctx context.Context, handler BasicManagement2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var TestID uint32
+	if value, err = soap.FindArg(in, "TestID"); err != nil {
+		return
+	}
+	if TestID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument TestID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var Status BasicManagement2PingStatus
+	var AdditionalInfo string
+	var SuccessCount uint32
+	var FailureCount uint32
+	var AverageResponseTime uint32
+	var MinimumResponseTime uint32
+	var MaximumResponseTime uint32
+	if Status, AdditionalInfo, SuccessCount, FailureCount, AverageResponseTime, MinimumResponseTime, MaximumResponseTime, err = handler.GetPingResult(ctx, TestID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 7)
+
+	out[0].Name = "Status"
+	if out[0].Value, err = soap.MarshalString(string(Status)); err != nil {
+		return
+	}
+	out[1].Name = "AdditionalInfo"
+	if out[1].Value, err = soap.MarshalString(AdditionalInfo); err != nil {
+		return
+	}
+	out[2].Name = "SuccessCount"
+	if out[2].Value, err = soap.MarshalUi4(SuccessCount); err != nil {
+		return
+	}
+	out[3].Name = "FailureCount"
+	if out[3].Value, err = soap.MarshalUi4(FailureCount); err != nil {
+		return
+	}
+	out[4].Name = "AverageResponseTime"
+	if out[4].Value, err = soap.MarshalUi4(AverageResponseTime); err != nil {
+		return
+	}
+	out[5].Name = "MinimumResponseTime"
+	if out[5].Value, err = soap.MarshalUi4(MinimumResponseTime); err != nil {
+		return
+	}
+	out[6].Name = "MaximumResponseTime"
+	if out[6].Value, err = soap.MarshalUi4(MaximumResponseTime); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveBasicManagement2StartNSLookup(ctx context.Context, handler BasicManagement2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var HostName string
+	if value, err = soap.FindArg(in, "HostName"); err != nil {
+		return
+	}
+	if HostName, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument HostName: "+err.Error())
+	}
+	var DNSServer string
+	if value, err = soap.FindArg(in, "DNSServer"); err != nil {
+		return
+	}
+	if DNSServer, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DNSServer: "+err.Error())
+	}
+	var NumberOfRepetitions uint32
+	if value, err = soap.FindArg(in, "NumberOfRepetitions"); err != nil {
+		return
+	}
+	if NumberOfRepetitions, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NumberOfRepetitions: "+err.Error())
+	}
+	var Timeout uint32
+	if value, err = soap.FindArg(in, "Timeout"); err != nil {
+		return
+	}
+	if Timeout, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Timeout: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var TestID uint32
+	if TestID, err = handler.StartNSLookup(ctx, HostName, DNSServer, NumberOfRepetitions, Timeout); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "TestID"
+	if out[0].Value, err = soap.MarshalUi4(TestID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveBasicManagement2GetNSLookupResult(ctx context.Context, handler BasicManagement2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var TestID uint32
+	if value, err = soap.FindArg(in, "TestID"); err != nil {
+		return
+	}
+	if TestID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument TestID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var Status BasicManagement2NSLookupStatus
+	var AdditionalInfo string
+	var SuccessCount uint32
+	var Result string
+	if Status, AdditionalInfo, SuccessCount, Result, err = handler.GetNSLookupResult(ctx, TestID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 4)
+
+	out[0].Name = "Status"
+	if out[0].Value, err = soap.MarshalString(string(Status)); err != nil {
+		return
+	}
+	out[1].Name = "AdditionalInfo"
+	if out[1].Value, err = soap.MarshalString(AdditionalInfo); err != nil {
+		return
+	}
+	out[2].Name = "SuccessCount"
+	if out[2].Value, err = soap.MarshalUi4(SuccessCount); err != nil {
+		return
+	}
+	out[3].Name = "Result"
+	if out[3].Value, err = soap.MarshalString(Result); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveBasicManagement2StartTraceroute(ctx context.Context, handler BasicManagement2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var Host string
+	if value, err = soap.FindArg(in, "Host"); err != nil {
+		return
+	}
+	if Host, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Host: "+err.Error())
+	}
+	var Timeout uint32
+	if value, err = soap.FindArg(in, "Timeout"); err != nil {
+		return
+	}
+	if Timeout, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Timeout: "+err.Error())
+	}
+	var DataBlockSize uint16
+	if value, err = soap.FindArg(in, "DataBlockSize"); err != nil {
+		return
+	}
+	if DataBlockSize, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DataBlockSize: "+err.Error())
+	}
+	var MaxHopCount uint8
+	if value, err = soap.FindArg(in, "MaxHopCount"); err != nil {
+		return
+	}
+	if MaxHopCount, err = soap.UnmarshalUi1(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument MaxHopCount: "+err.Error())
+	}
+	var DSCP uint8
+	if value, err = soap.FindArg(in, "DSCP"); err != nil {
+		return
+	}
+	if DSCP, err = soap.UnmarshalUi1(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DSCP: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var TestID uint32
+	if TestID, err = handler.StartTraceroute(ctx, Host, Timeout, DataBlockSize, MaxHopCount, DSCP); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "TestID"
+	if out[0].Value, err = soap.MarshalUi4(TestID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveBasicManagement2GetTracerouteResult(ctx context.Context, handler BasicManagement2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var TestID uint32
+	if value, err = soap.FindArg(in, "TestID"); err != nil {
+		return
+	}
+	if TestID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument TestID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var Status BasicManagement2TracerouteStatus
+	var AdditionalInfo string
+	var ResponseTime uint32
+	var HopHosts string
+	if Status, AdditionalInfo, ResponseTime, HopHosts, err = handler.GetTracerouteResult(ctx, TestID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 4)
+
+	out[0].Name = "Status"
+	if out[0].Value, err = soap.MarshalString(string(Status)); err != nil {
+		return
+	}
+	out[1].Name = "AdditionalInfo"
+	if out[1].Value, err = soap.MarshalString(AdditionalInfo); err != nil {
+		return
+	}
+	out[2].Name = "ResponseTime"
+	if out[2].Value, err = soap.MarshalUi4(ResponseTime); err != nil {
+		return
+	}
+	out[3].Name = "HopHosts"
+	if out[3].Value, err = soap.MarshalString(HopHosts); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveBasicManagement2GetTestIDs(ctx context.Context, handler BasicManagement2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var TestIDs string
+	if TestIDs, err = handler.GetTestIDs(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "TestIDs"
+	if out[0].Value, err = soap.MarshalString(TestIDs); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveBasicManagement2GetActiveTestIDs(ctx context.Context, handler BasicManagement2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var TestIDs string
+	if TestIDs, err = handler.GetActiveTestIDs(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "TestIDs"
+	if out[0].Value, err = soap.MarshalString(TestIDs); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveBasicManagement2GetTestInfo(ctx context.Context, handler BasicManagement2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var TestID uint32
+	if value, err = soap.FindArg(in, "TestID"); err != nil {
+		return
+	}
+	if TestID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument TestID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var Type BasicManagement2TestType
+	var State BasicManagement2TestState
+	if Type, State, err = handler.GetTestInfo(ctx, TestID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "Type"
+	if out[0].Value, err = soap.MarshalString(string(Type)); err != nil {
+		return
+	}
+	out[1].Name = "State"
+	if out[1].Value, err = soap.MarshalString(string(State)); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveBasicManagement2CancelTest(ctx context.Context, handler BasicManagement2Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var TestID uint32
+	if value, err = soap.FindArg(in, "TestID"); err != nil {
+		return
+	}
+	if TestID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument TestID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.CancelTest(ctx, TestID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
@@ -0,0 +1,68 @@
+package devicemanagement2
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrTestCanceled is returned by WaitForTest and Ping if the test was
+// canceled before it completed.
+var ErrTestCanceled = errors.New("goupnp: diagnostics test was canceled")
+
+// WaitForTest polls GetTestInfo every interval until the test with the given
+// ID has completed, or ctx is done.
+func WaitForTest(ctx context.Context, client BasicManagement2Client, testID uint32, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		_, state, err := client.GetTestInfoCtx(ctx, testID)
+		if err != nil {
+			return err
+		}
+		switch state {
+		case BasicManagement2TestState_Completed:
+			return nil
+		case BasicManagement2TestState_Canceled:
+			return ErrTestCanceled
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// PingResult is the result of a ping test run by the device.
+type PingResult struct {
+	Status         BasicManagement2PingStatus
+	AdditionalInfo string
+	SuccessCount   uint32
+	FailureCount   uint32
+	// Response times of the successful repetitions.
+	Average, Minimum, Maximum time.Duration
+}
+
+// Ping has the device ping host the given number of times, each repetition
+// timing out after timeout, and waits for the result. The data block size
+// and DSCP are left to the device.
+func Ping(ctx context.Context, client BasicManagement2Client, host string, repetitions uint32, timeout time.Duration) (*PingResult, error) {
+	testID, err := client.StartPingCtx(ctx, host, repetitions, uint32(timeout/time.Millisecond), 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	if err := WaitForTest(ctx, client, testID, time.Second); err != nil {
+		return nil, err
+	}
+	var r PingResult
+	var avg, min, max uint32
+	r.Status, r.AdditionalInfo, r.SuccessCount, r.FailureCount, avg, min, max, err = client.GetPingResultCtx(ctx, testID)
+	if err != nil {
+		return nil, err
+	}
+	r.Average = time.Duration(avg) * time.Millisecond
+	r.Minimum = time.Duration(min) * time.Millisecond
+	r.Maximum = time.Duration(max) * time.Millisecond
+	return &r, nil
+}
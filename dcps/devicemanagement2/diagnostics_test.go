@@ -0,0 +1,46 @@
+package devicemanagement2
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+type fakeBasicManagement struct {
+	BasicManagement2Client
+	polls int
+}
+
+func (f *fakeBasicManagement) StartPingCtx(ctx context.Context, Host string, NumberOfRepetitions uint32, Timeout uint32,
+	DataBlockSize uint16, DSCP uint8) (uint32, error) {
+	return 3, nil
+}
+
+func (f *fakeBasicManagement) GetTestInfoCtx(ctx context.Context, TestID uint32) (BasicManagement2TestType, BasicManagement2TestState, error) {
+	f.polls++
+	if f.polls < 2 {
+		return BasicManagement2TestType_Ping, BasicManagement2TestState_InProgress, nil
+	}
+	return BasicManagement2TestType_Ping, BasicManagement2TestState_Completed, nil
+}
+
+func (f *fakeBasicManagement) GetPingResultCtx(ctx context.Context, TestID uint32) (BasicManagement2PingStatus, string,
+	uint32, uint32, uint32, uint32, uint32, error) {
+	return BasicManagement2PingStatus_Success, "", 4, 0, 12, 10, 15, nil
+}
+
+func TestPing(t *testing.T) {
+	f := &fakeBasicManagement{}
+	r, err := Ping(context.Background(), f, "192.0.2.1", 4, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f.polls != 2 {
+		t.Errorf("polled test state %d times, want 2", f.polls)
+	}
+	want := PingResult{Status: BasicManagement2PingStatus_Success, SuccessCount: 4,
+		Average: 12 * time.Millisecond, Minimum: 10 * time.Millisecond, Maximum: 15 * time.Millisecond}
+	if *r != want {
+		t.Errorf("Ping() = %+v, want %+v", *r, want)
+	}
+}
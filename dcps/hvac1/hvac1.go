@@ -0,0 +1,1814 @@
+// Client for UPnP Device Control Protocol HVAC v1.
+//
+// Typically, use one of the New* functions to create clients for services.
+package hvac1
+
+// Generated file - do not edit by hand. See README.md
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/huin/goupnp"
+	"github.com/huin/goupnp/soap"
+)
+
+// Hack to avoid Go complaining if time isn't used.
+var _ time.Time
+
+// Device URNs:
+const (
+	URN_HVAC_System_1         = "urn:schemas-upnp-org:device:HVAC_System:1"
+	URN_HVAC_ZoneThermostat_1 = "urn:schemas-upnp-org:device:HVAC_ZoneThermostat:1"
+)
+
+// Service URNs:
+const (
+	URN_HVAC_FanOperatingMode_1  = "urn:schemas-upnp-org:service:HVAC_FanOperatingMode:1"
+	URN_HVAC_UserOperatingMode_1 = "urn:schemas-upnp-org:service:HVAC_UserOperatingMode:1"
+	URN_HouseStatus_1            = "urn:schemas-upnp-org:service:HouseStatus:1"
+	URN_TemperatureSensor_1      = "urn:schemas-upnp-org:service:TemperatureSensor:1"
+	URN_TemperatureSetpoint_1    = "urn:schemas-upnp-org:service:TemperatureSetpoint:1"
+)
+
+// HVAC_FanOperatingMode1 is a client for UPnP SOAP service with URN "urn:schemas-upnp-org:service:HVAC_FanOperatingMode:1". See
+// goupnp.ServiceClient, which contains RootDevice and Service attributes which
+// are provided for informational value.
+type HVAC_FanOperatingMode1 struct {
+	goupnp.ServiceClient
+}
+
+// HVAC_FanOperatingMode1Client is the interface of the actions of HVAC_FanOperatingMode1, for
+// substituting fakes or mocks for the service in tests.
+type HVAC_FanOperatingMode1Client interface {
+	SetMode(NewMode HVAC_FanOperatingMode1Mode) (err error)
+	SetModeCtx(ctx context.Context, NewMode HVAC_FanOperatingMode1Mode) (err error)
+	GetMode() (CurrentMode HVAC_FanOperatingMode1Mode, err error)
+	GetModeCtx(ctx context.Context) (CurrentMode HVAC_FanOperatingMode1Mode, err error)
+	GetFanStatus() (CurrentStatus HVAC_FanOperatingMode1FanStatus, err error)
+	GetFanStatusCtx(ctx context.Context) (CurrentStatus HVAC_FanOperatingMode1FanStatus, err error)
+	GetName() (CurrentName string, err error)
+	GetNameCtx(ctx context.Context) (CurrentName string, err error)
+	SetName(NewName string) (err error)
+	SetNameCtx(ctx context.Context, NewName string) (err error)
+}
+
+var _ HVAC_FanOperatingMode1Client = new(HVAC_FanOperatingMode1)
+
+// HVAC_FanOperatingMode1Mode is a value of the state variable Mode of
+// HVAC_FanOperatingMode1.
+type HVAC_FanOperatingMode1Mode string
+
+// Allowed values of HVAC_FanOperatingMode1Mode.
+const (
+	HVAC_FanOperatingMode1Mode_Auto         HVAC_FanOperatingMode1Mode = "Auto"
+	HVAC_FanOperatingMode1Mode_ContinuousOn HVAC_FanOperatingMode1Mode = "ContinuousOn"
+	HVAC_FanOperatingMode1Mode_PeriodicOn   HVAC_FanOperatingMode1Mode = "PeriodicOn"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v HVAC_FanOperatingMode1Mode) Valid() bool {
+	switch v {
+	case HVAC_FanOperatingMode1Mode_Auto,
+		HVAC_FanOperatingMode1Mode_ContinuousOn,
+		HVAC_FanOperatingMode1Mode_PeriodicOn:
+		return true
+	}
+	return false
+}
+
+// HVAC_FanOperatingMode1FanStatus is a value of the state variable FanStatus of
+// HVAC_FanOperatingMode1.
+type HVAC_FanOperatingMode1FanStatus string
+
+// Allowed values of HVAC_FanOperatingMode1FanStatus.
+const (
+	HVAC_FanOperatingMode1FanStatus_On     HVAC_FanOperatingMode1FanStatus = "On"
+	HVAC_FanOperatingMode1FanStatus_Off    HVAC_FanOperatingMode1FanStatus = "Off"
+	HVAC_FanOperatingMode1FanStatus_OnHigh HVAC_FanOperatingMode1FanStatus = "OnHigh"
+	HVAC_FanOperatingMode1FanStatus_OnLow  HVAC_FanOperatingMode1FanStatus = "OnLow"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v HVAC_FanOperatingMode1FanStatus) Valid() bool {
+	switch v {
+	case HVAC_FanOperatingMode1FanStatus_On,
+		HVAC_FanOperatingMode1FanStatus_Off,
+		HVAC_FanOperatingMode1FanStatus_OnHigh,
+		HVAC_FanOperatingMode1FanStatus_OnLow:
+		return true
+	}
+	return false
+}
+
+// NewHVAC_FanOperatingMode1Clients discovers instances of the service on the network,
+// and returns clients to any that are found. errors will contain an error for
+// any devices that replied but which could not be queried, and err will be set
+// if the discovery process failed outright.
+//
+// This is a typical entry calling point into this package.
+func NewHVAC_FanOperatingMode1Clients() (clients []*HVAC_FanOperatingMode1, errors []error, err error) {
+	var genericClients []goupnp.ServiceClient
+	if genericClients, errors, err = goupnp.NewServiceClients(URN_HVAC_FanOperatingMode_1); err != nil {
+		return
+	}
+	clients = newHVAC_FanOperatingMode1ClientsFromGenericClients(genericClients)
+	return
+}
+
+// NewHVAC_FanOperatingMode1ClientsByURL discovers instances of the service at the given
+// URL, and returns clients to any that are found. An error is returned if
+// there was an error probing the service.
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered service URL.
+func NewHVAC_FanOperatingMode1ClientsByURL(loc *url.URL) ([]*HVAC_FanOperatingMode1, error) {
+	genericClients, err := goupnp.NewServiceClientsByURL(loc, URN_HVAC_FanOperatingMode_1)
+	if err != nil {
+		return nil, err
+	}
+	return newHVAC_FanOperatingMode1ClientsFromGenericClients(genericClients), nil
+}
+
+// NewHVAC_FanOperatingMode1ClientsFromRootDevice discovers instances of the service in
+// a given root device, and returns clients to any that are found. An error is
+// returned if there was not at least one instance of the service within the
+// device. The location parameter is simply assigned to the Location attribute
+// of the wrapped ServiceClient(s).
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered root device.
+func NewHVAC_FanOperatingMode1ClientsFromRootDevice(rootDevice *goupnp.RootDevice, loc *url.URL) ([]*HVAC_FanOperatingMode1, error) {
+	genericClients, err := goupnp.NewServiceClientsFromRootDevice(rootDevice, loc, URN_HVAC_FanOperatingMode_1)
+	if err != nil {
+		return nil, err
+	}
+	return newHVAC_FanOperatingMode1ClientsFromGenericClients(genericClients), nil
+}
+
+func newHVAC_FanOperatingMode1ClientsFromGenericClients(genericClients []goupnp.ServiceClient) []*HVAC_FanOperatingMode1 {
+	clients := make([]*HVAC_FanOperatingMode1, len(genericClients))
+	for i := range genericClients {
+		clients[i] = &HVAC_FanOperatingMode1{genericClients[i]}
+	}
+	return clients
+}
+
+// HVAC_FanOperatingMode1Timeouts are the default timeouts of the actions of
+// HVAC_FanOperatingMode1, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var HVAC_FanOperatingMode1Timeouts = map[string]time.Duration{}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *HVAC_FanOperatingMode1) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, HVAC_FanOperatingMode1Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_HVAC_FanOperatingMode_1, actionName, request, response)
+}
+
+// HVAC_FanOperatingMode1SetModeRequest is the request of SetMode, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type HVAC_FanOperatingMode1SetModeRequest struct {
+	NewMode string
+}
+
+// SetMode performs the SetMode action of the service.
+//
+// Arguments:
+//   - NewMode (in, state variable Mode): allowed values Auto, ContinuousOn, PeriodicOn
+func (client *HVAC_FanOperatingMode1) SetMode(NewMode HVAC_FanOperatingMode1Mode) (err error) {
+	return client.SetModeCtx(context.Background(), NewMode)
+}
+
+// SetModeCtx is SetMode with a context, to cancel or time out the call.
+func (client *HVAC_FanOperatingMode1) SetModeCtx(ctx context.Context, NewMode HVAC_FanOperatingMode1Mode) (err error) {
+	// Request structure.
+	request := &HVAC_FanOperatingMode1SetModeRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewMode, err = soap.MarshalString(string(NewMode)); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SetMode", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// HVAC_FanOperatingMode1GetModeResponse is the response of GetMode, with each
+// argument in its SOAP string form.
+type HVAC_FanOperatingMode1GetModeResponse struct {
+	CurrentMode string
+}
+
+// GetMode performs the GetMode action of the service.
+//
+// Return values:
+//   - CurrentMode (out, state variable Mode): allowed values Auto, ContinuousOn, PeriodicOn
+func (client *HVAC_FanOperatingMode1) GetMode() (CurrentMode HVAC_FanOperatingMode1Mode, err error) {
+	return client.GetModeCtx(context.Background())
+}
+
+// GetModeCtx is GetMode with a context, to cancel or time out the call.
+func (client *HVAC_FanOperatingMode1) GetModeCtx(ctx context.Context) (CurrentMode HVAC_FanOperatingMode1Mode, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &HVAC_FanOperatingMode1GetModeResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetMode", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	CurrentMode = HVAC_FanOperatingMode1Mode(response.CurrentMode)
+	// END Unmarshal arguments from response.
+	return
+}
+
+// HVAC_FanOperatingMode1GetFanStatusResponse is the response of GetFanStatus, with each
+// argument in its SOAP string form.
+type HVAC_FanOperatingMode1GetFanStatusResponse struct {
+	CurrentStatus string
+}
+
+// GetFanStatus performs the GetFanStatus action of the service.
+//
+// Return values:
+//   - CurrentStatus (out, state variable FanStatus): allowed values On, Off, OnHigh, OnLow
+func (client *HVAC_FanOperatingMode1) GetFanStatus() (CurrentStatus HVAC_FanOperatingMode1FanStatus, err error) {
+	return client.GetFanStatusCtx(context.Background())
+}
+
+// GetFanStatusCtx is GetFanStatus with a context, to cancel or time out the call.
+func (client *HVAC_FanOperatingMode1) GetFanStatusCtx(ctx context.Context) (CurrentStatus HVAC_FanOperatingMode1FanStatus, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &HVAC_FanOperatingMode1GetFanStatusResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetFanStatus", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	CurrentStatus = HVAC_FanOperatingMode1FanStatus(response.CurrentStatus)
+	// END Unmarshal arguments from response.
+	return
+}
+
+// HVAC_FanOperatingMode1GetNameResponse is the response of GetName, with each
+// argument in its SOAP string form.
+type HVAC_FanOperatingMode1GetNameResponse struct {
+	CurrentName string
+}
+
+// GetName performs the GetName action of the service.
+//
+// Return values:
+//   - CurrentName (out, state variable Name)
+func (client *HVAC_FanOperatingMode1) GetName() (CurrentName string, err error) {
+	return client.GetNameCtx(context.Background())
+}
+
+// GetNameCtx is GetName with a context, to cancel or time out the call.
+func (client *HVAC_FanOperatingMode1) GetNameCtx(ctx context.Context) (CurrentName string, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &HVAC_FanOperatingMode1GetNameResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetName", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if CurrentName, err = soap.UnmarshalString(response.CurrentName); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// HVAC_FanOperatingMode1SetNameRequest is the request of SetName, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type HVAC_FanOperatingMode1SetNameRequest struct {
+	NewName string
+}
+
+// SetName performs the SetName action of the service.
+//
+// Arguments:
+//   - NewName (in, state variable Name)
+func (client *HVAC_FanOperatingMode1) SetName(NewName string) (err error) {
+	return client.SetNameCtx(context.Background(), NewName)
+}
+
+// SetNameCtx is SetName with a context, to cancel or time out the call.
+func (client *HVAC_FanOperatingMode1) SetNameCtx(ctx context.Context, NewName string) (err error) {
+	// Request structure.
+	request := &HVAC_FanOperatingMode1SetNameRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewName, err = soap.MarshalString(NewName); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SetName", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// HVAC_UserOperatingMode1 is a client for UPnP SOAP service with URN "urn:schemas-upnp-org:service:HVAC_UserOperatingMode:1". See
+// goupnp.ServiceClient, which contains RootDevice and Service attributes which
+// are provided for informational value.
+type HVAC_UserOperatingMode1 struct {
+	goupnp.ServiceClient
+}
+
+// HVAC_UserOperatingMode1Client is the interface of the actions of HVAC_UserOperatingMode1, for
+// substituting fakes or mocks for the service in tests.
+type HVAC_UserOperatingMode1Client interface {
+	SetModeTarget(NewModeTarget HVAC_UserOperatingMode1ModeTarget) (err error)
+	SetModeTargetCtx(ctx context.Context, NewModeTarget HVAC_UserOperatingMode1ModeTarget) (err error)
+	GetModeTarget() (CurrentModeTarget HVAC_UserOperatingMode1ModeTarget, err error)
+	GetModeTargetCtx(ctx context.Context) (CurrentModeTarget HVAC_UserOperatingMode1ModeTarget, err error)
+	GetModeStatus() (CurrentModeStatus HVAC_UserOperatingMode1ModeStatus, err error)
+	GetModeStatusCtx(ctx context.Context) (CurrentModeStatus HVAC_UserOperatingMode1ModeStatus, err error)
+	GetName() (CurrentName string, err error)
+	GetNameCtx(ctx context.Context) (CurrentName string, err error)
+	SetName(NewName string) (err error)
+	SetNameCtx(ctx context.Context, NewName string) (err error)
+}
+
+var _ HVAC_UserOperatingMode1Client = new(HVAC_UserOperatingMode1)
+
+// HVAC_UserOperatingMode1ModeTarget is a value of the state variable ModeTarget of
+// HVAC_UserOperatingMode1.
+type HVAC_UserOperatingMode1ModeTarget string
+
+// Allowed values of HVAC_UserOperatingMode1ModeTarget.
+const (
+	HVAC_UserOperatingMode1ModeTarget_Off                HVAC_UserOperatingMode1ModeTarget = "Off"
+	HVAC_UserOperatingMode1ModeTarget_HeatOn             HVAC_UserOperatingMode1ModeTarget = "HeatOn"
+	HVAC_UserOperatingMode1ModeTarget_CoolOn             HVAC_UserOperatingMode1ModeTarget = "CoolOn"
+	HVAC_UserOperatingMode1ModeTarget_AutoChangeOver     HVAC_UserOperatingMode1ModeTarget = "AutoChangeOver"
+	HVAC_UserOperatingMode1ModeTarget_AuxHeatOn          HVAC_UserOperatingMode1ModeTarget = "AuxHeatOn"
+	HVAC_UserOperatingMode1ModeTarget_EconomyHeatOn      HVAC_UserOperatingMode1ModeTarget = "EconomyHeatOn"
+	HVAC_UserOperatingMode1ModeTarget_EmergencyHeatOn    HVAC_UserOperatingMode1ModeTarget = "EmergencyHeatOn"
+	HVAC_UserOperatingMode1ModeTarget_AuxCoolOn          HVAC_UserOperatingMode1ModeTarget = "AuxCoolOn"
+	HVAC_UserOperatingMode1ModeTarget_EconomyCoolOn      HVAC_UserOperatingMode1ModeTarget = "EconomyCoolOn"
+	HVAC_UserOperatingMode1ModeTarget_BuildingProtection HVAC_UserOperatingMode1ModeTarget = "BuildingProtection"
+	HVAC_UserOperatingMode1ModeTarget_EnergySavingsMode  HVAC_UserOperatingMode1ModeTarget = "EnergySavingsMode"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v HVAC_UserOperatingMode1ModeTarget) Valid() bool {
+	switch v {
+	case HVAC_UserOperatingMode1ModeTarget_Off,
+		HVAC_UserOperatingMode1ModeTarget_HeatOn,
+		HVAC_UserOperatingMode1ModeTarget_CoolOn,
+		HVAC_UserOperatingMode1ModeTarget_AutoChangeOver,
+		HVAC_UserOperatingMode1ModeTarget_AuxHeatOn,
+		HVAC_UserOperatingMode1ModeTarget_EconomyHeatOn,
+		HVAC_UserOperatingMode1ModeTarget_EmergencyHeatOn,
+		HVAC_UserOperatingMode1ModeTarget_AuxCoolOn,
+		HVAC_UserOperatingMode1ModeTarget_EconomyCoolOn,
+		HVAC_UserOperatingMode1ModeTarget_BuildingProtection,
+		HVAC_UserOperatingMode1ModeTarget_EnergySavingsMode:
+		return true
+	}
+	return false
+}
+
+// HVAC_UserOperatingMode1ModeStatus is a value of the state variable ModeStatus of
+// HVAC_UserOperatingMode1.
+type HVAC_UserOperatingMode1ModeStatus string
+
+// Allowed values of HVAC_UserOperatingMode1ModeStatus.
+const (
+	HVAC_UserOperatingMode1ModeStatus_Off                HVAC_UserOperatingMode1ModeStatus = "Off"
+	HVAC_UserOperatingMode1ModeStatus_InDeadBand         HVAC_UserOperatingMode1ModeStatus = "InDeadBand"
+	HVAC_UserOperatingMode1ModeStatus_HeatOn             HVAC_UserOperatingMode1ModeStatus = "HeatOn"
+	HVAC_UserOperatingMode1ModeStatus_CoolOn             HVAC_UserOperatingMode1ModeStatus = "CoolOn"
+	HVAC_UserOperatingMode1ModeStatus_AutoChangeOver     HVAC_UserOperatingMode1ModeStatus = "AutoChangeOver"
+	HVAC_UserOperatingMode1ModeStatus_AuxHeatOn          HVAC_UserOperatingMode1ModeStatus = "AuxHeatOn"
+	HVAC_UserOperatingMode1ModeStatus_EconomyHeatOn      HVAC_UserOperatingMode1ModeStatus = "EconomyHeatOn"
+	HVAC_UserOperatingMode1ModeStatus_EmergencyHeatOn    HVAC_UserOperatingMode1ModeStatus = "EmergencyHeatOn"
+	HVAC_UserOperatingMode1ModeStatus_AuxCoolOn          HVAC_UserOperatingMode1ModeStatus = "AuxCoolOn"
+	HVAC_UserOperatingMode1ModeStatus_EconomyCoolOn      HVAC_UserOperatingMode1ModeStatus = "EconomyCoolOn"
+	HVAC_UserOperatingMode1ModeStatus_BuildingProtection HVAC_UserOperatingMode1ModeStatus = "BuildingProtection"
+	HVAC_UserOperatingMode1ModeStatus_EnergySavingsMode  HVAC_UserOperatingMode1ModeStatus = "EnergySavingsMode"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v HVAC_UserOperatingMode1ModeStatus) Valid() bool {
+	switch v {
+	case HVAC_UserOperatingMode1ModeStatus_Off,
+		HVAC_UserOperatingMode1ModeStatus_InDeadBand,
+		HVAC_UserOperatingMode1ModeStatus_HeatOn,
+		HVAC_UserOperatingMode1ModeStatus_CoolOn,
+		HVAC_UserOperatingMode1ModeStatus_AutoChangeOver,
+		HVAC_UserOperatingMode1ModeStatus_AuxHeatOn,
+		HVAC_UserOperatingMode1ModeStatus_EconomyHeatOn,
+		HVAC_UserOperatingMode1ModeStatus_EmergencyHeatOn,
+		HVAC_UserOperatingMode1ModeStatus_AuxCoolOn,
+		HVAC_UserOperatingMode1ModeStatus_EconomyCoolOn,
+		HVAC_UserOperatingMode1ModeStatus_BuildingProtection,
+		HVAC_UserOperatingMode1ModeStatus_EnergySavingsMode:
+		return true
+	}
+	return false
+}
+
+// NewHVAC_UserOperatingMode1Clients discovers instances of the service on the network,
+// and returns clients to any that are found. errors will contain an error for
+// any devices that replied but which could not be queried, and err will be set
+// if the discovery process failed outright.
+//
+// This is a typical entry calling point into this package.
+func NewHVAC_UserOperatingMode1Clients() (clients []*HVAC_UserOperatingMode1, errors []error, err error) {
+	var genericClients []goupnp.ServiceClient
+	if genericClients, errors, err = goupnp.NewServiceClients(URN_HVAC_UserOperatingMode_1); err != nil {
+		return
+	}
+	clients = newHVAC_UserOperatingMode1ClientsFromGenericClients(genericClients)
+	return
+}
+
+// NewHVAC_UserOperatingMode1ClientsByURL discovers instances of the service at the given
+// URL, and returns clients to any that are found. An error is returned if
+// there was an error probing the service.
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered service URL.
+func NewHVAC_UserOperatingMode1ClientsByURL(loc *url.URL) ([]*HVAC_UserOperatingMode1, error) {
+	genericClients, err := goupnp.NewServiceClientsByURL(loc, URN_HVAC_UserOperatingMode_1)
+	if err != nil {
+		return nil, err
+	}
+	return newHVAC_UserOperatingMode1ClientsFromGenericClients(genericClients), nil
+}
+
+// NewHVAC_UserOperatingMode1ClientsFromRootDevice discovers instances of the service in
+// a given root device, and returns clients to any that are found. An error is
+// returned if there was not at least one instance of the service within the
+// device. The location parameter is simply assigned to the Location attribute
+// of the wrapped ServiceClient(s).
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered root device.
+func NewHVAC_UserOperatingMode1ClientsFromRootDevice(rootDevice *goupnp.RootDevice, loc *url.URL) ([]*HVAC_UserOperatingMode1, error) {
+	genericClients, err := goupnp.NewServiceClientsFromRootDevice(rootDevice, loc, URN_HVAC_UserOperatingMode_1)
+	if err != nil {
+		return nil, err
+	}
+	return newHVAC_UserOperatingMode1ClientsFromGenericClients(genericClients), nil
+}
+
+func newHVAC_UserOperatingMode1ClientsFromGenericClients(genericClients []goupnp.ServiceClient) []*HVAC_UserOperatingMode1 {
+	clients := make([]*HVAC_UserOperatingMode1, len(genericClients))
+	for i := range genericClients {
+		clients[i] = &HVAC_UserOperatingMode1{genericClients[i]}
+	}
+	return clients
+}
+
+// HVAC_UserOperatingMode1Timeouts are the default timeouts of the actions of
+// HVAC_UserOperatingMode1, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var HVAC_UserOperatingMode1Timeouts = map[string]time.Duration{}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *HVAC_UserOperatingMode1) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, HVAC_UserOperatingMode1Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_HVAC_UserOperatingMode_1, actionName, request, response)
+}
+
+// HVAC_UserOperatingMode1SetModeTargetRequest is the request of SetModeTarget, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type HVAC_UserOperatingMode1SetModeTargetRequest struct {
+	NewModeTarget string
+}
+
+// SetModeTarget performs the SetModeTarget action of the service.
+//
+// Arguments:
+//   - NewModeTarget (in, state variable ModeTarget): allowed values Off, HeatOn, CoolOn, AutoChangeOver, AuxHeatOn, EconomyHeatOn, EmergencyHeatOn, AuxCoolOn, EconomyCoolOn, BuildingProtection, EnergySavingsMode
+func (client *HVAC_UserOperatingMode1) SetModeTarget(NewModeTarget HVAC_UserOperatingMode1ModeTarget) (err error) {
+	return client.SetModeTargetCtx(context.Background(), NewModeTarget)
+}
+
+// SetModeTargetCtx is SetModeTarget with a context, to cancel or time out the call.
+func (client *HVAC_UserOperatingMode1) SetModeTargetCtx(ctx context.Context, NewModeTarget HVAC_UserOperatingMode1ModeTarget) (err error) {
+	// Request structure.
+	request := &HVAC_UserOperatingMode1SetModeTargetRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewModeTarget, err = soap.MarshalString(string(NewModeTarget)); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SetModeTarget", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// HVAC_UserOperatingMode1GetModeTargetResponse is the response of GetModeTarget, with each
+// argument in its SOAP string form.
+type HVAC_UserOperatingMode1GetModeTargetResponse struct {
+	CurrentModeTarget string
+}
+
+// GetModeTarget performs the GetModeTarget action of the service.
+//
+// Return values:
+//   - CurrentModeTarget (out, state variable ModeTarget): allowed values Off, HeatOn, CoolOn, AutoChangeOver, AuxHeatOn, EconomyHeatOn, EmergencyHeatOn, AuxCoolOn, EconomyCoolOn, BuildingProtection, EnergySavingsMode
+func (client *HVAC_UserOperatingMode1) GetModeTarget() (CurrentModeTarget HVAC_UserOperatingMode1ModeTarget, err error) {
+	return client.GetModeTargetCtx(context.Background())
+}
+
+// GetModeTargetCtx is GetModeTarget with a context, to cancel or time out the call.
+func (client *HVAC_UserOperatingMode1) GetModeTargetCtx(ctx context.Context) (CurrentModeTarget HVAC_UserOperatingMode1ModeTarget, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &HVAC_UserOperatingMode1GetModeTargetResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetModeTarget", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	CurrentModeTarget = HVAC_UserOperatingMode1ModeTarget(response.CurrentModeTarget)
+	// END Unmarshal arguments from response.
+	return
+}
+
+// HVAC_UserOperatingMode1GetModeStatusResponse is the response of GetModeStatus, with each
+// argument in its SOAP string form.
+type HVAC_UserOperatingMode1GetModeStatusResponse struct {
+	CurrentModeStatus string
+}
+
+// GetModeStatus performs the GetModeStatus action of the service.
+//
+// Return values:
+//   - CurrentModeStatus (out, state variable ModeStatus): allowed values Off, InDeadBand, HeatOn, CoolOn, AutoChangeOver, AuxHeatOn, EconomyHeatOn, EmergencyHeatOn, AuxCoolOn, EconomyCoolOn, BuildingProtection, EnergySavingsMode
+func (client *HVAC_UserOperatingMode1) GetModeStatus() (CurrentModeStatus HVAC_UserOperatingMode1ModeStatus, err error) {
+	return client.GetModeStatusCtx(context.Background())
+}
+
+// GetModeStatusCtx is GetModeStatus with a context, to cancel or time out the call.
+func (client *HVAC_UserOperatingMode1) GetModeStatusCtx(ctx context.Context) (CurrentModeStatus HVAC_UserOperatingMode1ModeStatus, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &HVAC_UserOperatingMode1GetModeStatusResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetModeStatus", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	CurrentModeStatus = HVAC_UserOperatingMode1ModeStatus(response.CurrentModeStatus)
+	// END Unmarshal arguments from response.
+	return
+}
+
+// HVAC_UserOperatingMode1GetNameResponse is the response of GetName, with each
+// argument in its SOAP string form.
+type HVAC_UserOperatingMode1GetNameResponse struct {
+	CurrentName string
+}
+
+// GetName performs the GetName action of the service.
+//
+// Return values:
+//   - CurrentName (out, state variable Name)
+func (client *HVAC_UserOperatingMode1) GetName() (CurrentName string, err error) {
+	return client.GetNameCtx(context.Background())
+}
+
+// GetNameCtx is GetName with a context, to cancel or time out the call.
+func (client *HVAC_UserOperatingMode1) GetNameCtx(ctx context.Context) (CurrentName string, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &HVAC_UserOperatingMode1GetNameResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetName", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if CurrentName, err = soap.UnmarshalString(response.CurrentName); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// HVAC_UserOperatingMode1SetNameRequest is the request of SetName, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type HVAC_UserOperatingMode1SetNameRequest struct {
+	NewName string
+}
+
+// SetName performs the SetName action of the service.
+//
+// Arguments:
+//   - NewName (in, state variable Name)
+func (client *HVAC_UserOperatingMode1) SetName(NewName string) (err error) {
+	return client.SetNameCtx(context.Background(), NewName)
+}
+
+// SetNameCtx is SetName with a context, to cancel or time out the call.
+func (client *HVAC_UserOperatingMode1) SetNameCtx(ctx context.Context, NewName string) (err error) {
+	// Request structure.
+	request := &HVAC_UserOperatingMode1SetNameRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewName, err = soap.MarshalString(NewName); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SetName", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// HouseStatus1 is a client for UPnP SOAP service with URN "urn:schemas-upnp-org:service:HouseStatus:1". See
+// goupnp.ServiceClient, which contains RootDevice and Service attributes which
+// are provided for informational value.
+type HouseStatus1 struct {
+	goupnp.ServiceClient
+}
+
+// HouseStatus1Client is the interface of the actions of HouseStatus1, for
+// substituting fakes or mocks for the service in tests.
+type HouseStatus1Client interface {
+	SetOccupancyState(NewOccupancyState HouseStatus1OccupancyState) (err error)
+	SetOccupancyStateCtx(ctx context.Context, NewOccupancyState HouseStatus1OccupancyState) (err error)
+	GetOccupancyState() (CurrentOccupancyState HouseStatus1OccupancyState, err error)
+	GetOccupancyStateCtx(ctx context.Context) (CurrentOccupancyState HouseStatus1OccupancyState, err error)
+	SetActivityState(NewActivityState HouseStatus1ActivityState) (err error)
+	SetActivityStateCtx(ctx context.Context, NewActivityState HouseStatus1ActivityState) (err error)
+	GetActivityState() (CurrentActivityState HouseStatus1ActivityState, err error)
+	GetActivityStateCtx(ctx context.Context) (CurrentActivityState HouseStatus1ActivityState, err error)
+	SetDormancyState(NewDormancyState HouseStatus1DormancyState) (err error)
+	SetDormancyStateCtx(ctx context.Context, NewDormancyState HouseStatus1DormancyState) (err error)
+	GetDormancyState() (CurrentDormancyState HouseStatus1DormancyState, err error)
+	GetDormancyStateCtx(ctx context.Context) (CurrentDormancyState HouseStatus1DormancyState, err error)
+}
+
+var _ HouseStatus1Client = new(HouseStatus1)
+
+// HouseStatus1OccupancyState is a value of the state variable OccupancyState of
+// HouseStatus1.
+type HouseStatus1OccupancyState string
+
+// Allowed values of HouseStatus1OccupancyState.
+const (
+	HouseStatus1OccupancyState_Occupied      HouseStatus1OccupancyState = "Occupied"
+	HouseStatus1OccupancyState_Unoccupied    HouseStatus1OccupancyState = "Unoccupied"
+	HouseStatus1OccupancyState_Indeterminate HouseStatus1OccupancyState = "Indeterminate"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v HouseStatus1OccupancyState) Valid() bool {
+	switch v {
+	case HouseStatus1OccupancyState_Occupied,
+		HouseStatus1OccupancyState_Unoccupied,
+		HouseStatus1OccupancyState_Indeterminate:
+		return true
+	}
+	return false
+}
+
+// HouseStatus1ActivityState is a value of the state variable ActivityState of
+// HouseStatus1.
+type HouseStatus1ActivityState string
+
+// Allowed values of HouseStatus1ActivityState.
+const (
+	HouseStatus1ActivityState_Regular  HouseStatus1ActivityState = "Regular"
+	HouseStatus1ActivityState_Vacation HouseStatus1ActivityState = "Vacation"
+	HouseStatus1ActivityState_Holiday  HouseStatus1ActivityState = "Holiday"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v HouseStatus1ActivityState) Valid() bool {
+	switch v {
+	case HouseStatus1ActivityState_Regular,
+		HouseStatus1ActivityState_Vacation,
+		HouseStatus1ActivityState_Holiday:
+		return true
+	}
+	return false
+}
+
+// HouseStatus1DormancyState is a value of the state variable DormancyState of
+// HouseStatus1.
+type HouseStatus1DormancyState string
+
+// Allowed values of HouseStatus1DormancyState.
+const (
+	HouseStatus1DormancyState_Awake         HouseStatus1DormancyState = "Awake"
+	HouseStatus1DormancyState_Asleep        HouseStatus1DormancyState = "Asleep"
+	HouseStatus1DormancyState_Indeterminate HouseStatus1DormancyState = "Indeterminate"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v HouseStatus1DormancyState) Valid() bool {
+	switch v {
+	case HouseStatus1DormancyState_Awake,
+		HouseStatus1DormancyState_Asleep,
+		HouseStatus1DormancyState_Indeterminate:
+		return true
+	}
+	return false
+}
+
+// NewHouseStatus1Clients discovers instances of the service on the network,
+// and returns clients to any that are found. errors will contain an error for
+// any devices that replied but which could not be queried, and err will be set
+// if the discovery process failed outright.
+//
+// This is a typical entry calling point into this package.
+func NewHouseStatus1Clients() (clients []*HouseStatus1, errors []error, err error) {
+	var genericClients []goupnp.ServiceClient
+	if genericClients, errors, err = goupnp.NewServiceClients(URN_HouseStatus_1); err != nil {
+		return
+	}
+	clients = newHouseStatus1ClientsFromGenericClients(genericClients)
+	return
+}
+
+// NewHouseStatus1ClientsByURL discovers instances of the service at the given
+// URL, and returns clients to any that are found. An error is returned if
+// there was an error probing the service.
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered service URL.
+func NewHouseStatus1ClientsByURL(loc *url.URL) ([]*HouseStatus1, error) {
+	genericClients, err := goupnp.NewServiceClientsByURL(loc, URN_HouseStatus_1)
+	if err != nil {
+		return nil, err
+	}
+	return newHouseStatus1ClientsFromGenericClients(genericClients), nil
+}
+
+// NewHouseStatus1ClientsFromRootDevice discovers instances of the service in
+// a given root device, and returns clients to any that are found. An error is
+// returned if there was not at least one instance of the service within the
+// device. The location parameter is simply assigned to the Location attribute
+// of the wrapped ServiceClient(s).
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered root device.
+func NewHouseStatus1ClientsFromRootDevice(rootDevice *goupnp.RootDevice, loc *url.URL) ([]*HouseStatus1, error) {
+	genericClients, err := goupnp.NewServiceClientsFromRootDevice(rootDevice, loc, URN_HouseStatus_1)
+	if err != nil {
+		return nil, err
+	}
+	return newHouseStatus1ClientsFromGenericClients(genericClients), nil
+}
+
+func newHouseStatus1ClientsFromGenericClients(genericClients []goupnp.ServiceClient) []*HouseStatus1 {
+	clients := make([]*HouseStatus1, len(genericClients))
+	for i := range genericClients {
+		clients[i] = &HouseStatus1{genericClients[i]}
+	}
+	return clients
+}
+
+// HouseStatus1Timeouts are the default timeouts of the actions of
+// HouseStatus1, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var HouseStatus1Timeouts = map[string]time.Duration{}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *HouseStatus1) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, HouseStatus1Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_HouseStatus_1, actionName, request, response)
+}
+
+// HouseStatus1SetOccupancyStateRequest is the request of SetOccupancyState, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type HouseStatus1SetOccupancyStateRequest struct {
+	NewOccupancyState string
+}
+
+// SetOccupancyState performs the SetOccupancyState action of the service.
+//
+// Arguments:
+//   - NewOccupancyState (in, state variable OccupancyState): allowed values Occupied, Unoccupied, Indeterminate
+func (client *HouseStatus1) SetOccupancyState(NewOccupancyState HouseStatus1OccupancyState) (err error) {
+	return client.SetOccupancyStateCtx(context.Background(), NewOccupancyState)
+}
+
+// SetOccupancyStateCtx is SetOccupancyState with a context, to cancel or time out the call.
+func (client *HouseStatus1) SetOccupancyStateCtx(ctx context.Context, NewOccupancyState HouseStatus1OccupancyState) (err error) {
+	// Request structure.
+	request := &HouseStatus1SetOccupancyStateRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewOccupancyState, err = soap.MarshalString(string(NewOccupancyState)); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SetOccupancyState", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// HouseStatus1GetOccupancyStateResponse is the response of GetOccupancyState, with each
+// argument in its SOAP string form.
+type HouseStatus1GetOccupancyStateResponse struct {
+	CurrentOccupancyState string
+}
+
+// GetOccupancyState performs the GetOccupancyState action of the service.
+//
+// Return values:
+//   - CurrentOccupancyState (out, state variable OccupancyState): allowed values Occupied, Unoccupied, Indeterminate
+func (client *HouseStatus1) GetOccupancyState() (CurrentOccupancyState HouseStatus1OccupancyState, err error) {
+	return client.GetOccupancyStateCtx(context.Background())
+}
+
+// GetOccupancyStateCtx is GetOccupancyState with a context, to cancel or time out the call.
+func (client *HouseStatus1) GetOccupancyStateCtx(ctx context.Context) (CurrentOccupancyState HouseStatus1OccupancyState, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &HouseStatus1GetOccupancyStateResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetOccupancyState", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	CurrentOccupancyState = HouseStatus1OccupancyState(response.CurrentOccupancyState)
+	// END Unmarshal arguments from response.
+	return
+}
+
+// HouseStatus1SetActivityStateRequest is the request of SetActivityState, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type HouseStatus1SetActivityStateRequest struct {
+	NewActivityState string
+}
+
+// SetActivityState performs the SetActivityState action of the service.
+//
+// Arguments:
+//   - NewActivityState (in, state variable ActivityState): allowed values Regular, Vacation, Holiday
+func (client *HouseStatus1) SetActivityState(NewActivityState HouseStatus1ActivityState) (err error) {
+	return client.SetActivityStateCtx(context.Background(), NewActivityState)
+}
+
+// SetActivityStateCtx is SetActivityState with a context, to cancel or time out the call.
+func (client *HouseStatus1) SetActivityStateCtx(ctx context.Context, NewActivityState HouseStatus1ActivityState) (err error) {
+	// Request structure.
+	request := &HouseStatus1SetActivityStateRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewActivityState, err = soap.MarshalString(string(NewActivityState)); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SetActivityState", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// HouseStatus1GetActivityStateResponse is the response of GetActivityState, with each
+// argument in its SOAP string form.
+type HouseStatus1GetActivityStateResponse struct {
+	CurrentActivityState string
+}
+
+// GetActivityState performs the GetActivityState action of the service.
+//
+// Return values:
+//   - CurrentActivityState (out, state variable ActivityState): allowed values Regular, Vacation, Holiday
+func (client *HouseStatus1) GetActivityState() (CurrentActivityState HouseStatus1ActivityState, err error) {
+	return client.GetActivityStateCtx(context.Background())
+}
+
+// GetActivityStateCtx is GetActivityState with a context, to cancel or time out the call.
+func (client *HouseStatus1) GetActivityStateCtx(ctx context.Context) (CurrentActivityState HouseStatus1ActivityState, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &HouseStatus1GetActivityStateResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetActivityState", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	CurrentActivityState = HouseStatus1ActivityState(response.CurrentActivityState)
+	// END Unmarshal arguments from response.
+	return
+}
+
+// HouseStatus1SetDormancyStateRequest is the request of SetDormancyState, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type HouseStatus1SetDormancyStateRequest struct {
+	NewDormancyState string
+}
+
+// SetDormancyState performs the SetDormancyState action of the service.
+//
+// Arguments:
+//   - NewDormancyState (in, state variable DormancyState): allowed values Awake, Asleep, Indeterminate
+func (client *HouseStatus1) SetDormancyState(NewDormancyState HouseStatus1DormancyState) (err error) {
+	return client.SetDormancyStateCtx(context.Background(), NewDormancyState)
+}
+
+// SetDormancyStateCtx is SetDormancyState with a context, to cancel or time out the call.
+func (client *HouseStatus1) SetDormancyStateCtx(ctx context.Context, NewDormancyState HouseStatus1DormancyState) (err error) {
+	// Request structure.
+	request := &HouseStatus1SetDormancyStateRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewDormancyState, err = soap.MarshalString(string(NewDormancyState)); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SetDormancyState", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// HouseStatus1GetDormancyStateResponse is the response of GetDormancyState, with each
+// argument in its SOAP string form.
+type HouseStatus1GetDormancyStateResponse struct {
+	CurrentDormancyState string
+}
+
+// GetDormancyState performs the GetDormancyState action of the service.
+//
+// Return values:
+//   - CurrentDormancyState (out, state variable DormancyState): allowed values Awake, Asleep, Indeterminate
+func (client *HouseStatus1) GetDormancyState() (CurrentDormancyState HouseStatus1DormancyState, err error) {
+	return client.GetDormancyStateCtx(context.Background())
+}
+
+// GetDormancyStateCtx is GetDormancyState with a context, to cancel or time out the call.
+func (client *HouseStatus1) GetDormancyStateCtx(ctx context.Context) (CurrentDormancyState HouseStatus1DormancyState, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &HouseStatus1GetDormancyStateResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetDormancyState", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	CurrentDormancyState = HouseStatus1DormancyState(response.CurrentDormancyState)
+	// END Unmarshal arguments from response.
+	return
+}
+
+// TemperatureSensor1 is a client for UPnP SOAP service with URN "urn:schemas-upnp-org:service:TemperatureSensor:1". See
+// goupnp.ServiceClient, which contains RootDevice and Service attributes which
+// are provided for informational value.
+type TemperatureSensor1 struct {
+	goupnp.ServiceClient
+}
+
+// TemperatureSensor1Client is the interface of the actions of TemperatureSensor1, for
+// substituting fakes or mocks for the service in tests.
+type TemperatureSensor1Client interface {
+	GetApplication() (CurrentApp TemperatureSensor1Application, err error)
+	GetApplicationCtx(ctx context.Context) (CurrentApp TemperatureSensor1Application, err error)
+	SetApplication(NewApplication TemperatureSensor1Application) (err error)
+	SetApplicationCtx(ctx context.Context, NewApplication TemperatureSensor1Application) (err error)
+	GetCurrentTemperature() (CurrentTemp int32, err error)
+	GetCurrentTemperatureCtx(ctx context.Context) (CurrentTemp int32, err error)
+	GetName() (CurrentName string, err error)
+	GetNameCtx(ctx context.Context) (CurrentName string, err error)
+	SetName(NewName string) (err error)
+	SetNameCtx(ctx context.Context, NewName string) (err error)
+}
+
+var _ TemperatureSensor1Client = new(TemperatureSensor1)
+
+// TemperatureSensor1Application is a value of the state variable Application of
+// TemperatureSensor1.
+type TemperatureSensor1Application string
+
+// Allowed values of TemperatureSensor1Application.
+const (
+	TemperatureSensor1Application_Room    TemperatureSensor1Application = "Room"
+	TemperatureSensor1Application_Outdoor TemperatureSensor1Application = "Outdoor"
+	TemperatureSensor1Application_Pipe    TemperatureSensor1Application = "Pipe"
+	TemperatureSensor1Application_AirDuct TemperatureSensor1Application = "AirDuct"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v TemperatureSensor1Application) Valid() bool {
+	switch v {
+	case TemperatureSensor1Application_Room,
+		TemperatureSensor1Application_Outdoor,
+		TemperatureSensor1Application_Pipe,
+		TemperatureSensor1Application_AirDuct:
+		return true
+	}
+	return false
+}
+
+// NewTemperatureSensor1Clients discovers instances of the service on the network,
+// and returns clients to any that are found. errors will contain an error for
+// any devices that replied but which could not be queried, and err will be set
+// if the discovery process failed outright.
+//
+// This is a typical entry calling point into this package.
+func NewTemperatureSensor1Clients() (clients []*TemperatureSensor1, errors []error, err error) {
+	var genericClients []goupnp.ServiceClient
+	if genericClients, errors, err = goupnp.NewServiceClients(URN_TemperatureSensor_1); err != nil {
+		return
+	}
+	clients = newTemperatureSensor1ClientsFromGenericClients(genericClients)
+	return
+}
+
+// NewTemperatureSensor1ClientsByURL discovers instances of the service at the given
+// URL, and returns clients to any that are found. An error is returned if
+// there was an error probing the service.
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered service URL.
+func NewTemperatureSensor1ClientsByURL(loc *url.URL) ([]*TemperatureSensor1, error) {
+	genericClients, err := goupnp.NewServiceClientsByURL(loc, URN_TemperatureSensor_1)
+	if err != nil {
+		return nil, err
+	}
+	return newTemperatureSensor1ClientsFromGenericClients(genericClients), nil
+}
+
+// NewTemperatureSensor1ClientsFromRootDevice discovers instances of the service in
+// a given root device, and returns clients to any that are found. An error is
+// returned if there was not at least one instance of the service within the
+// device. The location parameter is simply assigned to the Location attribute
+// of the wrapped ServiceClient(s).
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered root device.
+func NewTemperatureSensor1ClientsFromRootDevice(rootDevice *goupnp.RootDevice, loc *url.URL) ([]*TemperatureSensor1, error) {
+	genericClients, err := goupnp.NewServiceClientsFromRootDevice(rootDevice, loc, URN_TemperatureSensor_1)
+	if err != nil {
+		return nil, err
+	}
+	return newTemperatureSensor1ClientsFromGenericClients(genericClients), nil
+}
+
+func newTemperatureSensor1ClientsFromGenericClients(genericClients []goupnp.ServiceClient) []*TemperatureSensor1 {
+	clients := make([]*TemperatureSensor1, len(genericClients))
+	for i := range genericClients {
+		clients[i] = &TemperatureSensor1{genericClients[i]}
+	}
+	return clients
+}
+
+// TemperatureSensor1Timeouts are the default timeouts of the actions of
+// TemperatureSensor1, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var TemperatureSensor1Timeouts = map[string]time.Duration{}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *TemperatureSensor1) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, TemperatureSensor1Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_TemperatureSensor_1, actionName, request, response)
+}
+
+// TemperatureSensor1GetApplicationResponse is the response of GetApplication, with each
+// argument in its SOAP string form.
+type TemperatureSensor1GetApplicationResponse struct {
+	CurrentApp string
+}
+
+// GetApplication performs the GetApplication action of the service.
+//
+// Return values:
+//   - CurrentApp (out, state variable Application): allowed values Room, Outdoor, Pipe, AirDuct
+func (client *TemperatureSensor1) GetApplication() (CurrentApp TemperatureSensor1Application, err error) {
+	return client.GetApplicationCtx(context.Background())
+}
+
+// GetApplicationCtx is GetApplication with a context, to cancel or time out the call.
+func (client *TemperatureSensor1) GetApplicationCtx(ctx context.Context) (CurrentApp TemperatureSensor1Application, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &TemperatureSensor1GetApplicationResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetApplication", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	CurrentApp = TemperatureSensor1Application(response.CurrentApp)
+	// END Unmarshal arguments from response.
+	return
+}
+
+// TemperatureSensor1SetApplicationRequest is the request of SetApplication, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type TemperatureSensor1SetApplicationRequest struct {
+	NewApplication string
+}
+
+// SetApplication performs the SetApplication action of the service.
+//
+// Arguments:
+//   - NewApplication (in, state variable Application): allowed values Room, Outdoor, Pipe, AirDuct
+func (client *TemperatureSensor1) SetApplication(NewApplication TemperatureSensor1Application) (err error) {
+	return client.SetApplicationCtx(context.Background(), NewApplication)
+}
+
+// SetApplicationCtx is SetApplication with a context, to cancel or time out the call.
+func (client *TemperatureSensor1) SetApplicationCtx(ctx context.Context, NewApplication TemperatureSensor1Application) (err error) {
+	// Request structure.
+	request := &TemperatureSensor1SetApplicationRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewApplication, err = soap.MarshalString(string(NewApplication)); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SetApplication", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// TemperatureSensor1GetCurrentTemperatureResponse is the response of GetCurrentTemperature, with each
+// argument in its SOAP string form.
+type TemperatureSensor1GetCurrentTemperatureResponse struct {
+	CurrentTemp string
+}
+
+// GetCurrentTemperature performs the GetCurrentTemperature action of the service.
+//
+// Return values:
+//   - CurrentTemp (out, state variable CurrentTemperature): allowed range minimum=-27315, maximum=2147483647
+func (client *TemperatureSensor1) GetCurrentTemperature() (CurrentTemp int32, err error) {
+	return client.GetCurrentTemperatureCtx(context.Background())
+}
+
+// GetCurrentTemperatureCtx is GetCurrentTemperature with a context, to cancel or time out the call.
+func (client *TemperatureSensor1) GetCurrentTemperatureCtx(ctx context.Context) (CurrentTemp int32, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &TemperatureSensor1GetCurrentTemperatureResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetCurrentTemperature", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if CurrentTemp, err = soap.UnmarshalI4(response.CurrentTemp); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// TemperatureSensor1GetNameResponse is the response of GetName, with each
+// argument in its SOAP string form.
+type TemperatureSensor1GetNameResponse struct {
+	CurrentName string
+}
+
+// GetName performs the GetName action of the service.
+//
+// Return values:
+//   - CurrentName (out, state variable Name)
+func (client *TemperatureSensor1) GetName() (CurrentName string, err error) {
+	return client.GetNameCtx(context.Background())
+}
+
+// GetNameCtx is GetName with a context, to cancel or time out the call.
+func (client *TemperatureSensor1) GetNameCtx(ctx context.Context) (CurrentName string, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &TemperatureSensor1GetNameResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetName", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if CurrentName, err = soap.UnmarshalString(response.CurrentName); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// TemperatureSensor1SetNameRequest is the request of SetName, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type TemperatureSensor1SetNameRequest struct {
+	NewName string
+}
+
+// SetName performs the SetName action of the service.
+//
+// Arguments:
+//   - NewName (in, state variable Name)
+func (client *TemperatureSensor1) SetName(NewName string) (err error) {
+	return client.SetNameCtx(context.Background(), NewName)
+}
+
+// SetNameCtx is SetName with a context, to cancel or time out the call.
+func (client *TemperatureSensor1) SetNameCtx(ctx context.Context, NewName string) (err error) {
+	// Request structure.
+	request := &TemperatureSensor1SetNameRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewName, err = soap.MarshalString(NewName); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SetName", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// TemperatureSetpoint1 is a client for UPnP SOAP service with URN "urn:schemas-upnp-org:service:TemperatureSetpoint:1". See
+// goupnp.ServiceClient, which contains RootDevice and Service attributes which
+// are provided for informational value.
+type TemperatureSetpoint1 struct {
+	goupnp.ServiceClient
+}
+
+// TemperatureSetpoint1Client is the interface of the actions of TemperatureSetpoint1, for
+// substituting fakes or mocks for the service in tests.
+type TemperatureSetpoint1Client interface {
+	GetApplication() (CurrentApplication TemperatureSetpoint1Application, err error)
+	GetApplicationCtx(ctx context.Context) (CurrentApplication TemperatureSetpoint1Application, err error)
+	SetApplication(NewApplication TemperatureSetpoint1Application) (err error)
+	SetApplicationCtx(ctx context.Context, NewApplication TemperatureSetpoint1Application) (err error)
+	GetCurrentSetpoint() (CurrentSP int32, err error)
+	GetCurrentSetpointCtx(ctx context.Context) (CurrentSP int32, err error)
+	SetCurrentSetpoint(NewCurrentSetpoint int32) (err error)
+	SetCurrentSetpointCtx(ctx context.Context, NewCurrentSetpoint int32) (err error)
+	GetSetpointAchieved() (CurrentSPA bool, err error)
+	GetSetpointAchievedCtx(ctx context.Context) (CurrentSPA bool, err error)
+	GetName() (CurrentName string, err error)
+	GetNameCtx(ctx context.Context) (CurrentName string, err error)
+	SetName(NewName string) (err error)
+	SetNameCtx(ctx context.Context, NewName string) (err error)
+}
+
+var _ TemperatureSetpoint1Client = new(TemperatureSetpoint1)
+
+// TemperatureSetpoint1Application is a value of the state variable Application of
+// TemperatureSetpoint1.
+type TemperatureSetpoint1Application string
+
+// Allowed values of TemperatureSetpoint1Application.
+const (
+	TemperatureSetpoint1Application_Heating            TemperatureSetpoint1Application = "Heating"
+	TemperatureSetpoint1Application_Cooling            TemperatureSetpoint1Application = "Cooling"
+	TemperatureSetpoint1Application_DualHeatingCooling TemperatureSetpoint1Application = "DualHeatingCooling"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v TemperatureSetpoint1Application) Valid() bool {
+	switch v {
+	case TemperatureSetpoint1Application_Heating,
+		TemperatureSetpoint1Application_Cooling,
+		TemperatureSetpoint1Application_DualHeatingCooling:
+		return true
+	}
+	return false
+}
+
+// NewTemperatureSetpoint1Clients discovers instances of the service on the network,
+// and returns clients to any that are found. errors will contain an error for
+// any devices that replied but which could not be queried, and err will be set
+// if the discovery process failed outright.
+//
+// This is a typical entry calling point into this package.
+func NewTemperatureSetpoint1Clients() (clients []*TemperatureSetpoint1, errors []error, err error) {
+	var genericClients []goupnp.ServiceClient
+	if genericClients, errors, err = goupnp.NewServiceClients(URN_TemperatureSetpoint_1); err != nil {
+		return
+	}
+	clients = newTemperatureSetpoint1ClientsFromGenericClients(genericClients)
+	return
+}
+
+// NewTemperatureSetpoint1ClientsByURL discovers instances of the service at the given
+// URL, and returns clients to any that are found. An error is returned if
+// there was an error probing the service.
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered service URL.
+func NewTemperatureSetpoint1ClientsByURL(loc *url.URL) ([]*TemperatureSetpoint1, error) {
+	genericClients, err := goupnp.NewServiceClientsByURL(loc, URN_TemperatureSetpoint_1)
+	if err != nil {
+		return nil, err
+	}
+	return newTemperatureSetpoint1ClientsFromGenericClients(genericClients), nil
+}
+
+// NewTemperatureSetpoint1ClientsFromRootDevice discovers instances of the service in
+// a given root device, and returns clients to any that are found. An error is
+// returned if there was not at least one instance of the service within the
+// device. The location parameter is simply assigned to the Location attribute
+// of the wrapped ServiceClient(s).
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered root device.
+func NewTemperatureSetpoint1ClientsFromRootDevice(rootDevice *goupnp.RootDevice, loc *url.URL) ([]*TemperatureSetpoint1, error) {
+	genericClients, err := goupnp.NewServiceClientsFromRootDevice(rootDevice, loc, URN_TemperatureSetpoint_1)
+	if err != nil {
+		return nil, err
+	}
+	return newTemperatureSetpoint1ClientsFromGenericClients(genericClients), nil
+}
+
+func newTemperatureSetpoint1ClientsFromGenericClients(genericClients []goupnp.ServiceClient) []*TemperatureSetpoint1 {
+	clients := make([]*TemperatureSetpoint1, len(genericClients))
+	for i := range genericClients {
+		clients[i] = &TemperatureSetpoint1{genericClients[i]}
+	}
+	return clients
+}
+
+// TemperatureSetpoint1Timeouts are the default timeouts of the actions of
+// TemperatureSetpoint1, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var TemperatureSetpoint1Timeouts = map[string]time.Duration{}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *TemperatureSetpoint1) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, TemperatureSetpoint1Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_TemperatureSetpoint_1, actionName, request, response)
+}
+
+// TemperatureSetpoint1GetApplicationResponse is the response of GetApplication, with each
+// argument in its SOAP string form.
+type TemperatureSetpoint1GetApplicationResponse struct {
+	CurrentApplication string
+}
+
+// GetApplication performs the GetApplication action of the service.
+//
+// Return values:
+//   - CurrentApplication (out, state variable Application): allowed values Heating, Cooling, DualHeatingCooling
+func (client *TemperatureSetpoint1) GetApplication() (CurrentApplication TemperatureSetpoint1Application, err error) {
+	return client.GetApplicationCtx(context.Background())
+}
+
+// GetApplicationCtx is GetApplication with a context, to cancel or time out the call.
+func (client *TemperatureSetpoint1) GetApplicationCtx(ctx context.Context) (CurrentApplication TemperatureSetpoint1Application, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &TemperatureSetpoint1GetApplicationResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetApplication", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	CurrentApplication = TemperatureSetpoint1Application(response.CurrentApplication)
+	// END Unmarshal arguments from response.
+	return
+}
+
+// TemperatureSetpoint1SetApplicationRequest is the request of SetApplication, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type TemperatureSetpoint1SetApplicationRequest struct {
+	NewApplication string
+}
+
+// SetApplication performs the SetApplication action of the service.
+//
+// Arguments:
+//   - NewApplication (in, state variable Application): allowed values Heating, Cooling, DualHeatingCooling
+func (client *TemperatureSetpoint1) SetApplication(NewApplication TemperatureSetpoint1Application) (err error) {
+	return client.SetApplicationCtx(context.Background(), NewApplication)
+}
+
+// SetApplicationCtx is SetApplication with a context, to cancel or time out the call.
+func (client *TemperatureSetpoint1) SetApplicationCtx(ctx context.Context, NewApplication TemperatureSetpoint1Application) (err error) {
+	// Request structure.
+	request := &TemperatureSetpoint1SetApplicationRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewApplication, err = soap.MarshalString(string(NewApplication)); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SetApplication", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// TemperatureSetpoint1GetCurrentSetpointResponse is the response of GetCurrentSetpoint, with each
+// argument in its SOAP string form.
+type TemperatureSetpoint1GetCurrentSetpointResponse struct {
+	CurrentSP string
+}
+
+// GetCurrentSetpoint performs the GetCurrentSetpoint action of the service.
+//
+// Return values:
+//   - CurrentSP (out, state variable CurrentSetpoint): allowed range minimum=-27315, maximum=2147483647
+func (client *TemperatureSetpoint1) GetCurrentSetpoint() (CurrentSP int32, err error) {
+	return client.GetCurrentSetpointCtx(context.Background())
+}
+
+// GetCurrentSetpointCtx is GetCurrentSetpoint with a context, to cancel or time out the call.
+func (client *TemperatureSetpoint1) GetCurrentSetpointCtx(ctx context.Context) (CurrentSP int32, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &TemperatureSetpoint1GetCurrentSetpointResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetCurrentSetpoint", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if CurrentSP, err = soap.UnmarshalI4(response.CurrentSP); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// TemperatureSetpoint1SetCurrentSetpointRequest is the request of SetCurrentSetpoint, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type TemperatureSetpoint1SetCurrentSetpointRequest struct {
+	NewCurrentSetpoint string
+}
+
+// SetCurrentSetpoint performs the SetCurrentSetpoint action of the service.
+//
+// Arguments:
+//   - NewCurrentSetpoint (in, state variable CurrentSetpoint): allowed range minimum=-27315, maximum=2147483647
+func (client *TemperatureSetpoint1) SetCurrentSetpoint(NewCurrentSetpoint int32) (err error) {
+	return client.SetCurrentSetpointCtx(context.Background(), NewCurrentSetpoint)
+}
+
+// SetCurrentSetpointCtx is SetCurrentSetpoint with a context, to cancel or time out the call.
+func (client *TemperatureSetpoint1) SetCurrentSetpointCtx(ctx context.Context, NewCurrentSetpoint int32) (err error) {
+	// Request structure.
+	request := &TemperatureSetpoint1SetCurrentSetpointRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewCurrentSetpoint, err = soap.MarshalI4(NewCurrentSetpoint); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SetCurrentSetpoint", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// TemperatureSetpoint1GetSetpointAchievedResponse is the response of GetSetpointAchieved, with each
+// argument in its SOAP string form.
+type TemperatureSetpoint1GetSetpointAchievedResponse struct {
+	CurrentSPA string
+}
+
+// GetSetpointAchieved performs the GetSetpointAchieved action of the service.
+//
+// Return values:
+//   - CurrentSPA (out, state variable SetpointAchieved)
+func (client *TemperatureSetpoint1) GetSetpointAchieved() (CurrentSPA bool, err error) {
+	return client.GetSetpointAchievedCtx(context.Background())
+}
+
+// GetSetpointAchievedCtx is GetSetpointAchieved with a context, to cancel or time out the call.
+func (client *TemperatureSetpoint1) GetSetpointAchievedCtx(ctx context.Context) (CurrentSPA bool, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &TemperatureSetpoint1GetSetpointAchievedResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetSetpointAchieved", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if CurrentSPA, err = soap.UnmarshalBoolean(response.CurrentSPA); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// TemperatureSetpoint1GetNameResponse is the response of GetName, with each
+// argument in its SOAP string form.
+type TemperatureSetpoint1GetNameResponse struct {
+	CurrentName string
+}
+
+// GetName performs the GetName action of the service.
+//
+// Return values:
+//   - CurrentName (out, state variable Name)
+func (client *TemperatureSetpoint1) GetName() (CurrentName string, err error) {
+	return client.GetNameCtx(context.Background())
+}
+
+// GetNameCtx is GetName with a context, to cancel or time out the call.
+func (client *TemperatureSetpoint1) GetNameCtx(ctx context.Context) (CurrentName string, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &TemperatureSetpoint1GetNameResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetName", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if CurrentName, err = soap.UnmarshalString(response.CurrentName); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// TemperatureSetpoint1SetNameRequest is the request of SetName, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type TemperatureSetpoint1SetNameRequest struct {
+	NewName string
+}
+
+// SetName performs the SetName action of the service.
+//
+// Arguments:
+//   - NewName (in, state variable Name)
+func (client *TemperatureSetpoint1) SetName(NewName string) (err error) {
+	return client.SetNameCtx(context.Background(), NewName)
+}
+
+// SetNameCtx is SetName with a context, to cancel or time out the call.
+func (client *TemperatureSetpoint1) SetNameCtx(ctx context.Context, NewName string) (err error) {
+	// Request structure.
+	request := &TemperatureSetpoint1SetNameRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewName, err = soap.MarshalString(NewName); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SetName", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
@@ -0,0 +1,882 @@
+package hvac1
+
+// Generated file - do not edit by hand. See README.md
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/huin/goupnp/device"
+	"github.com/huin/goupnp/soap"
+)
+
+// Hack to avoid Go complaining if url or time aren't used.
+var _ *url.URL
+var _ time.Time
+
+// HVAC_FanOperatingMode1Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:schemas-upnp-org:service:HVAC_FanOperatingMode:1". See RegisterHVAC_FanOperatingMode1Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type HVAC_FanOperatingMode1Handler interface {
+	SetMode(ctx context.Context, NewMode HVAC_FanOperatingMode1Mode) (err error)
+
+	GetMode(ctx context.Context) (CurrentMode HVAC_FanOperatingMode1Mode, err error)
+
+	GetFanStatus(ctx context.Context) (CurrentStatus HVAC_FanOperatingMode1FanStatus, err error)
+
+	GetName(ctx context.Context) (CurrentName string, err error)
+
+	SetName(ctx context.Context, NewName string) (err error)
+}
+
+// RegisterHVAC_FanOperatingMode1Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_HVAC_FanOperatingMode_1.
+func RegisterHVAC_FanOperatingMode1Handler(svc *device.Service, handler HVAC_FanOperatingMode1Handler) {
+	svc.HandleFunc("SetMode", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveHVAC_FanOperatingMode1SetMode(ctx, handler, in)
+	})
+	svc.HandleFunc("GetMode", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveHVAC_FanOperatingMode1GetMode(ctx, handler, in)
+	})
+	svc.HandleFunc("GetFanStatus", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveHVAC_FanOperatingMode1GetFanStatus(ctx, handler, in)
+	})
+	svc.HandleFunc("GetName", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveHVAC_FanOperatingMode1GetName(ctx, handler, in)
+	})
+	svc.HandleFunc("SetName", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveHVAC_FanOperatingMode1SetName(ctx, handler, in)
+	})
+}
+
+func serveHVAC_FanOperatingMode1SetMode(ctx context.Context, handler HVAC_FanOperatingMode1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewMode HVAC_FanOperatingMode1Mode
+	if value, err = soap.FindArg(in, "NewMode"); err != nil {
+		return
+	}
+	NewMode = HVAC_FanOperatingMode1Mode(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetMode(ctx, NewMode); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveHVAC_FanOperatingMode1GetMode(ctx context.Context, handler HVAC_FanOperatingMode1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentMode HVAC_FanOperatingMode1Mode
+	if CurrentMode, err = handler.GetMode(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentMode"
+	if out[0].Value, err = soap.MarshalString(string(CurrentMode)); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveHVAC_FanOperatingMode1GetFanStatus(ctx context.Context, handler HVAC_FanOperatingMode1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentStatus HVAC_FanOperatingMode1FanStatus
+	if CurrentStatus, err = handler.GetFanStatus(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentStatus"
+	if out[0].Value, err = soap.MarshalString(string(CurrentStatus)); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveHVAC_FanOperatingMode1GetName(ctx context.Context, handler HVAC_FanOperatingMode1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentName string
+	if CurrentName, err = handler.GetName(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentName"
+	if out[0].Value, err = soap.MarshalString(CurrentName); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveHVAC_FanOperatingMode1SetName(ctx context.Context, handler HVAC_FanOperatingMode1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewName string
+	if value, err = soap.FindArg(in, "NewName"); err != nil {
+		return
+	}
+	if NewName, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewName: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetName(ctx, NewName); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+// HVAC_UserOperatingMode1Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:schemas-upnp-org:service:HVAC_UserOperatingMode:1". See RegisterHVAC_UserOperatingMode1Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type HVAC_UserOperatingMode1Handler interface {
+	SetModeTarget(ctx context.Context, NewModeTarget HVAC_UserOperatingMode1ModeTarget) (err error)
+
+	GetModeTarget(ctx context.Context) (CurrentModeTarget HVAC_UserOperatingMode1ModeTarget, err error)
+
+	GetModeStatus(ctx context.Context) (CurrentModeStatus HVAC_UserOperatingMode1ModeStatus, err error)
+
+	GetName(ctx context.Context) (CurrentName string, err error)
+
+	SetName(ctx context.Context, NewName string) (err error)
+}
+
+// RegisterHVAC_UserOperatingMode1Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_HVAC_UserOperatingMode_1.
+func RegisterHVAC_UserOperatingMode1Handler(svc *device.Service, handler HVAC_UserOperatingMode1Handler) {
+	svc.HandleFunc("SetModeTarget", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveHVAC_UserOperatingMode1SetModeTarget(ctx, handler, in)
+	})
+	svc.HandleFunc("GetModeTarget", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveHVAC_UserOperatingMode1GetModeTarget(ctx, handler, in)
+	})
+	svc.HandleFunc("GetModeStatus", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveHVAC_UserOperatingMode1GetModeStatus(ctx, handler, in)
+	})
+	svc.HandleFunc("GetName", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveHVAC_UserOperatingMode1GetName(ctx, handler, in)
+	})
+	svc.HandleFunc("SetName", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveHVAC_UserOperatingMode1SetName(ctx, handler, in)
+	})
+}
+
+func serveHVAC_UserOperatingMode1SetModeTarget(ctx context.Context, handler HVAC_UserOperatingMode1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewModeTarget HVAC_UserOperatingMode1ModeTarget
+	if value, err = soap.FindArg(in, "NewModeTarget"); err != nil {
+		return
+	}
+	NewModeTarget = HVAC_UserOperatingMode1ModeTarget(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetModeTarget(ctx, NewModeTarget); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveHVAC_UserOperatingMode1GetModeTarget(ctx context.Context, handler HVAC_UserOperatingMode1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentModeTarget HVAC_UserOperatingMode1ModeTarget
+	if CurrentModeTarget, err = handler.GetModeTarget(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentModeTarget"
+	if out[0].Value, err = soap.MarshalString(string(CurrentModeTarget)); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveHVAC_UserOperatingMode1GetModeStatus(ctx context.Context, handler HVAC_UserOperatingMode1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentModeStatus HVAC_UserOperatingMode1ModeStatus
+	if CurrentModeStatus, err = handler.GetModeStatus(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentModeStatus"
+	if out[0].Value, err = soap.MarshalString(string(CurrentModeStatus)); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveHVAC_UserOperatingMode1GetName(ctx context.Context, handler HVAC_UserOperatingMode1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentName string
+	if CurrentName, err = handler.GetName(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentName"
+	if out[0].Value, err = soap.MarshalString(CurrentName); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveHVAC_UserOperatingMode1SetName(ctx context.Context, handler HVAC_UserOperatingMode1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewName string
+	if value, err = soap.FindArg(in, "NewName"); err != nil {
+		return
+	}
+	if NewName, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewName: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetName(ctx, NewName); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+// HouseStatus1Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:schemas-upnp-org:service:HouseStatus:1". See RegisterHouseStatus1Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type HouseStatus1Handler interface {
+	SetOccupancyState(ctx context.Context, NewOccupancyState HouseStatus1OccupancyState) (err error)
+
+	GetOccupancyState(ctx context.Context) (CurrentOccupancyState HouseStatus1OccupancyState, err error)
+
+	SetActivityState(ctx context.Context, NewActivityState HouseStatus1ActivityState) (err error)
+
+	GetActivityState(ctx context.Context) (CurrentActivityState HouseStatus1ActivityState, err error)
+
+	SetDormancyState(ctx context.Context, NewDormancyState HouseStatus1DormancyState) (err error)
+
+	GetDormancyState(ctx context.Context) (CurrentDormancyState HouseStatus1DormancyState, err error)
+}
+
+// RegisterHouseStatus1Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_HouseStatus_1.
+func RegisterHouseStatus1Handler(svc *device.Service, handler HouseStatus1Handler) {
+	svc.HandleFunc("SetOccupancyState", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveHouseStatus1SetOccupancyState(ctx, handler, in)
+	})
+	svc.HandleFunc("GetOccupancyState", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveHouseStatus1GetOccupancyState(ctx, handler, in)
+	})
+	svc.HandleFunc("SetActivityState", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveHouseStatus1SetActivityState(ctx, handler, in)
+	})
+	svc.HandleFunc("GetActivityState", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveHouseStatus1GetActivityState(ctx, handler, in)
+	})
+	svc.HandleFunc("SetDormancyState", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveHouseStatus1SetDormancyState(ctx, handler, in)
+	})
+	svc.HandleFunc("GetDormancyState", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveHouseStatus1GetDormancyState(ctx, handler, in)
+	})
+}
+
+func serveHouseStatus1SetOccupancyState(ctx context.Context, handler HouseStatus1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewOccupancyState HouseStatus1OccupancyState
+	if value, err = soap.FindArg(in, "NewOccupancyState"); err != nil {
+		return
+	}
+	NewOccupancyState = HouseStatus1OccupancyState(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetOccupancyState(ctx, NewOccupancyState); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveHouseStatus1GetOccupancyState(ctx context.Context, handler HouseStatus1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentOccupancyState HouseStatus1OccupancyState
+	if CurrentOccupancyState, err = handler.GetOccupancyState(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentOccupancyState"
+	if out[0].Value, err = soap.MarshalString(string(CurrentOccupancyState)); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveHouseStatus1SetActivityState(ctx context.Context, handler HouseStatus1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewActivityState HouseStatus1ActivityState
+	if value, err = soap.FindArg(in, "NewActivityState"); err != nil {
+		return
+	}
+	NewActivityState = HouseStatus1ActivityState(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetActivityState(ctx, NewActivityState); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveHouseStatus1GetActivityState(ctx context.Context, handler HouseStatus1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentActivityState HouseStatus1ActivityState
+	if CurrentActivityState, err = handler.GetActivityState(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentActivityState"
+	if out[0].Value, err = soap.MarshalString(string(CurrentActivityState)); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveHouseStatus1SetDormancyState(ctx context.Context, handler HouseStatus1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewDormancyState HouseStatus1DormancyState
+	if value, err = soap.FindArg(in, "NewDormancyState"); err != nil {
+		return
+	}
+	NewDormancyState = HouseStatus1DormancyState(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetDormancyState(ctx, NewDormancyState); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveHouseStatus1GetDormancyState(ctx context.Context, handler HouseStatus1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentDormancyState HouseStatus1DormancyState
+	if CurrentDormancyState, err = handler.GetDormancyState(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentDormancyState"
+	if out[0].Value, err = soap.MarshalString(string(CurrentDormancyState)); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+// TemperatureSensor1Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:schemas-upnp-org:service:TemperatureSensor:1". See RegisterTemperatureSensor1Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type TemperatureSensor1Handler interface {
+	GetApplication(ctx context.Context) (CurrentApp TemperatureSensor1Application, err error)
+
+	SetApplication(ctx context.Context, NewApplication TemperatureSensor1Application) (err error)
+
+	GetCurrentTemperature(ctx context.Context) (CurrentTemp int32, err error)
+
+	GetName(ctx context.Context) (CurrentName string, err error)
+
+	SetName(ctx context.Context, NewName string) (err error)
+}
+
+// RegisterTemperatureSensor1Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_TemperatureSensor_1.
+func RegisterTemperatureSensor1Handler(svc *device.Service, handler TemperatureSensor1Handler) {
+	svc.HandleFunc("GetApplication", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveTemperatureSensor1GetApplication(ctx, handler, in)
+	})
+	svc.HandleFunc("SetApplication", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveTemperatureSensor1SetApplication(ctx, handler, in)
+	})
+	svc.HandleFunc("GetCurrentTemperature", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveTemperatureSensor1GetCurrentTemperature(ctx, handler, in)
+	})
+	svc.HandleFunc("GetName", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveTemperatureSensor1GetName(ctx, handler, in)
+	})
+	svc.HandleFunc("SetName", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveTemperatureSensor1SetName(ctx, handler, in)
+	})
+}
+
+func serveTemperatureSensor1GetApplication(ctx context.Context, handler TemperatureSensor1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentApp TemperatureSensor1Application
+	if CurrentApp, err = handler.GetApplication(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentApp"
+	if out[0].Value, err = soap.MarshalString(string(CurrentApp)); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveTemperatureSensor1SetApplication(ctx context.Context, handler TemperatureSensor1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewApplication TemperatureSensor1Application
+	if value, err = soap.FindArg(in, "NewApplication"); err != nil {
+		return
+	}
+	NewApplication = TemperatureSensor1Application(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetApplication(ctx, NewApplication); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveTemperatureSensor1GetCurrentTemperature(ctx context.Context, handler TemperatureSensor1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentTemp int32
+	if CurrentTemp, err = handler.GetCurrentTemperature(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentTemp"
+	if out[0].Value, err = soap.MarshalI4(CurrentTemp); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveTemperatureSensor1GetName(ctx context.Context, handler TemperatureSensor1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentName string
+	if CurrentName, err = handler.GetName(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentName"
+	if out[0].Value, err = soap.MarshalString(CurrentName); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveTemperatureSensor1SetName(ctx context.Context, handler TemperatureSensor1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewName string
+	if value, err = soap.FindArg(in, "NewName"); err != nil {
+		return
+	}
+	if NewName, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewName: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetName(ctx, NewName); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+// TemperatureSetpoint1Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:schemas-upnp-org:service:TemperatureSetpoint:1". See RegisterTemperatureSetpoint1Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type TemperatureSetpoint1Handler interface {
+	GetApplication(ctx context.Context) (CurrentApplication TemperatureSetpoint1Application, err error)
+
+	SetApplication(ctx context.Context, NewApplication TemperatureSetpoint1Application) (err error)
+
+	GetCurrentSetpoint(ctx context.Context) (CurrentSP int32, err error)
+
+	SetCurrentSetpoint(ctx context.Context, NewCurrentSetpoint int32) (err error)
+
+	GetSetpointAchieved(ctx context.Context) (CurrentSPA bool, err error)
+
+	GetName(ctx context.Context) (CurrentName string, err error)
+
+	SetName(ctx context.Context, NewName string) (err error)
+}
+
+// RegisterTemperatureSetpoint1Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_TemperatureSetpoint_1.
+func RegisterTemperatureSetpoint1Handler(svc *device.Service, handler TemperatureSetpoint1Handler) {
+	svc.HandleFunc("GetApplication", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveTemperatureSetpoint1GetApplication(ctx, handler, in)
+	})
+	svc.HandleFunc("SetApplication", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveTemperatureSetpoint1SetApplication(ctx, handler, in)
+	})
+	svc.HandleFunc("GetCurrentSetpoint", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveTemperatureSetpoint1GetCurrentSetpoint(ctx, handler, in)
+	})
+	svc.HandleFunc("SetCurrentSetpoint", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveTemperatureSetpoint1SetCurrentSetpoint(ctx, handler, in)
+	})
+	svc.HandleFunc("GetSetpointAchieved", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveTemperatureSetpoint1GetSetpointAchieved(ctx, handler, in)
+	})
+	svc.HandleFunc("GetName", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveTemperatureSetpoint1GetName(ctx, handler, in)
+	})
+	svc.HandleFunc("SetName", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveTemperatureSetpoint1SetName(ctx, handler, in)
+	})
+}
+
+func serveTemperatureSetpoint1GetApplication(ctx context.Context, handler TemperatureSetpoint1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentApplication TemperatureSetpoint1Application
+	if CurrentApplication, err = handler.GetApplication(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentApplication"
+	if out[0].Value, err = soap.MarshalString(string(CurrentApplication)); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveTemperatureSetpoint1SetApplication(ctx context.Context, handler TemperatureSetpoint1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewApplication TemperatureSetpoint1Application
+	if value, err = soap.FindArg(in, "NewApplication"); err != nil {
+		return
+	}
+	NewApplication = TemperatureSetpoint1Application(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetApplication(ctx, NewApplication); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveTemperatureSetpoint1GetCurrentSetpoint(ctx context.Context, handler TemperatureSetpoint1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentSP int32
+	if CurrentSP, err = handler.GetCurrentSetpoint(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentSP"
+	if out[0].Value, err = soap.MarshalI4(CurrentSP); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveTemperatureSetpoint1SetCurrentSetpoint(ctx context.Context, handler TemperatureSetpoint1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewCurrentSetpoint int32
+	if value, err = soap.FindArg(in, "NewCurrentSetpoint"); err != nil {
+		return
+	}
+	if NewCurrentSetpoint, err = soap.UnmarshalI4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewCurrentSetpoint: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetCurrentSetpoint(ctx, NewCurrentSetpoint); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveTemperatureSetpoint1GetSetpointAchieved(ctx context.Context, handler TemperatureSetpoint1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentSPA bool
+	if CurrentSPA, err = handler.GetSetpointAchieved(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentSPA"
+	if out[0].Value, err = soap.MarshalBoolean(CurrentSPA); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveTemperatureSetpoint1GetName(ctx context.Context, handler TemperatureSetpoint1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentName string
+	if CurrentName, err = handler.GetName(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentName"
+	if out[0].Value, err = soap.MarshalString(CurrentName); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveTemperatureSetpoint1SetName(ctx context.Context, handler TemperatureSetpoint1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewName string
+	if value, err = soap.FindArg(in, "NewName"); err != nil {
+		return
+	}
+	if NewName, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewName: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetName(ctx, NewName); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
@@ -0,0 +1,3518 @@
+package internetgateway1
+
+// Generated file - do not edit by hand. See README.md
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/huin/goupnp/device"
+	"github.com/huin/goupnp/soap"
+)
+
+// Hack to avoid Go complaining if url or time aren't used.
+var _ *url.URL
+var _ time.Time
+
+// LANHostConfigManagement1Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:schemas-upnp-org:service:LANHostConfigManagement:1". See RegisterLANHostConfigManagement1Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type LANHostConfigManagement1Handler interface {
+	SetDHCPServerConfigurable(ctx context.Context, NewDHCPServerConfigurable bool) (err error)
+
+	GetDHCPServerConfigurable(ctx context.Context) (NewDHCPServerConfigurable bool, err error)
+
+	SetDHCPRelay(ctx context.Context, NewDHCPRelay bool) (err error)
+
+	GetDHCPRelay(ctx context.Context) (NewDHCPRelay bool, err error)
+
+	SetSubnetMask(ctx context.Context, NewSubnetMask string) (err error)
+
+	GetSubnetMask(ctx context.Context) (NewSubnetMask string, err error)
+
+	SetIPRouter(ctx context.Context, NewIPRouters string) (err error)
+
+	DeleteIPRouter(ctx context.Context, NewIPRouters string) (err error)
+
+	GetIPRoutersList(ctx context.Context) (NewIPRouters string, err error)
+
+	SetDomainName(ctx context.Context, NewDomainName string) (err error)
+
+	GetDomainName(ctx context.Context) (NewDomainName string, err error)
+
+	SetAddressRange(ctx context.Context, NewMinAddress string, NewMaxAddress string) (err error)
+
+	GetAddressRange(ctx context.Context) (NewMinAddress string, NewMaxAddress string, err error)
+
+	SetReservedAddress(ctx context.Context, NewReservedAddresses string) (err error)
+
+	DeleteReservedAddress(ctx context.Context, NewReservedAddresses string) (err error)
+
+	GetReservedAddresses(ctx context.Context) (NewReservedAddresses string, err error)
+
+	SetDNSServer(ctx context.Context, NewDNSServers string) (err error)
+
+	DeleteDNSServer(ctx context.Context, NewDNSServers string) (err error)
+
+	GetDNSServers(ctx context.Context) (NewDNSServers string, err error)
+}
+
+// RegisterLANHostConfigManagement1Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_LANHostConfigManagement_1.
+func RegisterLANHostConfigManagement1Handler(svc *device.Service, handler LANHostConfigManagement1Handler) {
+	svc.HandleFunc("SetDHCPServerConfigurable", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveLANHostConfigManagement1SetDHCPServerConfigurable(ctx, handler, in)
+	})
+	svc.HandleFunc("GetDHCPServerConfigurable", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveLANHostConfigManagement1GetDHCPServerConfigurable(ctx, handler, in)
+	})
+	svc.HandleFunc("SetDHCPRelay", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveLANHostConfigManagement1SetDHCPRelay(ctx, handler, in)
+	})
+	svc.HandleFunc("GetDHCPRelay", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveLANHostConfigManagement1GetDHCPRelay(ctx, handler, in)
+	})
+	svc.HandleFunc("SetSubnetMask", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveLANHostConfigManagement1SetSubnetMask(ctx, handler, in)
+	})
+	svc.HandleFunc("GetSubnetMask", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveLANHostConfigManagement1GetSubnetMask(ctx, handler, in)
+	})
+	svc.HandleFunc("SetIPRouter", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveLANHostConfigManagement1SetIPRouter(ctx, handler, in)
+	})
+	svc.HandleFunc("DeleteIPRouter", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveLANHostConfigManagement1DeleteIPRouter(ctx, handler, in)
+	})
+	svc.HandleFunc("GetIPRoutersList", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveLANHostConfigManagement1GetIPRoutersList(ctx, handler, in)
+	})
+	svc.HandleFunc("SetDomainName", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveLANHostConfigManagement1SetDomainName(ctx, handler, in)
+	})
+	svc.HandleFunc("GetDomainName", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveLANHostConfigManagement1GetDomainName(ctx, handler, in)
+	})
+	svc.HandleFunc("SetAddressRange", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveLANHostConfigManagement1SetAddressRange(ctx, handler, in)
+	})
+	svc.HandleFunc("GetAddressRange", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveLANHostConfigManagement1GetAddressRange(ctx, handler, in)
+	})
+	svc.HandleFunc("SetReservedAddress", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveLANHostConfigManagement1SetReservedAddress(ctx, handler, in)
+	})
+	svc.HandleFunc("DeleteReservedAddress", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveLANHostConfigManagement1DeleteReservedAddress(ctx, handler, in)
+	})
+	svc.HandleFunc("GetReservedAddresses", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveLANHostConfigManagement1GetReservedAddresses(ctx, handler, in)
+	})
+	svc.HandleFunc("SetDNSServer", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveLANHostConfigManagement1SetDNSServer(ctx, handler, in)
+	})
+	svc.HandleFunc("DeleteDNSServer", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveLANHostConfigManagement1DeleteDNSServer(ctx, handler, in)
+	})
+	svc.HandleFunc("GetDNSServers", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveLANHostConfigManagement1GetDNSServers(ctx, handler, in)
+	})
+}
+
+func serveLANHostConfigManagement1SetDHCPServerConfigurable(ctx context.Context, handler LANHostConfigManagement1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewDHCPServerConfigurable bool
+	if value, err = soap.FindArg(in, "NewDHCPServerConfigurable"); err != nil {
+		return
+	}
+	if NewDHCPServerConfigurable, err = soap.UnmarshalBoolean(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewDHCPServerConfigurable: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetDHCPServerConfigurable(ctx, NewDHCPServerConfigurable); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveLANHostConfigManagement1GetDHCPServerConfigurable(ctx context.Context, handler LANHostConfigManagement1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewDHCPServerConfigurable bool
+	if NewDHCPServerConfigurable, err = handler.GetDHCPServerConfigurable(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewDHCPServerConfigurable"
+	if out[0].Value, err = soap.MarshalBoolean(NewDHCPServerConfigurable); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveLANHostConfigManagement1SetDHCPRelay(ctx context.Context, handler LANHostConfigManagement1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewDHCPRelay bool
+	if value, err = soap.FindArg(in, "NewDHCPRelay"); err != nil {
+		return
+	}
+	if NewDHCPRelay, err = soap.UnmarshalBoolean(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewDHCPRelay: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetDHCPRelay(ctx, NewDHCPRelay); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveLANHostConfigManagement1GetDHCPRelay(ctx context.Context, handler LANHostConfigManagement1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewDHCPRelay bool
+	if NewDHCPRelay, err = handler.GetDHCPRelay(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewDHCPRelay"
+	if out[0].Value, err = soap.MarshalBoolean(NewDHCPRelay); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveLANHostConfigManagement1SetSubnetMask(ctx context.Context, handler LANHostConfigManagement1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewSubnetMask string
+	if value, err = soap.FindArg(in, "NewSubnetMask"); err != nil {
+		return
+	}
+	if NewSubnetMask, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewSubnetMask: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetSubnetMask(ctx, NewSubnetMask); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveLANHostConfigManagement1GetSubnetMask(ctx context.Context, handler LANHostConfigManagement1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewSubnetMask string
+	if NewSubnetMask, err = handler.GetSubnetMask(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewSubnetMask"
+	if out[0].Value, err = soap.MarshalString(NewSubnetMask); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveLANHostConfigManagement1SetIPRouter(ctx context.Context, handler LANHostConfigManagement1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewIPRouters string
+	if value, err = soap.FindArg(in, "NewIPRouters"); err != nil {
+		return
+	}
+	if NewIPRouters, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewIPRouters: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetIPRouter(ctx, NewIPRouters); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveLANHostConfigManagement1DeleteIPRouter(ctx context.Context, handler LANHostConfigManagement1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewIPRouters string
+	if value, err = soap.FindArg(in, "NewIPRouters"); err != nil {
+		return
+	}
+	if NewIPRouters, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewIPRouters: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.DeleteIPRouter(ctx, NewIPRouters); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveLANHostConfigManagement1GetIPRoutersList(ctx context.Context, handler LANHostConfigManagement1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewIPRouters string
+	if NewIPRouters, err = handler.GetIPRoutersList(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewIPRouters"
+	if out[0].Value, err = soap.MarshalString(NewIPRouters); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveLANHostConfigManagement1SetDomainName(ctx context.Context, handler LANHostConfigManagement1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewDomainName string
+	if value, err = soap.FindArg(in, "NewDomainName"); err != nil {
+		return
+	}
+	if NewDomainName, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewDomainName: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetDomainName(ctx, NewDomainName); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveLANHostConfigManagement1GetDomainName(ctx context.Context, handler LANHostConfigManagement1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewDomainName string
+	if NewDomainName, err = handler.GetDomainName(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewDomainName"
+	if out[0].Value, err = soap.MarshalString(NewDomainName); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveLANHostConfigManagement1SetAddressRange(ctx context.Context, handler LANHostConfigManagement1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewMinAddress string
+	if value, err = soap.FindArg(in, "NewMinAddress"); err != nil {
+		return
+	}
+	if NewMinAddress, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewMinAddress: "+err.Error())
+	}
+	var NewMaxAddress string
+	if value, err = soap.FindArg(in, "NewMaxAddress"); err != nil {
+		return
+	}
+	if NewMaxAddress, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewMaxAddress: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetAddressRange(ctx, NewMinAddress, NewMaxAddress); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveLANHostConfigManagement1GetAddressRange(ctx context.Context, handler LANHostConfigManagement1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewMinAddress string
+	var NewMaxAddress string
+	if NewMinAddress, NewMaxAddress, err = handler.GetAddressRange(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "NewMinAddress"
+	if out[0].Value, err = soap.MarshalString(NewMinAddress); err != nil {
+		return
+	}
+	out[1].Name = "NewMaxAddress"
+	if out[1].Value, err = soap.MarshalString(NewMaxAddress); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveLANHostConfigManagement1SetReservedAddress(ctx context.Context, handler LANHostConfigManagement1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewReservedAddresses string
+	if value, err = soap.FindArg(in, "NewReservedAddresses"); err != nil {
+		return
+	}
+	if NewReservedAddresses, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewReservedAddresses: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetReservedAddress(ctx, NewReservedAddresses); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveLANHostConfigManagement1DeleteReservedAddress(ctx context.Context, handler LANHostConfigManagement1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewReservedAddresses string
+	if value, err = soap.FindArg(in, "NewReservedAddresses"); err != nil {
+		return
+	}
+	if NewReservedAddresses, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewReservedAddresses: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.DeleteReservedAddress(ctx, NewReservedAddresses); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveLANHostConfigManagement1GetReservedAddresses(ctx context.Context, handler LANHostConfigManagement1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewReservedAddresses string
+	if NewReservedAddresses, err = handler.GetReservedAddresses(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewReservedAddresses"
+	if out[0].Value, err = soap.MarshalString(NewReservedAddresses); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveLANHostConfigManagement1SetDNSServer(ctx context.Context, handler LANHostConfigManagement1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewDNSServers string
+	if value, err = soap.FindArg(in, "NewDNSServers"); err != nil {
+		return
+	}
+	if NewDNSServers, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewDNSServers: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetDNSServer(ctx, NewDNSServers); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveLANHostConfigManagement1DeleteDNSServer(ctx context.Context, handler LANHostConfigManagement1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewDNSServers string
+	if value, err = soap.FindArg(in, "NewDNSServers"); err != nil {
+		return
+	}
+	if NewDNSServers, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewDNSServers: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.DeleteDNSServer(ctx, NewDNSServers); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveLANHostConfigManagement1GetDNSServers(ctx context.Context, handler LANHostConfigManagement1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewDNSServers string
+	if NewDNSServers, err = handler.GetDNSServers(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewDNSServers"
+	if out[0].Value, err = soap.MarshalString(NewDNSServers); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+// Layer3Forwarding1Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:schemas-upnp-org:service:Layer3Forwarding:1". See RegisterLayer3Forwarding1Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type Layer3Forwarding1Handler interface {
+	SetDefaultConnectionService(ctx context.Context, NewDefaultConnectionService string) (err error)
+
+	GetDefaultConnectionService(ctx context.Context) (NewDefaultConnectionService string, err error)
+}
+
+// RegisterLayer3Forwarding1Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_Layer3Forwarding_1.
+func RegisterLayer3Forwarding1Handler(svc *device.Service, handler Layer3Forwarding1Handler) {
+	svc.HandleFunc("SetDefaultConnectionService", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveLayer3Forwarding1SetDefaultConnectionService(ctx, handler, in)
+	})
+	svc.HandleFunc("GetDefaultConnectionService", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveLayer3Forwarding1GetDefaultConnectionService(ctx, handler, in)
+	})
+}
+
+func serveLayer3Forwarding1SetDefaultConnectionService(ctx context.Context, handler Layer3Forwarding1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewDefaultConnectionService string
+	if value, err = soap.FindArg(in, "NewDefaultConnectionService"); err != nil {
+		return
+	}
+	if NewDefaultConnectionService, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewDefaultConnectionService: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetDefaultConnectionService(ctx, NewDefaultConnectionService); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveLayer3Forwarding1GetDefaultConnectionService(ctx context.Context, handler Layer3Forwarding1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewDefaultConnectionService string
+	if NewDefaultConnectionService, err = handler.GetDefaultConnectionService(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewDefaultConnectionService"
+	if out[0].Value, err = soap.MarshalString(NewDefaultConnectionService); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+// WANCableLinkConfig1Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:schemas-upnp-org:service:WANCableLinkConfig:1". See RegisterWANCableLinkConfig1Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type WANCableLinkConfig1Handler interface {
+	GetCableLinkConfigInfo(ctx context.Context) (NewCableLinkConfigState WANCableLinkConfig1CableLinkConfigState, NewLinkType WANCableLinkConfig1LinkType, err error)
+
+	GetDownstreamFrequency(ctx context.Context) (NewDownstreamFrequency uint32, err error)
+
+	GetDownstreamModulation(ctx context.Context) (NewDownstreamModulation WANCableLinkConfig1DownstreamModulation, err error)
+
+	GetUpstreamFrequency(ctx context.Context) (NewUpstreamFrequency uint32, err error)
+
+	GetUpstreamModulation(ctx context.Context) (NewUpstreamModulation WANCableLinkConfig1UpstreamModulation, err error)
+
+	GetUpstreamChannelID(ctx context.Context) (NewUpstreamChannelID uint32, err error)
+
+	GetUpstreamPowerLevel(ctx context.Context) (NewUpstreamPowerLevel uint32, err error)
+
+	GetBPIEncryptionEnabled(ctx context.Context) (NewBPIEncryptionEnabled bool, err error)
+
+	GetConfigFile(ctx context.Context) (NewConfigFile string, err error)
+
+	GetTFTPServer(ctx context.Context) (NewTFTPServer string, err error)
+}
+
+// RegisterWANCableLinkConfig1Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_WANCableLinkConfig_1.
+func RegisterWANCableLinkConfig1Handler(svc *device.Service, handler WANCableLinkConfig1Handler) {
+	svc.HandleFunc("GetCableLinkConfigInfo", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANCableLinkConfig1GetCableLinkConfigInfo(ctx, handler, in)
+	})
+	svc.HandleFunc("GetDownstreamFrequency", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANCableLinkConfig1GetDownstreamFrequency(ctx, handler, in)
+	})
+	svc.HandleFunc("GetDownstreamModulation", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANCableLinkConfig1GetDownstreamModulation(ctx, handler, in)
+	})
+	svc.HandleFunc("GetUpstreamFrequency", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANCableLinkConfig1GetUpstreamFrequency(ctx, handler, in)
+	})
+	svc.HandleFunc("GetUpstreamModulation", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANCableLinkConfig1GetUpstreamModulation(ctx, handler, in)
+	})
+	svc.HandleFunc("GetUpstreamChannelID", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANCableLinkConfig1GetUpstreamChannelID(ctx, handler, in)
+	})
+	svc.HandleFunc("GetUpstreamPowerLevel", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANCableLinkConfig1GetUpstreamPowerLevel(ctx, handler, in)
+	})
+	svc.HandleFunc("GetBPIEncryptionEnabled", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANCableLinkConfig1GetBPIEncryptionEnabled(ctx, handler, in)
+	})
+	svc.HandleFunc("GetConfigFile", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANCableLinkConfig1GetConfigFile(ctx, handler, in)
+	})
+	svc.HandleFunc("GetTFTPServer", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANCableLinkConfig1GetTFTPServer(ctx, handler, in)
+	})
+}
+
+func serveWANCableLinkConfig1GetCableLinkConfigInfo(ctx context.Context, handler WANCableLinkConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewCableLinkConfigState WANCableLinkConfig1CableLinkConfigState
+	var NewLinkType WANCableLinkConfig1LinkType
+	if NewCableLinkConfigState, NewLinkType, err = handler.GetCableLinkConfigInfo(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "NewCableLinkConfigState"
+	if out[0].Value, err = soap.MarshalString(string(NewCableLinkConfigState)); err != nil {
+		return
+	}
+	out[1].Name = "NewLinkType"
+	if out[1].Value, err = soap.MarshalString(string(NewLinkType)); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANCableLinkConfig1GetDownstreamFrequency(ctx context.Context, handler WANCableLinkConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewDownstreamFrequency uint32
+	if NewDownstreamFrequency, err = handler.GetDownstreamFrequency(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewDownstreamFrequency"
+	if out[0].Value, err = soap.MarshalUi4(NewDownstreamFrequency); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANCableLinkConfig1GetDownstreamModulation(ctx context.Context, handler WANCableLinkConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewDownstreamModulation WANCableLinkConfig1DownstreamModulation
+	if NewDownstreamModulation, err = handler.GetDownstreamModulation(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewDownstreamModulation"
+	if out[0].Value, err = soap.MarshalString(string(NewDownstreamModulation)); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANCableLinkConfig1GetUpstreamFrequency(ctx context.Context, handler WANCableLinkConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewUpstreamFrequency uint32
+	if NewUpstreamFrequency, err = handler.GetUpstreamFrequency(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewUpstreamFrequency"
+	if out[0].Value, err = soap.MarshalUi4(NewUpstreamFrequency); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANCableLinkConfig1GetUpstreamModulation(ctx context.Context, handler WANCableLinkConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewUpstreamModulation WANCableLinkConfig1UpstreamModulation
+	if NewUpstreamModulation, err = handler.GetUpstreamModulation(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewUpstreamModulation"
+	if out[0].Value, err = soap.MarshalString(string(NewUpstreamModulation)); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANCableLinkConfig1GetUpstreamChannelID(ctx context.Context, handler WANCableLinkConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewUpstreamChannelID uint32
+	if NewUpstreamChannelID, err = handler.GetUpstreamChannelID(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewUpstreamChannelID"
+	if out[0].Value, err = soap.MarshalUi4(NewUpstreamChannelID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANCableLinkConfig1GetUpstreamPowerLevel(ctx context.Context, handler WANCableLinkConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewUpstreamPowerLevel uint32
+	if NewUpstreamPowerLevel, err = handler.GetUpstreamPowerLevel(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewUpstreamPowerLevel"
+	if out[0].Value, err = soap.MarshalUi4(NewUpstreamPowerLevel); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANCableLinkConfig1GetBPIEncryptionEnabled(ctx context.Context, handler WANCableLinkConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewBPIEncryptionEnabled bool
+	if NewBPIEncryptionEnabled, err = handler.GetBPIEncryptionEnabled(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewBPIEncryptionEnabled"
+	if out[0].Value, err = soap.MarshalBoolean(NewBPIEncryptionEnabled); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANCableLinkConfig1GetConfigFile(ctx context.Context, handler WANCableLinkConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewConfigFile string
+	if NewConfigFile, err = handler.GetConfigFile(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewConfigFile"
+	if out[0].Value, err = soap.MarshalString(NewConfigFile); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANCableLinkConfig1GetTFTPServer(ctx context.Context, handler WANCableLinkConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewTFTPServer string
+	if NewTFTPServer, err = handler.GetTFTPServer(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewTFTPServer"
+	if out[0].Value, err = soap.MarshalString(NewTFTPServer); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+// WANCommonInterfaceConfig1Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:schemas-upnp-org:service:WANCommonInterfaceConfig:1". See RegisterWANCommonInterfaceConfig1Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type WANCommonInterfaceConfig1Handler interface {
+	SetEnabledForInternet(ctx context.Context, NewEnabledForInternet bool) (err error)
+
+	GetEnabledForInternet(ctx context.Context) (NewEnabledForInternet bool, err error)
+
+	GetCommonLinkProperties(ctx context.Context) (NewWANAccessType WANCommonInterfaceConfig1WANAccessType, NewLayer1UpstreamMaxBitRate uint32, NewLayer1DownstreamMaxBitRate uint32, NewPhysicalLinkStatus WANCommonInterfaceConfig1PhysicalLinkStatus, err error)
+
+	GetWANAccessProvider(ctx context.Context) (NewWANAccessProvider string, err error)
+
+	GetMaximumActiveConnections(ctx context.Context) (NewMaximumActiveConnections uint16, err error)
+
+	GetTotalBytesSent(ctx context.Context) (NewTotalBytesSent uint32, err error)
+
+	GetTotalBytesReceived(ctx context.Context) (NewTotalBytesReceived uint32, err error)
+
+	GetTotalPacketsSent(ctx context.Context) (NewTotalPacketsSent uint32, err error)
+
+	GetTotalPacketsReceived(ctx context.Context) (NewTotalPacketsReceived uint32, err error)
+
+	GetActiveConnection(ctx context.Context, NewActiveConnectionIndex uint16) (NewActiveConnDeviceContainer string, NewActiveConnectionServiceID string, err error)
+}
+
+// RegisterWANCommonInterfaceConfig1Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_WANCommonInterfaceConfig_1.
+func RegisterWANCommonInterfaceConfig1Handler(svc *device.Service, handler WANCommonInterfaceConfig1Handler) {
+	svc.HandleFunc("SetEnabledForInternet", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANCommonInterfaceConfig1SetEnabledForInternet(ctx, handler, in)
+	})
+	svc.HandleFunc("GetEnabledForInternet", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANCommonInterfaceConfig1GetEnabledForInternet(ctx, handler, in)
+	})
+	svc.HandleFunc("GetCommonLinkProperties", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANCommonInterfaceConfig1GetCommonLinkProperties(ctx, handler, in)
+	})
+	svc.HandleFunc("GetWANAccessProvider", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANCommonInterfaceConfig1GetWANAccessProvider(ctx, handler, in)
+	})
+	svc.HandleFunc("GetMaximumActiveConnections", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANCommonInterfaceConfig1GetMaximumActiveConnections(ctx, handler, in)
+	})
+	svc.HandleFunc("GetTotalBytesSent", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANCommonInterfaceConfig1GetTotalBytesSent(ctx, handler, in)
+	})
+	svc.HandleFunc("GetTotalBytesReceived", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANCommonInterfaceConfig1GetTotalBytesReceived(ctx, handler, in)
+	})
+	svc.HandleFunc("GetTotalPacketsSent", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANCommonInterfaceConfig1GetTotalPacketsSent(ctx, handler, in)
+	})
+	svc.HandleFunc("GetTotalPacketsReceived", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANCommonInterfaceConfig1GetTotalPacketsReceived(ctx, handler, in)
+	})
+	svc.HandleFunc("GetActiveConnection", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANCommonInterfaceConfig1GetActiveConnection(ctx, handler, in)
+	})
+}
+
+func serveWANCommonInterfaceConfig1SetEnabledForInternet(ctx context.Context, handler WANCommonInterfaceConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewEnabledForInternet bool
+	if value, err = soap.FindArg(in, "NewEnabledForInternet"); err != nil {
+		return
+	}
+	if NewEnabledForInternet, err = soap.UnmarshalBoolean(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewEnabledForInternet: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetEnabledForInternet(ctx, NewEnabledForInternet); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANCommonInterfaceConfig1GetEnabledForInternet(ctx context.Context, handler WANCommonInterfaceConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewEnabledForInternet bool
+	if NewEnabledForInternet, err = handler.GetEnabledForInternet(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewEnabledForInternet"
+	if out[0].Value, err = soap.MarshalBoolean(NewEnabledForInternet); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANCommonInterfaceConfig1GetCommonLinkProperties(ctx context.Context, handler WANCommonInterfaceConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewWANAccessType WANCommonInterfaceConfig1WANAccessType
+	var NewLayer1UpstreamMaxBitRate uint32
+	var NewLayer1DownstreamMaxBitRate uint32
+	var NewPhysicalLinkStatus WANCommonInterfaceConfig1PhysicalLinkStatus
+	if NewWANAccessType, NewLayer1UpstreamMaxBitRate, NewLayer1DownstreamMaxBitRate, NewPhysicalLinkStatus, err = handler.GetCommonLinkProperties(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 4)
+
+	out[0].Name = "NewWANAccessType"
+	if out[0].Value, err = soap.MarshalString(string(NewWANAccessType)); err != nil {
+		return
+	}
+	out[1].Name = "NewLayer1UpstreamMaxBitRate"
+	if out[1].Value, err = soap.MarshalUi4(NewLayer1UpstreamMaxBitRate); err != nil {
+		return
+	}
+	out[2].Name = "NewLayer1DownstreamMaxBitRate"
+	if out[2].Value, err = soap.MarshalUi4(NewLayer1DownstreamMaxBitRate); err != nil {
+		return
+	}
+	out[3].Name = "NewPhysicalLinkStatus"
+	if out[3].Value, err = soap.MarshalString(string(NewPhysicalLinkStatus)); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANCommonInterfaceConfig1GetWANAccessProvider(ctx context.Context, handler WANCommonInterfaceConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewWANAccessProvider string
+	if NewWANAccessProvider, err = handler.GetWANAccessProvider(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewWANAccessProvider"
+	if out[0].Value, err = soap.MarshalString(NewWANAccessProvider); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANCommonInterfaceConfig1GetMaximumActiveConnections(ctx context.Context, handler WANCommonInterfaceConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewMaximumActiveConnections uint16
+	if NewMaximumActiveConnections, err = handler.GetMaximumActiveConnections(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewMaximumActiveConnections"
+	if out[0].Value, err = soap.MarshalUi2(NewMaximumActiveConnections); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANCommonInterfaceConfig1GetTotalBytesSent(ctx context.Context, handler WANCommonInterfaceConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewTotalBytesSent uint32
+	if NewTotalBytesSent, err = handler.GetTotalBytesSent(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewTotalBytesSent"
+	if out[0].Value, err = soap.MarshalUi4(NewTotalBytesSent); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANCommonInterfaceConfig1GetTotalBytesReceived(ctx context.Context, handler WANCommonInterfaceConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewTotalBytesReceived uint32
+	if NewTotalBytesReceived, err = handler.GetTotalBytesReceived(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewTotalBytesReceived"
+	if out[0].Value, err = soap.MarshalUi4(NewTotalBytesReceived); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANCommonInterfaceConfig1GetTotalPacketsSent(ctx context.Context, handler WANCommonInterfaceConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewTotalPacketsSent uint32
+	if NewTotalPacketsSent, err = handler.GetTotalPacketsSent(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewTotalPacketsSent"
+	if out[0].Value, err = soap.MarshalUi4(NewTotalPacketsSent); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANCommonInterfaceConfig1GetTotalPacketsReceived(ctx context.Context, handler WANCommonInterfaceConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewTotalPacketsReceived uint32
+	if NewTotalPacketsReceived, err = handler.GetTotalPacketsReceived(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewTotalPacketsReceived"
+	if out[0].Value, err = soap.MarshalUi4(NewTotalPacketsReceived); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANCommonInterfaceConfig1GetActiveConnection(ctx context.Context, handler WANCommonInterfaceConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewActiveConnectionIndex uint16
+	if value, err = soap.FindArg(in, "NewActiveConnectionIndex"); err != nil {
+		return
+	}
+	if NewActiveConnectionIndex, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewActiveConnectionIndex: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewActiveConnDeviceContainer string
+	var NewActiveConnectionServiceID string
+	if NewActiveConnDeviceContainer, NewActiveConnectionServiceID, err = handler.GetActiveConnection(ctx, NewActiveConnectionIndex); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "NewActiveConnDeviceContainer"
+	if out[0].Value, err = soap.MarshalString(NewActiveConnDeviceContainer); err != nil {
+		return
+	}
+	out[1].Name = "NewActiveConnectionServiceID"
+	if out[1].Value, err = soap.MarshalString(NewActiveConnectionServiceID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+// WANDSLLinkConfig1Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:schemas-upnp-org:service:WANDSLLinkConfig:1". See RegisterWANDSLLinkConfig1Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type WANDSLLinkConfig1Handler interface {
+	SetDSLLinkType(ctx context.Context, NewLinkType WANDSLLinkConfig1LinkType) (err error)
+
+	GetDSLLinkInfo(ctx context.Context) (NewLinkType WANDSLLinkConfig1LinkType, NewLinkStatus WANDSLLinkConfig1LinkStatus, err error)
+
+	GetAutoConfig(ctx context.Context) (NewAutoConfig bool, err error)
+
+	GetModulationType(ctx context.Context) (NewModulationType WANDSLLinkConfig1ModulationType, err error)
+
+	SetDestinationAddress(ctx context.Context, NewDestinationAddress string) (err error)
+
+	GetDestinationAddress(ctx context.Context) (NewDestinationAddress string, err error)
+
+	SetATMEncapsulation(ctx context.Context, NewATMEncapsulation WANDSLLinkConfig1ATMEncapsulation) (err error)
+
+	GetATMEncapsulation(ctx context.Context) (NewATMEncapsulation WANDSLLinkConfig1ATMEncapsulation, err error)
+
+	SetFCSPreserved(ctx context.Context, NewFCSPreserved bool) (err error)
+
+	GetFCSPreserved(ctx context.Context) (NewFCSPreserved bool, err error)
+}
+
+// RegisterWANDSLLinkConfig1Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_WANDSLLinkConfig_1.
+func RegisterWANDSLLinkConfig1Handler(svc *device.Service, handler WANDSLLinkConfig1Handler) {
+	svc.HandleFunc("SetDSLLinkType", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANDSLLinkConfig1SetDSLLinkType(ctx, handler, in)
+	})
+	svc.HandleFunc("GetDSLLinkInfo", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANDSLLinkConfig1GetDSLLinkInfo(ctx, handler, in)
+	})
+	svc.HandleFunc("GetAutoConfig", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANDSLLinkConfig1GetAutoConfig(ctx, handler, in)
+	})
+	svc.HandleFunc("GetModulationType", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANDSLLinkConfig1GetModulationType(ctx, handler, in)
+	})
+	svc.HandleFunc("SetDestinationAddress", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANDSLLinkConfig1SetDestinationAddress(ctx, handler, in)
+	})
+	svc.HandleFunc("GetDestinationAddress", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANDSLLinkConfig1GetDestinationAddress(ctx, handler, in)
+	})
+	svc.HandleFunc("SetATMEncapsulation", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANDSLLinkConfig1SetATMEncapsulation(ctx, handler, in)
+	})
+	svc.HandleFunc("GetATMEncapsulation", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANDSLLinkConfig1GetATMEncapsulation(ctx, handler, in)
+	})
+	svc.HandleFunc("SetFCSPreserved", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANDSLLinkConfig1SetFCSPreserved(ctx, handler, in)
+	})
+	svc.HandleFunc("GetFCSPreserved", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANDSLLinkConfig1GetFCSPreserved(ctx, handler, in)
+	})
+}
+
+func serveWANDSLLinkConfig1SetDSLLinkType(ctx context.Context, handler WANDSLLinkConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewLinkType WANDSLLinkConfig1LinkType
+	if value, err = soap.FindArg(in, "NewLinkType"); err != nil {
+		return
+	}
+	NewLinkType = WANDSLLinkConfig1LinkType(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetDSLLinkType(ctx, NewLinkType); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANDSLLinkConfig1GetDSLLinkInfo(ctx context.Context, handler WANDSLLinkConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewLinkType WANDSLLinkConfig1LinkType
+	var NewLinkStatus WANDSLLinkConfig1LinkStatus
+	if NewLinkType, NewLinkStatus, err = handler.GetDSLLinkInfo(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "NewLinkType"
+	if out[0].Value, err = soap.MarshalString(string(NewLinkType)); err != nil {
+		return
+	}
+	out[1].Name = "NewLinkStatus"
+	if out[1].Value, err = soap.MarshalString(string(NewLinkStatus)); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANDSLLinkConfig1GetAutoConfig(ctx context.Context, handler WANDSLLinkConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewAutoConfig bool
+	if NewAutoConfig, err = handler.GetAutoConfig(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewAutoConfig"
+	if out[0].Value, err = soap.MarshalBoolean(NewAutoConfig); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANDSLLinkConfig1GetModulationType(ctx context.Context, handler WANDSLLinkConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewModulationType WANDSLLinkConfig1ModulationType
+	if NewModulationType, err = handler.GetModulationType(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewModulationType"
+	if out[0].Value, err = soap.MarshalString(string(NewModulationType)); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANDSLLinkConfig1SetDestinationAddress(ctx context.Context, handler WANDSLLinkConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewDestinationAddress string
+	if value, err = soap.FindArg(in, "NewDestinationAddress"); err != nil {
+		return
+	}
+	if NewDestinationAddress, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewDestinationAddress: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetDestinationAddress(ctx, NewDestinationAddress); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANDSLLinkConfig1GetDestinationAddress(ctx context.Context, handler WANDSLLinkConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewDestinationAddress string
+	if NewDestinationAddress, err = handler.GetDestinationAddress(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewDestinationAddress"
+	if out[0].Value, err = soap.MarshalString(NewDestinationAddress); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANDSLLinkConfig1SetATMEncapsulation(ctx context.Context, handler WANDSLLinkConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewATMEncapsulation WANDSLLinkConfig1ATMEncapsulation
+	if value, err = soap.FindArg(in, "NewATMEncapsulation"); err != nil {
+		return
+	}
+	NewATMEncapsulation = WANDSLLinkConfig1ATMEncapsulation(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetATMEncapsulation(ctx, NewATMEncapsulation); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANDSLLinkConfig1GetATMEncapsulation(ctx context.Context, handler WANDSLLinkConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewATMEncapsulation WANDSLLinkConfig1ATMEncapsulation
+	if NewATMEncapsulation, err = handler.GetATMEncapsulation(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewATMEncapsulation"
+	if out[0].Value, err = soap.MarshalString(string(NewATMEncapsulation)); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANDSLLinkConfig1SetFCSPreserved(ctx context.Context, handler WANDSLLinkConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewFCSPreserved bool
+	if value, err = soap.FindArg(in, "NewFCSPreserved"); err != nil {
+		return
+	}
+	if NewFCSPreserved, err = soap.UnmarshalBoolean(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewFCSPreserved: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetFCSPreserved(ctx, NewFCSPreserved); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANDSLLinkConfig1GetFCSPreserved(ctx context.Context, handler WANDSLLinkConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewFCSPreserved bool
+	if NewFCSPreserved, err = handler.GetFCSPreserved(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewFCSPreserved"
+	if out[0].Value, err = soap.MarshalBoolean(NewFCSPreserved); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+// WANEthernetLinkConfig1Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:schemas-upnp-org:service:WANEthernetLinkConfig:1". See RegisterWANEthernetLinkConfig1Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type WANEthernetLinkConfig1Handler interface {
+	GetEthernetLinkStatus(ctx context.Context) (NewEthernetLinkStatus WANEthernetLinkConfig1EthernetLinkStatus, err error)
+}
+
+// RegisterWANEthernetLinkConfig1Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_WANEthernetLinkConfig_1.
+func RegisterWANEthernetLinkConfig1Handler(svc *device.Service, handler WANEthernetLinkConfig1Handler) {
+	svc.HandleFunc("GetEthernetLinkStatus", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANEthernetLinkConfig1GetEthernetLinkStatus(ctx, handler, in)
+	})
+}
+
+func serveWANEthernetLinkConfig1GetEthernetLinkStatus(ctx context.Context, handler WANEthernetLinkConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewEthernetLinkStatus WANEthernetLinkConfig1EthernetLinkStatus
+	if NewEthernetLinkStatus, err = handler.GetEthernetLinkStatus(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewEthernetLinkStatus"
+	if out[0].Value, err = soap.MarshalString(string(NewEthernetLinkStatus)); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+// WANIPConnection1Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:schemas-upnp-org:service:WANIPConnection:1". See RegisterWANIPConnection1Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type WANIPConnection1Handler interface {
+	SetConnectionType(ctx context.Context, NewConnectionType string) (err error)
+
+	GetConnectionTypeInfo(ctx context.Context) (NewConnectionType string, NewPossibleConnectionTypes WANIPConnection1PossibleConnectionTypes, err error)
+
+	RequestConnection(ctx context.Context) (err error)
+
+	RequestTermination(ctx context.Context) (err error)
+
+	ForceTermination(ctx context.Context) (err error)
+
+	SetAutoDisconnectTime(ctx context.Context, NewAutoDisconnectTime uint32) (err error)
+
+	SetIdleDisconnectTime(ctx context.Context, NewIdleDisconnectTime uint32) (err error)
+
+	SetWarnDisconnectDelay(ctx context.Context, NewWarnDisconnectDelay uint32) (err error)
+
+	GetStatusInfo(ctx context.Context) (NewConnectionStatus WANIPConnection1ConnectionStatus, NewLastConnectionError WANIPConnection1LastConnectionError, NewUptime uint32, err error)
+
+	GetAutoDisconnectTime(ctx context.Context) (NewAutoDisconnectTime uint32, err error)
+
+	GetIdleDisconnectTime(ctx context.Context) (NewIdleDisconnectTime uint32, err error)
+
+	GetWarnDisconnectDelay(ctx context.Context) (NewWarnDisconnectDelay uint32, err error)
+
+	GetNATRSIPStatus(ctx context.Context) (NewRSIPAvailable bool, NewNATEnabled bool, err error)
+
+	GetGenericPortMappingEntry(ctx context.Context, NewPortMappingIndex uint16) (NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection1PortMappingProtocol, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32, err error)
+
+	GetSpecificPortMappingEntry(ctx context.Context, NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection1PortMappingProtocol) (NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32, err error)
+
+	AddPortMapping(ctx context.Context, NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection1PortMappingProtocol, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32) (err error)
+
+	DeletePortMapping(ctx context.Context, NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection1PortMappingProtocol) (err error)
+
+	GetExternalIPAddress(ctx context.Context) (NewExternalIPAddress string, err error)
+}
+
+// RegisterWANIPConnection1Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_WANIPConnection_1.
+func RegisterWANIPConnection1Handler(svc *device.Service, handler WANIPConnection1Handler) {
+	svc.HandleFunc("SetConnectionType", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANIPConnection1SetConnectionType(ctx, handler, in)
+	})
+	svc.HandleFunc("GetConnectionTypeInfo", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANIPConnection1GetConnectionTypeInfo(ctx, handler, in)
+	})
+	svc.HandleFunc("RequestConnection", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANIPConnection1RequestConnection(ctx, handler, in)
+	})
+	svc.HandleFunc("RequestTermination", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANIPConnection1RequestTermination(ctx, handler, in)
+	})
+	svc.HandleFunc("ForceTermination", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANIPConnection1ForceTermination(ctx, handler, in)
+	})
+	svc.HandleFunc("SetAutoDisconnectTime", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANIPConnection1SetAutoDisconnectTime(ctx, handler, in)
+	})
+	svc.HandleFunc("SetIdleDisconnectTime", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANIPConnection1SetIdleDisconnectTime(ctx, handler, in)
+	})
+	svc.HandleFunc("SetWarnDisconnectDelay", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANIPConnection1SetWarnDisconnectDelay(ctx, handler, in)
+	})
+	svc.HandleFunc("GetStatusInfo", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANIPConnection1GetStatusInfo(ctx, handler, in)
+	})
+	svc.HandleFunc("GetAutoDisconnectTime", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANIPConnection1GetAutoDisconnectTime(ctx, handler, in)
+	})
+	svc.HandleFunc("GetIdleDisconnectTime", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANIPConnection1GetIdleDisconnectTime(ctx, handler, in)
+	})
+	svc.HandleFunc("GetWarnDisconnectDelay", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANIPConnection1GetWarnDisconnectDelay(ctx, handler, in)
+	})
+	svc.HandleFunc("GetNATRSIPStatus", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANIPConnection1GetNATRSIPStatus(ctx, handler, in)
+	})
+	svc.HandleFunc("GetGenericPortMappingEntry", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANIPConnection1GetGenericPortMappingEntry(ctx, handler, in)
+	})
+	svc.HandleFunc("GetSpecificPortMappingEntry", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANIPConnection1GetSpecificPortMappingEntry(ctx, handler, in)
+	})
+	svc.HandleFunc("AddPortMapping", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANIPConnection1AddPortMapping(ctx, handler, in)
+	})
+	svc.HandleFunc("DeletePortMapping", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANIPConnection1DeletePortMapping(ctx, handler, in)
+	})
+	svc.HandleFunc("GetExternalIPAddress", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANIPConnection1GetExternalIPAddress(ctx, handler, in)
+	})
+}
+
+func serveWANIPConnection1SetConnectionType(ctx context.Context, handler WANIPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewConnectionType string
+	if value, err = soap.FindArg(in, "NewConnectionType"); err != nil {
+		return
+	}
+	if NewConnectionType, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewConnectionType: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetConnectionType(ctx, NewConnectionType); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANIPConnection1GetConnectionTypeInfo(ctx context.Context, handler WANIPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewConnectionType string
+	var NewPossibleConnectionTypes WANIPConnection1PossibleConnectionTypes
+	if NewConnectionType, NewPossibleConnectionTypes, err = handler.GetConnectionTypeInfo(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "NewConnectionType"
+	if out[0].Value, err = soap.MarshalString(NewConnectionType); err != nil {
+		return
+	}
+	out[1].Name = "NewPossibleConnectionTypes"
+	if out[1].Value, err = soap.MarshalString(string(NewPossibleConnectionTypes)); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANIPConnection1RequestConnection(ctx context.Context, handler WANIPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.RequestConnection(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANIPConnection1RequestTermination(ctx context.Context, handler WANIPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.RequestTermination(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANIPConnection1ForceTermination(ctx context.Context, handler WANIPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.ForceTermination(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANIPConnection1SetAutoDisconnectTime(ctx context.Context, handler WANIPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewAutoDisconnectTime uint32
+	if value, err = soap.FindArg(in, "NewAutoDisconnectTime"); err != nil {
+		return
+	}
+	if NewAutoDisconnectTime, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewAutoDisconnectTime: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetAutoDisconnectTime(ctx, NewAutoDisconnectTime); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANIPConnection1SetIdleDisconnectTime(ctx context.Context, handler WANIPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewIdleDisconnectTime uint32
+	if value, err = soap.FindArg(in, "NewIdleDisconnectTime"); err != nil {
+		return
+	}
+	if NewIdleDisconnectTime, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewIdleDisconnectTime: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetIdleDisconnectTime(ctx, NewIdleDisconnectTime); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANIPConnection1SetWarnDisconnectDelay(ctx context.Context, handler WANIPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewWarnDisconnectDelay uint32
+	if value, err = soap.FindArg(in, "NewWarnDisconnectDelay"); err != nil {
+		return
+	}
+	if NewWarnDisconnectDelay, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewWarnDisconnectDelay: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetWarnDisconnectDelay(ctx, NewWarnDisconnectDelay); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANIPConnection1GetStatusInfo(ctx context.Context, handler WANIPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewConnectionStatus WANIPConnection1ConnectionStatus
+	var NewLastConnectionError WANIPConnection1LastConnectionError
+	var NewUptime uint32
+	if NewConnectionStatus, NewLastConnectionError, NewUptime, err = handler.GetStatusInfo(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 3)
+
+	out[0].Name = "NewConnectionStatus"
+	if out[0].Value, err = soap.MarshalString(string(NewConnectionStatus)); err != nil {
+		return
+	}
+	out[1].Name = "NewLastConnectionError"
+	if out[1].Value, err = soap.MarshalString(string(NewLastConnectionError)); err != nil {
+		return
+	}
+	out[2].Name = "NewUptime"
+	if out[2].Value, err = soap.MarshalUi4(NewUptime); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANIPConnection1GetAutoDisconnectTime(ctx context.Context, handler WANIPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewAutoDisconnectTime uint32
+	if NewAutoDisconnectTime, err = handler.GetAutoDisconnectTime(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewAutoDisconnectTime"
+	if out[0].Value, err = soap.MarshalUi4(NewAutoDisconnectTime); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANIPConnection1GetIdleDisconnectTime(ctx context.Context, handler WANIPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewIdleDisconnectTime uint32
+	if NewIdleDisconnectTime, err = handler.GetIdleDisconnectTime(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewIdleDisconnectTime"
+	if out[0].Value, err = soap.MarshalUi4(NewIdleDisconnectTime); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANIPConnection1GetWarnDisconnectDelay(ctx context.Context, handler WANIPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewWarnDisconnectDelay uint32
+	if NewWarnDisconnectDelay, err = handler.GetWarnDisconnectDelay(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewWarnDisconnectDelay"
+	if out[0].Value, err = soap.MarshalUi4(NewWarnDisconnectDelay); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANIPConnection1GetNATRSIPStatus(ctx context.Context, handler WANIPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewRSIPAvailable bool
+	var NewNATEnabled bool
+	if NewRSIPAvailable, NewNATEnabled, err = handler.GetNATRSIPStatus(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "NewRSIPAvailable"
+	if out[0].Value, err = soap.MarshalBoolean(NewRSIPAvailable); err != nil {
+		return
+	}
+	out[1].Name = "NewNATEnabled"
+	if out[1].Value, err = soap.MarshalBoolean(NewNATEnabled); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANIPConnection1GetGenericPortMappingEntry(ctx context.Context, handler WANIPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewPortMappingIndex uint16
+	if value, err = soap.FindArg(in, "NewPortMappingIndex"); err != nil {
+		return
+	}
+	if NewPortMappingIndex, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewPortMappingIndex: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewRemoteHost string
+	var NewExternalPort uint16
+	var NewProtocol WANIPConnection1PortMappingProtocol
+	var NewInternalPort uint16
+	var NewInternalClient string
+	var NewEnabled bool
+	var NewPortMappingDescription string
+	var NewLeaseDuration uint32
+	if NewRemoteHost, NewExternalPort, NewProtocol, NewInternalPort, NewInternalClient, NewEnabled, NewPortMappingDescription, NewLeaseDuration, err = handler.GetGenericPortMappingEntry(ctx, NewPortMappingIndex); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 8)
+
+	out[0].Name = "NewRemoteHost"
+	if out[0].Value, err = soap.MarshalString(NewRemoteHost); err != nil {
+		return
+	}
+	out[1].Name = "NewExternalPort"
+	if out[1].Value, err = soap.MarshalUi2(NewExternalPort); err != nil {
+		return
+	}
+	out[2].Name = "NewProtocol"
+	if out[2].Value, err = soap.MarshalString(string(NewProtocol)); err != nil {
+		return
+	}
+	out[3].Name = "NewInternalPort"
+	if out[3].Value, err = soap.MarshalUi2(NewInternalPort); err != nil {
+		return
+	}
+	out[4].Name = "NewInternalClient"
+	if out[4].Value, err = soap.MarshalString(NewInternalClient); err != nil {
+		return
+	}
+	out[5].Name = "NewEnabled"
+	if out[5].Value, err = soap.MarshalBoolean(NewEnabled); err != nil {
+		return
+	}
+	out[6].Name = "NewPortMappingDescription"
+	if out[6].Value, err = soap.MarshalString(NewPortMappingDescription); err != nil {
+		return
+	}
+	out[7].Name = "NewLeaseDuration"
+	if out[7].Value, err = soap.MarshalUi4(NewLeaseDuration); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANIPConnection1GetSpecificPortMappingEntry(ctx context.Context, handler WANIPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewRemoteHost string
+	if value, err = soap.FindArg(in, "NewRemoteHost"); err != nil {
+		return
+	}
+	if NewRemoteHost, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewRemoteHost: "+err.Error())
+	}
+	var NewExternalPort uint16
+	if value, err = soap.FindArg(in, "NewExternalPort"); err != nil {
+		return
+	}
+	if NewExternalPort, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewExternalPort: "+err.Error())
+	}
+	var NewProtocol WANIPConnection1PortMappingProtocol
+	if value, err = soap.FindArg(in, "NewProtocol"); err != nil {
+		return
+	}
+	NewProtocol = WANIPConnection1PortMappingProtocol(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewInternalPort uint16
+	var NewInternalClient string
+	var NewEnabled bool
+	var NewPortMappingDescription string
+	var NewLeaseDuration uint32
+	if NewInternalPort, NewInternalClient, NewEnabled, NewPortMappingDescription, NewLeaseDuration, err = handler.GetSpecificPortMappingEntry(ctx, NewRemoteHost, NewExternalPort, NewProtocol); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 5)
+
+	out[0].Name = "NewInternalPort"
+	if out[0].Value, err = soap.MarshalUi2(NewInternalPort); err != nil {
+		return
+	}
+	out[1].Name = "NewInternalClient"
+	if out[1].Value, err = soap.MarshalString(NewInternalClient); err != nil {
+		return
+	}
+	out[2].Name = "NewEnabled"
+	if out[2].Value, err = soap.MarshalBoolean(NewEnabled); err != nil {
+		return
+	}
+	out[3].Name = "NewPortMappingDescription"
+	if out[3].Value, err = soap.MarshalString(NewPortMappingDescription); err != nil {
+		return
+	}
+	out[4].Name = "NewLeaseDuration"
+	if out[4].Value, err = soap.MarshalUi4(NewLeaseDuration); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANIPConnection1AddPortMapping(ctx context.Context, handler WANIPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewRemoteHost string
+	if value, err = soap.FindArg(in, "NewRemoteHost"); err != nil {
+		return
+	}
+	if NewRemoteHost, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewRemoteHost: "+err.Error())
+	}
+	var NewExternalPort uint16
+	if value, err = soap.FindArg(in, "NewExternalPort"); err != nil {
+		return
+	}
+	if NewExternalPort, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewExternalPort: "+err.Error())
+	}
+	var NewProtocol WANIPConnection1PortMappingProtocol
+	if value, err = soap.FindArg(in, "NewProtocol"); err != nil {
+		return
+	}
+	NewProtocol = WANIPConnection1PortMappingProtocol(value)
+	var NewInternalPort uint16
+	if value, err = soap.FindArg(in, "NewInternalPort"); err != nil {
+		return
+	}
+	if NewInternalPort, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewInternalPort: "+err.Error())
+	}
+	var NewInternalClient string
+	if value, err = soap.FindArg(in, "NewInternalClient"); err != nil {
+		return
+	}
+	if NewInternalClient, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewInternalClient: "+err.Error())
+	}
+	var NewEnabled bool
+	if value, err = soap.FindArg(in, "NewEnabled"); err != nil {
+		return
+	}
+	if NewEnabled, err = soap.UnmarshalBoolean(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewEnabled: "+err.Error())
+	}
+	var NewPortMappingDescription string
+	if value, err = soap.FindArg(in, "NewPortMappingDescription"); err != nil {
+		return
+	}
+	if NewPortMappingDescription, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewPortMappingDescription: "+err.Error())
+	}
+	var NewLeaseDuration uint32
+	if value, err = soap.FindArg(in, "NewLeaseDuration"); err != nil {
+		return
+	}
+	if NewLeaseDuration, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewLeaseDuration: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.AddPortMapping(ctx, NewRemoteHost, NewExternalPort, NewProtocol, NewInternalPort, NewInternalClient, NewEnabled, NewPortMappingDescription, NewLeaseDuration); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANIPConnection1DeletePortMapping(ctx context.Context, handler WANIPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewRemoteHost string
+	if value, err = soap.FindArg(in, "NewRemoteHost"); err != nil {
+		return
+	}
+	if NewRemoteHost, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewRemoteHost: "+err.Error())
+	}
+	var NewExternalPort uint16
+	if value, err = soap.FindArg(in, "NewExternalPort"); err != nil {
+		return
+	}
+	if NewExternalPort, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewExternalPort: "+err.Error())
+	}
+	var NewProtocol WANIPConnection1PortMappingProtocol
+	if value, err = soap.FindArg(in, "NewProtocol"); err != nil {
+		return
+	}
+	NewProtocol = WANIPConnection1PortMappingProtocol(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.DeletePortMapping(ctx, NewRemoteHost, NewExternalPort, NewProtocol); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANIPConnection1GetExternalIPAddress(ctx context.Context, handler WANIPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewExternalIPAddress string
+	if NewExternalIPAddress, err = handler.GetExternalIPAddress(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewExternalIPAddress"
+	if out[0].Value, err = soap.MarshalString(NewExternalIPAddress); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+// WANPOTSLinkConfig1Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:schemas-upnp-org:service:WANPOTSLinkConfig:1". See RegisterWANPOTSLinkConfig1Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type WANPOTSLinkConfig1Handler interface {
+	SetISPInfo(ctx context.Context, NewISPPhoneNumber string, NewISPInfo string, NewLinkType WANPOTSLinkConfig1LinkType) (err error)
+
+	SetCallRetryInfo(ctx context.Context, NewNumberOfRetries uint32, NewDelayBetweenRetries uint32) (err error)
+
+	GetISPInfo(ctx context.Context) (NewISPPhoneNumber string, NewISPInfo string, NewLinkType WANPOTSLinkConfig1LinkType, err error)
+
+	GetCallRetryInfo(ctx context.Context) (NewNumberOfRetries uint32, NewDelayBetweenRetries uint32, err error)
+
+	GetFclass(ctx context.Context) (NewFclass string, err error)
+
+	GetDataModulationSupported(ctx context.Context) (NewDataModulationSupported string, err error)
+
+	GetDataProtocol(ctx context.Context) (NewDataProtocol string, err error)
+
+	GetDataCompression(ctx context.Context) (NewDataCompression string, err error)
+
+	GetPlusVTRCommandSupported(ctx context.Context) (NewPlusVTRCommandSupported bool, err error)
+}
+
+// RegisterWANPOTSLinkConfig1Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_WANPOTSLinkConfig_1.
+func RegisterWANPOTSLinkConfig1Handler(svc *device.Service, handler WANPOTSLinkConfig1Handler) {
+	svc.HandleFunc("SetISPInfo", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANPOTSLinkConfig1SetISPInfo(ctx, handler, in)
+	})
+	svc.HandleFunc("SetCallRetryInfo", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANPOTSLinkConfig1SetCallRetryInfo(ctx, handler, in)
+	})
+	svc.HandleFunc("GetISPInfo", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANPOTSLinkConfig1GetISPInfo(ctx, handler, in)
+	})
+	svc.HandleFunc("GetCallRetryInfo", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANPOTSLinkConfig1GetCallRetryInfo(ctx, handler, in)
+	})
+	svc.HandleFunc("GetFclass", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANPOTSLinkConfig1GetFclass(ctx, handler, in)
+	})
+	svc.HandleFunc("GetDataModulationSupported", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANPOTSLinkConfig1GetDataModulationSupported(ctx, handler, in)
+	})
+	svc.HandleFunc("GetDataProtocol", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANPOTSLinkConfig1GetDataProtocol(ctx, handler, in)
+	})
+	svc.HandleFunc("GetDataCompression", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANPOTSLinkConfig1GetDataCompression(ctx, handler, in)
+	})
+	svc.HandleFunc("GetPlusVTRCommandSupported", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANPOTSLinkConfig1GetPlusVTRCommandSupported(ctx, handler, in)
+	})
+}
+
+func serveWANPOTSLinkConfig1SetISPInfo(ctx context.Context, handler WANPOTSLinkConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewISPPhoneNumber string
+	if value, err = soap.FindArg(in, "NewISPPhoneNumber"); err != nil {
+		return
+	}
+	if NewISPPhoneNumber, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewISPPhoneNumber: "+err.Error())
+	}
+	var NewISPInfo string
+	if value, err = soap.FindArg(in, "NewISPInfo"); err != nil {
+		return
+	}
+	if NewISPInfo, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewISPInfo: "+err.Error())
+	}
+	var NewLinkType WANPOTSLinkConfig1LinkType
+	if value, err = soap.FindArg(in, "NewLinkType"); err != nil {
+		return
+	}
+	NewLinkType = WANPOTSLinkConfig1LinkType(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetISPInfo(ctx, NewISPPhoneNumber, NewISPInfo, NewLinkType); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANPOTSLinkConfig1SetCallRetryInfo(ctx context.Context, handler WANPOTSLinkConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewNumberOfRetries uint32
+	if value, err = soap.FindArg(in, "NewNumberOfRetries"); err != nil {
+		return
+	}
+	if NewNumberOfRetries, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewNumberOfRetries: "+err.Error())
+	}
+	var NewDelayBetweenRetries uint32
+	if value, err = soap.FindArg(in, "NewDelayBetweenRetries"); err != nil {
+		return
+	}
+	if NewDelayBetweenRetries, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewDelayBetweenRetries: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetCallRetryInfo(ctx, NewNumberOfRetries, NewDelayBetweenRetries); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANPOTSLinkConfig1GetISPInfo(ctx context.Context, handler WANPOTSLinkConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewISPPhoneNumber string
+	var NewISPInfo string
+	var NewLinkType WANPOTSLinkConfig1LinkType
+	if NewISPPhoneNumber, NewISPInfo, NewLinkType, err = handler.GetISPInfo(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 3)
+
+	out[0].Name = "NewISPPhoneNumber"
+	if out[0].Value, err = soap.MarshalString(NewISPPhoneNumber); err != nil {
+		return
+	}
+	out[1].Name = "NewISPInfo"
+	if out[1].Value, err = soap.MarshalString(NewISPInfo); err != nil {
+		return
+	}
+	out[2].Name = "NewLinkType"
+	if out[2].Value, err = soap.MarshalString(string(NewLinkType)); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANPOTSLinkConfig1GetCallRetryInfo(ctx context.Context, handler WANPOTSLinkConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewNumberOfRetries uint32
+	var NewDelayBetweenRetries uint32
+	if NewNumberOfRetries, NewDelayBetweenRetries, err = handler.GetCallRetryInfo(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "NewNumberOfRetries"
+	if out[0].Value, err = soap.MarshalUi4(NewNumberOfRetries); err != nil {
+		return
+	}
+	out[1].Name = "NewDelayBetweenRetries"
+	if out[1].Value, err = soap.MarshalUi4(NewDelayBetweenRetries); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANPOTSLinkConfig1GetFclass(ctx context.Context, handler WANPOTSLinkConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewFclass string
+	if NewFclass, err = handler.GetFclass(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewFclass"
+	if out[0].Value, err = soap.MarshalString(NewFclass); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANPOTSLinkConfig1GetDataModulationSupported(ctx context.Context, handler WANPOTSLinkConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewDataModulationSupported string
+	if NewDataModulationSupported, err = handler.GetDataModulationSupported(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewDataModulationSupported"
+	if out[0].Value, err = soap.MarshalString(NewDataModulationSupported); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANPOTSLinkConfig1GetDataProtocol(ctx context.Context, handler WANPOTSLinkConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewDataProtocol string
+	if NewDataProtocol, err = handler.GetDataProtocol(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewDataProtocol"
+	if out[0].Value, err = soap.MarshalString(NewDataProtocol); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANPOTSLinkConfig1GetDataCompression(ctx context.Context, handler WANPOTSLinkConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewDataCompression string
+	if NewDataCompression, err = handler.GetDataCompression(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewDataCompression"
+	if out[0].Value, err = soap.MarshalString(NewDataCompression); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANPOTSLinkConfig1GetPlusVTRCommandSupported(ctx context.Context, handler WANPOTSLinkConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewPlusVTRCommandSupported bool
+	if NewPlusVTRCommandSupported, err = handler.GetPlusVTRCommandSupported(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewPlusVTRCommandSupported"
+	if out[0].Value, err = soap.MarshalBoolean(NewPlusVTRCommandSupported); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+// WANPPPConnection1Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:schemas-upnp-org:service:WANPPPConnection:1". See RegisterWANPPPConnection1Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type WANPPPConnection1Handler interface {
+	SetConnectionType(ctx context.Context, NewConnectionType string) (err error)
+
+	GetConnectionTypeInfo(ctx context.Context) (NewConnectionType string, NewPossibleConnectionTypes WANPPPConnection1PossibleConnectionTypes, err error)
+
+	ConfigureConnection(ctx context.Context, NewUserName string, NewPassword string) (err error)
+
+	RequestConnection(ctx context.Context) (err error)
+
+	RequestTermination(ctx context.Context) (err error)
+
+	ForceTermination(ctx context.Context) (err error)
+
+	SetAutoDisconnectTime(ctx context.Context, NewAutoDisconnectTime uint32) (err error)
+
+	SetIdleDisconnectTime(ctx context.Context, NewIdleDisconnectTime uint32) (err error)
+
+	SetWarnDisconnectDelay(ctx context.Context, NewWarnDisconnectDelay uint32) (err error)
+
+	GetStatusInfo(ctx context.Context) (NewConnectionStatus WANPPPConnection1ConnectionStatus, NewLastConnectionError WANPPPConnection1LastConnectionError, NewUptime uint32, err error)
+
+	GetLinkLayerMaxBitRates(ctx context.Context) (NewUpstreamMaxBitRate uint32, NewDownstreamMaxBitRate uint32, err error)
+
+	GetPPPEncryptionProtocol(ctx context.Context) (NewPPPEncryptionProtocol string, err error)
+
+	GetPPPCompressionProtocol(ctx context.Context) (NewPPPCompressionProtocol string, err error)
+
+	GetPPPAuthenticationProtocol(ctx context.Context) (NewPPPAuthenticationProtocol string, err error)
+
+	GetUserName(ctx context.Context) (NewUserName string, err error)
+
+	GetPassword(ctx context.Context) (NewPassword string, err error)
+
+	GetAutoDisconnectTime(ctx context.Context) (NewAutoDisconnectTime uint32, err error)
+
+	GetIdleDisconnectTime(ctx context.Context) (NewIdleDisconnectTime uint32, err error)
+
+	GetWarnDisconnectDelay(ctx context.Context) (NewWarnDisconnectDelay uint32, err error)
+
+	GetNATRSIPStatus(ctx context.Context) (NewRSIPAvailable bool, NewNATEnabled bool, err error)
+
+	GetGenericPortMappingEntry(ctx context.Context, NewPortMappingIndex uint16) (NewRemoteHost string, NewExternalPort uint16, NewProtocol WANPPPConnection1PortMappingProtocol, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32, err error)
+
+	GetSpecificPortMappingEntry(ctx context.Context, NewRemoteHost string, NewExternalPort uint16, NewProtocol WANPPPConnection1PortMappingProtocol) (NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32, err error)
+
+	AddPortMapping(ctx context.Context, NewRemoteHost string, NewExternalPort uint16, NewProtocol WANPPPConnection1PortMappingProtocol, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32) (err error)
+
+	DeletePortMapping(ctx context.Context, NewRemoteHost string, NewExternalPort uint16, NewProtocol WANPPPConnection1PortMappingProtocol) (err error)
+
+	GetExternalIPAddress(ctx context.Context) (NewExternalIPAddress string, err error)
+}
+
+// RegisterWANPPPConnection1Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_WANPPPConnection_1.
+func RegisterWANPPPConnection1Handler(svc *device.Service, handler WANPPPConnection1Handler) {
+	svc.HandleFunc("SetConnectionType", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANPPPConnection1SetConnectionType(ctx, handler, in)
+	})
+	svc.HandleFunc("GetConnectionTypeInfo", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANPPPConnection1GetConnectionTypeInfo(ctx, handler, in)
+	})
+	svc.HandleFunc("ConfigureConnection", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANPPPConnection1ConfigureConnection(ctx, handler, in)
+	})
+	svc.HandleFunc("RequestConnection", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANPPPConnection1RequestConnection(ctx, handler, in)
+	})
+	svc.HandleFunc("RequestTermination", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANPPPConnection1RequestTermination(ctx, handler, in)
+	})
+	svc.HandleFunc("ForceTermination", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANPPPConnection1ForceTermination(ctx, handler, in)
+	})
+	svc.HandleFunc("SetAutoDisconnectTime", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANPPPConnection1SetAutoDisconnectTime(ctx, handler, in)
+	})
+	svc.HandleFunc("SetIdleDisconnectTime", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANPPPConnection1SetIdleDisconnectTime(ctx, handler, in)
+	})
+	svc.HandleFunc("SetWarnDisconnectDelay", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANPPPConnection1SetWarnDisconnectDelay(ctx, handler, in)
+	})
+	svc.HandleFunc("GetStatusInfo", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANPPPConnection1GetStatusInfo(ctx, handler, in)
+	})
+	svc.HandleFunc("GetLinkLayerMaxBitRates", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANPPPConnection1GetLinkLayerMaxBitRates(ctx, handler, in)
+	})
+	svc.HandleFunc("GetPPPEncryptionProtocol", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANPPPConnection1GetPPPEncryptionProtocol(ctx, handler, in)
+	})
+	svc.HandleFunc("GetPPPCompressionProtocol", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANPPPConnection1GetPPPCompressionProtocol(ctx, handler, in)
+	})
+	svc.HandleFunc("GetPPPAuthenticationProtocol", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANPPPConnection1GetPPPAuthenticationProtocol(ctx, handler, in)
+	})
+	svc.HandleFunc("GetUserName", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANPPPConnection1GetUserName(ctx, handler, in)
+	})
+	svc.HandleFunc("GetPassword", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANPPPConnection1GetPassword(ctx, handler, in)
+	})
+	svc.HandleFunc("GetAutoDisconnectTime", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANPPPConnection1GetAutoDisconnectTime(ctx, handler, in)
+	})
+	svc.HandleFunc("GetIdleDisconnectTime", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANPPPConnection1GetIdleDisconnectTime(ctx, handler, in)
+	})
+	svc.HandleFunc("GetWarnDisconnectDelay", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANPPPConnection1GetWarnDisconnectDelay(ctx, handler, in)
+	})
+	svc.HandleFunc("GetNATRSIPStatus", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANPPPConnection1GetNATRSIPStatus(ctx, handler, in)
+	})
+	svc.HandleFunc("GetGenericPortMappingEntry", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANPPPConnection1GetGenericPortMappingEntry(ctx, handler, in)
+	})
+	svc.HandleFunc("GetSpecificPortMappingEntry", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANPPPConnection1GetSpecificPortMappingEntry(ctx, handler, in)
+	})
+	svc.HandleFunc("AddPortMapping", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANPPPConnection1AddPortMapping(ctx, handler, in)
+	})
+	svc.HandleFunc("DeletePortMapping", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANPPPConnection1DeletePortMapping(ctx, handler, in)
+	})
+	svc.HandleFunc("GetExternalIPAddress", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWANPPPConnection1GetExternalIPAddress(ctx, handler, in)
+	})
+}
+
+func serveWANPPPConnection1SetConnectionType(ctx context.Context, handler WANPPPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewConnectionType string
+	if value, err = soap.FindArg(in, "NewConnectionType"); err != nil {
+		return
+	}
+	if NewConnectionType, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewConnectionType: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetConnectionType(ctx, NewConnectionType); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANPPPConnection1GetConnectionTypeInfo(ctx context.Context, handler WANPPPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewConnectionType string
+	var NewPossibleConnectionTypes WANPPPConnection1PossibleConnectionTypes
+	if NewConnectionType, NewPossibleConnectionTypes, err = handler.GetConnectionTypeInfo(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "NewConnectionType"
+	if out[0].Value, err = soap.MarshalString(NewConnectionType); err != nil {
+		return
+	}
+	out[1].Name = "NewPossibleConnectionTypes"
+	if out[1].Value, err = soap.MarshalString(string(NewPossibleConnectionTypes)); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANPPPConnection1ConfigureConnection(ctx context.Context, handler WANPPPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewUserName string
+	if value, err = soap.FindArg(in, "NewUserName"); err != nil {
+		return
+	}
+	if NewUserName, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewUserName: "+err.Error())
+	}
+	var NewPassword string
+	if value, err = soap.FindArg(in, "NewPassword"); err != nil {
+		return
+	}
+	if NewPassword, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewPassword: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.ConfigureConnection(ctx, NewUserName, NewPassword); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANPPPConnection1RequestConnection(ctx context.Context, handler WANPPPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.RequestConnection(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANPPPConnection1RequestTermination(ctx context.Context, handler WANPPPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.RequestTermination(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANPPPConnection1ForceTermination(ctx context.Context, handler WANPPPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.ForceTermination(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANPPPConnection1SetAutoDisconnectTime(ctx context.Context, handler WANPPPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewAutoDisconnectTime uint32
+	if value, err = soap.FindArg(in, "NewAutoDisconnectTime"); err != nil {
+		return
+	}
+	if NewAutoDisconnectTime, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewAutoDisconnectTime: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetAutoDisconnectTime(ctx, NewAutoDisconnectTime); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANPPPConnection1SetIdleDisconnectTime(ctx context.Context, handler WANPPPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewIdleDisconnectTime uint32
+	if value, err = soap.FindArg(in, "NewIdleDisconnectTime"); err != nil {
+		return
+	}
+	if NewIdleDisconnectTime, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewIdleDisconnectTime: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetIdleDisconnectTime(ctx, NewIdleDisconnectTime); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANPPPConnection1SetWarnDisconnectDelay(ctx context.Context, handler WANPPPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewWarnDisconnectDelay uint32
+	if value, err = soap.FindArg(in, "NewWarnDisconnectDelay"); err != nil {
+		return
+	}
+	if NewWarnDisconnectDelay, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewWarnDisconnectDelay: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetWarnDisconnectDelay(ctx, NewWarnDisconnectDelay); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANPPPConnection1GetStatusInfo(ctx context.Context, handler WANPPPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewConnectionStatus WANPPPConnection1ConnectionStatus
+	var NewLastConnectionError WANPPPConnection1LastConnectionError
+	var NewUptime uint32
+	if NewConnectionStatus, NewLastConnectionError, NewUptime, err = handler.GetStatusInfo(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 3)
+
+	out[0].Name = "NewConnectionStatus"
+	if out[0].Value, err = soap.MarshalString(string(NewConnectionStatus)); err != nil {
+		return
+	}
+	out[1].Name = "NewLastConnectionError"
+	if out[1].Value, err = soap.MarshalString(string(NewLastConnectionError)); err != nil {
+		return
+	}
+	out[2].Name = "NewUptime"
+	if out[2].Value, err = soap.MarshalUi4(NewUptime); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANPPPConnection1GetLinkLayerMaxBitRates(ctx context.Context, handler WANPPPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewUpstreamMaxBitRate uint32
+	var NewDownstreamMaxBitRate uint32
+	if NewUpstreamMaxBitRate, NewDownstreamMaxBitRate, err = handler.GetLinkLayerMaxBitRates(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "NewUpstreamMaxBitRate"
+	if out[0].Value, err = soap.MarshalUi4(NewUpstreamMaxBitRate); err != nil {
+		return
+	}
+	out[1].Name = "NewDownstreamMaxBitRate"
+	if out[1].Value, err = soap.MarshalUi4(NewDownstreamMaxBitRate); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANPPPConnection1GetPPPEncryptionProtocol(ctx context.Context, handler WANPPPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewPPPEncryptionProtocol string
+	if NewPPPEncryptionProtocol, err = handler.GetPPPEncryptionProtocol(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewPPPEncryptionProtocol"
+	if out[0].Value, err = soap.MarshalString(NewPPPEncryptionProtocol); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANPPPConnection1GetPPPCompressionProtocol(ctx context.Context, handler WANPPPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewPPPCompressionProtocol string
+	if NewPPPCompressionProtocol, err = handler.GetPPPCompressionProtocol(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewPPPCompressionProtocol"
+	if out[0].Value, err = soap.MarshalString(NewPPPCompressionProtocol); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANPPPConnection1GetPPPAuthenticationProtocol(ctx context.Context, handler WANPPPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewPPPAuthenticationProtocol string
+	if NewPPPAuthenticationProtocol, err = handler.GetPPPAuthenticationProtocol(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewPPPAuthenticationProtocol"
+	if out[0].Value, err = soap.MarshalString(NewPPPAuthenticationProtocol); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANPPPConnection1GetUserName(ctx context.Context, handler WANPPPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewUserName string
+	if NewUserName, err = handler.GetUserName(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewUserName"
+	if out[0].Value, err = soap.MarshalString(NewUserName); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANPPPConnection1GetPassword(ctx context.Context, handler WANPPPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewPassword string
+	if NewPassword, err = handler.GetPassword(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewPassword"
+	if out[0].Value, err = soap.MarshalString(NewPassword); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANPPPConnection1GetAutoDisconnectTime(ctx context.Context, handler WANPPPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewAutoDisconnectTime uint32
+	if NewAutoDisconnectTime, err = handler.GetAutoDisconnectTime(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewAutoDisconnectTime"
+	if out[0].Value, err = soap.MarshalUi4(NewAutoDisconnectTime); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANPPPConnection1GetIdleDisconnectTime(ctx context.Context, handler WANPPPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewIdleDisconnectTime uint32
+	if NewIdleDisconnectTime, err = handler.GetIdleDisconnectTime(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewIdleDisconnectTime"
+	if out[0].Value, err = soap.MarshalUi4(NewIdleDisconnectTime); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANPPPConnection1GetWarnDisconnectDelay(ctx context.Context, handler WANPPPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewWarnDisconnectDelay uint32
+	if NewWarnDisconnectDelay, err = handler.GetWarnDisconnectDelay(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewWarnDisconnectDelay"
+	if out[0].Value, err = soap.MarshalUi4(NewWarnDisconnectDelay); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANPPPConnection1GetNATRSIPStatus(ctx context.Context, handler WANPPPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewRSIPAvailable bool
+	var NewNATEnabled bool
+	if NewRSIPAvailable, NewNATEnabled, err = handler.GetNATRSIPStatus(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "NewRSIPAvailable"
+	if out[0].Value, err = soap.MarshalBoolean(NewRSIPAvailable); err != nil {
+		return
+	}
+	out[1].Name = "NewNATEnabled"
+	if out[1].Value, err = soap.MarshalBoolean(NewNATEnabled); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANPPPConnection1GetGenericPortMappingEntry(ctx context.Context, handler WANPPPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewPortMappingIndex uint16
+	if value, err = soap.FindArg(in, "NewPortMappingIndex"); err != nil {
+		return
+	}
+	if NewPortMappingIndex, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewPortMappingIndex: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewRemoteHost string
+	var NewExternalPort uint16
+	var NewProtocol WANPPPConnection1PortMappingProtocol
+	var NewInternalPort uint16
+	var NewInternalClient string
+	var NewEnabled bool
+	var NewPortMappingDescription string
+	var NewLeaseDuration uint32
+	if NewRemoteHost, NewExternalPort, NewProtocol, NewInternalPort, NewInternalClient, NewEnabled, NewPortMappingDescription, NewLeaseDuration, err = handler.GetGenericPortMappingEntry(ctx, NewPortMappingIndex); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 8)
+
+	out[0].Name = "NewRemoteHost"
+	if out[0].Value, err = soap.MarshalString(NewRemoteHost); err != nil {
+		return
+	}
+	out[1].Name = "NewExternalPort"
+	if out[1].Value, err = soap.MarshalUi2(NewExternalPort); err != nil {
+		return
+	}
+	out[2].Name = "NewProtocol"
+	if out[2].Value, err = soap.MarshalString(string(NewProtocol)); err != nil {
+		return
+	}
+	out[3].Name = "NewInternalPort"
+	if out[3].Value, err = soap.MarshalUi2(NewInternalPort); err != nil {
+		return
+	}
+	out[4].Name = "NewInternalClient"
+	if out[4].Value, err = soap.MarshalString(NewInternalClient); err != nil {
+		return
+	}
+	out[5].Name = "NewEnabled"
+	if out[5].Value, err = soap.MarshalBoolean(NewEnabled); err != nil {
+		return
+	}
+	out[6].Name = "NewPortMappingDescription"
+	if out[6].Value, err = soap.MarshalString(NewPortMappingDescription); err != nil {
+		return
+	}
+	out[7].Name = "NewLeaseDuration"
+	if out[7].Value, err = soap.MarshalUi4(NewLeaseDuration); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANPPPConnection1GetSpecificPortMappingEntry(ctx context.Context, handler WANPPPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewRemoteHost string
+	if value, err = soap.FindArg(in, "NewRemoteHost"); err != nil {
+		return
+	}
+	if NewRemoteHost, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewRemoteHost: "+err.Error())
+	}
+	var NewExternalPort uint16
+	if value, err = soap.FindArg(in, "NewExternalPort"); err != nil {
+		return
+	}
+	if NewExternalPort, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewExternalPort: "+err.Error())
+	}
+	var NewProtocol WANPPPConnection1PortMappingProtocol
+	if value, err = soap.FindArg(in, "NewProtocol"); err != nil {
+		return
+	}
+	NewProtocol = WANPPPConnection1PortMappingProtocol(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewInternalPort uint16
+	var NewInternalClient string
+	var NewEnabled bool
+	var NewPortMappingDescription string
+	var NewLeaseDuration uint32
+	if NewInternalPort, NewInternalClient, NewEnabled, NewPortMappingDescription, NewLeaseDuration, err = handler.GetSpecificPortMappingEntry(ctx, NewRemoteHost, NewExternalPort, NewProtocol); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 5)
+
+	out[0].Name = "NewInternalPort"
+	if out[0].Value, err = soap.MarshalUi2(NewInternalPort); err != nil {
+		return
+	}
+	out[1].Name = "NewInternalClient"
+	if out[1].Value, err = soap.MarshalString(NewInternalClient); err != nil {
+		return
+	}
+	out[2].Name = "NewEnabled"
+	if out[2].Value, err = soap.MarshalBoolean(NewEnabled); err != nil {
+		return
+	}
+	out[3].Name = "NewPortMappingDescription"
+	if out[3].Value, err = soap.MarshalString(NewPortMappingDescription); err != nil {
+		return
+	}
+	out[4].Name = "NewLeaseDuration"
+	if out[4].Value, err = soap.MarshalUi4(NewLeaseDuration); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANPPPConnection1AddPortMapping(ctx context.Context, handler WANPPPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewRemoteHost string
+	if value, err = soap.FindArg(in, "NewRemoteHost"); err != nil {
+		return
+	}
+	if NewRemoteHost, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewRemoteHost: "+err.Error())
+	}
+	var NewExternalPort uint16
+	if value, err = soap.FindArg(in, "NewExternalPort"); err != nil {
+		return
+	}
+	if NewExternalPort, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewExternalPort: "+err.Error())
+	}
+	var NewProtocol WANPPPConnection1PortMappingProtocol
+	if value, err = soap.FindArg(in, "NewProtocol"); err != nil {
+		return
+	}
+	NewProtocol = WANPPPConnection1PortMappingProtocol(value)
+	var NewInternalPort uint16
+	if value, err = soap.FindArg(in, "NewInternalPort"); err != nil {
+		return
+	}
+	if NewInternalPort, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewInternalPort: "+err.Error())
+	}
+	var NewInternalClient string
+	if value, err = soap.FindArg(in, "NewInternalClient"); err != nil {
+		return
+	}
+	if NewInternalClient, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewInternalClient: "+err.Error())
+	}
+	var NewEnabled bool
+	if value, err = soap.FindArg(in, "NewEnabled"); err != nil {
+		return
+	}
+	if NewEnabled, err = soap.UnmarshalBoolean(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewEnabled: "+err.Error())
+	}
+	var NewPortMappingDescription string
+	if value, err = soap.FindArg(in, "NewPortMappingDescription"); err != nil {
+		return
+	}
+	if NewPortMappingDescription, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewPortMappingDescription: "+err.Error())
+	}
+	var NewLeaseDuration uint32
+	if value, err = soap.FindArg(in, "NewLeaseDuration"); err != nil {
+		return
+	}
+	if NewLeaseDuration, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewLeaseDuration: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.AddPortMapping(ctx, NewRemoteHost, NewExternalPort, NewProtocol, NewInternalPort, NewInternalClient, NewEnabled, NewPortMappingDescription, NewLeaseDuration); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANPPPConnection1DeletePortMapping(ctx context.Context, handler WANPPPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewRemoteHost string
+	if value, err = soap.FindArg(in, "NewRemoteHost"); err != nil {
+		return
+	}
+	if NewRemoteHost, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewRemoteHost: "+err.Error())
+	}
+	var NewExternalPort uint16
+	if value, err = soap.FindArg(in, "NewExternalPort"); err != nil {
+		return
+	}
+	if NewExternalPort, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewExternalPort: "+err.Error())
+	}
+	var NewProtocol WANPPPConnection1PortMappingProtocol
+	if value, err = soap.FindArg(in, "NewProtocol"); err != nil {
+		return
+	}
+	NewProtocol = WANPPPConnection1PortMappingProtocol(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.DeletePortMapping(ctx, NewRemoteHost, NewExternalPort, NewProtocol); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWANPPPConnection1GetExternalIPAddress(ctx context.Context, handler WANPPPConnection1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewExternalIPAddress string
+	if NewExternalIPAddress, err = handler.GetExternalIPAddress(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewExternalIPAddress"
+	if out[0].Value, err = soap.MarshalString(NewExternalIPAddress); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
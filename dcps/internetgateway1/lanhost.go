@@ -0,0 +1,124 @@
+package internetgateway1
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/huin/goupnp"
+)
+
+// The helpers in this file take the client interfaces of this package, which
+// the LANHostConfigManagement1 and Layer3Forwarding1 clients of
+// internetgateway2 also implement.
+
+// ReservedAddresses returns the addresses that the DHCP server of the LAN
+// does not hand out.
+func ReservedAddresses(ctx context.Context, client LANHostConfigManagement1Client) ([]net.IP, error) {
+	list, err := client.GetReservedAddressesCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddressList(list)
+}
+
+// DNSServers returns the DNS servers that the DHCP server of the LAN hands
+// out.
+func DNSServers(ctx context.Context, client LANHostConfigManagement1Client) ([]net.IP, error) {
+	list, err := client.GetDNSServersCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddressList(list)
+}
+
+// IPRouters returns the default routers that the DHCP server of the LAN
+// hands out.
+func IPRouters(ctx context.Context, client LANHostConfigManagement1Client) ([]net.IP, error) {
+	list, err := client.GetIPRoutersListCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return ParseAddressList(list)
+}
+
+// ParseAddressList parses the comma separated IP address lists of
+// LANHostConfigManagement, such as ReservedAddresses and DNSServers.
+func ParseAddressList(list string) ([]net.IP, error) {
+	var ips []net.IP
+	for _, s := range strings.Split(list, ",") {
+		s = strings.TrimSpace(s)
+		if s == "" {
+			continue
+		}
+		ip := net.ParseIP(s)
+		if ip == nil {
+			return nil, fmt.Errorf("goupnp: invalid IP address %q in address list", s)
+		}
+		ips = append(ips, ip)
+	}
+	return ips, nil
+}
+
+// FormatAddressList formats ips as an IP address list for the Set and Delete
+// actions of LANHostConfigManagement.
+func FormatAddressList(ips []net.IP) string {
+	strs := make([]string, len(ips))
+	for i, ip := range ips {
+		strs[i] = ip.String()
+	}
+	return strings.Join(strs, ",")
+}
+
+// DefaultConnectionService returns a client for the WAN connection service
+// that Layer3Forwarding reports as the default route of the gateway. root and
+// loc are those of the gateway, e.g. of the ServiceClient of client.
+//
+// The returned client is for either a WANIPConnection or a WANPPPConnection
+// service, as told by its Service.ServiceType, and can be wrapped in the
+// client type of that service.
+func DefaultConnectionService(ctx context.Context, client Layer3Forwarding1Client, root *goupnp.RootDevice, loc *url.URL) (*goupnp.ServiceClient, error) {
+	ref, err := client.GetDefaultConnectionServiceCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return FindConnectionService(root, loc, ref)
+}
+
+// FindConnectionService returns a client for the service named by ref, a
+// DefaultConnectionService value of the form
+// "uuid:<device-UUID>:WANConnectionDevice:1,urn:upnp-org:serviceId:<serviceID>".
+func FindConnectionService(root *goupnp.RootDevice, loc *url.URL, ref string) (*goupnp.ServiceClient, error) {
+	comma := strings.IndexByte(ref, ',')
+	if comma < 0 {
+		return nil, fmt.Errorf("goupnp: malformed connection service reference %q", ref)
+	}
+	devicePath, serviceID := ref[:comma], ref[comma+1:]
+	var found *goupnp.Service
+	root.Device.VisitDevices(func(d *goupnp.Device) {
+		if found != nil || d.UDN == "" {
+			return
+		}
+		if devicePath != d.UDN && !strings.HasPrefix(devicePath, d.UDN+":") {
+			return
+		}
+		for i := range d.Services {
+			if d.Services[i].ServiceId == serviceID {
+				found = &d.Services[i]
+				return
+			}
+		}
+	})
+	if found == nil {
+		return nil, fmt.Errorf("goupnp: connection service %q not found within device %q",
+			ref, root.Device.FriendlyName)
+	}
+	return &goupnp.ServiceClient{
+		SOAPClient: found.NewSOAPClient(),
+		RootDevice: root,
+		Location:   loc,
+		Service:    found,
+	}, nil
+}
@@ -0,0 +1,61 @@
+package internetgateway1
+
+import (
+	"context"
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/huin/goupnp"
+)
+
+type fakeLANHostConfigManagement struct {
+	LANHostConfigManagement1Client
+}
+
+func (f *fakeLANHostConfigManagement) GetReservedAddressesCtx(ctx context.Context) (string, error) {
+	return "192.168.1.10, 192.168.1.11,", nil
+}
+
+func TestReservedAddresses(t *testing.T) {
+	ips, err := ReservedAddresses(context.Background(), &fakeLANHostConfigManagement{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []net.IP{net.ParseIP("192.168.1.10"), net.ParseIP("192.168.1.11")}
+	if !reflect.DeepEqual(ips, want) {
+		t.Errorf("got %v, want %v", ips, want)
+	}
+	if got := FormatAddressList(ips); got != "192.168.1.10,192.168.1.11" {
+		t.Errorf("FormatAddressList() = %q", got)
+	}
+	if _, err := ParseAddressList("192.168.1.10,bogus"); err == nil {
+		t.Error("parsed an invalid address list")
+	}
+}
+
+func TestFindConnectionService(t *testing.T) {
+	root := &goupnp.RootDevice{Device: goupnp.Device{
+		UDN: "uuid:igd",
+		Devices: []goupnp.Device{{
+			UDN: "uuid:wan",
+			Devices: []goupnp.Device{{
+				UDN: "uuid:conn",
+				Services: []goupnp.Service{
+					{ServiceType: URN_WANIPConnection_1, ServiceId: "urn:upnp-org:serviceId:WANIPConn1"},
+					{ServiceType: URN_WANPPPConnection_1, ServiceId: "urn:upnp-org:serviceId:WANPPPConn1"},
+				},
+			}},
+		}},
+	}}
+	sc, err := FindConnectionService(root, nil, "uuid:conn:WANConnectionDevice:1,urn:upnp-org:serviceId:WANPPPConn1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sc.Service.ServiceType != URN_WANPPPConnection_1 {
+		t.Errorf("found service %v, want the WANPPPConnection service", sc.Service)
+	}
+	if _, err := FindConnectionService(root, nil, "uuid:wan:WANConnectionDevice:1,urn:upnp-org:serviceId:WANPPPConn1"); err == nil {
+		t.Error("found a service of another device")
+	}
+}
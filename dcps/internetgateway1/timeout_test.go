@@ -0,0 +1,48 @@
+package internetgateway1
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/huin/goupnp"
+	"github.com/huin/goupnp/soap"
+)
+
+func TestActionTimeouts(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-release:
+		case <-r.Context().Done():
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	loc, err := url.Parse(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := &WANIPConnection1{goupnp.ServiceClient{
+		SOAPClient:     soap.NewSOAPClient(*loc),
+		ActionTimeouts: map[string]time.Duration{"GetExternalIPAddress": 50 * time.Millisecond},
+	}}
+	start := time.Now()
+	if _, err := client.GetExternalIPAddressCtx(context.Background()); err == nil {
+		t.Fatal("GetExternalIPAddress succeeded against a hanging server")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Errorf("GetExternalIPAddress returned after %v, want the 50ms override", elapsed)
+	}
+
+	// Actions not overridden keep the default of the service.
+	ctx, cancel := client.ActionContext(context.Background(), "AddPortMapping", WANIPConnection1Timeouts)
+	defer cancel()
+	if deadline, ok := ctx.Deadline(); !ok || time.Until(deadline) > WANIPConnection1Timeouts[""] {
+		t.Errorf("AddPortMapping deadline = %v, %v, want within the default of %v", deadline, ok, WANIPConnection1Timeouts[""])
+	}
+}
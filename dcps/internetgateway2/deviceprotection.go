@@ -0,0 +1,158 @@
+package internetgateway2
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/binary"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/huin/goupnp"
+)
+
+// DeviceProtectionPKCS5 is the ProtocolType of the password-based user login
+// of DeviceProtection:1.
+const DeviceProtectionPKCS5 = "PKCS5"
+
+// Parameters of the derivation of STORED from a password, as specified by
+// DeviceProtection:1 for the PKCS5 protocol.
+const (
+	storedIterations = 5000
+	storedLength     = 16
+	// authenticatorLength is the length to which the SHA-256 hash of STORED
+	// and the challenge is truncated.
+	authenticatorLength = 20
+)
+
+// DeviceProtectionStored returns the value STORED that a device keeps for a
+// password with the given salt, as passed to SetUserLoginPassword. It is
+// derived with PBKDF2 using HMAC-SHA-256.
+func DeviceProtectionStored(password string, salt []byte) []byte {
+	return pbkdf2SHA256([]byte(password), salt, storedIterations, storedLength)
+}
+
+// DeviceProtectionLogin logs in to the device as the user name with password,
+// using the GetUserLoginChallenge and UserLogin challenge flow. The login is
+// bound to the secure channel the actions are performed over, see
+// UseSecureChannel, and applies to protected actions of any service of the
+// device performed over the same channel until UserLogout.
+func DeviceProtectionLogin(ctx context.Context, client DeviceProtection1Client, name, password string) error {
+	salt, challenge, err := client.GetUserLoginChallengeCtx(ctx, DeviceProtectionPKCS5, name)
+	if err != nil {
+		return err
+	}
+	if len(challenge) == 0 {
+		return errors.New("goupnp: device returned an empty login challenge")
+	}
+	authenticator := deviceProtectionAuthenticator(DeviceProtectionStored(password, salt), challenge)
+	return client.UserLoginCtx(ctx, DeviceProtectionPKCS5, challenge, authenticator)
+}
+
+func deviceProtectionAuthenticator(stored, challenge []byte) []byte {
+	h := sha256.New()
+	h.Write(stored)
+	h.Write(challenge)
+	return h.Sum(nil)[:authenticatorLength]
+}
+
+// pbkdf2SHA256 is PBKDF2 from RFC 8018 with HMAC-SHA-256 as the PRF.
+func pbkdf2SHA256(password, salt []byte, iterations, keyLen int) []byte {
+	prf := hmac.New(sha256.New, password)
+	key := make([]byte, 0, keyLen+sha256.Size)
+	var block [4]byte
+	u := make([]byte, sha256.Size)
+	for i := uint32(1); len(key) < keyLen; i++ {
+		prf.Reset()
+		prf.Write(salt)
+		binary.BigEndian.PutUint32(block[:], i)
+		prf.Write(block[:])
+		u = prf.Sum(u[:0])
+		t := append([]byte(nil), u...)
+		for n := 1; n < iterations; n++ {
+			prf.Reset()
+			prf.Write(u)
+			u = prf.Sum(u[:0])
+			for j := range t {
+				t[j] ^= u[j]
+			}
+		}
+		key = append(key, t...)
+	}
+	return key[:keyLen]
+}
+
+// NewDeviceProtectionIdentity generates a self-signed certificate with the
+// given common name, which identifies a control point to DeviceProtection:1
+// devices over the secure channel. A control point should keep and reuse its
+// identity, as devices grant roles to identities.
+func NewDeviceProtectionIdentity(commonName string) (tls.Certificate, error) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	now := time.Now()
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.AddDate(20, 0, 0),
+		KeyUsage:     x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+	return tls.Certificate{Certificate: [][]byte{der}, PrivateKey: key}, nil
+}
+
+// NewSecureTransport returns an HTTP transport for the secure channel to
+// DeviceProtection:1 devices, presenting identity as the certificate of the
+// control point. Devices present self-signed certificates, so they are not
+// verified against certificate authorities; set VerifyPeerCertificate in the
+// TLSClientConfig of the transport to pin the certificate of a device.
+func NewSecureTransport(identity tls.Certificate) *http.Transport {
+	return &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		TLSClientConfig: &tls.Config{
+			Certificates:       []tls.Certificate{identity},
+			InsecureSkipVerify: true,
+		},
+		TLSHandshakeTimeout: 10 * time.Second,
+	}
+}
+
+// UseSecureChannel makes service client sc perform its actions through
+// transport, at its control URL resolved against secureLocation, which is the
+// HTTPS location of the device description given by the device in the
+// SECURELOCATION.UPNP.ORG header of its SSDP messages. All clients of a
+// device used after DeviceProtectionLogin must share the same transport.
+func UseSecureChannel(sc *goupnp.ServiceClient, secureLocation *url.URL, transport http.RoundTripper) error {
+	if secureLocation.Scheme != "https" {
+		return errors.New("goupnp: secure location " + secureLocation.String() + " is not an https URL")
+	}
+	controlURL, err := url.Parse(sc.Service.ControlURL.Str)
+	if err != nil {
+		return err
+	}
+	if controlURL.IsAbs() {
+		// Only the path of an absolute URL applies to the secure channel.
+		controlURL = &url.URL{Path: controlURL.Path, RawQuery: controlURL.RawQuery}
+	}
+	sc.SOAPClient.EndpointURL = *secureLocation.ResolveReference(controlURL)
+	sc.SOAPClient.HTTPClient.Transport = transport
+	return nil
+}
@@ -0,0 +1,93 @@
+package internetgateway2
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/hex"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/huin/goupnp"
+)
+
+func TestPBKDF2SHA256(t *testing.T) {
+	// Test vector from RFC 7914 section 11.
+	want := "55ac046e56e3089fec1691c22544b605f94185216dde0465e68b9d57c20dacbc" +
+		"49ca9cccf179b645991664b39d77ef317c71b845b1e30bd509112041d3a19783"
+	if got := hex.EncodeToString(pbkdf2SHA256([]byte("passwd"), []byte("salt"), 1, 64)); got != want {
+		t.Errorf("pbkdf2SHA256() = %s, want %s", got, want)
+	}
+}
+
+type fakeDeviceProtection struct {
+	DeviceProtection1Client
+	salt, challenge, stored []byte
+	loggedIn                bool
+}
+
+func (f *fakeDeviceProtection) GetUserLoginChallengeCtx(ctx context.Context, ProtocolType string, Name string) ([]byte, []byte, error) {
+	if ProtocolType != DeviceProtectionPKCS5 || Name != "admin" {
+		return nil, nil, fmt.Errorf("unexpected challenge request for %q of %q", ProtocolType, Name)
+	}
+	return f.salt, f.challenge, nil
+}
+
+func (f *fakeDeviceProtection) UserLoginCtx(ctx context.Context, ProtocolType string, Challenge []byte, Authenticator []byte) error {
+	if !bytes.Equal(Authenticator, deviceProtectionAuthenticator(f.stored, f.challenge)) {
+		return fmt.Errorf("bad authenticator %x", Authenticator)
+	}
+	f.loggedIn = true
+	return nil
+}
+
+func TestDeviceProtectionLogin(t *testing.T) {
+	f := &fakeDeviceProtection{salt: []byte("admin-salt"), challenge: []byte("challenge")}
+	f.stored = DeviceProtectionStored("secret", f.salt)
+	if len(f.stored) != storedLength {
+		t.Errorf("got STORED of %d bytes, want %d", len(f.stored), storedLength)
+	}
+	if err := DeviceProtectionLogin(context.Background(), f, "admin", "wrong"); err == nil || f.loggedIn {
+		t.Error("login with wrong password succeeded")
+	}
+	if err := DeviceProtectionLogin(context.Background(), f, "admin", "secret"); err != nil || !f.loggedIn {
+		t.Errorf("login failed: %v", err)
+	}
+}
+
+func TestUseSecureChannel(t *testing.T) {
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if len(r.TLS.PeerCertificates) != 1 || r.TLS.PeerCertificates[0].Subject.CommonName != "test CP" {
+			http.Error(w, "no identity", http.StatusForbidden)
+			return
+		}
+		if r.URL.Path != "/ctl/DP" {
+			http.NotFound(w, r)
+			return
+		}
+		fmt.Fprint(w, `<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/"><s:Body>`+
+			`<u:UserLogoutResponse xmlns:u="urn:schemas-upnp-org:service:DeviceProtection:1"/>`+
+			`</s:Body></s:Envelope>`)
+	}))
+	server.TLS = &tls.Config{ClientAuth: tls.RequireAnyClientCert}
+	server.StartTLS()
+	defer server.Close()
+
+	identity, err := NewDeviceProtectionIdentity("test CP")
+	if err != nil {
+		t.Fatal(err)
+	}
+	insecure, _ := url.Parse("http://192.0.2.1:49152/ctl/DP")
+	svc := &goupnp.Service{ControlURL: goupnp.URLField{Str: "/ctl/DP", URL: *insecure}}
+	client := &DeviceProtection1{goupnp.ServiceClient{SOAPClient: svc.NewSOAPClient(), Service: svc}}
+	secureLocation, _ := url.Parse(server.URL + "/desc.xml")
+	if err := UseSecureChannel(&client.ServiceClient, secureLocation, NewSecureTransport(identity)); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.UserLogout(); err != nil {
+		t.Fatal(err)
+	}
+}
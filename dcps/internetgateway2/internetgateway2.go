@@ -8,6 +8,7 @@ package internetgateway2
 // Generated file - do not edit by hand. See README.md
 
 import (
+	"context"
 	"net/url"
 	"time"
 
@@ -20,11 +21,12 @@ var _ time.Time
 
 // Device URNs:
 const (
-	URN_LANDevice_1           = "urn:schemas-upnp-org:device:LANDevice:1"
-	URN_WANConnectionDevice_1 = "urn:schemas-upnp-org:device:WANConnectionDevice:1"
-	URN_WANConnectionDevice_2 = "urn:schemas-upnp-org:device:WANConnectionDevice:2"
-	URN_WANDevice_1           = "urn:schemas-upnp-org:device:WANDevice:1"
-	URN_WANDevice_2           = "urn:schemas-upnp-org:device:WANDevice:2"
+	URN_InternetGatewayDevice_2 = "urn:schemas-upnp-org:device:InternetGatewayDevice:2"
+	URN_LANDevice_1             = "urn:schemas-upnp-org:device:LANDevice:1"
+	URN_WANConnectionDevice_1   = "urn:schemas-upnp-org:device:WANConnectionDevice:1"
+	URN_WANConnectionDevice_2   = "urn:schemas-upnp-org:device:WANConnectionDevice:2"
+	URN_WANDevice_1             = "urn:schemas-upnp-org:device:WANDevice:1"
+	URN_WANDevice_2             = "urn:schemas-upnp-org:device:WANDevice:2"
 )
 
 // Service URNs:
@@ -50,6 +52,39 @@ type DeviceProtection1 struct {
 	goupnp.ServiceClient
 }
 
+// DeviceProtection1Client is the interface of the actions of DeviceProtection1, for
+// substituting fakes or mocks for the service in tests.
+type DeviceProtection1Client interface {
+	SendSetupMessage(ProtocolType string, InMessage []byte) (OutMessage []byte, err error)
+	SendSetupMessageCtx(ctx context.Context, ProtocolType string, InMessage []byte) (OutMessage []byte, err error)
+	GetSupportedProtocols() (ProtocolList string, err error)
+	GetSupportedProtocolsCtx(ctx context.Context) (ProtocolList string, err error)
+	GetAssignedRoles() (RoleList string, err error)
+	GetAssignedRolesCtx(ctx context.Context) (RoleList string, err error)
+	GetRolesForAction(DeviceUDN string, ServiceId string, ActionName string) (RoleList string, RestrictedRoleList string, err error)
+	GetRolesForActionCtx(ctx context.Context, DeviceUDN string, ServiceId string, ActionName string) (RoleList string, RestrictedRoleList string, err error)
+	GetUserLoginChallenge(ProtocolType string, Name string) (Salt []byte, Challenge []byte, err error)
+	GetUserLoginChallengeCtx(ctx context.Context, ProtocolType string, Name string) (Salt []byte, Challenge []byte, err error)
+	UserLogin(ProtocolType string, Challenge []byte, Authenticator []byte) (err error)
+	UserLoginCtx(ctx context.Context, ProtocolType string, Challenge []byte, Authenticator []byte) (err error)
+	UserLogout() (err error)
+	UserLogoutCtx(ctx context.Context) (err error)
+	GetACLData() (ACL string, err error)
+	GetACLDataCtx(ctx context.Context) (ACL string, err error)
+	AddIdentityList(IdentityList string) (IdentityListResult string, err error)
+	AddIdentityListCtx(ctx context.Context, IdentityList string) (IdentityListResult string, err error)
+	RemoveIdentity(Identity string) (err error)
+	RemoveIdentityCtx(ctx context.Context, Identity string) (err error)
+	SetUserLoginPassword(ProtocolType string, Name string, Stored []byte, Salt []byte) (err error)
+	SetUserLoginPasswordCtx(ctx context.Context, ProtocolType string, Name string, Stored []byte, Salt []byte) (err error)
+	AddRolesForIdentity(Identity string, RoleList string) (err error)
+	AddRolesForIdentityCtx(ctx context.Context, Identity string, RoleList string) (err error)
+	RemoveRolesForIdentity(Identity string, RoleList string) (err error)
+	RemoveRolesForIdentityCtx(ctx context.Context, Identity string, RoleList string) (err error)
+}
+
+var _ DeviceProtection1Client = new(DeviceProtection1)
+
 // NewDeviceProtection1Clients discovers instances of the service on the network,
 // and returns clients to any that are found. errors will contain an error for
 // any devices that replied but which could not be queried, and err will be set
@@ -103,13 +138,53 @@ func newDeviceProtection1ClientsFromGenericClients(genericClients []goupnp.Servi
 	return clients
 }
 
+// DeviceProtection1Timeouts are the default timeouts of the actions of
+// DeviceProtection1, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var DeviceProtection1Timeouts = map[string]time.Duration{
+	"": 10 * time.Second,
+}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *DeviceProtection1) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, DeviceProtection1Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_DeviceProtection_1, actionName, request, response)
+}
+
+// DeviceProtection1SendSetupMessageRequest is the request of SendSetupMessage, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type DeviceProtection1SendSetupMessageRequest struct {
+	ProtocolType string
+	InMessage    string
+}
+
+// DeviceProtection1SendSetupMessageResponse is the response of SendSetupMessage, with each
+// argument in its SOAP string form.
+type DeviceProtection1SendSetupMessageResponse struct {
+	OutMessage string
+}
+
+// SendSetupMessage performs the SendSetupMessage action of the service.
+//
+// Arguments:
+//   - ProtocolType (in, state variable A_ARG_TYPE_SendSetupMessage_ProtocolType)
+//   - InMessage (in, state variable A_ARG_TYPE_SendSetupMessage_InMessage)
+//
+// Return values:
+//   - OutMessage (out, state variable A_ARG_TYPE_SendSetupMessage_OutMessage)
 func (client *DeviceProtection1) SendSetupMessage(ProtocolType string, InMessage []byte) (OutMessage []byte, err error) {
-	// Request structure.
-	request := &struct {
-		ProtocolType string
+	return client.SendSetupMessageCtx(context.Background(), ProtocolType, InMessage)
+}
 
-		InMessage string
-	}{}
+// SendSetupMessageCtx is SendSetupMessage with a context, to cancel or time out the call.
+func (client *DeviceProtection1) SendSetupMessageCtx(ctx context.Context, ProtocolType string, InMessage []byte) (OutMessage []byte, err error) {
+	// Request structure.
+	request := &DeviceProtection1SendSetupMessageRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.ProtocolType, err = soap.MarshalString(ProtocolType); err != nil {
@@ -121,12 +196,10 @@ func (client *DeviceProtection1) SendSetupMessage(ProtocolType string, InMessage
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		OutMessage string
-	}{}
+	response := &DeviceProtection1SendSetupMessageResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_DeviceProtection_1, "SendSetupMessage", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SendSetupMessage", request, response); err != nil {
 		return
 	}
 
@@ -139,7 +212,22 @@ func (client *DeviceProtection1) SendSetupMessage(ProtocolType string, InMessage
 	return
 }
 
+// DeviceProtection1GetSupportedProtocolsResponse is the response of GetSupportedProtocols, with each
+// argument in its SOAP string form.
+type DeviceProtection1GetSupportedProtocolsResponse struct {
+	ProtocolList string
+}
+
+// GetSupportedProtocols performs the GetSupportedProtocols action of the service.
+//
+// Return values:
+//   - ProtocolList (out, state variable A_ARG_TYPE_GetSupportedProtocols_ProtocolList)
 func (client *DeviceProtection1) GetSupportedProtocols() (ProtocolList string, err error) {
+	return client.GetSupportedProtocolsCtx(context.Background())
+}
+
+// GetSupportedProtocolsCtx is GetSupportedProtocols with a context, to cancel or time out the call.
+func (client *DeviceProtection1) GetSupportedProtocolsCtx(ctx context.Context) (ProtocolList string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -147,12 +235,10 @@ func (client *DeviceProtection1) GetSupportedProtocols() (ProtocolList string, e
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		ProtocolList string
-	}{}
+	response := &DeviceProtection1GetSupportedProtocolsResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_DeviceProtection_1, "GetSupportedProtocols", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetSupportedProtocols", request, response); err != nil {
 		return
 	}
 
@@ -165,7 +251,22 @@ func (client *DeviceProtection1) GetSupportedProtocols() (ProtocolList string, e
 	return
 }
 
+// DeviceProtection1GetAssignedRolesResponse is the response of GetAssignedRoles, with each
+// argument in its SOAP string form.
+type DeviceProtection1GetAssignedRolesResponse struct {
+	RoleList string
+}
+
+// GetAssignedRoles performs the GetAssignedRoles action of the service.
+//
+// Return values:
+//   - RoleList (out, state variable A_ARG_TYPE_GetAssignedRoles_RoleList)
 func (client *DeviceProtection1) GetAssignedRoles() (RoleList string, err error) {
+	return client.GetAssignedRolesCtx(context.Background())
+}
+
+// GetAssignedRolesCtx is GetAssignedRoles with a context, to cancel or time out the call.
+func (client *DeviceProtection1) GetAssignedRolesCtx(ctx context.Context) (RoleList string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -173,12 +274,10 @@ func (client *DeviceProtection1) GetAssignedRoles() (RoleList string, err error)
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		RoleList string
-	}{}
+	response := &DeviceProtection1GetAssignedRolesResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_DeviceProtection_1, "GetAssignedRoles", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetAssignedRoles", request, response); err != nil {
 		return
 	}
 
@@ -191,15 +290,39 @@ func (client *DeviceProtection1) GetAssignedRoles() (RoleList string, err error)
 	return
 }
 
-func (client *DeviceProtection1) GetRolesForAction(DeviceUDN string, ServiceId string, ActionName string) (RoleList string, RestrictedRoleList string, err error) {
-	// Request structure.
-	request := &struct {
-		DeviceUDN string
+// DeviceProtection1GetRolesForActionRequest is the request of GetRolesForAction, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type DeviceProtection1GetRolesForActionRequest struct {
+	DeviceUDN  string
+	ServiceId  string
+	ActionName string
+}
+
+// DeviceProtection1GetRolesForActionResponse is the response of GetRolesForAction, with each
+// argument in its SOAP string form.
+type DeviceProtection1GetRolesForActionResponse struct {
+	RoleList           string
+	RestrictedRoleList string
+}
 
-		ServiceId string
+// GetRolesForAction performs the GetRolesForAction action of the service.
+//
+// Arguments:
+//   - DeviceUDN (in, state variable A_ARG_TYPE_GetRolesForAction_DeviceUDN)
+//   - ServiceId (in, state variable A_ARG_TYPE_GetRolesForAction_ServiceId)
+//   - ActionName (in, state variable A_ARG_TYPE_GetRolesForAction_ActionName)
+//
+// Return values:
+//   - RoleList (out, state variable A_ARG_TYPE_GetRolesForAction_RoleList)
+//   - RestrictedRoleList (out, state variable A_ARG_TYPE_GetRolesForAction_RestrictedRoleList)
+func (client *DeviceProtection1) GetRolesForAction(DeviceUDN string, ServiceId string, ActionName string) (RoleList string, RestrictedRoleList string, err error) {
+	return client.GetRolesForActionCtx(context.Background(), DeviceUDN, ServiceId, ActionName)
+}
 
-		ActionName string
-	}{}
+// GetRolesForActionCtx is GetRolesForAction with a context, to cancel or time out the call.
+func (client *DeviceProtection1) GetRolesForActionCtx(ctx context.Context, DeviceUDN string, ServiceId string, ActionName string) (RoleList string, RestrictedRoleList string, err error) {
+	// Request structure.
+	request := &DeviceProtection1GetRolesForActionRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.DeviceUDN, err = soap.MarshalString(DeviceUDN); err != nil {
@@ -214,14 +337,10 @@ func (client *DeviceProtection1) GetRolesForAction(DeviceUDN string, ServiceId s
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		RoleList string
-
-		RestrictedRoleList string
-	}{}
+	response := &DeviceProtection1GetRolesForActionResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_DeviceProtection_1, "GetRolesForAction", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetRolesForAction", request, response); err != nil {
 		return
 	}
 
@@ -237,13 +356,37 @@ func (client *DeviceProtection1) GetRolesForAction(DeviceUDN string, ServiceId s
 	return
 }
 
+// DeviceProtection1GetUserLoginChallengeRequest is the request of GetUserLoginChallenge, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type DeviceProtection1GetUserLoginChallengeRequest struct {
+	ProtocolType string
+	Name         string
+}
+
+// DeviceProtection1GetUserLoginChallengeResponse is the response of GetUserLoginChallenge, with each
+// argument in its SOAP string form.
+type DeviceProtection1GetUserLoginChallengeResponse struct {
+	Salt      string
+	Challenge string
+}
+
+// GetUserLoginChallenge performs the GetUserLoginChallenge action of the service.
+//
+// Arguments:
+//   - ProtocolType (in, state variable A_ARG_TYPE_GetUserLoginChallenge_ProtocolType)
+//   - Name (in, state variable A_ARG_TYPE_GetUserLoginChallenge_Name)
+//
+// Return values:
+//   - Salt (out, state variable A_ARG_TYPE_GetUserLoginChallenge_Salt)
+//   - Challenge (out, state variable A_ARG_TYPE_GetUserLoginChallenge_Challenge)
 func (client *DeviceProtection1) GetUserLoginChallenge(ProtocolType string, Name string) (Salt []byte, Challenge []byte, err error) {
-	// Request structure.
-	request := &struct {
-		ProtocolType string
+	return client.GetUserLoginChallengeCtx(context.Background(), ProtocolType, Name)
+}
 
-		Name string
-	}{}
+// GetUserLoginChallengeCtx is GetUserLoginChallenge with a context, to cancel or time out the call.
+func (client *DeviceProtection1) GetUserLoginChallengeCtx(ctx context.Context, ProtocolType string, Name string) (Salt []byte, Challenge []byte, err error) {
+	// Request structure.
+	request := &DeviceProtection1GetUserLoginChallengeRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.ProtocolType, err = soap.MarshalString(ProtocolType); err != nil {
@@ -255,14 +398,10 @@ func (client *DeviceProtection1) GetUserLoginChallenge(ProtocolType string, Name
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		Salt string
-
-		Challenge string
-	}{}
+	response := &DeviceProtection1GetUserLoginChallengeResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_DeviceProtection_1, "GetUserLoginChallenge", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetUserLoginChallenge", request, response); err != nil {
 		return
 	}
 
@@ -278,15 +417,28 @@ func (client *DeviceProtection1) GetUserLoginChallenge(ProtocolType string, Name
 	return
 }
 
-func (client *DeviceProtection1) UserLogin(ProtocolType string, Challenge []byte, Authenticator []byte) (err error) {
-	// Request structure.
-	request := &struct {
-		ProtocolType string
+// DeviceProtection1UserLoginRequest is the request of UserLogin, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type DeviceProtection1UserLoginRequest struct {
+	ProtocolType  string
+	Challenge     string
+	Authenticator string
+}
 
-		Challenge string
+// UserLogin performs the UserLogin action of the service.
+//
+// Arguments:
+//   - ProtocolType (in, state variable A_ARG_TYPE_UserLogin_ProtocolType)
+//   - Challenge (in, state variable A_ARG_TYPE_UserLogin_Challenge)
+//   - Authenticator (in, state variable A_ARG_TYPE_UserLogin_Authenticator)
+func (client *DeviceProtection1) UserLogin(ProtocolType string, Challenge []byte, Authenticator []byte) (err error) {
+	return client.UserLoginCtx(context.Background(), ProtocolType, Challenge, Authenticator)
+}
 
-		Authenticator string
-	}{}
+// UserLoginCtx is UserLogin with a context, to cancel or time out the call.
+func (client *DeviceProtection1) UserLoginCtx(ctx context.Context, ProtocolType string, Challenge []byte, Authenticator []byte) (err error) {
+	// Request structure.
+	request := &DeviceProtection1UserLoginRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.ProtocolType, err = soap.MarshalString(ProtocolType); err != nil {
@@ -304,7 +456,7 @@ func (client *DeviceProtection1) UserLogin(ProtocolType string, Challenge []byte
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_DeviceProtection_1, "UserLogin", request, response); err != nil {
+	if err = client.PerformAction(ctx, "UserLogin", request, response); err != nil {
 		return
 	}
 
@@ -314,7 +466,13 @@ func (client *DeviceProtection1) UserLogin(ProtocolType string, Challenge []byte
 	return
 }
 
+// UserLogout performs the UserLogout action of the service.
 func (client *DeviceProtection1) UserLogout() (err error) {
+	return client.UserLogoutCtx(context.Background())
+}
+
+// UserLogoutCtx is UserLogout with a context, to cancel or time out the call.
+func (client *DeviceProtection1) UserLogoutCtx(ctx context.Context) (err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -325,7 +483,7 @@ func (client *DeviceProtection1) UserLogout() (err error) {
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_DeviceProtection_1, "UserLogout", request, response); err != nil {
+	if err = client.PerformAction(ctx, "UserLogout", request, response); err != nil {
 		return
 	}
 
@@ -335,7 +493,22 @@ func (client *DeviceProtection1) UserLogout() (err error) {
 	return
 }
 
+// DeviceProtection1GetACLDataResponse is the response of GetACLData, with each
+// argument in its SOAP string form.
+type DeviceProtection1GetACLDataResponse struct {
+	ACL string
+}
+
+// GetACLData performs the GetACLData action of the service.
+//
+// Return values:
+//   - ACL (out, state variable A_ARG_TYPE_GetACLData_ACL)
 func (client *DeviceProtection1) GetACLData() (ACL string, err error) {
+	return client.GetACLDataCtx(context.Background())
+}
+
+// GetACLDataCtx is GetACLData with a context, to cancel or time out the call.
+func (client *DeviceProtection1) GetACLDataCtx(ctx context.Context) (ACL string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -343,12 +516,10 @@ func (client *DeviceProtection1) GetACLData() (ACL string, err error) {
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		ACL string
-	}{}
+	response := &DeviceProtection1GetACLDataResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_DeviceProtection_1, "GetACLData", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetACLData", request, response); err != nil {
 		return
 	}
 
@@ -361,11 +532,33 @@ func (client *DeviceProtection1) GetACLData() (ACL string, err error) {
 	return
 }
 
+// DeviceProtection1AddIdentityListRequest is the request of AddIdentityList, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type DeviceProtection1AddIdentityListRequest struct {
+	IdentityList string
+}
+
+// DeviceProtection1AddIdentityListResponse is the response of AddIdentityList, with each
+// argument in its SOAP string form.
+type DeviceProtection1AddIdentityListResponse struct {
+	IdentityListResult string
+}
+
+// AddIdentityList performs the AddIdentityList action of the service.
+//
+// Arguments:
+//   - IdentityList (in, state variable A_ARG_TYPE_AddIdentityList_IdentityList)
+//
+// Return values:
+//   - IdentityListResult (out, state variable A_ARG_TYPE_AddIdentityList_IdentityListResult)
 func (client *DeviceProtection1) AddIdentityList(IdentityList string) (IdentityListResult string, err error) {
+	return client.AddIdentityListCtx(context.Background(), IdentityList)
+}
+
+// AddIdentityListCtx is AddIdentityList with a context, to cancel or time out the call.
+func (client *DeviceProtection1) AddIdentityListCtx(ctx context.Context, IdentityList string) (IdentityListResult string, err error) {
 	// Request structure.
-	request := &struct {
-		IdentityList string
-	}{}
+	request := &DeviceProtection1AddIdentityListRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.IdentityList, err = soap.MarshalString(IdentityList); err != nil {
@@ -374,12 +567,10 @@ func (client *DeviceProtection1) AddIdentityList(IdentityList string) (IdentityL
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		IdentityListResult string
-	}{}
+	response := &DeviceProtection1AddIdentityListResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_DeviceProtection_1, "AddIdentityList", request, response); err != nil {
+	if err = client.PerformAction(ctx, "AddIdentityList", request, response); err != nil {
 		return
 	}
 
@@ -392,11 +583,24 @@ func (client *DeviceProtection1) AddIdentityList(IdentityList string) (IdentityL
 	return
 }
 
+// DeviceProtection1RemoveIdentityRequest is the request of RemoveIdentity, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type DeviceProtection1RemoveIdentityRequest struct {
+	Identity string
+}
+
+// RemoveIdentity performs the RemoveIdentity action of the service.
+//
+// Arguments:
+//   - Identity (in, state variable A_ARG_TYPE_RemoveIdentity_Identity)
 func (client *DeviceProtection1) RemoveIdentity(Identity string) (err error) {
+	return client.RemoveIdentityCtx(context.Background(), Identity)
+}
+
+// RemoveIdentityCtx is RemoveIdentity with a context, to cancel or time out the call.
+func (client *DeviceProtection1) RemoveIdentityCtx(ctx context.Context, Identity string) (err error) {
 	// Request structure.
-	request := &struct {
-		Identity string
-	}{}
+	request := &DeviceProtection1RemoveIdentityRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.Identity, err = soap.MarshalString(Identity); err != nil {
@@ -408,7 +612,7 @@ func (client *DeviceProtection1) RemoveIdentity(Identity string) (err error) {
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_DeviceProtection_1, "RemoveIdentity", request, response); err != nil {
+	if err = client.PerformAction(ctx, "RemoveIdentity", request, response); err != nil {
 		return
 	}
 
@@ -418,17 +622,30 @@ func (client *DeviceProtection1) RemoveIdentity(Identity string) (err error) {
 	return
 }
 
-func (client *DeviceProtection1) SetUserLoginPassword(ProtocolType string, Name string, Stored []byte, Salt []byte) (err error) {
-	// Request structure.
-	request := &struct {
-		ProtocolType string
-
-		Name string
+// DeviceProtection1SetUserLoginPasswordRequest is the request of SetUserLoginPassword, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type DeviceProtection1SetUserLoginPasswordRequest struct {
+	ProtocolType string
+	Name         string
+	Stored       string
+	Salt         string
+}
 
-		Stored string
+// SetUserLoginPassword performs the SetUserLoginPassword action of the service.
+//
+// Arguments:
+//   - ProtocolType (in, state variable A_ARG_TYPE_SetUserLoginPassword_ProtocolType)
+//   - Name (in, state variable A_ARG_TYPE_SetUserLoginPassword_Name)
+//   - Stored (in, state variable A_ARG_TYPE_SetUserLoginPassword_Stored)
+//   - Salt (in, state variable A_ARG_TYPE_SetUserLoginPassword_Salt)
+func (client *DeviceProtection1) SetUserLoginPassword(ProtocolType string, Name string, Stored []byte, Salt []byte) (err error) {
+	return client.SetUserLoginPasswordCtx(context.Background(), ProtocolType, Name, Stored, Salt)
+}
 
-		Salt string
-	}{}
+// SetUserLoginPasswordCtx is SetUserLoginPassword with a context, to cancel or time out the call.
+func (client *DeviceProtection1) SetUserLoginPasswordCtx(ctx context.Context, ProtocolType string, Name string, Stored []byte, Salt []byte) (err error) {
+	// Request structure.
+	request := &DeviceProtection1SetUserLoginPasswordRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.ProtocolType, err = soap.MarshalString(ProtocolType); err != nil {
@@ -449,7 +666,7 @@ func (client *DeviceProtection1) SetUserLoginPassword(ProtocolType string, Name
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_DeviceProtection_1, "SetUserLoginPassword", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetUserLoginPassword", request, response); err != nil {
 		return
 	}
 
@@ -459,13 +676,26 @@ func (client *DeviceProtection1) SetUserLoginPassword(ProtocolType string, Name
 	return
 }
 
+// DeviceProtection1AddRolesForIdentityRequest is the request of AddRolesForIdentity, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type DeviceProtection1AddRolesForIdentityRequest struct {
+	Identity string
+	RoleList string
+}
+
+// AddRolesForIdentity performs the AddRolesForIdentity action of the service.
+//
+// Arguments:
+//   - Identity (in, state variable A_ARG_TYPE_AddRolesForIdentity_Identity)
+//   - RoleList (in, state variable A_ARG_TYPE_AddRolesForIdentity_RoleList)
 func (client *DeviceProtection1) AddRolesForIdentity(Identity string, RoleList string) (err error) {
-	// Request structure.
-	request := &struct {
-		Identity string
+	return client.AddRolesForIdentityCtx(context.Background(), Identity, RoleList)
+}
 
-		RoleList string
-	}{}
+// AddRolesForIdentityCtx is AddRolesForIdentity with a context, to cancel or time out the call.
+func (client *DeviceProtection1) AddRolesForIdentityCtx(ctx context.Context, Identity string, RoleList string) (err error) {
+	// Request structure.
+	request := &DeviceProtection1AddRolesForIdentityRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.Identity, err = soap.MarshalString(Identity); err != nil {
@@ -480,7 +710,7 @@ func (client *DeviceProtection1) AddRolesForIdentity(Identity string, RoleList s
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_DeviceProtection_1, "AddRolesForIdentity", request, response); err != nil {
+	if err = client.PerformAction(ctx, "AddRolesForIdentity", request, response); err != nil {
 		return
 	}
 
@@ -490,13 +720,26 @@ func (client *DeviceProtection1) AddRolesForIdentity(Identity string, RoleList s
 	return
 }
 
+// DeviceProtection1RemoveRolesForIdentityRequest is the request of RemoveRolesForIdentity, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type DeviceProtection1RemoveRolesForIdentityRequest struct {
+	Identity string
+	RoleList string
+}
+
+// RemoveRolesForIdentity performs the RemoveRolesForIdentity action of the service.
+//
+// Arguments:
+//   - Identity (in, state variable A_ARG_TYPE_RemoveRolesForIdentity_Identity)
+//   - RoleList (in, state variable A_ARG_TYPE_RemoveRolesForIdentity_RoleList)
 func (client *DeviceProtection1) RemoveRolesForIdentity(Identity string, RoleList string) (err error) {
-	// Request structure.
-	request := &struct {
-		Identity string
+	return client.RemoveRolesForIdentityCtx(context.Background(), Identity, RoleList)
+}
 
-		RoleList string
-	}{}
+// RemoveRolesForIdentityCtx is RemoveRolesForIdentity with a context, to cancel or time out the call.
+func (client *DeviceProtection1) RemoveRolesForIdentityCtx(ctx context.Context, Identity string, RoleList string) (err error) {
+	// Request structure.
+	request := &DeviceProtection1RemoveRolesForIdentityRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.Identity, err = soap.MarshalString(Identity); err != nil {
@@ -511,7 +754,7 @@ func (client *DeviceProtection1) RemoveRolesForIdentity(Identity string, RoleLis
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_DeviceProtection_1, "RemoveRolesForIdentity", request, response); err != nil {
+	if err = client.PerformAction(ctx, "RemoveRolesForIdentity", request, response); err != nil {
 		return
 	}
 
@@ -528,6 +771,51 @@ type LANHostConfigManagement1 struct {
 	goupnp.ServiceClient
 }
 
+// LANHostConfigManagement1Client is the interface of the actions of LANHostConfigManagement1, for
+// substituting fakes or mocks for the service in tests.
+type LANHostConfigManagement1Client interface {
+	SetDHCPServerConfigurable(NewDHCPServerConfigurable bool) (err error)
+	SetDHCPServerConfigurableCtx(ctx context.Context, NewDHCPServerConfigurable bool) (err error)
+	GetDHCPServerConfigurable() (NewDHCPServerConfigurable bool, err error)
+	GetDHCPServerConfigurableCtx(ctx context.Context) (NewDHCPServerConfigurable bool, err error)
+	SetDHCPRelay(NewDHCPRelay bool) (err error)
+	SetDHCPRelayCtx(ctx context.Context, NewDHCPRelay bool) (err error)
+	GetDHCPRelay() (NewDHCPRelay bool, err error)
+	GetDHCPRelayCtx(ctx context.Context) (NewDHCPRelay bool, err error)
+	SetSubnetMask(NewSubnetMask string) (err error)
+	SetSubnetMaskCtx(ctx context.Context, NewSubnetMask string) (err error)
+	GetSubnetMask() (NewSubnetMask string, err error)
+	GetSubnetMaskCtx(ctx context.Context) (NewSubnetMask string, err error)
+	SetIPRouter(NewIPRouters string) (err error)
+	SetIPRouterCtx(ctx context.Context, NewIPRouters string) (err error)
+	DeleteIPRouter(NewIPRouters string) (err error)
+	DeleteIPRouterCtx(ctx context.Context, NewIPRouters string) (err error)
+	GetIPRoutersList() (NewIPRouters string, err error)
+	GetIPRoutersListCtx(ctx context.Context) (NewIPRouters string, err error)
+	SetDomainName(NewDomainName string) (err error)
+	SetDomainNameCtx(ctx context.Context, NewDomainName string) (err error)
+	GetDomainName() (NewDomainName string, err error)
+	GetDomainNameCtx(ctx context.Context) (NewDomainName string, err error)
+	SetAddressRange(NewMinAddress string, NewMaxAddress string) (err error)
+	SetAddressRangeCtx(ctx context.Context, NewMinAddress string, NewMaxAddress string) (err error)
+	GetAddressRange() (NewMinAddress string, NewMaxAddress string, err error)
+	GetAddressRangeCtx(ctx context.Context) (NewMinAddress string, NewMaxAddress string, err error)
+	SetReservedAddress(NewReservedAddresses string) (err error)
+	SetReservedAddressCtx(ctx context.Context, NewReservedAddresses string) (err error)
+	DeleteReservedAddress(NewReservedAddresses string) (err error)
+	DeleteReservedAddressCtx(ctx context.Context, NewReservedAddresses string) (err error)
+	GetReservedAddresses() (NewReservedAddresses string, err error)
+	GetReservedAddressesCtx(ctx context.Context) (NewReservedAddresses string, err error)
+	SetDNSServer(NewDNSServers string) (err error)
+	SetDNSServerCtx(ctx context.Context, NewDNSServers string) (err error)
+	DeleteDNSServer(NewDNSServers string) (err error)
+	DeleteDNSServerCtx(ctx context.Context, NewDNSServers string) (err error)
+	GetDNSServers() (NewDNSServers string, err error)
+	GetDNSServersCtx(ctx context.Context) (NewDNSServers string, err error)
+}
+
+var _ LANHostConfigManagement1Client = new(LANHostConfigManagement1)
+
 // NewLANHostConfigManagement1Clients discovers instances of the service on the network,
 // and returns clients to any that are found. errors will contain an error for
 // any devices that replied but which could not be queried, and err will be set
@@ -581,11 +869,42 @@ func newLANHostConfigManagement1ClientsFromGenericClients(genericClients []goupn
 	return clients
 }
 
+// LANHostConfigManagement1Timeouts are the default timeouts of the actions of
+// LANHostConfigManagement1, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var LANHostConfigManagement1Timeouts = map[string]time.Duration{
+	"": 10 * time.Second,
+}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *LANHostConfigManagement1) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, LANHostConfigManagement1Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_LANHostConfigManagement_1, actionName, request, response)
+}
+
+// LANHostConfigManagement1SetDHCPServerConfigurableRequest is the request of SetDHCPServerConfigurable, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type LANHostConfigManagement1SetDHCPServerConfigurableRequest struct {
+	NewDHCPServerConfigurable string
+}
+
+// SetDHCPServerConfigurable performs the SetDHCPServerConfigurable action of the service.
+//
+// Arguments:
+//   - NewDHCPServerConfigurable (in, state variable A_ARG_TYPE_SetDHCPServerConfigurable_NewDHCPServerConfigurable)
 func (client *LANHostConfigManagement1) SetDHCPServerConfigurable(NewDHCPServerConfigurable bool) (err error) {
+	return client.SetDHCPServerConfigurableCtx(context.Background(), NewDHCPServerConfigurable)
+}
+
+// SetDHCPServerConfigurableCtx is SetDHCPServerConfigurable with a context, to cancel or time out the call.
+func (client *LANHostConfigManagement1) SetDHCPServerConfigurableCtx(ctx context.Context, NewDHCPServerConfigurable bool) (err error) {
 	// Request structure.
-	request := &struct {
-		NewDHCPServerConfigurable string
-	}{}
+	request := &LANHostConfigManagement1SetDHCPServerConfigurableRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewDHCPServerConfigurable, err = soap.MarshalBoolean(NewDHCPServerConfigurable); err != nil {
@@ -597,7 +916,7 @@ func (client *LANHostConfigManagement1) SetDHCPServerConfigurable(NewDHCPServerC
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_LANHostConfigManagement_1, "SetDHCPServerConfigurable", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetDHCPServerConfigurable", request, response); err != nil {
 		return
 	}
 
@@ -607,7 +926,22 @@ func (client *LANHostConfigManagement1) SetDHCPServerConfigurable(NewDHCPServerC
 	return
 }
 
+// LANHostConfigManagement1GetDHCPServerConfigurableResponse is the response of GetDHCPServerConfigurable, with each
+// argument in its SOAP string form.
+type LANHostConfigManagement1GetDHCPServerConfigurableResponse struct {
+	NewDHCPServerConfigurable string
+}
+
+// GetDHCPServerConfigurable performs the GetDHCPServerConfigurable action of the service.
+//
+// Return values:
+//   - NewDHCPServerConfigurable (out, state variable A_ARG_TYPE_GetDHCPServerConfigurable_NewDHCPServerConfigurable)
 func (client *LANHostConfigManagement1) GetDHCPServerConfigurable() (NewDHCPServerConfigurable bool, err error) {
+	return client.GetDHCPServerConfigurableCtx(context.Background())
+}
+
+// GetDHCPServerConfigurableCtx is GetDHCPServerConfigurable with a context, to cancel or time out the call.
+func (client *LANHostConfigManagement1) GetDHCPServerConfigurableCtx(ctx context.Context) (NewDHCPServerConfigurable bool, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -615,12 +949,10 @@ func (client *LANHostConfigManagement1) GetDHCPServerConfigurable() (NewDHCPServ
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewDHCPServerConfigurable string
-	}{}
+	response := &LANHostConfigManagement1GetDHCPServerConfigurableResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_LANHostConfigManagement_1, "GetDHCPServerConfigurable", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetDHCPServerConfigurable", request, response); err != nil {
 		return
 	}
 
@@ -633,11 +965,24 @@ func (client *LANHostConfigManagement1) GetDHCPServerConfigurable() (NewDHCPServ
 	return
 }
 
+// LANHostConfigManagement1SetDHCPRelayRequest is the request of SetDHCPRelay, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type LANHostConfigManagement1SetDHCPRelayRequest struct {
+	NewDHCPRelay string
+}
+
+// SetDHCPRelay performs the SetDHCPRelay action of the service.
+//
+// Arguments:
+//   - NewDHCPRelay (in, state variable A_ARG_TYPE_SetDHCPRelay_NewDHCPRelay)
 func (client *LANHostConfigManagement1) SetDHCPRelay(NewDHCPRelay bool) (err error) {
+	return client.SetDHCPRelayCtx(context.Background(), NewDHCPRelay)
+}
+
+// SetDHCPRelayCtx is SetDHCPRelay with a context, to cancel or time out the call.
+func (client *LANHostConfigManagement1) SetDHCPRelayCtx(ctx context.Context, NewDHCPRelay bool) (err error) {
 	// Request structure.
-	request := &struct {
-		NewDHCPRelay string
-	}{}
+	request := &LANHostConfigManagement1SetDHCPRelayRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewDHCPRelay, err = soap.MarshalBoolean(NewDHCPRelay); err != nil {
@@ -649,7 +994,7 @@ func (client *LANHostConfigManagement1) SetDHCPRelay(NewDHCPRelay bool) (err err
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_LANHostConfigManagement_1, "SetDHCPRelay", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetDHCPRelay", request, response); err != nil {
 		return
 	}
 
@@ -659,7 +1004,22 @@ func (client *LANHostConfigManagement1) SetDHCPRelay(NewDHCPRelay bool) (err err
 	return
 }
 
+// LANHostConfigManagement1GetDHCPRelayResponse is the response of GetDHCPRelay, with each
+// argument in its SOAP string form.
+type LANHostConfigManagement1GetDHCPRelayResponse struct {
+	NewDHCPRelay string
+}
+
+// GetDHCPRelay performs the GetDHCPRelay action of the service.
+//
+// Return values:
+//   - NewDHCPRelay (out, state variable A_ARG_TYPE_GetDHCPRelay_NewDHCPRelay)
 func (client *LANHostConfigManagement1) GetDHCPRelay() (NewDHCPRelay bool, err error) {
+	return client.GetDHCPRelayCtx(context.Background())
+}
+
+// GetDHCPRelayCtx is GetDHCPRelay with a context, to cancel or time out the call.
+func (client *LANHostConfigManagement1) GetDHCPRelayCtx(ctx context.Context) (NewDHCPRelay bool, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -667,12 +1027,10 @@ func (client *LANHostConfigManagement1) GetDHCPRelay() (NewDHCPRelay bool, err e
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewDHCPRelay string
-	}{}
+	response := &LANHostConfigManagement1GetDHCPRelayResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_LANHostConfigManagement_1, "GetDHCPRelay", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetDHCPRelay", request, response); err != nil {
 		return
 	}
 
@@ -685,11 +1043,24 @@ func (client *LANHostConfigManagement1) GetDHCPRelay() (NewDHCPRelay bool, err e
 	return
 }
 
+// LANHostConfigManagement1SetSubnetMaskRequest is the request of SetSubnetMask, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type LANHostConfigManagement1SetSubnetMaskRequest struct {
+	NewSubnetMask string
+}
+
+// SetSubnetMask performs the SetSubnetMask action of the service.
+//
+// Arguments:
+//   - NewSubnetMask (in, state variable A_ARG_TYPE_SetSubnetMask_NewSubnetMask)
 func (client *LANHostConfigManagement1) SetSubnetMask(NewSubnetMask string) (err error) {
+	return client.SetSubnetMaskCtx(context.Background(), NewSubnetMask)
+}
+
+// SetSubnetMaskCtx is SetSubnetMask with a context, to cancel or time out the call.
+func (client *LANHostConfigManagement1) SetSubnetMaskCtx(ctx context.Context, NewSubnetMask string) (err error) {
 	// Request structure.
-	request := &struct {
-		NewSubnetMask string
-	}{}
+	request := &LANHostConfigManagement1SetSubnetMaskRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewSubnetMask, err = soap.MarshalString(NewSubnetMask); err != nil {
@@ -701,7 +1072,7 @@ func (client *LANHostConfigManagement1) SetSubnetMask(NewSubnetMask string) (err
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_LANHostConfigManagement_1, "SetSubnetMask", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetSubnetMask", request, response); err != nil {
 		return
 	}
 
@@ -711,7 +1082,22 @@ func (client *LANHostConfigManagement1) SetSubnetMask(NewSubnetMask string) (err
 	return
 }
 
+// LANHostConfigManagement1GetSubnetMaskResponse is the response of GetSubnetMask, with each
+// argument in its SOAP string form.
+type LANHostConfigManagement1GetSubnetMaskResponse struct {
+	NewSubnetMask string
+}
+
+// GetSubnetMask performs the GetSubnetMask action of the service.
+//
+// Return values:
+//   - NewSubnetMask (out, state variable A_ARG_TYPE_GetSubnetMask_NewSubnetMask)
 func (client *LANHostConfigManagement1) GetSubnetMask() (NewSubnetMask string, err error) {
+	return client.GetSubnetMaskCtx(context.Background())
+}
+
+// GetSubnetMaskCtx is GetSubnetMask with a context, to cancel or time out the call.
+func (client *LANHostConfigManagement1) GetSubnetMaskCtx(ctx context.Context) (NewSubnetMask string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -719,12 +1105,10 @@ func (client *LANHostConfigManagement1) GetSubnetMask() (NewSubnetMask string, e
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewSubnetMask string
-	}{}
+	response := &LANHostConfigManagement1GetSubnetMaskResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_LANHostConfigManagement_1, "GetSubnetMask", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetSubnetMask", request, response); err != nil {
 		return
 	}
 
@@ -737,11 +1121,24 @@ func (client *LANHostConfigManagement1) GetSubnetMask() (NewSubnetMask string, e
 	return
 }
 
+// LANHostConfigManagement1SetIPRouterRequest is the request of SetIPRouter, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type LANHostConfigManagement1SetIPRouterRequest struct {
+	NewIPRouters string
+}
+
+// SetIPRouter performs the SetIPRouter action of the service.
+//
+// Arguments:
+//   - NewIPRouters (in, state variable A_ARG_TYPE_SetIPRouter_NewIPRouters)
 func (client *LANHostConfigManagement1) SetIPRouter(NewIPRouters string) (err error) {
+	return client.SetIPRouterCtx(context.Background(), NewIPRouters)
+}
+
+// SetIPRouterCtx is SetIPRouter with a context, to cancel or time out the call.
+func (client *LANHostConfigManagement1) SetIPRouterCtx(ctx context.Context, NewIPRouters string) (err error) {
 	// Request structure.
-	request := &struct {
-		NewIPRouters string
-	}{}
+	request := &LANHostConfigManagement1SetIPRouterRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewIPRouters, err = soap.MarshalString(NewIPRouters); err != nil {
@@ -753,7 +1150,7 @@ func (client *LANHostConfigManagement1) SetIPRouter(NewIPRouters string) (err er
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_LANHostConfigManagement_1, "SetIPRouter", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetIPRouter", request, response); err != nil {
 		return
 	}
 
@@ -763,11 +1160,24 @@ func (client *LANHostConfigManagement1) SetIPRouter(NewIPRouters string) (err er
 	return
 }
 
+// LANHostConfigManagement1DeleteIPRouterRequest is the request of DeleteIPRouter, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type LANHostConfigManagement1DeleteIPRouterRequest struct {
+	NewIPRouters string
+}
+
+// DeleteIPRouter performs the DeleteIPRouter action of the service.
+//
+// Arguments:
+//   - NewIPRouters (in, state variable A_ARG_TYPE_DeleteIPRouter_NewIPRouters)
 func (client *LANHostConfigManagement1) DeleteIPRouter(NewIPRouters string) (err error) {
+	return client.DeleteIPRouterCtx(context.Background(), NewIPRouters)
+}
+
+// DeleteIPRouterCtx is DeleteIPRouter with a context, to cancel or time out the call.
+func (client *LANHostConfigManagement1) DeleteIPRouterCtx(ctx context.Context, NewIPRouters string) (err error) {
 	// Request structure.
-	request := &struct {
-		NewIPRouters string
-	}{}
+	request := &LANHostConfigManagement1DeleteIPRouterRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewIPRouters, err = soap.MarshalString(NewIPRouters); err != nil {
@@ -779,7 +1189,7 @@ func (client *LANHostConfigManagement1) DeleteIPRouter(NewIPRouters string) (err
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_LANHostConfigManagement_1, "DeleteIPRouter", request, response); err != nil {
+	if err = client.PerformAction(ctx, "DeleteIPRouter", request, response); err != nil {
 		return
 	}
 
@@ -789,7 +1199,22 @@ func (client *LANHostConfigManagement1) DeleteIPRouter(NewIPRouters string) (err
 	return
 }
 
+// LANHostConfigManagement1GetIPRoutersListResponse is the response of GetIPRoutersList, with each
+// argument in its SOAP string form.
+type LANHostConfigManagement1GetIPRoutersListResponse struct {
+	NewIPRouters string
+}
+
+// GetIPRoutersList performs the GetIPRoutersList action of the service.
+//
+// Return values:
+//   - NewIPRouters (out, state variable A_ARG_TYPE_GetIPRoutersList_NewIPRouters)
 func (client *LANHostConfigManagement1) GetIPRoutersList() (NewIPRouters string, err error) {
+	return client.GetIPRoutersListCtx(context.Background())
+}
+
+// GetIPRoutersListCtx is GetIPRoutersList with a context, to cancel or time out the call.
+func (client *LANHostConfigManagement1) GetIPRoutersListCtx(ctx context.Context) (NewIPRouters string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -797,12 +1222,10 @@ func (client *LANHostConfigManagement1) GetIPRoutersList() (NewIPRouters string,
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewIPRouters string
-	}{}
+	response := &LANHostConfigManagement1GetIPRoutersListResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_LANHostConfigManagement_1, "GetIPRoutersList", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetIPRoutersList", request, response); err != nil {
 		return
 	}
 
@@ -815,11 +1238,24 @@ func (client *LANHostConfigManagement1) GetIPRoutersList() (NewIPRouters string,
 	return
 }
 
+// LANHostConfigManagement1SetDomainNameRequest is the request of SetDomainName, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type LANHostConfigManagement1SetDomainNameRequest struct {
+	NewDomainName string
+}
+
+// SetDomainName performs the SetDomainName action of the service.
+//
+// Arguments:
+//   - NewDomainName (in, state variable A_ARG_TYPE_SetDomainName_NewDomainName)
 func (client *LANHostConfigManagement1) SetDomainName(NewDomainName string) (err error) {
+	return client.SetDomainNameCtx(context.Background(), NewDomainName)
+}
+
+// SetDomainNameCtx is SetDomainName with a context, to cancel or time out the call.
+func (client *LANHostConfigManagement1) SetDomainNameCtx(ctx context.Context, NewDomainName string) (err error) {
 	// Request structure.
-	request := &struct {
-		NewDomainName string
-	}{}
+	request := &LANHostConfigManagement1SetDomainNameRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewDomainName, err = soap.MarshalString(NewDomainName); err != nil {
@@ -831,7 +1267,7 @@ func (client *LANHostConfigManagement1) SetDomainName(NewDomainName string) (err
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_LANHostConfigManagement_1, "SetDomainName", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetDomainName", request, response); err != nil {
 		return
 	}
 
@@ -841,7 +1277,22 @@ func (client *LANHostConfigManagement1) SetDomainName(NewDomainName string) (err
 	return
 }
 
+// LANHostConfigManagement1GetDomainNameResponse is the response of GetDomainName, with each
+// argument in its SOAP string form.
+type LANHostConfigManagement1GetDomainNameResponse struct {
+	NewDomainName string
+}
+
+// GetDomainName performs the GetDomainName action of the service.
+//
+// Return values:
+//   - NewDomainName (out, state variable A_ARG_TYPE_GetDomainName_NewDomainName)
 func (client *LANHostConfigManagement1) GetDomainName() (NewDomainName string, err error) {
+	return client.GetDomainNameCtx(context.Background())
+}
+
+// GetDomainNameCtx is GetDomainName with a context, to cancel or time out the call.
+func (client *LANHostConfigManagement1) GetDomainNameCtx(ctx context.Context) (NewDomainName string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -849,12 +1300,10 @@ func (client *LANHostConfigManagement1) GetDomainName() (NewDomainName string, e
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewDomainName string
-	}{}
+	response := &LANHostConfigManagement1GetDomainNameResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_LANHostConfigManagement_1, "GetDomainName", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetDomainName", request, response); err != nil {
 		return
 	}
 
@@ -867,13 +1316,26 @@ func (client *LANHostConfigManagement1) GetDomainName() (NewDomainName string, e
 	return
 }
 
+// LANHostConfigManagement1SetAddressRangeRequest is the request of SetAddressRange, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type LANHostConfigManagement1SetAddressRangeRequest struct {
+	NewMinAddress string
+	NewMaxAddress string
+}
+
+// SetAddressRange performs the SetAddressRange action of the service.
+//
+// Arguments:
+//   - NewMinAddress (in, state variable A_ARG_TYPE_SetAddressRange_NewMinAddress)
+//   - NewMaxAddress (in, state variable A_ARG_TYPE_SetAddressRange_NewMaxAddress)
 func (client *LANHostConfigManagement1) SetAddressRange(NewMinAddress string, NewMaxAddress string) (err error) {
-	// Request structure.
-	request := &struct {
-		NewMinAddress string
+	return client.SetAddressRangeCtx(context.Background(), NewMinAddress, NewMaxAddress)
+}
 
-		NewMaxAddress string
-	}{}
+// SetAddressRangeCtx is SetAddressRange with a context, to cancel or time out the call.
+func (client *LANHostConfigManagement1) SetAddressRangeCtx(ctx context.Context, NewMinAddress string, NewMaxAddress string) (err error) {
+	// Request structure.
+	request := &LANHostConfigManagement1SetAddressRangeRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewMinAddress, err = soap.MarshalString(NewMinAddress); err != nil {
@@ -888,7 +1350,7 @@ func (client *LANHostConfigManagement1) SetAddressRange(NewMinAddress string, Ne
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_LANHostConfigManagement_1, "SetAddressRange", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetAddressRange", request, response); err != nil {
 		return
 	}
 
@@ -898,7 +1360,24 @@ func (client *LANHostConfigManagement1) SetAddressRange(NewMinAddress string, Ne
 	return
 }
 
+// LANHostConfigManagement1GetAddressRangeResponse is the response of GetAddressRange, with each
+// argument in its SOAP string form.
+type LANHostConfigManagement1GetAddressRangeResponse struct {
+	NewMinAddress string
+	NewMaxAddress string
+}
+
+// GetAddressRange performs the GetAddressRange action of the service.
+//
+// Return values:
+//   - NewMinAddress (out, state variable A_ARG_TYPE_GetAddressRange_NewMinAddress)
+//   - NewMaxAddress (out, state variable A_ARG_TYPE_GetAddressRange_NewMaxAddress)
 func (client *LANHostConfigManagement1) GetAddressRange() (NewMinAddress string, NewMaxAddress string, err error) {
+	return client.GetAddressRangeCtx(context.Background())
+}
+
+// GetAddressRangeCtx is GetAddressRange with a context, to cancel or time out the call.
+func (client *LANHostConfigManagement1) GetAddressRangeCtx(ctx context.Context) (NewMinAddress string, NewMaxAddress string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -906,14 +1385,10 @@ func (client *LANHostConfigManagement1) GetAddressRange() (NewMinAddress string,
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewMinAddress string
-
-		NewMaxAddress string
-	}{}
+	response := &LANHostConfigManagement1GetAddressRangeResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_LANHostConfigManagement_1, "GetAddressRange", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetAddressRange", request, response); err != nil {
 		return
 	}
 
@@ -929,11 +1404,24 @@ func (client *LANHostConfigManagement1) GetAddressRange() (NewMinAddress string,
 	return
 }
 
+// LANHostConfigManagement1SetReservedAddressRequest is the request of SetReservedAddress, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type LANHostConfigManagement1SetReservedAddressRequest struct {
+	NewReservedAddresses string
+}
+
+// SetReservedAddress performs the SetReservedAddress action of the service.
+//
+// Arguments:
+//   - NewReservedAddresses (in, state variable A_ARG_TYPE_SetReservedAddress_NewReservedAddresses)
 func (client *LANHostConfigManagement1) SetReservedAddress(NewReservedAddresses string) (err error) {
+	return client.SetReservedAddressCtx(context.Background(), NewReservedAddresses)
+}
+
+// SetReservedAddressCtx is SetReservedAddress with a context, to cancel or time out the call.
+func (client *LANHostConfigManagement1) SetReservedAddressCtx(ctx context.Context, NewReservedAddresses string) (err error) {
 	// Request structure.
-	request := &struct {
-		NewReservedAddresses string
-	}{}
+	request := &LANHostConfigManagement1SetReservedAddressRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewReservedAddresses, err = soap.MarshalString(NewReservedAddresses); err != nil {
@@ -945,7 +1433,7 @@ func (client *LANHostConfigManagement1) SetReservedAddress(NewReservedAddresses
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_LANHostConfigManagement_1, "SetReservedAddress", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetReservedAddress", request, response); err != nil {
 		return
 	}
 
@@ -955,11 +1443,24 @@ func (client *LANHostConfigManagement1) SetReservedAddress(NewReservedAddresses
 	return
 }
 
+// LANHostConfigManagement1DeleteReservedAddressRequest is the request of DeleteReservedAddress, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type LANHostConfigManagement1DeleteReservedAddressRequest struct {
+	NewReservedAddresses string
+}
+
+// DeleteReservedAddress performs the DeleteReservedAddress action of the service.
+//
+// Arguments:
+//   - NewReservedAddresses (in, state variable A_ARG_TYPE_DeleteReservedAddress_NewReservedAddresses)
 func (client *LANHostConfigManagement1) DeleteReservedAddress(NewReservedAddresses string) (err error) {
+	return client.DeleteReservedAddressCtx(context.Background(), NewReservedAddresses)
+}
+
+// DeleteReservedAddressCtx is DeleteReservedAddress with a context, to cancel or time out the call.
+func (client *LANHostConfigManagement1) DeleteReservedAddressCtx(ctx context.Context, NewReservedAddresses string) (err error) {
 	// Request structure.
-	request := &struct {
-		NewReservedAddresses string
-	}{}
+	request := &LANHostConfigManagement1DeleteReservedAddressRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewReservedAddresses, err = soap.MarshalString(NewReservedAddresses); err != nil {
@@ -971,7 +1472,7 @@ func (client *LANHostConfigManagement1) DeleteReservedAddress(NewReservedAddress
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_LANHostConfigManagement_1, "DeleteReservedAddress", request, response); err != nil {
+	if err = client.PerformAction(ctx, "DeleteReservedAddress", request, response); err != nil {
 		return
 	}
 
@@ -981,7 +1482,22 @@ func (client *LANHostConfigManagement1) DeleteReservedAddress(NewReservedAddress
 	return
 }
 
+// LANHostConfigManagement1GetReservedAddressesResponse is the response of GetReservedAddresses, with each
+// argument in its SOAP string form.
+type LANHostConfigManagement1GetReservedAddressesResponse struct {
+	NewReservedAddresses string
+}
+
+// GetReservedAddresses performs the GetReservedAddresses action of the service.
+//
+// Return values:
+//   - NewReservedAddresses (out, state variable A_ARG_TYPE_GetReservedAddresses_NewReservedAddresses)
 func (client *LANHostConfigManagement1) GetReservedAddresses() (NewReservedAddresses string, err error) {
+	return client.GetReservedAddressesCtx(context.Background())
+}
+
+// GetReservedAddressesCtx is GetReservedAddresses with a context, to cancel or time out the call.
+func (client *LANHostConfigManagement1) GetReservedAddressesCtx(ctx context.Context) (NewReservedAddresses string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -989,12 +1505,10 @@ func (client *LANHostConfigManagement1) GetReservedAddresses() (NewReservedAddre
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewReservedAddresses string
-	}{}
+	response := &LANHostConfigManagement1GetReservedAddressesResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_LANHostConfigManagement_1, "GetReservedAddresses", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetReservedAddresses", request, response); err != nil {
 		return
 	}
 
@@ -1007,11 +1521,24 @@ func (client *LANHostConfigManagement1) GetReservedAddresses() (NewReservedAddre
 	return
 }
 
+// LANHostConfigManagement1SetDNSServerRequest is the request of SetDNSServer, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type LANHostConfigManagement1SetDNSServerRequest struct {
+	NewDNSServers string
+}
+
+// SetDNSServer performs the SetDNSServer action of the service.
+//
+// Arguments:
+//   - NewDNSServers (in, state variable A_ARG_TYPE_SetDNSServer_NewDNSServers)
 func (client *LANHostConfigManagement1) SetDNSServer(NewDNSServers string) (err error) {
+	return client.SetDNSServerCtx(context.Background(), NewDNSServers)
+}
+
+// SetDNSServerCtx is SetDNSServer with a context, to cancel or time out the call.
+func (client *LANHostConfigManagement1) SetDNSServerCtx(ctx context.Context, NewDNSServers string) (err error) {
 	// Request structure.
-	request := &struct {
-		NewDNSServers string
-	}{}
+	request := &LANHostConfigManagement1SetDNSServerRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewDNSServers, err = soap.MarshalString(NewDNSServers); err != nil {
@@ -1023,7 +1550,7 @@ func (client *LANHostConfigManagement1) SetDNSServer(NewDNSServers string) (err
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_LANHostConfigManagement_1, "SetDNSServer", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetDNSServer", request, response); err != nil {
 		return
 	}
 
@@ -1033,11 +1560,24 @@ func (client *LANHostConfigManagement1) SetDNSServer(NewDNSServers string) (err
 	return
 }
 
+// LANHostConfigManagement1DeleteDNSServerRequest is the request of DeleteDNSServer, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type LANHostConfigManagement1DeleteDNSServerRequest struct {
+	NewDNSServers string
+}
+
+// DeleteDNSServer performs the DeleteDNSServer action of the service.
+//
+// Arguments:
+//   - NewDNSServers (in, state variable A_ARG_TYPE_DeleteDNSServer_NewDNSServers)
 func (client *LANHostConfigManagement1) DeleteDNSServer(NewDNSServers string) (err error) {
+	return client.DeleteDNSServerCtx(context.Background(), NewDNSServers)
+}
+
+// DeleteDNSServerCtx is DeleteDNSServer with a context, to cancel or time out the call.
+func (client *LANHostConfigManagement1) DeleteDNSServerCtx(ctx context.Context, NewDNSServers string) (err error) {
 	// Request structure.
-	request := &struct {
-		NewDNSServers string
-	}{}
+	request := &LANHostConfigManagement1DeleteDNSServerRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewDNSServers, err = soap.MarshalString(NewDNSServers); err != nil {
@@ -1049,7 +1589,7 @@ func (client *LANHostConfigManagement1) DeleteDNSServer(NewDNSServers string) (e
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_LANHostConfigManagement_1, "DeleteDNSServer", request, response); err != nil {
+	if err = client.PerformAction(ctx, "DeleteDNSServer", request, response); err != nil {
 		return
 	}
 
@@ -1059,7 +1599,22 @@ func (client *LANHostConfigManagement1) DeleteDNSServer(NewDNSServers string) (e
 	return
 }
 
+// LANHostConfigManagement1GetDNSServersResponse is the response of GetDNSServers, with each
+// argument in its SOAP string form.
+type LANHostConfigManagement1GetDNSServersResponse struct {
+	NewDNSServers string
+}
+
+// GetDNSServers performs the GetDNSServers action of the service.
+//
+// Return values:
+//   - NewDNSServers (out, state variable A_ARG_TYPE_GetDNSServers_NewDNSServers)
 func (client *LANHostConfigManagement1) GetDNSServers() (NewDNSServers string, err error) {
+	return client.GetDNSServersCtx(context.Background())
+}
+
+// GetDNSServersCtx is GetDNSServers with a context, to cancel or time out the call.
+func (client *LANHostConfigManagement1) GetDNSServersCtx(ctx context.Context) (NewDNSServers string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -1067,12 +1622,10 @@ func (client *LANHostConfigManagement1) GetDNSServers() (NewDNSServers string, e
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewDNSServers string
-	}{}
+	response := &LANHostConfigManagement1GetDNSServersResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_LANHostConfigManagement_1, "GetDNSServers", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetDNSServers", request, response); err != nil {
 		return
 	}
 
@@ -1092,6 +1645,17 @@ type Layer3Forwarding1 struct {
 	goupnp.ServiceClient
 }
 
+// Layer3Forwarding1Client is the interface of the actions of Layer3Forwarding1, for
+// substituting fakes or mocks for the service in tests.
+type Layer3Forwarding1Client interface {
+	SetDefaultConnectionService(NewDefaultConnectionService string) (err error)
+	SetDefaultConnectionServiceCtx(ctx context.Context, NewDefaultConnectionService string) (err error)
+	GetDefaultConnectionService() (NewDefaultConnectionService string, err error)
+	GetDefaultConnectionServiceCtx(ctx context.Context) (NewDefaultConnectionService string, err error)
+}
+
+var _ Layer3Forwarding1Client = new(Layer3Forwarding1)
+
 // NewLayer3Forwarding1Clients discovers instances of the service on the network,
 // and returns clients to any that are found. errors will contain an error for
 // any devices that replied but which could not be queried, and err will be set
@@ -1145,11 +1709,42 @@ func newLayer3Forwarding1ClientsFromGenericClients(genericClients []goupnp.Servi
 	return clients
 }
 
+// Layer3Forwarding1Timeouts are the default timeouts of the actions of
+// Layer3Forwarding1, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var Layer3Forwarding1Timeouts = map[string]time.Duration{
+	"": 10 * time.Second,
+}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *Layer3Forwarding1) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, Layer3Forwarding1Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_Layer3Forwarding_1, actionName, request, response)
+}
+
+// Layer3Forwarding1SetDefaultConnectionServiceRequest is the request of SetDefaultConnectionService, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type Layer3Forwarding1SetDefaultConnectionServiceRequest struct {
+	NewDefaultConnectionService string
+}
+
+// SetDefaultConnectionService performs the SetDefaultConnectionService action of the service.
+//
+// Arguments:
+//   - NewDefaultConnectionService (in, state variable A_ARG_TYPE_SetDefaultConnectionService_NewDefaultConnectionService)
 func (client *Layer3Forwarding1) SetDefaultConnectionService(NewDefaultConnectionService string) (err error) {
+	return client.SetDefaultConnectionServiceCtx(context.Background(), NewDefaultConnectionService)
+}
+
+// SetDefaultConnectionServiceCtx is SetDefaultConnectionService with a context, to cancel or time out the call.
+func (client *Layer3Forwarding1) SetDefaultConnectionServiceCtx(ctx context.Context, NewDefaultConnectionService string) (err error) {
 	// Request structure.
-	request := &struct {
-		NewDefaultConnectionService string
-	}{}
+	request := &Layer3Forwarding1SetDefaultConnectionServiceRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewDefaultConnectionService, err = soap.MarshalString(NewDefaultConnectionService); err != nil {
@@ -1161,7 +1756,7 @@ func (client *Layer3Forwarding1) SetDefaultConnectionService(NewDefaultConnectio
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_Layer3Forwarding_1, "SetDefaultConnectionService", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetDefaultConnectionService", request, response); err != nil {
 		return
 	}
 
@@ -1171,7 +1766,22 @@ func (client *Layer3Forwarding1) SetDefaultConnectionService(NewDefaultConnectio
 	return
 }
 
+// Layer3Forwarding1GetDefaultConnectionServiceResponse is the response of GetDefaultConnectionService, with each
+// argument in its SOAP string form.
+type Layer3Forwarding1GetDefaultConnectionServiceResponse struct {
+	NewDefaultConnectionService string
+}
+
+// GetDefaultConnectionService performs the GetDefaultConnectionService action of the service.
+//
+// Return values:
+//   - NewDefaultConnectionService (out, state variable A_ARG_TYPE_GetDefaultConnectionService_NewDefaultConnectionService)
 func (client *Layer3Forwarding1) GetDefaultConnectionService() (NewDefaultConnectionService string, err error) {
+	return client.GetDefaultConnectionServiceCtx(context.Background())
+}
+
+// GetDefaultConnectionServiceCtx is GetDefaultConnectionService with a context, to cancel or time out the call.
+func (client *Layer3Forwarding1) GetDefaultConnectionServiceCtx(ctx context.Context) (NewDefaultConnectionService string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -1179,12 +1789,10 @@ func (client *Layer3Forwarding1) GetDefaultConnectionService() (NewDefaultConnec
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewDefaultConnectionService string
-	}{}
+	response := &Layer3Forwarding1GetDefaultConnectionServiceResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_Layer3Forwarding_1, "GetDefaultConnectionService", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetDefaultConnectionService", request, response); err != nil {
 		return
 	}
 
@@ -1204,14 +1812,135 @@ type WANCableLinkConfig1 struct {
 	goupnp.ServiceClient
 }
 
-// NewWANCableLinkConfig1Clients discovers instances of the service on the network,
-// and returns clients to any that are found. errors will contain an error for
-// any devices that replied but which could not be queried, and err will be set
-// if the discovery process failed outright.
-//
-// This is a typical entry calling point into this package.
-func NewWANCableLinkConfig1Clients() (clients []*WANCableLinkConfig1, errors []error, err error) {
-	var genericClients []goupnp.ServiceClient
+// WANCableLinkConfig1Client is the interface of the actions of WANCableLinkConfig1, for
+// substituting fakes or mocks for the service in tests.
+type WANCableLinkConfig1Client interface {
+	GetCableLinkConfigInfo() (NewCableLinkConfigState WANCableLinkConfig1CableLinkConfigState, NewLinkType WANCableLinkConfig1LinkType, err error)
+	GetCableLinkConfigInfoCtx(ctx context.Context) (NewCableLinkConfigState WANCableLinkConfig1CableLinkConfigState, NewLinkType WANCableLinkConfig1LinkType, err error)
+	GetDownstreamFrequency() (NewDownstreamFrequency uint32, err error)
+	GetDownstreamFrequencyCtx(ctx context.Context) (NewDownstreamFrequency uint32, err error)
+	GetDownstreamModulation() (NewDownstreamModulation WANCableLinkConfig1DownstreamModulation, err error)
+	GetDownstreamModulationCtx(ctx context.Context) (NewDownstreamModulation WANCableLinkConfig1DownstreamModulation, err error)
+	GetUpstreamFrequency() (NewUpstreamFrequency uint32, err error)
+	GetUpstreamFrequencyCtx(ctx context.Context) (NewUpstreamFrequency uint32, err error)
+	GetUpstreamModulation() (NewUpstreamModulation WANCableLinkConfig1UpstreamModulation, err error)
+	GetUpstreamModulationCtx(ctx context.Context) (NewUpstreamModulation WANCableLinkConfig1UpstreamModulation, err error)
+	GetUpstreamChannelID() (NewUpstreamChannelID uint32, err error)
+	GetUpstreamChannelIDCtx(ctx context.Context) (NewUpstreamChannelID uint32, err error)
+	GetUpstreamPowerLevel() (NewUpstreamPowerLevel uint32, err error)
+	GetUpstreamPowerLevelCtx(ctx context.Context) (NewUpstreamPowerLevel uint32, err error)
+	GetBPIEncryptionEnabled() (NewBPIEncryptionEnabled bool, err error)
+	GetBPIEncryptionEnabledCtx(ctx context.Context) (NewBPIEncryptionEnabled bool, err error)
+	GetConfigFile() (NewConfigFile string, err error)
+	GetConfigFileCtx(ctx context.Context) (NewConfigFile string, err error)
+	GetTFTPServer() (NewTFTPServer string, err error)
+	GetTFTPServerCtx(ctx context.Context) (NewTFTPServer string, err error)
+}
+
+var _ WANCableLinkConfig1Client = new(WANCableLinkConfig1)
+
+// WANCableLinkConfig1CableLinkConfigState is a value of the state variable CableLinkConfigState of
+// WANCableLinkConfig1.
+type WANCableLinkConfig1CableLinkConfigState string
+
+// Allowed values of WANCableLinkConfig1CableLinkConfigState.
+const (
+	WANCableLinkConfig1CableLinkConfigState_notReady              WANCableLinkConfig1CableLinkConfigState = "notReady"
+	WANCableLinkConfig1CableLinkConfigState_dsSyncComplete        WANCableLinkConfig1CableLinkConfigState = "dsSyncComplete"
+	WANCableLinkConfig1CableLinkConfigState_usParamAcquired       WANCableLinkConfig1CableLinkConfigState = "usParamAcquired"
+	WANCableLinkConfig1CableLinkConfigState_rangingComplete       WANCableLinkConfig1CableLinkConfigState = "rangingComplete"
+	WANCableLinkConfig1CableLinkConfigState_ipComplete            WANCableLinkConfig1CableLinkConfigState = "ipComplete"
+	WANCableLinkConfig1CableLinkConfigState_todEstablished        WANCableLinkConfig1CableLinkConfigState = "todEstablished"
+	WANCableLinkConfig1CableLinkConfigState_paramTransferComplete WANCableLinkConfig1CableLinkConfigState = "paramTransferComplete"
+	WANCableLinkConfig1CableLinkConfigState_registrationComplete  WANCableLinkConfig1CableLinkConfigState = "registrationComplete"
+	WANCableLinkConfig1CableLinkConfigState_operational           WANCableLinkConfig1CableLinkConfigState = "operational"
+	WANCableLinkConfig1CableLinkConfigState_accessDenied          WANCableLinkConfig1CableLinkConfigState = "accessDenied"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v WANCableLinkConfig1CableLinkConfigState) Valid() bool {
+	switch v {
+	case WANCableLinkConfig1CableLinkConfigState_notReady,
+		WANCableLinkConfig1CableLinkConfigState_dsSyncComplete,
+		WANCableLinkConfig1CableLinkConfigState_usParamAcquired,
+		WANCableLinkConfig1CableLinkConfigState_rangingComplete,
+		WANCableLinkConfig1CableLinkConfigState_ipComplete,
+		WANCableLinkConfig1CableLinkConfigState_todEstablished,
+		WANCableLinkConfig1CableLinkConfigState_paramTransferComplete,
+		WANCableLinkConfig1CableLinkConfigState_registrationComplete,
+		WANCableLinkConfig1CableLinkConfigState_operational,
+		WANCableLinkConfig1CableLinkConfigState_accessDenied:
+		return true
+	}
+	return false
+}
+
+// WANCableLinkConfig1LinkType is a value of the state variable LinkType of
+// WANCableLinkConfig1.
+type WANCableLinkConfig1LinkType string
+
+// Allowed values of WANCableLinkConfig1LinkType.
+const (
+	WANCableLinkConfig1LinkType_Ethernet WANCableLinkConfig1LinkType = "Ethernet"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v WANCableLinkConfig1LinkType) Valid() bool {
+	switch v {
+	case WANCableLinkConfig1LinkType_Ethernet:
+		return true
+	}
+	return false
+}
+
+// WANCableLinkConfig1DownstreamModulation is a value of the state variable DownstreamModulation of
+// WANCableLinkConfig1.
+type WANCableLinkConfig1DownstreamModulation string
+
+// Allowed values of WANCableLinkConfig1DownstreamModulation.
+const (
+	WANCableLinkConfig1DownstreamModulation_64QAM  WANCableLinkConfig1DownstreamModulation = "64QAM"
+	WANCableLinkConfig1DownstreamModulation_256QAM WANCableLinkConfig1DownstreamModulation = "256QAM"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v WANCableLinkConfig1DownstreamModulation) Valid() bool {
+	switch v {
+	case WANCableLinkConfig1DownstreamModulation_64QAM,
+		WANCableLinkConfig1DownstreamModulation_256QAM:
+		return true
+	}
+	return false
+}
+
+// WANCableLinkConfig1UpstreamModulation is a value of the state variable UpstreamModulation of
+// WANCableLinkConfig1.
+type WANCableLinkConfig1UpstreamModulation string
+
+// Allowed values of WANCableLinkConfig1UpstreamModulation.
+const (
+	WANCableLinkConfig1UpstreamModulation_QPSK  WANCableLinkConfig1UpstreamModulation = "QPSK"
+	WANCableLinkConfig1UpstreamModulation_16QAM WANCableLinkConfig1UpstreamModulation = "16QAM"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v WANCableLinkConfig1UpstreamModulation) Valid() bool {
+	switch v {
+	case WANCableLinkConfig1UpstreamModulation_QPSK,
+		WANCableLinkConfig1UpstreamModulation_16QAM:
+		return true
+	}
+	return false
+}
+
+// NewWANCableLinkConfig1Clients discovers instances of the service on the network,
+// and returns clients to any that are found. errors will contain an error for
+// any devices that replied but which could not be queried, and err will be set
+// if the discovery process failed outright.
+//
+// This is a typical entry calling point into this package.
+func NewWANCableLinkConfig1Clients() (clients []*WANCableLinkConfig1, errors []error, err error) {
+	var genericClients []goupnp.ServiceClient
 	if genericClients, errors, err = goupnp.NewServiceClients(URN_WANCableLinkConfig_1); err != nil {
 		return
 	}
@@ -1257,13 +1986,42 @@ func newWANCableLinkConfig1ClientsFromGenericClients(genericClients []goupnp.Ser
 	return clients
 }
 
+// WANCableLinkConfig1Timeouts are the default timeouts of the actions of
+// WANCableLinkConfig1, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var WANCableLinkConfig1Timeouts = map[string]time.Duration{
+	"": 10 * time.Second,
+}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *WANCableLinkConfig1) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, WANCableLinkConfig1Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_WANCableLinkConfig_1, actionName, request, response)
+}
+
+// WANCableLinkConfig1GetCableLinkConfigInfoResponse is the response of GetCableLinkConfigInfo, with each
+// argument in its SOAP string form.
+type WANCableLinkConfig1GetCableLinkConfigInfoResponse struct {
+	NewCableLinkConfigState string
+	NewLinkType             string
+}
+
+// GetCableLinkConfigInfo performs the GetCableLinkConfigInfo action of the service.
 //
 // Return values:
-//
-// * NewCableLinkConfigState: allowed values: notReady, dsSyncComplete, usParamAcquired, rangingComplete, ipComplete, todEstablished, paramTransferComplete, registrationComplete, operational, accessDenied
-//
-// * NewLinkType: allowed values: Ethernet
-func (client *WANCableLinkConfig1) GetCableLinkConfigInfo() (NewCableLinkConfigState string, NewLinkType string, err error) {
+//   - NewCableLinkConfigState (out, state variable CableLinkConfigState): allowed values notReady, dsSyncComplete, usParamAcquired, rangingComplete, ipComplete, todEstablished, paramTransferComplete, registrationComplete, operational, accessDenied
+//   - NewLinkType (out, state variable LinkType): allowed values Ethernet
+func (client *WANCableLinkConfig1) GetCableLinkConfigInfo() (NewCableLinkConfigState WANCableLinkConfig1CableLinkConfigState, NewLinkType WANCableLinkConfig1LinkType, err error) {
+	return client.GetCableLinkConfigInfoCtx(context.Background())
+}
+
+// GetCableLinkConfigInfoCtx is GetCableLinkConfigInfo with a context, to cancel or time out the call.
+func (client *WANCableLinkConfig1) GetCableLinkConfigInfoCtx(ctx context.Context) (NewCableLinkConfigState WANCableLinkConfig1CableLinkConfigState, NewLinkType WANCableLinkConfig1LinkType, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -1271,30 +2029,37 @@ func (client *WANCableLinkConfig1) GetCableLinkConfigInfo() (NewCableLinkConfigS
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewCableLinkConfigState string
-
-		NewLinkType string
-	}{}
+	response := &WANCableLinkConfig1GetCableLinkConfigInfoResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANCableLinkConfig_1, "GetCableLinkConfigInfo", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetCableLinkConfigInfo", request, response); err != nil {
 		return
 	}
 
 	// BEGIN Unmarshal arguments from response.
 
-	if NewCableLinkConfigState, err = soap.UnmarshalString(response.NewCableLinkConfigState); err != nil {
-		return
-	}
-	if NewLinkType, err = soap.UnmarshalString(response.NewLinkType); err != nil {
-		return
-	}
+	NewCableLinkConfigState = WANCableLinkConfig1CableLinkConfigState(response.NewCableLinkConfigState)
+	NewLinkType = WANCableLinkConfig1LinkType(response.NewLinkType)
 	// END Unmarshal arguments from response.
 	return
 }
 
+// WANCableLinkConfig1GetDownstreamFrequencyResponse is the response of GetDownstreamFrequency, with each
+// argument in its SOAP string form.
+type WANCableLinkConfig1GetDownstreamFrequencyResponse struct {
+	NewDownstreamFrequency string
+}
+
+// GetDownstreamFrequency performs the GetDownstreamFrequency action of the service.
+//
+// Return values:
+//   - NewDownstreamFrequency (out, state variable A_ARG_TYPE_GetDownstreamFrequency_NewDownstreamFrequency)
 func (client *WANCableLinkConfig1) GetDownstreamFrequency() (NewDownstreamFrequency uint32, err error) {
+	return client.GetDownstreamFrequencyCtx(context.Background())
+}
+
+// GetDownstreamFrequencyCtx is GetDownstreamFrequency with a context, to cancel or time out the call.
+func (client *WANCableLinkConfig1) GetDownstreamFrequencyCtx(ctx context.Context) (NewDownstreamFrequency uint32, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -1302,12 +2067,10 @@ func (client *WANCableLinkConfig1) GetDownstreamFrequency() (NewDownstreamFreque
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewDownstreamFrequency string
-	}{}
+	response := &WANCableLinkConfig1GetDownstreamFrequencyResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANCableLinkConfig_1, "GetDownstreamFrequency", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetDownstreamFrequency", request, response); err != nil {
 		return
 	}
 
@@ -1320,11 +2083,22 @@ func (client *WANCableLinkConfig1) GetDownstreamFrequency() (NewDownstreamFreque
 	return
 }
 
+// WANCableLinkConfig1GetDownstreamModulationResponse is the response of GetDownstreamModulation, with each
+// argument in its SOAP string form.
+type WANCableLinkConfig1GetDownstreamModulationResponse struct {
+	NewDownstreamModulation string
+}
+
+// GetDownstreamModulation performs the GetDownstreamModulation action of the service.
 //
 // Return values:
-//
-// * NewDownstreamModulation: allowed values: 64QAM, 256QAM
-func (client *WANCableLinkConfig1) GetDownstreamModulation() (NewDownstreamModulation string, err error) {
+//   - NewDownstreamModulation (out, state variable DownstreamModulation): allowed values 64QAM, 256QAM
+func (client *WANCableLinkConfig1) GetDownstreamModulation() (NewDownstreamModulation WANCableLinkConfig1DownstreamModulation, err error) {
+	return client.GetDownstreamModulationCtx(context.Background())
+}
+
+// GetDownstreamModulationCtx is GetDownstreamModulation with a context, to cancel or time out the call.
+func (client *WANCableLinkConfig1) GetDownstreamModulationCtx(ctx context.Context) (NewDownstreamModulation WANCableLinkConfig1DownstreamModulation, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -1332,25 +2106,36 @@ func (client *WANCableLinkConfig1) GetDownstreamModulation() (NewDownstreamModul
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewDownstreamModulation string
-	}{}
+	response := &WANCableLinkConfig1GetDownstreamModulationResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANCableLinkConfig_1, "GetDownstreamModulation", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetDownstreamModulation", request, response); err != nil {
 		return
 	}
 
 	// BEGIN Unmarshal arguments from response.
 
-	if NewDownstreamModulation, err = soap.UnmarshalString(response.NewDownstreamModulation); err != nil {
-		return
-	}
+	NewDownstreamModulation = WANCableLinkConfig1DownstreamModulation(response.NewDownstreamModulation)
 	// END Unmarshal arguments from response.
 	return
 }
 
+// WANCableLinkConfig1GetUpstreamFrequencyResponse is the response of GetUpstreamFrequency, with each
+// argument in its SOAP string form.
+type WANCableLinkConfig1GetUpstreamFrequencyResponse struct {
+	NewUpstreamFrequency string
+}
+
+// GetUpstreamFrequency performs the GetUpstreamFrequency action of the service.
+//
+// Return values:
+//   - NewUpstreamFrequency (out, state variable A_ARG_TYPE_GetUpstreamFrequency_NewUpstreamFrequency)
 func (client *WANCableLinkConfig1) GetUpstreamFrequency() (NewUpstreamFrequency uint32, err error) {
+	return client.GetUpstreamFrequencyCtx(context.Background())
+}
+
+// GetUpstreamFrequencyCtx is GetUpstreamFrequency with a context, to cancel or time out the call.
+func (client *WANCableLinkConfig1) GetUpstreamFrequencyCtx(ctx context.Context) (NewUpstreamFrequency uint32, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -1358,12 +2143,10 @@ func (client *WANCableLinkConfig1) GetUpstreamFrequency() (NewUpstreamFrequency
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewUpstreamFrequency string
-	}{}
+	response := &WANCableLinkConfig1GetUpstreamFrequencyResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANCableLinkConfig_1, "GetUpstreamFrequency", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetUpstreamFrequency", request, response); err != nil {
 		return
 	}
 
@@ -1376,11 +2159,22 @@ func (client *WANCableLinkConfig1) GetUpstreamFrequency() (NewUpstreamFrequency
 	return
 }
 
+// WANCableLinkConfig1GetUpstreamModulationResponse is the response of GetUpstreamModulation, with each
+// argument in its SOAP string form.
+type WANCableLinkConfig1GetUpstreamModulationResponse struct {
+	NewUpstreamModulation string
+}
+
+// GetUpstreamModulation performs the GetUpstreamModulation action of the service.
 //
 // Return values:
-//
-// * NewUpstreamModulation: allowed values: QPSK, 16QAM
-func (client *WANCableLinkConfig1) GetUpstreamModulation() (NewUpstreamModulation string, err error) {
+//   - NewUpstreamModulation (out, state variable UpstreamModulation): allowed values QPSK, 16QAM
+func (client *WANCableLinkConfig1) GetUpstreamModulation() (NewUpstreamModulation WANCableLinkConfig1UpstreamModulation, err error) {
+	return client.GetUpstreamModulationCtx(context.Background())
+}
+
+// GetUpstreamModulationCtx is GetUpstreamModulation with a context, to cancel or time out the call.
+func (client *WANCableLinkConfig1) GetUpstreamModulationCtx(ctx context.Context) (NewUpstreamModulation WANCableLinkConfig1UpstreamModulation, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -1388,25 +2182,36 @@ func (client *WANCableLinkConfig1) GetUpstreamModulation() (NewUpstreamModulatio
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewUpstreamModulation string
-	}{}
+	response := &WANCableLinkConfig1GetUpstreamModulationResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANCableLinkConfig_1, "GetUpstreamModulation", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetUpstreamModulation", request, response); err != nil {
 		return
 	}
 
 	// BEGIN Unmarshal arguments from response.
 
-	if NewUpstreamModulation, err = soap.UnmarshalString(response.NewUpstreamModulation); err != nil {
-		return
-	}
+	NewUpstreamModulation = WANCableLinkConfig1UpstreamModulation(response.NewUpstreamModulation)
 	// END Unmarshal arguments from response.
 	return
 }
 
+// WANCableLinkConfig1GetUpstreamChannelIDResponse is the response of GetUpstreamChannelID, with each
+// argument in its SOAP string form.
+type WANCableLinkConfig1GetUpstreamChannelIDResponse struct {
+	NewUpstreamChannelID string
+}
+
+// GetUpstreamChannelID performs the GetUpstreamChannelID action of the service.
+//
+// Return values:
+//   - NewUpstreamChannelID (out, state variable A_ARG_TYPE_GetUpstreamChannelID_NewUpstreamChannelID)
 func (client *WANCableLinkConfig1) GetUpstreamChannelID() (NewUpstreamChannelID uint32, err error) {
+	return client.GetUpstreamChannelIDCtx(context.Background())
+}
+
+// GetUpstreamChannelIDCtx is GetUpstreamChannelID with a context, to cancel or time out the call.
+func (client *WANCableLinkConfig1) GetUpstreamChannelIDCtx(ctx context.Context) (NewUpstreamChannelID uint32, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -1414,12 +2219,10 @@ func (client *WANCableLinkConfig1) GetUpstreamChannelID() (NewUpstreamChannelID
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewUpstreamChannelID string
-	}{}
+	response := &WANCableLinkConfig1GetUpstreamChannelIDResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANCableLinkConfig_1, "GetUpstreamChannelID", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetUpstreamChannelID", request, response); err != nil {
 		return
 	}
 
@@ -1432,7 +2235,22 @@ func (client *WANCableLinkConfig1) GetUpstreamChannelID() (NewUpstreamChannelID
 	return
 }
 
+// WANCableLinkConfig1GetUpstreamPowerLevelResponse is the response of GetUpstreamPowerLevel, with each
+// argument in its SOAP string form.
+type WANCableLinkConfig1GetUpstreamPowerLevelResponse struct {
+	NewUpstreamPowerLevel string
+}
+
+// GetUpstreamPowerLevel performs the GetUpstreamPowerLevel action of the service.
+//
+// Return values:
+//   - NewUpstreamPowerLevel (out, state variable A_ARG_TYPE_GetUpstreamPowerLevel_NewUpstreamPowerLevel)
 func (client *WANCableLinkConfig1) GetUpstreamPowerLevel() (NewUpstreamPowerLevel uint32, err error) {
+	return client.GetUpstreamPowerLevelCtx(context.Background())
+}
+
+// GetUpstreamPowerLevelCtx is GetUpstreamPowerLevel with a context, to cancel or time out the call.
+func (client *WANCableLinkConfig1) GetUpstreamPowerLevelCtx(ctx context.Context) (NewUpstreamPowerLevel uint32, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -1440,12 +2258,10 @@ func (client *WANCableLinkConfig1) GetUpstreamPowerLevel() (NewUpstreamPowerLeve
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewUpstreamPowerLevel string
-	}{}
+	response := &WANCableLinkConfig1GetUpstreamPowerLevelResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANCableLinkConfig_1, "GetUpstreamPowerLevel", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetUpstreamPowerLevel", request, response); err != nil {
 		return
 	}
 
@@ -1458,7 +2274,22 @@ func (client *WANCableLinkConfig1) GetUpstreamPowerLevel() (NewUpstreamPowerLeve
 	return
 }
 
+// WANCableLinkConfig1GetBPIEncryptionEnabledResponse is the response of GetBPIEncryptionEnabled, with each
+// argument in its SOAP string form.
+type WANCableLinkConfig1GetBPIEncryptionEnabledResponse struct {
+	NewBPIEncryptionEnabled string
+}
+
+// GetBPIEncryptionEnabled performs the GetBPIEncryptionEnabled action of the service.
+//
+// Return values:
+//   - NewBPIEncryptionEnabled (out, state variable A_ARG_TYPE_GetBPIEncryptionEnabled_NewBPIEncryptionEnabled)
 func (client *WANCableLinkConfig1) GetBPIEncryptionEnabled() (NewBPIEncryptionEnabled bool, err error) {
+	return client.GetBPIEncryptionEnabledCtx(context.Background())
+}
+
+// GetBPIEncryptionEnabledCtx is GetBPIEncryptionEnabled with a context, to cancel or time out the call.
+func (client *WANCableLinkConfig1) GetBPIEncryptionEnabledCtx(ctx context.Context) (NewBPIEncryptionEnabled bool, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -1466,12 +2297,10 @@ func (client *WANCableLinkConfig1) GetBPIEncryptionEnabled() (NewBPIEncryptionEn
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewBPIEncryptionEnabled string
-	}{}
+	response := &WANCableLinkConfig1GetBPIEncryptionEnabledResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANCableLinkConfig_1, "GetBPIEncryptionEnabled", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetBPIEncryptionEnabled", request, response); err != nil {
 		return
 	}
 
@@ -1484,7 +2313,22 @@ func (client *WANCableLinkConfig1) GetBPIEncryptionEnabled() (NewBPIEncryptionEn
 	return
 }
 
+// WANCableLinkConfig1GetConfigFileResponse is the response of GetConfigFile, with each
+// argument in its SOAP string form.
+type WANCableLinkConfig1GetConfigFileResponse struct {
+	NewConfigFile string
+}
+
+// GetConfigFile performs the GetConfigFile action of the service.
+//
+// Return values:
+//   - NewConfigFile (out, state variable A_ARG_TYPE_GetConfigFile_NewConfigFile)
 func (client *WANCableLinkConfig1) GetConfigFile() (NewConfigFile string, err error) {
+	return client.GetConfigFileCtx(context.Background())
+}
+
+// GetConfigFileCtx is GetConfigFile with a context, to cancel or time out the call.
+func (client *WANCableLinkConfig1) GetConfigFileCtx(ctx context.Context) (NewConfigFile string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -1492,12 +2336,10 @@ func (client *WANCableLinkConfig1) GetConfigFile() (NewConfigFile string, err er
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewConfigFile string
-	}{}
+	response := &WANCableLinkConfig1GetConfigFileResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANCableLinkConfig_1, "GetConfigFile", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetConfigFile", request, response); err != nil {
 		return
 	}
 
@@ -1510,7 +2352,22 @@ func (client *WANCableLinkConfig1) GetConfigFile() (NewConfigFile string, err er
 	return
 }
 
+// WANCableLinkConfig1GetTFTPServerResponse is the response of GetTFTPServer, with each
+// argument in its SOAP string form.
+type WANCableLinkConfig1GetTFTPServerResponse struct {
+	NewTFTPServer string
+}
+
+// GetTFTPServer performs the GetTFTPServer action of the service.
+//
+// Return values:
+//   - NewTFTPServer (out, state variable A_ARG_TYPE_GetTFTPServer_NewTFTPServer)
 func (client *WANCableLinkConfig1) GetTFTPServer() (NewTFTPServer string, err error) {
+	return client.GetTFTPServerCtx(context.Background())
+}
+
+// GetTFTPServerCtx is GetTFTPServer with a context, to cancel or time out the call.
+func (client *WANCableLinkConfig1) GetTFTPServerCtx(ctx context.Context) (NewTFTPServer string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -1518,12 +2375,10 @@ func (client *WANCableLinkConfig1) GetTFTPServer() (NewTFTPServer string, err er
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewTFTPServer string
-	}{}
+	response := &WANCableLinkConfig1GetTFTPServerResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANCableLinkConfig_1, "GetTFTPServer", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetTFTPServer", request, response); err != nil {
 		return
 	}
 
@@ -1543,6 +2398,77 @@ type WANCommonInterfaceConfig1 struct {
 	goupnp.ServiceClient
 }
 
+// WANCommonInterfaceConfig1Client is the interface of the actions of WANCommonInterfaceConfig1, for
+// substituting fakes or mocks for the service in tests.
+type WANCommonInterfaceConfig1Client interface {
+	SetEnabledForInternet(NewEnabledForInternet bool) (err error)
+	SetEnabledForInternetCtx(ctx context.Context, NewEnabledForInternet bool) (err error)
+	GetEnabledForInternet() (NewEnabledForInternet bool, err error)
+	GetEnabledForInternetCtx(ctx context.Context) (NewEnabledForInternet bool, err error)
+	GetCommonLinkProperties() (NewWANAccessType WANCommonInterfaceConfig1WANAccessType, NewLayer1UpstreamMaxBitRate uint32, NewLayer1DownstreamMaxBitRate uint32, NewPhysicalLinkStatus WANCommonInterfaceConfig1PhysicalLinkStatus, err error)
+	GetCommonLinkPropertiesCtx(ctx context.Context) (NewWANAccessType WANCommonInterfaceConfig1WANAccessType, NewLayer1UpstreamMaxBitRate uint32, NewLayer1DownstreamMaxBitRate uint32, NewPhysicalLinkStatus WANCommonInterfaceConfig1PhysicalLinkStatus, err error)
+	GetWANAccessProvider() (NewWANAccessProvider string, err error)
+	GetWANAccessProviderCtx(ctx context.Context) (NewWANAccessProvider string, err error)
+	GetMaximumActiveConnections() (NewMaximumActiveConnections uint16, err error)
+	GetMaximumActiveConnectionsCtx(ctx context.Context) (NewMaximumActiveConnections uint16, err error)
+	GetTotalBytesSent() (NewTotalBytesSent uint32, err error)
+	GetTotalBytesSentCtx(ctx context.Context) (NewTotalBytesSent uint32, err error)
+	GetTotalBytesReceived() (NewTotalBytesReceived uint32, err error)
+	GetTotalBytesReceivedCtx(ctx context.Context) (NewTotalBytesReceived uint32, err error)
+	GetTotalPacketsSent() (NewTotalPacketsSent uint32, err error)
+	GetTotalPacketsSentCtx(ctx context.Context) (NewTotalPacketsSent uint32, err error)
+	GetTotalPacketsReceived() (NewTotalPacketsReceived uint32, err error)
+	GetTotalPacketsReceivedCtx(ctx context.Context) (NewTotalPacketsReceived uint32, err error)
+	GetActiveConnection(NewActiveConnectionIndex uint16) (NewActiveConnDeviceContainer string, NewActiveConnectionServiceID string, err error)
+	GetActiveConnectionCtx(ctx context.Context, NewActiveConnectionIndex uint16) (NewActiveConnDeviceContainer string, NewActiveConnectionServiceID string, err error)
+}
+
+var _ WANCommonInterfaceConfig1Client = new(WANCommonInterfaceConfig1)
+
+// WANCommonInterfaceConfig1WANAccessType is a value of the state variable WANAccessType of
+// WANCommonInterfaceConfig1.
+type WANCommonInterfaceConfig1WANAccessType string
+
+// Allowed values of WANCommonInterfaceConfig1WANAccessType.
+const (
+	WANCommonInterfaceConfig1WANAccessType_DSL      WANCommonInterfaceConfig1WANAccessType = "DSL"
+	WANCommonInterfaceConfig1WANAccessType_POTS     WANCommonInterfaceConfig1WANAccessType = "POTS"
+	WANCommonInterfaceConfig1WANAccessType_Cable    WANCommonInterfaceConfig1WANAccessType = "Cable"
+	WANCommonInterfaceConfig1WANAccessType_Ethernet WANCommonInterfaceConfig1WANAccessType = "Ethernet"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v WANCommonInterfaceConfig1WANAccessType) Valid() bool {
+	switch v {
+	case WANCommonInterfaceConfig1WANAccessType_DSL,
+		WANCommonInterfaceConfig1WANAccessType_POTS,
+		WANCommonInterfaceConfig1WANAccessType_Cable,
+		WANCommonInterfaceConfig1WANAccessType_Ethernet:
+		return true
+	}
+	return false
+}
+
+// WANCommonInterfaceConfig1PhysicalLinkStatus is a value of the state variable PhysicalLinkStatus of
+// WANCommonInterfaceConfig1.
+type WANCommonInterfaceConfig1PhysicalLinkStatus string
+
+// Allowed values of WANCommonInterfaceConfig1PhysicalLinkStatus.
+const (
+	WANCommonInterfaceConfig1PhysicalLinkStatus_Up   WANCommonInterfaceConfig1PhysicalLinkStatus = "Up"
+	WANCommonInterfaceConfig1PhysicalLinkStatus_Down WANCommonInterfaceConfig1PhysicalLinkStatus = "Down"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v WANCommonInterfaceConfig1PhysicalLinkStatus) Valid() bool {
+	switch v {
+	case WANCommonInterfaceConfig1PhysicalLinkStatus_Up,
+		WANCommonInterfaceConfig1PhysicalLinkStatus_Down:
+		return true
+	}
+	return false
+}
+
 // NewWANCommonInterfaceConfig1Clients discovers instances of the service on the network,
 // and returns clients to any that are found. errors will contain an error for
 // any devices that replied but which could not be queried, and err will be set
@@ -1596,11 +2522,42 @@ func newWANCommonInterfaceConfig1ClientsFromGenericClients(genericClients []goup
 	return clients
 }
 
+// WANCommonInterfaceConfig1Timeouts are the default timeouts of the actions of
+// WANCommonInterfaceConfig1, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var WANCommonInterfaceConfig1Timeouts = map[string]time.Duration{
+	"": 10 * time.Second,
+}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *WANCommonInterfaceConfig1) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, WANCommonInterfaceConfig1Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_WANCommonInterfaceConfig_1, actionName, request, response)
+}
+
+// WANCommonInterfaceConfig1SetEnabledForInternetRequest is the request of SetEnabledForInternet, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANCommonInterfaceConfig1SetEnabledForInternetRequest struct {
+	NewEnabledForInternet string
+}
+
+// SetEnabledForInternet performs the SetEnabledForInternet action of the service.
+//
+// Arguments:
+//   - NewEnabledForInternet (in, state variable A_ARG_TYPE_SetEnabledForInternet_NewEnabledForInternet)
 func (client *WANCommonInterfaceConfig1) SetEnabledForInternet(NewEnabledForInternet bool) (err error) {
+	return client.SetEnabledForInternetCtx(context.Background(), NewEnabledForInternet)
+}
+
+// SetEnabledForInternetCtx is SetEnabledForInternet with a context, to cancel or time out the call.
+func (client *WANCommonInterfaceConfig1) SetEnabledForInternetCtx(ctx context.Context, NewEnabledForInternet bool) (err error) {
 	// Request structure.
-	request := &struct {
-		NewEnabledForInternet string
-	}{}
+	request := &WANCommonInterfaceConfig1SetEnabledForInternetRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewEnabledForInternet, err = soap.MarshalBoolean(NewEnabledForInternet); err != nil {
@@ -1612,7 +2569,7 @@ func (client *WANCommonInterfaceConfig1) SetEnabledForInternet(NewEnabledForInte
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANCommonInterfaceConfig_1, "SetEnabledForInternet", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetEnabledForInternet", request, response); err != nil {
 		return
 	}
 
@@ -1622,7 +2579,22 @@ func (client *WANCommonInterfaceConfig1) SetEnabledForInternet(NewEnabledForInte
 	return
 }
 
+// WANCommonInterfaceConfig1GetEnabledForInternetResponse is the response of GetEnabledForInternet, with each
+// argument in its SOAP string form.
+type WANCommonInterfaceConfig1GetEnabledForInternetResponse struct {
+	NewEnabledForInternet string
+}
+
+// GetEnabledForInternet performs the GetEnabledForInternet action of the service.
+//
+// Return values:
+//   - NewEnabledForInternet (out, state variable A_ARG_TYPE_GetEnabledForInternet_NewEnabledForInternet)
 func (client *WANCommonInterfaceConfig1) GetEnabledForInternet() (NewEnabledForInternet bool, err error) {
+	return client.GetEnabledForInternetCtx(context.Background())
+}
+
+// GetEnabledForInternetCtx is GetEnabledForInternet with a context, to cancel or time out the call.
+func (client *WANCommonInterfaceConfig1) GetEnabledForInternetCtx(ctx context.Context) (NewEnabledForInternet bool, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -1630,12 +2602,10 @@ func (client *WANCommonInterfaceConfig1) GetEnabledForInternet() (NewEnabledForI
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewEnabledForInternet string
-	}{}
+	response := &WANCommonInterfaceConfig1GetEnabledForInternetResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANCommonInterfaceConfig_1, "GetEnabledForInternet", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetEnabledForInternet", request, response); err != nil {
 		return
 	}
 
@@ -1648,13 +2618,28 @@ func (client *WANCommonInterfaceConfig1) GetEnabledForInternet() (NewEnabledForI
 	return
 }
 
+// WANCommonInterfaceConfig1GetCommonLinkPropertiesResponse is the response of GetCommonLinkProperties, with each
+// argument in its SOAP string form.
+type WANCommonInterfaceConfig1GetCommonLinkPropertiesResponse struct {
+	NewWANAccessType              string
+	NewLayer1UpstreamMaxBitRate   string
+	NewLayer1DownstreamMaxBitRate string
+	NewPhysicalLinkStatus         string
+}
+
+// GetCommonLinkProperties performs the GetCommonLinkProperties action of the service.
 //
 // Return values:
-//
-// * NewWANAccessType: allowed values: DSL, POTS, Cable, Ethernet
-//
-// * NewPhysicalLinkStatus: allowed values: Up, Down
-func (client *WANCommonInterfaceConfig1) GetCommonLinkProperties() (NewWANAccessType string, NewLayer1UpstreamMaxBitRate uint32, NewLayer1DownstreamMaxBitRate uint32, NewPhysicalLinkStatus string, err error) {
+//   - NewWANAccessType (out, state variable WANAccessType): allowed values DSL, POTS, Cable, Ethernet
+//   - NewLayer1UpstreamMaxBitRate (out, state variable A_ARG_TYPE_GetCommonLinkProperties_NewLayer1UpstreamMaxBitRate)
+//   - NewLayer1DownstreamMaxBitRate (out, state variable A_ARG_TYPE_GetCommonLinkProperties_NewLayer1DownstreamMaxBitRate)
+//   - NewPhysicalLinkStatus (out, state variable PhysicalLinkStatus): allowed values Up, Down
+func (client *WANCommonInterfaceConfig1) GetCommonLinkProperties() (NewWANAccessType WANCommonInterfaceConfig1WANAccessType, NewLayer1UpstreamMaxBitRate uint32, NewLayer1DownstreamMaxBitRate uint32, NewPhysicalLinkStatus WANCommonInterfaceConfig1PhysicalLinkStatus, err error) {
+	return client.GetCommonLinkPropertiesCtx(context.Background())
+}
+
+// GetCommonLinkPropertiesCtx is GetCommonLinkProperties with a context, to cancel or time out the call.
+func (client *WANCommonInterfaceConfig1) GetCommonLinkPropertiesCtx(ctx context.Context) (NewWANAccessType WANCommonInterfaceConfig1WANAccessType, NewLayer1UpstreamMaxBitRate uint32, NewLayer1DownstreamMaxBitRate uint32, NewPhysicalLinkStatus WANCommonInterfaceConfig1PhysicalLinkStatus, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -1662,40 +2647,43 @@ func (client *WANCommonInterfaceConfig1) GetCommonLinkProperties() (NewWANAccess
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewWANAccessType string
-
-		NewLayer1UpstreamMaxBitRate string
-
-		NewLayer1DownstreamMaxBitRate string
-
-		NewPhysicalLinkStatus string
-	}{}
+	response := &WANCommonInterfaceConfig1GetCommonLinkPropertiesResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANCommonInterfaceConfig_1, "GetCommonLinkProperties", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetCommonLinkProperties", request, response); err != nil {
 		return
 	}
 
 	// BEGIN Unmarshal arguments from response.
 
-	if NewWANAccessType, err = soap.UnmarshalString(response.NewWANAccessType); err != nil {
-		return
-	}
+	NewWANAccessType = WANCommonInterfaceConfig1WANAccessType(response.NewWANAccessType)
 	if NewLayer1UpstreamMaxBitRate, err = soap.UnmarshalUi4(response.NewLayer1UpstreamMaxBitRate); err != nil {
 		return
 	}
 	if NewLayer1DownstreamMaxBitRate, err = soap.UnmarshalUi4(response.NewLayer1DownstreamMaxBitRate); err != nil {
 		return
 	}
-	if NewPhysicalLinkStatus, err = soap.UnmarshalString(response.NewPhysicalLinkStatus); err != nil {
-		return
-	}
+	NewPhysicalLinkStatus = WANCommonInterfaceConfig1PhysicalLinkStatus(response.NewPhysicalLinkStatus)
 	// END Unmarshal arguments from response.
 	return
 }
 
+// WANCommonInterfaceConfig1GetWANAccessProviderResponse is the response of GetWANAccessProvider, with each
+// argument in its SOAP string form.
+type WANCommonInterfaceConfig1GetWANAccessProviderResponse struct {
+	NewWANAccessProvider string
+}
+
+// GetWANAccessProvider performs the GetWANAccessProvider action of the service.
+//
+// Return values:
+//   - NewWANAccessProvider (out, state variable A_ARG_TYPE_GetWANAccessProvider_NewWANAccessProvider)
 func (client *WANCommonInterfaceConfig1) GetWANAccessProvider() (NewWANAccessProvider string, err error) {
+	return client.GetWANAccessProviderCtx(context.Background())
+}
+
+// GetWANAccessProviderCtx is GetWANAccessProvider with a context, to cancel or time out the call.
+func (client *WANCommonInterfaceConfig1) GetWANAccessProviderCtx(ctx context.Context) (NewWANAccessProvider string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -1703,12 +2691,10 @@ func (client *WANCommonInterfaceConfig1) GetWANAccessProvider() (NewWANAccessPro
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewWANAccessProvider string
-	}{}
+	response := &WANCommonInterfaceConfig1GetWANAccessProviderResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANCommonInterfaceConfig_1, "GetWANAccessProvider", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetWANAccessProvider", request, response); err != nil {
 		return
 	}
 
@@ -1721,11 +2707,22 @@ func (client *WANCommonInterfaceConfig1) GetWANAccessProvider() (NewWANAccessPro
 	return
 }
 
+// WANCommonInterfaceConfig1GetMaximumActiveConnectionsResponse is the response of GetMaximumActiveConnections, with each
+// argument in its SOAP string form.
+type WANCommonInterfaceConfig1GetMaximumActiveConnectionsResponse struct {
+	NewMaximumActiveConnections string
+}
+
+// GetMaximumActiveConnections performs the GetMaximumActiveConnections action of the service.
 //
 // Return values:
-//
-// * NewMaximumActiveConnections: allowed value range: minimum=1, step=1
+//   - NewMaximumActiveConnections (out, state variable A_ARG_TYPE_GetMaximumActiveConnections_NewMaximumActiveConnections): allowed range minimum=1, step=1
 func (client *WANCommonInterfaceConfig1) GetMaximumActiveConnections() (NewMaximumActiveConnections uint16, err error) {
+	return client.GetMaximumActiveConnectionsCtx(context.Background())
+}
+
+// GetMaximumActiveConnectionsCtx is GetMaximumActiveConnections with a context, to cancel or time out the call.
+func (client *WANCommonInterfaceConfig1) GetMaximumActiveConnectionsCtx(ctx context.Context) (NewMaximumActiveConnections uint16, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -1733,12 +2730,10 @@ func (client *WANCommonInterfaceConfig1) GetMaximumActiveConnections() (NewMaxim
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewMaximumActiveConnections string
-	}{}
+	response := &WANCommonInterfaceConfig1GetMaximumActiveConnectionsResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANCommonInterfaceConfig_1, "GetMaximumActiveConnections", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetMaximumActiveConnections", request, response); err != nil {
 		return
 	}
 
@@ -1751,7 +2746,22 @@ func (client *WANCommonInterfaceConfig1) GetMaximumActiveConnections() (NewMaxim
 	return
 }
 
+// WANCommonInterfaceConfig1GetTotalBytesSentResponse is the response of GetTotalBytesSent, with each
+// argument in its SOAP string form.
+type WANCommonInterfaceConfig1GetTotalBytesSentResponse struct {
+	NewTotalBytesSent string
+}
+
+// GetTotalBytesSent performs the GetTotalBytesSent action of the service.
+//
+// Return values:
+//   - NewTotalBytesSent (out, state variable A_ARG_TYPE_GetTotalBytesSent_NewTotalBytesSent)
 func (client *WANCommonInterfaceConfig1) GetTotalBytesSent() (NewTotalBytesSent uint32, err error) {
+	return client.GetTotalBytesSentCtx(context.Background())
+}
+
+// GetTotalBytesSentCtx is GetTotalBytesSent with a context, to cancel or time out the call.
+func (client *WANCommonInterfaceConfig1) GetTotalBytesSentCtx(ctx context.Context) (NewTotalBytesSent uint32, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -1759,12 +2769,10 @@ func (client *WANCommonInterfaceConfig1) GetTotalBytesSent() (NewTotalBytesSent
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewTotalBytesSent string
-	}{}
+	response := &WANCommonInterfaceConfig1GetTotalBytesSentResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANCommonInterfaceConfig_1, "GetTotalBytesSent", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetTotalBytesSent", request, response); err != nil {
 		return
 	}
 
@@ -1777,7 +2785,22 @@ func (client *WANCommonInterfaceConfig1) GetTotalBytesSent() (NewTotalBytesSent
 	return
 }
 
+// WANCommonInterfaceConfig1GetTotalBytesReceivedResponse is the response of GetTotalBytesReceived, with each
+// argument in its SOAP string form.
+type WANCommonInterfaceConfig1GetTotalBytesReceivedResponse struct {
+	NewTotalBytesReceived string
+}
+
+// GetTotalBytesReceived performs the GetTotalBytesReceived action of the service.
+//
+// Return values:
+//   - NewTotalBytesReceived (out, state variable A_ARG_TYPE_GetTotalBytesReceived_NewTotalBytesReceived)
 func (client *WANCommonInterfaceConfig1) GetTotalBytesReceived() (NewTotalBytesReceived uint32, err error) {
+	return client.GetTotalBytesReceivedCtx(context.Background())
+}
+
+// GetTotalBytesReceivedCtx is GetTotalBytesReceived with a context, to cancel or time out the call.
+func (client *WANCommonInterfaceConfig1) GetTotalBytesReceivedCtx(ctx context.Context) (NewTotalBytesReceived uint32, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -1785,12 +2808,10 @@ func (client *WANCommonInterfaceConfig1) GetTotalBytesReceived() (NewTotalBytesR
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewTotalBytesReceived string
-	}{}
+	response := &WANCommonInterfaceConfig1GetTotalBytesReceivedResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANCommonInterfaceConfig_1, "GetTotalBytesReceived", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetTotalBytesReceived", request, response); err != nil {
 		return
 	}
 
@@ -1803,7 +2824,22 @@ func (client *WANCommonInterfaceConfig1) GetTotalBytesReceived() (NewTotalBytesR
 	return
 }
 
+// WANCommonInterfaceConfig1GetTotalPacketsSentResponse is the response of GetTotalPacketsSent, with each
+// argument in its SOAP string form.
+type WANCommonInterfaceConfig1GetTotalPacketsSentResponse struct {
+	NewTotalPacketsSent string
+}
+
+// GetTotalPacketsSent performs the GetTotalPacketsSent action of the service.
+//
+// Return values:
+//   - NewTotalPacketsSent (out, state variable A_ARG_TYPE_GetTotalPacketsSent_NewTotalPacketsSent)
 func (client *WANCommonInterfaceConfig1) GetTotalPacketsSent() (NewTotalPacketsSent uint32, err error) {
+	return client.GetTotalPacketsSentCtx(context.Background())
+}
+
+// GetTotalPacketsSentCtx is GetTotalPacketsSent with a context, to cancel or time out the call.
+func (client *WANCommonInterfaceConfig1) GetTotalPacketsSentCtx(ctx context.Context) (NewTotalPacketsSent uint32, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -1811,12 +2847,10 @@ func (client *WANCommonInterfaceConfig1) GetTotalPacketsSent() (NewTotalPacketsS
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewTotalPacketsSent string
-	}{}
+	response := &WANCommonInterfaceConfig1GetTotalPacketsSentResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANCommonInterfaceConfig_1, "GetTotalPacketsSent", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetTotalPacketsSent", request, response); err != nil {
 		return
 	}
 
@@ -1829,7 +2863,22 @@ func (client *WANCommonInterfaceConfig1) GetTotalPacketsSent() (NewTotalPacketsS
 	return
 }
 
+// WANCommonInterfaceConfig1GetTotalPacketsReceivedResponse is the response of GetTotalPacketsReceived, with each
+// argument in its SOAP string form.
+type WANCommonInterfaceConfig1GetTotalPacketsReceivedResponse struct {
+	NewTotalPacketsReceived string
+}
+
+// GetTotalPacketsReceived performs the GetTotalPacketsReceived action of the service.
+//
+// Return values:
+//   - NewTotalPacketsReceived (out, state variable A_ARG_TYPE_GetTotalPacketsReceived_NewTotalPacketsReceived)
 func (client *WANCommonInterfaceConfig1) GetTotalPacketsReceived() (NewTotalPacketsReceived uint32, err error) {
+	return client.GetTotalPacketsReceivedCtx(context.Background())
+}
+
+// GetTotalPacketsReceivedCtx is GetTotalPacketsReceived with a context, to cancel or time out the call.
+func (client *WANCommonInterfaceConfig1) GetTotalPacketsReceivedCtx(ctx context.Context) (NewTotalPacketsReceived uint32, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -1837,12 +2886,10 @@ func (client *WANCommonInterfaceConfig1) GetTotalPacketsReceived() (NewTotalPack
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewTotalPacketsReceived string
-	}{}
+	response := &WANCommonInterfaceConfig1GetTotalPacketsReceivedResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANCommonInterfaceConfig_1, "GetTotalPacketsReceived", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetTotalPacketsReceived", request, response); err != nil {
 		return
 	}
 
@@ -1855,11 +2902,35 @@ func (client *WANCommonInterfaceConfig1) GetTotalPacketsReceived() (NewTotalPack
 	return
 }
 
+// WANCommonInterfaceConfig1GetActiveConnectionRequest is the request of GetActiveConnection, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANCommonInterfaceConfig1GetActiveConnectionRequest struct {
+	NewActiveConnectionIndex string
+}
+
+// WANCommonInterfaceConfig1GetActiveConnectionResponse is the response of GetActiveConnection, with each
+// argument in its SOAP string form.
+type WANCommonInterfaceConfig1GetActiveConnectionResponse struct {
+	NewActiveConnDeviceContainer string
+	NewActiveConnectionServiceID string
+}
+
+// GetActiveConnection performs the GetActiveConnection action of the service.
+//
+// Arguments:
+//   - NewActiveConnectionIndex (in, state variable A_ARG_TYPE_GetActiveConnection_NewActiveConnectionIndex)
+//
+// Return values:
+//   - NewActiveConnDeviceContainer (out, state variable A_ARG_TYPE_GetActiveConnection_NewActiveConnDeviceContainer)
+//   - NewActiveConnectionServiceID (out, state variable A_ARG_TYPE_GetActiveConnection_NewActiveConnectionServiceID)
 func (client *WANCommonInterfaceConfig1) GetActiveConnection(NewActiveConnectionIndex uint16) (NewActiveConnDeviceContainer string, NewActiveConnectionServiceID string, err error) {
+	return client.GetActiveConnectionCtx(context.Background(), NewActiveConnectionIndex)
+}
+
+// GetActiveConnectionCtx is GetActiveConnection with a context, to cancel or time out the call.
+func (client *WANCommonInterfaceConfig1) GetActiveConnectionCtx(ctx context.Context, NewActiveConnectionIndex uint16) (NewActiveConnDeviceContainer string, NewActiveConnectionServiceID string, err error) {
 	// Request structure.
-	request := &struct {
-		NewActiveConnectionIndex string
-	}{}
+	request := &WANCommonInterfaceConfig1GetActiveConnectionRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewActiveConnectionIndex, err = soap.MarshalUi2(NewActiveConnectionIndex); err != nil {
@@ -1868,14 +2939,10 @@ func (client *WANCommonInterfaceConfig1) GetActiveConnection(NewActiveConnection
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewActiveConnDeviceContainer string
-
-		NewActiveConnectionServiceID string
-	}{}
+	response := &WANCommonInterfaceConfig1GetActiveConnectionResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANCommonInterfaceConfig_1, "GetActiveConnection", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetActiveConnection", request, response); err != nil {
 		return
 	}
 
@@ -1898,6 +2965,135 @@ type WANDSLLinkConfig1 struct {
 	goupnp.ServiceClient
 }
 
+// WANDSLLinkConfig1Client is the interface of the actions of WANDSLLinkConfig1, for
+// substituting fakes or mocks for the service in tests.
+type WANDSLLinkConfig1Client interface {
+	SetDSLLinkType(NewLinkType WANDSLLinkConfig1LinkType) (err error)
+	SetDSLLinkTypeCtx(ctx context.Context, NewLinkType WANDSLLinkConfig1LinkType) (err error)
+	GetDSLLinkInfo() (NewLinkType WANDSLLinkConfig1LinkType, NewLinkStatus WANDSLLinkConfig1LinkStatus, err error)
+	GetDSLLinkInfoCtx(ctx context.Context) (NewLinkType WANDSLLinkConfig1LinkType, NewLinkStatus WANDSLLinkConfig1LinkStatus, err error)
+	GetAutoConfig() (NewAutoConfig bool, err error)
+	GetAutoConfigCtx(ctx context.Context) (NewAutoConfig bool, err error)
+	GetModulationType() (NewModulationType WANDSLLinkConfig1ModulationType, err error)
+	GetModulationTypeCtx(ctx context.Context) (NewModulationType WANDSLLinkConfig1ModulationType, err error)
+	SetDestinationAddress(NewDestinationAddress string) (err error)
+	SetDestinationAddressCtx(ctx context.Context, NewDestinationAddress string) (err error)
+	GetDestinationAddress() (NewDestinationAddress string, err error)
+	GetDestinationAddressCtx(ctx context.Context) (NewDestinationAddress string, err error)
+	SetATMEncapsulation(NewATMEncapsulation WANDSLLinkConfig1ATMEncapsulation) (err error)
+	SetATMEncapsulationCtx(ctx context.Context, NewATMEncapsulation WANDSLLinkConfig1ATMEncapsulation) (err error)
+	GetATMEncapsulation() (NewATMEncapsulation WANDSLLinkConfig1ATMEncapsulation, err error)
+	GetATMEncapsulationCtx(ctx context.Context) (NewATMEncapsulation WANDSLLinkConfig1ATMEncapsulation, err error)
+	SetFCSPreserved(NewFCSPreserved bool) (err error)
+	SetFCSPreservedCtx(ctx context.Context, NewFCSPreserved bool) (err error)
+	GetFCSPreserved() (NewFCSPreserved bool, err error)
+	GetFCSPreservedCtx(ctx context.Context) (NewFCSPreserved bool, err error)
+}
+
+var _ WANDSLLinkConfig1Client = new(WANDSLLinkConfig1)
+
+// WANDSLLinkConfig1LinkStatus is a value of the state variable LinkStatus of
+// WANDSLLinkConfig1.
+type WANDSLLinkConfig1LinkStatus string
+
+// Allowed values of WANDSLLinkConfig1LinkStatus.
+const (
+	WANDSLLinkConfig1LinkStatus_Up           WANDSLLinkConfig1LinkStatus = "Up"
+	WANDSLLinkConfig1LinkStatus_Down         WANDSLLinkConfig1LinkStatus = "Down"
+	WANDSLLinkConfig1LinkStatus_Initializing WANDSLLinkConfig1LinkStatus = "Initializing"
+	WANDSLLinkConfig1LinkStatus_Unavailable  WANDSLLinkConfig1LinkStatus = "Unavailable"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v WANDSLLinkConfig1LinkStatus) Valid() bool {
+	switch v {
+	case WANDSLLinkConfig1LinkStatus_Up,
+		WANDSLLinkConfig1LinkStatus_Down,
+		WANDSLLinkConfig1LinkStatus_Initializing,
+		WANDSLLinkConfig1LinkStatus_Unavailable:
+		return true
+	}
+	return false
+}
+
+// WANDSLLinkConfig1LinkType is a value of the state variable LinkType of
+// WANDSLLinkConfig1.
+type WANDSLLinkConfig1LinkType string
+
+// Allowed values of WANDSLLinkConfig1LinkType.
+const (
+	WANDSLLinkConfig1LinkType_EoA          WANDSLLinkConfig1LinkType = "EoA"
+	WANDSLLinkConfig1LinkType_IPoA         WANDSLLinkConfig1LinkType = "IPoA"
+	WANDSLLinkConfig1LinkType_PPPoA        WANDSLLinkConfig1LinkType = "PPPoA"
+	WANDSLLinkConfig1LinkType_PPPoE        WANDSLLinkConfig1LinkType = "PPPoE"
+	WANDSLLinkConfig1LinkType_CIP          WANDSLLinkConfig1LinkType = "CIP"
+	WANDSLLinkConfig1LinkType_Unconfigured WANDSLLinkConfig1LinkType = "Unconfigured"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v WANDSLLinkConfig1LinkType) Valid() bool {
+	switch v {
+	case WANDSLLinkConfig1LinkType_EoA,
+		WANDSLLinkConfig1LinkType_IPoA,
+		WANDSLLinkConfig1LinkType_PPPoA,
+		WANDSLLinkConfig1LinkType_PPPoE,
+		WANDSLLinkConfig1LinkType_CIP,
+		WANDSLLinkConfig1LinkType_Unconfigured:
+		return true
+	}
+	return false
+}
+
+// WANDSLLinkConfig1ModulationType is a value of the state variable ModulationType of
+// WANDSLLinkConfig1.
+type WANDSLLinkConfig1ModulationType string
+
+// Allowed values of WANDSLLinkConfig1ModulationType.
+const (
+	WANDSLLinkConfig1ModulationType_ADSL_G_dmt  WANDSLLinkConfig1ModulationType = "ADSL_G.dmt"
+	WANDSLLinkConfig1ModulationType_ADSL_G_lite WANDSLLinkConfig1ModulationType = "ADSL_G.lite"
+	WANDSLLinkConfig1ModulationType_G_shdsl     WANDSLLinkConfig1ModulationType = "G.shdsl"
+	WANDSLLinkConfig1ModulationType_IDSL        WANDSLLinkConfig1ModulationType = "IDSL"
+	WANDSLLinkConfig1ModulationType_HDSL        WANDSLLinkConfig1ModulationType = "HDSL"
+	WANDSLLinkConfig1ModulationType_SDSL        WANDSLLinkConfig1ModulationType = "SDSL"
+	WANDSLLinkConfig1ModulationType_VDSL        WANDSLLinkConfig1ModulationType = "VDSL"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v WANDSLLinkConfig1ModulationType) Valid() bool {
+	switch v {
+	case WANDSLLinkConfig1ModulationType_ADSL_G_dmt,
+		WANDSLLinkConfig1ModulationType_ADSL_G_lite,
+		WANDSLLinkConfig1ModulationType_G_shdsl,
+		WANDSLLinkConfig1ModulationType_IDSL,
+		WANDSLLinkConfig1ModulationType_HDSL,
+		WANDSLLinkConfig1ModulationType_SDSL,
+		WANDSLLinkConfig1ModulationType_VDSL:
+		return true
+	}
+	return false
+}
+
+// WANDSLLinkConfig1ATMEncapsulation is a value of the state variable ATMEncapsulation of
+// WANDSLLinkConfig1.
+type WANDSLLinkConfig1ATMEncapsulation string
+
+// Allowed values of WANDSLLinkConfig1ATMEncapsulation.
+const (
+	WANDSLLinkConfig1ATMEncapsulation_LLC   WANDSLLinkConfig1ATMEncapsulation = "LLC"
+	WANDSLLinkConfig1ATMEncapsulation_VCMUX WANDSLLinkConfig1ATMEncapsulation = "VCMUX"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v WANDSLLinkConfig1ATMEncapsulation) Valid() bool {
+	switch v {
+	case WANDSLLinkConfig1ATMEncapsulation_LLC,
+		WANDSLLinkConfig1ATMEncapsulation_VCMUX:
+		return true
+	}
+	return false
+}
+
 // NewWANDSLLinkConfig1Clients discovers instances of the service on the network,
 // and returns clients to any that are found. errors will contain an error for
 // any devices that replied but which could not be queried, and err will be set
@@ -1951,14 +3147,45 @@ func newWANDSLLinkConfig1ClientsFromGenericClients(genericClients []goupnp.Servi
 	return clients
 }
 
-func (client *WANDSLLinkConfig1) SetDSLLinkType(NewLinkType string) (err error) {
+// WANDSLLinkConfig1Timeouts are the default timeouts of the actions of
+// WANDSLLinkConfig1, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var WANDSLLinkConfig1Timeouts = map[string]time.Duration{
+	"": 10 * time.Second,
+}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *WANDSLLinkConfig1) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, WANDSLLinkConfig1Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_WANDSLLinkConfig_1, actionName, request, response)
+}
+
+// WANDSLLinkConfig1SetDSLLinkTypeRequest is the request of SetDSLLinkType, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANDSLLinkConfig1SetDSLLinkTypeRequest struct {
+	NewLinkType string
+}
+
+// SetDSLLinkType performs the SetDSLLinkType action of the service.
+//
+// Arguments:
+//   - NewLinkType (in, state variable LinkType): allowed values EoA, IPoA, PPPoA, PPPoE, CIP, Unconfigured
+func (client *WANDSLLinkConfig1) SetDSLLinkType(NewLinkType WANDSLLinkConfig1LinkType) (err error) {
+	return client.SetDSLLinkTypeCtx(context.Background(), NewLinkType)
+}
+
+// SetDSLLinkTypeCtx is SetDSLLinkType with a context, to cancel or time out the call.
+func (client *WANDSLLinkConfig1) SetDSLLinkTypeCtx(ctx context.Context, NewLinkType WANDSLLinkConfig1LinkType) (err error) {
 	// Request structure.
-	request := &struct {
-		NewLinkType string
-	}{}
+	request := &WANDSLLinkConfig1SetDSLLinkTypeRequest{}
 	// BEGIN Marshal arguments into request.
 
-	if request.NewLinkType, err = soap.MarshalString(NewLinkType); err != nil {
+	if request.NewLinkType, err = soap.MarshalString(string(NewLinkType)); err != nil {
 		return
 	}
 	// END Marshal arguments into request.
@@ -1967,7 +3194,7 @@ func (client *WANDSLLinkConfig1) SetDSLLinkType(NewLinkType string) (err error)
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANDSLLinkConfig_1, "SetDSLLinkType", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetDSLLinkType", request, response); err != nil {
 		return
 	}
 
@@ -1977,11 +3204,24 @@ func (client *WANDSLLinkConfig1) SetDSLLinkType(NewLinkType string) (err error)
 	return
 }
 
+// WANDSLLinkConfig1GetDSLLinkInfoResponse is the response of GetDSLLinkInfo, with each
+// argument in its SOAP string form.
+type WANDSLLinkConfig1GetDSLLinkInfoResponse struct {
+	NewLinkType   string
+	NewLinkStatus string
+}
+
+// GetDSLLinkInfo performs the GetDSLLinkInfo action of the service.
 //
 // Return values:
-//
-// * NewLinkStatus: allowed values: Up, Down
-func (client *WANDSLLinkConfig1) GetDSLLinkInfo() (NewLinkType string, NewLinkStatus string, err error) {
+//   - NewLinkType (out, state variable LinkType): allowed values EoA, IPoA, PPPoA, PPPoE, CIP, Unconfigured
+//   - NewLinkStatus (out, state variable LinkStatus): allowed values Up, Down, Initializing, Unavailable
+func (client *WANDSLLinkConfig1) GetDSLLinkInfo() (NewLinkType WANDSLLinkConfig1LinkType, NewLinkStatus WANDSLLinkConfig1LinkStatus, err error) {
+	return client.GetDSLLinkInfoCtx(context.Background())
+}
+
+// GetDSLLinkInfoCtx is GetDSLLinkInfo with a context, to cancel or time out the call.
+func (client *WANDSLLinkConfig1) GetDSLLinkInfoCtx(ctx context.Context) (NewLinkType WANDSLLinkConfig1LinkType, NewLinkStatus WANDSLLinkConfig1LinkStatus, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -1989,30 +3229,37 @@ func (client *WANDSLLinkConfig1) GetDSLLinkInfo() (NewLinkType string, NewLinkSt
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewLinkType string
-
-		NewLinkStatus string
-	}{}
+	response := &WANDSLLinkConfig1GetDSLLinkInfoResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANDSLLinkConfig_1, "GetDSLLinkInfo", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetDSLLinkInfo", request, response); err != nil {
 		return
 	}
 
 	// BEGIN Unmarshal arguments from response.
 
-	if NewLinkType, err = soap.UnmarshalString(response.NewLinkType); err != nil {
-		return
-	}
-	if NewLinkStatus, err = soap.UnmarshalString(response.NewLinkStatus); err != nil {
-		return
-	}
+	NewLinkType = WANDSLLinkConfig1LinkType(response.NewLinkType)
+	NewLinkStatus = WANDSLLinkConfig1LinkStatus(response.NewLinkStatus)
 	// END Unmarshal arguments from response.
 	return
 }
 
+// WANDSLLinkConfig1GetAutoConfigResponse is the response of GetAutoConfig, with each
+// argument in its SOAP string form.
+type WANDSLLinkConfig1GetAutoConfigResponse struct {
+	NewAutoConfig string
+}
+
+// GetAutoConfig performs the GetAutoConfig action of the service.
+//
+// Return values:
+//   - NewAutoConfig (out, state variable A_ARG_TYPE_GetAutoConfig_NewAutoConfig)
 func (client *WANDSLLinkConfig1) GetAutoConfig() (NewAutoConfig bool, err error) {
+	return client.GetAutoConfigCtx(context.Background())
+}
+
+// GetAutoConfigCtx is GetAutoConfig with a context, to cancel or time out the call.
+func (client *WANDSLLinkConfig1) GetAutoConfigCtx(ctx context.Context) (NewAutoConfig bool, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -2020,12 +3267,10 @@ func (client *WANDSLLinkConfig1) GetAutoConfig() (NewAutoConfig bool, err error)
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewAutoConfig string
-	}{}
+	response := &WANDSLLinkConfig1GetAutoConfigResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANDSLLinkConfig_1, "GetAutoConfig", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetAutoConfig", request, response); err != nil {
 		return
 	}
 
@@ -2038,7 +3283,22 @@ func (client *WANDSLLinkConfig1) GetAutoConfig() (NewAutoConfig bool, err error)
 	return
 }
 
-func (client *WANDSLLinkConfig1) GetModulationType() (NewModulationType string, err error) {
+// WANDSLLinkConfig1GetModulationTypeResponse is the response of GetModulationType, with each
+// argument in its SOAP string form.
+type WANDSLLinkConfig1GetModulationTypeResponse struct {
+	NewModulationType string
+}
+
+// GetModulationType performs the GetModulationType action of the service.
+//
+// Return values:
+//   - NewModulationType (out, state variable ModulationType): allowed values ADSL_G.dmt, ADSL_G.lite, G.shdsl, IDSL, HDSL, SDSL, VDSL
+func (client *WANDSLLinkConfig1) GetModulationType() (NewModulationType WANDSLLinkConfig1ModulationType, err error) {
+	return client.GetModulationTypeCtx(context.Background())
+}
+
+// GetModulationTypeCtx is GetModulationType with a context, to cancel or time out the call.
+func (client *WANDSLLinkConfig1) GetModulationTypeCtx(ctx context.Context) (NewModulationType WANDSLLinkConfig1ModulationType, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -2046,29 +3306,38 @@ func (client *WANDSLLinkConfig1) GetModulationType() (NewModulationType string,
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewModulationType string
-	}{}
+	response := &WANDSLLinkConfig1GetModulationTypeResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANDSLLinkConfig_1, "GetModulationType", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetModulationType", request, response); err != nil {
 		return
 	}
 
 	// BEGIN Unmarshal arguments from response.
 
-	if NewModulationType, err = soap.UnmarshalString(response.NewModulationType); err != nil {
-		return
-	}
+	NewModulationType = WANDSLLinkConfig1ModulationType(response.NewModulationType)
 	// END Unmarshal arguments from response.
 	return
 }
 
+// WANDSLLinkConfig1SetDestinationAddressRequest is the request of SetDestinationAddress, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANDSLLinkConfig1SetDestinationAddressRequest struct {
+	NewDestinationAddress string
+}
+
+// SetDestinationAddress performs the SetDestinationAddress action of the service.
+//
+// Arguments:
+//   - NewDestinationAddress (in, state variable A_ARG_TYPE_SetDestinationAddress_NewDestinationAddress)
 func (client *WANDSLLinkConfig1) SetDestinationAddress(NewDestinationAddress string) (err error) {
+	return client.SetDestinationAddressCtx(context.Background(), NewDestinationAddress)
+}
+
+// SetDestinationAddressCtx is SetDestinationAddress with a context, to cancel or time out the call.
+func (client *WANDSLLinkConfig1) SetDestinationAddressCtx(ctx context.Context, NewDestinationAddress string) (err error) {
 	// Request structure.
-	request := &struct {
-		NewDestinationAddress string
-	}{}
+	request := &WANDSLLinkConfig1SetDestinationAddressRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewDestinationAddress, err = soap.MarshalString(NewDestinationAddress); err != nil {
@@ -2080,7 +3349,7 @@ func (client *WANDSLLinkConfig1) SetDestinationAddress(NewDestinationAddress str
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANDSLLinkConfig_1, "SetDestinationAddress", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetDestinationAddress", request, response); err != nil {
 		return
 	}
 
@@ -2090,7 +3359,22 @@ func (client *WANDSLLinkConfig1) SetDestinationAddress(NewDestinationAddress str
 	return
 }
 
+// WANDSLLinkConfig1GetDestinationAddressResponse is the response of GetDestinationAddress, with each
+// argument in its SOAP string form.
+type WANDSLLinkConfig1GetDestinationAddressResponse struct {
+	NewDestinationAddress string
+}
+
+// GetDestinationAddress performs the GetDestinationAddress action of the service.
+//
+// Return values:
+//   - NewDestinationAddress (out, state variable A_ARG_TYPE_GetDestinationAddress_NewDestinationAddress)
 func (client *WANDSLLinkConfig1) GetDestinationAddress() (NewDestinationAddress string, err error) {
+	return client.GetDestinationAddressCtx(context.Background())
+}
+
+// GetDestinationAddressCtx is GetDestinationAddress with a context, to cancel or time out the call.
+func (client *WANDSLLinkConfig1) GetDestinationAddressCtx(ctx context.Context) (NewDestinationAddress string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -2098,12 +3382,10 @@ func (client *WANDSLLinkConfig1) GetDestinationAddress() (NewDestinationAddress
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewDestinationAddress string
-	}{}
+	response := &WANDSLLinkConfig1GetDestinationAddressResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANDSLLinkConfig_1, "GetDestinationAddress", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetDestinationAddress", request, response); err != nil {
 		return
 	}
 
@@ -2116,14 +3398,27 @@ func (client *WANDSLLinkConfig1) GetDestinationAddress() (NewDestinationAddress
 	return
 }
 
-func (client *WANDSLLinkConfig1) SetATMEncapsulation(NewATMEncapsulation string) (err error) {
+// WANDSLLinkConfig1SetATMEncapsulationRequest is the request of SetATMEncapsulation, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANDSLLinkConfig1SetATMEncapsulationRequest struct {
+	NewATMEncapsulation string
+}
+
+// SetATMEncapsulation performs the SetATMEncapsulation action of the service.
+//
+// Arguments:
+//   - NewATMEncapsulation (in, state variable ATMEncapsulation): allowed values LLC, VCMUX
+func (client *WANDSLLinkConfig1) SetATMEncapsulation(NewATMEncapsulation WANDSLLinkConfig1ATMEncapsulation) (err error) {
+	return client.SetATMEncapsulationCtx(context.Background(), NewATMEncapsulation)
+}
+
+// SetATMEncapsulationCtx is SetATMEncapsulation with a context, to cancel or time out the call.
+func (client *WANDSLLinkConfig1) SetATMEncapsulationCtx(ctx context.Context, NewATMEncapsulation WANDSLLinkConfig1ATMEncapsulation) (err error) {
 	// Request structure.
-	request := &struct {
-		NewATMEncapsulation string
-	}{}
+	request := &WANDSLLinkConfig1SetATMEncapsulationRequest{}
 	// BEGIN Marshal arguments into request.
 
-	if request.NewATMEncapsulation, err = soap.MarshalString(NewATMEncapsulation); err != nil {
+	if request.NewATMEncapsulation, err = soap.MarshalString(string(NewATMEncapsulation)); err != nil {
 		return
 	}
 	// END Marshal arguments into request.
@@ -2132,7 +3427,7 @@ func (client *WANDSLLinkConfig1) SetATMEncapsulation(NewATMEncapsulation string)
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANDSLLinkConfig_1, "SetATMEncapsulation", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetATMEncapsulation", request, response); err != nil {
 		return
 	}
 
@@ -2142,7 +3437,22 @@ func (client *WANDSLLinkConfig1) SetATMEncapsulation(NewATMEncapsulation string)
 	return
 }
 
-func (client *WANDSLLinkConfig1) GetATMEncapsulation() (NewATMEncapsulation string, err error) {
+// WANDSLLinkConfig1GetATMEncapsulationResponse is the response of GetATMEncapsulation, with each
+// argument in its SOAP string form.
+type WANDSLLinkConfig1GetATMEncapsulationResponse struct {
+	NewATMEncapsulation string
+}
+
+// GetATMEncapsulation performs the GetATMEncapsulation action of the service.
+//
+// Return values:
+//   - NewATMEncapsulation (out, state variable ATMEncapsulation): allowed values LLC, VCMUX
+func (client *WANDSLLinkConfig1) GetATMEncapsulation() (NewATMEncapsulation WANDSLLinkConfig1ATMEncapsulation, err error) {
+	return client.GetATMEncapsulationCtx(context.Background())
+}
+
+// GetATMEncapsulationCtx is GetATMEncapsulation with a context, to cancel or time out the call.
+func (client *WANDSLLinkConfig1) GetATMEncapsulationCtx(ctx context.Context) (NewATMEncapsulation WANDSLLinkConfig1ATMEncapsulation, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -2150,29 +3460,38 @@ func (client *WANDSLLinkConfig1) GetATMEncapsulation() (NewATMEncapsulation stri
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewATMEncapsulation string
-	}{}
+	response := &WANDSLLinkConfig1GetATMEncapsulationResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANDSLLinkConfig_1, "GetATMEncapsulation", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetATMEncapsulation", request, response); err != nil {
 		return
 	}
 
 	// BEGIN Unmarshal arguments from response.
 
-	if NewATMEncapsulation, err = soap.UnmarshalString(response.NewATMEncapsulation); err != nil {
-		return
-	}
+	NewATMEncapsulation = WANDSLLinkConfig1ATMEncapsulation(response.NewATMEncapsulation)
 	// END Unmarshal arguments from response.
 	return
 }
 
+// WANDSLLinkConfig1SetFCSPreservedRequest is the request of SetFCSPreserved, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANDSLLinkConfig1SetFCSPreservedRequest struct {
+	NewFCSPreserved string
+}
+
+// SetFCSPreserved performs the SetFCSPreserved action of the service.
+//
+// Arguments:
+//   - NewFCSPreserved (in, state variable A_ARG_TYPE_SetFCSPreserved_NewFCSPreserved)
 func (client *WANDSLLinkConfig1) SetFCSPreserved(NewFCSPreserved bool) (err error) {
+	return client.SetFCSPreservedCtx(context.Background(), NewFCSPreserved)
+}
+
+// SetFCSPreservedCtx is SetFCSPreserved with a context, to cancel or time out the call.
+func (client *WANDSLLinkConfig1) SetFCSPreservedCtx(ctx context.Context, NewFCSPreserved bool) (err error) {
 	// Request structure.
-	request := &struct {
-		NewFCSPreserved string
-	}{}
+	request := &WANDSLLinkConfig1SetFCSPreservedRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewFCSPreserved, err = soap.MarshalBoolean(NewFCSPreserved); err != nil {
@@ -2184,7 +3503,7 @@ func (client *WANDSLLinkConfig1) SetFCSPreserved(NewFCSPreserved bool) (err erro
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANDSLLinkConfig_1, "SetFCSPreserved", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetFCSPreserved", request, response); err != nil {
 		return
 	}
 
@@ -2194,7 +3513,22 @@ func (client *WANDSLLinkConfig1) SetFCSPreserved(NewFCSPreserved bool) (err erro
 	return
 }
 
+// WANDSLLinkConfig1GetFCSPreservedResponse is the response of GetFCSPreserved, with each
+// argument in its SOAP string form.
+type WANDSLLinkConfig1GetFCSPreservedResponse struct {
+	NewFCSPreserved string
+}
+
+// GetFCSPreserved performs the GetFCSPreserved action of the service.
+//
+// Return values:
+//   - NewFCSPreserved (out, state variable A_ARG_TYPE_GetFCSPreserved_NewFCSPreserved)
 func (client *WANDSLLinkConfig1) GetFCSPreserved() (NewFCSPreserved bool, err error) {
+	return client.GetFCSPreservedCtx(context.Background())
+}
+
+// GetFCSPreservedCtx is GetFCSPreserved with a context, to cancel or time out the call.
+func (client *WANDSLLinkConfig1) GetFCSPreservedCtx(ctx context.Context) (NewFCSPreserved bool, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -2202,12 +3536,10 @@ func (client *WANDSLLinkConfig1) GetFCSPreserved() (NewFCSPreserved bool, err er
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewFCSPreserved string
-	}{}
+	response := &WANDSLLinkConfig1GetFCSPreservedResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANDSLLinkConfig_1, "GetFCSPreserved", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetFCSPreserved", request, response); err != nil {
 		return
 	}
 
@@ -2227,6 +3559,37 @@ type WANEthernetLinkConfig1 struct {
 	goupnp.ServiceClient
 }
 
+// WANEthernetLinkConfig1Client is the interface of the actions of WANEthernetLinkConfig1, for
+// substituting fakes or mocks for the service in tests.
+type WANEthernetLinkConfig1Client interface {
+	GetEthernetLinkStatus() (NewEthernetLinkStatus WANEthernetLinkConfig1EthernetLinkStatus, err error)
+	GetEthernetLinkStatusCtx(ctx context.Context) (NewEthernetLinkStatus WANEthernetLinkConfig1EthernetLinkStatus, err error)
+}
+
+var _ WANEthernetLinkConfig1Client = new(WANEthernetLinkConfig1)
+
+// WANEthernetLinkConfig1EthernetLinkStatus is a value of the state variable EthernetLinkStatus of
+// WANEthernetLinkConfig1.
+type WANEthernetLinkConfig1EthernetLinkStatus string
+
+// Allowed values of WANEthernetLinkConfig1EthernetLinkStatus.
+const (
+	WANEthernetLinkConfig1EthernetLinkStatus_Up          WANEthernetLinkConfig1EthernetLinkStatus = "Up"
+	WANEthernetLinkConfig1EthernetLinkStatus_Down        WANEthernetLinkConfig1EthernetLinkStatus = "Down"
+	WANEthernetLinkConfig1EthernetLinkStatus_Unavailable WANEthernetLinkConfig1EthernetLinkStatus = "Unavailable"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v WANEthernetLinkConfig1EthernetLinkStatus) Valid() bool {
+	switch v {
+	case WANEthernetLinkConfig1EthernetLinkStatus_Up,
+		WANEthernetLinkConfig1EthernetLinkStatus_Down,
+		WANEthernetLinkConfig1EthernetLinkStatus_Unavailable:
+		return true
+	}
+	return false
+}
+
 // NewWANEthernetLinkConfig1Clients discovers instances of the service on the network,
 // and returns clients to any that are found. errors will contain an error for
 // any devices that replied but which could not be queried, and err will be set
@@ -2280,11 +3643,40 @@ func newWANEthernetLinkConfig1ClientsFromGenericClients(genericClients []goupnp.
 	return clients
 }
 
+// WANEthernetLinkConfig1Timeouts are the default timeouts of the actions of
+// WANEthernetLinkConfig1, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var WANEthernetLinkConfig1Timeouts = map[string]time.Duration{
+	"": 10 * time.Second,
+}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *WANEthernetLinkConfig1) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, WANEthernetLinkConfig1Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_WANEthernetLinkConfig_1, actionName, request, response)
+}
+
+// WANEthernetLinkConfig1GetEthernetLinkStatusResponse is the response of GetEthernetLinkStatus, with each
+// argument in its SOAP string form.
+type WANEthernetLinkConfig1GetEthernetLinkStatusResponse struct {
+	NewEthernetLinkStatus string
+}
+
+// GetEthernetLinkStatus performs the GetEthernetLinkStatus action of the service.
 //
 // Return values:
-//
-// * NewEthernetLinkStatus: allowed values: Up, Down
-func (client *WANEthernetLinkConfig1) GetEthernetLinkStatus() (NewEthernetLinkStatus string, err error) {
+//   - NewEthernetLinkStatus (out, state variable EthernetLinkStatus): allowed values Up, Down, Unavailable
+func (client *WANEthernetLinkConfig1) GetEthernetLinkStatus() (NewEthernetLinkStatus WANEthernetLinkConfig1EthernetLinkStatus, err error) {
+	return client.GetEthernetLinkStatusCtx(context.Background())
+}
+
+// GetEthernetLinkStatusCtx is GetEthernetLinkStatus with a context, to cancel or time out the call.
+func (client *WANEthernetLinkConfig1) GetEthernetLinkStatusCtx(ctx context.Context) (NewEthernetLinkStatus WANEthernetLinkConfig1EthernetLinkStatus, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -2292,20 +3684,16 @@ func (client *WANEthernetLinkConfig1) GetEthernetLinkStatus() (NewEthernetLinkSt
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewEthernetLinkStatus string
-	}{}
+	response := &WANEthernetLinkConfig1GetEthernetLinkStatusResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANEthernetLinkConfig_1, "GetEthernetLinkStatus", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetEthernetLinkStatus", request, response); err != nil {
 		return
 	}
 
 	// BEGIN Unmarshal arguments from response.
 
-	if NewEthernetLinkStatus, err = soap.UnmarshalString(response.NewEthernetLinkStatus); err != nil {
-		return
-	}
+	NewEthernetLinkStatus = WANEthernetLinkConfig1EthernetLinkStatus(response.NewEthernetLinkStatus)
 	// END Unmarshal arguments from response.
 	return
 }
@@ -2317,6 +3705,131 @@ type WANIPConnection1 struct {
 	goupnp.ServiceClient
 }
 
+// WANIPConnection1Client is the interface of the actions of WANIPConnection1, for
+// substituting fakes or mocks for the service in tests.
+type WANIPConnection1Client interface {
+	SetConnectionType(NewConnectionType string) (err error)
+	SetConnectionTypeCtx(ctx context.Context, NewConnectionType string) (err error)
+	GetConnectionTypeInfo() (NewConnectionType string, NewPossibleConnectionTypes WANIPConnection1PossibleConnectionTypes, err error)
+	GetConnectionTypeInfoCtx(ctx context.Context) (NewConnectionType string, NewPossibleConnectionTypes WANIPConnection1PossibleConnectionTypes, err error)
+	RequestConnection() (err error)
+	RequestConnectionCtx(ctx context.Context) (err error)
+	RequestTermination() (err error)
+	RequestTerminationCtx(ctx context.Context) (err error)
+	ForceTermination() (err error)
+	ForceTerminationCtx(ctx context.Context) (err error)
+	SetAutoDisconnectTime(NewAutoDisconnectTime uint32) (err error)
+	SetAutoDisconnectTimeCtx(ctx context.Context, NewAutoDisconnectTime uint32) (err error)
+	SetIdleDisconnectTime(NewIdleDisconnectTime uint32) (err error)
+	SetIdleDisconnectTimeCtx(ctx context.Context, NewIdleDisconnectTime uint32) (err error)
+	SetWarnDisconnectDelay(NewWarnDisconnectDelay uint32) (err error)
+	SetWarnDisconnectDelayCtx(ctx context.Context, NewWarnDisconnectDelay uint32) (err error)
+	GetStatusInfo() (NewConnectionStatus WANIPConnection1ConnectionStatus, NewLastConnectionError WANIPConnection1LastConnectionError, NewUptime uint32, err error)
+	GetStatusInfoCtx(ctx context.Context) (NewConnectionStatus WANIPConnection1ConnectionStatus, NewLastConnectionError WANIPConnection1LastConnectionError, NewUptime uint32, err error)
+	GetAutoDisconnectTime() (NewAutoDisconnectTime uint32, err error)
+	GetAutoDisconnectTimeCtx(ctx context.Context) (NewAutoDisconnectTime uint32, err error)
+	GetIdleDisconnectTime() (NewIdleDisconnectTime uint32, err error)
+	GetIdleDisconnectTimeCtx(ctx context.Context) (NewIdleDisconnectTime uint32, err error)
+	GetWarnDisconnectDelay() (NewWarnDisconnectDelay uint32, err error)
+	GetWarnDisconnectDelayCtx(ctx context.Context) (NewWarnDisconnectDelay uint32, err error)
+	GetNATRSIPStatus() (NewRSIPAvailable bool, NewNATEnabled bool, err error)
+	GetNATRSIPStatusCtx(ctx context.Context) (NewRSIPAvailable bool, NewNATEnabled bool, err error)
+	GetGenericPortMappingEntry(NewPortMappingIndex uint16) (NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection1PortMappingProtocol, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32, err error)
+	GetGenericPortMappingEntryCtx(ctx context.Context, NewPortMappingIndex uint16) (NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection1PortMappingProtocol, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32, err error)
+	GetSpecificPortMappingEntry(NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection1PortMappingProtocol) (NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32, err error)
+	GetSpecificPortMappingEntryCtx(ctx context.Context, NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection1PortMappingProtocol) (NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32, err error)
+	AddPortMapping(NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection1PortMappingProtocol, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32) (err error)
+	AddPortMappingCtx(ctx context.Context, NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection1PortMappingProtocol, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32) (err error)
+	DeletePortMapping(NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection1PortMappingProtocol) (err error)
+	DeletePortMappingCtx(ctx context.Context, NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection1PortMappingProtocol) (err error)
+	GetExternalIPAddress() (NewExternalIPAddress string, err error)
+	GetExternalIPAddressCtx(ctx context.Context) (NewExternalIPAddress string, err error)
+}
+
+var _ WANIPConnection1Client = new(WANIPConnection1)
+
+// WANIPConnection1PossibleConnectionTypes is a value of the state variable PossibleConnectionTypes of
+// WANIPConnection1.
+type WANIPConnection1PossibleConnectionTypes string
+
+// Allowed values of WANIPConnection1PossibleConnectionTypes.
+const (
+	WANIPConnection1PossibleConnectionTypes_Unconfigured WANIPConnection1PossibleConnectionTypes = "Unconfigured"
+	WANIPConnection1PossibleConnectionTypes_IP_Routed    WANIPConnection1PossibleConnectionTypes = "IP_Routed"
+	WANIPConnection1PossibleConnectionTypes_IP_Bridged   WANIPConnection1PossibleConnectionTypes = "IP_Bridged"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v WANIPConnection1PossibleConnectionTypes) Valid() bool {
+	switch v {
+	case WANIPConnection1PossibleConnectionTypes_Unconfigured,
+		WANIPConnection1PossibleConnectionTypes_IP_Routed,
+		WANIPConnection1PossibleConnectionTypes_IP_Bridged:
+		return true
+	}
+	return false
+}
+
+// WANIPConnection1ConnectionStatus is a value of the state variable ConnectionStatus of
+// WANIPConnection1.
+type WANIPConnection1ConnectionStatus string
+
+// Allowed values of WANIPConnection1ConnectionStatus.
+const (
+	WANIPConnection1ConnectionStatus_Unconfigured WANIPConnection1ConnectionStatus = "Unconfigured"
+	WANIPConnection1ConnectionStatus_Connected    WANIPConnection1ConnectionStatus = "Connected"
+	WANIPConnection1ConnectionStatus_Disconnected WANIPConnection1ConnectionStatus = "Disconnected"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v WANIPConnection1ConnectionStatus) Valid() bool {
+	switch v {
+	case WANIPConnection1ConnectionStatus_Unconfigured,
+		WANIPConnection1ConnectionStatus_Connected,
+		WANIPConnection1ConnectionStatus_Disconnected:
+		return true
+	}
+	return false
+}
+
+// WANIPConnection1LastConnectionError is a value of the state variable LastConnectionError of
+// WANIPConnection1.
+type WANIPConnection1LastConnectionError string
+
+// Allowed values of WANIPConnection1LastConnectionError.
+const (
+	WANIPConnection1LastConnectionError_ERROR_NONE WANIPConnection1LastConnectionError = "ERROR_NONE"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v WANIPConnection1LastConnectionError) Valid() bool {
+	switch v {
+	case WANIPConnection1LastConnectionError_ERROR_NONE:
+		return true
+	}
+	return false
+}
+
+// WANIPConnection1PortMappingProtocol is a value of the state variable PortMappingProtocol of
+// WANIPConnection1.
+type WANIPConnection1PortMappingProtocol string
+
+// Allowed values of WANIPConnection1PortMappingProtocol.
+const (
+	WANIPConnection1PortMappingProtocol_TCP WANIPConnection1PortMappingProtocol = "TCP"
+	WANIPConnection1PortMappingProtocol_UDP WANIPConnection1PortMappingProtocol = "UDP"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v WANIPConnection1PortMappingProtocol) Valid() bool {
+	switch v {
+	case WANIPConnection1PortMappingProtocol_TCP,
+		WANIPConnection1PortMappingProtocol_UDP:
+		return true
+	}
+	return false
+}
+
 // NewWANIPConnection1Clients discovers instances of the service on the network,
 // and returns clients to any that are found. errors will contain an error for
 // any devices that replied but which could not be queried, and err will be set
@@ -2370,11 +3883,42 @@ func newWANIPConnection1ClientsFromGenericClients(genericClients []goupnp.Servic
 	return clients
 }
 
+// WANIPConnection1Timeouts are the default timeouts of the actions of
+// WANIPConnection1, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var WANIPConnection1Timeouts = map[string]time.Duration{
+	"": 10 * time.Second,
+}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *WANIPConnection1) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, WANIPConnection1Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_WANIPConnection_1, actionName, request, response)
+}
+
+// WANIPConnection1SetConnectionTypeRequest is the request of SetConnectionType, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANIPConnection1SetConnectionTypeRequest struct {
+	NewConnectionType string
+}
+
+// SetConnectionType performs the SetConnectionType action of the service.
+//
+// Arguments:
+//   - NewConnectionType (in, state variable A_ARG_TYPE_SetConnectionType_NewConnectionType)
 func (client *WANIPConnection1) SetConnectionType(NewConnectionType string) (err error) {
+	return client.SetConnectionTypeCtx(context.Background(), NewConnectionType)
+}
+
+// SetConnectionTypeCtx is SetConnectionType with a context, to cancel or time out the call.
+func (client *WANIPConnection1) SetConnectionTypeCtx(ctx context.Context, NewConnectionType string) (err error) {
 	// Request structure.
-	request := &struct {
-		NewConnectionType string
-	}{}
+	request := &WANIPConnection1SetConnectionTypeRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewConnectionType, err = soap.MarshalString(NewConnectionType); err != nil {
@@ -2386,7 +3930,7 @@ func (client *WANIPConnection1) SetConnectionType(NewConnectionType string) (err
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_1, "SetConnectionType", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetConnectionType", request, response); err != nil {
 		return
 	}
 
@@ -2396,11 +3940,24 @@ func (client *WANIPConnection1) SetConnectionType(NewConnectionType string) (err
 	return
 }
 
+// WANIPConnection1GetConnectionTypeInfoResponse is the response of GetConnectionTypeInfo, with each
+// argument in its SOAP string form.
+type WANIPConnection1GetConnectionTypeInfoResponse struct {
+	NewConnectionType          string
+	NewPossibleConnectionTypes string
+}
+
+// GetConnectionTypeInfo performs the GetConnectionTypeInfo action of the service.
 //
 // Return values:
-//
-// * NewPossibleConnectionTypes: allowed values: Unconfigured, IP_Routed, IP_Bridged
-func (client *WANIPConnection1) GetConnectionTypeInfo() (NewConnectionType string, NewPossibleConnectionTypes string, err error) {
+//   - NewConnectionType (out, state variable A_ARG_TYPE_GetConnectionTypeInfo_NewConnectionType)
+//   - NewPossibleConnectionTypes (out, state variable PossibleConnectionTypes): allowed values Unconfigured, IP_Routed, IP_Bridged
+func (client *WANIPConnection1) GetConnectionTypeInfo() (NewConnectionType string, NewPossibleConnectionTypes WANIPConnection1PossibleConnectionTypes, err error) {
+	return client.GetConnectionTypeInfoCtx(context.Background())
+}
+
+// GetConnectionTypeInfoCtx is GetConnectionTypeInfo with a context, to cancel or time out the call.
+func (client *WANIPConnection1) GetConnectionTypeInfoCtx(ctx context.Context) (NewConnectionType string, NewPossibleConnectionTypes WANIPConnection1PossibleConnectionTypes, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -2408,14 +3965,10 @@ func (client *WANIPConnection1) GetConnectionTypeInfo() (NewConnectionType strin
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewConnectionType string
-
-		NewPossibleConnectionTypes string
-	}{}
+	response := &WANIPConnection1GetConnectionTypeInfoResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_1, "GetConnectionTypeInfo", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetConnectionTypeInfo", request, response); err != nil {
 		return
 	}
 
@@ -2424,14 +3977,18 @@ func (client *WANIPConnection1) GetConnectionTypeInfo() (NewConnectionType strin
 	if NewConnectionType, err = soap.UnmarshalString(response.NewConnectionType); err != nil {
 		return
 	}
-	if NewPossibleConnectionTypes, err = soap.UnmarshalString(response.NewPossibleConnectionTypes); err != nil {
-		return
-	}
+	NewPossibleConnectionTypes = WANIPConnection1PossibleConnectionTypes(response.NewPossibleConnectionTypes)
 	// END Unmarshal arguments from response.
 	return
 }
 
+// RequestConnection performs the RequestConnection action of the service.
 func (client *WANIPConnection1) RequestConnection() (err error) {
+	return client.RequestConnectionCtx(context.Background())
+}
+
+// RequestConnectionCtx is RequestConnection with a context, to cancel or time out the call.
+func (client *WANIPConnection1) RequestConnectionCtx(ctx context.Context) (err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -2442,7 +3999,7 @@ func (client *WANIPConnection1) RequestConnection() (err error) {
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_1, "RequestConnection", request, response); err != nil {
+	if err = client.PerformAction(ctx, "RequestConnection", request, response); err != nil {
 		return
 	}
 
@@ -2452,7 +4009,13 @@ func (client *WANIPConnection1) RequestConnection() (err error) {
 	return
 }
 
+// RequestTermination performs the RequestTermination action of the service.
 func (client *WANIPConnection1) RequestTermination() (err error) {
+	return client.RequestTerminationCtx(context.Background())
+}
+
+// RequestTerminationCtx is RequestTermination with a context, to cancel or time out the call.
+func (client *WANIPConnection1) RequestTerminationCtx(ctx context.Context) (err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -2463,7 +4026,7 @@ func (client *WANIPConnection1) RequestTermination() (err error) {
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_1, "RequestTermination", request, response); err != nil {
+	if err = client.PerformAction(ctx, "RequestTermination", request, response); err != nil {
 		return
 	}
 
@@ -2473,7 +4036,13 @@ func (client *WANIPConnection1) RequestTermination() (err error) {
 	return
 }
 
+// ForceTermination performs the ForceTermination action of the service.
 func (client *WANIPConnection1) ForceTermination() (err error) {
+	return client.ForceTerminationCtx(context.Background())
+}
+
+// ForceTerminationCtx is ForceTermination with a context, to cancel or time out the call.
+func (client *WANIPConnection1) ForceTerminationCtx(ctx context.Context) (err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -2484,7 +4053,7 @@ func (client *WANIPConnection1) ForceTermination() (err error) {
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_1, "ForceTermination", request, response); err != nil {
+	if err = client.PerformAction(ctx, "ForceTermination", request, response); err != nil {
 		return
 	}
 
@@ -2494,11 +4063,24 @@ func (client *WANIPConnection1) ForceTermination() (err error) {
 	return
 }
 
+// WANIPConnection1SetAutoDisconnectTimeRequest is the request of SetAutoDisconnectTime, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANIPConnection1SetAutoDisconnectTimeRequest struct {
+	NewAutoDisconnectTime string
+}
+
+// SetAutoDisconnectTime performs the SetAutoDisconnectTime action of the service.
+//
+// Arguments:
+//   - NewAutoDisconnectTime (in, state variable A_ARG_TYPE_SetAutoDisconnectTime_NewAutoDisconnectTime)
 func (client *WANIPConnection1) SetAutoDisconnectTime(NewAutoDisconnectTime uint32) (err error) {
+	return client.SetAutoDisconnectTimeCtx(context.Background(), NewAutoDisconnectTime)
+}
+
+// SetAutoDisconnectTimeCtx is SetAutoDisconnectTime with a context, to cancel or time out the call.
+func (client *WANIPConnection1) SetAutoDisconnectTimeCtx(ctx context.Context, NewAutoDisconnectTime uint32) (err error) {
 	// Request structure.
-	request := &struct {
-		NewAutoDisconnectTime string
-	}{}
+	request := &WANIPConnection1SetAutoDisconnectTimeRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewAutoDisconnectTime, err = soap.MarshalUi4(NewAutoDisconnectTime); err != nil {
@@ -2510,7 +4092,7 @@ func (client *WANIPConnection1) SetAutoDisconnectTime(NewAutoDisconnectTime uint
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_1, "SetAutoDisconnectTime", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetAutoDisconnectTime", request, response); err != nil {
 		return
 	}
 
@@ -2520,11 +4102,24 @@ func (client *WANIPConnection1) SetAutoDisconnectTime(NewAutoDisconnectTime uint
 	return
 }
 
+// WANIPConnection1SetIdleDisconnectTimeRequest is the request of SetIdleDisconnectTime, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANIPConnection1SetIdleDisconnectTimeRequest struct {
+	NewIdleDisconnectTime string
+}
+
+// SetIdleDisconnectTime performs the SetIdleDisconnectTime action of the service.
+//
+// Arguments:
+//   - NewIdleDisconnectTime (in, state variable A_ARG_TYPE_SetIdleDisconnectTime_NewIdleDisconnectTime)
 func (client *WANIPConnection1) SetIdleDisconnectTime(NewIdleDisconnectTime uint32) (err error) {
+	return client.SetIdleDisconnectTimeCtx(context.Background(), NewIdleDisconnectTime)
+}
+
+// SetIdleDisconnectTimeCtx is SetIdleDisconnectTime with a context, to cancel or time out the call.
+func (client *WANIPConnection1) SetIdleDisconnectTimeCtx(ctx context.Context, NewIdleDisconnectTime uint32) (err error) {
 	// Request structure.
-	request := &struct {
-		NewIdleDisconnectTime string
-	}{}
+	request := &WANIPConnection1SetIdleDisconnectTimeRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewIdleDisconnectTime, err = soap.MarshalUi4(NewIdleDisconnectTime); err != nil {
@@ -2536,7 +4131,7 @@ func (client *WANIPConnection1) SetIdleDisconnectTime(NewIdleDisconnectTime uint
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_1, "SetIdleDisconnectTime", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetIdleDisconnectTime", request, response); err != nil {
 		return
 	}
 
@@ -2546,11 +4141,24 @@ func (client *WANIPConnection1) SetIdleDisconnectTime(NewIdleDisconnectTime uint
 	return
 }
 
+// WANIPConnection1SetWarnDisconnectDelayRequest is the request of SetWarnDisconnectDelay, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANIPConnection1SetWarnDisconnectDelayRequest struct {
+	NewWarnDisconnectDelay string
+}
+
+// SetWarnDisconnectDelay performs the SetWarnDisconnectDelay action of the service.
+//
+// Arguments:
+//   - NewWarnDisconnectDelay (in, state variable A_ARG_TYPE_SetWarnDisconnectDelay_NewWarnDisconnectDelay)
 func (client *WANIPConnection1) SetWarnDisconnectDelay(NewWarnDisconnectDelay uint32) (err error) {
+	return client.SetWarnDisconnectDelayCtx(context.Background(), NewWarnDisconnectDelay)
+}
+
+// SetWarnDisconnectDelayCtx is SetWarnDisconnectDelay with a context, to cancel or time out the call.
+func (client *WANIPConnection1) SetWarnDisconnectDelayCtx(ctx context.Context, NewWarnDisconnectDelay uint32) (err error) {
 	// Request structure.
-	request := &struct {
-		NewWarnDisconnectDelay string
-	}{}
+	request := &WANIPConnection1SetWarnDisconnectDelayRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewWarnDisconnectDelay, err = soap.MarshalUi4(NewWarnDisconnectDelay); err != nil {
@@ -2562,7 +4170,7 @@ func (client *WANIPConnection1) SetWarnDisconnectDelay(NewWarnDisconnectDelay ui
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_1, "SetWarnDisconnectDelay", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetWarnDisconnectDelay", request, response); err != nil {
 		return
 	}
 
@@ -2572,13 +4180,29 @@ func (client *WANIPConnection1) SetWarnDisconnectDelay(NewWarnDisconnectDelay ui
 	return
 }
 
+// WANIPConnection1GetStatusInfoResponse is the response of GetStatusInfo, with each
+// argument in its SOAP string form.
+type WANIPConnection1GetStatusInfoResponse struct {
+	NewConnectionStatus    string
+	NewLastConnectionError string
+	NewUptime              string
+}
+
+// GetStatusInfo performs the GetStatusInfo action of the service.
 //
-// Return values:
-//
-// * NewConnectionStatus: allowed values: Unconfigured, Connected, Disconnected
+// Returns the status of the connection, the cause of the last failure to
+// connect, and the number of seconds for which the connection has been up.
 //
-// * NewLastConnectionError: allowed values: ERROR_NONE
-func (client *WANIPConnection1) GetStatusInfo() (NewConnectionStatus string, NewLastConnectionError string, NewUptime uint32, err error) {
+// Return values:
+//   - NewConnectionStatus (out, state variable ConnectionStatus): allowed values Unconfigured, Connected, Disconnected
+//   - NewLastConnectionError (out, state variable LastConnectionError): allowed values ERROR_NONE
+//   - NewUptime (out, state variable A_ARG_TYPE_GetStatusInfo_NewUptime)
+func (client *WANIPConnection1) GetStatusInfo() (NewConnectionStatus WANIPConnection1ConnectionStatus, NewLastConnectionError WANIPConnection1LastConnectionError, NewUptime uint32, err error) {
+	return client.GetStatusInfoCtx(context.Background())
+}
+
+// GetStatusInfoCtx is GetStatusInfo with a context, to cancel or time out the call.
+func (client *WANIPConnection1) GetStatusInfoCtx(ctx context.Context) (NewConnectionStatus WANIPConnection1ConnectionStatus, NewLastConnectionError WANIPConnection1LastConnectionError, NewUptime uint32, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -2586,27 +4210,17 @@ func (client *WANIPConnection1) GetStatusInfo() (NewConnectionStatus string, New
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewConnectionStatus string
-
-		NewLastConnectionError string
-
-		NewUptime string
-	}{}
+	response := &WANIPConnection1GetStatusInfoResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_1, "GetStatusInfo", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetStatusInfo", request, response); err != nil {
 		return
 	}
 
 	// BEGIN Unmarshal arguments from response.
 
-	if NewConnectionStatus, err = soap.UnmarshalString(response.NewConnectionStatus); err != nil {
-		return
-	}
-	if NewLastConnectionError, err = soap.UnmarshalString(response.NewLastConnectionError); err != nil {
-		return
-	}
+	NewConnectionStatus = WANIPConnection1ConnectionStatus(response.NewConnectionStatus)
+	NewLastConnectionError = WANIPConnection1LastConnectionError(response.NewLastConnectionError)
 	if NewUptime, err = soap.UnmarshalUi4(response.NewUptime); err != nil {
 		return
 	}
@@ -2614,7 +4228,22 @@ func (client *WANIPConnection1) GetStatusInfo() (NewConnectionStatus string, New
 	return
 }
 
+// WANIPConnection1GetAutoDisconnectTimeResponse is the response of GetAutoDisconnectTime, with each
+// argument in its SOAP string form.
+type WANIPConnection1GetAutoDisconnectTimeResponse struct {
+	NewAutoDisconnectTime string
+}
+
+// GetAutoDisconnectTime performs the GetAutoDisconnectTime action of the service.
+//
+// Return values:
+//   - NewAutoDisconnectTime (out, state variable A_ARG_TYPE_GetAutoDisconnectTime_NewAutoDisconnectTime)
 func (client *WANIPConnection1) GetAutoDisconnectTime() (NewAutoDisconnectTime uint32, err error) {
+	return client.GetAutoDisconnectTimeCtx(context.Background())
+}
+
+// GetAutoDisconnectTimeCtx is GetAutoDisconnectTime with a context, to cancel or time out the call.
+func (client *WANIPConnection1) GetAutoDisconnectTimeCtx(ctx context.Context) (NewAutoDisconnectTime uint32, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -2622,12 +4251,10 @@ func (client *WANIPConnection1) GetAutoDisconnectTime() (NewAutoDisconnectTime u
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewAutoDisconnectTime string
-	}{}
+	response := &WANIPConnection1GetAutoDisconnectTimeResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_1, "GetAutoDisconnectTime", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetAutoDisconnectTime", request, response); err != nil {
 		return
 	}
 
@@ -2640,7 +4267,22 @@ func (client *WANIPConnection1) GetAutoDisconnectTime() (NewAutoDisconnectTime u
 	return
 }
 
+// WANIPConnection1GetIdleDisconnectTimeResponse is the response of GetIdleDisconnectTime, with each
+// argument in its SOAP string form.
+type WANIPConnection1GetIdleDisconnectTimeResponse struct {
+	NewIdleDisconnectTime string
+}
+
+// GetIdleDisconnectTime performs the GetIdleDisconnectTime action of the service.
+//
+// Return values:
+//   - NewIdleDisconnectTime (out, state variable A_ARG_TYPE_GetIdleDisconnectTime_NewIdleDisconnectTime)
 func (client *WANIPConnection1) GetIdleDisconnectTime() (NewIdleDisconnectTime uint32, err error) {
+	return client.GetIdleDisconnectTimeCtx(context.Background())
+}
+
+// GetIdleDisconnectTimeCtx is GetIdleDisconnectTime with a context, to cancel or time out the call.
+func (client *WANIPConnection1) GetIdleDisconnectTimeCtx(ctx context.Context) (NewIdleDisconnectTime uint32, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -2648,12 +4290,10 @@ func (client *WANIPConnection1) GetIdleDisconnectTime() (NewIdleDisconnectTime u
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewIdleDisconnectTime string
-	}{}
+	response := &WANIPConnection1GetIdleDisconnectTimeResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_1, "GetIdleDisconnectTime", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetIdleDisconnectTime", request, response); err != nil {
 		return
 	}
 
@@ -2666,7 +4306,22 @@ func (client *WANIPConnection1) GetIdleDisconnectTime() (NewIdleDisconnectTime u
 	return
 }
 
+// WANIPConnection1GetWarnDisconnectDelayResponse is the response of GetWarnDisconnectDelay, with each
+// argument in its SOAP string form.
+type WANIPConnection1GetWarnDisconnectDelayResponse struct {
+	NewWarnDisconnectDelay string
+}
+
+// GetWarnDisconnectDelay performs the GetWarnDisconnectDelay action of the service.
+//
+// Return values:
+//   - NewWarnDisconnectDelay (out, state variable A_ARG_TYPE_GetWarnDisconnectDelay_NewWarnDisconnectDelay)
 func (client *WANIPConnection1) GetWarnDisconnectDelay() (NewWarnDisconnectDelay uint32, err error) {
+	return client.GetWarnDisconnectDelayCtx(context.Background())
+}
+
+// GetWarnDisconnectDelayCtx is GetWarnDisconnectDelay with a context, to cancel or time out the call.
+func (client *WANIPConnection1) GetWarnDisconnectDelayCtx(ctx context.Context) (NewWarnDisconnectDelay uint32, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -2674,12 +4329,10 @@ func (client *WANIPConnection1) GetWarnDisconnectDelay() (NewWarnDisconnectDelay
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewWarnDisconnectDelay string
-	}{}
+	response := &WANIPConnection1GetWarnDisconnectDelayResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_1, "GetWarnDisconnectDelay", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetWarnDisconnectDelay", request, response); err != nil {
 		return
 	}
 
@@ -2692,7 +4345,24 @@ func (client *WANIPConnection1) GetWarnDisconnectDelay() (NewWarnDisconnectDelay
 	return
 }
 
+// WANIPConnection1GetNATRSIPStatusResponse is the response of GetNATRSIPStatus, with each
+// argument in its SOAP string form.
+type WANIPConnection1GetNATRSIPStatusResponse struct {
+	NewRSIPAvailable string
+	NewNATEnabled    string
+}
+
+// GetNATRSIPStatus performs the GetNATRSIPStatus action of the service.
+//
+// Return values:
+//   - NewRSIPAvailable (out, state variable A_ARG_TYPE_GetNATRSIPStatus_NewRSIPAvailable)
+//   - NewNATEnabled (out, state variable A_ARG_TYPE_GetNATRSIPStatus_NewNATEnabled)
 func (client *WANIPConnection1) GetNATRSIPStatus() (NewRSIPAvailable bool, NewNATEnabled bool, err error) {
+	return client.GetNATRSIPStatusCtx(context.Background())
+}
+
+// GetNATRSIPStatusCtx is GetNATRSIPStatus with a context, to cancel or time out the call.
+func (client *WANIPConnection1) GetNATRSIPStatusCtx(ctx context.Context) (NewRSIPAvailable bool, NewNATEnabled bool, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -2700,14 +4370,10 @@ func (client *WANIPConnection1) GetNATRSIPStatus() (NewRSIPAvailable bool, NewNA
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewRSIPAvailable string
-
-		NewNATEnabled string
-	}{}
+	response := &WANIPConnection1GetNATRSIPStatusResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_1, "GetNATRSIPStatus", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetNATRSIPStatus", request, response); err != nil {
 		return
 	}
 
@@ -2723,15 +4389,51 @@ func (client *WANIPConnection1) GetNATRSIPStatus() (NewRSIPAvailable bool, NewNA
 	return
 }
 
+// WANIPConnection1GetGenericPortMappingEntryRequest is the request of GetGenericPortMappingEntry, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANIPConnection1GetGenericPortMappingEntryRequest struct {
+	NewPortMappingIndex string
+}
+
+// WANIPConnection1GetGenericPortMappingEntryResponse is the response of GetGenericPortMappingEntry, with each
+// argument in its SOAP string form.
+type WANIPConnection1GetGenericPortMappingEntryResponse struct {
+	NewRemoteHost             string
+	NewExternalPort           string
+	NewProtocol               string
+	NewInternalPort           string
+	NewInternalClient         string
+	NewEnabled                string
+	NewPortMappingDescription string
+	NewLeaseDuration          string
+}
+
+// GetGenericPortMappingEntry performs the GetGenericPortMappingEntry action of the service.
 //
-// Return values:
+// Returns the port mapping at NewPortMappingIndex in the table of port
+// mappings, counting from 0, for listing the mappings. It fails with error
+// 713 (SpecifiedArrayIndexInvalid) after the last mapping.
+//
+// Arguments:
+//   - NewPortMappingIndex (in, state variable A_ARG_TYPE_GetGenericPortMappingEntry_NewPortMappingIndex)
 //
-// * NewProtocol: allowed values: TCP, UDP
-func (client *WANIPConnection1) GetGenericPortMappingEntry(NewPortMappingIndex uint16) (NewRemoteHost string, NewExternalPort uint16, NewProtocol string, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32, err error) {
+// Return values:
+//   - NewRemoteHost (out, state variable A_ARG_TYPE_GetGenericPortMappingEntry_NewRemoteHost)
+//   - NewExternalPort (out, state variable A_ARG_TYPE_GetGenericPortMappingEntry_NewExternalPort)
+//   - NewProtocol (out, state variable PortMappingProtocol): allowed values TCP, UDP
+//   - NewInternalPort (out, state variable A_ARG_TYPE_GetGenericPortMappingEntry_NewInternalPort)
+//   - NewInternalClient (out, state variable A_ARG_TYPE_GetGenericPortMappingEntry_NewInternalClient)
+//   - NewEnabled (out, state variable A_ARG_TYPE_GetGenericPortMappingEntry_NewEnabled)
+//   - NewPortMappingDescription (out, state variable A_ARG_TYPE_GetGenericPortMappingEntry_NewPortMappingDescription)
+//   - NewLeaseDuration (out, state variable A_ARG_TYPE_GetGenericPortMappingEntry_NewLeaseDuration)
+func (client *WANIPConnection1) GetGenericPortMappingEntry(NewPortMappingIndex uint16) (NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection1PortMappingProtocol, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32, err error) {
+	return client.GetGenericPortMappingEntryCtx(context.Background(), NewPortMappingIndex)
+}
+
+// GetGenericPortMappingEntryCtx is GetGenericPortMappingEntry with a context, to cancel or time out the call.
+func (client *WANIPConnection1) GetGenericPortMappingEntryCtx(ctx context.Context, NewPortMappingIndex uint16) (NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection1PortMappingProtocol, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32, err error) {
 	// Request structure.
-	request := &struct {
-		NewPortMappingIndex string
-	}{}
+	request := &WANIPConnection1GetGenericPortMappingEntryRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewPortMappingIndex, err = soap.MarshalUi2(NewPortMappingIndex); err != nil {
@@ -2740,26 +4442,10 @@ func (client *WANIPConnection1) GetGenericPortMappingEntry(NewPortMappingIndex u
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewRemoteHost string
-
-		NewExternalPort string
-
-		NewProtocol string
-
-		NewInternalPort string
-
-		NewInternalClient string
-
-		NewEnabled string
-
-		NewPortMappingDescription string
-
-		NewLeaseDuration string
-	}{}
+	response := &WANIPConnection1GetGenericPortMappingEntryResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_1, "GetGenericPortMappingEntry", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetGenericPortMappingEntry", request, response); err != nil {
 		return
 	}
 
@@ -2771,9 +4457,7 @@ func (client *WANIPConnection1) GetGenericPortMappingEntry(NewPortMappingIndex u
 	if NewExternalPort, err = soap.UnmarshalUi2(response.NewExternalPort); err != nil {
 		return
 	}
-	if NewProtocol, err = soap.UnmarshalString(response.NewProtocol); err != nil {
-		return
-	}
+	NewProtocol = WANIPConnection1PortMappingProtocol(response.NewProtocol)
 	if NewInternalPort, err = soap.UnmarshalUi2(response.NewInternalPort); err != nil {
 		return
 	}
@@ -2793,20 +4477,49 @@ func (client *WANIPConnection1) GetGenericPortMappingEntry(NewPortMappingIndex u
 	return
 }
 
+// WANIPConnection1GetSpecificPortMappingEntryRequest is the request of GetSpecificPortMappingEntry, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANIPConnection1GetSpecificPortMappingEntryRequest struct {
+	NewRemoteHost   string
+	NewExternalPort string
+	NewProtocol     string
+}
+
+// WANIPConnection1GetSpecificPortMappingEntryResponse is the response of GetSpecificPortMappingEntry, with each
+// argument in its SOAP string form.
+type WANIPConnection1GetSpecificPortMappingEntryResponse struct {
+	NewInternalPort           string
+	NewInternalClient         string
+	NewEnabled                string
+	NewPortMappingDescription string
+	NewLeaseDuration          string
+}
+
+// GetSpecificPortMappingEntry performs the GetSpecificPortMappingEntry action of the service.
+//
+// Returns the internal client and settings of the port mapping with the
+// given NewRemoteHost, NewExternalPort and NewProtocol, failing with error
+// 714 (NoSuchEntryInArray) if there is none.
 //
 // Arguments:
+//   - NewRemoteHost (in, state variable A_ARG_TYPE_GetSpecificPortMappingEntry_NewRemoteHost)
+//   - NewExternalPort (in, state variable A_ARG_TYPE_GetSpecificPortMappingEntry_NewExternalPort)
+//   - NewProtocol (in, state variable PortMappingProtocol): allowed values TCP, UDP
 //
-// * NewProtocol: allowed values: TCP, UDP
+// Return values:
+//   - NewInternalPort (out, state variable A_ARG_TYPE_GetSpecificPortMappingEntry_NewInternalPort)
+//   - NewInternalClient (out, state variable A_ARG_TYPE_GetSpecificPortMappingEntry_NewInternalClient)
+//   - NewEnabled (out, state variable A_ARG_TYPE_GetSpecificPortMappingEntry_NewEnabled)
+//   - NewPortMappingDescription (out, state variable A_ARG_TYPE_GetSpecificPortMappingEntry_NewPortMappingDescription)
+//   - NewLeaseDuration (out, state variable A_ARG_TYPE_GetSpecificPortMappingEntry_NewLeaseDuration)
+func (client *WANIPConnection1) GetSpecificPortMappingEntry(NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection1PortMappingProtocol) (NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32, err error) {
+	return client.GetSpecificPortMappingEntryCtx(context.Background(), NewRemoteHost, NewExternalPort, NewProtocol)
+}
 
-func (client *WANIPConnection1) GetSpecificPortMappingEntry(NewRemoteHost string, NewExternalPort uint16, NewProtocol string) (NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32, err error) {
+// GetSpecificPortMappingEntryCtx is GetSpecificPortMappingEntry with a context, to cancel or time out the call.
+func (client *WANIPConnection1) GetSpecificPortMappingEntryCtx(ctx context.Context, NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection1PortMappingProtocol) (NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32, err error) {
 	// Request structure.
-	request := &struct {
-		NewRemoteHost string
-
-		NewExternalPort string
-
-		NewProtocol string
-	}{}
+	request := &WANIPConnection1GetSpecificPortMappingEntryRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewRemoteHost, err = soap.MarshalString(NewRemoteHost); err != nil {
@@ -2815,26 +4528,16 @@ func (client *WANIPConnection1) GetSpecificPortMappingEntry(NewRemoteHost string
 	if request.NewExternalPort, err = soap.MarshalUi2(NewExternalPort); err != nil {
 		return
 	}
-	if request.NewProtocol, err = soap.MarshalString(NewProtocol); err != nil {
+	if request.NewProtocol, err = soap.MarshalString(string(NewProtocol)); err != nil {
 		return
 	}
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewInternalPort string
-
-		NewInternalClient string
-
-		NewEnabled string
-
-		NewPortMappingDescription string
-
-		NewLeaseDuration string
-	}{}
+	response := &WANIPConnection1GetSpecificPortMappingEntryResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_1, "GetSpecificPortMappingEntry", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetSpecificPortMappingEntry", request, response); err != nil {
 		return
 	}
 
@@ -2859,30 +4562,48 @@ func (client *WANIPConnection1) GetSpecificPortMappingEntry(NewRemoteHost string
 	return
 }
 
+// WANIPConnection1AddPortMappingRequest is the request of AddPortMapping, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANIPConnection1AddPortMappingRequest struct {
+	NewRemoteHost             string
+	NewExternalPort           string
+	NewProtocol               string
+	NewInternalPort           string
+	NewInternalClient         string
+	NewEnabled                string
+	NewPortMappingDescription string
+	NewLeaseDuration          string
+}
+
+// AddPortMapping performs the AddPortMapping action of the service.
 //
-// Arguments:
+// Creates a port mapping, forwarding NewExternalPort of NewProtocol on the
+// WAN side to NewInternalPort of NewInternalClient on the LAN. An existing
+// mapping with the same NewRemoteHost, NewExternalPort and NewProtocol is
+// replaced if it is for the same internal client, otherwise the action fails
+// with error 718 (ConflictInMappingEntry).
 //
-// * NewProtocol: allowed values: TCP, UDP
+// An empty NewRemoteHost matches any remote host. A NewLeaseDuration of 0
+// makes the mapping permanent; gateways that only support permanent mappings
+// fail other durations with error 725 (OnlyPermanentLeasesSupported).
+//
+// Arguments:
+//   - NewRemoteHost (in, state variable A_ARG_TYPE_AddPortMapping_NewRemoteHost)
+//   - NewExternalPort (in, state variable A_ARG_TYPE_AddPortMapping_NewExternalPort)
+//   - NewProtocol (in, state variable PortMappingProtocol): allowed values TCP, UDP
+//   - NewInternalPort (in, state variable A_ARG_TYPE_AddPortMapping_NewInternalPort)
+//   - NewInternalClient (in, state variable A_ARG_TYPE_AddPortMapping_NewInternalClient)
+//   - NewEnabled (in, state variable A_ARG_TYPE_AddPortMapping_NewEnabled)
+//   - NewPortMappingDescription (in, state variable A_ARG_TYPE_AddPortMapping_NewPortMappingDescription)
+//   - NewLeaseDuration (in, state variable A_ARG_TYPE_AddPortMapping_NewLeaseDuration)
+func (client *WANIPConnection1) AddPortMapping(NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection1PortMappingProtocol, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32) (err error) {
+	return client.AddPortMappingCtx(context.Background(), NewRemoteHost, NewExternalPort, NewProtocol, NewInternalPort, NewInternalClient, NewEnabled, NewPortMappingDescription, NewLeaseDuration)
+}
 
-func (client *WANIPConnection1) AddPortMapping(NewRemoteHost string, NewExternalPort uint16, NewProtocol string, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32) (err error) {
+// AddPortMappingCtx is AddPortMapping with a context, to cancel or time out the call.
+func (client *WANIPConnection1) AddPortMappingCtx(ctx context.Context, NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection1PortMappingProtocol, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32) (err error) {
 	// Request structure.
-	request := &struct {
-		NewRemoteHost string
-
-		NewExternalPort string
-
-		NewProtocol string
-
-		NewInternalPort string
-
-		NewInternalClient string
-
-		NewEnabled string
-
-		NewPortMappingDescription string
-
-		NewLeaseDuration string
-	}{}
+	request := &WANIPConnection1AddPortMappingRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewRemoteHost, err = soap.MarshalString(NewRemoteHost); err != nil {
@@ -2891,7 +4612,7 @@ func (client *WANIPConnection1) AddPortMapping(NewRemoteHost string, NewExternal
 	if request.NewExternalPort, err = soap.MarshalUi2(NewExternalPort); err != nil {
 		return
 	}
-	if request.NewProtocol, err = soap.MarshalString(NewProtocol); err != nil {
+	if request.NewProtocol, err = soap.MarshalString(string(NewProtocol)); err != nil {
 		return
 	}
 	if request.NewInternalPort, err = soap.MarshalUi2(NewInternalPort); err != nil {
@@ -2915,7 +4636,7 @@ func (client *WANIPConnection1) AddPortMapping(NewRemoteHost string, NewExternal
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_1, "AddPortMapping", request, response); err != nil {
+	if err = client.PerformAction(ctx, "AddPortMapping", request, response); err != nil {
 		return
 	}
 
@@ -2925,20 +4646,31 @@ func (client *WANIPConnection1) AddPortMapping(NewRemoteHost string, NewExternal
 	return
 }
 
+// WANIPConnection1DeletePortMappingRequest is the request of DeletePortMapping, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANIPConnection1DeletePortMappingRequest struct {
+	NewRemoteHost   string
+	NewExternalPort string
+	NewProtocol     string
+}
+
+// DeletePortMapping performs the DeletePortMapping action of the service.
 //
-// Arguments:
+// Deletes the port mapping with the given NewRemoteHost, NewExternalPort and
+// NewProtocol, failing with error 714 (NoSuchEntryInArray) if there is none.
 //
-// * NewProtocol: allowed values: TCP, UDP
+// Arguments:
+//   - NewRemoteHost (in, state variable A_ARG_TYPE_DeletePortMapping_NewRemoteHost)
+//   - NewExternalPort (in, state variable A_ARG_TYPE_DeletePortMapping_NewExternalPort)
+//   - NewProtocol (in, state variable PortMappingProtocol): allowed values TCP, UDP
+func (client *WANIPConnection1) DeletePortMapping(NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection1PortMappingProtocol) (err error) {
+	return client.DeletePortMappingCtx(context.Background(), NewRemoteHost, NewExternalPort, NewProtocol)
+}
 
-func (client *WANIPConnection1) DeletePortMapping(NewRemoteHost string, NewExternalPort uint16, NewProtocol string) (err error) {
+// DeletePortMappingCtx is DeletePortMapping with a context, to cancel or time out the call.
+func (client *WANIPConnection1) DeletePortMappingCtx(ctx context.Context, NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection1PortMappingProtocol) (err error) {
 	// Request structure.
-	request := &struct {
-		NewRemoteHost string
-
-		NewExternalPort string
-
-		NewProtocol string
-	}{}
+	request := &WANIPConnection1DeletePortMappingRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewRemoteHost, err = soap.MarshalString(NewRemoteHost); err != nil {
@@ -2947,7 +4679,7 @@ func (client *WANIPConnection1) DeletePortMapping(NewRemoteHost string, NewExter
 	if request.NewExternalPort, err = soap.MarshalUi2(NewExternalPort); err != nil {
 		return
 	}
-	if request.NewProtocol, err = soap.MarshalString(NewProtocol); err != nil {
+	if request.NewProtocol, err = soap.MarshalString(string(NewProtocol)); err != nil {
 		return
 	}
 	// END Marshal arguments into request.
@@ -2956,7 +4688,7 @@ func (client *WANIPConnection1) DeletePortMapping(NewRemoteHost string, NewExter
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_1, "DeletePortMapping", request, response); err != nil {
+	if err = client.PerformAction(ctx, "DeletePortMapping", request, response); err != nil {
 		return
 	}
 
@@ -2966,7 +4698,25 @@ func (client *WANIPConnection1) DeletePortMapping(NewRemoteHost string, NewExter
 	return
 }
 
+// WANIPConnection1GetExternalIPAddressResponse is the response of GetExternalIPAddress, with each
+// argument in its SOAP string form.
+type WANIPConnection1GetExternalIPAddressResponse struct {
+	NewExternalIPAddress string
+}
+
+// GetExternalIPAddress performs the GetExternalIPAddress action of the service.
+//
+// Returns the external IP address of the connection, which is the address of
+// the gateway on the WAN side.
+//
+// Return values:
+//   - NewExternalIPAddress (out, state variable A_ARG_TYPE_GetExternalIPAddress_NewExternalIPAddress)
 func (client *WANIPConnection1) GetExternalIPAddress() (NewExternalIPAddress string, err error) {
+	return client.GetExternalIPAddressCtx(context.Background())
+}
+
+// GetExternalIPAddressCtx is GetExternalIPAddress with a context, to cancel or time out the call.
+func (client *WANIPConnection1) GetExternalIPAddressCtx(ctx context.Context) (NewExternalIPAddress string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -2974,12 +4724,10 @@ func (client *WANIPConnection1) GetExternalIPAddress() (NewExternalIPAddress str
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewExternalIPAddress string
-	}{}
+	response := &WANIPConnection1GetExternalIPAddressResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_1, "GetExternalIPAddress", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetExternalIPAddress", request, response); err != nil {
 		return
 	}
 
@@ -2999,6 +4747,139 @@ type WANIPConnection2 struct {
 	goupnp.ServiceClient
 }
 
+// WANIPConnection2Client is the interface of the actions of WANIPConnection2, for
+// substituting fakes or mocks for the service in tests.
+type WANIPConnection2Client interface {
+	SetConnectionType(NewConnectionType string) (err error)
+	SetConnectionTypeCtx(ctx context.Context, NewConnectionType string) (err error)
+	GetConnectionTypeInfo() (NewConnectionType string, NewPossibleConnectionTypes string, err error)
+	GetConnectionTypeInfoCtx(ctx context.Context) (NewConnectionType string, NewPossibleConnectionTypes string, err error)
+	RequestConnection() (err error)
+	RequestConnectionCtx(ctx context.Context) (err error)
+	RequestTermination() (err error)
+	RequestTerminationCtx(ctx context.Context) (err error)
+	ForceTermination() (err error)
+	ForceTerminationCtx(ctx context.Context) (err error)
+	SetAutoDisconnectTime(NewAutoDisconnectTime uint32) (err error)
+	SetAutoDisconnectTimeCtx(ctx context.Context, NewAutoDisconnectTime uint32) (err error)
+	SetIdleDisconnectTime(NewIdleDisconnectTime uint32) (err error)
+	SetIdleDisconnectTimeCtx(ctx context.Context, NewIdleDisconnectTime uint32) (err error)
+	SetWarnDisconnectDelay(NewWarnDisconnectDelay uint32) (err error)
+	SetWarnDisconnectDelayCtx(ctx context.Context, NewWarnDisconnectDelay uint32) (err error)
+	GetStatusInfo() (NewConnectionStatus WANIPConnection2ConnectionStatus, NewLastConnectionError WANIPConnection2LastConnectionError, NewUptime uint32, err error)
+	GetStatusInfoCtx(ctx context.Context) (NewConnectionStatus WANIPConnection2ConnectionStatus, NewLastConnectionError WANIPConnection2LastConnectionError, NewUptime uint32, err error)
+	GetAutoDisconnectTime() (NewAutoDisconnectTime uint32, err error)
+	GetAutoDisconnectTimeCtx(ctx context.Context) (NewAutoDisconnectTime uint32, err error)
+	GetIdleDisconnectTime() (NewIdleDisconnectTime uint32, err error)
+	GetIdleDisconnectTimeCtx(ctx context.Context) (NewIdleDisconnectTime uint32, err error)
+	GetWarnDisconnectDelay() (NewWarnDisconnectDelay uint32, err error)
+	GetWarnDisconnectDelayCtx(ctx context.Context) (NewWarnDisconnectDelay uint32, err error)
+	GetNATRSIPStatus() (NewRSIPAvailable bool, NewNATEnabled bool, err error)
+	GetNATRSIPStatusCtx(ctx context.Context) (NewRSIPAvailable bool, NewNATEnabled bool, err error)
+	GetGenericPortMappingEntry(NewPortMappingIndex uint16) (NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection2PortMappingProtocol, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32, err error)
+	GetGenericPortMappingEntryCtx(ctx context.Context, NewPortMappingIndex uint16) (NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection2PortMappingProtocol, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32, err error)
+	GetSpecificPortMappingEntry(NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection2PortMappingProtocol) (NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32, err error)
+	GetSpecificPortMappingEntryCtx(ctx context.Context, NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection2PortMappingProtocol) (NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32, err error)
+	AddPortMapping(NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection2PortMappingProtocol, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32) (err error)
+	AddPortMappingCtx(ctx context.Context, NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection2PortMappingProtocol, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32) (err error)
+	DeletePortMapping(NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection2PortMappingProtocol) (err error)
+	DeletePortMappingCtx(ctx context.Context, NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection2PortMappingProtocol) (err error)
+	DeletePortMappingRange(NewStartPort uint16, NewEndPort uint16, NewProtocol WANIPConnection2PortMappingProtocol, NewManage bool) (err error)
+	DeletePortMappingRangeCtx(ctx context.Context, NewStartPort uint16, NewEndPort uint16, NewProtocol WANIPConnection2PortMappingProtocol, NewManage bool) (err error)
+	GetExternalIPAddress() (NewExternalIPAddress string, err error)
+	GetExternalIPAddressCtx(ctx context.Context) (NewExternalIPAddress string, err error)
+	GetListOfPortMappings(NewStartPort uint16, NewEndPort uint16, NewProtocol WANIPConnection2PortMappingProtocol, NewManage bool, NewNumberOfPorts uint16) (NewPortListing string, err error)
+	GetListOfPortMappingsCtx(ctx context.Context, NewStartPort uint16, NewEndPort uint16, NewProtocol WANIPConnection2PortMappingProtocol, NewManage bool, NewNumberOfPorts uint16) (NewPortListing string, err error)
+	AddAnyPortMapping(NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection2PortMappingProtocol, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32) (NewReservedPort uint16, err error)
+	AddAnyPortMappingCtx(ctx context.Context, NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection2PortMappingProtocol, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32) (NewReservedPort uint16, err error)
+}
+
+var _ WANIPConnection2Client = new(WANIPConnection2)
+
+// WANIPConnection2ConnectionStatus is a value of the state variable ConnectionStatus of
+// WANIPConnection2.
+type WANIPConnection2ConnectionStatus string
+
+// Allowed values of WANIPConnection2ConnectionStatus.
+const (
+	WANIPConnection2ConnectionStatus_Unconfigured      WANIPConnection2ConnectionStatus = "Unconfigured"
+	WANIPConnection2ConnectionStatus_Connecting        WANIPConnection2ConnectionStatus = "Connecting"
+	WANIPConnection2ConnectionStatus_Connected         WANIPConnection2ConnectionStatus = "Connected"
+	WANIPConnection2ConnectionStatus_PendingDisconnect WANIPConnection2ConnectionStatus = "PendingDisconnect"
+	WANIPConnection2ConnectionStatus_Disconnecting     WANIPConnection2ConnectionStatus = "Disconnecting"
+	WANIPConnection2ConnectionStatus_Disconnected      WANIPConnection2ConnectionStatus = "Disconnected"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v WANIPConnection2ConnectionStatus) Valid() bool {
+	switch v {
+	case WANIPConnection2ConnectionStatus_Unconfigured,
+		WANIPConnection2ConnectionStatus_Connecting,
+		WANIPConnection2ConnectionStatus_Connected,
+		WANIPConnection2ConnectionStatus_PendingDisconnect,
+		WANIPConnection2ConnectionStatus_Disconnecting,
+		WANIPConnection2ConnectionStatus_Disconnected:
+		return true
+	}
+	return false
+}
+
+// WANIPConnection2LastConnectionError is a value of the state variable LastConnectionError of
+// WANIPConnection2.
+type WANIPConnection2LastConnectionError string
+
+// Allowed values of WANIPConnection2LastConnectionError.
+const (
+	WANIPConnection2LastConnectionError_ERROR_NONE                     WANIPConnection2LastConnectionError = "ERROR_NONE"
+	WANIPConnection2LastConnectionError_ERROR_COMMAND_ABORTED          WANIPConnection2LastConnectionError = "ERROR_COMMAND_ABORTED"
+	WANIPConnection2LastConnectionError_ERROR_NOT_ENABLED_FOR_INTERNET WANIPConnection2LastConnectionError = "ERROR_NOT_ENABLED_FOR_INTERNET"
+	WANIPConnection2LastConnectionError_ERROR_USER_DISCONNECT          WANIPConnection2LastConnectionError = "ERROR_USER_DISCONNECT"
+	WANIPConnection2LastConnectionError_ERROR_ISP_DISCONNECT           WANIPConnection2LastConnectionError = "ERROR_ISP_DISCONNECT"
+	WANIPConnection2LastConnectionError_ERROR_IDLE_DISCONNECT          WANIPConnection2LastConnectionError = "ERROR_IDLE_DISCONNECT"
+	WANIPConnection2LastConnectionError_ERROR_FORCED_DISCONNECT        WANIPConnection2LastConnectionError = "ERROR_FORCED_DISCONNECT"
+	WANIPConnection2LastConnectionError_ERROR_NO_CARRIER               WANIPConnection2LastConnectionError = "ERROR_NO_CARRIER"
+	WANIPConnection2LastConnectionError_ERROR_IP_CONFIGURATION         WANIPConnection2LastConnectionError = "ERROR_IP_CONFIGURATION"
+	WANIPConnection2LastConnectionError_ERROR_UNKNOWN                  WANIPConnection2LastConnectionError = "ERROR_UNKNOWN"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v WANIPConnection2LastConnectionError) Valid() bool {
+	switch v {
+	case WANIPConnection2LastConnectionError_ERROR_NONE,
+		WANIPConnection2LastConnectionError_ERROR_COMMAND_ABORTED,
+		WANIPConnection2LastConnectionError_ERROR_NOT_ENABLED_FOR_INTERNET,
+		WANIPConnection2LastConnectionError_ERROR_USER_DISCONNECT,
+		WANIPConnection2LastConnectionError_ERROR_ISP_DISCONNECT,
+		WANIPConnection2LastConnectionError_ERROR_IDLE_DISCONNECT,
+		WANIPConnection2LastConnectionError_ERROR_FORCED_DISCONNECT,
+		WANIPConnection2LastConnectionError_ERROR_NO_CARRIER,
+		WANIPConnection2LastConnectionError_ERROR_IP_CONFIGURATION,
+		WANIPConnection2LastConnectionError_ERROR_UNKNOWN:
+		return true
+	}
+	return false
+}
+
+// WANIPConnection2PortMappingProtocol is a value of the state variable PortMappingProtocol of
+// WANIPConnection2.
+type WANIPConnection2PortMappingProtocol string
+
+// Allowed values of WANIPConnection2PortMappingProtocol.
+const (
+	WANIPConnection2PortMappingProtocol_TCP WANIPConnection2PortMappingProtocol = "TCP"
+	WANIPConnection2PortMappingProtocol_UDP WANIPConnection2PortMappingProtocol = "UDP"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v WANIPConnection2PortMappingProtocol) Valid() bool {
+	switch v {
+	case WANIPConnection2PortMappingProtocol_TCP,
+		WANIPConnection2PortMappingProtocol_UDP:
+		return true
+	}
+	return false
+}
+
 // NewWANIPConnection2Clients discovers instances of the service on the network,
 // and returns clients to any that are found. errors will contain an error for
 // any devices that replied but which could not be queried, and err will be set
@@ -3052,11 +4933,42 @@ func newWANIPConnection2ClientsFromGenericClients(genericClients []goupnp.Servic
 	return clients
 }
 
+// WANIPConnection2Timeouts are the default timeouts of the actions of
+// WANIPConnection2, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var WANIPConnection2Timeouts = map[string]time.Duration{
+	"": 10 * time.Second,
+}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *WANIPConnection2) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, WANIPConnection2Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_WANIPConnection_2, actionName, request, response)
+}
+
+// WANIPConnection2SetConnectionTypeRequest is the request of SetConnectionType, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANIPConnection2SetConnectionTypeRequest struct {
+	NewConnectionType string
+}
+
+// SetConnectionType performs the SetConnectionType action of the service.
+//
+// Arguments:
+//   - NewConnectionType (in, state variable A_ARG_TYPE_SetConnectionType_NewConnectionType)
 func (client *WANIPConnection2) SetConnectionType(NewConnectionType string) (err error) {
+	return client.SetConnectionTypeCtx(context.Background(), NewConnectionType)
+}
+
+// SetConnectionTypeCtx is SetConnectionType with a context, to cancel or time out the call.
+func (client *WANIPConnection2) SetConnectionTypeCtx(ctx context.Context, NewConnectionType string) (err error) {
 	// Request structure.
-	request := &struct {
-		NewConnectionType string
-	}{}
+	request := &WANIPConnection2SetConnectionTypeRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewConnectionType, err = soap.MarshalString(NewConnectionType); err != nil {
@@ -3068,7 +4980,7 @@ func (client *WANIPConnection2) SetConnectionType(NewConnectionType string) (err
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_2, "SetConnectionType", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetConnectionType", request, response); err != nil {
 		return
 	}
 
@@ -3078,7 +4990,24 @@ func (client *WANIPConnection2) SetConnectionType(NewConnectionType string) (err
 	return
 }
 
+// WANIPConnection2GetConnectionTypeInfoResponse is the response of GetConnectionTypeInfo, with each
+// argument in its SOAP string form.
+type WANIPConnection2GetConnectionTypeInfoResponse struct {
+	NewConnectionType          string
+	NewPossibleConnectionTypes string
+}
+
+// GetConnectionTypeInfo performs the GetConnectionTypeInfo action of the service.
+//
+// Return values:
+//   - NewConnectionType (out, state variable A_ARG_TYPE_GetConnectionTypeInfo_NewConnectionType)
+//   - NewPossibleConnectionTypes (out, state variable A_ARG_TYPE_GetConnectionTypeInfo_NewPossibleConnectionTypes)
 func (client *WANIPConnection2) GetConnectionTypeInfo() (NewConnectionType string, NewPossibleConnectionTypes string, err error) {
+	return client.GetConnectionTypeInfoCtx(context.Background())
+}
+
+// GetConnectionTypeInfoCtx is GetConnectionTypeInfo with a context, to cancel or time out the call.
+func (client *WANIPConnection2) GetConnectionTypeInfoCtx(ctx context.Context) (NewConnectionType string, NewPossibleConnectionTypes string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -3086,14 +5015,10 @@ func (client *WANIPConnection2) GetConnectionTypeInfo() (NewConnectionType strin
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewConnectionType string
-
-		NewPossibleConnectionTypes string
-	}{}
+	response := &WANIPConnection2GetConnectionTypeInfoResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_2, "GetConnectionTypeInfo", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetConnectionTypeInfo", request, response); err != nil {
 		return
 	}
 
@@ -3109,7 +5034,13 @@ func (client *WANIPConnection2) GetConnectionTypeInfo() (NewConnectionType strin
 	return
 }
 
+// RequestConnection performs the RequestConnection action of the service.
 func (client *WANIPConnection2) RequestConnection() (err error) {
+	return client.RequestConnectionCtx(context.Background())
+}
+
+// RequestConnectionCtx is RequestConnection with a context, to cancel or time out the call.
+func (client *WANIPConnection2) RequestConnectionCtx(ctx context.Context) (err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -3120,7 +5051,7 @@ func (client *WANIPConnection2) RequestConnection() (err error) {
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_2, "RequestConnection", request, response); err != nil {
+	if err = client.PerformAction(ctx, "RequestConnection", request, response); err != nil {
 		return
 	}
 
@@ -3130,7 +5061,13 @@ func (client *WANIPConnection2) RequestConnection() (err error) {
 	return
 }
 
+// RequestTermination performs the RequestTermination action of the service.
 func (client *WANIPConnection2) RequestTermination() (err error) {
+	return client.RequestTerminationCtx(context.Background())
+}
+
+// RequestTerminationCtx is RequestTermination with a context, to cancel or time out the call.
+func (client *WANIPConnection2) RequestTerminationCtx(ctx context.Context) (err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -3141,7 +5078,7 @@ func (client *WANIPConnection2) RequestTermination() (err error) {
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_2, "RequestTermination", request, response); err != nil {
+	if err = client.PerformAction(ctx, "RequestTermination", request, response); err != nil {
 		return
 	}
 
@@ -3151,7 +5088,13 @@ func (client *WANIPConnection2) RequestTermination() (err error) {
 	return
 }
 
+// ForceTermination performs the ForceTermination action of the service.
 func (client *WANIPConnection2) ForceTermination() (err error) {
+	return client.ForceTerminationCtx(context.Background())
+}
+
+// ForceTerminationCtx is ForceTermination with a context, to cancel or time out the call.
+func (client *WANIPConnection2) ForceTerminationCtx(ctx context.Context) (err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -3162,7 +5105,7 @@ func (client *WANIPConnection2) ForceTermination() (err error) {
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_2, "ForceTermination", request, response); err != nil {
+	if err = client.PerformAction(ctx, "ForceTermination", request, response); err != nil {
 		return
 	}
 
@@ -3172,11 +5115,24 @@ func (client *WANIPConnection2) ForceTermination() (err error) {
 	return
 }
 
+// WANIPConnection2SetAutoDisconnectTimeRequest is the request of SetAutoDisconnectTime, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANIPConnection2SetAutoDisconnectTimeRequest struct {
+	NewAutoDisconnectTime string
+}
+
+// SetAutoDisconnectTime performs the SetAutoDisconnectTime action of the service.
+//
+// Arguments:
+//   - NewAutoDisconnectTime (in, state variable A_ARG_TYPE_SetAutoDisconnectTime_NewAutoDisconnectTime)
 func (client *WANIPConnection2) SetAutoDisconnectTime(NewAutoDisconnectTime uint32) (err error) {
+	return client.SetAutoDisconnectTimeCtx(context.Background(), NewAutoDisconnectTime)
+}
+
+// SetAutoDisconnectTimeCtx is SetAutoDisconnectTime with a context, to cancel or time out the call.
+func (client *WANIPConnection2) SetAutoDisconnectTimeCtx(ctx context.Context, NewAutoDisconnectTime uint32) (err error) {
 	// Request structure.
-	request := &struct {
-		NewAutoDisconnectTime string
-	}{}
+	request := &WANIPConnection2SetAutoDisconnectTimeRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewAutoDisconnectTime, err = soap.MarshalUi4(NewAutoDisconnectTime); err != nil {
@@ -3188,7 +5144,7 @@ func (client *WANIPConnection2) SetAutoDisconnectTime(NewAutoDisconnectTime uint
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_2, "SetAutoDisconnectTime", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetAutoDisconnectTime", request, response); err != nil {
 		return
 	}
 
@@ -3198,11 +5154,24 @@ func (client *WANIPConnection2) SetAutoDisconnectTime(NewAutoDisconnectTime uint
 	return
 }
 
+// WANIPConnection2SetIdleDisconnectTimeRequest is the request of SetIdleDisconnectTime, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANIPConnection2SetIdleDisconnectTimeRequest struct {
+	NewIdleDisconnectTime string
+}
+
+// SetIdleDisconnectTime performs the SetIdleDisconnectTime action of the service.
+//
+// Arguments:
+//   - NewIdleDisconnectTime (in, state variable A_ARG_TYPE_SetIdleDisconnectTime_NewIdleDisconnectTime)
 func (client *WANIPConnection2) SetIdleDisconnectTime(NewIdleDisconnectTime uint32) (err error) {
+	return client.SetIdleDisconnectTimeCtx(context.Background(), NewIdleDisconnectTime)
+}
+
+// SetIdleDisconnectTimeCtx is SetIdleDisconnectTime with a context, to cancel or time out the call.
+func (client *WANIPConnection2) SetIdleDisconnectTimeCtx(ctx context.Context, NewIdleDisconnectTime uint32) (err error) {
 	// Request structure.
-	request := &struct {
-		NewIdleDisconnectTime string
-	}{}
+	request := &WANIPConnection2SetIdleDisconnectTimeRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewIdleDisconnectTime, err = soap.MarshalUi4(NewIdleDisconnectTime); err != nil {
@@ -3214,7 +5183,7 @@ func (client *WANIPConnection2) SetIdleDisconnectTime(NewIdleDisconnectTime uint
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_2, "SetIdleDisconnectTime", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetIdleDisconnectTime", request, response); err != nil {
 		return
 	}
 
@@ -3224,11 +5193,24 @@ func (client *WANIPConnection2) SetIdleDisconnectTime(NewIdleDisconnectTime uint
 	return
 }
 
+// WANIPConnection2SetWarnDisconnectDelayRequest is the request of SetWarnDisconnectDelay, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANIPConnection2SetWarnDisconnectDelayRequest struct {
+	NewWarnDisconnectDelay string
+}
+
+// SetWarnDisconnectDelay performs the SetWarnDisconnectDelay action of the service.
+//
+// Arguments:
+//   - NewWarnDisconnectDelay (in, state variable A_ARG_TYPE_SetWarnDisconnectDelay_NewWarnDisconnectDelay)
 func (client *WANIPConnection2) SetWarnDisconnectDelay(NewWarnDisconnectDelay uint32) (err error) {
+	return client.SetWarnDisconnectDelayCtx(context.Background(), NewWarnDisconnectDelay)
+}
+
+// SetWarnDisconnectDelayCtx is SetWarnDisconnectDelay with a context, to cancel or time out the call.
+func (client *WANIPConnection2) SetWarnDisconnectDelayCtx(ctx context.Context, NewWarnDisconnectDelay uint32) (err error) {
 	// Request structure.
-	request := &struct {
-		NewWarnDisconnectDelay string
-	}{}
+	request := &WANIPConnection2SetWarnDisconnectDelayRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewWarnDisconnectDelay, err = soap.MarshalUi4(NewWarnDisconnectDelay); err != nil {
@@ -3240,7 +5222,7 @@ func (client *WANIPConnection2) SetWarnDisconnectDelay(NewWarnDisconnectDelay ui
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_2, "SetWarnDisconnectDelay", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetWarnDisconnectDelay", request, response); err != nil {
 		return
 	}
 
@@ -3250,13 +5232,26 @@ func (client *WANIPConnection2) SetWarnDisconnectDelay(NewWarnDisconnectDelay ui
 	return
 }
 
+// WANIPConnection2GetStatusInfoResponse is the response of GetStatusInfo, with each
+// argument in its SOAP string form.
+type WANIPConnection2GetStatusInfoResponse struct {
+	NewConnectionStatus    string
+	NewLastConnectionError string
+	NewUptime              string
+}
+
+// GetStatusInfo performs the GetStatusInfo action of the service.
 //
 // Return values:
-//
-// * NewConnectionStatus: allowed values: Unconfigured, Connecting, Connected, PendingDisconnect, Disconnecting, Disconnected
-//
-// * NewLastConnectionError: allowed values: ERROR_NONE, ERROR_COMMAND_ABORTED, ERROR_NOT_ENABLED_FOR_INTERNET, ERROR_USER_DISCONNECT, ERROR_ISP_DISCONNECT, ERROR_IDLE_DISCONNECT, ERROR_FORCED_DISCONNECT, ERROR_NO_CARRIER, ERROR_IP_CONFIGURATION, ERROR_UNKNOWN
-func (client *WANIPConnection2) GetStatusInfo() (NewConnectionStatus string, NewLastConnectionError string, NewUptime uint32, err error) {
+//   - NewConnectionStatus (out, state variable ConnectionStatus): allowed values Unconfigured, Connecting, Connected, PendingDisconnect, Disconnecting, Disconnected
+//   - NewLastConnectionError (out, state variable LastConnectionError): allowed values ERROR_NONE, ERROR_COMMAND_ABORTED, ERROR_NOT_ENABLED_FOR_INTERNET, ERROR_USER_DISCONNECT, ERROR_ISP_DISCONNECT, ERROR_IDLE_DISCONNECT, ERROR_FORCED_DISCONNECT, ERROR_NO_CARRIER, ERROR_IP_CONFIGURATION, ERROR_UNKNOWN
+//   - NewUptime (out, state variable A_ARG_TYPE_GetStatusInfo_NewUptime)
+func (client *WANIPConnection2) GetStatusInfo() (NewConnectionStatus WANIPConnection2ConnectionStatus, NewLastConnectionError WANIPConnection2LastConnectionError, NewUptime uint32, err error) {
+	return client.GetStatusInfoCtx(context.Background())
+}
+
+// GetStatusInfoCtx is GetStatusInfo with a context, to cancel or time out the call.
+func (client *WANIPConnection2) GetStatusInfoCtx(ctx context.Context) (NewConnectionStatus WANIPConnection2ConnectionStatus, NewLastConnectionError WANIPConnection2LastConnectionError, NewUptime uint32, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -3264,27 +5259,17 @@ func (client *WANIPConnection2) GetStatusInfo() (NewConnectionStatus string, New
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewConnectionStatus string
-
-		NewLastConnectionError string
-
-		NewUptime string
-	}{}
+	response := &WANIPConnection2GetStatusInfoResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_2, "GetStatusInfo", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetStatusInfo", request, response); err != nil {
 		return
 	}
 
 	// BEGIN Unmarshal arguments from response.
 
-	if NewConnectionStatus, err = soap.UnmarshalString(response.NewConnectionStatus); err != nil {
-		return
-	}
-	if NewLastConnectionError, err = soap.UnmarshalString(response.NewLastConnectionError); err != nil {
-		return
-	}
+	NewConnectionStatus = WANIPConnection2ConnectionStatus(response.NewConnectionStatus)
+	NewLastConnectionError = WANIPConnection2LastConnectionError(response.NewLastConnectionError)
 	if NewUptime, err = soap.UnmarshalUi4(response.NewUptime); err != nil {
 		return
 	}
@@ -3292,7 +5277,22 @@ func (client *WANIPConnection2) GetStatusInfo() (NewConnectionStatus string, New
 	return
 }
 
+// WANIPConnection2GetAutoDisconnectTimeResponse is the response of GetAutoDisconnectTime, with each
+// argument in its SOAP string form.
+type WANIPConnection2GetAutoDisconnectTimeResponse struct {
+	NewAutoDisconnectTime string
+}
+
+// GetAutoDisconnectTime performs the GetAutoDisconnectTime action of the service.
+//
+// Return values:
+//   - NewAutoDisconnectTime (out, state variable A_ARG_TYPE_GetAutoDisconnectTime_NewAutoDisconnectTime)
 func (client *WANIPConnection2) GetAutoDisconnectTime() (NewAutoDisconnectTime uint32, err error) {
+	return client.GetAutoDisconnectTimeCtx(context.Background())
+}
+
+// GetAutoDisconnectTimeCtx is GetAutoDisconnectTime with a context, to cancel or time out the call.
+func (client *WANIPConnection2) GetAutoDisconnectTimeCtx(ctx context.Context) (NewAutoDisconnectTime uint32, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -3300,12 +5300,10 @@ func (client *WANIPConnection2) GetAutoDisconnectTime() (NewAutoDisconnectTime u
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewAutoDisconnectTime string
-	}{}
+	response := &WANIPConnection2GetAutoDisconnectTimeResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_2, "GetAutoDisconnectTime", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetAutoDisconnectTime", request, response); err != nil {
 		return
 	}
 
@@ -3318,7 +5316,22 @@ func (client *WANIPConnection2) GetAutoDisconnectTime() (NewAutoDisconnectTime u
 	return
 }
 
+// WANIPConnection2GetIdleDisconnectTimeResponse is the response of GetIdleDisconnectTime, with each
+// argument in its SOAP string form.
+type WANIPConnection2GetIdleDisconnectTimeResponse struct {
+	NewIdleDisconnectTime string
+}
+
+// GetIdleDisconnectTime performs the GetIdleDisconnectTime action of the service.
+//
+// Return values:
+//   - NewIdleDisconnectTime (out, state variable A_ARG_TYPE_GetIdleDisconnectTime_NewIdleDisconnectTime)
 func (client *WANIPConnection2) GetIdleDisconnectTime() (NewIdleDisconnectTime uint32, err error) {
+	return client.GetIdleDisconnectTimeCtx(context.Background())
+}
+
+// GetIdleDisconnectTimeCtx is GetIdleDisconnectTime with a context, to cancel or time out the call.
+func (client *WANIPConnection2) GetIdleDisconnectTimeCtx(ctx context.Context) (NewIdleDisconnectTime uint32, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -3326,12 +5339,10 @@ func (client *WANIPConnection2) GetIdleDisconnectTime() (NewIdleDisconnectTime u
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewIdleDisconnectTime string
-	}{}
+	response := &WANIPConnection2GetIdleDisconnectTimeResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_2, "GetIdleDisconnectTime", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetIdleDisconnectTime", request, response); err != nil {
 		return
 	}
 
@@ -3344,7 +5355,22 @@ func (client *WANIPConnection2) GetIdleDisconnectTime() (NewIdleDisconnectTime u
 	return
 }
 
+// WANIPConnection2GetWarnDisconnectDelayResponse is the response of GetWarnDisconnectDelay, with each
+// argument in its SOAP string form.
+type WANIPConnection2GetWarnDisconnectDelayResponse struct {
+	NewWarnDisconnectDelay string
+}
+
+// GetWarnDisconnectDelay performs the GetWarnDisconnectDelay action of the service.
+//
+// Return values:
+//   - NewWarnDisconnectDelay (out, state variable A_ARG_TYPE_GetWarnDisconnectDelay_NewWarnDisconnectDelay)
 func (client *WANIPConnection2) GetWarnDisconnectDelay() (NewWarnDisconnectDelay uint32, err error) {
+	return client.GetWarnDisconnectDelayCtx(context.Background())
+}
+
+// GetWarnDisconnectDelayCtx is GetWarnDisconnectDelay with a context, to cancel or time out the call.
+func (client *WANIPConnection2) GetWarnDisconnectDelayCtx(ctx context.Context) (NewWarnDisconnectDelay uint32, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -3352,12 +5378,10 @@ func (client *WANIPConnection2) GetWarnDisconnectDelay() (NewWarnDisconnectDelay
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewWarnDisconnectDelay string
-	}{}
+	response := &WANIPConnection2GetWarnDisconnectDelayResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_2, "GetWarnDisconnectDelay", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetWarnDisconnectDelay", request, response); err != nil {
 		return
 	}
 
@@ -3370,7 +5394,24 @@ func (client *WANIPConnection2) GetWarnDisconnectDelay() (NewWarnDisconnectDelay
 	return
 }
 
+// WANIPConnection2GetNATRSIPStatusResponse is the response of GetNATRSIPStatus, with each
+// argument in its SOAP string form.
+type WANIPConnection2GetNATRSIPStatusResponse struct {
+	NewRSIPAvailable string
+	NewNATEnabled    string
+}
+
+// GetNATRSIPStatus performs the GetNATRSIPStatus action of the service.
+//
+// Return values:
+//   - NewRSIPAvailable (out, state variable A_ARG_TYPE_GetNATRSIPStatus_NewRSIPAvailable)
+//   - NewNATEnabled (out, state variable A_ARG_TYPE_GetNATRSIPStatus_NewNATEnabled)
 func (client *WANIPConnection2) GetNATRSIPStatus() (NewRSIPAvailable bool, NewNATEnabled bool, err error) {
+	return client.GetNATRSIPStatusCtx(context.Background())
+}
+
+// GetNATRSIPStatusCtx is GetNATRSIPStatus with a context, to cancel or time out the call.
+func (client *WANIPConnection2) GetNATRSIPStatusCtx(ctx context.Context) (NewRSIPAvailable bool, NewNATEnabled bool, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -3378,14 +5419,10 @@ func (client *WANIPConnection2) GetNATRSIPStatus() (NewRSIPAvailable bool, NewNA
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewRSIPAvailable string
-
-		NewNATEnabled string
-	}{}
+	response := &WANIPConnection2GetNATRSIPStatusResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_2, "GetNATRSIPStatus", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetNATRSIPStatus", request, response); err != nil {
 		return
 	}
 
@@ -3401,15 +5438,47 @@ func (client *WANIPConnection2) GetNATRSIPStatus() (NewRSIPAvailable bool, NewNA
 	return
 }
 
+// WANIPConnection2GetGenericPortMappingEntryRequest is the request of GetGenericPortMappingEntry, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANIPConnection2GetGenericPortMappingEntryRequest struct {
+	NewPortMappingIndex string
+}
+
+// WANIPConnection2GetGenericPortMappingEntryResponse is the response of GetGenericPortMappingEntry, with each
+// argument in its SOAP string form.
+type WANIPConnection2GetGenericPortMappingEntryResponse struct {
+	NewRemoteHost             string
+	NewExternalPort           string
+	NewProtocol               string
+	NewInternalPort           string
+	NewInternalClient         string
+	NewEnabled                string
+	NewPortMappingDescription string
+	NewLeaseDuration          string
+}
+
+// GetGenericPortMappingEntry performs the GetGenericPortMappingEntry action of the service.
 //
-// Return values:
+// Arguments:
+//   - NewPortMappingIndex (in, state variable A_ARG_TYPE_GetGenericPortMappingEntry_NewPortMappingIndex)
 //
-// * NewProtocol: allowed values: TCP, UDP
-func (client *WANIPConnection2) GetGenericPortMappingEntry(NewPortMappingIndex uint16) (NewRemoteHost string, NewExternalPort uint16, NewProtocol string, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32, err error) {
+// Return values:
+//   - NewRemoteHost (out, state variable A_ARG_TYPE_GetGenericPortMappingEntry_NewRemoteHost)
+//   - NewExternalPort (out, state variable A_ARG_TYPE_GetGenericPortMappingEntry_NewExternalPort)
+//   - NewProtocol (out, state variable PortMappingProtocol): allowed values TCP, UDP
+//   - NewInternalPort (out, state variable A_ARG_TYPE_GetGenericPortMappingEntry_NewInternalPort)
+//   - NewInternalClient (out, state variable A_ARG_TYPE_GetGenericPortMappingEntry_NewInternalClient)
+//   - NewEnabled (out, state variable A_ARG_TYPE_GetGenericPortMappingEntry_NewEnabled)
+//   - NewPortMappingDescription (out, state variable A_ARG_TYPE_GetGenericPortMappingEntry_NewPortMappingDescription)
+//   - NewLeaseDuration (out, state variable A_ARG_TYPE_GetGenericPortMappingEntry_NewLeaseDuration)
+func (client *WANIPConnection2) GetGenericPortMappingEntry(NewPortMappingIndex uint16) (NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection2PortMappingProtocol, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32, err error) {
+	return client.GetGenericPortMappingEntryCtx(context.Background(), NewPortMappingIndex)
+}
+
+// GetGenericPortMappingEntryCtx is GetGenericPortMappingEntry with a context, to cancel or time out the call.
+func (client *WANIPConnection2) GetGenericPortMappingEntryCtx(ctx context.Context, NewPortMappingIndex uint16) (NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection2PortMappingProtocol, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32, err error) {
 	// Request structure.
-	request := &struct {
-		NewPortMappingIndex string
-	}{}
+	request := &WANIPConnection2GetGenericPortMappingEntryRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewPortMappingIndex, err = soap.MarshalUi2(NewPortMappingIndex); err != nil {
@@ -3418,26 +5487,10 @@ func (client *WANIPConnection2) GetGenericPortMappingEntry(NewPortMappingIndex u
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewRemoteHost string
-
-		NewExternalPort string
-
-		NewProtocol string
-
-		NewInternalPort string
-
-		NewInternalClient string
-
-		NewEnabled string
-
-		NewPortMappingDescription string
-
-		NewLeaseDuration string
-	}{}
+	response := &WANIPConnection2GetGenericPortMappingEntryResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_2, "GetGenericPortMappingEntry", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetGenericPortMappingEntry", request, response); err != nil {
 		return
 	}
 
@@ -3449,9 +5502,7 @@ func (client *WANIPConnection2) GetGenericPortMappingEntry(NewPortMappingIndex u
 	if NewExternalPort, err = soap.UnmarshalUi2(response.NewExternalPort); err != nil {
 		return
 	}
-	if NewProtocol, err = soap.UnmarshalString(response.NewProtocol); err != nil {
-		return
-	}
+	NewProtocol = WANIPConnection2PortMappingProtocol(response.NewProtocol)
 	if NewInternalPort, err = soap.UnmarshalUi2(response.NewInternalPort); err != nil {
 		return
 	}
@@ -3471,20 +5522,45 @@ func (client *WANIPConnection2) GetGenericPortMappingEntry(NewPortMappingIndex u
 	return
 }
 
+// WANIPConnection2GetSpecificPortMappingEntryRequest is the request of GetSpecificPortMappingEntry, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANIPConnection2GetSpecificPortMappingEntryRequest struct {
+	NewRemoteHost   string
+	NewExternalPort string
+	NewProtocol     string
+}
+
+// WANIPConnection2GetSpecificPortMappingEntryResponse is the response of GetSpecificPortMappingEntry, with each
+// argument in its SOAP string form.
+type WANIPConnection2GetSpecificPortMappingEntryResponse struct {
+	NewInternalPort           string
+	NewInternalClient         string
+	NewEnabled                string
+	NewPortMappingDescription string
+	NewLeaseDuration          string
+}
+
+// GetSpecificPortMappingEntry performs the GetSpecificPortMappingEntry action of the service.
 //
 // Arguments:
+//   - NewRemoteHost (in, state variable A_ARG_TYPE_GetSpecificPortMappingEntry_NewRemoteHost)
+//   - NewExternalPort (in, state variable A_ARG_TYPE_GetSpecificPortMappingEntry_NewExternalPort)
+//   - NewProtocol (in, state variable PortMappingProtocol): allowed values TCP, UDP
 //
-// * NewProtocol: allowed values: TCP, UDP
+// Return values:
+//   - NewInternalPort (out, state variable A_ARG_TYPE_GetSpecificPortMappingEntry_NewInternalPort)
+//   - NewInternalClient (out, state variable A_ARG_TYPE_GetSpecificPortMappingEntry_NewInternalClient)
+//   - NewEnabled (out, state variable A_ARG_TYPE_GetSpecificPortMappingEntry_NewEnabled)
+//   - NewPortMappingDescription (out, state variable A_ARG_TYPE_GetSpecificPortMappingEntry_NewPortMappingDescription)
+//   - NewLeaseDuration (out, state variable A_ARG_TYPE_GetSpecificPortMappingEntry_NewLeaseDuration)
+func (client *WANIPConnection2) GetSpecificPortMappingEntry(NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection2PortMappingProtocol) (NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32, err error) {
+	return client.GetSpecificPortMappingEntryCtx(context.Background(), NewRemoteHost, NewExternalPort, NewProtocol)
+}
 
-func (client *WANIPConnection2) GetSpecificPortMappingEntry(NewRemoteHost string, NewExternalPort uint16, NewProtocol string) (NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32, err error) {
+// GetSpecificPortMappingEntryCtx is GetSpecificPortMappingEntry with a context, to cancel or time out the call.
+func (client *WANIPConnection2) GetSpecificPortMappingEntryCtx(ctx context.Context, NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection2PortMappingProtocol) (NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32, err error) {
 	// Request structure.
-	request := &struct {
-		NewRemoteHost string
-
-		NewExternalPort string
-
-		NewProtocol string
-	}{}
+	request := &WANIPConnection2GetSpecificPortMappingEntryRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewRemoteHost, err = soap.MarshalString(NewRemoteHost); err != nil {
@@ -3493,26 +5569,16 @@ func (client *WANIPConnection2) GetSpecificPortMappingEntry(NewRemoteHost string
 	if request.NewExternalPort, err = soap.MarshalUi2(NewExternalPort); err != nil {
 		return
 	}
-	if request.NewProtocol, err = soap.MarshalString(NewProtocol); err != nil {
+	if request.NewProtocol, err = soap.MarshalString(string(NewProtocol)); err != nil {
 		return
 	}
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewInternalPort string
-
-		NewInternalClient string
-
-		NewEnabled string
-
-		NewPortMappingDescription string
-
-		NewLeaseDuration string
-	}{}
+	response := &WANIPConnection2GetSpecificPortMappingEntryResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_2, "GetSpecificPortMappingEntry", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetSpecificPortMappingEntry", request, response); err != nil {
 		return
 	}
 
@@ -3537,30 +5603,38 @@ func (client *WANIPConnection2) GetSpecificPortMappingEntry(NewRemoteHost string
 	return
 }
 
+// WANIPConnection2AddPortMappingRequest is the request of AddPortMapping, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANIPConnection2AddPortMappingRequest struct {
+	NewRemoteHost             string
+	NewExternalPort           string
+	NewProtocol               string
+	NewInternalPort           string
+	NewInternalClient         string
+	NewEnabled                string
+	NewPortMappingDescription string
+	NewLeaseDuration          string
+}
+
+// AddPortMapping performs the AddPortMapping action of the service.
 //
 // Arguments:
-//
-// * NewProtocol: allowed values: TCP, UDP
+//   - NewRemoteHost (in, state variable A_ARG_TYPE_AddPortMapping_NewRemoteHost)
+//   - NewExternalPort (in, state variable A_ARG_TYPE_AddPortMapping_NewExternalPort)
+//   - NewProtocol (in, state variable PortMappingProtocol): allowed values TCP, UDP
+//   - NewInternalPort (in, state variable A_ARG_TYPE_AddPortMapping_NewInternalPort)
+//   - NewInternalClient (in, state variable A_ARG_TYPE_AddPortMapping_NewInternalClient)
+//   - NewEnabled (in, state variable A_ARG_TYPE_AddPortMapping_NewEnabled)
+//   - NewPortMappingDescription (in, state variable A_ARG_TYPE_AddPortMapping_NewPortMappingDescription)
+//   - NewLeaseDuration (in, state variable A_ARG_TYPE_AddPortMapping_NewLeaseDuration)
+func (client *WANIPConnection2) AddPortMapping(NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection2PortMappingProtocol, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32) (err error) {
+	return client.AddPortMappingCtx(context.Background(), NewRemoteHost, NewExternalPort, NewProtocol, NewInternalPort, NewInternalClient, NewEnabled, NewPortMappingDescription, NewLeaseDuration)
+}
 
-func (client *WANIPConnection2) AddPortMapping(NewRemoteHost string, NewExternalPort uint16, NewProtocol string, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32) (err error) {
+// AddPortMappingCtx is AddPortMapping with a context, to cancel or time out the call.
+func (client *WANIPConnection2) AddPortMappingCtx(ctx context.Context, NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection2PortMappingProtocol, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32) (err error) {
 	// Request structure.
-	request := &struct {
-		NewRemoteHost string
-
-		NewExternalPort string
-
-		NewProtocol string
-
-		NewInternalPort string
-
-		NewInternalClient string
-
-		NewEnabled string
-
-		NewPortMappingDescription string
-
-		NewLeaseDuration string
-	}{}
+	request := &WANIPConnection2AddPortMappingRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewRemoteHost, err = soap.MarshalString(NewRemoteHost); err != nil {
@@ -3569,7 +5643,7 @@ func (client *WANIPConnection2) AddPortMapping(NewRemoteHost string, NewExternal
 	if request.NewExternalPort, err = soap.MarshalUi2(NewExternalPort); err != nil {
 		return
 	}
-	if request.NewProtocol, err = soap.MarshalString(NewProtocol); err != nil {
+	if request.NewProtocol, err = soap.MarshalString(string(NewProtocol)); err != nil {
 		return
 	}
 	if request.NewInternalPort, err = soap.MarshalUi2(NewInternalPort); err != nil {
@@ -3593,7 +5667,7 @@ func (client *WANIPConnection2) AddPortMapping(NewRemoteHost string, NewExternal
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_2, "AddPortMapping", request, response); err != nil {
+	if err = client.PerformAction(ctx, "AddPortMapping", request, response); err != nil {
 		return
 	}
 
@@ -3603,20 +5677,28 @@ func (client *WANIPConnection2) AddPortMapping(NewRemoteHost string, NewExternal
 	return
 }
 
+// WANIPConnection2DeletePortMappingRequest is the request of DeletePortMapping, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANIPConnection2DeletePortMappingRequest struct {
+	NewRemoteHost   string
+	NewExternalPort string
+	NewProtocol     string
+}
+
+// DeletePortMapping performs the DeletePortMapping action of the service.
 //
 // Arguments:
-//
-// * NewProtocol: allowed values: TCP, UDP
+//   - NewRemoteHost (in, state variable A_ARG_TYPE_DeletePortMapping_NewRemoteHost)
+//   - NewExternalPort (in, state variable A_ARG_TYPE_DeletePortMapping_NewExternalPort)
+//   - NewProtocol (in, state variable PortMappingProtocol): allowed values TCP, UDP
+func (client *WANIPConnection2) DeletePortMapping(NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection2PortMappingProtocol) (err error) {
+	return client.DeletePortMappingCtx(context.Background(), NewRemoteHost, NewExternalPort, NewProtocol)
+}
 
-func (client *WANIPConnection2) DeletePortMapping(NewRemoteHost string, NewExternalPort uint16, NewProtocol string) (err error) {
+// DeletePortMappingCtx is DeletePortMapping with a context, to cancel or time out the call.
+func (client *WANIPConnection2) DeletePortMappingCtx(ctx context.Context, NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection2PortMappingProtocol) (err error) {
 	// Request structure.
-	request := &struct {
-		NewRemoteHost string
-
-		NewExternalPort string
-
-		NewProtocol string
-	}{}
+	request := &WANIPConnection2DeletePortMappingRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewRemoteHost, err = soap.MarshalString(NewRemoteHost); err != nil {
@@ -3625,7 +5707,7 @@ func (client *WANIPConnection2) DeletePortMapping(NewRemoteHost string, NewExter
 	if request.NewExternalPort, err = soap.MarshalUi2(NewExternalPort); err != nil {
 		return
 	}
-	if request.NewProtocol, err = soap.MarshalString(NewProtocol); err != nil {
+	if request.NewProtocol, err = soap.MarshalString(string(NewProtocol)); err != nil {
 		return
 	}
 	// END Marshal arguments into request.
@@ -3634,7 +5716,7 @@ func (client *WANIPConnection2) DeletePortMapping(NewRemoteHost string, NewExter
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_2, "DeletePortMapping", request, response); err != nil {
+	if err = client.PerformAction(ctx, "DeletePortMapping", request, response); err != nil {
 		return
 	}
 
@@ -3644,22 +5726,30 @@ func (client *WANIPConnection2) DeletePortMapping(NewRemoteHost string, NewExter
 	return
 }
 
+// WANIPConnection2DeletePortMappingRangeRequest is the request of DeletePortMappingRange, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANIPConnection2DeletePortMappingRangeRequest struct {
+	NewStartPort string
+	NewEndPort   string
+	NewProtocol  string
+	NewManage    string
+}
+
+// DeletePortMappingRange performs the DeletePortMappingRange action of the service.
 //
 // Arguments:
-//
-// * NewProtocol: allowed values: TCP, UDP
+//   - NewStartPort (in, state variable A_ARG_TYPE_DeletePortMappingRange_NewStartPort)
+//   - NewEndPort (in, state variable A_ARG_TYPE_DeletePortMappingRange_NewEndPort)
+//   - NewProtocol (in, state variable PortMappingProtocol): allowed values TCP, UDP
+//   - NewManage (in, state variable A_ARG_TYPE_DeletePortMappingRange_NewManage)
+func (client *WANIPConnection2) DeletePortMappingRange(NewStartPort uint16, NewEndPort uint16, NewProtocol WANIPConnection2PortMappingProtocol, NewManage bool) (err error) {
+	return client.DeletePortMappingRangeCtx(context.Background(), NewStartPort, NewEndPort, NewProtocol, NewManage)
+}
 
-func (client *WANIPConnection2) DeletePortMappingRange(NewStartPort uint16, NewEndPort uint16, NewProtocol string, NewManage bool) (err error) {
+// DeletePortMappingRangeCtx is DeletePortMappingRange with a context, to cancel or time out the call.
+func (client *WANIPConnection2) DeletePortMappingRangeCtx(ctx context.Context, NewStartPort uint16, NewEndPort uint16, NewProtocol WANIPConnection2PortMappingProtocol, NewManage bool) (err error) {
 	// Request structure.
-	request := &struct {
-		NewStartPort string
-
-		NewEndPort string
-
-		NewProtocol string
-
-		NewManage string
-	}{}
+	request := &WANIPConnection2DeletePortMappingRangeRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewStartPort, err = soap.MarshalUi2(NewStartPort); err != nil {
@@ -3668,7 +5758,7 @@ func (client *WANIPConnection2) DeletePortMappingRange(NewStartPort uint16, NewE
 	if request.NewEndPort, err = soap.MarshalUi2(NewEndPort); err != nil {
 		return
 	}
-	if request.NewProtocol, err = soap.MarshalString(NewProtocol); err != nil {
+	if request.NewProtocol, err = soap.MarshalString(string(NewProtocol)); err != nil {
 		return
 	}
 	if request.NewManage, err = soap.MarshalBoolean(NewManage); err != nil {
@@ -3680,7 +5770,7 @@ func (client *WANIPConnection2) DeletePortMappingRange(NewStartPort uint16, NewE
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_2, "DeletePortMappingRange", request, response); err != nil {
+	if err = client.PerformAction(ctx, "DeletePortMappingRange", request, response); err != nil {
 		return
 	}
 
@@ -3690,7 +5780,22 @@ func (client *WANIPConnection2) DeletePortMappingRange(NewStartPort uint16, NewE
 	return
 }
 
+// WANIPConnection2GetExternalIPAddressResponse is the response of GetExternalIPAddress, with each
+// argument in its SOAP string form.
+type WANIPConnection2GetExternalIPAddressResponse struct {
+	NewExternalIPAddress string
+}
+
+// GetExternalIPAddress performs the GetExternalIPAddress action of the service.
+//
+// Return values:
+//   - NewExternalIPAddress (out, state variable A_ARG_TYPE_GetExternalIPAddress_NewExternalIPAddress)
 func (client *WANIPConnection2) GetExternalIPAddress() (NewExternalIPAddress string, err error) {
+	return client.GetExternalIPAddressCtx(context.Background())
+}
+
+// GetExternalIPAddressCtx is GetExternalIPAddress with a context, to cancel or time out the call.
+func (client *WANIPConnection2) GetExternalIPAddressCtx(ctx context.Context) (NewExternalIPAddress string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -3698,12 +5803,10 @@ func (client *WANIPConnection2) GetExternalIPAddress() (NewExternalIPAddress str
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewExternalIPAddress string
-	}{}
+	response := &WANIPConnection2GetExternalIPAddressResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_2, "GetExternalIPAddress", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetExternalIPAddress", request, response); err != nil {
 		return
 	}
 
@@ -3716,24 +5819,41 @@ func (client *WANIPConnection2) GetExternalIPAddress() (NewExternalIPAddress str
 	return
 }
 
+// WANIPConnection2GetListOfPortMappingsRequest is the request of GetListOfPortMappings, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANIPConnection2GetListOfPortMappingsRequest struct {
+	NewStartPort     string
+	NewEndPort       string
+	NewProtocol      string
+	NewManage        string
+	NewNumberOfPorts string
+}
+
+// WANIPConnection2GetListOfPortMappingsResponse is the response of GetListOfPortMappings, with each
+// argument in its SOAP string form.
+type WANIPConnection2GetListOfPortMappingsResponse struct {
+	NewPortListing string
+}
+
+// GetListOfPortMappings performs the GetListOfPortMappings action of the service.
 //
 // Arguments:
+//   - NewStartPort (in, state variable A_ARG_TYPE_GetListOfPortMappings_NewStartPort)
+//   - NewEndPort (in, state variable A_ARG_TYPE_GetListOfPortMappings_NewEndPort)
+//   - NewProtocol (in, state variable PortMappingProtocol): allowed values TCP, UDP
+//   - NewManage (in, state variable A_ARG_TYPE_GetListOfPortMappings_NewManage)
+//   - NewNumberOfPorts (in, state variable A_ARG_TYPE_GetListOfPortMappings_NewNumberOfPorts)
 //
-// * NewProtocol: allowed values: TCP, UDP
+// Return values:
+//   - NewPortListing (out, state variable A_ARG_TYPE_GetListOfPortMappings_NewPortListing)
+func (client *WANIPConnection2) GetListOfPortMappings(NewStartPort uint16, NewEndPort uint16, NewProtocol WANIPConnection2PortMappingProtocol, NewManage bool, NewNumberOfPorts uint16) (NewPortListing string, err error) {
+	return client.GetListOfPortMappingsCtx(context.Background(), NewStartPort, NewEndPort, NewProtocol, NewManage, NewNumberOfPorts)
+}
 
-func (client *WANIPConnection2) GetListOfPortMappings(NewStartPort uint16, NewEndPort uint16, NewProtocol string, NewManage bool, NewNumberOfPorts uint16) (NewPortListing string, err error) {
+// GetListOfPortMappingsCtx is GetListOfPortMappings with a context, to cancel or time out the call.
+func (client *WANIPConnection2) GetListOfPortMappingsCtx(ctx context.Context, NewStartPort uint16, NewEndPort uint16, NewProtocol WANIPConnection2PortMappingProtocol, NewManage bool, NewNumberOfPorts uint16) (NewPortListing string, err error) {
 	// Request structure.
-	request := &struct {
-		NewStartPort string
-
-		NewEndPort string
-
-		NewProtocol string
-
-		NewManage string
-
-		NewNumberOfPorts string
-	}{}
+	request := &WANIPConnection2GetListOfPortMappingsRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewStartPort, err = soap.MarshalUi2(NewStartPort); err != nil {
@@ -3742,7 +5862,7 @@ func (client *WANIPConnection2) GetListOfPortMappings(NewStartPort uint16, NewEn
 	if request.NewEndPort, err = soap.MarshalUi2(NewEndPort); err != nil {
 		return
 	}
-	if request.NewProtocol, err = soap.MarshalString(NewProtocol); err != nil {
+	if request.NewProtocol, err = soap.MarshalString(string(NewProtocol)); err != nil {
 		return
 	}
 	if request.NewManage, err = soap.MarshalBoolean(NewManage); err != nil {
@@ -3754,12 +5874,10 @@ func (client *WANIPConnection2) GetListOfPortMappings(NewStartPort uint16, NewEn
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewPortListing string
-	}{}
+	response := &WANIPConnection2GetListOfPortMappingsResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_2, "GetListOfPortMappings", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetListOfPortMappings", request, response); err != nil {
 		return
 	}
 
@@ -3772,30 +5890,47 @@ func (client *WANIPConnection2) GetListOfPortMappings(NewStartPort uint16, NewEn
 	return
 }
 
+// WANIPConnection2AddAnyPortMappingRequest is the request of AddAnyPortMapping, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANIPConnection2AddAnyPortMappingRequest struct {
+	NewRemoteHost             string
+	NewExternalPort           string
+	NewProtocol               string
+	NewInternalPort           string
+	NewInternalClient         string
+	NewEnabled                string
+	NewPortMappingDescription string
+	NewLeaseDuration          string
+}
+
+// WANIPConnection2AddAnyPortMappingResponse is the response of AddAnyPortMapping, with each
+// argument in its SOAP string form.
+type WANIPConnection2AddAnyPortMappingResponse struct {
+	NewReservedPort string
+}
+
+// AddAnyPortMapping performs the AddAnyPortMapping action of the service.
 //
 // Arguments:
+//   - NewRemoteHost (in, state variable A_ARG_TYPE_AddAnyPortMapping_NewRemoteHost)
+//   - NewExternalPort (in, state variable A_ARG_TYPE_AddAnyPortMapping_NewExternalPort)
+//   - NewProtocol (in, state variable PortMappingProtocol): allowed values TCP, UDP
+//   - NewInternalPort (in, state variable A_ARG_TYPE_AddAnyPortMapping_NewInternalPort)
+//   - NewInternalClient (in, state variable A_ARG_TYPE_AddAnyPortMapping_NewInternalClient)
+//   - NewEnabled (in, state variable A_ARG_TYPE_AddAnyPortMapping_NewEnabled)
+//   - NewPortMappingDescription (in, state variable A_ARG_TYPE_AddAnyPortMapping_NewPortMappingDescription)
+//   - NewLeaseDuration (in, state variable A_ARG_TYPE_AddAnyPortMapping_NewLeaseDuration)
 //
-// * NewProtocol: allowed values: TCP, UDP
+// Return values:
+//   - NewReservedPort (out, state variable A_ARG_TYPE_AddAnyPortMapping_NewReservedPort)
+func (client *WANIPConnection2) AddAnyPortMapping(NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection2PortMappingProtocol, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32) (NewReservedPort uint16, err error) {
+	return client.AddAnyPortMappingCtx(context.Background(), NewRemoteHost, NewExternalPort, NewProtocol, NewInternalPort, NewInternalClient, NewEnabled, NewPortMappingDescription, NewLeaseDuration)
+}
 
-func (client *WANIPConnection2) AddAnyPortMapping(NewRemoteHost string, NewExternalPort uint16, NewProtocol string, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32) (NewReservedPort uint16, err error) {
+// AddAnyPortMappingCtx is AddAnyPortMapping with a context, to cancel or time out the call.
+func (client *WANIPConnection2) AddAnyPortMappingCtx(ctx context.Context, NewRemoteHost string, NewExternalPort uint16, NewProtocol WANIPConnection2PortMappingProtocol, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32) (NewReservedPort uint16, err error) {
 	// Request structure.
-	request := &struct {
-		NewRemoteHost string
-
-		NewExternalPort string
-
-		NewProtocol string
-
-		NewInternalPort string
-
-		NewInternalClient string
-
-		NewEnabled string
-
-		NewPortMappingDescription string
-
-		NewLeaseDuration string
-	}{}
+	request := &WANIPConnection2AddAnyPortMappingRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewRemoteHost, err = soap.MarshalString(NewRemoteHost); err != nil {
@@ -3804,7 +5939,7 @@ func (client *WANIPConnection2) AddAnyPortMapping(NewRemoteHost string, NewExter
 	if request.NewExternalPort, err = soap.MarshalUi2(NewExternalPort); err != nil {
 		return
 	}
-	if request.NewProtocol, err = soap.MarshalString(NewProtocol); err != nil {
+	if request.NewProtocol, err = soap.MarshalString(string(NewProtocol)); err != nil {
 		return
 	}
 	if request.NewInternalPort, err = soap.MarshalUi2(NewInternalPort); err != nil {
@@ -3825,12 +5960,10 @@ func (client *WANIPConnection2) AddAnyPortMapping(NewRemoteHost string, NewExter
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewReservedPort string
-	}{}
+	response := &WANIPConnection2AddAnyPortMappingResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPConnection_2, "AddAnyPortMapping", request, response); err != nil {
+	if err = client.PerformAction(ctx, "AddAnyPortMapping", request, response); err != nil {
 		return
 	}
 
@@ -3850,6 +5983,27 @@ type WANIPv6FirewallControl1 struct {
 	goupnp.ServiceClient
 }
 
+// WANIPv6FirewallControl1Client is the interface of the actions of WANIPv6FirewallControl1, for
+// substituting fakes or mocks for the service in tests.
+type WANIPv6FirewallControl1Client interface {
+	GetFirewallStatus() (FirewallEnabled bool, InboundPinholeAllowed bool, err error)
+	GetFirewallStatusCtx(ctx context.Context) (FirewallEnabled bool, InboundPinholeAllowed bool, err error)
+	GetOutboundPinholeTimeout(RemoteHost string, RemotePort uint16, InternalClient string, InternalPort uint16, Protocol uint16) (OutboundPinholeTimeout uint32, err error)
+	GetOutboundPinholeTimeoutCtx(ctx context.Context, RemoteHost string, RemotePort uint16, InternalClient string, InternalPort uint16, Protocol uint16) (OutboundPinholeTimeout uint32, err error)
+	AddPinhole(RemoteHost string, RemotePort uint16, InternalClient string, InternalPort uint16, Protocol uint16, LeaseTime uint32) (UniqueID uint16, err error)
+	AddPinholeCtx(ctx context.Context, RemoteHost string, RemotePort uint16, InternalClient string, InternalPort uint16, Protocol uint16, LeaseTime uint32) (UniqueID uint16, err error)
+	UpdatePinhole(UniqueID uint16, NewLeaseTime uint32) (err error)
+	UpdatePinholeCtx(ctx context.Context, UniqueID uint16, NewLeaseTime uint32) (err error)
+	DeletePinhole(UniqueID uint16) (err error)
+	DeletePinholeCtx(ctx context.Context, UniqueID uint16) (err error)
+	GetPinholePackets(UniqueID uint16) (PinholePackets uint32, err error)
+	GetPinholePacketsCtx(ctx context.Context, UniqueID uint16) (PinholePackets uint32, err error)
+	CheckPinholeWorking(UniqueID uint16) (IsWorking bool, err error)
+	CheckPinholeWorkingCtx(ctx context.Context, UniqueID uint16) (IsWorking bool, err error)
+}
+
+var _ WANIPv6FirewallControl1Client = new(WANIPv6FirewallControl1)
+
 // NewWANIPv6FirewallControl1Clients discovers instances of the service on the network,
 // and returns clients to any that are found. errors will contain an error for
 // any devices that replied but which could not be queried, and err will be set
@@ -3903,7 +6057,42 @@ func newWANIPv6FirewallControl1ClientsFromGenericClients(genericClients []goupnp
 	return clients
 }
 
+// WANIPv6FirewallControl1Timeouts are the default timeouts of the actions of
+// WANIPv6FirewallControl1, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var WANIPv6FirewallControl1Timeouts = map[string]time.Duration{
+	"": 10 * time.Second,
+}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *WANIPv6FirewallControl1) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, WANIPv6FirewallControl1Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_WANIPv6FirewallControl_1, actionName, request, response)
+}
+
+// WANIPv6FirewallControl1GetFirewallStatusResponse is the response of GetFirewallStatus, with each
+// argument in its SOAP string form.
+type WANIPv6FirewallControl1GetFirewallStatusResponse struct {
+	FirewallEnabled       string
+	InboundPinholeAllowed string
+}
+
+// GetFirewallStatus performs the GetFirewallStatus action of the service.
+//
+// Return values:
+//   - FirewallEnabled (out, state variable A_ARG_TYPE_GetFirewallStatus_FirewallEnabled)
+//   - InboundPinholeAllowed (out, state variable A_ARG_TYPE_GetFirewallStatus_InboundPinholeAllowed)
 func (client *WANIPv6FirewallControl1) GetFirewallStatus() (FirewallEnabled bool, InboundPinholeAllowed bool, err error) {
+	return client.GetFirewallStatusCtx(context.Background())
+}
+
+// GetFirewallStatusCtx is GetFirewallStatus with a context, to cancel or time out the call.
+func (client *WANIPv6FirewallControl1) GetFirewallStatusCtx(ctx context.Context) (FirewallEnabled bool, InboundPinholeAllowed bool, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -3911,14 +6100,10 @@ func (client *WANIPv6FirewallControl1) GetFirewallStatus() (FirewallEnabled bool
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		FirewallEnabled string
-
-		InboundPinholeAllowed string
-	}{}
+	response := &WANIPv6FirewallControl1GetFirewallStatusResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPv6FirewallControl_1, "GetFirewallStatus", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetFirewallStatus", request, response); err != nil {
 		return
 	}
 
@@ -3934,19 +6119,41 @@ func (client *WANIPv6FirewallControl1) GetFirewallStatus() (FirewallEnabled bool
 	return
 }
 
-func (client *WANIPv6FirewallControl1) GetOutboundPinholeTimeout(RemoteHost string, RemotePort uint16, InternalClient string, InternalPort uint16, Protocol uint16) (OutboundPinholeTimeout uint32, err error) {
-	// Request structure.
-	request := &struct {
-		RemoteHost string
-
-		RemotePort string
+// WANIPv6FirewallControl1GetOutboundPinholeTimeoutRequest is the request of GetOutboundPinholeTimeout, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANIPv6FirewallControl1GetOutboundPinholeTimeoutRequest struct {
+	RemoteHost     string
+	RemotePort     string
+	InternalClient string
+	InternalPort   string
+	Protocol       string
+}
 
-		InternalClient string
+// WANIPv6FirewallControl1GetOutboundPinholeTimeoutResponse is the response of GetOutboundPinholeTimeout, with each
+// argument in its SOAP string form.
+type WANIPv6FirewallControl1GetOutboundPinholeTimeoutResponse struct {
+	OutboundPinholeTimeout string
+}
 
-		InternalPort string
+// GetOutboundPinholeTimeout performs the GetOutboundPinholeTimeout action of the service.
+//
+// Arguments:
+//   - RemoteHost (in, state variable A_ARG_TYPE_GetOutboundPinholeTimeout_RemoteHost)
+//   - RemotePort (in, state variable A_ARG_TYPE_GetOutboundPinholeTimeout_RemotePort)
+//   - InternalClient (in, state variable A_ARG_TYPE_GetOutboundPinholeTimeout_InternalClient)
+//   - InternalPort (in, state variable A_ARG_TYPE_GetOutboundPinholeTimeout_InternalPort)
+//   - Protocol (in, state variable A_ARG_TYPE_GetOutboundPinholeTimeout_Protocol)
+//
+// Return values:
+//   - OutboundPinholeTimeout (out, state variable A_ARG_TYPE_GetOutboundPinholeTimeout_OutboundPinholeTimeout)
+func (client *WANIPv6FirewallControl1) GetOutboundPinholeTimeout(RemoteHost string, RemotePort uint16, InternalClient string, InternalPort uint16, Protocol uint16) (OutboundPinholeTimeout uint32, err error) {
+	return client.GetOutboundPinholeTimeoutCtx(context.Background(), RemoteHost, RemotePort, InternalClient, InternalPort, Protocol)
+}
 
-		Protocol string
-	}{}
+// GetOutboundPinholeTimeoutCtx is GetOutboundPinholeTimeout with a context, to cancel or time out the call.
+func (client *WANIPv6FirewallControl1) GetOutboundPinholeTimeoutCtx(ctx context.Context, RemoteHost string, RemotePort uint16, InternalClient string, InternalPort uint16, Protocol uint16) (OutboundPinholeTimeout uint32, err error) {
+	// Request structure.
+	request := &WANIPv6FirewallControl1GetOutboundPinholeTimeoutRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.RemoteHost, err = soap.MarshalString(RemoteHost); err != nil {
@@ -3967,12 +6174,10 @@ func (client *WANIPv6FirewallControl1) GetOutboundPinholeTimeout(RemoteHost stri
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		OutboundPinholeTimeout string
-	}{}
+	response := &WANIPv6FirewallControl1GetOutboundPinholeTimeoutResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPv6FirewallControl_1, "GetOutboundPinholeTimeout", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetOutboundPinholeTimeout", request, response); err != nil {
 		return
 	}
 
@@ -3985,26 +6190,43 @@ func (client *WANIPv6FirewallControl1) GetOutboundPinholeTimeout(RemoteHost stri
 	return
 }
 
+// WANIPv6FirewallControl1AddPinholeRequest is the request of AddPinhole, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANIPv6FirewallControl1AddPinholeRequest struct {
+	RemoteHost     string
+	RemotePort     string
+	InternalClient string
+	InternalPort   string
+	Protocol       string
+	LeaseTime      string
+}
+
+// WANIPv6FirewallControl1AddPinholeResponse is the response of AddPinhole, with each
+// argument in its SOAP string form.
+type WANIPv6FirewallControl1AddPinholeResponse struct {
+	UniqueID string
+}
+
+// AddPinhole performs the AddPinhole action of the service.
 //
 // Arguments:
+//   - RemoteHost (in, state variable A_ARG_TYPE_AddPinhole_RemoteHost)
+//   - RemotePort (in, state variable A_ARG_TYPE_AddPinhole_RemotePort)
+//   - InternalClient (in, state variable A_ARG_TYPE_AddPinhole_InternalClient)
+//   - InternalPort (in, state variable A_ARG_TYPE_AddPinhole_InternalPort)
+//   - Protocol (in, state variable A_ARG_TYPE_AddPinhole_Protocol)
+//   - LeaseTime (in, state variable A_ARG_TYPE_AddPinhole_LeaseTime): allowed range minimum=1, maximum=86400
 //
-// * LeaseTime: allowed value range: minimum=1, maximum=86400
-
+// Return values:
+//   - UniqueID (out, state variable A_ARG_TYPE_AddPinhole_UniqueID)
 func (client *WANIPv6FirewallControl1) AddPinhole(RemoteHost string, RemotePort uint16, InternalClient string, InternalPort uint16, Protocol uint16, LeaseTime uint32) (UniqueID uint16, err error) {
-	// Request structure.
-	request := &struct {
-		RemoteHost string
-
-		RemotePort string
-
-		InternalClient string
-
-		InternalPort string
-
-		Protocol string
+	return client.AddPinholeCtx(context.Background(), RemoteHost, RemotePort, InternalClient, InternalPort, Protocol, LeaseTime)
+}
 
-		LeaseTime string
-	}{}
+// AddPinholeCtx is AddPinhole with a context, to cancel or time out the call.
+func (client *WANIPv6FirewallControl1) AddPinholeCtx(ctx context.Context, RemoteHost string, RemotePort uint16, InternalClient string, InternalPort uint16, Protocol uint16, LeaseTime uint32) (UniqueID uint16, err error) {
+	// Request structure.
+	request := &WANIPv6FirewallControl1AddPinholeRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.RemoteHost, err = soap.MarshalString(RemoteHost); err != nil {
@@ -4028,12 +6250,10 @@ func (client *WANIPv6FirewallControl1) AddPinhole(RemoteHost string, RemotePort
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		UniqueID string
-	}{}
+	response := &WANIPv6FirewallControl1AddPinholeResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPv6FirewallControl_1, "AddPinhole", request, response); err != nil {
+	if err = client.PerformAction(ctx, "AddPinhole", request, response); err != nil {
 		return
 	}
 
@@ -4046,18 +6266,26 @@ func (client *WANIPv6FirewallControl1) AddPinhole(RemoteHost string, RemotePort
 	return
 }
 
+// WANIPv6FirewallControl1UpdatePinholeRequest is the request of UpdatePinhole, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANIPv6FirewallControl1UpdatePinholeRequest struct {
+	UniqueID     string
+	NewLeaseTime string
+}
+
+// UpdatePinhole performs the UpdatePinhole action of the service.
 //
 // Arguments:
-//
-// * NewLeaseTime: allowed value range: minimum=1, maximum=86400
-
+//   - UniqueID (in, state variable A_ARG_TYPE_UpdatePinhole_UniqueID)
+//   - NewLeaseTime (in, state variable A_ARG_TYPE_UpdatePinhole_NewLeaseTime): allowed range minimum=1, maximum=86400
 func (client *WANIPv6FirewallControl1) UpdatePinhole(UniqueID uint16, NewLeaseTime uint32) (err error) {
-	// Request structure.
-	request := &struct {
-		UniqueID string
+	return client.UpdatePinholeCtx(context.Background(), UniqueID, NewLeaseTime)
+}
 
-		NewLeaseTime string
-	}{}
+// UpdatePinholeCtx is UpdatePinhole with a context, to cancel or time out the call.
+func (client *WANIPv6FirewallControl1) UpdatePinholeCtx(ctx context.Context, UniqueID uint16, NewLeaseTime uint32) (err error) {
+	// Request structure.
+	request := &WANIPv6FirewallControl1UpdatePinholeRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.UniqueID, err = soap.MarshalUi2(UniqueID); err != nil {
@@ -4072,7 +6300,7 @@ func (client *WANIPv6FirewallControl1) UpdatePinhole(UniqueID uint16, NewLeaseTi
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPv6FirewallControl_1, "UpdatePinhole", request, response); err != nil {
+	if err = client.PerformAction(ctx, "UpdatePinhole", request, response); err != nil {
 		return
 	}
 
@@ -4082,11 +6310,24 @@ func (client *WANIPv6FirewallControl1) UpdatePinhole(UniqueID uint16, NewLeaseTi
 	return
 }
 
+// WANIPv6FirewallControl1DeletePinholeRequest is the request of DeletePinhole, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANIPv6FirewallControl1DeletePinholeRequest struct {
+	UniqueID string
+}
+
+// DeletePinhole performs the DeletePinhole action of the service.
+//
+// Arguments:
+//   - UniqueID (in, state variable A_ARG_TYPE_DeletePinhole_UniqueID)
 func (client *WANIPv6FirewallControl1) DeletePinhole(UniqueID uint16) (err error) {
+	return client.DeletePinholeCtx(context.Background(), UniqueID)
+}
+
+// DeletePinholeCtx is DeletePinhole with a context, to cancel or time out the call.
+func (client *WANIPv6FirewallControl1) DeletePinholeCtx(ctx context.Context, UniqueID uint16) (err error) {
 	// Request structure.
-	request := &struct {
-		UniqueID string
-	}{}
+	request := &WANIPv6FirewallControl1DeletePinholeRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.UniqueID, err = soap.MarshalUi2(UniqueID); err != nil {
@@ -4098,7 +6339,7 @@ func (client *WANIPv6FirewallControl1) DeletePinhole(UniqueID uint16) (err error
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPv6FirewallControl_1, "DeletePinhole", request, response); err != nil {
+	if err = client.PerformAction(ctx, "DeletePinhole", request, response); err != nil {
 		return
 	}
 
@@ -4108,11 +6349,33 @@ func (client *WANIPv6FirewallControl1) DeletePinhole(UniqueID uint16) (err error
 	return
 }
 
+// WANIPv6FirewallControl1GetPinholePacketsRequest is the request of GetPinholePackets, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANIPv6FirewallControl1GetPinholePacketsRequest struct {
+	UniqueID string
+}
+
+// WANIPv6FirewallControl1GetPinholePacketsResponse is the response of GetPinholePackets, with each
+// argument in its SOAP string form.
+type WANIPv6FirewallControl1GetPinholePacketsResponse struct {
+	PinholePackets string
+}
+
+// GetPinholePackets performs the GetPinholePackets action of the service.
+//
+// Arguments:
+//   - UniqueID (in, state variable A_ARG_TYPE_GetPinholePackets_UniqueID)
+//
+// Return values:
+//   - PinholePackets (out, state variable A_ARG_TYPE_GetPinholePackets_PinholePackets)
 func (client *WANIPv6FirewallControl1) GetPinholePackets(UniqueID uint16) (PinholePackets uint32, err error) {
+	return client.GetPinholePacketsCtx(context.Background(), UniqueID)
+}
+
+// GetPinholePacketsCtx is GetPinholePackets with a context, to cancel or time out the call.
+func (client *WANIPv6FirewallControl1) GetPinholePacketsCtx(ctx context.Context, UniqueID uint16) (PinholePackets uint32, err error) {
 	// Request structure.
-	request := &struct {
-		UniqueID string
-	}{}
+	request := &WANIPv6FirewallControl1GetPinholePacketsRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.UniqueID, err = soap.MarshalUi2(UniqueID); err != nil {
@@ -4121,12 +6384,10 @@ func (client *WANIPv6FirewallControl1) GetPinholePackets(UniqueID uint16) (Pinho
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		PinholePackets string
-	}{}
+	response := &WANIPv6FirewallControl1GetPinholePacketsResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPv6FirewallControl_1, "GetPinholePackets", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetPinholePackets", request, response); err != nil {
 		return
 	}
 
@@ -4139,11 +6400,33 @@ func (client *WANIPv6FirewallControl1) GetPinholePackets(UniqueID uint16) (Pinho
 	return
 }
 
+// WANIPv6FirewallControl1CheckPinholeWorkingRequest is the request of CheckPinholeWorking, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANIPv6FirewallControl1CheckPinholeWorkingRequest struct {
+	UniqueID string
+}
+
+// WANIPv6FirewallControl1CheckPinholeWorkingResponse is the response of CheckPinholeWorking, with each
+// argument in its SOAP string form.
+type WANIPv6FirewallControl1CheckPinholeWorkingResponse struct {
+	IsWorking string
+}
+
+// CheckPinholeWorking performs the CheckPinholeWorking action of the service.
+//
+// Arguments:
+//   - UniqueID (in, state variable A_ARG_TYPE_CheckPinholeWorking_UniqueID)
+//
+// Return values:
+//   - IsWorking (out, state variable A_ARG_TYPE_CheckPinholeWorking_IsWorking)
 func (client *WANIPv6FirewallControl1) CheckPinholeWorking(UniqueID uint16) (IsWorking bool, err error) {
+	return client.CheckPinholeWorkingCtx(context.Background(), UniqueID)
+}
+
+// CheckPinholeWorkingCtx is CheckPinholeWorking with a context, to cancel or time out the call.
+func (client *WANIPv6FirewallControl1) CheckPinholeWorkingCtx(ctx context.Context, UniqueID uint16) (IsWorking bool, err error) {
 	// Request structure.
-	request := &struct {
-		UniqueID string
-	}{}
+	request := &WANIPv6FirewallControl1CheckPinholeWorkingRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.UniqueID, err = soap.MarshalUi2(UniqueID); err != nil {
@@ -4152,12 +6435,10 @@ func (client *WANIPv6FirewallControl1) CheckPinholeWorking(UniqueID uint16) (IsW
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		IsWorking string
-	}{}
+	response := &WANIPv6FirewallControl1CheckPinholeWorkingResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANIPv6FirewallControl_1, "CheckPinholeWorking", request, response); err != nil {
+	if err = client.PerformAction(ctx, "CheckPinholeWorking", request, response); err != nil {
 		return
 	}
 
@@ -4177,6 +6458,49 @@ type WANPOTSLinkConfig1 struct {
 	goupnp.ServiceClient
 }
 
+// WANPOTSLinkConfig1Client is the interface of the actions of WANPOTSLinkConfig1, for
+// substituting fakes or mocks for the service in tests.
+type WANPOTSLinkConfig1Client interface {
+	SetISPInfo(NewISPPhoneNumber string, NewISPInfo string, NewLinkType WANPOTSLinkConfig1LinkType) (err error)
+	SetISPInfoCtx(ctx context.Context, NewISPPhoneNumber string, NewISPInfo string, NewLinkType WANPOTSLinkConfig1LinkType) (err error)
+	SetCallRetryInfo(NewNumberOfRetries uint32, NewDelayBetweenRetries uint32) (err error)
+	SetCallRetryInfoCtx(ctx context.Context, NewNumberOfRetries uint32, NewDelayBetweenRetries uint32) (err error)
+	GetISPInfo() (NewISPPhoneNumber string, NewISPInfo string, NewLinkType WANPOTSLinkConfig1LinkType, err error)
+	GetISPInfoCtx(ctx context.Context) (NewISPPhoneNumber string, NewISPInfo string, NewLinkType WANPOTSLinkConfig1LinkType, err error)
+	GetCallRetryInfo() (NewNumberOfRetries uint32, NewDelayBetweenRetries uint32, err error)
+	GetCallRetryInfoCtx(ctx context.Context) (NewNumberOfRetries uint32, NewDelayBetweenRetries uint32, err error)
+	GetFclass() (NewFclass string, err error)
+	GetFclassCtx(ctx context.Context) (NewFclass string, err error)
+	GetDataModulationSupported() (NewDataModulationSupported string, err error)
+	GetDataModulationSupportedCtx(ctx context.Context) (NewDataModulationSupported string, err error)
+	GetDataProtocol() (NewDataProtocol string, err error)
+	GetDataProtocolCtx(ctx context.Context) (NewDataProtocol string, err error)
+	GetDataCompression() (NewDataCompression string, err error)
+	GetDataCompressionCtx(ctx context.Context) (NewDataCompression string, err error)
+	GetPlusVTRCommandSupported() (NewPlusVTRCommandSupported bool, err error)
+	GetPlusVTRCommandSupportedCtx(ctx context.Context) (NewPlusVTRCommandSupported bool, err error)
+}
+
+var _ WANPOTSLinkConfig1Client = new(WANPOTSLinkConfig1)
+
+// WANPOTSLinkConfig1LinkType is a value of the state variable LinkType of
+// WANPOTSLinkConfig1.
+type WANPOTSLinkConfig1LinkType string
+
+// Allowed values of WANPOTSLinkConfig1LinkType.
+const (
+	WANPOTSLinkConfig1LinkType_PPP_Dialup WANPOTSLinkConfig1LinkType = "PPP_Dialup"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v WANPOTSLinkConfig1LinkType) Valid() bool {
+	switch v {
+	case WANPOTSLinkConfig1LinkType_PPP_Dialup:
+		return true
+	}
+	return false
+}
+
 // NewWANPOTSLinkConfig1Clients discovers instances of the service on the network,
 // and returns clients to any that are found. errors will contain an error for
 // any devices that replied but which could not be queried, and err will be set
@@ -4230,20 +6554,46 @@ func newWANPOTSLinkConfig1ClientsFromGenericClients(genericClients []goupnp.Serv
 	return clients
 }
 
+// WANPOTSLinkConfig1Timeouts are the default timeouts of the actions of
+// WANPOTSLinkConfig1, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var WANPOTSLinkConfig1Timeouts = map[string]time.Duration{
+	"": 10 * time.Second,
+}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *WANPOTSLinkConfig1) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, WANPOTSLinkConfig1Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_WANPOTSLinkConfig_1, actionName, request, response)
+}
+
+// WANPOTSLinkConfig1SetISPInfoRequest is the request of SetISPInfo, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANPOTSLinkConfig1SetISPInfoRequest struct {
+	NewISPPhoneNumber string
+	NewISPInfo        string
+	NewLinkType       string
+}
+
+// SetISPInfo performs the SetISPInfo action of the service.
 //
 // Arguments:
-//
-// * NewLinkType: allowed values: PPP_Dialup
+//   - NewISPPhoneNumber (in, state variable A_ARG_TYPE_SetISPInfo_NewISPPhoneNumber)
+//   - NewISPInfo (in, state variable A_ARG_TYPE_SetISPInfo_NewISPInfo)
+//   - NewLinkType (in, state variable LinkType): allowed values PPP_Dialup
+func (client *WANPOTSLinkConfig1) SetISPInfo(NewISPPhoneNumber string, NewISPInfo string, NewLinkType WANPOTSLinkConfig1LinkType) (err error) {
+	return client.SetISPInfoCtx(context.Background(), NewISPPhoneNumber, NewISPInfo, NewLinkType)
+}
 
-func (client *WANPOTSLinkConfig1) SetISPInfo(NewISPPhoneNumber string, NewISPInfo string, NewLinkType string) (err error) {
+// SetISPInfoCtx is SetISPInfo with a context, to cancel or time out the call.
+func (client *WANPOTSLinkConfig1) SetISPInfoCtx(ctx context.Context, NewISPPhoneNumber string, NewISPInfo string, NewLinkType WANPOTSLinkConfig1LinkType) (err error) {
 	// Request structure.
-	request := &struct {
-		NewISPPhoneNumber string
-
-		NewISPInfo string
-
-		NewLinkType string
-	}{}
+	request := &WANPOTSLinkConfig1SetISPInfoRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewISPPhoneNumber, err = soap.MarshalString(NewISPPhoneNumber); err != nil {
@@ -4252,7 +6602,7 @@ func (client *WANPOTSLinkConfig1) SetISPInfo(NewISPPhoneNumber string, NewISPInf
 	if request.NewISPInfo, err = soap.MarshalString(NewISPInfo); err != nil {
 		return
 	}
-	if request.NewLinkType, err = soap.MarshalString(NewLinkType); err != nil {
+	if request.NewLinkType, err = soap.MarshalString(string(NewLinkType)); err != nil {
 		return
 	}
 	// END Marshal arguments into request.
@@ -4261,7 +6611,7 @@ func (client *WANPOTSLinkConfig1) SetISPInfo(NewISPPhoneNumber string, NewISPInf
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANPOTSLinkConfig_1, "SetISPInfo", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetISPInfo", request, response); err != nil {
 		return
 	}
 
@@ -4271,13 +6621,26 @@ func (client *WANPOTSLinkConfig1) SetISPInfo(NewISPPhoneNumber string, NewISPInf
 	return
 }
 
+// WANPOTSLinkConfig1SetCallRetryInfoRequest is the request of SetCallRetryInfo, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANPOTSLinkConfig1SetCallRetryInfoRequest struct {
+	NewNumberOfRetries     string
+	NewDelayBetweenRetries string
+}
+
+// SetCallRetryInfo performs the SetCallRetryInfo action of the service.
+//
+// Arguments:
+//   - NewNumberOfRetries (in, state variable A_ARG_TYPE_SetCallRetryInfo_NewNumberOfRetries)
+//   - NewDelayBetweenRetries (in, state variable A_ARG_TYPE_SetCallRetryInfo_NewDelayBetweenRetries)
 func (client *WANPOTSLinkConfig1) SetCallRetryInfo(NewNumberOfRetries uint32, NewDelayBetweenRetries uint32) (err error) {
-	// Request structure.
-	request := &struct {
-		NewNumberOfRetries string
+	return client.SetCallRetryInfoCtx(context.Background(), NewNumberOfRetries, NewDelayBetweenRetries)
+}
 
-		NewDelayBetweenRetries string
-	}{}
+// SetCallRetryInfoCtx is SetCallRetryInfo with a context, to cancel or time out the call.
+func (client *WANPOTSLinkConfig1) SetCallRetryInfoCtx(ctx context.Context, NewNumberOfRetries uint32, NewDelayBetweenRetries uint32) (err error) {
+	// Request structure.
+	request := &WANPOTSLinkConfig1SetCallRetryInfoRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewNumberOfRetries, err = soap.MarshalUi4(NewNumberOfRetries); err != nil {
@@ -4292,7 +6655,7 @@ func (client *WANPOTSLinkConfig1) SetCallRetryInfo(NewNumberOfRetries uint32, Ne
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANPOTSLinkConfig_1, "SetCallRetryInfo", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetCallRetryInfo", request, response); err != nil {
 		return
 	}
 
@@ -4302,11 +6665,26 @@ func (client *WANPOTSLinkConfig1) SetCallRetryInfo(NewNumberOfRetries uint32, Ne
 	return
 }
 
+// WANPOTSLinkConfig1GetISPInfoResponse is the response of GetISPInfo, with each
+// argument in its SOAP string form.
+type WANPOTSLinkConfig1GetISPInfoResponse struct {
+	NewISPPhoneNumber string
+	NewISPInfo        string
+	NewLinkType       string
+}
+
+// GetISPInfo performs the GetISPInfo action of the service.
 //
 // Return values:
-//
-// * NewLinkType: allowed values: PPP_Dialup
-func (client *WANPOTSLinkConfig1) GetISPInfo() (NewISPPhoneNumber string, NewISPInfo string, NewLinkType string, err error) {
+//   - NewISPPhoneNumber (out, state variable A_ARG_TYPE_GetISPInfo_NewISPPhoneNumber)
+//   - NewISPInfo (out, state variable A_ARG_TYPE_GetISPInfo_NewISPInfo)
+//   - NewLinkType (out, state variable LinkType): allowed values PPP_Dialup
+func (client *WANPOTSLinkConfig1) GetISPInfo() (NewISPPhoneNumber string, NewISPInfo string, NewLinkType WANPOTSLinkConfig1LinkType, err error) {
+	return client.GetISPInfoCtx(context.Background())
+}
+
+// GetISPInfoCtx is GetISPInfo with a context, to cancel or time out the call.
+func (client *WANPOTSLinkConfig1) GetISPInfoCtx(ctx context.Context) (NewISPPhoneNumber string, NewISPInfo string, NewLinkType WANPOTSLinkConfig1LinkType, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -4314,16 +6692,10 @@ func (client *WANPOTSLinkConfig1) GetISPInfo() (NewISPPhoneNumber string, NewISP
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewISPPhoneNumber string
-
-		NewISPInfo string
-
-		NewLinkType string
-	}{}
+	response := &WANPOTSLinkConfig1GetISPInfoResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANPOTSLinkConfig_1, "GetISPInfo", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetISPInfo", request, response); err != nil {
 		return
 	}
 
@@ -4335,14 +6707,29 @@ func (client *WANPOTSLinkConfig1) GetISPInfo() (NewISPPhoneNumber string, NewISP
 	if NewISPInfo, err = soap.UnmarshalString(response.NewISPInfo); err != nil {
 		return
 	}
-	if NewLinkType, err = soap.UnmarshalString(response.NewLinkType); err != nil {
-		return
-	}
+	NewLinkType = WANPOTSLinkConfig1LinkType(response.NewLinkType)
 	// END Unmarshal arguments from response.
 	return
 }
 
+// WANPOTSLinkConfig1GetCallRetryInfoResponse is the response of GetCallRetryInfo, with each
+// argument in its SOAP string form.
+type WANPOTSLinkConfig1GetCallRetryInfoResponse struct {
+	NewNumberOfRetries     string
+	NewDelayBetweenRetries string
+}
+
+// GetCallRetryInfo performs the GetCallRetryInfo action of the service.
+//
+// Return values:
+//   - NewNumberOfRetries (out, state variable A_ARG_TYPE_GetCallRetryInfo_NewNumberOfRetries)
+//   - NewDelayBetweenRetries (out, state variable A_ARG_TYPE_GetCallRetryInfo_NewDelayBetweenRetries)
 func (client *WANPOTSLinkConfig1) GetCallRetryInfo() (NewNumberOfRetries uint32, NewDelayBetweenRetries uint32, err error) {
+	return client.GetCallRetryInfoCtx(context.Background())
+}
+
+// GetCallRetryInfoCtx is GetCallRetryInfo with a context, to cancel or time out the call.
+func (client *WANPOTSLinkConfig1) GetCallRetryInfoCtx(ctx context.Context) (NewNumberOfRetries uint32, NewDelayBetweenRetries uint32, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -4350,14 +6737,10 @@ func (client *WANPOTSLinkConfig1) GetCallRetryInfo() (NewNumberOfRetries uint32,
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewNumberOfRetries string
-
-		NewDelayBetweenRetries string
-	}{}
+	response := &WANPOTSLinkConfig1GetCallRetryInfoResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANPOTSLinkConfig_1, "GetCallRetryInfo", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetCallRetryInfo", request, response); err != nil {
 		return
 	}
 
@@ -4373,7 +6756,22 @@ func (client *WANPOTSLinkConfig1) GetCallRetryInfo() (NewNumberOfRetries uint32,
 	return
 }
 
+// WANPOTSLinkConfig1GetFclassResponse is the response of GetFclass, with each
+// argument in its SOAP string form.
+type WANPOTSLinkConfig1GetFclassResponse struct {
+	NewFclass string
+}
+
+// GetFclass performs the GetFclass action of the service.
+//
+// Return values:
+//   - NewFclass (out, state variable A_ARG_TYPE_GetFclass_NewFclass)
 func (client *WANPOTSLinkConfig1) GetFclass() (NewFclass string, err error) {
+	return client.GetFclassCtx(context.Background())
+}
+
+// GetFclassCtx is GetFclass with a context, to cancel or time out the call.
+func (client *WANPOTSLinkConfig1) GetFclassCtx(ctx context.Context) (NewFclass string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -4381,12 +6779,10 @@ func (client *WANPOTSLinkConfig1) GetFclass() (NewFclass string, err error) {
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewFclass string
-	}{}
+	response := &WANPOTSLinkConfig1GetFclassResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANPOTSLinkConfig_1, "GetFclass", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetFclass", request, response); err != nil {
 		return
 	}
 
@@ -4399,7 +6795,22 @@ func (client *WANPOTSLinkConfig1) GetFclass() (NewFclass string, err error) {
 	return
 }
 
+// WANPOTSLinkConfig1GetDataModulationSupportedResponse is the response of GetDataModulationSupported, with each
+// argument in its SOAP string form.
+type WANPOTSLinkConfig1GetDataModulationSupportedResponse struct {
+	NewDataModulationSupported string
+}
+
+// GetDataModulationSupported performs the GetDataModulationSupported action of the service.
+//
+// Return values:
+//   - NewDataModulationSupported (out, state variable A_ARG_TYPE_GetDataModulationSupported_NewDataModulationSupported)
 func (client *WANPOTSLinkConfig1) GetDataModulationSupported() (NewDataModulationSupported string, err error) {
+	return client.GetDataModulationSupportedCtx(context.Background())
+}
+
+// GetDataModulationSupportedCtx is GetDataModulationSupported with a context, to cancel or time out the call.
+func (client *WANPOTSLinkConfig1) GetDataModulationSupportedCtx(ctx context.Context) (NewDataModulationSupported string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -4407,12 +6818,10 @@ func (client *WANPOTSLinkConfig1) GetDataModulationSupported() (NewDataModulatio
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewDataModulationSupported string
-	}{}
+	response := &WANPOTSLinkConfig1GetDataModulationSupportedResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANPOTSLinkConfig_1, "GetDataModulationSupported", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetDataModulationSupported", request, response); err != nil {
 		return
 	}
 
@@ -4425,7 +6834,22 @@ func (client *WANPOTSLinkConfig1) GetDataModulationSupported() (NewDataModulatio
 	return
 }
 
+// WANPOTSLinkConfig1GetDataProtocolResponse is the response of GetDataProtocol, with each
+// argument in its SOAP string form.
+type WANPOTSLinkConfig1GetDataProtocolResponse struct {
+	NewDataProtocol string
+}
+
+// GetDataProtocol performs the GetDataProtocol action of the service.
+//
+// Return values:
+//   - NewDataProtocol (out, state variable A_ARG_TYPE_GetDataProtocol_NewDataProtocol)
 func (client *WANPOTSLinkConfig1) GetDataProtocol() (NewDataProtocol string, err error) {
+	return client.GetDataProtocolCtx(context.Background())
+}
+
+// GetDataProtocolCtx is GetDataProtocol with a context, to cancel or time out the call.
+func (client *WANPOTSLinkConfig1) GetDataProtocolCtx(ctx context.Context) (NewDataProtocol string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -4433,12 +6857,10 @@ func (client *WANPOTSLinkConfig1) GetDataProtocol() (NewDataProtocol string, err
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewDataProtocol string
-	}{}
+	response := &WANPOTSLinkConfig1GetDataProtocolResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANPOTSLinkConfig_1, "GetDataProtocol", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetDataProtocol", request, response); err != nil {
 		return
 	}
 
@@ -4451,7 +6873,22 @@ func (client *WANPOTSLinkConfig1) GetDataProtocol() (NewDataProtocol string, err
 	return
 }
 
+// WANPOTSLinkConfig1GetDataCompressionResponse is the response of GetDataCompression, with each
+// argument in its SOAP string form.
+type WANPOTSLinkConfig1GetDataCompressionResponse struct {
+	NewDataCompression string
+}
+
+// GetDataCompression performs the GetDataCompression action of the service.
+//
+// Return values:
+//   - NewDataCompression (out, state variable A_ARG_TYPE_GetDataCompression_NewDataCompression)
 func (client *WANPOTSLinkConfig1) GetDataCompression() (NewDataCompression string, err error) {
+	return client.GetDataCompressionCtx(context.Background())
+}
+
+// GetDataCompressionCtx is GetDataCompression with a context, to cancel or time out the call.
+func (client *WANPOTSLinkConfig1) GetDataCompressionCtx(ctx context.Context) (NewDataCompression string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -4459,12 +6896,10 @@ func (client *WANPOTSLinkConfig1) GetDataCompression() (NewDataCompression strin
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewDataCompression string
-	}{}
+	response := &WANPOTSLinkConfig1GetDataCompressionResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANPOTSLinkConfig_1, "GetDataCompression", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetDataCompression", request, response); err != nil {
 		return
 	}
 
@@ -4477,7 +6912,22 @@ func (client *WANPOTSLinkConfig1) GetDataCompression() (NewDataCompression strin
 	return
 }
 
+// WANPOTSLinkConfig1GetPlusVTRCommandSupportedResponse is the response of GetPlusVTRCommandSupported, with each
+// argument in its SOAP string form.
+type WANPOTSLinkConfig1GetPlusVTRCommandSupportedResponse struct {
+	NewPlusVTRCommandSupported string
+}
+
+// GetPlusVTRCommandSupported performs the GetPlusVTRCommandSupported action of the service.
+//
+// Return values:
+//   - NewPlusVTRCommandSupported (out, state variable A_ARG_TYPE_GetPlusVTRCommandSupported_NewPlusVTRCommandSupported)
 func (client *WANPOTSLinkConfig1) GetPlusVTRCommandSupported() (NewPlusVTRCommandSupported bool, err error) {
+	return client.GetPlusVTRCommandSupportedCtx(context.Background())
+}
+
+// GetPlusVTRCommandSupportedCtx is GetPlusVTRCommandSupported with a context, to cancel or time out the call.
+func (client *WANPOTSLinkConfig1) GetPlusVTRCommandSupportedCtx(ctx context.Context) (NewPlusVTRCommandSupported bool, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -4485,12 +6935,10 @@ func (client *WANPOTSLinkConfig1) GetPlusVTRCommandSupported() (NewPlusVTRComman
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewPlusVTRCommandSupported string
-	}{}
+	response := &WANPOTSLinkConfig1GetPlusVTRCommandSupportedResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANPOTSLinkConfig_1, "GetPlusVTRCommandSupported", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetPlusVTRCommandSupported", request, response); err != nil {
 		return
 	}
 
@@ -4510,6 +6958,153 @@ type WANPPPConnection1 struct {
 	goupnp.ServiceClient
 }
 
+// WANPPPConnection1Client is the interface of the actions of WANPPPConnection1, for
+// substituting fakes or mocks for the service in tests.
+type WANPPPConnection1Client interface {
+	SetConnectionType(NewConnectionType string) (err error)
+	SetConnectionTypeCtx(ctx context.Context, NewConnectionType string) (err error)
+	GetConnectionTypeInfo() (NewConnectionType string, NewPossibleConnectionTypes WANPPPConnection1PossibleConnectionTypes, err error)
+	GetConnectionTypeInfoCtx(ctx context.Context) (NewConnectionType string, NewPossibleConnectionTypes WANPPPConnection1PossibleConnectionTypes, err error)
+	ConfigureConnection(NewUserName string, NewPassword string) (err error)
+	ConfigureConnectionCtx(ctx context.Context, NewUserName string, NewPassword string) (err error)
+	RequestConnection() (err error)
+	RequestConnectionCtx(ctx context.Context) (err error)
+	RequestTermination() (err error)
+	RequestTerminationCtx(ctx context.Context) (err error)
+	ForceTermination() (err error)
+	ForceTerminationCtx(ctx context.Context) (err error)
+	SetAutoDisconnectTime(NewAutoDisconnectTime uint32) (err error)
+	SetAutoDisconnectTimeCtx(ctx context.Context, NewAutoDisconnectTime uint32) (err error)
+	SetIdleDisconnectTime(NewIdleDisconnectTime uint32) (err error)
+	SetIdleDisconnectTimeCtx(ctx context.Context, NewIdleDisconnectTime uint32) (err error)
+	SetWarnDisconnectDelay(NewWarnDisconnectDelay uint32) (err error)
+	SetWarnDisconnectDelayCtx(ctx context.Context, NewWarnDisconnectDelay uint32) (err error)
+	GetStatusInfo() (NewConnectionStatus WANPPPConnection1ConnectionStatus, NewLastConnectionError WANPPPConnection1LastConnectionError, NewUptime uint32, err error)
+	GetStatusInfoCtx(ctx context.Context) (NewConnectionStatus WANPPPConnection1ConnectionStatus, NewLastConnectionError WANPPPConnection1LastConnectionError, NewUptime uint32, err error)
+	GetLinkLayerMaxBitRates() (NewUpstreamMaxBitRate uint32, NewDownstreamMaxBitRate uint32, err error)
+	GetLinkLayerMaxBitRatesCtx(ctx context.Context) (NewUpstreamMaxBitRate uint32, NewDownstreamMaxBitRate uint32, err error)
+	GetPPPEncryptionProtocol() (NewPPPEncryptionProtocol string, err error)
+	GetPPPEncryptionProtocolCtx(ctx context.Context) (NewPPPEncryptionProtocol string, err error)
+	GetPPPCompressionProtocol() (NewPPPCompressionProtocol string, err error)
+	GetPPPCompressionProtocolCtx(ctx context.Context) (NewPPPCompressionProtocol string, err error)
+	GetPPPAuthenticationProtocol() (NewPPPAuthenticationProtocol string, err error)
+	GetPPPAuthenticationProtocolCtx(ctx context.Context) (NewPPPAuthenticationProtocol string, err error)
+	GetUserName() (NewUserName string, err error)
+	GetUserNameCtx(ctx context.Context) (NewUserName string, err error)
+	GetPassword() (NewPassword string, err error)
+	GetPasswordCtx(ctx context.Context) (NewPassword string, err error)
+	GetAutoDisconnectTime() (NewAutoDisconnectTime uint32, err error)
+	GetAutoDisconnectTimeCtx(ctx context.Context) (NewAutoDisconnectTime uint32, err error)
+	GetIdleDisconnectTime() (NewIdleDisconnectTime uint32, err error)
+	GetIdleDisconnectTimeCtx(ctx context.Context) (NewIdleDisconnectTime uint32, err error)
+	GetWarnDisconnectDelay() (NewWarnDisconnectDelay uint32, err error)
+	GetWarnDisconnectDelayCtx(ctx context.Context) (NewWarnDisconnectDelay uint32, err error)
+	GetNATRSIPStatus() (NewRSIPAvailable bool, NewNATEnabled bool, err error)
+	GetNATRSIPStatusCtx(ctx context.Context) (NewRSIPAvailable bool, NewNATEnabled bool, err error)
+	GetGenericPortMappingEntry(NewPortMappingIndex uint16) (NewRemoteHost string, NewExternalPort uint16, NewProtocol WANPPPConnection1PortMappingProtocol, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32, err error)
+	GetGenericPortMappingEntryCtx(ctx context.Context, NewPortMappingIndex uint16) (NewRemoteHost string, NewExternalPort uint16, NewProtocol WANPPPConnection1PortMappingProtocol, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32, err error)
+	GetSpecificPortMappingEntry(NewRemoteHost string, NewExternalPort uint16, NewProtocol WANPPPConnection1PortMappingProtocol) (NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32, err error)
+	GetSpecificPortMappingEntryCtx(ctx context.Context, NewRemoteHost string, NewExternalPort uint16, NewProtocol WANPPPConnection1PortMappingProtocol) (NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32, err error)
+	AddPortMapping(NewRemoteHost string, NewExternalPort uint16, NewProtocol WANPPPConnection1PortMappingProtocol, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32) (err error)
+	AddPortMappingCtx(ctx context.Context, NewRemoteHost string, NewExternalPort uint16, NewProtocol WANPPPConnection1PortMappingProtocol, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32) (err error)
+	DeletePortMapping(NewRemoteHost string, NewExternalPort uint16, NewProtocol WANPPPConnection1PortMappingProtocol) (err error)
+	DeletePortMappingCtx(ctx context.Context, NewRemoteHost string, NewExternalPort uint16, NewProtocol WANPPPConnection1PortMappingProtocol) (err error)
+	GetExternalIPAddress() (NewExternalIPAddress string, err error)
+	GetExternalIPAddressCtx(ctx context.Context) (NewExternalIPAddress string, err error)
+}
+
+var _ WANPPPConnection1Client = new(WANPPPConnection1)
+
+// WANPPPConnection1PossibleConnectionTypes is a value of the state variable PossibleConnectionTypes of
+// WANPPPConnection1.
+type WANPPPConnection1PossibleConnectionTypes string
+
+// Allowed values of WANPPPConnection1PossibleConnectionTypes.
+const (
+	WANPPPConnection1PossibleConnectionTypes_Unconfigured  WANPPPConnection1PossibleConnectionTypes = "Unconfigured"
+	WANPPPConnection1PossibleConnectionTypes_IP_Routed     WANPPPConnection1PossibleConnectionTypes = "IP_Routed"
+	WANPPPConnection1PossibleConnectionTypes_DHCP_Spoofed  WANPPPConnection1PossibleConnectionTypes = "DHCP_Spoofed"
+	WANPPPConnection1PossibleConnectionTypes_PPPoE_Bridged WANPPPConnection1PossibleConnectionTypes = "PPPoE_Bridged"
+	WANPPPConnection1PossibleConnectionTypes_PPTP_Relay    WANPPPConnection1PossibleConnectionTypes = "PPTP_Relay"
+	WANPPPConnection1PossibleConnectionTypes_L2TP_Relay    WANPPPConnection1PossibleConnectionTypes = "L2TP_Relay"
+	WANPPPConnection1PossibleConnectionTypes_PPPoE_Relay   WANPPPConnection1PossibleConnectionTypes = "PPPoE_Relay"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v WANPPPConnection1PossibleConnectionTypes) Valid() bool {
+	switch v {
+	case WANPPPConnection1PossibleConnectionTypes_Unconfigured,
+		WANPPPConnection1PossibleConnectionTypes_IP_Routed,
+		WANPPPConnection1PossibleConnectionTypes_DHCP_Spoofed,
+		WANPPPConnection1PossibleConnectionTypes_PPPoE_Bridged,
+		WANPPPConnection1PossibleConnectionTypes_PPTP_Relay,
+		WANPPPConnection1PossibleConnectionTypes_L2TP_Relay,
+		WANPPPConnection1PossibleConnectionTypes_PPPoE_Relay:
+		return true
+	}
+	return false
+}
+
+// WANPPPConnection1ConnectionStatus is a value of the state variable ConnectionStatus of
+// WANPPPConnection1.
+type WANPPPConnection1ConnectionStatus string
+
+// Allowed values of WANPPPConnection1ConnectionStatus.
+const (
+	WANPPPConnection1ConnectionStatus_Unconfigured WANPPPConnection1ConnectionStatus = "Unconfigured"
+	WANPPPConnection1ConnectionStatus_Connected    WANPPPConnection1ConnectionStatus = "Connected"
+	WANPPPConnection1ConnectionStatus_Disconnected WANPPPConnection1ConnectionStatus = "Disconnected"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v WANPPPConnection1ConnectionStatus) Valid() bool {
+	switch v {
+	case WANPPPConnection1ConnectionStatus_Unconfigured,
+		WANPPPConnection1ConnectionStatus_Connected,
+		WANPPPConnection1ConnectionStatus_Disconnected:
+		return true
+	}
+	return false
+}
+
+// WANPPPConnection1LastConnectionError is a value of the state variable LastConnectionError of
+// WANPPPConnection1.
+type WANPPPConnection1LastConnectionError string
+
+// Allowed values of WANPPPConnection1LastConnectionError.
+const (
+	WANPPPConnection1LastConnectionError_ERROR_NONE WANPPPConnection1LastConnectionError = "ERROR_NONE"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v WANPPPConnection1LastConnectionError) Valid() bool {
+	switch v {
+	case WANPPPConnection1LastConnectionError_ERROR_NONE:
+		return true
+	}
+	return false
+}
+
+// WANPPPConnection1PortMappingProtocol is a value of the state variable PortMappingProtocol of
+// WANPPPConnection1.
+type WANPPPConnection1PortMappingProtocol string
+
+// Allowed values of WANPPPConnection1PortMappingProtocol.
+const (
+	WANPPPConnection1PortMappingProtocol_TCP WANPPPConnection1PortMappingProtocol = "TCP"
+	WANPPPConnection1PortMappingProtocol_UDP WANPPPConnection1PortMappingProtocol = "UDP"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v WANPPPConnection1PortMappingProtocol) Valid() bool {
+	switch v {
+	case WANPPPConnection1PortMappingProtocol_TCP,
+		WANPPPConnection1PortMappingProtocol_UDP:
+		return true
+	}
+	return false
+}
+
 // NewWANPPPConnection1Clients discovers instances of the service on the network,
 // and returns clients to any that are found. errors will contain an error for
 // any devices that replied but which could not be queried, and err will be set
@@ -4563,11 +7158,42 @@ func newWANPPPConnection1ClientsFromGenericClients(genericClients []goupnp.Servi
 	return clients
 }
 
+// WANPPPConnection1Timeouts are the default timeouts of the actions of
+// WANPPPConnection1, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var WANPPPConnection1Timeouts = map[string]time.Duration{
+	"": 10 * time.Second,
+}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *WANPPPConnection1) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, WANPPPConnection1Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_WANPPPConnection_1, actionName, request, response)
+}
+
+// WANPPPConnection1SetConnectionTypeRequest is the request of SetConnectionType, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANPPPConnection1SetConnectionTypeRequest struct {
+	NewConnectionType string
+}
+
+// SetConnectionType performs the SetConnectionType action of the service.
+//
+// Arguments:
+//   - NewConnectionType (in, state variable A_ARG_TYPE_SetConnectionType_NewConnectionType)
 func (client *WANPPPConnection1) SetConnectionType(NewConnectionType string) (err error) {
+	return client.SetConnectionTypeCtx(context.Background(), NewConnectionType)
+}
+
+// SetConnectionTypeCtx is SetConnectionType with a context, to cancel or time out the call.
+func (client *WANPPPConnection1) SetConnectionTypeCtx(ctx context.Context, NewConnectionType string) (err error) {
 	// Request structure.
-	request := &struct {
-		NewConnectionType string
-	}{}
+	request := &WANPPPConnection1SetConnectionTypeRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewConnectionType, err = soap.MarshalString(NewConnectionType); err != nil {
@@ -4579,7 +7205,7 @@ func (client *WANPPPConnection1) SetConnectionType(NewConnectionType string) (er
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANPPPConnection_1, "SetConnectionType", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetConnectionType", request, response); err != nil {
 		return
 	}
 
@@ -4589,11 +7215,24 @@ func (client *WANPPPConnection1) SetConnectionType(NewConnectionType string) (er
 	return
 }
 
+// WANPPPConnection1GetConnectionTypeInfoResponse is the response of GetConnectionTypeInfo, with each
+// argument in its SOAP string form.
+type WANPPPConnection1GetConnectionTypeInfoResponse struct {
+	NewConnectionType          string
+	NewPossibleConnectionTypes string
+}
+
+// GetConnectionTypeInfo performs the GetConnectionTypeInfo action of the service.
 //
 // Return values:
-//
-// * NewPossibleConnectionTypes: allowed values: Unconfigured, IP_Routed, DHCP_Spoofed, PPPoE_Bridged, PPTP_Relay, L2TP_Relay, PPPoE_Relay
-func (client *WANPPPConnection1) GetConnectionTypeInfo() (NewConnectionType string, NewPossibleConnectionTypes string, err error) {
+//   - NewConnectionType (out, state variable A_ARG_TYPE_GetConnectionTypeInfo_NewConnectionType)
+//   - NewPossibleConnectionTypes (out, state variable PossibleConnectionTypes): allowed values Unconfigured, IP_Routed, DHCP_Spoofed, PPPoE_Bridged, PPTP_Relay, L2TP_Relay, PPPoE_Relay
+func (client *WANPPPConnection1) GetConnectionTypeInfo() (NewConnectionType string, NewPossibleConnectionTypes WANPPPConnection1PossibleConnectionTypes, err error) {
+	return client.GetConnectionTypeInfoCtx(context.Background())
+}
+
+// GetConnectionTypeInfoCtx is GetConnectionTypeInfo with a context, to cancel or time out the call.
+func (client *WANPPPConnection1) GetConnectionTypeInfoCtx(ctx context.Context) (NewConnectionType string, NewPossibleConnectionTypes WANPPPConnection1PossibleConnectionTypes, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -4601,14 +7240,10 @@ func (client *WANPPPConnection1) GetConnectionTypeInfo() (NewConnectionType stri
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewConnectionType string
-
-		NewPossibleConnectionTypes string
-	}{}
+	response := &WANPPPConnection1GetConnectionTypeInfoResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANPPPConnection_1, "GetConnectionTypeInfo", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetConnectionTypeInfo", request, response); err != nil {
 		return
 	}
 
@@ -4617,20 +7252,31 @@ func (client *WANPPPConnection1) GetConnectionTypeInfo() (NewConnectionType stri
 	if NewConnectionType, err = soap.UnmarshalString(response.NewConnectionType); err != nil {
 		return
 	}
-	if NewPossibleConnectionTypes, err = soap.UnmarshalString(response.NewPossibleConnectionTypes); err != nil {
-		return
-	}
+	NewPossibleConnectionTypes = WANPPPConnection1PossibleConnectionTypes(response.NewPossibleConnectionTypes)
 	// END Unmarshal arguments from response.
 	return
 }
 
+// WANPPPConnection1ConfigureConnectionRequest is the request of ConfigureConnection, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANPPPConnection1ConfigureConnectionRequest struct {
+	NewUserName string
+	NewPassword string
+}
+
+// ConfigureConnection performs the ConfigureConnection action of the service.
+//
+// Arguments:
+//   - NewUserName (in, state variable A_ARG_TYPE_ConfigureConnection_NewUserName)
+//   - NewPassword (in, state variable A_ARG_TYPE_ConfigureConnection_NewPassword)
 func (client *WANPPPConnection1) ConfigureConnection(NewUserName string, NewPassword string) (err error) {
-	// Request structure.
-	request := &struct {
-		NewUserName string
+	return client.ConfigureConnectionCtx(context.Background(), NewUserName, NewPassword)
+}
 
-		NewPassword string
-	}{}
+// ConfigureConnectionCtx is ConfigureConnection with a context, to cancel or time out the call.
+func (client *WANPPPConnection1) ConfigureConnectionCtx(ctx context.Context, NewUserName string, NewPassword string) (err error) {
+	// Request structure.
+	request := &WANPPPConnection1ConfigureConnectionRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewUserName, err = soap.MarshalString(NewUserName); err != nil {
@@ -4645,7 +7291,7 @@ func (client *WANPPPConnection1) ConfigureConnection(NewUserName string, NewPass
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANPPPConnection_1, "ConfigureConnection", request, response); err != nil {
+	if err = client.PerformAction(ctx, "ConfigureConnection", request, response); err != nil {
 		return
 	}
 
@@ -4655,7 +7301,13 @@ func (client *WANPPPConnection1) ConfigureConnection(NewUserName string, NewPass
 	return
 }
 
+// RequestConnection performs the RequestConnection action of the service.
 func (client *WANPPPConnection1) RequestConnection() (err error) {
+	return client.RequestConnectionCtx(context.Background())
+}
+
+// RequestConnectionCtx is RequestConnection with a context, to cancel or time out the call.
+func (client *WANPPPConnection1) RequestConnectionCtx(ctx context.Context) (err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -4666,7 +7318,7 @@ func (client *WANPPPConnection1) RequestConnection() (err error) {
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANPPPConnection_1, "RequestConnection", request, response); err != nil {
+	if err = client.PerformAction(ctx, "RequestConnection", request, response); err != nil {
 		return
 	}
 
@@ -4676,7 +7328,13 @@ func (client *WANPPPConnection1) RequestConnection() (err error) {
 	return
 }
 
+// RequestTermination performs the RequestTermination action of the service.
 func (client *WANPPPConnection1) RequestTermination() (err error) {
+	return client.RequestTerminationCtx(context.Background())
+}
+
+// RequestTerminationCtx is RequestTermination with a context, to cancel or time out the call.
+func (client *WANPPPConnection1) RequestTerminationCtx(ctx context.Context) (err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -4687,7 +7345,7 @@ func (client *WANPPPConnection1) RequestTermination() (err error) {
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANPPPConnection_1, "RequestTermination", request, response); err != nil {
+	if err = client.PerformAction(ctx, "RequestTermination", request, response); err != nil {
 		return
 	}
 
@@ -4697,7 +7355,13 @@ func (client *WANPPPConnection1) RequestTermination() (err error) {
 	return
 }
 
+// ForceTermination performs the ForceTermination action of the service.
 func (client *WANPPPConnection1) ForceTermination() (err error) {
+	return client.ForceTerminationCtx(context.Background())
+}
+
+// ForceTerminationCtx is ForceTermination with a context, to cancel or time out the call.
+func (client *WANPPPConnection1) ForceTerminationCtx(ctx context.Context) (err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -4708,7 +7372,7 @@ func (client *WANPPPConnection1) ForceTermination() (err error) {
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANPPPConnection_1, "ForceTermination", request, response); err != nil {
+	if err = client.PerformAction(ctx, "ForceTermination", request, response); err != nil {
 		return
 	}
 
@@ -4718,11 +7382,24 @@ func (client *WANPPPConnection1) ForceTermination() (err error) {
 	return
 }
 
+// WANPPPConnection1SetAutoDisconnectTimeRequest is the request of SetAutoDisconnectTime, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANPPPConnection1SetAutoDisconnectTimeRequest struct {
+	NewAutoDisconnectTime string
+}
+
+// SetAutoDisconnectTime performs the SetAutoDisconnectTime action of the service.
+//
+// Arguments:
+//   - NewAutoDisconnectTime (in, state variable A_ARG_TYPE_SetAutoDisconnectTime_NewAutoDisconnectTime)
 func (client *WANPPPConnection1) SetAutoDisconnectTime(NewAutoDisconnectTime uint32) (err error) {
+	return client.SetAutoDisconnectTimeCtx(context.Background(), NewAutoDisconnectTime)
+}
+
+// SetAutoDisconnectTimeCtx is SetAutoDisconnectTime with a context, to cancel or time out the call.
+func (client *WANPPPConnection1) SetAutoDisconnectTimeCtx(ctx context.Context, NewAutoDisconnectTime uint32) (err error) {
 	// Request structure.
-	request := &struct {
-		NewAutoDisconnectTime string
-	}{}
+	request := &WANPPPConnection1SetAutoDisconnectTimeRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewAutoDisconnectTime, err = soap.MarshalUi4(NewAutoDisconnectTime); err != nil {
@@ -4734,7 +7411,7 @@ func (client *WANPPPConnection1) SetAutoDisconnectTime(NewAutoDisconnectTime uin
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANPPPConnection_1, "SetAutoDisconnectTime", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetAutoDisconnectTime", request, response); err != nil {
 		return
 	}
 
@@ -4744,11 +7421,24 @@ func (client *WANPPPConnection1) SetAutoDisconnectTime(NewAutoDisconnectTime uin
 	return
 }
 
+// WANPPPConnection1SetIdleDisconnectTimeRequest is the request of SetIdleDisconnectTime, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANPPPConnection1SetIdleDisconnectTimeRequest struct {
+	NewIdleDisconnectTime string
+}
+
+// SetIdleDisconnectTime performs the SetIdleDisconnectTime action of the service.
+//
+// Arguments:
+//   - NewIdleDisconnectTime (in, state variable A_ARG_TYPE_SetIdleDisconnectTime_NewIdleDisconnectTime)
 func (client *WANPPPConnection1) SetIdleDisconnectTime(NewIdleDisconnectTime uint32) (err error) {
+	return client.SetIdleDisconnectTimeCtx(context.Background(), NewIdleDisconnectTime)
+}
+
+// SetIdleDisconnectTimeCtx is SetIdleDisconnectTime with a context, to cancel or time out the call.
+func (client *WANPPPConnection1) SetIdleDisconnectTimeCtx(ctx context.Context, NewIdleDisconnectTime uint32) (err error) {
 	// Request structure.
-	request := &struct {
-		NewIdleDisconnectTime string
-	}{}
+	request := &WANPPPConnection1SetIdleDisconnectTimeRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewIdleDisconnectTime, err = soap.MarshalUi4(NewIdleDisconnectTime); err != nil {
@@ -4760,7 +7450,7 @@ func (client *WANPPPConnection1) SetIdleDisconnectTime(NewIdleDisconnectTime uin
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANPPPConnection_1, "SetIdleDisconnectTime", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetIdleDisconnectTime", request, response); err != nil {
 		return
 	}
 
@@ -4770,11 +7460,24 @@ func (client *WANPPPConnection1) SetIdleDisconnectTime(NewIdleDisconnectTime uin
 	return
 }
 
+// WANPPPConnection1SetWarnDisconnectDelayRequest is the request of SetWarnDisconnectDelay, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANPPPConnection1SetWarnDisconnectDelayRequest struct {
+	NewWarnDisconnectDelay string
+}
+
+// SetWarnDisconnectDelay performs the SetWarnDisconnectDelay action of the service.
+//
+// Arguments:
+//   - NewWarnDisconnectDelay (in, state variable A_ARG_TYPE_SetWarnDisconnectDelay_NewWarnDisconnectDelay)
 func (client *WANPPPConnection1) SetWarnDisconnectDelay(NewWarnDisconnectDelay uint32) (err error) {
+	return client.SetWarnDisconnectDelayCtx(context.Background(), NewWarnDisconnectDelay)
+}
+
+// SetWarnDisconnectDelayCtx is SetWarnDisconnectDelay with a context, to cancel or time out the call.
+func (client *WANPPPConnection1) SetWarnDisconnectDelayCtx(ctx context.Context, NewWarnDisconnectDelay uint32) (err error) {
 	// Request structure.
-	request := &struct {
-		NewWarnDisconnectDelay string
-	}{}
+	request := &WANPPPConnection1SetWarnDisconnectDelayRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewWarnDisconnectDelay, err = soap.MarshalUi4(NewWarnDisconnectDelay); err != nil {
@@ -4786,7 +7489,7 @@ func (client *WANPPPConnection1) SetWarnDisconnectDelay(NewWarnDisconnectDelay u
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANPPPConnection_1, "SetWarnDisconnectDelay", request, response); err != nil {
+	if err = client.PerformAction(ctx, "SetWarnDisconnectDelay", request, response); err != nil {
 		return
 	}
 
@@ -4796,13 +7499,29 @@ func (client *WANPPPConnection1) SetWarnDisconnectDelay(NewWarnDisconnectDelay u
 	return
 }
 
+// WANPPPConnection1GetStatusInfoResponse is the response of GetStatusInfo, with each
+// argument in its SOAP string form.
+type WANPPPConnection1GetStatusInfoResponse struct {
+	NewConnectionStatus    string
+	NewLastConnectionError string
+	NewUptime              string
+}
+
+// GetStatusInfo performs the GetStatusInfo action of the service.
 //
-// Return values:
-//
-// * NewConnectionStatus: allowed values: Unconfigured, Connected, Disconnected
+// Returns the status of the connection, the cause of the last failure to
+// connect, and the number of seconds for which the connection has been up.
 //
-// * NewLastConnectionError: allowed values: ERROR_NONE
-func (client *WANPPPConnection1) GetStatusInfo() (NewConnectionStatus string, NewLastConnectionError string, NewUptime uint32, err error) {
+// Return values:
+//   - NewConnectionStatus (out, state variable ConnectionStatus): allowed values Unconfigured, Connected, Disconnected
+//   - NewLastConnectionError (out, state variable LastConnectionError): allowed values ERROR_NONE
+//   - NewUptime (out, state variable A_ARG_TYPE_GetStatusInfo_NewUptime)
+func (client *WANPPPConnection1) GetStatusInfo() (NewConnectionStatus WANPPPConnection1ConnectionStatus, NewLastConnectionError WANPPPConnection1LastConnectionError, NewUptime uint32, err error) {
+	return client.GetStatusInfoCtx(context.Background())
+}
+
+// GetStatusInfoCtx is GetStatusInfo with a context, to cancel or time out the call.
+func (client *WANPPPConnection1) GetStatusInfoCtx(ctx context.Context) (NewConnectionStatus WANPPPConnection1ConnectionStatus, NewLastConnectionError WANPPPConnection1LastConnectionError, NewUptime uint32, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -4810,27 +7529,17 @@ func (client *WANPPPConnection1) GetStatusInfo() (NewConnectionStatus string, Ne
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewConnectionStatus string
-
-		NewLastConnectionError string
-
-		NewUptime string
-	}{}
+	response := &WANPPPConnection1GetStatusInfoResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANPPPConnection_1, "GetStatusInfo", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetStatusInfo", request, response); err != nil {
 		return
 	}
 
 	// BEGIN Unmarshal arguments from response.
 
-	if NewConnectionStatus, err = soap.UnmarshalString(response.NewConnectionStatus); err != nil {
-		return
-	}
-	if NewLastConnectionError, err = soap.UnmarshalString(response.NewLastConnectionError); err != nil {
-		return
-	}
+	NewConnectionStatus = WANPPPConnection1ConnectionStatus(response.NewConnectionStatus)
+	NewLastConnectionError = WANPPPConnection1LastConnectionError(response.NewLastConnectionError)
 	if NewUptime, err = soap.UnmarshalUi4(response.NewUptime); err != nil {
 		return
 	}
@@ -4838,7 +7547,24 @@ func (client *WANPPPConnection1) GetStatusInfo() (NewConnectionStatus string, Ne
 	return
 }
 
+// WANPPPConnection1GetLinkLayerMaxBitRatesResponse is the response of GetLinkLayerMaxBitRates, with each
+// argument in its SOAP string form.
+type WANPPPConnection1GetLinkLayerMaxBitRatesResponse struct {
+	NewUpstreamMaxBitRate   string
+	NewDownstreamMaxBitRate string
+}
+
+// GetLinkLayerMaxBitRates performs the GetLinkLayerMaxBitRates action of the service.
+//
+// Return values:
+//   - NewUpstreamMaxBitRate (out, state variable A_ARG_TYPE_GetLinkLayerMaxBitRates_NewUpstreamMaxBitRate)
+//   - NewDownstreamMaxBitRate (out, state variable A_ARG_TYPE_GetLinkLayerMaxBitRates_NewDownstreamMaxBitRate)
 func (client *WANPPPConnection1) GetLinkLayerMaxBitRates() (NewUpstreamMaxBitRate uint32, NewDownstreamMaxBitRate uint32, err error) {
+	return client.GetLinkLayerMaxBitRatesCtx(context.Background())
+}
+
+// GetLinkLayerMaxBitRatesCtx is GetLinkLayerMaxBitRates with a context, to cancel or time out the call.
+func (client *WANPPPConnection1) GetLinkLayerMaxBitRatesCtx(ctx context.Context) (NewUpstreamMaxBitRate uint32, NewDownstreamMaxBitRate uint32, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -4846,14 +7572,10 @@ func (client *WANPPPConnection1) GetLinkLayerMaxBitRates() (NewUpstreamMaxBitRat
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewUpstreamMaxBitRate string
-
-		NewDownstreamMaxBitRate string
-	}{}
+	response := &WANPPPConnection1GetLinkLayerMaxBitRatesResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANPPPConnection_1, "GetLinkLayerMaxBitRates", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetLinkLayerMaxBitRates", request, response); err != nil {
 		return
 	}
 
@@ -4869,7 +7591,22 @@ func (client *WANPPPConnection1) GetLinkLayerMaxBitRates() (NewUpstreamMaxBitRat
 	return
 }
 
+// WANPPPConnection1GetPPPEncryptionProtocolResponse is the response of GetPPPEncryptionProtocol, with each
+// argument in its SOAP string form.
+type WANPPPConnection1GetPPPEncryptionProtocolResponse struct {
+	NewPPPEncryptionProtocol string
+}
+
+// GetPPPEncryptionProtocol performs the GetPPPEncryptionProtocol action of the service.
+//
+// Return values:
+//   - NewPPPEncryptionProtocol (out, state variable A_ARG_TYPE_GetPPPEncryptionProtocol_NewPPPEncryptionProtocol)
 func (client *WANPPPConnection1) GetPPPEncryptionProtocol() (NewPPPEncryptionProtocol string, err error) {
+	return client.GetPPPEncryptionProtocolCtx(context.Background())
+}
+
+// GetPPPEncryptionProtocolCtx is GetPPPEncryptionProtocol with a context, to cancel or time out the call.
+func (client *WANPPPConnection1) GetPPPEncryptionProtocolCtx(ctx context.Context) (NewPPPEncryptionProtocol string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -4877,12 +7614,10 @@ func (client *WANPPPConnection1) GetPPPEncryptionProtocol() (NewPPPEncryptionPro
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewPPPEncryptionProtocol string
-	}{}
+	response := &WANPPPConnection1GetPPPEncryptionProtocolResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANPPPConnection_1, "GetPPPEncryptionProtocol", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetPPPEncryptionProtocol", request, response); err != nil {
 		return
 	}
 
@@ -4895,7 +7630,22 @@ func (client *WANPPPConnection1) GetPPPEncryptionProtocol() (NewPPPEncryptionPro
 	return
 }
 
+// WANPPPConnection1GetPPPCompressionProtocolResponse is the response of GetPPPCompressionProtocol, with each
+// argument in its SOAP string form.
+type WANPPPConnection1GetPPPCompressionProtocolResponse struct {
+	NewPPPCompressionProtocol string
+}
+
+// GetPPPCompressionProtocol performs the GetPPPCompressionProtocol action of the service.
+//
+// Return values:
+//   - NewPPPCompressionProtocol (out, state variable A_ARG_TYPE_GetPPPCompressionProtocol_NewPPPCompressionProtocol)
 func (client *WANPPPConnection1) GetPPPCompressionProtocol() (NewPPPCompressionProtocol string, err error) {
+	return client.GetPPPCompressionProtocolCtx(context.Background())
+}
+
+// GetPPPCompressionProtocolCtx is GetPPPCompressionProtocol with a context, to cancel or time out the call.
+func (client *WANPPPConnection1) GetPPPCompressionProtocolCtx(ctx context.Context) (NewPPPCompressionProtocol string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -4903,12 +7653,10 @@ func (client *WANPPPConnection1) GetPPPCompressionProtocol() (NewPPPCompressionP
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewPPPCompressionProtocol string
-	}{}
+	response := &WANPPPConnection1GetPPPCompressionProtocolResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANPPPConnection_1, "GetPPPCompressionProtocol", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetPPPCompressionProtocol", request, response); err != nil {
 		return
 	}
 
@@ -4921,7 +7669,22 @@ func (client *WANPPPConnection1) GetPPPCompressionProtocol() (NewPPPCompressionP
 	return
 }
 
+// WANPPPConnection1GetPPPAuthenticationProtocolResponse is the response of GetPPPAuthenticationProtocol, with each
+// argument in its SOAP string form.
+type WANPPPConnection1GetPPPAuthenticationProtocolResponse struct {
+	NewPPPAuthenticationProtocol string
+}
+
+// GetPPPAuthenticationProtocol performs the GetPPPAuthenticationProtocol action of the service.
+//
+// Return values:
+//   - NewPPPAuthenticationProtocol (out, state variable A_ARG_TYPE_GetPPPAuthenticationProtocol_NewPPPAuthenticationProtocol)
 func (client *WANPPPConnection1) GetPPPAuthenticationProtocol() (NewPPPAuthenticationProtocol string, err error) {
+	return client.GetPPPAuthenticationProtocolCtx(context.Background())
+}
+
+// GetPPPAuthenticationProtocolCtx is GetPPPAuthenticationProtocol with a context, to cancel or time out the call.
+func (client *WANPPPConnection1) GetPPPAuthenticationProtocolCtx(ctx context.Context) (NewPPPAuthenticationProtocol string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -4929,12 +7692,10 @@ func (client *WANPPPConnection1) GetPPPAuthenticationProtocol() (NewPPPAuthentic
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewPPPAuthenticationProtocol string
-	}{}
+	response := &WANPPPConnection1GetPPPAuthenticationProtocolResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANPPPConnection_1, "GetPPPAuthenticationProtocol", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetPPPAuthenticationProtocol", request, response); err != nil {
 		return
 	}
 
@@ -4947,7 +7708,22 @@ func (client *WANPPPConnection1) GetPPPAuthenticationProtocol() (NewPPPAuthentic
 	return
 }
 
+// WANPPPConnection1GetUserNameResponse is the response of GetUserName, with each
+// argument in its SOAP string form.
+type WANPPPConnection1GetUserNameResponse struct {
+	NewUserName string
+}
+
+// GetUserName performs the GetUserName action of the service.
+//
+// Return values:
+//   - NewUserName (out, state variable A_ARG_TYPE_GetUserName_NewUserName)
 func (client *WANPPPConnection1) GetUserName() (NewUserName string, err error) {
+	return client.GetUserNameCtx(context.Background())
+}
+
+// GetUserNameCtx is GetUserName with a context, to cancel or time out the call.
+func (client *WANPPPConnection1) GetUserNameCtx(ctx context.Context) (NewUserName string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -4955,12 +7731,10 @@ func (client *WANPPPConnection1) GetUserName() (NewUserName string, err error) {
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewUserName string
-	}{}
+	response := &WANPPPConnection1GetUserNameResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANPPPConnection_1, "GetUserName", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetUserName", request, response); err != nil {
 		return
 	}
 
@@ -4973,7 +7747,22 @@ func (client *WANPPPConnection1) GetUserName() (NewUserName string, err error) {
 	return
 }
 
+// WANPPPConnection1GetPasswordResponse is the response of GetPassword, with each
+// argument in its SOAP string form.
+type WANPPPConnection1GetPasswordResponse struct {
+	NewPassword string
+}
+
+// GetPassword performs the GetPassword action of the service.
+//
+// Return values:
+//   - NewPassword (out, state variable A_ARG_TYPE_GetPassword_NewPassword)
 func (client *WANPPPConnection1) GetPassword() (NewPassword string, err error) {
+	return client.GetPasswordCtx(context.Background())
+}
+
+// GetPasswordCtx is GetPassword with a context, to cancel or time out the call.
+func (client *WANPPPConnection1) GetPasswordCtx(ctx context.Context) (NewPassword string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -4981,12 +7770,10 @@ func (client *WANPPPConnection1) GetPassword() (NewPassword string, err error) {
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewPassword string
-	}{}
+	response := &WANPPPConnection1GetPasswordResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANPPPConnection_1, "GetPassword", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetPassword", request, response); err != nil {
 		return
 	}
 
@@ -4999,7 +7786,22 @@ func (client *WANPPPConnection1) GetPassword() (NewPassword string, err error) {
 	return
 }
 
+// WANPPPConnection1GetAutoDisconnectTimeResponse is the response of GetAutoDisconnectTime, with each
+// argument in its SOAP string form.
+type WANPPPConnection1GetAutoDisconnectTimeResponse struct {
+	NewAutoDisconnectTime string
+}
+
+// GetAutoDisconnectTime performs the GetAutoDisconnectTime action of the service.
+//
+// Return values:
+//   - NewAutoDisconnectTime (out, state variable A_ARG_TYPE_GetAutoDisconnectTime_NewAutoDisconnectTime)
 func (client *WANPPPConnection1) GetAutoDisconnectTime() (NewAutoDisconnectTime uint32, err error) {
+	return client.GetAutoDisconnectTimeCtx(context.Background())
+}
+
+// GetAutoDisconnectTimeCtx is GetAutoDisconnectTime with a context, to cancel or time out the call.
+func (client *WANPPPConnection1) GetAutoDisconnectTimeCtx(ctx context.Context) (NewAutoDisconnectTime uint32, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -5007,12 +7809,10 @@ func (client *WANPPPConnection1) GetAutoDisconnectTime() (NewAutoDisconnectTime
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewAutoDisconnectTime string
-	}{}
+	response := &WANPPPConnection1GetAutoDisconnectTimeResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANPPPConnection_1, "GetAutoDisconnectTime", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetAutoDisconnectTime", request, response); err != nil {
 		return
 	}
 
@@ -5025,7 +7825,22 @@ func (client *WANPPPConnection1) GetAutoDisconnectTime() (NewAutoDisconnectTime
 	return
 }
 
+// WANPPPConnection1GetIdleDisconnectTimeResponse is the response of GetIdleDisconnectTime, with each
+// argument in its SOAP string form.
+type WANPPPConnection1GetIdleDisconnectTimeResponse struct {
+	NewIdleDisconnectTime string
+}
+
+// GetIdleDisconnectTime performs the GetIdleDisconnectTime action of the service.
+//
+// Return values:
+//   - NewIdleDisconnectTime (out, state variable A_ARG_TYPE_GetIdleDisconnectTime_NewIdleDisconnectTime)
 func (client *WANPPPConnection1) GetIdleDisconnectTime() (NewIdleDisconnectTime uint32, err error) {
+	return client.GetIdleDisconnectTimeCtx(context.Background())
+}
+
+// GetIdleDisconnectTimeCtx is GetIdleDisconnectTime with a context, to cancel or time out the call.
+func (client *WANPPPConnection1) GetIdleDisconnectTimeCtx(ctx context.Context) (NewIdleDisconnectTime uint32, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -5033,12 +7848,10 @@ func (client *WANPPPConnection1) GetIdleDisconnectTime() (NewIdleDisconnectTime
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewIdleDisconnectTime string
-	}{}
+	response := &WANPPPConnection1GetIdleDisconnectTimeResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANPPPConnection_1, "GetIdleDisconnectTime", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetIdleDisconnectTime", request, response); err != nil {
 		return
 	}
 
@@ -5051,7 +7864,22 @@ func (client *WANPPPConnection1) GetIdleDisconnectTime() (NewIdleDisconnectTime
 	return
 }
 
+// WANPPPConnection1GetWarnDisconnectDelayResponse is the response of GetWarnDisconnectDelay, with each
+// argument in its SOAP string form.
+type WANPPPConnection1GetWarnDisconnectDelayResponse struct {
+	NewWarnDisconnectDelay string
+}
+
+// GetWarnDisconnectDelay performs the GetWarnDisconnectDelay action of the service.
+//
+// Return values:
+//   - NewWarnDisconnectDelay (out, state variable A_ARG_TYPE_GetWarnDisconnectDelay_NewWarnDisconnectDelay)
 func (client *WANPPPConnection1) GetWarnDisconnectDelay() (NewWarnDisconnectDelay uint32, err error) {
+	return client.GetWarnDisconnectDelayCtx(context.Background())
+}
+
+// GetWarnDisconnectDelayCtx is GetWarnDisconnectDelay with a context, to cancel or time out the call.
+func (client *WANPPPConnection1) GetWarnDisconnectDelayCtx(ctx context.Context) (NewWarnDisconnectDelay uint32, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -5059,12 +7887,10 @@ func (client *WANPPPConnection1) GetWarnDisconnectDelay() (NewWarnDisconnectDela
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewWarnDisconnectDelay string
-	}{}
+	response := &WANPPPConnection1GetWarnDisconnectDelayResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANPPPConnection_1, "GetWarnDisconnectDelay", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetWarnDisconnectDelay", request, response); err != nil {
 		return
 	}
 
@@ -5077,7 +7903,24 @@ func (client *WANPPPConnection1) GetWarnDisconnectDelay() (NewWarnDisconnectDela
 	return
 }
 
+// WANPPPConnection1GetNATRSIPStatusResponse is the response of GetNATRSIPStatus, with each
+// argument in its SOAP string form.
+type WANPPPConnection1GetNATRSIPStatusResponse struct {
+	NewRSIPAvailable string
+	NewNATEnabled    string
+}
+
+// GetNATRSIPStatus performs the GetNATRSIPStatus action of the service.
+//
+// Return values:
+//   - NewRSIPAvailable (out, state variable A_ARG_TYPE_GetNATRSIPStatus_NewRSIPAvailable)
+//   - NewNATEnabled (out, state variable A_ARG_TYPE_GetNATRSIPStatus_NewNATEnabled)
 func (client *WANPPPConnection1) GetNATRSIPStatus() (NewRSIPAvailable bool, NewNATEnabled bool, err error) {
+	return client.GetNATRSIPStatusCtx(context.Background())
+}
+
+// GetNATRSIPStatusCtx is GetNATRSIPStatus with a context, to cancel or time out the call.
+func (client *WANPPPConnection1) GetNATRSIPStatusCtx(ctx context.Context) (NewRSIPAvailable bool, NewNATEnabled bool, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -5085,14 +7928,10 @@ func (client *WANPPPConnection1) GetNATRSIPStatus() (NewRSIPAvailable bool, NewN
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewRSIPAvailable string
-
-		NewNATEnabled string
-	}{}
+	response := &WANPPPConnection1GetNATRSIPStatusResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANPPPConnection_1, "GetNATRSIPStatus", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetNATRSIPStatus", request, response); err != nil {
 		return
 	}
 
@@ -5108,15 +7947,51 @@ func (client *WANPPPConnection1) GetNATRSIPStatus() (NewRSIPAvailable bool, NewN
 	return
 }
 
+// WANPPPConnection1GetGenericPortMappingEntryRequest is the request of GetGenericPortMappingEntry, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANPPPConnection1GetGenericPortMappingEntryRequest struct {
+	NewPortMappingIndex string
+}
+
+// WANPPPConnection1GetGenericPortMappingEntryResponse is the response of GetGenericPortMappingEntry, with each
+// argument in its SOAP string form.
+type WANPPPConnection1GetGenericPortMappingEntryResponse struct {
+	NewRemoteHost             string
+	NewExternalPort           string
+	NewProtocol               string
+	NewInternalPort           string
+	NewInternalClient         string
+	NewEnabled                string
+	NewPortMappingDescription string
+	NewLeaseDuration          string
+}
+
+// GetGenericPortMappingEntry performs the GetGenericPortMappingEntry action of the service.
 //
-// Return values:
+// Returns the port mapping at NewPortMappingIndex in the table of port
+// mappings, counting from 0, for listing the mappings. It fails with error
+// 713 (SpecifiedArrayIndexInvalid) after the last mapping.
+//
+// Arguments:
+//   - NewPortMappingIndex (in, state variable A_ARG_TYPE_GetGenericPortMappingEntry_NewPortMappingIndex)
 //
-// * NewProtocol: allowed values: TCP, UDP
-func (client *WANPPPConnection1) GetGenericPortMappingEntry(NewPortMappingIndex uint16) (NewRemoteHost string, NewExternalPort uint16, NewProtocol string, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32, err error) {
+// Return values:
+//   - NewRemoteHost (out, state variable A_ARG_TYPE_GetGenericPortMappingEntry_NewRemoteHost)
+//   - NewExternalPort (out, state variable A_ARG_TYPE_GetGenericPortMappingEntry_NewExternalPort)
+//   - NewProtocol (out, state variable PortMappingProtocol): allowed values TCP, UDP
+//   - NewInternalPort (out, state variable A_ARG_TYPE_GetGenericPortMappingEntry_NewInternalPort)
+//   - NewInternalClient (out, state variable A_ARG_TYPE_GetGenericPortMappingEntry_NewInternalClient)
+//   - NewEnabled (out, state variable A_ARG_TYPE_GetGenericPortMappingEntry_NewEnabled)
+//   - NewPortMappingDescription (out, state variable A_ARG_TYPE_GetGenericPortMappingEntry_NewPortMappingDescription)
+//   - NewLeaseDuration (out, state variable A_ARG_TYPE_GetGenericPortMappingEntry_NewLeaseDuration)
+func (client *WANPPPConnection1) GetGenericPortMappingEntry(NewPortMappingIndex uint16) (NewRemoteHost string, NewExternalPort uint16, NewProtocol WANPPPConnection1PortMappingProtocol, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32, err error) {
+	return client.GetGenericPortMappingEntryCtx(context.Background(), NewPortMappingIndex)
+}
+
+// GetGenericPortMappingEntryCtx is GetGenericPortMappingEntry with a context, to cancel or time out the call.
+func (client *WANPPPConnection1) GetGenericPortMappingEntryCtx(ctx context.Context, NewPortMappingIndex uint16) (NewRemoteHost string, NewExternalPort uint16, NewProtocol WANPPPConnection1PortMappingProtocol, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32, err error) {
 	// Request structure.
-	request := &struct {
-		NewPortMappingIndex string
-	}{}
+	request := &WANPPPConnection1GetGenericPortMappingEntryRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewPortMappingIndex, err = soap.MarshalUi2(NewPortMappingIndex); err != nil {
@@ -5125,26 +8000,10 @@ func (client *WANPPPConnection1) GetGenericPortMappingEntry(NewPortMappingIndex
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewRemoteHost string
-
-		NewExternalPort string
-
-		NewProtocol string
-
-		NewInternalPort string
-
-		NewInternalClient string
-
-		NewEnabled string
-
-		NewPortMappingDescription string
-
-		NewLeaseDuration string
-	}{}
+	response := &WANPPPConnection1GetGenericPortMappingEntryResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANPPPConnection_1, "GetGenericPortMappingEntry", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetGenericPortMappingEntry", request, response); err != nil {
 		return
 	}
 
@@ -5156,9 +8015,7 @@ func (client *WANPPPConnection1) GetGenericPortMappingEntry(NewPortMappingIndex
 	if NewExternalPort, err = soap.UnmarshalUi2(response.NewExternalPort); err != nil {
 		return
 	}
-	if NewProtocol, err = soap.UnmarshalString(response.NewProtocol); err != nil {
-		return
-	}
+	NewProtocol = WANPPPConnection1PortMappingProtocol(response.NewProtocol)
 	if NewInternalPort, err = soap.UnmarshalUi2(response.NewInternalPort); err != nil {
 		return
 	}
@@ -5178,20 +8035,49 @@ func (client *WANPPPConnection1) GetGenericPortMappingEntry(NewPortMappingIndex
 	return
 }
 
+// WANPPPConnection1GetSpecificPortMappingEntryRequest is the request of GetSpecificPortMappingEntry, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANPPPConnection1GetSpecificPortMappingEntryRequest struct {
+	NewRemoteHost   string
+	NewExternalPort string
+	NewProtocol     string
+}
+
+// WANPPPConnection1GetSpecificPortMappingEntryResponse is the response of GetSpecificPortMappingEntry, with each
+// argument in its SOAP string form.
+type WANPPPConnection1GetSpecificPortMappingEntryResponse struct {
+	NewInternalPort           string
+	NewInternalClient         string
+	NewEnabled                string
+	NewPortMappingDescription string
+	NewLeaseDuration          string
+}
+
+// GetSpecificPortMappingEntry performs the GetSpecificPortMappingEntry action of the service.
+//
+// Returns the internal client and settings of the port mapping with the
+// given NewRemoteHost, NewExternalPort and NewProtocol, failing with error
+// 714 (NoSuchEntryInArray) if there is none.
 //
 // Arguments:
+//   - NewRemoteHost (in, state variable A_ARG_TYPE_GetSpecificPortMappingEntry_NewRemoteHost)
+//   - NewExternalPort (in, state variable A_ARG_TYPE_GetSpecificPortMappingEntry_NewExternalPort)
+//   - NewProtocol (in, state variable PortMappingProtocol): allowed values TCP, UDP
 //
-// * NewProtocol: allowed values: TCP, UDP
+// Return values:
+//   - NewInternalPort (out, state variable A_ARG_TYPE_GetSpecificPortMappingEntry_NewInternalPort)
+//   - NewInternalClient (out, state variable A_ARG_TYPE_GetSpecificPortMappingEntry_NewInternalClient)
+//   - NewEnabled (out, state variable A_ARG_TYPE_GetSpecificPortMappingEntry_NewEnabled)
+//   - NewPortMappingDescription (out, state variable A_ARG_TYPE_GetSpecificPortMappingEntry_NewPortMappingDescription)
+//   - NewLeaseDuration (out, state variable A_ARG_TYPE_GetSpecificPortMappingEntry_NewLeaseDuration)
+func (client *WANPPPConnection1) GetSpecificPortMappingEntry(NewRemoteHost string, NewExternalPort uint16, NewProtocol WANPPPConnection1PortMappingProtocol) (NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32, err error) {
+	return client.GetSpecificPortMappingEntryCtx(context.Background(), NewRemoteHost, NewExternalPort, NewProtocol)
+}
 
-func (client *WANPPPConnection1) GetSpecificPortMappingEntry(NewRemoteHost string, NewExternalPort uint16, NewProtocol string) (NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32, err error) {
+// GetSpecificPortMappingEntryCtx is GetSpecificPortMappingEntry with a context, to cancel or time out the call.
+func (client *WANPPPConnection1) GetSpecificPortMappingEntryCtx(ctx context.Context, NewRemoteHost string, NewExternalPort uint16, NewProtocol WANPPPConnection1PortMappingProtocol) (NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32, err error) {
 	// Request structure.
-	request := &struct {
-		NewRemoteHost string
-
-		NewExternalPort string
-
-		NewProtocol string
-	}{}
+	request := &WANPPPConnection1GetSpecificPortMappingEntryRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewRemoteHost, err = soap.MarshalString(NewRemoteHost); err != nil {
@@ -5200,26 +8086,16 @@ func (client *WANPPPConnection1) GetSpecificPortMappingEntry(NewRemoteHost strin
 	if request.NewExternalPort, err = soap.MarshalUi2(NewExternalPort); err != nil {
 		return
 	}
-	if request.NewProtocol, err = soap.MarshalString(NewProtocol); err != nil {
+	if request.NewProtocol, err = soap.MarshalString(string(NewProtocol)); err != nil {
 		return
 	}
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewInternalPort string
-
-		NewInternalClient string
-
-		NewEnabled string
-
-		NewPortMappingDescription string
-
-		NewLeaseDuration string
-	}{}
+	response := &WANPPPConnection1GetSpecificPortMappingEntryResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANPPPConnection_1, "GetSpecificPortMappingEntry", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetSpecificPortMappingEntry", request, response); err != nil {
 		return
 	}
 
@@ -5244,30 +8120,48 @@ func (client *WANPPPConnection1) GetSpecificPortMappingEntry(NewRemoteHost strin
 	return
 }
 
+// WANPPPConnection1AddPortMappingRequest is the request of AddPortMapping, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANPPPConnection1AddPortMappingRequest struct {
+	NewRemoteHost             string
+	NewExternalPort           string
+	NewProtocol               string
+	NewInternalPort           string
+	NewInternalClient         string
+	NewEnabled                string
+	NewPortMappingDescription string
+	NewLeaseDuration          string
+}
+
+// AddPortMapping performs the AddPortMapping action of the service.
 //
-// Arguments:
+// Creates a port mapping, forwarding NewExternalPort of NewProtocol on the
+// WAN side to NewInternalPort of NewInternalClient on the LAN. An existing
+// mapping with the same NewRemoteHost, NewExternalPort and NewProtocol is
+// replaced if it is for the same internal client, otherwise the action fails
+// with error 718 (ConflictInMappingEntry).
 //
-// * NewProtocol: allowed values: TCP, UDP
+// An empty NewRemoteHost matches any remote host. A NewLeaseDuration of 0
+// makes the mapping permanent; gateways that only support permanent mappings
+// fail other durations with error 725 (OnlyPermanentLeasesSupported).
+//
+// Arguments:
+//   - NewRemoteHost (in, state variable A_ARG_TYPE_AddPortMapping_NewRemoteHost)
+//   - NewExternalPort (in, state variable A_ARG_TYPE_AddPortMapping_NewExternalPort)
+//   - NewProtocol (in, state variable PortMappingProtocol): allowed values TCP, UDP
+//   - NewInternalPort (in, state variable A_ARG_TYPE_AddPortMapping_NewInternalPort)
+//   - NewInternalClient (in, state variable A_ARG_TYPE_AddPortMapping_NewInternalClient)
+//   - NewEnabled (in, state variable A_ARG_TYPE_AddPortMapping_NewEnabled)
+//   - NewPortMappingDescription (in, state variable A_ARG_TYPE_AddPortMapping_NewPortMappingDescription)
+//   - NewLeaseDuration (in, state variable A_ARG_TYPE_AddPortMapping_NewLeaseDuration)
+func (client *WANPPPConnection1) AddPortMapping(NewRemoteHost string, NewExternalPort uint16, NewProtocol WANPPPConnection1PortMappingProtocol, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32) (err error) {
+	return client.AddPortMappingCtx(context.Background(), NewRemoteHost, NewExternalPort, NewProtocol, NewInternalPort, NewInternalClient, NewEnabled, NewPortMappingDescription, NewLeaseDuration)
+}
 
-func (client *WANPPPConnection1) AddPortMapping(NewRemoteHost string, NewExternalPort uint16, NewProtocol string, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32) (err error) {
+// AddPortMappingCtx is AddPortMapping with a context, to cancel or time out the call.
+func (client *WANPPPConnection1) AddPortMappingCtx(ctx context.Context, NewRemoteHost string, NewExternalPort uint16, NewProtocol WANPPPConnection1PortMappingProtocol, NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32) (err error) {
 	// Request structure.
-	request := &struct {
-		NewRemoteHost string
-
-		NewExternalPort string
-
-		NewProtocol string
-
-		NewInternalPort string
-
-		NewInternalClient string
-
-		NewEnabled string
-
-		NewPortMappingDescription string
-
-		NewLeaseDuration string
-	}{}
+	request := &WANPPPConnection1AddPortMappingRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewRemoteHost, err = soap.MarshalString(NewRemoteHost); err != nil {
@@ -5276,7 +8170,7 @@ func (client *WANPPPConnection1) AddPortMapping(NewRemoteHost string, NewExterna
 	if request.NewExternalPort, err = soap.MarshalUi2(NewExternalPort); err != nil {
 		return
 	}
-	if request.NewProtocol, err = soap.MarshalString(NewProtocol); err != nil {
+	if request.NewProtocol, err = soap.MarshalString(string(NewProtocol)); err != nil {
 		return
 	}
 	if request.NewInternalPort, err = soap.MarshalUi2(NewInternalPort); err != nil {
@@ -5300,7 +8194,7 @@ func (client *WANPPPConnection1) AddPortMapping(NewRemoteHost string, NewExterna
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANPPPConnection_1, "AddPortMapping", request, response); err != nil {
+	if err = client.PerformAction(ctx, "AddPortMapping", request, response); err != nil {
 		return
 	}
 
@@ -5310,20 +8204,31 @@ func (client *WANPPPConnection1) AddPortMapping(NewRemoteHost string, NewExterna
 	return
 }
 
+// WANPPPConnection1DeletePortMappingRequest is the request of DeletePortMapping, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WANPPPConnection1DeletePortMappingRequest struct {
+	NewRemoteHost   string
+	NewExternalPort string
+	NewProtocol     string
+}
+
+// DeletePortMapping performs the DeletePortMapping action of the service.
 //
-// Arguments:
+// Deletes the port mapping with the given NewRemoteHost, NewExternalPort and
+// NewProtocol, failing with error 714 (NoSuchEntryInArray) if there is none.
 //
-// * NewProtocol: allowed values: TCP, UDP
+// Arguments:
+//   - NewRemoteHost (in, state variable A_ARG_TYPE_DeletePortMapping_NewRemoteHost)
+//   - NewExternalPort (in, state variable A_ARG_TYPE_DeletePortMapping_NewExternalPort)
+//   - NewProtocol (in, state variable PortMappingProtocol): allowed values TCP, UDP
+func (client *WANPPPConnection1) DeletePortMapping(NewRemoteHost string, NewExternalPort uint16, NewProtocol WANPPPConnection1PortMappingProtocol) (err error) {
+	return client.DeletePortMappingCtx(context.Background(), NewRemoteHost, NewExternalPort, NewProtocol)
+}
 
-func (client *WANPPPConnection1) DeletePortMapping(NewRemoteHost string, NewExternalPort uint16, NewProtocol string) (err error) {
+// DeletePortMappingCtx is DeletePortMapping with a context, to cancel or time out the call.
+func (client *WANPPPConnection1) DeletePortMappingCtx(ctx context.Context, NewRemoteHost string, NewExternalPort uint16, NewProtocol WANPPPConnection1PortMappingProtocol) (err error) {
 	// Request structure.
-	request := &struct {
-		NewRemoteHost string
-
-		NewExternalPort string
-
-		NewProtocol string
-	}{}
+	request := &WANPPPConnection1DeletePortMappingRequest{}
 	// BEGIN Marshal arguments into request.
 
 	if request.NewRemoteHost, err = soap.MarshalString(NewRemoteHost); err != nil {
@@ -5332,7 +8237,7 @@ func (client *WANPPPConnection1) DeletePortMapping(NewRemoteHost string, NewExte
 	if request.NewExternalPort, err = soap.MarshalUi2(NewExternalPort); err != nil {
 		return
 	}
-	if request.NewProtocol, err = soap.MarshalString(NewProtocol); err != nil {
+	if request.NewProtocol, err = soap.MarshalString(string(NewProtocol)); err != nil {
 		return
 	}
 	// END Marshal arguments into request.
@@ -5341,7 +8246,7 @@ func (client *WANPPPConnection1) DeletePortMapping(NewRemoteHost string, NewExte
 	response := interface{}(nil)
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANPPPConnection_1, "DeletePortMapping", request, response); err != nil {
+	if err = client.PerformAction(ctx, "DeletePortMapping", request, response); err != nil {
 		return
 	}
 
@@ -5351,7 +8256,25 @@ func (client *WANPPPConnection1) DeletePortMapping(NewRemoteHost string, NewExte
 	return
 }
 
+// WANPPPConnection1GetExternalIPAddressResponse is the response of GetExternalIPAddress, with each
+// argument in its SOAP string form.
+type WANPPPConnection1GetExternalIPAddressResponse struct {
+	NewExternalIPAddress string
+}
+
+// GetExternalIPAddress performs the GetExternalIPAddress action of the service.
+//
+// Returns the external IP address of the connection, which is the address of
+// the gateway on the WAN side.
+//
+// Return values:
+//   - NewExternalIPAddress (out, state variable A_ARG_TYPE_GetExternalIPAddress_NewExternalIPAddress)
 func (client *WANPPPConnection1) GetExternalIPAddress() (NewExternalIPAddress string, err error) {
+	return client.GetExternalIPAddressCtx(context.Background())
+}
+
+// GetExternalIPAddressCtx is GetExternalIPAddress with a context, to cancel or time out the call.
+func (client *WANPPPConnection1) GetExternalIPAddressCtx(ctx context.Context) (NewExternalIPAddress string, err error) {
 	// Request structure.
 	request := interface{}(nil)
 	// BEGIN Marshal arguments into request.
@@ -5359,12 +8282,10 @@ func (client *WANPPPConnection1) GetExternalIPAddress() (NewExternalIPAddress st
 	// END Marshal arguments into request.
 
 	// Response structure.
-	response := &struct {
-		NewExternalIPAddress string
-	}{}
+	response := &WANPPPConnection1GetExternalIPAddressResponse{}
 
 	// Perform the SOAP call.
-	if err = client.SOAPClient.PerformAction(URN_WANPPPConnection_1, "GetExternalIPAddress", request, response); err != nil {
+	if err = client.PerformAction(ctx, "GetExternalIPAddress", request, response); err != nil {
 		return
 	}
 
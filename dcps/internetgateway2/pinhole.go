@@ -0,0 +1,189 @@
+package internetgateway2
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/huin/goupnp/clock"
+	"github.com/huin/goupnp/upnperr"
+)
+
+// IANA protocol numbers for the Protocol argument of the pinhole actions.
+const (
+	PinholeProtocolTCP = 6
+	PinholeProtocolUDP = 17
+	PinholeProtocolAny = 65535
+)
+
+// Error codes of WANIPv6FirewallControl:1 used by Pinhole.
+const (
+	errCodeNoSuchEntry       = 704
+	errCodeNoTrafficReceived = 709
+)
+
+// Limits of the LeaseTime argument of AddPinhole and UpdatePinhole.
+const (
+	minPinholeLease = time.Second
+	maxPinholeLease = 86400 * time.Second
+)
+
+// PinholeSpec describes the traffic allowed through a pinhole. An empty
+// RemoteHost and zero RemotePort are wildcards, matching any remote host and
+// port.
+type PinholeSpec struct {
+	RemoteHost     string
+	RemotePort     uint16
+	InternalClient string
+	InternalPort   uint16
+	Protocol       uint16
+}
+
+// PinholeStatus is the status of a pinhole reported by the firewall.
+type PinholeStatus struct {
+	// Working is true if the firewall has seen traffic through the pinhole.
+	Working bool
+	// Packets is the number of packets that have gone through the pinhole.
+	Packets uint32
+}
+
+// PinholeOption configures a Pinhole opened by OpenPinhole.
+type PinholeOption func(*Pinhole)
+
+// WithPinholeClock sets the clock that the renewals of a Pinhole are
+// scheduled on, the system clock by default.
+func WithPinholeClock(c clock.Clock) PinholeOption {
+	return func(p *Pinhole) { p.clock = c }
+}
+
+// Pinhole is an IPv6 firewall pinhole, the IPv6 analog of a port mapping,
+// whose lease is renewed until it is closed. Use OpenPinhole to create one.
+type Pinhole struct {
+	client WANIPv6FirewallControl1Client
+	spec   PinholeSpec
+	lease  time.Duration
+	clock  clock.Clock
+
+	stop      chan struct{}
+	done      chan struct{}
+	closeOnce sync.Once
+
+	lock     sync.Mutex
+	uniqueID uint16
+	err      error
+}
+
+// OpenPinhole adds a pinhole with AddPinhole and starts renewing it at half
+// of its lease, which is clamped to the range allowed by the specification.
+// If the firewall has lost the pinhole, for example after a restart, it is
+// added again. Close deletes the pinhole.
+func OpenPinhole(ctx context.Context, client WANIPv6FirewallControl1Client, spec PinholeSpec, lease time.Duration, opts ...PinholeOption) (*Pinhole, error) {
+	if lease < minPinholeLease {
+		lease = minPinholeLease
+	} else if lease > maxPinholeLease {
+		lease = maxPinholeLease
+	}
+	p := &Pinhole{
+		client: client,
+		spec:   spec,
+		lease:  lease,
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(p)
+	}
+	p.clock = clock.Or(p.clock)
+	if err := p.add(ctx); err != nil {
+		return nil, err
+	}
+	go p.renew(p.clock.NewTimer(p.lease / 2))
+	return p, nil
+}
+
+// UniqueID returns the identifier of the pinhole assigned by the firewall,
+// which changes if the pinhole has to be added again.
+func (p *Pinhole) UniqueID() uint16 {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.uniqueID
+}
+
+// Err returns the error of the last renewal of the pinhole, or nil if it
+// succeeded.
+func (p *Pinhole) Err() error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+	return p.err
+}
+
+// Status returns the status of the pinhole, from CheckPinholeWorking and
+// GetPinholePackets.
+func (p *Pinhole) Status(ctx context.Context) (PinholeStatus, error) {
+	var status PinholeStatus
+	id := p.UniqueID()
+	working, err := p.client.CheckPinholeWorkingCtx(ctx, id)
+	if err != nil && !isUPnPError(err, errCodeNoTrafficReceived) {
+		return status, err
+	}
+	status.Working = err == nil && working
+	if status.Packets, err = p.client.GetPinholePacketsCtx(ctx, id); err != nil {
+		return status, err
+	}
+	return status, nil
+}
+
+// Close stops renewing the pinhole and deletes it.
+func (p *Pinhole) Close() error {
+	var err error
+	p.closeOnce.Do(func() {
+		close(p.stop)
+		<-p.done
+		err = p.client.DeletePinholeCtx(context.Background(), p.UniqueID())
+	})
+	return err
+}
+
+func (p *Pinhole) add(ctx context.Context) error {
+	id, err := p.client.AddPinholeCtx(ctx, p.spec.RemoteHost, p.spec.RemotePort,
+		p.spec.InternalClient, p.spec.InternalPort, p.spec.Protocol, uint32(p.lease/time.Second))
+	if err != nil {
+		return err
+	}
+	p.lock.Lock()
+	p.uniqueID = id
+	p.lock.Unlock()
+	return nil
+}
+
+func (p *Pinhole) renew(timer clock.Timer) {
+	defer close(p.done)
+	defer timer.Stop()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-p.stop
+		cancel()
+	}()
+
+	for {
+		select {
+		case <-p.stop:
+			return
+		case <-timer.C():
+			timer.Reset(p.lease / 2)
+		}
+		err := p.client.UpdatePinholeCtx(ctx, p.UniqueID(), uint32(p.lease/time.Second))
+		if isUPnPError(err, errCodeNoSuchEntry) {
+			err = p.add(ctx)
+		}
+		p.lock.Lock()
+		p.err = err
+		p.lock.Unlock()
+	}
+}
+
+func isUPnPError(err error, code int) bool {
+	got, ok := upnperr.FaultCode(err)
+	return ok && got == code
+}
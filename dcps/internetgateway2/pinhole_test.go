@@ -0,0 +1,109 @@
+package internetgateway2
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/huin/goupnp/clock"
+	"github.com/huin/goupnp/soap"
+)
+
+// fakeFirewall implements the pinhole actions used by Pinhole, the other
+// methods of the embedded nil interface panic if called.
+type fakeFirewall struct {
+	WANIPv6FirewallControl1Client
+	nextID  uint16
+	added   chan uint32
+	updated chan uint16
+	deleted chan uint16
+	// lost makes the next UpdatePinhole fail as if the pinhole was lost.
+	lost bool
+}
+
+func newFakeFirewall() *fakeFirewall {
+	return &fakeFirewall{
+		nextID:  1,
+		added:   make(chan uint32, 10),
+		updated: make(chan uint16, 10),
+		deleted: make(chan uint16, 10),
+	}
+}
+
+func (f *fakeFirewall) AddPinholeCtx(ctx context.Context, RemoteHost string, RemotePort uint16, InternalClient string,
+	InternalPort uint16, Protocol uint16, LeaseTime uint32) (uint16, error) {
+	id := f.nextID
+	f.nextID++
+	f.added <- LeaseTime
+	return id, nil
+}
+
+func (f *fakeFirewall) UpdatePinholeCtx(ctx context.Context, UniqueID uint16, NewLeaseTime uint32) error {
+	if f.lost {
+		f.lost = false
+		return &soap.SOAPFaultError{UPnPError: &soap.UPnPError{Code: errCodeNoSuchEntry}}
+	}
+	f.updated <- UniqueID
+	return nil
+}
+
+func (f *fakeFirewall) DeletePinholeCtx(ctx context.Context, UniqueID uint16) error {
+	f.deleted <- UniqueID
+	return nil
+}
+
+func (f *fakeFirewall) CheckPinholeWorkingCtx(ctx context.Context, UniqueID uint16) (bool, error) {
+	return false, &soap.SOAPFaultError{UPnPError: &soap.UPnPError{Code: errCodeNoTrafficReceived}}
+}
+
+func (f *fakeFirewall) GetPinholePacketsCtx(ctx context.Context, UniqueID uint16) (uint32, error) {
+	return 0, nil
+}
+
+func TestPinhole(t *testing.T) {
+	f := newFakeFirewall()
+	f.lost = true
+	fc := clock.NewFake(time.Unix(1000, 0))
+	p, err := OpenPinhole(context.Background(), f, PinholeSpec{
+		InternalClient: "2001:db8::1",
+		InternalPort:   80,
+		Protocol:       PinholeProtocolTCP,
+	}, 0, WithPinholeClock(fc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if lease := <-f.added; lease != 1 {
+		t.Errorf("added pinhole with lease %d, want lease clamped to 1", lease)
+	}
+
+	// The first renewal finds the pinhole lost and adds it again, the
+	// second renews the new pinhole.
+	for _, want := range []string{"added", "updated"} {
+		fc.Advance(500 * time.Millisecond)
+		select {
+		case <-f.added:
+			if want != "added" {
+				t.Fatal("pinhole added again, want it renewed")
+			}
+		case id := <-f.updated:
+			if want != "updated" || id != 2 {
+				t.Fatalf("renewed pinhole %d, want pinhole 2 %s", id, want)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for pinhole to be %s", want)
+		}
+	}
+	if status, err := p.Status(context.Background()); err != nil || status.Working {
+		t.Errorf("Status() = %+v, %v, want not working without error", status, err)
+	}
+
+	if err := p.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if id := <-f.deleted; id != 2 {
+		t.Errorf("deleted pinhole %d, want 2", id)
+	}
+	if err := p.Close(); err != nil || len(f.deleted) != 0 {
+		t.Errorf("second Close() = %v, want no error and no deletion", err)
+	}
+}
@@ -0,0 +1,1126 @@
+// Client for UPnP Device Control Protocol Lighting Controls v1.
+//
+// Typically, use one of the New* functions to create clients for services.
+package lighting1
+
+// Generated file - do not edit by hand. See README.md
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/huin/goupnp"
+	"github.com/huin/goupnp/soap"
+)
+
+// Hack to avoid Go complaining if time isn't used.
+var _ time.Time
+
+// Device URNs:
+const (
+	URN_BinaryLight_1   = "urn:schemas-upnp-org:device:BinaryLight:1"
+	URN_DimmableLight_1 = "urn:schemas-upnp-org:device:DimmableLight:1"
+)
+
+// Service URNs:
+const (
+	URN_Dimming_1     = "urn:schemas-upnp-org:service:Dimming:1"
+	URN_SwitchPower_1 = "urn:schemas-upnp-org:service:SwitchPower:1"
+)
+
+// Dimming1 is a client for UPnP SOAP service with URN "urn:schemas-upnp-org:service:Dimming:1". See
+// goupnp.ServiceClient, which contains RootDevice and Service attributes which
+// are provided for informational value.
+type Dimming1 struct {
+	goupnp.ServiceClient
+}
+
+// Dimming1Client is the interface of the actions of Dimming1, for
+// substituting fakes or mocks for the service in tests.
+type Dimming1Client interface {
+	SetLoadLevelTarget(newLoadlevelTarget uint8) (err error)
+	SetLoadLevelTargetCtx(ctx context.Context, newLoadlevelTarget uint8) (err error)
+	GetLoadLevelTarget() (GetLoadlevelTarget uint8, err error)
+	GetLoadLevelTargetCtx(ctx context.Context) (GetLoadlevelTarget uint8, err error)
+	GetLoadLevelStatus() (retLoadlevelStatus uint8, err error)
+	GetLoadLevelStatusCtx(ctx context.Context) (retLoadlevelStatus uint8, err error)
+	SetOnEffectLevel(newOnEffectLevel uint8) (err error)
+	SetOnEffectLevelCtx(ctx context.Context, newOnEffectLevel uint8) (err error)
+	SetOnEffect(newOnEffect Dimming1OnEffect) (err error)
+	SetOnEffectCtx(ctx context.Context, newOnEffect Dimming1OnEffect) (err error)
+	GetOnEffectParameters() (retOnEffect Dimming1OnEffect, retOnEffectLevel uint8, err error)
+	GetOnEffectParametersCtx(ctx context.Context) (retOnEffect Dimming1OnEffect, retOnEffectLevel uint8, err error)
+	StepUp() (err error)
+	StepUpCtx(ctx context.Context) (err error)
+	StepDown() (err error)
+	StepDownCtx(ctx context.Context) (err error)
+	StartRampUp() (err error)
+	StartRampUpCtx(ctx context.Context) (err error)
+	StartRampDown() (err error)
+	StartRampDownCtx(ctx context.Context) (err error)
+	StopRamp() (err error)
+	StopRampCtx(ctx context.Context) (err error)
+	StartRampToLevel(newLoadLevelTarget uint8, newRampTime uint32) (err error)
+	StartRampToLevelCtx(ctx context.Context, newLoadLevelTarget uint8, newRampTime uint32) (err error)
+	SetStepDelta(newStepDelta uint8) (err error)
+	SetStepDeltaCtx(ctx context.Context, newStepDelta uint8) (err error)
+	GetStepDelta() (retStepDelta uint8, err error)
+	GetStepDeltaCtx(ctx context.Context) (retStepDelta uint8, err error)
+	SetRampRate(newRampRate uint8) (err error)
+	SetRampRateCtx(ctx context.Context, newRampRate uint8) (err error)
+	GetRampRate() (retRampRate uint8, err error)
+	GetRampRateCtx(ctx context.Context) (retRampRate uint8, err error)
+	PauseRamp() (err error)
+	PauseRampCtx(ctx context.Context) (err error)
+	ResumeRamp() (err error)
+	ResumeRampCtx(ctx context.Context) (err error)
+	GetIsRamping() (retIsRamping bool, err error)
+	GetIsRampingCtx(ctx context.Context) (retIsRamping bool, err error)
+	GetRampPaused() (retRampPaused bool, err error)
+	GetRampPausedCtx(ctx context.Context) (retRampPaused bool, err error)
+	GetRampTime() (retRampTime uint32, err error)
+	GetRampTimeCtx(ctx context.Context) (retRampTime uint32, err error)
+}
+
+var _ Dimming1Client = new(Dimming1)
+
+// Dimming1OnEffect is a value of the state variable OnEffect of
+// Dimming1.
+type Dimming1OnEffect string
+
+// Allowed values of Dimming1OnEffect.
+const (
+	Dimming1OnEffect_OnEffectLevel Dimming1OnEffect = "OnEffectLevel"
+	Dimming1OnEffect_LastSetting   Dimming1OnEffect = "LastSetting"
+	Dimming1OnEffect_Default       Dimming1OnEffect = "Default"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v Dimming1OnEffect) Valid() bool {
+	switch v {
+	case Dimming1OnEffect_OnEffectLevel,
+		Dimming1OnEffect_LastSetting,
+		Dimming1OnEffect_Default:
+		return true
+	}
+	return false
+}
+
+// NewDimming1Clients discovers instances of the service on the network,
+// and returns clients to any that are found. errors will contain an error for
+// any devices that replied but which could not be queried, and err will be set
+// if the discovery process failed outright.
+//
+// This is a typical entry calling point into this package.
+func NewDimming1Clients() (clients []*Dimming1, errors []error, err error) {
+	var genericClients []goupnp.ServiceClient
+	if genericClients, errors, err = goupnp.NewServiceClients(URN_Dimming_1); err != nil {
+		return
+	}
+	clients = newDimming1ClientsFromGenericClients(genericClients)
+	return
+}
+
+// NewDimming1ClientsByURL discovers instances of the service at the given
+// URL, and returns clients to any that are found. An error is returned if
+// there was an error probing the service.
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered service URL.
+func NewDimming1ClientsByURL(loc *url.URL) ([]*Dimming1, error) {
+	genericClients, err := goupnp.NewServiceClientsByURL(loc, URN_Dimming_1)
+	if err != nil {
+		return nil, err
+	}
+	return newDimming1ClientsFromGenericClients(genericClients), nil
+}
+
+// NewDimming1ClientsFromRootDevice discovers instances of the service in
+// a given root device, and returns clients to any that are found. An error is
+// returned if there was not at least one instance of the service within the
+// device. The location parameter is simply assigned to the Location attribute
+// of the wrapped ServiceClient(s).
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered root device.
+func NewDimming1ClientsFromRootDevice(rootDevice *goupnp.RootDevice, loc *url.URL) ([]*Dimming1, error) {
+	genericClients, err := goupnp.NewServiceClientsFromRootDevice(rootDevice, loc, URN_Dimming_1)
+	if err != nil {
+		return nil, err
+	}
+	return newDimming1ClientsFromGenericClients(genericClients), nil
+}
+
+func newDimming1ClientsFromGenericClients(genericClients []goupnp.ServiceClient) []*Dimming1 {
+	clients := make([]*Dimming1, len(genericClients))
+	for i := range genericClients {
+		clients[i] = &Dimming1{genericClients[i]}
+	}
+	return clients
+}
+
+// Dimming1Timeouts are the default timeouts of the actions of
+// Dimming1, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var Dimming1Timeouts = map[string]time.Duration{}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *Dimming1) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, Dimming1Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_Dimming_1, actionName, request, response)
+}
+
+// Dimming1SetLoadLevelTargetRequest is the request of SetLoadLevelTarget, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type Dimming1SetLoadLevelTargetRequest struct {
+	newLoadlevelTarget string
+}
+
+// SetLoadLevelTarget performs the SetLoadLevelTarget action of the service.
+//
+// Arguments:
+//   - newLoadlevelTarget (in, state variable LoadLevelTarget): allowed range minimum=0, maximum=100
+func (client *Dimming1) SetLoadLevelTarget(newLoadlevelTarget uint8) (err error) {
+	return client.SetLoadLevelTargetCtx(context.Background(), newLoadlevelTarget)
+}
+
+// SetLoadLevelTargetCtx is SetLoadLevelTarget with a context, to cancel or time out the call.
+func (client *Dimming1) SetLoadLevelTargetCtx(ctx context.Context, newLoadlevelTarget uint8) (err error) {
+	// Request structure.
+	request := &Dimming1SetLoadLevelTargetRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.newLoadlevelTarget, err = soap.MarshalUi1(newLoadlevelTarget); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SetLoadLevelTarget", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// Dimming1GetLoadLevelTargetResponse is the response of GetLoadLevelTarget, with each
+// argument in its SOAP string form.
+type Dimming1GetLoadLevelTargetResponse struct {
+	GetLoadlevelTarget string
+}
+
+// GetLoadLevelTarget performs the GetLoadLevelTarget action of the service.
+//
+// Return values:
+//   - GetLoadlevelTarget (out, state variable LoadLevelTarget): allowed range minimum=0, maximum=100
+func (client *Dimming1) GetLoadLevelTarget() (GetLoadlevelTarget uint8, err error) {
+	return client.GetLoadLevelTargetCtx(context.Background())
+}
+
+// GetLoadLevelTargetCtx is GetLoadLevelTarget with a context, to cancel or time out the call.
+func (client *Dimming1) GetLoadLevelTargetCtx(ctx context.Context) (GetLoadlevelTarget uint8, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &Dimming1GetLoadLevelTargetResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetLoadLevelTarget", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if GetLoadlevelTarget, err = soap.UnmarshalUi1(response.GetLoadlevelTarget); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// Dimming1GetLoadLevelStatusResponse is the response of GetLoadLevelStatus, with each
+// argument in its SOAP string form.
+type Dimming1GetLoadLevelStatusResponse struct {
+	retLoadlevelStatus string
+}
+
+// GetLoadLevelStatus performs the GetLoadLevelStatus action of the service.
+//
+// Return values:
+//   - retLoadlevelStatus (out, state variable LoadLevelStatus): allowed range minimum=0, maximum=100
+func (client *Dimming1) GetLoadLevelStatus() (retLoadlevelStatus uint8, err error) {
+	return client.GetLoadLevelStatusCtx(context.Background())
+}
+
+// GetLoadLevelStatusCtx is GetLoadLevelStatus with a context, to cancel or time out the call.
+func (client *Dimming1) GetLoadLevelStatusCtx(ctx context.Context) (retLoadlevelStatus uint8, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &Dimming1GetLoadLevelStatusResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetLoadLevelStatus", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if retLoadlevelStatus, err = soap.UnmarshalUi1(response.retLoadlevelStatus); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// Dimming1SetOnEffectLevelRequest is the request of SetOnEffectLevel, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type Dimming1SetOnEffectLevelRequest struct {
+	newOnEffectLevel string
+}
+
+// SetOnEffectLevel performs the SetOnEffectLevel action of the service.
+//
+// Arguments:
+//   - newOnEffectLevel (in, state variable OnEffectLevel): allowed range minimum=0, maximum=100
+func (client *Dimming1) SetOnEffectLevel(newOnEffectLevel uint8) (err error) {
+	return client.SetOnEffectLevelCtx(context.Background(), newOnEffectLevel)
+}
+
+// SetOnEffectLevelCtx is SetOnEffectLevel with a context, to cancel or time out the call.
+func (client *Dimming1) SetOnEffectLevelCtx(ctx context.Context, newOnEffectLevel uint8) (err error) {
+	// Request structure.
+	request := &Dimming1SetOnEffectLevelRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.newOnEffectLevel, err = soap.MarshalUi1(newOnEffectLevel); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SetOnEffectLevel", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// Dimming1SetOnEffectRequest is the request of SetOnEffect, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type Dimming1SetOnEffectRequest struct {
+	newOnEffect string
+}
+
+// SetOnEffect performs the SetOnEffect action of the service.
+//
+// Arguments:
+//   - newOnEffect (in, state variable OnEffect): allowed values OnEffectLevel, LastSetting, Default
+func (client *Dimming1) SetOnEffect(newOnEffect Dimming1OnEffect) (err error) {
+	return client.SetOnEffectCtx(context.Background(), newOnEffect)
+}
+
+// SetOnEffectCtx is SetOnEffect with a context, to cancel or time out the call.
+func (client *Dimming1) SetOnEffectCtx(ctx context.Context, newOnEffect Dimming1OnEffect) (err error) {
+	// Request structure.
+	request := &Dimming1SetOnEffectRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.newOnEffect, err = soap.MarshalString(string(newOnEffect)); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SetOnEffect", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// Dimming1GetOnEffectParametersResponse is the response of GetOnEffectParameters, with each
+// argument in its SOAP string form.
+type Dimming1GetOnEffectParametersResponse struct {
+	retOnEffect      string
+	retOnEffectLevel string
+}
+
+// GetOnEffectParameters performs the GetOnEffectParameters action of the service.
+//
+// Return values:
+//   - retOnEffect (out, state variable OnEffect): allowed values OnEffectLevel, LastSetting, Default
+//   - retOnEffectLevel (out, state variable OnEffectLevel): allowed range minimum=0, maximum=100
+func (client *Dimming1) GetOnEffectParameters() (retOnEffect Dimming1OnEffect, retOnEffectLevel uint8, err error) {
+	return client.GetOnEffectParametersCtx(context.Background())
+}
+
+// GetOnEffectParametersCtx is GetOnEffectParameters with a context, to cancel or time out the call.
+func (client *Dimming1) GetOnEffectParametersCtx(ctx context.Context) (retOnEffect Dimming1OnEffect, retOnEffectLevel uint8, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &Dimming1GetOnEffectParametersResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetOnEffectParameters", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	retOnEffect = Dimming1OnEffect(response.retOnEffect)
+	if retOnEffectLevel, err = soap.UnmarshalUi1(response.retOnEffectLevel); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// StepUp performs the StepUp action of the service.
+func (client *Dimming1) StepUp() (err error) {
+	return client.StepUpCtx(context.Background())
+}
+
+// StepUpCtx is StepUp with a context, to cancel or time out the call.
+func (client *Dimming1) StepUpCtx(ctx context.Context) (err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "StepUp", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// StepDown performs the StepDown action of the service.
+func (client *Dimming1) StepDown() (err error) {
+	return client.StepDownCtx(context.Background())
+}
+
+// StepDownCtx is StepDown with a context, to cancel or time out the call.
+func (client *Dimming1) StepDownCtx(ctx context.Context) (err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "StepDown", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// StartRampUp performs the StartRampUp action of the service.
+func (client *Dimming1) StartRampUp() (err error) {
+	return client.StartRampUpCtx(context.Background())
+}
+
+// StartRampUpCtx is StartRampUp with a context, to cancel or time out the call.
+func (client *Dimming1) StartRampUpCtx(ctx context.Context) (err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "StartRampUp", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// StartRampDown performs the StartRampDown action of the service.
+func (client *Dimming1) StartRampDown() (err error) {
+	return client.StartRampDownCtx(context.Background())
+}
+
+// StartRampDownCtx is StartRampDown with a context, to cancel or time out the call.
+func (client *Dimming1) StartRampDownCtx(ctx context.Context) (err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "StartRampDown", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// StopRamp performs the StopRamp action of the service.
+func (client *Dimming1) StopRamp() (err error) {
+	return client.StopRampCtx(context.Background())
+}
+
+// StopRampCtx is StopRamp with a context, to cancel or time out the call.
+func (client *Dimming1) StopRampCtx(ctx context.Context) (err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "StopRamp", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// Dimming1StartRampToLevelRequest is the request of StartRampToLevel, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type Dimming1StartRampToLevelRequest struct {
+	newLoadLevelTarget string
+	newRampTime        string
+}
+
+// StartRampToLevel performs the StartRampToLevel action of the service.
+//
+// Arguments:
+//   - newLoadLevelTarget (in, state variable LoadLevelTarget): allowed range minimum=0, maximum=100
+//   - newRampTime (in, state variable RampTime)
+func (client *Dimming1) StartRampToLevel(newLoadLevelTarget uint8, newRampTime uint32) (err error) {
+	return client.StartRampToLevelCtx(context.Background(), newLoadLevelTarget, newRampTime)
+}
+
+// StartRampToLevelCtx is StartRampToLevel with a context, to cancel or time out the call.
+func (client *Dimming1) StartRampToLevelCtx(ctx context.Context, newLoadLevelTarget uint8, newRampTime uint32) (err error) {
+	// Request structure.
+	request := &Dimming1StartRampToLevelRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.newLoadLevelTarget, err = soap.MarshalUi1(newLoadLevelTarget); err != nil {
+		return
+	}
+	if request.newRampTime, err = soap.MarshalUi4(newRampTime); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "StartRampToLevel", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// Dimming1SetStepDeltaRequest is the request of SetStepDelta, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type Dimming1SetStepDeltaRequest struct {
+	newStepDelta string
+}
+
+// SetStepDelta performs the SetStepDelta action of the service.
+//
+// Arguments:
+//   - newStepDelta (in, state variable StepDelta): allowed range minimum=1, maximum=100
+func (client *Dimming1) SetStepDelta(newStepDelta uint8) (err error) {
+	return client.SetStepDeltaCtx(context.Background(), newStepDelta)
+}
+
+// SetStepDeltaCtx is SetStepDelta with a context, to cancel or time out the call.
+func (client *Dimming1) SetStepDeltaCtx(ctx context.Context, newStepDelta uint8) (err error) {
+	// Request structure.
+	request := &Dimming1SetStepDeltaRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.newStepDelta, err = soap.MarshalUi1(newStepDelta); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SetStepDelta", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// Dimming1GetStepDeltaResponse is the response of GetStepDelta, with each
+// argument in its SOAP string form.
+type Dimming1GetStepDeltaResponse struct {
+	retStepDelta string
+}
+
+// GetStepDelta performs the GetStepDelta action of the service.
+//
+// Return values:
+//   - retStepDelta (out, state variable StepDelta): allowed range minimum=1, maximum=100
+func (client *Dimming1) GetStepDelta() (retStepDelta uint8, err error) {
+	return client.GetStepDeltaCtx(context.Background())
+}
+
+// GetStepDeltaCtx is GetStepDelta with a context, to cancel or time out the call.
+func (client *Dimming1) GetStepDeltaCtx(ctx context.Context) (retStepDelta uint8, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &Dimming1GetStepDeltaResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetStepDelta", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if retStepDelta, err = soap.UnmarshalUi1(response.retStepDelta); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// Dimming1SetRampRateRequest is the request of SetRampRate, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type Dimming1SetRampRateRequest struct {
+	newRampRate string
+}
+
+// SetRampRate performs the SetRampRate action of the service.
+//
+// Arguments:
+//   - newRampRate (in, state variable RampRate): allowed range minimum=0, maximum=100
+func (client *Dimming1) SetRampRate(newRampRate uint8) (err error) {
+	return client.SetRampRateCtx(context.Background(), newRampRate)
+}
+
+// SetRampRateCtx is SetRampRate with a context, to cancel or time out the call.
+func (client *Dimming1) SetRampRateCtx(ctx context.Context, newRampRate uint8) (err error) {
+	// Request structure.
+	request := &Dimming1SetRampRateRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.newRampRate, err = soap.MarshalUi1(newRampRate); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SetRampRate", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// Dimming1GetRampRateResponse is the response of GetRampRate, with each
+// argument in its SOAP string form.
+type Dimming1GetRampRateResponse struct {
+	retRampRate string
+}
+
+// GetRampRate performs the GetRampRate action of the service.
+//
+// Return values:
+//   - retRampRate (out, state variable RampRate): allowed range minimum=0, maximum=100
+func (client *Dimming1) GetRampRate() (retRampRate uint8, err error) {
+	return client.GetRampRateCtx(context.Background())
+}
+
+// GetRampRateCtx is GetRampRate with a context, to cancel or time out the call.
+func (client *Dimming1) GetRampRateCtx(ctx context.Context) (retRampRate uint8, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &Dimming1GetRampRateResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetRampRate", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if retRampRate, err = soap.UnmarshalUi1(response.retRampRate); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// PauseRamp performs the PauseRamp action of the service.
+func (client *Dimming1) PauseRamp() (err error) {
+	return client.PauseRampCtx(context.Background())
+}
+
+// PauseRampCtx is PauseRamp with a context, to cancel or time out the call.
+func (client *Dimming1) PauseRampCtx(ctx context.Context) (err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "PauseRamp", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// ResumeRamp performs the ResumeRamp action of the service.
+func (client *Dimming1) ResumeRamp() (err error) {
+	return client.ResumeRampCtx(context.Background())
+}
+
+// ResumeRampCtx is ResumeRamp with a context, to cancel or time out the call.
+func (client *Dimming1) ResumeRampCtx(ctx context.Context) (err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "ResumeRamp", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// Dimming1GetIsRampingResponse is the response of GetIsRamping, with each
+// argument in its SOAP string form.
+type Dimming1GetIsRampingResponse struct {
+	retIsRamping string
+}
+
+// GetIsRamping performs the GetIsRamping action of the service.
+//
+// Return values:
+//   - retIsRamping (out, state variable IsRamping)
+func (client *Dimming1) GetIsRamping() (retIsRamping bool, err error) {
+	return client.GetIsRampingCtx(context.Background())
+}
+
+// GetIsRampingCtx is GetIsRamping with a context, to cancel or time out the call.
+func (client *Dimming1) GetIsRampingCtx(ctx context.Context) (retIsRamping bool, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &Dimming1GetIsRampingResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetIsRamping", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if retIsRamping, err = soap.UnmarshalBoolean(response.retIsRamping); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// Dimming1GetRampPausedResponse is the response of GetRampPaused, with each
+// argument in its SOAP string form.
+type Dimming1GetRampPausedResponse struct {
+	retRampPaused string
+}
+
+// GetRampPaused performs the GetRampPaused action of the service.
+//
+// Return values:
+//   - retRampPaused (out, state variable RampPaused)
+func (client *Dimming1) GetRampPaused() (retRampPaused bool, err error) {
+	return client.GetRampPausedCtx(context.Background())
+}
+
+// GetRampPausedCtx is GetRampPaused with a context, to cancel or time out the call.
+func (client *Dimming1) GetRampPausedCtx(ctx context.Context) (retRampPaused bool, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &Dimming1GetRampPausedResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetRampPaused", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if retRampPaused, err = soap.UnmarshalBoolean(response.retRampPaused); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// Dimming1GetRampTimeResponse is the response of GetRampTime, with each
+// argument in its SOAP string form.
+type Dimming1GetRampTimeResponse struct {
+	retRampTime string
+}
+
+// GetRampTime performs the GetRampTime action of the service.
+//
+// Return values:
+//   - retRampTime (out, state variable RampTime)
+func (client *Dimming1) GetRampTime() (retRampTime uint32, err error) {
+	return client.GetRampTimeCtx(context.Background())
+}
+
+// GetRampTimeCtx is GetRampTime with a context, to cancel or time out the call.
+func (client *Dimming1) GetRampTimeCtx(ctx context.Context) (retRampTime uint32, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &Dimming1GetRampTimeResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetRampTime", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if retRampTime, err = soap.UnmarshalUi4(response.retRampTime); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// SwitchPower1 is a client for UPnP SOAP service with URN "urn:schemas-upnp-org:service:SwitchPower:1". See
+// goupnp.ServiceClient, which contains RootDevice and Service attributes which
+// are provided for informational value.
+type SwitchPower1 struct {
+	goupnp.ServiceClient
+}
+
+// SwitchPower1Client is the interface of the actions of SwitchPower1, for
+// substituting fakes or mocks for the service in tests.
+type SwitchPower1Client interface {
+	SetTarget(newTargetValue bool) (err error)
+	SetTargetCtx(ctx context.Context, newTargetValue bool) (err error)
+	GetTarget() (RetTargetValue bool, err error)
+	GetTargetCtx(ctx context.Context) (RetTargetValue bool, err error)
+	GetStatus() (ResultStatus bool, err error)
+	GetStatusCtx(ctx context.Context) (ResultStatus bool, err error)
+}
+
+var _ SwitchPower1Client = new(SwitchPower1)
+
+// NewSwitchPower1Clients discovers instances of the service on the network,
+// and returns clients to any that are found. errors will contain an error for
+// any devices that replied but which could not be queried, and err will be set
+// if the discovery process failed outright.
+//
+// This is a typical entry calling point into this package.
+func NewSwitchPower1Clients() (clients []*SwitchPower1, errors []error, err error) {
+	var genericClients []goupnp.ServiceClient
+	if genericClients, errors, err = goupnp.NewServiceClients(URN_SwitchPower_1); err != nil {
+		return
+	}
+	clients = newSwitchPower1ClientsFromGenericClients(genericClients)
+	return
+}
+
+// NewSwitchPower1ClientsByURL discovers instances of the service at the given
+// URL, and returns clients to any that are found. An error is returned if
+// there was an error probing the service.
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered service URL.
+func NewSwitchPower1ClientsByURL(loc *url.URL) ([]*SwitchPower1, error) {
+	genericClients, err := goupnp.NewServiceClientsByURL(loc, URN_SwitchPower_1)
+	if err != nil {
+		return nil, err
+	}
+	return newSwitchPower1ClientsFromGenericClients(genericClients), nil
+}
+
+// NewSwitchPower1ClientsFromRootDevice discovers instances of the service in
+// a given root device, and returns clients to any that are found. An error is
+// returned if there was not at least one instance of the service within the
+// device. The location parameter is simply assigned to the Location attribute
+// of the wrapped ServiceClient(s).
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered root device.
+func NewSwitchPower1ClientsFromRootDevice(rootDevice *goupnp.RootDevice, loc *url.URL) ([]*SwitchPower1, error) {
+	genericClients, err := goupnp.NewServiceClientsFromRootDevice(rootDevice, loc, URN_SwitchPower_1)
+	if err != nil {
+		return nil, err
+	}
+	return newSwitchPower1ClientsFromGenericClients(genericClients), nil
+}
+
+func newSwitchPower1ClientsFromGenericClients(genericClients []goupnp.ServiceClient) []*SwitchPower1 {
+	clients := make([]*SwitchPower1, len(genericClients))
+	for i := range genericClients {
+		clients[i] = &SwitchPower1{genericClients[i]}
+	}
+	return clients
+}
+
+// SwitchPower1Timeouts are the default timeouts of the actions of
+// SwitchPower1, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var SwitchPower1Timeouts = map[string]time.Duration{}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *SwitchPower1) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, SwitchPower1Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_SwitchPower_1, actionName, request, response)
+}
+
+// SwitchPower1SetTargetRequest is the request of SetTarget, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type SwitchPower1SetTargetRequest struct {
+	newTargetValue string
+}
+
+// SetTarget performs the SetTarget action of the service.
+//
+// Arguments:
+//   - newTargetValue (in, state variable Target)
+func (client *SwitchPower1) SetTarget(newTargetValue bool) (err error) {
+	return client.SetTargetCtx(context.Background(), newTargetValue)
+}
+
+// SetTargetCtx is SetTarget with a context, to cancel or time out the call.
+func (client *SwitchPower1) SetTargetCtx(ctx context.Context, newTargetValue bool) (err error) {
+	// Request structure.
+	request := &SwitchPower1SetTargetRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.newTargetValue, err = soap.MarshalBoolean(newTargetValue); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SetTarget", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// SwitchPower1GetTargetResponse is the response of GetTarget, with each
+// argument in its SOAP string form.
+type SwitchPower1GetTargetResponse struct {
+	RetTargetValue string
+}
+
+// GetTarget performs the GetTarget action of the service.
+//
+// Return values:
+//   - RetTargetValue (out, state variable Target)
+func (client *SwitchPower1) GetTarget() (RetTargetValue bool, err error) {
+	return client.GetTargetCtx(context.Background())
+}
+
+// GetTargetCtx is GetTarget with a context, to cancel or time out the call.
+func (client *SwitchPower1) GetTargetCtx(ctx context.Context) (RetTargetValue bool, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &SwitchPower1GetTargetResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetTarget", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if RetTargetValue, err = soap.UnmarshalBoolean(response.RetTargetValue); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// SwitchPower1GetStatusResponse is the response of GetStatus, with each
+// argument in its SOAP string form.
+type SwitchPower1GetStatusResponse struct {
+	ResultStatus string
+}
+
+// GetStatus performs the GetStatus action of the service.
+//
+// Return values:
+//   - ResultStatus (out, state variable Status)
+func (client *SwitchPower1) GetStatus() (ResultStatus bool, err error) {
+	return client.GetStatusCtx(context.Background())
+}
+
+// GetStatusCtx is GetStatus with a context, to cancel or time out the call.
+func (client *SwitchPower1) GetStatusCtx(ctx context.Context) (ResultStatus bool, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &SwitchPower1GetStatusResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetStatus", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if ResultStatus, err = soap.UnmarshalBoolean(response.ResultStatus); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
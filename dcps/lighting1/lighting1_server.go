@@ -0,0 +1,708 @@
+package lighting1
+
+// Generated file - do not edit by hand. See README.md
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/huin/goupnp/device"
+	"github.com/huin/goupnp/soap"
+)
+
+// Hack to avoid Go complaining if url or time aren't used.
+var _ *url.URL
+var _ time.Time
+
+// Dimming1Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:schemas-upnp-org:service:Dimming:1". See RegisterDimming1Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type Dimming1Handler interface {
+	SetLoadLevelTarget(ctx context.Context, newLoadlevelTarget uint8) (err error)
+
+	GetLoadLevelTarget(ctx context.Context) (GetLoadlevelTarget uint8, err error)
+
+	GetLoadLevelStatus(ctx context.Context) (retLoadlevelStatus uint8, err error)
+
+	SetOnEffectLevel(ctx context.Context, newOnEffectLevel uint8) (err error)
+
+	SetOnEffect(ctx context.Context, newOnEffect Dimming1OnEffect) (err error)
+
+	GetOnEffectParameters(ctx context.Context) (retOnEffect Dimming1OnEffect, retOnEffectLevel uint8, err error)
+
+	StepUp(ctx context.Context) (err error)
+
+	StepDown(ctx context.Context) (err error)
+
+	StartRampUp(ctx context.Context) (err error)
+
+	StartRampDown(ctx context.Context) (err error)
+
+	StopRamp(ctx context.Context) (err error)
+
+	StartRampToLevel(ctx context.Context, newLoadLevelTarget uint8, newRampTime uint32) (err error)
+
+	SetStepDelta(ctx context.Context, newStepDelta uint8) (err error)
+
+	GetStepDelta(ctx context.Context) (retStepDelta uint8, err error)
+
+	SetRampRate(ctx context.Context, newRampRate uint8) (err error)
+
+	GetRampRate(ctx context.Context) (retRampRate uint8, err error)
+
+	PauseRamp(ctx context.Context) (err error)
+
+	ResumeRamp(ctx context.Context) (err error)
+
+	GetIsRamping(ctx context.Context) (retIsRamping bool, err error)
+
+	GetRampPaused(ctx context.Context) (retRampPaused bool, err error)
+
+	GetRampTime(ctx context.Context) (retRampTime uint32, err error)
+}
+
+// RegisterDimming1Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_Dimming_1.
+func RegisterDimming1Handler(svc *device.Service, handler Dimming1Handler) {
+	svc.HandleFunc("SetLoadLevelTarget", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveDimming1SetLoadLevelTarget(ctx, handler, in)
+	})
+	svc.HandleFunc("GetLoadLevelTarget", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveDimming1GetLoadLevelTarget(ctx, handler, in)
+	})
+	svc.HandleFunc("GetLoadLevelStatus", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveDimming1GetLoadLevelStatus(ctx, handler, in)
+	})
+	svc.HandleFunc("SetOnEffectLevel", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveDimming1SetOnEffectLevel(ctx, handler, in)
+	})
+	svc.HandleFunc("SetOnEffect", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveDimming1SetOnEffect(ctx, handler, in)
+	})
+	svc.HandleFunc("GetOnEffectParameters", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveDimming1GetOnEffectParameters(ctx, handler, in)
+	})
+	svc.HandleFunc("StepUp", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveDimming1StepUp(ctx, handler, in)
+	})
+	svc.HandleFunc("StepDown", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveDimming1StepDown(ctx, handler, in)
+	})
+	svc.HandleFunc("StartRampUp", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveDimming1StartRampUp(ctx, handler, in)
+	})
+	svc.HandleFunc("StartRampDown", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveDimming1StartRampDown(ctx, handler, in)
+	})
+	svc.HandleFunc("StopRamp", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveDimming1StopRamp(ctx, handler, in)
+	})
+	svc.HandleFunc("StartRampToLevel", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveDimming1StartRampToLevel(ctx, handler, in)
+	})
+	svc.HandleFunc("SetStepDelta", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveDimming1SetStepDelta(ctx, handler, in)
+	})
+	svc.HandleFunc("GetStepDelta", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveDimming1GetStepDelta(ctx, handler, in)
+	})
+	svc.HandleFunc("SetRampRate", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveDimming1SetRampRate(ctx, handler, in)
+	})
+	svc.HandleFunc("GetRampRate", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveDimming1GetRampRate(ctx, handler, in)
+	})
+	svc.HandleFunc("PauseRamp", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveDimming1PauseRamp(ctx, handler, in)
+	})
+	svc.HandleFunc("ResumeRamp", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveDimming1ResumeRamp(ctx, handler, in)
+	})
+	svc.HandleFunc("GetIsRamping", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveDimming1GetIsRamping(ctx, handler, in)
+	})
+	svc.HandleFunc("GetRampPaused", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveDimming1GetRampPaused(ctx, handler, in)
+	})
+	svc.HandleFunc("GetRampTime", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveDimming1GetRampTime(ctx, handler, in)
+	})
+}
+
+func serveDimming1SetLoadLevelTarget(ctx context.Context, handler Dimming1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var newLoadlevelTarget uint8
+	if value, err = soap.FindArg(in, "newLoadlevelTarget"); err != nil {
+		return
+	}
+	if newLoadlevelTarget, err = soap.UnmarshalUi1(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument newLoadlevelTarget: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetLoadLevelTarget(ctx, newLoadlevelTarget); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveDimming1GetLoadLevelTarget(ctx context.Context, handler Dimming1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var GetLoadlevelTarget uint8
+	if GetLoadlevelTarget, err = handler.GetLoadLevelTarget(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "GetLoadlevelTarget"
+	if out[0].Value, err = soap.MarshalUi1(GetLoadlevelTarget); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveDimming1GetLoadLevelStatus(ctx context.Context, handler Dimming1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var retLoadlevelStatus uint8
+	if retLoadlevelStatus, err = handler.GetLoadLevelStatus(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "retLoadlevelStatus"
+	if out[0].Value, err = soap.MarshalUi1(retLoadlevelStatus); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveDimming1SetOnEffectLevel(ctx context.Context, handler Dimming1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var newOnEffectLevel uint8
+	if value, err = soap.FindArg(in, "newOnEffectLevel"); err != nil {
+		return
+	}
+	if newOnEffectLevel, err = soap.UnmarshalUi1(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument newOnEffectLevel: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetOnEffectLevel(ctx, newOnEffectLevel); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveDimming1SetOnEffect(ctx context.Context, handler Dimming1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var newOnEffect Dimming1OnEffect
+	if value, err = soap.FindArg(in, "newOnEffect"); err != nil {
+		return
+	}
+	newOnEffect = Dimming1OnEffect(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetOnEffect(ctx, newOnEffect); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveDimming1GetOnEffectParameters(ctx context.Context, handler Dimming1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var retOnEffect Dimming1OnEffect
+	var retOnEffectLevel uint8
+	if retOnEffect, retOnEffectLevel, err = handler.GetOnEffectParameters(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "retOnEffect"
+	if out[0].Value, err = soap.MarshalString(string(retOnEffect)); err != nil {
+		return
+	}
+	out[1].Name = "retOnEffectLevel"
+	if out[1].Value, err = soap.MarshalUi1(retOnEffectLevel); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveDimming1StepUp(ctx context.Context, handler Dimming1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.StepUp(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveDimming1StepDown(ctx context.Context, handler Dimming1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.StepDown(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveDimming1StartRampUp(ctx context.Context, handler Dimming1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.StartRampUp(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveDimming1StartRampDown(ctx context.Context, handler Dimming1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.StartRampDown(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveDimming1StopRamp(ctx context.Context, handler Dimming1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.StopRamp(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveDimming1StartRampToLevel(ctx context.Context, handler Dimming1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var newLoadLevelTarget uint8
+	if value, err = soap.FindArg(in, "newLoadLevelTarget"); err != nil {
+		return
+	}
+	if newLoadLevelTarget, err = soap.UnmarshalUi1(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument newLoadLevelTarget: "+err.Error())
+	}
+	var newRampTime uint32
+	if value, err = soap.FindArg(in, "newRampTime"); err != nil {
+		return
+	}
+	if newRampTime, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument newRampTime: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.StartRampToLevel(ctx, newLoadLevelTarget, newRampTime); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveDimming1SetStepDelta(ctx context.Context, handler Dimming1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var newStepDelta uint8
+	if value, err = soap.FindArg(in, "newStepDelta"); err != nil {
+		return
+	}
+	if newStepDelta, err = soap.UnmarshalUi1(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument newStepDelta: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetStepDelta(ctx, newStepDelta); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveDimming1GetStepDelta(ctx context.Context, handler Dimming1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var retStepDelta uint8
+	if retStepDelta, err = handler.GetStepDelta(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "retStepDelta"
+	if out[0].Value, err = soap.MarshalUi1(retStepDelta); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveDimming1SetRampRate(ctx context.Context, handler Dimming1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var newRampRate uint8
+	if value, err = soap.FindArg(in, "newRampRate"); err != nil {
+		return
+	}
+	if newRampRate, err = soap.UnmarshalUi1(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument newRampRate: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetRampRate(ctx, newRampRate); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveDimming1GetRampRate(ctx context.Context, handler Dimming1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var retRampRate uint8
+	if retRampRate, err = handler.GetRampRate(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "retRampRate"
+	if out[0].Value, err = soap.MarshalUi1(retRampRate); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveDimming1PauseRamp(ctx context.Context, handler Dimming1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.PauseRamp(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveDimming1ResumeRamp(ctx context.Context, handler Dimming1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.ResumeRamp(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveDimming1GetIsRamping(ctx context.Context, handler Dimming1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var retIsRamping bool
+	if retIsRamping, err = handler.GetIsRamping(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "retIsRamping"
+	if out[0].Value, err = soap.MarshalBoolean(retIsRamping); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveDimming1GetRampPaused(ctx context.Context, handler Dimming1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var retRampPaused bool
+	if retRampPaused, err = handler.GetRampPaused(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "retRampPaused"
+	if out[0].Value, err = soap.MarshalBoolean(retRampPaused); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveDimming1GetRampTime(ctx context.Context, handler Dimming1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var retRampTime uint32
+	if retRampTime, err = handler.GetRampTime(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "retRampTime"
+	if out[0].Value, err = soap.MarshalUi4(retRampTime); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+// SwitchPower1Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:schemas-upnp-org:service:SwitchPower:1". See RegisterSwitchPower1Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type SwitchPower1Handler interface {
+	SetTarget(ctx context.Context, newTargetValue bool) (err error)
+
+	GetTarget(ctx context.Context) (RetTargetValue bool, err error)
+
+	GetStatus(ctx context.Context) (ResultStatus bool, err error)
+}
+
+// RegisterSwitchPower1Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_SwitchPower_1.
+func RegisterSwitchPower1Handler(svc *device.Service, handler SwitchPower1Handler) {
+	svc.HandleFunc("SetTarget", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveSwitchPower1SetTarget(ctx, handler, in)
+	})
+	svc.HandleFunc("GetTarget", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveSwitchPower1GetTarget(ctx, handler, in)
+	})
+	svc.HandleFunc("GetStatus", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveSwitchPower1GetStatus(ctx, handler, in)
+	})
+}
+
+func serveSwitchPower1SetTarget(ctx context.Context, handler SwitchPower1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var newTargetValue bool
+	if value, err = soap.FindArg(in, "newTargetValue"); err != nil {
+		return
+	}
+	if newTargetValue, err = soap.UnmarshalBoolean(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument newTargetValue: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetTarget(ctx, newTargetValue); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveSwitchPower1GetTarget(ctx context.Context, handler SwitchPower1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var RetTargetValue bool
+	if RetTargetValue, err = handler.GetTarget(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "RetTargetValue"
+	if out[0].Value, err = soap.MarshalBoolean(RetTargetValue); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveSwitchPower1GetStatus(ctx context.Context, handler SwitchPower1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var ResultStatus bool
+	if ResultStatus, err = handler.GetStatus(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "ResultStatus"
+	if out[0].Value, err = soap.MarshalBoolean(ResultStatus); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
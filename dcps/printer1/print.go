@@ -0,0 +1,83 @@
+package printer1
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+)
+
+// Job holds the arguments of CreateJob. Empty settings are sent as
+// "device-setting", leaving them to the printer, and zero Copies as 1.
+type Job struct {
+	Name           string
+	UserName       string
+	DocumentFormat string // MIME type of the document, e.g. "application/pdf".
+	Copies         uint8
+	Sides          PrintBasic1Sides
+	NumberUp       PrintBasic1NumberUp
+	Orientation    PrintBasic1OrientationRequested
+	MediaSize      string
+	MediaType      string
+	PrintQuality   PrintBasic1PrintQuality
+}
+
+// PrintDocument prints doc with the printer: it creates a job with CreateJob
+// and sends doc to the DataSink of the job with SendDocument. The job is
+// cancelled if doc cannot be sent. It returns the ID of the job, whose
+// progress can be followed with GetJobAttributes.
+func PrintDocument(ctx context.Context, client PrintBasic1Client, job Job, doc io.Reader) (uint32, error) {
+	if job.Copies == 0 {
+		job.Copies = 1
+	}
+	if job.Sides == "" {
+		job.Sides = PrintBasic1Sides_device_setting
+	}
+	if job.NumberUp == "" {
+		job.NumberUp = PrintBasic1NumberUp_device_setting
+	}
+	if job.Orientation == "" {
+		job.Orientation = PrintBasic1OrientationRequested_device_setting
+	}
+	if job.PrintQuality == "" {
+		job.PrintQuality = PrintBasic1PrintQuality_device_setting
+	}
+	jobID, dataSink, err := client.CreateJobCtx(ctx, job.Name, job.UserName, job.DocumentFormat, job.Copies,
+		job.Sides, job.NumberUp, job.Orientation, job.MediaSize, job.MediaType, job.PrintQuality)
+	if err != nil {
+		return 0, err
+	}
+	if err := SendDocument(ctx, dataSink, job.DocumentFormat, doc); err != nil {
+		client.CancelJobCtx(ctx, jobID)
+		return 0, err
+	}
+	return jobID, nil
+}
+
+// SendDocument sends doc, of the MIME type documentFormat, to the DataSink
+// returned by CreateJob or CreateJobV2, with an HTTP POST.
+func SendDocument(ctx context.Context, dataSink *url.URL, documentFormat string, doc io.Reader) error {
+	if dataSink == nil {
+		return errors.New("goupnp: printer returned no DataSink for the job")
+	}
+	req, err := http.NewRequest("POST", dataSink.String(), doc)
+	if err != nil {
+		return err
+	}
+	if documentFormat != "" {
+		req.Header.Set("Content-Type", documentFormat)
+	}
+	resp, err := http.DefaultClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	io.Copy(ioutil.Discard, resp.Body)
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("goupnp: got response status %s sending document to %q", resp.Status, dataSink)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package printer1
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+type fakePrinter struct {
+	PrintBasic1Client
+	dataSink  *url.URL
+	job       PrintBasic1CreateJobRequest
+	cancelled bool
+}
+
+func (f *fakePrinter) CreateJobCtx(ctx context.Context, JobName string, JobOriginatingUserName string, DocumentFormat string,
+	Copies uint8, Sides PrintBasic1Sides, NumberUp PrintBasic1NumberUp, OrientationRequested PrintBasic1OrientationRequested,
+	MediaSize string, MediaType string, PrintQuality PrintBasic1PrintQuality) (uint32, *url.URL, error) {
+	f.job = PrintBasic1CreateJobRequest{JobName: JobName, Sides: string(Sides), PrintQuality: string(PrintQuality)}
+	return 7, f.dataSink, nil
+}
+
+func (f *fakePrinter) CancelJobCtx(ctx context.Context, JobId uint32) error {
+	f.cancelled = true
+	return nil
+}
+
+func TestPrintDocument(t *testing.T) {
+	var got, gotType string
+	sink := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/job/7" {
+			http.NotFound(w, r)
+			return
+		}
+		body, _ := ioutil.ReadAll(r.Body)
+		got, gotType = string(body), r.Header.Get("Content-Type")
+	}))
+	defer sink.Close()
+
+	dataSink, _ := url.Parse(sink.URL + "/job/7")
+	f := &fakePrinter{dataSink: dataSink}
+	job := Job{Name: "test", DocumentFormat: "text/plain", PrintQuality: PrintBasic1PrintQuality_draft}
+	id, err := PrintDocument(context.Background(), f, job, strings.NewReader("hello"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != 7 || got != "hello" || gotType != "text/plain" {
+		t.Errorf("got job %d with document %q of type %q", id, got, gotType)
+	}
+	if f.job.Sides != "device-setting" || f.job.PrintQuality != "draft" {
+		t.Errorf("created job %+v, want default sides and draft quality", f.job)
+	}
+
+	f.dataSink, _ = url.Parse(sink.URL + "/missing")
+	if _, err := PrintDocument(context.Background(), f, job, strings.NewReader("hello")); err == nil || !f.cancelled {
+		t.Errorf("PrintDocument() to a bad DataSink = %v, cancelled %t, want error and cancelled job", err, f.cancelled)
+	}
+}
@@ -0,0 +1,1476 @@
+// Client for UPnP Device Control Protocol Printer v1.
+//
+// Typically, use one of the New* functions to create clients for services.
+package printer1
+
+// Generated file - do not edit by hand. See README.md
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/huin/goupnp"
+	"github.com/huin/goupnp/soap"
+)
+
+// Hack to avoid Go complaining if time isn't used.
+var _ time.Time
+
+// Device URNs:
+const (
+	URN_Printer_1 = "urn:schemas-upnp-org:device:Printer:1"
+)
+
+// Service URNs:
+const (
+	URN_PrintBasic_1    = "urn:schemas-upnp-org:service:PrintBasic:1"
+	URN_PrintEnhanced_1 = "urn:schemas-upnp-org:service:PrintEnhanced:1"
+)
+
+// PrintBasic1 is a client for UPnP SOAP service with URN "urn:schemas-upnp-org:service:PrintBasic:1". See
+// goupnp.ServiceClient, which contains RootDevice and Service attributes which
+// are provided for informational value.
+type PrintBasic1 struct {
+	goupnp.ServiceClient
+}
+
+// PrintBasic1Client is the interface of the actions of PrintBasic1, for
+// substituting fakes or mocks for the service in tests.
+type PrintBasic1Client interface {
+	GetPrinterAttributes() (PrinterState PrintBasic1PrinterState, PrinterStateReasons string, JobIdList string, JobId uint32, err error)
+	GetPrinterAttributesCtx(ctx context.Context) (PrinterState PrintBasic1PrinterState, PrinterStateReasons string, JobIdList string, JobId uint32, err error)
+	CreateJob(JobName string, JobOriginatingUserName string, DocumentFormat string, Copies uint8, Sides PrintBasic1Sides, NumberUp PrintBasic1NumberUp, OrientationRequested PrintBasic1OrientationRequested, MediaSize string, MediaType string, PrintQuality PrintBasic1PrintQuality) (JobId uint32, DataSink *url.URL, err error)
+	CreateJobCtx(ctx context.Context, JobName string, JobOriginatingUserName string, DocumentFormat string, Copies uint8, Sides PrintBasic1Sides, NumberUp PrintBasic1NumberUp, OrientationRequested PrintBasic1OrientationRequested, MediaSize string, MediaType string, PrintQuality PrintBasic1PrintQuality) (JobId uint32, DataSink *url.URL, err error)
+	CancelJob(JobId uint32) (err error)
+	CancelJobCtx(ctx context.Context, JobId uint32) (err error)
+	GetJobAttributes(JobId uint32) (JobName string, JobOriginatingUserName string, JobMediaSheetsCompleted string, err error)
+	GetJobAttributesCtx(ctx context.Context, JobId uint32) (JobName string, JobOriginatingUserName string, JobMediaSheetsCompleted string, err error)
+	GetMargins(MediaSize string, MediaType string) (Margins string, err error)
+	GetMarginsCtx(ctx context.Context, MediaSize string, MediaType string) (Margins string, err error)
+	GetMediaList(MediaSize string, MediaType string) (MediaList string, err error)
+	GetMediaListCtx(ctx context.Context, MediaSize string, MediaType string) (MediaList string, err error)
+}
+
+var _ PrintBasic1Client = new(PrintBasic1)
+
+// PrintBasic1PrinterState is a value of the state variable PrinterState of
+// PrintBasic1.
+type PrintBasic1PrinterState string
+
+// Allowed values of PrintBasic1PrinterState.
+const (
+	PrintBasic1PrinterState_idle       PrintBasic1PrinterState = "idle"
+	PrintBasic1PrinterState_processing PrintBasic1PrinterState = "processing"
+	PrintBasic1PrinterState_stopped    PrintBasic1PrinterState = "stopped"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v PrintBasic1PrinterState) Valid() bool {
+	switch v {
+	case PrintBasic1PrinterState_idle,
+		PrintBasic1PrinterState_processing,
+		PrintBasic1PrinterState_stopped:
+		return true
+	}
+	return false
+}
+
+// PrintBasic1Sides is a value of the state variable Sides of
+// PrintBasic1.
+type PrintBasic1Sides string
+
+// Allowed values of PrintBasic1Sides.
+const (
+	PrintBasic1Sides_one_sided            PrintBasic1Sides = "one-sided"
+	PrintBasic1Sides_two_sided_long_edge  PrintBasic1Sides = "two-sided-long-edge"
+	PrintBasic1Sides_two_sided_short_edge PrintBasic1Sides = "two-sided-short-edge"
+	PrintBasic1Sides_device_setting       PrintBasic1Sides = "device-setting"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v PrintBasic1Sides) Valid() bool {
+	switch v {
+	case PrintBasic1Sides_one_sided,
+		PrintBasic1Sides_two_sided_long_edge,
+		PrintBasic1Sides_two_sided_short_edge,
+		PrintBasic1Sides_device_setting:
+		return true
+	}
+	return false
+}
+
+// PrintBasic1NumberUp is a value of the state variable NumberUp of
+// PrintBasic1.
+type PrintBasic1NumberUp string
+
+// Allowed values of PrintBasic1NumberUp.
+const (
+	PrintBasic1NumberUp_1              PrintBasic1NumberUp = "1"
+	PrintBasic1NumberUp_2              PrintBasic1NumberUp = "2"
+	PrintBasic1NumberUp_4              PrintBasic1NumberUp = "4"
+	PrintBasic1NumberUp_device_setting PrintBasic1NumberUp = "device-setting"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v PrintBasic1NumberUp) Valid() bool {
+	switch v {
+	case PrintBasic1NumberUp_1,
+		PrintBasic1NumberUp_2,
+		PrintBasic1NumberUp_4,
+		PrintBasic1NumberUp_device_setting:
+		return true
+	}
+	return false
+}
+
+// PrintBasic1OrientationRequested is a value of the state variable OrientationRequested of
+// PrintBasic1.
+type PrintBasic1OrientationRequested string
+
+// Allowed values of PrintBasic1OrientationRequested.
+const (
+	PrintBasic1OrientationRequested_portrait       PrintBasic1OrientationRequested = "portrait"
+	PrintBasic1OrientationRequested_landscape      PrintBasic1OrientationRequested = "landscape"
+	PrintBasic1OrientationRequested_device_setting PrintBasic1OrientationRequested = "device-setting"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v PrintBasic1OrientationRequested) Valid() bool {
+	switch v {
+	case PrintBasic1OrientationRequested_portrait,
+		PrintBasic1OrientationRequested_landscape,
+		PrintBasic1OrientationRequested_device_setting:
+		return true
+	}
+	return false
+}
+
+// PrintBasic1PrintQuality is a value of the state variable PrintQuality of
+// PrintBasic1.
+type PrintBasic1PrintQuality string
+
+// Allowed values of PrintBasic1PrintQuality.
+const (
+	PrintBasic1PrintQuality_draft          PrintBasic1PrintQuality = "draft"
+	PrintBasic1PrintQuality_normal         PrintBasic1PrintQuality = "normal"
+	PrintBasic1PrintQuality_high           PrintBasic1PrintQuality = "high"
+	PrintBasic1PrintQuality_device_setting PrintBasic1PrintQuality = "device-setting"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v PrintBasic1PrintQuality) Valid() bool {
+	switch v {
+	case PrintBasic1PrintQuality_draft,
+		PrintBasic1PrintQuality_normal,
+		PrintBasic1PrintQuality_high,
+		PrintBasic1PrintQuality_device_setting:
+		return true
+	}
+	return false
+}
+
+// NewPrintBasic1Clients discovers instances of the service on the network,
+// and returns clients to any that are found. errors will contain an error for
+// any devices that replied but which could not be queried, and err will be set
+// if the discovery process failed outright.
+//
+// This is a typical entry calling point into this package.
+func NewPrintBasic1Clients() (clients []*PrintBasic1, errors []error, err error) {
+	var genericClients []goupnp.ServiceClient
+	if genericClients, errors, err = goupnp.NewServiceClients(URN_PrintBasic_1); err != nil {
+		return
+	}
+	clients = newPrintBasic1ClientsFromGenericClients(genericClients)
+	return
+}
+
+// NewPrintBasic1ClientsByURL discovers instances of the service at the given
+// URL, and returns clients to any that are found. An error is returned if
+// there was an error probing the service.
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered service URL.
+func NewPrintBasic1ClientsByURL(loc *url.URL) ([]*PrintBasic1, error) {
+	genericClients, err := goupnp.NewServiceClientsByURL(loc, URN_PrintBasic_1)
+	if err != nil {
+		return nil, err
+	}
+	return newPrintBasic1ClientsFromGenericClients(genericClients), nil
+}
+
+// NewPrintBasic1ClientsFromRootDevice discovers instances of the service in
+// a given root device, and returns clients to any that are found. An error is
+// returned if there was not at least one instance of the service within the
+// device. The location parameter is simply assigned to the Location attribute
+// of the wrapped ServiceClient(s).
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered root device.
+func NewPrintBasic1ClientsFromRootDevice(rootDevice *goupnp.RootDevice, loc *url.URL) ([]*PrintBasic1, error) {
+	genericClients, err := goupnp.NewServiceClientsFromRootDevice(rootDevice, loc, URN_PrintBasic_1)
+	if err != nil {
+		return nil, err
+	}
+	return newPrintBasic1ClientsFromGenericClients(genericClients), nil
+}
+
+func newPrintBasic1ClientsFromGenericClients(genericClients []goupnp.ServiceClient) []*PrintBasic1 {
+	clients := make([]*PrintBasic1, len(genericClients))
+	for i := range genericClients {
+		clients[i] = &PrintBasic1{genericClients[i]}
+	}
+	return clients
+}
+
+// PrintBasic1Timeouts are the default timeouts of the actions of
+// PrintBasic1, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var PrintBasic1Timeouts = map[string]time.Duration{}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *PrintBasic1) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, PrintBasic1Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_PrintBasic_1, actionName, request, response)
+}
+
+// PrintBasic1GetPrinterAttributesResponse is the response of GetPrinterAttributes, with each
+// argument in its SOAP string form.
+type PrintBasic1GetPrinterAttributesResponse struct {
+	PrinterState        string
+	PrinterStateReasons string
+	JobIdList           string
+	JobId               string
+}
+
+// GetPrinterAttributes performs the GetPrinterAttributes action of the service.
+//
+// Return values:
+//   - PrinterState (out, state variable PrinterState): allowed values idle, processing, stopped
+//   - PrinterStateReasons (out, state variable PrinterStateReasons)
+//   - JobIdList (out, state variable JobIdList)
+//   - JobId (out, state variable JobId)
+func (client *PrintBasic1) GetPrinterAttributes() (PrinterState PrintBasic1PrinterState, PrinterStateReasons string, JobIdList string, JobId uint32, err error) {
+	return client.GetPrinterAttributesCtx(context.Background())
+}
+
+// GetPrinterAttributesCtx is GetPrinterAttributes with a context, to cancel or time out the call.
+func (client *PrintBasic1) GetPrinterAttributesCtx(ctx context.Context) (PrinterState PrintBasic1PrinterState, PrinterStateReasons string, JobIdList string, JobId uint32, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &PrintBasic1GetPrinterAttributesResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetPrinterAttributes", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	PrinterState = PrintBasic1PrinterState(response.PrinterState)
+	if PrinterStateReasons, err = soap.UnmarshalString(response.PrinterStateReasons); err != nil {
+		return
+	}
+	if JobIdList, err = soap.UnmarshalString(response.JobIdList); err != nil {
+		return
+	}
+	if JobId, err = soap.UnmarshalUi4(response.JobId); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// PrintBasic1CreateJobRequest is the request of CreateJob, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type PrintBasic1CreateJobRequest struct {
+	JobName                string
+	JobOriginatingUserName string
+	DocumentFormat         string
+	Copies                 string
+	Sides                  string
+	NumberUp               string
+	OrientationRequested   string
+	MediaSize              string
+	MediaType              string
+	PrintQuality           string
+}
+
+// PrintBasic1CreateJobResponse is the response of CreateJob, with each
+// argument in its SOAP string form.
+type PrintBasic1CreateJobResponse struct {
+	JobId    string
+	DataSink string
+}
+
+// CreateJob performs the CreateJob action of the service.
+//
+// Arguments:
+//   - JobName (in, state variable JobName)
+//   - JobOriginatingUserName (in, state variable JobOriginatingUserName)
+//   - DocumentFormat (in, state variable DocumentFormat)
+//   - Copies (in, state variable Copies): allowed range minimum=1, maximum=255
+//   - Sides (in, state variable Sides): allowed values one-sided, two-sided-long-edge, two-sided-short-edge, device-setting
+//   - NumberUp (in, state variable NumberUp): allowed values 1, 2, 4, device-setting
+//   - OrientationRequested (in, state variable OrientationRequested): allowed values portrait, landscape, device-setting
+//   - MediaSize (in, state variable MediaSize)
+//   - MediaType (in, state variable MediaType)
+//   - PrintQuality (in, state variable PrintQuality): allowed values draft, normal, high, device-setting
+//
+// Return values:
+//   - JobId (out, state variable JobId)
+//   - DataSink (out, state variable DataSink)
+func (client *PrintBasic1) CreateJob(JobName string, JobOriginatingUserName string, DocumentFormat string, Copies uint8, Sides PrintBasic1Sides, NumberUp PrintBasic1NumberUp, OrientationRequested PrintBasic1OrientationRequested, MediaSize string, MediaType string, PrintQuality PrintBasic1PrintQuality) (JobId uint32, DataSink *url.URL, err error) {
+	return client.CreateJobCtx(context.Background(), JobName, JobOriginatingUserName, DocumentFormat, Copies, Sides, NumberUp, OrientationRequested, MediaSize, MediaType, PrintQuality)
+}
+
+// CreateJobCtx is CreateJob with a context, to cancel or time out the call.
+func (client *PrintBasic1) CreateJobCtx(ctx context.Context, JobName string, JobOriginatingUserName string, DocumentFormat string, Copies uint8, Sides PrintBasic1Sides, NumberUp PrintBasic1NumberUp, OrientationRequested PrintBasic1OrientationRequested, MediaSize string, MediaType string, PrintQuality PrintBasic1PrintQuality) (JobId uint32, DataSink *url.URL, err error) {
+	// Request structure.
+	request := &PrintBasic1CreateJobRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.JobName, err = soap.MarshalString(JobName); err != nil {
+		return
+	}
+	if request.JobOriginatingUserName, err = soap.MarshalString(JobOriginatingUserName); err != nil {
+		return
+	}
+	if request.DocumentFormat, err = soap.MarshalString(DocumentFormat); err != nil {
+		return
+	}
+	if request.Copies, err = soap.MarshalUi1(Copies); err != nil {
+		return
+	}
+	if request.Sides, err = soap.MarshalString(string(Sides)); err != nil {
+		return
+	}
+	if request.NumberUp, err = soap.MarshalString(string(NumberUp)); err != nil {
+		return
+	}
+	if request.OrientationRequested, err = soap.MarshalString(string(OrientationRequested)); err != nil {
+		return
+	}
+	if request.MediaSize, err = soap.MarshalString(MediaSize); err != nil {
+		return
+	}
+	if request.MediaType, err = soap.MarshalString(MediaType); err != nil {
+		return
+	}
+	if request.PrintQuality, err = soap.MarshalString(string(PrintQuality)); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &PrintBasic1CreateJobResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "CreateJob", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if JobId, err = soap.UnmarshalUi4(response.JobId); err != nil {
+		return
+	}
+	if DataSink, err = soap.UnmarshalURI(response.DataSink); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// PrintBasic1CancelJobRequest is the request of CancelJob, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type PrintBasic1CancelJobRequest struct {
+	JobId string
+}
+
+// CancelJob performs the CancelJob action of the service.
+//
+// Arguments:
+//   - JobId (in, state variable JobId)
+func (client *PrintBasic1) CancelJob(JobId uint32) (err error) {
+	return client.CancelJobCtx(context.Background(), JobId)
+}
+
+// CancelJobCtx is CancelJob with a context, to cancel or time out the call.
+func (client *PrintBasic1) CancelJobCtx(ctx context.Context, JobId uint32) (err error) {
+	// Request structure.
+	request := &PrintBasic1CancelJobRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.JobId, err = soap.MarshalUi4(JobId); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "CancelJob", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// PrintBasic1GetJobAttributesRequest is the request of GetJobAttributes, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type PrintBasic1GetJobAttributesRequest struct {
+	JobId string
+}
+
+// PrintBasic1GetJobAttributesResponse is the response of GetJobAttributes, with each
+// argument in its SOAP string form.
+type PrintBasic1GetJobAttributesResponse struct {
+	JobName                 string
+	JobOriginatingUserName  string
+	JobMediaSheetsCompleted string
+}
+
+// GetJobAttributes performs the GetJobAttributes action of the service.
+//
+// Arguments:
+//   - JobId (in, state variable JobId)
+//
+// Return values:
+//   - JobName (out, state variable JobName)
+//   - JobOriginatingUserName (out, state variable JobOriginatingUserName)
+//   - JobMediaSheetsCompleted (out, state variable JobMediaSheetsCompleted)
+func (client *PrintBasic1) GetJobAttributes(JobId uint32) (JobName string, JobOriginatingUserName string, JobMediaSheetsCompleted string, err error) {
+	return client.GetJobAttributesCtx(context.Background(), JobId)
+}
+
+// GetJobAttributesCtx is GetJobAttributes with a context, to cancel or time out the call.
+func (client *PrintBasic1) GetJobAttributesCtx(ctx context.Context, JobId uint32) (JobName string, JobOriginatingUserName string, JobMediaSheetsCompleted string, err error) {
+	// Request structure.
+	request := &PrintBasic1GetJobAttributesRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.JobId, err = soap.MarshalUi4(JobId); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &PrintBasic1GetJobAttributesResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetJobAttributes", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if JobName, err = soap.UnmarshalString(response.JobName); err != nil {
+		return
+	}
+	if JobOriginatingUserName, err = soap.UnmarshalString(response.JobOriginatingUserName); err != nil {
+		return
+	}
+	if JobMediaSheetsCompleted, err = soap.UnmarshalString(response.JobMediaSheetsCompleted); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// PrintBasic1GetMarginsRequest is the request of GetMargins, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type PrintBasic1GetMarginsRequest struct {
+	MediaSize string
+	MediaType string
+}
+
+// PrintBasic1GetMarginsResponse is the response of GetMargins, with each
+// argument in its SOAP string form.
+type PrintBasic1GetMarginsResponse struct {
+	Margins string
+}
+
+// GetMargins performs the GetMargins action of the service.
+//
+// Arguments:
+//   - MediaSize (in, state variable MediaSize)
+//   - MediaType (in, state variable MediaType)
+//
+// Return values:
+//   - Margins (out, state variable A_ARG_TYPE_Margins)
+func (client *PrintBasic1) GetMargins(MediaSize string, MediaType string) (Margins string, err error) {
+	return client.GetMarginsCtx(context.Background(), MediaSize, MediaType)
+}
+
+// GetMarginsCtx is GetMargins with a context, to cancel or time out the call.
+func (client *PrintBasic1) GetMarginsCtx(ctx context.Context, MediaSize string, MediaType string) (Margins string, err error) {
+	// Request structure.
+	request := &PrintBasic1GetMarginsRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.MediaSize, err = soap.MarshalString(MediaSize); err != nil {
+		return
+	}
+	if request.MediaType, err = soap.MarshalString(MediaType); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &PrintBasic1GetMarginsResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetMargins", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if Margins, err = soap.UnmarshalString(response.Margins); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// PrintBasic1GetMediaListRequest is the request of GetMediaList, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type PrintBasic1GetMediaListRequest struct {
+	MediaSize string
+	MediaType string
+}
+
+// PrintBasic1GetMediaListResponse is the response of GetMediaList, with each
+// argument in its SOAP string form.
+type PrintBasic1GetMediaListResponse struct {
+	MediaList string
+}
+
+// GetMediaList performs the GetMediaList action of the service.
+//
+// Arguments:
+//   - MediaSize (in, state variable MediaSize)
+//   - MediaType (in, state variable MediaType)
+//
+// Return values:
+//   - MediaList (out, state variable A_ARG_TYPE_MediaList)
+func (client *PrintBasic1) GetMediaList(MediaSize string, MediaType string) (MediaList string, err error) {
+	return client.GetMediaListCtx(context.Background(), MediaSize, MediaType)
+}
+
+// GetMediaListCtx is GetMediaList with a context, to cancel or time out the call.
+func (client *PrintBasic1) GetMediaListCtx(ctx context.Context, MediaSize string, MediaType string) (MediaList string, err error) {
+	// Request structure.
+	request := &PrintBasic1GetMediaListRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.MediaSize, err = soap.MarshalString(MediaSize); err != nil {
+		return
+	}
+	if request.MediaType, err = soap.MarshalString(MediaType); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &PrintBasic1GetMediaListResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetMediaList", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if MediaList, err = soap.UnmarshalString(response.MediaList); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// PrintEnhanced1 is a client for UPnP SOAP service with URN "urn:schemas-upnp-org:service:PrintEnhanced:1". See
+// goupnp.ServiceClient, which contains RootDevice and Service attributes which
+// are provided for informational value.
+type PrintEnhanced1 struct {
+	goupnp.ServiceClient
+}
+
+// PrintEnhanced1Client is the interface of the actions of PrintEnhanced1, for
+// substituting fakes or mocks for the service in tests.
+type PrintEnhanced1Client interface {
+	GetPrinterAttributes() (PrinterState PrintEnhanced1PrinterState, PrinterStateReasons string, JobIdList string, JobId uint32, err error)
+	GetPrinterAttributesCtx(ctx context.Context) (PrinterState PrintEnhanced1PrinterState, PrinterStateReasons string, JobIdList string, JobId uint32, err error)
+	CreateJob(JobName string, JobOriginatingUserName string, DocumentFormat string, Copies uint8, Sides PrintEnhanced1Sides, NumberUp PrintEnhanced1NumberUp, OrientationRequested PrintEnhanced1OrientationRequested, MediaSize string, MediaType string, PrintQuality PrintEnhanced1PrintQuality) (JobId uint32, DataSink *url.URL, err error)
+	CreateJobCtx(ctx context.Context, JobName string, JobOriginatingUserName string, DocumentFormat string, Copies uint8, Sides PrintEnhanced1Sides, NumberUp PrintEnhanced1NumberUp, OrientationRequested PrintEnhanced1OrientationRequested, MediaSize string, MediaType string, PrintQuality PrintEnhanced1PrintQuality) (JobId uint32, DataSink *url.URL, err error)
+	CancelJob(JobId uint32) (err error)
+	CancelJobCtx(ctx context.Context, JobId uint32) (err error)
+	GetJobAttributes(JobId uint32) (JobName string, JobOriginatingUserName string, JobMediaSheetsCompleted string, err error)
+	GetJobAttributesCtx(ctx context.Context, JobId uint32) (JobName string, JobOriginatingUserName string, JobMediaSheetsCompleted string, err error)
+	GetMargins(MediaSize string, MediaType string) (Margins string, err error)
+	GetMarginsCtx(ctx context.Context, MediaSize string, MediaType string) (Margins string, err error)
+	GetMediaList(MediaSize string, MediaType string) (MediaList string, err error)
+	GetMediaListCtx(ctx context.Context, MediaSize string, MediaType string) (MediaList string, err error)
+	GetPrinterAttributesV2() (PrinterState PrintEnhanced1PrinterState, PrinterStateReasons string, JobIdList string, JobId uint32, InternetConnectState PrintEnhanced1InternetConnectState, err error)
+	GetPrinterAttributesV2Ctx(ctx context.Context) (PrinterState PrintEnhanced1PrinterState, PrinterStateReasons string, JobIdList string, JobId uint32, InternetConnectState PrintEnhanced1InternetConnectState, err error)
+	CreateJobV2(JobName string, JobOriginatingUserName string, DocumentFormat string, Copies uint8, Sides PrintEnhanced1Sides, NumberUp PrintEnhanced1NumberUp, OrientationRequested PrintEnhanced1OrientationRequested, MediaSize string, MediaType string, PrintQuality PrintEnhanced1PrintQuality, CriticalAttributesList string) (JobId uint32, DataSink *url.URL, err error)
+	CreateJobV2Ctx(ctx context.Context, JobName string, JobOriginatingUserName string, DocumentFormat string, Copies uint8, Sides PrintEnhanced1Sides, NumberUp PrintEnhanced1NumberUp, OrientationRequested PrintEnhanced1OrientationRequested, MediaSize string, MediaType string, PrintQuality PrintEnhanced1PrintQuality, CriticalAttributesList string) (JobId uint32, DataSink *url.URL, err error)
+	CreateURIJob(JobName string, JobOriginatingUserName string, DocumentFormat string, Copies uint8, Sides PrintEnhanced1Sides, NumberUp PrintEnhanced1NumberUp, OrientationRequested PrintEnhanced1OrientationRequested, MediaSize string, MediaType string, PrintQuality PrintEnhanced1PrintQuality, CriticalAttributesList string, SourceURI *url.URL) (JobId uint32, err error)
+	CreateURIJobCtx(ctx context.Context, JobName string, JobOriginatingUserName string, DocumentFormat string, Copies uint8, Sides PrintEnhanced1Sides, NumberUp PrintEnhanced1NumberUp, OrientationRequested PrintEnhanced1OrientationRequested, MediaSize string, MediaType string, PrintQuality PrintEnhanced1PrintQuality, CriticalAttributesList string, SourceURI *url.URL) (JobId uint32, err error)
+}
+
+var _ PrintEnhanced1Client = new(PrintEnhanced1)
+
+// PrintEnhanced1PrinterState is a value of the state variable PrinterState of
+// PrintEnhanced1.
+type PrintEnhanced1PrinterState string
+
+// Allowed values of PrintEnhanced1PrinterState.
+const (
+	PrintEnhanced1PrinterState_idle       PrintEnhanced1PrinterState = "idle"
+	PrintEnhanced1PrinterState_processing PrintEnhanced1PrinterState = "processing"
+	PrintEnhanced1PrinterState_stopped    PrintEnhanced1PrinterState = "stopped"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v PrintEnhanced1PrinterState) Valid() bool {
+	switch v {
+	case PrintEnhanced1PrinterState_idle,
+		PrintEnhanced1PrinterState_processing,
+		PrintEnhanced1PrinterState_stopped:
+		return true
+	}
+	return false
+}
+
+// PrintEnhanced1Sides is a value of the state variable Sides of
+// PrintEnhanced1.
+type PrintEnhanced1Sides string
+
+// Allowed values of PrintEnhanced1Sides.
+const (
+	PrintEnhanced1Sides_one_sided            PrintEnhanced1Sides = "one-sided"
+	PrintEnhanced1Sides_two_sided_long_edge  PrintEnhanced1Sides = "two-sided-long-edge"
+	PrintEnhanced1Sides_two_sided_short_edge PrintEnhanced1Sides = "two-sided-short-edge"
+	PrintEnhanced1Sides_device_setting       PrintEnhanced1Sides = "device-setting"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v PrintEnhanced1Sides) Valid() bool {
+	switch v {
+	case PrintEnhanced1Sides_one_sided,
+		PrintEnhanced1Sides_two_sided_long_edge,
+		PrintEnhanced1Sides_two_sided_short_edge,
+		PrintEnhanced1Sides_device_setting:
+		return true
+	}
+	return false
+}
+
+// PrintEnhanced1NumberUp is a value of the state variable NumberUp of
+// PrintEnhanced1.
+type PrintEnhanced1NumberUp string
+
+// Allowed values of PrintEnhanced1NumberUp.
+const (
+	PrintEnhanced1NumberUp_1              PrintEnhanced1NumberUp = "1"
+	PrintEnhanced1NumberUp_2              PrintEnhanced1NumberUp = "2"
+	PrintEnhanced1NumberUp_4              PrintEnhanced1NumberUp = "4"
+	PrintEnhanced1NumberUp_device_setting PrintEnhanced1NumberUp = "device-setting"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v PrintEnhanced1NumberUp) Valid() bool {
+	switch v {
+	case PrintEnhanced1NumberUp_1,
+		PrintEnhanced1NumberUp_2,
+		PrintEnhanced1NumberUp_4,
+		PrintEnhanced1NumberUp_device_setting:
+		return true
+	}
+	return false
+}
+
+// PrintEnhanced1OrientationRequested is a value of the state variable OrientationRequested of
+// PrintEnhanced1.
+type PrintEnhanced1OrientationRequested string
+
+// Allowed values of PrintEnhanced1OrientationRequested.
+const (
+	PrintEnhanced1OrientationRequested_portrait       PrintEnhanced1OrientationRequested = "portrait"
+	PrintEnhanced1OrientationRequested_landscape      PrintEnhanced1OrientationRequested = "landscape"
+	PrintEnhanced1OrientationRequested_device_setting PrintEnhanced1OrientationRequested = "device-setting"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v PrintEnhanced1OrientationRequested) Valid() bool {
+	switch v {
+	case PrintEnhanced1OrientationRequested_portrait,
+		PrintEnhanced1OrientationRequested_landscape,
+		PrintEnhanced1OrientationRequested_device_setting:
+		return true
+	}
+	return false
+}
+
+// PrintEnhanced1PrintQuality is a value of the state variable PrintQuality of
+// PrintEnhanced1.
+type PrintEnhanced1PrintQuality string
+
+// Allowed values of PrintEnhanced1PrintQuality.
+const (
+	PrintEnhanced1PrintQuality_draft          PrintEnhanced1PrintQuality = "draft"
+	PrintEnhanced1PrintQuality_normal         PrintEnhanced1PrintQuality = "normal"
+	PrintEnhanced1PrintQuality_high           PrintEnhanced1PrintQuality = "high"
+	PrintEnhanced1PrintQuality_device_setting PrintEnhanced1PrintQuality = "device-setting"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v PrintEnhanced1PrintQuality) Valid() bool {
+	switch v {
+	case PrintEnhanced1PrintQuality_draft,
+		PrintEnhanced1PrintQuality_normal,
+		PrintEnhanced1PrintQuality_high,
+		PrintEnhanced1PrintQuality_device_setting:
+		return true
+	}
+	return false
+}
+
+// PrintEnhanced1InternetConnectState is a value of the state variable InternetConnectState of
+// PrintEnhanced1.
+type PrintEnhanced1InternetConnectState string
+
+// Allowed values of PrintEnhanced1InternetConnectState.
+const (
+	PrintEnhanced1InternetConnectState_connected     PrintEnhanced1InternetConnectState = "connected"
+	PrintEnhanced1InternetConnectState_not_connected PrintEnhanced1InternetConnectState = "not-connected"
+	PrintEnhanced1InternetConnectState_unknown       PrintEnhanced1InternetConnectState = "unknown"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v PrintEnhanced1InternetConnectState) Valid() bool {
+	switch v {
+	case PrintEnhanced1InternetConnectState_connected,
+		PrintEnhanced1InternetConnectState_not_connected,
+		PrintEnhanced1InternetConnectState_unknown:
+		return true
+	}
+	return false
+}
+
+// NewPrintEnhanced1Clients discovers instances of the service on the network,
+// and returns clients to any that are found. errors will contain an error for
+// any devices that replied but which could not be queried, and err will be set
+// if the discovery process failed outright.
+//
+// This is a typical entry calling point into this package.
+func NewPrintEnhanced1Clients() (clients []*PrintEnhanced1, errors []error, err error) {
+	var genericClients []goupnp.ServiceClient
+	if genericClients, errors, err = goupnp.NewServiceClients(URN_PrintEnhanced_1); err != nil {
+		return
+	}
+	clients = newPrintEnhanced1ClientsFromGenericClients(genericClients)
+	return
+}
+
+// NewPrintEnhanced1ClientsByURL discovers instances of the service at the given
+// URL, and returns clients to any that are found. An error is returned if
+// there was an error probing the service.
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered service URL.
+func NewPrintEnhanced1ClientsByURL(loc *url.URL) ([]*PrintEnhanced1, error) {
+	genericClients, err := goupnp.NewServiceClientsByURL(loc, URN_PrintEnhanced_1)
+	if err != nil {
+		return nil, err
+	}
+	return newPrintEnhanced1ClientsFromGenericClients(genericClients), nil
+}
+
+// NewPrintEnhanced1ClientsFromRootDevice discovers instances of the service in
+// a given root device, and returns clients to any that are found. An error is
+// returned if there was not at least one instance of the service within the
+// device. The location parameter is simply assigned to the Location attribute
+// of the wrapped ServiceClient(s).
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered root device.
+func NewPrintEnhanced1ClientsFromRootDevice(rootDevice *goupnp.RootDevice, loc *url.URL) ([]*PrintEnhanced1, error) {
+	genericClients, err := goupnp.NewServiceClientsFromRootDevice(rootDevice, loc, URN_PrintEnhanced_1)
+	if err != nil {
+		return nil, err
+	}
+	return newPrintEnhanced1ClientsFromGenericClients(genericClients), nil
+}
+
+func newPrintEnhanced1ClientsFromGenericClients(genericClients []goupnp.ServiceClient) []*PrintEnhanced1 {
+	clients := make([]*PrintEnhanced1, len(genericClients))
+	for i := range genericClients {
+		clients[i] = &PrintEnhanced1{genericClients[i]}
+	}
+	return clients
+}
+
+// PrintEnhanced1Timeouts are the default timeouts of the actions of
+// PrintEnhanced1, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var PrintEnhanced1Timeouts = map[string]time.Duration{}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *PrintEnhanced1) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, PrintEnhanced1Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_PrintEnhanced_1, actionName, request, response)
+}
+
+// PrintEnhanced1GetPrinterAttributesResponse is the response of GetPrinterAttributes, with each
+// argument in its SOAP string form.
+type PrintEnhanced1GetPrinterAttributesResponse struct {
+	PrinterState        string
+	PrinterStateReasons string
+	JobIdList           string
+	JobId               string
+}
+
+// GetPrinterAttributes performs the GetPrinterAttributes action of the service.
+//
+// Return values:
+//   - PrinterState (out, state variable PrinterState): allowed values idle, processing, stopped
+//   - PrinterStateReasons (out, state variable PrinterStateReasons)
+//   - JobIdList (out, state variable JobIdList)
+//   - JobId (out, state variable JobId)
+func (client *PrintEnhanced1) GetPrinterAttributes() (PrinterState PrintEnhanced1PrinterState, PrinterStateReasons string, JobIdList string, JobId uint32, err error) {
+	return client.GetPrinterAttributesCtx(context.Background())
+}
+
+// GetPrinterAttributesCtx is GetPrinterAttributes with a context, to cancel or time out the call.
+func (client *PrintEnhanced1) GetPrinterAttributesCtx(ctx context.Context) (PrinterState PrintEnhanced1PrinterState, PrinterStateReasons string, JobIdList string, JobId uint32, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &PrintEnhanced1GetPrinterAttributesResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetPrinterAttributes", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	PrinterState = PrintEnhanced1PrinterState(response.PrinterState)
+	if PrinterStateReasons, err = soap.UnmarshalString(response.PrinterStateReasons); err != nil {
+		return
+	}
+	if JobIdList, err = soap.UnmarshalString(response.JobIdList); err != nil {
+		return
+	}
+	if JobId, err = soap.UnmarshalUi4(response.JobId); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// PrintEnhanced1CreateJobRequest is the request of CreateJob, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type PrintEnhanced1CreateJobRequest struct {
+	JobName                string
+	JobOriginatingUserName string
+	DocumentFormat         string
+	Copies                 string
+	Sides                  string
+	NumberUp               string
+	OrientationRequested   string
+	MediaSize              string
+	MediaType              string
+	PrintQuality           string
+}
+
+// PrintEnhanced1CreateJobResponse is the response of CreateJob, with each
+// argument in its SOAP string form.
+type PrintEnhanced1CreateJobResponse struct {
+	JobId    string
+	DataSink string
+}
+
+// CreateJob performs the CreateJob action of the service.
+//
+// Arguments:
+//   - JobName (in, state variable JobName)
+//   - JobOriginatingUserName (in, state variable JobOriginatingUserName)
+//   - DocumentFormat (in, state variable DocumentFormat)
+//   - Copies (in, state variable Copies): allowed range minimum=1, maximum=255
+//   - Sides (in, state variable Sides): allowed values one-sided, two-sided-long-edge, two-sided-short-edge, device-setting
+//   - NumberUp (in, state variable NumberUp): allowed values 1, 2, 4, device-setting
+//   - OrientationRequested (in, state variable OrientationRequested): allowed values portrait, landscape, device-setting
+//   - MediaSize (in, state variable MediaSize)
+//   - MediaType (in, state variable MediaType)
+//   - PrintQuality (in, state variable PrintQuality): allowed values draft, normal, high, device-setting
+//
+// Return values:
+//   - JobId (out, state variable JobId)
+//   - DataSink (out, state variable DataSink)
+func (client *PrintEnhanced1) CreateJob(JobName string, JobOriginatingUserName string, DocumentFormat string, Copies uint8, Sides PrintEnhanced1Sides, NumberUp PrintEnhanced1NumberUp, OrientationRequested PrintEnhanced1OrientationRequested, MediaSize string, MediaType string, PrintQuality PrintEnhanced1PrintQuality) (JobId uint32, DataSink *url.URL, err error) {
+	return client.CreateJobCtx(context.Background(), JobName, JobOriginatingUserName, DocumentFormat, Copies, Sides, NumberUp, OrientationRequested, MediaSize, MediaType, PrintQuality)
+}
+
+// CreateJobCtx is CreateJob with a context, to cancel or time out the call.
+func (client *PrintEnhanced1) CreateJobCtx(ctx context.Context, JobName string, JobOriginatingUserName string, DocumentFormat string, Copies uint8, Sides PrintEnhanced1Sides, NumberUp PrintEnhanced1NumberUp, OrientationRequested PrintEnhanced1OrientationRequested, MediaSize string, MediaType string, PrintQuality PrintEnhanced1PrintQuality) (JobId uint32, DataSink *url.URL, err error) {
+	// Request structure.
+	request := &PrintEnhanced1CreateJobRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.JobName, err = soap.MarshalString(JobName); err != nil {
+		return
+	}
+	if request.JobOriginatingUserName, err = soap.MarshalString(JobOriginatingUserName); err != nil {
+		return
+	}
+	if request.DocumentFormat, err = soap.MarshalString(DocumentFormat); err != nil {
+		return
+	}
+	if request.Copies, err = soap.MarshalUi1(Copies); err != nil {
+		return
+	}
+	if request.Sides, err = soap.MarshalString(string(Sides)); err != nil {
+		return
+	}
+	if request.NumberUp, err = soap.MarshalString(string(NumberUp)); err != nil {
+		return
+	}
+	if request.OrientationRequested, err = soap.MarshalString(string(OrientationRequested)); err != nil {
+		return
+	}
+	if request.MediaSize, err = soap.MarshalString(MediaSize); err != nil {
+		return
+	}
+	if request.MediaType, err = soap.MarshalString(MediaType); err != nil {
+		return
+	}
+	if request.PrintQuality, err = soap.MarshalString(string(PrintQuality)); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &PrintEnhanced1CreateJobResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "CreateJob", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if JobId, err = soap.UnmarshalUi4(response.JobId); err != nil {
+		return
+	}
+	if DataSink, err = soap.UnmarshalURI(response.DataSink); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// PrintEnhanced1CancelJobRequest is the request of CancelJob, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type PrintEnhanced1CancelJobRequest struct {
+	JobId string
+}
+
+// CancelJob performs the CancelJob action of the service.
+//
+// Arguments:
+//   - JobId (in, state variable JobId)
+func (client *PrintEnhanced1) CancelJob(JobId uint32) (err error) {
+	return client.CancelJobCtx(context.Background(), JobId)
+}
+
+// CancelJobCtx is CancelJob with a context, to cancel or time out the call.
+func (client *PrintEnhanced1) CancelJobCtx(ctx context.Context, JobId uint32) (err error) {
+	// Request structure.
+	request := &PrintEnhanced1CancelJobRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.JobId, err = soap.MarshalUi4(JobId); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "CancelJob", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// PrintEnhanced1GetJobAttributesRequest is the request of GetJobAttributes, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type PrintEnhanced1GetJobAttributesRequest struct {
+	JobId string
+}
+
+// PrintEnhanced1GetJobAttributesResponse is the response of GetJobAttributes, with each
+// argument in its SOAP string form.
+type PrintEnhanced1GetJobAttributesResponse struct {
+	JobName                 string
+	JobOriginatingUserName  string
+	JobMediaSheetsCompleted string
+}
+
+// GetJobAttributes performs the GetJobAttributes action of the service.
+//
+// Arguments:
+//   - JobId (in, state variable JobId)
+//
+// Return values:
+//   - JobName (out, state variable JobName)
+//   - JobOriginatingUserName (out, state variable JobOriginatingUserName)
+//   - JobMediaSheetsCompleted (out, state variable JobMediaSheetsCompleted)
+func (client *PrintEnhanced1) GetJobAttributes(JobId uint32) (JobName string, JobOriginatingUserName string, JobMediaSheetsCompleted string, err error) {
+	return client.GetJobAttributesCtx(context.Background(), JobId)
+}
+
+// GetJobAttributesCtx is GetJobAttributes with a context, to cancel or time out the call.
+func (client *PrintEnhanced1) GetJobAttributesCtx(ctx context.Context, JobId uint32) (JobName string, JobOriginatingUserName string, JobMediaSheetsCompleted string, err error) {
+	// Request structure.
+	request := &PrintEnhanced1GetJobAttributesRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.JobId, err = soap.MarshalUi4(JobId); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &PrintEnhanced1GetJobAttributesResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetJobAttributes", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if JobName, err = soap.UnmarshalString(response.JobName); err != nil {
+		return
+	}
+	if JobOriginatingUserName, err = soap.UnmarshalString(response.JobOriginatingUserName); err != nil {
+		return
+	}
+	if JobMediaSheetsCompleted, err = soap.UnmarshalString(response.JobMediaSheetsCompleted); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// PrintEnhanced1GetMarginsRequest is the request of GetMargins, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type PrintEnhanced1GetMarginsRequest struct {
+	MediaSize string
+	MediaType string
+}
+
+// PrintEnhanced1GetMarginsResponse is the response of GetMargins, with each
+// argument in its SOAP string form.
+type PrintEnhanced1GetMarginsResponse struct {
+	Margins string
+}
+
+// GetMargins performs the GetMargins action of the service.
+//
+// Arguments:
+//   - MediaSize (in, state variable MediaSize)
+//   - MediaType (in, state variable MediaType)
+//
+// Return values:
+//   - Margins (out, state variable A_ARG_TYPE_Margins)
+func (client *PrintEnhanced1) GetMargins(MediaSize string, MediaType string) (Margins string, err error) {
+	return client.GetMarginsCtx(context.Background(), MediaSize, MediaType)
+}
+
+// GetMarginsCtx is GetMargins with a context, to cancel or time out the call.
+func (client *PrintEnhanced1) GetMarginsCtx(ctx context.Context, MediaSize string, MediaType string) (Margins string, err error) {
+	// Request structure.
+	request := &PrintEnhanced1GetMarginsRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.MediaSize, err = soap.MarshalString(MediaSize); err != nil {
+		return
+	}
+	if request.MediaType, err = soap.MarshalString(MediaType); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &PrintEnhanced1GetMarginsResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetMargins", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if Margins, err = soap.UnmarshalString(response.Margins); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// PrintEnhanced1GetMediaListRequest is the request of GetMediaList, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type PrintEnhanced1GetMediaListRequest struct {
+	MediaSize string
+	MediaType string
+}
+
+// PrintEnhanced1GetMediaListResponse is the response of GetMediaList, with each
+// argument in its SOAP string form.
+type PrintEnhanced1GetMediaListResponse struct {
+	MediaList string
+}
+
+// GetMediaList performs the GetMediaList action of the service.
+//
+// Arguments:
+//   - MediaSize (in, state variable MediaSize)
+//   - MediaType (in, state variable MediaType)
+//
+// Return values:
+//   - MediaList (out, state variable A_ARG_TYPE_MediaList)
+func (client *PrintEnhanced1) GetMediaList(MediaSize string, MediaType string) (MediaList string, err error) {
+	return client.GetMediaListCtx(context.Background(), MediaSize, MediaType)
+}
+
+// GetMediaListCtx is GetMediaList with a context, to cancel or time out the call.
+func (client *PrintEnhanced1) GetMediaListCtx(ctx context.Context, MediaSize string, MediaType string) (MediaList string, err error) {
+	// Request structure.
+	request := &PrintEnhanced1GetMediaListRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.MediaSize, err = soap.MarshalString(MediaSize); err != nil {
+		return
+	}
+	if request.MediaType, err = soap.MarshalString(MediaType); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &PrintEnhanced1GetMediaListResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetMediaList", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if MediaList, err = soap.UnmarshalString(response.MediaList); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// PrintEnhanced1GetPrinterAttributesV2Response is the response of GetPrinterAttributesV2, with each
+// argument in its SOAP string form.
+type PrintEnhanced1GetPrinterAttributesV2Response struct {
+	PrinterState         string
+	PrinterStateReasons  string
+	JobIdList            string
+	JobId                string
+	InternetConnectState string
+}
+
+// GetPrinterAttributesV2 performs the GetPrinterAttributesV2 action of the service.
+//
+// Return values:
+//   - PrinterState (out, state variable PrinterState): allowed values idle, processing, stopped
+//   - PrinterStateReasons (out, state variable PrinterStateReasons)
+//   - JobIdList (out, state variable JobIdList)
+//   - JobId (out, state variable JobId)
+//   - InternetConnectState (out, state variable InternetConnectState): allowed values connected, not-connected, unknown
+func (client *PrintEnhanced1) GetPrinterAttributesV2() (PrinterState PrintEnhanced1PrinterState, PrinterStateReasons string, JobIdList string, JobId uint32, InternetConnectState PrintEnhanced1InternetConnectState, err error) {
+	return client.GetPrinterAttributesV2Ctx(context.Background())
+}
+
+// GetPrinterAttributesV2Ctx is GetPrinterAttributesV2 with a context, to cancel or time out the call.
+func (client *PrintEnhanced1) GetPrinterAttributesV2Ctx(ctx context.Context) (PrinterState PrintEnhanced1PrinterState, PrinterStateReasons string, JobIdList string, JobId uint32, InternetConnectState PrintEnhanced1InternetConnectState, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &PrintEnhanced1GetPrinterAttributesV2Response{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetPrinterAttributesV2", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	PrinterState = PrintEnhanced1PrinterState(response.PrinterState)
+	if PrinterStateReasons, err = soap.UnmarshalString(response.PrinterStateReasons); err != nil {
+		return
+	}
+	if JobIdList, err = soap.UnmarshalString(response.JobIdList); err != nil {
+		return
+	}
+	if JobId, err = soap.UnmarshalUi4(response.JobId); err != nil {
+		return
+	}
+	InternetConnectState = PrintEnhanced1InternetConnectState(response.InternetConnectState)
+	// END Unmarshal arguments from response.
+	return
+}
+
+// PrintEnhanced1CreateJobV2Request is the request of CreateJobV2, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type PrintEnhanced1CreateJobV2Request struct {
+	JobName                string
+	JobOriginatingUserName string
+	DocumentFormat         string
+	Copies                 string
+	Sides                  string
+	NumberUp               string
+	OrientationRequested   string
+	MediaSize              string
+	MediaType              string
+	PrintQuality           string
+	CriticalAttributesList string
+}
+
+// PrintEnhanced1CreateJobV2Response is the response of CreateJobV2, with each
+// argument in its SOAP string form.
+type PrintEnhanced1CreateJobV2Response struct {
+	JobId    string
+	DataSink string
+}
+
+// CreateJobV2 performs the CreateJobV2 action of the service.
+//
+// Arguments:
+//   - JobName (in, state variable JobName)
+//   - JobOriginatingUserName (in, state variable JobOriginatingUserName)
+//   - DocumentFormat (in, state variable DocumentFormat)
+//   - Copies (in, state variable Copies): allowed range minimum=1, maximum=255
+//   - Sides (in, state variable Sides): allowed values one-sided, two-sided-long-edge, two-sided-short-edge, device-setting
+//   - NumberUp (in, state variable NumberUp): allowed values 1, 2, 4, device-setting
+//   - OrientationRequested (in, state variable OrientationRequested): allowed values portrait, landscape, device-setting
+//   - MediaSize (in, state variable MediaSize)
+//   - MediaType (in, state variable MediaType)
+//   - PrintQuality (in, state variable PrintQuality): allowed values draft, normal, high, device-setting
+//   - CriticalAttributesList (in, state variable CriticalAttributesList)
+//
+// Return values:
+//   - JobId (out, state variable JobId)
+//   - DataSink (out, state variable DataSink)
+func (client *PrintEnhanced1) CreateJobV2(JobName string, JobOriginatingUserName string, DocumentFormat string, Copies uint8, Sides PrintEnhanced1Sides, NumberUp PrintEnhanced1NumberUp, OrientationRequested PrintEnhanced1OrientationRequested, MediaSize string, MediaType string, PrintQuality PrintEnhanced1PrintQuality, CriticalAttributesList string) (JobId uint32, DataSink *url.URL, err error) {
+	return client.CreateJobV2Ctx(context.Background(), JobName, JobOriginatingUserName, DocumentFormat, Copies, Sides, NumberUp, OrientationRequested, MediaSize, MediaType, PrintQuality, CriticalAttributesList)
+}
+
+// CreateJobV2Ctx is CreateJobV2 with a context, to cancel or time out the call.
+func (client *PrintEnhanced1) CreateJobV2Ctx(ctx context.Context, JobName string, JobOriginatingUserName string, DocumentFormat string, Copies uint8, Sides PrintEnhanced1Sides, NumberUp PrintEnhanced1NumberUp, OrientationRequested PrintEnhanced1OrientationRequested, MediaSize string, MediaType string, PrintQuality PrintEnhanced1PrintQuality, CriticalAttributesList string) (JobId uint32, DataSink *url.URL, err error) {
+	// Request structure.
+	request := &PrintEnhanced1CreateJobV2Request{}
+	// BEGIN Marshal arguments into request.
+
+	if request.JobName, err = soap.MarshalString(JobName); err != nil {
+		return
+	}
+	if request.JobOriginatingUserName, err = soap.MarshalString(JobOriginatingUserName); err != nil {
+		return
+	}
+	if request.DocumentFormat, err = soap.MarshalString(DocumentFormat); err != nil {
+		return
+	}
+	if request.Copies, err = soap.MarshalUi1(Copies); err != nil {
+		return
+	}
+	if request.Sides, err = soap.MarshalString(string(Sides)); err != nil {
+		return
+	}
+	if request.NumberUp, err = soap.MarshalString(string(NumberUp)); err != nil {
+		return
+	}
+	if request.OrientationRequested, err = soap.MarshalString(string(OrientationRequested)); err != nil {
+		return
+	}
+	if request.MediaSize, err = soap.MarshalString(MediaSize); err != nil {
+		return
+	}
+	if request.MediaType, err = soap.MarshalString(MediaType); err != nil {
+		return
+	}
+	if request.PrintQuality, err = soap.MarshalString(string(PrintQuality)); err != nil {
+		return
+	}
+	if request.CriticalAttributesList, err = soap.MarshalString(CriticalAttributesList); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &PrintEnhanced1CreateJobV2Response{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "CreateJobV2", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if JobId, err = soap.UnmarshalUi4(response.JobId); err != nil {
+		return
+	}
+	if DataSink, err = soap.UnmarshalURI(response.DataSink); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// PrintEnhanced1CreateURIJobRequest is the request of CreateURIJob, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type PrintEnhanced1CreateURIJobRequest struct {
+	JobName                string
+	JobOriginatingUserName string
+	DocumentFormat         string
+	Copies                 string
+	Sides                  string
+	NumberUp               string
+	OrientationRequested   string
+	MediaSize              string
+	MediaType              string
+	PrintQuality           string
+	CriticalAttributesList string
+	SourceURI              string
+}
+
+// PrintEnhanced1CreateURIJobResponse is the response of CreateURIJob, with each
+// argument in its SOAP string form.
+type PrintEnhanced1CreateURIJobResponse struct {
+	JobId string
+}
+
+// CreateURIJob performs the CreateURIJob action of the service.
+//
+// Arguments:
+//   - JobName (in, state variable JobName)
+//   - JobOriginatingUserName (in, state variable JobOriginatingUserName)
+//   - DocumentFormat (in, state variable DocumentFormat)
+//   - Copies (in, state variable Copies): allowed range minimum=1, maximum=255
+//   - Sides (in, state variable Sides): allowed values one-sided, two-sided-long-edge, two-sided-short-edge, device-setting
+//   - NumberUp (in, state variable NumberUp): allowed values 1, 2, 4, device-setting
+//   - OrientationRequested (in, state variable OrientationRequested): allowed values portrait, landscape, device-setting
+//   - MediaSize (in, state variable MediaSize)
+//   - MediaType (in, state variable MediaType)
+//   - PrintQuality (in, state variable PrintQuality): allowed values draft, normal, high, device-setting
+//   - CriticalAttributesList (in, state variable CriticalAttributesList)
+//   - SourceURI (in, state variable A_ARG_TYPE_SourceURI)
+//
+// Return values:
+//   - JobId (out, state variable JobId)
+func (client *PrintEnhanced1) CreateURIJob(JobName string, JobOriginatingUserName string, DocumentFormat string, Copies uint8, Sides PrintEnhanced1Sides, NumberUp PrintEnhanced1NumberUp, OrientationRequested PrintEnhanced1OrientationRequested, MediaSize string, MediaType string, PrintQuality PrintEnhanced1PrintQuality, CriticalAttributesList string, SourceURI *url.URL) (JobId uint32, err error) {
+	return client.CreateURIJobCtx(context.Background(), JobName, JobOriginatingUserName, DocumentFormat, Copies, Sides, NumberUp, OrientationRequested, MediaSize, MediaType, PrintQuality, CriticalAttributesList, SourceURI)
+}
+
+// CreateURIJobCtx is CreateURIJob with a context, to cancel or time out the call.
+func (client *PrintEnhanced1) CreateURIJobCtx(ctx context.Context, JobName string, JobOriginatingUserName string, DocumentFormat string, Copies uint8, Sides PrintEnhanced1Sides, NumberUp PrintEnhanced1NumberUp, OrientationRequested PrintEnhanced1OrientationRequested, MediaSize string, MediaType string, PrintQuality PrintEnhanced1PrintQuality, CriticalAttributesList string, SourceURI *url.URL) (JobId uint32, err error) {
+	// Request structure.
+	request := &PrintEnhanced1CreateURIJobRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.JobName, err = soap.MarshalString(JobName); err != nil {
+		return
+	}
+	if request.JobOriginatingUserName, err = soap.MarshalString(JobOriginatingUserName); err != nil {
+		return
+	}
+	if request.DocumentFormat, err = soap.MarshalString(DocumentFormat); err != nil {
+		return
+	}
+	if request.Copies, err = soap.MarshalUi1(Copies); err != nil {
+		return
+	}
+	if request.Sides, err = soap.MarshalString(string(Sides)); err != nil {
+		return
+	}
+	if request.NumberUp, err = soap.MarshalString(string(NumberUp)); err != nil {
+		return
+	}
+	if request.OrientationRequested, err = soap.MarshalString(string(OrientationRequested)); err != nil {
+		return
+	}
+	if request.MediaSize, err = soap.MarshalString(MediaSize); err != nil {
+		return
+	}
+	if request.MediaType, err = soap.MarshalString(MediaType); err != nil {
+		return
+	}
+	if request.PrintQuality, err = soap.MarshalString(string(PrintQuality)); err != nil {
+		return
+	}
+	if request.CriticalAttributesList, err = soap.MarshalString(CriticalAttributesList); err != nil {
+		return
+	}
+	if request.SourceURI, err = soap.MarshalURI(SourceURI); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &PrintEnhanced1CreateURIJobResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "CreateURIJob", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if JobId, err = soap.UnmarshalUi4(response.JobId); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
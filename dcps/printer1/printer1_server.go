@@ -0,0 +1,900 @@
+package printer1
+
+// Generated file - do not edit by hand. See README.md
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/huin/goupnp/device"
+	"github.com/huin/goupnp/soap"
+)
+
+// Hack to avoid Go complaining if url or time aren't used.
+var _ *url.URL
+var _ time.Time
+
+// PrintBasic1Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:schemas-upnp-org:service:PrintBasic:1". See RegisterPrintBasic1Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type PrintBasic1Handler interface {
+	GetPrinterAttributes(ctx context.Context) (PrinterState PrintBasic1PrinterState, PrinterStateReasons string, JobIdList string, JobId uint32, err error)
+
+	CreateJob(ctx context.Context, JobName string, JobOriginatingUserName string, DocumentFormat string, Copies uint8, Sides PrintBasic1Sides, NumberUp PrintBasic1NumberUp, OrientationRequested PrintBasic1OrientationRequested, MediaSize string, MediaType string, PrintQuality PrintBasic1PrintQuality) (JobId uint32, DataSink *url.URL, err error)
+
+	CancelJob(ctx context.Context, JobId uint32) (err error)
+
+	GetJobAttributes(ctx context.Context, JobId uint32) (JobName string, JobOriginatingUserName string, JobMediaSheetsCompleted string, err error)
+
+	GetMargins(ctx context.Context, MediaSize string, MediaType string) (Margins string, err error)
+
+	GetMediaList(ctx context.Context, MediaSize string, MediaType string) (MediaList string, err error)
+}
+
+// RegisterPrintBasic1Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_PrintBasic_1.
+func RegisterPrintBasic1Handler(svc *device.Service, handler PrintBasic1Handler) {
+	svc.HandleFunc("GetPrinterAttributes", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return servePrintBasic1GetPrinterAttributes(ctx, handler, in)
+	})
+	svc.HandleFunc("CreateJob", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return servePrintBasic1CreateJob(ctx, handler, in)
+	})
+	svc.HandleFunc("CancelJob", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return servePrintBasic1CancelJob(ctx, handler, in)
+	})
+	svc.HandleFunc("GetJobAttributes", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return servePrintBasic1GetJobAttributes(ctx, handler, in)
+	})
+	svc.HandleFunc("GetMargins", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return servePrintBasic1GetMargins(ctx, handler, in)
+	})
+	svc.HandleFunc("GetMediaList", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return servePrintBasic1GetMediaList(ctx, handler, in)
+	})
+}
+
+func servePrintBasic1GetPrinterAttributes(ctx context.Context, handler PrintBasic1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var PrinterState PrintBasic1PrinterState
+	var PrinterStateReasons string
+	var JobIdList string
+	var JobId uint32
+	if PrinterState, PrinterStateReasons, JobIdList, JobId, err = handler.GetPrinterAttributes(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 4)
+
+	out[0].Name = "PrinterState"
+	if out[0].Value, err = soap.MarshalString(string(PrinterState)); err != nil {
+		return
+	}
+	out[1].Name = "PrinterStateReasons"
+	if out[1].Value, err = soap.MarshalString(PrinterStateReasons); err != nil {
+		return
+	}
+	out[2].Name = "JobIdList"
+	if out[2].Value, err = soap.MarshalString(JobIdList); err != nil {
+		return
+	}
+	out[3].Name = "JobId"
+	if out[3].Value, err = soap.MarshalUi4(JobId); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func servePrintBasic1CreateJob(ctx context.Context, handler PrintBasic1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var JobName string
+	if value, err = soap.FindArg(in, "JobName"); err != nil {
+		return
+	}
+	if JobName, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument JobName: "+err.Error())
+	}
+	var JobOriginatingUserName string
+	if value, err = soap.FindArg(in, "JobOriginatingUserName"); err != nil {
+		return
+	}
+	if JobOriginatingUserName, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument JobOriginatingUserName: "+err.Error())
+	}
+	var DocumentFormat string
+	if value, err = soap.FindArg(in, "DocumentFormat"); err != nil {
+		return
+	}
+	if DocumentFormat, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DocumentFormat: "+err.Error())
+	}
+	var Copies uint8
+	if value, err = soap.FindArg(in, "Copies"); err != nil {
+		return
+	}
+	if Copies, err = soap.UnmarshalUi1(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Copies: "+err.Error())
+	}
+	var Sides PrintBasic1Sides
+	if value, err = soap.FindArg(in, "Sides"); err != nil {
+		return
+	}
+	Sides = PrintBasic1Sides(value)
+	var NumberUp PrintBasic1NumberUp
+	if value, err = soap.FindArg(in, "NumberUp"); err != nil {
+		return
+	}
+	NumberUp = PrintBasic1NumberUp(value)
+	var OrientationRequested PrintBasic1OrientationRequested
+	if value, err = soap.FindArg(in, "OrientationRequested"); err != nil {
+		return
+	}
+	OrientationRequested = PrintBasic1OrientationRequested(value)
+	var MediaSize string
+	if value, err = soap.FindArg(in, "MediaSize"); err != nil {
+		return
+	}
+	if MediaSize, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument MediaSize: "+err.Error())
+	}
+	var MediaType string
+	if value, err = soap.FindArg(in, "MediaType"); err != nil {
+		return
+	}
+	if MediaType, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument MediaType: "+err.Error())
+	}
+	var PrintQuality PrintBasic1PrintQuality
+	if value, err = soap.FindArg(in, "PrintQuality"); err != nil {
+		return
+	}
+	PrintQuality = PrintBasic1PrintQuality(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var JobId uint32
+	var DataSink *url.URL
+	if JobId, DataSink, err = handler.CreateJob(ctx, JobName, JobOriginatingUserName, DocumentFormat, Copies, Sides, NumberUp, OrientationRequested, MediaSize, MediaType, PrintQuality); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "JobId"
+	if out[0].Value, err = soap.MarshalUi4(JobId); err != nil {
+		return
+	}
+	out[1].Name = "DataSink"
+	if out[1].Value, err = soap.MarshalURI(DataSink); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func servePrintBasic1CancelJob(ctx context.Context, handler PrintBasic1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var JobId uint32
+	if value, err = soap.FindArg(in, "JobId"); err != nil {
+		return
+	}
+	if JobId, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument JobId: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.CancelJob(ctx, JobId); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func servePrintBasic1GetJobAttributes(ctx context.Context, handler PrintBasic1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var JobId uint32
+	if value, err = soap.FindArg(in, "JobId"); err != nil {
+		return
+	}
+	if JobId, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument JobId: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var JobName string
+	var JobOriginatingUserName string
+	var JobMediaSheetsCompleted string
+	if JobName, JobOriginatingUserName, JobMediaSheetsCompleted, err = handler.GetJobAttributes(ctx, JobId); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 3)
+
+	out[0].Name = "JobName"
+	if out[0].Value, err = soap.MarshalString(JobName); err != nil {
+		return
+	}
+	out[1].Name = "JobOriginatingUserName"
+	if out[1].Value, err = soap.MarshalString(JobOriginatingUserName); err != nil {
+		return
+	}
+	out[2].Name = "JobMediaSheetsCompleted"
+	if out[2].Value, err = soap.MarshalString(JobMediaSheetsCompleted); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func servePrintBasic1GetMargins(ctx context.Context, handler PrintBasic1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var MediaSize string
+	if value, err = soap.FindArg(in, "MediaSize"); err != nil {
+		return
+	}
+	if MediaSize, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument MediaSize: "+err.Error())
+	}
+	var MediaType string
+	if value, err = soap.FindArg(in, "MediaType"); err != nil {
+		return
+	}
+	if MediaType, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument MediaType: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var Margins string
+	if Margins, err = handler.GetMargins(ctx, MediaSize, MediaType); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "Margins"
+	if out[0].Value, err = soap.MarshalString(Margins); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func servePrintBasic1GetMediaList(ctx context.Context, handler PrintBasic1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var MediaSize string
+	if value, err = soap.FindArg(in, "MediaSize"); err != nil {
+		return
+	}
+	if MediaSize, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument MediaSize: "+err.Error())
+	}
+	var MediaType string
+	if value, err = soap.FindArg(in, "MediaType"); err != nil {
+		return
+	}
+	if MediaType, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument MediaType: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var MediaList string
+	if MediaList, err = handler.GetMediaList(ctx, MediaSize, MediaType); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "MediaList"
+	if out[0].Value, err = soap.MarshalString(MediaList); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+// PrintEnhanced1Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:schemas-upnp-org:service:PrintEnhanced:1". See RegisterPrintEnhanced1Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type PrintEnhanced1Handler interface {
+	GetPrinterAttributes(ctx context.Context) (PrinterState PrintEnhanced1PrinterState, PrinterStateReasons string, JobIdList string, JobId uint32, err error)
+
+	CreateJob(ctx context.Context, JobName string, JobOriginatingUserName string, DocumentFormat string, Copies uint8, Sides PrintEnhanced1Sides, NumberUp PrintEnhanced1NumberUp, OrientationRequested PrintEnhanced1OrientationRequested, MediaSize string, MediaType string, PrintQuality PrintEnhanced1PrintQuality) (JobId uint32, DataSink *url.URL, err error)
+
+	CancelJob(ctx context.Context, JobId uint32) (err error)
+
+	GetJobAttributes(ctx context.Context, JobId uint32) (JobName string, JobOriginatingUserName string, JobMediaSheetsCompleted string, err error)
+
+	GetMargins(ctx context.Context, MediaSize string, MediaType string) (Margins string, err error)
+
+	GetMediaList(ctx context.Context, MediaSize string, MediaType string) (MediaList string, err error)
+
+	GetPrinterAttributesV2(ctx context.Context) (PrinterState PrintEnhanced1PrinterState, PrinterStateReasons string, JobIdList string, JobId uint32, InternetConnectState PrintEnhanced1InternetConnectState, err error)
+
+	CreateJobV2(ctx context.Context, JobName string, JobOriginatingUserName string, DocumentFormat string, Copies uint8, Sides PrintEnhanced1Sides, NumberUp PrintEnhanced1NumberUp, OrientationRequested PrintEnhanced1OrientationRequested, MediaSize string, MediaType string, PrintQuality PrintEnhanced1PrintQuality, CriticalAttributesList string) (JobId uint32, DataSink *url.URL, err error)
+
+	CreateURIJob(ctx context.Context, JobName string, JobOriginatingUserName string, DocumentFormat string, Copies uint8, Sides PrintEnhanced1Sides, NumberUp PrintEnhanced1NumberUp, OrientationRequested PrintEnhanced1OrientationRequested, MediaSize string, MediaType string, PrintQuality PrintEnhanced1PrintQuality, CriticalAttributesList string, SourceURI *url.URL) (JobId uint32, err error)
+}
+
+// RegisterPrintEnhanced1Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_PrintEnhanced_1.
+func RegisterPrintEnhanced1Handler(svc *device.Service, handler PrintEnhanced1Handler) {
+	svc.HandleFunc("GetPrinterAttributes", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return servePrintEnhanced1GetPrinterAttributes(ctx, handler, in)
+	})
+	svc.HandleFunc("CreateJob", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return servePrintEnhanced1CreateJob(ctx, handler, in)
+	})
+	svc.HandleFunc("CancelJob", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return servePrintEnhanced1CancelJob(ctx, handler, in)
+	})
+	svc.HandleFunc("GetJobAttributes", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return servePrintEnhanced1GetJobAttributes(ctx, handler, in)
+	})
+	svc.HandleFunc("GetMargins", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return servePrintEnhanced1GetMargins(ctx, handler, in)
+	})
+	svc.HandleFunc("GetMediaList", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return servePrintEnhanced1GetMediaList(ctx, handler, in)
+	})
+	svc.HandleFunc("GetPrinterAttributesV2", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return servePrintEnhanced1GetPrinterAttributesV2(ctx, handler, in)
+	})
+	svc.HandleFunc("CreateJobV2", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return servePrintEnhanced1CreateJobV2(ctx, handler, in)
+	})
+	svc.HandleFunc("CreateURIJob", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return servePrintEnhanced1CreateURIJob(ctx, handler, in)
+	})
+}
+
+func servePrintEnhanced1GetPrinterAttributes(ctx context.Context, handler PrintEnhanced1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var PrinterState PrintEnhanced1PrinterState
+	var PrinterStateReasons string
+	var JobIdList string
+	var JobId uint32
+	if PrinterState, PrinterStateReasons, JobIdList, JobId, err = handler.GetPrinterAttributes(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 4)
+
+	out[0].Name = "PrinterState"
+	if out[0].Value, err = soap.MarshalString(string(PrinterState)); err != nil {
+		return
+	}
+	out[1].Name = "PrinterStateReasons"
+	if out[1].Value, err = soap.MarshalString(PrinterStateReasons); err != nil {
+		return
+	}
+	out[2].Name = "JobIdList"
+	if out[2].Value, err = soap.MarshalString(JobIdList); err != nil {
+		return
+	}
+	out[3].Name = "JobId"
+	if out[3].Value, err = soap.MarshalUi4(JobId); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func servePrintEnhanced1CreateJob(ctx context.Context, handler PrintEnhanced1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var JobName string
+	if value, err = soap.FindArg(in, "JobName"); err != nil {
+		return
+	}
+	if JobName, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument JobName: "+err.Error())
+	}
+	var JobOriginatingUserName string
+	if value, err = soap.FindArg(in, "JobOriginatingUserName"); err != nil {
+		return
+	}
+	if JobOriginatingUserName, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument JobOriginatingUserName: "+err.Error())
+	}
+	var DocumentFormat string
+	if value, err = soap.FindArg(in, "DocumentFormat"); err != nil {
+		return
+	}
+	if DocumentFormat, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DocumentFormat: "+err.Error())
+	}
+	var Copies uint8
+	if value, err = soap.FindArg(in, "Copies"); err != nil {
+		return
+	}
+	if Copies, err = soap.UnmarshalUi1(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Copies: "+err.Error())
+	}
+	var Sides PrintEnhanced1Sides
+	if value, err = soap.FindArg(in, "Sides"); err != nil {
+		return
+	}
+	Sides = PrintEnhanced1Sides(value)
+	var NumberUp PrintEnhanced1NumberUp
+	if value, err = soap.FindArg(in, "NumberUp"); err != nil {
+		return
+	}
+	NumberUp = PrintEnhanced1NumberUp(value)
+	var OrientationRequested PrintEnhanced1OrientationRequested
+	if value, err = soap.FindArg(in, "OrientationRequested"); err != nil {
+		return
+	}
+	OrientationRequested = PrintEnhanced1OrientationRequested(value)
+	var MediaSize string
+	if value, err = soap.FindArg(in, "MediaSize"); err != nil {
+		return
+	}
+	if MediaSize, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument MediaSize: "+err.Error())
+	}
+	var MediaType string
+	if value, err = soap.FindArg(in, "MediaType"); err != nil {
+		return
+	}
+	if MediaType, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument MediaType: "+err.Error())
+	}
+	var PrintQuality PrintEnhanced1PrintQuality
+	if value, err = soap.FindArg(in, "PrintQuality"); err != nil {
+		return
+	}
+	PrintQuality = PrintEnhanced1PrintQuality(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var JobId uint32
+	var DataSink *url.URL
+	if JobId, DataSink, err = handler.CreateJob(ctx, JobName, JobOriginatingUserName, DocumentFormat, Copies, Sides, NumberUp, OrientationRequested, MediaSize, MediaType, PrintQuality); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "JobId"
+	if out[0].Value, err = soap.MarshalUi4(JobId); err != nil {
+		return
+	}
+	out[1].Name = "DataSink"
+	if out[1].Value, err = soap.MarshalURI(DataSink); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func servePrintEnhanced1CancelJob(ctx context.Context, handler PrintEnhanced1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var JobId uint32
+	if value, err = soap.FindArg(in, "JobId"); err != nil {
+		return
+	}
+	if JobId, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument JobId: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.CancelJob(ctx, JobId); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func servePrintEnhanced1GetJobAttributes(ctx context.Context, handler PrintEnhanced1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var JobId uint32
+	if value, err = soap.FindArg(in, "JobId"); err != nil {
+		return
+	}
+	if JobId, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument JobId: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var JobName string
+	var JobOriginatingUserName string
+	var JobMediaSheetsCompleted string
+	if JobName, JobOriginatingUserName, JobMediaSheetsCompleted, err = handler.GetJobAttributes(ctx, JobId); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 3)
+
+	out[0].Name = "JobName"
+	if out[0].Value, err = soap.MarshalString(JobName); err != nil {
+		return
+	}
+	out[1].Name = "JobOriginatingUserName"
+	if out[1].Value, err = soap.MarshalString(JobOriginatingUserName); err != nil {
+		return
+	}
+	out[2].Name = "JobMediaSheetsCompleted"
+	if out[2].Value, err = soap.MarshalString(JobMediaSheetsCompleted); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func servePrintEnhanced1GetMargins(ctx context.Context, handler PrintEnhanced1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var MediaSize string
+	if value, err = soap.FindArg(in, "MediaSize"); err != nil {
+		return
+	}
+	if MediaSize, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument MediaSize: "+err.Error())
+	}
+	var MediaType string
+	if value, err = soap.FindArg(in, "MediaType"); err != nil {
+		return
+	}
+	if MediaType, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument MediaType: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var Margins string
+	if Margins, err = handler.GetMargins(ctx, MediaSize, MediaType); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "Margins"
+	if out[0].Value, err = soap.MarshalString(Margins); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func servePrintEnhanced1GetMediaList(ctx context.Context, handler PrintEnhanced1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var MediaSize string
+	if value, err = soap.FindArg(in, "MediaSize"); err != nil {
+		return
+	}
+	if MediaSize, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument MediaSize: "+err.Error())
+	}
+	var MediaType string
+	if value, err = soap.FindArg(in, "MediaType"); err != nil {
+		return
+	}
+	if MediaType, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument MediaType: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var MediaList string
+	if MediaList, err = handler.GetMediaList(ctx, MediaSize, MediaType); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "MediaList"
+	if out[0].Value, err = soap.MarshalString(MediaList); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func servePrintEnhanced1GetPrinterAttributesV2(ctx context.Context, handler PrintEnhanced1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var PrinterState PrintEnhanced1PrinterState
+	var PrinterStateReasons string
+	var JobIdList string
+	var JobId uint32
+	var InternetConnectState PrintEnhanced1InternetConnectState
+	if PrinterState, PrinterStateReasons, JobIdList, JobId, InternetConnectState, err = handler.GetPrinterAttributesV2(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 5)
+
+	out[0].Name = "PrinterState"
+	if out[0].Value, err = soap.MarshalString(string(PrinterState)); err != nil {
+		return
+	}
+	out[1].Name = "PrinterStateReasons"
+	if out[1].Value, err = soap.MarshalString(PrinterStateReasons); err != nil {
+		return
+	}
+	out[2].Name = "JobIdList"
+	if out[2].Value, err = soap.MarshalString(JobIdList); err != nil {
+		return
+	}
+	out[3].Name = "JobId"
+	if out[3].Value, err = soap.MarshalUi4(JobId); err != nil {
+		return
+	}
+	out[4].Name = "InternetConnectState"
+	if out[4].Value, err = soap.MarshalString(string(InternetConnectState)); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func servePrintEnhanced1CreateJobV2(ctx context.Context, handler PrintEnhanced1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var JobName string
+	if value, err = soap.FindArg(in, "JobName"); err != nil {
+		return
+	}
+	if JobName, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument JobName: "+err.Error())
+	}
+	var JobOriginatingUserName string
+	if value, err = soap.FindArg(in, "JobOriginatingUserName"); err != nil {
+		return
+	}
+	if JobOriginatingUserName, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument JobOriginatingUserName: "+err.Error())
+	}
+	var DocumentFormat string
+	if value, err = soap.FindArg(in, "DocumentFormat"); err != nil {
+		return
+	}
+	if DocumentFormat, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DocumentFormat: "+err.Error())
+	}
+	var Copies uint8
+	if value, err = soap.FindArg(in, "Copies"); err != nil {
+		return
+	}
+	if Copies, err = soap.UnmarshalUi1(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Copies: "+err.Error())
+	}
+	var Sides PrintEnhanced1Sides
+	if value, err = soap.FindArg(in, "Sides"); err != nil {
+		return
+	}
+	Sides = PrintEnhanced1Sides(value)
+	var NumberUp PrintEnhanced1NumberUp
+	if value, err = soap.FindArg(in, "NumberUp"); err != nil {
+		return
+	}
+	NumberUp = PrintEnhanced1NumberUp(value)
+	var OrientationRequested PrintEnhanced1OrientationRequested
+	if value, err = soap.FindArg(in, "OrientationRequested"); err != nil {
+		return
+	}
+	OrientationRequested = PrintEnhanced1OrientationRequested(value)
+	var MediaSize string
+	if value, err = soap.FindArg(in, "MediaSize"); err != nil {
+		return
+	}
+	if MediaSize, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument MediaSize: "+err.Error())
+	}
+	var MediaType string
+	if value, err = soap.FindArg(in, "MediaType"); err != nil {
+		return
+	}
+	if MediaType, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument MediaType: "+err.Error())
+	}
+	var PrintQuality PrintEnhanced1PrintQuality
+	if value, err = soap.FindArg(in, "PrintQuality"); err != nil {
+		return
+	}
+	PrintQuality = PrintEnhanced1PrintQuality(value)
+	var CriticalAttributesList string
+	if value, err = soap.FindArg(in, "CriticalAttributesList"); err != nil {
+		return
+	}
+	if CriticalAttributesList, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument CriticalAttributesList: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var JobId uint32
+	var DataSink *url.URL
+	if JobId, DataSink, err = handler.CreateJobV2(ctx, JobName, JobOriginatingUserName, DocumentFormat, Copies, Sides, NumberUp, OrientationRequested, MediaSize, MediaType, PrintQuality, CriticalAttributesList); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "JobId"
+	if out[0].Value, err = soap.MarshalUi4(JobId); err != nil {
+		return
+	}
+	out[1].Name = "DataSink"
+	if out[1].Value, err = soap.MarshalURI(DataSink); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func servePrintEnhanced1CreateURIJob(ctx context.Context, handler PrintEnhanced1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var JobName string
+	if value, err = soap.FindArg(in, "JobName"); err != nil {
+		return
+	}
+	if JobName, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument JobName: "+err.Error())
+	}
+	var JobOriginatingUserName string
+	if value, err = soap.FindArg(in, "JobOriginatingUserName"); err != nil {
+		return
+	}
+	if JobOriginatingUserName, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument JobOriginatingUserName: "+err.Error())
+	}
+	var DocumentFormat string
+	if value, err = soap.FindArg(in, "DocumentFormat"); err != nil {
+		return
+	}
+	if DocumentFormat, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DocumentFormat: "+err.Error())
+	}
+	var Copies uint8
+	if value, err = soap.FindArg(in, "Copies"); err != nil {
+		return
+	}
+	if Copies, err = soap.UnmarshalUi1(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Copies: "+err.Error())
+	}
+	var Sides PrintEnhanced1Sides
+	if value, err = soap.FindArg(in, "Sides"); err != nil {
+		return
+	}
+	Sides = PrintEnhanced1Sides(value)
+	var NumberUp PrintEnhanced1NumberUp
+	if value, err = soap.FindArg(in, "NumberUp"); err != nil {
+		return
+	}
+	NumberUp = PrintEnhanced1NumberUp(value)
+	var OrientationRequested PrintEnhanced1OrientationRequested
+	if value, err = soap.FindArg(in, "OrientationRequested"); err != nil {
+		return
+	}
+	OrientationRequested = PrintEnhanced1OrientationRequested(value)
+	var MediaSize string
+	if value, err = soap.FindArg(in, "MediaSize"); err != nil {
+		return
+	}
+	if MediaSize, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument MediaSize: "+err.Error())
+	}
+	var MediaType string
+	if value, err = soap.FindArg(in, "MediaType"); err != nil {
+		return
+	}
+	if MediaType, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument MediaType: "+err.Error())
+	}
+	var PrintQuality PrintEnhanced1PrintQuality
+	if value, err = soap.FindArg(in, "PrintQuality"); err != nil {
+		return
+	}
+	PrintQuality = PrintEnhanced1PrintQuality(value)
+	var CriticalAttributesList string
+	if value, err = soap.FindArg(in, "CriticalAttributesList"); err != nil {
+		return
+	}
+	if CriticalAttributesList, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument CriticalAttributesList: "+err.Error())
+	}
+	var SourceURI *url.URL
+	if value, err = soap.FindArg(in, "SourceURI"); err != nil {
+		return
+	}
+	if SourceURI, err = soap.UnmarshalURI(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument SourceURI: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var JobId uint32
+	if JobId, err = handler.CreateURIJob(ctx, JobName, JobOriginatingUserName, DocumentFormat, Copies, Sides, NumberUp, OrientationRequested, MediaSize, MediaType, PrintQuality, CriticalAttributesList, SourceURI); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "JobId"
+	if out[0].Value, err = soap.MarshalUi4(JobId); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
@@ -0,0 +1,2649 @@
+// Client for UPnP Device Control Protocol Sonos ZonePlayer.
+//
+// Typically, use one of the New* functions to create clients for services.
+package sonos
+
+// Generated file - do not edit by hand. See README.md
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/huin/goupnp"
+	"github.com/huin/goupnp/soap"
+)
+
+// Hack to avoid Go complaining if time isn't used.
+var _ time.Time
+
+// Device URNs:
+const (
+	URN_MediaRenderer_1 = "urn:schemas-upnp-org:device:MediaRenderer:1"
+	URN_ZonePlayer_1    = "urn:schemas-upnp-org:device:ZonePlayer:1"
+)
+
+// Service URNs:
+const (
+	URN_Queue_1             = "urn:schemas-sonos-com:service:Queue:1"
+	URN_AlarmClock_1        = "urn:schemas-upnp-org:service:AlarmClock:1"
+	URN_MusicServices_1     = "urn:schemas-upnp-org:service:MusicServices:1"
+	URN_ZoneGroupTopology_1 = "urn:schemas-upnp-org:service:ZoneGroupTopology:1"
+)
+
+// AlarmClock1 is a client for UPnP SOAP service with URN "urn:schemas-upnp-org:service:AlarmClock:1". See
+// goupnp.ServiceClient, which contains RootDevice and Service attributes which
+// are provided for informational value.
+type AlarmClock1 struct {
+	goupnp.ServiceClient
+}
+
+// AlarmClock1Client is the interface of the actions of AlarmClock1, for
+// substituting fakes or mocks for the service in tests.
+type AlarmClock1Client interface {
+	SetFormat(DesiredTimeFormat string, DesiredDateFormat string) (err error)
+	SetFormatCtx(ctx context.Context, DesiredTimeFormat string, DesiredDateFormat string) (err error)
+	GetFormat() (CurrentTimeFormat string, CurrentDateFormat string, err error)
+	GetFormatCtx(ctx context.Context) (CurrentTimeFormat string, CurrentDateFormat string, err error)
+	SetTimeZone(Index int32, AutoAdjustDst bool) (err error)
+	SetTimeZoneCtx(ctx context.Context, Index int32, AutoAdjustDst bool) (err error)
+	GetTimeZone() (Index int32, AutoAdjustDst bool, err error)
+	GetTimeZoneCtx(ctx context.Context) (Index int32, AutoAdjustDst bool, err error)
+	GetTimeZoneAndRule() (Index int32, AutoAdjustDst bool, CurrentTimeZone string, err error)
+	GetTimeZoneAndRuleCtx(ctx context.Context) (Index int32, AutoAdjustDst bool, CurrentTimeZone string, err error)
+	GetTimeZoneRule(Index int32) (TimeZone string, err error)
+	GetTimeZoneRuleCtx(ctx context.Context, Index int32) (TimeZone string, err error)
+	SetTimeServer(DesiredTimeServer string) (err error)
+	SetTimeServerCtx(ctx context.Context, DesiredTimeServer string) (err error)
+	GetTimeServer() (CurrentTimeServer string, err error)
+	GetTimeServerCtx(ctx context.Context) (CurrentTimeServer string, err error)
+	SetTimeNow(DesiredTime string, TimeZoneForDesiredTime string) (err error)
+	SetTimeNowCtx(ctx context.Context, DesiredTime string, TimeZoneForDesiredTime string) (err error)
+	GetHouseholdTimeAtStamp(TimeStamp string) (HouseholdUTCTime string, err error)
+	GetHouseholdTimeAtStampCtx(ctx context.Context, TimeStamp string) (HouseholdUTCTime string, err error)
+	GetTimeNow() (CurrentUTCTime string, CurrentLocalTime string, CurrentTimeZone string, CurrentTimeGeneration uint32, err error)
+	GetTimeNowCtx(ctx context.Context) (CurrentUTCTime string, CurrentLocalTime string, CurrentTimeZone string, CurrentTimeGeneration uint32, err error)
+	CreateAlarm(StartLocalTime string, Duration string, Recurrence AlarmClock1Recurrence, Enabled bool, RoomUUID string, ProgramURI string, ProgramMetaData string, PlayMode AlarmClock1AlarmPlayMode, Volume uint16, IncludeLinkedZones bool) (AssignedID uint32, err error)
+	CreateAlarmCtx(ctx context.Context, StartLocalTime string, Duration string, Recurrence AlarmClock1Recurrence, Enabled bool, RoomUUID string, ProgramURI string, ProgramMetaData string, PlayMode AlarmClock1AlarmPlayMode, Volume uint16, IncludeLinkedZones bool) (AssignedID uint32, err error)
+	UpdateAlarm(ID uint32, StartLocalTime string, Duration string, Recurrence AlarmClock1Recurrence, Enabled bool, RoomUUID string, ProgramURI string, ProgramMetaData string, PlayMode AlarmClock1AlarmPlayMode, Volume uint16, IncludeLinkedZones bool) (err error)
+	UpdateAlarmCtx(ctx context.Context, ID uint32, StartLocalTime string, Duration string, Recurrence AlarmClock1Recurrence, Enabled bool, RoomUUID string, ProgramURI string, ProgramMetaData string, PlayMode AlarmClock1AlarmPlayMode, Volume uint16, IncludeLinkedZones bool) (err error)
+	DestroyAlarm(ID uint32) (err error)
+	DestroyAlarmCtx(ctx context.Context, ID uint32) (err error)
+	ListAlarms() (CurrentAlarmList string, CurrentAlarmListVersion string, err error)
+	ListAlarmsCtx(ctx context.Context) (CurrentAlarmList string, CurrentAlarmListVersion string, err error)
+	SetDailyIndexRefreshTime(DesiredDailyIndexRefreshTime string) (err error)
+	SetDailyIndexRefreshTimeCtx(ctx context.Context, DesiredDailyIndexRefreshTime string) (err error)
+	GetDailyIndexRefreshTime() (CurrentDailyIndexRefreshTime string, err error)
+	GetDailyIndexRefreshTimeCtx(ctx context.Context) (CurrentDailyIndexRefreshTime string, err error)
+}
+
+var _ AlarmClock1Client = new(AlarmClock1)
+
+// AlarmClock1Recurrence is a value of the state variable A_ARG_TYPE_Recurrence of
+// AlarmClock1.
+type AlarmClock1Recurrence string
+
+// Allowed values of AlarmClock1Recurrence.
+const (
+	AlarmClock1Recurrence_ONCE     AlarmClock1Recurrence = "ONCE"
+	AlarmClock1Recurrence_WEEKDAYS AlarmClock1Recurrence = "WEEKDAYS"
+	AlarmClock1Recurrence_WEEKENDS AlarmClock1Recurrence = "WEEKENDS"
+	AlarmClock1Recurrence_DAILY    AlarmClock1Recurrence = "DAILY"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v AlarmClock1Recurrence) Valid() bool {
+	switch v {
+	case AlarmClock1Recurrence_ONCE,
+		AlarmClock1Recurrence_WEEKDAYS,
+		AlarmClock1Recurrence_WEEKENDS,
+		AlarmClock1Recurrence_DAILY:
+		return true
+	}
+	return false
+}
+
+// AlarmClock1AlarmPlayMode is a value of the state variable A_ARG_TYPE_AlarmPlayMode of
+// AlarmClock1.
+type AlarmClock1AlarmPlayMode string
+
+// Allowed values of AlarmClock1AlarmPlayMode.
+const (
+	AlarmClock1AlarmPlayMode_NORMAL           AlarmClock1AlarmPlayMode = "NORMAL"
+	AlarmClock1AlarmPlayMode_REPEAT_ALL       AlarmClock1AlarmPlayMode = "REPEAT_ALL"
+	AlarmClock1AlarmPlayMode_SHUFFLE_NOREPEAT AlarmClock1AlarmPlayMode = "SHUFFLE_NOREPEAT"
+	AlarmClock1AlarmPlayMode_SHUFFLE          AlarmClock1AlarmPlayMode = "SHUFFLE"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v AlarmClock1AlarmPlayMode) Valid() bool {
+	switch v {
+	case AlarmClock1AlarmPlayMode_NORMAL,
+		AlarmClock1AlarmPlayMode_REPEAT_ALL,
+		AlarmClock1AlarmPlayMode_SHUFFLE_NOREPEAT,
+		AlarmClock1AlarmPlayMode_SHUFFLE:
+		return true
+	}
+	return false
+}
+
+// NewAlarmClock1Clients discovers instances of the service on the network,
+// and returns clients to any that are found. errors will contain an error for
+// any devices that replied but which could not be queried, and err will be set
+// if the discovery process failed outright.
+//
+// This is a typical entry calling point into this package.
+func NewAlarmClock1Clients() (clients []*AlarmClock1, errors []error, err error) {
+	var genericClients []goupnp.ServiceClient
+	if genericClients, errors, err = goupnp.NewServiceClients(URN_AlarmClock_1); err != nil {
+		return
+	}
+	clients = newAlarmClock1ClientsFromGenericClients(genericClients)
+	return
+}
+
+// NewAlarmClock1ClientsByURL discovers instances of the service at the given
+// URL, and returns clients to any that are found. An error is returned if
+// there was an error probing the service.
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered service URL.
+func NewAlarmClock1ClientsByURL(loc *url.URL) ([]*AlarmClock1, error) {
+	genericClients, err := goupnp.NewServiceClientsByURL(loc, URN_AlarmClock_1)
+	if err != nil {
+		return nil, err
+	}
+	return newAlarmClock1ClientsFromGenericClients(genericClients), nil
+}
+
+// NewAlarmClock1ClientsFromRootDevice discovers instances of the service in
+// a given root device, and returns clients to any that are found. An error is
+// returned if there was not at least one instance of the service within the
+// device. The location parameter is simply assigned to the Location attribute
+// of the wrapped ServiceClient(s).
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered root device.
+func NewAlarmClock1ClientsFromRootDevice(rootDevice *goupnp.RootDevice, loc *url.URL) ([]*AlarmClock1, error) {
+	genericClients, err := goupnp.NewServiceClientsFromRootDevice(rootDevice, loc, URN_AlarmClock_1)
+	if err != nil {
+		return nil, err
+	}
+	return newAlarmClock1ClientsFromGenericClients(genericClients), nil
+}
+
+func newAlarmClock1ClientsFromGenericClients(genericClients []goupnp.ServiceClient) []*AlarmClock1 {
+	clients := make([]*AlarmClock1, len(genericClients))
+	for i := range genericClients {
+		clients[i] = &AlarmClock1{genericClients[i]}
+	}
+	return clients
+}
+
+// AlarmClock1Timeouts are the default timeouts of the actions of
+// AlarmClock1, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var AlarmClock1Timeouts = map[string]time.Duration{}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *AlarmClock1) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, AlarmClock1Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_AlarmClock_1, actionName, request, response)
+}
+
+// AlarmClock1SetFormatRequest is the request of SetFormat, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AlarmClock1SetFormatRequest struct {
+	DesiredTimeFormat string
+	DesiredDateFormat string
+}
+
+// SetFormat performs the SetFormat action of the service.
+//
+// Arguments:
+//   - DesiredTimeFormat (in, state variable TimeFormat)
+//   - DesiredDateFormat (in, state variable DateFormat)
+func (client *AlarmClock1) SetFormat(DesiredTimeFormat string, DesiredDateFormat string) (err error) {
+	return client.SetFormatCtx(context.Background(), DesiredTimeFormat, DesiredDateFormat)
+}
+
+// SetFormatCtx is SetFormat with a context, to cancel or time out the call.
+func (client *AlarmClock1) SetFormatCtx(ctx context.Context, DesiredTimeFormat string, DesiredDateFormat string) (err error) {
+	// Request structure.
+	request := &AlarmClock1SetFormatRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.DesiredTimeFormat, err = soap.MarshalString(DesiredTimeFormat); err != nil {
+		return
+	}
+	if request.DesiredDateFormat, err = soap.MarshalString(DesiredDateFormat); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SetFormat", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// AlarmClock1GetFormatResponse is the response of GetFormat, with each
+// argument in its SOAP string form.
+type AlarmClock1GetFormatResponse struct {
+	CurrentTimeFormat string
+	CurrentDateFormat string
+}
+
+// GetFormat performs the GetFormat action of the service.
+//
+// Return values:
+//   - CurrentTimeFormat (out, state variable TimeFormat)
+//   - CurrentDateFormat (out, state variable DateFormat)
+func (client *AlarmClock1) GetFormat() (CurrentTimeFormat string, CurrentDateFormat string, err error) {
+	return client.GetFormatCtx(context.Background())
+}
+
+// GetFormatCtx is GetFormat with a context, to cancel or time out the call.
+func (client *AlarmClock1) GetFormatCtx(ctx context.Context) (CurrentTimeFormat string, CurrentDateFormat string, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &AlarmClock1GetFormatResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetFormat", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if CurrentTimeFormat, err = soap.UnmarshalString(response.CurrentTimeFormat); err != nil {
+		return
+	}
+	if CurrentDateFormat, err = soap.UnmarshalString(response.CurrentDateFormat); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// AlarmClock1SetTimeZoneRequest is the request of SetTimeZone, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AlarmClock1SetTimeZoneRequest struct {
+	Index         string
+	AutoAdjustDst string
+}
+
+// SetTimeZone performs the SetTimeZone action of the service.
+//
+// Arguments:
+//   - Index (in, state variable TimeZoneIndex)
+//   - AutoAdjustDst (in, state variable TimeZoneAutoAdjustDst)
+func (client *AlarmClock1) SetTimeZone(Index int32, AutoAdjustDst bool) (err error) {
+	return client.SetTimeZoneCtx(context.Background(), Index, AutoAdjustDst)
+}
+
+// SetTimeZoneCtx is SetTimeZone with a context, to cancel or time out the call.
+func (client *AlarmClock1) SetTimeZoneCtx(ctx context.Context, Index int32, AutoAdjustDst bool) (err error) {
+	// Request structure.
+	request := &AlarmClock1SetTimeZoneRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.Index, err = soap.MarshalI4(Index); err != nil {
+		return
+	}
+	if request.AutoAdjustDst, err = soap.MarshalBoolean(AutoAdjustDst); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SetTimeZone", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// AlarmClock1GetTimeZoneResponse is the response of GetTimeZone, with each
+// argument in its SOAP string form.
+type AlarmClock1GetTimeZoneResponse struct {
+	Index         string
+	AutoAdjustDst string
+}
+
+// GetTimeZone performs the GetTimeZone action of the service.
+//
+// Return values:
+//   - Index (out, state variable TimeZoneIndex)
+//   - AutoAdjustDst (out, state variable TimeZoneAutoAdjustDst)
+func (client *AlarmClock1) GetTimeZone() (Index int32, AutoAdjustDst bool, err error) {
+	return client.GetTimeZoneCtx(context.Background())
+}
+
+// GetTimeZoneCtx is GetTimeZone with a context, to cancel or time out the call.
+func (client *AlarmClock1) GetTimeZoneCtx(ctx context.Context) (Index int32, AutoAdjustDst bool, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &AlarmClock1GetTimeZoneResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetTimeZone", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if Index, err = soap.UnmarshalI4(response.Index); err != nil {
+		return
+	}
+	if AutoAdjustDst, err = soap.UnmarshalBoolean(response.AutoAdjustDst); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// AlarmClock1GetTimeZoneAndRuleResponse is the response of GetTimeZoneAndRule, with each
+// argument in its SOAP string form.
+type AlarmClock1GetTimeZoneAndRuleResponse struct {
+	Index           string
+	AutoAdjustDst   string
+	CurrentTimeZone string
+}
+
+// GetTimeZoneAndRule performs the GetTimeZoneAndRule action of the service.
+//
+// Return values:
+//   - Index (out, state variable TimeZoneIndex)
+//   - AutoAdjustDst (out, state variable TimeZoneAutoAdjustDst)
+//   - CurrentTimeZone (out, state variable TimeZone)
+func (client *AlarmClock1) GetTimeZoneAndRule() (Index int32, AutoAdjustDst bool, CurrentTimeZone string, err error) {
+	return client.GetTimeZoneAndRuleCtx(context.Background())
+}
+
+// GetTimeZoneAndRuleCtx is GetTimeZoneAndRule with a context, to cancel or time out the call.
+func (client *AlarmClock1) GetTimeZoneAndRuleCtx(ctx context.Context) (Index int32, AutoAdjustDst bool, CurrentTimeZone string, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &AlarmClock1GetTimeZoneAndRuleResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetTimeZoneAndRule", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if Index, err = soap.UnmarshalI4(response.Index); err != nil {
+		return
+	}
+	if AutoAdjustDst, err = soap.UnmarshalBoolean(response.AutoAdjustDst); err != nil {
+		return
+	}
+	if CurrentTimeZone, err = soap.UnmarshalString(response.CurrentTimeZone); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// AlarmClock1GetTimeZoneRuleRequest is the request of GetTimeZoneRule, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AlarmClock1GetTimeZoneRuleRequest struct {
+	Index string
+}
+
+// AlarmClock1GetTimeZoneRuleResponse is the response of GetTimeZoneRule, with each
+// argument in its SOAP string form.
+type AlarmClock1GetTimeZoneRuleResponse struct {
+	TimeZone string
+}
+
+// GetTimeZoneRule performs the GetTimeZoneRule action of the service.
+//
+// Arguments:
+//   - Index (in, state variable TimeZoneIndex)
+//
+// Return values:
+//   - TimeZone (out, state variable TimeZone)
+func (client *AlarmClock1) GetTimeZoneRule(Index int32) (TimeZone string, err error) {
+	return client.GetTimeZoneRuleCtx(context.Background(), Index)
+}
+
+// GetTimeZoneRuleCtx is GetTimeZoneRule with a context, to cancel or time out the call.
+func (client *AlarmClock1) GetTimeZoneRuleCtx(ctx context.Context, Index int32) (TimeZone string, err error) {
+	// Request structure.
+	request := &AlarmClock1GetTimeZoneRuleRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.Index, err = soap.MarshalI4(Index); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &AlarmClock1GetTimeZoneRuleResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetTimeZoneRule", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if TimeZone, err = soap.UnmarshalString(response.TimeZone); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// AlarmClock1SetTimeServerRequest is the request of SetTimeServer, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AlarmClock1SetTimeServerRequest struct {
+	DesiredTimeServer string
+}
+
+// SetTimeServer performs the SetTimeServer action of the service.
+//
+// Arguments:
+//   - DesiredTimeServer (in, state variable TimeServer)
+func (client *AlarmClock1) SetTimeServer(DesiredTimeServer string) (err error) {
+	return client.SetTimeServerCtx(context.Background(), DesiredTimeServer)
+}
+
+// SetTimeServerCtx is SetTimeServer with a context, to cancel or time out the call.
+func (client *AlarmClock1) SetTimeServerCtx(ctx context.Context, DesiredTimeServer string) (err error) {
+	// Request structure.
+	request := &AlarmClock1SetTimeServerRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.DesiredTimeServer, err = soap.MarshalString(DesiredTimeServer); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SetTimeServer", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// AlarmClock1GetTimeServerResponse is the response of GetTimeServer, with each
+// argument in its SOAP string form.
+type AlarmClock1GetTimeServerResponse struct {
+	CurrentTimeServer string
+}
+
+// GetTimeServer performs the GetTimeServer action of the service.
+//
+// Return values:
+//   - CurrentTimeServer (out, state variable TimeServer)
+func (client *AlarmClock1) GetTimeServer() (CurrentTimeServer string, err error) {
+	return client.GetTimeServerCtx(context.Background())
+}
+
+// GetTimeServerCtx is GetTimeServer with a context, to cancel or time out the call.
+func (client *AlarmClock1) GetTimeServerCtx(ctx context.Context) (CurrentTimeServer string, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &AlarmClock1GetTimeServerResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetTimeServer", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if CurrentTimeServer, err = soap.UnmarshalString(response.CurrentTimeServer); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// AlarmClock1SetTimeNowRequest is the request of SetTimeNow, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AlarmClock1SetTimeNowRequest struct {
+	DesiredTime            string
+	TimeZoneForDesiredTime string
+}
+
+// SetTimeNow performs the SetTimeNow action of the service.
+//
+// Arguments:
+//   - DesiredTime (in, state variable A_ARG_TYPE_ISO8601Time)
+//   - TimeZoneForDesiredTime (in, state variable TimeZone)
+func (client *AlarmClock1) SetTimeNow(DesiredTime string, TimeZoneForDesiredTime string) (err error) {
+	return client.SetTimeNowCtx(context.Background(), DesiredTime, TimeZoneForDesiredTime)
+}
+
+// SetTimeNowCtx is SetTimeNow with a context, to cancel or time out the call.
+func (client *AlarmClock1) SetTimeNowCtx(ctx context.Context, DesiredTime string, TimeZoneForDesiredTime string) (err error) {
+	// Request structure.
+	request := &AlarmClock1SetTimeNowRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.DesiredTime, err = soap.MarshalString(DesiredTime); err != nil {
+		return
+	}
+	if request.TimeZoneForDesiredTime, err = soap.MarshalString(TimeZoneForDesiredTime); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SetTimeNow", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// AlarmClock1GetHouseholdTimeAtStampRequest is the request of GetHouseholdTimeAtStamp, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AlarmClock1GetHouseholdTimeAtStampRequest struct {
+	TimeStamp string
+}
+
+// AlarmClock1GetHouseholdTimeAtStampResponse is the response of GetHouseholdTimeAtStamp, with each
+// argument in its SOAP string form.
+type AlarmClock1GetHouseholdTimeAtStampResponse struct {
+	HouseholdUTCTime string
+}
+
+// GetHouseholdTimeAtStamp performs the GetHouseholdTimeAtStamp action of the service.
+//
+// Arguments:
+//   - TimeStamp (in, state variable A_ARG_TYPE_TimeStamp)
+//
+// Return values:
+//   - HouseholdUTCTime (out, state variable A_ARG_TYPE_ISO8601Time)
+func (client *AlarmClock1) GetHouseholdTimeAtStamp(TimeStamp string) (HouseholdUTCTime string, err error) {
+	return client.GetHouseholdTimeAtStampCtx(context.Background(), TimeStamp)
+}
+
+// GetHouseholdTimeAtStampCtx is GetHouseholdTimeAtStamp with a context, to cancel or time out the call.
+func (client *AlarmClock1) GetHouseholdTimeAtStampCtx(ctx context.Context, TimeStamp string) (HouseholdUTCTime string, err error) {
+	// Request structure.
+	request := &AlarmClock1GetHouseholdTimeAtStampRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.TimeStamp, err = soap.MarshalString(TimeStamp); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &AlarmClock1GetHouseholdTimeAtStampResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetHouseholdTimeAtStamp", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if HouseholdUTCTime, err = soap.UnmarshalString(response.HouseholdUTCTime); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// AlarmClock1GetTimeNowResponse is the response of GetTimeNow, with each
+// argument in its SOAP string form.
+type AlarmClock1GetTimeNowResponse struct {
+	CurrentUTCTime        string
+	CurrentLocalTime      string
+	CurrentTimeZone       string
+	CurrentTimeGeneration string
+}
+
+// GetTimeNow performs the GetTimeNow action of the service.
+//
+// Return values:
+//   - CurrentUTCTime (out, state variable A_ARG_TYPE_ISO8601Time)
+//   - CurrentLocalTime (out, state variable A_ARG_TYPE_ISO8601Time)
+//   - CurrentTimeZone (out, state variable TimeZone)
+//   - CurrentTimeGeneration (out, state variable TimeGeneration)
+func (client *AlarmClock1) GetTimeNow() (CurrentUTCTime string, CurrentLocalTime string, CurrentTimeZone string, CurrentTimeGeneration uint32, err error) {
+	return client.GetTimeNowCtx(context.Background())
+}
+
+// GetTimeNowCtx is GetTimeNow with a context, to cancel or time out the call.
+func (client *AlarmClock1) GetTimeNowCtx(ctx context.Context) (CurrentUTCTime string, CurrentLocalTime string, CurrentTimeZone string, CurrentTimeGeneration uint32, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &AlarmClock1GetTimeNowResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetTimeNow", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if CurrentUTCTime, err = soap.UnmarshalString(response.CurrentUTCTime); err != nil {
+		return
+	}
+	if CurrentLocalTime, err = soap.UnmarshalString(response.CurrentLocalTime); err != nil {
+		return
+	}
+	if CurrentTimeZone, err = soap.UnmarshalString(response.CurrentTimeZone); err != nil {
+		return
+	}
+	if CurrentTimeGeneration, err = soap.UnmarshalUi4(response.CurrentTimeGeneration); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// AlarmClock1CreateAlarmRequest is the request of CreateAlarm, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AlarmClock1CreateAlarmRequest struct {
+	StartLocalTime     string
+	Duration           string
+	Recurrence         string
+	Enabled            string
+	RoomUUID           string
+	ProgramURI         string
+	ProgramMetaData    string
+	PlayMode           string
+	Volume             string
+	IncludeLinkedZones string
+}
+
+// AlarmClock1CreateAlarmResponse is the response of CreateAlarm, with each
+// argument in its SOAP string form.
+type AlarmClock1CreateAlarmResponse struct {
+	AssignedID string
+}
+
+// CreateAlarm performs the CreateAlarm action of the service.
+//
+// Arguments:
+//   - StartLocalTime (in, state variable A_ARG_TYPE_ISO8601Time)
+//   - Duration (in, state variable A_ARG_TYPE_ISO8601Time)
+//   - Recurrence (in, state variable A_ARG_TYPE_Recurrence): allowed values ONCE, WEEKDAYS, WEEKENDS, DAILY
+//   - Enabled (in, state variable A_ARG_TYPE_AlarmEnabled)
+//   - RoomUUID (in, state variable A_ARG_TYPE_AlarmRoomUUID)
+//   - ProgramURI (in, state variable A_ARG_TYPE_AlarmProgramURI)
+//   - ProgramMetaData (in, state variable A_ARG_TYPE_AlarmProgramMetaData)
+//   - PlayMode (in, state variable A_ARG_TYPE_AlarmPlayMode): allowed values NORMAL, REPEAT_ALL, SHUFFLE_NOREPEAT, SHUFFLE
+//   - Volume (in, state variable A_ARG_TYPE_AlarmVolume)
+//   - IncludeLinkedZones (in, state variable A_ARG_TYPE_AlarmIncludeLinkedZones)
+//
+// Return values:
+//   - AssignedID (out, state variable A_ARG_TYPE_AlarmID)
+func (client *AlarmClock1) CreateAlarm(StartLocalTime string, Duration string, Recurrence AlarmClock1Recurrence, Enabled bool, RoomUUID string, ProgramURI string, ProgramMetaData string, PlayMode AlarmClock1AlarmPlayMode, Volume uint16, IncludeLinkedZones bool) (AssignedID uint32, err error) {
+	return client.CreateAlarmCtx(context.Background(), StartLocalTime, Duration, Recurrence, Enabled, RoomUUID, ProgramURI, ProgramMetaData, PlayMode, Volume, IncludeLinkedZones)
+}
+
+// CreateAlarmCtx is CreateAlarm with a context, to cancel or time out the call.
+func (client *AlarmClock1) CreateAlarmCtx(ctx context.Context, StartLocalTime string, Duration string, Recurrence AlarmClock1Recurrence, Enabled bool, RoomUUID string, ProgramURI string, ProgramMetaData string, PlayMode AlarmClock1AlarmPlayMode, Volume uint16, IncludeLinkedZones bool) (AssignedID uint32, err error) {
+	// Request structure.
+	request := &AlarmClock1CreateAlarmRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.StartLocalTime, err = soap.MarshalString(StartLocalTime); err != nil {
+		return
+	}
+	if request.Duration, err = soap.MarshalString(Duration); err != nil {
+		return
+	}
+	if request.Recurrence, err = soap.MarshalString(string(Recurrence)); err != nil {
+		return
+	}
+	if request.Enabled, err = soap.MarshalBoolean(Enabled); err != nil {
+		return
+	}
+	if request.RoomUUID, err = soap.MarshalString(RoomUUID); err != nil {
+		return
+	}
+	if request.ProgramURI, err = soap.MarshalString(ProgramURI); err != nil {
+		return
+	}
+	if request.ProgramMetaData, err = soap.MarshalString(ProgramMetaData); err != nil {
+		return
+	}
+	if request.PlayMode, err = soap.MarshalString(string(PlayMode)); err != nil {
+		return
+	}
+	if request.Volume, err = soap.MarshalUi2(Volume); err != nil {
+		return
+	}
+	if request.IncludeLinkedZones, err = soap.MarshalBoolean(IncludeLinkedZones); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &AlarmClock1CreateAlarmResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "CreateAlarm", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if AssignedID, err = soap.UnmarshalUi4(response.AssignedID); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// AlarmClock1UpdateAlarmRequest is the request of UpdateAlarm, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AlarmClock1UpdateAlarmRequest struct {
+	ID                 string
+	StartLocalTime     string
+	Duration           string
+	Recurrence         string
+	Enabled            string
+	RoomUUID           string
+	ProgramURI         string
+	ProgramMetaData    string
+	PlayMode           string
+	Volume             string
+	IncludeLinkedZones string
+}
+
+// UpdateAlarm performs the UpdateAlarm action of the service.
+//
+// Arguments:
+//   - ID (in, state variable A_ARG_TYPE_AlarmID)
+//   - StartLocalTime (in, state variable A_ARG_TYPE_ISO8601Time)
+//   - Duration (in, state variable A_ARG_TYPE_ISO8601Time)
+//   - Recurrence (in, state variable A_ARG_TYPE_Recurrence): allowed values ONCE, WEEKDAYS, WEEKENDS, DAILY
+//   - Enabled (in, state variable A_ARG_TYPE_AlarmEnabled)
+//   - RoomUUID (in, state variable A_ARG_TYPE_AlarmRoomUUID)
+//   - ProgramURI (in, state variable A_ARG_TYPE_AlarmProgramURI)
+//   - ProgramMetaData (in, state variable A_ARG_TYPE_AlarmProgramMetaData)
+//   - PlayMode (in, state variable A_ARG_TYPE_AlarmPlayMode): allowed values NORMAL, REPEAT_ALL, SHUFFLE_NOREPEAT, SHUFFLE
+//   - Volume (in, state variable A_ARG_TYPE_AlarmVolume)
+//   - IncludeLinkedZones (in, state variable A_ARG_TYPE_AlarmIncludeLinkedZones)
+func (client *AlarmClock1) UpdateAlarm(ID uint32, StartLocalTime string, Duration string, Recurrence AlarmClock1Recurrence, Enabled bool, RoomUUID string, ProgramURI string, ProgramMetaData string, PlayMode AlarmClock1AlarmPlayMode, Volume uint16, IncludeLinkedZones bool) (err error) {
+	return client.UpdateAlarmCtx(context.Background(), ID, StartLocalTime, Duration, Recurrence, Enabled, RoomUUID, ProgramURI, ProgramMetaData, PlayMode, Volume, IncludeLinkedZones)
+}
+
+// UpdateAlarmCtx is UpdateAlarm with a context, to cancel or time out the call.
+func (client *AlarmClock1) UpdateAlarmCtx(ctx context.Context, ID uint32, StartLocalTime string, Duration string, Recurrence AlarmClock1Recurrence, Enabled bool, RoomUUID string, ProgramURI string, ProgramMetaData string, PlayMode AlarmClock1AlarmPlayMode, Volume uint16, IncludeLinkedZones bool) (err error) {
+	// Request structure.
+	request := &AlarmClock1UpdateAlarmRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.ID, err = soap.MarshalUi4(ID); err != nil {
+		return
+	}
+	if request.StartLocalTime, err = soap.MarshalString(StartLocalTime); err != nil {
+		return
+	}
+	if request.Duration, err = soap.MarshalString(Duration); err != nil {
+		return
+	}
+	if request.Recurrence, err = soap.MarshalString(string(Recurrence)); err != nil {
+		return
+	}
+	if request.Enabled, err = soap.MarshalBoolean(Enabled); err != nil {
+		return
+	}
+	if request.RoomUUID, err = soap.MarshalString(RoomUUID); err != nil {
+		return
+	}
+	if request.ProgramURI, err = soap.MarshalString(ProgramURI); err != nil {
+		return
+	}
+	if request.ProgramMetaData, err = soap.MarshalString(ProgramMetaData); err != nil {
+		return
+	}
+	if request.PlayMode, err = soap.MarshalString(string(PlayMode)); err != nil {
+		return
+	}
+	if request.Volume, err = soap.MarshalUi2(Volume); err != nil {
+		return
+	}
+	if request.IncludeLinkedZones, err = soap.MarshalBoolean(IncludeLinkedZones); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "UpdateAlarm", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// AlarmClock1DestroyAlarmRequest is the request of DestroyAlarm, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AlarmClock1DestroyAlarmRequest struct {
+	ID string
+}
+
+// DestroyAlarm performs the DestroyAlarm action of the service.
+//
+// Arguments:
+//   - ID (in, state variable A_ARG_TYPE_AlarmID)
+func (client *AlarmClock1) DestroyAlarm(ID uint32) (err error) {
+	return client.DestroyAlarmCtx(context.Background(), ID)
+}
+
+// DestroyAlarmCtx is DestroyAlarm with a context, to cancel or time out the call.
+func (client *AlarmClock1) DestroyAlarmCtx(ctx context.Context, ID uint32) (err error) {
+	// Request structure.
+	request := &AlarmClock1DestroyAlarmRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.ID, err = soap.MarshalUi4(ID); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "DestroyAlarm", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// AlarmClock1ListAlarmsResponse is the response of ListAlarms, with each
+// argument in its SOAP string form.
+type AlarmClock1ListAlarmsResponse struct {
+	CurrentAlarmList        string
+	CurrentAlarmListVersion string
+}
+
+// ListAlarms performs the ListAlarms action of the service.
+//
+// Return values:
+//   - CurrentAlarmList (out, state variable A_ARG_TYPE_AlarmList)
+//   - CurrentAlarmListVersion (out, state variable AlarmListVersion)
+func (client *AlarmClock1) ListAlarms() (CurrentAlarmList string, CurrentAlarmListVersion string, err error) {
+	return client.ListAlarmsCtx(context.Background())
+}
+
+// ListAlarmsCtx is ListAlarms with a context, to cancel or time out the call.
+func (client *AlarmClock1) ListAlarmsCtx(ctx context.Context) (CurrentAlarmList string, CurrentAlarmListVersion string, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &AlarmClock1ListAlarmsResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "ListAlarms", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if CurrentAlarmList, err = soap.UnmarshalString(response.CurrentAlarmList); err != nil {
+		return
+	}
+	if CurrentAlarmListVersion, err = soap.UnmarshalString(response.CurrentAlarmListVersion); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// AlarmClock1SetDailyIndexRefreshTimeRequest is the request of SetDailyIndexRefreshTime, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type AlarmClock1SetDailyIndexRefreshTimeRequest struct {
+	DesiredDailyIndexRefreshTime string
+}
+
+// SetDailyIndexRefreshTime performs the SetDailyIndexRefreshTime action of the service.
+//
+// Arguments:
+//   - DesiredDailyIndexRefreshTime (in, state variable DailyIndexRefreshTime)
+func (client *AlarmClock1) SetDailyIndexRefreshTime(DesiredDailyIndexRefreshTime string) (err error) {
+	return client.SetDailyIndexRefreshTimeCtx(context.Background(), DesiredDailyIndexRefreshTime)
+}
+
+// SetDailyIndexRefreshTimeCtx is SetDailyIndexRefreshTime with a context, to cancel or time out the call.
+func (client *AlarmClock1) SetDailyIndexRefreshTimeCtx(ctx context.Context, DesiredDailyIndexRefreshTime string) (err error) {
+	// Request structure.
+	request := &AlarmClock1SetDailyIndexRefreshTimeRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.DesiredDailyIndexRefreshTime, err = soap.MarshalString(DesiredDailyIndexRefreshTime); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SetDailyIndexRefreshTime", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// AlarmClock1GetDailyIndexRefreshTimeResponse is the response of GetDailyIndexRefreshTime, with each
+// argument in its SOAP string form.
+type AlarmClock1GetDailyIndexRefreshTimeResponse struct {
+	CurrentDailyIndexRefreshTime string
+}
+
+// GetDailyIndexRefreshTime performs the GetDailyIndexRefreshTime action of the service.
+//
+// Return values:
+//   - CurrentDailyIndexRefreshTime (out, state variable DailyIndexRefreshTime)
+func (client *AlarmClock1) GetDailyIndexRefreshTime() (CurrentDailyIndexRefreshTime string, err error) {
+	return client.GetDailyIndexRefreshTimeCtx(context.Background())
+}
+
+// GetDailyIndexRefreshTimeCtx is GetDailyIndexRefreshTime with a context, to cancel or time out the call.
+func (client *AlarmClock1) GetDailyIndexRefreshTimeCtx(ctx context.Context) (CurrentDailyIndexRefreshTime string, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &AlarmClock1GetDailyIndexRefreshTimeResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetDailyIndexRefreshTime", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if CurrentDailyIndexRefreshTime, err = soap.UnmarshalString(response.CurrentDailyIndexRefreshTime); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// MusicServices1 is a client for UPnP SOAP service with URN "urn:schemas-upnp-org:service:MusicServices:1". See
+// goupnp.ServiceClient, which contains RootDevice and Service attributes which
+// are provided for informational value.
+type MusicServices1 struct {
+	goupnp.ServiceClient
+}
+
+// MusicServices1Client is the interface of the actions of MusicServices1, for
+// substituting fakes or mocks for the service in tests.
+type MusicServices1Client interface {
+	GetSessionId(ServiceId uint32, Username string) (SessionId string, err error)
+	GetSessionIdCtx(ctx context.Context, ServiceId uint32, Username string) (SessionId string, err error)
+	ListAvailableServices() (AvailableServiceDescriptorList string, AvailableServiceTypeList string, AvailableServiceListVersion string, err error)
+	ListAvailableServicesCtx(ctx context.Context) (AvailableServiceDescriptorList string, AvailableServiceTypeList string, AvailableServiceListVersion string, err error)
+	UpdateAvailableServices() (err error)
+	UpdateAvailableServicesCtx(ctx context.Context) (err error)
+}
+
+var _ MusicServices1Client = new(MusicServices1)
+
+// NewMusicServices1Clients discovers instances of the service on the network,
+// and returns clients to any that are found. errors will contain an error for
+// any devices that replied but which could not be queried, and err will be set
+// if the discovery process failed outright.
+//
+// This is a typical entry calling point into this package.
+func NewMusicServices1Clients() (clients []*MusicServices1, errors []error, err error) {
+	var genericClients []goupnp.ServiceClient
+	if genericClients, errors, err = goupnp.NewServiceClients(URN_MusicServices_1); err != nil {
+		return
+	}
+	clients = newMusicServices1ClientsFromGenericClients(genericClients)
+	return
+}
+
+// NewMusicServices1ClientsByURL discovers instances of the service at the given
+// URL, and returns clients to any that are found. An error is returned if
+// there was an error probing the service.
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered service URL.
+func NewMusicServices1ClientsByURL(loc *url.URL) ([]*MusicServices1, error) {
+	genericClients, err := goupnp.NewServiceClientsByURL(loc, URN_MusicServices_1)
+	if err != nil {
+		return nil, err
+	}
+	return newMusicServices1ClientsFromGenericClients(genericClients), nil
+}
+
+// NewMusicServices1ClientsFromRootDevice discovers instances of the service in
+// a given root device, and returns clients to any that are found. An error is
+// returned if there was not at least one instance of the service within the
+// device. The location parameter is simply assigned to the Location attribute
+// of the wrapped ServiceClient(s).
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered root device.
+func NewMusicServices1ClientsFromRootDevice(rootDevice *goupnp.RootDevice, loc *url.URL) ([]*MusicServices1, error) {
+	genericClients, err := goupnp.NewServiceClientsFromRootDevice(rootDevice, loc, URN_MusicServices_1)
+	if err != nil {
+		return nil, err
+	}
+	return newMusicServices1ClientsFromGenericClients(genericClients), nil
+}
+
+func newMusicServices1ClientsFromGenericClients(genericClients []goupnp.ServiceClient) []*MusicServices1 {
+	clients := make([]*MusicServices1, len(genericClients))
+	for i := range genericClients {
+		clients[i] = &MusicServices1{genericClients[i]}
+	}
+	return clients
+}
+
+// MusicServices1Timeouts are the default timeouts of the actions of
+// MusicServices1, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var MusicServices1Timeouts = map[string]time.Duration{}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *MusicServices1) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, MusicServices1Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_MusicServices_1, actionName, request, response)
+}
+
+// MusicServices1GetSessionIdRequest is the request of GetSessionId, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type MusicServices1GetSessionIdRequest struct {
+	ServiceId string
+	Username  string
+}
+
+// MusicServices1GetSessionIdResponse is the response of GetSessionId, with each
+// argument in its SOAP string form.
+type MusicServices1GetSessionIdResponse struct {
+	SessionId string
+}
+
+// GetSessionId performs the GetSessionId action of the service.
+//
+// Arguments:
+//   - ServiceId (in, state variable A_ARG_TYPE_ServiceId)
+//   - Username (in, state variable A_ARG_TYPE_Username)
+//
+// Return values:
+//   - SessionId (out, state variable A_ARG_TYPE_SessionId)
+func (client *MusicServices1) GetSessionId(ServiceId uint32, Username string) (SessionId string, err error) {
+	return client.GetSessionIdCtx(context.Background(), ServiceId, Username)
+}
+
+// GetSessionIdCtx is GetSessionId with a context, to cancel or time out the call.
+func (client *MusicServices1) GetSessionIdCtx(ctx context.Context, ServiceId uint32, Username string) (SessionId string, err error) {
+	// Request structure.
+	request := &MusicServices1GetSessionIdRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.ServiceId, err = soap.MarshalUi4(ServiceId); err != nil {
+		return
+	}
+	if request.Username, err = soap.MarshalString(Username); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &MusicServices1GetSessionIdResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetSessionId", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if SessionId, err = soap.UnmarshalString(response.SessionId); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// MusicServices1ListAvailableServicesResponse is the response of ListAvailableServices, with each
+// argument in its SOAP string form.
+type MusicServices1ListAvailableServicesResponse struct {
+	AvailableServiceDescriptorList string
+	AvailableServiceTypeList       string
+	AvailableServiceListVersion    string
+}
+
+// ListAvailableServices performs the ListAvailableServices action of the service.
+//
+// Return values:
+//   - AvailableServiceDescriptorList (out, state variable A_ARG_TYPE_ServiceDescriptorList)
+//   - AvailableServiceTypeList (out, state variable A_ARG_TYPE_ServiceTypeList)
+//   - AvailableServiceListVersion (out, state variable ServiceListVersion)
+func (client *MusicServices1) ListAvailableServices() (AvailableServiceDescriptorList string, AvailableServiceTypeList string, AvailableServiceListVersion string, err error) {
+	return client.ListAvailableServicesCtx(context.Background())
+}
+
+// ListAvailableServicesCtx is ListAvailableServices with a context, to cancel or time out the call.
+func (client *MusicServices1) ListAvailableServicesCtx(ctx context.Context) (AvailableServiceDescriptorList string, AvailableServiceTypeList string, AvailableServiceListVersion string, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &MusicServices1ListAvailableServicesResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "ListAvailableServices", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if AvailableServiceDescriptorList, err = soap.UnmarshalString(response.AvailableServiceDescriptorList); err != nil {
+		return
+	}
+	if AvailableServiceTypeList, err = soap.UnmarshalString(response.AvailableServiceTypeList); err != nil {
+		return
+	}
+	if AvailableServiceListVersion, err = soap.UnmarshalString(response.AvailableServiceListVersion); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// UpdateAvailableServices performs the UpdateAvailableServices action of the service.
+func (client *MusicServices1) UpdateAvailableServices() (err error) {
+	return client.UpdateAvailableServicesCtx(context.Background())
+}
+
+// UpdateAvailableServicesCtx is UpdateAvailableServices with a context, to cancel or time out the call.
+func (client *MusicServices1) UpdateAvailableServicesCtx(ctx context.Context) (err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "UpdateAvailableServices", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// Queue1 is a client for UPnP SOAP service with URN "urn:schemas-sonos-com:service:Queue:1". See
+// goupnp.ServiceClient, which contains RootDevice and Service attributes which
+// are provided for informational value.
+type Queue1 struct {
+	goupnp.ServiceClient
+}
+
+// Queue1Client is the interface of the actions of Queue1, for
+// substituting fakes or mocks for the service in tests.
+type Queue1Client interface {
+	AddURI(QueueID uint32, UpdateID uint32, EnqueuedURI string, EnqueuedURIMetaData string, DesiredFirstTrackNumberEnqueued uint32, EnqueueAsNext bool) (FirstTrackNumberEnqueued uint32, NumTracksAdded uint32, NewQueueLength uint32, NewUpdateID uint32, err error)
+	AddURICtx(ctx context.Context, QueueID uint32, UpdateID uint32, EnqueuedURI string, EnqueuedURIMetaData string, DesiredFirstTrackNumberEnqueued uint32, EnqueueAsNext bool) (FirstTrackNumberEnqueued uint32, NumTracksAdded uint32, NewQueueLength uint32, NewUpdateID uint32, err error)
+	AddMultipleURIs(QueueID uint32, UpdateID uint32, ContainerURI string, ContainerMetaData string, DesiredFirstTrackNumberEnqueued uint32, EnqueueAsNext bool, NumberOfURIs uint32, EnqueuedURIsAndMetaData string) (FirstTrackNumberEnqueued uint32, NumTracksAdded uint32, NewQueueLength uint32, NewUpdateID uint32, err error)
+	AddMultipleURIsCtx(ctx context.Context, QueueID uint32, UpdateID uint32, ContainerURI string, ContainerMetaData string, DesiredFirstTrackNumberEnqueued uint32, EnqueueAsNext bool, NumberOfURIs uint32, EnqueuedURIsAndMetaData string) (FirstTrackNumberEnqueued uint32, NumTracksAdded uint32, NewQueueLength uint32, NewUpdateID uint32, err error)
+	AttachQueue(QueueOwnerID string) (QueueID uint32, QueueOwnerContext string, err error)
+	AttachQueueCtx(ctx context.Context, QueueOwnerID string) (QueueID uint32, QueueOwnerContext string, err error)
+	Backup() (err error)
+	BackupCtx(ctx context.Context) (err error)
+	Browse(QueueID uint32, StartingIndex uint32, RequestedCount uint32) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error)
+	BrowseCtx(ctx context.Context, QueueID uint32, StartingIndex uint32, RequestedCount uint32) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error)
+	CreateQueue(QueueOwnerID string, QueueOwnerContext string, QueuePolicy string) (QueueID uint32, err error)
+	CreateQueueCtx(ctx context.Context, QueueOwnerID string, QueueOwnerContext string, QueuePolicy string) (QueueID uint32, err error)
+	RemoveAllTracks(QueueID uint32, UpdateID uint32) (NewUpdateID uint32, err error)
+	RemoveAllTracksCtx(ctx context.Context, QueueID uint32, UpdateID uint32) (NewUpdateID uint32, err error)
+	RemoveTrackRange(QueueID uint32, UpdateID uint32, StartingIndex uint32, NumberOfTracks uint32) (NewUpdateID uint32, err error)
+	RemoveTrackRangeCtx(ctx context.Context, QueueID uint32, UpdateID uint32, StartingIndex uint32, NumberOfTracks uint32) (NewUpdateID uint32, err error)
+	ReorderTracks(QueueID uint32, StartingIndex uint32, NumberOfTracks uint32, InsertBefore uint32, UpdateID uint32) (NewUpdateID uint32, err error)
+	ReorderTracksCtx(ctx context.Context, QueueID uint32, StartingIndex uint32, NumberOfTracks uint32, InsertBefore uint32, UpdateID uint32) (NewUpdateID uint32, err error)
+	ReplaceAllTracks(QueueID uint32, UpdateID uint32, ContainerURI string, ContainerMetaData string, CurrentTrackIndex uint32, NewCurrentTrackIndices string, NumberOfURIs uint32, EnqueuedURIsAndMetaData string) (NewQueueLength uint32, NewUpdateID uint32, err error)
+	ReplaceAllTracksCtx(ctx context.Context, QueueID uint32, UpdateID uint32, ContainerURI string, ContainerMetaData string, CurrentTrackIndex uint32, NewCurrentTrackIndices string, NumberOfURIs uint32, EnqueuedURIsAndMetaData string) (NewQueueLength uint32, NewUpdateID uint32, err error)
+	SaveAsSonosPlaylist(QueueID uint32, Title string, ObjectID string) (AssignedObjectID string, err error)
+	SaveAsSonosPlaylistCtx(ctx context.Context, QueueID uint32, Title string, ObjectID string) (AssignedObjectID string, err error)
+}
+
+var _ Queue1Client = new(Queue1)
+
+// NewQueue1Clients discovers instances of the service on the network,
+// and returns clients to any that are found. errors will contain an error for
+// any devices that replied but which could not be queried, and err will be set
+// if the discovery process failed outright.
+//
+// This is a typical entry calling point into this package.
+func NewQueue1Clients() (clients []*Queue1, errors []error, err error) {
+	var genericClients []goupnp.ServiceClient
+	if genericClients, errors, err = goupnp.NewServiceClients(URN_Queue_1); err != nil {
+		return
+	}
+	clients = newQueue1ClientsFromGenericClients(genericClients)
+	return
+}
+
+// NewQueue1ClientsByURL discovers instances of the service at the given
+// URL, and returns clients to any that are found. An error is returned if
+// there was an error probing the service.
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered service URL.
+func NewQueue1ClientsByURL(loc *url.URL) ([]*Queue1, error) {
+	genericClients, err := goupnp.NewServiceClientsByURL(loc, URN_Queue_1)
+	if err != nil {
+		return nil, err
+	}
+	return newQueue1ClientsFromGenericClients(genericClients), nil
+}
+
+// NewQueue1ClientsFromRootDevice discovers instances of the service in
+// a given root device, and returns clients to any that are found. An error is
+// returned if there was not at least one instance of the service within the
+// device. The location parameter is simply assigned to the Location attribute
+// of the wrapped ServiceClient(s).
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered root device.
+func NewQueue1ClientsFromRootDevice(rootDevice *goupnp.RootDevice, loc *url.URL) ([]*Queue1, error) {
+	genericClients, err := goupnp.NewServiceClientsFromRootDevice(rootDevice, loc, URN_Queue_1)
+	if err != nil {
+		return nil, err
+	}
+	return newQueue1ClientsFromGenericClients(genericClients), nil
+}
+
+func newQueue1ClientsFromGenericClients(genericClients []goupnp.ServiceClient) []*Queue1 {
+	clients := make([]*Queue1, len(genericClients))
+	for i := range genericClients {
+		clients[i] = &Queue1{genericClients[i]}
+	}
+	return clients
+}
+
+// Queue1Timeouts are the default timeouts of the actions of
+// Queue1, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var Queue1Timeouts = map[string]time.Duration{}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *Queue1) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, Queue1Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_Queue_1, actionName, request, response)
+}
+
+// Queue1AddURIRequest is the request of AddURI, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type Queue1AddURIRequest struct {
+	QueueID                         string
+	UpdateID                        string
+	EnqueuedURI                     string
+	EnqueuedURIMetaData             string
+	DesiredFirstTrackNumberEnqueued string
+	EnqueueAsNext                   string
+}
+
+// Queue1AddURIResponse is the response of AddURI, with each
+// argument in its SOAP string form.
+type Queue1AddURIResponse struct {
+	FirstTrackNumberEnqueued string
+	NumTracksAdded           string
+	NewQueueLength           string
+	NewUpdateID              string
+}
+
+// AddURI performs the AddURI action of the service.
+//
+// Arguments:
+//   - QueueID (in, state variable A_ARG_TYPE_QueueID)
+//   - UpdateID (in, state variable A_ARG_TYPE_UpdateID)
+//   - EnqueuedURI (in, state variable A_ARG_TYPE_URI)
+//   - EnqueuedURIMetaData (in, state variable A_ARG_TYPE_URIMetaData)
+//   - DesiredFirstTrackNumberEnqueued (in, state variable A_ARG_TYPE_TrackNumber)
+//   - EnqueueAsNext (in, state variable A_ARG_TYPE_EnqueueAsNext)
+//
+// Return values:
+//   - FirstTrackNumberEnqueued (out, state variable A_ARG_TYPE_TrackNumber)
+//   - NumTracksAdded (out, state variable A_ARG_TYPE_NumTracks)
+//   - NewQueueLength (out, state variable A_ARG_TYPE_NumTracks)
+//   - NewUpdateID (out, state variable A_ARG_TYPE_UpdateID)
+func (client *Queue1) AddURI(QueueID uint32, UpdateID uint32, EnqueuedURI string, EnqueuedURIMetaData string, DesiredFirstTrackNumberEnqueued uint32, EnqueueAsNext bool) (FirstTrackNumberEnqueued uint32, NumTracksAdded uint32, NewQueueLength uint32, NewUpdateID uint32, err error) {
+	return client.AddURICtx(context.Background(), QueueID, UpdateID, EnqueuedURI, EnqueuedURIMetaData, DesiredFirstTrackNumberEnqueued, EnqueueAsNext)
+}
+
+// AddURICtx is AddURI with a context, to cancel or time out the call.
+func (client *Queue1) AddURICtx(ctx context.Context, QueueID uint32, UpdateID uint32, EnqueuedURI string, EnqueuedURIMetaData string, DesiredFirstTrackNumberEnqueued uint32, EnqueueAsNext bool) (FirstTrackNumberEnqueued uint32, NumTracksAdded uint32, NewQueueLength uint32, NewUpdateID uint32, err error) {
+	// Request structure.
+	request := &Queue1AddURIRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.QueueID, err = soap.MarshalUi4(QueueID); err != nil {
+		return
+	}
+	if request.UpdateID, err = soap.MarshalUi4(UpdateID); err != nil {
+		return
+	}
+	if request.EnqueuedURI, err = soap.MarshalString(EnqueuedURI); err != nil {
+		return
+	}
+	if request.EnqueuedURIMetaData, err = soap.MarshalString(EnqueuedURIMetaData); err != nil {
+		return
+	}
+	if request.DesiredFirstTrackNumberEnqueued, err = soap.MarshalUi4(DesiredFirstTrackNumberEnqueued); err != nil {
+		return
+	}
+	if request.EnqueueAsNext, err = soap.MarshalBoolean(EnqueueAsNext); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &Queue1AddURIResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "AddURI", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if FirstTrackNumberEnqueued, err = soap.UnmarshalUi4(response.FirstTrackNumberEnqueued); err != nil {
+		return
+	}
+	if NumTracksAdded, err = soap.UnmarshalUi4(response.NumTracksAdded); err != nil {
+		return
+	}
+	if NewQueueLength, err = soap.UnmarshalUi4(response.NewQueueLength); err != nil {
+		return
+	}
+	if NewUpdateID, err = soap.UnmarshalUi4(response.NewUpdateID); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// Queue1AddMultipleURIsRequest is the request of AddMultipleURIs, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type Queue1AddMultipleURIsRequest struct {
+	QueueID                         string
+	UpdateID                        string
+	ContainerURI                    string
+	ContainerMetaData               string
+	DesiredFirstTrackNumberEnqueued string
+	EnqueueAsNext                   string
+	NumberOfURIs                    string
+	EnqueuedURIsAndMetaData         string
+}
+
+// Queue1AddMultipleURIsResponse is the response of AddMultipleURIs, with each
+// argument in its SOAP string form.
+type Queue1AddMultipleURIsResponse struct {
+	FirstTrackNumberEnqueued string
+	NumTracksAdded           string
+	NewQueueLength           string
+	NewUpdateID              string
+}
+
+// AddMultipleURIs performs the AddMultipleURIs action of the service.
+//
+// Arguments:
+//   - QueueID (in, state variable A_ARG_TYPE_QueueID)
+//   - UpdateID (in, state variable A_ARG_TYPE_UpdateID)
+//   - ContainerURI (in, state variable A_ARG_TYPE_URI)
+//   - ContainerMetaData (in, state variable A_ARG_TYPE_URIMetaData)
+//   - DesiredFirstTrackNumberEnqueued (in, state variable A_ARG_TYPE_TrackNumber)
+//   - EnqueueAsNext (in, state variable A_ARG_TYPE_EnqueueAsNext)
+//   - NumberOfURIs (in, state variable A_ARG_TYPE_NumTracks)
+//   - EnqueuedURIsAndMetaData (in, state variable A_ARG_TYPE_URIsAndMetaData)
+//
+// Return values:
+//   - FirstTrackNumberEnqueued (out, state variable A_ARG_TYPE_TrackNumber)
+//   - NumTracksAdded (out, state variable A_ARG_TYPE_NumTracks)
+//   - NewQueueLength (out, state variable A_ARG_TYPE_NumTracks)
+//   - NewUpdateID (out, state variable A_ARG_TYPE_UpdateID)
+func (client *Queue1) AddMultipleURIs(QueueID uint32, UpdateID uint32, ContainerURI string, ContainerMetaData string, DesiredFirstTrackNumberEnqueued uint32, EnqueueAsNext bool, NumberOfURIs uint32, EnqueuedURIsAndMetaData string) (FirstTrackNumberEnqueued uint32, NumTracksAdded uint32, NewQueueLength uint32, NewUpdateID uint32, err error) {
+	return client.AddMultipleURIsCtx(context.Background(), QueueID, UpdateID, ContainerURI, ContainerMetaData, DesiredFirstTrackNumberEnqueued, EnqueueAsNext, NumberOfURIs, EnqueuedURIsAndMetaData)
+}
+
+// AddMultipleURIsCtx is AddMultipleURIs with a context, to cancel or time out the call.
+func (client *Queue1) AddMultipleURIsCtx(ctx context.Context, QueueID uint32, UpdateID uint32, ContainerURI string, ContainerMetaData string, DesiredFirstTrackNumberEnqueued uint32, EnqueueAsNext bool, NumberOfURIs uint32, EnqueuedURIsAndMetaData string) (FirstTrackNumberEnqueued uint32, NumTracksAdded uint32, NewQueueLength uint32, NewUpdateID uint32, err error) {
+	// Request structure.
+	request := &Queue1AddMultipleURIsRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.QueueID, err = soap.MarshalUi4(QueueID); err != nil {
+		return
+	}
+	if request.UpdateID, err = soap.MarshalUi4(UpdateID); err != nil {
+		return
+	}
+	if request.ContainerURI, err = soap.MarshalString(ContainerURI); err != nil {
+		return
+	}
+	if request.ContainerMetaData, err = soap.MarshalString(ContainerMetaData); err != nil {
+		return
+	}
+	if request.DesiredFirstTrackNumberEnqueued, err = soap.MarshalUi4(DesiredFirstTrackNumberEnqueued); err != nil {
+		return
+	}
+	if request.EnqueueAsNext, err = soap.MarshalBoolean(EnqueueAsNext); err != nil {
+		return
+	}
+	if request.NumberOfURIs, err = soap.MarshalUi4(NumberOfURIs); err != nil {
+		return
+	}
+	if request.EnqueuedURIsAndMetaData, err = soap.MarshalString(EnqueuedURIsAndMetaData); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &Queue1AddMultipleURIsResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "AddMultipleURIs", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if FirstTrackNumberEnqueued, err = soap.UnmarshalUi4(response.FirstTrackNumberEnqueued); err != nil {
+		return
+	}
+	if NumTracksAdded, err = soap.UnmarshalUi4(response.NumTracksAdded); err != nil {
+		return
+	}
+	if NewQueueLength, err = soap.UnmarshalUi4(response.NewQueueLength); err != nil {
+		return
+	}
+	if NewUpdateID, err = soap.UnmarshalUi4(response.NewUpdateID); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// Queue1AttachQueueRequest is the request of AttachQueue, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type Queue1AttachQueueRequest struct {
+	QueueOwnerID string
+}
+
+// Queue1AttachQueueResponse is the response of AttachQueue, with each
+// argument in its SOAP string form.
+type Queue1AttachQueueResponse struct {
+	QueueID           string
+	QueueOwnerContext string
+}
+
+// AttachQueue performs the AttachQueue action of the service.
+//
+// Arguments:
+//   - QueueOwnerID (in, state variable A_ARG_TYPE_QueueOwnerID)
+//
+// Return values:
+//   - QueueID (out, state variable A_ARG_TYPE_QueueID)
+//   - QueueOwnerContext (out, state variable A_ARG_TYPE_QueueOwnerContext)
+func (client *Queue1) AttachQueue(QueueOwnerID string) (QueueID uint32, QueueOwnerContext string, err error) {
+	return client.AttachQueueCtx(context.Background(), QueueOwnerID)
+}
+
+// AttachQueueCtx is AttachQueue with a context, to cancel or time out the call.
+func (client *Queue1) AttachQueueCtx(ctx context.Context, QueueOwnerID string) (QueueID uint32, QueueOwnerContext string, err error) {
+	// Request structure.
+	request := &Queue1AttachQueueRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.QueueOwnerID, err = soap.MarshalString(QueueOwnerID); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &Queue1AttachQueueResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "AttachQueue", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if QueueID, err = soap.UnmarshalUi4(response.QueueID); err != nil {
+		return
+	}
+	if QueueOwnerContext, err = soap.UnmarshalString(response.QueueOwnerContext); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// Backup performs the Backup action of the service.
+func (client *Queue1) Backup() (err error) {
+	return client.BackupCtx(context.Background())
+}
+
+// BackupCtx is Backup with a context, to cancel or time out the call.
+func (client *Queue1) BackupCtx(ctx context.Context) (err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "Backup", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// Queue1BrowseRequest is the request of Browse, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type Queue1BrowseRequest struct {
+	QueueID        string
+	StartingIndex  string
+	RequestedCount string
+}
+
+// Queue1BrowseResponse is the response of Browse, with each
+// argument in its SOAP string form.
+type Queue1BrowseResponse struct {
+	Result         string
+	NumberReturned string
+	TotalMatches   string
+	UpdateID       string
+}
+
+// Browse performs the Browse action of the service.
+//
+// Arguments:
+//   - QueueID (in, state variable A_ARG_TYPE_QueueID)
+//   - StartingIndex (in, state variable A_ARG_TYPE_TrackNumber)
+//   - RequestedCount (in, state variable A_ARG_TYPE_NumTracks)
+//
+// Return values:
+//   - Result (out, state variable A_ARG_TYPE_Result)
+//   - NumberReturned (out, state variable A_ARG_TYPE_NumTracks)
+//   - TotalMatches (out, state variable A_ARG_TYPE_NumTracks)
+//   - UpdateID (out, state variable A_ARG_TYPE_UpdateID)
+func (client *Queue1) Browse(QueueID uint32, StartingIndex uint32, RequestedCount uint32) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error) {
+	return client.BrowseCtx(context.Background(), QueueID, StartingIndex, RequestedCount)
+}
+
+// BrowseCtx is Browse with a context, to cancel or time out the call.
+func (client *Queue1) BrowseCtx(ctx context.Context, QueueID uint32, StartingIndex uint32, RequestedCount uint32) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error) {
+	// Request structure.
+	request := &Queue1BrowseRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.QueueID, err = soap.MarshalUi4(QueueID); err != nil {
+		return
+	}
+	if request.StartingIndex, err = soap.MarshalUi4(StartingIndex); err != nil {
+		return
+	}
+	if request.RequestedCount, err = soap.MarshalUi4(RequestedCount); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &Queue1BrowseResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "Browse", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if Result, err = soap.UnmarshalString(response.Result); err != nil {
+		return
+	}
+	if NumberReturned, err = soap.UnmarshalUi4(response.NumberReturned); err != nil {
+		return
+	}
+	if TotalMatches, err = soap.UnmarshalUi4(response.TotalMatches); err != nil {
+		return
+	}
+	if UpdateID, err = soap.UnmarshalUi4(response.UpdateID); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// Queue1CreateQueueRequest is the request of CreateQueue, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type Queue1CreateQueueRequest struct {
+	QueueOwnerID      string
+	QueueOwnerContext string
+	QueuePolicy       string
+}
+
+// Queue1CreateQueueResponse is the response of CreateQueue, with each
+// argument in its SOAP string form.
+type Queue1CreateQueueResponse struct {
+	QueueID string
+}
+
+// CreateQueue performs the CreateQueue action of the service.
+//
+// Arguments:
+//   - QueueOwnerID (in, state variable A_ARG_TYPE_QueueOwnerID)
+//   - QueueOwnerContext (in, state variable A_ARG_TYPE_QueueOwnerContext)
+//   - QueuePolicy (in, state variable A_ARG_TYPE_QueuePolicy)
+//
+// Return values:
+//   - QueueID (out, state variable A_ARG_TYPE_QueueID)
+func (client *Queue1) CreateQueue(QueueOwnerID string, QueueOwnerContext string, QueuePolicy string) (QueueID uint32, err error) {
+	return client.CreateQueueCtx(context.Background(), QueueOwnerID, QueueOwnerContext, QueuePolicy)
+}
+
+// CreateQueueCtx is CreateQueue with a context, to cancel or time out the call.
+func (client *Queue1) CreateQueueCtx(ctx context.Context, QueueOwnerID string, QueueOwnerContext string, QueuePolicy string) (QueueID uint32, err error) {
+	// Request structure.
+	request := &Queue1CreateQueueRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.QueueOwnerID, err = soap.MarshalString(QueueOwnerID); err != nil {
+		return
+	}
+	if request.QueueOwnerContext, err = soap.MarshalString(QueueOwnerContext); err != nil {
+		return
+	}
+	if request.QueuePolicy, err = soap.MarshalString(QueuePolicy); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &Queue1CreateQueueResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "CreateQueue", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if QueueID, err = soap.UnmarshalUi4(response.QueueID); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// Queue1RemoveAllTracksRequest is the request of RemoveAllTracks, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type Queue1RemoveAllTracksRequest struct {
+	QueueID  string
+	UpdateID string
+}
+
+// Queue1RemoveAllTracksResponse is the response of RemoveAllTracks, with each
+// argument in its SOAP string form.
+type Queue1RemoveAllTracksResponse struct {
+	NewUpdateID string
+}
+
+// RemoveAllTracks performs the RemoveAllTracks action of the service.
+//
+// Arguments:
+//   - QueueID (in, state variable A_ARG_TYPE_QueueID)
+//   - UpdateID (in, state variable A_ARG_TYPE_UpdateID)
+//
+// Return values:
+//   - NewUpdateID (out, state variable A_ARG_TYPE_UpdateID)
+func (client *Queue1) RemoveAllTracks(QueueID uint32, UpdateID uint32) (NewUpdateID uint32, err error) {
+	return client.RemoveAllTracksCtx(context.Background(), QueueID, UpdateID)
+}
+
+// RemoveAllTracksCtx is RemoveAllTracks with a context, to cancel or time out the call.
+func (client *Queue1) RemoveAllTracksCtx(ctx context.Context, QueueID uint32, UpdateID uint32) (NewUpdateID uint32, err error) {
+	// Request structure.
+	request := &Queue1RemoveAllTracksRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.QueueID, err = soap.MarshalUi4(QueueID); err != nil {
+		return
+	}
+	if request.UpdateID, err = soap.MarshalUi4(UpdateID); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &Queue1RemoveAllTracksResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "RemoveAllTracks", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if NewUpdateID, err = soap.UnmarshalUi4(response.NewUpdateID); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// Queue1RemoveTrackRangeRequest is the request of RemoveTrackRange, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type Queue1RemoveTrackRangeRequest struct {
+	QueueID        string
+	UpdateID       string
+	StartingIndex  string
+	NumberOfTracks string
+}
+
+// Queue1RemoveTrackRangeResponse is the response of RemoveTrackRange, with each
+// argument in its SOAP string form.
+type Queue1RemoveTrackRangeResponse struct {
+	NewUpdateID string
+}
+
+// RemoveTrackRange performs the RemoveTrackRange action of the service.
+//
+// Arguments:
+//   - QueueID (in, state variable A_ARG_TYPE_QueueID)
+//   - UpdateID (in, state variable A_ARG_TYPE_UpdateID)
+//   - StartingIndex (in, state variable A_ARG_TYPE_TrackNumber)
+//   - NumberOfTracks (in, state variable A_ARG_TYPE_NumTracks)
+//
+// Return values:
+//   - NewUpdateID (out, state variable A_ARG_TYPE_UpdateID)
+func (client *Queue1) RemoveTrackRange(QueueID uint32, UpdateID uint32, StartingIndex uint32, NumberOfTracks uint32) (NewUpdateID uint32, err error) {
+	return client.RemoveTrackRangeCtx(context.Background(), QueueID, UpdateID, StartingIndex, NumberOfTracks)
+}
+
+// RemoveTrackRangeCtx is RemoveTrackRange with a context, to cancel or time out the call.
+func (client *Queue1) RemoveTrackRangeCtx(ctx context.Context, QueueID uint32, UpdateID uint32, StartingIndex uint32, NumberOfTracks uint32) (NewUpdateID uint32, err error) {
+	// Request structure.
+	request := &Queue1RemoveTrackRangeRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.QueueID, err = soap.MarshalUi4(QueueID); err != nil {
+		return
+	}
+	if request.UpdateID, err = soap.MarshalUi4(UpdateID); err != nil {
+		return
+	}
+	if request.StartingIndex, err = soap.MarshalUi4(StartingIndex); err != nil {
+		return
+	}
+	if request.NumberOfTracks, err = soap.MarshalUi4(NumberOfTracks); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &Queue1RemoveTrackRangeResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "RemoveTrackRange", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if NewUpdateID, err = soap.UnmarshalUi4(response.NewUpdateID); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// Queue1ReorderTracksRequest is the request of ReorderTracks, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type Queue1ReorderTracksRequest struct {
+	QueueID        string
+	StartingIndex  string
+	NumberOfTracks string
+	InsertBefore   string
+	UpdateID       string
+}
+
+// Queue1ReorderTracksResponse is the response of ReorderTracks, with each
+// argument in its SOAP string form.
+type Queue1ReorderTracksResponse struct {
+	NewUpdateID string
+}
+
+// ReorderTracks performs the ReorderTracks action of the service.
+//
+// Arguments:
+//   - QueueID (in, state variable A_ARG_TYPE_QueueID)
+//   - StartingIndex (in, state variable A_ARG_TYPE_TrackNumber)
+//   - NumberOfTracks (in, state variable A_ARG_TYPE_NumTracks)
+//   - InsertBefore (in, state variable A_ARG_TYPE_TrackNumber)
+//   - UpdateID (in, state variable A_ARG_TYPE_UpdateID)
+//
+// Return values:
+//   - NewUpdateID (out, state variable A_ARG_TYPE_UpdateID)
+func (client *Queue1) ReorderTracks(QueueID uint32, StartingIndex uint32, NumberOfTracks uint32, InsertBefore uint32, UpdateID uint32) (NewUpdateID uint32, err error) {
+	return client.ReorderTracksCtx(context.Background(), QueueID, StartingIndex, NumberOfTracks, InsertBefore, UpdateID)
+}
+
+// ReorderTracksCtx is ReorderTracks with a context, to cancel or time out the call.
+func (client *Queue1) ReorderTracksCtx(ctx context.Context, QueueID uint32, StartingIndex uint32, NumberOfTracks uint32, InsertBefore uint32, UpdateID uint32) (NewUpdateID uint32, err error) {
+	// Request structure.
+	request := &Queue1ReorderTracksRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.QueueID, err = soap.MarshalUi4(QueueID); err != nil {
+		return
+	}
+	if request.StartingIndex, err = soap.MarshalUi4(StartingIndex); err != nil {
+		return
+	}
+	if request.NumberOfTracks, err = soap.MarshalUi4(NumberOfTracks); err != nil {
+		return
+	}
+	if request.InsertBefore, err = soap.MarshalUi4(InsertBefore); err != nil {
+		return
+	}
+	if request.UpdateID, err = soap.MarshalUi4(UpdateID); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &Queue1ReorderTracksResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "ReorderTracks", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if NewUpdateID, err = soap.UnmarshalUi4(response.NewUpdateID); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// Queue1ReplaceAllTracksRequest is the request of ReplaceAllTracks, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type Queue1ReplaceAllTracksRequest struct {
+	QueueID                 string
+	UpdateID                string
+	ContainerURI            string
+	ContainerMetaData       string
+	CurrentTrackIndex       string
+	NewCurrentTrackIndices  string
+	NumberOfURIs            string
+	EnqueuedURIsAndMetaData string
+}
+
+// Queue1ReplaceAllTracksResponse is the response of ReplaceAllTracks, with each
+// argument in its SOAP string form.
+type Queue1ReplaceAllTracksResponse struct {
+	NewQueueLength string
+	NewUpdateID    string
+}
+
+// ReplaceAllTracks performs the ReplaceAllTracks action of the service.
+//
+// Arguments:
+//   - QueueID (in, state variable A_ARG_TYPE_QueueID)
+//   - UpdateID (in, state variable A_ARG_TYPE_UpdateID)
+//   - ContainerURI (in, state variable A_ARG_TYPE_URI)
+//   - ContainerMetaData (in, state variable A_ARG_TYPE_URIMetaData)
+//   - CurrentTrackIndex (in, state variable A_ARG_TYPE_TrackNumber)
+//   - NewCurrentTrackIndices (in, state variable A_ARG_TYPE_TrackIndices)
+//   - NumberOfURIs (in, state variable A_ARG_TYPE_NumTracks)
+//   - EnqueuedURIsAndMetaData (in, state variable A_ARG_TYPE_URIsAndMetaData)
+//
+// Return values:
+//   - NewQueueLength (out, state variable A_ARG_TYPE_NumTracks)
+//   - NewUpdateID (out, state variable A_ARG_TYPE_UpdateID)
+func (client *Queue1) ReplaceAllTracks(QueueID uint32, UpdateID uint32, ContainerURI string, ContainerMetaData string, CurrentTrackIndex uint32, NewCurrentTrackIndices string, NumberOfURIs uint32, EnqueuedURIsAndMetaData string) (NewQueueLength uint32, NewUpdateID uint32, err error) {
+	return client.ReplaceAllTracksCtx(context.Background(), QueueID, UpdateID, ContainerURI, ContainerMetaData, CurrentTrackIndex, NewCurrentTrackIndices, NumberOfURIs, EnqueuedURIsAndMetaData)
+}
+
+// ReplaceAllTracksCtx is ReplaceAllTracks with a context, to cancel or time out the call.
+func (client *Queue1) ReplaceAllTracksCtx(ctx context.Context, QueueID uint32, UpdateID uint32, ContainerURI string, ContainerMetaData string, CurrentTrackIndex uint32, NewCurrentTrackIndices string, NumberOfURIs uint32, EnqueuedURIsAndMetaData string) (NewQueueLength uint32, NewUpdateID uint32, err error) {
+	// Request structure.
+	request := &Queue1ReplaceAllTracksRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.QueueID, err = soap.MarshalUi4(QueueID); err != nil {
+		return
+	}
+	if request.UpdateID, err = soap.MarshalUi4(UpdateID); err != nil {
+		return
+	}
+	if request.ContainerURI, err = soap.MarshalString(ContainerURI); err != nil {
+		return
+	}
+	if request.ContainerMetaData, err = soap.MarshalString(ContainerMetaData); err != nil {
+		return
+	}
+	if request.CurrentTrackIndex, err = soap.MarshalUi4(CurrentTrackIndex); err != nil {
+		return
+	}
+	if request.NewCurrentTrackIndices, err = soap.MarshalString(NewCurrentTrackIndices); err != nil {
+		return
+	}
+	if request.NumberOfURIs, err = soap.MarshalUi4(NumberOfURIs); err != nil {
+		return
+	}
+	if request.EnqueuedURIsAndMetaData, err = soap.MarshalString(EnqueuedURIsAndMetaData); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &Queue1ReplaceAllTracksResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "ReplaceAllTracks", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if NewQueueLength, err = soap.UnmarshalUi4(response.NewQueueLength); err != nil {
+		return
+	}
+	if NewUpdateID, err = soap.UnmarshalUi4(response.NewUpdateID); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// Queue1SaveAsSonosPlaylistRequest is the request of SaveAsSonosPlaylist, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type Queue1SaveAsSonosPlaylistRequest struct {
+	QueueID  string
+	Title    string
+	ObjectID string
+}
+
+// Queue1SaveAsSonosPlaylistResponse is the response of SaveAsSonosPlaylist, with each
+// argument in its SOAP string form.
+type Queue1SaveAsSonosPlaylistResponse struct {
+	AssignedObjectID string
+}
+
+// SaveAsSonosPlaylist performs the SaveAsSonosPlaylist action of the service.
+//
+// Arguments:
+//   - QueueID (in, state variable A_ARG_TYPE_QueueID)
+//   - Title (in, state variable A_ARG_TYPE_Title)
+//   - ObjectID (in, state variable A_ARG_TYPE_ObjectID)
+//
+// Return values:
+//   - AssignedObjectID (out, state variable A_ARG_TYPE_ObjectID)
+func (client *Queue1) SaveAsSonosPlaylist(QueueID uint32, Title string, ObjectID string) (AssignedObjectID string, err error) {
+	return client.SaveAsSonosPlaylistCtx(context.Background(), QueueID, Title, ObjectID)
+}
+
+// SaveAsSonosPlaylistCtx is SaveAsSonosPlaylist with a context, to cancel or time out the call.
+func (client *Queue1) SaveAsSonosPlaylistCtx(ctx context.Context, QueueID uint32, Title string, ObjectID string) (AssignedObjectID string, err error) {
+	// Request structure.
+	request := &Queue1SaveAsSonosPlaylistRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.QueueID, err = soap.MarshalUi4(QueueID); err != nil {
+		return
+	}
+	if request.Title, err = soap.MarshalString(Title); err != nil {
+		return
+	}
+	if request.ObjectID, err = soap.MarshalString(ObjectID); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &Queue1SaveAsSonosPlaylistResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SaveAsSonosPlaylist", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if AssignedObjectID, err = soap.UnmarshalString(response.AssignedObjectID); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// ZoneGroupTopology1 is a client for UPnP SOAP service with URN "urn:schemas-upnp-org:service:ZoneGroupTopology:1". See
+// goupnp.ServiceClient, which contains RootDevice and Service attributes which
+// are provided for informational value.
+type ZoneGroupTopology1 struct {
+	goupnp.ServiceClient
+}
+
+// ZoneGroupTopology1Client is the interface of the actions of ZoneGroupTopology1, for
+// substituting fakes or mocks for the service in tests.
+type ZoneGroupTopology1Client interface {
+	CheckForUpdate(UpdateType ZoneGroupTopology1UpdateType, CachedOnly bool, Version string) (UpdateItem string, err error)
+	CheckForUpdateCtx(ctx context.Context, UpdateType ZoneGroupTopology1UpdateType, CachedOnly bool, Version string) (UpdateItem string, err error)
+	BeginSoftwareUpdate(UpdateURL string, Flags uint32, ExtraOptions string) (err error)
+	BeginSoftwareUpdateCtx(ctx context.Context, UpdateURL string, Flags uint32, ExtraOptions string) (err error)
+	ReportUnresponsiveDevice(DeviceUUID string, DesiredAction ZoneGroupTopology1UnresponsiveDeviceActionType) (err error)
+	ReportUnresponsiveDeviceCtx(ctx context.Context, DeviceUUID string, DesiredAction ZoneGroupTopology1UnresponsiveDeviceActionType) (err error)
+	ReportAlarmStartedRunning() (err error)
+	ReportAlarmStartedRunningCtx(ctx context.Context) (err error)
+	SubmitDiagnostics(IncludeControllers bool, Type string) (DiagnosticID uint32, err error)
+	SubmitDiagnosticsCtx(ctx context.Context, IncludeControllers bool, Type string) (DiagnosticID uint32, err error)
+	RegisterMobileDevice(MobileDeviceName string, MobileDeviceUDN string, MobileIPAndPort string) (err error)
+	RegisterMobileDeviceCtx(ctx context.Context, MobileDeviceName string, MobileDeviceUDN string, MobileIPAndPort string) (err error)
+	GetZoneGroupAttributes() (CurrentZoneGroupName string, CurrentZoneGroupID string, CurrentZonePlayerUUIDsInGroup string, CurrentMuseHouseholdId string, err error)
+	GetZoneGroupAttributesCtx(ctx context.Context) (CurrentZoneGroupName string, CurrentZoneGroupID string, CurrentZonePlayerUUIDsInGroup string, CurrentMuseHouseholdId string, err error)
+	GetZoneGroupState() (ZoneGroupState string, err error)
+	GetZoneGroupStateCtx(ctx context.Context) (ZoneGroupState string, err error)
+}
+
+var _ ZoneGroupTopology1Client = new(ZoneGroupTopology1)
+
+// ZoneGroupTopology1UpdateType is a value of the state variable A_ARG_TYPE_UpdateType of
+// ZoneGroupTopology1.
+type ZoneGroupTopology1UpdateType string
+
+// Allowed values of ZoneGroupTopology1UpdateType.
+const (
+	ZoneGroupTopology1UpdateType_All      ZoneGroupTopology1UpdateType = "All"
+	ZoneGroupTopology1UpdateType_Software ZoneGroupTopology1UpdateType = "Software"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v ZoneGroupTopology1UpdateType) Valid() bool {
+	switch v {
+	case ZoneGroupTopology1UpdateType_All,
+		ZoneGroupTopology1UpdateType_Software:
+		return true
+	}
+	return false
+}
+
+// ZoneGroupTopology1UnresponsiveDeviceActionType is a value of the state variable A_ARG_TYPE_UnresponsiveDeviceActionType of
+// ZoneGroupTopology1.
+type ZoneGroupTopology1UnresponsiveDeviceActionType string
+
+// Allowed values of ZoneGroupTopology1UnresponsiveDeviceActionType.
+const (
+	ZoneGroupTopology1UnresponsiveDeviceActionType_Remove                     ZoneGroupTopology1UnresponsiveDeviceActionType = "Remove"
+	ZoneGroupTopology1UnresponsiveDeviceActionType_TopologyMonitorProbe       ZoneGroupTopology1UnresponsiveDeviceActionType = "TopologyMonitorProbe"
+	ZoneGroupTopology1UnresponsiveDeviceActionType_VerifyThenRemoveSystemwide ZoneGroupTopology1UnresponsiveDeviceActionType = "VerifyThenRemoveSystemwide"
+)
+
+// Valid returns whether v is one of the allowed values.
+func (v ZoneGroupTopology1UnresponsiveDeviceActionType) Valid() bool {
+	switch v {
+	case ZoneGroupTopology1UnresponsiveDeviceActionType_Remove,
+		ZoneGroupTopology1UnresponsiveDeviceActionType_TopologyMonitorProbe,
+		ZoneGroupTopology1UnresponsiveDeviceActionType_VerifyThenRemoveSystemwide:
+		return true
+	}
+	return false
+}
+
+// NewZoneGroupTopology1Clients discovers instances of the service on the network,
+// and returns clients to any that are found. errors will contain an error for
+// any devices that replied but which could not be queried, and err will be set
+// if the discovery process failed outright.
+//
+// This is a typical entry calling point into this package.
+func NewZoneGroupTopology1Clients() (clients []*ZoneGroupTopology1, errors []error, err error) {
+	var genericClients []goupnp.ServiceClient
+	if genericClients, errors, err = goupnp.NewServiceClients(URN_ZoneGroupTopology_1); err != nil {
+		return
+	}
+	clients = newZoneGroupTopology1ClientsFromGenericClients(genericClients)
+	return
+}
+
+// NewZoneGroupTopology1ClientsByURL discovers instances of the service at the given
+// URL, and returns clients to any that are found. An error is returned if
+// there was an error probing the service.
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered service URL.
+func NewZoneGroupTopology1ClientsByURL(loc *url.URL) ([]*ZoneGroupTopology1, error) {
+	genericClients, err := goupnp.NewServiceClientsByURL(loc, URN_ZoneGroupTopology_1)
+	if err != nil {
+		return nil, err
+	}
+	return newZoneGroupTopology1ClientsFromGenericClients(genericClients), nil
+}
+
+// NewZoneGroupTopology1ClientsFromRootDevice discovers instances of the service in
+// a given root device, and returns clients to any that are found. An error is
+// returned if there was not at least one instance of the service within the
+// device. The location parameter is simply assigned to the Location attribute
+// of the wrapped ServiceClient(s).
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered root device.
+func NewZoneGroupTopology1ClientsFromRootDevice(rootDevice *goupnp.RootDevice, loc *url.URL) ([]*ZoneGroupTopology1, error) {
+	genericClients, err := goupnp.NewServiceClientsFromRootDevice(rootDevice, loc, URN_ZoneGroupTopology_1)
+	if err != nil {
+		return nil, err
+	}
+	return newZoneGroupTopology1ClientsFromGenericClients(genericClients), nil
+}
+
+func newZoneGroupTopology1ClientsFromGenericClients(genericClients []goupnp.ServiceClient) []*ZoneGroupTopology1 {
+	clients := make([]*ZoneGroupTopology1, len(genericClients))
+	for i := range genericClients {
+		clients[i] = &ZoneGroupTopology1{genericClients[i]}
+	}
+	return clients
+}
+
+// ZoneGroupTopology1Timeouts are the default timeouts of the actions of
+// ZoneGroupTopology1, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var ZoneGroupTopology1Timeouts = map[string]time.Duration{}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *ZoneGroupTopology1) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, ZoneGroupTopology1Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_ZoneGroupTopology_1, actionName, request, response)
+}
+
+// ZoneGroupTopology1CheckForUpdateRequest is the request of CheckForUpdate, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ZoneGroupTopology1CheckForUpdateRequest struct {
+	UpdateType string
+	CachedOnly string
+	Version    string
+}
+
+// ZoneGroupTopology1CheckForUpdateResponse is the response of CheckForUpdate, with each
+// argument in its SOAP string form.
+type ZoneGroupTopology1CheckForUpdateResponse struct {
+	UpdateItem string
+}
+
+// CheckForUpdate performs the CheckForUpdate action of the service.
+//
+// Arguments:
+//   - UpdateType (in, state variable A_ARG_TYPE_UpdateType): allowed values All, Software
+//   - CachedOnly (in, state variable A_ARG_TYPE_CachedOnly)
+//   - Version (in, state variable A_ARG_TYPE_Version)
+//
+// Return values:
+//   - UpdateItem (out, state variable A_ARG_TYPE_UpdateItem)
+func (client *ZoneGroupTopology1) CheckForUpdate(UpdateType ZoneGroupTopology1UpdateType, CachedOnly bool, Version string) (UpdateItem string, err error) {
+	return client.CheckForUpdateCtx(context.Background(), UpdateType, CachedOnly, Version)
+}
+
+// CheckForUpdateCtx is CheckForUpdate with a context, to cancel or time out the call.
+func (client *ZoneGroupTopology1) CheckForUpdateCtx(ctx context.Context, UpdateType ZoneGroupTopology1UpdateType, CachedOnly bool, Version string) (UpdateItem string, err error) {
+	// Request structure.
+	request := &ZoneGroupTopology1CheckForUpdateRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.UpdateType, err = soap.MarshalString(string(UpdateType)); err != nil {
+		return
+	}
+	if request.CachedOnly, err = soap.MarshalBoolean(CachedOnly); err != nil {
+		return
+	}
+	if request.Version, err = soap.MarshalString(Version); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &ZoneGroupTopology1CheckForUpdateResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "CheckForUpdate", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if UpdateItem, err = soap.UnmarshalString(response.UpdateItem); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// ZoneGroupTopology1BeginSoftwareUpdateRequest is the request of BeginSoftwareUpdate, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ZoneGroupTopology1BeginSoftwareUpdateRequest struct {
+	UpdateURL    string
+	Flags        string
+	ExtraOptions string
+}
+
+// BeginSoftwareUpdate performs the BeginSoftwareUpdate action of the service.
+//
+// Arguments:
+//   - UpdateURL (in, state variable A_ARG_TYPE_UpdateURL)
+//   - Flags (in, state variable A_ARG_TYPE_UpdateFlags)
+//   - ExtraOptions (in, state variable A_ARG_TYPE_UpdateExtraOptions)
+func (client *ZoneGroupTopology1) BeginSoftwareUpdate(UpdateURL string, Flags uint32, ExtraOptions string) (err error) {
+	return client.BeginSoftwareUpdateCtx(context.Background(), UpdateURL, Flags, ExtraOptions)
+}
+
+// BeginSoftwareUpdateCtx is BeginSoftwareUpdate with a context, to cancel or time out the call.
+func (client *ZoneGroupTopology1) BeginSoftwareUpdateCtx(ctx context.Context, UpdateURL string, Flags uint32, ExtraOptions string) (err error) {
+	// Request structure.
+	request := &ZoneGroupTopology1BeginSoftwareUpdateRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.UpdateURL, err = soap.MarshalString(UpdateURL); err != nil {
+		return
+	}
+	if request.Flags, err = soap.MarshalUi4(Flags); err != nil {
+		return
+	}
+	if request.ExtraOptions, err = soap.MarshalString(ExtraOptions); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "BeginSoftwareUpdate", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// ZoneGroupTopology1ReportUnresponsiveDeviceRequest is the request of ReportUnresponsiveDevice, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ZoneGroupTopology1ReportUnresponsiveDeviceRequest struct {
+	DeviceUUID    string
+	DesiredAction string
+}
+
+// ReportUnresponsiveDevice performs the ReportUnresponsiveDevice action of the service.
+//
+// Arguments:
+//   - DeviceUUID (in, state variable A_ARG_TYPE_MemberID)
+//   - DesiredAction (in, state variable A_ARG_TYPE_UnresponsiveDeviceActionType): allowed values Remove, TopologyMonitorProbe, VerifyThenRemoveSystemwide
+func (client *ZoneGroupTopology1) ReportUnresponsiveDevice(DeviceUUID string, DesiredAction ZoneGroupTopology1UnresponsiveDeviceActionType) (err error) {
+	return client.ReportUnresponsiveDeviceCtx(context.Background(), DeviceUUID, DesiredAction)
+}
+
+// ReportUnresponsiveDeviceCtx is ReportUnresponsiveDevice with a context, to cancel or time out the call.
+func (client *ZoneGroupTopology1) ReportUnresponsiveDeviceCtx(ctx context.Context, DeviceUUID string, DesiredAction ZoneGroupTopology1UnresponsiveDeviceActionType) (err error) {
+	// Request structure.
+	request := &ZoneGroupTopology1ReportUnresponsiveDeviceRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.DeviceUUID, err = soap.MarshalString(DeviceUUID); err != nil {
+		return
+	}
+	if request.DesiredAction, err = soap.MarshalString(string(DesiredAction)); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "ReportUnresponsiveDevice", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// ReportAlarmStartedRunning performs the ReportAlarmStartedRunning action of the service.
+func (client *ZoneGroupTopology1) ReportAlarmStartedRunning() (err error) {
+	return client.ReportAlarmStartedRunningCtx(context.Background())
+}
+
+// ReportAlarmStartedRunningCtx is ReportAlarmStartedRunning with a context, to cancel or time out the call.
+func (client *ZoneGroupTopology1) ReportAlarmStartedRunningCtx(ctx context.Context) (err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "ReportAlarmStartedRunning", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// ZoneGroupTopology1SubmitDiagnosticsRequest is the request of SubmitDiagnostics, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ZoneGroupTopology1SubmitDiagnosticsRequest struct {
+	IncludeControllers string
+	Type               string
+}
+
+// ZoneGroupTopology1SubmitDiagnosticsResponse is the response of SubmitDiagnostics, with each
+// argument in its SOAP string form.
+type ZoneGroupTopology1SubmitDiagnosticsResponse struct {
+	DiagnosticID string
+}
+
+// SubmitDiagnostics performs the SubmitDiagnostics action of the service.
+//
+// Arguments:
+//   - IncludeControllers (in, state variable A_ARG_TYPE_IncludeControllers)
+//   - Type (in, state variable A_ARG_TYPE_Type)
+//
+// Return values:
+//   - DiagnosticID (out, state variable A_ARG_TYPE_DiagnosticID)
+func (client *ZoneGroupTopology1) SubmitDiagnostics(IncludeControllers bool, Type string) (DiagnosticID uint32, err error) {
+	return client.SubmitDiagnosticsCtx(context.Background(), IncludeControllers, Type)
+}
+
+// SubmitDiagnosticsCtx is SubmitDiagnostics with a context, to cancel or time out the call.
+func (client *ZoneGroupTopology1) SubmitDiagnosticsCtx(ctx context.Context, IncludeControllers bool, Type string) (DiagnosticID uint32, err error) {
+	// Request structure.
+	request := &ZoneGroupTopology1SubmitDiagnosticsRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.IncludeControllers, err = soap.MarshalBoolean(IncludeControllers); err != nil {
+		return
+	}
+	if request.Type, err = soap.MarshalString(Type); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &ZoneGroupTopology1SubmitDiagnosticsResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SubmitDiagnostics", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if DiagnosticID, err = soap.UnmarshalUi4(response.DiagnosticID); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// ZoneGroupTopology1RegisterMobileDeviceRequest is the request of RegisterMobileDevice, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type ZoneGroupTopology1RegisterMobileDeviceRequest struct {
+	MobileDeviceName string
+	MobileDeviceUDN  string
+	MobileIPAndPort  string
+}
+
+// RegisterMobileDevice performs the RegisterMobileDevice action of the service.
+//
+// Arguments:
+//   - MobileDeviceName (in, state variable A_ARG_TYPE_MobileDeviceName)
+//   - MobileDeviceUDN (in, state variable A_ARG_TYPE_MobileDeviceUDN)
+//   - MobileIPAndPort (in, state variable A_ARG_TYPE_MobileIPAndPort)
+func (client *ZoneGroupTopology1) RegisterMobileDevice(MobileDeviceName string, MobileDeviceUDN string, MobileIPAndPort string) (err error) {
+	return client.RegisterMobileDeviceCtx(context.Background(), MobileDeviceName, MobileDeviceUDN, MobileIPAndPort)
+}
+
+// RegisterMobileDeviceCtx is RegisterMobileDevice with a context, to cancel or time out the call.
+func (client *ZoneGroupTopology1) RegisterMobileDeviceCtx(ctx context.Context, MobileDeviceName string, MobileDeviceUDN string, MobileIPAndPort string) (err error) {
+	// Request structure.
+	request := &ZoneGroupTopology1RegisterMobileDeviceRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.MobileDeviceName, err = soap.MarshalString(MobileDeviceName); err != nil {
+		return
+	}
+	if request.MobileDeviceUDN, err = soap.MarshalString(MobileDeviceUDN); err != nil {
+		return
+	}
+	if request.MobileIPAndPort, err = soap.MarshalString(MobileIPAndPort); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "RegisterMobileDevice", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// ZoneGroupTopology1GetZoneGroupAttributesResponse is the response of GetZoneGroupAttributes, with each
+// argument in its SOAP string form.
+type ZoneGroupTopology1GetZoneGroupAttributesResponse struct {
+	CurrentZoneGroupName          string
+	CurrentZoneGroupID            string
+	CurrentZonePlayerUUIDsInGroup string
+	CurrentMuseHouseholdId        string
+}
+
+// GetZoneGroupAttributes performs the GetZoneGroupAttributes action of the service.
+//
+// Return values:
+//   - CurrentZoneGroupName (out, state variable ZoneGroupName)
+//   - CurrentZoneGroupID (out, state variable ZoneGroupID)
+//   - CurrentZonePlayerUUIDsInGroup (out, state variable ZonePlayerUUIDsInGroup)
+//   - CurrentMuseHouseholdId (out, state variable MuseHouseholdId)
+func (client *ZoneGroupTopology1) GetZoneGroupAttributes() (CurrentZoneGroupName string, CurrentZoneGroupID string, CurrentZonePlayerUUIDsInGroup string, CurrentMuseHouseholdId string, err error) {
+	return client.GetZoneGroupAttributesCtx(context.Background())
+}
+
+// GetZoneGroupAttributesCtx is GetZoneGroupAttributes with a context, to cancel or time out the call.
+func (client *ZoneGroupTopology1) GetZoneGroupAttributesCtx(ctx context.Context) (CurrentZoneGroupName string, CurrentZoneGroupID string, CurrentZonePlayerUUIDsInGroup string, CurrentMuseHouseholdId string, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &ZoneGroupTopology1GetZoneGroupAttributesResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetZoneGroupAttributes", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if CurrentZoneGroupName, err = soap.UnmarshalString(response.CurrentZoneGroupName); err != nil {
+		return
+	}
+	if CurrentZoneGroupID, err = soap.UnmarshalString(response.CurrentZoneGroupID); err != nil {
+		return
+	}
+	if CurrentZonePlayerUUIDsInGroup, err = soap.UnmarshalString(response.CurrentZonePlayerUUIDsInGroup); err != nil {
+		return
+	}
+	if CurrentMuseHouseholdId, err = soap.UnmarshalString(response.CurrentMuseHouseholdId); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// ZoneGroupTopology1GetZoneGroupStateResponse is the response of GetZoneGroupState, with each
+// argument in its SOAP string form.
+type ZoneGroupTopology1GetZoneGroupStateResponse struct {
+	ZoneGroupState string
+}
+
+// GetZoneGroupState performs the GetZoneGroupState action of the service.
+//
+// Return values:
+//   - ZoneGroupState (out, state variable ZoneGroupState)
+func (client *ZoneGroupTopology1) GetZoneGroupState() (ZoneGroupState string, err error) {
+	return client.GetZoneGroupStateCtx(context.Background())
+}
+
+// GetZoneGroupStateCtx is GetZoneGroupState with a context, to cancel or time out the call.
+func (client *ZoneGroupTopology1) GetZoneGroupStateCtx(ctx context.Context) (ZoneGroupState string, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &ZoneGroupTopology1GetZoneGroupStateResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetZoneGroupState", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if ZoneGroupState, err = soap.UnmarshalString(response.ZoneGroupState); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
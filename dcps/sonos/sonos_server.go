@@ -0,0 +1,1855 @@
+package sonos
+
+// Generated file - do not edit by hand. See README.md
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/huin/goupnp/device"
+	"github.com/huin/goupnp/soap"
+)
+
+// Hack to avoid Go complaining if url or time aren't used.
+var _ *url.URL
+var _ time.Time
+
+// AlarmClock1Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:schemas-upnp-org:service:AlarmClock:1". See RegisterAlarmClock1Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type AlarmClock1Handler interface {
+	SetFormat(ctx context.Context, DesiredTimeFormat string, DesiredDateFormat string) (err error)
+
+	GetFormat(ctx context.Context) (CurrentTimeFormat string, CurrentDateFormat string, err error)
+
+	SetTimeZone(ctx context.Context, Index int32, AutoAdjustDst bool) (err error)
+
+	GetTimeZone(ctx context.Context) (Index int32, AutoAdjustDst bool, err error)
+
+	GetTimeZoneAndRule(ctx context.Context) (Index int32, AutoAdjustDst bool, CurrentTimeZone string, err error)
+
+	GetTimeZoneRule(ctx context.Context, Index int32) (TimeZone string, err error)
+
+	SetTimeServer(ctx context.Context, DesiredTimeServer string) (err error)
+
+	GetTimeServer(ctx context.Context) (CurrentTimeServer string, err error)
+
+	SetTimeNow(ctx context.Context, DesiredTime string, TimeZoneForDesiredTime string) (err error)
+
+	GetHouseholdTimeAtStamp(ctx context.Context, TimeStamp string) (HouseholdUTCTime string, err error)
+
+	GetTimeNow(ctx context.Context) (CurrentUTCTime string, CurrentLocalTime string, CurrentTimeZone string, CurrentTimeGeneration uint32, err error)
+
+	CreateAlarm(ctx context.Context, StartLocalTime string, Duration string, Recurrence AlarmClock1Recurrence, Enabled bool, RoomUUID string, ProgramURI string, ProgramMetaData string, PlayMode AlarmClock1AlarmPlayMode, Volume uint16, IncludeLinkedZones bool) (AssignedID uint32, err error)
+
+	UpdateAlarm(ctx context.Context, ID uint32, StartLocalTime string, Duration string, Recurrence AlarmClock1Recurrence, Enabled bool, RoomUUID string, ProgramURI string, ProgramMetaData string, PlayMode AlarmClock1AlarmPlayMode, Volume uint16, IncludeLinkedZones bool) (err error)
+
+	DestroyAlarm(ctx context.Context, ID uint32) (err error)
+
+	ListAlarms(ctx context.Context) (CurrentAlarmList string, CurrentAlarmListVersion string, err error)
+
+	SetDailyIndexRefreshTime(ctx context.Context, DesiredDailyIndexRefreshTime string) (err error)
+
+	GetDailyIndexRefreshTime(ctx context.Context) (CurrentDailyIndexRefreshTime string, err error)
+}
+
+// RegisterAlarmClock1Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_AlarmClock_1.
+func RegisterAlarmClock1Handler(svc *device.Service, handler AlarmClock1Handler) {
+	svc.HandleFunc("SetFormat", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAlarmClock1SetFormat(ctx, handler, in)
+	})
+	svc.HandleFunc("GetFormat", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAlarmClock1GetFormat(ctx, handler, in)
+	})
+	svc.HandleFunc("SetTimeZone", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAlarmClock1SetTimeZone(ctx, handler, in)
+	})
+	svc.HandleFunc("GetTimeZone", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAlarmClock1GetTimeZone(ctx, handler, in)
+	})
+	svc.HandleFunc("GetTimeZoneAndRule", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAlarmClock1GetTimeZoneAndRule(ctx, handler, in)
+	})
+	svc.HandleFunc("GetTimeZoneRule", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAlarmClock1GetTimeZoneRule(ctx, handler, in)
+	})
+	svc.HandleFunc("SetTimeServer", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAlarmClock1SetTimeServer(ctx, handler, in)
+	})
+	svc.HandleFunc("GetTimeServer", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAlarmClock1GetTimeServer(ctx, handler, in)
+	})
+	svc.HandleFunc("SetTimeNow", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAlarmClock1SetTimeNow(ctx, handler, in)
+	})
+	svc.HandleFunc("GetHouseholdTimeAtStamp", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAlarmClock1GetHouseholdTimeAtStamp(ctx, handler, in)
+	})
+	svc.HandleFunc("GetTimeNow", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAlarmClock1GetTimeNow(ctx, handler, in)
+	})
+	svc.HandleFunc("CreateAlarm", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAlarmClock1CreateAlarm(ctx, handler, in)
+	})
+	svc.HandleFunc("UpdateAlarm", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAlarmClock1UpdateAlarm(ctx, handler, in)
+	})
+	svc.HandleFunc("DestroyAlarm", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAlarmClock1DestroyAlarm(ctx, handler, in)
+	})
+	svc.HandleFunc("ListAlarms", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAlarmClock1ListAlarms(ctx, handler, in)
+	})
+	svc.HandleFunc("SetDailyIndexRefreshTime", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAlarmClock1SetDailyIndexRefreshTime(ctx, handler, in)
+	})
+	svc.HandleFunc("GetDailyIndexRefreshTime", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveAlarmClock1GetDailyIndexRefreshTime(ctx, handler, in)
+	})
+}
+
+func serveAlarmClock1SetFormat(ctx context.Context, handler AlarmClock1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var DesiredTimeFormat string
+	if value, err = soap.FindArg(in, "DesiredTimeFormat"); err != nil {
+		return
+	}
+	if DesiredTimeFormat, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredTimeFormat: "+err.Error())
+	}
+	var DesiredDateFormat string
+	if value, err = soap.FindArg(in, "DesiredDateFormat"); err != nil {
+		return
+	}
+	if DesiredDateFormat, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredDateFormat: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetFormat(ctx, DesiredTimeFormat, DesiredDateFormat); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAlarmClock1GetFormat(ctx context.Context, handler AlarmClock1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentTimeFormat string
+	var CurrentDateFormat string
+	if CurrentTimeFormat, CurrentDateFormat, err = handler.GetFormat(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "CurrentTimeFormat"
+	if out[0].Value, err = soap.MarshalString(CurrentTimeFormat); err != nil {
+		return
+	}
+	out[1].Name = "CurrentDateFormat"
+	if out[1].Value, err = soap.MarshalString(CurrentDateFormat); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAlarmClock1SetTimeZone(ctx context.Context, handler AlarmClock1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var Index int32
+	if value, err = soap.FindArg(in, "Index"); err != nil {
+		return
+	}
+	if Index, err = soap.UnmarshalI4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Index: "+err.Error())
+	}
+	var AutoAdjustDst bool
+	if value, err = soap.FindArg(in, "AutoAdjustDst"); err != nil {
+		return
+	}
+	if AutoAdjustDst, err = soap.UnmarshalBoolean(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument AutoAdjustDst: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetTimeZone(ctx, Index, AutoAdjustDst); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAlarmClock1GetTimeZone(ctx context.Context, handler AlarmClock1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var Index int32
+	var AutoAdjustDst bool
+	if Index, AutoAdjustDst, err = handler.GetTimeZone(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "Index"
+	if out[0].Value, err = soap.MarshalI4(Index); err != nil {
+		return
+	}
+	out[1].Name = "AutoAdjustDst"
+	if out[1].Value, err = soap.MarshalBoolean(AutoAdjustDst); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAlarmClock1GetTimeZoneAndRule(ctx context.Context, handler AlarmClock1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var Index int32
+	var AutoAdjustDst bool
+	var CurrentTimeZone string
+	if Index, AutoAdjustDst, CurrentTimeZone, err = handler.GetTimeZoneAndRule(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 3)
+
+	out[0].Name = "Index"
+	if out[0].Value, err = soap.MarshalI4(Index); err != nil {
+		return
+	}
+	out[1].Name = "AutoAdjustDst"
+	if out[1].Value, err = soap.MarshalBoolean(AutoAdjustDst); err != nil {
+		return
+	}
+	out[2].Name = "CurrentTimeZone"
+	if out[2].Value, err = soap.MarshalString(CurrentTimeZone); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAlarmClock1GetTimeZoneRule(ctx context.Context, handler AlarmClock1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var Index int32
+	if value, err = soap.FindArg(in, "Index"); err != nil {
+		return
+	}
+	if Index, err = soap.UnmarshalI4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Index: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var TimeZone string
+	if TimeZone, err = handler.GetTimeZoneRule(ctx, Index); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "TimeZone"
+	if out[0].Value, err = soap.MarshalString(TimeZone); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAlarmClock1SetTimeServer(ctx context.Context, handler AlarmClock1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var DesiredTimeServer string
+	if value, err = soap.FindArg(in, "DesiredTimeServer"); err != nil {
+		return
+	}
+	if DesiredTimeServer, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredTimeServer: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetTimeServer(ctx, DesiredTimeServer); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAlarmClock1GetTimeServer(ctx context.Context, handler AlarmClock1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentTimeServer string
+	if CurrentTimeServer, err = handler.GetTimeServer(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentTimeServer"
+	if out[0].Value, err = soap.MarshalString(CurrentTimeServer); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAlarmClock1SetTimeNow(ctx context.Context, handler AlarmClock1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var DesiredTime string
+	if value, err = soap.FindArg(in, "DesiredTime"); err != nil {
+		return
+	}
+	if DesiredTime, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredTime: "+err.Error())
+	}
+	var TimeZoneForDesiredTime string
+	if value, err = soap.FindArg(in, "TimeZoneForDesiredTime"); err != nil {
+		return
+	}
+	if TimeZoneForDesiredTime, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument TimeZoneForDesiredTime: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetTimeNow(ctx, DesiredTime, TimeZoneForDesiredTime); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAlarmClock1GetHouseholdTimeAtStamp(ctx context.Context, handler AlarmClock1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var TimeStamp string
+	if value, err = soap.FindArg(in, "TimeStamp"); err != nil {
+		return
+	}
+	if TimeStamp, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument TimeStamp: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var HouseholdUTCTime string
+	if HouseholdUTCTime, err = handler.GetHouseholdTimeAtStamp(ctx, TimeStamp); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "HouseholdUTCTime"
+	if out[0].Value, err = soap.MarshalString(HouseholdUTCTime); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAlarmClock1GetTimeNow(ctx context.Context, handler AlarmClock1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentUTCTime string
+	var CurrentLocalTime string
+	var CurrentTimeZone string
+	var CurrentTimeGeneration uint32
+	if CurrentUTCTime, CurrentLocalTime, CurrentTimeZone, CurrentTimeGeneration, err = handler.GetTimeNow(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 4)
+
+	out[0].Name = "CurrentUTCTime"
+	if out[0].Value, err = soap.MarshalString(CurrentUTCTime); err != nil {
+		return
+	}
+	out[1].Name = "CurrentLocalTime"
+	if out[1].Value, err = soap.MarshalString(CurrentLocalTime); err != nil {
+		return
+	}
+	out[2].Name = "CurrentTimeZone"
+	if out[2].Value, err = soap.MarshalString(CurrentTimeZone); err != nil {
+		return
+	}
+	out[3].Name = "CurrentTimeGeneration"
+	if out[3].Value, err = soap.MarshalUi4(CurrentTimeGeneration); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAlarmClock1CreateAlarm(ctx context.Context, handler AlarmClock1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var StartLocalTime string
+	if value, err = soap.FindArg(in, "StartLocalTime"); err != nil {
+		return
+	}
+	if StartLocalTime, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument StartLocalTime: "+err.Error())
+	}
+	var Duration string
+	if value, err = soap.FindArg(in, "Duration"); err != nil {
+		return
+	}
+	if Duration, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Duration: "+err.Error())
+	}
+	var Recurrence AlarmClock1Recurrence
+	if value, err = soap.FindArg(in, "Recurrence"); err != nil {
+		return
+	}
+	Recurrence = AlarmClock1Recurrence(value)
+	var Enabled bool
+	if value, err = soap.FindArg(in, "Enabled"); err != nil {
+		return
+	}
+	if Enabled, err = soap.UnmarshalBoolean(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Enabled: "+err.Error())
+	}
+	var RoomUUID string
+	if value, err = soap.FindArg(in, "RoomUUID"); err != nil {
+		return
+	}
+	if RoomUUID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RoomUUID: "+err.Error())
+	}
+	var ProgramURI string
+	if value, err = soap.FindArg(in, "ProgramURI"); err != nil {
+		return
+	}
+	if ProgramURI, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ProgramURI: "+err.Error())
+	}
+	var ProgramMetaData string
+	if value, err = soap.FindArg(in, "ProgramMetaData"); err != nil {
+		return
+	}
+	if ProgramMetaData, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ProgramMetaData: "+err.Error())
+	}
+	var PlayMode AlarmClock1AlarmPlayMode
+	if value, err = soap.FindArg(in, "PlayMode"); err != nil {
+		return
+	}
+	PlayMode = AlarmClock1AlarmPlayMode(value)
+	var Volume uint16
+	if value, err = soap.FindArg(in, "Volume"); err != nil {
+		return
+	}
+	if Volume, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Volume: "+err.Error())
+	}
+	var IncludeLinkedZones bool
+	if value, err = soap.FindArg(in, "IncludeLinkedZones"); err != nil {
+		return
+	}
+	if IncludeLinkedZones, err = soap.UnmarshalBoolean(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument IncludeLinkedZones: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var AssignedID uint32
+	if AssignedID, err = handler.CreateAlarm(ctx, StartLocalTime, Duration, Recurrence, Enabled, RoomUUID, ProgramURI, ProgramMetaData, PlayMode, Volume, IncludeLinkedZones); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "AssignedID"
+	if out[0].Value, err = soap.MarshalUi4(AssignedID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAlarmClock1UpdateAlarm(ctx context.Context, handler AlarmClock1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ID uint32
+	if value, err = soap.FindArg(in, "ID"); err != nil {
+		return
+	}
+	if ID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ID: "+err.Error())
+	}
+	var StartLocalTime string
+	if value, err = soap.FindArg(in, "StartLocalTime"); err != nil {
+		return
+	}
+	if StartLocalTime, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument StartLocalTime: "+err.Error())
+	}
+	var Duration string
+	if value, err = soap.FindArg(in, "Duration"); err != nil {
+		return
+	}
+	if Duration, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Duration: "+err.Error())
+	}
+	var Recurrence AlarmClock1Recurrence
+	if value, err = soap.FindArg(in, "Recurrence"); err != nil {
+		return
+	}
+	Recurrence = AlarmClock1Recurrence(value)
+	var Enabled bool
+	if value, err = soap.FindArg(in, "Enabled"); err != nil {
+		return
+	}
+	if Enabled, err = soap.UnmarshalBoolean(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Enabled: "+err.Error())
+	}
+	var RoomUUID string
+	if value, err = soap.FindArg(in, "RoomUUID"); err != nil {
+		return
+	}
+	if RoomUUID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RoomUUID: "+err.Error())
+	}
+	var ProgramURI string
+	if value, err = soap.FindArg(in, "ProgramURI"); err != nil {
+		return
+	}
+	if ProgramURI, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ProgramURI: "+err.Error())
+	}
+	var ProgramMetaData string
+	if value, err = soap.FindArg(in, "ProgramMetaData"); err != nil {
+		return
+	}
+	if ProgramMetaData, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ProgramMetaData: "+err.Error())
+	}
+	var PlayMode AlarmClock1AlarmPlayMode
+	if value, err = soap.FindArg(in, "PlayMode"); err != nil {
+		return
+	}
+	PlayMode = AlarmClock1AlarmPlayMode(value)
+	var Volume uint16
+	if value, err = soap.FindArg(in, "Volume"); err != nil {
+		return
+	}
+	if Volume, err = soap.UnmarshalUi2(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Volume: "+err.Error())
+	}
+	var IncludeLinkedZones bool
+	if value, err = soap.FindArg(in, "IncludeLinkedZones"); err != nil {
+		return
+	}
+	if IncludeLinkedZones, err = soap.UnmarshalBoolean(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument IncludeLinkedZones: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.UpdateAlarm(ctx, ID, StartLocalTime, Duration, Recurrence, Enabled, RoomUUID, ProgramURI, ProgramMetaData, PlayMode, Volume, IncludeLinkedZones); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAlarmClock1DestroyAlarm(ctx context.Context, handler AlarmClock1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ID uint32
+	if value, err = soap.FindArg(in, "ID"); err != nil {
+		return
+	}
+	if ID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.DestroyAlarm(ctx, ID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAlarmClock1ListAlarms(ctx context.Context, handler AlarmClock1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentAlarmList string
+	var CurrentAlarmListVersion string
+	if CurrentAlarmList, CurrentAlarmListVersion, err = handler.ListAlarms(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "CurrentAlarmList"
+	if out[0].Value, err = soap.MarshalString(CurrentAlarmList); err != nil {
+		return
+	}
+	out[1].Name = "CurrentAlarmListVersion"
+	if out[1].Value, err = soap.MarshalString(CurrentAlarmListVersion); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAlarmClock1SetDailyIndexRefreshTime(ctx context.Context, handler AlarmClock1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var DesiredDailyIndexRefreshTime string
+	if value, err = soap.FindArg(in, "DesiredDailyIndexRefreshTime"); err != nil {
+		return
+	}
+	if DesiredDailyIndexRefreshTime, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredDailyIndexRefreshTime: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetDailyIndexRefreshTime(ctx, DesiredDailyIndexRefreshTime); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveAlarmClock1GetDailyIndexRefreshTime(ctx context.Context, handler AlarmClock1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentDailyIndexRefreshTime string
+	if CurrentDailyIndexRefreshTime, err = handler.GetDailyIndexRefreshTime(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "CurrentDailyIndexRefreshTime"
+	if out[0].Value, err = soap.MarshalString(CurrentDailyIndexRefreshTime); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+// MusicServices1Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:schemas-upnp-org:service:MusicServices:1". See RegisterMusicServices1Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type MusicServices1Handler interface {
+	GetSessionId(ctx context.Context, ServiceId uint32, Username string) (SessionId string, err error)
+
+	ListAvailableServices(ctx context.Context) (AvailableServiceDescriptorList string, AvailableServiceTypeList string, AvailableServiceListVersion string, err error)
+
+	UpdateAvailableServices(ctx context.Context) (err error)
+}
+
+// RegisterMusicServices1Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_MusicServices_1.
+func RegisterMusicServices1Handler(svc *device.Service, handler MusicServices1Handler) {
+	svc.HandleFunc("GetSessionId", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveMusicServices1GetSessionId(ctx, handler, in)
+	})
+	svc.HandleFunc("ListAvailableServices", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveMusicServices1ListAvailableServices(ctx, handler, in)
+	})
+	svc.HandleFunc("UpdateAvailableServices", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveMusicServices1UpdateAvailableServices(ctx, handler, in)
+	})
+}
+
+func serveMusicServices1GetSessionId(ctx context.Context, handler MusicServices1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var ServiceId uint32
+	if value, err = soap.FindArg(in, "ServiceId"); err != nil {
+		return
+	}
+	if ServiceId, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ServiceId: "+err.Error())
+	}
+	var Username string
+	if value, err = soap.FindArg(in, "Username"); err != nil {
+		return
+	}
+	if Username, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Username: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var SessionId string
+	if SessionId, err = handler.GetSessionId(ctx, ServiceId, Username); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "SessionId"
+	if out[0].Value, err = soap.MarshalString(SessionId); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveMusicServices1ListAvailableServices(ctx context.Context, handler MusicServices1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var AvailableServiceDescriptorList string
+	var AvailableServiceTypeList string
+	var AvailableServiceListVersion string
+	if AvailableServiceDescriptorList, AvailableServiceTypeList, AvailableServiceListVersion, err = handler.ListAvailableServices(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 3)
+
+	out[0].Name = "AvailableServiceDescriptorList"
+	if out[0].Value, err = soap.MarshalString(AvailableServiceDescriptorList); err != nil {
+		return
+	}
+	out[1].Name = "AvailableServiceTypeList"
+	if out[1].Value, err = soap.MarshalString(AvailableServiceTypeList); err != nil {
+		return
+	}
+	out[2].Name = "AvailableServiceListVersion"
+	if out[2].Value, err = soap.MarshalString(AvailableServiceListVersion); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveMusicServices1UpdateAvailableServices(ctx context.Context, handler MusicServices1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.UpdateAvailableServices(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+// Queue1Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:schemas-sonos-com:service:Queue:1". See RegisterQueue1Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type Queue1Handler interface {
+	AddURI(ctx context.Context, QueueID uint32, UpdateID uint32, EnqueuedURI string, EnqueuedURIMetaData string, DesiredFirstTrackNumberEnqueued uint32, EnqueueAsNext bool) (FirstTrackNumberEnqueued uint32, NumTracksAdded uint32, NewQueueLength uint32, NewUpdateID uint32, err error)
+
+	AddMultipleURIs(ctx context.Context, QueueID uint32, UpdateID uint32, ContainerURI string, ContainerMetaData string, DesiredFirstTrackNumberEnqueued uint32, EnqueueAsNext bool, NumberOfURIs uint32, EnqueuedURIsAndMetaData string) (FirstTrackNumberEnqueued uint32, NumTracksAdded uint32, NewQueueLength uint32, NewUpdateID uint32, err error)
+
+	AttachQueue(ctx context.Context, QueueOwnerID string) (QueueID uint32, QueueOwnerContext string, err error)
+
+	Backup(ctx context.Context) (err error)
+
+	Browse(ctx context.Context, QueueID uint32, StartingIndex uint32, RequestedCount uint32) (Result string, NumberReturned uint32, TotalMatches uint32, UpdateID uint32, err error)
+
+	CreateQueue(ctx context.Context, QueueOwnerID string, QueueOwnerContext string, QueuePolicy string) (QueueID uint32, err error)
+
+	RemoveAllTracks(ctx context.Context, QueueID uint32, UpdateID uint32) (NewUpdateID uint32, err error)
+
+	RemoveTrackRange(ctx context.Context, QueueID uint32, UpdateID uint32, StartingIndex uint32, NumberOfTracks uint32) (NewUpdateID uint32, err error)
+
+	ReorderTracks(ctx context.Context, QueueID uint32, StartingIndex uint32, NumberOfTracks uint32, InsertBefore uint32, UpdateID uint32) (NewUpdateID uint32, err error)
+
+	ReplaceAllTracks(ctx context.Context, QueueID uint32, UpdateID uint32, ContainerURI string, ContainerMetaData string, CurrentTrackIndex uint32, NewCurrentTrackIndices string, NumberOfURIs uint32, EnqueuedURIsAndMetaData string) (NewQueueLength uint32, NewUpdateID uint32, err error)
+
+	SaveAsSonosPlaylist(ctx context.Context, QueueID uint32, Title string, ObjectID string) (AssignedObjectID string, err error)
+}
+
+// RegisterQueue1Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_Queue_1.
+func RegisterQueue1Handler(svc *device.Service, handler Queue1Handler) {
+	svc.HandleFunc("AddURI", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveQueue1AddURI(ctx, handler, in)
+	})
+	svc.HandleFunc("AddMultipleURIs", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveQueue1AddMultipleURIs(ctx, handler, in)
+	})
+	svc.HandleFunc("AttachQueue", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveQueue1AttachQueue(ctx, handler, in)
+	})
+	svc.HandleFunc("Backup", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveQueue1Backup(ctx, handler, in)
+	})
+	svc.HandleFunc("Browse", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveQueue1Browse(ctx, handler, in)
+	})
+	svc.HandleFunc("CreateQueue", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveQueue1CreateQueue(ctx, handler, in)
+	})
+	svc.HandleFunc("RemoveAllTracks", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveQueue1RemoveAllTracks(ctx, handler, in)
+	})
+	svc.HandleFunc("RemoveTrackRange", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveQueue1RemoveTrackRange(ctx, handler, in)
+	})
+	svc.HandleFunc("ReorderTracks", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveQueue1ReorderTracks(ctx, handler, in)
+	})
+	svc.HandleFunc("ReplaceAllTracks", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveQueue1ReplaceAllTracks(ctx, handler, in)
+	})
+	svc.HandleFunc("SaveAsSonosPlaylist", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveQueue1SaveAsSonosPlaylist(ctx, handler, in)
+	})
+}
+
+func serveQueue1AddURI(ctx context.Context, handler Queue1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var QueueID uint32
+	if value, err = soap.FindArg(in, "QueueID"); err != nil {
+		return
+	}
+	if QueueID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument QueueID: "+err.Error())
+	}
+	var UpdateID uint32
+	if value, err = soap.FindArg(in, "UpdateID"); err != nil {
+		return
+	}
+	if UpdateID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument UpdateID: "+err.Error())
+	}
+	var EnqueuedURI string
+	if value, err = soap.FindArg(in, "EnqueuedURI"); err != nil {
+		return
+	}
+	if EnqueuedURI, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument EnqueuedURI: "+err.Error())
+	}
+	var EnqueuedURIMetaData string
+	if value, err = soap.FindArg(in, "EnqueuedURIMetaData"); err != nil {
+		return
+	}
+	if EnqueuedURIMetaData, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument EnqueuedURIMetaData: "+err.Error())
+	}
+	var DesiredFirstTrackNumberEnqueued uint32
+	if value, err = soap.FindArg(in, "DesiredFirstTrackNumberEnqueued"); err != nil {
+		return
+	}
+	if DesiredFirstTrackNumberEnqueued, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredFirstTrackNumberEnqueued: "+err.Error())
+	}
+	var EnqueueAsNext bool
+	if value, err = soap.FindArg(in, "EnqueueAsNext"); err != nil {
+		return
+	}
+	if EnqueueAsNext, err = soap.UnmarshalBoolean(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument EnqueueAsNext: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var FirstTrackNumberEnqueued uint32
+	var NumTracksAdded uint32
+	var NewQueueLength uint32
+	var NewUpdateID uint32
+	if FirstTrackNumberEnqueued, NumTracksAdded, NewQueueLength, NewUpdateID, err = handler.AddURI(ctx, QueueID, UpdateID, EnqueuedURI, EnqueuedURIMetaData, DesiredFirstTrackNumberEnqueued, EnqueueAsNext); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 4)
+
+	out[0].Name = "FirstTrackNumberEnqueued"
+	if out[0].Value, err = soap.MarshalUi4(FirstTrackNumberEnqueued); err != nil {
+		return
+	}
+	out[1].Name = "NumTracksAdded"
+	if out[1].Value, err = soap.MarshalUi4(NumTracksAdded); err != nil {
+		return
+	}
+	out[2].Name = "NewQueueLength"
+	if out[2].Value, err = soap.MarshalUi4(NewQueueLength); err != nil {
+		return
+	}
+	out[3].Name = "NewUpdateID"
+	if out[3].Value, err = soap.MarshalUi4(NewUpdateID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveQueue1AddMultipleURIs(ctx context.Context, handler Queue1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var QueueID uint32
+	if value, err = soap.FindArg(in, "QueueID"); err != nil {
+		return
+	}
+	if QueueID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument QueueID: "+err.Error())
+	}
+	var UpdateID uint32
+	if value, err = soap.FindArg(in, "UpdateID"); err != nil {
+		return
+	}
+	if UpdateID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument UpdateID: "+err.Error())
+	}
+	var ContainerURI string
+	if value, err = soap.FindArg(in, "ContainerURI"); err != nil {
+		return
+	}
+	if ContainerURI, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ContainerURI: "+err.Error())
+	}
+	var ContainerMetaData string
+	if value, err = soap.FindArg(in, "ContainerMetaData"); err != nil {
+		return
+	}
+	if ContainerMetaData, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ContainerMetaData: "+err.Error())
+	}
+	var DesiredFirstTrackNumberEnqueued uint32
+	if value, err = soap.FindArg(in, "DesiredFirstTrackNumberEnqueued"); err != nil {
+		return
+	}
+	if DesiredFirstTrackNumberEnqueued, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DesiredFirstTrackNumberEnqueued: "+err.Error())
+	}
+	var EnqueueAsNext bool
+	if value, err = soap.FindArg(in, "EnqueueAsNext"); err != nil {
+		return
+	}
+	if EnqueueAsNext, err = soap.UnmarshalBoolean(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument EnqueueAsNext: "+err.Error())
+	}
+	var NumberOfURIs uint32
+	if value, err = soap.FindArg(in, "NumberOfURIs"); err != nil {
+		return
+	}
+	if NumberOfURIs, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NumberOfURIs: "+err.Error())
+	}
+	var EnqueuedURIsAndMetaData string
+	if value, err = soap.FindArg(in, "EnqueuedURIsAndMetaData"); err != nil {
+		return
+	}
+	if EnqueuedURIsAndMetaData, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument EnqueuedURIsAndMetaData: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var FirstTrackNumberEnqueued uint32
+	var NumTracksAdded uint32
+	var NewQueueLength uint32
+	var NewUpdateID uint32
+	if FirstTrackNumberEnqueued, NumTracksAdded, NewQueueLength, NewUpdateID, err = handler.AddMultipleURIs(ctx, QueueID, UpdateID, ContainerURI, ContainerMetaData, DesiredFirstTrackNumberEnqueued, EnqueueAsNext, NumberOfURIs, EnqueuedURIsAndMetaData); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 4)
+
+	out[0].Name = "FirstTrackNumberEnqueued"
+	if out[0].Value, err = soap.MarshalUi4(FirstTrackNumberEnqueued); err != nil {
+		return
+	}
+	out[1].Name = "NumTracksAdded"
+	if out[1].Value, err = soap.MarshalUi4(NumTracksAdded); err != nil {
+		return
+	}
+	out[2].Name = "NewQueueLength"
+	if out[2].Value, err = soap.MarshalUi4(NewQueueLength); err != nil {
+		return
+	}
+	out[3].Name = "NewUpdateID"
+	if out[3].Value, err = soap.MarshalUi4(NewUpdateID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveQueue1AttachQueue(ctx context.Context, handler Queue1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var QueueOwnerID string
+	if value, err = soap.FindArg(in, "QueueOwnerID"); err != nil {
+		return
+	}
+	if QueueOwnerID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument QueueOwnerID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var QueueID uint32
+	var QueueOwnerContext string
+	if QueueID, QueueOwnerContext, err = handler.AttachQueue(ctx, QueueOwnerID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "QueueID"
+	if out[0].Value, err = soap.MarshalUi4(QueueID); err != nil {
+		return
+	}
+	out[1].Name = "QueueOwnerContext"
+	if out[1].Value, err = soap.MarshalString(QueueOwnerContext); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveQueue1Backup(ctx context.Context, handler Queue1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.Backup(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveQueue1Browse(ctx context.Context, handler Queue1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var QueueID uint32
+	if value, err = soap.FindArg(in, "QueueID"); err != nil {
+		return
+	}
+	if QueueID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument QueueID: "+err.Error())
+	}
+	var StartingIndex uint32
+	if value, err = soap.FindArg(in, "StartingIndex"); err != nil {
+		return
+	}
+	if StartingIndex, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument StartingIndex: "+err.Error())
+	}
+	var RequestedCount uint32
+	if value, err = soap.FindArg(in, "RequestedCount"); err != nil {
+		return
+	}
+	if RequestedCount, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument RequestedCount: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var Result string
+	var NumberReturned uint32
+	var TotalMatches uint32
+	var UpdateID uint32
+	if Result, NumberReturned, TotalMatches, UpdateID, err = handler.Browse(ctx, QueueID, StartingIndex, RequestedCount); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 4)
+
+	out[0].Name = "Result"
+	if out[0].Value, err = soap.MarshalString(Result); err != nil {
+		return
+	}
+	out[1].Name = "NumberReturned"
+	if out[1].Value, err = soap.MarshalUi4(NumberReturned); err != nil {
+		return
+	}
+	out[2].Name = "TotalMatches"
+	if out[2].Value, err = soap.MarshalUi4(TotalMatches); err != nil {
+		return
+	}
+	out[3].Name = "UpdateID"
+	if out[3].Value, err = soap.MarshalUi4(UpdateID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveQueue1CreateQueue(ctx context.Context, handler Queue1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var QueueOwnerID string
+	if value, err = soap.FindArg(in, "QueueOwnerID"); err != nil {
+		return
+	}
+	if QueueOwnerID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument QueueOwnerID: "+err.Error())
+	}
+	var QueueOwnerContext string
+	if value, err = soap.FindArg(in, "QueueOwnerContext"); err != nil {
+		return
+	}
+	if QueueOwnerContext, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument QueueOwnerContext: "+err.Error())
+	}
+	var QueuePolicy string
+	if value, err = soap.FindArg(in, "QueuePolicy"); err != nil {
+		return
+	}
+	if QueuePolicy, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument QueuePolicy: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var QueueID uint32
+	if QueueID, err = handler.CreateQueue(ctx, QueueOwnerID, QueueOwnerContext, QueuePolicy); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "QueueID"
+	if out[0].Value, err = soap.MarshalUi4(QueueID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveQueue1RemoveAllTracks(ctx context.Context, handler Queue1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var QueueID uint32
+	if value, err = soap.FindArg(in, "QueueID"); err != nil {
+		return
+	}
+	if QueueID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument QueueID: "+err.Error())
+	}
+	var UpdateID uint32
+	if value, err = soap.FindArg(in, "UpdateID"); err != nil {
+		return
+	}
+	if UpdateID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument UpdateID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewUpdateID uint32
+	if NewUpdateID, err = handler.RemoveAllTracks(ctx, QueueID, UpdateID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewUpdateID"
+	if out[0].Value, err = soap.MarshalUi4(NewUpdateID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveQueue1RemoveTrackRange(ctx context.Context, handler Queue1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var QueueID uint32
+	if value, err = soap.FindArg(in, "QueueID"); err != nil {
+		return
+	}
+	if QueueID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument QueueID: "+err.Error())
+	}
+	var UpdateID uint32
+	if value, err = soap.FindArg(in, "UpdateID"); err != nil {
+		return
+	}
+	if UpdateID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument UpdateID: "+err.Error())
+	}
+	var StartingIndex uint32
+	if value, err = soap.FindArg(in, "StartingIndex"); err != nil {
+		return
+	}
+	if StartingIndex, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument StartingIndex: "+err.Error())
+	}
+	var NumberOfTracks uint32
+	if value, err = soap.FindArg(in, "NumberOfTracks"); err != nil {
+		return
+	}
+	if NumberOfTracks, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NumberOfTracks: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewUpdateID uint32
+	if NewUpdateID, err = handler.RemoveTrackRange(ctx, QueueID, UpdateID, StartingIndex, NumberOfTracks); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewUpdateID"
+	if out[0].Value, err = soap.MarshalUi4(NewUpdateID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveQueue1ReorderTracks(ctx context.Context, handler Queue1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var QueueID uint32
+	if value, err = soap.FindArg(in, "QueueID"); err != nil {
+		return
+	}
+	if QueueID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument QueueID: "+err.Error())
+	}
+	var StartingIndex uint32
+	if value, err = soap.FindArg(in, "StartingIndex"); err != nil {
+		return
+	}
+	if StartingIndex, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument StartingIndex: "+err.Error())
+	}
+	var NumberOfTracks uint32
+	if value, err = soap.FindArg(in, "NumberOfTracks"); err != nil {
+		return
+	}
+	if NumberOfTracks, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NumberOfTracks: "+err.Error())
+	}
+	var InsertBefore uint32
+	if value, err = soap.FindArg(in, "InsertBefore"); err != nil {
+		return
+	}
+	if InsertBefore, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument InsertBefore: "+err.Error())
+	}
+	var UpdateID uint32
+	if value, err = soap.FindArg(in, "UpdateID"); err != nil {
+		return
+	}
+	if UpdateID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument UpdateID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewUpdateID uint32
+	if NewUpdateID, err = handler.ReorderTracks(ctx, QueueID, StartingIndex, NumberOfTracks, InsertBefore, UpdateID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewUpdateID"
+	if out[0].Value, err = soap.MarshalUi4(NewUpdateID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveQueue1ReplaceAllTracks(ctx context.Context, handler Queue1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var QueueID uint32
+	if value, err = soap.FindArg(in, "QueueID"); err != nil {
+		return
+	}
+	if QueueID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument QueueID: "+err.Error())
+	}
+	var UpdateID uint32
+	if value, err = soap.FindArg(in, "UpdateID"); err != nil {
+		return
+	}
+	if UpdateID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument UpdateID: "+err.Error())
+	}
+	var ContainerURI string
+	if value, err = soap.FindArg(in, "ContainerURI"); err != nil {
+		return
+	}
+	if ContainerURI, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ContainerURI: "+err.Error())
+	}
+	var ContainerMetaData string
+	if value, err = soap.FindArg(in, "ContainerMetaData"); err != nil {
+		return
+	}
+	if ContainerMetaData, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ContainerMetaData: "+err.Error())
+	}
+	var CurrentTrackIndex uint32
+	if value, err = soap.FindArg(in, "CurrentTrackIndex"); err != nil {
+		return
+	}
+	if CurrentTrackIndex, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument CurrentTrackIndex: "+err.Error())
+	}
+	var NewCurrentTrackIndices string
+	if value, err = soap.FindArg(in, "NewCurrentTrackIndices"); err != nil {
+		return
+	}
+	if NewCurrentTrackIndices, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewCurrentTrackIndices: "+err.Error())
+	}
+	var NumberOfURIs uint32
+	if value, err = soap.FindArg(in, "NumberOfURIs"); err != nil {
+		return
+	}
+	if NumberOfURIs, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NumberOfURIs: "+err.Error())
+	}
+	var EnqueuedURIsAndMetaData string
+	if value, err = soap.FindArg(in, "EnqueuedURIsAndMetaData"); err != nil {
+		return
+	}
+	if EnqueuedURIsAndMetaData, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument EnqueuedURIsAndMetaData: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewQueueLength uint32
+	var NewUpdateID uint32
+	if NewQueueLength, NewUpdateID, err = handler.ReplaceAllTracks(ctx, QueueID, UpdateID, ContainerURI, ContainerMetaData, CurrentTrackIndex, NewCurrentTrackIndices, NumberOfURIs, EnqueuedURIsAndMetaData); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 2)
+
+	out[0].Name = "NewQueueLength"
+	if out[0].Value, err = soap.MarshalUi4(NewQueueLength); err != nil {
+		return
+	}
+	out[1].Name = "NewUpdateID"
+	if out[1].Value, err = soap.MarshalUi4(NewUpdateID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveQueue1SaveAsSonosPlaylist(ctx context.Context, handler Queue1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var QueueID uint32
+	if value, err = soap.FindArg(in, "QueueID"); err != nil {
+		return
+	}
+	if QueueID, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument QueueID: "+err.Error())
+	}
+	var Title string
+	if value, err = soap.FindArg(in, "Title"); err != nil {
+		return
+	}
+	if Title, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Title: "+err.Error())
+	}
+	var ObjectID string
+	if value, err = soap.FindArg(in, "ObjectID"); err != nil {
+		return
+	}
+	if ObjectID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ObjectID: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var AssignedObjectID string
+	if AssignedObjectID, err = handler.SaveAsSonosPlaylist(ctx, QueueID, Title, ObjectID); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "AssignedObjectID"
+	if out[0].Value, err = soap.MarshalString(AssignedObjectID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+// ZoneGroupTopology1Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:schemas-upnp-org:service:ZoneGroupTopology:1". See RegisterZoneGroupTopology1Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type ZoneGroupTopology1Handler interface {
+	CheckForUpdate(ctx context.Context, UpdateType ZoneGroupTopology1UpdateType, CachedOnly bool, Version string) (UpdateItem string, err error)
+
+	BeginSoftwareUpdate(ctx context.Context, UpdateURL string, Flags uint32, ExtraOptions string) (err error)
+
+	ReportUnresponsiveDevice(ctx context.Context, DeviceUUID string, DesiredAction ZoneGroupTopology1UnresponsiveDeviceActionType) (err error)
+
+	ReportAlarmStartedRunning(ctx context.Context) (err error)
+
+	SubmitDiagnostics(ctx context.Context, IncludeControllers bool, Type string) (DiagnosticID uint32, err error)
+
+	RegisterMobileDevice(ctx context.Context, MobileDeviceName string, MobileDeviceUDN string, MobileIPAndPort string) (err error)
+
+	GetZoneGroupAttributes(ctx context.Context) (CurrentZoneGroupName string, CurrentZoneGroupID string, CurrentZonePlayerUUIDsInGroup string, CurrentMuseHouseholdId string, err error)
+
+	GetZoneGroupState(ctx context.Context) (ZoneGroupState string, err error)
+}
+
+// RegisterZoneGroupTopology1Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_ZoneGroupTopology_1.
+func RegisterZoneGroupTopology1Handler(svc *device.Service, handler ZoneGroupTopology1Handler) {
+	svc.HandleFunc("CheckForUpdate", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveZoneGroupTopology1CheckForUpdate(ctx, handler, in)
+	})
+	svc.HandleFunc("BeginSoftwareUpdate", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveZoneGroupTopology1BeginSoftwareUpdate(ctx, handler, in)
+	})
+	svc.HandleFunc("ReportUnresponsiveDevice", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveZoneGroupTopology1ReportUnresponsiveDevice(ctx, handler, in)
+	})
+	svc.HandleFunc("ReportAlarmStartedRunning", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveZoneGroupTopology1ReportAlarmStartedRunning(ctx, handler, in)
+	})
+	svc.HandleFunc("SubmitDiagnostics", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveZoneGroupTopology1SubmitDiagnostics(ctx, handler, in)
+	})
+	svc.HandleFunc("RegisterMobileDevice", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveZoneGroupTopology1RegisterMobileDevice(ctx, handler, in)
+	})
+	svc.HandleFunc("GetZoneGroupAttributes", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveZoneGroupTopology1GetZoneGroupAttributes(ctx, handler, in)
+	})
+	svc.HandleFunc("GetZoneGroupState", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveZoneGroupTopology1GetZoneGroupState(ctx, handler, in)
+	})
+}
+
+func serveZoneGroupTopology1CheckForUpdate(ctx context.Context, handler ZoneGroupTopology1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var UpdateType ZoneGroupTopology1UpdateType
+	if value, err = soap.FindArg(in, "UpdateType"); err != nil {
+		return
+	}
+	UpdateType = ZoneGroupTopology1UpdateType(value)
+	var CachedOnly bool
+	if value, err = soap.FindArg(in, "CachedOnly"); err != nil {
+		return
+	}
+	if CachedOnly, err = soap.UnmarshalBoolean(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument CachedOnly: "+err.Error())
+	}
+	var Version string
+	if value, err = soap.FindArg(in, "Version"); err != nil {
+		return
+	}
+	if Version, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Version: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var UpdateItem string
+	if UpdateItem, err = handler.CheckForUpdate(ctx, UpdateType, CachedOnly, Version); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "UpdateItem"
+	if out[0].Value, err = soap.MarshalString(UpdateItem); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveZoneGroupTopology1BeginSoftwareUpdate(ctx context.Context, handler ZoneGroupTopology1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var UpdateURL string
+	if value, err = soap.FindArg(in, "UpdateURL"); err != nil {
+		return
+	}
+	if UpdateURL, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument UpdateURL: "+err.Error())
+	}
+	var Flags uint32
+	if value, err = soap.FindArg(in, "Flags"); err != nil {
+		return
+	}
+	if Flags, err = soap.UnmarshalUi4(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Flags: "+err.Error())
+	}
+	var ExtraOptions string
+	if value, err = soap.FindArg(in, "ExtraOptions"); err != nil {
+		return
+	}
+	if ExtraOptions, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument ExtraOptions: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.BeginSoftwareUpdate(ctx, UpdateURL, Flags, ExtraOptions); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveZoneGroupTopology1ReportUnresponsiveDevice(ctx context.Context, handler ZoneGroupTopology1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var DeviceUUID string
+	if value, err = soap.FindArg(in, "DeviceUUID"); err != nil {
+		return
+	}
+	if DeviceUUID, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument DeviceUUID: "+err.Error())
+	}
+	var DesiredAction ZoneGroupTopology1UnresponsiveDeviceActionType
+	if value, err = soap.FindArg(in, "DesiredAction"); err != nil {
+		return
+	}
+	DesiredAction = ZoneGroupTopology1UnresponsiveDeviceActionType(value)
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.ReportUnresponsiveDevice(ctx, DeviceUUID, DesiredAction); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveZoneGroupTopology1ReportAlarmStartedRunning(ctx context.Context, handler ZoneGroupTopology1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.ReportAlarmStartedRunning(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveZoneGroupTopology1SubmitDiagnostics(ctx context.Context, handler ZoneGroupTopology1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var IncludeControllers bool
+	if value, err = soap.FindArg(in, "IncludeControllers"); err != nil {
+		return
+	}
+	if IncludeControllers, err = soap.UnmarshalBoolean(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument IncludeControllers: "+err.Error())
+	}
+	var Type string
+	if value, err = soap.FindArg(in, "Type"); err != nil {
+		return
+	}
+	if Type, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument Type: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var DiagnosticID uint32
+	if DiagnosticID, err = handler.SubmitDiagnostics(ctx, IncludeControllers, Type); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "DiagnosticID"
+	if out[0].Value, err = soap.MarshalUi4(DiagnosticID); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveZoneGroupTopology1RegisterMobileDevice(ctx context.Context, handler ZoneGroupTopology1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var MobileDeviceName string
+	if value, err = soap.FindArg(in, "MobileDeviceName"); err != nil {
+		return
+	}
+	if MobileDeviceName, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument MobileDeviceName: "+err.Error())
+	}
+	var MobileDeviceUDN string
+	if value, err = soap.FindArg(in, "MobileDeviceUDN"); err != nil {
+		return
+	}
+	if MobileDeviceUDN, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument MobileDeviceUDN: "+err.Error())
+	}
+	var MobileIPAndPort string
+	if value, err = soap.FindArg(in, "MobileIPAndPort"); err != nil {
+		return
+	}
+	if MobileIPAndPort, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument MobileIPAndPort: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.RegisterMobileDevice(ctx, MobileDeviceName, MobileDeviceUDN, MobileIPAndPort); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveZoneGroupTopology1GetZoneGroupAttributes(ctx context.Context, handler ZoneGroupTopology1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var CurrentZoneGroupName string
+	var CurrentZoneGroupID string
+	var CurrentZonePlayerUUIDsInGroup string
+	var CurrentMuseHouseholdId string
+	if CurrentZoneGroupName, CurrentZoneGroupID, CurrentZonePlayerUUIDsInGroup, CurrentMuseHouseholdId, err = handler.GetZoneGroupAttributes(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 4)
+
+	out[0].Name = "CurrentZoneGroupName"
+	if out[0].Value, err = soap.MarshalString(CurrentZoneGroupName); err != nil {
+		return
+	}
+	out[1].Name = "CurrentZoneGroupID"
+	if out[1].Value, err = soap.MarshalString(CurrentZoneGroupID); err != nil {
+		return
+	}
+	out[2].Name = "CurrentZonePlayerUUIDsInGroup"
+	if out[2].Value, err = soap.MarshalString(CurrentZonePlayerUUIDsInGroup); err != nil {
+		return
+	}
+	out[3].Name = "CurrentMuseHouseholdId"
+	if out[3].Value, err = soap.MarshalString(CurrentMuseHouseholdId); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveZoneGroupTopology1GetZoneGroupState(ctx context.Context, handler ZoneGroupTopology1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var ZoneGroupState string
+	if ZoneGroupState, err = handler.GetZoneGroupState(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "ZoneGroupState"
+	if out[0].Value, err = soap.MarshalString(ZoneGroupState); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
@@ -0,0 +1,28 @@
+package sonos
+
+import (
+	"testing"
+
+	"github.com/huin/goupnp"
+)
+
+func TestQueueServiceType(t *testing.T) {
+	const want = "urn:schemas-sonos-com:service:Queue:1"
+	if URN_Queue_1 != want {
+		t.Errorf("URN_Queue_1 = %q, want %q", URN_Queue_1, want)
+	}
+	root := &goupnp.RootDevice{Device: goupnp.Device{
+		UDN: "uuid:RINCON_A1400",
+		Devices: []goupnp.Device{{
+			UDN:      "uuid:RINCON_A1400_MR",
+			Services: []goupnp.Service{{ServiceType: want, ServiceId: "urn:sonos-com:serviceId:Queue"}},
+		}},
+	}}
+	clients, err := NewQueue1ClientsFromRootDevice(root, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(clients) != 1 {
+		t.Errorf("got %d clients, want 1", len(clients))
+	}
+}
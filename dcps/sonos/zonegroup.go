@@ -0,0 +1,99 @@
+package sonos
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strings"
+)
+
+// ZoneGroupState is the grouping of the Sonos players of a household, as
+// returned by GetZoneGroupState or evented by the ZoneGroupState state
+// variable of ZoneGroupTopology.
+type ZoneGroupState struct {
+	Groups []ZoneGroup
+	// VanishedDevices are players that have recently dropped off the network.
+	VanishedDevices []ZoneGroupMember
+}
+
+// ZoneGroup is a group of players that play in sync, controlled through its
+// coordinator.
+type ZoneGroup struct {
+	ID          string            `xml:"ID,attr"`
+	Coordinator string            `xml:"Coordinator,attr"` // UUID of the coordinating member.
+	Members     []ZoneGroupMember `xml:"ZoneGroupMember"`
+}
+
+// ZoneGroupMember is a player in a ZoneGroup.
+type ZoneGroupMember struct {
+	UUID     string `xml:"UUID,attr"`
+	Location string `xml:"Location,attr"` // URL of the device description of the player.
+	ZoneName string `xml:"ZoneName,attr"`
+	// Invisible is set for players that are not shown as rooms of their own,
+	// such as the second speaker of a stereo pair.
+	Invisible bool `xml:"Invisible,attr"`
+	// Satellites are the surround and sub speakers bonded to a home theatre
+	// player.
+	Satellites []ZoneGroupMember `xml:"Satellite"`
+}
+
+// CoordinatorMember returns the member of g that coordinates it, or nil if it
+// is not one of the members.
+func (g *ZoneGroup) CoordinatorMember() *ZoneGroupMember {
+	for i := range g.Members {
+		if g.Members[i].UUID == g.Coordinator {
+			return &g.Members[i]
+		}
+	}
+	return nil
+}
+
+// Name returns the name of g as the Sonos apps show it: the zone name of the
+// coordinator, followed by the number of other visible members.
+func (g *ZoneGroup) Name() string {
+	c := g.CoordinatorMember()
+	if c == nil {
+		return ""
+	}
+	others := 0
+	for _, m := range g.Members {
+		if m.UUID != g.Coordinator && !m.Invisible {
+			others++
+		}
+	}
+	if others == 0 {
+		return c.ZoneName
+	}
+	return fmt.Sprintf("%s + %d", c.ZoneName, others)
+}
+
+// ParseZoneGroupState parses ZoneGroupState XML. Both the current form, with a
+// ZoneGroupState root element, and the bare ZoneGroups element sent by older
+// firmware are accepted.
+func ParseZoneGroupState(s string) (*ZoneGroupState, error) {
+	var doc struct {
+		XMLName         xml.Name
+		Groups          []ZoneGroup       `xml:"ZoneGroup"`
+		ZoneGroups      []ZoneGroup       `xml:"ZoneGroups>ZoneGroup"`
+		VanishedDevices []ZoneGroupMember `xml:"VanishedDevices>Device"`
+	}
+	if err := xml.NewDecoder(strings.NewReader(s)).Decode(&doc); err != nil {
+		return nil, fmt.Errorf("goupnp: error decoding ZoneGroupState: %v", err)
+	}
+	switch doc.XMLName.Local {
+	case "ZoneGroupState":
+		return &ZoneGroupState{Groups: doc.ZoneGroups, VanishedDevices: doc.VanishedDevices}, nil
+	case "ZoneGroups":
+		return &ZoneGroupState{Groups: doc.Groups}, nil
+	}
+	return nil, fmt.Errorf("goupnp: unexpected ZoneGroupState root element %q", doc.XMLName.Local)
+}
+
+// GetZoneGroups calls GetZoneGroupState and parses the result.
+func GetZoneGroups(ctx context.Context, client ZoneGroupTopology1Client) (*ZoneGroupState, error) {
+	s, err := client.GetZoneGroupStateCtx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return ParseZoneGroupState(s)
+}
@@ -0,0 +1,62 @@
+package sonos
+
+import (
+	"context"
+	"testing"
+)
+
+const testZoneGroupState = `<ZoneGroupState><ZoneGroups>
+<ZoneGroup Coordinator="RINCON_A1400" ID="RINCON_A1400:12">
+<ZoneGroupMember UUID="RINCON_A1400" Location="http://192.0.2.10:1400/xml/device_description.xml" ZoneName="Living Room">
+<Satellite UUID="RINCON_S1400" Location="http://192.0.2.13:1400/xml/device_description.xml" ZoneName="Living Room" Invisible="1"/>
+</ZoneGroupMember>
+<ZoneGroupMember UUID="RINCON_B1400" Location="http://192.0.2.11:1400/xml/device_description.xml" ZoneName="Kitchen"/>
+<ZoneGroupMember UUID="RINCON_C1400" Location="http://192.0.2.12:1400/xml/device_description.xml" ZoneName="Kitchen" Invisible="1"/>
+</ZoneGroup>
+<ZoneGroup Coordinator="RINCON_D1400" ID="RINCON_D1400:3">
+<ZoneGroupMember UUID="RINCON_D1400" Location="http://192.0.2.14:1400/xml/device_description.xml" ZoneName="Office"/>
+</ZoneGroup>
+</ZoneGroups><VanishedDevices><Device UUID="RINCON_E1400" ZoneName="Garage"/></VanishedDevices></ZoneGroupState>`
+
+type fakeZoneGroupTopology struct {
+	ZoneGroupTopology1Client
+	state string
+}
+
+func (f *fakeZoneGroupTopology) GetZoneGroupStateCtx(ctx context.Context) (string, error) {
+	return f.state, nil
+}
+
+func TestGetZoneGroups(t *testing.T) {
+	zgs, err := GetZoneGroups(context.Background(), &fakeZoneGroupTopology{state: testZoneGroupState})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(zgs.Groups) != 2 {
+		t.Fatalf("got %d groups, want 2", len(zgs.Groups))
+	}
+	g := zgs.Groups[0]
+	if c := g.CoordinatorMember(); c == nil || c.ZoneName != "Living Room" || len(c.Satellites) != 1 || !c.Satellites[0].Invisible {
+		t.Errorf("CoordinatorMember() = %+v", c)
+	}
+	if got, want := g.Name(), "Living Room + 1"; got != want {
+		t.Errorf("Name() = %q, want %q", got, want)
+	}
+	if got, want := zgs.Groups[1].Name(), "Office"; got != want {
+		t.Errorf("Name() = %q, want %q", got, want)
+	}
+	if len(zgs.VanishedDevices) != 1 || zgs.VanishedDevices[0].ZoneName != "Garage" {
+		t.Errorf("VanishedDevices = %+v", zgs.VanishedDevices)
+	}
+}
+
+func TestParseZoneGroupStateLegacy(t *testing.T) {
+	zgs, err := ParseZoneGroupState(`<ZoneGroups><ZoneGroup Coordinator="RINCON_D1400" ID="RINCON_D1400:3">` +
+		`<ZoneGroupMember UUID="RINCON_D1400" ZoneName="Office"/></ZoneGroup></ZoneGroups>`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(zgs.Groups) != 1 || zgs.Groups[0].Name() != "Office" {
+		t.Errorf("got %+v", zgs)
+	}
+}
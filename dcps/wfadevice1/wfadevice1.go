@@ -0,0 +1,731 @@
+// Client for UPnP Device Control Protocol Wi-Fi Alliance WFADevice v1.
+//
+// Typically, use one of the New* functions to create clients for services.
+package wfadevice1
+
+// Generated file - do not edit by hand. See README.md
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/huin/goupnp"
+	"github.com/huin/goupnp/soap"
+)
+
+// Hack to avoid Go complaining if time isn't used.
+var _ time.Time
+
+// Device URNs:
+const (
+	URN_WFADevice_1 = "urn:schemas-wifialliance-org:device:WFADevice:1"
+)
+
+// Service URNs:
+const (
+	URN_WFAWLANConfig_1 = "urn:schemas-wifialliance-org:service:WFAWLANConfig:1"
+)
+
+// WFAWLANConfig1 is a client for UPnP SOAP service with URN "urn:schemas-wifialliance-org:service:WFAWLANConfig:1". See
+// goupnp.ServiceClient, which contains RootDevice and Service attributes which
+// are provided for informational value.
+type WFAWLANConfig1 struct {
+	goupnp.ServiceClient
+}
+
+// WFAWLANConfig1Client is the interface of the actions of WFAWLANConfig1, for
+// substituting fakes or mocks for the service in tests.
+type WFAWLANConfig1Client interface {
+	GetDeviceInfo() (NewDeviceInfo []byte, err error)
+	GetDeviceInfoCtx(ctx context.Context) (NewDeviceInfo []byte, err error)
+	PutMessage(NewInMessage []byte) (NewOutMessage []byte, err error)
+	PutMessageCtx(ctx context.Context, NewInMessage []byte) (NewOutMessage []byte, err error)
+	GetAPSettings(NewMessage []byte) (NewAPSettings []byte, err error)
+	GetAPSettingsCtx(ctx context.Context, NewMessage []byte) (NewAPSettings []byte, err error)
+	SetAPSettings(NewAPSettings []byte) (err error)
+	SetAPSettingsCtx(ctx context.Context, NewAPSettings []byte) (err error)
+	DelAPSettings(NewAPSettings []byte) (err error)
+	DelAPSettingsCtx(ctx context.Context, NewAPSettings []byte) (err error)
+	GetSTASettings(NewMessage []byte) (NewSTASettings []byte, err error)
+	GetSTASettingsCtx(ctx context.Context, NewMessage []byte) (NewSTASettings []byte, err error)
+	SetSTASettings() (NewSTASettings []byte, err error)
+	SetSTASettingsCtx(ctx context.Context) (NewSTASettings []byte, err error)
+	DelSTASettings(NewSTASettings []byte) (err error)
+	DelSTASettingsCtx(ctx context.Context, NewSTASettings []byte) (err error)
+	PutWLANResponse(NewMessage []byte, NewWLANEventType uint8, NewWLANEventMAC string) (err error)
+	PutWLANResponseCtx(ctx context.Context, NewMessage []byte, NewWLANEventType uint8, NewWLANEventMAC string) (err error)
+	SetSelectedRegistrar(NewMessage []byte) (err error)
+	SetSelectedRegistrarCtx(ctx context.Context, NewMessage []byte) (err error)
+	RebootAP(NewAPSettings []byte) (err error)
+	RebootAPCtx(ctx context.Context, NewAPSettings []byte) (err error)
+	ResetAP(NewMessage []byte) (err error)
+	ResetAPCtx(ctx context.Context, NewMessage []byte) (err error)
+	RebootSTA(NewSTASettings []byte) (err error)
+	RebootSTACtx(ctx context.Context, NewSTASettings []byte) (err error)
+	ResetSTA(NewMessage []byte) (err error)
+	ResetSTACtx(ctx context.Context, NewMessage []byte) (err error)
+}
+
+var _ WFAWLANConfig1Client = new(WFAWLANConfig1)
+
+// NewWFAWLANConfig1Clients discovers instances of the service on the network,
+// and returns clients to any that are found. errors will contain an error for
+// any devices that replied but which could not be queried, and err will be set
+// if the discovery process failed outright.
+//
+// This is a typical entry calling point into this package.
+func NewWFAWLANConfig1Clients() (clients []*WFAWLANConfig1, errors []error, err error) {
+	var genericClients []goupnp.ServiceClient
+	if genericClients, errors, err = goupnp.NewServiceClients(URN_WFAWLANConfig_1); err != nil {
+		return
+	}
+	clients = newWFAWLANConfig1ClientsFromGenericClients(genericClients)
+	return
+}
+
+// NewWFAWLANConfig1ClientsByURL discovers instances of the service at the given
+// URL, and returns clients to any that are found. An error is returned if
+// there was an error probing the service.
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered service URL.
+func NewWFAWLANConfig1ClientsByURL(loc *url.URL) ([]*WFAWLANConfig1, error) {
+	genericClients, err := goupnp.NewServiceClientsByURL(loc, URN_WFAWLANConfig_1)
+	if err != nil {
+		return nil, err
+	}
+	return newWFAWLANConfig1ClientsFromGenericClients(genericClients), nil
+}
+
+// NewWFAWLANConfig1ClientsFromRootDevice discovers instances of the service in
+// a given root device, and returns clients to any that are found. An error is
+// returned if there was not at least one instance of the service within the
+// device. The location parameter is simply assigned to the Location attribute
+// of the wrapped ServiceClient(s).
+//
+// This is a typical entry calling point into this package when reusing an
+// previously discovered root device.
+func NewWFAWLANConfig1ClientsFromRootDevice(rootDevice *goupnp.RootDevice, loc *url.URL) ([]*WFAWLANConfig1, error) {
+	genericClients, err := goupnp.NewServiceClientsFromRootDevice(rootDevice, loc, URN_WFAWLANConfig_1)
+	if err != nil {
+		return nil, err
+	}
+	return newWFAWLANConfig1ClientsFromGenericClients(genericClients), nil
+}
+
+func newWFAWLANConfig1ClientsFromGenericClients(genericClients []goupnp.ServiceClient) []*WFAWLANConfig1 {
+	clients := make([]*WFAWLANConfig1, len(genericClients))
+	for i := range genericClients {
+		clients[i] = &WFAWLANConfig1{genericClients[i]}
+	}
+	return clients
+}
+
+// WFAWLANConfig1Timeouts are the default timeouts of the actions of
+// WFAWLANConfig1, keyed by action name, with the timeout keyed by "" applying
+// to the other actions. Set the ActionTimeouts of a client to override them.
+var WFAWLANConfig1Timeouts = map[string]time.Duration{}
+
+// PerformAction performs the named action of the service, marshalling request
+// as its arguments and unmarshalling its results into response, which are
+// pointers to structs with string fields such as the generated request and
+// response types. It is the low-level call made by the action methods, for
+// actions or arguments that the generated methods do not cover.
+func (client *WFAWLANConfig1) PerformAction(ctx context.Context, actionName string, request, response interface{}) error {
+	ctx, cancel := client.ActionContext(ctx, actionName, WFAWLANConfig1Timeouts)
+	defer cancel()
+	return client.SOAPClient.PerformActionCtx(ctx, URN_WFAWLANConfig_1, actionName, request, response)
+}
+
+// WFAWLANConfig1GetDeviceInfoResponse is the response of GetDeviceInfo, with each
+// argument in its SOAP string form.
+type WFAWLANConfig1GetDeviceInfoResponse struct {
+	NewDeviceInfo string
+}
+
+// GetDeviceInfo performs the GetDeviceInfo action of the service.
+//
+// Return values:
+//   - NewDeviceInfo (out, state variable DeviceInfo)
+func (client *WFAWLANConfig1) GetDeviceInfo() (NewDeviceInfo []byte, err error) {
+	return client.GetDeviceInfoCtx(context.Background())
+}
+
+// GetDeviceInfoCtx is GetDeviceInfo with a context, to cancel or time out the call.
+func (client *WFAWLANConfig1) GetDeviceInfoCtx(ctx context.Context) (NewDeviceInfo []byte, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &WFAWLANConfig1GetDeviceInfoResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetDeviceInfo", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if NewDeviceInfo, err = soap.UnmarshalBinBase64(response.NewDeviceInfo); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// WFAWLANConfig1PutMessageRequest is the request of PutMessage, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WFAWLANConfig1PutMessageRequest struct {
+	NewInMessage string
+}
+
+// WFAWLANConfig1PutMessageResponse is the response of PutMessage, with each
+// argument in its SOAP string form.
+type WFAWLANConfig1PutMessageResponse struct {
+	NewOutMessage string
+}
+
+// PutMessage performs the PutMessage action of the service.
+//
+// Arguments:
+//   - NewInMessage (in, state variable InMessage)
+//
+// Return values:
+//   - NewOutMessage (out, state variable OutMessage)
+func (client *WFAWLANConfig1) PutMessage(NewInMessage []byte) (NewOutMessage []byte, err error) {
+	return client.PutMessageCtx(context.Background(), NewInMessage)
+}
+
+// PutMessageCtx is PutMessage with a context, to cancel or time out the call.
+func (client *WFAWLANConfig1) PutMessageCtx(ctx context.Context, NewInMessage []byte) (NewOutMessage []byte, err error) {
+	// Request structure.
+	request := &WFAWLANConfig1PutMessageRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewInMessage, err = soap.MarshalBinBase64(NewInMessage); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &WFAWLANConfig1PutMessageResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "PutMessage", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if NewOutMessage, err = soap.UnmarshalBinBase64(response.NewOutMessage); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// WFAWLANConfig1GetAPSettingsRequest is the request of GetAPSettings, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WFAWLANConfig1GetAPSettingsRequest struct {
+	NewMessage string
+}
+
+// WFAWLANConfig1GetAPSettingsResponse is the response of GetAPSettings, with each
+// argument in its SOAP string form.
+type WFAWLANConfig1GetAPSettingsResponse struct {
+	NewAPSettings string
+}
+
+// GetAPSettings performs the GetAPSettings action of the service.
+//
+// Arguments:
+//   - NewMessage (in, state variable Message)
+//
+// Return values:
+//   - NewAPSettings (out, state variable APSettings)
+func (client *WFAWLANConfig1) GetAPSettings(NewMessage []byte) (NewAPSettings []byte, err error) {
+	return client.GetAPSettingsCtx(context.Background(), NewMessage)
+}
+
+// GetAPSettingsCtx is GetAPSettings with a context, to cancel or time out the call.
+func (client *WFAWLANConfig1) GetAPSettingsCtx(ctx context.Context, NewMessage []byte) (NewAPSettings []byte, err error) {
+	// Request structure.
+	request := &WFAWLANConfig1GetAPSettingsRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewMessage, err = soap.MarshalBinBase64(NewMessage); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &WFAWLANConfig1GetAPSettingsResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetAPSettings", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if NewAPSettings, err = soap.UnmarshalBinBase64(response.NewAPSettings); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// WFAWLANConfig1SetAPSettingsRequest is the request of SetAPSettings, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WFAWLANConfig1SetAPSettingsRequest struct {
+	NewAPSettings string
+}
+
+// SetAPSettings performs the SetAPSettings action of the service.
+//
+// Arguments:
+//   - NewAPSettings (in, state variable APSettings)
+func (client *WFAWLANConfig1) SetAPSettings(NewAPSettings []byte) (err error) {
+	return client.SetAPSettingsCtx(context.Background(), NewAPSettings)
+}
+
+// SetAPSettingsCtx is SetAPSettings with a context, to cancel or time out the call.
+func (client *WFAWLANConfig1) SetAPSettingsCtx(ctx context.Context, NewAPSettings []byte) (err error) {
+	// Request structure.
+	request := &WFAWLANConfig1SetAPSettingsRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewAPSettings, err = soap.MarshalBinBase64(NewAPSettings); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SetAPSettings", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// WFAWLANConfig1DelAPSettingsRequest is the request of DelAPSettings, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WFAWLANConfig1DelAPSettingsRequest struct {
+	NewAPSettings string
+}
+
+// DelAPSettings performs the DelAPSettings action of the service.
+//
+// Arguments:
+//   - NewAPSettings (in, state variable APSettings)
+func (client *WFAWLANConfig1) DelAPSettings(NewAPSettings []byte) (err error) {
+	return client.DelAPSettingsCtx(context.Background(), NewAPSettings)
+}
+
+// DelAPSettingsCtx is DelAPSettings with a context, to cancel or time out the call.
+func (client *WFAWLANConfig1) DelAPSettingsCtx(ctx context.Context, NewAPSettings []byte) (err error) {
+	// Request structure.
+	request := &WFAWLANConfig1DelAPSettingsRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewAPSettings, err = soap.MarshalBinBase64(NewAPSettings); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "DelAPSettings", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// WFAWLANConfig1GetSTASettingsRequest is the request of GetSTASettings, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WFAWLANConfig1GetSTASettingsRequest struct {
+	NewMessage string
+}
+
+// WFAWLANConfig1GetSTASettingsResponse is the response of GetSTASettings, with each
+// argument in its SOAP string form.
+type WFAWLANConfig1GetSTASettingsResponse struct {
+	NewSTASettings string
+}
+
+// GetSTASettings performs the GetSTASettings action of the service.
+//
+// Arguments:
+//   - NewMessage (in, state variable Message)
+//
+// Return values:
+//   - NewSTASettings (out, state variable STASettings)
+func (client *WFAWLANConfig1) GetSTASettings(NewMessage []byte) (NewSTASettings []byte, err error) {
+	return client.GetSTASettingsCtx(context.Background(), NewMessage)
+}
+
+// GetSTASettingsCtx is GetSTASettings with a context, to cancel or time out the call.
+func (client *WFAWLANConfig1) GetSTASettingsCtx(ctx context.Context, NewMessage []byte) (NewSTASettings []byte, err error) {
+	// Request structure.
+	request := &WFAWLANConfig1GetSTASettingsRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewMessage, err = soap.MarshalBinBase64(NewMessage); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &WFAWLANConfig1GetSTASettingsResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "GetSTASettings", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if NewSTASettings, err = soap.UnmarshalBinBase64(response.NewSTASettings); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// WFAWLANConfig1SetSTASettingsResponse is the response of SetSTASettings, with each
+// argument in its SOAP string form.
+type WFAWLANConfig1SetSTASettingsResponse struct {
+	NewSTASettings string
+}
+
+// SetSTASettings performs the SetSTASettings action of the service.
+//
+// Return values:
+//   - NewSTASettings (out, state variable STASettings)
+func (client *WFAWLANConfig1) SetSTASettings() (NewSTASettings []byte, err error) {
+	return client.SetSTASettingsCtx(context.Background())
+}
+
+// SetSTASettingsCtx is SetSTASettings with a context, to cancel or time out the call.
+func (client *WFAWLANConfig1) SetSTASettingsCtx(ctx context.Context) (NewSTASettings []byte, err error) {
+	// Request structure.
+	request := interface{}(nil)
+	// BEGIN Marshal arguments into request.
+
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := &WFAWLANConfig1SetSTASettingsResponse{}
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SetSTASettings", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	if NewSTASettings, err = soap.UnmarshalBinBase64(response.NewSTASettings); err != nil {
+		return
+	}
+	// END Unmarshal arguments from response.
+	return
+}
+
+// WFAWLANConfig1DelSTASettingsRequest is the request of DelSTASettings, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WFAWLANConfig1DelSTASettingsRequest struct {
+	NewSTASettings string
+}
+
+// DelSTASettings performs the DelSTASettings action of the service.
+//
+// Arguments:
+//   - NewSTASettings (in, state variable STASettings)
+func (client *WFAWLANConfig1) DelSTASettings(NewSTASettings []byte) (err error) {
+	return client.DelSTASettingsCtx(context.Background(), NewSTASettings)
+}
+
+// DelSTASettingsCtx is DelSTASettings with a context, to cancel or time out the call.
+func (client *WFAWLANConfig1) DelSTASettingsCtx(ctx context.Context, NewSTASettings []byte) (err error) {
+	// Request structure.
+	request := &WFAWLANConfig1DelSTASettingsRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewSTASettings, err = soap.MarshalBinBase64(NewSTASettings); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "DelSTASettings", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// WFAWLANConfig1PutWLANResponseRequest is the request of PutWLANResponse, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WFAWLANConfig1PutWLANResponseRequest struct {
+	NewMessage       string
+	NewWLANEventType string
+	NewWLANEventMAC  string
+}
+
+// PutWLANResponse performs the PutWLANResponse action of the service.
+//
+// Arguments:
+//   - NewMessage (in, state variable Message)
+//   - NewWLANEventType (in, state variable WLANEventType)
+//   - NewWLANEventMAC (in, state variable WLANEventMAC)
+func (client *WFAWLANConfig1) PutWLANResponse(NewMessage []byte, NewWLANEventType uint8, NewWLANEventMAC string) (err error) {
+	return client.PutWLANResponseCtx(context.Background(), NewMessage, NewWLANEventType, NewWLANEventMAC)
+}
+
+// PutWLANResponseCtx is PutWLANResponse with a context, to cancel or time out the call.
+func (client *WFAWLANConfig1) PutWLANResponseCtx(ctx context.Context, NewMessage []byte, NewWLANEventType uint8, NewWLANEventMAC string) (err error) {
+	// Request structure.
+	request := &WFAWLANConfig1PutWLANResponseRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewMessage, err = soap.MarshalBinBase64(NewMessage); err != nil {
+		return
+	}
+	if request.NewWLANEventType, err = soap.MarshalUi1(NewWLANEventType); err != nil {
+		return
+	}
+	if request.NewWLANEventMAC, err = soap.MarshalString(NewWLANEventMAC); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "PutWLANResponse", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// WFAWLANConfig1SetSelectedRegistrarRequest is the request of SetSelectedRegistrar, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WFAWLANConfig1SetSelectedRegistrarRequest struct {
+	NewMessage string
+}
+
+// SetSelectedRegistrar performs the SetSelectedRegistrar action of the service.
+//
+// Arguments:
+//   - NewMessage (in, state variable Message)
+func (client *WFAWLANConfig1) SetSelectedRegistrar(NewMessage []byte) (err error) {
+	return client.SetSelectedRegistrarCtx(context.Background(), NewMessage)
+}
+
+// SetSelectedRegistrarCtx is SetSelectedRegistrar with a context, to cancel or time out the call.
+func (client *WFAWLANConfig1) SetSelectedRegistrarCtx(ctx context.Context, NewMessage []byte) (err error) {
+	// Request structure.
+	request := &WFAWLANConfig1SetSelectedRegistrarRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewMessage, err = soap.MarshalBinBase64(NewMessage); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "SetSelectedRegistrar", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// WFAWLANConfig1RebootAPRequest is the request of RebootAP, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WFAWLANConfig1RebootAPRequest struct {
+	NewAPSettings string
+}
+
+// RebootAP performs the RebootAP action of the service.
+//
+// Arguments:
+//   - NewAPSettings (in, state variable APSettings)
+func (client *WFAWLANConfig1) RebootAP(NewAPSettings []byte) (err error) {
+	return client.RebootAPCtx(context.Background(), NewAPSettings)
+}
+
+// RebootAPCtx is RebootAP with a context, to cancel or time out the call.
+func (client *WFAWLANConfig1) RebootAPCtx(ctx context.Context, NewAPSettings []byte) (err error) {
+	// Request structure.
+	request := &WFAWLANConfig1RebootAPRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewAPSettings, err = soap.MarshalBinBase64(NewAPSettings); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "RebootAP", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// WFAWLANConfig1ResetAPRequest is the request of ResetAP, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WFAWLANConfig1ResetAPRequest struct {
+	NewMessage string
+}
+
+// ResetAP performs the ResetAP action of the service.
+//
+// Arguments:
+//   - NewMessage (in, state variable Message)
+func (client *WFAWLANConfig1) ResetAP(NewMessage []byte) (err error) {
+	return client.ResetAPCtx(context.Background(), NewMessage)
+}
+
+// ResetAPCtx is ResetAP with a context, to cancel or time out the call.
+func (client *WFAWLANConfig1) ResetAPCtx(ctx context.Context, NewMessage []byte) (err error) {
+	// Request structure.
+	request := &WFAWLANConfig1ResetAPRequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewMessage, err = soap.MarshalBinBase64(NewMessage); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "ResetAP", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// WFAWLANConfig1RebootSTARequest is the request of RebootSTA, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WFAWLANConfig1RebootSTARequest struct {
+	NewSTASettings string
+}
+
+// RebootSTA performs the RebootSTA action of the service.
+//
+// Arguments:
+//   - NewSTASettings (in, state variable STASettings)
+func (client *WFAWLANConfig1) RebootSTA(NewSTASettings []byte) (err error) {
+	return client.RebootSTACtx(context.Background(), NewSTASettings)
+}
+
+// RebootSTACtx is RebootSTA with a context, to cancel or time out the call.
+func (client *WFAWLANConfig1) RebootSTACtx(ctx context.Context, NewSTASettings []byte) (err error) {
+	// Request structure.
+	request := &WFAWLANConfig1RebootSTARequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewSTASettings, err = soap.MarshalBinBase64(NewSTASettings); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "RebootSTA", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
+
+// WFAWLANConfig1ResetSTARequest is the request of ResetSTA, with each
+// argument in its SOAP string form. Embed it in a struct to add arguments.
+type WFAWLANConfig1ResetSTARequest struct {
+	NewMessage string
+}
+
+// ResetSTA performs the ResetSTA action of the service.
+//
+// Arguments:
+//   - NewMessage (in, state variable Message)
+func (client *WFAWLANConfig1) ResetSTA(NewMessage []byte) (err error) {
+	return client.ResetSTACtx(context.Background(), NewMessage)
+}
+
+// ResetSTACtx is ResetSTA with a context, to cancel or time out the call.
+func (client *WFAWLANConfig1) ResetSTACtx(ctx context.Context, NewMessage []byte) (err error) {
+	// Request structure.
+	request := &WFAWLANConfig1ResetSTARequest{}
+	// BEGIN Marshal arguments into request.
+
+	if request.NewMessage, err = soap.MarshalBinBase64(NewMessage); err != nil {
+		return
+	}
+	// END Marshal arguments into request.
+
+	// Response structure.
+	response := interface{}(nil)
+
+	// Perform the SOAP call.
+	if err = client.PerformAction(ctx, "ResetSTA", request, response); err != nil {
+		return
+	}
+
+	// BEGIN Unmarshal arguments from response.
+
+	// END Unmarshal arguments from response.
+	return
+}
@@ -0,0 +1,485 @@
+package wfadevice1
+
+// Generated file - do not edit by hand. See README.md
+
+import (
+	"context"
+	"net/url"
+	"time"
+
+	"github.com/huin/goupnp/device"
+	"github.com/huin/goupnp/soap"
+)
+
+// Hack to avoid Go complaining if url or time aren't used.
+var _ *url.URL
+var _ time.Time
+
+// WFAWLANConfig1Handler implements the actions of a hosted UPnP SOAP service
+// with URN "urn:schemas-wifialliance-org:service:WFAWLANConfig:1". See RegisterWFAWLANConfig1Handler.
+//
+// Returning a *soap.UPnPError from a method reports that error code to the
+// control point, other errors are reported as soap.ErrCodeActionFailed.
+type WFAWLANConfig1Handler interface {
+	GetDeviceInfo(ctx context.Context) (NewDeviceInfo []byte, err error)
+
+	PutMessage(ctx context.Context, NewInMessage []byte) (NewOutMessage []byte, err error)
+
+	GetAPSettings(ctx context.Context, NewMessage []byte) (NewAPSettings []byte, err error)
+
+	SetAPSettings(ctx context.Context, NewAPSettings []byte) (err error)
+
+	DelAPSettings(ctx context.Context, NewAPSettings []byte) (err error)
+
+	GetSTASettings(ctx context.Context, NewMessage []byte) (NewSTASettings []byte, err error)
+
+	SetSTASettings(ctx context.Context) (NewSTASettings []byte, err error)
+
+	DelSTASettings(ctx context.Context, NewSTASettings []byte) (err error)
+
+	PutWLANResponse(ctx context.Context, NewMessage []byte, NewWLANEventType uint8, NewWLANEventMAC string) (err error)
+
+	SetSelectedRegistrar(ctx context.Context, NewMessage []byte) (err error)
+
+	RebootAP(ctx context.Context, NewAPSettings []byte) (err error)
+
+	ResetAP(ctx context.Context, NewMessage []byte) (err error)
+
+	RebootSTA(ctx context.Context, NewSTASettings []byte) (err error)
+
+	ResetSTA(ctx context.Context, NewMessage []byte) (err error)
+}
+
+// RegisterWFAWLANConfig1Handler registers handler as the handler of every
+// action of svc, which must be a hosted service of type URN_WFAWLANConfig_1.
+func RegisterWFAWLANConfig1Handler(svc *device.Service, handler WFAWLANConfig1Handler) {
+	svc.HandleFunc("GetDeviceInfo", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWFAWLANConfig1GetDeviceInfo(ctx, handler, in)
+	})
+	svc.HandleFunc("PutMessage", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWFAWLANConfig1PutMessage(ctx, handler, in)
+	})
+	svc.HandleFunc("GetAPSettings", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWFAWLANConfig1GetAPSettings(ctx, handler, in)
+	})
+	svc.HandleFunc("SetAPSettings", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWFAWLANConfig1SetAPSettings(ctx, handler, in)
+	})
+	svc.HandleFunc("DelAPSettings", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWFAWLANConfig1DelAPSettings(ctx, handler, in)
+	})
+	svc.HandleFunc("GetSTASettings", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWFAWLANConfig1GetSTASettings(ctx, handler, in)
+	})
+	svc.HandleFunc("SetSTASettings", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWFAWLANConfig1SetSTASettings(ctx, handler, in)
+	})
+	svc.HandleFunc("DelSTASettings", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWFAWLANConfig1DelSTASettings(ctx, handler, in)
+	})
+	svc.HandleFunc("PutWLANResponse", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWFAWLANConfig1PutWLANResponse(ctx, handler, in)
+	})
+	svc.HandleFunc("SetSelectedRegistrar", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWFAWLANConfig1SetSelectedRegistrar(ctx, handler, in)
+	})
+	svc.HandleFunc("RebootAP", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWFAWLANConfig1RebootAP(ctx, handler, in)
+	})
+	svc.HandleFunc("ResetAP", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWFAWLANConfig1ResetAP(ctx, handler, in)
+	})
+	svc.HandleFunc("RebootSTA", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWFAWLANConfig1RebootSTA(ctx, handler, in)
+	})
+	svc.HandleFunc("ResetSTA", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return serveWFAWLANConfig1ResetSTA(ctx, handler, in)
+	})
+}
+
+func serveWFAWLANConfig1GetDeviceInfo(ctx context.Context, handler WFAWLANConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewDeviceInfo []byte
+	if NewDeviceInfo, err = handler.GetDeviceInfo(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewDeviceInfo"
+	if out[0].Value, err = soap.MarshalBinBase64(NewDeviceInfo); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWFAWLANConfig1PutMessage(ctx context.Context, handler WFAWLANConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewInMessage []byte
+	if value, err = soap.FindArg(in, "NewInMessage"); err != nil {
+		return
+	}
+	if NewInMessage, err = soap.UnmarshalBinBase64(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewInMessage: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewOutMessage []byte
+	if NewOutMessage, err = handler.PutMessage(ctx, NewInMessage); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewOutMessage"
+	if out[0].Value, err = soap.MarshalBinBase64(NewOutMessage); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWFAWLANConfig1GetAPSettings(ctx context.Context, handler WFAWLANConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewMessage []byte
+	if value, err = soap.FindArg(in, "NewMessage"); err != nil {
+		return
+	}
+	if NewMessage, err = soap.UnmarshalBinBase64(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewMessage: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewAPSettings []byte
+	if NewAPSettings, err = handler.GetAPSettings(ctx, NewMessage); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewAPSettings"
+	if out[0].Value, err = soap.MarshalBinBase64(NewAPSettings); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWFAWLANConfig1SetAPSettings(ctx context.Context, handler WFAWLANConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewAPSettings []byte
+	if value, err = soap.FindArg(in, "NewAPSettings"); err != nil {
+		return
+	}
+	if NewAPSettings, err = soap.UnmarshalBinBase64(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewAPSettings: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetAPSettings(ctx, NewAPSettings); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWFAWLANConfig1DelAPSettings(ctx context.Context, handler WFAWLANConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewAPSettings []byte
+	if value, err = soap.FindArg(in, "NewAPSettings"); err != nil {
+		return
+	}
+	if NewAPSettings, err = soap.UnmarshalBinBase64(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewAPSettings: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.DelAPSettings(ctx, NewAPSettings); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWFAWLANConfig1GetSTASettings(ctx context.Context, handler WFAWLANConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewMessage []byte
+	if value, err = soap.FindArg(in, "NewMessage"); err != nil {
+		return
+	}
+	if NewMessage, err = soap.UnmarshalBinBase64(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewMessage: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewSTASettings []byte
+	if NewSTASettings, err = handler.GetSTASettings(ctx, NewMessage); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewSTASettings"
+	if out[0].Value, err = soap.MarshalBinBase64(NewSTASettings); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWFAWLANConfig1SetSTASettings(ctx context.Context, handler WFAWLANConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	var NewSTASettings []byte
+	if NewSTASettings, err = handler.SetSTASettings(ctx); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 1)
+
+	out[0].Name = "NewSTASettings"
+	if out[0].Value, err = soap.MarshalBinBase64(NewSTASettings); err != nil {
+		return
+	}
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWFAWLANConfig1DelSTASettings(ctx context.Context, handler WFAWLANConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewSTASettings []byte
+	if value, err = soap.FindArg(in, "NewSTASettings"); err != nil {
+		return
+	}
+	if NewSTASettings, err = soap.UnmarshalBinBase64(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewSTASettings: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.DelSTASettings(ctx, NewSTASettings); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWFAWLANConfig1PutWLANResponse(ctx context.Context, handler WFAWLANConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewMessage []byte
+	if value, err = soap.FindArg(in, "NewMessage"); err != nil {
+		return
+	}
+	if NewMessage, err = soap.UnmarshalBinBase64(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewMessage: "+err.Error())
+	}
+	var NewWLANEventType uint8
+	if value, err = soap.FindArg(in, "NewWLANEventType"); err != nil {
+		return
+	}
+	if NewWLANEventType, err = soap.UnmarshalUi1(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewWLANEventType: "+err.Error())
+	}
+	var NewWLANEventMAC string
+	if value, err = soap.FindArg(in, "NewWLANEventMAC"); err != nil {
+		return
+	}
+	if NewWLANEventMAC, err = soap.UnmarshalString(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewWLANEventMAC: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.PutWLANResponse(ctx, NewMessage, NewWLANEventType, NewWLANEventMAC); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWFAWLANConfig1SetSelectedRegistrar(ctx context.Context, handler WFAWLANConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewMessage []byte
+	if value, err = soap.FindArg(in, "NewMessage"); err != nil {
+		return
+	}
+	if NewMessage, err = soap.UnmarshalBinBase64(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewMessage: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.SetSelectedRegistrar(ctx, NewMessage); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWFAWLANConfig1RebootAP(ctx context.Context, handler WFAWLANConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewAPSettings []byte
+	if value, err = soap.FindArg(in, "NewAPSettings"); err != nil {
+		return
+	}
+	if NewAPSettings, err = soap.UnmarshalBinBase64(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewAPSettings: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.RebootAP(ctx, NewAPSettings); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWFAWLANConfig1ResetAP(ctx context.Context, handler WFAWLANConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewMessage []byte
+	if value, err = soap.FindArg(in, "NewMessage"); err != nil {
+		return
+	}
+	if NewMessage, err = soap.UnmarshalBinBase64(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewMessage: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.ResetAP(ctx, NewMessage); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWFAWLANConfig1RebootSTA(ctx context.Context, handler WFAWLANConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewSTASettings []byte
+	if value, err = soap.FindArg(in, "NewSTASettings"); err != nil {
+		return
+	}
+	if NewSTASettings, err = soap.UnmarshalBinBase64(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewSTASettings: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.RebootSTA(ctx, NewSTASettings); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
+
+func serveWFAWLANConfig1ResetSTA(ctx context.Context, handler WFAWLANConfig1Handler, in []soap.Arg) (out []soap.Arg, err error) {
+	// BEGIN Unmarshal arguments from request.
+	var value string
+
+	var NewMessage []byte
+	if value, err = soap.FindArg(in, "NewMessage"); err != nil {
+		return
+	}
+	if NewMessage, err = soap.UnmarshalBinBase64(value); err != nil {
+		return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad value for argument NewMessage: "+err.Error())
+	}
+	// END Unmarshal arguments from request.
+
+	// Call the handler.
+
+	if err = handler.ResetSTA(ctx, NewMessage); err != nil {
+		return
+	}
+
+	// BEGIN Marshal arguments into response.
+	out = make([]soap.Arg, 0)
+
+	// END Marshal arguments into response.
+	return
+}
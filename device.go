@@ -3,6 +3,7 @@
 package goupnp
 
 import (
+	"context"
 	"encoding/xml"
 	"errors"
 	"fmt"
@@ -23,8 +24,10 @@ type RootDevice struct {
 	XMLName     xml.Name    `xml:"root"`
 	SpecVersion SpecVersion `xml:"specVersion"`
 	URLBase     url.URL     `xml:"-"`
-	URLBaseStr  string      `xml:"URLBase"`
+	URLBaseStr  string      `xml:"URLBase,omitempty"`
 	Device      Device      `xml:"device"`
+
+	quirks Quirks
 }
 
 // SetURLBase sets the URLBase for the RootDevice and its underlying components.
@@ -150,18 +153,26 @@ func (srv *Service) String() string {
 // RequestSCDP requests the SCPD (soap actions and state variables description)
 // for the service.
 func (srv *Service) RequestSCDP() (*scpd.SCPD, error) {
+	return srv.RequestSCDPCtx(context.Background())
+}
+
+// RequestSCDPCtx is as RequestSCDP, fetching the SCPD with ctx and tracing
+// the fetch as a child of the span of ctx.
+func (srv *Service) RequestSCDPCtx(ctx context.Context) (*scpd.SCPD, error) {
 	if !srv.SCPDURL.Ok {
 		return nil, errors.New("bad/missing SCPD URL, or no URLBase has been set")
 	}
 	s := new(scpd.SCPD)
-	if err := requestXml(srv.SCPDURL.URL.String(), scpd.SCPDXMLNamespace, s); err != nil {
+	if err := requestXml(ctx, newDiscoveryConfig(nil).httpClient(), srv.SCPDURL.URL.String(), scpd.SCPDXMLNamespace, s); err != nil {
 		return nil, err
 	}
 	return s, nil
 }
 
-func (srv *Service) NewSOAPClient() *soap.SOAPClient {
-	return soap.NewSOAPClient(srv.ControlURL.URL)
+// NewSOAPClient returns a client of the control URL of the service,
+// configured by opts.
+func (srv *Service) NewSOAPClient(opts ...soap.ClientOption) *soap.SOAPClient {
+	return soap.NewSOAPClient(srv.ControlURL.URL, opts...)
 }
 
 // URLField is a URL that is part of a device description.
@@ -0,0 +1,295 @@
+package device
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/huin/goupnp/scpd"
+	"github.com/huin/goupnp/soap"
+)
+
+var (
+	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+	errorType   = reflect.TypeOf((*error)(nil)).Elem()
+)
+
+// HandleAction registers fn as the handler for the named action, with the
+// arguments converted to and from structs. fn must be of the form:
+//
+//	func(ctx context.Context, in In) (Out, E)
+//
+// where In and Out are structs or pointers to structs, and E is error or a
+// type that implements it, such as *soap.UPnPError. The exported fields of In
+// and Out are the input and output arguments of the action, named as for
+// soap.SOAPClient.PerformAction: by the field name, or by a `soap:"..."` tag.
+// Output arguments are sent in field order.
+//
+// Fields may be strings, bools, integers or floats. These are converted as
+// by soap.MarshalArgs, as the data types of the state variables of the
+// arguments if the service's SCPD has been set, otherwise as the UPnP types of
+// the same size, e.g a uint32 field as a "ui4". Fields tagged `soap:"-"` are
+// ignored. Input arguments that are missing or cannot be converted are
+// reported to the control point as soap.ErrCodeInvalidArgs.
+//
+// If the service's SCPD has been set, the fields must agree with the
+// arguments of the action in it.
+func (svc *Service) HandleAction(action string, fn interface{}) error {
+	h, err := newTypedHandler(fn)
+	if err != nil {
+		return fmt.Errorf("device: handler for action %s: %v", action, err)
+	}
+	if s := svc.SCPD(); s != nil {
+		if err := h.check(s, s.GetAction(action)); err != nil {
+			return fmt.Errorf("device: handler for action %s: %v", action, err)
+		}
+	}
+	svc.Handle(action, h)
+	return nil
+}
+
+// typedHandler is an ActionHandler that calls a function taking and returning
+// argument structs.
+type typedHandler struct {
+	fn reflect.Value
+	// in is the struct type of the input arguments, passed by pointer if
+	// inPtr is set.
+	in        reflect.Type
+	inPtr     bool
+	inFields  []argField
+	outFields []argField
+}
+
+type argField struct {
+	name  string
+	index int
+	// dataType is the data type of the state variable of the argument, or
+	// "" if the SCPD is not known.
+	dataType string
+}
+
+func newTypedHandler(fn interface{}) (*typedHandler, error) {
+	v := reflect.ValueOf(fn)
+	if !v.IsValid() || (v.Kind() == reflect.Func && v.IsNil()) {
+		return nil, fmt.Errorf("handler is nil")
+	}
+	t := v.Type()
+	if t.Kind() != reflect.Func || t.NumIn() != 2 || t.NumOut() != 2 ||
+		t.In(0) != contextType || !t.Out(1).Implements(errorType) {
+		return nil, fmt.Errorf("%v is not of the form func(context.Context, In) (Out, error)", t)
+	}
+
+	h := &typedHandler{fn: v}
+	var err error
+	if h.in, h.inPtr, h.inFields, err = argFields(t.In(1)); err != nil {
+		return nil, err
+	}
+	if _, _, h.outFields, err = argFields(t.Out(0)); err != nil {
+		return nil, err
+	}
+	return h, nil
+}
+
+// argFields returns the struct type underlying t, whether t is a pointer to
+// it, and its argument fields.
+func argFields(t reflect.Type) (reflect.Type, bool, []argField, error) {
+	ptr := t.Kind() == reflect.Ptr
+	if ptr {
+		t = t.Elem()
+	}
+	if t.Kind() != reflect.Struct {
+		return nil, false, nil, fmt.Errorf("argument type %v is not a struct", t)
+	}
+	var fields []argField
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" {
+			continue
+		}
+		name := f.Name
+		if tag := f.Tag.Get("soap"); tag == "-" {
+			continue
+		} else if tag != "" {
+			name = tag
+		}
+		switch f.Type.Kind() {
+		case reflect.String, reflect.Bool,
+			reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+		default:
+			return nil, false, nil, fmt.Errorf("argument %s is of unsupported type %v", name, f.Type)
+		}
+		fields = append(fields, argField{name: name, index: i})
+	}
+	return t, ptr, fields, nil
+}
+
+// check returns an error if the argument fields do not agree with action of
+// s, and otherwise takes the data types of the fields from s.
+func (h *typedHandler) check(s *scpd.SCPD, action *scpd.Action) error {
+	if action == nil {
+		return fmt.Errorf("action is not in the SCPD")
+	}
+	if err := checkFields(s, h.inFields, action.InputArguments()); err != nil {
+		return fmt.Errorf("input arguments: %v", err)
+	}
+	if err := checkFields(s, h.outFields, action.OutputArguments()); err != nil {
+		return fmt.Errorf("output arguments: %v", err)
+	}
+	return nil
+}
+
+func checkFields(s *scpd.SCPD, fields []argField, args []*scpd.Argument) error {
+	if len(fields) != len(args) {
+		return fmt.Errorf("have %d fields, SCPD has %d arguments", len(fields), len(args))
+	}
+	for i, f := range fields {
+		if f.name != args[i].Name {
+			return fmt.Errorf("field %d is %s, SCPD has %s", i, f.name, args[i].Name)
+		}
+	}
+	for i := range fields {
+		if sv := s.GetStateVariable(args[i].RelatedStateVariable); sv != nil {
+			fields[i].dataType = sv.DataType.Name
+		}
+	}
+	return nil
+}
+
+func (h *typedHandler) ServeAction(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+	values := make(map[string]string, len(in))
+	for _, arg := range in {
+		values[arg.Name] = arg.Value
+	}
+	inValue := reflect.New(h.in)
+	for _, f := range h.inFields {
+		s, ok := values[f.name]
+		if !ok {
+			return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "missing argument "+f.name)
+		}
+		if err := soap.UnmarshalValue(f.dataType, s, inValue.Elem().Field(f.index)); err != nil {
+			return nil, soap.NewUPnPError(soap.ErrCodeInvalidArgs, fmt.Sprintf("bad value for argument %s: %v", f.name, err))
+		}
+	}
+	if !h.inPtr {
+		inValue = inValue.Elem()
+	}
+
+	results := h.fn.Call([]reflect.Value{reflect.ValueOf(ctx), inValue})
+	// Check for nil before converting to error, as a nil *soap.UPnPError
+	// would otherwise be a non-nil error.
+	if errValue := results[1]; !isNil(errValue) {
+		return nil, errValue.Interface().(error)
+	}
+	outValue := reflect.Indirect(results[0])
+	if !outValue.IsValid() {
+		// A nil *Out sends no output arguments.
+		return nil, nil
+	}
+	out := make([]soap.Arg, len(h.outFields))
+	for i, f := range h.outFields {
+		value, err := soap.MarshalValue(f.dataType, outValue.Field(f.index))
+		if err != nil {
+			return nil, fmt.Errorf("bad value for output argument %s: %v", f.name, err)
+		}
+		out[i] = soap.Arg{Name: f.name, Value: value}
+	}
+	return out, nil
+}
+
+func isNil(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface, reflect.Map, reflect.Slice:
+		return v.IsNil()
+	}
+	return false
+}
+
+// validateArgs checks the input arguments of an action invocation against
+// the SCPD, returning a *soap.UPnPError if they are invalid.
+func validateArgs(s *scpd.SCPD, action *scpd.Action, in []soap.Arg) error {
+	args := action.InputArguments()
+	if len(in) != len(args) {
+		return soap.NewUPnPError(soap.ErrCodeInvalidArgs, fmt.Sprintf("want %d arguments, got %d", len(args), len(in)))
+	}
+	values := make(map[string]string, len(in))
+	for _, arg := range in {
+		values[arg.Name] = arg.Value
+	}
+	for _, arg := range args {
+		value, ok := values[arg.Name]
+		if !ok {
+			return soap.NewUPnPError(soap.ErrCodeInvalidArgs, "missing argument "+arg.Name)
+		}
+		sv := s.GetStateVariable(arg.RelatedStateVariable)
+		if sv == nil {
+			continue
+		}
+		if err := validateValue(sv, value); err != nil {
+			return soap.NewUPnPError(err.Code, fmt.Sprintf("argument %s: %s", arg.Name, err.Description))
+		}
+	}
+	return nil
+}
+
+// intTypeBits is the size of each UPnP integer type.
+var intTypeBits = map[string]int{
+	"ui1": 8, "ui2": 16, "ui4": 32, "ui8": 64,
+	"i1": 8, "i2": 16, "i4": 32, "i8": 64, "int": 64,
+}
+
+// validateValue checks value against the data type, allowed values and
+// allowed range of sv.
+func validateValue(sv *scpd.StateVariable, value string) *soap.UPnPError {
+	if len(sv.AllowedValues) > 0 {
+		for _, allowed := range sv.AllowedValues {
+			if value == allowed {
+				return nil
+			}
+		}
+		return soap.NewUPnPError(soap.ErrCodeArgumentValueInvalid, fmt.Sprintf("%q is not an allowed value", value))
+	}
+
+	var f float64
+	var err error
+	switch name := sv.DataType.Name; name {
+	case "ui1", "ui2", "ui4", "ui8":
+		var u uint64
+		u, err = strconv.ParseUint(strings.TrimSpace(value), 10, intTypeBits[name])
+		f = float64(u)
+	case "i1", "i2", "i4", "i8", "int":
+		var i int64
+		i, err = strconv.ParseInt(strings.TrimSpace(value), 10, intTypeBits[name])
+		f = float64(i)
+	case "r4", "r8", "number", "float", "fixed.14.4":
+		f, err = strconv.ParseFloat(strings.TrimSpace(value), 64)
+	case "boolean":
+		_, err = soap.UnmarshalBoolean(value)
+		return argTypeError(value, sv, err)
+	default:
+		return nil
+	}
+	if err != nil {
+		return argTypeError(value, sv, err)
+	}
+
+	if r := sv.AllowedValueRange; r != nil {
+		if min, err := strconv.ParseFloat(r.Minimum, 64); err == nil && f < min {
+			return soap.NewUPnPError(soap.ErrCodeArgumentValueOutOfRange, fmt.Sprintf("%s is below minimum %s", value, r.Minimum))
+		}
+		if max, err := strconv.ParseFloat(r.Maximum, 64); err == nil && f > max {
+			return soap.NewUPnPError(soap.ErrCodeArgumentValueOutOfRange, fmt.Sprintf("%s is above maximum %s", value, r.Maximum))
+		}
+	}
+	return nil
+}
+
+func argTypeError(value string, sv *scpd.StateVariable, err error) *soap.UPnPError {
+	if err == nil {
+		return nil
+	}
+	return soap.NewUPnPError(soap.ErrCodeInvalidArgs, fmt.Sprintf("%q is not a valid %s", value, sv.DataType.Name))
+}
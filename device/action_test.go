@@ -0,0 +1,145 @@
+package device
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/huin/goupnp/scpd"
+	"github.com/huin/goupnp/soap"
+)
+
+type setLevelArgs struct {
+	Level  uint8  `soap:"NewLevel"`
+	Fade   bool   `soap:"NewFade"`
+	Caller string `soap:"-"`
+}
+
+type setLevelResult struct {
+	Level uint8 `soap:"OldLevel"`
+}
+
+var levelSCPD = &scpd.SCPD{
+	Actions: []scpd.Action{{
+		Name: "SetLevel",
+		Arguments: []scpd.Argument{
+			{Name: "NewLevel", Direction: "in", RelatedStateVariable: "Level"},
+			{Name: "NewFade", Direction: "in", RelatedStateVariable: "Fade"},
+			{Name: "OldLevel", Direction: "out", RelatedStateVariable: "Level"},
+		},
+	}},
+	StateVariables: []scpd.StateVariable{
+		{
+			Name:              "Level",
+			DataType:          scpd.DataType{Name: "ui1"},
+			AllowedValueRange: &scpd.AllowedValueRange{Minimum: "0", Maximum: "100"},
+		},
+		{Name: "Fade", DataType: scpd.DataType{Name: "boolean"}},
+	},
+}
+
+// args returns the soap.Args for the given name, value pairs.
+func args(nameValues ...string) []soap.Arg {
+	var result []soap.Arg
+	for i := 0; i < len(nameValues); i += 2 {
+		result = append(result, soap.Arg{Name: nameValues[i], Value: nameValues[i+1]})
+	}
+	return result
+}
+
+func TestHandleAction(t *testing.T) {
+	svc := &Service{actions: make(map[string]ActionHandler)}
+	svc.SetSCPD(levelSCPD)
+	level := uint8(10)
+	err := svc.HandleAction("SetLevel", func(ctx context.Context, in *setLevelArgs) (setLevelResult, *soap.UPnPError) {
+		if !in.Fade {
+			return setLevelResult{}, soap.NewUPnPError(701, "fade required")
+		}
+		old := level
+		level = in.Level
+		return setLevelResult{Level: old}, nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := svc.handler("SetLevel")
+
+	out, err := h.ServeAction(context.Background(), args("NewLevel", "50", "NewFade", "1"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := args("OldLevel", "10"); !reflect.DeepEqual(out, want) {
+		t.Errorf("Bad output\nwant: %+v\n got: %+v", want, out)
+	}
+	if level != 50 {
+		t.Errorf("want level 50, got %d", level)
+	}
+
+	tests := []struct {
+		name string
+		in   []soap.Arg
+		code int
+	}{
+		{"handler error", args("NewLevel", "50", "NewFade", "0"), 701},
+		{"missing arg", args("NewLevel", "50"), soap.ErrCodeInvalidArgs},
+		{"bad type", args("NewLevel", "x", "NewFade", "1"), soap.ErrCodeInvalidArgs},
+	}
+	for _, test := range tests {
+		_, err := h.ServeAction(context.Background(), test.in)
+		if upnpErr, ok := err.(*soap.UPnPError); !ok || upnpErr.Code != test.code {
+			t.Errorf("%s: want UPnP error %d, got %v", test.name, test.code, err)
+		}
+	}
+
+	if err := svc.HandleAction("SetLevel", func(ctx context.Context, in setLevelArgs) (*struct{}, error) {
+		return nil, nil
+	}); err == nil {
+		t.Error("want error for handler disagreeing with SCPD, got nil")
+	}
+	if err := svc.HandleAction("SetLevel", func(in setLevelArgs) error { return nil }); err == nil {
+		t.Error("want error for handler of wrong form, got nil")
+	}
+	if err := svc.HandleAction("SetLevel", nil); err == nil {
+		t.Error("want error for nil handler, got nil")
+	}
+
+	// Output arguments are converted as the data types of the SCPD.
+	if err := svc.HandleAction("SetLevel", func(ctx context.Context, in setLevelArgs) (struct {
+		Level int `soap:"OldLevel"`
+	}, error) {
+		return struct {
+			Level int `soap:"OldLevel"`
+		}{300}, nil
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.handler("SetLevel").ServeAction(context.Background(), args("NewLevel", "50", "NewFade", "1")); err == nil {
+		t.Error("want error for output argument out of range of ui1, got nil")
+	}
+}
+
+func TestValidateArgs(t *testing.T) {
+	action := levelSCPD.GetAction("SetLevel")
+	tests := []struct {
+		in   []soap.Arg
+		code int
+	}{
+		{args("NewLevel", "100", "NewFade", "0"), 0},
+		{args("NewLevel", "101", "NewFade", "0"), soap.ErrCodeArgumentValueOutOfRange},
+		{args("NewLevel", "256", "NewFade", "0"), soap.ErrCodeInvalidArgs},
+		{args("NewLevel", "1", "NewFade", "maybe"), soap.ErrCodeInvalidArgs},
+		{args("NewLevel", "1", "Other", "0"), soap.ErrCodeInvalidArgs},
+	}
+	for _, test := range tests {
+		err := validateArgs(levelSCPD, action, test.in)
+		if test.code == 0 {
+			if err != nil {
+				t.Errorf("%+v: unexpected error: %v", test.in, err)
+			}
+			continue
+		}
+		if upnpErr, ok := err.(*soap.UPnPError); !ok || upnpErr.Code != test.code {
+			t.Errorf("%+v: want UPnP error %d, got %v", test.in, test.code, err)
+		}
+	}
+}
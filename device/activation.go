@@ -0,0 +1,63 @@
+package device
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+)
+
+// listenFDsStart is the first file descriptor passed by systemd socket
+// activation.
+const listenFDsStart = 3
+
+// SystemdSockets returns the sockets passed to the process by systemd socket
+// activation, as described in sd_listen_fds(3): the stream sockets as
+// listeners, and the datagram sockets as packet connections. For a device
+// whose socket unit has, e.g.
+//
+//	ListenStream=8200
+//	ListenDatagram=239.255.255.250:1900
+//
+// the returned sockets are for the Listeners and PacketConns of a Server (see
+// WithSystemdSockets), so that it runs unprivileged, and systemd keeps the
+// SSDP socket open across its restarts. None are returned if the process was
+// not socket activated. The LISTEN_* environment variables are unset, so that
+// child processes do not take the sockets to be theirs.
+func SystemdSockets() ([]net.Listener, []net.PacketConn, error) {
+	pid, err := strconv.Atoi(os.Getenv("LISTEN_PID"))
+	if err != nil || pid != os.Getpid() {
+		return nil, nil, nil
+	}
+	n, err := strconv.Atoi(os.Getenv("LISTEN_FDS"))
+	if err != nil || n <= 0 {
+		return nil, nil, nil
+	}
+	os.Unsetenv("LISTEN_PID")
+	os.Unsetenv("LISTEN_FDS")
+	os.Unsetenv("LISTEN_FDNAMES")
+
+	var ls []net.Listener
+	var conns []net.PacketConn
+	for fd := listenFDsStart; fd < listenFDsStart+n; fd++ {
+		f := os.NewFile(uintptr(fd), "LISTEN_FD_"+strconv.Itoa(fd))
+		// The net package duplicates the descriptor, so f is closed either
+		// way.
+		if l, err := net.FileListener(f); err == nil {
+			ls = append(ls, l)
+		} else if conn, err := net.FilePacketConn(f); err == nil {
+			conns = append(conns, conn)
+		} else {
+			f.Close()
+			for _, l := range ls {
+				l.Close()
+			}
+			for _, conn := range conns {
+				conn.Close()
+			}
+			return nil, nil, fmt.Errorf("device: socket %d passed by systemd is neither a listener nor a packet connection: %v", fd, err)
+		}
+		f.Close()
+	}
+	return ls, conns, nil
+}
@@ -0,0 +1,63 @@
+package device
+
+import (
+	"fmt"
+	"net"
+	"os"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"testing"
+)
+
+func TestSystemdSockets(t *testing.T) {
+	if os.Getenv("GOUPNP_TEST_SYSTEMD_SOCKETS") == "1" {
+		// The process was started below, with the sockets at fds 3 and 4.
+		os.Setenv("LISTEN_PID", strconv.Itoa(os.Getpid()))
+		ls, conns, err := SystemdSockets()
+		if err != nil || len(ls) != 1 || len(conns) != 1 {
+			fmt.Printf("got %d listeners and %d packet conns, error %v; want 1 and 1\n", len(ls), len(conns), err)
+			os.Exit(1)
+		}
+		if os.Getenv("LISTEN_FDS") != "" {
+			fmt.Println("LISTEN_FDS is still set")
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
+	if runtime.GOOS == "windows" {
+		t.Skip("socket activation is not supported on Windows")
+	}
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	lf, err := l.(*net.TCPListener).File()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer lf.Close()
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	cf, err := conn.(*net.UDPConn).File()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cf.Close()
+
+	cmd := exec.Command(os.Args[0], "-test.run=^TestSystemdSockets$")
+	cmd.Env = append(os.Environ(), "GOUPNP_TEST_SYSTEMD_SOCKETS=1", "LISTEN_FDS=2")
+	cmd.ExtraFiles = []*os.File{lf, cf}
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("socket activated process failed: %v\n%s", err, out)
+	}
+
+	if ls, conns, err := SystemdSockets(); err != nil || len(ls) != 0 || len(conns) != 0 {
+		t.Errorf("SystemdSockets without activation = %v, %v, %v; want none", ls, conns, err)
+	}
+}
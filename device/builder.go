@@ -0,0 +1,203 @@
+package device
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/huin/goupnp"
+	"github.com/huin/goupnp/scpd"
+)
+
+// DeviceBuilder assembles the description of a device, its services and its
+// embedded devices. Its methods return the builder, so that calls can be
+// chained.
+type DeviceBuilder struct {
+	dev          goupnp.Device
+	scpds        map[string]*scpd.SCPD // Keyed by serviceId.
+	devices      []*DeviceBuilder
+	presentation http.Handler
+}
+
+// NewDeviceBuilder creates a builder for a device with the given device type
+// and friendly name. The device is given a random UDN, which should be
+// replaced using UDN by devices that persist their identity, e.g with
+// StableUDN.
+func NewDeviceBuilder(deviceType, friendlyName string) *DeviceBuilder {
+	return &DeviceBuilder{
+		dev: goupnp.Device{
+			DeviceType:   deviceType,
+			FriendlyName: friendlyName,
+			UDN:          NewUDN(),
+		},
+		scpds: make(map[string]*scpd.SCPD),
+	}
+}
+
+// UDN sets the unique device name, which must be of the form "uuid:...".
+func (b *DeviceBuilder) UDN(udn string) *DeviceBuilder {
+	b.dev.UDN = udn
+	return b
+}
+
+// Manufacturer sets the manufacturer name, and optionally its URL.
+func (b *DeviceBuilder) Manufacturer(name, url string) *DeviceBuilder {
+	b.dev.Manufacturer = name
+	b.dev.ManufacturerURL.Str = url
+	return b
+}
+
+// Model sets the model name, and optionally its number, description and URL.
+func (b *DeviceBuilder) Model(name, number, description, url string) *DeviceBuilder {
+	b.dev.ModelName = name
+	b.dev.ModelNumber = number
+	b.dev.ModelDescription = description
+	b.dev.ModelURL.Str = url
+	return b
+}
+
+// SerialNumber sets the serial number.
+func (b *DeviceBuilder) SerialNumber(serial string) *DeviceBuilder {
+	b.dev.SerialNumber = serial
+	return b
+}
+
+// UPC sets the universal product code.
+func (b *DeviceBuilder) UPC(upc string) *DeviceBuilder {
+	b.dev.UPC = upc
+	return b
+}
+
+// PresentationURL sets the URL of the device's presentation page.
+func (b *DeviceBuilder) PresentationURL(url string) *DeviceBuilder {
+	b.dev.PresentationURL.Str = url
+	return b
+}
+
+// Presentation sets the handler of the device's presentation page, as for
+// Server.HandlePresentation, replacing any URL set with PresentationURL.
+func (b *DeviceBuilder) Presentation(h http.Handler) *DeviceBuilder {
+	b.presentation = h
+	return b
+}
+
+// Icon adds an icon. url is relative to the device description.
+func (b *DeviceBuilder) Icon(mimetype string, width, height, depth int32, url string) *DeviceBuilder {
+	icon := goupnp.Icon{Mimetype: mimetype, Width: width, Height: height, Depth: depth}
+	icon.URL.Str = url
+	b.dev.Icons = append(b.dev.Icons, icon)
+	return b
+}
+
+// Service adds a service, with its SCPD. The service URLs are assigned by
+// the Server.
+func (b *DeviceBuilder) Service(serviceType, serviceID string, s *scpd.SCPD) *DeviceBuilder {
+	b.dev.Services = append(b.dev.Services, goupnp.Service{
+		ServiceType: serviceType,
+		ServiceId:   serviceID,
+	})
+	b.scpds[serviceID] = s
+	return b
+}
+
+// Device adds an embedded device.
+func (b *DeviceBuilder) Device(child *DeviceBuilder) *DeviceBuilder {
+	b.devices = append(b.devices, child)
+	return b
+}
+
+// Root validates the description, and returns it as a root device.
+func (b *DeviceBuilder) Root() (*goupnp.RootDevice, error) {
+	root := &goupnp.RootDevice{
+		SpecVersion: goupnp.SpecVersion{Major: 1, Minor: 1},
+	}
+	udns := make(map[string]bool)
+	var err error
+	root.Device, err = b.build(udns)
+	if err != nil {
+		return nil, err
+	}
+	return root, nil
+}
+
+func (b *DeviceBuilder) build(udns map[string]bool) (goupnp.Device, error) {
+	d := b.dev
+	switch {
+	case d.DeviceType == "":
+		return d, fmt.Errorf("device: device %s has no device type", d.UDN)
+	case d.FriendlyName == "":
+		return d, fmt.Errorf("device: device %s has no friendly name", d.UDN)
+	case d.Manufacturer == "":
+		return d, fmt.Errorf("device: device %s has no manufacturer", d.UDN)
+	case d.ModelName == "":
+		return d, fmt.Errorf("device: device %s has no model name", d.UDN)
+	case udns[d.UDN]:
+		return d, fmt.Errorf("device: duplicate UDN %s", d.UDN)
+	}
+	udns[d.UDN] = true
+
+	seen := make(map[string]bool)
+	for _, s := range d.Services {
+		if seen[s.ServiceId] {
+			return d, fmt.Errorf("device: device %s has duplicate serviceId %s", d.UDN, s.ServiceId)
+		}
+		seen[s.ServiceId] = true
+	}
+	// Copy the slices so that the built device does not share storage with
+	// the builder.
+	d.Icons = append([]goupnp.Icon(nil), d.Icons...)
+	d.Services = append([]goupnp.Service(nil), d.Services...)
+	d.Devices = nil
+	for _, child := range b.devices {
+		cd, err := child.build(udns)
+		if err != nil {
+			return d, err
+		}
+		d.Devices = append(d.Devices, cd)
+	}
+	return d, nil
+}
+
+// NewServer builds the root device, and creates a Server hosting it, with
+// the SCPD of each service set.
+func (b *DeviceBuilder) NewServer() (*Server, error) {
+	srv, err := NewServer()
+	if err != nil {
+		return nil, err
+	}
+	if err := b.AddTo(srv); err != nil {
+		return nil, err
+	}
+	return srv, nil
+}
+
+// AddTo builds the root device, and adds it to srv, with the SCPD of each
+// service and the presentation handler of each device set.
+func (b *DeviceBuilder) AddTo(srv *Server) error {
+	root, err := b.Root()
+	if err != nil {
+		return err
+	}
+	if err := srv.AddRoot(root); err != nil {
+		return err
+	}
+	return b.setHandlers(srv)
+}
+
+func (b *DeviceBuilder) setHandlers(srv *Server) error {
+	for serviceID, s := range b.scpds {
+		if s != nil {
+			srv.Service(b.dev.UDN, serviceID).SetSCPD(s)
+		}
+	}
+	if b.presentation != nil {
+		if err := srv.HandlePresentation(b.dev.UDN, b.presentation); err != nil {
+			return err
+		}
+	}
+	for _, child := range b.devices {
+		if err := child.setHandlers(srv); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,24 @@
+package device
+
+import (
+	"errors"
+
+	"github.com/huin/goupnp"
+	"github.com/huin/goupnp/scpd"
+)
+
+// CheckCompliance checks the hosted root devices and the SCPDs of their
+// services with goupnp.CheckCompliance, so that devices can be checked before
+// they are advertised.
+func (srv *Server) CheckCompliance() []goupnp.Finding {
+	var findings []goupnp.Finding
+	for _, root := range srv.RootDevices() {
+		findings = append(findings, goupnp.CheckCompliance(root, func(d *goupnp.Device, s *goupnp.Service) (*scpd.SCPD, error) {
+			if svc := srv.Service(d.UDN, s.ServiceId); svc != nil && svc.SCPD() != nil {
+				return svc.SCPD(), nil
+			}
+			return nil, errors.New("device: service has no SCPD")
+		})...)
+	}
+	return findings
+}
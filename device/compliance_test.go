@@ -0,0 +1,26 @@
+package device
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestServerCheckCompliance(t *testing.T) {
+	srv, err := NewServer(newTestRoot())
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv.Service("", testServiceID).SetSCPD(testSCPD)
+	var missing []string
+	for _, f := range srv.CheckCompliance() {
+		if f.Rule != "required-action" {
+			t.Errorf("unexpected finding %v", f)
+			continue
+		}
+		missing = append(missing, f.Message)
+	}
+	// The test SCPD only has the GetStatus action of SwitchPower.
+	if len(missing) != 2 || !strings.Contains(missing[0], "SetTarget") || !strings.Contains(missing[1], "GetTarget") {
+		t.Errorf("got missing actions %q, want SetTarget and GetTarget", missing)
+	}
+}
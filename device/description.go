@@ -0,0 +1,147 @@
+package device
+
+import (
+	"encoding/xml"
+
+	"github.com/huin/goupnp"
+)
+
+// The types in this file mirror those in the goupnp package, but omit empty
+// optional elements, which some control points reject, and place the
+// elements in the order required by the device description schema.
+
+type descRoot struct {
+	XMLName     xml.Name           `xml:"root"`
+	Namespace   string             `xml:"xmlns,attr"`
+	ConfigID    string             `xml:"configId,attr,omitempty"`
+	SpecVersion goupnp.SpecVersion `xml:"specVersion"`
+	URLBase     string             `xml:"URLBase,omitempty"`
+	Device      descDevice         `xml:"device"`
+}
+
+type descDevice struct {
+	DeviceType       string           `xml:"deviceType"`
+	FriendlyName     string           `xml:"friendlyName"`
+	Manufacturer     string           `xml:"manufacturer"`
+	ManufacturerURL  string           `xml:"manufacturerURL,omitempty"`
+	ModelDescription string           `xml:"modelDescription,omitempty"`
+	ModelName        string           `xml:"modelName"`
+	ModelNumber      string           `xml:"modelNumber,omitempty"`
+	ModelURL         string           `xml:"modelURL,omitempty"`
+	SerialNumber     string           `xml:"serialNumber,omitempty"`
+	UDN              string           `xml:"UDN"`
+	UPC              string           `xml:"UPC,omitempty"`
+	Icons            *descIconList    `xml:"iconList"`
+	Services         *descServiceList `xml:"serviceList"`
+	Devices          *descDeviceList  `xml:"deviceList"`
+	PresentationURL  string           `xml:"presentationURL,omitempty"`
+}
+
+// The list types are pointers in descDevice so that empty lists are omitted
+// entirely, encoding/xml writes the parent of an "a>b" path regardless.
+
+type descIconList struct {
+	Icons []descIcon `xml:"icon"`
+}
+
+type descServiceList struct {
+	Services []descService `xml:"service"`
+}
+
+type descDeviceList struct {
+	Devices []descDevice `xml:"device"`
+}
+
+type descIcon struct {
+	Mimetype string `xml:"mimetype"`
+	Width    int32  `xml:"width"`
+	Height   int32  `xml:"height"`
+	Depth    int32  `xml:"depth"`
+	URL      string `xml:"url"`
+}
+
+type descService struct {
+	ServiceType string `xml:"serviceType"`
+	ServiceID   string `xml:"serviceId"`
+	SCPDURL     string `xml:"SCPDURL"`
+	ControlURL  string `xml:"controlURL"`
+	EventSubURL string `xml:"eventSubURL"`
+}
+
+// MarshalDescription serializes root as a device description document. A
+// zero SpecVersion is written as 1.1. URLBase is written only for
+// descriptions of version 1.0, as it is deprecated from 1.1 onwards, where
+// all URLs are relative to the URL of the description.
+func MarshalDescription(root *goupnp.RootDevice) ([]byte, error) {
+	return marshalDescription(root, "")
+}
+
+// marshalDescription is as MarshalDescription, also writing the configId
+// attribute of the root element if configID is not empty.
+func marshalDescription(root *goupnp.RootDevice, configID string) ([]byte, error) {
+	desc := descRoot{
+		ConfigID:    configID,
+		Namespace:   goupnp.DeviceXMLNamespace,
+		SpecVersion: root.SpecVersion,
+		Device:      newDescDevice(&root.Device),
+	}
+	if desc.SpecVersion.Major == 0 {
+		desc.SpecVersion = goupnp.SpecVersion{Major: 1, Minor: 1}
+	}
+	if desc.SpecVersion.Major == 1 && desc.SpecVersion.Minor == 0 {
+		desc.URLBase = root.URLBaseStr
+	}
+	body, err := xml.MarshalIndent(&desc, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte(xml.Header), body...), nil
+}
+
+func newDescDevice(d *goupnp.Device) descDevice {
+	desc := descDevice{
+		DeviceType:       d.DeviceType,
+		FriendlyName:     d.FriendlyName,
+		Manufacturer:     d.Manufacturer,
+		ManufacturerURL:  d.ManufacturerURL.Str,
+		ModelDescription: d.ModelDescription,
+		ModelName:        d.ModelName,
+		ModelNumber:      d.ModelNumber,
+		ModelURL:         d.ModelURL.Str,
+		SerialNumber:     d.SerialNumber,
+		UDN:              d.UDN,
+		UPC:              d.UPC,
+		PresentationURL:  d.PresentationURL.Str,
+	}
+	if len(d.Icons) > 0 {
+		desc.Icons = new(descIconList)
+	}
+	for _, icon := range d.Icons {
+		desc.Icons.Icons = append(desc.Icons.Icons, descIcon{
+			Mimetype: icon.Mimetype,
+			Width:    icon.Width,
+			Height:   icon.Height,
+			Depth:    icon.Depth,
+			URL:      icon.URL.Str,
+		})
+	}
+	if len(d.Services) > 0 {
+		desc.Services = new(descServiceList)
+	}
+	for _, s := range d.Services {
+		desc.Services.Services = append(desc.Services.Services, descService{
+			ServiceType: s.ServiceType,
+			ServiceID:   s.ServiceId,
+			SCPDURL:     s.SCPDURL.Str,
+			ControlURL:  s.ControlURL.Str,
+			EventSubURL: s.EventSubURL.Str,
+		})
+	}
+	if len(d.Devices) > 0 {
+		desc.Devices = new(descDeviceList)
+	}
+	for i := range d.Devices {
+		desc.Devices.Devices = append(desc.Devices.Devices, newDescDevice(&d.Devices[i]))
+	}
+	return desc
+}
@@ -0,0 +1,1021 @@
+// device hosts UPnP devices, which is to say that it implements the device
+// side of UPnP: serving device descriptions, service descriptions (SCPDs),
+// SOAP control endpoints and GENA event subscriptions over a single HTTP
+// server, and advertising the devices via SSDP.
+//
+// NOTE: the interface for this is experimental and may change, or go away
+// entirely.
+package device
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"log/slog"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/huin/goupnp"
+	"github.com/huin/goupnp/clock"
+	"github.com/huin/goupnp/internal/logging"
+	"github.com/huin/goupnp/internal/netif"
+	"github.com/huin/goupnp/scpd"
+	"github.com/huin/goupnp/ssdp"
+)
+
+const (
+	rootDeviceNT = "upnp:rootdevice"
+	uuidPrefix   = "uuid:"
+	pathPrefix   = "/upnp/"
+)
+
+// Server hosts one or more root devices, including all of their embedded
+// devices and services, on a single HTTP server and SSDP advertiser.
+type Server struct {
+	// Addr is the TCP address to serve HTTP on. An automatically chosen port
+	// on all local addresses is used if empty. Only the port is used if
+	// Interfaces is set.
+	Addr string
+	// Interfaces restricts the network interfaces that ListenAndServe listens
+	// on and that the devices are advertised on. All interfaces are used if
+	// empty.
+	Interfaces []net.Interface
+	// IPv6 enables serving and advertising over IPv6 as well as IPv4. IPv6
+	// link-local addresses are advertised without a zone, as zones are only
+	// meaningful to the local host, and control points on the link will
+	// reach them via the interface that received the advertisement.
+	IPv6 bool
+	// ServerHeader is the SERVER header value used in SSDP messages and HTTP
+	// responses, ssdp.DefaultServerHeader if empty.
+	ServerHeader string
+	// BootID is the BOOTID.UPNP.ORG value. It is incremented by Serve, so
+	// should be set to the value last used, which devices should persist
+	// across restarts. The value advertised is returned by AdvertisedBootID.
+	BootID int32
+	// Logger logs the failures to advertise the devices, respond to actions
+	// and send event messages, the goupnp.SetLogger logger if nil. Set it
+	// before adding root devices.
+	Logger *slog.Logger
+	// Clock schedules re-announcements, the expiry of event subscriptions
+	// and moderated events, clock.Real if nil. Set it before adding root
+	// devices.
+	Clock clock.Clock
+	// Listeners, if not empty, are pre-opened listeners, all on the same
+	// port, that ListenAndServe serves HTTP on instead of listening itself.
+	Listeners []net.Listener
+	// AdvertisedAddr, if set, is the host and port advertised in the
+	// LOCATION of the devices, instead of the local address that each
+	// advertisement is sent from and the port served on, for deployments
+	// where control points reach the server at another address, such as
+	// the published port of a container or a reverse proxy. Either the host
+	// or the port may be empty, as in ":8200", to advertise the local one.
+	AdvertisedAddr string
+	// InterfaceAdvertisedAddrs override AdvertisedAddr for the interfaces
+	// whose names they are keyed by.
+	InterfaceAdvertisedAddrs map[string]string
+	// PacketConns, if not empty, are pre-opened UDP sockets bound to port
+	// 1900 that SSDP searches are received on, as for the Conns of
+	// ssdp.Advertiser, so that the server can run unprivileged and be
+	// restarted without the SSDP socket being closed in between.
+	PacketConns []net.PacketConn
+
+	httpServer http.Server
+	advertiser ssdp.Advertiser
+
+	lock          sync.RWMutex // Protects all below.
+	roots         []*hostedRoot
+	handlers      map[string]http.HandlerFunc // Keyed by path.
+	presentations map[string]http.Handler     // Keyed by path prefix.
+	listeners     []net.Listener
+}
+
+func (srv *Server) logger() *slog.Logger {
+	return logging.Or(srv.Logger)
+}
+
+// hostedRoot is a root device hosted by a Server.
+type hostedRoot struct {
+	root     *goupnp.RootDevice
+	descPath string
+	services []*Service
+
+	// description is the serialized description, and content is what its
+	// CONFIGID is derived from.
+	description []byte
+	content     []byte
+	configID    int32
+}
+
+// NewServer creates a Server that hosts the given root devices, as for
+// AddRoot. More root devices may be added later. It is New(WithRoots(roots...)).
+func NewServer(roots ...*goupnp.RootDevice) (*Server, error) {
+	return New(WithRoots(roots...))
+}
+
+// ServerOption configures a Server created by New.
+type ServerOption func(*serverConfig)
+
+type serverConfig struct {
+	srv   *Server
+	roots []*goupnp.RootDevice
+	err   error
+}
+
+// WithAddr sets the Addr of the server, an automatically chosen port by
+// default.
+func WithAddr(addr string) ServerOption {
+	return func(c *serverConfig) { c.srv.Addr = addr }
+}
+
+// WithInterfaces sets the Interfaces of the server, all interfaces by
+// default.
+func WithInterfaces(ifs ...net.Interface) ServerOption {
+	return func(c *serverConfig) { c.srv.Interfaces = ifs }
+}
+
+// WithIPv6 sets the IPv6 of the server, IPv4 only by default.
+func WithIPv6(ipv6 bool) ServerOption {
+	return func(c *serverConfig) { c.srv.IPv6 = ipv6 }
+}
+
+// WithServerHeader sets the ServerHeader of the server,
+// ssdp.DefaultServerHeader by default.
+func WithServerHeader(header string) ServerOption {
+	return func(c *serverConfig) { c.srv.ServerHeader = header }
+}
+
+// WithBootID sets the BootID last used by the devices, 0 by default.
+func WithBootID(bootID int32) ServerOption {
+	return func(c *serverConfig) { c.srv.BootID = bootID }
+}
+
+// WithLogger sets the Logger of the server.
+func WithLogger(l *slog.Logger) ServerOption {
+	return func(c *serverConfig) { c.srv.Logger = l }
+}
+
+// WithClock sets the Clock of the server.
+func WithClock(cl clock.Clock) ServerOption {
+	return func(c *serverConfig) { c.srv.Clock = cl }
+}
+
+// WithAdvertisedAddr sets the AdvertisedAddr of the server.
+func WithAdvertisedAddr(addr string) ServerOption {
+	return func(c *serverConfig) { c.srv.AdvertisedAddr = addr }
+}
+
+// WithInterfaceAdvertisedAddr sets the advertised address of the server for
+// the interface named ifName, as for InterfaceAdvertisedAddrs.
+func WithInterfaceAdvertisedAddr(ifName, addr string) ServerOption {
+	return func(c *serverConfig) {
+		if c.srv.InterfaceAdvertisedAddrs == nil {
+			c.srv.InterfaceAdvertisedAddrs = make(map[string]string)
+		}
+		c.srv.InterfaceAdvertisedAddrs[ifName] = addr
+	}
+}
+
+// WithListeners sets the Listeners of the server.
+func WithListeners(ls ...net.Listener) ServerOption {
+	return func(c *serverConfig) { c.srv.Listeners = ls }
+}
+
+// WithPacketConns sets the PacketConns of the server.
+func WithPacketConns(conns ...net.PacketConn) ServerOption {
+	return func(c *serverConfig) { c.srv.PacketConns = conns }
+}
+
+// WithSystemdSockets sets the Listeners and PacketConns of the server to the
+// sockets passed by systemd socket activation, as returned by
+// SystemdSockets. New fails if there are none.
+func WithSystemdSockets() ServerOption {
+	return func(c *serverConfig) {
+		ls, conns, err := SystemdSockets()
+		if err == nil && len(ls) == 0 && len(conns) == 0 {
+			err = errors.New("device: no sockets passed by systemd")
+		}
+		if err != nil {
+			c.err = err
+			return
+		}
+		c.srv.Listeners, c.srv.PacketConns = ls, conns
+	}
+}
+
+// WithRoots adds root devices to be hosted, as for AddRoot. They are added
+// after the other options are applied.
+func WithRoots(roots ...*goupnp.RootDevice) ServerOption {
+	return func(c *serverConfig) { c.roots = append(c.roots, roots...) }
+}
+
+// New creates a Server configured by opts.
+func New(opts ...ServerOption) (*Server, error) {
+	srv := &Server{
+		handlers:      make(map[string]http.HandlerFunc),
+		presentations: make(map[string]http.Handler),
+	}
+	srv.httpServer.Handler = srv
+	c := serverConfig{srv: srv}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	if c.err != nil {
+		return nil, c.err
+	}
+	for _, root := range c.roots {
+		if err := srv.AddRoot(root); err != nil {
+			return nil, err
+		}
+	}
+	return srv, nil
+}
+
+// AddRoot adds a root device to be hosted, and announces it if the server is
+// serving. Every device must have a UDN of the form "uuid:...", distinct from
+// those of all other hosted devices. The SCPDURL, controlURL and eventSubURL
+// of every service are assigned by the server, overwriting any existing
+// values. Use Service to obtain the hosted service, in order to provide its
+// SCPD and action handlers.
+//
+// The root device must not be modified after calling AddRoot, use UpdateRoot
+// to change it.
+func (srv *Server) AddRoot(root *goupnp.RootDevice) error {
+	srv.lock.Lock()
+	defer srv.lock.Unlock()
+
+	hr := &hostedRoot{
+		root:     root,
+		descPath: pathPrefix + strings.TrimPrefix(root.Device.UDN, uuidPrefix) + "/desc.xml",
+	}
+	// root is only changed once it is known to be valid.
+	services, handlers, err := srv.layout(root, hr, nil)
+	if err != nil {
+		return err
+	}
+	if root.SpecVersion.Major == 0 {
+		root.SpecVersion = goupnp.SpecVersion{Major: 1, Minor: 1}
+	}
+	// URLBase is deprecated as of UPnP 1.1, all URLs are relative to the
+	// description URL.
+	root.URLBaseStr = ""
+	hr.services = services
+	if _, err := hr.updateDescription(); err != nil {
+		return err
+	}
+	handlers[hr.descPath] = func(w http.ResponseWriter, r *http.Request) {
+		srv.serveDescription(w, r, hr)
+	}
+
+	for path, h := range handlers {
+		srv.handlers[path] = h
+	}
+	srv.roots = append(srv.roots, hr)
+	if err := srv.advertiser.AddAdvertisements(hr.advertisements()); err != nil {
+		srv.logger().Warn("device: error announcing device", slog.String(logging.KeyUDN, root.Device.UDN), logging.Err(err))
+	}
+	return nil
+}
+
+// layout validates the devices of root, which is to be hosted as hr, and
+// assigns the URLs of their services, leaving root unchanged if it is not
+// valid. It returns the hosted services and their handlers. Services in
+// existing, keyed by serviceKey, are reused, and other services are created.
+// srv.lock must be held.
+func (srv *Server) layout(root *goupnp.RootDevice, hr *hostedRoot, existing map[string]*Service) ([]*Service, map[string]http.HandlerFunc, error) {
+	if err := srv.validate(root, hr); err != nil {
+		return nil, nil, err
+	}
+	var services []*Service
+	handlers := make(map[string]http.HandlerFunc)
+	root.Device.VisitDevices(func(d *goupnp.Device) {
+		for i := range d.Services {
+			desc := &d.Services[i]
+			prefix := pathPrefix + strings.TrimPrefix(d.UDN, uuidPrefix) + "/" + shortServiceID(desc.ServiceId) + "/"
+			desc.SCPDURL.Str = prefix + "scpd.xml"
+			desc.ControlURL.Str = prefix + "control"
+			desc.EventSubURL.Str = prefix + "event"
+			svc := existing[serviceKey(d.UDN, desc.ServiceId)]
+			if svc == nil || svc.ServiceType != desc.ServiceType {
+				svc = newService(d.UDN, desc, srv)
+			} else {
+				svc.desc = desc
+			}
+			services = append(services, svc)
+			handlers[desc.SCPDURL.Str] = svc.serveSCPD
+			handlers[desc.ControlURL.Str] = svc.serveControl
+			handlers[desc.EventSubURL.Str] = svc.serveEvent
+		}
+	})
+	return services, handlers, nil
+}
+
+// validate checks that the devices of root, which is to be hosted as hr, have
+// distinct UDNs of the form "uuid:...", that are not those of devices hosted
+// by other roots, and that their services have a type and ID. srv.lock must
+// be held.
+func (srv *Server) validate(root *goupnp.RootDevice, hr *hostedRoot) error {
+	udns := make(map[string]bool)
+	var err error
+	root.Device.VisitDevices(func(d *goupnp.Device) {
+		if err != nil {
+			return
+		}
+		if !strings.HasPrefix(d.UDN, uuidPrefix) || len(d.UDN) == len(uuidPrefix) {
+			err = fmt.Errorf("device: device %q has bad UDN %q", d.FriendlyName, d.UDN)
+			return
+		}
+		if udns[d.UDN] || srv.hostsUDN(d.UDN, hr) {
+			err = fmt.Errorf("device: device %q has duplicate UDN %q", d.FriendlyName, d.UDN)
+			return
+		}
+		udns[d.UDN] = true
+		for i := range d.Services {
+			if desc := &d.Services[i]; desc.ServiceType == "" || desc.ServiceId == "" {
+				err = fmt.Errorf("device: service in device %s is missing serviceType or serviceId", d.UDN)
+				return
+			}
+		}
+	})
+	return err
+}
+
+// serviceKey identifies a service within all hosted devices.
+func serviceKey(udn, serviceID string) string {
+	return udn + " " + serviceID
+}
+
+// RemoveRoot stops hosting the root device with the given UDN, sending
+// ssdp:byebye messages for it if the server is serving, and ending its event
+// subscriptions. It returns false if there is no such root device.
+func (srv *Server) RemoveRoot(udn string) bool {
+	srv.lock.Lock()
+	defer srv.lock.Unlock()
+	for i, hr := range srv.roots {
+		if hr.root.Device.UDN != udn {
+			continue
+		}
+		srv.roots = append(srv.roots[:i], srv.roots[i+1:]...)
+		delete(srv.handlers, hr.descPath)
+		for _, svc := range hr.services {
+			srv.removeService(svc)
+		}
+		hr.root.Device.VisitDevices(func(d *goupnp.Device) {
+			delete(srv.presentations, presentationPath(d.UDN))
+		})
+		if err := srv.advertiser.RemoveAdvertisements(hr.usns()); err != nil {
+			srv.logger().Warn("device: error sending byebye", slog.String(logging.KeyUDN, udn), logging.Err(err))
+		}
+		return true
+	}
+	return false
+}
+
+// UpdateRoot changes the description of the hosted root device with the
+// given UDN, by calling update with a copy of the description to modify.
+// Devices, services and icons may be added, changed or removed, subject to
+// the same rules as for AddRoot, and the root UDN must be kept. Services that
+// keep their UDN, serviceId and serviceType remain hosted as before, with
+// their SCPDs, handlers and subscriptions. Removed services stop being
+// served, and their subscriptions end.
+//
+// If the description changes, CONFIGID.UPNP.ORG changes, as required by UPnP
+// 1.1, and the device is re-announced if the server is serving: ssdp:byebye
+// is sent for removed devices and services, and ssdp:alive for the rest.
+func (srv *Server) UpdateRoot(udn string, update func(root *goupnp.RootDevice)) error {
+	srv.lock.Lock()
+	defer srv.lock.Unlock()
+	var hr *hostedRoot
+	for _, r := range srv.roots {
+		if r.root.Device.UDN == udn {
+			hr = r
+		}
+	}
+	if hr == nil {
+		return fmt.Errorf("device: no hosted root device %s", udn)
+	}
+
+	root := copyRoot(hr.root)
+	update(root)
+	if root.Device.UDN != udn {
+		return fmt.Errorf("device: update changed root UDN %s to %q", udn, root.Device.UDN)
+	}
+	if root.SpecVersion.Major == 0 {
+		root.SpecVersion = goupnp.SpecVersion{Major: 1, Minor: 1}
+	}
+	root.URLBaseStr = ""
+	existing := make(map[string]*Service, len(hr.services))
+	for _, svc := range hr.services {
+		existing[serviceKey(svc.udn, svc.ServiceID)] = svc
+	}
+	services, handlers, err := srv.layout(root, hr, existing)
+	if err != nil {
+		return err
+	}
+
+	kept := make(map[*Service]bool, len(services))
+	for _, svc := range services {
+		kept[svc] = true
+	}
+	for _, svc := range hr.services {
+		if !kept[svc] {
+			srv.removeService(svc)
+		}
+	}
+	for path, h := range handlers {
+		srv.handlers[path] = h
+	}
+	udns := make(map[string]bool)
+	root.Device.VisitDevices(func(d *goupnp.Device) {
+		udns[d.UDN] = true
+	})
+	hr.root.Device.VisitDevices(func(d *goupnp.Device) {
+		if !udns[d.UDN] {
+			delete(srv.presentations, presentationPath(d.UDN))
+		}
+	})
+
+	usns := hr.usns()
+	hr.root = root
+	hr.services = services
+	return srv.descriptionChanged(hr, usns)
+}
+
+// copyRoot returns a deep copy of root, such that changing the copy does not
+// change root.
+func copyRoot(root *goupnp.RootDevice) *goupnp.RootDevice {
+	c := *root
+	c.Device = copyDevice(&root.Device)
+	return &c
+}
+
+func copyDevice(d *goupnp.Device) goupnp.Device {
+	c := *d
+	c.Icons = append([]goupnp.Icon(nil), d.Icons...)
+	c.Services = append([]goupnp.Service(nil), d.Services...)
+	c.Devices = nil
+	for i := range d.Devices {
+		c.Devices = append(c.Devices, copyDevice(&d.Devices[i]))
+	}
+	return c
+}
+
+// descriptionChanged regenerates the description of hr. If it changed, the
+// advertisements with the given USNs, being those of hr before the change,
+// are replaced by those for the new description. srv.lock must be held.
+func (srv *Server) descriptionChanged(hr *hostedRoot, usns []string) error {
+	changed, err := hr.updateDescription()
+	if err != nil || !changed {
+		return err
+	}
+	if err := srv.advertiser.ReplaceAdvertisements(usns, hr.advertisements()); err != nil {
+		srv.logger().Warn("device: error re-announcing device", slog.String(logging.KeyUDN, hr.root.Device.UDN), logging.Err(err))
+	}
+	return nil
+}
+
+// scpdChanged is called when the SCPD of svc is set, as the SCPDs of a device
+// also determine its CONFIGID.UPNP.ORG.
+func (srv *Server) scpdChanged(svc *Service) {
+	srv.lock.Lock()
+	defer srv.lock.Unlock()
+	for _, hr := range srv.roots {
+		for _, s := range hr.services {
+			if s != svc {
+				continue
+			}
+			if err := srv.descriptionChanged(hr, hr.usns()); err != nil {
+				srv.logger().Warn("device: error updating description", slog.String(logging.KeyUDN, hr.root.Device.UDN), logging.Err(err))
+			}
+			return
+		}
+	}
+}
+
+// HandlePresentation serves the presentation page of the hosted device with
+// the given UDN using h, and sets the presentationURL of the device to refer
+// to it. The URL is a path on the server, so that control points resolve it
+// against the URL that they retrieved the description from. h sees request
+// paths relative to the presentation URL, as for http.StripPrefix, so that
+// "/" is the presentation page itself.
+func (srv *Server) HandlePresentation(udn string, h http.Handler) error {
+	srv.lock.Lock()
+	defer srv.lock.Unlock()
+	var hr *hostedRoot
+	for _, r := range srv.roots {
+		r.root.Device.VisitDevices(func(d *goupnp.Device) {
+			if d.UDN == udn {
+				hr = r
+			}
+		})
+	}
+	if hr == nil {
+		return fmt.Errorf("device: no hosted device %s", udn)
+	}
+	path := presentationPath(udn)
+	srv.presentations[path] = http.StripPrefix(strings.TrimSuffix(path, "/"), h)
+
+	// The root is replaced rather than changed, as those returned by Root
+	// and Roots are read without the lock.
+	root := copyRoot(hr.root)
+	changed := false
+	root.Device.VisitDevices(func(d *goupnp.Device) {
+		if d.UDN == udn && d.PresentationURL.Str != path {
+			d.PresentationURL.Str = path
+			changed = true
+		}
+	})
+	if !changed {
+		return nil
+	}
+	usns := hr.usns()
+	hr.root = root
+	return srv.descriptionChanged(hr, usns)
+}
+
+// presentationPath returns the path of the presentation page of the device.
+func presentationPath(udn string) string {
+	return pathPrefix + strings.TrimPrefix(udn, uuidPrefix) + "/presentation/"
+}
+
+// removeService stops serving svc, and ends its subscriptions. srv.lock must
+// be held.
+func (srv *Server) removeService(svc *Service) {
+	delete(srv.handlers, svc.desc.SCPDURL.Str)
+	delete(srv.handlers, svc.desc.ControlURL.Str)
+	delete(srv.handlers, svc.desc.EventSubURL.Str)
+	svc.events.closeAll()
+}
+
+// hostsUDN returns true if a device with the given UDN is hosted, other than
+// within exclude. srv.lock must be held.
+func (srv *Server) hostsUDN(udn string, exclude *hostedRoot) bool {
+	for _, hr := range srv.roots {
+		if hr == exclude {
+			continue
+		}
+		found := false
+		hr.root.Device.VisitDevices(func(d *goupnp.Device) {
+			found = found || d.UDN == udn
+		})
+		if found {
+			return true
+		}
+	}
+	return false
+}
+
+// shortServiceID returns the last component of a serviceId, e.g "WANIPConn1"
+// for "urn:upnp-org:serviceId:WANIPConn1".
+func shortServiceID(serviceID string) string {
+	return serviceID[strings.LastIndex(serviceID, ":")+1:]
+}
+
+// RootDevice returns the description of the first hosted root device, or nil
+// if there is none.
+func (srv *Server) RootDevice() *goupnp.RootDevice {
+	srv.lock.RLock()
+	defer srv.lock.RUnlock()
+	if len(srv.roots) == 0 {
+		return nil
+	}
+	return srv.roots[0].root
+}
+
+// RootDevices returns the descriptions of all hosted root devices.
+func (srv *Server) RootDevices() []*goupnp.RootDevice {
+	srv.lock.RLock()
+	defer srv.lock.RUnlock()
+	roots := make([]*goupnp.RootDevice, len(srv.roots))
+	for i, hr := range srv.roots {
+		roots[i] = hr.root
+	}
+	return roots
+}
+
+// DescriptionPath returns the URL path of the description of the hosted root
+// device with the given UDN, or "" if there is no such root device.
+func (srv *Server) DescriptionPath(udn string) string {
+	srv.lock.RLock()
+	defer srv.lock.RUnlock()
+	for _, hr := range srv.roots {
+		if hr.root.Device.UDN == udn {
+			return hr.descPath
+		}
+	}
+	return ""
+}
+
+// Service returns the hosted service with the given serviceId within the
+// device with the given UDN, or nil if there is no such service. If udn is
+// empty, the first service with the serviceId in any device is returned.
+func (srv *Server) Service(udn, serviceID string) *Service {
+	srv.lock.RLock()
+	defer srv.lock.RUnlock()
+	for _, hr := range srv.roots {
+		for _, svc := range hr.services {
+			if (udn == "" || svc.udn == udn) && svc.ServiceID == serviceID {
+				return svc
+			}
+		}
+	}
+	return nil
+}
+
+// ServeHTTP implements http.Handler, serving the descriptions, control and
+// eventing endpoints of the hosted devices.
+func (srv *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	srv.lock.RLock()
+	h := srv.handlers[r.URL.Path]
+	if h == nil {
+		for prefix, ph := range srv.presentations {
+			if strings.HasPrefix(r.URL.Path, prefix) {
+				h = ph.ServeHTTP
+				break
+			}
+			if r.URL.Path+"/" == prefix {
+				h = func(w http.ResponseWriter, r *http.Request) {
+					http.Redirect(w, r, prefix, http.StatusMovedPermanently)
+				}
+				break
+			}
+		}
+	}
+	srv.lock.RUnlock()
+	server := srv.ServerHeader
+	if server == "" {
+		server = ssdp.DefaultServerHeader
+	}
+	w.Header().Set("Server", server)
+	if h == nil {
+		http.NotFound(w, r)
+		return
+	}
+	defer func() {
+		p := recover()
+		if p == nil {
+			return
+		}
+		if p == http.ErrAbortHandler {
+			panic(p)
+		}
+		logging.LogPanic(srv.logger(), "device: HTTP handler panicked", p, slog.String("path", r.URL.Path))
+		http.Error(w, "internal server error", http.StatusInternalServerError)
+	}()
+	h(w, r)
+}
+
+// ListenAndServe listens on the TCP network address srv.Addr, or on the
+// addresses of srv.Interfaces, and then serves as for Serve. It serves on
+// srv.Listeners instead if set.
+func (srv *Server) ListenAndServe() error {
+	if len(srv.Listeners) > 0 {
+		return srv.serve(srv.Listeners)
+	}
+	addr := srv.Addr
+	if addr == "" {
+		addr = ":0"
+	}
+	if len(srv.Interfaces) == 0 {
+		l, err := net.Listen("tcp", addr)
+		if err != nil {
+			return err
+		}
+		return srv.serve([]net.Listener{l})
+	}
+
+	_, port, err := net.SplitHostPort(addr)
+	if err != nil {
+		return err
+	}
+	ips := interfaceIPs(srv.Interfaces, srv.IPv6)
+	if len(ips) == 0 {
+		return errors.New("device: no addresses on the given interfaces")
+	}
+	var ls []net.Listener
+	for _, ip := range ips {
+		l, err := net.Listen("tcp", net.JoinHostPort(ip.String(), port))
+		if err != nil {
+			for _, l := range ls {
+				l.Close()
+			}
+			return err
+		}
+		// Every address uses the same port, which may have been
+		// automatically chosen for the first.
+		port = strconv.Itoa(l.Addr().(*net.TCPAddr).Port)
+		ls = append(ls, l)
+	}
+	return srv.serve(ls)
+}
+
+// interfaceIPs returns the IPv4 addresses of the interfaces, and their IPv6
+// addresses if ipv6 is true. IPv6 link-local addresses have the zone of the
+// interface, as required to listen on them.
+func interfaceIPs(ifs []net.Interface, ipv6 bool) []*net.IPAddr {
+	var ips []*net.IPAddr
+	for i := range ifs {
+		addrs, err := netif.Addrs(&ifs[i])
+		if err != nil {
+			continue
+		}
+		for _, addr := range addrs {
+			ipNet, ok := addr.(*net.IPNet)
+			if !ok {
+				continue
+			}
+			ip := &net.IPAddr{IP: ipNet.IP}
+			if ip.IP.To4() == nil {
+				if !ipv6 {
+					continue
+				}
+				if ip.IP.IsLinkLocalUnicast() {
+					ip.Zone = ifs[i].Name
+				}
+			}
+			ips = append(ips, ip)
+		}
+	}
+	return ips
+}
+
+// Serve serves HTTP requests on l, and advertises the devices via SSDP, until
+// Close is called. A Server cannot be reused once closed, a new Server should
+// be created to restart the device.
+func (srv *Server) Serve(l net.Listener) error {
+	return srv.serve([]net.Listener{l})
+}
+
+// serve serves on all of ls, which must have the same port.
+func (srv *Server) serve(ls []net.Listener) error {
+	if err := srv.checkAdvertisedAddrs(); err != nil {
+		for _, l := range ls {
+			l.Close()
+		}
+		return err
+	}
+	srv.lock.Lock()
+	if srv.listeners != nil {
+		srv.lock.Unlock()
+		for _, l := range ls {
+			l.Close()
+		}
+		return errors.New("device: server already serving")
+	}
+	srv.listeners = ls
+	srv.lock.Unlock()
+
+	port := strconv.Itoa(ls[0].Addr().(*net.TCPAddr).Port)
+	srv.advertiser.Location = func(localIP net.IP) string {
+		return srv.location(localIP, port)
+	}
+	srv.advertiser.Server = srv.ServerHeader
+	srv.advertiser.BootID = srv.BootID
+	srv.advertiser.Interfaces = srv.Interfaces
+	srv.advertiser.IPv6 = srv.IPv6
+	srv.advertiser.Logger = srv.Logger
+	srv.advertiser.Clock = srv.Clock
+	srv.advertiser.Conns = srv.PacketConns
+	if err := srv.advertiser.Start(); err != nil {
+		srv.lock.Lock()
+		srv.listeners = nil
+		srv.lock.Unlock()
+		for _, l := range ls {
+			l.Close()
+		}
+		return err
+	}
+	defer srv.advertiser.Close()
+	srv.lock.Lock()
+	srv.BootID = srv.advertiser.BootID
+	srv.lock.Unlock()
+
+	errs := make(chan error, len(ls))
+	for _, l := range ls {
+		go func(l net.Listener) {
+			errs <- srv.httpServer.Serve(l)
+		}(l)
+	}
+	// All listeners stop once any of them fails.
+	err := <-errs
+	if err == http.ErrServerClosed {
+		return nil
+	}
+	srv.httpServer.Close()
+	return err
+}
+
+// AdvertisedBootID returns the BOOTID.UPNP.ORG value advertised once Serve has
+// started, which devices should persist to set BootID to when restarted.
+func (srv *Server) AdvertisedBootID() int32 {
+	srv.lock.RLock()
+	defer srv.lock.RUnlock()
+	return srv.BootID
+}
+
+// checkAdvertisedAddrs checks that the advertised addresses are of the form
+// "host:port".
+func (srv *Server) checkAdvertisedAddrs() error {
+	addrs := []string{srv.AdvertisedAddr}
+	for _, addr := range srv.InterfaceAdvertisedAddrs {
+		addrs = append(addrs, addr)
+	}
+	for _, addr := range addrs {
+		if addr == "" {
+			continue
+		}
+		if _, _, err := net.SplitHostPort(addr); err != nil {
+			return fmt.Errorf("device: bad advertised address %q: %v", addr, err)
+		}
+	}
+	return nil
+}
+
+// location returns the base URL of the devices advertised from localIP, the
+// server listening on port.
+func (srv *Server) location(localIP net.IP, port string) string {
+	host := localIP.String()
+	addr := srv.AdvertisedAddr
+	if len(srv.InterfaceAdvertisedAddrs) > 0 {
+		if ifAddr, ok := srv.InterfaceAdvertisedAddrs[interfaceName(localIP)]; ok {
+			addr = ifAddr
+		}
+	}
+	if addr != "" {
+		if h, p, err := net.SplitHostPort(addr); err == nil {
+			if h != "" {
+				host = h
+			}
+			if p != "" {
+				port = p
+			}
+		}
+	}
+	return "http://" + net.JoinHostPort(host, port)
+}
+
+// interfaceName returns the name of the interface that has the address ip,
+// or "" if none has.
+func interfaceName(ip net.IP) string {
+	ifs, err := netif.List()
+	if err != nil {
+		return ""
+	}
+	for i := range ifs {
+		addrs, err := netif.Addrs(&ifs[i])
+		if err != nil {
+			continue
+		}
+		for _, addr := range addrs {
+			if ipNet, ok := addr.(*net.IPNet); ok && ipNet.IP.Equal(ip) {
+				return ifs[i].Name
+			}
+		}
+	}
+	return ""
+}
+
+// Close sends ssdp:byebye messages for the devices, stops the server, and
+// ends all event subscriptions.
+func (srv *Server) Close() error {
+	if err := srv.advertiser.Close(); err != nil {
+		srv.logger().Warn("device: error closing SSDP advertiser", logging.Err(err))
+	}
+	srv.lock.RLock()
+	for _, hr := range srv.roots {
+		for _, svc := range hr.services {
+			svc.events.closeAll()
+		}
+	}
+	srv.lock.RUnlock()
+	return srv.httpServer.Close()
+}
+
+// maxConfigID is the largest CONFIGID.UPNP.ORG value, higher values being
+// reserved by UPnP 1.1.
+const maxConfigID = 1<<24 - 1
+
+// updateDescription regenerates the cached description of the root device,
+// and returns whether it changed. The CONFIGID.UPNP.ORG is derived from the
+// description and SCPDs, so that it is the same across restarts of a device
+// whose descriptions have not changed, and differs from the previous value
+// when they have.
+func (hr *hostedRoot) updateDescription() (bool, error) {
+	content, err := marshalDescription(hr.root, "")
+	if err != nil {
+		return false, err
+	}
+	for _, svc := range hr.services {
+		s := svc.SCPD()
+		if s == nil {
+			continue
+		}
+		body, err := marshalXML("scpd", scpd.SCPDXMLNamespace, s)
+		if err != nil {
+			return false, err
+		}
+		content = append(content, body...)
+	}
+	if hr.description != nil && bytes.Equal(content, hr.content) {
+		return false, nil
+	}
+
+	configID := int32(crc32.ChecksumIEEE(content) & maxConfigID)
+	if hr.description != nil && configID == hr.configID {
+		configID = (configID + 1) & maxConfigID
+	}
+	description, err := marshalDescription(hr.root, strconv.Itoa(int(configID)))
+	if err != nil {
+		return false, err
+	}
+	hr.description = description
+	hr.content = content
+	hr.configID = configID
+	return true, nil
+}
+
+// usns returns the USNs of the advertisements for the root device.
+func (hr *hostedRoot) usns() []string {
+	var usns []string
+	for _, ad := range hr.advertisements() {
+		usns = append(usns, ad.USN)
+	}
+	return usns
+}
+
+// advertisements returns the SSDP advertisements for the root device.
+func (hr *hostedRoot) advertisements() []ssdp.Advertisement {
+	rootUDN := hr.root.Device.UDN
+	ads := []ssdp.Advertisement{{
+		NT:              rootDeviceNT,
+		USN:             rootUDN + "::" + rootDeviceNT,
+		DescriptionPath: hr.descPath,
+	}}
+	hr.root.Device.VisitDevices(func(d *goupnp.Device) {
+		ads = append(ads,
+			ssdp.Advertisement{NT: d.UDN, USN: d.UDN, DescriptionPath: hr.descPath},
+			ssdp.Advertisement{NT: d.DeviceType, USN: d.UDN + "::" + d.DeviceType, DescriptionPath: hr.descPath},
+		)
+		seen := make(map[string]bool)
+		for _, s := range d.Services {
+			if seen[s.ServiceType] {
+				continue
+			}
+			seen[s.ServiceType] = true
+			ads = append(ads, ssdp.Advertisement{
+				NT:              s.ServiceType,
+				USN:             d.UDN + "::" + s.ServiceType,
+				DescriptionPath: hr.descPath,
+			})
+		}
+	})
+	for i := range ads {
+		ads[i].ConfigID = hr.configID
+	}
+	return ads
+}
+
+func (srv *Server) serveDescription(w http.ResponseWriter, r *http.Request, hr *hostedRoot) {
+	if r.Method != "GET" && r.Method != "HEAD" {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	srv.lock.RLock()
+	body := hr.description
+	srv.lock.RUnlock()
+	writeXML(w, r, body)
+}
+
+// writeXML writes the XML document body as the response.
+func writeXML(w http.ResponseWriter, r *http.Request, body []byte) {
+	w.Header().Set("Content-Type", `text/xml; charset="utf-8"`)
+	w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	if r.Method == "HEAD" {
+		return
+	}
+	w.Write(body)
+}
+
+// marshalXML serializes v as an XML document with the given root element
+// name and default namespace.
+func marshalXML(rootName, namespace string, v interface{}) ([]byte, error) {
+	var buf strings.Builder
+	buf.WriteString(xml.Header)
+	enc := xml.NewEncoder(&buf)
+	enc.Indent("", "  ")
+	start := xml.StartElement{
+		Name: xml.Name{Local: rootName},
+		Attr: []xml.Attr{{Name: xml.Name{Local: "xmlns"}, Value: namespace}},
+	}
+	if err := enc.EncodeElement(v, start); err != nil {
+		return nil, err
+	}
+	if err := enc.Flush(); err != nil {
+		return nil, err
+	}
+	return []byte(buf.String()), nil
+}
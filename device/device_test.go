@@ -0,0 +1,867 @@
+package device
+
+import (
+	"bytes"
+	"context"
+	"encoding/xml"
+	"io/ioutil"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/huin/goupnp"
+	"github.com/huin/goupnp/clock"
+	"github.com/huin/goupnp/gena"
+	"github.com/huin/goupnp/internal/netif"
+	"github.com/huin/goupnp/scpd"
+	"github.com/huin/goupnp/soap"
+)
+
+const (
+	testServiceType = "urn:schemas-upnp-org:service:SwitchPower:1"
+	testServiceID   = "urn:upnp-org:serviceId:SwitchPower"
+)
+
+func newTestRoot() *goupnp.RootDevice {
+	return &goupnp.RootDevice{
+		Device: goupnp.Device{
+			DeviceType:   "urn:schemas-upnp-org:device:BinaryLight:1",
+			FriendlyName: "Test light",
+			Manufacturer: "goupnp",
+			ModelName:    "test",
+			UDN:          "uuid:11111111-2222-3333-4444-555555555555",
+			Services: []goupnp.Service{{
+				ServiceType: testServiceType,
+				ServiceId:   testServiceID,
+			}},
+		},
+	}
+}
+
+var testSCPD = &scpd.SCPD{
+	SpecVersion: scpd.SpecVersion{Major: 1, Minor: 0},
+	Actions: []scpd.Action{{
+		Name: "GetStatus",
+		Arguments: []scpd.Argument{
+			{Name: "ResultStatus", Direction: "out", RelatedStateVariable: "Status"},
+		},
+	}},
+	StateVariables: []scpd.StateVariable{
+		{Name: "Status", SendEvents: "yes", DataType: scpd.DataType{Name: "boolean"}},
+	},
+}
+
+// newTestServer starts serving srv via httptest, without SSDP.
+func newTestServer(t *testing.T, srv *Server) (*httptest.Server, *url.URL) {
+	ts := httptest.NewServer(srv)
+	loc, err := url.Parse(ts.URL + srv.roots[0].descPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ts, loc
+}
+
+func TestServer(t *testing.T) {
+	srv, err := NewServer(newTestRoot())
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := srv.Service("", testServiceID)
+	if svc == nil {
+		t.Fatal("hosted service not found")
+	}
+	svc.SetSCPD(testSCPD)
+	svc.HandleFunc("GetStatus", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		return []soap.Arg{{Name: "ResultStatus", Value: "1"}}, nil
+	})
+	ts, loc := newTestServer(t, srv)
+	defer ts.Close()
+
+	root, err := goupnp.DeviceByURL(loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root.Device.FriendlyName != "Test light" {
+		t.Errorf("Bad friendly name %q", root.Device.FriendlyName)
+	}
+	srvs := root.Device.FindService(testServiceType)
+	if len(srvs) != 1 {
+		t.Fatalf("want 1 service, got %d", len(srvs))
+	}
+
+	gotSCPD, err := srvs[0].RequestSCDP()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if gotSCPD.GetAction("GetStatus") == nil {
+		t.Error("GetStatus action missing from served SCPD")
+	}
+
+	client := srvs[0].NewSOAPClient()
+	out := &struct{ ResultStatus string }{}
+	if err := client.PerformAction(testServiceType, "GetStatus", nil, out); err != nil {
+		t.Fatal(err)
+	}
+	if out.ResultStatus != "1" {
+		t.Errorf("Bad ResultStatus %q", out.ResultStatus)
+	}
+
+	err = client.PerformAction(testServiceType, "SetTarget", nil, nil)
+	if fault, ok := err.(*soap.SOAPFaultError); !ok || fault.UPnPError == nil || fault.UPnPError.Code != soap.ErrCodeInvalidAction {
+		t.Errorf("want fault with UPnP error %d for unknown action, got %v", soap.ErrCodeInvalidAction, err)
+	}
+
+	events := make(chan *gena.Event, 1)
+	sub, err := gena.NewSubscriber(gena.HandlerFunc(func(ev *gena.Event) { events <- ev }))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sub.Close()
+	s, err := sub.Subscribe(&srvs[0].EventSubURL.URL, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc.Notify(gena.Property{Name: "Status", Value: "1"})
+	select {
+	case ev := <-events:
+		if ev.SID != s.SID {
+			t.Errorf("Bad event SID\nwant: %q\n got: %q", s.SID, ev.SID)
+		}
+		if v, _ := ev.Get("Status"); v != "1" {
+			t.Errorf("Bad Status in event: %q", v)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+	if err := s.Unsubscribe(); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestActionPanic(t *testing.T) {
+	var logs bytes.Buffer
+	srv, err := New(WithRoots(newTestRoot()), WithLogger(slog.New(slog.NewTextHandler(&logs, nil))))
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := srv.Service("", testServiceID)
+	svc.HandleFunc("GetStatus", func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+		panic("broken handler")
+	})
+	ts, loc := newTestServer(t, srv)
+	defer ts.Close()
+
+	root, err := goupnp.DeviceByURL(loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := root.Device.FindService(testServiceType)[0].NewSOAPClient()
+	err = client.PerformAction(testServiceType, "GetStatus", nil, nil)
+	if fault, ok := err.(*soap.SOAPFaultError); !ok || fault.UPnPError == nil || fault.UPnPError.Code != soap.ErrCodeActionFailed {
+		t.Errorf("want fault with UPnP error %d for panicking action, got %v", soap.ErrCodeActionFailed, err)
+	}
+	if !strings.Contains(logs.String(), "broken handler") {
+		t.Errorf("panic not logged:\n%s", logs.String())
+	}
+}
+
+func TestNewServerBadUDN(t *testing.T) {
+	root := newTestRoot()
+	root.Device.UDN = "not-a-uuid"
+	if _, err := NewServer(root); err == nil {
+		t.Error("want error for bad UDN, got nil")
+	}
+}
+
+func TestAddRootInvalid(t *testing.T) {
+	srv, err := NewServer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	root := newTestRoot()
+	root.URLBaseStr = "http://192.0.2.1/"
+	root.Device.Devices = []goupnp.Device{{UDN: root.Device.UDN}}
+	if err := srv.AddRoot(root); err == nil {
+		t.Fatal("want error for duplicate UDN, got nil")
+	}
+	if root.SpecVersion.Major != 0 || root.URLBaseStr == "" || root.Device.Services[0].ControlURL.Str != "" {
+		t.Errorf("invalid root was changed by AddRoot: %+v", root)
+	}
+}
+
+func TestNewOptions(t *testing.T) {
+	logger := slog.New(slog.NewTextHandler(ioutil.Discard, nil))
+	fc := clock.NewFake(time.Unix(1000, 0))
+	srv, err := New(WithAddr("127.0.0.1:0"), WithServerHeader("test/1.0 UPnP/1.1 test/1.0"),
+		WithBootID(7), WithLogger(logger), WithClock(fc), WithRoots(newTestRoot()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if srv.Addr != "127.0.0.1:0" || srv.ServerHeader != "test/1.0 UPnP/1.1 test/1.0" || srv.BootID != 7 ||
+		srv.Logger != logger || srv.Clock != fc {
+		t.Errorf("options not applied: %+v", srv)
+	}
+	if len(srv.roots) != 1 {
+		t.Errorf("got %d root devices, want 1", len(srv.roots))
+	}
+
+	root := newTestRoot()
+	root.Device.UDN = "bad"
+	if _, err := New(WithRoots(root)); err == nil {
+		t.Error("New with a bad root device: want error, got nil")
+	}
+}
+
+func TestAdvertisedAddr(t *testing.T) {
+	netif.Set(func() ([]net.Interface, error) {
+		return []net.Interface{{Index: 1, Name: "eth0"}, {Index: 2, Name: "docker0"}}, nil
+	}, func(ifc *net.Interface) ([]net.Addr, error) {
+		if ifc.Name == "eth0" {
+			return []net.Addr{&net.IPNet{IP: net.IPv4(192, 168, 1, 2), Mask: net.CIDRMask(24, 32)}}, nil
+		}
+		return []net.Addr{&net.IPNet{IP: net.IPv4(172, 17, 0, 2), Mask: net.CIDRMask(16, 32)}}, nil
+	})
+	defer netif.Set(nil, nil)
+
+	srv, err := New(WithAdvertisedAddr(":8200"), WithInterfaceAdvertisedAddr("docker0", "203.0.113.5:18200"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, test := range []struct {
+		ip   net.IP
+		want string
+	}{
+		{net.IPv4(192, 168, 1, 2), "http://192.168.1.2:8200"},
+		{net.IPv4(172, 17, 0, 2), "http://203.0.113.5:18200"},
+	} {
+		if got := srv.location(test.ip, "49152"); got != test.want {
+			t.Errorf("location(%v) = %q, want %q", test.ip, got, test.want)
+		}
+	}
+
+	srv.AdvertisedAddr = "no-port"
+	if err := srv.checkAdvertisedAddrs(); err == nil {
+		t.Error("want error for advertised address without port")
+	}
+}
+
+// loopbackInterface lists the loopback interface, as multicast capable and
+// with the addresses ips, as the only interface, and returns it.
+func loopbackInterface(t *testing.T, ips ...net.IP) net.Interface {
+	lo, err := net.InterfaceByName("lo")
+	if err != nil {
+		t.Skipf("no loopback interface: %v", err)
+	}
+	lo.Flags |= net.FlagMulticast | net.FlagUp
+	netif.Set(func() ([]net.Interface, error) {
+		return []net.Interface{*lo}, nil
+	}, func(*net.Interface) ([]net.Addr, error) {
+		var addrs []net.Addr
+		for _, ip := range ips {
+			addrs = append(addrs, &net.IPNet{IP: ip, Mask: net.CIDRMask(8*len(ip), 8*len(ip))})
+		}
+		return addrs, nil
+	})
+	return *lo
+}
+
+func TestServeBootID(t *testing.T) {
+	loopbackInterface(t, net.IPv4(127, 0, 0, 1).To4())
+	defer netif.Set(nil, nil)
+	conn, err := net.ListenPacket("udp4", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv, err := New(WithBootID(7), WithPacketConns(conn), WithRoots(newTestRoot()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	served := make(chan error, 1)
+	go func() { served <- srv.Serve(l) }()
+	deadline := time.Now().Add(5 * time.Second)
+	for srv.AdvertisedBootID() != 8 {
+		if time.Now().After(deadline) {
+			t.Fatalf("AdvertisedBootID = %d, want 8, the BootID incremented once", srv.AdvertisedBootID())
+		}
+		time.Sleep(time.Millisecond)
+	}
+	srv.Close()
+	if err := <-served; err != nil {
+		t.Error(err)
+	}
+}
+
+func TestServeAdvertiserFailure(t *testing.T) {
+	// Without multicast interfaces, nothing can be advertised.
+	netif.Set(func() ([]net.Interface, error) { return nil, nil }, nil)
+	defer netif.Set(nil, nil)
+	srv, err := New(WithRoots(newTestRoot()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		l, err := net.Listen("tcp", "127.0.0.1:0")
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err := srv.Serve(l); err == nil || strings.Contains(err.Error(), "already serving") {
+			t.Fatalf("Serve() attempt %d = %v, want the error of the advertiser", i, err)
+		}
+		if _, err := l.Accept(); err == nil {
+			t.Errorf("Serve() attempt %d left the listener open", i)
+		}
+	}
+}
+
+func TestListenAndServeInterfaces(t *testing.T) {
+	if l, err := net.Listen("tcp6", "[::1]:0"); err != nil {
+		t.Skipf("no IPv6 loopback: %v", err)
+	} else {
+		l.Close()
+	}
+	lo := loopbackInterface(t, net.IPv4(127, 0, 0, 1).To4(), net.IPv6loopback)
+	defer netif.Set(nil, nil)
+	group, err := net.ResolveUDPAddr("udp4", "239.255.255.250:1900")
+	if err != nil {
+		t.Fatal(err)
+	}
+	recv, err := net.ListenMulticastUDP("udp4", &lo, group)
+	if err != nil {
+		t.Skipf("cannot join multicast group on loopback: %v", err)
+	}
+	defer recv.Close()
+	conn, err := net.ListenPacket("udp4", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv, err := New(WithInterfaces(lo), WithIPv6(true), WithPacketConns(conn), WithRoots(newTestRoot()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	served := make(chan error, 1)
+	go func() { served <- srv.ListenAndServe() }()
+	defer func() {
+		srv.Close()
+		if err := <-served; err != nil {
+			t.Error(err)
+		}
+	}()
+
+	// The alive notification is sent once all listeners are open.
+	usn := newTestRoot().Device.UDN + "::upnp:rootdevice"
+	var location string
+	buf := make([]byte, 2048)
+	for location == "" {
+		recv.SetReadDeadline(time.Now().Add(5 * time.Second))
+		n, _, err := recv.ReadFrom(buf)
+		if err != nil {
+			t.Fatalf("reading NOTIFY: %v", err)
+		}
+		msg := string(buf[:n])
+		if strings.Contains(msg, "USN: "+usn+"\r\n") && strings.Contains(msg, "NTS: ssdp:alive\r\n") {
+			location = msg[strings.Index(msg, "LOCATION: ")+len("LOCATION: "):]
+			location = location[:strings.Index(location, "\r\n")]
+		}
+	}
+
+	srv.lock.RLock()
+	ls := srv.listeners
+	srv.lock.RUnlock()
+	if len(ls) != 2 {
+		t.Fatalf("got %d listeners, want one for each of 127.0.0.1 and ::1", len(ls))
+	}
+	port := ls[0].Addr().(*net.TCPAddr).Port
+	for i, want := range []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback} {
+		addr := ls[i].Addr().(*net.TCPAddr)
+		if !addr.IP.Equal(want) || addr.Port != port {
+			t.Errorf("listener %d on %v, want %v port %d", i, addr, want, port)
+		}
+		desc := "http://" + addr.String() + srv.roots[0].descPath
+		resp, err := http.Get(desc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("GET %s: got status %d", desc, resp.StatusCode)
+		}
+		if i == 0 && location != desc {
+			t.Errorf("got LOCATION %q, want %q", location, desc)
+		}
+	}
+	for srv.AdvertisedBootID() != 1 {
+		time.Sleep(time.Millisecond)
+	}
+	if got, want := srv.advertiser.Location(net.IPv6loopback), "http://[::1]:"+strconv.Itoa(port); got != want {
+		t.Errorf("got IPv6 location %q, want %q", got, want)
+	}
+}
+
+func TestInterfaceIPs(t *testing.T) {
+	linkLocal := net.ParseIP("fe80::1")
+	netif.Set(nil, func(ifc *net.Interface) ([]net.Addr, error) {
+		return []net.Addr{
+			&net.IPNet{IP: net.IPv4(192, 168, byte(ifc.Index), 2).To4(), Mask: net.CIDRMask(24, 32)},
+			&net.IPNet{IP: linkLocal, Mask: net.CIDRMask(64, 128)},
+			&net.IPNet{IP: net.ParseIP("fd00::2"), Mask: net.CIDRMask(64, 128)},
+		}, nil
+	})
+	defer netif.Set(nil, nil)
+	ifs := []net.Interface{{Index: 1, Name: "eth0"}, {Index: 2, Name: "eth1"}}
+
+	var got []string
+	for _, ip := range interfaceIPs(ifs, false) {
+		got = append(got, ip.String())
+	}
+	if strings.Join(got, " ") != "192.168.1.2 192.168.2.2" {
+		t.Errorf("got IPv4 addresses %v", got)
+	}
+	got = nil
+	for _, ip := range interfaceIPs(ifs, true) {
+		got = append(got, ip.String())
+	}
+	if want := "192.168.1.2 fe80::1%eth0 fd00::2 192.168.2.2 fe80::1%eth1 fd00::2"; strings.Join(got, " ") != want {
+		t.Errorf("got addresses %v, want %s", got, want)
+	}
+}
+
+func TestDeviceBuilder(t *testing.T) {
+	b := NewDeviceBuilder("urn:schemas-upnp-org:device:BinaryLight:1", "Test light").
+		UDN("uuid:11111111-2222-3333-4444-555555555555").
+		Manufacturer("goupnp", "").
+		Model("test", "", "", "").
+		Service(testServiceType, testServiceID, testSCPD).
+		Device(NewDeviceBuilder("urn:schemas-upnp-org:device:Embedded:1", "Child").
+			UDN("uuid:11111111-2222-3333-4444-666666666666").
+			Manufacturer("goupnp", "").
+			Model("child", "", "", ""))
+	srv, err := b.NewServer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if srv.Service("", testServiceID).SCPD() != testSCPD {
+		t.Error("SCPD not set on hosted service")
+	}
+
+	body, err := MarshalDescription(srv.RootDevice())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, elem := range []string{"<URLBase", "<modelNumber", "<UPC", "<presentationURL", "<iconList"} {
+		if strings.Contains(string(body), elem) {
+			t.Errorf("description contains empty optional element %s:\n%s", elem, body)
+		}
+	}
+	var got goupnp.RootDevice
+	if err := xml.Unmarshal(body, &got); err != nil {
+		t.Fatal(err)
+	}
+	if got.XMLName.Space != goupnp.DeviceXMLNamespace {
+		t.Errorf("Bad namespace %q", got.XMLName.Space)
+	}
+	if got.SpecVersion != (goupnp.SpecVersion{Major: 1, Minor: 1}) {
+		t.Errorf("Bad specVersion %+v", got.SpecVersion)
+	}
+	if len(got.Device.Devices) != 1 || got.Device.Devices[0].FriendlyName != "Child" {
+		t.Errorf("Bad embedded devices %+v", got.Device.Devices)
+	}
+	if s := got.Device.Services; len(s) != 1 || s[0].ControlURL.Str == "" {
+		t.Errorf("Bad services %+v", s)
+	}
+
+	b.Device(NewDeviceBuilder("urn:schemas-upnp-org:device:Embedded:1", "Dup").
+		UDN("uuid:11111111-2222-3333-4444-666666666666").
+		Manufacturer("goupnp", "").
+		Model("child", "", "", ""))
+	if _, err := b.Root(); err == nil {
+		t.Error("want error for duplicate UDN, got nil")
+	}
+}
+
+func TestServiceState(t *testing.T) {
+	srv, err := NewServer(newTestRoot())
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := srv.Service("", testServiceID)
+	svc.SetSCPD(testSCPD)
+	svc.Moderate("Status", time.Hour, 0)
+	svc.SetState(gena.Property{Name: "Status", Value: "0"})
+	ts, _ := newTestServer(t, srv)
+	defer ts.Close()
+
+	events := make(chan *gena.Event, 10)
+	sub, err := gena.NewSubscriber(gena.HandlerFunc(func(ev *gena.Event) { events <- ev }))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sub.Close()
+	eventURL, err := url.Parse(ts.URL + svc.desc.EventSubURL.Str)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := sub.Subscribe(eventURL, 0); err != nil {
+		t.Fatal(err)
+	}
+
+	nextEvent := func() *gena.Event {
+		select {
+		case ev := <-events:
+			return ev
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for event")
+		}
+		return nil
+	}
+	if ev := nextEvent(); ev.Seq != 0 {
+		t.Errorf("want initial event with SEQ 0, got %d", ev.Seq)
+	} else if v, _ := ev.Get("Status"); v != "0" {
+		t.Errorf("Bad Status in initial event: %q", v)
+	}
+
+	// The change is held back by the maximum rate, Notify bypasses it.
+	svc.SetState(gena.Property{Name: "Status", Value: "1"})
+	if v, _ := svc.State("Status"); v != "1" {
+		t.Errorf("Bad state %q", v)
+	}
+	svc.Notify(gena.Property{Name: "Status", Value: "0"})
+	if ev := nextEvent(); ev.Seq != 1 {
+		t.Errorf("want event with SEQ 1, got %d", ev.Seq)
+	} else if v, _ := ev.Get("Status"); v != "0" {
+		t.Errorf("Bad Status in event: %q", v)
+	}
+	select {
+	case ev := <-events:
+		t.Errorf("Unexpected event %+v", ev)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestSubscriptionManagement(t *testing.T) {
+	srv, err := NewServer(newTestRoot())
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := srv.Service("", testServiceID)
+	ts, _ := newTestServer(t, srv)
+	defer ts.Close()
+	defer srv.Close()
+	eventURL := ts.URL + svc.desc.EventSubURL.Str
+
+	do := func(method string, header http.Header) *http.Response {
+		req, err := http.NewRequest(method, eventURL, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header = header
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		return resp
+	}
+
+	resp := do("SUBSCRIBE", http.Header{
+		"NT":       {"upnp:event"},
+		"CALLBACK": {"<bad> <http://192.0.2.1/a><http://192.0.2.1/b>"},
+		"TIMEOUT":  {"Second-5"},
+	})
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("SUBSCRIBE got %s", resp.Status)
+	}
+	sid := resp.Header.Get("SID")
+	if got, want := resp.Header.Get("TIMEOUT"), "Second-60"; got != want {
+		t.Errorf("want TIMEOUT %q, got %q", want, got)
+	}
+	svc.events.lock.Lock()
+	if n := len(svc.events.subs[sid].callbacks); n != 2 {
+		t.Errorf("want 2 callbacks, got %d", n)
+	}
+	svc.events.lock.Unlock()
+
+	tests := []struct {
+		method string
+		header http.Header
+		status int
+	}{
+		{"SUBSCRIBE", http.Header{"SID": {sid}, "TIMEOUT": {"Second-infinite"}}, http.StatusOK},
+		{"SUBSCRIBE", http.Header{"SID": {sid}, "NT": {"upnp:event"}}, http.StatusBadRequest},
+		{"SUBSCRIBE", http.Header{"SID": {"uuid:unknown"}}, http.StatusPreconditionFailed},
+		{"SUBSCRIBE", http.Header{"NT": {"upnp:event"}}, http.StatusPreconditionFailed},
+		{"UNSUBSCRIBE", http.Header{"SID": {"uuid:unknown"}}, http.StatusPreconditionFailed},
+		{"UNSUBSCRIBE", http.Header{"SID": {sid}}, http.StatusOK},
+		{"SUBSCRIBE", http.Header{"SID": {sid}}, http.StatusPreconditionFailed},
+	}
+	for _, test := range tests {
+		if resp := do(test.method, test.header); resp.StatusCode != test.status {
+			t.Errorf("%s %v: want %d, got %s", test.method, test.header, test.status, resp.Status)
+		}
+	}
+
+	resp = do("SUBSCRIBE", http.Header{"NT": {"upnp:event"}, "CALLBACK": {"<http://192.0.2.1/>"}})
+	sid = resp.Header.Get("SID")
+	svc.events.lock.Lock()
+	svc.events.subs[sid].expiry = time.Now().Add(-time.Second)
+	svc.events.lock.Unlock()
+	if resp := do("SUBSCRIBE", http.Header{"SID": {sid}}); resp.StatusCode != http.StatusPreconditionFailed {
+		t.Errorf("renewing expired subscription: want 412, got %s", resp.Status)
+	}
+}
+
+func TestSubscriptionExpiry(t *testing.T) {
+	fc := clock.NewFake(time.Unix(1000, 0))
+	srv, err := New(WithClock(fc), WithRoots(newTestRoot()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	svc := srv.Service("", testServiceID)
+	ts, _ := newTestServer(t, srv)
+	defer ts.Close()
+	defer srv.Close()
+
+	subscribe := func(timeout string) *subscription {
+		req, err := http.NewRequest("SUBSCRIBE", ts.URL+svc.desc.EventSubURL.Str, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header = http.Header{"NT": {"upnp:event"}, "CALLBACK": {"<http://192.0.2.1/>"}, "TIMEOUT": {timeout}}
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		svc.events.lock.Lock()
+		defer svc.events.lock.Unlock()
+		return svc.events.subs[resp.Header.Get("SID")]
+	}
+	short, long := subscribe("Second-60"), subscribe("Second-120")
+	if short == nil || long == nil {
+		t.Fatal("subscriptions not made")
+	}
+
+	// Each subscription is reaped at its expiry, without further requests,
+	// which stops its deliver goroutine.
+	for _, test := range []struct {
+		sub  *subscription
+		left int
+	}{{short, 1}, {long, 0}} {
+		fc.Advance(60 * time.Second)
+		select {
+		case <-test.sub.done:
+		default:
+			t.Errorf("subscription %s not reaped at its expiry", test.sub.sid)
+		}
+		svc.events.lock.Lock()
+		if n := len(svc.events.subs); n != test.left {
+			t.Errorf("got %d subscriptions, want %d", n, test.left)
+		}
+		svc.events.lock.Unlock()
+	}
+	if n := fc.Pending(); n != 0 {
+		t.Errorf("got %d pending timers without subscriptions, want 0", n)
+	}
+}
+
+func TestMultipleRoots(t *testing.T) {
+	srv, err := NewServer(newTestRoot())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := srv.AddRoot(newTestRoot()); err == nil {
+		t.Error("want error for duplicate UDN, got nil")
+	}
+	second := newTestRoot()
+	second.Device.UDN = "uuid:11111111-2222-3333-4444-777777777777"
+	second.Device.FriendlyName = "Second light"
+	if err := srv.AddRoot(second); err != nil {
+		t.Fatal(err)
+	}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	for _, hr := range srv.roots {
+		loc, err := url.Parse(ts.URL + hr.descPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		root, err := goupnp.DeviceByURL(loc)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if root.Device.UDN != hr.root.Device.UDN {
+			t.Errorf("want UDN %s at %s, got %s", hr.root.Device.UDN, loc, root.Device.UDN)
+		}
+	}
+	if svc := srv.Service(second.Device.UDN, testServiceID); svc == nil || svc.UDN() != second.Device.UDN {
+		t.Errorf("Bad service for second root: %+v", svc)
+	}
+
+	descPath := srv.roots[1].descPath
+	if !srv.RemoveRoot(second.Device.UDN) {
+		t.Fatal("RemoveRoot returned false")
+	}
+	resp, err := http.Get(ts.URL + descPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("want 404 for removed root, got %s", resp.Status)
+	}
+	if n := len(srv.RootDevices()); n != 1 {
+		t.Errorf("want 1 root device, got %d", n)
+	}
+}
+
+func TestHandlePresentation(t *testing.T) {
+	srv, err := NewServer(newTestRoot())
+	if err != nil {
+		t.Fatal(err)
+	}
+	before := srv.RootDevice()
+	udn := before.Device.UDN
+	err = srv.HandlePresentation(udn, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte("page " + r.URL.Path))
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if before.Device.PresentationURL.Str != "" || srv.RootDevice().Device.PresentationURL.Str == "" {
+		t.Error("HandlePresentation changed the root device in place, rather than replacing it")
+	}
+	if err := srv.HandlePresentation("uuid:00000000-0000-0000-0000-000000000000", http.NotFoundHandler()); err == nil {
+		t.Error("want error for unknown UDN, got nil")
+	}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+
+	loc, err := url.Parse(ts.URL + srv.DescriptionPath(udn))
+	if err != nil {
+		t.Fatal(err)
+	}
+	root, err := goupnp.DeviceByURL(loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pageURL := root.Device.PresentationURL.URL
+	if pageURL.Host != loc.Host {
+		t.Fatalf("presentationURL %s does not resolve against %s", &pageURL, loc)
+	}
+	for path, want := range map[string]string{"": "page /", "style.css": "page /style.css"} {
+		ref, _ := url.Parse(path)
+		resp, err := http.Get(pageURL.ResolveReference(ref).String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, _ := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if string(body) != want {
+			t.Errorf("GET %q: got %q, want %q", path, body, want)
+		}
+	}
+}
+
+func TestUpdateRoot(t *testing.T) {
+	srv, err := NewServer(newTestRoot())
+	if err != nil {
+		t.Fatal(err)
+	}
+	udn := srv.RootDevice().Device.UDN
+	svc := srv.Service("", testServiceID)
+	svc.SetSCPD(testSCPD)
+	ts, loc := newTestServer(t, srv)
+	defer ts.Close()
+
+	configID := func() string {
+		resp, err := http.Get(loc.String())
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		var root struct {
+			ConfigID string `xml:"configId,attr"`
+		}
+		if err := xml.NewDecoder(resp.Body).Decode(&root); err != nil {
+			t.Fatal(err)
+		}
+		return root.ConfigID
+	}
+	before := configID()
+	if before == "" {
+		t.Fatal("description has no configId")
+	}
+	if ads := srv.advertiser.Advertisements(); ads[0].ConfigID != srv.roots[0].configID {
+		t.Errorf("advertised CONFIGID %d, want %d", ads[0].ConfigID, srv.roots[0].configID)
+	}
+
+	const dimmingID = "urn:upnp-org:serviceId:Dimming"
+	err = srv.UpdateRoot(udn, func(root *goupnp.RootDevice) {
+		root.Device.Icons = append(root.Device.Icons, goupnp.Icon{
+			Mimetype: "image/png", Width: 48, Height: 48, Depth: 24,
+			URL: goupnp.URLField{Str: "/icon.png"},
+		})
+		root.Device.Services = append(root.Device.Services, goupnp.Service{
+			ServiceType: "urn:schemas-upnp-org:service:Dimming:1",
+			ServiceId:   dimmingID,
+		})
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	after := configID()
+	if after == before {
+		t.Errorf("configId %s unchanged after update", after)
+	}
+	if srv.Service(udn, testServiceID) != svc {
+		t.Error("existing service replaced by update")
+	}
+	if srv.Service(udn, dimmingID) == nil {
+		t.Error("added service not hosted")
+	}
+	root, err := goupnp.DeviceByURL(loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(root.Device.Icons) != 1 || len(root.Device.Services) != 2 {
+		t.Errorf("got %d icons and %d services, want 1 and 2", len(root.Device.Icons), len(root.Device.Services))
+	}
+
+	// Setting an equal SCPD leaves the configId unchanged.
+	svc.SetSCPD(testSCPD)
+	if id := configID(); id != after {
+		t.Errorf("configId changed from %s to %s after setting the same SCPD", after, id)
+	}
+
+	err = srv.UpdateRoot(udn, func(root *goupnp.RootDevice) {
+		root.Device.Services = root.Device.Services[1:]
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if srv.Service(udn, testServiceID) != nil {
+		t.Error("removed service still hosted")
+	}
+	resp, err := http.Get(ts.URL + svc.desc.SCPDURL.Str)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusNotFound {
+		t.Errorf("SCPD of removed service: got status %s, want 404", resp.Status)
+	}
+
+	if err := srv.UpdateRoot(udn, func(root *goupnp.RootDevice) { root.Device.UDN = NewUDN() }); err == nil {
+		t.Error("UpdateRoot changing the root UDN: got nil error")
+	}
+}
@@ -0,0 +1,511 @@
+package device
+
+import (
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/huin/goupnp/clock"
+	"github.com/huin/goupnp/gena"
+	"github.com/huin/goupnp/internal/logging"
+	"github.com/huin/goupnp/scpd"
+)
+
+const (
+	// maxSubscriptionTimeout is the longest duration granted to
+	// subscriptions, and is granted when no or an infinite timeout is
+	// requested.
+	maxSubscriptionTimeout = 1800 * time.Second
+	// minSubscriptionTimeout is the shortest duration granted to
+	// subscriptions, to limit the rate of renewals.
+	minSubscriptionTimeout = 60 * time.Second
+
+	notifyTimeout = 5 * time.Second
+
+	// maxPendingEvents is the number of event messages queued for a
+	// subscription, beyond which the oldest are dropped. The control point
+	// detects the gap in SEQ, and should resubscribe.
+	maxPendingEvents = 100
+)
+
+// subscription is a control point's subscription to a hosted service.
+type subscription struct {
+	sid       string
+	callbacks []*url.URL
+	expiry    time.Time
+
+	// seq is the SEQ of the next event message.
+	seq uint32
+	// pending are the event messages waiting to be sent, in order.
+	pending []eventMessage
+	// wake is signalled when messages are added to pending.
+	wake chan struct{}
+	// done is closed when the subscription is removed.
+	done chan struct{}
+}
+
+type eventMessage struct {
+	seq   uint32
+	props []gena.Property
+}
+
+// stateVar is the state of a single state variable of a hosted service.
+type stateVar struct {
+	value   string
+	evented bool
+
+	// Moderation, see Service.Moderate.
+	maximumRate  time.Duration
+	minimumDelta float64
+	// sentValue and sentTime are the last evented value, and when.
+	sentValue string
+	sentTime  time.Time
+	// delayed is set while a moderated change is waiting to be sent.
+	delayed bool
+}
+
+// eventPublisher manages the state variables and subscriptions of a hosted
+// service, and sends event messages to the subscriptions.
+type eventPublisher struct {
+	client http.Client
+	logger func() *slog.Logger
+	clock  func() clock.Clock // nil for clock.Real.
+
+	lock     sync.Mutex // Protects all below.
+	subs     map[string]*subscription
+	vars     map[string]*stateVar
+	varNames []string // Names of vars, in the order they were first set.
+	// reaper fires at the earliest expiry of subs, if any.
+	reaper clock.Timer
+}
+
+func (ep *eventPublisher) source() clock.Clock {
+	if ep.clock == nil {
+		return clock.Real
+	}
+	return clock.Or(ep.clock())
+}
+
+func (ep *eventPublisher) init() {
+	ep.client.Timeout = notifyTimeout
+	ep.subs = make(map[string]*subscription)
+	ep.vars = make(map[string]*stateVar)
+}
+
+// SetState sets the values of state variables of the service. Changes to
+// evented variables are sent to all subscribers, subject to any moderation
+// set with Moderate. Variables are evented if they have sendEvents="yes" in
+// the SCPD, or if no SCPD is set, unless their names begin with
+// "A_ARG_TYPE_".
+func (svc *Service) SetState(props ...gena.Property) {
+	s := svc.SCPD()
+	svc.events.setState(props, func(name string) bool { return isEvented(s, name) }, true)
+}
+
+// State returns the current value of the named state variable, and whether
+// it has been set.
+func (svc *Service) State(name string) (string, bool) {
+	svc.events.lock.Lock()
+	defer svc.events.lock.Unlock()
+	v, ok := svc.events.vars[name]
+	if !ok {
+		return "", false
+	}
+	return v.value, true
+}
+
+// Moderate limits the rate at which changes to the named state variable are
+// evented, as for the maximumRate and minimumDelta SCPD elements of UPnP
+// 1.0. Changes are evented at most once per maximumRate, with the latest
+// value. For numeric variables, changes are only evented once the value has
+// moved by at least minimumDelta since it was last evented. Zero values
+// disable each limit.
+func (svc *Service) Moderate(name string, maximumRate time.Duration, minimumDelta float64) {
+	ep := &svc.events
+	ep.lock.Lock()
+	defer ep.lock.Unlock()
+	v := ep.stateVar(name)
+	v.maximumRate = maximumRate
+	v.minimumDelta = minimumDelta
+}
+
+// Notify sends an event message containing props to all subscribers
+// immediately, bypassing moderation. The values are recorded as the state of
+// the service, as for SetState.
+func (svc *Service) Notify(props ...gena.Property) {
+	svc.events.setState(props, func(string) bool { return true }, false)
+}
+
+func isEvented(s *scpd.SCPD, name string) bool {
+	if s == nil {
+		return !strings.HasPrefix(name, "A_ARG_TYPE_")
+	}
+	sv := s.GetStateVariable(name)
+	return sv != nil && sv.Evented()
+}
+
+// stateVar returns the named variable, creating it if needed. ep.lock must
+// be held.
+func (ep *eventPublisher) stateVar(name string) *stateVar {
+	v := ep.vars[name]
+	if v == nil {
+		v = &stateVar{}
+		ep.vars[name] = v
+		ep.varNames = append(ep.varNames, name)
+	}
+	return v
+}
+
+func (ep *eventPublisher) setState(props []gena.Property, evented func(string) bool, moderate bool) {
+	ep.lock.Lock()
+	defer ep.lock.Unlock()
+	now := ep.source().Now()
+	ep.reap(now)
+	var send []gena.Property
+	for _, p := range props {
+		v := ep.stateVar(p.Name)
+		v.value = p.Value
+		v.evented = evented(p.Name)
+		if !v.evented {
+			continue
+		}
+		if moderate {
+			// A delayed change is sent with the latest value when it is due.
+			if v.delayed || !v.deltaExceeded() {
+				continue
+			}
+			if wait := v.sentTime.Add(v.maximumRate).Sub(now); v.maximumRate > 0 && wait > 0 {
+				v.delayed = true
+				name := p.Name
+				ep.source().AfterFunc(wait, func() { ep.sendDelayed(name) })
+				continue
+			}
+		}
+		v.sentValue, v.sentTime = v.value, now
+		send = append(send, p)
+	}
+	if len(send) > 0 {
+		for _, sub := range ep.subs {
+			ep.queue(sub, send)
+		}
+	}
+}
+
+// sendDelayed sends a change that was delayed by the maximum rate of the
+// variable.
+func (ep *eventPublisher) sendDelayed(name string) {
+	ep.lock.Lock()
+	defer ep.lock.Unlock()
+	v := ep.vars[name]
+	v.delayed = false
+	if !v.evented || !v.deltaExceeded() {
+		return
+	}
+	now := ep.source().Now()
+	ep.reap(now)
+	v.sentValue, v.sentTime = v.value, now
+	props := []gena.Property{{Name: name, Value: v.value}}
+	for _, sub := range ep.subs {
+		ep.queue(sub, props)
+	}
+}
+
+// deltaExceeded returns true if the value has changed enough since the last
+// evented value to be evented.
+func (v *stateVar) deltaExceeded() bool {
+	if v.sentTime.IsZero() {
+		return true
+	}
+	if v.minimumDelta <= 0 {
+		return v.value != v.sentValue
+	}
+	value, err1 := strconv.ParseFloat(v.value, 64)
+	sent, err2 := strconv.ParseFloat(v.sentValue, 64)
+	if err1 != nil || err2 != nil {
+		return v.value != v.sentValue
+	}
+	delta := value - sent
+	return delta >= v.minimumDelta || -delta >= v.minimumDelta
+}
+
+// initialProps returns the current values of all evented variables, for the
+// initial event message. ep.lock must be held.
+func (ep *eventPublisher) initialProps() []gena.Property {
+	var props []gena.Property
+	for _, name := range ep.varNames {
+		if v := ep.vars[name]; v.evented {
+			props = append(props, gena.Property{Name: name, Value: v.value})
+		}
+	}
+	return props
+}
+
+// queue adds an event message to be sent to a subscription. ep.lock must be
+// held.
+func (ep *eventPublisher) queue(sub *subscription, props []gena.Property) {
+	if len(sub.pending) >= maxPendingEvents {
+		sub.pending = sub.pending[1:]
+	}
+	sub.pending = append(sub.pending, eventMessage{seq: sub.seq, props: props})
+	// SEQ wraps to 1 rather than 0, as 0 is reserved for the initial event.
+	if sub.seq == ^uint32(0) {
+		sub.seq = 1
+	} else {
+		sub.seq++
+	}
+	select {
+	case sub.wake <- struct{}{}:
+	default:
+	}
+}
+
+// deliver sends the event messages queued for sub, in order, until the
+// subscription is removed.
+func (ep *eventPublisher) deliver(sub *subscription) {
+	for {
+		select {
+		case <-sub.wake:
+		case <-sub.done:
+			return
+		}
+		ep.lock.Lock()
+		msgs := sub.pending
+		sub.pending = nil
+		ep.lock.Unlock()
+		for _, msg := range msgs {
+			select {
+			case <-sub.done:
+				return
+			default:
+			}
+			ep.lock.Lock()
+			expired := !ep.source().Now().Before(sub.expiry)
+			if expired {
+				ep.remove(sub)
+			}
+			ep.lock.Unlock()
+			if expired {
+				return
+			}
+			ep.send(sub, msg)
+		}
+	}
+}
+
+// send sends an event message to the first callback URL of sub that accepts
+// it, trying each in turn.
+func (ep *eventPublisher) send(sub *subscription, msg eventMessage) {
+	for _, callback := range sub.callbacks {
+		req, err := gena.NewNotifyRequest(callback, sub.sid, msg.seq, msg.props)
+		if err != nil {
+			ep.logger().Warn("device: error creating event message", slog.String(logging.KeySID, sub.sid), logging.Err(err))
+			return
+		}
+		resp, err := ep.client.Do(req)
+		if err != nil {
+			ep.logger().Warn("device: error sending event message", slog.String("callback", callback.String()),
+				slog.String(logging.KeySID, sub.sid), logging.Err(err))
+			continue
+		}
+		resp.Body.Close()
+		if resp.StatusCode == http.StatusOK {
+			return
+		}
+		ep.logger().Warn("device: event message refused", slog.String("callback", callback.String()),
+			slog.String(logging.KeySID, sub.sid), slog.String("status", resp.Status))
+	}
+}
+
+// remove removes a subscription, if it has not been already, which stops its
+// deliver goroutine. ep.lock must be held.
+func (ep *eventPublisher) remove(sub *subscription) {
+	if ep.subs[sub.sid] != sub {
+		return
+	}
+	delete(ep.subs, sub.sid)
+	close(sub.done)
+}
+
+// reap removes expired subscriptions, and schedules itself for the next
+// expiry. It is also called whenever the subscriptions are used, so that
+// they are never used once expired even if the timer is late. ep.lock must
+// be held.
+func (ep *eventPublisher) reap(now time.Time) {
+	var next time.Time
+	for _, sub := range ep.subs {
+		if !now.Before(sub.expiry) {
+			ep.remove(sub)
+		} else if next.IsZero() || sub.expiry.Before(next) {
+			next = sub.expiry
+		}
+	}
+	switch {
+	case next.IsZero():
+		if ep.reaper != nil {
+			ep.reaper.Stop()
+		}
+	case ep.reaper == nil:
+		ep.reaper = ep.source().AfterFunc(next.Sub(now), ep.reapExpired)
+	default:
+		ep.reaper.Reset(next.Sub(now))
+	}
+}
+
+// reapExpired is called by the reaper timer.
+func (ep *eventPublisher) reapExpired() {
+	ep.lock.Lock()
+	defer ep.lock.Unlock()
+	ep.reap(ep.source().Now())
+}
+
+// closeAll removes all subscriptions.
+func (ep *eventPublisher) closeAll() {
+	ep.lock.Lock()
+	defer ep.lock.Unlock()
+	for _, sub := range ep.subs {
+		ep.remove(sub)
+	}
+	if ep.reaper != nil {
+		ep.reaper.Stop()
+	}
+}
+
+func (svc *Service) serveEvent(w http.ResponseWriter, r *http.Request) {
+	switch r.Method {
+	case "SUBSCRIBE":
+		svc.events.serveSubscribe(w, r)
+	case "UNSUBSCRIBE":
+		svc.events.serveUnsubscribe(w, r)
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+	}
+}
+
+func (ep *eventPublisher) serveSubscribe(w http.ResponseWriter, r *http.Request) {
+	// The response is written without ep.lock, so that a slow subscriber does
+	// not hold up the events of the others.
+	sub, timeout, isNew, status, reason := ep.subscribe(r)
+	if status != http.StatusOK {
+		http.Error(w, reason, status)
+		return
+	}
+	w.Header()["SID"] = []string{sub.sid}
+	w.Header()["TIMEOUT"] = []string{gena.FormatTimeout(timeout)}
+	w.WriteHeader(http.StatusOK)
+
+	if isNew {
+		// The initial event message must not arrive before the response, so
+		// flush the response before delivering it.
+		if f, ok := w.(http.Flusher); ok {
+			f.Flush()
+		}
+		go ep.deliver(sub)
+	}
+}
+
+// subscribe adds or renews the subscription requested by r, returning it with
+// its timeout and whether it is new, or the status and reason to fail the
+// request with if the status is not http.StatusOK. The initial event message
+// of a new subscription is queued before any other.
+func (ep *eventPublisher) subscribe(r *http.Request) (sub *subscription, timeout time.Duration, isNew bool, status int, reason string) {
+	ep.lock.Lock()
+	defer ep.lock.Unlock()
+	now := ep.source().Now()
+	ep.reap(now)
+
+	sid := r.Header.Get("SID")
+	nt := r.Header.Get("NT")
+	callback := r.Header.Get("CALLBACK")
+	switch {
+	case sid != "" && (nt != "" || callback != ""):
+		return nil, 0, false, http.StatusBadRequest, "SID with NT or CALLBACK header"
+	case sid != "":
+		// Renewal.
+		if sub = ep.subs[sid]; sub == nil {
+			return nil, 0, false, http.StatusPreconditionFailed, "no such subscription"
+		}
+	default:
+		if nt != "upnp:event" {
+			return nil, 0, false, http.StatusPreconditionFailed, "bad NT header"
+		}
+		callbacks := parseCallbacks(callback)
+		if len(callbacks) == 0 {
+			return nil, 0, false, http.StatusPreconditionFailed, "bad CALLBACK header"
+		}
+		sub = &subscription{
+			sid:       uuidPrefix + newUUID(),
+			callbacks: callbacks,
+			wake:      make(chan struct{}, 1),
+			done:      make(chan struct{}),
+		}
+		ep.subs[sub.sid] = sub
+		isNew = true
+		if props := ep.initialProps(); len(props) > 0 {
+			ep.queue(sub, props)
+		}
+	}
+	timeout = negotiateTimeout(r.Header.Get("TIMEOUT"))
+	sub.expiry = now.Add(timeout)
+	// Reschedule the reaper for the new expiry.
+	ep.reap(now)
+	return sub, timeout, isNew, http.StatusOK, ""
+}
+
+// negotiateTimeout returns the subscription duration to grant for the
+// requested TIMEOUT header value.
+func negotiateTimeout(requested string) time.Duration {
+	timeout, err := gena.ParseTimeout(requested)
+	switch {
+	case err != nil, timeout == 0, timeout > maxSubscriptionTimeout:
+		return maxSubscriptionTimeout
+	case timeout < minSubscriptionTimeout:
+		return minSubscriptionTimeout
+	}
+	return timeout
+}
+
+func (ep *eventPublisher) serveUnsubscribe(w http.ResponseWriter, r *http.Request) {
+	if status, reason := ep.unsubscribe(r); status != http.StatusOK {
+		http.Error(w, reason, status)
+		return
+	}
+	w.WriteHeader(http.StatusOK)
+}
+
+// unsubscribe removes the subscription of r, as subscribe adds it.
+func (ep *eventPublisher) unsubscribe(r *http.Request) (status int, reason string) {
+	ep.lock.Lock()
+	defer ep.lock.Unlock()
+	ep.reap(ep.source().Now())
+	if r.Header.Get("NT") != "" || r.Header.Get("CALLBACK") != "" {
+		return http.StatusBadRequest, "NT or CALLBACK header in UNSUBSCRIBE"
+	}
+	sub, ok := ep.subs[r.Header.Get("SID")]
+	if !ok {
+		return http.StatusPreconditionFailed, "no such subscription"
+	}
+	ep.remove(sub)
+	return http.StatusOK, ""
+}
+
+// parseCallbacks parses a CALLBACK header of the form "<url1><url2>...",
+// returning the valid http(s) URLs within it.
+func parseCallbacks(s string) []*url.URL {
+	var callbacks []*url.URL
+	for _, part := range strings.Split(s, ">") {
+		part = strings.TrimSpace(part)
+		if !strings.HasPrefix(part, "<") {
+			continue
+		}
+		u, err := url.Parse(part[1:])
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			continue
+		}
+		callbacks = append(callbacks, u)
+	}
+	return callbacks
+}
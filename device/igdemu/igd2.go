@@ -0,0 +1,227 @@
+package igdemu
+
+import (
+	"context"
+	"encoding/xml"
+
+	"github.com/huin/goupnp/dcps/internetgateway1"
+	"github.com/huin/goupnp/dcps/internetgateway2"
+	"github.com/huin/goupnp/soap"
+)
+
+// Error codes returned by WANIPConnection:2 actions only, as defined by the
+// WANIPConnection:2 service specification.
+const (
+	ErrCodePortMappingNotFound = 730
+	ErrCodeInconsistentParams  = 733
+)
+
+// NewIGD2 creates and starts an Emulator of an InternetGatewayDevice v2,
+// hosting a WANIPConnection:2 service which shares the behaviour of the
+// WANIPConnection:1 one, and in addition has AddAnyPortMapping,
+// DeletePortMappingRange and GetListOfPortMappings. Close must be called to
+// stop it.
+func NewIGD2() (*Emulator, error) {
+	return newEmulator(internetgateway2.URN_InternetGatewayDevice_2, internetgateway2.URN_WANDevice_2, internetgateway2.URN_WANConnectionDevice_2,
+		internetgateway2.URN_WANIPConnection_2, func(e *Emulator) {
+			internetgateway2.RegisterWANIPConnection2Handler(e.service, wanIPConnection2{wanIPConnection{e}})
+		})
+}
+
+// wanIPConnection2 implements the WANIPConnection:2 service of an Emulator,
+// with the actions of WANIPConnection:1 delegated to wanIPConnection.
+type wanIPConnection2 struct {
+	v1 wanIPConnection
+}
+
+var _ internetgateway2.WANIPConnection2Handler = wanIPConnection2{}
+
+func protocol1(p internetgateway2.WANIPConnection2PortMappingProtocol) internetgateway1.WANIPConnection1PortMappingProtocol {
+	return internetgateway1.WANIPConnection1PortMappingProtocol(p)
+}
+
+func (c wanIPConnection2) SetConnectionType(ctx context.Context, NewConnectionType string) error {
+	return c.v1.SetConnectionType(ctx, NewConnectionType)
+}
+
+func (c wanIPConnection2) GetConnectionTypeInfo(ctx context.Context) (string, string, error) {
+	connType, possible, err := c.v1.GetConnectionTypeInfo(ctx)
+	return connType, string(possible), err
+}
+
+func (c wanIPConnection2) RequestConnection(ctx context.Context) error {
+	return c.v1.RequestConnection(ctx)
+}
+
+func (c wanIPConnection2) RequestTermination(ctx context.Context) error {
+	return c.v1.RequestTermination(ctx)
+}
+
+func (c wanIPConnection2) ForceTermination(ctx context.Context) error {
+	return c.v1.ForceTermination(ctx)
+}
+
+func (c wanIPConnection2) SetAutoDisconnectTime(ctx context.Context, NewAutoDisconnectTime uint32) error {
+	return c.v1.SetAutoDisconnectTime(ctx, NewAutoDisconnectTime)
+}
+
+func (c wanIPConnection2) SetIdleDisconnectTime(ctx context.Context, NewIdleDisconnectTime uint32) error {
+	return c.v1.SetIdleDisconnectTime(ctx, NewIdleDisconnectTime)
+}
+
+func (c wanIPConnection2) SetWarnDisconnectDelay(ctx context.Context, NewWarnDisconnectDelay uint32) error {
+	return c.v1.SetWarnDisconnectDelay(ctx, NewWarnDisconnectDelay)
+}
+
+func (c wanIPConnection2) GetStatusInfo(ctx context.Context) (
+	internetgateway2.WANIPConnection2ConnectionStatus, internetgateway2.WANIPConnection2LastConnectionError, uint32, error) {
+	status, lastErr, uptime, err := c.v1.GetStatusInfo(ctx)
+	return internetgateway2.WANIPConnection2ConnectionStatus(status), internetgateway2.WANIPConnection2LastConnectionError(lastErr), uptime, err
+}
+
+func (c wanIPConnection2) GetAutoDisconnectTime(ctx context.Context) (uint32, error) {
+	return c.v1.GetAutoDisconnectTime(ctx)
+}
+
+func (c wanIPConnection2) GetIdleDisconnectTime(ctx context.Context) (uint32, error) {
+	return c.v1.GetIdleDisconnectTime(ctx)
+}
+
+func (c wanIPConnection2) GetWarnDisconnectDelay(ctx context.Context) (uint32, error) {
+	return c.v1.GetWarnDisconnectDelay(ctx)
+}
+
+func (c wanIPConnection2) GetNATRSIPStatus(ctx context.Context) (bool, bool, error) {
+	return c.v1.GetNATRSIPStatus(ctx)
+}
+
+func (c wanIPConnection2) GetGenericPortMappingEntry(ctx context.Context, NewPortMappingIndex uint16) (
+	string, uint16, internetgateway2.WANIPConnection2PortMappingProtocol, uint16, string, bool, string, uint32, error) {
+	remoteHost, externalPort, protocol, internalPort, client, enabled, desc, lease, err := c.v1.GetGenericPortMappingEntry(ctx, NewPortMappingIndex)
+	return remoteHost, externalPort, internetgateway2.WANIPConnection2PortMappingProtocol(protocol), internalPort, client, enabled, desc, lease, err
+}
+
+func (c wanIPConnection2) GetSpecificPortMappingEntry(ctx context.Context, NewRemoteHost string, NewExternalPort uint16, NewProtocol internetgateway2.WANIPConnection2PortMappingProtocol) (
+	uint16, string, bool, string, uint32, error) {
+	return c.v1.GetSpecificPortMappingEntry(ctx, NewRemoteHost, NewExternalPort, protocol1(NewProtocol))
+}
+
+func (c wanIPConnection2) AddPortMapping(ctx context.Context, NewRemoteHost string, NewExternalPort uint16, NewProtocol internetgateway2.WANIPConnection2PortMappingProtocol,
+	NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32) error {
+	return c.v1.AddPortMapping(ctx, NewRemoteHost, NewExternalPort, protocol1(NewProtocol),
+		NewInternalPort, NewInternalClient, NewEnabled, NewPortMappingDescription, NewLeaseDuration)
+}
+
+func (c wanIPConnection2) DeletePortMapping(ctx context.Context, NewRemoteHost string, NewExternalPort uint16, NewProtocol internetgateway2.WANIPConnection2PortMappingProtocol) error {
+	return c.v1.DeletePortMapping(ctx, NewRemoteHost, NewExternalPort, protocol1(NewProtocol))
+}
+
+func (c wanIPConnection2) DeletePortMappingRange(ctx context.Context, NewStartPort uint16, NewEndPort uint16, NewProtocol internetgateway2.WANIPConnection2PortMappingProtocol, NewManage bool) error {
+	e := c.v1.e
+	defer e.lock.Unlock()
+	if err := e.begin("DeletePortMappingRange"); err != nil {
+		return err
+	}
+	if NewStartPort > NewEndPort {
+		return soap.NewUPnPError(ErrCodeInconsistentParams, "InconsistentParameters")
+	}
+	kept := e.mappings[:0]
+	deleted := false
+	for _, m := range e.mappings {
+		if m.Protocol == string(NewProtocol) && m.ExternalPort >= NewStartPort && m.ExternalPort <= NewEndPort {
+			deleted = true
+			continue
+		}
+		kept = append(kept, m)
+	}
+	e.mappings = kept
+	if !deleted {
+		return soap.NewUPnPError(ErrCodePortMappingNotFound, "PortMappingNotFound")
+	}
+	return nil
+}
+
+func (c wanIPConnection2) GetExternalIPAddress(ctx context.Context) (string, error) {
+	return c.v1.GetExternalIPAddress(ctx)
+}
+
+// portMappingEntry is an entry of the PortListing of GetListOfPortMappings.
+type portMappingEntry struct {
+	RemoteHost     string `xml:"p:NewRemoteHost"`
+	ExternalPort   uint16 `xml:"p:NewExternalPort"`
+	Protocol       string `xml:"p:NewProtocol"`
+	InternalPort   uint16 `xml:"p:NewInternalPort"`
+	InternalClient string `xml:"p:NewInternalClient"`
+	Enabled        int    `xml:"p:NewEnabled"`
+	Description    string `xml:"p:NewDescription"`
+	LeaseTime      uint32 `xml:"p:NewLeaseTime"`
+}
+
+func (c wanIPConnection2) GetListOfPortMappings(ctx context.Context, NewStartPort uint16, NewEndPort uint16, NewProtocol internetgateway2.WANIPConnection2PortMappingProtocol, NewManage bool, NewNumberOfPorts uint16) (string, error) {
+	e := c.v1.e
+	defer e.lock.Unlock()
+	if err := e.begin("GetListOfPortMappings"); err != nil {
+		return "", err
+	}
+	if NewStartPort > NewEndPort {
+		return "", soap.NewUPnPError(ErrCodeInconsistentParams, "InconsistentParameters")
+	}
+	var listing struct {
+		XMLName xml.Name           `xml:"p:PortMappingList"`
+		NS      string             `xml:"xmlns:p,attr"`
+		Entries []portMappingEntry `xml:"p:PortMappingEntry"`
+	}
+	listing.NS = "urn:schemas-upnp-org:gw:WANIPConnection"
+	for _, m := range e.mappings {
+		if m.Protocol != string(NewProtocol) || m.ExternalPort < NewStartPort || m.ExternalPort > NewEndPort {
+			continue
+		}
+		if NewNumberOfPorts > 0 && len(listing.Entries) == int(NewNumberOfPorts) {
+			break
+		}
+		entry := portMappingEntry{m.RemoteHost, m.ExternalPort, m.Protocol, m.InternalPort, m.InternalClient, 0, m.Description, m.remainingLease()}
+		if m.Enabled {
+			entry.Enabled = 1
+		}
+		listing.Entries = append(listing.Entries, entry)
+	}
+	if len(listing.Entries) == 0 {
+		return "", soap.NewUPnPError(ErrCodePortMappingNotFound, "PortMappingNotFound")
+	}
+	b, err := xml.Marshal(&listing)
+	if err != nil {
+		return "", err
+	}
+	return xml.Header + string(b), nil
+}
+
+// AddAnyPortMapping adds the mapping, on the requested external port if it is
+// free or already mapped to the same client, and otherwise on the next free
+// port.
+func (c wanIPConnection2) AddAnyPortMapping(ctx context.Context, NewRemoteHost string, NewExternalPort uint16, NewProtocol internetgateway2.WANIPConnection2PortMappingProtocol,
+	NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32) (uint16, error) {
+	e := c.v1.e
+	defer e.lock.Unlock()
+	if err := e.begin("AddAnyPortMapping"); err != nil {
+		return 0, err
+	}
+	port := NewExternalPort
+	for tries := 0; ; tries++ {
+		i := e.findMapping(NewRemoteHost, port, string(NewProtocol))
+		if i < 0 || e.mappings[i].InternalClient == NewInternalClient {
+			break
+		}
+		if tries == 65535 {
+			return 0, soap.NewUPnPError(ErrCodeConflictInMappingEntry, "ConflictInMappingEntry")
+		}
+		if port++; port == 0 {
+			port = 1
+		}
+	}
+	err := e.addMapping(NewRemoteHost, port, protocol1(NewProtocol), NewInternalPort, NewInternalClient,
+		NewEnabled, NewPortMappingDescription, NewLeaseDuration)
+	if err != nil {
+		return 0, err
+	}
+	return port, nil
+}
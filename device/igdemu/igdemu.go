@@ -0,0 +1,462 @@
+// Package igdemu provides an emulated UPnP InternetGatewayDevice v1, for
+// testing applications that use the internetgateway1 clients without a real
+// router, and an InternetGatewayDevice v2 for the internetgateway2 clients.
+//
+// The emulator hosts a WANIPConnection:1 service, or a WANIPConnection:2
+// service as created by NewIGD2, with an in-memory port mapping table, within
+// the WANDevice and WANConnectionDevice hierarchy that routers present. Faults
+// can be injected into any action. Changes of the ConnectionStatus and
+// ExternalIPAddress state variables are evented.
+package igdemu
+
+import (
+	"context"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/huin/goupnp/dcps/internetgateway1"
+	"github.com/huin/goupnp/device"
+	"github.com/huin/goupnp/gena"
+	"github.com/huin/goupnp/soap"
+)
+
+const (
+	urnInternetGatewayDevice1 = "urn:schemas-upnp-org:device:InternetGatewayDevice:1"
+	serviceIDWANIPConn1       = "urn:upnp-org:serviceId:WANIPConn1"
+
+	// DefaultExternalIP is the external IP address reported until
+	// SetExternalIP is called.
+	DefaultExternalIP = "203.0.113.1"
+)
+
+// Error codes returned by WANIPConnection:1 actions, as defined by the
+// WANIPConnection:1 service specification.
+const (
+	ErrCodeSpecifiedArrayIndexInvalid = 713
+	ErrCodeNoSuchEntryInArray         = 714
+	ErrCodeConflictInMappingEntry     = 718
+)
+
+// PortMapping is an entry in the port mapping table of an Emulator.
+type PortMapping struct {
+	RemoteHost     string
+	ExternalPort   uint16
+	Protocol       string
+	InternalPort   uint16
+	InternalClient string
+	Enabled        bool
+	Description    string
+	// LeaseDuration is the lease requested when the mapping was added, in
+	// seconds, or 0 for a permanent mapping.
+	LeaseDuration uint32
+	// Expiry is when the mapping is removed, zero for a permanent mapping.
+	Expiry time.Time
+}
+
+// Emulator is an emulated InternetGatewayDevice, served over HTTP on the
+// loopback interface. It does not advertise itself via SSDP, so clients
+// should be created with internetgateway1.NewWANIPConnection1ClientsByURL
+// using Location.
+type Emulator struct {
+	server   *device.Server
+	service  *device.Service
+	http     *httptest.Server
+	location *url.URL
+
+	lock             sync.Mutex // Protects all below.
+	externalIP       string
+	connectionStatus string
+	connectedAt      time.Time
+	autoDisconnect   uint32
+	idleDisconnect   uint32
+	warnDisconnect   uint32
+	mappings         []PortMapping
+	faults           map[string]error
+}
+
+// New creates and starts an Emulator. Close must be called to stop it.
+func New() (*Emulator, error) {
+	return newEmulator(urnInternetGatewayDevice1, internetgateway1.URN_WANDevice_1, internetgateway1.URN_WANConnectionDevice_1,
+		internetgateway1.URN_WANIPConnection_1, func(e *Emulator) {
+			internetgateway1.RegisterWANIPConnection1Handler(e.service, wanIPConnection{e})
+		})
+}
+
+// newEmulator creates and starts an Emulator of the given device and service
+// types, whose actions are registered by register.
+func newEmulator(rootType, wanDeviceType, wanConnDeviceType, serviceType string, register func(e *Emulator)) (*Emulator, error) {
+	wanConnDevice := device.NewDeviceBuilder(wanConnDeviceType, "WANConnectionDevice").
+		Manufacturer("goupnp", "").
+		Model("igdemu", "", "", "").
+		Service(serviceType, serviceIDWANIPConn1, nil)
+	wanDevice := device.NewDeviceBuilder(wanDeviceType, "WANDevice").
+		Manufacturer("goupnp", "").
+		Model("igdemu", "", "", "").
+		Device(wanConnDevice)
+	root := device.NewDeviceBuilder(rootType, "Emulated Internet Gateway Device").
+		Manufacturer("goupnp", "").
+		Model("igdemu", "1", "Emulated InternetGatewayDevice for testing", "").
+		Device(wanDevice)
+	server, err := root.NewServer()
+	if err != nil {
+		return nil, err
+	}
+
+	e := &Emulator{
+		server:           server,
+		externalIP:       DefaultExternalIP,
+		connectionStatus: "Connected",
+		connectedAt:      time.Now(),
+		faults:           make(map[string]error),
+	}
+	e.service = server.Service("", serviceIDWANIPConn1)
+	register(e)
+	e.service.SetState(
+		gena.Property{Name: "ConnectionStatus", Value: e.connectionStatus},
+		gena.Property{Name: "ExternalIPAddress", Value: e.externalIP},
+	)
+
+	e.http = httptest.NewServer(server)
+	udn := server.RootDevice().Device.UDN
+	if e.location, err = url.Parse(e.http.URL + server.DescriptionPath(udn)); err != nil {
+		e.http.Close()
+		return nil, err
+	}
+	return e, nil
+}
+
+// Close stops the emulator.
+func (e *Emulator) Close() {
+	e.http.Close()
+	e.server.Close()
+}
+
+// Location returns the URL of the root device description.
+func (e *Emulator) Location() *url.URL {
+	u := *e.location
+	return &u
+}
+
+// Server returns the server hosting the emulated device, e.g to access the
+// hosted services directly.
+func (e *Emulator) Server() *device.Server {
+	return e.server
+}
+
+// SetExternalIP sets the address returned by GetExternalIPAddress.
+func (e *Emulator) SetExternalIP(ip string) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.externalIP = ip
+	e.service.SetState(gena.Property{Name: "ExternalIPAddress", Value: ip})
+}
+
+// SetConnectionStatus sets the connection status returned by GetStatusInfo,
+// e.g "Connected" or "Disconnected".
+func (e *Emulator) SetConnectionStatus(status string) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.setConnectionStatus(status)
+}
+
+func (e *Emulator) setConnectionStatus(status string) {
+	if status == "Connected" && e.connectionStatus != "Connected" {
+		e.connectedAt = time.Now()
+	}
+	e.connectionStatus = status
+	e.service.SetState(gena.Property{Name: "ConnectionStatus", Value: status})
+}
+
+// InjectFault makes all subsequent invocations of the named action fail with
+// err, which is typically a *soap.UPnPError. A nil err removes the fault.
+func (e *Emulator) InjectFault(action string, err error) {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	if err == nil {
+		delete(e.faults, action)
+	} else {
+		e.faults[action] = err
+	}
+}
+
+// PortMappings returns a copy of the current port mapping table.
+func (e *Emulator) PortMappings() []PortMapping {
+	e.lock.Lock()
+	defer e.lock.Unlock()
+	e.expireMappings()
+	return append([]PortMapping(nil), e.mappings...)
+}
+
+// begin locks e, removes expired mappings, and returns the fault injected for
+// the action, if any. The caller must unlock e.
+func (e *Emulator) begin(action string) error {
+	e.lock.Lock()
+	e.expireMappings()
+	return e.faults[action]
+}
+
+// expireMappings removes mappings whose leases have expired. e.lock must be
+// held.
+func (e *Emulator) expireMappings() {
+	now := time.Now()
+	kept := e.mappings[:0]
+	for _, m := range e.mappings {
+		if m.Expiry.IsZero() || now.Before(m.Expiry) {
+			kept = append(kept, m)
+		}
+	}
+	e.mappings = kept
+}
+
+// findMapping returns the index of the mapping with the given key, or -1.
+// e.lock must be held.
+func (e *Emulator) findMapping(remoteHost string, externalPort uint16, protocol string) int {
+	for i, m := range e.mappings {
+		if m.RemoteHost == remoteHost && m.ExternalPort == externalPort && m.Protocol == protocol {
+			return i
+		}
+	}
+	return -1
+}
+
+func invalidArgs(description string) error {
+	return soap.NewUPnPError(soap.ErrCodeInvalidArgs, description)
+}
+
+func noSuchEntry() error {
+	return soap.NewUPnPError(ErrCodeNoSuchEntryInArray, "NoSuchEntryInArray")
+}
+
+// wanIPConnection implements the WANIPConnection:1 service of an Emulator.
+type wanIPConnection struct {
+	e *Emulator
+}
+
+var _ internetgateway1.WANIPConnection1Handler = wanIPConnection{}
+
+func (c wanIPConnection) SetConnectionType(ctx context.Context, NewConnectionType string) error {
+	defer c.e.lock.Unlock()
+	if err := c.e.begin("SetConnectionType"); err != nil {
+		return err
+	}
+	if NewConnectionType != "IP_Routed" {
+		return invalidArgs("unsupported connection type " + NewConnectionType)
+	}
+	return nil
+}
+
+func (c wanIPConnection) GetConnectionTypeInfo(ctx context.Context) (string, internetgateway1.WANIPConnection1PossibleConnectionTypes, error) {
+	defer c.e.lock.Unlock()
+	if err := c.e.begin("GetConnectionTypeInfo"); err != nil {
+		return "", "", err
+	}
+	return "IP_Routed", internetgateway1.WANIPConnection1PossibleConnectionTypes_IP_Routed, nil
+}
+
+func (c wanIPConnection) RequestConnection(ctx context.Context) error {
+	defer c.e.lock.Unlock()
+	if err := c.e.begin("RequestConnection"); err != nil {
+		return err
+	}
+	c.e.setConnectionStatus("Connected")
+	return nil
+}
+
+func (c wanIPConnection) RequestTermination(ctx context.Context) error {
+	defer c.e.lock.Unlock()
+	if err := c.e.begin("RequestTermination"); err != nil {
+		return err
+	}
+	c.e.setConnectionStatus("Disconnected")
+	return nil
+}
+
+func (c wanIPConnection) ForceTermination(ctx context.Context) error {
+	defer c.e.lock.Unlock()
+	if err := c.e.begin("ForceTermination"); err != nil {
+		return err
+	}
+	c.e.setConnectionStatus("Disconnected")
+	return nil
+}
+
+func (c wanIPConnection) SetAutoDisconnectTime(ctx context.Context, NewAutoDisconnectTime uint32) error {
+	defer c.e.lock.Unlock()
+	if err := c.e.begin("SetAutoDisconnectTime"); err != nil {
+		return err
+	}
+	c.e.autoDisconnect = NewAutoDisconnectTime
+	return nil
+}
+
+func (c wanIPConnection) SetIdleDisconnectTime(ctx context.Context, NewIdleDisconnectTime uint32) error {
+	defer c.e.lock.Unlock()
+	if err := c.e.begin("SetIdleDisconnectTime"); err != nil {
+		return err
+	}
+	c.e.idleDisconnect = NewIdleDisconnectTime
+	return nil
+}
+
+func (c wanIPConnection) SetWarnDisconnectDelay(ctx context.Context, NewWarnDisconnectDelay uint32) error {
+	defer c.e.lock.Unlock()
+	if err := c.e.begin("SetWarnDisconnectDelay"); err != nil {
+		return err
+	}
+	c.e.warnDisconnect = NewWarnDisconnectDelay
+	return nil
+}
+
+func (c wanIPConnection) GetStatusInfo(ctx context.Context) (
+	internetgateway1.WANIPConnection1ConnectionStatus, internetgateway1.WANIPConnection1LastConnectionError, uint32, error) {
+	defer c.e.lock.Unlock()
+	if err := c.e.begin("GetStatusInfo"); err != nil {
+		return "", "", 0, err
+	}
+	var uptime uint32
+	if c.e.connectionStatus == "Connected" {
+		uptime = uint32(time.Since(c.e.connectedAt) / time.Second)
+	}
+	return internetgateway1.WANIPConnection1ConnectionStatus(c.e.connectionStatus),
+		internetgateway1.WANIPConnection1LastConnectionError_ERROR_NONE, uptime, nil
+}
+
+func (c wanIPConnection) GetAutoDisconnectTime(ctx context.Context) (uint32, error) {
+	defer c.e.lock.Unlock()
+	if err := c.e.begin("GetAutoDisconnectTime"); err != nil {
+		return 0, err
+	}
+	return c.e.autoDisconnect, nil
+}
+
+func (c wanIPConnection) GetIdleDisconnectTime(ctx context.Context) (uint32, error) {
+	defer c.e.lock.Unlock()
+	if err := c.e.begin("GetIdleDisconnectTime"); err != nil {
+		return 0, err
+	}
+	return c.e.idleDisconnect, nil
+}
+
+func (c wanIPConnection) GetWarnDisconnectDelay(ctx context.Context) (uint32, error) {
+	defer c.e.lock.Unlock()
+	if err := c.e.begin("GetWarnDisconnectDelay"); err != nil {
+		return 0, err
+	}
+	return c.e.warnDisconnect, nil
+}
+
+func (c wanIPConnection) GetNATRSIPStatus(ctx context.Context) (bool, bool, error) {
+	defer c.e.lock.Unlock()
+	if err := c.e.begin("GetNATRSIPStatus"); err != nil {
+		return false, false, err
+	}
+	return false, true, nil
+}
+
+func (c wanIPConnection) GetGenericPortMappingEntry(ctx context.Context, NewPortMappingIndex uint16) (
+	string, uint16, internetgateway1.WANIPConnection1PortMappingProtocol, uint16, string, bool, string, uint32, error) {
+	defer c.e.lock.Unlock()
+	if err := c.e.begin("GetGenericPortMappingEntry"); err != nil {
+		return "", 0, "", 0, "", false, "", 0, err
+	}
+	if int(NewPortMappingIndex) >= len(c.e.mappings) {
+		return "", 0, "", 0, "", false, "", 0, soap.NewUPnPError(ErrCodeSpecifiedArrayIndexInvalid, "SpecifiedArrayIndexInvalid")
+	}
+	m := c.e.mappings[NewPortMappingIndex]
+	return m.RemoteHost, m.ExternalPort, internetgateway1.WANIPConnection1PortMappingProtocol(m.Protocol), m.InternalPort, m.InternalClient, m.Enabled, m.Description, m.remainingLease(), nil
+}
+
+func (c wanIPConnection) GetSpecificPortMappingEntry(ctx context.Context, NewRemoteHost string, NewExternalPort uint16, NewProtocol internetgateway1.WANIPConnection1PortMappingProtocol) (
+	uint16, string, bool, string, uint32, error) {
+	defer c.e.lock.Unlock()
+	if err := c.e.begin("GetSpecificPortMappingEntry"); err != nil {
+		return 0, "", false, "", 0, err
+	}
+	i := c.e.findMapping(NewRemoteHost, NewExternalPort, string(NewProtocol))
+	if i < 0 {
+		return 0, "", false, "", 0, noSuchEntry()
+	}
+	m := c.e.mappings[i]
+	return m.InternalPort, m.InternalClient, m.Enabled, m.Description, m.remainingLease(), nil
+}
+
+func (c wanIPConnection) AddPortMapping(ctx context.Context, NewRemoteHost string, NewExternalPort uint16, NewProtocol internetgateway1.WANIPConnection1PortMappingProtocol,
+	NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32) error {
+	defer c.e.lock.Unlock()
+	if err := c.e.begin("AddPortMapping"); err != nil {
+		return err
+	}
+	return c.e.addMapping(NewRemoteHost, NewExternalPort, NewProtocol, NewInternalPort, NewInternalClient,
+		NewEnabled, NewPortMappingDescription, NewLeaseDuration)
+}
+
+// addMapping adds or updates a mapping as for AddPortMapping. e.lock must be
+// held.
+func (e *Emulator) addMapping(NewRemoteHost string, NewExternalPort uint16, NewProtocol internetgateway1.WANIPConnection1PortMappingProtocol,
+	NewInternalPort uint16, NewInternalClient string, NewEnabled bool, NewPortMappingDescription string, NewLeaseDuration uint32) error {
+	if !NewProtocol.Valid() {
+		return invalidArgs("bad protocol " + string(NewProtocol))
+	}
+	if NewInternalPort == 0 || NewInternalClient == "" {
+		return invalidArgs("missing internal port or client")
+	}
+	m := PortMapping{
+		RemoteHost:     NewRemoteHost,
+		ExternalPort:   NewExternalPort,
+		Protocol:       string(NewProtocol),
+		InternalPort:   NewInternalPort,
+		InternalClient: NewInternalClient,
+		Enabled:        NewEnabled,
+		Description:    NewPortMappingDescription,
+		LeaseDuration:  NewLeaseDuration,
+	}
+	if NewLeaseDuration > 0 {
+		m.Expiry = time.Now().Add(time.Duration(NewLeaseDuration) * time.Second)
+	}
+	// An existing mapping may be updated, but only by the same client.
+	if i := e.findMapping(NewRemoteHost, NewExternalPort, string(NewProtocol)); i >= 0 {
+		if e.mappings[i].InternalClient != NewInternalClient {
+			return soap.NewUPnPError(ErrCodeConflictInMappingEntry, "ConflictInMappingEntry")
+		}
+		e.mappings[i] = m
+		return nil
+	}
+	e.mappings = append(e.mappings, m)
+	return nil
+}
+
+func (c wanIPConnection) DeletePortMapping(ctx context.Context, NewRemoteHost string, NewExternalPort uint16, NewProtocol internetgateway1.WANIPConnection1PortMappingProtocol) error {
+	defer c.e.lock.Unlock()
+	if err := c.e.begin("DeletePortMapping"); err != nil {
+		return err
+	}
+	i := c.e.findMapping(NewRemoteHost, NewExternalPort, string(NewProtocol))
+	if i < 0 {
+		return noSuchEntry()
+	}
+	c.e.mappings = append(c.e.mappings[:i], c.e.mappings[i+1:]...)
+	return nil
+}
+
+func (c wanIPConnection) GetExternalIPAddress(ctx context.Context) (string, error) {
+	defer c.e.lock.Unlock()
+	if err := c.e.begin("GetExternalIPAddress"); err != nil {
+		return "", err
+	}
+	return c.e.externalIP, nil
+}
+
+// remainingLease returns the remaining lease duration in seconds, or 0 for a
+// permanent mapping.
+func (m *PortMapping) remainingLease() uint32 {
+	if m.Expiry.IsZero() {
+		return 0
+	}
+	remaining := time.Until(m.Expiry) / time.Second
+	if remaining < 1 {
+		remaining = 1
+	}
+	return uint32(remaining)
+}
@@ -0,0 +1,75 @@
+package igdemu
+
+import (
+	"testing"
+
+	"github.com/huin/goupnp/dcps/internetgateway1"
+	"github.com/huin/goupnp/soap"
+)
+
+func upnpErrorCode(err error) int {
+	if fault, ok := err.(*soap.SOAPFaultError); ok && fault.UPnPError != nil {
+		return fault.UPnPError.Code
+	}
+	return 0
+}
+
+func TestEmulator(t *testing.T) {
+	e, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer e.Close()
+
+	clients, err := internetgateway1.NewWANIPConnection1ClientsByURL(e.Location())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(clients) != 1 {
+		t.Fatalf("got %d clients, want 1", len(clients))
+	}
+	c := clients[0]
+
+	e.SetExternalIP("198.51.100.7")
+	if ip, err := c.GetExternalIPAddress(); err != nil || ip != "198.51.100.7" {
+		t.Errorf("GetExternalIPAddress() = %q, %v", ip, err)
+	}
+
+	if err := c.AddPortMapping("", 8080, "TCP", 80, "192.168.1.2", true, "web", 0); err != nil {
+		t.Fatal(err)
+	}
+	port, client, enabled, desc, _, err := c.GetSpecificPortMappingEntry("", 8080, "TCP")
+	if err != nil || port != 80 || client != "192.168.1.2" || !enabled || desc != "web" {
+		t.Errorf("GetSpecificPortMappingEntry() = %d, %q, %t, %q, %v", port, client, enabled, desc, err)
+	}
+	if got := e.PortMappings(); len(got) != 1 || got[0].ExternalPort != 8080 {
+		t.Errorf("PortMappings() = %+v", got)
+	}
+
+	err = c.AddPortMapping("", 8080, "TCP", 80, "192.168.1.3", true, "web", 0)
+	if code := upnpErrorCode(err); code != ErrCodeConflictInMappingEntry {
+		t.Errorf("conflicting AddPortMapping: got %v, want code %d", err, ErrCodeConflictInMappingEntry)
+	}
+	_, _, _, _, _, _, _, _, err = c.GetGenericPortMappingEntry(1)
+	if code := upnpErrorCode(err); code != ErrCodeSpecifiedArrayIndexInvalid {
+		t.Errorf("GetGenericPortMappingEntry(1): got %v, want code %d", err, ErrCodeSpecifiedArrayIndexInvalid)
+	}
+
+	if err := c.DeletePortMapping("", 8080, "TCP"); err != nil {
+		t.Fatal(err)
+	}
+	err = c.DeletePortMapping("", 8080, "TCP")
+	if code := upnpErrorCode(err); code != ErrCodeNoSuchEntryInArray {
+		t.Errorf("second DeletePortMapping: got %v, want code %d", err, ErrCodeNoSuchEntryInArray)
+	}
+
+	e.InjectFault("GetExternalIPAddress", soap.NewUPnPError(soap.ErrCodeActionFailed, "Action Failed"))
+	_, err = c.GetExternalIPAddress()
+	if code := upnpErrorCode(err); code != soap.ErrCodeActionFailed {
+		t.Errorf("injected fault: got %v, want code %d", err, soap.ErrCodeActionFailed)
+	}
+	e.InjectFault("GetExternalIPAddress", nil)
+	if _, err := c.GetExternalIPAddress(); err != nil {
+		t.Errorf("after clearing fault: %v", err)
+	}
+}
@@ -0,0 +1,143 @@
+package mediaserver
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/huin/goupnp/dcps/av1"
+	"github.com/huin/goupnp/gena"
+	"github.com/huin/goupnp/soap"
+)
+
+// Errors defined by ConnectionManager:1.
+const (
+	errCodeIncompatibleProtocolInfo   = 701
+	errCodeIncompatibleDirections     = 702
+	errCodeInvalidConnectionReference = 706
+)
+
+// defaultConnectionID is the connection that always exists, for control
+// points that do not use PrepareForConnection.
+const defaultConnectionID = 0
+
+// connection is a connection prepared by PrepareForConnection.
+type connection struct {
+	protocolInfo          string
+	peerConnectionManager string
+	peerConnectionID      int32
+}
+
+func defaultConnection() *connection {
+	return &connection{peerConnectionID: -1}
+}
+
+// protocolInfoMatches returns whether two protocolInfo values of the form
+// "<protocol>:<network>:<contentFormat>:<additionalInfo>" are compatible,
+// treating "*" as matching any value of a field.
+func protocolInfoMatches(a, b string) bool {
+	af := strings.SplitN(a, ":", 4)
+	bf := strings.SplitN(b, ":", 4)
+	if len(af) != 4 || len(bf) != 4 {
+		return false
+	}
+	for i := range af {
+		if af[i] == "*" || bf[i] == "*" {
+			continue
+		}
+		if i < 3 && !strings.EqualFold(af[i], bf[i]) || i == 3 && af[i] != bf[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// connectionIDs returns the CurrentConnectionIDs value. ms.lock must be held,
+// except during construction.
+func (ms *MediaServer) connectionIDs() string {
+	ids := make([]int, 0, len(ms.connections))
+	for id := range ms.connections {
+		ids = append(ids, int(id))
+	}
+	sort.Ints(ids)
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = fmt.Sprint(id)
+	}
+	return strings.Join(parts, ",")
+}
+
+// connectionManager implements the ConnectionManager:1 service of a
+// MediaServer.
+type connectionManager struct {
+	ms *MediaServer
+}
+
+var _ av1.ConnectionManager1Handler = connectionManager{}
+
+func (cm connectionManager) GetProtocolInfo(ctx context.Context) (string, string, error) {
+	return strings.Join(cm.ms.sourceProtocolInfo, ","), "", nil
+}
+
+func (cm connectionManager) PrepareForConnection(ctx context.Context, RemoteProtocolInfo string, PeerConnectionManager string,
+	PeerConnectionID int32, Direction av1.ConnectionManager1Direction) (int32, int32, int32, error) {
+	if Direction != av1.ConnectionManager1Direction_Output {
+		return 0, 0, 0, soap.NewUPnPError(errCodeIncompatibleDirections, "Incompatible directions")
+	}
+	var protocolInfo string
+	for _, pi := range cm.ms.sourceProtocolInfo {
+		if protocolInfoMatches(pi, RemoteProtocolInfo) {
+			protocolInfo = pi
+			break
+		}
+	}
+	if protocolInfo == "" {
+		return 0, 0, 0, soap.NewUPnPError(errCodeIncompatibleProtocolInfo, "Incompatible protocol info")
+	}
+
+	cm.ms.lock.Lock()
+	id := cm.ms.nextConnectionID
+	cm.ms.nextConnectionID++
+	cm.ms.connections[id] = &connection{
+		protocolInfo:          protocolInfo,
+		peerConnectionManager: PeerConnectionManager,
+		peerConnectionID:      PeerConnectionID,
+	}
+	// The state is set with the lock held so that changes are evented in
+	// order.
+	cm.ms.connectionManager.SetState(gena.Property{Name: "CurrentConnectionIDs", Value: cm.ms.connectionIDs()})
+	cm.ms.lock.Unlock()
+	// A MediaServer has no AVTransport or RenderingControl services.
+	return id, -1, -1, nil
+}
+
+func (cm connectionManager) ConnectionComplete(ctx context.Context, ConnectionID int32) error {
+	cm.ms.lock.Lock()
+	if _, ok := cm.ms.connections[ConnectionID]; !ok || ConnectionID == defaultConnectionID {
+		cm.ms.lock.Unlock()
+		return soap.NewUPnPError(errCodeInvalidConnectionReference, "Invalid connection reference")
+	}
+	delete(cm.ms.connections, ConnectionID)
+	cm.ms.connectionManager.SetState(gena.Property{Name: "CurrentConnectionIDs", Value: cm.ms.connectionIDs()})
+	cm.ms.lock.Unlock()
+	return nil
+}
+
+func (cm connectionManager) GetCurrentConnectionIDs(ctx context.Context) (string, error) {
+	cm.ms.lock.Lock()
+	defer cm.ms.lock.Unlock()
+	return cm.ms.connectionIDs(), nil
+}
+
+func (cm connectionManager) GetCurrentConnectionInfo(ctx context.Context, ConnectionID int32) (
+	int32, int32, string, string, int32, av1.ConnectionManager1Direction, av1.ConnectionManager1ConnectionStatus, error) {
+	cm.ms.lock.Lock()
+	defer cm.ms.lock.Unlock()
+	c, ok := cm.ms.connections[ConnectionID]
+	if !ok {
+		return 0, 0, "", "", 0, "", "", soap.NewUPnPError(errCodeInvalidConnectionReference, "Invalid connection reference")
+	}
+	return -1, -1, c.protocolInfo, c.peerConnectionManager, c.peerConnectionID,
+		av1.ConnectionManager1Direction_Output, av1.ConnectionManager1ConnectionStatus_OK, nil
+}
@@ -0,0 +1,213 @@
+package mediaserver
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+)
+
+const (
+	didlNamespace = "urn:schemas-upnp-org:metadata-1-0/DIDL-Lite/"
+	dcNamespace   = "http://purl.org/dc/elements/1.1/"
+	upnpNamespace = "urn:schemas-upnp-org:metadata-1-0/upnp/"
+)
+
+// Object is a ContentDirectory object, either a container or an item.
+type Object struct {
+	ID       string
+	ParentID string
+	Title    string
+	// Class is the UPnP class of the object, e.g "object.container" or
+	// "object.item.audioItem.musicTrack". Objects with classes beginning
+	// "object.container" are containers.
+	Class      string
+	Restricted bool
+
+	// Optional properties, only included when not empty and requested by the
+	// Browse or Search filter.
+	Creator     string
+	Artist      string
+	Album       string
+	Genre       string
+	AlbumArtURI string
+	// ChildCount is the number of children of a container.
+	ChildCount int
+	// Searchable is whether Search may be performed on a container.
+	Searchable bool
+	Resources  []Resource
+}
+
+// IsContainer returns whether the object is a container.
+func (o *Object) IsContainer() bool {
+	return strings.HasPrefix(o.Class, "object.container")
+}
+
+// Resource is a resource of an item, typically a URL from which its content
+// can be retrieved.
+type Resource struct {
+	URL string
+	// ProtocolInfo is the protocolInfo of the resource, e.g
+	// "http-get:*:audio/mpeg:*".
+	ProtocolInfo string
+	// Optional properties, only included when not zero and requested by the
+	// Browse or Search filter.
+	Size       int64
+	Duration   time.Duration
+	Resolution string
+}
+
+type didlLite struct {
+	XMLName   xml.Name     `xml:"DIDL-Lite"`
+	Xmlns     string       `xml:"xmlns,attr"`
+	XmlnsDC   string       `xml:"xmlns:dc,attr"`
+	XmlnsUPnP string       `xml:"xmlns:upnp,attr"`
+	Objects   []didlObject `xml:",any"`
+}
+
+// didlObject is a container or item element, as named by XMLName.
+type didlObject struct {
+	XMLName     xml.Name
+	ID          string         `xml:"id,attr"`
+	ParentID    string         `xml:"parentID,attr"`
+	Restricted  string         `xml:"restricted,attr"`
+	ChildCount  string         `xml:"childCount,attr,omitempty"`
+	Searchable  string         `xml:"searchable,attr,omitempty"`
+	Title       string         `xml:"dc:title"`
+	Creator     string         `xml:"dc:creator,omitempty"`
+	Class       string         `xml:"upnp:class"`
+	Artist      string         `xml:"upnp:artist,omitempty"`
+	Album       string         `xml:"upnp:album,omitempty"`
+	Genre       string         `xml:"upnp:genre,omitempty"`
+	AlbumArtURI string         `xml:"upnp:albumArtURI,omitempty"`
+	Resources   []didlResource `xml:"res"`
+}
+
+type didlResource struct {
+	ProtocolInfo string `xml:"protocolInfo,attr"`
+	Size         string `xml:"size,attr,omitempty"`
+	Duration     string `xml:"duration,attr,omitempty"`
+	Resolution   string `xml:"resolution,attr,omitempty"`
+	URL          string `xml:",chardata"`
+}
+
+// propertyFilter is a parsed Browse or Search filter, which selects the
+// optional properties to include in results.
+type propertyFilter struct {
+	all   bool
+	names map[string]bool
+}
+
+func parseFilter(s string) propertyFilter {
+	f := propertyFilter{names: make(map[string]bool)}
+	for _, name := range strings.Split(s, ",") {
+		name = strings.TrimSpace(name)
+		if name == "*" {
+			f.all = true
+		}
+		f.names[name] = true
+		// Any res attribute implies the res element itself.
+		if strings.HasPrefix(name, "res@") {
+			f.names["res"] = true
+		}
+	}
+	return f
+}
+
+func (f propertyFilter) has(names ...string) bool {
+	if f.all {
+		return true
+	}
+	for _, name := range names {
+		if f.names[name] {
+			return true
+		}
+	}
+	return false
+}
+
+// MarshalDIDL serializes objects as a DIDL-Lite document, as returned in the
+// Result of Browse and Search. filter is a comma separated list of the
+// optional properties to include, or "*" to include all of them, as given to
+// Browse and Search.
+func MarshalDIDL(objects []Object, filter string) (string, error) {
+	f := parseFilter(filter)
+	doc := didlLite{
+		Xmlns:     didlNamespace,
+		XmlnsDC:   dcNamespace,
+		XmlnsUPnP: upnpNamespace,
+	}
+	for i := range objects {
+		o := &objects[i]
+		d := didlObject{
+			ID:         o.ID,
+			ParentID:   o.ParentID,
+			Restricted: boolAttr(o.Restricted),
+			Title:      o.Title,
+			Class:      o.Class,
+		}
+		if f.has("dc:creator") {
+			d.Creator = o.Creator
+		}
+		if f.has("upnp:artist") {
+			d.Artist = o.Artist
+		}
+		if f.has("upnp:album") {
+			d.Album = o.Album
+		}
+		if f.has("upnp:genre") {
+			d.Genre = o.Genre
+		}
+		if f.has("upnp:albumArtURI") {
+			d.AlbumArtURI = o.AlbumArtURI
+		}
+		if f.has("res") {
+			for _, r := range o.Resources {
+				dr := didlResource{ProtocolInfo: r.ProtocolInfo, URL: r.URL}
+				if r.Size > 0 && f.has("res@size") {
+					dr.Size = fmt.Sprint(r.Size)
+				}
+				if r.Duration > 0 && f.has("res@duration") {
+					dr.Duration = formatDuration(r.Duration)
+				}
+				if f.has("res@resolution") {
+					dr.Resolution = r.Resolution
+				}
+				d.Resources = append(d.Resources, dr)
+			}
+		}
+
+		if o.IsContainer() {
+			d.XMLName.Local = "container"
+			if f.has("@childCount", "container@childCount") {
+				d.ChildCount = fmt.Sprint(o.ChildCount)
+			}
+			if f.has("@searchable", "container@searchable") {
+				d.Searchable = boolAttr(o.Searchable)
+			}
+		} else {
+			d.XMLName.Local = "item"
+		}
+		doc.Objects = append(doc.Objects, d)
+	}
+
+	buf := new(bytes.Buffer)
+	if err := xml.NewEncoder(buf).Encode(&doc); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
+
+func boolAttr(b bool) string {
+	if b {
+		return "1"
+	}
+	return "0"
+}
+
+// formatDuration formats d in the H+:MM:SS.FFF form used by res@duration.
+func formatDuration(d time.Duration) string {
+	ms := d / time.Millisecond
+	return fmt.Sprintf("%d:%02d:%02d.%03d", ms/3600000, ms/60000%60, ms/1000%60, ms%1000)
+}
@@ -0,0 +1,308 @@
+// mediaserver provides a hosted UPnP MediaServer:1 device, with
+// ContentDirectory:1 and ConnectionManager:1 services, serving content from
+// a user supplied Backend.
+//
+// The package handles the DIDL-Lite serialization of Browse and Search
+// results, eventing of SystemUpdateID and ContainerUpdateIDs, and the
+// negotiation of protocolInfo by ConnectionManager:
+//
+//	b := mediaserver.NewDeviceBuilder("My Media")
+//	srv, err := b.NewServer()
+//	...
+//	ms, err := mediaserver.New(srv, "", backend, []string{"http-get:*:audio/mpeg:*"})
+//	...
+//	err = srv.ListenAndServe()
+package mediaserver
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/huin/goupnp/dcps/av1"
+	"github.com/huin/goupnp/device"
+	"github.com/huin/goupnp/gena"
+	"github.com/huin/goupnp/soap"
+)
+
+const (
+	URN_MediaServer_1 = "urn:schemas-upnp-org:device:MediaServer:1"
+
+	ServiceID_ContentDirectory  = "urn:upnp-org:serviceId:ContentDirectory"
+	ServiceID_ConnectionManager = "urn:upnp-org:serviceId:ConnectionManager"
+
+	// updateIDRate is the maximum rate at which SystemUpdateID and
+	// ContainerUpdateIDs are evented, as required by ContentDirectory:1.
+	updateIDRate = 2 * time.Second
+)
+
+// Errors defined by ContentDirectory:1, which may be returned by Backend
+// methods.
+var (
+	ErrNoSuchObject              = soap.NewUPnPError(701, "No such object")
+	ErrUnsupportedSearchCriteria = soap.NewUPnPError(708, "Unsupported or invalid search criteria")
+	ErrUnsupportedSortCriteria   = soap.NewUPnPError(709, "Unsupported or invalid sort criteria")
+	ErrNoSuchContainer           = soap.NewUPnPError(710, "No such container")
+)
+
+// Backend supplies the content served by a MediaServer. Errors returned by
+// its methods are returned to the control point, so should typically be one
+// of the Err* errors of this package, or another *soap.UPnPError.
+type Backend interface {
+	// BrowseMetadata returns the object with the given ID. The root
+	// container has ID "0".
+	BrowseMetadata(ctx context.Context, objectID string) (*Object, error)
+
+	// BrowseChildren returns up to count children of the container with the
+	// given ID, starting from the start'th child, along with the total number
+	// of children. A count of zero requests all remaining children.
+	BrowseChildren(ctx context.Context, containerID string, start, count uint32) (children []Object, total uint32, err error)
+}
+
+// Searcher may be implemented by a Backend to support the Search action.
+type Searcher interface {
+	// SearchCapabilities returns the property names that can be used in
+	// search criteria, e.g "dc:title", "upnp:class".
+	SearchCapabilities() []string
+
+	// Search returns up to count objects within the container with the given
+	// ID that match the criteria, starting from the start'th match, along
+	// with the total number of matches. A count of zero requests all
+	// remaining matches.
+	Search(ctx context.Context, containerID, criteria string, start, count uint32) (matches []Object, total uint32, err error)
+}
+
+// MediaServer implements the ContentDirectory and ConnectionManager services
+// of a hosted MediaServer device.
+type MediaServer struct {
+	backend            Backend
+	contentDirectory   *device.Service
+	connectionManager  *device.Service
+	sourceProtocolInfo []string
+
+	lock               sync.Mutex // Protects all below.
+	systemUpdateID     uint32
+	containerUpdateIDs map[string]uint32
+	connections        map[int32]*connection
+	nextConnectionID   int32
+}
+
+// NewDeviceBuilder returns a builder for a MediaServer:1 device with the
+// ContentDirectory and ConnectionManager services, to be passed to New once
+// added to a server. The manufacturer and model may be overridden.
+func NewDeviceBuilder(friendlyName string) *device.DeviceBuilder {
+	return device.NewDeviceBuilder(URN_MediaServer_1, friendlyName).
+		Manufacturer("goupnp", "").
+		Model("goupnp MediaServer", "1", "", "").
+		Service(av1.URN_ContentDirectory_1, ServiceID_ContentDirectory, nil).
+		Service(av1.URN_ConnectionManager_1, ServiceID_ConnectionManager, nil)
+}
+
+// New creates a MediaServer for the hosted device with the given UDN within
+// srv, or the first MediaServer device if udn is empty, and registers it as
+// the handler of the device's services. sourceProtocolInfo lists the
+// protocolInfo of the content that the backend serves, e.g
+// "http-get:*:audio/mpeg:*".
+func New(srv *device.Server, udn string, backend Backend, sourceProtocolInfo []string) (*MediaServer, error) {
+	cds := srv.Service(udn, ServiceID_ContentDirectory)
+	if cds == nil {
+		return nil, fmt.Errorf("mediaserver: no hosted %s service", ServiceID_ContentDirectory)
+	}
+	cms := srv.Service(cds.UDN(), ServiceID_ConnectionManager)
+	if cms == nil {
+		return nil, fmt.Errorf("mediaserver: no hosted %s service in device %s", ServiceID_ConnectionManager, cds.UDN())
+	}
+
+	ms := &MediaServer{
+		backend:            backend,
+		contentDirectory:   cds,
+		connectionManager:  cms,
+		sourceProtocolInfo: append([]string(nil), sourceProtocolInfo...),
+		containerUpdateIDs: make(map[string]uint32),
+		connections:        map[int32]*connection{defaultConnectionID: defaultConnection()},
+		nextConnectionID:   defaultConnectionID + 1,
+	}
+
+	cds.Moderate("SystemUpdateID", updateIDRate, 0)
+	cds.Moderate("ContainerUpdateIDs", updateIDRate, 0)
+	cds.SetState(
+		gena.Property{Name: "SystemUpdateID", Value: "0"},
+		gena.Property{Name: "ContainerUpdateIDs", Value: ""},
+		gena.Property{Name: "TransferIDs", Value: ""},
+	)
+	cms.SetState(
+		gena.Property{Name: "SourceProtocolInfo", Value: strings.Join(ms.sourceProtocolInfo, ",")},
+		gena.Property{Name: "SinkProtocolInfo", Value: ""},
+		gena.Property{Name: "CurrentConnectionIDs", Value: ms.connectionIDs()},
+	)
+
+	av1.RegisterContentDirectory1Handler(cds, contentDirectory{ms})
+	av1.RegisterConnectionManager1Handler(cms, connectionManager{ms})
+	return ms, nil
+}
+
+// SystemUpdateID returns the current SystemUpdateID.
+func (ms *MediaServer) SystemUpdateID() uint32 {
+	ms.lock.Lock()
+	defer ms.lock.Unlock()
+	return ms.systemUpdateID
+}
+
+// ContentChanged must be called when the content of the backend changes. It
+// increments the SystemUpdateID, and the update IDs of the given containers
+// whose children or metadata changed, and events them to subscribers.
+func (ms *MediaServer) ContentChanged(containerIDs ...string) {
+	ms.lock.Lock()
+	defer ms.lock.Unlock()
+	ms.systemUpdateID++
+	for _, id := range containerIDs {
+		ms.containerUpdateIDs[id] = ms.systemUpdateID
+	}
+	props := []gena.Property{{Name: "SystemUpdateID", Value: fmt.Sprint(ms.systemUpdateID)}}
+	if len(containerIDs) > 0 {
+		props = append(props, gena.Property{Name: "ContainerUpdateIDs", Value: ms.containerUpdateIDsValue()})
+	}
+	ms.contentDirectory.SetState(props...)
+}
+
+// containerUpdateIDsValue returns the ContainerUpdateIDs value, listing the
+// containers that have changed along with their update IDs. ms.lock must be
+// held.
+func (ms *MediaServer) containerUpdateIDsValue() string {
+	ids := make([]string, 0, len(ms.containerUpdateIDs))
+	for id := range ms.containerUpdateIDs {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	parts := make([]string, 0, 2*len(ids))
+	for _, id := range ids {
+		parts = append(parts, escapeCSV(id), fmt.Sprint(ms.containerUpdateIDs[id]))
+	}
+	return strings.Join(parts, ",")
+}
+
+// escapeCSV escapes commas and backslashes within an element of a CSV state
+// variable.
+func escapeCSV(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `,`, `\,`).Replace(s)
+}
+
+// contentDirectory implements the ContentDirectory:1 service of a
+// MediaServer. Sort criteria are ignored, and results are returned in the
+// order given by the Backend.
+type contentDirectory struct {
+	ms *MediaServer
+}
+
+var _ av1.ContentDirectory1Handler = contentDirectory{}
+
+func notImplemented() error {
+	return soap.NewUPnPError(soap.ErrCodeOptionalActionNotImplemented, "Optional Action Not Implemented")
+}
+
+func (cd contentDirectory) GetSearchCapabilities(ctx context.Context) (string, error) {
+	if s, ok := cd.ms.backend.(Searcher); ok {
+		return strings.Join(s.SearchCapabilities(), ","), nil
+	}
+	return "", nil
+}
+
+func (cd contentDirectory) GetSortCapabilities(ctx context.Context) (string, error) {
+	return "", nil
+}
+
+func (cd contentDirectory) GetSystemUpdateID(ctx context.Context) (uint32, error) {
+	return cd.ms.SystemUpdateID(), nil
+}
+
+func (cd contentDirectory) Browse(ctx context.Context, ObjectID string, BrowseFlag av1.ContentDirectory1BrowseFlag, Filter string,
+	StartingIndex uint32, RequestedCount uint32, SortCriteria string) (string, uint32, uint32, uint32, error) {
+	updateID := cd.ms.SystemUpdateID()
+	var objects []Object
+	var total uint32
+	switch BrowseFlag {
+	case av1.ContentDirectory1BrowseFlag_BrowseMetadata:
+		if StartingIndex != 0 {
+			return "", 0, 0, 0, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "StartingIndex must be 0 for BrowseMetadata")
+		}
+		o, err := cd.ms.backend.BrowseMetadata(ctx, ObjectID)
+		if err != nil {
+			return "", 0, 0, 0, err
+		}
+		objects, total = []Object{*o}, 1
+	case av1.ContentDirectory1BrowseFlag_BrowseDirectChildren:
+		var err error
+		if objects, total, err = cd.ms.backend.BrowseChildren(ctx, ObjectID, StartingIndex, RequestedCount); err != nil {
+			return "", 0, 0, 0, err
+		}
+	default:
+		return "", 0, 0, 0, soap.NewUPnPError(soap.ErrCodeInvalidArgs, "bad BrowseFlag "+string(BrowseFlag))
+	}
+	return result(objects, total, Filter, RequestedCount, updateID)
+}
+
+func (cd contentDirectory) Search(ctx context.Context, ContainerID string, SearchCriteria string, Filter string,
+	StartingIndex uint32, RequestedCount uint32, SortCriteria string) (string, uint32, uint32, uint32, error) {
+	s, ok := cd.ms.backend.(Searcher)
+	if !ok {
+		return "", 0, 0, 0, notImplemented()
+	}
+	updateID := cd.ms.SystemUpdateID()
+	objects, total, err := s.Search(ctx, ContainerID, SearchCriteria, StartingIndex, RequestedCount)
+	if err != nil {
+		return "", 0, 0, 0, err
+	}
+	return result(objects, total, Filter, RequestedCount, updateID)
+}
+
+// result returns the output arguments of Browse and Search.
+func result(objects []Object, total uint32, filter string, count uint32, updateID uint32) (string, uint32, uint32, uint32, error) {
+	if count > 0 && uint32(len(objects)) > count {
+		objects = objects[:count]
+	}
+	didl, err := MarshalDIDL(objects, filter)
+	if err != nil {
+		return "", 0, 0, 0, err
+	}
+	return didl, uint32(len(objects)), total, updateID, nil
+}
+
+func (cd contentDirectory) CreateObject(ctx context.Context, ContainerID string, Elements string) (string, string, error) {
+	return "", "", notImplemented()
+}
+
+func (cd contentDirectory) DestroyObject(ctx context.Context, ObjectID string) error {
+	return notImplemented()
+}
+
+func (cd contentDirectory) UpdateObject(ctx context.Context, ObjectID string, CurrentTagValue string, NewTagValue string) error {
+	return notImplemented()
+}
+
+func (cd contentDirectory) ImportResource(ctx context.Context, SourceURI *url.URL, DestinationURI *url.URL) (uint32, error) {
+	return 0, notImplemented()
+}
+
+func (cd contentDirectory) ExportResource(ctx context.Context, SourceURI *url.URL, DestinationURI *url.URL) (uint32, error) {
+	return 0, notImplemented()
+}
+
+func (cd contentDirectory) StopTransferResource(ctx context.Context, TransferID uint32) error {
+	return notImplemented()
+}
+
+func (cd contentDirectory) GetTransferProgress(ctx context.Context, TransferID uint32) (av1.ContentDirectory1TransferStatus, string, string, error) {
+	return "", "", "", notImplemented()
+}
+
+func (cd contentDirectory) DeleteResource(ctx context.Context, ResourceURI *url.URL) error {
+	return notImplemented()
+}
+
+func (cd contentDirectory) CreateReference(ctx context.Context, ContainerID string, ObjectID string) (string, error) {
+	return "", notImplemented()
+}
@@ -0,0 +1,169 @@
+package mediaserver
+
+import (
+	"context"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/huin/goupnp/dcps/av1"
+	"github.com/huin/goupnp/soap"
+)
+
+type testBackend map[string][]Object
+
+func (b testBackend) BrowseMetadata(ctx context.Context, objectID string) (*Object, error) {
+	for _, children := range b {
+		for i := range children {
+			if children[i].ID == objectID {
+				return &children[i], nil
+			}
+		}
+	}
+	if objectID == "0" {
+		return &Object{ID: "0", ParentID: "-1", Title: "Root", Class: "object.container", ChildCount: len(b["0"])}, nil
+	}
+	return nil, ErrNoSuchObject
+}
+
+func (b testBackend) BrowseChildren(ctx context.Context, containerID string, start, count uint32) ([]Object, uint32, error) {
+	children, ok := b[containerID]
+	if !ok {
+		return nil, 0, ErrNoSuchContainer
+	}
+	total := uint32(len(children))
+	if start > total {
+		start = total
+	}
+	return children[start:], total, nil
+}
+
+func TestMarshalDIDL(t *testing.T) {
+	objects := []Object{
+		{ID: "1", ParentID: "0", Title: "Music", Class: "object.container.storageFolder", ChildCount: 2},
+		{ID: "2", ParentID: "0", Title: "Song & Dance", Class: "object.item.audioItem.musicTrack", Restricted: true,
+			Creator: "Someone", Resources: []Resource{{
+				URL: "http://host/2.mp3", ProtocolInfo: "http-get:*:audio/mpeg:*", Size: 1234, Duration: 3*time.Minute + 25500*time.Millisecond}}},
+	}
+	tests := []struct {
+		filter string
+		want   []string
+		absent []string
+	}{
+		{
+			filter: "*",
+			want: []string{
+				`<container id="1" parentID="0" restricted="0" childCount="2" searchable="0"><dc:title>Music</dc:title><upnp:class>object.container.storageFolder</upnp:class></container>`,
+				`<item id="2" parentID="0" restricted="1"><dc:title>Song &amp; Dance</dc:title><dc:creator>Someone</dc:creator>`,
+				`<res protocolInfo="http-get:*:audio/mpeg:*" size="1234" duration="0:03:25.500">http://host/2.mp3</res>`,
+			},
+		},
+		{
+			filter: "",
+			want:   []string{`<container id="1" parentID="0" restricted="0">`},
+			absent: []string{"childCount", "dc:creator", "<res"},
+		},
+		{
+			filter: "res@size",
+			want:   []string{`<res protocolInfo="http-get:*:audio/mpeg:*" size="1234">`},
+			absent: []string{"duration", "dc:creator"},
+		},
+	}
+	for _, test := range tests {
+		got, err := MarshalDIDL(objects, test.filter)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !strings.HasPrefix(got, `<DIDL-Lite xmlns="`+didlNamespace+`"`) {
+			t.Errorf("filter %q: bad document element in %s", test.filter, got)
+		}
+		for _, want := range test.want {
+			if !strings.Contains(got, want) {
+				t.Errorf("filter %q: %s does not contain %s", test.filter, got, want)
+			}
+		}
+		for _, absent := range test.absent {
+			if strings.Contains(got, absent) {
+				t.Errorf("filter %q: %s contains %s", test.filter, got, absent)
+			}
+		}
+	}
+}
+
+func TestMediaServer(t *testing.T) {
+	backend := testBackend{
+		"0": {
+			{ID: "1", ParentID: "0", Title: "One", Class: "object.item"},
+			{ID: "2", ParentID: "0", Title: "Two", Class: "object.item"},
+			{ID: "3", ParentID: "0", Title: "Three", Class: "object.item"},
+		},
+	}
+	srv, err := NewDeviceBuilder("Test Media").NewServer()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ms, err := New(srv, "", backend, []string{"http-get:*:audio/mpeg:*", "http-get:*:video/mp4:*"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	ts := httptest.NewServer(srv)
+	defer ts.Close()
+	loc, err := url.Parse(ts.URL + srv.DescriptionPath(srv.RootDevice().Device.UDN))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cds, err := av1.NewContentDirectory1ClientsByURL(loc)
+	if err != nil || len(cds) != 1 {
+		t.Fatalf("got %d ContentDirectory clients, err %v", len(cds), err)
+	}
+	ms.ContentChanged("0")
+	result, returned, total, updateID, err := cds[0].Browse("0", "BrowseDirectChildren", "*", 1, 1, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if returned != 1 || total != 3 || updateID != 1 || !strings.Contains(result, `<item id="2"`) {
+		t.Errorf("Browse children = %d, %d, %d, %s", returned, total, updateID, result)
+	}
+	if _, _, _, _, err := cds[0].Browse("missing", "BrowseMetadata", "*", 0, 0, ""); !isUPnPError(err, 701) {
+		t.Errorf("Browse missing object: got %v, want code 701", err)
+	}
+	if _, _, _, _, err := cds[0].Search("0", "*", "*", 0, 0, ""); !isUPnPError(err, soap.ErrCodeOptionalActionNotImplemented) {
+		t.Errorf("Search: got %v, want code %d", err, soap.ErrCodeOptionalActionNotImplemented)
+	}
+	if v, _ := srv.Service("", ServiceID_ContentDirectory).State("ContainerUpdateIDs"); v != "0,1" {
+		t.Errorf("ContainerUpdateIDs = %q, want %q", v, "0,1")
+	}
+
+	cms, err := av1.NewConnectionManager1ClientsByURL(loc)
+	if err != nil || len(cms) != 1 {
+		t.Fatalf("got %d ConnectionManager clients, err %v", len(cms), err)
+	}
+	if _, _, _, err := cms[0].PrepareForConnection("http-get:*:audio/wav:*", "", -1, "Output"); !isUPnPError(err, errCodeIncompatibleProtocolInfo) {
+		t.Errorf("PrepareForConnection incompatible: got %v, want code %d", err, errCodeIncompatibleProtocolInfo)
+	}
+	id, _, _, err := cms[0].PrepareForConnection("http-get:*:video/mp4:DLNA.ORG_PN=AVC_MP4_BL_CIF15_AAC_520", "", -1, "Output")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ids, err := cms[0].GetCurrentConnectionIDs(); err != nil || ids != "0,1" {
+		t.Errorf("GetCurrentConnectionIDs() = %q, %v", ids, err)
+	}
+	_, _, protocolInfo, _, _, _, _, err := cms[0].GetCurrentConnectionInfo(id)
+	if err != nil || protocolInfo != "http-get:*:video/mp4:*" {
+		t.Errorf("GetCurrentConnectionInfo(%d) protocolInfo = %q, %v", id, protocolInfo, err)
+	}
+	if err := cms[0].ConnectionComplete(id); err != nil {
+		t.Fatal(err)
+	}
+	if err := cms[0].ConnectionComplete(id); !isUPnPError(err, errCodeInvalidConnectionReference) {
+		t.Errorf("second ConnectionComplete: got %v, want code %d", err, errCodeInvalidConnectionReference)
+	}
+}
+
+func isUPnPError(err error, code int) bool {
+	fault, ok := err.(*soap.SOAPFaultError)
+	return ok && fault.UPnPError != nil && fault.UPnPError.Code == code
+}
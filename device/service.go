@@ -0,0 +1,194 @@
+package device
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"sync"
+
+	"github.com/huin/goupnp"
+	"github.com/huin/goupnp/clock"
+	"github.com/huin/goupnp/internal/logging"
+	"github.com/huin/goupnp/scpd"
+	"github.com/huin/goupnp/soap"
+)
+
+// ActionHandler responds to invocations of a single action of a hosted
+// service.
+type ActionHandler interface {
+	// ServeAction is called for each invocation of the action, with the input
+	// arguments in the order received. The output arguments should be
+	// returned in the order given in the SCPD. Returning a *soap.UPnPError
+	// reports that error code to the control point, other errors are reported
+	// as soap.ErrCodeActionFailed.
+	ServeAction(ctx context.Context, in []soap.Arg) (out []soap.Arg, err error)
+}
+
+// ActionHandlerFunc is a function-to-ActionHandler adapter.
+type ActionHandlerFunc func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error)
+
+func (f ActionHandlerFunc) ServeAction(ctx context.Context, in []soap.Arg) ([]soap.Arg, error) {
+	return f(ctx, in)
+}
+
+// Service is a service hosted by a Server.
+type Service struct {
+	ServiceType string
+	ServiceID   string
+
+	// udn is the UDN of the device containing the service.
+	udn    string
+	desc   *goupnp.Service
+	server *Server
+
+	lock    sync.RWMutex // Protects scpd and actions.
+	scpd    *scpd.SCPD
+	actions map[string]ActionHandler
+
+	events eventPublisher
+}
+
+func newService(udn string, desc *goupnp.Service, server *Server) *Service {
+	svc := &Service{
+		ServiceType: desc.ServiceType,
+		ServiceID:   desc.ServiceId,
+		udn:         udn,
+		desc:        desc,
+		server:      server,
+		actions:     make(map[string]ActionHandler),
+	}
+	svc.events.init()
+	svc.events.logger = svc.logger
+	svc.events.clock = svc.clock
+	return svc
+}
+
+// clock returns the clock of the server.
+func (svc *Service) clock() clock.Clock {
+	if svc.server == nil {
+		return nil
+	}
+	return svc.server.Clock
+}
+
+// logger returns the logger of the server, with the attributes of svc.
+func (svc *Service) logger() *slog.Logger {
+	var l *slog.Logger
+	if svc.server != nil {
+		l = svc.server.Logger
+	}
+	return logging.Or(l).With(slog.String(logging.KeyUDN, svc.udn), slog.String(logging.KeyService, svc.ServiceID))
+}
+
+// UDN returns the UDN of the device that contains the service.
+func (svc *Service) UDN() string {
+	return svc.udn
+}
+
+// SetSCPD sets the service description that is served for the service. The
+// CONFIGID.UPNP.ORG of the device changes if the description differs from
+// the previous one. The SCPD must not be modified after calling SetSCPD.
+func (svc *Service) SetSCPD(s *scpd.SCPD) {
+	svc.lock.Lock()
+	svc.scpd = s
+	svc.lock.Unlock()
+	if svc.server != nil {
+		svc.server.scpdChanged(svc)
+	}
+}
+
+// SCPD returns the service description, or nil if none has been set.
+func (svc *Service) SCPD() *scpd.SCPD {
+	svc.lock.RLock()
+	defer svc.lock.RUnlock()
+	return svc.scpd
+}
+
+// Handle registers the handler for the named action.
+func (svc *Service) Handle(action string, handler ActionHandler) {
+	svc.lock.Lock()
+	defer svc.lock.Unlock()
+	svc.actions[action] = handler
+}
+
+// HandleFunc registers the handler function for the named action.
+func (svc *Service) HandleFunc(action string, handler func(ctx context.Context, in []soap.Arg) ([]soap.Arg, error)) {
+	svc.Handle(action, ActionHandlerFunc(handler))
+}
+
+func (svc *Service) handler(action string) ActionHandler {
+	svc.lock.RLock()
+	defer svc.lock.RUnlock()
+	return svc.actions[action]
+}
+
+func (svc *Service) serveSCPD(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "GET" && r.Method != "HEAD" {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	s := svc.SCPD()
+	if s == nil {
+		http.NotFound(w, r)
+		return
+	}
+	body, err := marshalXML("scpd", scpd.SCPDXMLNamespace, s)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	writeXML(w, r, body)
+}
+
+func (svc *Service) serveControl(w http.ResponseWriter, r *http.Request) {
+	if r.Method != "POST" {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	req, err := soap.ParseActionRequest(r)
+	if err != nil {
+		soap.WriteActionFault(w, err)
+		return
+	}
+	if req.ServiceType != svc.ServiceType {
+		soap.WriteActionFault(w, soap.NewUPnPError(soap.ErrCodeInvalidAction, "wrong service type "+req.ServiceType))
+		return
+	}
+	handler := svc.handler(req.Action)
+	if handler == nil {
+		soap.WriteActionFault(w, soap.NewUPnPError(soap.ErrCodeInvalidAction, "no such action "+req.Action))
+		return
+	}
+
+	if s := svc.SCPD(); s != nil {
+		if action := s.GetAction(req.Action); action != nil {
+			if err := validateArgs(s, action, req.Args); err != nil {
+				soap.WriteActionFault(w, err)
+				return
+			}
+		}
+	}
+
+	out, err := svc.serveAction(r.Context(), handler, req)
+	if err != nil {
+		if err := soap.WriteActionFault(w, err); err != nil {
+			svc.logger().Warn("device: error writing fault response", slog.String(logging.KeyAction, req.Action), logging.Err(err))
+		}
+		return
+	}
+	if err := soap.WriteActionResponse(w, svc.ServiceType, req.Action, out); err != nil {
+		svc.logger().Warn("device: error writing action response", slog.String(logging.KeyAction, req.Action), logging.Err(err))
+	}
+}
+
+// serveAction calls handler, turning a panic of it into an ActionFailed fault
+// so that the client gets a response.
+func (svc *Service) serveAction(ctx context.Context, handler ActionHandler, req *soap.ActionRequest) (out []soap.Arg, err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			logging.LogPanic(svc.logger(), "device: action handler panicked", p, slog.String(logging.KeyAction, req.Action))
+			out, err = nil, soap.NewUPnPError(soap.ErrCodeActionFailed, "action failed")
+		}
+	}()
+	return handler.ServeAction(ctx, req.Args)
+}
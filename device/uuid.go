@@ -0,0 +1,199 @@
+package device
+
+import (
+	"bufio"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"unicode"
+)
+
+// newUUID returns a random (version 4) UUID in its canonical string form.
+func newUUID() string {
+	var b [16]byte
+	if _, err := rand.Read(b[:]); err != nil {
+		// crypto/rand does not fail on supported platforms.
+		panic(err)
+	}
+	return formatUUID(b, 4)
+}
+
+// formatUUID sets the version and RFC 4122 variant bits of b, and returns it
+// in canonical string form.
+func formatUUID(b [16]byte, version byte) string {
+	b[6] = (b[6] & 0x0f) | version<<4
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// parseUUID parses a UUID in canonical string form.
+func parseUUID(s string) ([16]byte, error) {
+	var b [16]byte
+	if len(s) != 36 || s[8] != '-' || s[13] != '-' || s[18] != '-' || s[23] != '-' {
+		return b, fmt.Errorf("device: bad UUID %q", s)
+	}
+	if _, err := hex.Decode(b[:], []byte(strings.Replace(s, "-", "", -1))); err != nil {
+		return b, fmt.Errorf("device: bad UUID %q", s)
+	}
+	return b, nil
+}
+
+// NewUDN returns a new random UDN, of the form "uuid:" followed by a version
+// 4 UUID.
+func NewUDN() string {
+	return uuidPrefix + newUUID()
+}
+
+// ValidUDN returns whether udn is of the form "uuid:" followed by a UUID in
+// canonical string form.
+func ValidUDN(udn string) bool {
+	if !strings.HasPrefix(udn, uuidPrefix) {
+		return false
+	}
+	_, err := parseUUID(udn[len(uuidPrefix):])
+	return err == nil
+}
+
+// DeriveUDN returns a name based (version 5) UDN derived from the UUID of
+// base and name. It gives embedded devices stable UDNs that follow from the
+// UDN of their root device, so that only the root UDN needs to be persisted.
+func DeriveUDN(base, name string) (string, error) {
+	ns, err := parseUUID(strings.TrimPrefix(base, uuidPrefix))
+	if err != nil {
+		return "", err
+	}
+	h := sha1.New()
+	h.Write(ns[:])
+	h.Write([]byte(name))
+	var b [16]byte
+	copy(b[:], h.Sum(nil))
+	return uuidPrefix + formatUUID(b, 5), nil
+}
+
+// UDNStore persists the UDNs of devices, so that devices keep the same
+// identity across restarts. Control points key their state by UDN, so a
+// device whose UDN changes appears to be a new device.
+type UDNStore interface {
+	// LoadUDN returns the UDN stored under name, or "" if there is none.
+	LoadUDN(name string) (string, error)
+	// SaveUDN stores udn under name.
+	SaveUDN(name, udn string) error
+}
+
+// StableUDN returns the UDN stored under name in store, generating and
+// storing a new random UDN if there is none.
+func StableUDN(store UDNStore, name string) (string, error) {
+	udn, err := store.LoadUDN(name)
+	if err != nil {
+		return "", err
+	}
+	if udn != "" {
+		if !ValidUDN(udn) {
+			return "", fmt.Errorf("device: stored UDN %q for %q is malformed", udn, name)
+		}
+		return udn, nil
+	}
+	udn = NewUDN()
+	if err := store.SaveUDN(name, udn); err != nil {
+		return "", err
+	}
+	return udn, nil
+}
+
+// FileUDNStore is a UDNStore that keeps UDNs in a text file, one per line
+// following its name and a space. The file is created when the first UDN is
+// saved.
+type FileUDNStore struct {
+	Path string
+
+	lock sync.Mutex
+}
+
+var _ UDNStore = new(FileUDNStore)
+
+// LoadUDN implements UDNStore.
+func (s *FileUDNStore) LoadUDN(name string) (string, error) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	udns, err := s.read()
+	if err != nil {
+		return "", err
+	}
+	return udns[name], nil
+}
+
+// SaveUDN implements UDNStore. The file is replaced atomically, so that it is
+// not left partially written. name must be non-empty and without whitespace,
+// and udn valid as by ValidUDN.
+func (s *FileUDNStore) SaveUDN(name, udn string) error {
+	if name == "" || strings.IndexFunc(name, unicode.IsSpace) >= 0 {
+		return fmt.Errorf("device: UDN store name %q is empty or contains whitespace", name)
+	}
+	if !ValidUDN(udn) {
+		return fmt.Errorf("device: UDN %q for %q is malformed", udn, name)
+	}
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	udns, err := s.read()
+	if err != nil {
+		return err
+	}
+	udns[name] = udn
+
+	names := make([]string, 0, len(udns))
+	for n := range udns {
+		names = append(names, n)
+	}
+	sort.Strings(names)
+	var buf strings.Builder
+	for _, n := range names {
+		fmt.Fprintf(&buf, "%s %s\n", n, udns[n])
+	}
+
+	f, err := ioutil.TempFile(filepath.Dir(s.Path), filepath.Base(s.Path)+".tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := f.WriteString(buf.String()); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return os.Rename(f.Name(), s.Path)
+}
+
+// read returns the stored UDNs keyed by name. s.lock must be held.
+func (s *FileUDNStore) read() (map[string]string, error) {
+	udns := make(map[string]string)
+	f, err := os.Open(s.Path)
+	if os.IsNotExist(err) {
+		return udns, nil
+	} else if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" {
+			continue
+		}
+		fields := strings.Fields(line)
+		if len(fields) != 2 {
+			return nil, fmt.Errorf("device: malformed line %q in UDN store %s", line, s.Path)
+		}
+		udns[fields[0]] = fields[1]
+	}
+	return udns, scanner.Err()
+}
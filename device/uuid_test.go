@@ -0,0 +1,70 @@
+package device
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestStableUDN(t *testing.T) {
+	dir, err := ioutil.TempDir("", "udn")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "udns")
+
+	udn, err := StableUDN(&FileUDNStore{Path: path}, "root")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ValidUDN(udn) {
+		t.Fatalf("StableUDN() = %q, not a valid UDN", udn)
+	}
+	other, err := StableUDN(&FileUDNStore{Path: path}, "other")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other == udn {
+		t.Errorf("StableUDN() returned %q for two names", udn)
+	}
+
+	// A new store for the same file, as after a restart.
+	again, err := StableUDN(&FileUDNStore{Path: path}, "root")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again != udn {
+		t.Errorf("StableUDN() after restart = %q, want %q", again, udn)
+	}
+
+	// Entries that could not be read back are refused, leaving the file
+	// readable.
+	store := &FileUDNStore{Path: path}
+	for _, name := range []string{"", "a b", "a\tb", "a\u00a0b"} {
+		if err := store.SaveUDN(name, udn); err == nil {
+			t.Errorf("SaveUDN(%q): want error, got nil", name)
+		}
+	}
+	if err := store.SaveUDN("bad", "uuid:bad"); err == nil {
+		t.Error("SaveUDN() of a malformed UDN: want error, got nil")
+	}
+	if got, err := store.LoadUDN("root"); err != nil || got != udn {
+		t.Errorf("LoadUDN() = %q, %v, want %q", got, err, udn)
+	}
+}
+
+func TestDeriveUDN(t *testing.T) {
+	// The commonly cited version 5 UUID of "www.example.com" in the DNS namespace.
+	got, err := DeriveUDN("uuid:6ba7b810-9dad-11d1-80b4-00c04fd430c8", "www.example.com")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := "uuid:2ed6657d-e927-568b-95e1-2665a8aea6a2"; got != want {
+		t.Errorf("DeriveUDN() = %q, want %q", got, want)
+	}
+	if _, err := DeriveUDN("uuid:bad", "x"); err == nil {
+		t.Error("DeriveUDN() with bad base: got nil error")
+	}
+}
@@ -0,0 +1,110 @@
+package goupnp
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+
+	"github.com/huin/goupnp/internal/transport"
+	"github.com/huin/goupnp/soap"
+)
+
+// redirectDialer dials addr whatever address is asked for, recording them.
+type redirectDialer struct {
+	addr string
+
+	lock   sync.Mutex
+	dialed []string
+}
+
+func (rd *redirectDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	rd.lock.Lock()
+	rd.dialed = append(rd.dialed, address)
+	rd.lock.Unlock()
+	var d net.Dialer
+	return d.DialContext(ctx, network, rd.addr)
+}
+
+func TestSetDialer(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "POST" {
+			fmt.Fprint(w, `<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/"><s:Body>
+				<u:PingResponse xmlns:u="urn:test"></u:PingResponse></s:Body></s:Envelope>`)
+			return
+		}
+		fmt.Fprint(w, `<root xmlns="urn:schemas-upnp-org:device-1-0"><device><UDN>uuid:dialed</UDN></device></root>`)
+	}))
+	defer ts.Close()
+
+	rd := &redirectDialer{addr: ts.Listener.Addr().String()}
+	SetDialer(rd)
+	defer SetDialer(nil)
+
+	// 192.0.2.0/24 is reserved for documentation, so is only reached through
+	// the dialer.
+	loc, _ := url.Parse("http://192.0.2.1:49000/desc.xml")
+	root, err := DeviceByURL(loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root.Device.UDN != "uuid:dialed" {
+		t.Errorf("got UDN %q", root.Device.UDN)
+	}
+	control, _ := url.Parse("http://192.0.2.1:49000/control")
+	if err := soap.NewSOAPClient(*control).PerformAction("urn:test", "Ping", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	if len(rd.dialed) == 0 || rd.dialed[0] != "192.0.2.1:49000" {
+		t.Errorf("dialed %q, want 192.0.2.1:49000", rd.dialed)
+	}
+}
+
+func TestSetProxy(t *testing.T) {
+	defer SetProxy(nil)
+	for _, s := range []string{"socks5://127.0.0.1:1080", "http://127.0.0.1:3128"} {
+		u, _ := url.Parse(s)
+		if err := SetProxy(u); err != nil {
+			t.Errorf("SetProxy(%s): %v", s, err)
+		}
+	}
+	u, _ := url.Parse("ftp://127.0.0.1")
+	if err := SetProxy(u); err == nil {
+		t.Error("SetProxy of an ftp URL: want error, got nil")
+	}
+}
+
+func TestSetProxyNilKeepsDialer(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprint(w, `<root xmlns="urn:schemas-upnp-org:device-1-0"><device><UDN>uuid:dialed</UDN></device></root>`)
+	}))
+	defer ts.Close()
+
+	rd := &redirectDialer{addr: ts.Listener.Addr().String()}
+	SetDialer(rd)
+	defer SetDialer(nil)
+	if err := SetProxy(nil); err != nil {
+		t.Fatal(err)
+	}
+
+	loc, _ := url.Parse("http://192.0.2.1:49000/desc.xml")
+	if _, err := DeviceByURL(loc); err != nil {
+		t.Fatalf("DeviceByURL() after SetProxy(nil): %v, want the dialer of SetDialer kept", err)
+	}
+
+	// A SOCKS5 proxy replaces the dialer, until it is removed.
+	u, _ := url.Parse("socks5://127.0.0.1:1")
+	if err := SetProxy(u); err != nil {
+		t.Fatal(err)
+	}
+	if err := SetProxy(nil); err != nil {
+		t.Fatal(err)
+	}
+	if transport.Default() != nil {
+		t.Error("SetProxy(nil) kept the dialer of the SOCKS5 proxy")
+	}
+}
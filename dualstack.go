@@ -0,0 +1,158 @@
+package goupnp
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/huin/goupnp/httpu"
+	"github.com/huin/goupnp/ssdp"
+)
+
+// search performs an SSDP search over IPv4, or IPv6 if ipv6 is true.
+func search(ctx context.Context, searchTarget string, cfg *discoveryConfig, ipv6 bool) ([]*http.Response, error) {
+	opts := cfg.httpuOptions
+	if ipv6 {
+		opts = append(opts[:len(opts):len(opts)], httpu.WithIPv6())
+	}
+	client, err := httpu.NewHTTPUClient(opts...)
+	if err != nil {
+		return nil, err
+	}
+	defer client.Close()
+	return ssdp.SSDPRawSearchLocations(ctx, client, searchTarget, cfg.searchWait, cfg.searchSends)
+}
+
+// discoverDualStack searches over IPv4 and IPv6 in parallel, and fetches the
+// description of each device once, from the first of its locations that it is
+// fetched from. An error is only returned if both searches fail.
+func discoverDualStack(ctx context.Context, searchTarget string, cfg *discoveryConfig) ([]MaybeRootDevice, error) {
+	responses, err := searchDualStack(ctx, searchTarget, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return fetchMerged(ctx, mergeResponses(responses, true), cfg), nil
+}
+
+// searchDualStack searches over IPv4 and IPv6 in parallel, returning the
+// responses of both. An error is only returned if both searches fail.
+func searchDualStack(ctx context.Context, searchTarget string, cfg *discoveryConfig) ([]*http.Response, error) {
+	type result struct {
+		responses []*http.Response
+		err       error
+	}
+	results := make(chan result, 1)
+	go func() {
+		responses, err := search(ctx, searchTarget, cfg, true)
+		results <- result{responses, err}
+	}()
+	responses, err4 := search(ctx, searchTarget, cfg, false)
+	r6 := <-results
+	if err4 != nil && r6.err != nil {
+		return nil, err4
+	}
+	return append(responses, r6.responses...), nil
+}
+
+// fetchMerged fetches the description of each device of merged, as returned
+// by mergeResponses, concurrently, racing the fetches from its locations.
+func fetchMerged(ctx context.Context, merged [][]*url.URL, cfg *discoveryConfig) []MaybeRootDevice {
+	devices := make([]MaybeRootDevice, len(merged))
+	fetcher := cfg.fetcherOrDefault()
+	var wg sync.WaitGroup
+	for i, locs := range merged {
+		wg.Add(1)
+		go func(maybe *MaybeRootDevice, locs []*url.URL) {
+			defer wg.Done()
+			*maybe = fetchFirst(ctx, fetcher, locs, cfg)
+		}(&devices[i], locs)
+	}
+	wg.Wait()
+	return devices
+}
+
+// mergeResponses groups the locations of responses by the UDN of their USN if
+// byUDN is true, or by their whole USN otherwise, in order of the first
+// response of each device. The locations of each
+// device are in order of preference: IPv4 addresses and host names, then
+// global IPv6 addresses, then link-local IPv6 addresses, to which the zone of
+// the address that the response came from is added. Responses without a UDN
+// are grouped by location.
+func mergeResponses(responses []*http.Response, byUDN bool) [][]*url.URL {
+	var order []string
+	groups := make(map[string][]*url.URL)
+	seen := make(map[string]bool)
+	for _, resp := range responses {
+		loc, err := resp.Location()
+		if err != nil {
+			continue
+		}
+		addLinkLocalZone(loc, resp)
+		key := resp.Header.Get("USN")
+		if i := strings.Index(key, "::"); i >= 0 && byUDN {
+			key = key[:i]
+		}
+		if !strings.HasPrefix(key, "uuid:") {
+			key = loc.String()
+		}
+		if seen[key+" "+loc.String()] {
+			continue
+		}
+		seen[key+" "+loc.String()] = true
+		if _, ok := groups[key]; !ok {
+			order = append(order, key)
+		}
+		groups[key] = append(groups[key], loc)
+	}
+
+	merged := make([][]*url.URL, len(order))
+	for i, key := range order {
+		locs := groups[key]
+		sort.SliceStable(locs, func(i, j int) bool { return locationRank(locs[i]) < locationRank(locs[j]) })
+		merged[i] = locs
+	}
+	return merged
+}
+
+// locationRank ranks the reachability of a description URL, lower is better.
+func locationRank(loc *url.URL) int {
+	ip := net.ParseIP(stripZone(loc.Hostname()))
+	switch {
+	case ip == nil || ip.To4() != nil:
+		return 0
+	case !ip.IsLinkLocalUnicast():
+		return 1
+	}
+	return 2
+}
+
+// addLinkLocalZone adds the zone of the address that resp came from to loc,
+// if loc is at that link-local IPv6 address without a zone, which cannot be
+// reached without one.
+func addLinkLocalZone(loc *url.URL, resp *http.Response) {
+	host := loc.Hostname()
+	ip := net.ParseIP(host)
+	if ip == nil || ip.To4() != nil || !ip.IsLinkLocalUnicast() || resp.Request == nil {
+		return
+	}
+	remote, err := net.ResolveUDPAddr("udp", resp.Request.RemoteAddr)
+	if err != nil || remote.Zone == "" || !remote.IP.Equal(ip) {
+		return
+	}
+	hostport := "[" + host + "%" + remote.Zone + "]"
+	if port := loc.Port(); port != "" {
+		hostport += ":" + port
+	}
+	loc.Host = hostport
+}
+
+func stripZone(host string) string {
+	if i := strings.IndexByte(host, '%'); i >= 0 {
+		return host[:i]
+	}
+	return host
+}
@@ -0,0 +1,42 @@
+package goupnp
+
+import (
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestMergeResponses(t *testing.T) {
+	response := func(usn, location, remote string) *http.Response {
+		return &http.Response{
+			Header:  http.Header{"Usn": {usn}, "Location": {location}},
+			Request: &http.Request{RemoteAddr: remote},
+		}
+	}
+	responses := []*http.Response{
+		response("uuid:a::urn:schemas-upnp-org:device:Basic:1", "http://[fe80::1]:80/desc.xml", "[fe80::1%eth0]:1900"),
+		response("uuid:b::urn:schemas-upnp-org:device:Basic:1", "http://[2001:db8::2]:80/desc.xml", "[2001:db8::2]:1900"),
+		response("uuid:a::urn:schemas-upnp-org:device:Basic:1", "http://192.0.2.1:80/desc.xml", "192.0.2.1:1900"),
+		response("uuid:a", "http://[2001:db8::1]:80/desc.xml", "[2001:db8::1]:1900"),
+		// Repeated over another interface.
+		response("uuid:a::urn:schemas-upnp-org:device:Basic:1", "http://192.0.2.1:80/desc.xml", "192.0.2.1:1900"),
+		response("no-udn", "http://192.0.2.3/desc.xml", "192.0.2.3:1900"),
+	}
+
+	var got [][]string
+	for _, locs := range mergeResponses(responses, true) {
+		var strs []string
+		for _, loc := range locs {
+			strs = append(strs, loc.String())
+		}
+		got = append(got, strs)
+	}
+	want := [][]string{
+		{"http://192.0.2.1:80/desc.xml", "http://[2001:db8::1]:80/desc.xml", "http://[fe80::1%25eth0]:80/desc.xml"},
+		{"http://[2001:db8::2]:80/desc.xml"},
+		{"http://192.0.2.3/desc.xml"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,353 @@
+package goupnp
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"net/url"
+
+	"github.com/huin/goupnp/scpd"
+)
+
+// DeviceExport is a root device description in a form for encoding as JSON,
+// for inventory tools and UIs, with its URLs resolved and, once FetchSCPDs is
+// called, the actions and state variables of its services. It is created with
+// NewDeviceExport, or decoded with LoadDeviceExport, and converted back with
+// RootDevice.
+type DeviceExport struct {
+	// Location is the URL of the device description, if known.
+	Location    string      `json:"location,omitempty"`
+	SpecVersion SpecVersion `json:"specVersion"`
+	URLBase     string      `json:"urlBase,omitempty"`
+	Device      DeviceJSON  `json:"device"`
+}
+
+// DeviceJSON is a device of a DeviceExport.
+type DeviceJSON struct {
+	DeviceType       string        `json:"deviceType"`
+	FriendlyName     string        `json:"friendlyName"`
+	Manufacturer     string        `json:"manufacturer,omitempty"`
+	ManufacturerURL  string        `json:"manufacturerURL,omitempty"`
+	ModelDescription string        `json:"modelDescription,omitempty"`
+	ModelName        string        `json:"modelName,omitempty"`
+	ModelNumber      string        `json:"modelNumber,omitempty"`
+	ModelURL         string        `json:"modelURL,omitempty"`
+	SerialNumber     string        `json:"serialNumber,omitempty"`
+	UDN              string        `json:"udn"`
+	UPC              string        `json:"upc,omitempty"`
+	PresentationURL  string        `json:"presentationURL,omitempty"`
+	Icons            []IconJSON    `json:"icons,omitempty"`
+	Services         []ServiceJSON `json:"services,omitempty"`
+	Devices          []DeviceJSON  `json:"devices,omitempty"`
+}
+
+// IconJSON is an icon of a DeviceJSON.
+type IconJSON struct {
+	Mimetype string `json:"mimetype"`
+	Width    int32  `json:"width"`
+	Height   int32  `json:"height"`
+	Depth    int32  `json:"depth"`
+	URL      string `json:"url"`
+}
+
+// ServiceJSON is a service of a DeviceJSON. Actions and StateVariables are
+// only set once the SCPDs are fetched, and SCPDError if that failed.
+type ServiceJSON struct {
+	ServiceType    string              `json:"serviceType"`
+	ServiceID      string              `json:"serviceId"`
+	SCPDURL        string              `json:"scpdURL"`
+	ControlURL     string              `json:"controlURL"`
+	EventSubURL    string              `json:"eventSubURL,omitempty"`
+	Actions        []ActionJSON        `json:"actions,omitempty"`
+	StateVariables []StateVariableJSON `json:"stateVariables,omitempty"`
+	SCPDError      string              `json:"scpdError,omitempty"`
+}
+
+// ActionJSON is an action of a ServiceJSON.
+type ActionJSON struct {
+	Name      string         `json:"name"`
+	Arguments []ArgumentJSON `json:"arguments,omitempty"`
+	// Optional is whether the action is marked optional in the SCPD.
+	Optional bool `json:"optional,omitempty"`
+}
+
+// ArgumentJSON is an argument of an ActionJSON.
+type ArgumentJSON struct {
+	Name string `json:"name"`
+	// Direction is "in" or "out".
+	Direction     string `json:"direction"`
+	StateVariable string `json:"stateVariable"`
+}
+
+// StateVariableJSON is a state variable of a ServiceJSON.
+type StateVariableJSON struct {
+	Name          string          `json:"name"`
+	DataType      string          `json:"dataType"`
+	SendEvents    bool            `json:"sendEvents"`
+	Multicast     bool            `json:"multicast,omitempty"`
+	DefaultValue  string          `json:"defaultValue,omitempty"`
+	AllowedValues []string        `json:"allowedValues,omitempty"`
+	AllowedRange  *ValueRangeJSON `json:"allowedRange,omitempty"`
+}
+
+// ValueRangeJSON is the allowed range of a StateVariableJSON.
+type ValueRangeJSON struct {
+	Minimum string `json:"minimum"`
+	Maximum string `json:"maximum"`
+	Step    string `json:"step,omitempty"`
+}
+
+// NewDeviceExport returns the export of root, whose description is at loc,
+// or nil if unknown. The URLs of root must be resolved, as they are for roots
+// returned by discovery and DeviceByURL.
+func NewDeviceExport(root *RootDevice, loc *url.URL) *DeviceExport {
+	e := &DeviceExport{
+		SpecVersion: root.SpecVersion,
+		URLBase:     root.URLBaseStr,
+		Device:      exportDevice(&root.Device),
+	}
+	if loc != nil {
+		e.Location = loc.String()
+	}
+	return e
+}
+
+// LoadDeviceExport decodes a DeviceExport encoded as JSON.
+func LoadDeviceExport(r io.Reader) (*DeviceExport, error) {
+	e := new(DeviceExport)
+	if err := json.NewDecoder(r).Decode(e); err != nil {
+		return nil, err
+	}
+	return e, nil
+}
+
+// FetchSCPDs fetches the SCPD of every service of the export through
+// DefaultFetcher, and sets their actions and state variables. The services
+// whose SCPDs cannot be fetched have their SCPDError set, and an error is only
+// returned if ctx is done before all are fetched.
+func (e *DeviceExport) FetchSCPDs(ctx context.Context) error {
+	var services []*ServiceJSON
+	e.Device.visitServices(func(s *ServiceJSON) { services = append(services, s) })
+	actions := make([]func(context.Context) error, len(services))
+	for i, s := range services {
+		s := s
+		actions[i] = func(ctx context.Context) error {
+			srv := s.service()
+			doc, err := DefaultFetcher.RequestSCPD(ctx, &srv)
+			if err != nil {
+				s.SCPDError = err.Error()
+				return nil
+			}
+			s.setSCPD(doc)
+			return nil
+		}
+	}
+	return Batch(ctx, 0, actions...)
+}
+
+// RootDevice returns the root device of the export, with its URLs resolved
+// against its URLBase, or its Location if it has none.
+func (e *DeviceExport) RootDevice() (*RootDevice, error) {
+	root := &RootDevice{
+		SpecVersion: e.SpecVersion,
+		Device:      e.Device.device(),
+	}
+	base := e.URLBase
+	if base == "" {
+		base = e.Location
+	}
+	urlBase, err := url.Parse(base)
+	if err != nil {
+		return nil, err
+	}
+	root.SetURLBase(urlBase)
+	// URLBase is only that of the description, not the Location.
+	root.URLBaseStr = e.URLBase
+	return root, nil
+}
+
+func exportDevice(d *Device) DeviceJSON {
+	dj := DeviceJSON{
+		DeviceType:       d.DeviceType,
+		FriendlyName:     d.FriendlyName,
+		Manufacturer:     d.Manufacturer,
+		ManufacturerURL:  exportURL(&d.ManufacturerURL),
+		ModelDescription: d.ModelDescription,
+		ModelName:        d.ModelName,
+		ModelNumber:      d.ModelNumber,
+		ModelURL:         exportURL(&d.ModelURL),
+		SerialNumber:     d.SerialNumber,
+		UDN:              d.UDN,
+		UPC:              d.UPC,
+		PresentationURL:  exportURL(&d.PresentationURL),
+	}
+	for i := range d.Icons {
+		icon := &d.Icons[i]
+		dj.Icons = append(dj.Icons, IconJSON{
+			Mimetype: icon.Mimetype,
+			Width:    icon.Width,
+			Height:   icon.Height,
+			Depth:    icon.Depth,
+			URL:      exportURL(&icon.URL),
+		})
+	}
+	for i := range d.Services {
+		s := &d.Services[i]
+		dj.Services = append(dj.Services, ServiceJSON{
+			ServiceType: s.ServiceType,
+			ServiceID:   s.ServiceId,
+			SCPDURL:     exportURL(&s.SCPDURL),
+			ControlURL:  exportURL(&s.ControlURL),
+			EventSubURL: exportURL(&s.EventSubURL),
+		})
+	}
+	for i := range d.Devices {
+		dj.Devices = append(dj.Devices, exportDevice(&d.Devices[i]))
+	}
+	return dj
+}
+
+// exportURL returns the resolved URL of uf, or its text if it is not
+// resolved.
+func exportURL(uf *URLField) string {
+	if uf.Str == "" {
+		return ""
+	}
+	if !uf.Ok {
+		return uf.Str
+	}
+	return uf.URL.String()
+}
+
+func (dj *DeviceJSON) visitServices(visitor func(*ServiceJSON)) {
+	for i := range dj.Services {
+		visitor(&dj.Services[i])
+	}
+	for i := range dj.Devices {
+		dj.Devices[i].visitServices(visitor)
+	}
+}
+
+// device returns the device of dj, with unresolved URLs.
+func (dj *DeviceJSON) device() Device {
+	d := Device{
+		DeviceType:       dj.DeviceType,
+		FriendlyName:     dj.FriendlyName,
+		Manufacturer:     dj.Manufacturer,
+		ManufacturerURL:  URLField{Str: dj.ManufacturerURL},
+		ModelDescription: dj.ModelDescription,
+		ModelName:        dj.ModelName,
+		ModelNumber:      dj.ModelNumber,
+		ModelURL:         URLField{Str: dj.ModelURL},
+		SerialNumber:     dj.SerialNumber,
+		UDN:              dj.UDN,
+		UPC:              dj.UPC,
+		PresentationURL:  URLField{Str: dj.PresentationURL},
+	}
+	for _, icon := range dj.Icons {
+		d.Icons = append(d.Icons, Icon{
+			Mimetype: icon.Mimetype,
+			Width:    icon.Width,
+			Height:   icon.Height,
+			Depth:    icon.Depth,
+			URL:      URLField{Str: icon.URL},
+		})
+	}
+	for i := range dj.Services {
+		d.Services = append(d.Services, dj.Services[i].service())
+	}
+	for i := range dj.Devices {
+		d.Devices = append(d.Devices, dj.Devices[i].device())
+	}
+	return d
+}
+
+// service returns the service of s. Its URLs are resolved, as those of an
+// export are absolute.
+func (s *ServiceJSON) service() Service {
+	srv := Service{
+		ServiceType: s.ServiceType,
+		ServiceId:   s.ServiceID,
+		SCPDURL:     URLField{Str: s.SCPDURL},
+		ControlURL:  URLField{Str: s.ControlURL},
+		EventSubURL: URLField{Str: s.EventSubURL},
+	}
+	srv.SetURLBase(&url.URL{})
+	return srv
+}
+
+// SCPD returns the SCPD of the service, from its actions and state
+// variables.
+func (s *ServiceJSON) SCPD() *scpd.SCPD {
+	doc := new(scpd.SCPD)
+	for _, a := range s.Actions {
+		action := scpd.Action{Name: a.Name}
+		if a.Optional {
+			action.Extensions = []scpd.Extension{{XMLName: xml.Name{Local: "Optional"}}}
+		}
+		for _, arg := range a.Arguments {
+			action.Arguments = append(action.Arguments, scpd.Argument{
+				Name:                 arg.Name,
+				Direction:            arg.Direction,
+				RelatedStateVariable: arg.StateVariable,
+			})
+		}
+		doc.Actions = append(doc.Actions, action)
+	}
+	for _, v := range s.StateVariables {
+		sv := scpd.StateVariable{
+			Name:          v.Name,
+			SendEvents:    yesNo(v.SendEvents),
+			DataType:      scpd.DataType{Name: v.DataType},
+			DefaultValue:  v.DefaultValue,
+			AllowedValues: v.AllowedValues,
+		}
+		if v.Multicast {
+			sv.Multicast = "yes"
+		}
+		if r := v.AllowedRange; r != nil {
+			sv.AllowedValueRange = &scpd.AllowedValueRange{Minimum: r.Minimum, Maximum: r.Maximum, Step: r.Step}
+		}
+		doc.StateVariables = append(doc.StateVariables, sv)
+	}
+	return doc
+}
+
+func (s *ServiceJSON) setSCPD(doc *scpd.SCPD) {
+	s.Actions, s.StateVariables, s.SCPDError = nil, nil, ""
+	for i := range doc.Actions {
+		a := &doc.Actions[i]
+		aj := ActionJSON{Name: a.Name, Optional: a.IsOptional()}
+		for _, arg := range a.Arguments {
+			aj.Arguments = append(aj.Arguments, ArgumentJSON{
+				Name:          arg.Name,
+				Direction:     arg.Direction,
+				StateVariable: arg.RelatedStateVariable,
+			})
+		}
+		s.Actions = append(s.Actions, aj)
+	}
+	for i := range doc.StateVariables {
+		v := &doc.StateVariables[i]
+		vj := StateVariableJSON{
+			Name:          v.Name,
+			DataType:      v.DataType.Name,
+			SendEvents:    v.Evented(),
+			Multicast:     v.Multicast == "yes",
+			DefaultValue:  v.DefaultValue,
+			AllowedValues: v.AllowedValues,
+		}
+		if r := v.AllowedValueRange; r != nil {
+			vj.AllowedRange = &ValueRangeJSON{Minimum: r.Minimum, Maximum: r.Maximum, Step: r.Step}
+		}
+		s.StateVariables = append(s.StateVariables, vj)
+	}
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
@@ -0,0 +1,88 @@
+package goupnp
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestDeviceExport(t *testing.T) {
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/desc.xml":
+			fmt.Fprint(w, `<root xmlns="urn:schemas-upnp-org:device-1-0"><device>
+				<deviceType>urn:schemas-upnp-org:device:MediaRenderer:1</deviceType>
+				<friendlyName>Speaker</friendlyName><UDN>uuid:speaker</UDN>
+				<iconList><icon><mimetype>image/png</mimetype><width>48</width><height>48</height><depth>24</depth><url>/icon.png</url></icon></iconList>
+				<serviceList>
+					<service><serviceType>urn:schemas-upnp-org:service:RenderingControl:1</serviceType>
+						<serviceId>urn:upnp-org:serviceId:RenderingControl</serviceId>
+						<SCPDURL>/rc.xml</SCPDURL><controlURL>/rc/ctl</controlURL><eventSubURL>/rc/evt</eventSubURL></service>
+					<service><serviceType>urn:schemas-upnp-org:service:AVTransport:1</serviceType>
+						<serviceId>urn:upnp-org:serviceId:AVTransport</serviceId>
+						<SCPDURL>/missing.xml</SCPDURL><controlURL>/avt/ctl</controlURL></service>
+				</serviceList></device></root>`)
+		case "/rc.xml":
+			fmt.Fprint(w, `<scpd xmlns="urn:schemas-upnp-org:service-1-0"><actionList><action><name>GetVolume</name>
+				<argumentList><argument><name>CurrentVolume</name><direction>out</direction>
+				<relatedStateVariable>Volume</relatedStateVariable></argument></argumentList></action></actionList>
+				<serviceStateTable><stateVariable sendEvents="no"><name>Volume</name><dataType>ui2</dataType>
+				<allowedValueRange><minimum>0</minimum><maximum>100</maximum><step>1</step></allowedValueRange>
+				</stateVariable></serviceStateTable></scpd>`)
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer ts.Close()
+
+	loc, _ := url.Parse(ts.URL + "/desc.xml")
+	root, err := DeviceByURL(loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e := NewDeviceExport(root, loc)
+	if err := e.FetchSCPDs(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := json.NewEncoder(&buf).Encode(e); err != nil {
+		t.Fatal(err)
+	}
+
+	loaded, err := LoadDeviceExport(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	services := loaded.Device.Services
+	if len(services) != 2 {
+		t.Fatalf("got %d services, want 2", len(services))
+	}
+	rc, avt := &services[0], &services[1]
+	if rc.ControlURL != ts.URL+"/rc/ctl" || loaded.Device.Icons[0].URL != ts.URL+"/icon.png" {
+		t.Errorf("got control URL %q and icon URL %q, want them resolved", rc.ControlURL, loaded.Device.Icons[0].URL)
+	}
+	if len(rc.Actions) != 1 || rc.Actions[0].Arguments[0].StateVariable != "Volume" ||
+		len(rc.StateVariables) != 1 || rc.StateVariables[0].SendEvents || rc.StateVariables[0].AllowedRange.Maximum != "100" {
+		t.Errorf("got RenderingControl %+v", rc)
+	}
+	if avt.SCPDError == "" || avt.Actions != nil {
+		t.Errorf("got AVTransport %+v, want an SCPD error", avt)
+	}
+
+	restored, err := loaded.RootDevice()
+	if err != nil {
+		t.Fatal(err)
+	}
+	srv := restored.Device.FindService("urn:schemas-upnp-org:service:RenderingControl:1")
+	if len(srv) != 1 || srv[0].ControlURL.URL.String() != ts.URL+"/rc/ctl" || !srv[0].ControlURL.Ok {
+		t.Fatalf("restored services %+v", srv)
+	}
+	if doc := rc.SCPD(); doc.GetAction("GetVolume") == nil || doc.GetStateVariable("Volume").SendEvents != "no" {
+		t.Errorf("SCPD() = %+v", doc)
+	}
+}
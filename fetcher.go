@@ -0,0 +1,177 @@
+package goupnp
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/huin/goupnp/scpd"
+)
+
+// DefaultFetchConcurrency is the concurrency of DefaultFetcher.
+const DefaultFetchConcurrency = 8
+
+// fetchTimeout bounds a fetch, including the time that it waits for its host
+// and concurrency slots, as it is not cancelled by the context of any caller.
+const fetchTimeout = time.Minute
+
+// DefaultFetcher is the Fetcher of discovery and of DeviceExport.FetchSCPDs,
+// unless another is set with WithFetcher.
+var DefaultFetcher = NewFetcher(DefaultFetchConcurrency)
+
+// Fetcher fetches device descriptions and SCPDs concurrently, so that large
+// networks are neither fetched serially nor with a goroutine per document. It
+// makes at most its concurrency of fetches at a time, one at a time per host,
+// as many devices serve requests one by one, and fetches of a URL that is
+// already being fetched wait for that fetch rather than making another one.
+// Fetchers are created with NewFetcher, and are safe for concurrent use.
+type Fetcher struct {
+	sem chan struct{}
+
+	lock     sync.Mutex // Protects hosts and inflight.
+	hosts    map[string]*hostSlot
+	inflight map[string]*fetchCall
+}
+
+// hostSlot serializes the fetches from a host.
+type hostSlot struct {
+	ch    chan struct{}
+	users int
+}
+
+// fetchCall is a fetch in flight, whose result is shared with all the callers
+// fetching the same URL.
+type fetchCall struct {
+	done chan struct{}
+	val  interface{}
+	err  error
+
+	waiters int // Protected by Fetcher.lock.
+	cancel  context.CancelFunc
+}
+
+// NewFetcher returns a Fetcher making at most concurrency fetches at a time,
+// DefaultFetchConcurrency if not positive.
+func NewFetcher(concurrency int) *Fetcher {
+	if concurrency <= 0 {
+		concurrency = DefaultFetchConcurrency
+	}
+	return &Fetcher{
+		sem:      make(chan struct{}, concurrency),
+		hosts:    make(map[string]*hostSlot),
+		inflight: make(map[string]*fetchCall),
+	}
+}
+
+// DeviceByURL is as DeviceByURLCtx, fetching the description through f.
+// Callers fetching the same location at the same time get the same
+// RootDevice, which they must not modify. A merged fetch has the values of
+// the context of its first caller, but is not cancelled with it: each caller
+// stops waiting when its own context is done, and the fetch is cancelled once
+// no caller is waiting for it.
+func (f *Fetcher) DeviceByURL(ctx context.Context, loc *url.URL, opts ...DiscoveryOption) (*RootDevice, error) {
+	return f.deviceByURL(ctx, loc, newDiscoveryConfig(opts))
+}
+
+func (f *Fetcher) deviceByURL(ctx context.Context, loc *url.URL, cfg *discoveryConfig) (*RootDevice, error) {
+	val, err := f.do(ctx, "description "+loc.String(), loc.Host, func(ctx context.Context) (interface{}, error) {
+		return deviceByURL(ctx, loc, cfg)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return val.(*RootDevice), nil
+}
+
+// RequestSCPD is as Service.RequestSCDPCtx, fetching the SCPD through f.
+// Callers fetching the same SCPD at the same time get the same one, as with
+// DeviceByURL.
+func (f *Fetcher) RequestSCPD(ctx context.Context, srv *Service) (*scpd.SCPD, error) {
+	if !srv.SCPDURL.Ok {
+		return nil, errors.New("bad/missing SCPD URL, or no URLBase has been set")
+	}
+	u := &srv.SCPDURL.URL
+	val, err := f.do(ctx, "scpd "+u.String(), u.Host, func(ctx context.Context) (interface{}, error) {
+		return srv.RequestSCDPCtx(ctx)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return val.(*scpd.SCPD), nil
+}
+
+// do calls fetch, with the fetches of key merged and those of host
+// serialized. The fetch runs apart from the callers, so that a caller giving
+// up does not fail the others waiting for it.
+func (f *Fetcher) do(ctx context.Context, key, host string, fetch func(context.Context) (interface{}, error)) (interface{}, error) {
+	f.lock.Lock()
+	c, ok := f.inflight[key]
+	if !ok {
+		c = &fetchCall{done: make(chan struct{})}
+		var fetchCtx context.Context
+		fetchCtx, c.cancel = context.WithTimeout(context.WithoutCancel(ctx), fetchTimeout)
+		f.inflight[key] = c
+		slot := f.hosts[host]
+		if slot == nil {
+			slot = &hostSlot{ch: make(chan struct{}, 1)}
+			f.hosts[host] = slot
+		}
+		slot.users++
+		go f.call(fetchCtx, c, key, host, slot, fetch)
+	}
+	c.waiters++
+	f.lock.Unlock()
+
+	select {
+	case <-c.done:
+		return c.val, c.err
+	case <-ctx.Done():
+	}
+	f.lock.Lock()
+	if c.waiters--; c.waiters == 0 {
+		// Nobody is left waiting, so later callers start a fetch afresh.
+		c.cancel()
+		if f.inflight[key] == c {
+			delete(f.inflight, key)
+		}
+	}
+	f.lock.Unlock()
+	return nil, ctx.Err()
+}
+
+// call makes the fetch of c, and then releases it.
+func (f *Fetcher) call(ctx context.Context, c *fetchCall, key, host string, slot *hostSlot, fetch func(context.Context) (interface{}, error)) {
+	c.val, c.err = f.run(ctx, slot, fetch)
+	c.cancel()
+
+	f.lock.Lock()
+	if f.inflight[key] == c {
+		delete(f.inflight, key)
+	}
+	if slot.users--; slot.users == 0 {
+		delete(f.hosts, host)
+	}
+	f.lock.Unlock()
+	close(c.done)
+}
+
+// run calls fetch once it holds both slot and one of the concurrency slots of
+// f. The host slot is taken first, so that fetches waiting for their host do
+// not hold up the fetches from other hosts.
+func (f *Fetcher) run(ctx context.Context, slot *hostSlot, fetch func(context.Context) (interface{}, error)) (interface{}, error) {
+	select {
+	case slot.ch <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	defer func() { <-slot.ch }()
+	select {
+	case f.sem <- struct{}{}:
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+	defer func() { <-f.sem }()
+	return fetch(ctx)
+}
@@ -0,0 +1,107 @@
+package goupnp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestFetcher(t *testing.T) {
+	var lock sync.Mutex
+	active, maxActive, requests := 0, 0, 0
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		lock.Lock()
+		active++
+		requests++
+		if active > maxActive {
+			maxActive = active
+		}
+		lock.Unlock()
+		time.Sleep(20 * time.Millisecond)
+		lock.Lock()
+		active--
+		lock.Unlock()
+		fmt.Fprintf(w, `<root xmlns="urn:schemas-upnp-org:device-1-0"><device><UDN>uuid:%s</UDN></device></root>`, r.URL.Path[1:])
+	}))
+	defer ts.Close()
+
+	f := NewFetcher(4)
+	ctx := context.Background()
+	var wg sync.WaitGroup
+	errs := make(chan error, 6)
+	// Three fetches of one URL, and three of others, all from one host.
+	for _, path := range []string{"a", "a", "a", "b", "c", "d"} {
+		wg.Add(1)
+		go func(path string) {
+			defer wg.Done()
+			loc, _ := url.Parse(ts.URL + "/" + path)
+			root, err := f.DeviceByURL(ctx, loc)
+			if err == nil && root.Device.UDN != "uuid:"+path {
+				err = fmt.Errorf("got UDN %q for %s", root.Device.UDN, path)
+			}
+			errs <- err
+		}(path)
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		if err != nil {
+			t.Error(err)
+		}
+	}
+	if requests > 4 {
+		t.Errorf("got %d requests, want at most 4 with fetches of a merged", requests)
+	}
+	if maxActive != 1 {
+		t.Errorf("got %d concurrent requests to one host, want 1", maxActive)
+	}
+
+	cancelled, cancel := context.WithCancel(ctx)
+	cancel()
+	loc, _ := url.Parse(ts.URL + "/e")
+	if _, err := f.DeviceByURL(cancelled, loc); !errors.Is(err, context.Canceled) {
+		t.Errorf("DeviceByURL with cancelled context: got %v, want context.Canceled", err)
+	}
+
+	// The merged fetch outlives the cancellation of its first caller.
+	release := make(chan struct{})
+	slow := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+		fmt.Fprint(w, `<root xmlns="urn:schemas-upnp-org:device-1-0"><device><UDN>uuid:slow</UDN></device></root>`)
+	}))
+	defer slow.Close()
+	loc, _ = url.Parse(slow.URL + "/slow")
+	first, cancelFirst := context.WithCancel(ctx)
+	firstErr := make(chan error, 1)
+	go func() {
+		_, err := f.DeviceByURL(first, loc)
+		firstErr <- err
+	}()
+	secondErr := make(chan error, 1)
+	go func() {
+		_, err := f.DeviceByURL(ctx, loc)
+		secondErr <- err
+	}()
+	for waiters := 0; waiters != 2; {
+		time.Sleep(time.Millisecond)
+		f.lock.Lock()
+		if c := f.inflight["description "+loc.String()]; c != nil {
+			waiters = c.waiters
+		}
+		f.lock.Unlock()
+	}
+	cancelFirst()
+	if err := <-firstErr; !errors.Is(err, context.Canceled) {
+		t.Errorf("cancelled caller of a merged fetch: got %v, want context.Canceled", err)
+	}
+	close(release)
+	if err := <-secondErr; err != nil {
+		t.Errorf("other caller of a merged fetch: got %v, want the device", err)
+	}
+}
@@ -0,0 +1,106 @@
+package gena
+
+import (
+	"fmt"
+	"sync"
+)
+
+// OverflowPolicy determines what a ChanHandler does with an event when its
+// buffer is full.
+type OverflowPolicy int8
+
+const (
+	// DropOldest discards the oldest buffered event to make room for the new
+	// one.
+	DropOldest = OverflowPolicy(iota)
+	// DropNewest discards the new event.
+	DropNewest
+	// Block waits until the consumer makes room for the new event. Note that
+	// this stalls the HTTP (or HTTPU) server delivering the event, which may
+	// cause a device to give up on the subscription.
+	Block
+)
+
+func (p OverflowPolicy) String() string {
+	switch p {
+	case DropOldest:
+		return "DropOldest"
+	case DropNewest:
+		return "DropNewest"
+	case Block:
+		return "Block"
+	default:
+		return fmt.Sprintf("OverflowPolicy(%d)", int8(p))
+	}
+}
+
+var _ Handler = new(ChanHandler)
+
+// ChanHandler is a Handler that delivers events to a buffered channel, so
+// that slow consumers do not hold up the server receiving the events.
+type ChanHandler struct {
+	c      chan *Event
+	policy OverflowPolicy
+
+	// lock serializes senders, so that dropping the oldest event and sending
+	// the new one is atomic with respect to other senders.
+	lock    sync.Mutex
+	dropped uint64
+}
+
+// NewChanHandler creates a ChanHandler that buffers up to bufferSize events,
+// applying policy when the buffer is full. A negative bufferSize is taken as
+// 0, for an unbuffered channel, with which DropOldest drops the new event as
+// DropNewest does, as there is no buffered event to discard.
+func NewChanHandler(bufferSize int, policy OverflowPolicy) *ChanHandler {
+	if bufferSize < 0 {
+		bufferSize = 0
+	}
+	return &ChanHandler{
+		c:      make(chan *Event, bufferSize),
+		policy: policy,
+	}
+}
+
+// Events returns the channel that events are delivered on. The channel is
+// never closed.
+func (h *ChanHandler) Events() <-chan *Event {
+	return h.c
+}
+
+// Dropped returns the number of events that have been discarded due to the
+// overflow policy.
+func (h *ChanHandler) Dropped() uint64 {
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	return h.dropped
+}
+
+// HandleEvent implements Handler.
+func (h *ChanHandler) HandleEvent(ev *Event) {
+	if h.policy == Block {
+		h.c <- ev
+		return
+	}
+
+	h.lock.Lock()
+	defer h.lock.Unlock()
+	for {
+		select {
+		case h.c <- ev:
+			return
+		default:
+		}
+		if h.policy == DropNewest || cap(h.c) == 0 {
+			h.dropped++
+			return
+		}
+		// DropOldest. The consumer might have emptied the buffer in the
+		// meantime, in which case the send is retried without dropping.
+		select {
+		case <-h.c:
+			h.dropped++
+		default:
+		}
+	}
+}
@@ -0,0 +1,236 @@
+// gena implements GENA (General Event Notification Architecture), which UPnP
+// services use to publish changes to their evented state variables. This
+// package primarily covers subscribing to and receiving events, but also
+// contains the message encoding used by hosted services (see
+// github.com/huin/goupnp/device). See section 4 "Eventing" in
+// http://upnp.org/specs/arch/UPnP-arch-DeviceArchitecture-v1.1.pdf
+package gena
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"golang.org/x/net/html/charset"
+
+	"github.com/huin/goupnp/internal/logging"
+	"github.com/huin/goupnp/metrics"
+	"github.com/huin/goupnp/upnperr"
+)
+
+const (
+	// EventXMLNamespace is the namespace of the propertyset document carried
+	// by event messages.
+	EventXMLNamespace = "urn:schemas-upnp-org:event-1-0"
+
+	methodNotify  = "NOTIFY"
+	ntEvent       = "upnp:event"
+	ntsPropChange = "upnp:propchange"
+)
+
+// Property is a single evented state variable and its new value.
+type Property struct {
+	Name  string
+	Value string
+}
+
+// Event is a single event message received from a service, either via a
+// unicast subscription or via multicast eventing.
+type Event struct {
+	// The address that the event was received from.
+	RemoteAddr string
+
+	// SID is the subscription identifier. It is only set for unicast events.
+	SID string
+
+	// Seq is the event key, which increments for each event sent for the
+	// subscription (unicast) or service (multicast).
+	Seq uint32
+
+	// The following are only set for multicast events.
+
+	// USN identifies the device publishing the event.
+	USN string
+	// SVCID is the serviceId of the service within the device.
+	SVCID string
+	// LVL is the importance level of the event (e.g "upnp:/info").
+	LVL string
+	// BootID is the BOOTID.UPNP.ORG of the device, or -1 if not present.
+	BootID int32
+
+	// Properties contains the changed state variables, in document order.
+	Properties []Property
+}
+
+// Get returns the value of the named property. ok is false if the property
+// is not present in the event.
+func (ev *Event) Get(name string) (value string, ok bool) {
+	for _, p := range ev.Properties {
+		if p.Name == name {
+			return p.Value, true
+		}
+	}
+	return "", false
+}
+
+// Handler is the interface by which received events are passed to handling
+// code.
+type Handler interface {
+	// HandleEvent is called for each event received.
+	HandleEvent(ev *Event)
+}
+
+// HandlerFunc is a function-to-Handler adapter.
+type HandlerFunc func(ev *Event)
+
+func (f HandlerFunc) HandleEvent(ev *Event) {
+	f(ev)
+}
+
+type propertySet struct {
+	XMLName    xml.Name      `xml:"propertyset"`
+	Properties []propertyXML `xml:"property"`
+}
+
+type propertyXML struct {
+	Variables []variableXML `xml:",any"`
+}
+
+type variableXML struct {
+	XMLName xml.Name
+	Value   string `xml:",chardata"`
+}
+
+// ParsePropertySet decodes the propertyset document that forms the body of
+// an event message.
+func ParsePropertySet(r io.Reader) ([]Property, error) {
+	decoder := xml.NewDecoder(r)
+	decoder.DefaultSpace = EventXMLNamespace
+	decoder.CharsetReader = charset.NewReaderLabel
+
+	var ps propertySet
+	if err := decoder.Decode(&ps); err != nil {
+		return nil, upnperr.Wrap(upnperr.ErrMalformed, "gena: error decoding propertyset", err)
+	}
+	var props []Property
+	for _, p := range ps.Properties {
+		for _, v := range p.Variables {
+			props = append(props, Property{
+				Name:  v.XMLName.Local,
+				Value: v.Value,
+			})
+		}
+	}
+	return props, nil
+}
+
+// WritePropertySet encodes props as a propertyset document, as used for the
+// body of event messages.
+func WritePropertySet(w io.Writer, props []Property) error {
+	buf := new(bytes.Buffer)
+	buf.WriteString(xml.Header)
+	buf.WriteString(`<e:propertyset xmlns:e="` + EventXMLNamespace + `">`)
+	for _, p := range props {
+		buf.WriteString(`<e:property><`)
+		xml.EscapeText(buf, []byte(p.Name))
+		buf.WriteString(`>`)
+		xml.EscapeText(buf, []byte(p.Value))
+		buf.WriteString(`</`)
+		xml.EscapeText(buf, []byte(p.Name))
+		buf.WriteString(`></e:property>`)
+	}
+	buf.WriteString(`</e:propertyset>`)
+	_, err := buf.WriteTo(w)
+	return err
+}
+
+// NewNotifyRequest creates a unicast event message request, to be sent to a
+// subscriber's callback URL.
+func NewNotifyRequest(callback *url.URL, sid string, seq uint32, props []Property) (*http.Request, error) {
+	body := new(bytes.Buffer)
+	if err := WritePropertySet(body, props); err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(methodNotify, callback.String(), body)
+	if err != nil {
+		return nil, err
+	}
+	// Putting headers in here avoids them being title-cased.
+	req.Header = http.Header{
+		"CONTENT-TYPE": []string{`text/xml; charset="utf-8"`},
+		"NT":           []string{ntEvent},
+		"NTS":          []string{ntsPropChange},
+		"SID":          []string{sid},
+		"SEQ":          []string{strconv.FormatUint(uint64(seq), 10)},
+	}
+	return req, nil
+}
+
+func parseSeq(s string) (uint32, error) {
+	seq, err := strconv.ParseUint(strings.TrimSpace(s), 10, 32)
+	if err != nil {
+		return 0, upnperr.Wrap(upnperr.ErrMalformed, fmt.Sprintf("gena: could not parse SEQ header %q", s), err)
+	}
+	return uint32(seq), nil
+}
+
+var _ http.Handler = new(NotifyHandler)
+
+// NotifyHandler is an http.Handler that receives unicast event messages sent
+// to subscription callback URLs, and passes them to Handler.
+type NotifyHandler struct {
+	Handler Handler
+	// Logger logs the malformed event messages received, the
+	// goupnp.SetLogger logger if nil.
+	Logger *slog.Logger
+}
+
+// ServeHTTP implements http.Handler.
+func (nh *NotifyHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != methodNotify {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	nt, nts, sid := r.Header.Get("NT"), r.Header.Get("NTS"), r.Header.Get("SID")
+	if nt == "" || nts == "" {
+		metrics.Inc(metrics.EventNotifications, metrics.Label{Name: metrics.LabelResult, Value: metrics.ResultError})
+		http.Error(w, "missing NT or NTS header", http.StatusBadRequest)
+		return
+	}
+	if nt != ntEvent || nts != ntsPropChange || sid == "" {
+		metrics.Inc(metrics.EventNotifications, metrics.Label{Name: metrics.LabelResult, Value: metrics.ResultError})
+		http.Error(w, "bad NT, NTS or SID header", http.StatusPreconditionFailed)
+		return
+	}
+	seq, err := parseSeq(r.Header.Get("SEQ"))
+	if err != nil {
+		metrics.Inc(metrics.EventNotifications, metrics.Result(err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+	props, err := ParsePropertySet(r.Body)
+	if err != nil {
+		logging.ReportMalformed(nh.Logger, "gena", "gena: bad event message", r.RemoteAddr, err,
+			slog.String(logging.KeySID, sid))
+		metrics.Inc(metrics.EventNotifications, metrics.Result(err))
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	metrics.Inc(metrics.EventNotifications, metrics.Result(nil))
+	// The event is still acknowledged if the handler panics.
+	defer logging.Recover(nh.Logger, "gena: event handler panicked", slog.String(logging.KeySID, sid))
+	nh.Handler.HandleEvent(&Event{
+		RemoteAddr: r.RemoteAddr,
+		SID:        sid,
+		Seq:        seq,
+		BootID:     -1,
+		Properties: props,
+	})
+}
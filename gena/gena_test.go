@@ -0,0 +1,188 @@
+package gena
+
+import (
+	"bufio"
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testPropertySet = `<?xml version="1.0"?>
+<e:propertyset xmlns:e="urn:schemas-upnp-org:event-1-0">
+	<e:property><ConnectionStatus>Connected</ConnectionStatus></e:property>
+	<e:property><ExternalIPAddress>192.0.2.1</ExternalIPAddress></e:property>
+</e:propertyset>`
+
+var testProperties = []Property{
+	{Name: "ConnectionStatus", Value: "Connected"},
+	{Name: "ExternalIPAddress", Value: "192.0.2.1"},
+}
+
+func TestNotifyHandler(t *testing.T) {
+	var got *Event
+	nh := &NotifyHandler{Handler: HandlerFunc(func(ev *Event) { got = ev })}
+
+	req := httptest.NewRequest(methodNotify, "/callback", strings.NewReader(testPropertySet))
+	req.Header.Set("NT", ntEvent)
+	req.Header.Set("NTS", ntsPropChange)
+	req.Header.Set("SID", "uuid:1234")
+	req.Header.Set("SEQ", "7")
+	rec := httptest.NewRecorder()
+	nh.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusOK {
+		t.Fatalf("want status 200, got %d", rec.Code)
+	}
+	if got == nil {
+		t.Fatal("handler not called")
+	}
+	if got.SID != "uuid:1234" || got.Seq != 7 {
+		t.Errorf("Bad SID/SEQ: %q/%d", got.SID, got.Seq)
+	}
+	if !reflect.DeepEqual(testProperties, got.Properties) {
+		t.Errorf("Bad properties\nwant: %+v\n got: %+v", testProperties, got.Properties)
+	}
+}
+
+func TestNotifyHandlerRejectsBadSID(t *testing.T) {
+	nh := &NotifyHandler{Handler: HandlerFunc(func(ev *Event) {
+		t.Error("handler unexpectedly called")
+	})}
+	req := httptest.NewRequest(methodNotify, "/callback", strings.NewReader(testPropertySet))
+	req.Header.Set("NT", ntEvent)
+	req.Header.Set("NTS", ntsPropChange)
+	req.Header.Set("SEQ", "0")
+	rec := httptest.NewRecorder()
+	nh.ServeHTTP(rec, req)
+	if rec.Code != http.StatusPreconditionFailed {
+		t.Errorf("want status 412, got %d", rec.Code)
+	}
+}
+
+func TestNotifyHandlerLogsBadEvent(t *testing.T) {
+	var buf bytes.Buffer
+	nh := &NotifyHandler{
+		Handler: HandlerFunc(func(ev *Event) { t.Error("handler unexpectedly called") }),
+		Logger:  slog.New(slog.NewTextHandler(&buf, nil)),
+	}
+	req := httptest.NewRequest(methodNotify, "/callback", strings.NewReader("<e:propertyset"))
+	req.Header.Set("NT", ntEvent)
+	req.Header.Set("NTS", ntsPropChange)
+	req.Header.Set("SID", "uuid:1234")
+	req.Header.Set("SEQ", "0")
+	rec := httptest.NewRecorder()
+	nh.ServeHTTP(rec, req)
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("want status 400, got %d", rec.Code)
+	}
+	if log := buf.String(); !strings.Contains(log, "level=WARN") || !strings.Contains(log, "sid=uuid:1234") {
+		t.Errorf("got log %q, want a warning with the SID", log)
+	}
+}
+
+func TestMulticastListener(t *testing.T) {
+	msg := "NOTIFY * HTTP/1.1\r\n" +
+		"HOST: 239.255.255.246:7900\r\n" +
+		"CONTENT-TYPE: text/xml; charset=\"utf-8\"\r\n" +
+		"USN: uuid:device-1::urn:schemas-upnp-org:service:WANIPConnection:2\r\n" +
+		"SVCID: urn:upnp-org:serviceId:WANIPConn1\r\n" +
+		"NT: upnp:event\r\n" +
+		"NTS: upnp:propchange\r\n" +
+		"SEQ: 3\r\n" +
+		"LVL: upnp:/info\r\n" +
+		"BOOTID.UPNP.ORG: 12\r\n" +
+		"CONTENT-LENGTH: " + strconv.Itoa(len(testPropertySet)) + "\r\n" +
+		"\r\n" + testPropertySet
+	req, err := http.ReadRequest(bufio.NewReader(strings.NewReader(msg)))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got *Event
+	ml := &MulticastListener{Handler: HandlerFunc(func(ev *Event) { got = ev })}
+	ml.ServeMessage(req)
+	if got == nil {
+		t.Fatal("handler not called")
+	}
+	if got.SVCID != "urn:upnp-org:serviceId:WANIPConn1" || got.LVL != "upnp:/info" || got.BootID != 12 || got.Seq != 3 {
+		t.Errorf("Bad event headers: %+v", got)
+	}
+	if !reflect.DeepEqual(testProperties, got.Properties) {
+		t.Errorf("Bad properties\nwant: %+v\n got: %+v", testProperties, got.Properties)
+	}
+}
+
+func TestChanHandler(t *testing.T) {
+	tests := []struct {
+		policy   OverflowPolicy
+		wantSeqs []uint32
+	}{
+		{DropOldest, []uint32{2, 3}},
+		{DropNewest, []uint32{0, 1}},
+	}
+	for _, test := range tests {
+		h := NewChanHandler(2, test.policy)
+		for seq := uint32(0); seq < 4; seq++ {
+			h.HandleEvent(&Event{Seq: seq})
+		}
+		var gotSeqs []uint32
+		for len(h.Events()) > 0 {
+			gotSeqs = append(gotSeqs, (<-h.Events()).Seq)
+		}
+		if !reflect.DeepEqual(test.wantSeqs, gotSeqs) {
+			t.Errorf("%v: want events %v, got %v", test.policy, test.wantSeqs, gotSeqs)
+		}
+		if h.Dropped() != 2 {
+			t.Errorf("%v: want 2 dropped, got %d", test.policy, h.Dropped())
+		}
+	}
+
+	// Without a buffer, events that no consumer is waiting for are dropped,
+	// rather than the sender spinning forever.
+	h := NewChanHandler(0, DropOldest)
+	done := make(chan struct{})
+	go func() {
+		h.HandleEvent(&Event{Seq: 0})
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("HandleEvent blocked on an unbuffered DropOldest handler")
+	}
+	if h.Dropped() != 1 {
+		t.Errorf("unbuffered: want 1 dropped, got %d", h.Dropped())
+	}
+
+	// A negative buffer size is unbuffered, rather than a panic.
+	h = NewChanHandler(-1, DropOldest)
+	h.HandleEvent(&Event{Seq: 0})
+	if cap(h.Events()) != 0 || h.Dropped() != 1 {
+		t.Errorf("negative buffer size: want an unbuffered channel and 1 dropped, got capacity %d and %d dropped",
+			cap(h.Events()), h.Dropped())
+	}
+}
+
+func TestWritePropertySetRoundTrip(t *testing.T) {
+	props := []Property{
+		{Name: "A", Value: "1 < 2"},
+		{Name: "LastChange", Value: `<Event xmlns="urn:schemas-upnp-org:metadata-1-0/AVT/"/>`},
+	}
+	buf := new(strings.Builder)
+	if err := WritePropertySet(buf, props); err != nil {
+		t.Fatal(err)
+	}
+	got, err := ParsePropertySet(strings.NewReader(buf.String()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(props, got) {
+		t.Errorf("Bad round trip\nwant: %+v\n got: %+v", props, got)
+	}
+}
@@ -0,0 +1,95 @@
+package gena
+
+import (
+	"log/slog"
+	"net/http"
+	"strconv"
+
+	"github.com/huin/goupnp/httpu"
+	"github.com/huin/goupnp/internal/logging"
+	"github.com/huin/goupnp/metrics"
+	"github.com/huin/goupnp/upnperr"
+)
+
+const (
+	// MulticastUDP4Addr is the address that UPnP 1.1 multicast events are
+	// sent to.
+	MulticastUDP4Addr = "239.255.255.246:7900"
+
+	// Multicast event messages must fit within a single UDP datagram, but
+	// can be larger than typical SSDP messages.
+	multicastMaxMessageBytes = 8192
+)
+
+var _ httpu.Handler = new(MulticastListener)
+
+// MulticastListener receives UPnP 1.1 multicast event messages, and passes
+// them to Handler. Services using multicast eventing publish state changes
+// without requiring a subscription. See section 4.3 "Eventing: Multicast
+// event messages" in
+// http://upnp.org/specs/arch/UPnP-arch-DeviceArchitecture-v1.1.pdf
+type MulticastListener struct {
+	Handler Handler
+	// Logger logs the malformed event messages received, the
+	// goupnp.SetLogger logger if nil.
+	Logger *slog.Logger
+}
+
+// NewMulticastServer is a convenience function to create an httpu server that
+// passes multicast event messages to the given handler. Call ListenAndServe on
+// the server for messages to be processed.
+func NewMulticastServer(handler Handler) *httpu.Server {
+	return &httpu.Server{
+		Addr:            MulticastUDP4Addr,
+		Multicast:       true,
+		Handler:         &MulticastListener{Handler: handler},
+		MaxMessageBytes: multicastMaxMessageBytes,
+	}
+}
+
+// ServeMessage implements httpu.Handler.
+func (ml *MulticastListener) ServeMessage(r *http.Request) {
+	if r.Method != methodNotify {
+		return
+	}
+	if nt, nts := r.Header.Get("NT"), r.Header.Get("NTS"); nt != ntEvent || nts != ntsPropChange {
+		return
+	}
+
+	seq, err := parseSeq(r.Header.Get("SEQ"))
+	if err != nil {
+		ml.logBadEvent(r, err)
+		return
+	}
+	bootID := int32(-1)
+	if s := r.Header.Get("BOOTID.UPNP.ORG"); s != "" {
+		v, err := strconv.ParseInt(s, 10, 32)
+		if err != nil {
+			ml.logBadEvent(r, upnperr.Wrap(upnperr.ErrMalformed, "bad BOOTID.UPNP.ORG", err))
+			return
+		}
+		bootID = int32(v)
+	}
+	props, err := ParsePropertySet(r.Body)
+	if err != nil {
+		ml.logBadEvent(r, err)
+		return
+	}
+
+	metrics.Inc(metrics.EventNotifications, metrics.Result(nil))
+	defer logging.Recover(ml.Logger, "gena: event handler panicked", slog.String(logging.KeyRemote, r.RemoteAddr))
+	ml.Handler.HandleEvent(&Event{
+		RemoteAddr: r.RemoteAddr,
+		Seq:        seq,
+		USN:        r.Header.Get("USN"),
+		SVCID:      r.Header.Get("SVCID"),
+		LVL:        r.Header.Get("LVL"),
+		BootID:     bootID,
+		Properties: props,
+	})
+}
+
+func (ml *MulticastListener) logBadEvent(r *http.Request, err error) {
+	metrics.Inc(metrics.EventNotifications, metrics.Result(err))
+	logging.ReportMalformed(ml.Logger, "gena", "gena: bad multicast event message", r.RemoteAddr, err)
+}
@@ -0,0 +1,161 @@
+package gena
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/huin/goupnp/clock"
+	"github.com/huin/goupnp/internal/logging"
+	"github.com/huin/goupnp/store"
+	"github.com/huin/goupnp/upnperr"
+)
+
+// ErrSubscriptionExpired is returned by Resume for subscriptions that have
+// already expired at the device. It is of the kind
+// upnperr.ErrSubscriptionExpired, as are the errors of renewing subscriptions
+// that the publisher no longer knows of.
+var ErrSubscriptionExpired error = upnperr.New(upnperr.ErrSubscriptionExpired, "gena: subscription has expired")
+
+// SubscriptionState is the persistable state of a Subscription, as returned by
+// Subscription.State. It is suitable for encoding with encoding/json, so that
+// a restarted control point can Resume its subscriptions rather than leaking
+// them on devices with few subscriber slots.
+type SubscriptionState struct {
+	SID       string    `json:"sid"`
+	EventURL  string    `json:"eventURL"`
+	Callbacks []string  `json:"callbacks"`
+	Timeout   int64     `json:"timeoutSeconds"`
+	Expiry    time.Time `json:"expiry"`
+}
+
+// State returns the persistable state of the subscription.
+func (sub *Subscription) State() SubscriptionState {
+	callbacks := make([]string, len(sub.Callbacks))
+	for i, cb := range sub.Callbacks {
+		callbacks[i] = cb.String()
+	}
+	return SubscriptionState{
+		SID:       sub.SID,
+		EventURL:  sub.EventURL.String(),
+		Callbacks: callbacks,
+		Timeout:   int64(sub.Timeout / time.Second),
+		Expiry:    sub.Expiry,
+	}
+}
+
+// Resume recreates a Subscription from state previously returned by
+// Subscription.State, without contacting the device. The caller should Renew
+// the returned subscription promptly, and before its Expiry.
+//
+// GENA does not allow a renewal to change the callback URL, so the Subscriber
+// must be listening on the same port as when the subscription was made (see
+// NewSubscriberAddr), otherwise an error is returned. ErrSubscriptionExpired
+// is returned if the subscription has already expired.
+func (s *Subscriber) Resume(state SubscriptionState) (*Subscription, error) {
+	if state.SID == "" {
+		return nil, errors.New("gena: subscription state has no SID")
+	}
+	if !state.Expiry.IsZero() && !clock.Or(s.Clock).Now().Before(state.Expiry) {
+		return nil, ErrSubscriptionExpired
+	}
+	eventURL, err := url.Parse(state.EventURL)
+	if err != nil {
+		return nil, fmt.Errorf("gena: bad event URL in subscription state: %v", err)
+	}
+
+	port := strconv.Itoa(s.port)
+	var reachable bool
+	callbacks := make([]*url.URL, len(state.Callbacks))
+	for i, cbStr := range state.Callbacks {
+		cb, err := url.Parse(cbStr)
+		if err != nil {
+			return nil, fmt.Errorf("gena: bad callback URL in subscription state: %v", err)
+		}
+		if cb.Scheme == s.scheme && cb.Port() == port && cb.Path == callbackPath {
+			reachable = true
+		}
+		callbacks[i] = cb
+	}
+	if !reachable {
+		return nil, fmt.Errorf("gena: none of the callback URLs %q of subscription %s reach this subscriber on port %s",
+			state.Callbacks, state.SID, port)
+	}
+
+	return &Subscription{
+		SID:        state.SID,
+		EventURL:   *eventURL,
+		Callbacks:  callbacks,
+		Timeout:    time.Duration(state.Timeout) * time.Second,
+		Expiry:     state.Expiry,
+		subscriber: s,
+	}, nil
+}
+
+// ResumeStored resumes the subscriptions kept in the Store, as Resume does.
+// Expired subscriptions are removed from the Store and skipped, and the first
+// other error of resuming one is returned along with those resumed.
+func (s *Subscriber) ResumeStored() ([]*Subscription, error) {
+	if s.Store == nil {
+		return nil, nil
+	}
+	items, err := s.Store.List(store.PrefixSubscription)
+	if err != nil {
+		return nil, err
+	}
+	var subs []*Subscription
+	var firstErr error
+	for _, item := range items {
+		var state SubscriptionState
+		if err := json.Unmarshal(item.Value, &state); err != nil {
+			if firstErr == nil {
+				firstErr = fmt.Errorf("gena: error decoding stored subscription %s: %v", item.Key, err)
+			}
+			continue
+		}
+		sub, err := s.Resume(state)
+		if err == ErrSubscriptionExpired {
+			s.forget(state.SID)
+			continue
+		}
+		if err != nil {
+			if firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		subs = append(subs, sub)
+	}
+	return subs, firstErr
+}
+
+// save keeps the state of sub in the Store, if any, until it expires.
+func (s *Subscriber) save(sub *Subscription) {
+	if s.Store == nil {
+		return
+	}
+	value, err := json.Marshal(sub.State())
+	if err == nil {
+		var ttl time.Duration
+		if !sub.Expiry.IsZero() {
+			ttl = sub.Expiry.Sub(clock.Or(s.Clock).Now())
+		}
+		err = s.Store.Put(store.PrefixSubscription+sub.SID, value, ttl)
+	}
+	if err != nil {
+		logging.Or(s.Logger).Warn("gena: failed to store subscription", logging.Err(err))
+	}
+}
+
+// forget removes the subscription sid from the Store, if any.
+func (s *Subscriber) forget(sid string) {
+	if s.Store == nil || sid == "" {
+		return
+	}
+	if err := s.Store.Delete(store.PrefixSubscription + sid); err != nil {
+		logging.Or(s.Logger).Warn("gena: failed to remove stored subscription", logging.Err(err))
+	}
+}
@@ -0,0 +1,387 @@
+package gena
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/huin/goupnp/clock"
+	"github.com/huin/goupnp/internal/transport"
+	"github.com/huin/goupnp/metrics"
+	"github.com/huin/goupnp/store"
+	"github.com/huin/goupnp/tracing"
+	"github.com/huin/goupnp/upnperr"
+)
+
+const (
+	methodSubscribe   = "SUBSCRIBE"
+	methodUnsubscribe = "UNSUBSCRIBE"
+
+	// DefaultTimeout is the subscription duration requested if none is given.
+	DefaultTimeout = 30 * time.Minute
+
+	// callbackPath is the path that the Subscriber serves event messages on.
+	callbackPath = "/gena/event"
+
+	timeoutInfinite = "Second-infinite"
+)
+
+// Subscriber subscribes to services, and receives the resulting event
+// messages on a local HTTP server that it manages. The callback URL for each
+// subscription is determined automatically, using the local address that
+// routes to the service's event URL.
+type Subscriber struct {
+	// HTTPClient is used to send SUBSCRIBE and UNSUBSCRIBE requests.
+	HTTPClient http.Client
+	// Logger logs the malformed event messages received, the
+	// goupnp.SetLogger logger if nil. Set it before subscribing.
+	Logger *slog.Logger
+	// Clock is the source of the Expiry of subscriptions, clock.Real if nil.
+	// Set it before subscribing.
+	Clock clock.Clock
+	// Store, if not nil, keeps the state of subscriptions until they expire
+	// or are cancelled, so that they can be resumed with ResumeStored after a
+	// restart. Set it before subscribing.
+	Store store.Store
+
+	server *http.Server
+	port   int
+	// scheme of callback URLs, "http" or "https".
+	scheme string
+
+	// closeOnce protects against closing server twice.
+	closeOnce sync.Once
+	closeErr  error
+}
+
+// DefaultRequestTimeout is the time limit of the SUBSCRIBE and UNSUBSCRIBE
+// requests of a Subscriber, unless set with WithRequestTimeout.
+const DefaultRequestTimeout = 3 * time.Second
+
+// SubscriberOption configures a Subscriber created by NewSubscriber,
+// NewSubscriberAddr or NewTLSSubscriber. Options are applied before the
+// Subscriber starts receiving event messages.
+type SubscriberOption func(*Subscriber)
+
+// WithRequestTimeout sets the time limit of SUBSCRIBE and UNSUBSCRIBE
+// requests, DefaultRequestTimeout by default.
+func WithRequestTimeout(d time.Duration) SubscriberOption {
+	return func(s *Subscriber) { s.HTTPClient.Timeout = d }
+}
+
+// WithTransport sets the transport of SUBSCRIBE and UNSUBSCRIBE requests, by
+// default http.DefaultTransport, dialing through the dialer and proxy set with
+// goupnp.SetDialer and goupnp.SetProxy.
+func WithTransport(rt http.RoundTripper) SubscriberOption {
+	return func(s *Subscriber) { s.HTTPClient.Transport = rt }
+}
+
+// WithLogger sets the Logger of the Subscriber.
+func WithLogger(l *slog.Logger) SubscriberOption {
+	return func(s *Subscriber) { s.Logger = l }
+}
+
+// WithClock sets the Clock of the Subscriber.
+func WithClock(c clock.Clock) SubscriberOption {
+	return func(s *Subscriber) { s.Clock = c }
+}
+
+// WithStore sets the Store of the Subscriber.
+func WithStore(st store.Store) SubscriberOption {
+	return func(s *Subscriber) { s.Store = st }
+}
+
+// NewSubscriber creates a Subscriber that listens for event messages on an
+// automatically chosen TCP port on all local addresses, and passes the events
+// to handler.
+func NewSubscriber(handler Handler, opts ...SubscriberOption) (*Subscriber, error) {
+	return NewSubscriberAddr(":0", handler, opts...)
+}
+
+// NewSubscriberAddr creates a Subscriber that listens for event messages on
+// the given TCP address, and passes the events to handler.
+func NewSubscriberAddr(addr string, handler Handler, opts ...SubscriberOption) (*Subscriber, error) {
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	return newSubscriber(l, "http", handler, opts), nil
+}
+
+// NewTLSSubscriber creates a Subscriber that listens for event messages using
+// HTTPS on the given TCP address, and passes the events to handler. config
+// must contain at least one certificate. Subscriptions made with the returned
+// Subscriber use https callback URLs, as required by devices implementing
+// DeviceProtection that refuse to deliver events in plaintext.
+//
+// To subscribe to services with https event URLs (for example where the
+// device requires a client certificate), configure the Transport of
+// HTTPClient accordingly.
+func NewTLSSubscriber(addr string, config *tls.Config, handler Handler, opts ...SubscriberOption) (*Subscriber, error) {
+	if config == nil || (len(config.Certificates) == 0 && config.GetCertificate == nil) {
+		return nil, errors.New("gena: TLS subscriber requires a certificate")
+	}
+	l, err := net.Listen("tcp", addr)
+	if err != nil {
+		return nil, err
+	}
+	return newSubscriber(tls.NewListener(l, config), "https", handler, opts), nil
+}
+
+func newSubscriber(l net.Listener, scheme string, handler Handler, opts []SubscriberOption) *Subscriber {
+	mux := http.NewServeMux()
+	s := &Subscriber{
+		HTTPClient: http.Client{Timeout: DefaultRequestTimeout},
+		server:     &http.Server{Handler: mux},
+		port:       l.Addr().(*net.TCPAddr).Port,
+		scheme:     scheme,
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	mux.HandleFunc(callbackPath, func(w http.ResponseWriter, r *http.Request) {
+		nh := NotifyHandler{Handler: handler, Logger: s.Logger}
+		nh.ServeHTTP(w, r)
+	})
+	go s.server.Serve(l)
+	return s
+}
+
+// Close stops the callback server. Existing subscriptions are not cancelled,
+// and will expire at the device.
+func (s *Subscriber) Close() error {
+	s.closeOnce.Do(func() {
+		s.closeErr = s.server.Close()
+	})
+	return s.closeErr
+}
+
+// CallbackURL returns the URL that a service at eventURL should send event
+// messages to, in order for them to reach this Subscriber.
+func (s *Subscriber) CallbackURL(eventURL *url.URL) (*url.URL, error) {
+	ip, err := LocalIPFor(eventURL)
+	if err != nil {
+		return nil, err
+	}
+	return &url.URL{
+		Scheme: s.scheme,
+		Host:   net.JoinHostPort(ip.String(), strconv.Itoa(s.port)),
+		Path:   callbackPath,
+	}, nil
+}
+
+// LocalIPFor returns the local IP address that the host would use to reach
+// the host in the given URL. This is determined by "connecting" a UDP socket
+// to the remote host, which selects a route without sending any packets. This
+// is primarily useful on multi-homed machines, where the correct address to
+// put in a callback URL depends on the network that the device is on.
+func LocalIPFor(remote *url.URL) (net.IP, error) {
+	host := remote.Hostname()
+	if host == "" {
+		return nil, fmt.Errorf("gena: no host in URL %q", remote.String())
+	}
+	port := remote.Port()
+	if port == "" {
+		port = "80"
+	}
+	conn, err := net.Dial("udp", net.JoinHostPort(host, port))
+	if err != nil {
+		return nil, fmt.Errorf("gena: could not determine local address for %q: %v", host, err)
+	}
+	defer conn.Close()
+	addr, ok := conn.LocalAddr().(*net.UDPAddr)
+	if !ok {
+		return nil, fmt.Errorf("gena: unexpected local address type %T", conn.LocalAddr())
+	}
+	return addr.IP, nil
+}
+
+// Subscribe subscribes to the service with the given event subscription URL
+// (as found in goupnp.Service.EventSubURL), with a callback URL determined by
+// CallbackURL. timeout is the requested subscription duration, or
+// DefaultTimeout if zero. The device may grant a different duration.
+func (s *Subscriber) Subscribe(eventURL *url.URL, timeout time.Duration) (*Subscription, error) {
+	return s.SubscribeCtx(context.Background(), eventURL, timeout)
+}
+
+// SubscribeCtx is as Subscribe, making the request with ctx and tracing it as
+// a child of the span of ctx.
+func (s *Subscriber) SubscribeCtx(ctx context.Context, eventURL *url.URL, timeout time.Duration) (*Subscription, error) {
+	callback, err := s.CallbackURL(eventURL)
+	if err != nil {
+		return nil, err
+	}
+	return s.SubscribeWithCallbacksCtx(ctx, eventURL, []*url.URL{callback}, timeout)
+}
+
+// SubscribeWithCallbacks is like Subscribe, but with explicitly provided
+// callback URLs. The device tries each callback URL in turn when delivering an
+// event message.
+func (s *Subscriber) SubscribeWithCallbacks(eventURL *url.URL, callbacks []*url.URL, timeout time.Duration) (*Subscription, error) {
+	return s.SubscribeWithCallbacksCtx(context.Background(), eventURL, callbacks, timeout)
+}
+
+// SubscribeWithCallbacksCtx is as SubscribeWithCallbacks, making the request
+// with ctx and tracing it as a child of the span of ctx.
+func (s *Subscriber) SubscribeWithCallbacksCtx(ctx context.Context, eventURL *url.URL, callbacks []*url.URL, timeout time.Duration) (*Subscription, error) {
+	if len(callbacks) == 0 {
+		return nil, errors.New("gena: at least one callback URL is required")
+	}
+	var callbackHeader []string
+	for _, cb := range callbacks {
+		callbackHeader = append(callbackHeader, "<"+cb.String()+">")
+	}
+
+	sub := &Subscription{
+		EventURL:   *eventURL,
+		Callbacks:  callbacks,
+		subscriber: s,
+	}
+	err := sub.do(ctx, tracing.SpanGENASubscribe, methodSubscribe, http.Header{
+		"CALLBACK": []string{strings.Join(callbackHeader, "")},
+		"NT":       []string{ntEvent},
+		"TIMEOUT":  []string{FormatTimeout(timeout)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return sub, nil
+}
+
+// Subscription is an active subscription to a service's events.
+type Subscription struct {
+	// SID is the subscription identifier assigned by the device.
+	SID string
+	// EventURL is the event subscription URL of the service.
+	EventURL url.URL
+	// Callbacks are the URLs that the device delivers event messages to.
+	Callbacks []*url.URL
+	// Timeout is the subscription duration granted by the device, or zero if
+	// the subscription does not expire.
+	Timeout time.Duration
+	// Expiry is when the subscription expires unless renewed. It is the zero
+	// time if the subscription does not expire.
+	Expiry time.Time
+
+	subscriber *Subscriber
+}
+
+// Renew renews the subscription. timeout is the requested subscription
+// duration, or DefaultTimeout if zero.
+func (sub *Subscription) Renew(timeout time.Duration) error {
+	return sub.RenewCtx(context.Background(), timeout)
+}
+
+// RenewCtx is as Renew, making the request with ctx and tracing it as a child
+// of the span of ctx.
+func (sub *Subscription) RenewCtx(ctx context.Context, timeout time.Duration) error {
+	err := sub.do(ctx, tracing.SpanGENARenew, methodSubscribe, http.Header{
+		"SID":     []string{sub.SID},
+		"TIMEOUT": []string{FormatTimeout(timeout)},
+	})
+	metrics.Inc(metrics.SubscriptionRenewals, metrics.Result(err))
+	return err
+}
+
+// Unsubscribe cancels the subscription.
+func (sub *Subscription) Unsubscribe() error {
+	return sub.UnsubscribeCtx(context.Background())
+}
+
+// UnsubscribeCtx is as Unsubscribe, making the request with ctx and tracing
+// it as a child of the span of ctx.
+func (sub *Subscription) UnsubscribeCtx(ctx context.Context) error {
+	return sub.do(ctx, tracing.SpanGENAUnsubscribe, methodUnsubscribe, http.Header{
+		"SID": []string{sub.SID},
+	})
+}
+
+// do performs a SUBSCRIBE or UNSUBSCRIBE request, and updates the
+// subscription from the response. Headers are set directly in the map to
+// avoid them being title-cased. The request is traced as a span of the given
+// name.
+func (sub *Subscription) do(ctx context.Context, spanName, method string, header http.Header) (err error) {
+	ctx, span := tracing.Start(ctx, spanName, tracing.String(tracing.KeyURL, sub.EventURL.String()))
+	defer func() {
+		span.SetAttributes(tracing.String(tracing.KeySID, sub.SID))
+		span.End(err)
+	}()
+
+	req := &http.Request{
+		Method: method,
+		URL:    &sub.EventURL,
+		Host:   sub.EventURL.Host,
+		Header: header,
+	}
+	resp, err := transport.Client(sub.subscriber.HTTPClient).Do(req.WithContext(ctx))
+	if err != nil {
+		return upnperr.Transport("gena: error performing "+method+" request", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode == http.StatusPreconditionFailed && sub.SID != "" {
+		// The publisher does not know of the SID, typically as the
+		// subscription expired.
+		sub.subscriber.forget(sub.SID)
+		return upnperr.New(upnperr.ErrSubscriptionExpired, fmt.Sprintf("gena: %s request got HTTP %s", method, resp.Status))
+	}
+	if resp.StatusCode != http.StatusOK {
+		return upnperr.New(upnperr.ErrStatus, fmt.Sprintf("gena: %s request got HTTP %s", method, resp.Status))
+	}
+	if method == methodUnsubscribe {
+		sub.subscriber.forget(sub.SID)
+		return nil
+	}
+
+	sid := resp.Header.Get("SID")
+	if sid == "" {
+		return upnperr.New(upnperr.ErrMalformed, fmt.Sprintf("gena: %s response has no SID", method))
+	}
+	timeout, err := ParseTimeout(resp.Header.Get("TIMEOUT"))
+	if err != nil {
+		return err
+	}
+	sub.SID = sid
+	sub.Timeout = timeout
+	if timeout == 0 {
+		sub.Expiry = time.Time{}
+	} else {
+		sub.Expiry = clock.Or(sub.subscriber.Clock).Now().Add(timeout)
+	}
+	sub.subscriber.save(sub)
+	return nil
+}
+
+// FormatTimeout formats a TIMEOUT header of the form "Second-N".
+// DefaultTimeout is used if timeout is not positive.
+func FormatTimeout(timeout time.Duration) string {
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
+	return "Second-" + strconv.FormatInt(int64(timeout/time.Second), 10)
+}
+
+// ParseTimeout parses a TIMEOUT header of the form "Second-N" or
+// "Second-infinite". A zero duration is returned for an infinite timeout.
+func ParseTimeout(s string) (time.Duration, error) {
+	s = strings.TrimSpace(s)
+	if strings.EqualFold(s, timeoutInfinite) {
+		return 0, nil
+	}
+	if len(s) < 7 || !strings.EqualFold(s[:7], "Second-") {
+		return 0, upnperr.New(upnperr.ErrMalformed, fmt.Sprintf("gena: bad TIMEOUT header %q", s))
+	}
+	secs, err := strconv.ParseUint(s[7:], 10, 32)
+	if err != nil || secs == 0 {
+		return 0, upnperr.New(upnperr.ErrMalformed, fmt.Sprintf("gena: bad TIMEOUT header %q", s))
+	}
+	return time.Duration(secs) * time.Second, nil
+}
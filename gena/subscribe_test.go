@@ -0,0 +1,294 @@
+package gena
+
+import (
+	"crypto/tls"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/huin/goupnp/clock"
+	"github.com/huin/goupnp/store"
+	"github.com/huin/goupnp/upnperr"
+)
+
+func TestSubscribe(t *testing.T) {
+	var gotMethods, gotCallbacks []string
+	device := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		gotMethods = append(gotMethods, r.Method)
+		switch r.Method {
+		case methodSubscribe:
+			gotCallbacks = append(gotCallbacks, r.Header.Get("CALLBACK"))
+			w.Header()["SID"] = []string{"uuid:sub-1"}
+			w.Header()["TIMEOUT"] = []string{"Second-300"}
+		case methodUnsubscribe:
+			if sid := r.Header.Get("SID"); sid != "uuid:sub-1" {
+				w.WriteHeader(http.StatusPreconditionFailed)
+			}
+		}
+	}))
+	defer device.Close()
+
+	s, err := NewSubscriber(HandlerFunc(func(*Event) {}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	eventURL, _ := url.Parse(device.URL + "/event")
+	sub, err := s.Subscribe(eventURL, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sub.SID != "uuid:sub-1" || sub.Timeout != 300*time.Second {
+		t.Errorf("Bad subscription: SID=%q Timeout=%v", sub.SID, sub.Timeout)
+	}
+	if len(gotCallbacks) != 1 || !strings.HasPrefix(gotCallbacks[0], "<http://127.0.0.1:") {
+		t.Errorf("Bad CALLBACK header: %q", gotCallbacks)
+	}
+
+	if err := sub.Renew(0); err != nil {
+		t.Fatal(err)
+	}
+	if err := sub.Unsubscribe(); err != nil {
+		t.Fatal(err)
+	}
+	if want := "SUBSCRIBE,SUBSCRIBE,UNSUBSCRIBE"; strings.Join(gotMethods, ",") != want {
+		t.Errorf("Bad methods\nwant: %s\n got: %s", want, strings.Join(gotMethods, ","))
+	}
+}
+
+func TestRenewExpired(t *testing.T) {
+	var renewals int
+	device := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("SID") != "" {
+			// The publisher forgot the subscription.
+			renewals++
+			w.WriteHeader(http.StatusPreconditionFailed)
+			return
+		}
+		w.Header()["SID"] = []string{"uuid:sub-1"}
+		w.Header()["TIMEOUT"] = []string{"Second-300"}
+	}))
+	defer device.Close()
+
+	s, err := NewSubscriber(HandlerFunc(func(*Event) {}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	eventURL, _ := url.Parse(device.URL + "/event")
+	sub, err := s.Subscribe(eventURL, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = sub.Renew(0)
+	if !errors.Is(err, upnperr.ErrSubscriptionExpired) {
+		t.Errorf("Renew got %v, want an error of upnperr.ErrSubscriptionExpired", err)
+	}
+	if renewals != 1 {
+		t.Errorf("got %d renewals, want 1", renewals)
+	}
+
+	device.Close()
+	if err := sub.Renew(0); !errors.Is(err, upnperr.ErrUnreachable) {
+		t.Errorf("Renew of a closed device got %v, want an error of upnperr.ErrUnreachable", err)
+	}
+}
+
+func TestParseTimeout(t *testing.T) {
+	tests := []struct {
+		s       string
+		want    time.Duration
+		wantErr bool
+	}{
+		{s: "Second-1800", want: 1800 * time.Second},
+		{s: "second-5", want: 5 * time.Second},
+		{s: "Second-infinite", want: 0},
+		{s: "Second-0", wantErr: true},
+		{s: "1800", wantErr: true},
+		{s: "", wantErr: true},
+	}
+	for _, test := range tests {
+		got, err := ParseTimeout(test.s)
+		if test.wantErr {
+			if err == nil {
+				t.Errorf("ParseTimeout(%q): want error, got %v", test.s, got)
+			}
+		} else if err != nil || got != test.want {
+			t.Errorf("ParseTimeout(%q): want %v, got %v, %v", test.s, test.want, got, err)
+		}
+	}
+}
+
+func TestResume(t *testing.T) {
+	s, err := NewSubscriber(HandlerFunc(func(*Event) {}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	eventURL, _ := url.Parse("http://127.0.0.1:5000/event")
+	callback, err := s.CallbackURL(eventURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	orig := &Subscription{
+		SID:        "uuid:sub-1",
+		EventURL:   *eventURL,
+		Callbacks:  []*url.URL{callback},
+		Timeout:    300 * time.Second,
+		Expiry:     time.Now().Add(time.Minute),
+		subscriber: s,
+	}
+
+	resumed, err := s.Resume(orig.State())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resumed.SID != orig.SID || resumed.EventURL != orig.EventURL || resumed.Timeout != orig.Timeout {
+		t.Errorf("Bad resumed subscription\nwant: %+v\n got: %+v", orig, resumed)
+	}
+
+	expired := orig.State()
+	expired.Expiry = time.Now().Add(-time.Second)
+	if _, err := s.Resume(expired); err != ErrSubscriptionExpired {
+		t.Errorf("want ErrSubscriptionExpired, got %v", err)
+	}
+
+	other := orig.State()
+	other.Callbacks = []string{"http://192.0.2.2:1/gena/event"}
+	if _, err := s.Resume(other); err == nil {
+		t.Error("want error for unreachable callback, got nil")
+	}
+}
+
+func TestResumeStored(t *testing.T) {
+	device := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == methodSubscribe {
+			w.Header()["SID"] = []string{"uuid:sub-1"}
+			w.Header()["TIMEOUT"] = []string{"Second-300"}
+		}
+	}))
+	defer device.Close()
+
+	st := store.NewMemory()
+	s, err := NewSubscriber(HandlerFunc(func(*Event) {}), WithStore(st))
+	if err != nil {
+		t.Fatal(err)
+	}
+	eventURL, _ := url.Parse(device.URL + "/event")
+	if _, err := s.Subscribe(eventURL, time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	s.Close()
+
+	// The restarted subscriber listens on the same port.
+	restarted, err := NewSubscriberAddr(net.JoinHostPort("", strconv.Itoa(s.port)), HandlerFunc(func(*Event) {}), WithStore(st))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer restarted.Close()
+	subs, err := restarted.ResumeStored()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(subs) != 1 || subs[0].SID != "uuid:sub-1" || subs[0].EventURL != *eventURL {
+		t.Fatalf("got resumed subscriptions %+v, want uuid:sub-1", subs)
+	}
+	if err := subs[0].Unsubscribe(); err != nil {
+		t.Fatal(err)
+	}
+	if items, _ := st.List(store.PrefixSubscription); len(items) != 0 {
+		t.Errorf("got %d stored subscriptions after Unsubscribe, want 0", len(items))
+	}
+}
+
+func TestSubscriptionClock(t *testing.T) {
+	device := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header()["SID"] = []string{"uuid:sub-1"}
+		w.Header()["TIMEOUT"] = []string{"Second-300"}
+	}))
+	defer device.Close()
+
+	s, err := NewSubscriber(HandlerFunc(func(*Event) {}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	fc := clock.NewFake(time.Unix(1000, 0))
+	s.Clock = fc
+
+	eventURL, _ := url.Parse(device.URL + "/event")
+	sub, err := s.Subscribe(eventURL, time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := time.Unix(1300, 0); !sub.Expiry.Equal(want) {
+		t.Errorf("got Expiry %v, want %v", sub.Expiry, want)
+	}
+
+	fc.Advance(299 * time.Second)
+	if _, err := s.Resume(sub.State()); err != nil {
+		t.Errorf("Resume before expiry: %v", err)
+	}
+	fc.Advance(time.Second)
+	if _, err := s.Resume(sub.State()); err != ErrSubscriptionExpired {
+		t.Errorf("Resume at expiry: want ErrSubscriptionExpired, got %v", err)
+	}
+}
+
+func TestTLSSubscriber(t *testing.T) {
+	// Borrow the httptest package's self-signed certificate.
+	certSrv := httptest.NewUnstartedServer(http.NotFoundHandler())
+	certSrv.StartTLS()
+	config := &tls.Config{Certificates: certSrv.TLS.Certificates}
+	client := certSrv.Client()
+	certSrv.Close()
+
+	events := make(chan *Event, 1)
+	s, err := NewTLSSubscriber("127.0.0.1:0", config, HandlerFunc(func(ev *Event) { events <- ev }))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	eventURL, _ := url.Parse("https://127.0.0.1:5000/event")
+	callback, err := s.CallbackURL(eventURL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if callback.Scheme != "https" {
+		t.Errorf("want https callback URL, got %q", callback.String())
+	}
+
+	req, err := http.NewRequest(methodNotify, callback.String(), strings.NewReader(testPropertySet))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("NT", ntEvent)
+	req.Header.Set("NTS", ntsPropChange)
+	req.Header.Set("SID", "uuid:sub-1")
+	req.Header.Set("SEQ", "0")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		t.Fatalf("want status 200, got %s", resp.Status)
+	}
+	if ev := <-events; ev.SID != "uuid:sub-1" {
+		t.Errorf("Bad event SID %q", ev.SID)
+	}
+
+	if _, err := NewTLSSubscriber("127.0.0.1:0", &tls.Config{}, HandlerFunc(func(*Event) {})); err == nil {
+		t.Error("want error for TLS config without certificate, got nil")
+	}
+}
@@ -15,16 +15,21 @@
 package goupnp
 
 import (
+	"bytes"
+	"context"
+	"encoding/json"
 	"encoding/xml"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
-	"time"
 
 	"golang.org/x/net/html/charset"
 
-	"github.com/huin/goupnp/httpu"
-	"github.com/huin/goupnp/ssdp"
+	"github.com/huin/goupnp/internal/transport"
+	"github.com/huin/goupnp/store"
+	"github.com/huin/goupnp/tracing"
+	"github.com/huin/goupnp/upnperr"
 )
 
 // ContextError is an error that wraps an error with some context information.
@@ -37,6 +42,12 @@ func (err ContextError) Error() string {
 	return fmt.Sprintf("%s: %v", err.Context, err.Err)
 }
 
+// Unwrap returns the wrapped error, so that errors.Is and errors.As see
+// through the context.
+func (err ContextError) Unwrap() error {
+	return err.Err
+}
+
 // MaybeRootDevice contains either a RootDevice or an error.
 type MaybeRootDevice struct {
 	// Set iff Err == nil.
@@ -56,45 +67,57 @@ type MaybeRootDevice struct {
 // in the form "urn:schemas-upnp-org:device:..." or
 // "urn:schemas-upnp-org:service:...". A single error is returned for errors
 // while attempting to send the query. An error or RootDevice is returned for
-// each discovered RootDevice.
-func DiscoverDevices(searchTarget string) ([]MaybeRootDevice, error) {
-	httpu, err := httpu.NewHTTPUClient()
-	if err != nil {
-		return nil, err
+// each discovered RootDevice. opts configure the search and the fetching of
+// the descriptions, which are fetched concurrently through a Fetcher. The
+// description of a device responding from several locations is fetched from
+// the first of them to answer, which is its Location.
+func DiscoverDevices(searchTarget string, opts ...DiscoveryOption) ([]MaybeRootDevice, error) {
+	return DiscoverDevicesCtx(context.Background(), searchTarget, opts...)
+}
+
+// DiscoverDevicesCtx is as DiscoverDevices, tracing the search and the
+// fetches of the descriptions of the discovered devices as children of the
+// span of ctx, and fetching the descriptions with ctx.
+func DiscoverDevicesCtx(ctx context.Context, searchTarget string, opts ...DiscoveryOption) ([]MaybeRootDevice, error) {
+	cfg := newDiscoveryConfig(opts)
+	if cfg.dualStack {
+		return discoverDualStack(ctx, searchTarget, cfg)
 	}
-	defer httpu.Close()
-	responses, err := ssdp.SSDPRawSearch(httpu, string(searchTarget), 2, 3)
+	responses, err := search(ctx, searchTarget, cfg, false)
 	if err != nil {
 		return nil, err
 	}
 
-	results := make([]MaybeRootDevice, len(responses))
-	for i, response := range responses {
-		maybe := &results[i]
-		loc, err := response.Location()
-		if err != nil {
-			maybe.Err = ContextError{"unexpected bad location from search", err}
-			continue
-		}
-		maybe.Location = loc
-		if root, err := DeviceByURL(loc); err != nil {
-			maybe.Err = err
-		} else {
-			maybe.Root = root
-		}
-	}
+	// A device responding from several addresses is fetched once, from the
+	// first of its locations that it is fetched from.
+	return fetchMerged(ctx, mergeResponses(responses, false), cfg), nil
+}
+
+// DeviceByURL fetches the description of the root device at loc. Of opts, only
+// those for fetching descriptions apply.
+func DeviceByURL(loc *url.URL, opts ...DiscoveryOption) (*RootDevice, error) {
+	return DeviceByURLCtx(context.Background(), loc, opts...)
+}
 
-	return results, nil
+// DeviceByURLCtx is as DeviceByURL, fetching the description with ctx and
+// tracing the fetch as a child of the span of ctx.
+func DeviceByURLCtx(ctx context.Context, loc *url.URL, opts ...DiscoveryOption) (*RootDevice, error) {
+	return deviceByURL(ctx, loc, newDiscoveryConfig(opts))
 }
 
-func DeviceByURL(loc *url.URL) (*RootDevice, error) {
+func deviceByURL(ctx context.Context, loc *url.URL, cfg *discoveryConfig) (*RootDevice, error) {
 	locStr := loc.String()
 	root := new(RootDevice)
-	if err := requestXml(locStr, DeviceXMLNamespace, root); err != nil {
+	server, brokenChunked, err := requestDescription(ctx, cfg, locStr, root)
+	if err != nil {
 		return nil, ContextError{fmt.Sprintf("error requesting root device details from %q", locStr), err}
 	}
+	root.quirks = LookupQuirks(server, &root.Device).Or(Quirks{
+		BrokenChunked: brokenChunked,
+		WrongURLBase:  wrongURLBase(root.URLBaseStr, loc),
+	})
 	var urlBaseStr string
-	if root.URLBaseStr != "" {
+	if root.URLBaseStr != "" && !root.quirks.WrongURLBase {
 		urlBaseStr = root.URLBaseStr
 	} else {
 		urlBaseStr = locStr
@@ -107,25 +130,92 @@ func DeviceByURL(loc *url.URL) (*RootDevice, error) {
 	return root, nil
 }
 
-func requestXml(url string, defaultSpace string, doc interface{}) error {
-	timeout := time.Duration(3 * time.Second)
-	client := http.Client{
-		Timeout: timeout,
+// storedDescription is the value of a description in the description store.
+type storedDescription struct {
+	Server        string `json:"server,omitempty"`
+	BrokenChunked bool   `json:"brokenChunked,omitempty"`
+	Description   []byte `json:"description"`
+}
+
+// requestDescription fetches the description at locStr into root, or decodes
+// it from the description store of cfg if it is kept there. It returns the
+// SERVER header of the response that the description was fetched with, and
+// whether the response ended early.
+func requestDescription(ctx context.Context, cfg *discoveryConfig, locStr string, root *RootDevice) (string, bool, error) {
+	if cfg.descriptionStore == nil {
+		return fetchXml(ctx, cfg.httpClient(), locStr, DeviceXMLNamespace, root, nil)
+	}
+	key := store.PrefixDescription + locStr
+	if data, ok, err := cfg.descriptionStore.Get(key); err == nil && ok {
+		var stored storedDescription
+		if json.Unmarshal(data, &stored) == nil &&
+			decodeXml(bytes.NewReader(stored.Description), DeviceXMLNamespace, root) == nil {
+			return stored.Server, stored.BrokenChunked, nil
+		}
+		*root = RootDevice{}
+	}
+	var raw bytes.Buffer
+	server, brokenChunked, err := fetchXml(ctx, cfg.httpClient(), locStr, DeviceXMLNamespace, root, &raw)
+	if err != nil {
+		return "", false, err
+	}
+	// The description is fetched again next time if it cannot be stored.
+	stored := &storedDescription{Server: server, BrokenChunked: brokenChunked, Description: raw.Bytes()}
+	if data, err := json.Marshal(stored); err == nil {
+		cfg.descriptionStore.Put(key, data, cfg.descriptionTTL)
+	}
+	return server, brokenChunked, nil
+}
+
+func requestXml(ctx context.Context, client *http.Client, url string, defaultSpace string, doc interface{}) error {
+	_, _, err := fetchXml(ctx, client, url, defaultSpace, doc, nil)
+	return err
+}
+
+// fetchXml is as requestXml, also copying the document to raw if not nil, and
+// returning the SERVER header of the response. The response is accepted if it
+// ends early after the end of the document, as chunked responses without
+// their final chunk do, which brokenChunked is then set for.
+func fetchXml(ctx context.Context, client *http.Client, url string, defaultSpace string, doc interface{}, raw io.Writer) (server string, brokenChunked bool, err error) {
+	ctx, span := tracing.Start(ctx, tracing.SpanDescription, tracing.String(tracing.KeyURL, url))
+	defer func() { span.End(err) }()
+
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return "", false, err
 	}
-	resp, err := client.Get(url)
+	resp, err := client.Do(req.WithContext(ctx))
 	if err != nil {
-		return err
+		return "", false, upnperr.Transport("", err)
 	}
 	defer resp.Body.Close()
+	server = resp.Header.Get("SERVER")
 
 	if resp.StatusCode != 200 {
-		return fmt.Errorf("goupnp: got response status %s from %q",
-			resp.Status, url)
+		return server, false, upnperr.New(upnperr.ErrStatus, fmt.Sprintf("goupnp: got response status %s from %q",
+			resp.Status, url))
+	}
+
+	lenient := transport.LenientBodyDetect(resp.Body, &brokenChunked)
+	var body io.Reader = lenient
+	if raw != nil {
+		body = io.TeeReader(body, raw)
+	}
+	if err := decodeXml(body, defaultSpace, doc); err != nil {
+		return server, false, err
 	}
+	// The end of the response is only read past the end of the document.
+	io.Copy(io.Discard, lenient)
+	return server, brokenChunked, nil
+}
 
-	decoder := xml.NewDecoder(resp.Body)
+func decodeXml(r io.Reader, defaultSpace string, doc interface{}) error {
+	decoder := xml.NewDecoder(r)
 	decoder.DefaultSpace = defaultSpace
 	decoder.CharsetReader = charset.NewReaderLabel
 
-	return decoder.Decode(doc)
+	if err := decoder.Decode(doc); err != nil {
+		return upnperr.Wrap(upnperr.ErrMalformed, "", err)
+	}
+	return nil
 }
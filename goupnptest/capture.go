@@ -0,0 +1,322 @@
+package goupnptest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/huin/goupnp"
+	"github.com/huin/goupnp/httpu"
+	"github.com/huin/goupnp/soap"
+	"github.com/huin/goupnp/ssdp"
+)
+
+// Capture is a recording of the exchanges with a real device: its responses
+// to SSDP searches, the documents fetched from it, such as its description
+// and SCPDs, and the SOAP actions invoked on it. Captures are recorded with a
+// Recorder, saved as JSON files, e.g. to attach to a bug report, and replayed
+// with Replay, so that the behaviour of the device can be reproduced in tests.
+type Capture struct {
+	// Location is the URL of the description of the device.
+	Location string `json:"location"`
+	// Search are the responses of the device to SSDP searches.
+	Search []CapturedSearchResponse `json:"search,omitempty"`
+	// Files are the documents fetched from the device.
+	Files []CapturedFile `json:"files,omitempty"`
+	// Actions are the SOAP actions invoked on the device, in order.
+	Actions []CapturedAction `json:"actions,omitempty"`
+}
+
+// CapturedSearchResponse is a response of a device to an SSDP search.
+type CapturedSearchResponse struct {
+	ST       string `json:"st"`
+	USN      string `json:"usn"`
+	Server   string `json:"server,omitempty"`
+	Location string `json:"location"`
+}
+
+// CapturedFile is a document fetched from a device.
+type CapturedFile struct {
+	// Path is the path of the URL of the document.
+	Path        string `json:"path"`
+	ContentType string `json:"contentType,omitempty"`
+	Body        string `json:"body"`
+}
+
+// CapturedAction is a SOAP action invoked on a device, and its response.
+type CapturedAction struct {
+	ControlPath string     `json:"controlPath"`
+	ServiceType string     `json:"serviceType"`
+	Action      string     `json:"action"`
+	Args        []soap.Arg `json:"args,omitempty"`
+	// Response are the output arguments, unless the device responded with
+	// a fault, whose UPnP error code and description are then set.
+	Response         []soap.Arg `json:"response,omitempty"`
+	FaultCode        int        `json:"faultCode,omitempty"`
+	FaultDescription string     `json:"faultDescription,omitempty"`
+}
+
+// LoadCapture reads a Capture from a JSON file written by Save.
+func LoadCapture(filename string) (*Capture, error) {
+	data, err := ioutil.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	c := new(Capture)
+	if err := json.Unmarshal(data, c); err != nil {
+		return nil, fmt.Errorf("goupnptest: error decoding capture %s: %v", filename, err)
+	}
+	return c, nil
+}
+
+// Save writes the capture to a JSON file.
+func (c *Capture) Save(filename string) error {
+	data, err := json.MarshalIndent(c, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filename, append(data, '\n'), 0644)
+}
+
+// Recorder records a Capture of the exchanges with a device. It is an
+// http.RoundTripper, so that the SOAP actions of clients whose transport it
+// is are recorded, e.g. by setting the Transport of the HTTPClient of a
+// ServiceClient's SOAPClient, or with soap.WithTransport.
+type Recorder struct {
+	// Transport performs the requests, http.DefaultTransport if nil.
+	Transport http.RoundTripper
+
+	lock    sync.Mutex // Protects capture.
+	capture Capture
+}
+
+// Capture returns a copy of what has been recorded so far.
+func (r *Recorder) Capture() *Capture {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	c := r.capture
+	c.Search = append([]CapturedSearchResponse(nil), c.Search...)
+	c.Files = append([]CapturedFile(nil), c.Files...)
+	c.Actions = append([]CapturedAction(nil), c.Actions...)
+	return &c
+}
+
+// RecordDevice records the description at loc, and the SCPDs of all of its
+// services, and returns the device. Their Location is loc.
+func (r *Recorder) RecordDevice(ctx context.Context, loc *url.URL) (*goupnp.RootDevice, error) {
+	r.lock.Lock()
+	r.capture.Location = loc.String()
+	r.lock.Unlock()
+	root, err := goupnp.DeviceByURLCtx(ctx, loc, goupnp.WithTransport(r))
+	if err != nil {
+		return nil, err
+	}
+	client := &http.Client{Transport: r}
+	var fetchErr error
+	root.Device.VisitServices(func(srv *goupnp.Service) {
+		if fetchErr != nil || !srv.SCPDURL.Ok {
+			return
+		}
+		req, err := http.NewRequest("GET", srv.SCPDURL.URL.String(), nil)
+		if err != nil {
+			fetchErr = err
+			return
+		}
+		resp, err := client.Do(req.WithContext(ctx))
+		if err != nil {
+			fetchErr = err
+			return
+		}
+		resp.Body.Close()
+	})
+	return root, fetchErr
+}
+
+// RecordSearch records the responses to an SSDP search for searchTarget of
+// the device at the Location of the capture, or of every device if it is not
+// yet set.
+func (r *Recorder) RecordSearch(ctx context.Context, searchTarget string) error {
+	client, err := httpu.NewHTTPUClient()
+	if err != nil {
+		return err
+	}
+	defer client.Close()
+	responses, err := ssdp.SSDPRawSearchCtx(ctx, client, searchTarget, goupnp.DefaultSearchWaitSeconds, goupnp.DefaultSearchSends)
+	if err != nil {
+		return err
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	var host string
+	if loc, err := url.Parse(r.capture.Location); err == nil {
+		host = loc.Host
+	}
+	for _, resp := range responses {
+		sr := CapturedSearchResponse{
+			ST:       resp.Header.Get("ST"),
+			USN:      resp.Header.Get("USN"),
+			Server:   resp.Header.Get("SERVER"),
+			Location: resp.Header.Get("LOCATION"),
+		}
+		if loc, err := url.Parse(sr.Location); host != "" && (err != nil || loc.Host != host) {
+			continue
+		}
+		r.capture.Search = append(r.capture.Search, sr)
+	}
+	return nil
+}
+
+// RoundTrip implements http.RoundTripper, recording the documents fetched
+// and the SOAP actions invoked.
+func (r *Recorder) RoundTrip(req *http.Request) (*http.Response, error) {
+	t := r.Transport
+	if t == nil {
+		t = http.DefaultTransport
+	}
+	var action *soap.ActionRequest
+	if req.Method == "POST" && req.Body != nil {
+		body, err := ioutil.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, err
+		}
+		parse := req.Clone(req.Context())
+		parse.Body = ioutil.NopCloser(bytes.NewReader(body))
+		// The SOAP client sets SOAPACTION without canonicalizing it.
+		parse.Header = make(http.Header, len(req.Header))
+		for k, v := range req.Header {
+			parse.Header[http.CanonicalHeaderKey(k)] = v
+		}
+		// Requests that are not SOAP actions are not recorded.
+		action, _ = soap.ParseActionRequest(parse)
+		req = req.Clone(req.Context())
+		req.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	resp, err := t.RoundTrip(req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	switch {
+	case action != nil:
+		ca := CapturedAction{
+			ControlPath: req.URL.Path,
+			ServiceType: action.ServiceType,
+			Action:      action.Action,
+			Args:        action.Args,
+		}
+		ca.Response, ca.FaultCode, ca.FaultDescription = parseActionResponse(body)
+		r.capture.Actions = append(r.capture.Actions, ca)
+	case req.Method == "GET" && resp.StatusCode == http.StatusOK:
+		r.capture.Files = append(r.capture.Files, CapturedFile{
+			Path:        req.URL.Path,
+			ContentType: resp.Header.Get("Content-Type"),
+			Body:        string(body),
+		})
+	}
+	return resp, nil
+}
+
+type responseEnvelope struct {
+	Body struct {
+		Fault *struct {
+			Code        int    `xml:"detail>UPnPError>errorCode"`
+			Description string `xml:"detail>UPnPError>errorDescription"`
+		} `xml:"Fault"`
+		Action struct {
+			Args []struct {
+				XMLName xml.Name
+				Value   string `xml:",chardata"`
+			} `xml:",any"`
+		} `xml:",any"`
+	} `xml:"Body"`
+}
+
+// parseActionResponse returns the output arguments of a SOAP action
+// response, or the code and description of its fault. Faults without a UPnP
+// error, and malformed responses, are recorded as ErrCodeActionFailed.
+func parseActionResponse(body []byte) (args []soap.Arg, faultCode int, faultDescription string) {
+	var env responseEnvelope
+	if err := xml.Unmarshal(body, &env); err != nil {
+		return nil, soap.ErrCodeActionFailed, "malformed response: " + err.Error()
+	}
+	if f := env.Body.Fault; f != nil {
+		if f.Code == 0 {
+			return nil, soap.ErrCodeActionFailed, f.Description
+		}
+		return nil, f.Code, f.Description
+	}
+	for _, arg := range env.Body.Action.Args {
+		args = append(args, soap.Arg{Name: arg.XMLName.Local, Value: arg.Value})
+	}
+	return args, 0, ""
+}
+
+// Replay starts a FakeDevice replaying c: serving its files at their paths,
+// with the description at the path of its Location, and responding to each
+// action with its recorded responses in turn, the last one repeated. URLs of
+// the recorded device within the files are rewritten to those of the fake.
+func Replay(c *Capture) (*FakeDevice, error) {
+	loc, err := url.Parse(c.Location)
+	if err != nil {
+		return nil, err
+	}
+	d := newFakeDevice()
+	d.descPath = loc.Path
+	origin := loc.Scheme + "://" + loc.Host
+	for _, f := range c.Files {
+		contentType := f.ContentType
+		if contentType == "" {
+			contentType = `text/xml; charset="utf-8"`
+		}
+		d.ServeFile(f.Path, contentType, strings.Replace(f.Body, origin, d.Server.URL, -1))
+	}
+	responses := make(map[string][]ActionResponse)
+	var keys []string
+	for _, a := range c.Actions {
+		key := a.ControlPath + "#" + a.Action
+		if _, ok := responses[key]; !ok {
+			keys = append(keys, key)
+		}
+		resp := ActionResponse{Args: a.Response}
+		if a.FaultCode != 0 {
+			resp = Fault(a.FaultCode, a.FaultDescription)
+		}
+		responses[key] = append(responses[key], resp)
+	}
+	for _, key := range keys {
+		i := strings.LastIndex(key, "#")
+		d.ScriptAction(key[:i], key[i+1:], responses[key]...)
+	}
+	return d, nil
+}
+
+// ReplaySearch adds the recorded search responses of c, with their LOCATION
+// that of d, as returned by Replay.
+func (s *SSDPShim) ReplaySearch(c *Capture, d *FakeDevice) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for _, sr := range c.Search {
+		location := d.Location().String()
+		if loc, err := url.Parse(sr.Location); err == nil {
+			location = d.URL(loc.Path).String()
+		}
+		s.ads = append(s.ads, shimAdvertisement{nt: sr.ST, usn: sr.USN, location: location, server: sr.Server})
+	}
+}
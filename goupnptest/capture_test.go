@@ -0,0 +1,100 @@
+package goupnptest
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/huin/goupnp/dcps/internetgateway1"
+	"github.com/huin/goupnp/upnperr"
+)
+
+func TestRecordReplay(t *testing.T) {
+	// The original device uses an absolute control URL, which replay must
+	// rewrite.
+	orig := NewFakeDevice("")
+	orig.Serve(DescriptionPath, strings.Replace(testDescription, "<controlURL>/ctl/IPConn",
+		"<controlURL>"+orig.Server.URL+"/ctl/IPConn", 1))
+	orig.Serve("/scpd/IPConn.xml", `<scpd xmlns="urn:schemas-upnp-org:service-1-0"></scpd>`)
+	orig.ScriptAction("/ctl/IPConn", "GetExternalIPAddress",
+		Respond("NewExternalIPAddress", "203.0.113.1"), Fault(501, "Action Failed"))
+
+	shim, err := NewSSDPShim()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := shim.AdvertiseDevice(orig); err != nil {
+		t.Fatal(err)
+	}
+
+	rec := &Recorder{}
+	ctx := context.Background()
+	root, err := rec.RecordDevice(ctx, orig.Location())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rec.RecordSearch(ctx, internetgateway1.URN_WANIPConnection_1); err != nil {
+		t.Fatal(err)
+	}
+	clients, err := internetgateway1.NewWANIPConnection1ClientsFromRootDevice(root, orig.Location())
+	if err != nil {
+		t.Fatal(err)
+	}
+	clients[0].SOAPClient.HTTPClient.Transport = rec
+	clients[0].GetExternalIPAddress()
+	clients[0].GetExternalIPAddress()
+	orig.Close()
+	shim.Close()
+
+	dir, err := ioutil.TempDir("", "goupnptest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	filename := filepath.Join(dir, "capture.json")
+	if err := rec.Capture().Save(filename); err != nil {
+		t.Fatal(err)
+	}
+	c, err := LoadCapture(filename)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(c.Files) != 2 || len(c.Actions) != 2 || len(c.Search) != 1 {
+		t.Fatalf("captured %d files, %d actions and %d search responses, want 2, 2 and 1",
+			len(c.Files), len(c.Actions), len(c.Search))
+	}
+
+	d, err := Replay(c)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	shim, err = NewSSDPShim()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer shim.Close()
+	shim.ReplaySearch(c, d)
+	replayed, errs, err := internetgateway1.NewWANIPConnection1Clients()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(replayed) != 1 {
+		t.Fatalf("discovered %d replayed devices, want 1; errors %v", len(replayed), errs)
+	}
+	client := replayed[0]
+	if got := client.ServiceClient.Service.ControlURL.URL.Host; got != d.Location().Host {
+		t.Errorf("control URL host %q, want that of the replay %q", got, d.Location().Host)
+	}
+	if ip, err := client.GetExternalIPAddress(); err != nil || ip != "203.0.113.1" {
+		t.Errorf("first GetExternalIPAddress() = %q, %v", ip, err)
+	}
+	if _, err := client.GetExternalIPAddress(); err == nil {
+		t.Error("second GetExternalIPAddress(): want error, got nil")
+	} else if code, _ := upnperr.FaultCode(err); code != 501 {
+		t.Errorf("second GetExternalIPAddress() error = %v, want UPnP error 501", err)
+	}
+}
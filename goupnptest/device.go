@@ -0,0 +1,389 @@
+// goupnptest provides fakes for unit testing code that uses goupnp, without
+// real devices or network access beyond the loopback interface.
+//
+// FakeDevice serves canned device descriptions and SCPDs, scripted SOAP
+// action responses and scripted GENA event notifications via an
+// httptest.Server. SSDPShim answers SSDP searches made by goupnp and the
+// ssdp package on the loopback interface, so that discovery finds only fake
+// devices. A Recorder captures the exchanges with a real device, which Replay
+// serves from a FakeDevice, so that bug reports about specific devices can be
+// reproduced in tests.
+//
+// Clients can be created for a FakeDevice by URL, e.g
+//
+//	d := goupnptest.NewFakeDevice(description)
+//	defer d.Close()
+//	d.ScriptAction("/ctl/IPConn", "GetExternalIPAddress",
+//		goupnptest.Respond("NewExternalIPAddress", "203.0.113.1"))
+//	clients, err := internetgateway1.NewWANIPConnection1ClientsByURL(d.Location())
+package goupnptest
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/huin/goupnp/gena"
+	"github.com/huin/goupnp/soap"
+)
+
+// DescriptionPath is the path at which a FakeDevice serves its description.
+const DescriptionPath = "/description.xml"
+
+// ActionResponse is a scripted response to an action invocation.
+type ActionResponse struct {
+	// Args are the output arguments of a successful response.
+	Args []soap.Arg
+	// Err, if not nil, is returned as a fault instead. A *soap.UPnPError is
+	// returned as is, other errors as UPnP error 501 Action Failed.
+	Err error
+}
+
+// Respond returns a successful ActionResponse with output arguments given as
+// alternating names and values.
+func Respond(nameValues ...string) ActionResponse {
+	if len(nameValues)%2 != 0 {
+		panic("goupnptest: Respond requires names and values in pairs")
+	}
+	var r ActionResponse
+	for i := 0; i < len(nameValues); i += 2 {
+		r.Args = append(r.Args, soap.Arg{Name: nameValues[i], Value: nameValues[i+1]})
+	}
+	return r
+}
+
+// Fault returns an ActionResponse that is a UPnP error fault.
+func Fault(code int, description string) ActionResponse {
+	return ActionResponse{Err: soap.NewUPnPError(code, description)}
+}
+
+// ActionCall is an action invocation received by a FakeDevice.
+type ActionCall struct {
+	ControlPath string
+	ServiceType string
+	Action      string
+	Args        []soap.Arg
+}
+
+// Arg returns the value of the named input argument, or "" if absent.
+func (c *ActionCall) Arg(name string) string {
+	value, _ := soap.FindArg(c.Args, name)
+	return value
+}
+
+type cannedFile struct {
+	contentType string
+	body        string
+}
+
+// scriptedAction holds the remaining responses to an action. The last
+// response is repeated once the others are used.
+type scriptedAction struct {
+	responses []ActionResponse
+}
+
+func (sa *scriptedAction) next() ActionResponse {
+	r := sa.responses[0]
+	if len(sa.responses) > 1 {
+		sa.responses = sa.responses[1:]
+	}
+	return r
+}
+
+// FakeDevice is a fake UPnP device, served over HTTP on the loopback
+// interface.
+type FakeDevice struct {
+	// Server is the underlying test server.
+	Server *httptest.Server
+
+	descPath string // Path of the description.
+
+	lock    sync.Mutex // Protects all below.
+	files   map[string]cannedFile
+	actions map[string]*scriptedAction // Keyed by control path and action.
+	calls   []ActionCall
+	events  map[string]*eventSource // Keyed by event path.
+	nextSID int
+}
+
+// NewFakeDevice starts a FakeDevice, serving description as its root device
+// description at DescriptionPath. URLs within the description are best given
+// as paths, which resolve against the device's location. Close must be
+// called to stop the device.
+func NewFakeDevice(description string) *FakeDevice {
+	d := newFakeDevice()
+	d.Serve(DescriptionPath, description)
+	return d
+}
+
+func newFakeDevice() *FakeDevice {
+	d := &FakeDevice{
+		descPath: DescriptionPath,
+		files:    make(map[string]cannedFile),
+		actions:  make(map[string]*scriptedAction),
+		events:   make(map[string]*eventSource),
+	}
+	d.Server = httptest.NewServer(http.HandlerFunc(d.serveHTTP))
+	return d
+}
+
+// Close stops the device.
+func (d *FakeDevice) Close() {
+	d.Server.Close()
+}
+
+// Location returns the URL of the device description.
+func (d *FakeDevice) Location() *url.URL {
+	return d.URL(d.descPath)
+}
+
+// URL returns the absolute URL of a path on the device.
+func (d *FakeDevice) URL(path string) *url.URL {
+	u, err := url.Parse(d.Server.URL + path)
+	if err != nil {
+		panic(err)
+	}
+	return u
+}
+
+// Serve serves body as an XML document at path, typically an SCPD or an
+// updated description.
+func (d *FakeDevice) Serve(path, body string) {
+	d.ServeFile(path, `text/xml; charset="utf-8"`, body)
+}
+
+// ServeFile serves body with the given content type at path.
+func (d *FakeDevice) ServeFile(path, contentType, body string) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.files[path] = cannedFile{contentType: contentType, body: body}
+}
+
+// ScriptAction sets the responses to invocations of the named action via the
+// control URL path. Each invocation consumes the next response, and the last
+// response is repeated once the others have been used. Actions that are not
+// scripted return UPnP error 401 Invalid Action.
+func (d *FakeDevice) ScriptAction(controlPath, action string, responses ...ActionResponse) {
+	if len(responses) == 0 {
+		panic("goupnptest: ScriptAction requires at least one response")
+	}
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	d.actions[controlPath+"#"+action] = &scriptedAction{responses: responses}
+}
+
+// Calls returns the action invocations received so far, in order.
+func (d *FakeDevice) Calls() []ActionCall {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	return append([]ActionCall(nil), d.calls...)
+}
+
+func (d *FakeDevice) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	d.lock.Lock()
+	f, isFile := d.files[r.URL.Path]
+	es := d.events[r.URL.Path]
+	d.lock.Unlock()
+
+	switch {
+	case isFile && (r.Method == "GET" || r.Method == "HEAD"):
+		w.Header().Set("Content-Type", f.contentType)
+		if r.Method == "GET" {
+			w.Write([]byte(f.body))
+		}
+	case es != nil && (r.Method == "SUBSCRIBE" || r.Method == "UNSUBSCRIBE"):
+		es.serve(w, r)
+	case r.Method == "POST":
+		d.serveControl(w, r)
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (d *FakeDevice) serveControl(w http.ResponseWriter, r *http.Request) {
+	req, err := soap.ParseActionRequest(r)
+	if err != nil {
+		soap.WriteActionFault(w, err)
+		return
+	}
+	d.lock.Lock()
+	d.calls = append(d.calls, ActionCall{
+		ControlPath: r.URL.Path,
+		ServiceType: req.ServiceType,
+		Action:      req.Action,
+		Args:        req.Args,
+	})
+	var resp ActionResponse
+	sa := d.actions[r.URL.Path+"#"+req.Action]
+	if sa != nil {
+		resp = sa.next()
+	}
+	d.lock.Unlock()
+
+	switch {
+	case sa == nil:
+		soap.WriteActionFault(w, soap.NewUPnPError(soap.ErrCodeInvalidAction, "no scripted response for "+req.Action))
+	case resp.Err != nil:
+		soap.WriteActionFault(w, resp.Err)
+	default:
+		soap.WriteActionResponse(w, req.ServiceType, req.Action, resp.Args)
+	}
+}
+
+// ServeEvents accepts GENA subscriptions at the event URL path. Each new
+// subscription is sent an initial event with the given properties, if any.
+func (d *FakeDevice) ServeEvents(eventPath string, initial ...gena.Property) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	es := d.events[eventPath]
+	if es == nil {
+		es = &eventSource{device: d, subs: make(map[string]*fakeSubscription)}
+		d.events[eventPath] = es
+	}
+	es.initial = initial
+}
+
+// Notify sends an event with the given properties to every subscriber of
+// the event URL path, and returns once they have all been delivered. An error
+// is returned if any delivery fails.
+func (d *FakeDevice) Notify(eventPath string, props ...gena.Property) error {
+	d.lock.Lock()
+	es := d.events[eventPath]
+	d.lock.Unlock()
+	if es == nil {
+		return fmt.Errorf("goupnptest: no events served at %s", eventPath)
+	}
+	var lastErr error
+	for _, sub := range es.subscriptions() {
+		if err := sub.send(props); err != nil {
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+// Subscribers returns the number of current subscriptions to the event URL
+// path.
+func (d *FakeDevice) Subscribers(eventPath string) int {
+	d.lock.Lock()
+	es := d.events[eventPath]
+	d.lock.Unlock()
+	if es == nil {
+		return 0
+	}
+	return len(es.subscriptions())
+}
+
+// eventSource is the subscriptions to an event URL path of a FakeDevice.
+type eventSource struct {
+	device  *FakeDevice
+	initial []gena.Property // Protected by device.lock.
+
+	lock sync.Mutex // Protects subs.
+	subs map[string]*fakeSubscription
+}
+
+// fakeSubscription is a subscription to a FakeDevice. Its lock also orders
+// the delivery of events.
+type fakeSubscription struct {
+	lock     sync.Mutex
+	sid      string
+	callback *url.URL
+	seq      uint32
+}
+
+func (es *eventSource) subscriptions() []*fakeSubscription {
+	es.lock.Lock()
+	defer es.lock.Unlock()
+	subs := make([]*fakeSubscription, 0, len(es.subs))
+	for _, sub := range es.subs {
+		subs = append(subs, sub)
+	}
+	return subs
+}
+
+func (es *eventSource) serve(w http.ResponseWriter, r *http.Request) {
+	sid := r.Header.Get("SID")
+	es.lock.Lock()
+	defer es.lock.Unlock()
+
+	if r.Method == "UNSUBSCRIBE" {
+		if _, ok := es.subs[sid]; !ok {
+			http.Error(w, "no such subscription", http.StatusPreconditionFailed)
+			return
+		}
+		delete(es.subs, sid)
+		return
+	}
+	if sid != "" {
+		// Renewal.
+		if _, ok := es.subs[sid]; !ok {
+			http.Error(w, "no such subscription", http.StatusPreconditionFailed)
+			return
+		}
+		w.Header()["SID"] = []string{sid}
+		w.Header()["TIMEOUT"] = []string{"Second-1800"}
+		return
+	}
+
+	callback := strings.Trim(strings.TrimSpace(strings.SplitN(r.Header.Get("CALLBACK"), ">", 2)[0]), "<")
+	callbackURL, err := url.Parse(callback)
+	if err != nil || r.Header.Get("NT") != "upnp:event" || callbackURL.Host == "" {
+		http.Error(w, "bad subscription request", http.StatusPreconditionFailed)
+		return
+	}
+	es.device.lock.Lock()
+	es.device.nextSID++
+	sub := &fakeSubscription{
+		sid:      fmt.Sprintf("uuid:goupnptest-%d", es.device.nextSID),
+		callback: callbackURL,
+	}
+	initial := es.initial
+	es.device.lock.Unlock()
+	es.subs[sub.sid] = sub
+
+	w.Header()["SID"] = []string{sub.sid}
+	w.Header()["TIMEOUT"] = []string{"Second-1800"}
+	if len(initial) == 0 {
+		return
+	}
+	// The initial event must follow the response, and precede any event sent
+	// by Notify.
+	w.WriteHeader(http.StatusOK)
+	if f, ok := w.(http.Flusher); ok {
+		f.Flush()
+	}
+	sub.lock.Lock()
+	go func() {
+		defer sub.lock.Unlock()
+		sub.deliver(initial)
+	}()
+}
+
+// send delivers an event to the subscriber.
+func (sub *fakeSubscription) send(props []gena.Property) error {
+	sub.lock.Lock()
+	defer sub.lock.Unlock()
+	return sub.deliver(props)
+}
+
+// deliver sends an event with the next SEQ. sub.lock must be held.
+func (sub *fakeSubscription) deliver(props []gena.Property) error {
+	req, err := gena.NewNotifyRequest(sub.callback, sub.sid, sub.seq, props)
+	if err != nil {
+		return err
+	}
+	sub.seq++
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("goupnptest: event to %s got status %s", sub.callback, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,191 @@
+package goupnptest
+
+import (
+	"testing"
+	"time"
+
+	"github.com/huin/goupnp"
+	"github.com/huin/goupnp/dcps/internetgateway1"
+	"github.com/huin/goupnp/gena"
+	"github.com/huin/goupnp/soap"
+	"github.com/huin/goupnp/ssdp"
+)
+
+const testDescription = `<?xml version="1.0"?>
+<root xmlns="urn:schemas-upnp-org:device-1-0">
+  <specVersion><major>1</major><minor>0</minor></specVersion>
+  <device>
+    <deviceType>urn:schemas-upnp-org:device:WANConnectionDevice:1</deviceType>
+    <friendlyName>Fake connection</friendlyName>
+    <manufacturer>goupnptest</manufacturer>
+    <modelName>fake</modelName>
+    <UDN>uuid:00000000-0000-0000-0000-000000000001</UDN>
+    <serviceList>
+      <service>
+        <serviceType>urn:schemas-upnp-org:service:WANIPConnection:1</serviceType>
+        <serviceId>urn:upnp-org:serviceId:WANIPConn1</serviceId>
+        <SCPDURL>/scpd/IPConn.xml</SCPDURL>
+        <controlURL>/ctl/IPConn</controlURL>
+        <eventSubURL>/evt/IPConn</eventSubURL>
+      </service>
+    </serviceList>
+  </device>
+</root>`
+
+func newTestClient(t *testing.T, d *FakeDevice) *internetgateway1.WANIPConnection1 {
+	clients, err := internetgateway1.NewWANIPConnection1ClientsByURL(d.Location())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(clients) != 1 {
+		t.Fatalf("got %d clients, want 1", len(clients))
+	}
+	return clients[0]
+}
+
+func TestFakeDeviceActions(t *testing.T) {
+	d := NewFakeDevice(testDescription)
+	defer d.Close()
+	d.ScriptAction("/ctl/IPConn", "GetExternalIPAddress",
+		Respond("NewExternalIPAddress", "203.0.113.1"),
+		Fault(501, "Action Failed"))
+	c := newTestClient(t, d)
+
+	if ip, err := c.GetExternalIPAddress(); err != nil || ip != "203.0.113.1" {
+		t.Errorf("first GetExternalIPAddress() = %q, %v", ip, err)
+	}
+	for i := 0; i < 2; i++ {
+		_, err := c.GetExternalIPAddress()
+		if fault, ok := err.(*soap.SOAPFaultError); !ok || fault.UPnPError == nil || fault.UPnPError.Code != 501 {
+			t.Errorf("GetExternalIPAddress() error = %v, want UPnP error 501", err)
+		}
+	}
+	err := c.DeletePortMapping("", 80, "TCP")
+	if fault, ok := err.(*soap.SOAPFaultError); !ok || fault.UPnPError == nil || fault.UPnPError.Code != soap.ErrCodeInvalidAction {
+		t.Errorf("unscripted action error = %v, want UPnP error %d", err, soap.ErrCodeInvalidAction)
+	}
+
+	calls := d.Calls()
+	if len(calls) != 4 {
+		t.Fatalf("got %d calls, want 4", len(calls))
+	}
+	if last := calls[3]; last.Action != "DeletePortMapping" || last.Arg("NewExternalPort") != "80" ||
+		last.ServiceType != internetgateway1.URN_WANIPConnection_1 {
+		t.Errorf("last call = %+v", last)
+	}
+}
+
+func TestFakeDeviceEvents(t *testing.T) {
+	d := NewFakeDevice(testDescription)
+	defer d.Close()
+	d.ServeEvents("/evt/IPConn", gena.Property{Name: "ExternalIPAddress", Value: "203.0.113.1"})
+
+	events := gena.NewChanHandler(10, gena.Block)
+	subscriber, err := gena.NewSubscriber(events)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer subscriber.Close()
+	sub, err := subscriber.Subscribe(d.URL("/evt/IPConn"), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n := d.Subscribers("/evt/IPConn"); n != 1 {
+		t.Errorf("got %d subscribers, want 1", n)
+	}
+	if err := d.Notify("/evt/IPConn", gena.Property{Name: "ExternalIPAddress", Value: "198.51.100.2"}); err != nil {
+		t.Fatal(err)
+	}
+	for i, want := range []string{"203.0.113.1", "198.51.100.2"} {
+		select {
+		case ev := <-events.Events():
+			if v, _ := ev.Get("ExternalIPAddress"); v != want || ev.Seq != uint32(i) {
+				t.Errorf("event %d: got SEQ %d value %q, want %q", i, ev.Seq, v, want)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatalf("timed out waiting for event %d", i)
+		}
+	}
+	if err := sub.Unsubscribe(); err != nil {
+		t.Fatal(err)
+	}
+	if n := d.Subscribers("/evt/IPConn"); n != 0 {
+		t.Errorf("got %d subscribers after unsubscribing, want 0", n)
+	}
+}
+
+func TestSSDPShim(t *testing.T) {
+	d := NewFakeDevice(testDescription)
+	defer d.Close()
+	shim, err := NewSSDPShim()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer shim.Close()
+	if err := shim.AdvertiseDevice(d); err != nil {
+		t.Fatal(err)
+	}
+
+	devices, err := goupnp.DiscoverDevices(internetgateway1.URN_WANIPConnection_1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(devices) != 1 {
+		t.Fatalf("discovered %d devices, want 1", len(devices))
+	}
+	if devices[0].Err != nil {
+		t.Fatal(devices[0].Err)
+	}
+	if udn := devices[0].Root.Device.UDN; udn != "uuid:00000000-0000-0000-0000-000000000001" {
+		t.Errorf("discovered UDN %q", udn)
+	}
+}
+
+func TestRediscovererSSDPAll(t *testing.T) {
+	d := NewFakeDevice(testDescription)
+	defer d.Close()
+	shim, err := NewSSDPShim()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer shim.Close()
+	if err := shim.AdvertiseDevice(d); err != nil {
+		t.Fatal(err)
+	}
+
+	reg := ssdp.NewRegistry()
+	discovered := make(chan []goupnp.MaybeRootDevice, 1)
+	r := &goupnp.Rediscoverer{
+		Options:    []goupnp.DiscoveryOption{goupnp.WithSearchWait(1), goupnp.WithSearchSends(1)},
+		Registry:   reg,
+		OnDiscover: func(devices []goupnp.MaybeRootDevice) { discovered <- devices },
+	}
+	if err := r.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer r.Stop()
+
+	var devices []goupnp.MaybeRootDevice
+	select {
+	case devices = <-discovered:
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the first search")
+	}
+	if len(devices) == 0 {
+		t.Fatal("discovered no devices searching for ssdp:all")
+	}
+	for _, dev := range devices {
+		if dev.Err != nil {
+			t.Fatal(dev.Err)
+		}
+		if udn := dev.Root.Device.UDN; udn != "uuid:00000000-0000-0000-0000-000000000001" {
+			t.Errorf("discovered UDN %q", udn)
+		}
+	}
+	if h := r.Health(); !h.Healthy() || h.Responses == 0 {
+		t.Errorf("health = %+v, want healthy with responses", h)
+	}
+	if entries := reg.GetService(internetgateway1.URN_WANIPConnection_1); len(entries) != 1 {
+		t.Errorf("registry has %d entries for the service, want 1", len(entries))
+	}
+}
@@ -0,0 +1,117 @@
+package goupnptest
+
+import (
+	"bytes"
+	"net"
+	"net/http"
+	"sync"
+
+	"github.com/huin/goupnp"
+	"github.com/huin/goupnp/httpu"
+	"github.com/huin/goupnp/internal/logging"
+	"github.com/huin/goupnp/ssdp"
+)
+
+type shimAdvertisement struct {
+	nt       string
+	usn      string
+	location string
+	server   string // SERVER header, that of goupnptest if empty.
+}
+
+// SSDPShim answers SSDP searches on the loopback interface. While it is open,
+// searches made via goupnp.DiscoverDevices and ssdp.SSDPRawSearch are sent to
+// it rather than to the SSDP multicast group, so that they only discover the
+// advertised fake devices. Only one SSDPShim should be open at a time, and
+// tests using one must not run in parallel with other tests that search.
+type SSDPShim struct {
+	conn       net.PacketConn
+	searchAddr string // Previous ssdp.SearchAddr.
+
+	lock sync.Mutex // Protects ads.
+	ads  []shimAdvertisement
+}
+
+// NewSSDPShim starts an SSDPShim, with no advertisements. Close must be called
+// to restore normal discovery.
+func NewSSDPShim() (*SSDPShim, error) {
+	conn, err := net.ListenPacket("udp4", "127.0.0.1:0")
+	if err != nil {
+		return nil, err
+	}
+	s := &SSDPShim{conn: conn, searchAddr: ssdp.SearchAddr}
+	ssdp.SearchAddr = conn.LocalAddr().String()
+	go httpu.Serve(conn, s)
+	return s, nil
+}
+
+// Close stops the shim, and restores ssdp.SearchAddr.
+func (s *SSDPShim) Close() error {
+	ssdp.SearchAddr = s.searchAddr
+	return s.conn.Close()
+}
+
+// Advertise adds an advertisement, answering searches for nt (or for
+// "ssdp:all") with the given USN and LOCATION.
+func (s *SSDPShim) Advertise(nt, usn, location string) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	s.ads = append(s.ads, shimAdvertisement{nt: nt, usn: usn, location: location})
+}
+
+// AdvertiseDevice adds the advertisements for a fake device, as a real device
+// would send for its description: for "upnp:rootdevice", and for the UDN and
+// type of every device and the type of every service.
+func (s *SSDPShim) AdvertiseDevice(d *FakeDevice) error {
+	loc := d.Location()
+	root, err := goupnp.DeviceByURL(loc)
+	if err != nil {
+		return err
+	}
+	location := loc.String()
+	s.Advertise("upnp:rootdevice", root.Device.UDN+"::upnp:rootdevice", location)
+	root.Device.VisitDevices(func(dev *goupnp.Device) {
+		s.Advertise(dev.UDN, dev.UDN, location)
+		s.Advertise(dev.DeviceType, dev.UDN+"::"+dev.DeviceType, location)
+		for _, svc := range dev.Services {
+			s.Advertise(svc.ServiceType, dev.UDN+"::"+svc.ServiceType, location)
+		}
+	})
+	return nil
+}
+
+// ServeMessage implements httpu.Handler.
+func (s *SSDPShim) ServeMessage(r *http.Request) {
+	if r.Method != "M-SEARCH" {
+		return
+	}
+	st := r.Header.Get("ST")
+	remoteAddr, err := net.ResolveUDPAddr("udp4", r.RemoteAddr)
+	if err != nil {
+		return
+	}
+	s.lock.Lock()
+	ads := append([]shimAdvertisement(nil), s.ads...)
+	s.lock.Unlock()
+	for _, ad := range ads {
+		if st != "ssdp:all" && st != ad.nt {
+			continue
+		}
+		var buf bytes.Buffer
+		buf.WriteString("HTTP/1.1 200 OK\r\n")
+		buf.WriteString("CACHE-CONTROL: max-age=1800\r\n")
+		buf.WriteString("EXT: \r\n")
+		buf.WriteString("LOCATION: " + ad.location + "\r\n")
+		server := ad.server
+		if server == "" {
+			server = "goupnptest/1.0 UPnP/1.1 goupnptest/1.0"
+		}
+		buf.WriteString("SERVER: " + server + "\r\n")
+		buf.WriteString("ST: " + ad.nt + "\r\n")
+		buf.WriteString("USN: " + ad.usn + "\r\n")
+		buf.WriteString("\r\n")
+		if _, err := s.conn.WriteTo(buf.Bytes(), remoteAddr); err != nil {
+			logging.Default().Warn("goupnptest: error responding to M-SEARCH", logging.Err(err))
+		}
+	}
+}
@@ -0,0 +1,75 @@
+package httpu
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+)
+
+// WriteRequest writes req to w as an HTTPU message. This is a subset of what
+// http.Request.Write does, deliberately to avoid adding fields which may
+// confuse some devices: only the request line, the header of req, with the
+// case of its keys as given, and its body, if any, are written. The HOST
+// header must be in the header of req.
+//
+// The request target is req.RequestURI if set, as it is for requests read by
+// ReadRequest, so that they are written as they were read. Otherwise it is
+// "*" for a URL of "*", e.g. &url.URL{Opaque: "*"} for M-SEARCH and NOTIFY
+// requests, the absolute URI of absolute URLs whose Host is not that of req,
+// and the path and query of the URL otherwise.
+func WriteRequest(w io.Writer, req *http.Request) error {
+	method := req.Method
+	if method == "" {
+		method = "GET"
+	}
+	bw := bufio.NewWriter(w)
+	bw.WriteString(method)
+	bw.WriteString(" ")
+	bw.WriteString(requestTarget(req))
+	bw.WriteString(" HTTP/1.1\r\n")
+	if err := req.Header.Write(bw); err != nil {
+		return err
+	}
+	bw.WriteString("\r\n")
+	if req.Body != nil && req.Body != http.NoBody {
+		if _, err := io.Copy(bw, req.Body); err != nil {
+			return err
+		}
+	}
+	return bw.Flush()
+}
+
+// requestTarget returns the request target of the request line of req.
+func requestTarget(req *http.Request) string {
+	if req.RequestURI != "" {
+		return req.RequestURI
+	}
+	u := req.URL
+	if u == nil {
+		return "*"
+	}
+	if u.Opaque == "*" || (u.Scheme == "" && u.Host == "" && u.Path == "*") {
+		return "*"
+	}
+	if u.Scheme != "" && u.Host != "" && req.Host != "" && u.Host != req.Host {
+		return u.String()
+	}
+	return u.RequestURI()
+}
+
+// ReadRequest reads an HTTPU request, of at most DefaultMaxMessageBytes, from
+// r, which is read to its end as a datagram delimits the message. Its body is
+// the remainder of the message, even without a CONTENT-LENGTH header, and its
+// RequestURI is the request target as read, e.g. "*" or an absolute URI.
+func ReadRequest(r io.Reader) (*http.Request, error) {
+	packet, err := ioutil.ReadAll(io.LimitReader(r, DefaultMaxMessageBytes+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(packet) > DefaultMaxMessageBytes {
+		return nil, errors.New("httpu: request too large")
+	}
+	return parseRequest(packet)
+}
@@ -0,0 +1,49 @@
+package httpu
+
+import (
+	"bytes"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestWriteRequestTargets(t *testing.T) {
+	for _, tc := range []struct {
+		req  *http.Request
+		want string
+	}{
+		{&http.Request{Method: "M-SEARCH", Host: "239.255.255.250:1900", URL: &url.URL{Opaque: "*"}}, "M-SEARCH * HTTP/1.1\r\n"},
+		{&http.Request{Method: "NOTIFY", URL: &url.URL{Path: "*"}}, "NOTIFY * HTTP/1.1\r\n"},
+		{&http.Request{Method: "GET", Host: "192.168.1.2:80", URL: &url.URL{Scheme: "http", Host: "192.168.1.1:5000", Path: "/desc.xml"}}, "GET http://192.168.1.1:5000/desc.xml HTTP/1.1\r\n"},
+		{&http.Request{Method: "GET", Host: "192.168.1.1:5000", URL: &url.URL{Scheme: "http", Host: "192.168.1.1:5000", Path: "/desc.xml", RawQuery: "a=1"}}, "GET /desc.xml?a=1 HTTP/1.1\r\n"},
+	} {
+		var buf bytes.Buffer
+		if err := WriteRequest(&buf, tc.req); err != nil {
+			t.Fatal(err)
+		}
+		if got := buf.String(); got != tc.want+"\r\n" {
+			t.Errorf("WriteRequest(%v) = %q, want %q", tc.req.URL, got, tc.want+"\r\n")
+		}
+	}
+}
+
+func TestReadWriteRequestRoundTrip(t *testing.T) {
+	for _, target := range []string{"*", "http://192.168.1.1:5000/desc.xml"} {
+		packet := "M-SEARCH " + target + " HTTP/1.1\r\nHOST: 239.255.255.250:1900\r\nST: ssdp:all\r\n\r\n"
+		req, err := ReadRequest(strings.NewReader(packet))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if req.RequestURI != target {
+			t.Errorf("got RequestURI %q, want %q", req.RequestURI, target)
+		}
+		var buf bytes.Buffer
+		if err := WriteRequest(&buf, req); err != nil {
+			t.Fatal(err)
+		}
+		if got := buf.String(); !strings.HasPrefix(got, "M-SEARCH "+target+" HTTP/1.1\r\n") || !strings.Contains(got, "St: ssdp:all\r\n") {
+			t.Errorf("got %q, want it to match %q", got, packet)
+		}
+	}
+}
@@ -0,0 +1,43 @@
+package httpu
+
+import (
+	"net"
+	"strings"
+)
+
+// InterfaceFilter returns whether multicast requests are sent on an
+// interface. Interfaces that are down or not multicast capable are skipped
+// regardless.
+type InterfaceFilter func(ifc *net.Interface) bool
+
+// AllInterfaces accepts every interface, to disable the filtering of
+// DefaultInterfaceFilter.
+func AllInterfaces(ifc *net.Interface) bool {
+	return true
+}
+
+// virtualAdapterNames are parts of the names of Windows virtual adapters that
+// devices are not found on. Multicast sends on them are wasted, or fail.
+var virtualAdapterNames = []string{
+	"vethernet", // Hyper-V virtual switches, "vEthernet (Default Switch)".
+	"hyper-v",
+	"wsl",
+	"virtualbox host-only",
+	"vmware network adapter",
+}
+
+// SkipVirtualAdapters skips loopback interfaces, those that are down, and the
+// virtual adapters of Hyper-V, WSL, VirtualBox and VMware, by their Windows
+// names. It is the DefaultInterfaceFilter on Windows.
+func SkipVirtualAdapters(ifc *net.Interface) bool {
+	if ifc.Flags&net.FlagLoopback != 0 || ifc.Flags&net.FlagUp == 0 {
+		return false
+	}
+	name := strings.ToLower(ifc.Name)
+	for _, virtual := range virtualAdapterNames {
+		if strings.Contains(name, virtual) {
+			return false
+		}
+	}
+	return true
+}
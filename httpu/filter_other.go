@@ -0,0 +1,9 @@
+//go:build !windows
+// +build !windows
+
+package httpu
+
+// DefaultInterfaceFilter is the filter of the interfaces that multicast
+// requests are sent on, unless set with WithInterfaceFilter. It accepts every
+// interface except on Windows, where it skips virtual adapters.
+var DefaultInterfaceFilter InterfaceFilter = AllInterfaces
@@ -0,0 +1,49 @@
+package httpu
+
+import (
+	"net"
+	"testing"
+
+	"github.com/huin/goupnp/internal/netif"
+)
+
+func TestInterfaceFilter(t *testing.T) {
+	up := net.FlagUp | net.FlagMulticast
+	ifs := []net.Interface{
+		{Index: 1, Name: "Loopback Pseudo-Interface 1", Flags: up | net.FlagLoopback},
+		{Index: 2, Name: "Ethernet", Flags: up},
+		{Index: 3, Name: "vEthernet (WSL)", Flags: up},
+		{Index: 4, Name: "vEthernet (Default Switch)", Flags: up},
+		{Index: 5, Name: "Wi-Fi", Flags: net.FlagMulticast},
+	}
+	netif.Set(func() ([]net.Interface, error) { return ifs, nil }, nil)
+	defer netif.Set(nil, nil)
+
+	for _, test := range []struct {
+		filter InterfaceFilter
+		want   []string
+	}{
+		{SkipVirtualAdapters, []string{"Ethernet"}},
+		{AllInterfaces, []string{"Loopback Pseudo-Interface 1", "Ethernet", "vEthernet (WSL)", "vEthernet (Default Switch)", "Wi-Fi"}},
+	} {
+		client := &HTTPUClient{filter: test.filter}
+		got, err := client.filteredInterfaces()
+		if err != nil {
+			t.Fatal(err)
+		}
+		var names []string
+		for _, ifc := range got {
+			names = append(names, ifc.Name)
+		}
+		if len(names) != len(test.want) {
+			t.Errorf("got %q, want %q", names, test.want)
+			continue
+		}
+		for i := range names {
+			if names[i] != test.want[i] {
+				t.Errorf("got %q, want %q", names, test.want)
+				break
+			}
+		}
+	}
+}
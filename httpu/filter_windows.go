@@ -0,0 +1,10 @@
+//go:build windows
+// +build windows
+
+package httpu
+
+// DefaultInterfaceFilter is the filter of the interfaces that multicast
+// requests are sent on, unless set with WithInterfaceFilter. On Windows, it
+// skips virtual adapters, which would otherwise take up the whole timeout of
+// a search.
+var DefaultInterfaceFilter InterfaceFilter = SkipVirtualAdapters
@@ -1,32 +1,126 @@
 package httpu
 
 import (
-	"bufio"
 	"bytes"
+	"context"
 	"fmt"
 	"golang.org/x/net/ipv4"
-	"log"
+	"golang.org/x/net/ipv6"
+	"log/slog"
 	"net"
 	"net/http"
 	"sync"
 	"time"
+
+	"github.com/huin/goupnp/internal/logging"
+	"github.com/huin/goupnp/internal/netif"
+	"github.com/huin/goupnp/upnperr"
 )
 
 // HTTPUClient is a client for dealing with HTTPU (HTTP over UDP). Its typical
 // function is for HTTPMU, and particularly SSDP.
 type HTTPUClient struct {
+	// Logger logs the responses that are discarded, the goupnp.SetLogger
+	// logger if nil.
+	Logger *slog.Logger
+
+	network          string // "udp4" or "udp6".
+	interfaces       []net.Interface
+	filter           InterfaceFilter
+	maxResponseBytes int
+
 	connLock sync.Mutex // Protects use of conn.
-	conn     *ipv4.PacketConn
+	conn     net.PacketConn
+	// setMulticastInterface sets the interface that multicast requests are
+	// sent on.
+	setMulticastInterface func(*net.Interface) error
+}
+
+// DefaultMaxResponseBytes is the size of the largest response read by an
+// HTTPUClient, unless set with WithMaxResponseBytes. Larger responses are
+// truncated and so discarded as malformed.
+const DefaultMaxResponseBytes = 2048
+
+// clientConfig is the configuration of a new HTTPUClient.
+type clientConfig struct {
+	network          string
+	addr             string
+	interfaces       []net.Interface
+	filter           InterfaceFilter
+	maxResponseBytes int
+	logger           *slog.Logger
+}
+
+// ClientOption configures an HTTPUClient created by NewHTTPUClient.
+type ClientOption func(*clientConfig)
+
+// WithLocalAddr sets the local UDP address that the client listens on, ":0"
+// (any address and an automatically chosen port) by default.
+func WithLocalAddr(addr string) ClientOption {
+	return func(c *clientConfig) { c.addr = addr }
+}
+
+// WithIPv6 makes the client use IPv6 rather than IPv4, e.g. to search for
+// devices at the SSDP link-local scope multicast address [FF02::C]:1900.
+func WithIPv6() ClientOption {
+	return func(c *clientConfig) { c.network = "udp6" }
+}
+
+// WithInterfaces restricts the network interfaces that multicast requests are
+// sent on, all multicast capable interfaces by default, as listed by
+// net.Interfaces or the function set with goupnp.SetInterfaces.
+func WithInterfaces(ifs ...net.Interface) ClientOption {
+	return func(c *clientConfig) { c.interfaces = ifs }
+}
+
+// WithInterfaceFilter sets the filter of the interfaces that multicast
+// requests are sent on, DefaultInterfaceFilter by default. It is not applied
+// to the interfaces given with WithInterfaces.
+func WithInterfaceFilter(f InterfaceFilter) ClientOption {
+	return func(c *clientConfig) { c.filter = f }
+}
+
+// WithMaxResponseBytes sets the size of the largest response read,
+// DefaultMaxResponseBytes by default.
+func WithMaxResponseBytes(n int) ClientOption {
+	return func(c *clientConfig) { c.maxResponseBytes = n }
+}
+
+// WithLogger sets the Logger of the client.
+func WithLogger(l *slog.Logger) ClientOption {
+	return func(c *clientConfig) { c.logger = l }
 }
 
 // NewHTTPUClient creates a new HTTPUClient, opening up a new UDP socket for the
 // purpose.
-func NewHTTPUClient() (*HTTPUClient, error) {
-	conn, err := net.ListenPacket("udp4", ":0")
+func NewHTTPUClient(opts ...ClientOption) (*HTTPUClient, error) {
+	c := clientConfig{network: "udp4", addr: ":0", filter: DefaultInterfaceFilter, maxResponseBytes: DefaultMaxResponseBytes}
+	for _, opt := range opts {
+		opt(&c)
+	}
+	conn, err := net.ListenPacket(c.network, c.addr)
 	if err != nil {
 		return nil, err
 	}
-	return &HTTPUClient{conn: ipv4.NewPacketConn(conn)}, nil
+	client := &HTTPUClient{
+		Logger:           c.logger,
+		network:          c.network,
+		interfaces:       c.interfaces,
+		filter:           c.filter,
+		maxResponseBytes: c.maxResponseBytes,
+		conn:             conn,
+	}
+	if c.network == "udp6" {
+		client.setMulticastInterface = ipv6.NewPacketConn(conn).SetMulticastInterface
+	} else {
+		client.setMulticastInterface = ipv4.NewPacketConn(conn).SetMulticastInterface
+	}
+	return client, nil
+}
+
+// IPv6 returns whether the client uses IPv6, as set by WithIPv6.
+func (httpu *HTTPUClient) IPv6() bool {
+	return httpu.network == "udp6"
 }
 
 // Close shuts down the client. The client will no longer be useful following
@@ -40,29 +134,26 @@ func (httpu *HTTPUClient) Close() error {
 // Do performs a request. The timeout is how long to wait for before returning
 // the responses that were received. An error is only returned for failing to
 // send the request. Failures in receipt simply do not add to the resulting
-// responses.
+// responses. The Request of each response is a copy of req whose RemoteAddr
+// is the address that the response was received from.
 //
 // Note that at present only one concurrent connection will happen per
 // HTTPUClient.
 func (httpu *HTTPUClient) Do(req *http.Request, timeout time.Duration, numSends int) ([]*http.Response, error) {
+	return httpu.DoCtx(context.Background(), req, timeout, numSends)
+}
+
+// DoCtx is as Do, but stops sending and awaiting responses when ctx is done,
+// returning the error of ctx.
+func (httpu *HTTPUClient) DoCtx(ctx context.Context, req *http.Request, timeout time.Duration, numSends int) ([]*http.Response, error) {
 	httpu.connLock.Lock()
 	defer httpu.connLock.Unlock()
-
-	// Create the request. This is a subset of what http.Request.Write does
-	// deliberately to avoid creating extra fields which may confuse some
-	// devices.
-	var requestBuf bytes.Buffer
-	method := req.Method
-	if method == "" {
-		method = "GET"
-	}
-	if _, err := fmt.Fprintf(&requestBuf, "%s %s HTTP/1.1\r\n", method, req.URL.RequestURI()); err != nil {
+	if err := ctx.Err(); err != nil {
 		return nil, err
 	}
-	if err := req.Header.Write(&requestBuf); err != nil {
-		return nil, err
-	}
-	if _, err := requestBuf.Write([]byte{'\r', '\n'}); err != nil {
+
+	var requestBuf bytes.Buffer
+	if err := WriteRequest(&requestBuf, req); err != nil {
 		return nil, err
 	}
 
@@ -73,46 +164,90 @@ func (httpu *HTTPUClient) Do(req *http.Request, timeout time.Duration, numSends
 	if err = httpu.conn.SetDeadline(time.Now().Add(timeout)); err != nil {
 		return nil, err
 	}
+	if ctx.Done() != nil {
+		// Cancellation ends the wait for responses by expiring the deadline.
+		done := make(chan struct{})
+		stopped := make(chan struct{})
+		go func() {
+			defer close(stopped)
+			select {
+			case <-ctx.Done():
+				httpu.conn.SetDeadline(time.Now())
+			case <-done:
+			}
+		}()
+		defer func() {
+			close(done)
+			<-stopped
+		}()
+	}
 
-	ifs, err := net.Interfaces()
-	if err != nil {
-		return nil, err
+	ifs := httpu.interfaces
+	if len(ifs) == 0 {
+		if ifs, err = httpu.filteredInterfaces(); err != nil {
+			return nil, err
+		}
 	}
 
 	// Send request.
 	for i := 0; i < numSends; i++ {
+		if destAddr.IP.IsMulticast() {
+			// send to every interface which support multicast. Interfaces
+			// that cannot send, such as those without an address of the
+			// family of the client, are skipped unless all of them fail.
+			var sent bool
+			var sendErr error
+			for _, ifc := range ifs {
+				if ifc.Flags&net.FlagMulticast == 0 || ifc.Flags&net.FlagUp == 0 {
+					// interface does not support multicast
+					continue
+				}
 
-		// send to every interface which support multicast
-		for _, ifc := range ifs {
-			if ifc.Flags&net.FlagMulticast == 0 {
-				// interface does not support multicast
-				continue
+				// set multicast interface to send the packet
+				err := httpu.setMulticastInterface(&ifc)
+				if err == nil {
+					err = httpu.send(requestBuf.Bytes(), destAddr)
+				}
+				if err != nil {
+					logging.Or(httpu.Logger).Debug("httpu: error sending request", slog.String(logging.KeyInterface, ifc.Name), logging.Err(err))
+					sendErr = err
+					continue
+				}
+				sent = true
 			}
-
-			// set multicast interface to send the packet
-			if err := httpu.conn.SetMulticastInterface(&ifc); err != nil {
-				return nil, err
+			if !sent && sendErr != nil {
+				return nil, upnperr.Transport("httpu: error sending request", sendErr)
 			}
-
-			if n, err := httpu.conn.WriteTo(requestBuf.Bytes(), nil, destAddr); err != nil {
-				return nil, err
-			} else if n < len(requestBuf.Bytes()) {
-				return nil, fmt.Errorf("httpu: wrote %d bytes rather than full %d in request",
-					n, len(requestBuf.Bytes()))
+		} else {
+			// A unicast request is routed by its destination address.
+			if err := httpu.send(requestBuf.Bytes(), destAddr); err != nil {
+				return nil, upnperr.Transport("httpu: error sending request", err)
 			}
 		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
 		time.Sleep(5 * time.Millisecond)
 	}
 
 	// Await responses until timeout.
 	var responses []*http.Response
-	responseBytes := make([]byte, 2048)
+	maxResponseBytes := httpu.maxResponseBytes
+	if maxResponseBytes <= 0 {
+		maxResponseBytes = DefaultMaxResponseBytes
+	}
+	buf := getPacketBuffer(maxResponseBytes)
+	defer putPacketBuffer(buf)
+	responseBytes := *buf
 	for {
-		// 2048 bytes should be sufficient for most networks.
-		n, _, _, err := httpu.conn.ReadFrom(responseBytes)
+		// DefaultMaxResponseBytes should be sufficient for most networks.
+		n, src, err := httpu.conn.ReadFrom(responseBytes)
 		if err != nil {
 			if err, ok := err.(net.Error); ok {
 				if err.Timeout() {
+					if ctxErr := ctx.Err(); ctxErr != nil {
+						return nil, ctxErr
+					}
 					break
 				}
 				if err.Temporary() {
@@ -121,13 +256,17 @@ func (httpu *HTTPUClient) Do(req *http.Request, timeout time.Duration, numSends
 					continue
 				}
 			}
-			return nil, err
+			return nil, upnperr.Transport("httpu: error reading response", err)
 		}
 
 		// Parse response.
-		response, err := http.ReadResponse(bufio.NewReader(bytes.NewBuffer(responseBytes[:n])), req)
+		// The Request of the response records where it came from, e.g. to
+		// tell the zone of link-local addresses.
+		respReq := *req
+		respReq.RemoteAddr = src.String()
+		response, err := parseResponse(responseBytes[:n], &respReq)
 		if err != nil {
-			log.Print("httpu: error while parsing response: %v", err)
+			logging.Or(httpu.Logger).Debug("httpu: discarding malformed response", logging.Err(err))
 			continue
 		}
 
@@ -135,3 +274,28 @@ func (httpu *HTTPUClient) Do(req *http.Request, timeout time.Duration, numSends
 	}
 	return responses, err
 }
+
+// filteredInterfaces lists the interfaces accepted by the filter of the
+// client.
+func (httpu *HTTPUClient) filteredInterfaces() ([]net.Interface, error) {
+	ifs, err := netif.List()
+	if err != nil || httpu.filter == nil {
+		return ifs, err
+	}
+	var filtered []net.Interface
+	for i := range ifs {
+		if httpu.filter(&ifs[i]) {
+			filtered = append(filtered, ifs[i])
+		}
+	}
+	return filtered, nil
+}
+
+func (httpu *HTTPUClient) send(msg []byte, destAddr *net.UDPAddr) error {
+	if n, err := httpu.conn.WriteTo(msg, destAddr); err != nil {
+		return err
+	} else if n < len(msg) {
+		return fmt.Errorf("httpu: wrote %d bytes rather than full %d in request", n, len(msg))
+	}
+	return nil
+}
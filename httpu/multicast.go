@@ -0,0 +1,93 @@
+package httpu
+
+import (
+	"fmt"
+	"net"
+
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+)
+
+// groupJoiner joins multicast groups, as ipv4.PacketConn and ipv6.PacketConn
+// do.
+type groupJoiner interface {
+	JoinGroup(ifi *net.Interface, group net.Addr) error
+	LeaveGroup(ifi *net.Interface, group net.Addr) error
+	JoinSourceSpecificGroup(ifi *net.Interface, group, source net.Addr) error
+}
+
+// listenMulticast listens on the multicast group addr, joined on each of ifs,
+// or the default multicast interface if none, for any source or, if sources
+// is not empty, for those sources only.
+func listenMulticast(addr *net.UDPAddr, ifs []net.Interface, sources []net.IP) (net.PacketConn, error) {
+	isIPv4 := addr.IP.To4() != nil
+	for _, src := range sources {
+		if (src.To4() != nil) != isIPv4 {
+			return nil, fmt.Errorf("httpu: source %s is not of the family of group %s", src, addr.IP)
+		}
+	}
+	// ListenMulticastUDP joins the group on the first interface, and sets
+	// the socket options that let other listeners share the port.
+	var first *net.Interface
+	if len(ifs) > 0 {
+		first = &ifs[0]
+	}
+	conn, err := net.ListenMulticastUDP("udp", first, addr)
+	if err != nil {
+		return nil, err
+	}
+	if len(ifs) <= 1 && len(sources) == 0 {
+		return conn, nil
+	}
+
+	var joiner groupJoiner
+	if isIPv4 {
+		joiner = ipv4.NewPacketConn(conn)
+	} else {
+		joiner = ipv6.NewPacketConn(conn)
+	}
+	group := &net.UDPAddr{IP: addr.IP}
+	joinIfs := []*net.Interface{first}
+	if len(ifs) > 1 {
+		for i := range ifs[1:] {
+			joinIfs = append(joinIfs, &ifs[i+1])
+		}
+	}
+	for i, ifc := range joinIfs {
+		if err := join(joiner, ifc, group, sources, i == 0); err != nil {
+			conn.Close()
+			return nil, err
+		}
+	}
+	return conn, nil
+}
+
+// join joins group on ifc for sources, or for any source if there are none.
+// The group is already joined for any source on ifc if joined is true.
+func join(joiner groupJoiner, ifc *net.Interface, group net.Addr, sources []net.IP, joined bool) error {
+	name := "default interface"
+	if ifc != nil {
+		name = ifc.Name
+	}
+	if len(sources) == 0 {
+		if joined {
+			return nil
+		}
+		if err := joiner.JoinGroup(ifc, group); err != nil {
+			return fmt.Errorf("httpu: error joining %s on %s: %v", group, name, err)
+		}
+		return nil
+	}
+	// An any-source membership must be left before joining for sources.
+	if joined {
+		if err := joiner.LeaveGroup(ifc, group); err != nil {
+			return fmt.Errorf("httpu: error leaving %s on %s: %v", group, name, err)
+		}
+	}
+	for _, src := range sources {
+		if err := joiner.JoinSourceSpecificGroup(ifc, group, &net.UDPAddr{IP: src}); err != nil {
+			return fmt.Errorf("httpu: error joining %s for source %s on %s: %v", group, src, name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,41 @@
+package httpu
+
+import (
+	"net"
+	"testing"
+)
+
+func TestListenMulticastSources(t *testing.T) {
+	group := &net.UDPAddr{IP: net.IPv4(239, 255, 255, 250), Port: 0}
+	if _, err := listenMulticast(group, nil, []net.IP{net.ParseIP("fe80::1")}); err == nil {
+		t.Error("want error for IPv6 source of IPv4 group")
+	}
+
+	// Without an interface, the sources are joined on the default one. The
+	// join may fail without a multicast route, but must not panic.
+	if conn, err := listenMulticast(group, nil, []net.IP{net.IPv4(127, 0, 0, 1)}); err == nil {
+		conn.Close()
+	}
+
+	lo := loopbackInterface(t)
+	conn, err := listenMulticast(group, []net.Interface{*lo}, []net.IP{net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Skipf("source-specific multicast is not available: %v", err)
+	}
+	conn.Close()
+}
+
+func loopbackInterface(t *testing.T) *net.Interface {
+	t.Helper()
+	ifs, err := net.Interfaces()
+	if err != nil {
+		t.Skip(err)
+	}
+	for i := range ifs {
+		if ifs[i].Flags&net.FlagLoopback != 0 && ifs[i].Flags&net.FlagMulticast != 0 {
+			return &ifs[i]
+		}
+	}
+	t.Skip("no multicast capable loopback interface")
+	return nil
+}
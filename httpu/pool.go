@@ -0,0 +1,132 @@
+package httpu
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"sync"
+)
+
+// packetBuffers holds buffers that packets are received into, as *[]byte.
+var packetBuffers sync.Pool
+
+// getPacketBuffer returns a buffer of size bytes from packetBuffers, which
+// should be returned with putPacketBuffer once the packet is parsed.
+func getPacketBuffer(size int) *[]byte {
+	if buf, ok := packetBuffers.Get().(*[]byte); ok && cap(*buf) >= size {
+		*buf = (*buf)[:size]
+		return buf
+	}
+	buf := make([]byte, size)
+	return &buf
+}
+
+func putPacketBuffer(buf *[]byte) {
+	packetBuffers.Put(buf)
+}
+
+// packetReader reads an HTTP message from a packet. packetReaders holds them
+// for reuse, as the bufio.Reader needed by http.ReadRequest and
+// http.ReadResponse is the largest allocation of parsing a packet.
+type packetReader struct {
+	packet bytes.Reader
+	buf    *bufio.Reader
+}
+
+var packetReaders = sync.Pool{
+	New: func() interface{} {
+		pr := new(packetReader)
+		pr.buf = bufio.NewReaderSize(&pr.packet, DefaultMaxMessageBytes)
+		return pr
+	},
+}
+
+func getPacketReader(packet []byte) *packetReader {
+	pr := packetReaders.Get().(*packetReader)
+	pr.packet.Reset(packet)
+	pr.buf.Reset(&pr.packet)
+	return pr
+}
+
+func (pr *packetReader) put() {
+	pr.packet.Reset(nil)
+	pr.buf.Reset(&pr.packet)
+	packetReaders.Put(pr)
+}
+
+// copyBody returns a message body of a copy of body, which may refer to the
+// buffer of a packetReader.
+func copyBody(body []byte) io.ReadCloser {
+	if len(body) == 0 {
+		return http.NoBody
+	}
+	return ioutil.NopCloser(bytes.NewReader(append([]byte(nil), body...)))
+}
+
+// parseRequest parses a request received in packet. The request does not
+// refer to packet, so it can be reused once parseRequest returns.
+func parseRequest(packet []byte) (*http.Request, error) {
+	// At least one router's UPnP implementation has added a trailing space
+	// after "HTTP/1.1" - trim it.
+	packet = trimTrailingSpaces(packet)
+	pr := getPacketReader(packet)
+	defer pr.put()
+	req, err := http.ReadRequest(pr.buf)
+	if err != nil {
+		return nil, err
+	}
+	var body []byte
+	if req.ContentLength == 0 && len(req.TransferEncoding) == 0 && req.Header.Get("Content-Length") == "" {
+		// The datagram delimits the message, so any remaining bytes are
+		// the body even without a CONTENT-LENGTH header (as is the case
+		// for multicast event messages).
+		body, _ = ioutil.ReadAll(pr.buf)
+	} else if body, err = ioutil.ReadAll(req.Body); err != nil {
+		return nil, err
+	}
+	req.Body, req.ContentLength = copyBody(body), int64(len(body))
+	return req, nil
+}
+
+// parseResponse parses a response to req received in packet. The response
+// does not refer to packet, so it can be reused once parseResponse returns.
+func parseResponse(packet []byte, req *http.Request) (*http.Response, error) {
+	pr := getPacketReader(packet)
+	defer pr.put()
+	resp, err := http.ReadResponse(pr.buf, req)
+	if err != nil {
+		return nil, err
+	}
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	resp.Body = copyBody(body)
+	return resp, nil
+}
+
+// trimTrailingSpaces removes the spaces before the line breaks of b in place,
+// and returns the shortened b.
+func trimTrailingSpaces(b []byte) []byte {
+	if bytes.Index(b, []byte(" \r\n")) < 0 {
+		return b
+	}
+	out := b[:0]
+	for i := 0; i < len(b); i++ {
+		if b[i] == ' ' {
+			j := i
+			for j < len(b) && b[j] == ' ' {
+				j++
+			}
+			if j+1 >= len(b) || b[j] != '\r' || b[j+1] != '\n' {
+				out = append(out, b[i:j]...)
+			}
+			i = j - 1
+			continue
+		}
+		out = append(out, b[i])
+	}
+	return out
+}
@@ -0,0 +1,80 @@
+package httpu
+
+import (
+	"io/ioutil"
+	"net/http"
+	"testing"
+)
+
+const notifyPacket = "NOTIFY * HTTP/1.1 \r\n" +
+	"HOST: 239.255.255.250:1900\r\n" +
+	"CACHE-CONTROL: max-age=1800\r\n" +
+	"LOCATION: http://192.168.1.1:5000/rootDesc.xml\r\n" +
+	"NT: urn:schemas-upnp-org:device:InternetGatewayDevice:1\r\n" +
+	"NTS: ssdp:alive\r\n" +
+	"SERVER: Linux/3.14 UPnP/1.1 MiniUPnPd/2.1  \r\n" +
+	"USN: uuid:00000000-0000-0000-0000-000000000001::urn:schemas-upnp-org:device:InternetGatewayDevice:1\r\n" +
+	"\r\n"
+
+const searchResponsePacket = "HTTP/1.1 200 OK\r\n" +
+	"CACHE-CONTROL: max-age=120\r\n" +
+	"EXT:\r\n" +
+	"LOCATION: http://192.168.1.1:5000/rootDesc.xml\r\n" +
+	"SERVER: Linux/3.14 UPnP/1.1 MiniUPnPd/2.1\r\n" +
+	"ST: upnp:rootdevice\r\n" +
+	"USN: uuid:00000000-0000-0000-0000-000000000001::upnp:rootdevice\r\n" +
+	"\r\n"
+
+func TestParseRequest(t *testing.T) {
+	packet := []byte(notifyPacket + "<e:propertyset/>")
+	req, err := parseRequest(packet)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Reusing the packet must not change the request.
+	for i := range packet {
+		packet[i] = 'x'
+	}
+	if got := req.Header.Get("SERVER"); got != "Linux/3.14 UPnP/1.1 MiniUPnPd/2.1" {
+		t.Errorf("got SERVER %q, want its trailing spaces trimmed", got)
+	}
+	body, _ := ioutil.ReadAll(req.Body)
+	if string(body) != "<e:propertyset/>" || req.ContentLength != int64(len(body)) {
+		t.Errorf("got body %q of length %d", body, req.ContentLength)
+	}
+}
+
+func TestTrimTrailingSpaces(t *testing.T) {
+	for in, want := range map[string]string{
+		"a \r\nb  \r\n":  "a\r\nb\r\n",
+		"a b\r\n":        "a b\r\n",
+		"a  b \r":        "a  b \r",
+		"no line breaks": "no line breaks",
+	} {
+		if got := string(trimTrailingSpaces([]byte(in))); got != want {
+			t.Errorf("trimTrailingSpaces(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func BenchmarkParseRequest(b *testing.B) {
+	packet := make([]byte, len(notifyPacket))
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		copy(packet, notifyPacket)
+		if _, err := parseRequest(packet); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func BenchmarkParseResponse(b *testing.B) {
+	packet := []byte(searchResponsePacket)
+	req := &http.Request{Method: "M-SEARCH"}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if _, err := parseResponse(packet, req); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
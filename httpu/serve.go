@@ -1,23 +1,17 @@
 package httpu
 
 import (
-	"bufio"
-	"bytes"
-	"log"
+	"log/slog"
 	"net"
 	"net/http"
-	"regexp"
+
+	"github.com/huin/goupnp/internal/logging"
 )
 
 const (
 	DefaultMaxMessageBytes = 2048
 )
 
-var (
-	trailingWhitespaceRx = regexp.MustCompile(" +\r\n")
-	crlf                 = []byte("\r\n")
-)
-
 // Handler is the interface by which received HTTPU messages are passed to
 // handling code.
 type Handler interface {
@@ -40,22 +34,37 @@ type Server struct {
 	Interface       *net.Interface // Network interface to listen on for multicast, nil for default multicast interface
 	Handler         Handler        // handler to invoke
 	MaxMessageBytes int            // maximum number of bytes to read from a packet, DefaultMaxMessageBytes if 0
+	Logger          *slog.Logger   // logs malformed requests, the goupnp.SetLogger logger if nil
+
+	// Interfaces, if not empty, are the network interfaces that the
+	// multicast group is joined on, instead of Interface, so that messages
+	// are received on those interfaces only.
+	Interfaces []net.Interface
+	// Sources, if not empty, restricts the multicast messages received to
+	// those sent by these addresses, with source-specific joins (IGMPv3, or
+	// MLDv2 for IPv6 groups), as required by switches that filter multicast
+	// traffic by source. Their family must be that of the group.
+	Sources []net.IP
 }
 
 // ListenAndServe listens on the UDP network address srv.Addr. If srv.Multicast
-// is true, then a multicast UDP listener will be used on srv.Interface (or
-// default interface if nil).
+// is true, then a multicast UDP listener will be used on srv.Interfaces, or
+// srv.Interface (or default interface if nil), joined for srv.Sources if set.
 func (srv *Server) ListenAndServe() error {
 	var err error
 
 	var addr *net.UDPAddr
 	if addr, err = net.ResolveUDPAddr("udp", srv.Addr); err != nil {
-		log.Fatal(err)
+		return err
 	}
 
 	var conn net.PacketConn
 	if srv.Multicast {
-		if conn, err = net.ListenMulticastUDP("udp", srv.Interface, addr); err != nil {
+		ifs := srv.Interfaces
+		if len(ifs) == 0 && srv.Interface != nil {
+			ifs = []net.Interface{*srv.Interface}
+		}
+		if conn, err = listenMulticast(addr, ifs, srv.Sources); err != nil {
 			return err
 		}
 	} else {
@@ -74,27 +83,27 @@ func (srv *Server) Serve(l net.PacketConn) error {
 		maxMessageBytes = srv.MaxMessageBytes
 	}
 	for {
-		buf := make([]byte, maxMessageBytes)
-		n, peerAddr, err := l.ReadFrom(buf)
+		// Packets are received into pooled buffers, which are reused once
+		// parsed, as servers such as that of an ssdp.Registry receive many.
+		buf := getPacketBuffer(maxMessageBytes)
+		n, peerAddr, err := l.ReadFrom(*buf)
 		if err != nil {
+			putPacketBuffer(buf)
 			return err
 		}
-		buf = buf[:n]
-
-		go func(buf []byte, peerAddr net.Addr) {
-			// At least one router's UPnP implementation has added a trailing space
-			// after "HTTP/1.1" - trim it.
-			buf = trailingWhitespaceRx.ReplaceAllLiteral(buf, crlf)
 
-			req, err := http.ReadRequest(bufio.NewReader(bytes.NewBuffer(buf)))
+		go func(buf *[]byte, n int, peerAddr net.Addr) {
+			req, err := parseRequest((*buf)[:n])
+			putPacketBuffer(buf)
 			if err != nil {
-				log.Printf("httpu: Failed to parse request: %v", err)
+				logging.ReportMalformed(srv.Logger, "httpu", "httpu: failed to parse request", peerAddr.String(), err)
 				return
 			}
 			req.RemoteAddr = peerAddr.String()
+			defer logging.Recover(srv.Logger, "httpu: message handler panicked",
+				slog.String(logging.KeyRemote, req.RemoteAddr))
 			srv.Handler.ServeMessage(req)
-			// No need to call req.Body.Close - underlying reader is bytes.Buffer.
-		}(buf, peerAddr)
+		}(buf, n, peerAddr)
 	}
 }
 
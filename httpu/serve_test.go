@@ -0,0 +1,49 @@
+package httpu
+
+import (
+	"io/ioutil"
+	"log/slog"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestServeHandlerPanic(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	served := make(chan string, 2)
+	srv := &Server{
+		Logger: slog.New(slog.NewTextHandler(ioutil.Discard, nil)),
+		Handler: HandlerFunc(func(r *http.Request) {
+			if r.Header.Get("ST") == "panic" {
+				panic("broken handler")
+			}
+			served <- r.Header.Get("ST")
+		}),
+	}
+	go srv.Serve(conn)
+
+	sender, err := net.Dial("udp", conn.LocalAddr().String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer sender.Close()
+	for _, st := range []string{"panic", "ok"} {
+		msg := "M-SEARCH * HTTP/1.1\r\nHOST: 239.255.255.250:1900\r\nST: " + st + "\r\n\r\n"
+		if _, err := sender.Write([]byte(msg)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	select {
+	case st := <-served:
+		if st != "ok" {
+			t.Errorf("served ST %q, want ok", st)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("message after panic not served")
+	}
+}
@@ -0,0 +1,79 @@
+package goupnp
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"net/url"
+	"strings"
+)
+
+// Identity is what identifies a physical device across changes of its
+// address, e.g. by DHCP, from its description.
+type Identity struct {
+	UDN          string
+	Manufacturer string
+	ModelName    string
+	ModelNumber  string
+	SerialNumber string
+}
+
+// IdentityOf returns the identity of the root device of root.
+func IdentityOf(root *RootDevice) Identity {
+	d := &root.Device
+	return Identity{
+		UDN:          d.UDN,
+		Manufacturer: d.Manufacturer,
+		ModelName:    d.ModelName,
+		ModelNumber:  d.ModelNumber,
+		SerialNumber: d.SerialNumber,
+	}
+}
+
+// normalize returns id with the case and surrounding spaces of its fields,
+// which devices are not consistent about, removed.
+func (id Identity) normalize() Identity {
+	norm := func(s string) string { return strings.ToLower(strings.TrimSpace(s)) }
+	return Identity{
+		UDN:          norm(id.UDN),
+		Manufacturer: norm(id.Manufacturer),
+		ModelName:    norm(id.ModelName),
+		ModelNumber:  norm(id.ModelNumber),
+		SerialNumber: norm(id.SerialNumber),
+	}
+}
+
+// Fingerprint returns a stable fingerprint of id, the hex encoded SHA-256 of
+// its fields regardless of their case, for use as a key of the devices of a
+// registry. It does not depend on the address of the device, so it is kept
+// across DHCP churn, but it changes if any of the fields do.
+func (id Identity) Fingerprint() string {
+	n := id.normalize()
+	h := sha256.New()
+	for _, field := range []string{n.UDN, n.Manufacturer, n.ModelName, n.ModelNumber, n.SerialNumber} {
+		// Fields are NUL terminated, so that they cannot run into each
+		// other.
+		h.Write([]byte(field))
+		h.Write([]byte{0})
+	}
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// SameDevice returns whether id and other are of the same device: if they
+// have the same UDN, or, for devices that generate a new UDN when they
+// restart, the same manufacturer, model and non-empty serial number.
+func (id Identity) SameDevice(other Identity) bool {
+	a, b := id.normalize(), other.normalize()
+	if a.UDN != "" && a.UDN == b.UDN {
+		return true
+	}
+	return a.SerialNumber != "" && a.SerialNumber == b.SerialNumber &&
+		a.Manufacturer == b.Manufacturer && a.ModelName == b.ModelName && a.ModelNumber == b.ModelNumber
+}
+
+// Relocated returns whether a device whose description was at oldLoc, with
+// identity id, has moved to newLoc, with identity other: whether they are the
+// same device at another host, so that a registry can update its address
+// rather than add another device.
+func (id Identity) Relocated(oldLoc *url.URL, other Identity, newLoc *url.URL) bool {
+	return id.SameDevice(other) && !strings.EqualFold(oldLoc.Host, newLoc.Host)
+}
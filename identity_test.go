@@ -0,0 +1,37 @@
+package goupnp
+
+import (
+	"net/url"
+	"testing"
+)
+
+func TestIdentity(t *testing.T) {
+	root := &RootDevice{Device: Device{UDN: "uuid:1", Manufacturer: "Acme", ModelName: "Router", ModelNumber: "2", SerialNumber: "S1"}}
+	id := IdentityOf(root)
+	same := Identity{UDN: "UUID:1 ", Manufacturer: "ACME", ModelName: "router", ModelNumber: "2", SerialNumber: "s1"}
+	if id.Fingerprint() != same.Fingerprint() {
+		t.Error("got different fingerprints for identities differing in case and spaces")
+	}
+	if fp := (Identity{UDN: "uuid:1", Manufacturer: "Acme", ModelName: "Router2"}).Fingerprint(); fp == id.Fingerprint() {
+		t.Error("got the same fingerprint for different identities")
+	}
+
+	restarted := id
+	restarted.UDN = "uuid:2"
+	if !id.SameDevice(restarted) {
+		t.Error("want devices of the same serial number to be the same")
+	}
+	other := Identity{UDN: "uuid:3", Manufacturer: "Acme", ModelName: "Router", ModelNumber: "2"}
+	if id.SameDevice(other) {
+		t.Error("want devices of different UDNs without serial numbers to differ")
+	}
+
+	oldLoc, _ := url.Parse("http://192.168.1.10:5000/desc.xml")
+	newLoc, _ := url.Parse("http://192.168.1.23:5000/desc.xml")
+	if !id.Relocated(oldLoc, same, newLoc) {
+		t.Error("want the device relocated")
+	}
+	if id.Relocated(oldLoc, same, oldLoc) || id.Relocated(oldLoc, other, newLoc) {
+		t.Error("want devices at the same host, or other devices, not relocated")
+	}
+}
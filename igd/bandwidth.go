@@ -0,0 +1,183 @@
+package igd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/huin/goupnp"
+	"github.com/huin/goupnp/clock"
+	"github.com/huin/goupnp/dcps/internetgateway2"
+	"github.com/huin/goupnp/internal/logging"
+)
+
+// BandwidthSample is a sample of the traffic of a WAN interface, as reported
+// by MonitorBandwidth.
+type BandwidthSample struct {
+	Time time.Time
+	// BytesSent and BytesReceived are the bytes since the first sample, with
+	// the wrapping of the 32 bit counters of the gateway undone.
+	BytesSent     uint64
+	BytesReceived uint64
+	// SendRate and ReceiveRate are in bytes per second since the previous
+	// sample, zero for the first sample.
+	SendRate    float64
+	ReceiveRate float64
+	// The common link properties of the interface.
+	UpstreamMaxBitRate   uint32
+	DownstreamMaxBitRate uint32
+	PhysicalLinkStatus   string
+	// Err is the error sampling the interface, in which case the other
+	// fields are those of the previous sample but for Time.
+	Err error
+}
+
+// CommonInterfaceConfig returns a client for the WANCommonInterfaceConfig
+// service of the WAN interface of c, that of the WANDevice containing c.
+func (c *Connection) CommonInterfaceConfig() (*internetgateway2.WANCommonInterfaceConfig1, error) {
+	root := c.sc.RootDevice
+	var found *goupnp.Service
+	root.Device.VisitDevices(func(d *goupnp.Device) {
+		if found != nil || (d.DeviceType != internetgateway2.URN_WANDevice_1 && d.DeviceType != internetgateway2.URN_WANDevice_2) {
+			return
+		}
+		contains := false
+		d.VisitServices(func(s *goupnp.Service) {
+			contains = contains || s == c.sc.Service
+		})
+		if !contains {
+			return
+		}
+		if srvs := d.FindService(internetgateway2.URN_WANCommonInterfaceConfig_1); len(srvs) > 0 {
+			found = srvs[0]
+		}
+	})
+	if found == nil {
+		return nil, fmt.Errorf("goupnp: no WANCommonInterfaceConfig service found for connection service %q of device %q",
+			c.sc.Service.ServiceId, root.Device.FriendlyName)
+	}
+	return &internetgateway2.WANCommonInterfaceConfig1{ServiceClient: *newServiceClient(root, c.sc.Location, found)}, nil
+}
+
+// DefaultBandwidthInterval is the interval at which MonitorBandwidth samples
+// if the interval given to it is not positive.
+const DefaultBandwidthInterval = 10 * time.Second
+
+// BandwidthOption configures MonitorBandwidth and BandwidthSamples.
+type BandwidthOption func(*bandwidthSampler)
+
+// WithBandwidthClock sets the clock that the samples are timed and scheduled
+// on, the system clock by default.
+func WithBandwidthClock(c Clock) BandwidthOption {
+	return func(bs *bandwidthSampler) { bs.clock = c }
+}
+
+// MonitorBandwidth samples the traffic counters and link properties of client
+// every interval, or DefaultBandwidthInterval if it is not positive, and calls
+// fn with each sample, until ctx is done. It returns the error of ctx.
+func MonitorBandwidth(ctx context.Context, client internetgateway2.WANCommonInterfaceConfig1Client, interval time.Duration, fn func(BandwidthSample), opts ...BandwidthOption) error {
+	var s bandwidthSampler
+	for _, opt := range opts {
+		opt(&s)
+	}
+	s.clock = clock.Or(s.clock)
+	if interval <= 0 {
+		interval = DefaultBandwidthInterval
+	}
+	timer := s.clock.NewTimer(interval)
+	defer timer.Stop()
+	for {
+		deliverSample(fn, s.sample(ctx, client))
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C():
+			timer.Reset(interval)
+		}
+	}
+}
+
+// deliverSample calls fn with sample, recovering from its panics so that they
+// do not stop the monitoring.
+func deliverSample(fn func(BandwidthSample), sample BandwidthSample) {
+	defer logging.Recover(nil, "goupnp: panic in bandwidth callback")
+	fn(sample)
+}
+
+// BandwidthSamples is MonitorBandwidth delivering the samples on a channel,
+// which is closed when ctx is done. Samples are delayed until the previous one
+// is received from the channel.
+func BandwidthSamples(ctx context.Context, client internetgateway2.WANCommonInterfaceConfig1Client, interval time.Duration, opts ...BandwidthOption) <-chan BandwidthSample {
+	samples := make(chan BandwidthSample)
+	go func() {
+		defer close(samples)
+		MonitorBandwidth(ctx, client, interval, func(s BandwidthSample) {
+			select {
+			case samples <- s:
+			case <-ctx.Done():
+			}
+		}, opts...)
+	}()
+	return samples
+}
+
+type bandwidthSampler struct {
+	clock            Clock
+	last             BandwidthSample
+	sent, received   uint32
+	haveLastCounters bool
+}
+
+func (bs *bandwidthSampler) sample(ctx context.Context, client internetgateway2.WANCommonInterfaceConfig1Client) BandwidthSample {
+	var sent, received, upMax, downMax uint32
+	var linkStatus internetgateway2.WANCommonInterfaceConfig1PhysicalLinkStatus
+	err := goupnp.Batch(ctx, 0,
+		func(ctx context.Context) (err error) {
+			sent, err = client.GetTotalBytesSentCtx(ctx)
+			return
+		},
+		func(ctx context.Context) (err error) {
+			received, err = client.GetTotalBytesReceivedCtx(ctx)
+			return
+		},
+		func(ctx context.Context) (err error) {
+			_, upMax, downMax, linkStatus, err = client.GetCommonLinkPropertiesCtx(ctx)
+			return
+		},
+	)
+	now := clock.Or(bs.clock).Now()
+	// Report the first error of the actions rather than the BatchError.
+	if errs, ok := err.(goupnp.BatchError); ok {
+		for _, err = range errs {
+			if err != nil {
+				break
+			}
+		}
+	}
+	if err != nil {
+		s := bs.last
+		s.Time, s.Err = now, err
+		return s
+	}
+
+	s := BandwidthSample{
+		Time:                 now,
+		BytesSent:            bs.last.BytesSent,
+		BytesReceived:        bs.last.BytesReceived,
+		UpstreamMaxBitRate:   upMax,
+		DownstreamMaxBitRate: downMax,
+		PhysicalLinkStatus:   string(linkStatus),
+	}
+	if bs.haveLastCounters {
+		// The unsigned differences undo a wrap of the counters.
+		sentDelta, receivedDelta := sent-bs.sent, received-bs.received
+		s.BytesSent += uint64(sentDelta)
+		s.BytesReceived += uint64(receivedDelta)
+		if elapsed := now.Sub(bs.last.Time).Seconds(); elapsed > 0 {
+			s.SendRate = float64(sentDelta) / elapsed
+			s.ReceiveRate = float64(receivedDelta) / elapsed
+		}
+	}
+	bs.last, bs.sent, bs.received, bs.haveLastCounters = s, sent, received, true
+	return s
+}
@@ -0,0 +1,78 @@
+package igd
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/huin/goupnp/clock"
+	"github.com/huin/goupnp/dcps/internetgateway2"
+)
+
+type fakeCommonInterfaceConfig struct {
+	internetgateway2.WANCommonInterfaceConfig1Client
+	sent, received []uint32
+	err            error
+}
+
+func (f *fakeCommonInterfaceConfig) GetTotalBytesSentCtx(ctx context.Context) (uint32, error) {
+	n := f.sent[0]
+	f.sent = f.sent[1:]
+	return n, nil
+}
+
+func (f *fakeCommonInterfaceConfig) GetTotalBytesReceivedCtx(ctx context.Context) (uint32, error) {
+	n := f.received[0]
+	f.received = f.received[1:]
+	return n, f.err
+}
+
+func (f *fakeCommonInterfaceConfig) GetCommonLinkPropertiesCtx(ctx context.Context) (internetgateway2.WANCommonInterfaceConfig1WANAccessType, uint32, uint32, internetgateway2.WANCommonInterfaceConfig1PhysicalLinkStatus, error) {
+	return internetgateway2.WANCommonInterfaceConfig1WANAccessType_Ethernet, 1000000, 8000000, internetgateway2.WANCommonInterfaceConfig1PhysicalLinkStatus_Up, nil
+}
+
+func TestBandwidthSampler(t *testing.T) {
+	client := &fakeCommonInterfaceConfig{
+		sent:     []uint32{1 << 31, 1<<31 + 1000, 1000},
+		received: []uint32{0xfffffff0, 0x10, 0x20},
+	}
+	fc := clock.NewFake(time.Unix(1000, 0))
+	bs := bandwidthSampler{clock: fc}
+	ctx := context.Background()
+
+	s := bs.sample(ctx, client)
+	if s.Err != nil || s.BytesSent != 0 || s.SendRate != 0 || s.DownstreamMaxBitRate != 8000000 || s.PhysicalLinkStatus != "Up" {
+		t.Errorf("first sample = %+v", s)
+	}
+	fc.Advance(time.Second)
+	s = bs.sample(ctx, client)
+	if s.BytesSent != 1000 || s.BytesReceived != 0x20 || s.SendRate != 1000 || s.ReceiveRate != 0x20 {
+		t.Errorf("second sample = %+v, want 1000 bytes sent and the wrapped 32 bytes received", s)
+	}
+
+	client.err = errors.New("failed")
+	if s := bs.sample(ctx, client); s.Err != client.err || s.BytesSent != 1000 {
+		t.Errorf("failed sample = %+v, want the error and the previous counters", s)
+	}
+}
+
+func TestBandwidthSamples(t *testing.T) {
+	client := &fakeCommonInterfaceConfig{sent: []uint32{0, 500, 1500}, received: make([]uint32, 3)}
+	fc := clock.NewFake(time.Unix(1000, 0))
+	ctx, cancel := context.WithCancel(context.Background())
+	samples := BandwidthSamples(ctx, client, 0, WithBandwidthClock(fc))
+	if s := <-samples; !s.Time.Equal(time.Unix(1000, 0)) {
+		t.Errorf("first sample at %v, want it immediately", s.Time)
+	}
+	// A non-positive interval samples every DefaultBandwidthInterval.
+	for _, want := range []float64{50, 100} {
+		fc.Advance(DefaultBandwidthInterval)
+		if s := <-samples; s.SendRate != want {
+			t.Errorf("got sample %+v, want a send rate of %v", s, want)
+		}
+	}
+	cancel()
+	for range samples {
+	}
+}
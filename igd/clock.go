@@ -0,0 +1,44 @@
+package igd
+
+import (
+	"time"
+
+	"github.com/huin/goupnp/clock"
+)
+
+// Clock is the source of time of a PortMapper, replaceable to test the renewal
+// and expiry of leases without waiting for them, e.g. with a clock.Fake.
+type Clock = clock.Clock
+
+// Timer is a timer of a Clock, like time.Timer.
+type Timer = clock.Timer
+
+// Renewal is the outcome of the renewal of a lease by a PortMapper, as passed
+// to the function set by SetRenewalFunc.
+type Renewal struct {
+	// Mapping is the renewed mapping, or the mapping that failed to be
+	// renewed.
+	Mapping Mapping
+	Err     error
+	// Failures is the number of consecutive failed renewals of the mapping,
+	// zero if this one succeeded.
+	Failures int
+	// Expires is when the lease of the mapping expires on the gateway, as of
+	// its last successful renewal, and Expired whether it has.
+	Expires time.Time
+	Expired bool
+}
+
+// SetClock sets the clock that pm schedules renewals on, the system clock by
+// default. It must be called before Map.
+func (pm *PortMapper) SetClock(c Clock) {
+	pm.clock = c
+}
+
+// SetRenewalFunc sets fn to be called with the outcome of each renewal of a
+// lease, e.g. to alert on repeated failures. fn is called from the goroutine
+// renewing the mapping, and must not unmap it or close pm, which wait for that
+// goroutine to stop. It must be called before Map.
+func (pm *PortMapper) SetRenewalFunc(fn func(Renewal)) {
+	pm.onRenewal = fn
+}
@@ -0,0 +1,112 @@
+package igd
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/huin/goupnp/clock"
+)
+
+// lockedMapper is a fakeMapper safe for use by the renewal goroutine.
+type lockedMapper struct {
+	lock sync.Mutex
+	fakeMapper
+}
+
+func (lm *lockedMapper) add(ctx context.Context, m Mapping, renewal bool) (Mapping, error) {
+	lm.lock.Lock()
+	defer lm.lock.Unlock()
+	return lm.fakeMapper.add(ctx, m, renewal)
+}
+
+func (lm *lockedMapper) setErr(err error) {
+	lm.lock.Lock()
+	lm.err = err
+	lm.lock.Unlock()
+}
+
+func TestPortMapperRenewalClock(t *testing.T) {
+	fc := clock.NewFake(time.Unix(1000, 0))
+	mapper := &lockedMapper{}
+	pm := newPortMapper(nil, mapper)
+	pm.SetClock(fc)
+	renewals := make(chan Renewal)
+	pm.SetRenewalFunc(func(r Renewal) { renewals <- r })
+	defer pm.Close()
+
+	if _, err := pm.Map(context.Background(), TCP, 80, 8080, "web", time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	fc.Advance(30 * time.Second)
+	if r := <-renewals; r.Err != nil || r.Failures != 0 || !r.Expires.Equal(time.Unix(1090, 0)) || r.Expired {
+		t.Errorf("got renewal %+v, want a successful renewal until 1090", r)
+	}
+
+	errFailed := errors.New("failed")
+	mapper.setErr(errFailed)
+	fc.Advance(30 * time.Second)
+	if r := <-renewals; r.Err != errFailed || r.Failures != 1 || r.Expired {
+		t.Errorf("got renewal %+v, want a first failure before expiry", r)
+	}
+	// Failed renewals are retried at half of what is left of the lease.
+	fc.Advance(15 * time.Second)
+	if r := <-renewals; r.Failures != 2 || r.Expired {
+		t.Errorf("got renewal %+v, want a second failure before expiry", r)
+	}
+	if pm.Err(TCP, 8080) != errFailed {
+		t.Errorf("Err() = %v, want the renewal error", pm.Err(TCP, 8080))
+	}
+
+	mapper.setErr(nil)
+	fc.Advance(7500 * time.Millisecond)
+	if r := <-renewals; r.Err != nil || r.Failures != 0 || r.Expired || !r.Expires.Equal(time.Unix(1142, 5e8)) {
+		t.Errorf("got renewal %+v, want a successful renewal until 1142.5", r)
+	}
+
+	// Retries are at least minRenewalRetry apart, until the lease expires.
+	mapper.setErr(errFailed)
+	fc.Advance(30 * time.Second)
+	<-renewals
+	fc.Advance(15 * time.Second)
+	<-renewals
+	fc.Advance(7500 * time.Millisecond)
+	<-renewals
+	fc.Advance(3750 * time.Millisecond)
+	<-renewals
+	fc.Advance(1875 * time.Millisecond)
+	<-renewals
+	fc.Advance(time.Second)
+	if r := <-renewals; r.Failures != 6 || r.Expired {
+		t.Errorf("got renewal %+v, want a sixth failure before expiry", r)
+	}
+	fc.Advance(time.Second)
+	if r := <-renewals; r.Failures != 7 || !r.Expired {
+		t.Errorf("got renewal %+v, want a seventh failure after expiry", r)
+	}
+}
+
+func TestPortMapperRenewalFuncPanic(t *testing.T) {
+	fc := clock.NewFake(time.Unix(1000, 0))
+	pm := newPortMapper(nil, &lockedMapper{})
+	pm.SetClock(fc)
+	renewals := make(chan Renewal)
+	pm.SetRenewalFunc(func(r Renewal) {
+		renewals <- r
+		panic("renewal callback")
+	})
+	defer pm.Close()
+
+	if _, err := pm.Map(context.Background(), TCP, 80, 8080, "web", time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	// The renewals go on after the function panics.
+	for i := 0; i < 2; i++ {
+		fc.Advance(30 * time.Second)
+		if r := <-renewals; r.Err != nil {
+			t.Errorf("got renewal %+v, want a successful renewal", r)
+		}
+	}
+}
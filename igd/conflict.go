@@ -0,0 +1,94 @@
+package igd
+
+import (
+	"context"
+	"fmt"
+)
+
+// errCodeConflictInMappingEntry is the error of AddPortMapping for an external
+// port mapped to another client.
+const errCodeConflictInMappingEntry = 718
+
+const (
+	// maxConflictPorts is the number of ports tried by ConflictNextPort.
+	maxConflictPorts = 16
+	// maxConflictRetries bounds the resolutions of conflicts reported by
+	// AddPortMapping for a mapping.
+	maxConflictRetries = 3
+)
+
+// ConflictStrategy is how a PortMapper resolves the conflict of a mapping with
+// an existing mapping of the same external port to another client. An
+// existing mapping of the same internal client and description is taken to be
+// a mapping of this host, and is updated with the new mapping.
+type ConflictStrategy int
+
+const (
+	// ConflictFail fails the mapping with a *ConflictError.
+	ConflictFail ConflictStrategy = iota
+	// ConflictNextPort tries the following external ports until a free one
+	// is found.
+	ConflictNextPort
+	// ConflictReplace deletes the existing mapping.
+	ConflictReplace
+)
+
+// ConflictError is returned by Map when the external port is mapped to
+// another client.
+type ConflictError struct {
+	// Existing is the existing mapping, of which only the RemoteHost,
+	// ExternalPort and Protocol are known if the gateway did not report it.
+	Existing Mapping
+}
+
+func (err *ConflictError) Error() string {
+	if err.Existing.InternalClient == "" {
+		return fmt.Sprintf("goupnp: %s port %d is already mapped", err.Existing.Protocol, err.Existing.ExternalPort)
+	}
+	return fmt.Sprintf("goupnp: %s port %d is already mapped to %s:%d (%q)", err.Existing.Protocol,
+		err.Existing.ExternalPort, err.Existing.InternalClient, err.Existing.InternalPort, err.Existing.Description)
+}
+
+// SetConflictStrategy sets how pm resolves conflicts with existing mappings on
+// a WAN connection service, ConflictFail by default. It must be called before
+// Map.
+func (pm *PortMapper) SetConflictStrategy(strategy ConflictStrategy) {
+	for _, m := range pm.mappers {
+		if um, ok := m.(*upnpMapper); ok {
+			um.conflictStrategy = strategy
+		}
+	}
+}
+
+// resolveConflict resolves the conflict of m with an existing mapping, if any,
+// updating the external port of m for ConflictNextPort. known is set if the
+// gateway has reported a conflict, which is then not doubted if the existing
+// mapping cannot be looked up.
+func (um *upnpMapper) resolveConflict(ctx context.Context, m *Mapping, known bool) error {
+	for tries := 1; ; tries++ {
+		existing, err := um.conn.GetSpecificPortMappingEntry(ctx, m.RemoteHost, m.ExternalPort, m.Protocol)
+		if err != nil {
+			if !known || isUPnPError(err, errCodeNoSuchEntryInArray) {
+				return nil
+			}
+			existing = Mapping{RemoteHost: m.RemoteHost, ExternalPort: m.ExternalPort, Protocol: m.Protocol}
+		}
+		if existing.InternalClient == m.InternalClient && existing.Description == m.Description {
+			return nil
+		}
+		switch um.conflictStrategy {
+		case ConflictReplace:
+			return um.delete(ctx, existing)
+		case ConflictNextPort:
+			if tries < maxConflictPorts {
+				m.ExternalPort++
+				if m.ExternalPort == 0 {
+					m.ExternalPort = 1
+				}
+				known = false
+				continue
+			}
+		}
+		return &ConflictError{Existing: existing}
+	}
+}
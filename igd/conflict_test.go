@@ -0,0 +1,90 @@
+package igd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/huin/goupnp/device/igdemu"
+	"github.com/huin/goupnp/soap"
+)
+
+func TestConflictStrategy(t *testing.T) {
+	e, pm := newTestPortMapper(t)
+	defer e.Close()
+	defer pm.Close()
+	ctx := context.Background()
+	other := Mapping{ExternalPort: 8080, Protocol: TCP, InternalPort: 80, InternalClient: "192.0.2.3", Enabled: true, Description: "other"}
+
+	if err := pm.Connection().AddPortMapping(ctx, other); err != nil {
+		t.Fatal(err)
+	}
+	_, err := pm.Map(ctx, TCP, 80, 8080, "web", 0)
+	if cerr, ok := err.(*ConflictError); !ok || cerr.Existing.InternalClient != "192.0.2.3" {
+		t.Fatalf("Map() = %v, want a ConflictError for the other mapping", err)
+	}
+
+	pm.SetConflictStrategy(ConflictNextPort)
+	if port, err := pm.Map(ctx, TCP, 80, 8080, "web", 0); err != nil || port != 8081 {
+		t.Errorf("Map() with ConflictNextPort = %d, %v, want 8081", port, err)
+	}
+	// A mapping of this host is reused whatever the strategy.
+	pm.SetConflictStrategy(ConflictFail)
+	if port, err := pm.Map(ctx, TCP, 80, 8081, "web", 0); err != nil || port != 8081 {
+		t.Errorf("Map() of an own mapping = %d, %v, want 8081", port, err)
+	}
+
+	pm.SetConflictStrategy(ConflictReplace)
+	if port, err := pm.Map(ctx, TCP, 80, 8080, "web", 0); err != nil || port != 8080 {
+		t.Errorf("Map() with ConflictReplace = %d, %v, want 8080", port, err)
+	}
+	m, err := pm.Connection().GetSpecificPortMappingEntry(ctx, "", 8080, TCP)
+	if err != nil || m.InternalClient != pm.InternalClient() {
+		t.Errorf("mapping of port 8080 = %+v, %v, want the replacing mapping", m, err)
+	}
+}
+
+func TestConflictAddAny(t *testing.T) {
+	e, pm := newEmulatedPortMapper(t, igdemu.NewIGD2, "urn:schemas-upnp-org:service:WANIPConnection:2")
+	defer e.Close()
+	defer pm.Close()
+	ctx := context.Background()
+	other := Mapping{ExternalPort: 8080, Protocol: TCP, InternalPort: 80, InternalClient: "192.0.2.3", Enabled: true, Description: "other"}
+	if err := pm.Connection().AddPortMapping(ctx, other); err != nil {
+		t.Fatal(err)
+	}
+
+	// The conflict is detected before AddAnyPortMapping could pick another
+	// port.
+	_, err := pm.Map(ctx, TCP, 80, 8080, "web", 0)
+	if cerr, ok := err.(*ConflictError); !ok || cerr.Existing.InternalClient != "192.0.2.3" {
+		t.Fatalf("Map() = %v, want a ConflictError for the other mapping", err)
+	}
+
+	// With ConflictNextPort, the gateway picks a free port with
+	// AddAnyPortMapping.
+	pm.SetConflictStrategy(ConflictNextPort)
+	port, err := pm.Map(ctx, TCP, 81, 8080, "web", 0)
+	if err != nil || port != 8081 {
+		t.Fatalf("Map() with ConflictNextPort = %d, %v, want the free port 8081 picked by the gateway", port, err)
+	}
+	mappings, err := pm.Connection().ListPortMappings(ctx)
+	if err != nil || len(mappings) != 2 {
+		t.Fatalf("ListPortMappings() = %+v, %v, want both mappings", mappings, err)
+	}
+
+	pm.SetConflictStrategy(ConflictReplace)
+	if port, err := pm.Map(ctx, TCP, 80, 8080, "web", 0); err != nil || port != 8080 {
+		t.Errorf("Map() with ConflictReplace = %d, %v, want 8080", port, err)
+	}
+	m, err := pm.Connection().GetSpecificPortMappingEntry(ctx, "", 8080, TCP)
+	if err != nil || m.InternalClient != pm.InternalClient() {
+		t.Errorf("mapping of port 8080 = %+v, %v, want the replacing mapping", m, err)
+	}
+
+	// Without AddAnyPortMapping, ConflictNextPort tries the following ports.
+	pm.SetConflictStrategy(ConflictNextPort)
+	e.InjectFault("AddAnyPortMapping", soap.NewUPnPError(soap.ErrCodeOptionalActionNotImplemented, "OptionalActionNotImplemented"))
+	if port, err := pm.Map(ctx, TCP, 82, 8080, "other web", 0); err != nil || port != 8082 {
+		t.Errorf("Map() with AddPortMapping and ConflictNextPort = %d, %v, want 8082", port, err)
+	}
+}
@@ -0,0 +1,45 @@
+package igd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+)
+
+// ExternalIP discovers the WAN connection services on the network, and returns
+// the external address of the best one that is connected, by the ranking of
+// CheckConnections, along with the connection service it came from.
+func ExternalIP(ctx context.Context) (net.IP, *Connection, error) {
+	conns, err := DiscoverConnections(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	return externalIP(ctx, conns)
+}
+
+func externalIP(ctx context.Context, conns []*Connection) (net.IP, *Connection, error) {
+	if len(conns) == 0 {
+		return nil, nil, errors.New("goupnp: no WAN connection service found")
+	}
+	var lastErr error
+	for _, c := range CheckConnections(ctx, conns) {
+		if c.Err != nil {
+			lastErr = c.Err
+			continue
+		}
+		if !c.Connected() {
+			continue
+		}
+		if c.ExternalIP == nil {
+			lastErr = fmt.Errorf("goupnp: gateway %q reported no valid external IP address",
+				c.Connection.ServiceClient().RootDevice.Device.FriendlyName)
+			continue
+		}
+		return c.ExternalIP, c.Connection, nil
+	}
+	if lastErr != nil {
+		return nil, nil, lastErr
+	}
+	return nil, nil, errors.New("goupnp: no connected WAN connection service found")
+}
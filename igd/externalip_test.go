@@ -0,0 +1,30 @@
+package igd
+
+import (
+	"context"
+	"testing"
+)
+
+func TestExternalIP(t *testing.T) {
+	e, pm := newTestPortMapper(t)
+	defer e.Close()
+	ctx := context.Background()
+	conns := []*Connection{pm.Connection()}
+
+	e.SetExternalIP("198.51.100.7")
+	ip, conn, err := externalIP(ctx, conns)
+	if err != nil || ip.String() != "198.51.100.7" || conn != pm.Connection() {
+		t.Errorf("externalIP() = %v, %v, %v", ip, conn, err)
+	}
+
+	e.SetExternalIP("0.0.0.0")
+	if ip, _, err := externalIP(ctx, conns); err == nil {
+		t.Errorf("externalIP() = %v, want an error for an unspecified address", ip)
+	}
+
+	e.SetExternalIP("198.51.100.7")
+	e.SetConnectionStatus("Disconnected")
+	if ip, _, err := externalIP(ctx, conns); err == nil {
+		t.Errorf("externalIP() = %v, want an error for a disconnected gateway", ip)
+	}
+}
@@ -0,0 +1,461 @@
+// Package igd manages port mappings on Internet Gateway Devices, whichever of
+// the WAN connection services they offer: WANIPConnection:1, WANIPConnection:2
+// or WANPPPConnection:1. It builds on the clients of the internetgateway2
+// package, which also talk to IGD v1 routers.
+package igd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"time"
+
+	"github.com/huin/goupnp"
+	"github.com/huin/goupnp/dcps/internetgateway2"
+	"github.com/huin/goupnp/internal/logging"
+	"github.com/huin/goupnp/upnperr"
+)
+
+// Protocol is the protocol of a port mapping.
+type Protocol string
+
+const (
+	TCP Protocol = "TCP"
+	UDP Protocol = "UDP"
+)
+
+// Mapping is a port mapping of a gateway.
+type Mapping struct {
+	// RemoteHost restricts the mapping to traffic from the host, or is empty
+	// for a mapping of traffic from any host.
+	RemoteHost     string
+	ExternalPort   uint16
+	Protocol       Protocol
+	InternalPort   uint16
+	InternalClient string
+	Enabled        bool
+	Description    string
+	// Lease is the lease of the mapping, with a granularity of a second, or
+	// zero for a permanent mapping.
+	Lease time.Duration
+}
+
+// ErrNotSupported is returned for actions that the type of the connection
+// service does not define, such as AddAnyPortMapping of WANIPConnection:1.
+var ErrNotSupported = errors.New("goupnp: action not supported by the connection service")
+
+// Error codes of the WAN connection services used by this package.
+const (
+	errCodeInvalidAction                = 401
+	errCodeOptionalActionNotImplemented = 602
+	errCodeNoSuchEntryInArray           = 714
+	errCodeSamePortValuesRequired       = 724
+	errCodeOnlyPermanentLeasesSupported = 725
+)
+
+// ConnectionTypes are the service types of the WAN connection services, in
+// the order of preference of DiscoverConnections.
+var ConnectionTypes = []string{
+	internetgateway2.URN_WANIPConnection_2,
+	internetgateway2.URN_WANIPConnection_1,
+	internetgateway2.URN_WANPPPConnection_1,
+}
+
+// Connection is a WAN connection service of a gateway. Its methods perform the
+// actions of the same names, with the arguments of the different service
+// types unified.
+type Connection struct {
+	sc     *goupnp.ServiceClient
+	client wanConnection
+}
+
+// NewConnection returns a Connection for sc, which must be a client of one of
+// the ConnectionTypes.
+func NewConnection(sc *goupnp.ServiceClient) (*Connection, error) {
+	switch sc.Service.ServiceType {
+	case internetgateway2.URN_WANIPConnection_1:
+		client := &internetgateway2.WANIPConnection1{ServiceClient: *sc}
+		return &Connection{&client.ServiceClient, wanIPConnection1{client}}, nil
+	case internetgateway2.URN_WANIPConnection_2:
+		client := &internetgateway2.WANIPConnection2{ServiceClient: *sc}
+		return &Connection{&client.ServiceClient, wanIPConnection2{client}}, nil
+	case internetgateway2.URN_WANPPPConnection_1:
+		client := &internetgateway2.WANPPPConnection1{ServiceClient: *sc}
+		return &Connection{&client.ServiceClient, wanPPPConnection1{client}}, nil
+	}
+	return nil, fmt.Errorf("goupnp: service type %q is not a WAN connection service", sc.Service.ServiceType)
+}
+
+// DiscoverConnections discovers the WAN connection services on the network,
+// and returns them in the order of ConnectionTypes. Gateways that fail to
+// describe themselves are skipped.
+func DiscoverConnections(ctx context.Context) ([]*Connection, error) {
+	found := make([][]goupnp.ServiceClient, len(ConnectionTypes))
+	actions := make([]func(context.Context) error, len(ConnectionTypes))
+	for i, searchTarget := range ConnectionTypes {
+		i, searchTarget := i, searchTarget
+		actions[i] = func(ctx context.Context) (err error) {
+			found[i], err = discoverServiceClients(ctx, searchTarget)
+			return
+		}
+	}
+	if err := goupnp.Batch(ctx, 0, actions...); err != nil {
+		return nil, err
+	}
+	var conns []*Connection
+	for _, clients := range found {
+		for i := range clients {
+			c, err := NewConnection(&clients[i])
+			if err != nil {
+				return nil, err
+			}
+			conns = append(conns, c)
+		}
+	}
+	return conns, nil
+}
+
+// ConnectionsByURL returns the WAN connection services of the gateway whose
+// device description is at loc, in the order of ConnectionTypes.
+func ConnectionsByURL(loc *url.URL) ([]*Connection, error) {
+	root, err := goupnp.DeviceByURL(loc)
+	if err != nil {
+		return nil, err
+	}
+	return ConnectionsFromRootDevice(root, loc)
+}
+
+// ConnectionsFromRootDevice returns the WAN connection services within root,
+// in the order of ConnectionTypes. loc is assigned to the Location of their
+// ServiceClients. An error is returned if there are none.
+func ConnectionsFromRootDevice(root *goupnp.RootDevice, loc *url.URL) ([]*Connection, error) {
+	var conns []*Connection
+	for _, searchTarget := range ConnectionTypes {
+		clients, err := goupnp.NewServiceClientsFromRootDevice(root, loc, searchTarget)
+		if err != nil {
+			continue
+		}
+		for i := range clients {
+			c, err := NewConnection(&clients[i])
+			if err != nil {
+				return nil, err
+			}
+			conns = append(conns, c)
+		}
+	}
+	if len(conns) == 0 {
+		return nil, fmt.Errorf("goupnp: no WAN connection service found within device %q", root.Device.FriendlyName)
+	}
+	return conns, nil
+}
+
+// discoverServiceClients is goupnp.NewServiceClients returning when ctx is
+// done, leaving the discovery to finish in the background.
+func discoverServiceClients(ctx context.Context, searchTarget string) ([]goupnp.ServiceClient, error) {
+	type result struct {
+		clients []goupnp.ServiceClient
+		err     error
+	}
+	done := make(chan result, 1)
+	go func() {
+		clients, errs, err := goupnp.NewServiceClientsCtx(ctx, searchTarget)
+		for _, err := range errs {
+			logging.Default().Debug("goupnp: skipping gateway", slog.String(logging.KeyService, searchTarget), logging.Err(err))
+		}
+		done <- result{clients, err}
+	}()
+	select {
+	case r := <-done:
+		return r.clients, r.err
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// ServiceClient returns the client of the service, e.g. to set its
+// ActionTimeouts.
+func (c *Connection) ServiceClient() *goupnp.ServiceClient {
+	return c.sc
+}
+
+// ServiceType returns the service type of the service, one of the
+// ConnectionTypes.
+func (c *Connection) ServiceType() string {
+	return c.sc.Service.ServiceType
+}
+
+// GetExternalIPAddress returns the external IP address of the connection.
+func (c *Connection) GetExternalIPAddress(ctx context.Context) (string, error) {
+	return c.client.getExternalIPAddress(ctx)
+}
+
+// GetStatusInfo returns the status of the connection, such as "Connected",
+// and how long it has been up.
+func (c *Connection) GetStatusInfo(ctx context.Context) (status string, uptime time.Duration, err error) {
+	status, _, seconds, err := c.client.getStatusInfo(ctx)
+	return status, time.Duration(seconds) * time.Second, err
+}
+
+// AddPortMapping adds m, or updates the mapping of the same RemoteHost,
+// ExternalPort and Protocol.
+func (c *Connection) AddPortMapping(ctx context.Context, m Mapping) error {
+	return c.client.addPortMapping(ctx, &m)
+}
+
+// AddAnyPortMapping adds m, or a mapping of another external port if the
+// gateway chooses to, and returns the external port of the mapping. It returns
+// ErrNotSupported for services other than WANIPConnection:2.
+func (c *Connection) AddAnyPortMapping(ctx context.Context, m Mapping) (uint16, error) {
+	return c.client.addAnyPortMapping(ctx, &m)
+}
+
+// DeletePortMapping deletes the mapping of remoteHost, externalPort and
+// protocol.
+func (c *Connection) DeletePortMapping(ctx context.Context, remoteHost string, externalPort uint16, protocol Protocol) error {
+	return c.client.deletePortMapping(ctx, remoteHost, externalPort, protocol)
+}
+
+// GetGenericPortMappingEntry returns the mapping at index in the mapping table
+// of the gateway.
+func (c *Connection) GetGenericPortMappingEntry(ctx context.Context, index uint16) (Mapping, error) {
+	return c.client.getGenericPortMappingEntry(ctx, index)
+}
+
+// GetSpecificPortMappingEntry returns the mapping of remoteHost, externalPort
+// and protocol.
+func (c *Connection) GetSpecificPortMappingEntry(ctx context.Context, remoteHost string, externalPort uint16, protocol Protocol) (Mapping, error) {
+	return c.client.getSpecificPortMappingEntry(ctx, remoteHost, externalPort, protocol)
+}
+
+func leaseSeconds(lease time.Duration) uint32 {
+	if lease <= 0 {
+		return 0
+	}
+	if lease < time.Second {
+		return 1
+	}
+	return uint32(lease / time.Second)
+}
+
+func secondsLease(seconds uint32) time.Duration {
+	return time.Duration(seconds) * time.Second
+}
+
+func isUPnPError(err error, codes ...int) bool {
+	got, ok := upnperr.FaultCode(err)
+	if !ok {
+		return false
+	}
+	for _, code := range codes {
+		if got == code {
+			return true
+		}
+	}
+	return false
+}
+
+// wanConnection are the actions of a WAN connection service used by
+// Connection.
+type wanConnection interface {
+	getExternalIPAddress(ctx context.Context) (string, error)
+	getStatusInfo(ctx context.Context) (status, lastError string, uptime uint32, err error)
+	getAutoDisconnectTime(ctx context.Context) (uint32, error)
+	getIdleDisconnectTime(ctx context.Context) (uint32, error)
+	addPortMapping(ctx context.Context, m *Mapping) error
+	addAnyPortMapping(ctx context.Context, m *Mapping) (uint16, error)
+	deletePortMapping(ctx context.Context, remoteHost string, externalPort uint16, protocol Protocol) error
+	getGenericPortMappingEntry(ctx context.Context, index uint16) (Mapping, error)
+	getSpecificPortMappingEntry(ctx context.Context, remoteHost string, externalPort uint16, protocol Protocol) (Mapping, error)
+	getListOfPortMappings(ctx context.Context, startPort, endPort uint16, protocol Protocol, manage bool, numberOfPorts uint16) (string, error)
+}
+
+type wanIPConnection1 struct {
+	c *internetgateway2.WANIPConnection1
+}
+
+func (w wanIPConnection1) getExternalIPAddress(ctx context.Context) (string, error) {
+	return w.c.GetExternalIPAddressCtx(ctx)
+}
+
+func (w wanIPConnection1) getStatusInfo(ctx context.Context) (string, string, uint32, error) {
+	status, lastError, uptime, err := w.c.GetStatusInfoCtx(ctx)
+	return string(status), string(lastError), uptime, err
+}
+
+func (w wanIPConnection1) getAutoDisconnectTime(ctx context.Context) (uint32, error) {
+	return w.c.GetAutoDisconnectTimeCtx(ctx)
+}
+
+func (w wanIPConnection1) getIdleDisconnectTime(ctx context.Context) (uint32, error) {
+	return w.c.GetIdleDisconnectTimeCtx(ctx)
+}
+
+func (w wanIPConnection1) addPortMapping(ctx context.Context, m *Mapping) error {
+	return w.c.AddPortMappingCtx(ctx, m.RemoteHost, m.ExternalPort,
+		internetgateway2.WANIPConnection1PortMappingProtocol(m.Protocol), m.InternalPort,
+		m.InternalClient, m.Enabled, m.Description, leaseSeconds(m.Lease))
+}
+
+func (w wanIPConnection1) addAnyPortMapping(ctx context.Context, m *Mapping) (uint16, error) {
+	return 0, ErrNotSupported
+}
+
+func (w wanIPConnection1) deletePortMapping(ctx context.Context, remoteHost string, externalPort uint16, protocol Protocol) error {
+	return w.c.DeletePortMappingCtx(ctx, remoteHost, externalPort,
+		internetgateway2.WANIPConnection1PortMappingProtocol(protocol))
+}
+
+func (w wanIPConnection1) getGenericPortMappingEntry(ctx context.Context, index uint16) (Mapping, error) {
+	var m Mapping
+	var protocol internetgateway2.WANIPConnection1PortMappingProtocol
+	var lease uint32
+	var err error
+	m.RemoteHost, m.ExternalPort, protocol, m.InternalPort, m.InternalClient, m.Enabled, m.Description, lease, err =
+		w.c.GetGenericPortMappingEntryCtx(ctx, index)
+	m.Protocol, m.Lease = Protocol(protocol), secondsLease(lease)
+	return m, err
+}
+
+func (w wanIPConnection1) getSpecificPortMappingEntry(ctx context.Context, remoteHost string, externalPort uint16, protocol Protocol) (Mapping, error) {
+	m := Mapping{RemoteHost: remoteHost, ExternalPort: externalPort, Protocol: protocol}
+	var lease uint32
+	var err error
+	m.InternalPort, m.InternalClient, m.Enabled, m.Description, lease, err =
+		w.c.GetSpecificPortMappingEntryCtx(ctx, remoteHost, externalPort,
+			internetgateway2.WANIPConnection1PortMappingProtocol(protocol))
+	m.Lease = secondsLease(lease)
+	return m, err
+}
+
+func (w wanIPConnection1) getListOfPortMappings(ctx context.Context, startPort, endPort uint16, protocol Protocol, manage bool, numberOfPorts uint16) (string, error) {
+	return "", ErrNotSupported
+}
+
+type wanIPConnection2 struct {
+	c *internetgateway2.WANIPConnection2
+}
+
+func (w wanIPConnection2) getExternalIPAddress(ctx context.Context) (string, error) {
+	return w.c.GetExternalIPAddressCtx(ctx)
+}
+
+func (w wanIPConnection2) getStatusInfo(ctx context.Context) (string, string, uint32, error) {
+	status, lastError, uptime, err := w.c.GetStatusInfoCtx(ctx)
+	return string(status), string(lastError), uptime, err
+}
+
+func (w wanIPConnection2) getAutoDisconnectTime(ctx context.Context) (uint32, error) {
+	return w.c.GetAutoDisconnectTimeCtx(ctx)
+}
+
+func (w wanIPConnection2) getIdleDisconnectTime(ctx context.Context) (uint32, error) {
+	return w.c.GetIdleDisconnectTimeCtx(ctx)
+}
+
+func (w wanIPConnection2) addPortMapping(ctx context.Context, m *Mapping) error {
+	return w.c.AddPortMappingCtx(ctx, m.RemoteHost, m.ExternalPort,
+		internetgateway2.WANIPConnection2PortMappingProtocol(m.Protocol), m.InternalPort,
+		m.InternalClient, m.Enabled, m.Description, leaseSeconds(m.Lease))
+}
+
+func (w wanIPConnection2) addAnyPortMapping(ctx context.Context, m *Mapping) (uint16, error) {
+	return w.c.AddAnyPortMappingCtx(ctx, m.RemoteHost, m.ExternalPort,
+		internetgateway2.WANIPConnection2PortMappingProtocol(m.Protocol), m.InternalPort,
+		m.InternalClient, m.Enabled, m.Description, leaseSeconds(m.Lease))
+}
+
+func (w wanIPConnection2) deletePortMapping(ctx context.Context, remoteHost string, externalPort uint16, protocol Protocol) error {
+	return w.c.DeletePortMappingCtx(ctx, remoteHost, externalPort,
+		internetgateway2.WANIPConnection2PortMappingProtocol(protocol))
+}
+
+func (w wanIPConnection2) getGenericPortMappingEntry(ctx context.Context, index uint16) (Mapping, error) {
+	var m Mapping
+	var protocol internetgateway2.WANIPConnection2PortMappingProtocol
+	var lease uint32
+	var err error
+	m.RemoteHost, m.ExternalPort, protocol, m.InternalPort, m.InternalClient, m.Enabled, m.Description, lease, err =
+		w.c.GetGenericPortMappingEntryCtx(ctx, index)
+	m.Protocol, m.Lease = Protocol(protocol), secondsLease(lease)
+	return m, err
+}
+
+func (w wanIPConnection2) getSpecificPortMappingEntry(ctx context.Context, remoteHost string, externalPort uint16, protocol Protocol) (Mapping, error) {
+	m := Mapping{RemoteHost: remoteHost, ExternalPort: externalPort, Protocol: protocol}
+	var lease uint32
+	var err error
+	m.InternalPort, m.InternalClient, m.Enabled, m.Description, lease, err =
+		w.c.GetSpecificPortMappingEntryCtx(ctx, remoteHost, externalPort,
+			internetgateway2.WANIPConnection2PortMappingProtocol(protocol))
+	m.Lease = secondsLease(lease)
+	return m, err
+}
+
+func (w wanIPConnection2) getListOfPortMappings(ctx context.Context, startPort, endPort uint16, protocol Protocol, manage bool, numberOfPorts uint16) (string, error) {
+	return w.c.GetListOfPortMappingsCtx(ctx, startPort, endPort,
+		internetgateway2.WANIPConnection2PortMappingProtocol(protocol), manage, numberOfPorts)
+}
+
+type wanPPPConnection1 struct {
+	c *internetgateway2.WANPPPConnection1
+}
+
+func (w wanPPPConnection1) getExternalIPAddress(ctx context.Context) (string, error) {
+	return w.c.GetExternalIPAddressCtx(ctx)
+}
+
+func (w wanPPPConnection1) getStatusInfo(ctx context.Context) (string, string, uint32, error) {
+	status, lastError, uptime, err := w.c.GetStatusInfoCtx(ctx)
+	return string(status), string(lastError), uptime, err
+}
+
+func (w wanPPPConnection1) getAutoDisconnectTime(ctx context.Context) (uint32, error) {
+	return w.c.GetAutoDisconnectTimeCtx(ctx)
+}
+
+func (w wanPPPConnection1) getIdleDisconnectTime(ctx context.Context) (uint32, error) {
+	return w.c.GetIdleDisconnectTimeCtx(ctx)
+}
+
+func (w wanPPPConnection1) addPortMapping(ctx context.Context, m *Mapping) error {
+	return w.c.AddPortMappingCtx(ctx, m.RemoteHost, m.ExternalPort,
+		internetgateway2.WANPPPConnection1PortMappingProtocol(m.Protocol), m.InternalPort,
+		m.InternalClient, m.Enabled, m.Description, leaseSeconds(m.Lease))
+}
+
+func (w wanPPPConnection1) addAnyPortMapping(ctx context.Context, m *Mapping) (uint16, error) {
+	return 0, ErrNotSupported
+}
+
+func (w wanPPPConnection1) deletePortMapping(ctx context.Context, remoteHost string, externalPort uint16, protocol Protocol) error {
+	return w.c.DeletePortMappingCtx(ctx, remoteHost, externalPort,
+		internetgateway2.WANPPPConnection1PortMappingProtocol(protocol))
+}
+
+func (w wanPPPConnection1) getGenericPortMappingEntry(ctx context.Context, index uint16) (Mapping, error) {
+	var m Mapping
+	var protocol internetgateway2.WANPPPConnection1PortMappingProtocol
+	var lease uint32
+	var err error
+	m.RemoteHost, m.ExternalPort, protocol, m.InternalPort, m.InternalClient, m.Enabled, m.Description, lease, err =
+		w.c.GetGenericPortMappingEntryCtx(ctx, index)
+	m.Protocol, m.Lease = Protocol(protocol), secondsLease(lease)
+	return m, err
+}
+
+func (w wanPPPConnection1) getSpecificPortMappingEntry(ctx context.Context, remoteHost string, externalPort uint16, protocol Protocol) (Mapping, error) {
+	m := Mapping{RemoteHost: remoteHost, ExternalPort: externalPort, Protocol: protocol}
+	var lease uint32
+	var err error
+	m.InternalPort, m.InternalClient, m.Enabled, m.Description, lease, err =
+		w.c.GetSpecificPortMappingEntryCtx(ctx, remoteHost, externalPort,
+			internetgateway2.WANPPPConnection1PortMappingProtocol(protocol))
+	m.Lease = secondsLease(lease)
+	return m, err
+}
+
+func (w wanPPPConnection1) getListOfPortMappings(ctx context.Context, startPort, endPort uint16, protocol Protocol, manage bool, numberOfPorts uint16) (string, error) {
+	return "", ErrNotSupported
+}
@@ -0,0 +1,141 @@
+package igd
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/huin/goupnp/soap"
+)
+
+// Error codes of GetListOfPortMappings and GetGenericPortMappingEntry.
+const (
+	errCodeInvalidArgs                = 402
+	errCodeActionFailed               = 501
+	errCodeActionNotAuthorized        = 606
+	errCodeSpecifiedArrayIndexInvalid = 713
+	errCodePortMappingNotFound        = 730
+)
+
+// maxGenericEntries bounds the iteration of GetGenericPortMappingEntry, in case
+// a gateway never reports the end of the table.
+const maxGenericEntries = 1024
+
+// GetListOfPortMappings returns the mappings of protocol with external ports
+// from startPort to endPort, at most numberOfPorts of them if it is not 0.
+// Unless manage is set, only the mappings of this host are listed. It returns
+// ErrNotSupported for services other than WANIPConnection:2.
+func (c *Connection) GetListOfPortMappings(ctx context.Context, startPort, endPort uint16, protocol Protocol, manage bool, numberOfPorts uint16) ([]Mapping, error) {
+	listing, err := c.client.getListOfPortMappings(ctx, startPort, endPort, protocol, manage, numberOfPorts)
+	if isUPnPError(err, errCodePortMappingNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return ParsePortListing(listing)
+}
+
+// ListPortMappings returns all the mappings of the gateway.
+//
+// On WANIPConnection:2 they are listed with GetListOfPortMappings. Otherwise,
+// or if the gateway refuses the listing, the mappings are read one at a time
+// with GetGenericPortMappingEntry until the gateway reports the end of the
+// table. Gateways that end the table with errors other than
+// SpecifiedArrayIndexInvalid, or that number it from 1, are handled.
+func (c *Connection) ListPortMappings(ctx context.Context) ([]Mapping, error) {
+	var mappings []Mapping
+	for _, protocol := range []Protocol{TCP, UDP} {
+		list, err := c.GetListOfPortMappings(ctx, 0, 65535, protocol, true, 0)
+		if err == ErrNotSupported || isUPnPError(err, errCodeInvalidAction, errCodeOptionalActionNotImplemented,
+			errCodeInvalidArgs, errCodeActionFailed, errCodeActionNotAuthorized) {
+			return c.listGenericPortMappings(ctx)
+		}
+		if err != nil {
+			return nil, err
+		}
+		mappings = append(mappings, list...)
+	}
+	return mappings, nil
+}
+
+func (c *Connection) listGenericPortMappings(ctx context.Context) ([]Mapping, error) {
+	var mappings []Mapping
+	for index := uint16(0); index < maxGenericEntries; index++ {
+		m, err := c.GetGenericPortMappingEntry(ctx, index)
+		if isEndOfTable(err) {
+			if index == 0 {
+				// Some gateways number the table from 1.
+				if m, err = c.GetGenericPortMappingEntry(ctx, 1); err == nil {
+					mappings = append(mappings, m)
+					index++
+					continue
+				}
+			}
+			break
+		}
+		if err != nil {
+			return nil, err
+		}
+		mappings = append(mappings, m)
+	}
+	return mappings, nil
+}
+
+// isEndOfTable returns whether err from GetGenericPortMappingEntry marks the
+// end of the mapping table. The specification has SpecifiedArrayIndexInvalid,
+// but gateways also use these others.
+func isEndOfTable(err error) bool {
+	return isUPnPError(err, errCodeSpecifiedArrayIndexInvalid, errCodeNoSuchEntryInArray,
+		errCodeInvalidArgs, errCodeActionFailed)
+}
+
+// ParsePortListing parses the PortListing XML returned by
+// GetListOfPortMappings.
+func ParsePortListing(listing string) ([]Mapping, error) {
+	var doc struct {
+		Entries []struct {
+			RemoteHost     string `xml:"NewRemoteHost"`
+			ExternalPort   string `xml:"NewExternalPort"`
+			Protocol       string `xml:"NewProtocol"`
+			InternalPort   string `xml:"NewInternalPort"`
+			InternalClient string `xml:"NewInternalClient"`
+			Enabled        string `xml:"NewEnabled"`
+			Description    string `xml:"NewDescription"`
+			LeaseTime      string `xml:"NewLeaseTime"`
+		} `xml:"PortMappingEntry"`
+	}
+	if err := xml.Unmarshal([]byte(listing), &doc); err != nil {
+		return nil, fmt.Errorf("goupnp: error decoding port listing: %v", err)
+	}
+	mappings := make([]Mapping, 0, len(doc.Entries))
+	for _, e := range doc.Entries {
+		m := Mapping{
+			RemoteHost:     strings.TrimSpace(e.RemoteHost),
+			Protocol:       Protocol(strings.ToUpper(strings.TrimSpace(e.Protocol))),
+			InternalClient: strings.TrimSpace(e.InternalClient),
+			Description:    e.Description,
+		}
+		var err error
+		if m.ExternalPort, err = soap.UnmarshalUi2(strings.TrimSpace(e.ExternalPort)); err != nil {
+			return nil, fmt.Errorf("goupnp: invalid external port in port listing: %v", err)
+		}
+		if m.InternalPort, err = soap.UnmarshalUi2(strings.TrimSpace(e.InternalPort)); err != nil {
+			return nil, fmt.Errorf("goupnp: invalid internal port in port listing: %v", err)
+		}
+		if m.Enabled, err = soap.UnmarshalBoolean(strings.TrimSpace(e.Enabled)); err != nil {
+			return nil, fmt.Errorf("goupnp: invalid enabled flag in port listing: %v", err)
+		}
+		if lease := strings.TrimSpace(e.LeaseTime); lease != "" {
+			seconds, err := strconv.ParseUint(lease, 10, 32)
+			if err != nil {
+				return nil, fmt.Errorf("goupnp: invalid lease time in port listing: %v", err)
+			}
+			m.Lease = secondsLease(uint32(seconds))
+		}
+		mappings = append(mappings, m)
+	}
+	return mappings, nil
+}
@@ -0,0 +1,63 @@
+package igd
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestListPortMappings(t *testing.T) {
+	e, pm := newTestPortMapper(t)
+	defer e.Close()
+	defer pm.Close()
+	ctx := context.Background()
+
+	if list, err := pm.Connection().ListPortMappings(ctx); err != nil || len(list) != 0 {
+		t.Fatalf("ListPortMappings() = %+v, %v, want no mappings", list, err)
+	}
+	for _, port := range []uint16{8080, 8081, 8082} {
+		if _, err := pm.Map(ctx, TCP, 80, port, "web", 0); err != nil {
+			t.Fatal(err)
+		}
+	}
+	list, err := pm.Connection().ListPortMappings(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list) != 3 || list[2].ExternalPort != 8082 || list[2].InternalClient != pm.InternalClient() {
+		t.Errorf("ListPortMappings() = %+v", list)
+	}
+}
+
+func TestParsePortListing(t *testing.T) {
+	listing := `<?xml version="1.0"?>
+<p:PortMappingList xmlns:p="urn:schemas-upnp-org:gw:WANIPConnection">
+<p:PortMappingEntry>
+<p:NewRemoteHost></p:NewRemoteHost>
+<p:NewExternalPort>8080</p:NewExternalPort>
+<p:NewProtocol>TCP</p:NewProtocol>
+<p:NewInternalPort>80</p:NewInternalPort>
+<p:NewInternalClient>192.168.1.2</p:NewInternalClient>
+<p:NewEnabled>1</p:NewEnabled>
+<p:NewDescription>web</p:NewDescription>
+<p:NewLeaseTime>3600</p:NewLeaseTime>
+</p:PortMappingEntry>
+</p:PortMappingList>`
+	got, err := ParsePortListing(listing)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Mapping{{
+		ExternalPort:   8080,
+		Protocol:       TCP,
+		InternalPort:   80,
+		InternalClient: "192.168.1.2",
+		Enabled:        true,
+		Description:    "web",
+		Lease:          time.Hour,
+	}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ParsePortListing() = %+v, want %+v", got, want)
+	}
+}
@@ -0,0 +1,156 @@
+package igd
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"time"
+
+	"github.com/huin/goupnp/igd/natpmp"
+)
+
+// natpmpPermanentLease is the lease of the mappings requested as permanent
+// from NAT-PMP and PCP, which have no permanent mappings.
+const natpmpPermanentLease = 2 * time.Hour
+
+// mapper is a protocol that a PortMapper maps ports with.
+type mapper interface {
+	// internalClient returns the address of this host that ports are mapped
+	// to.
+	internalClient() string
+	// add adds m, or renews it if renewal is set, and returns the mapping
+	// that the gateway granted.
+	add(ctx context.Context, m Mapping, renewal bool) (Mapping, error)
+	delete(ctx context.Context, m Mapping) error
+}
+
+// upnpMapper maps ports with a WAN connection service.
+type upnpMapper struct {
+	conn             *Connection
+	local            string
+	conflictStrategy ConflictStrategy
+}
+
+func newUPnPMapper(conn *Connection) (*upnpMapper, error) {
+	local, err := localAddress(conn.ServiceClient().SOAPClient.EndpointURL.Hostname())
+	if err != nil {
+		return nil, fmt.Errorf("goupnp: error finding the address of this host towards the gateway: %v", err)
+	}
+	return &upnpMapper{conn: conn, local: local}, nil
+}
+
+func (um *upnpMapper) internalClient() string {
+	return um.local
+}
+
+// add adds m, with AddAnyPortMapping for the ConflictNextPort strategy unless
+// it is a renewal or the service does not support it. AddAnyPortMapping
+// leaves conflicts with existing mappings to the gateway, which picks a free
+// external port. Otherwise they are resolved by the conflict strategy, before
+// AddPortMapping and once it reports one, except for renewals.
+func (um *upnpMapper) add(ctx context.Context, m Mapping, renewal bool) (Mapping, error) {
+	m.InternalClient = um.local
+	useAny := !renewal && um.conflictStrategy == ConflictNextPort
+	checked := renewal
+	conflicts := 0
+	for {
+		var err error
+		if useAny {
+			var port uint16
+			if port, err = um.conn.AddAnyPortMapping(ctx, m); err == nil {
+				m.ExternalPort = port
+				return m, nil
+			}
+			if err == ErrNotSupported || isUPnPError(err, errCodeInvalidAction, errCodeOptionalActionNotImplemented) {
+				useAny = false
+				continue
+			}
+		} else {
+			if !checked {
+				checked = true
+				if err := um.resolveConflict(ctx, &m, false); err != nil {
+					return m, err
+				}
+			}
+			if err = um.conn.AddPortMapping(ctx, m); err == nil {
+				return m, nil
+			}
+		}
+		switch {
+		case m.Lease != 0 && isUPnPError(err, errCodeOnlyPermanentLeasesSupported):
+			m.Lease = 0
+		case m.ExternalPort != m.InternalPort && isUPnPError(err, errCodeSamePortValuesRequired):
+			m.ExternalPort = m.InternalPort
+		case !renewal && conflicts < maxConflictRetries && isUPnPError(err, errCodeConflictInMappingEntry):
+			conflicts++
+			if err := um.resolveConflict(ctx, &m, true); err != nil {
+				return m, err
+			}
+		default:
+			return m, err
+		}
+	}
+}
+
+func (um *upnpMapper) delete(ctx context.Context, m Mapping) error {
+	err := um.conn.DeletePortMapping(ctx, m.RemoteHost, m.ExternalPort, m.Protocol)
+	if isUPnPError(err, errCodeNoSuchEntryInArray) {
+		return nil
+	}
+	return err
+}
+
+// natpmpMapper maps ports with NAT-PMP or PCP.
+type natpmpMapper struct {
+	client *natpmp.Client
+	local  string
+}
+
+func newNATPMPMapper(gateway net.IP) (*natpmpMapper, error) {
+	local, err := localAddress(gateway.String())
+	if err != nil {
+		return nil, fmt.Errorf("goupnp: error finding the address of this host towards the gateway: %v", err)
+	}
+	return &natpmpMapper{natpmp.NewClient(gateway), local}, nil
+}
+
+func (nm *natpmpMapper) internalClient() string {
+	return nm.local
+}
+
+func (nm *natpmpMapper) add(ctx context.Context, m Mapping, renewal bool) (Mapping, error) {
+	lease := m.Lease
+	if lease == 0 {
+		lease = natpmpPermanentLease
+	}
+	granted, err := nm.client.AddPortMapping(ctx, natpmpProtocol(m.Protocol), m.InternalPort, m.ExternalPort, lease)
+	if err != nil {
+		return m, err
+	}
+	m.InternalClient = nm.local
+	m.ExternalPort = granted.ExternalPort
+	m.Lease = granted.Lifetime
+	return m, nil
+}
+
+func (nm *natpmpMapper) delete(ctx context.Context, m Mapping) error {
+	return nm.client.DeletePortMapping(ctx, natpmpProtocol(m.Protocol), m.InternalPort)
+}
+
+func natpmpProtocol(protocol Protocol) natpmp.Protocol {
+	if protocol == UDP {
+		return natpmp.UDP
+	}
+	return natpmp.TCP
+}
+
+// localAddress returns the local address of the interface that reaches host.
+// No packets are sent.
+func localAddress(host string) (string, error) {
+	conn, err := net.Dial("udp", net.JoinHostPort(host, "9"))
+	if err != nil {
+		return "", err
+	}
+	defer conn.Close()
+	return conn.LocalAddr().(*net.UDPAddr).IP.String(), nil
+}
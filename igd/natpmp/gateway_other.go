@@ -0,0 +1,16 @@
+//go:build !linux
+// +build !linux
+
+package natpmp
+
+import (
+	"errors"
+	"net"
+)
+
+// DefaultGateway returns the IPv4 default gateway of this host. It is only
+// implemented on Linux; elsewhere, pass the address of the gateway to
+// NewClient.
+func DefaultGateway() (net.IP, error) {
+	return nil, errors.New("goupnp: finding the default gateway is not supported on this platform")
+}
@@ -0,0 +1,316 @@
+// Package natpmp is a client of NAT-PMP (RFC 6886) and of the MAP opcode of
+// its successor PCP (RFC 6887), the port mapping protocols of routers that do
+// not offer UPnP.
+//
+// A Client speaks PCP to the gateway, and falls back to NAT-PMP if the gateway
+// answers that it does not support PCP.
+package natpmp
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// Port is the UDP port of NAT-PMP and PCP servers.
+const Port = 5351
+
+// Protocol is the IANA protocol number of a mapping.
+type Protocol uint8
+
+const (
+	TCP Protocol = 6
+	UDP Protocol = 17
+)
+
+const (
+	versionNATPMP = 0
+	versionPCP    = 2
+
+	opExternalAddress = 0
+	opMapUDP          = 1
+	opMapTCP          = 2
+	opPCPMap          = 1
+	opResponse        = 0x80
+
+	resultUnsupportedVersion = 1
+
+	initialTimeout = 250 * time.Millisecond
+	maxTries       = 9
+)
+
+// ResultError is a non-zero result code of a response of the gateway.
+type ResultError struct {
+	// Version is 0 for a NAT-PMP response, 2 for a PCP response.
+	Version int
+	Code    int
+}
+
+func (err *ResultError) Error() string {
+	protocol := "NAT-PMP"
+	if err.Version == versionPCP {
+		protocol = "PCP"
+	}
+	return fmt.Sprintf("goupnp: %s result code %d", protocol, err.Code)
+}
+
+// Mapping is a port mapping granted by the gateway.
+type Mapping struct {
+	Protocol     Protocol
+	InternalPort uint16
+	ExternalPort uint16
+	// ExternalIP is the external address of the mapping, only reported over
+	// PCP.
+	ExternalIP net.IP
+	// Lifetime is the lifetime granted by the gateway, after which the
+	// mapping must have been renewed.
+	Lifetime time.Duration
+}
+
+// Client is a NAT-PMP and PCP client of a gateway.
+type Client struct {
+	gateway net.IP
+	port    int
+
+	lock       sync.Mutex // Protects all below.
+	natpmpOnly bool
+	nonces     map[mappingKey][12]byte
+}
+
+type mappingKey struct {
+	protocol     Protocol
+	internalPort uint16
+}
+
+// NewClient returns a Client of the gateway, e.g. one returned by
+// DefaultGateway.
+func NewClient(gateway net.IP) *Client {
+	return &Client{
+		gateway: gateway,
+		port:    Port,
+		nonces:  make(map[mappingKey][12]byte),
+	}
+}
+
+// Gateway returns the address of the gateway of c.
+func (c *Client) Gateway() net.IP {
+	return c.gateway
+}
+
+// ExternalAddress returns the external address of the gateway, with the
+// NAT-PMP external address request.
+func (c *Client) ExternalAddress(ctx context.Context) (net.IP, error) {
+	resp, err := c.roundTrip(ctx, []byte{versionNATPMP, opExternalAddress}, versionNATPMP, opExternalAddress, 12)
+	if err != nil {
+		return nil, err
+	}
+	return net.IPv4(resp[8], resp[9], resp[10], resp[11]), nil
+}
+
+// AddPortMapping maps an external port of the gateway, preferably
+// suggestedExternalPort, to internalPort of this host, for lifetime. The
+// mapping must be renewed, by calling AddPortMapping again, before the
+// lifetime it was granted expires.
+func (c *Client) AddPortMapping(ctx context.Context, protocol Protocol, internalPort, suggestedExternalPort uint16, lifetime time.Duration) (Mapping, error) {
+	if lifetime < time.Second {
+		return Mapping{}, errors.New("goupnp: NAT-PMP mapping lifetime must be at least a second")
+	}
+	return c.portMapping(ctx, protocol, internalPort, suggestedExternalPort, uint32(lifetime/time.Second))
+}
+
+// DeletePortMapping deletes the mapping of internalPort of this host.
+func (c *Client) DeletePortMapping(ctx context.Context, protocol Protocol, internalPort uint16) error {
+	_, err := c.portMapping(ctx, protocol, internalPort, 0, 0)
+	if err == nil {
+		c.lock.Lock()
+		delete(c.nonces, mappingKey{protocol, internalPort})
+		c.lock.Unlock()
+	}
+	return err
+}
+
+func (c *Client) portMapping(ctx context.Context, protocol Protocol, internalPort, externalPort uint16, lifetime uint32) (Mapping, error) {
+	c.lock.Lock()
+	natpmpOnly := c.natpmpOnly
+	c.lock.Unlock()
+	if !natpmpOnly {
+		m, err := c.pcpMap(ctx, protocol, internalPort, externalPort, lifetime)
+		if rerr, ok := err.(*ResultError); !ok || rerr.Version != versionNATPMP || rerr.Code != resultUnsupportedVersion {
+			return m, err
+		}
+		c.lock.Lock()
+		c.natpmpOnly = true
+		c.lock.Unlock()
+	}
+	return c.natpmpMap(ctx, protocol, internalPort, externalPort, lifetime)
+}
+
+func (c *Client) natpmpMap(ctx context.Context, protocol Protocol, internalPort, externalPort uint16, lifetime uint32) (Mapping, error) {
+	op := byte(opMapTCP)
+	if protocol == UDP {
+		op = opMapUDP
+	}
+	req := make([]byte, 12)
+	req[0], req[1] = versionNATPMP, op
+	binary.BigEndian.PutUint16(req[4:], internalPort)
+	binary.BigEndian.PutUint16(req[6:], externalPort)
+	binary.BigEndian.PutUint32(req[8:], lifetime)
+	resp, err := c.roundTrip(ctx, req, versionNATPMP, op, 16)
+	if err != nil {
+		return Mapping{}, err
+	}
+	return Mapping{
+		Protocol:     protocol,
+		InternalPort: binary.BigEndian.Uint16(resp[8:]),
+		ExternalPort: binary.BigEndian.Uint16(resp[10:]),
+		Lifetime:     time.Duration(binary.BigEndian.Uint32(resp[12:])) * time.Second,
+	}, nil
+}
+
+func (c *Client) pcpMap(ctx context.Context, protocol Protocol, internalPort, externalPort uint16, lifetime uint32) (Mapping, error) {
+	conn, err := c.dial()
+	if err != nil {
+		return Mapping{}, err
+	}
+	defer conn.Close()
+
+	// The nonce identifies this host as the owner of the mapping, so it is
+	// reused for the renewals and deletion of the mapping.
+	key := mappingKey{protocol, internalPort}
+	c.lock.Lock()
+	nonce, ok := c.nonces[key]
+	if !ok {
+		if _, err := rand.Read(nonce[:]); err != nil {
+			c.lock.Unlock()
+			return Mapping{}, err
+		}
+		c.nonces[key] = nonce
+	}
+	c.lock.Unlock()
+
+	req := make([]byte, 60)
+	req[0], req[1] = versionPCP, opPCPMap
+	binary.BigEndian.PutUint32(req[4:], lifetime)
+	copy(req[8:24], conn.LocalAddr().(*net.UDPAddr).IP.To16())
+	copy(req[24:36], nonce[:])
+	req[36] = byte(protocol)
+	binary.BigEndian.PutUint16(req[40:], internalPort)
+	binary.BigEndian.PutUint16(req[42:], externalPort)
+	// No preference for the external address, other than being IPv4.
+	copy(req[44:60], net.IPv4zero.To16())
+
+	resp, err := exchange(ctx, conn, req, func(resp []byte) bool {
+		return len(resp) >= 60 && resp[1] == opResponse|opPCPMap && string(resp[24:36]) == string(nonce[:]) ||
+			len(resp) >= 4 && resp[0] == versionNATPMP
+	})
+	if err != nil {
+		return Mapping{}, err
+	}
+	if resp[0] == versionNATPMP {
+		return Mapping{}, &ResultError{Version: versionNATPMP, Code: int(binary.BigEndian.Uint16(resp[2:]))}
+	}
+	if code := resp[3]; code != 0 {
+		return Mapping{}, &ResultError{Version: versionPCP, Code: int(code)}
+	}
+	return Mapping{
+		Protocol:     protocol,
+		InternalPort: binary.BigEndian.Uint16(resp[40:]),
+		ExternalPort: binary.BigEndian.Uint16(resp[42:]),
+		ExternalIP:   net.IP(append([]byte(nil), resp[44:60]...)),
+		Lifetime:     time.Duration(binary.BigEndian.Uint32(resp[4:])) * time.Second,
+	}, nil
+}
+
+// roundTrip sends a NAT-PMP request, and returns the response of the opcode,
+// of at least size bytes.
+func (c *Client) roundTrip(ctx context.Context, req []byte, version, op byte, size int) ([]byte, error) {
+	conn, err := c.dial()
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	resp, err := exchange(ctx, conn, req, func(resp []byte) bool {
+		return len(resp) >= 4 && resp[0] == version && resp[1] == opResponse|op
+	})
+	if err != nil {
+		return nil, err
+	}
+	if code := binary.BigEndian.Uint16(resp[2:]); code != 0 {
+		return nil, &ResultError{Version: versionNATPMP, Code: int(code)}
+	}
+	if len(resp) < size {
+		return nil, fmt.Errorf("goupnp: short NAT-PMP response of %d bytes", len(resp))
+	}
+	return resp, nil
+}
+
+func (c *Client) dial() (*net.UDPConn, error) {
+	addr := net.JoinHostPort(c.gateway.String(), strconv.Itoa(c.port))
+	raddr, err := net.ResolveUDPAddr("udp", addr)
+	if err != nil {
+		return nil, err
+	}
+	return net.DialUDP("udp", nil, raddr)
+}
+
+// exchange sends req until a response for which match returns true is
+// received, retransmitting it with the doubling timeouts of RFC 6886.
+func exchange(ctx context.Context, conn *net.UDPConn, req []byte, match func([]byte) bool) ([]byte, error) {
+	// Unblock the read when ctx is done.
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.SetReadDeadline(time.Now())
+		case <-done:
+		}
+	}()
+
+	buf := make([]byte, 1100)
+	timeout := initialTimeout
+	for try := 0; try < maxTries; try++ {
+		if _, err := conn.Write(req); err != nil {
+			return nil, err
+		}
+		deadline := time.Now().Add(timeout)
+		if d, ok := ctx.Deadline(); ok && d.Before(deadline) {
+			deadline = d
+		}
+		conn.SetReadDeadline(deadline)
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		for {
+			n, err := conn.Read(buf)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil, ctx.Err()
+				}
+				if nerr, ok := err.(net.Error); ok && nerr.Timeout() {
+					if d, ok := ctx.Deadline(); ok && !time.Now().Before(d) {
+						// The read timed out at the deadline of ctx, which
+						// may not be marked done yet.
+						return nil, context.DeadlineExceeded
+					}
+					break
+				}
+				return nil, err
+			}
+			if match(buf[:n]) {
+				return append([]byte(nil), buf[:n]...), nil
+			}
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		timeout *= 2
+	}
+	return nil, errors.New("goupnp: no NAT-PMP or PCP response from the gateway")
+}
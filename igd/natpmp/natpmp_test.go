@@ -0,0 +1,128 @@
+package natpmp
+
+import (
+	"context"
+	"encoding/binary"
+	"net"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeGateway is a NAT-PMP server, which also speaks PCP if pcp is set.
+type fakeGateway struct {
+	conn *net.UDPConn
+	pcp  bool
+
+	lock     sync.Mutex
+	mappings map[uint16]uint32 // Lifetime by internal port.
+}
+
+func newFakeGateway(t *testing.T, pcp bool) (*fakeGateway, *Client) {
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	g := &fakeGateway{conn: conn, pcp: pcp, mappings: make(map[uint16]uint32)}
+	go g.serve()
+	c := NewClient(net.IPv4(127, 0, 0, 1))
+	c.port = conn.LocalAddr().(*net.UDPAddr).Port
+	return g, c
+}
+
+func (g *fakeGateway) serve() {
+	buf := make([]byte, 1100)
+	for {
+		n, addr, err := g.conn.ReadFromUDP(buf)
+		if err != nil {
+			return
+		}
+		if resp := g.respond(buf[:n]); resp != nil {
+			g.conn.WriteToUDP(resp, addr)
+		}
+	}
+}
+
+func (g *fakeGateway) respond(req []byte) []byte {
+	g.lock.Lock()
+	defer g.lock.Unlock()
+	switch {
+	case req[0] == versionPCP && g.pcp:
+		resp := make([]byte, 60)
+		copy(resp, req)
+		resp[1] |= opResponse
+		internalPort := binary.BigEndian.Uint16(req[40:])
+		g.mappings[internalPort] = binary.BigEndian.Uint32(req[4:])
+		binary.BigEndian.PutUint16(resp[42:], internalPort+1000)
+		copy(resp[44:60], net.IPv4(198, 51, 100, 7).To16())
+		return resp
+	case req[0] != versionNATPMP:
+		return []byte{versionNATPMP, req[1] | opResponse, 0, resultUnsupportedVersion, 0, 0, 0, 0}
+	case req[1] == opExternalAddress:
+		return []byte{versionNATPMP, opResponse, 0, 0, 0, 0, 0, 1, 198, 51, 100, 7}
+	}
+	resp := make([]byte, 16)
+	resp[1] = req[1] | opResponse
+	internalPort := binary.BigEndian.Uint16(req[4:])
+	lifetime := binary.BigEndian.Uint32(req[8:])
+	g.mappings[internalPort] = lifetime
+	binary.BigEndian.PutUint16(resp[8:], internalPort)
+	binary.BigEndian.PutUint16(resp[10:], internalPort+1000)
+	binary.BigEndian.PutUint32(resp[12:], lifetime)
+	return resp
+}
+
+func TestClient(t *testing.T) {
+	for _, pcp := range []bool{true, false} {
+		g, c := newFakeGateway(t, pcp)
+		ctx := context.Background()
+
+		m, err := c.AddPortMapping(ctx, TCP, 80, 80, time.Hour)
+		if err != nil {
+			t.Fatalf("pcp=%t: %v", pcp, err)
+		}
+		if m.ExternalPort != 1080 || m.Lifetime != time.Hour {
+			t.Errorf("pcp=%t: AddPortMapping() = %+v", pcp, m)
+		}
+		if pcp && !m.ExternalIP.Equal(net.IPv4(198, 51, 100, 7)) {
+			t.Errorf("pcp=%t: AddPortMapping() = %+v, want the external address", pcp, m)
+		}
+		if c.natpmpOnly == pcp {
+			t.Errorf("pcp=%t: client fell back to NAT-PMP: %t", pcp, c.natpmpOnly)
+		}
+		if err := c.DeletePortMapping(ctx, TCP, 80); err != nil {
+			t.Fatal(err)
+		}
+		g.lock.Lock()
+		lifetime := g.mappings[80]
+		g.lock.Unlock()
+		if lifetime != 0 {
+			t.Errorf("pcp=%t: mapping not deleted", pcp)
+		}
+		g.conn.Close()
+	}
+}
+
+func TestExternalAddress(t *testing.T) {
+	g, c := newFakeGateway(t, false)
+	defer g.conn.Close()
+	ip, err := c.ExternalAddress(context.Background())
+	if err != nil || !ip.Equal(net.IPv4(198, 51, 100, 7)) {
+		t.Errorf("ExternalAddress() = %v, %v", ip, err)
+	}
+}
+
+func TestNoResponse(t *testing.T) {
+	conn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	c := NewClient(net.IPv4(127, 0, 0, 1))
+	c.port = conn.LocalAddr().(*net.UDPAddr).Port
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	if _, err := c.ExternalAddress(ctx); err != context.DeadlineExceeded {
+		t.Errorf("ExternalAddress() = %v, want context.DeadlineExceeded", err)
+	}
+}
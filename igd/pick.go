@@ -0,0 +1,156 @@
+package igd
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sort"
+	"time"
+
+	"github.com/huin/goupnp"
+)
+
+// RouterCandidate is a WAN connection service as checked by CheckConnections.
+type RouterCandidate struct {
+	Connection *Connection
+	// Status is the connection status from GetStatusInfo, such as
+	// "Connected".
+	Status string
+	// ExternalIP is the address from GetExternalIPAddress, or nil if it was
+	// missing or invalid.
+	ExternalIP net.IP
+	// RTT is the time GetExternalIPAddress took.
+	RTT time.Duration
+	// Err is the error of GetStatusInfo or GetExternalIPAddress, if any.
+	Err error
+}
+
+// Connected returns whether the candidate answered and reports being
+// connected.
+func (c *RouterCandidate) Connected() bool {
+	return c.Err == nil && c.Status == "Connected"
+}
+
+// PublicIP returns whether the external address of the candidate is a public
+// one, rather than a private or carrier-grade NAT address of a router behind
+// another NAT.
+func (c *RouterCandidate) PublicIP() bool {
+	if c.ExternalIP == nil || c.ExternalIP.IsUnspecified() || c.ExternalIP.IsLoopback() {
+		return false
+	}
+	for _, n := range nonPublicNets {
+		if n.Contains(c.ExternalIP) {
+			return false
+		}
+	}
+	return true
+}
+
+var nonPublicNets = func() []*net.IPNet {
+	var nets []*net.IPNet
+	for _, s := range []string{
+		"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16", // RFC 1918
+		"100.64.0.0/10",  // RFC 6598 carrier-grade NAT
+		"169.254.0.0/16", // RFC 3927 link-local
+		"fc00::/7",       // RFC 4193 unique local
+		"fe80::/10",      // link-local
+	} {
+		_, n, _ := net.ParseCIDR(s)
+		nets = append(nets, n)
+	}
+	return nets
+}()
+
+// PickRouterClient discovers the WAN connection services on the network, and
+// returns the best one by the ranking of CheckConnections. An error is
+// returned if none is connected.
+func PickRouterClient(ctx context.Context) (*Connection, error) {
+	conns, err := DiscoverConnections(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return pickRouterClient(ctx, conns)
+}
+
+func pickRouterClient(ctx context.Context, conns []*Connection) (*Connection, error) {
+	if len(conns) == 0 {
+		return nil, errors.New("goupnp: no WAN connection service found")
+	}
+	candidates := CheckConnections(ctx, conns)
+	if !candidates[0].Connected() {
+		if err := candidates[0].Err; err != nil {
+			return nil, err
+		}
+		return nil, errors.New("goupnp: no connected WAN connection service found")
+	}
+	return candidates[0].Connection, nil
+}
+
+// CheckConnections checks conns concurrently with GetStatusInfo and
+// GetExternalIPAddress, and returns them ranked from best to worst: those that
+// are connected first, then those with a valid external address, a public
+// one, of the most preferred of the ConnectionTypes, and with the lowest RTT.
+func CheckConnections(ctx context.Context, conns []*Connection) []RouterCandidate {
+	candidates := make([]RouterCandidate, len(conns))
+	actions := make([]func(context.Context) error, len(conns))
+	for i, conn := range conns {
+		c := &candidates[i]
+		c.Connection = conn
+		actions[i] = func(ctx context.Context) error {
+			c.check(ctx)
+			return nil
+		}
+	}
+	goupnp.Batch(ctx, 0, actions...)
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].betterThan(&candidates[j])
+	})
+	return candidates
+}
+
+func (c *RouterCandidate) check(ctx context.Context) {
+	if err := ctx.Err(); err != nil {
+		c.Err = err
+		return
+	}
+	if c.Status, _, c.Err = c.Connection.GetStatusInfo(ctx); c.Err != nil {
+		return
+	}
+	start := time.Now()
+	s, err := c.Connection.GetExternalIPAddress(ctx)
+	c.RTT = time.Since(start)
+	if err != nil {
+		c.Err = err
+		return
+	}
+	if ip := net.ParseIP(s); ip != nil && !ip.IsUnspecified() {
+		c.ExternalIP = ip
+	}
+}
+
+func (c *RouterCandidate) betterThan(o *RouterCandidate) bool {
+	if c.Connected() != o.Connected() {
+		return c.Connected()
+	}
+	if (c.ExternalIP != nil) != (o.ExternalIP != nil) {
+		return c.ExternalIP != nil
+	}
+	if c.PublicIP() != o.PublicIP() {
+		return c.PublicIP()
+	}
+	if cp, op := typePreference(c.Connection), typePreference(o.Connection); cp != op {
+		return cp < op
+	}
+	return c.RTT < o.RTT
+}
+
+// typePreference returns the index of the service type of conn in
+// ConnectionTypes.
+func typePreference(conn *Connection) int {
+	for i, t := range ConnectionTypes {
+		if conn.ServiceType() == t {
+			return i
+		}
+	}
+	return len(ConnectionTypes)
+}
@@ -0,0 +1,64 @@
+package igd
+
+import (
+	"context"
+	"errors"
+	"net"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/huin/goupnp"
+	"github.com/huin/goupnp/dcps/internetgateway2"
+	"github.com/huin/goupnp/device/igdemu"
+)
+
+func testConnection(serviceType string) *Connection {
+	return &Connection{sc: &goupnp.ServiceClient{Service: &goupnp.Service{ServiceType: serviceType}}}
+}
+
+func TestRouterCandidateRanking(t *testing.T) {
+	ip1 := testConnection(internetgateway2.URN_WANIPConnection_1)
+	ip2 := testConnection(internetgateway2.URN_WANIPConnection_2)
+	ppp := testConnection(internetgateway2.URN_WANPPPConnection_1)
+	public := net.ParseIP("198.51.100.7")
+	candidates := []RouterCandidate{
+		{Connection: ip2, Err: errors.New("timeout")},
+		{Connection: ip2, Status: "Disconnected", ExternalIP: public},
+		{Connection: ip2, Status: "Connected", ExternalIP: net.ParseIP("100.64.0.3")},
+		{Connection: ppp, Status: "Connected", ExternalIP: public, RTT: time.Millisecond},
+		{Connection: ip1, Status: "Connected", ExternalIP: public, RTT: 5 * time.Millisecond},
+		{Connection: ip1, Status: "Connected", ExternalIP: public, RTT: time.Millisecond},
+		{Connection: ip2, Status: "Connected"},
+	}
+	want := []int{5, 4, 3, 2, 6, 1, 0}
+	got := append([]RouterCandidate(nil), candidates...)
+	sort.SliceStable(got, func(i, j int) bool { return got[i].betterThan(&got[j]) })
+	for i, w := range want {
+		if got[i].Connection != candidates[w].Connection || got[i].RTT != candidates[w].RTT ||
+			got[i].Status != candidates[w].Status || !got[i].ExternalIP.Equal(candidates[w].ExternalIP) {
+			t.Errorf("rank %d: got %+v, want candidate %d", i, got[i], w)
+		}
+	}
+}
+
+func TestPickRouterClient(t *testing.T) {
+	e, pm := newTestPortMapper(t)
+	defer e.Close()
+	ctx := context.Background()
+	conns := []*Connection{pm.Connection()}
+
+	conn, err := pickRouterClient(ctx, conns)
+	if err != nil || conn != pm.Connection() {
+		t.Errorf("pickRouterClient() = %v, %v", conn, err)
+	}
+	candidates := CheckConnections(ctx, conns)
+	if c := candidates[0]; !c.Connected() || !c.PublicIP() || c.ExternalIP.String() != igdemu.DefaultExternalIP {
+		t.Errorf("CheckConnections() = %+v", candidates)
+	}
+
+	e.SetConnectionStatus("Disconnected")
+	if _, err := pickRouterClient(ctx, conns); err == nil {
+		t.Error("picked a disconnected gateway")
+	}
+}
@@ -0,0 +1,365 @@
+package igd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/huin/goupnp/clock"
+	"github.com/huin/goupnp/igd/natpmp"
+	"github.com/huin/goupnp/internal/logging"
+)
+
+// ErrClosed is returned by the methods of a PortMapper after Close.
+var ErrClosed = errors.New("goupnp: port mapper closed")
+
+// deleteTimeout bounds deleting the mappings that no caller's context applies
+// to, those of Close and of Map after Close.
+const deleteTimeout = 10 * time.Second
+
+// minRenewalRetry is the least time before a failed renewal is retried.
+const minRenewalRetry = time.Second
+
+// PortMapper maps ports of this host on a gateway, renewing the leases of the
+// mappings until they are unmapped or the PortMapper is closed.
+type PortMapper struct {
+	conn      *Connection // Nil if ports are only mapped with NAT-PMP or PCP.
+	mappers   []mapper
+	clock     Clock
+	onRenewal func(Renewal)
+
+	lock     sync.Mutex // Protects all below.
+	closed   bool
+	mappings map[mappingKey]*activeMapping
+}
+
+type mappingKey struct {
+	protocol     Protocol
+	externalPort uint16
+}
+
+// activeMapping is a mapping of a PortMapper. mapping and err are protected
+// by the lock of the PortMapper.
+type activeMapping struct {
+	mapper  mapper
+	mapping Mapping
+	err     error
+	expires time.Time
+
+	stop chan struct{}
+	done chan struct{}
+}
+
+// NewPortMapper returns a PortMapper that maps ports on the gateway of conn.
+// The ports are mapped to the address of this host on the interface that
+// reaches the gateway.
+func NewPortMapper(conn *Connection) (*PortMapper, error) {
+	m, err := newUPnPMapper(conn)
+	if err != nil {
+		return nil, err
+	}
+	return newPortMapper(conn, m), nil
+}
+
+// NewNATPMPPortMapper returns a PortMapper that maps ports on gateway with PCP,
+// or NAT-PMP if the gateway does not support PCP. Permanent mappings are
+// given a lease of two hours, renewed like the others.
+func NewNATPMPPortMapper(gateway net.IP) (*PortMapper, error) {
+	m, err := newNATPMPMapper(gateway)
+	if err != nil {
+		return nil, err
+	}
+	return newPortMapper(nil, m), nil
+}
+
+func newPortMapper(conn *Connection, mappers ...mapper) *PortMapper {
+	return &PortMapper{
+		conn:     conn,
+		mappers:  mappers,
+		clock:    clock.Real,
+		mappings: make(map[mappingKey]*activeMapping),
+	}
+}
+
+// DiscoverPortMapper discovers the WAN connection services on the network, and
+// returns a PortMapper for the best one by the ranking of CheckConnections,
+// which may not be connected if none is.
+func DiscoverPortMapper(ctx context.Context) (*PortMapper, error) {
+	conns, err := DiscoverConnections(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(conns) == 0 {
+		return nil, errors.New("goupnp: no WAN connection service found")
+	}
+	return NewPortMapper(CheckConnections(ctx, conns)[0].Connection)
+}
+
+// DiscoverPortMapperWithFallback is DiscoverPortMapper falling back to
+// NAT-PMP and PCP against the default gateway of this host: if no WAN
+// connection service is found, ports are mapped with them alone, and
+// otherwise they are used for the mappings that the WAN connection service
+// fails to add.
+func DiscoverPortMapperWithFallback(ctx context.Context) (*PortMapper, error) {
+	conns, err := DiscoverConnections(ctx)
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return nil, ctxErr
+	}
+	gateway, gatewayErr := natpmp.DefaultGateway()
+	if err != nil || len(conns) == 0 {
+		if gatewayErr != nil {
+			return nil, fmt.Errorf("goupnp: no WAN connection service found, and no NAT-PMP fallback: %v", gatewayErr)
+		}
+		return NewNATPMPPortMapper(gateway)
+	}
+	pm, err := NewPortMapper(CheckConnections(ctx, conns)[0].Connection)
+	if err != nil || gatewayErr != nil {
+		return pm, err
+	}
+	if m, err := newNATPMPMapper(gateway); err == nil {
+		pm.mappers = append(pm.mappers, m)
+	}
+	return pm, nil
+}
+
+// Connection returns the connection service that ports are mapped on, or nil
+// if they are only mapped with NAT-PMP or PCP.
+func (pm *PortMapper) Connection() *Connection {
+	return pm.conn
+}
+
+// InternalClient returns the address of this host that ports are mapped to.
+func (pm *PortMapper) InternalClient() string {
+	return pm.mappers[0].internalClient()
+}
+
+// Map maps externalPort of the gateway to internalPort of this host, and
+// returns the external port of the mapping. An externalPort of 0 requests the
+// same port as internalPort.
+//
+// On WANIPConnection:2 with the ConflictNextPort strategy, the mapping is
+// added with AddAnyPortMapping, so the gateway picks another external port if
+// the requested one is taken.
+// Gateways that only support permanent mappings, or mappings of the same
+// external and internal port, are given such a mapping instead.
+//
+// An existing mapping of the external port to another client is a conflict,
+// resolved as set by SetConflictStrategy.
+//
+// If the PortMapper falls back to NAT-PMP and PCP, they are used when the WAN
+// connection service fails to add the mapping, and the error of the latter is
+// returned if both fail.
+//
+// A lease of zero requests a permanent mapping. Otherwise the lease, with a
+// granularity of a second, is renewed at half of its duration, and a failed
+// renewal is retried at half of what is left of the lease. Mapping the same
+// protocol and external port again replaces the mapping.
+func (pm *PortMapper) Map(ctx context.Context, protocol Protocol, internalPort, externalPort uint16, description string, lease time.Duration) (uint16, error) {
+	if externalPort == 0 {
+		externalPort = internalPort
+	}
+	m := Mapping{
+		ExternalPort: externalPort,
+		Protocol:     protocol,
+		InternalPort: internalPort,
+		Enabled:      true,
+		Description:  description,
+		Lease:        secondsLease(leaseSeconds(lease)),
+	}
+	var mapper mapper
+	var firstErr error
+	for _, mapper = range pm.mappers {
+		added, err := mapper.add(ctx, m, false)
+		if err == nil {
+			m, firstErr = added, nil
+			break
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+		if ctx.Err() != nil {
+			break
+		}
+	}
+	if firstErr != nil {
+		return 0, firstErr
+	}
+
+	am := &activeMapping{
+		mapper:  mapper,
+		mapping: m,
+		expires: pm.clock.Now().Add(m.Lease),
+		stop:    make(chan struct{}),
+		done:    make(chan struct{}),
+	}
+	key := mappingKey{m.Protocol, m.ExternalPort}
+	pm.lock.Lock()
+	if pm.closed {
+		pm.lock.Unlock()
+		ctx, cancel := context.WithTimeout(context.Background(), deleteTimeout)
+		defer cancel()
+		mapper.delete(ctx, m)
+		return 0, ErrClosed
+	}
+	old := pm.mappings[key]
+	pm.mappings[key] = am
+	pm.lock.Unlock()
+	if old != nil {
+		old.stopRenewal()
+		if old.mapper != mapper {
+			// The mapping of the other mapper is no longer renewed, and would
+			// otherwise be left on its gateway until its lease expires.
+			old.mapper.delete(ctx, old.mapping)
+		}
+	}
+	if m.Lease > 0 {
+		go pm.renew(am, pm.clock.NewTimer(m.Lease/2))
+	} else {
+		close(am.done)
+	}
+	return m.ExternalPort, nil
+}
+
+// Unmap stops renewing the mapping of protocol and externalPort, and deletes
+// it.
+func (pm *PortMapper) Unmap(ctx context.Context, protocol Protocol, externalPort uint16) error {
+	key := mappingKey{protocol, externalPort}
+	pm.lock.Lock()
+	if pm.closed {
+		pm.lock.Unlock()
+		return ErrClosed
+	}
+	am := pm.mappings[key]
+	delete(pm.mappings, key)
+	pm.lock.Unlock()
+	if am == nil {
+		return fmt.Errorf("goupnp: %s port %d is not mapped", protocol, externalPort)
+	}
+	am.stopRenewal()
+	return am.mapper.delete(ctx, am.mapping)
+}
+
+// Mappings returns the current mappings of pm.
+func (pm *PortMapper) Mappings() []Mapping {
+	pm.lock.Lock()
+	defer pm.lock.Unlock()
+	mappings := make([]Mapping, 0, len(pm.mappings))
+	for _, am := range pm.mappings {
+		mappings = append(mappings, am.mapping)
+	}
+	return mappings
+}
+
+// Err returns the error of the last renewal of the mapping of protocol and
+// externalPort, or nil if it succeeded or the mapping is not renewed.
+func (pm *PortMapper) Err(protocol Protocol, externalPort uint16) error {
+	pm.lock.Lock()
+	defer pm.lock.Unlock()
+	if am := pm.mappings[mappingKey{protocol, externalPort}]; am != nil {
+		return am.err
+	}
+	return nil
+}
+
+// Close stops renewing the mappings and deletes them. It returns the first
+// error deleting a mapping, other than for mappings whose lease has already
+// expired.
+func (pm *PortMapper) Close() error {
+	pm.lock.Lock()
+	if pm.closed {
+		pm.lock.Unlock()
+		return nil
+	}
+	pm.closed = true
+	mappings := pm.mappings
+	pm.mappings = nil
+	pm.lock.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), deleteTimeout)
+	defer cancel()
+	var firstErr error
+	for _, am := range mappings {
+		am.stopRenewal()
+		if err := am.mapper.delete(ctx, am.mapping); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+func (pm *PortMapper) renew(am *activeMapping, timer Timer) {
+	defer close(am.done)
+	defer timer.Stop()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		<-am.stop
+		cancel()
+	}()
+
+	m := am.mapping
+	failures := 0
+	for {
+		select {
+		case <-am.stop:
+			return
+		case <-timer.C():
+		}
+		renewed, err := am.mapper.add(ctx, m, true)
+		now := pm.clock.Now()
+		pm.lock.Lock()
+		am.err = err
+		if err == nil {
+			m = renewed
+			am.mapping, am.expires = m, now.Add(m.Lease)
+			failures = 0
+		} else {
+			failures++
+		}
+		r := Renewal{Mapping: m, Err: err, Failures: failures, Expires: am.expires}
+		pm.lock.Unlock()
+		r.Expired = m.Lease > 0 && !now.Before(r.Expires)
+		if ctx.Err() != nil {
+			// The renewal was interrupted by Unmap or Close.
+			return
+		}
+		if m.Lease > 0 {
+			timer.Reset(renewalDelay(m.Lease, r.Expires.Sub(now), err))
+		}
+		if pm.onRenewal != nil {
+			pm.notifyRenewal(r)
+		}
+		if m.Lease == 0 {
+			return
+		}
+	}
+}
+
+// notifyRenewal calls the function set by SetRenewalFunc with r, recovering
+// from its panics so that they do not crash the renewing goroutine.
+func (pm *PortMapper) notifyRenewal(r Renewal) {
+	defer logging.Recover(nil, "goupnp: panic in renewal callback")
+	pm.onRenewal(r)
+}
+
+// renewalDelay returns the time until the next renewal of a lease, which
+// expires in left: half the lease after a successful renewal, and half of
+// what is left of it, but at least minRenewalRetry, after a failed one, so
+// that the renewal is retried before the lease expires.
+func renewalDelay(lease, left time.Duration, err error) time.Duration {
+	if err == nil {
+		return lease / 2
+	}
+	if left /= 2; left < minRenewalRetry {
+		return minRenewalRetry
+	}
+	return left
+}
+
+func (am *activeMapping) stopRenewal() {
+	close(am.stop)
+	<-am.done
+}
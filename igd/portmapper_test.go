@@ -0,0 +1,207 @@
+package igd
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/huin/goupnp/clock"
+	"github.com/huin/goupnp/device/igdemu"
+	"github.com/huin/goupnp/soap"
+)
+
+func newTestPortMapper(t *testing.T) (*igdemu.Emulator, *PortMapper) {
+	return newEmulatedPortMapper(t, igdemu.New, "urn:schemas-upnp-org:service:WANIPConnection:1")
+}
+
+// newEmulatedPortMapper returns a PortMapper of the serviceType connection of
+// the emulator created by newEmulator.
+func newEmulatedPortMapper(t *testing.T, newEmulator func() (*igdemu.Emulator, error), serviceType string) (*igdemu.Emulator, *PortMapper) {
+	e, err := newEmulator()
+	if err != nil {
+		t.Fatal(err)
+	}
+	conns, err := ConnectionsByURL(e.Location())
+	if err != nil {
+		e.Close()
+		t.Fatal(err)
+	}
+	if len(conns) != 1 || conns[0].ServiceType() != serviceType {
+		e.Close()
+		t.Fatalf("got connections %v, want the %s service", conns, serviceType)
+	}
+	pm, err := NewPortMapper(conns[0])
+	if err != nil {
+		e.Close()
+		t.Fatal(err)
+	}
+	return e, pm
+}
+
+// fakeRenewals makes pm renew on a fake clock, returned with the channel that
+// the renewals are reported on.
+func fakeRenewals(pm *PortMapper) (*clock.Fake, <-chan Renewal) {
+	fc := clock.NewFake(time.Unix(1000, 0))
+	pm.SetClock(fc)
+	renewals := make(chan Renewal, 10)
+	pm.SetRenewalFunc(func(r Renewal) { renewals <- r })
+	return fc, renewals
+}
+
+func TestPortMapper(t *testing.T) {
+	e, pm := newTestPortMapper(t)
+	defer e.Close()
+	fc, renewals := fakeRenewals(pm)
+	ctx := context.Background()
+
+	port, err := pm.Map(ctx, TCP, 80, 8080, "web", time.Second)
+	if err != nil || port != 8080 {
+		t.Fatalf("Map() = %d, %v, want 8080", port, err)
+	}
+	if _, err := pm.Map(ctx, UDP, 5000, 0, "game", 0); err != nil {
+		t.Fatal(err)
+	}
+	mappings := e.PortMappings()
+	if len(mappings) != 2 {
+		t.Fatalf("gateway has mappings %+v, want 2", mappings)
+	}
+	if m := mappings[0]; m.InternalClient != pm.InternalClient() || m.InternalPort != 80 || m.LeaseDuration != 1 {
+		t.Errorf("gateway has mapping %+v", m)
+	}
+	if m := mappings[1]; m.ExternalPort != 5000 || m.LeaseDuration != 0 {
+		t.Errorf("gateway has mapping %+v", m)
+	}
+
+	// The mapping is renewed halfway through its lease.
+	fc.Advance(500 * time.Millisecond)
+	if r := <-renewals; r.Err != nil || !r.Expires.Equal(time.Unix(1000, 0).Add(1500*time.Millisecond)) {
+		t.Errorf("got renewal %+v, want a successful renewal", r)
+	}
+	if len(e.PortMappings()) != 2 || pm.Err(TCP, 8080) != nil {
+		t.Errorf("mapping was not renewed: %+v, %v", e.PortMappings(), pm.Err(TCP, 8080))
+	}
+
+	if err := pm.Unmap(ctx, UDP, 5000); err != nil {
+		t.Fatal(err)
+	}
+	if err := pm.Unmap(ctx, UDP, 5000); err == nil {
+		t.Error("unmapped a port twice")
+	}
+	if err := pm.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if got := e.PortMappings(); len(got) != 0 {
+		t.Errorf("gateway has mappings %+v after Close", got)
+	}
+	if _, err := pm.Map(ctx, TCP, 80, 8080, "web", 0); err != ErrClosed {
+		t.Errorf("Map() after Close = %v, want ErrClosed", err)
+	}
+}
+
+func TestPortMapperRenewalError(t *testing.T) {
+	e, pm := newTestPortMapper(t)
+	defer e.Close()
+	defer pm.Close()
+	fc, renewals := fakeRenewals(pm)
+
+	if _, err := pm.Map(context.Background(), TCP, 80, 8080, "web", time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	e.InjectFault("AddPortMapping", soap.NewUPnPError(501, "ActionFailed"))
+	fc.Advance(30 * time.Second)
+	if r := <-renewals; !isUPnPError(r.Err, 501) || r.Failures != 1 {
+		t.Errorf("got renewal %+v, want a first failure", r)
+	}
+	if !isUPnPError(pm.Err(TCP, 8080), 501) {
+		t.Errorf("Err() = %v, want the renewal error", pm.Err(TCP, 8080))
+	}
+
+	// The renewal is retried before the lease expires, keeping the mapping.
+	e.InjectFault("AddPortMapping", nil)
+	fc.Advance(15 * time.Second)
+	if r := <-renewals; r.Err != nil || r.Expired || !r.Expires.Equal(time.Unix(1105, 0)) {
+		t.Errorf("got renewal %+v, want a successful retry before expiry", r)
+	}
+	if len(e.PortMappings()) != 1 || pm.Err(TCP, 8080) != nil {
+		t.Errorf("mapping was not renewed: %+v, %v", e.PortMappings(), pm.Err(TCP, 8080))
+	}
+}
+
+// fakeMapper is a mapper granting the requested mappings, or failing with
+// err.
+type fakeMapper struct {
+	err     error
+	added   []Mapping
+	deleted []Mapping
+	// unbounded is set if a mapping is deleted without a deadline.
+	unbounded bool
+}
+
+func (fm *fakeMapper) internalClient() string {
+	return "192.168.1.2"
+}
+
+func (fm *fakeMapper) add(ctx context.Context, m Mapping, renewal bool) (Mapping, error) {
+	if fm.err != nil {
+		return m, fm.err
+	}
+	m.InternalClient = fm.internalClient()
+	fm.added = append(fm.added, m)
+	return m, nil
+}
+
+func (fm *fakeMapper) delete(ctx context.Context, m Mapping) error {
+	if _, ok := ctx.Deadline(); !ok {
+		fm.unbounded = true
+	}
+	fm.deleted = append(fm.deleted, m)
+	return nil
+}
+
+func TestPortMapperFallback(t *testing.T) {
+	errFailed := errors.New("failed")
+	primary := &fakeMapper{err: errFailed}
+	fallback := &fakeMapper{}
+	pm := newPortMapper(nil, primary, fallback)
+
+	if port, err := pm.Map(context.Background(), UDP, 5000, 0, "game", 0); err != nil || port != 5000 {
+		t.Fatalf("Map() = %d, %v", port, err)
+	}
+	if len(fallback.added) != 1 {
+		t.Errorf("fallback added %+v, want the mapping", fallback.added)
+	}
+	if err := pm.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if len(primary.deleted) != 0 || len(fallback.deleted) != 1 {
+		t.Errorf("deleted %+v from the primary and %+v from the fallback, want the mapping from the fallback",
+			primary.deleted, fallback.deleted)
+	}
+	if fallback.unbounded {
+		t.Error("Close deleted the mapping without a deadline")
+	}
+
+	// Mapping the port again with the primary deletes it from the fallback.
+	fallback.deleted = nil
+	pm = newPortMapper(nil, primary, fallback)
+	if _, err := pm.Map(context.Background(), UDP, 5000, 0, "game", 0); err != nil {
+		t.Fatal(err)
+	}
+	primary.err = nil
+	if _, err := pm.Map(context.Background(), UDP, 5000, 0, "game", 0); err != nil {
+		t.Fatal(err)
+	}
+	if len(primary.added) != 1 || len(fallback.deleted) != 1 {
+		t.Errorf("added %+v to the primary and deleted %+v from the fallback, want the mapping moved to the primary",
+			primary.added, fallback.deleted)
+	}
+	pm.Close()
+
+	primary.err = errFailed
+	fallback.err = errors.New("also failed")
+	pm = newPortMapper(nil, primary, fallback)
+	if _, err := pm.Map(context.Background(), UDP, 5000, 0, "game", 0); err != errFailed {
+		t.Errorf("Map() = %v, want the error of the primary mapper", err)
+	}
+}
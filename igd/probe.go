@@ -0,0 +1,124 @@
+package igd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"time"
+)
+
+// Prober checks whether port of ip is reachable for protocol, returning nil if
+// it is.
+type Prober interface {
+	Probe(ctx context.Context, protocol Protocol, ip net.IP, port uint16) error
+}
+
+// ProberFunc is a function-to-Prober adapter, e.g. for a prober asking a
+// service on the Internet to connect back to the port.
+type ProberFunc func(ctx context.Context, protocol Protocol, ip net.IP, port uint16) error
+
+func (f ProberFunc) Probe(ctx context.Context, protocol Protocol, ip net.IP, port uint16) error {
+	return f(ctx, protocol, ip, port)
+}
+
+// HairpinProber probes a port by connecting to it from this host, relying on
+// the gateway looping back connections to its external address to the mapped
+// ports. Many gateways do not, so a failed probe is not conclusive, and only
+// an external prober tells whether the port is reachable from the Internet.
+type HairpinProber struct {
+	// UDPPayload is sent to probe UDP ports, which are reachable if any
+	// datagram is received in reply. UDP ports cannot be probed without it.
+	UDPPayload []byte
+}
+
+func (p HairpinProber) Probe(ctx context.Context, protocol Protocol, ip net.IP, port uint16) error {
+	addr := net.JoinHostPort(ip.String(), strconv.Itoa(int(port)))
+	var d net.Dialer
+	switch protocol {
+	case TCP:
+		conn, err := d.DialContext(ctx, "tcp", addr)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	case UDP:
+		if p.UDPPayload == nil {
+			return errors.New("goupnp: no payload to probe UDP ports with")
+		}
+		conn, err := d.DialContext(ctx, "udp", addr)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		// Unblock the read when ctx is done.
+		done := make(chan struct{})
+		defer close(done)
+		go func() {
+			select {
+			case <-ctx.Done():
+				conn.SetDeadline(time.Now())
+			case <-done:
+			}
+		}()
+		if _, err := conn.Write(p.UDPPayload); err != nil {
+			return err
+		}
+		if _, err := conn.Read(make([]byte, 1)); err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			return err
+		}
+		return nil
+	}
+	return fmt.Errorf("goupnp: cannot probe protocol %q", protocol)
+}
+
+// Reachability is the result of VerifyMapping.
+type Reachability struct {
+	// ExternalIP is the external address of the gateway.
+	ExternalIP net.IP
+	// Mapped is whether the gateway reports the mapping, which is then in
+	// Mapping.
+	Mapped  bool
+	Mapping Mapping
+	// DoubleNAT is set if ExternalIP is not a public address, so that the
+	// gateway is behind another NAT, which the mapping does not cross.
+	DoubleNAT bool
+	// Reachable is whether the probe of the port succeeded, with ProbeErr
+	// the error of the probe otherwise.
+	Reachable bool
+	ProbeErr  error
+}
+
+// VerifyMapping checks that the mapping of protocol and externalPort works end
+// to end: that the gateway of conn reports it, and that prober reaches the
+// port through the external address of the gateway. A listener must be
+// serving the internal port of the mapping for the probe to succeed.
+// Gateways frequently accept mappings that do not work, e.g. when they are
+// behind another NAT.
+func VerifyMapping(ctx context.Context, conn *Connection, protocol Protocol, externalPort uint16, prober Prober) (*Reachability, error) {
+	s, err := conn.GetExternalIPAddress(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r := &Reachability{ExternalIP: net.ParseIP(s)}
+	if r.ExternalIP == nil || r.ExternalIP.IsUnspecified() {
+		return nil, fmt.Errorf("goupnp: gateway reported invalid external IP address %q", s)
+	}
+	r.DoubleNAT = !(&RouterCandidate{ExternalIP: r.ExternalIP}).PublicIP()
+
+	r.Mapping, err = conn.GetSpecificPortMappingEntry(ctx, "", externalPort, protocol)
+	switch {
+	case err == nil:
+		r.Mapped = true
+	case !isUPnPError(err, errCodeNoSuchEntryInArray):
+		return nil, err
+	}
+
+	r.ProbeErr = prober.Probe(ctx, protocol, r.ExternalIP, externalPort)
+	r.Reachable = r.ProbeErr == nil
+	return r, nil
+}
@@ -0,0 +1,74 @@
+package igd
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestVerifyMapping(t *testing.T) {
+	e, pm := newTestPortMapper(t)
+	defer e.Close()
+	defer pm.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// The emulated gateway "loops back" its external address to this host.
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+	port := uint16(l.Addr().(*net.TCPAddr).Port)
+	e.SetExternalIP("127.0.0.1")
+	if _, err := pm.Map(ctx, TCP, port, port, "probe", 0); err != nil {
+		t.Fatal(err)
+	}
+
+	r, err := VerifyMapping(ctx, pm.Connection(), TCP, port, HairpinProber{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !r.Mapped || r.Mapping.InternalPort != port || !r.Reachable || !r.DoubleNAT {
+		t.Errorf("VerifyMapping() = %+v, want a reachable mapping behind a double NAT", r)
+	}
+
+	e.SetExternalIP("198.51.100.7")
+	errUnreachable := errors.New("unreachable")
+	r, err = VerifyMapping(ctx, pm.Connection(), UDP, port, ProberFunc(func(ctx context.Context, protocol Protocol, ip net.IP, port uint16) error {
+		return errUnreachable
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Mapped || r.Reachable || r.ProbeErr != errUnreachable || r.DoubleNAT {
+		t.Errorf("VerifyMapping() = %+v, want an unmapped and unreachable port", r)
+	}
+}
+
+func TestHairpinProberUDPCancel(t *testing.T) {
+	// A UDP port that never replies.
+	pc, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pc.Close()
+	port := uint16(pc.LocalAddr().(*net.UDPAddr).Port)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	time.AfterFunc(50*time.Millisecond, cancel)
+	errc := make(chan error, 1)
+	go func() {
+		errc <- HairpinProber{UDPPayload: []byte("ping")}.Probe(ctx, UDP, net.IPv4(127, 0, 0, 1), port)
+	}()
+	select {
+	case err := <-errc:
+		if err != context.Canceled {
+			t.Errorf("Probe() = %v, want %v", err, context.Canceled)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Probe() did not return after its context was cancelled")
+	}
+}
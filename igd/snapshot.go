@@ -0,0 +1,94 @@
+package igd
+
+import (
+	"context"
+	"time"
+)
+
+// Snapshot is the mapping table of a gateway at a time, e.g. for auditing the
+// mappings that the software of the LAN adds, by diffing snapshots taken over
+// time with Diff.
+type Snapshot struct {
+	Time     time.Time
+	Mappings []Mapping
+}
+
+// Snapshot lists all the mappings of the gateway, with ListPortMappings.
+func (c *Connection) Snapshot(ctx context.Context) (*Snapshot, error) {
+	mappings, err := c.ListPortMappings(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &Snapshot{Time: time.Now(), Mappings: mappings}, nil
+}
+
+// MappingKey identifies a mapping within the mapping table of a gateway, as
+// the arguments of GetSpecificPortMappingEntry do.
+type MappingKey struct {
+	RemoteHost   string
+	ExternalPort uint16
+	Protocol     Protocol
+}
+
+// Key returns the key of m.
+func (m *Mapping) Key() MappingKey {
+	return MappingKey{m.RemoteHost, m.ExternalPort, m.Protocol}
+}
+
+// MappingChange is a change of a mapping between two snapshots.
+type MappingChange struct {
+	Old, New Mapping
+}
+
+// SnapshotDiff is the difference between two snapshots of a mapping table.
+type SnapshotDiff struct {
+	Added   []Mapping
+	Removed []Mapping
+	Changed []MappingChange
+}
+
+// Empty returns whether the snapshots have the same mappings.
+func (d *SnapshotDiff) Empty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// Diff returns the mappings added, removed and changed from prev to next,
+// matched by their keys. Mappings only differing in the remaining time of
+// their leases, which counts down, are not changed, but mappings that became
+// permanent or expiring are. The mappings are in the order of next, and the
+// removed ones in that of prev. prev may be nil, for all the mappings of next
+// to be added.
+func Diff(prev, next *Snapshot) SnapshotDiff {
+	var d SnapshotDiff
+	old := make(map[MappingKey]Mapping)
+	if prev != nil {
+		for _, m := range prev.Mappings {
+			old[m.Key()] = m
+		}
+	}
+	seen := make(map[MappingKey]bool, len(next.Mappings))
+	for _, m := range next.Mappings {
+		key := m.Key()
+		seen[key] = true
+		o, ok := old[key]
+		switch {
+		case !ok:
+			d.Added = append(d.Added, m)
+		case mappingChanged(&o, &m):
+			d.Changed = append(d.Changed, MappingChange{Old: o, New: m})
+		}
+	}
+	if prev != nil {
+		for _, m := range prev.Mappings {
+			if !seen[m.Key()] {
+				d.Removed = append(d.Removed, m)
+			}
+		}
+	}
+	return d
+}
+
+func mappingChanged(a, b *Mapping) bool {
+	return a.InternalPort != b.InternalPort || a.InternalClient != b.InternalClient ||
+		a.Enabled != b.Enabled || a.Description != b.Description || (a.Lease == 0) != (b.Lease == 0)
+}
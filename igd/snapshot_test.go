@@ -0,0 +1,54 @@
+package igd
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestSnapshotDiff(t *testing.T) {
+	e, pm := newTestPortMapper(t)
+	defer e.Close()
+	defer pm.Close()
+	ctx := context.Background()
+
+	if _, err := pm.Map(ctx, TCP, 80, 8080, "web", 0); err != nil {
+		t.Fatal(err)
+	}
+	prev, err := pm.Connection().Snapshot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := pm.Map(ctx, UDP, 53, 5353, "dns", 0); err != nil {
+		t.Fatal(err)
+	}
+	next, err := pm.Connection().Snapshot(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := Diff(prev, next)
+	if len(d.Added) != 1 || d.Added[0].ExternalPort != 5353 || len(d.Removed) != 0 || len(d.Changed) != 0 {
+		t.Errorf("Diff() = %+v, want the UDP mapping added", d)
+	}
+	if d := Diff(next, next); !d.Empty() {
+		t.Errorf("Diff() of a snapshot with itself = %+v, want empty", d)
+	}
+
+	web := next.Mappings[0]
+	changed := web
+	changed.InternalClient = "192.168.1.99"
+	d = Diff(next, &Snapshot{Mappings: []Mapping{changed}})
+	if len(d.Changed) != 1 || d.Changed[0].New.InternalClient != "192.168.1.99" || len(d.Removed) != 1 {
+		t.Errorf("Diff() = %+v, want the web mapping changed and the DNS one removed", d)
+	}
+
+	leased := Mapping{ExternalPort: 9000, Protocol: TCP, Lease: time.Hour}
+	counted, permanent := leased, leased
+	counted.Lease, permanent.Lease = 59*time.Minute, 0
+	if d := Diff(&Snapshot{Mappings: []Mapping{leased}}, &Snapshot{Mappings: []Mapping{counted}}); !d.Empty() {
+		t.Errorf("Diff() = %+v, want leases counting down ignored", d)
+	}
+	if d := Diff(&Snapshot{Mappings: []Mapping{leased}}, &Snapshot{Mappings: []Mapping{permanent}}); len(d.Changed) != 1 {
+		t.Errorf("Diff() = %+v, want the mapping made permanent changed", d)
+	}
+}
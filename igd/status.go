@@ -0,0 +1,125 @@
+package igd
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// ConnectionStatus is the ConnectionStatus of a WAN connection service.
+type ConnectionStatus string
+
+// The connection statuses of the WANIPConnection:2 and WANPPPConnection:1
+// services, of which WANIPConnection:1 has Unconfigured, Connected and
+// Disconnected.
+const (
+	StatusUnconfigured      ConnectionStatus = "Unconfigured"
+	StatusConnecting        ConnectionStatus = "Connecting"
+	StatusAuthenticating    ConnectionStatus = "Authenticating"
+	StatusConnected         ConnectionStatus = "Connected"
+	StatusPendingDisconnect ConnectionStatus = "PendingDisconnect"
+	StatusDisconnecting     ConnectionStatus = "Disconnecting"
+	StatusDisconnected      ConnectionStatus = "Disconnected"
+)
+
+// ConnectionErrorKind classifies the errors of a connection.
+type ConnectionErrorKind int
+
+const (
+	// ErrorUnknown is an error of no other kind.
+	ErrorUnknown ConnectionErrorKind = iota
+	// ErrorLocalDisconnect is a disconnection requested locally, by a user
+	// or the gateway itself, e.g. when the connection was idle.
+	ErrorLocalDisconnect
+	// ErrorISP is a disconnection or failure of the ISP.
+	ErrorISP
+	// ErrorLink is a failure of the physical link to the ISP.
+	ErrorLink
+	// ErrorAuthentication is a rejection of the account of the connection.
+	ErrorAuthentication
+	// ErrorConfiguration is a misconfiguration of the connection.
+	ErrorConfiguration
+)
+
+var connectionErrorKinds = map[string]ConnectionErrorKind{
+	"ERROR_COMMAND_ABORTED":           ErrorLocalDisconnect,
+	"ERROR_USER_DISCONNECT":           ErrorLocalDisconnect,
+	"ERROR_IDLE_DISCONNECT":           ErrorLocalDisconnect,
+	"ERROR_FORCED_DISCONNECT":         ErrorLocalDisconnect,
+	"ERROR_ISP_TIME_OUT":              ErrorISP,
+	"ERROR_ISP_DISCONNECT":            ErrorISP,
+	"ERROR_SERVER_OUT_OF_RESOURCES":   ErrorISP,
+	"ERROR_NO_CARRIER":                ErrorLink,
+	"ERROR_NO_DIALTONE":               ErrorLink,
+	"ERROR_NO_ANSWER":                 ErrorLink,
+	"ERROR_LINE_BUSY":                 ErrorLink,
+	"ERROR_BAD_PHONE_NUMBER":          ErrorLink,
+	"ERROR_UNSUPPORTED_BITSPERSECOND": ErrorLink,
+	"ERROR_TOO_MANY_LINE_ERRORS":      ErrorLink,
+	"ERROR_AUTHENTICATION_FAILURE":    ErrorAuthentication,
+	"ERROR_ACCOUNT_DISABLED":          ErrorAuthentication,
+	"ERROR_ACCOUNT_EXPIRED":           ErrorAuthentication,
+	"ERROR_PASSWORD_EXPIRED":          ErrorAuthentication,
+	"ERROR_RESTRICTED_LOGON_HOURS":    ErrorAuthentication,
+	"ERROR_NOT_ENABLED_FOR_INTERNET":  ErrorConfiguration,
+	"ERROR_IP_CONFIGURATION":          ErrorConfiguration,
+}
+
+// ConnectionError is the LastConnectionError of a WAN connection service.
+type ConnectionError struct {
+	// Code is the value of LastConnectionError, such as
+	// "ERROR_ISP_DISCONNECT".
+	Code string
+	Kind ConnectionErrorKind
+}
+
+func (err *ConnectionError) Error() string {
+	return fmt.Sprintf("goupnp: connection error %s", err.Code)
+}
+
+// parseConnectionError returns the error of a LastConnectionError, nil for
+// ERROR_NONE.
+func parseConnectionError(code string) error {
+	if code == "" || code == "ERROR_NONE" {
+		return nil
+	}
+	return &ConnectionError{Code: code, Kind: connectionErrorKinds[code]}
+}
+
+// StatusInfo is the status of a connection, as returned by
+// Connection.StatusInfo.
+type StatusInfo struct {
+	Status ConnectionStatus
+	// LastError is the *ConnectionError of the last failure of the
+	// connection, or nil if there was none.
+	LastError error
+	// Uptime is how long the connection has been up.
+	Uptime time.Duration
+}
+
+// StatusInfo returns the status of the connection.
+func (c *Connection) StatusInfo(ctx context.Context) (StatusInfo, error) {
+	status, lastError, uptime, err := c.client.getStatusInfo(ctx)
+	if err != nil {
+		return StatusInfo{}, err
+	}
+	return StatusInfo{
+		Status:    ConnectionStatus(status),
+		LastError: parseConnectionError(lastError),
+		Uptime:    time.Duration(uptime) * time.Second,
+	}, nil
+}
+
+// GetAutoDisconnectTime returns the time after which the connection is
+// disconnected after being connected, zero if it is not.
+func (c *Connection) GetAutoDisconnectTime(ctx context.Context) (time.Duration, error) {
+	seconds, err := c.client.getAutoDisconnectTime(ctx)
+	return time.Duration(seconds) * time.Second, err
+}
+
+// GetIdleDisconnectTime returns the time after which the connection is
+// disconnected when idle, zero if it is not.
+func (c *Connection) GetIdleDisconnectTime(ctx context.Context) (time.Duration, error) {
+	seconds, err := c.client.getIdleDisconnectTime(ctx)
+	return time.Duration(seconds) * time.Second, err
+}
@@ -0,0 +1,68 @@
+package igd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/huin/goupnp/dcps/internetgateway2"
+)
+
+func TestStatusInfo(t *testing.T) {
+	e, pm := newTestPortMapper(t)
+	defer e.Close()
+	ctx := context.Background()
+	conn := pm.Connection()
+
+	info, err := conn.StatusInfo(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Status != StatusConnected || info.LastError != nil || info.Uptime < 0 {
+		t.Errorf("StatusInfo() = %+v, want a connected status", info)
+	}
+	e.SetConnectionStatus("Disconnected")
+	if info, err := conn.StatusInfo(ctx); err != nil || info.Status != StatusDisconnected || info.Uptime != 0 {
+		t.Errorf("StatusInfo() = %+v, %v, want a disconnected status", info, err)
+	}
+
+	client := &internetgateway2.WANIPConnection1{ServiceClient: *conn.ServiceClient()}
+	if err := client.SetAutoDisconnectTimeCtx(ctx, 3600); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.SetIdleDisconnectTimeCtx(ctx, 300); err != nil {
+		t.Fatal(err)
+	}
+	if d, err := conn.GetAutoDisconnectTime(ctx); err != nil || d != time.Hour {
+		t.Errorf("GetAutoDisconnectTime() = %v, %v, want 1h", d, err)
+	}
+	if d, err := conn.GetIdleDisconnectTime(ctx); err != nil || d != 5*time.Minute {
+		t.Errorf("GetIdleDisconnectTime() = %v, %v, want 5m", d, err)
+	}
+}
+
+func TestParseConnectionError(t *testing.T) {
+	tests := []struct {
+		code string
+		kind ConnectionErrorKind
+	}{
+		{"ERROR_ISP_DISCONNECT", ErrorISP},
+		{"ERROR_IDLE_DISCONNECT", ErrorLocalDisconnect},
+		{"ERROR_NO_CARRIER", ErrorLink},
+		{"ERROR_AUTHENTICATION_FAILURE", ErrorAuthentication},
+		{"ERROR_IP_CONFIGURATION", ErrorConfiguration},
+		{"ERROR_UNKNOWN", ErrorUnknown},
+		{"X_VENDOR_ERROR", ErrorUnknown},
+	}
+	for _, test := range tests {
+		err, ok := parseConnectionError(test.code).(*ConnectionError)
+		if !ok || err.Code != test.code || err.Kind != test.kind {
+			t.Errorf("parseConnectionError(%q) = %#v, want kind %d", test.code, err, test.kind)
+		}
+	}
+	for _, code := range []string{"", "ERROR_NONE"} {
+		if err := parseConnectionError(code); err != nil {
+			t.Errorf("parseConnectionError(%q) = %v, want nil", code, err)
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package igd
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/huin/goupnp"
+	"github.com/huin/goupnp/dcps/internetgateway1"
+	"github.com/huin/goupnp/dcps/internetgateway2"
+	"github.com/huin/goupnp/soap"
+)
+
+// WANDevice is a WAN interface of a gateway, with the connection services of
+// all its WANConnectionDevices, of which gateways have one for each of their
+// WAN profiles.
+type WANDevice struct {
+	Device      *goupnp.Device
+	Connections []*Connection
+}
+
+// WANDevices returns the WAN interfaces of the gateway root, described at loc,
+// in the order of its description. Connection services of types other than
+// ConnectionTypes are left out.
+func WANDevices(root *goupnp.RootDevice, loc *url.URL) []*WANDevice {
+	var devices []*WANDevice
+	root.Device.VisitDevices(func(d *goupnp.Device) {
+		if d.DeviceType != internetgateway2.URN_WANDevice_1 && d.DeviceType != internetgateway2.URN_WANDevice_2 {
+			return
+		}
+		wd := &WANDevice{Device: d}
+		for i := range d.Devices {
+			cd := &d.Devices[i]
+			if cd.DeviceType != internetgateway2.URN_WANConnectionDevice_1 && cd.DeviceType != internetgateway2.URN_WANConnectionDevice_2 {
+				continue
+			}
+			for j := range cd.Services {
+				if c, err := NewConnection(newServiceClient(root, loc, &cd.Services[j])); err == nil {
+					wd.Connections = append(wd.Connections, c)
+				}
+			}
+		}
+		devices = append(devices, wd)
+	})
+	return devices
+}
+
+// Layer3Forwarding returns a client for the Layer3Forwarding service of the
+// gateway root, described at loc.
+func Layer3Forwarding(root *goupnp.RootDevice, loc *url.URL) (*internetgateway1.Layer3Forwarding1, error) {
+	srvs := root.Device.FindService(internetgateway1.URN_Layer3Forwarding_1)
+	if len(srvs) == 0 {
+		return nil, fmt.Errorf("goupnp: no Layer3Forwarding service found within device %q", root.Device.FriendlyName)
+	}
+	return &internetgateway1.Layer3Forwarding1{ServiceClient: *newServiceClient(root, loc, srvs[0])}, nil
+}
+
+// DefaultConnection returns the connection service that client, the
+// Layer3Forwarding service of the gateway root, reports as carrying the
+// default route, which is the active connection of gateways with several.
+func DefaultConnection(ctx context.Context, client internetgateway1.Layer3Forwarding1Client, root *goupnp.RootDevice, loc *url.URL) (*Connection, error) {
+	sc, err := internetgateway1.DefaultConnectionService(ctx, client, root, loc)
+	if err != nil {
+		return nil, err
+	}
+	return NewConnection(sc)
+}
+
+func newServiceClient(root *goupnp.RootDevice, loc *url.URL, srv *goupnp.Service) *goupnp.ServiceClient {
+	return &goupnp.ServiceClient{
+		SOAPClient: srv.NewSOAPClient(soap.WithCompat(root.Quirks().SOAPCompat())),
+		RootDevice: root,
+		Location:   loc,
+		Service:    srv,
+	}
+}
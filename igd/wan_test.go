@@ -0,0 +1,65 @@
+package igd
+
+import (
+	"context"
+	"testing"
+
+	"github.com/huin/goupnp"
+	"github.com/huin/goupnp/dcps/internetgateway1"
+	"github.com/huin/goupnp/dcps/internetgateway2"
+)
+
+type fakeLayer3Forwarding struct {
+	internetgateway1.Layer3Forwarding1Client
+	ref string
+}
+
+func (f *fakeLayer3Forwarding) GetDefaultConnectionServiceCtx(ctx context.Context) (string, error) {
+	return f.ref, nil
+}
+
+func testGatewayWithWANDevices() *goupnp.RootDevice {
+	wanDevice := func(udn string, connDevices ...goupnp.Device) goupnp.Device {
+		return goupnp.Device{DeviceType: internetgateway2.URN_WANDevice_1, UDN: udn, Devices: connDevices}
+	}
+	connDevice := func(udn string, services ...goupnp.Service) goupnp.Device {
+		return goupnp.Device{DeviceType: internetgateway2.URN_WANConnectionDevice_1, UDN: udn, Services: services}
+	}
+	return &goupnp.RootDevice{Device: goupnp.Device{
+		DeviceType: internetgateway2.URN_InternetGatewayDevice_2,
+		UDN:        "uuid:igd",
+		Services:   []goupnp.Service{{ServiceType: internetgateway1.URN_Layer3Forwarding_1, ServiceId: "urn:upnp-org:serviceId:L3Forwarding1"}},
+		Devices: []goupnp.Device{
+			wanDevice("uuid:dsl",
+				connDevice("uuid:dsl-ppp", goupnp.Service{ServiceType: internetgateway2.URN_WANPPPConnection_1, ServiceId: "urn:upnp-org:serviceId:WANPPPConn1"}),
+				connDevice("uuid:dsl-ip", goupnp.Service{ServiceType: internetgateway2.URN_WANIPConnection_1, ServiceId: "urn:upnp-org:serviceId:WANIPConn1"}),
+			),
+			wanDevice("uuid:lte",
+				connDevice("uuid:lte-ip", goupnp.Service{ServiceType: internetgateway2.URN_WANIPConnection_2, ServiceId: "urn:upnp-org:serviceId:WANIPConn1"}),
+			),
+		},
+	}}
+}
+
+func TestWANDevices(t *testing.T) {
+	root := testGatewayWithWANDevices()
+	devices := WANDevices(root, nil)
+	if len(devices) != 2 || len(devices[0].Connections) != 2 || len(devices[1].Connections) != 1 {
+		t.Fatalf("got WAN devices %+v, want 2 with 2 and 1 connections", devices)
+	}
+	if devices[1].Device.UDN != "uuid:lte" || devices[1].Connections[0].ServiceType() != internetgateway2.URN_WANIPConnection_2 {
+		t.Errorf("got WAN device %+v, want the LTE interface", devices[1])
+	}
+
+	if _, err := Layer3Forwarding(root, nil); err != nil {
+		t.Fatal(err)
+	}
+	conn, err := DefaultConnection(context.Background(),
+		&fakeLayer3Forwarding{ref: "uuid:lte-ip:WANConnectionDevice:1,urn:upnp-org:serviceId:WANIPConn1"}, root, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if conn.ServiceClient().Service != devices[1].Connections[0].ServiceClient().Service {
+		t.Errorf("DefaultConnection() = %v, want the connection of the LTE interface", conn.ServiceClient().Service)
+	}
+}
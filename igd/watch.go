@@ -0,0 +1,279 @@
+package igd
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/huin/goupnp/clock"
+	"github.com/huin/goupnp/gena"
+	"github.com/huin/goupnp/internal/logging"
+)
+
+// watchSubscriptionTimeout is the duration of the event subscriptions
+// requested by a Watcher.
+const watchSubscriptionTimeout = 30 * time.Minute
+
+// DefaultWatchPollInterval is the interval at which a Watcher polls if the
+// interval given to Watch is not positive.
+const DefaultWatchPollInterval = time.Minute
+
+// WatchOption configures a Watcher.
+type WatchOption func(*Watcher)
+
+// WithWatchClock sets the clock that a Watcher schedules polls and renewals
+// of its subscription on, the system clock by default.
+func WithWatchClock(c Clock) WatchOption {
+	return func(w *Watcher) { w.clock = c }
+}
+
+// StatusChange is a change of the status of a connection, reported by a
+// Watcher.
+type StatusChange struct {
+	Status, PrevStatus         string
+	ExternalIP, PrevExternalIP string
+}
+
+// Disconnected returns whether the connection went down.
+func (c StatusChange) Disconnected() bool {
+	return c.PrevStatus == "Connected" && c.Status != "Connected"
+}
+
+// Reconnected returns whether the connection came up.
+func (c StatusChange) Reconnected() bool {
+	return c.PrevStatus != "Connected" && c.Status == "Connected"
+}
+
+// ExternalIPChanged returns whether the external address of the connection
+// changed, in which case mappings may have to be added again and peers told of
+// the new address.
+func (c StatusChange) ExternalIPChanged() bool {
+	return c.ExternalIP != c.PrevExternalIP
+}
+
+// Watcher tracks the ConnectionStatus and ExternalIPAddress of a connection
+// service. Use Watch to create one.
+type Watcher struct {
+	conn         *Connection
+	pollInterval time.Duration
+	fn           func(StatusChange)
+	clock        Clock
+
+	ctx        context.Context
+	cancel     context.CancelFunc
+	events     chan *gena.Event
+	done       chan struct{}
+	closeOnce  sync.Once
+	subscriber *gena.Subscriber
+	sub        *gena.Subscription
+
+	lock       sync.Mutex // Protects all below.
+	status     string
+	externalIP string
+	err        error
+}
+
+// Watch starts tracking the status of conn, calling fn for each change of its
+// ConnectionStatus or ExternalIPAddress. The changes are taken from the events
+// of the service if it can be subscribed to, otherwise it is polled every
+// pollInterval, or DefaultWatchPollInterval if it is not positive, as it also
+// is if renewing the subscription fails. fn is called from a single goroutine
+// at a time. ctx is used for the initial poll of the status, whose error is
+// returned.
+func Watch(ctx context.Context, conn *Connection, pollInterval time.Duration, fn func(StatusChange), opts ...WatchOption) (*Watcher, error) {
+	return watch(ctx, conn, pollInterval, fn, true, opts)
+}
+
+func watch(ctx context.Context, conn *Connection, pollInterval time.Duration, fn func(StatusChange), subscribe bool, opts []WatchOption) (*Watcher, error) {
+	if pollInterval <= 0 {
+		pollInterval = DefaultWatchPollInterval
+	}
+	w := &Watcher{
+		conn:         conn,
+		pollInterval: pollInterval,
+		fn:           fn,
+		events:       make(chan *gena.Event),
+		done:         make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(w)
+	}
+	w.clock = clock.Or(w.clock)
+	status, ip, err := w.poll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	w.status, w.externalIP = status, ip
+	w.ctx, w.cancel = context.WithCancel(context.Background())
+	if subscribe && conn.sc.Service.EventSubURL.Ok {
+		w.subscribe()
+	}
+	go w.run()
+	return w, nil
+}
+
+// Status returns the current ConnectionStatus and ExternalIPAddress.
+func (w *Watcher) Status() (status, externalIP string) {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.status, w.externalIP
+}
+
+// Evented returns whether the changes are taken from events, rather than by
+// polling.
+func (w *Watcher) Evented() bool {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.sub != nil
+}
+
+// Err returns the error of the last poll or renewal of the subscription, or
+// nil if it succeeded.
+func (w *Watcher) Err() error {
+	w.lock.Lock()
+	defer w.lock.Unlock()
+	return w.err
+}
+
+// Close stops tracking the connection.
+func (w *Watcher) Close() error {
+	var err error
+	w.closeOnce.Do(func() {
+		w.cancel()
+		<-w.done
+		if w.subscriber != nil {
+			if w.sub != nil {
+				err = w.sub.Unsubscribe()
+			}
+			w.subscriber.Close()
+		}
+	})
+	return err
+}
+
+// subscribe subscribes to the events of the service, leaving w.sub nil on
+// failure.
+func (w *Watcher) subscribe() {
+	s, err := gena.NewSubscriber(gena.HandlerFunc(func(ev *gena.Event) {
+		select {
+		case w.events <- ev:
+		case <-w.ctx.Done():
+		}
+	}))
+	if err != nil {
+		return
+	}
+	eventURL := w.conn.sc.Service.EventSubURL.URL
+	sub, err := s.Subscribe(&eventURL, watchSubscriptionTimeout)
+	if err != nil {
+		s.Close()
+		return
+	}
+	w.subscriber, w.sub = s, sub
+}
+
+func (w *Watcher) run() {
+	defer close(w.done)
+	var pollC, renewC <-chan time.Time
+	var pollTimer, renewTimer Timer
+	startPolling := func() {
+		pollTimer = w.clock.NewTimer(w.pollInterval)
+		pollC = pollTimer.C()
+	}
+	if w.sub != nil && w.sub.Timeout > 0 {
+		renewTimer = w.clock.NewTimer(w.sub.Timeout / 2)
+		renewC = renewTimer.C()
+	} else if w.sub == nil {
+		startPolling()
+	}
+	defer func() {
+		if pollTimer != nil {
+			pollTimer.Stop()
+		}
+		if renewTimer != nil {
+			renewTimer.Stop()
+		}
+	}()
+
+	for {
+		select {
+		case <-w.ctx.Done():
+			return
+		case ev := <-w.events:
+			w.handleEvent(ev)
+		case <-pollC:
+			status, ip, err := w.poll(w.ctx)
+			w.setErr(err)
+			if err == nil {
+				w.update(status, ip)
+			}
+			pollTimer.Reset(w.pollInterval)
+		case <-renewC:
+			err := w.sub.Renew(watchSubscriptionTimeout)
+			w.setErr(err)
+			if err != nil || w.sub.Timeout <= 0 {
+				if err != nil {
+					w.lock.Lock()
+					w.sub = nil
+					w.lock.Unlock()
+					startPolling()
+				}
+				renewC = nil
+				continue
+			}
+			renewTimer.Reset(w.sub.Timeout / 2)
+		}
+	}
+}
+
+func (w *Watcher) handleEvent(ev *gena.Event) {
+	status, ip := w.Status()
+	newStatus, hasStatus := ev.Get("ConnectionStatus")
+	if hasStatus {
+		status = newStatus
+	}
+	if newIP, ok := ev.Get("ExternalIPAddress"); ok {
+		ip = newIP
+	} else if hasStatus && status == "Connected" {
+		// The address is likely to change on reconnection, but may not be
+		// evented along with the status.
+		if newIP, err := w.conn.GetExternalIPAddress(w.ctx); err == nil {
+			ip = newIP
+		}
+	}
+	w.update(status, ip)
+}
+
+func (w *Watcher) poll(ctx context.Context) (status, ip string, err error) {
+	if status, _, err = w.conn.GetStatusInfo(ctx); err != nil {
+		return
+	}
+	ip, err = w.conn.GetExternalIPAddress(ctx)
+	return
+}
+
+func (w *Watcher) update(status, ip string) {
+	w.lock.Lock()
+	change := StatusChange{
+		Status: status, PrevStatus: w.status,
+		ExternalIP: ip, PrevExternalIP: w.externalIP,
+	}
+	w.status, w.externalIP = status, ip
+	w.lock.Unlock()
+	if change.Status != change.PrevStatus || change.ExternalIPChanged() {
+		w.notify(change)
+	}
+}
+
+// notify calls the function of w with change, recovering from its panics so
+// that they do not stop the watching.
+func (w *Watcher) notify(change StatusChange) {
+	defer logging.Recover(nil, "goupnp: panic in status change callback")
+	w.fn(change)
+}
+
+func (w *Watcher) setErr(err error) {
+	w.lock.Lock()
+	w.err = err
+	w.lock.Unlock()
+}
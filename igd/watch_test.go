@@ -0,0 +1,69 @@
+package igd
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/huin/goupnp/clock"
+)
+
+func testWatcher(t *testing.T, subscribe bool) {
+	e, pm := newTestPortMapper(t)
+	defer e.Close()
+	changes := make(chan StatusChange, 10)
+	fc := clock.NewFake(time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC))
+	// A non-positive interval polls at the default one.
+	w, err := watch(context.Background(), pm.Connection(), 0, func(c StatusChange) {
+		changes <- c
+	}, subscribe, []WatchOption{WithWatchClock(fc)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer w.Close()
+	if w.Evented() != subscribe {
+		t.Errorf("Evented() = %t, want %t", w.Evented(), subscribe)
+	}
+
+	next := func() StatusChange {
+		if !subscribe {
+			// Wait for the next poll to be scheduled, and make it.
+			for fc.Pending() == 0 {
+				time.Sleep(time.Millisecond)
+			}
+			fc.Advance(DefaultWatchPollInterval)
+		}
+		select {
+		case c := <-changes:
+			return c
+		case <-time.After(5 * time.Second):
+			t.Fatal("timed out waiting for a status change")
+		}
+		return StatusChange{}
+	}
+	e.SetConnectionStatus("Disconnected")
+	if c := next(); !c.Disconnected() {
+		t.Errorf("got change %+v, want a disconnection", c)
+	}
+	e.SetExternalIP("198.51.100.7")
+	e.SetConnectionStatus("Connected")
+	c := next()
+	if c.Status != "Connected" {
+		// An evented address change may come separately.
+		c = next()
+	}
+	if !c.Reconnected() || c.ExternalIP != "198.51.100.7" {
+		t.Errorf("got change %+v, want a reconnection with the new address", c)
+	}
+	if status, ip := w.Status(); status != "Connected" || ip != "198.51.100.7" {
+		t.Errorf("Status() = %q, %q", status, ip)
+	}
+}
+
+func TestWatcherEvents(t *testing.T) {
+	testWatcher(t, true)
+}
+
+func TestWatcherPolling(t *testing.T) {
+	testWatcher(t, false)
+}
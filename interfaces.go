@@ -0,0 +1,53 @@
+package goupnp
+
+import (
+	"net"
+
+	"github.com/huin/goupnp/internal/netif"
+)
+
+// SetInterfaces sets the functions listing the network interfaces and their
+// addresses, for goupnp and its subpackages: the interfaces that SSDP searches
+// and advertisements are multicast on, unless restricted with WithInterfaces
+// or the Interfaces of an advertiser or server, and the addresses that hosted
+// devices listen on and advertise. It is for platforms such as iOS and
+// Android, where net.Interfaces is restricted or misleading, to supply the
+// interfaces obtained from the platform APIs. A nil function restores that of
+// the net package.
+func SetInterfaces(list func() ([]net.Interface, error), addrs func(*net.Interface) ([]net.Addr, error)) {
+	netif.Set(list, addrs)
+}
+
+// InterfaceAddrs is a network interface and its addresses.
+type InterfaceAddrs struct {
+	net.Interface
+	Addrs []net.Addr
+}
+
+// SetInterfaceList sets the network interfaces and their addresses to ifs,
+// as for SetInterfaces. The addresses of an interface are found by its Index,
+// or by its Name if it has no Index. A nil list restores those of the net
+// package.
+func SetInterfaceList(ifs []InterfaceAddrs) {
+	if ifs == nil {
+		netif.Set(nil, nil)
+		return
+	}
+	ifs = append([]InterfaceAddrs(nil), ifs...)
+	list := func() ([]net.Interface, error) {
+		l := make([]net.Interface, len(ifs))
+		for i := range ifs {
+			l[i] = ifs[i].Interface
+		}
+		return l, nil
+	}
+	addrs := func(ifc *net.Interface) ([]net.Addr, error) {
+		for i := range ifs {
+			if (ifc.Index != 0 && ifs[i].Index == ifc.Index) || (ifc.Index == 0 && ifs[i].Name == ifc.Name) {
+				return ifs[i].Addrs, nil
+			}
+		}
+		return nil, nil
+	}
+	netif.Set(list, addrs)
+}
@@ -0,0 +1,35 @@
+package goupnp
+
+import (
+	"net"
+	"testing"
+
+	"github.com/huin/goupnp/internal/netif"
+)
+
+func TestSetInterfaceList(t *testing.T) {
+	defer SetInterfaceList(nil)
+	wlan := &net.IPNet{IP: net.IPv4(192, 168, 1, 20), Mask: net.CIDRMask(24, 32)}
+	SetInterfaceList([]InterfaceAddrs{
+		{Interface: net.Interface{Index: 7, Name: "wlan0", Flags: net.FlagUp | net.FlagMulticast}, Addrs: []net.Addr{wlan}},
+		{Interface: net.Interface{Name: "rmnet0", Flags: net.FlagUp}},
+	})
+
+	ifs, err := netif.List()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ifs) != 2 || ifs[0].Name != "wlan0" || ifs[1].Name != "rmnet0" {
+		t.Fatalf("got interfaces %v", ifs)
+	}
+	addrs, err := netif.Addrs(&net.Interface{Index: 7})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(addrs) != 1 || addrs[0] != wlan {
+		t.Errorf("got addresses %v of wlan0, want %v", addrs, wlan)
+	}
+	if addrs, _ := netif.Addrs(&net.Interface{Name: "rmnet0"}); len(addrs) != 0 {
+		t.Errorf("got addresses %v of rmnet0, want none", addrs)
+	}
+}
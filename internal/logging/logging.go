@@ -0,0 +1,71 @@
+// Package logging is the logging shared by the packages of goupnp: the logger
+// of components given none, and the names of the attributes that they log.
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"runtime/debug"
+	"sync/atomic"
+)
+
+// The names of the attributes of log records.
+const (
+	KeyUDN       = "udn"
+	KeyService   = "service"
+	KeyAction    = "action"
+	KeyInterface = "interface"
+	KeyRemote    = "remote"
+	KeyLocation  = "location"
+	KeySID       = "sid"
+	KeyError     = "err"
+	KeyPanic     = "panic"
+	KeyStack     = "stack"
+)
+
+var defaultLogger atomic.Value // Of *slog.Logger.
+
+// SetDefault sets the logger of components given none. A nil logger restores
+// the default, slog.Default().
+func SetDefault(l *slog.Logger) {
+	defaultLogger.Store(&l)
+}
+
+// Default returns the logger of components given none.
+func Default() *slog.Logger {
+	if l, _ := defaultLogger.Load().(**slog.Logger); l != nil && *l != nil {
+		return *l
+	}
+	return slog.Default()
+}
+
+// Or returns l if it is not nil, and Default otherwise.
+func Or(l *slog.Logger) *slog.Logger {
+	if l != nil {
+		return l
+	}
+	return Default()
+}
+
+// Err returns the attribute of an error.
+func Err(err error) slog.Attr {
+	return slog.Any(KeyError, err)
+}
+
+// Recover recovers from a panic of a callback of the application, logging it
+// to l with msg, so that it does not crash the listener or server shared by
+// others that called it. It must be deferred directly:
+//
+//	defer logging.Recover(l, "gena: event handler panicked")
+func Recover(l *slog.Logger, msg string, attrs ...slog.Attr) {
+	if p := recover(); p != nil {
+		LogPanic(l, msg, p, attrs...)
+	}
+}
+
+// LogPanic logs p, as recovered from a panic, with the stack of the panicking
+// goroutine, as an error.
+func LogPanic(l *slog.Logger, msg string, p interface{}, attrs ...slog.Attr) {
+	attrs = append(attrs, slog.Any(KeyPanic, p), slog.String(KeyStack, string(debug.Stack())))
+	Or(l).LogAttrs(context.Background(), slog.LevelError, msg, attrs...)
+}
@@ -0,0 +1,28 @@
+package logging
+
+import (
+	"bytes"
+	"log/slog"
+	"testing"
+)
+
+func TestDefault(t *testing.T) {
+	defer SetDefault(nil)
+	if Default() != slog.Default() {
+		t.Error("Default() is not slog.Default() when unset")
+	}
+	var buf bytes.Buffer
+	l := slog.New(slog.NewTextHandler(&buf, nil))
+	SetDefault(l)
+	if Default() != l || Or(nil) != l {
+		t.Error("Default() is not the logger set")
+	}
+	other := slog.New(slog.NewTextHandler(&buf, nil))
+	if Or(other) != other {
+		t.Error("Or() did not return the logger given")
+	}
+	SetDefault(nil)
+	if Default() != slog.Default() {
+		t.Error("Default() is not slog.Default() after SetDefault(nil)")
+	}
+}
@@ -0,0 +1,167 @@
+package logging
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/huin/goupnp/clock"
+	"github.com/huin/goupnp/metrics"
+)
+
+// The defaults of Reporter.
+const (
+	DefaultReportInterval = time.Minute
+	DefaultReportBurst    = 10
+	// maxReportKeys bounds the errors remembered, against peers sending
+	// endless distinct errors.
+	maxReportKeys = 1024
+)
+
+// Reporter logs the malformed messages received from other devices, which a
+// single broken device can send many of: each error of a peer is logged at
+// most once per Interval, with the number of its repeats suppressed since,
+// and at most Burst distinct errors are logged per Interval. All are counted
+// as metrics.MalformedMessages. Its zero value is ready to use.
+type Reporter struct {
+	// Interval is DefaultReportInterval if zero.
+	Interval time.Duration
+	// Burst is DefaultReportBurst if zero.
+	Burst int
+	// Clock is clock.Real if nil.
+	Clock clock.Clock
+
+	lock        sync.Mutex // Protects all below.
+	reports     map[string]*report
+	windowStart time.Time
+	logged      int
+}
+
+// report is the state of an error of a peer.
+type report struct {
+	last       time.Time
+	suppressed int
+	// msg and peer are those last reported, for reporting the repeats
+	// suppressed when the report is evicted.
+	msg, peer string
+}
+
+// reporters are the Reporters of ReportMalformed, one per logger, so that the
+// reports of components logging to different loggers do not suppress each
+// other.
+var reporters sync.Map // Of *slog.Logger to *Reporter.
+
+// ReportMalformed reports a malformed message of component, from peer, with
+// the Reporter of l, or of the default logger if l is nil.
+func ReportMalformed(l *slog.Logger, component, msg, peer string, err error, attrs ...slog.Attr) {
+	l = Or(l)
+	r, ok := reporters.Load(l)
+	if !ok {
+		r, _ = reporters.LoadOrStore(l, new(Reporter))
+	}
+	r.(*Reporter).Report(l, component, msg, peer, err, attrs...)
+}
+
+// ResetReports forgets the Reporters of ReportMalformed, and so the reports
+// that they suppress, for tests.
+func ResetReports() {
+	reporters.Range(func(k, _ interface{}) bool {
+		reporters.Delete(k)
+		return true
+	})
+}
+
+// Report reports a malformed message of component, e.g. "ssdp", received
+// from peer, an address of the form "host:port", logging it to l as a warning
+// with msg and attrs unless suppressed. The repeats of reports that are
+// forgotten, once they have not been made for an Interval or to make room for
+// others, are logged as they are.
+func (r *Reporter) Report(l *slog.Logger, component, msg, peer string, err error, attrs ...slog.Attr) {
+	metrics.Inc(metrics.MalformedMessages, metrics.Component(component))
+	suppressed, ok, evicted := r.allow(component, msg, peer, err)
+	for _, rep := range evicted {
+		Or(l).LogAttrs(context.Background(), slog.LevelWarn, rep.msg,
+			slog.String(KeyRemote, rep.peer), slog.Int("suppressed", rep.suppressed))
+	}
+	if !ok {
+		return
+	}
+	attrs = append(attrs, slog.String(KeyRemote, peer))
+	if err != nil {
+		attrs = append(attrs, Err(err))
+	}
+	if suppressed > 0 {
+		attrs = append(attrs, slog.Int("suppressed", suppressed))
+	}
+	Or(l).LogAttrs(context.Background(), slog.LevelWarn, msg, attrs...)
+}
+
+// allow returns whether a report is to be logged, the number of its repeats
+// suppressed since it last was, and the reports forgotten with repeats
+// suppressed.
+func (r *Reporter) allow(component, msg, peer string, err error) (int, bool, []report) {
+	// Ports are left out, as they differ between the packets of a peer.
+	host := peer
+	if h, _, splitErr := net.SplitHostPort(peer); splitErr == nil {
+		host = h
+	}
+	key := component + "\x00" + host
+	if err != nil {
+		key += "\x00" + err.Error()
+	}
+	interval, burst := r.Interval, r.Burst
+	if interval <= 0 {
+		interval = DefaultReportInterval
+	}
+	if burst <= 0 {
+		burst = DefaultReportBurst
+	}
+	now := clock.Or(r.Clock).Now()
+
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	var evicted []report
+	evict := func(k string) {
+		if rep := r.reports[k]; rep.suppressed > 0 {
+			evicted = append(evicted, *rep)
+		}
+		delete(r.reports, k)
+	}
+	if now.Sub(r.windowStart) >= interval {
+		r.windowStart, r.logged = now, 0
+		for k, rep := range r.reports {
+			if k != key && now.Sub(rep.last) >= interval {
+				evict(k)
+			}
+		}
+	}
+	rep := r.reports[key]
+	if rep == nil {
+		if len(r.reports) >= maxReportKeys {
+			// Make room by forgetting the report least recently logged.
+			var oldest string
+			for k, rep := range r.reports {
+				if oldest == "" || rep.last.Before(r.reports[oldest].last) {
+					oldest = k
+				}
+			}
+			evict(oldest)
+		}
+		if r.reports == nil {
+			r.reports = make(map[string]*report)
+		}
+		rep = new(report)
+		r.reports[key] = rep
+	}
+	rep.msg, rep.peer = msg, peer
+	if (!rep.last.IsZero() && now.Sub(rep.last) < interval) || r.logged >= burst {
+		rep.suppressed++
+		return 0, false, evicted
+	}
+	suppressed := rep.suppressed
+	rep.last, rep.suppressed = now, 0
+	r.logged++
+	return suppressed, true, evicted
+}
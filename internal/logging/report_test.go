@@ -0,0 +1,73 @@
+package logging
+
+import (
+	"bytes"
+	"errors"
+	"log/slog"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/huin/goupnp/clock"
+)
+
+func TestReporter(t *testing.T) {
+	var buf bytes.Buffer
+	l := slog.New(slog.NewTextHandler(&buf, nil))
+	fc := clock.NewFake(time.Unix(1000, 0))
+	r := &Reporter{Burst: 2, Clock: fc}
+	errBad := errors.New("bad header")
+
+	// Repeats of an error of a peer, whatever its port, are suppressed.
+	for i := 0; i < 5; i++ {
+		r.Report(l, "ssdp", "bad message", "192.0.2.1:"+strconv.Itoa(1900+i), errBad)
+	}
+	// Only Burst distinct errors are logged per interval.
+	r.Report(l, "ssdp", "bad message", "192.0.2.2:1900", errBad)
+	r.Report(l, "ssdp", "bad message", "192.0.2.3:1900", errBad)
+	if got := strings.Count(buf.String(), "bad message"); got != 2 {
+		t.Fatalf("got %d log records, want 2:\n%s", got, buf.String())
+	}
+
+	fc.Advance(time.Minute)
+	buf.Reset()
+	r.Report(l, "ssdp", "bad message", "192.0.2.1:1900", errBad)
+	if !strings.Contains(buf.String(), "suppressed=4") {
+		t.Errorf("want the count of suppressed repeats, got:\n%s", buf.String())
+	}
+	// The peer suppressed by the burst is forgotten, with its repeats.
+	if !strings.Contains(buf.String(), "remote=192.0.2.3:1900 suppressed=1") {
+		t.Errorf("want the repeats of forgotten reports, got:\n%s", buf.String())
+	}
+}
+
+func TestReporterFull(t *testing.T) {
+	var buf bytes.Buffer
+	l := slog.New(slog.NewTextHandler(&buf, nil))
+	fc := clock.NewFake(time.Unix(1000, 0))
+	r := &Reporter{Burst: 2 * maxReportKeys, Clock: fc}
+	r.Report(l, "ssdp", "bad message", "192.0.2.1:1900", nil)
+	r.Report(l, "ssdp", "bad message", "192.0.2.1:1900", nil)
+	for i := 1; i < maxReportKeys; i++ {
+		fc.Advance(time.Millisecond)
+		r.Report(l, "ssdp", "bad message", "10.0.0."+strconv.Itoa(i)+":1900", nil)
+	}
+	buf.Reset()
+	// A new error is logged, making room by forgetting the oldest report.
+	r.Report(l, "ssdp", "bad message", "192.0.2.9:1900", nil)
+	if !strings.Contains(buf.String(), "remote=192.0.2.1:1900 suppressed=1") || !strings.Contains(buf.String(), "remote=192.0.2.9:1900") {
+		t.Errorf("got:\n%s", buf.String())
+	}
+}
+
+func TestReportMalformedPerLogger(t *testing.T) {
+	defer ResetReports()
+	for i := 0; i < 2; i++ {
+		var buf bytes.Buffer
+		ReportMalformed(slog.New(slog.NewTextHandler(&buf, nil)), "gena", "bad event", "192.0.2.1:1900", nil)
+		if !strings.Contains(buf.String(), "bad event") {
+			t.Errorf("report to logger %d suppressed by that to another", i)
+		}
+	}
+}
@@ -0,0 +1,53 @@
+// Package netif lists the network interfaces and addresses used by the
+// packages of goupnp for multicast and for advertised locations. They are
+// those of the net package unless set with goupnp.SetInterfaces, e.g. on
+// platforms where net.Interfaces is restricted.
+package netif
+
+import (
+	"net"
+	"sync"
+)
+
+// ListFunc lists network interfaces, like net.Interfaces.
+type ListFunc func() ([]net.Interface, error)
+
+// AddrsFunc lists the addresses of a network interface, like
+// net.Interface.Addrs.
+type AddrsFunc func(*net.Interface) ([]net.Addr, error)
+
+var (
+	lock  sync.RWMutex // Protects all below.
+	list  ListFunc
+	addrs AddrsFunc
+)
+
+// Set sets the functions listing interfaces and their addresses. Either may
+// be nil for that of the net package.
+func Set(l ListFunc, a AddrsFunc) {
+	lock.Lock()
+	defer lock.Unlock()
+	list, addrs = l, a
+}
+
+// List lists the network interfaces.
+func List() ([]net.Interface, error) {
+	lock.RLock()
+	l := list
+	lock.RUnlock()
+	if l == nil {
+		return net.Interfaces()
+	}
+	return l()
+}
+
+// Addrs lists the addresses of ifc.
+func Addrs(ifc *net.Interface) ([]net.Addr, error) {
+	lock.RLock()
+	a := addrs
+	lock.RUnlock()
+	if a == nil {
+		return ifc.Addrs()
+	}
+	return a(ifc)
+}
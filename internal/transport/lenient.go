@@ -0,0 +1,42 @@
+package transport
+
+import "io"
+
+// LenientBody returns body, ending at io.EOF rather than failing with
+// io.ErrUnexpectedEOF, for devices whose chunked responses end without their
+// final chunk, or that close the connection before their Content-Length.
+func LenientBody(body io.ReadCloser) io.ReadCloser {
+	return lenientBody{body}
+}
+
+type lenientBody struct {
+	io.ReadCloser
+}
+
+func (b lenientBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err == io.ErrUnexpectedEOF {
+		err = io.EOF
+	}
+	return n, err
+}
+
+// LenientBodyDetect is as LenientBody, also setting *truncated once body ends
+// early.
+func LenientBodyDetect(body io.ReadCloser, truncated *bool) io.ReadCloser {
+	return detectingLenientBody{body, truncated}
+}
+
+type detectingLenientBody struct {
+	io.ReadCloser
+	truncated *bool
+}
+
+func (b detectingLenientBody) Read(p []byte) (int, error) {
+	n, err := b.ReadCloser.Read(p)
+	if err == io.ErrUnexpectedEOF {
+		*b.truncated = true
+		err = io.EOF
+	}
+	return n, err
+}
@@ -0,0 +1,117 @@
+// Package transport is the HTTP transport shared by the packages of goupnp for
+// the requests of clients given none: description fetches, SOAP actions and
+// GENA subscriptions. It dials through the dialer and proxy set with
+// goupnp.SetDialer and goupnp.SetProxy.
+package transport
+
+import (
+	"context"
+	"net"
+	"net/http"
+	"net/url"
+	"sync"
+)
+
+// DialFunc dials a connection, like net.Dialer.DialContext.
+type DialFunc func(ctx context.Context, network, address string) (net.Conn, error)
+
+var (
+	lock      sync.RWMutex // Protects all below.
+	dial      DialFunc
+	proxyDial bool // Whether dial was set with SetProxyDial.
+	proxy     func(*http.Request) (*url.URL, error)
+	transport *http.Transport // Nil unless dial or proxy is set.
+)
+
+// Set sets the dialer and HTTP proxy of the default transport. Either may be
+// nil to dial directly.
+func Set(d DialFunc, p func(*http.Request) (*url.URL, error)) {
+	lock.Lock()
+	defer lock.Unlock()
+	set(d, false, p)
+}
+
+// SetDial sets the dialer of the default transport, keeping its proxy.
+func SetDial(d DialFunc) {
+	lock.Lock()
+	defer lock.Unlock()
+	set(d, false, proxy)
+}
+
+// SetProxy sets the HTTP proxy of the default transport, keeping its dialer.
+func SetProxy(p func(*http.Request) (*url.URL, error)) {
+	lock.Lock()
+	defer lock.Unlock()
+	set(dial, proxyDial, p)
+}
+
+// SetProxyDial sets the dialer of the default transport to d, that of a proxy
+// such as a SOCKS5 one, which replaces the dialer and the HTTP proxy.
+func SetProxyDial(d DialFunc) {
+	lock.Lock()
+	defer lock.Unlock()
+	set(d, true, nil)
+}
+
+// ClearProxy removes the HTTP proxy of the default transport, and its dialer
+// if set with SetProxyDial, keeping one set with Set or SetDial.
+func ClearProxy() {
+	lock.Lock()
+	defer lock.Unlock()
+	if proxyDial {
+		set(nil, false, nil)
+	} else {
+		set(dial, false, nil)
+	}
+}
+
+// set sets the dialer and HTTP proxy of the default transport. lock must be
+// held.
+func set(d DialFunc, isProxyDial bool, p func(*http.Request) (*url.URL, error)) {
+	dial, proxyDial, proxy = d, isProxyDial && d != nil, p
+	if transport != nil {
+		transport.CloseIdleConnections()
+	}
+	transport = nil
+	if d == nil && p == nil {
+		return
+	}
+	t := http.DefaultTransport.(*http.Transport).Clone()
+	t.Proxy = p
+	if d != nil {
+		t.DialContext = d
+	}
+	transport = t
+}
+
+// Default returns the default transport, or nil for http.DefaultTransport if
+// no dialer or proxy is set.
+func Default() http.RoundTripper {
+	lock.RLock()
+	defer lock.RUnlock()
+	if transport == nil {
+		return nil
+	}
+	return transport
+}
+
+// Client returns c, with the default transport if it has none.
+func Client(c http.Client) *http.Client {
+	if c.Transport == nil {
+		c.Transport = Default()
+	}
+	return &c
+}
+
+// Dial dials address through the dialer set with Set or SetDial, or directly
+// if none is set.
+func Dial(ctx context.Context, network, address string) (net.Conn, error) {
+	lock.RLock()
+	d := dial
+	lock.RUnlock()
+	if d == nil {
+		var nd net.Dialer
+		d = nd.DialContext
+	}
+	return d(ctx, network, address)
+}
@@ -0,0 +1,30 @@
+package goupnp
+
+import (
+	"log/slog"
+
+	"github.com/huin/goupnp/internal/logging"
+)
+
+// The names of the attributes of the log records of goupnp and its
+// subpackages.
+const (
+	LogKeyUDN       = logging.KeyUDN       // UDN of a device.
+	LogKeyService   = logging.KeyService   // Service type or ID.
+	LogKeyAction    = logging.KeyAction    // Name of a SOAP action.
+	LogKeyInterface = logging.KeyInterface // Name of a network interface.
+	LogKeyRemote    = logging.KeyRemote    // Address of the peer of a message.
+	LogKeyLocation  = logging.KeyLocation  // URL of a device description.
+	LogKeySID       = logging.KeySID       // SID of an event subscription.
+	LogKeyError     = logging.KeyError
+)
+
+// SetLogger sets the logger of the components of goupnp and its subpackages
+// that are not given one through their Logger fields, such as discovery, event
+// subscribers and hosted devices. Failures that are not returned, such as
+// malformed messages from other devices, are logged at the warning level, and
+// actions and discarded responses at the debug level. A nil logger restores
+// the default, slog.Default().
+func SetLogger(l *slog.Logger) {
+	logging.SetDefault(l)
+}
@@ -0,0 +1,133 @@
+// Package metrics counts and times the network operations of goupnp and its
+// subpackages, so that programs embedding them can monitor their UPnP stack.
+// Measurements are passed to a Recorder set with SetRecorder, such as a
+// Prometheus from NewPrometheus, which serves them in the Prometheus text
+// format:
+//
+//	p := metrics.NewPrometheus()
+//	metrics.SetRecorder(p)
+//	http.Handle("/metrics", p)
+//
+// Nothing is recorded if no Recorder is set. A Recorder can also adapt
+// another metrics library, such as the Prometheus client library, by
+// registering a vector of each of the metrics below.
+package metrics
+
+import (
+	"sync/atomic"
+)
+
+// The metrics of goupnp. The names of counters end with "_total".
+const (
+	// Discoveries counts SSDP searches, labelled by LabelSearchTarget.
+	Discoveries = "goupnp_discoveries_total"
+	// SSDPResponses counts the unique valid responses to SSDP searches,
+	// labelled by LabelSearchTarget.
+	SSDPResponses = "goupnp_ssdp_responses_total"
+	// SOAPDuration is a histogram of the durations of SOAP actions in
+	// seconds, labelled by LabelAction and LabelResult.
+	SOAPDuration = "goupnp_soap_action_duration_seconds"
+	// EventNotifications counts received GENA event messages, unicast and
+	// multicast, labelled by LabelResult, ResultError for malformed messages.
+	EventNotifications = "goupnp_gena_notifications_total"
+	// SubscriptionRenewals counts renewals of GENA subscriptions, labelled by
+	// LabelResult.
+	SubscriptionRenewals = "goupnp_gena_subscription_renewals_total"
+	// MalformedMessages counts the messages from other devices that could
+	// not be parsed or handled, whether or not they were logged, labelled by
+	// LabelComponent.
+	MalformedMessages = "goupnp_malformed_messages_total"
+)
+
+// The names of the labels of metrics.
+const (
+	LabelSearchTarget = "search_target"
+	LabelAction       = "action"
+	LabelResult       = "result"
+	LabelComponent    = "component"
+)
+
+// The values of LabelResult.
+const (
+	ResultOK    = "ok"
+	ResultError = "error"
+)
+
+// Label is a label of a measurement.
+type Label struct {
+	Name, Value string
+}
+
+// SearchTarget returns the LabelSearchTarget label of st.
+func SearchTarget(st string) Label {
+	return Label{Name: LabelSearchTarget, Value: st}
+}
+
+// Action returns the LabelAction label of the SOAP action name.
+func Action(name string) Label {
+	return Label{Name: LabelAction, Value: name}
+}
+
+// Component returns the LabelComponent label of a package of goupnp, e.g.
+// "ssdp".
+func Component(name string) Label {
+	return Label{Name: LabelComponent, Value: name}
+}
+
+// Result returns the LabelResult label of an operation that failed with err,
+// or succeeded if err is nil.
+func Result(err error) Label {
+	if err != nil {
+		return Label{Name: LabelResult, Value: ResultError}
+	}
+	return Label{Name: LabelResult, Value: ResultOK}
+}
+
+// Recorder records measurements. Its methods are called concurrently.
+type Recorder interface {
+	// Add adds delta, which is positive, to the counter name of labels.
+	Add(name string, delta float64, labels ...Label)
+	// Observe records value in the histogram name of labels.
+	Observe(name string, value float64, labels ...Label)
+}
+
+var recorder atomic.Value // Of *Recorder.
+
+// SetRecorder sets the Recorder of the measurements of goupnp. A nil Recorder
+// stops recording.
+func SetRecorder(r Recorder) {
+	recorder.Store(&r)
+}
+
+func current() Recorder {
+	if r, _ := recorder.Load().(*Recorder); r != nil {
+		return *r
+	}
+	return nil
+}
+
+// Add adds delta to a counter with the Recorder set with SetRecorder, if any.
+func Add(name string, delta float64, labels ...Label) {
+	if r := current(); r != nil {
+		r.Add(name, delta, labels...)
+	}
+}
+
+// Inc adds one to a counter with the Recorder set with SetRecorder, if any.
+func Inc(name string, labels ...Label) {
+	Add(name, 1, labels...)
+}
+
+// Observe records a value of a histogram with the Recorder set with
+// SetRecorder, if any.
+func Observe(name string, value float64, labels ...Label) {
+	if r := current(); r != nil {
+		r.Observe(name, value, labels...)
+	}
+}
+
+// Enabled returns whether a Recorder is set, so that callers can skip
+// measuring otherwise.
+func Enabled() bool {
+	return current() != nil
+}
@@ -0,0 +1,217 @@
+package metrics
+
+import (
+	"bufio"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// DefaultBuckets are the upper bounds of the histogram buckets of a
+// Prometheus, in seconds, as those of the Prometheus client library.
+var DefaultBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// help is the HELP text of the metrics of goupnp.
+var help = map[string]string{
+	Discoveries:          "SSDP searches performed.",
+	SSDPResponses:        "Unique valid responses to SSDP searches.",
+	SOAPDuration:         "Durations of SOAP actions in seconds.",
+	EventNotifications:   "GENA event messages received.",
+	SubscriptionRenewals: "Renewals of GENA subscriptions.",
+	MalformedMessages:    "Messages from other devices that could not be parsed or handled.",
+}
+
+// Prometheus is a Recorder that holds the measurements in memory, and serves
+// them in the Prometheus text exposition format as an http.Handler.
+type Prometheus struct {
+	buckets []float64
+
+	mu       sync.Mutex
+	families map[string]*family
+}
+
+var _ Recorder = new(Prometheus)
+var _ http.Handler = new(Prometheus)
+
+type family struct {
+	histogram bool
+	series    map[string]*series // By formatted labels.
+}
+
+type series struct {
+	value  float64  // Of counters, and the sum of histograms.
+	counts []uint64 // Of histograms, by bucket, the last for +Inf.
+}
+
+// NewPrometheus returns a Prometheus whose histograms have buckets of the
+// given upper bounds, in increasing order, or DefaultBuckets if none are
+// given.
+func NewPrometheus(buckets ...float64) *Prometheus {
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+	return &Prometheus{
+		buckets:  append([]float64(nil), buckets...),
+		families: make(map[string]*family),
+	}
+}
+
+// Add implements Recorder.
+func (p *Prometheus) Add(name string, delta float64, labels ...Label) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if s := p.series(name, false, labels); s != nil {
+		s.value += delta
+	}
+}
+
+// Observe implements Recorder.
+func (p *Prometheus) Observe(name string, value float64, labels ...Label) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	s := p.series(name, true, labels)
+	if s == nil {
+		return
+	}
+	s.value += value
+	i := sort.SearchFloat64s(p.buckets, value)
+	s.counts[i]++
+}
+
+// series returns the series of labels of the metric name, creating it if
+// needed, or nil if name is already a metric of the other type.
+func (p *Prometheus) series(name string, histogram bool, labels []Label) *series {
+	f := p.families[name]
+	if f == nil {
+		f = &family{histogram: histogram, series: make(map[string]*series)}
+		p.families[name] = f
+	} else if f.histogram != histogram {
+		return nil
+	}
+	key := formatLabels(labels)
+	s := f.series[key]
+	if s == nil {
+		s = new(series)
+		if histogram {
+			s.counts = make([]uint64, len(p.buckets)+1)
+		}
+		f.series[key] = s
+	}
+	return s
+}
+
+// ServeHTTP implements http.Handler, serving the measurements.
+func (p *Prometheus) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4; charset=utf-8")
+	p.WriteTo(w)
+}
+
+// WriteTo writes the measurements to w in the Prometheus text exposition
+// format, the metrics and their series in order of name and labels.
+func (p *Prometheus) WriteTo(w io.Writer) (int64, error) {
+	cw := &countingWriter{w: w}
+	bw := bufio.NewWriter(cw)
+
+	p.mu.Lock()
+	names := make([]string, 0, len(p.families))
+	for name := range p.families {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		f := p.families[name]
+		if h, ok := help[name]; ok {
+			bw.WriteString("# HELP " + name + " " + h + "\n")
+		}
+		if f.histogram {
+			bw.WriteString("# TYPE " + name + " histogram\n")
+		} else {
+			bw.WriteString("# TYPE " + name + " counter\n")
+		}
+		keys := make([]string, 0, len(f.series))
+		for key := range f.series {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			s := f.series[key]
+			if !f.histogram {
+				writeSample(bw, name, key, "", s.value)
+				continue
+			}
+			var count uint64
+			for i, c := range s.counts {
+				count += c
+				le := math.Inf(1)
+				if i < len(p.buckets) {
+					le = p.buckets[i]
+				}
+				writeSample(bw, name+"_bucket", key, `le="`+formatFloat(le)+`"`, float64(count))
+			}
+			writeSample(bw, name+"_sum", key, "", s.value)
+			writeSample(bw, name+"_count", key, "", float64(count))
+		}
+	}
+	p.mu.Unlock()
+
+	err := bw.Flush()
+	return cw.n, err
+}
+
+func writeSample(w *bufio.Writer, name, labels, extra string, value float64) {
+	w.WriteString(name)
+	if labels != "" || extra != "" {
+		w.WriteByte('{')
+		w.WriteString(labels)
+		if labels != "" && extra != "" {
+			w.WriteByte(',')
+		}
+		w.WriteString(extra)
+		w.WriteByte('}')
+	}
+	w.WriteByte(' ')
+	w.WriteString(formatFloat(value))
+	w.WriteByte('\n')
+}
+
+// formatLabels formats labels as within the braces of a sample, in order of
+// name.
+func formatLabels(labels []Label) string {
+	if len(labels) == 0 {
+		return ""
+	}
+	sorted := append([]Label(nil), labels...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].Name < sorted[j].Name })
+	parts := make([]string, len(sorted))
+	for i, l := range sorted {
+		parts[i] = l.Name + `="` + labelValueEscaper.Replace(l.Value) + `"`
+	}
+	return strings.Join(parts, ",")
+}
+
+var labelValueEscaper = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (cw *countingWriter) Write(p []byte) (int, error) {
+	n, err := cw.w.Write(p)
+	cw.n += int64(n)
+	return n, err
+}
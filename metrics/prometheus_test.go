@@ -0,0 +1,82 @@
+package metrics
+
+import (
+	"bytes"
+	"errors"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestPrometheus(t *testing.T) {
+	p := NewPrometheus(0.1, 1)
+	p.Add(Discoveries, 1, SearchTarget("ssdp:all"))
+	p.Add(Discoveries, 2, SearchTarget("ssdp:all"))
+	p.Add(Discoveries, 1, SearchTarget(`odd"st`))
+	p.Observe(SOAPDuration, 0.05, Action("Play"), Result(nil))
+	p.Observe(SOAPDuration, 0.5, Result(nil), Action("Play"))
+	p.Observe(SOAPDuration, 3, Action("Play"), Result(errors.New("failed")))
+	// Ignored, as Discoveries is a counter.
+	p.Observe(Discoveries, 1)
+
+	want := `# HELP goupnp_discoveries_total SSDP searches performed.
+# TYPE goupnp_discoveries_total counter
+goupnp_discoveries_total{search_target="odd\"st"} 1
+goupnp_discoveries_total{search_target="ssdp:all"} 3
+# HELP goupnp_soap_action_duration_seconds Durations of SOAP actions in seconds.
+# TYPE goupnp_soap_action_duration_seconds histogram
+goupnp_soap_action_duration_seconds_bucket{action="Play",result="error",le="0.1"} 0
+goupnp_soap_action_duration_seconds_bucket{action="Play",result="error",le="1"} 0
+goupnp_soap_action_duration_seconds_bucket{action="Play",result="error",le="+Inf"} 1
+goupnp_soap_action_duration_seconds_sum{action="Play",result="error"} 3
+goupnp_soap_action_duration_seconds_count{action="Play",result="error"} 1
+goupnp_soap_action_duration_seconds_bucket{action="Play",result="ok",le="0.1"} 1
+goupnp_soap_action_duration_seconds_bucket{action="Play",result="ok",le="1"} 2
+goupnp_soap_action_duration_seconds_bucket{action="Play",result="ok",le="+Inf"} 2
+goupnp_soap_action_duration_seconds_sum{action="Play",result="ok"} 0.55
+goupnp_soap_action_duration_seconds_count{action="Play",result="ok"} 2
+`
+	var buf bytes.Buffer
+	n, err := p.WriteTo(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := buf.String(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+	if n != int64(buf.Len()) {
+		t.Errorf("WriteTo returned %d, wrote %d bytes", n, buf.Len())
+	}
+
+	rec := httptest.NewRecorder()
+	p.ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	if ct := rec.Header().Get("Content-Type"); !strings.HasPrefix(ct, "text/plain; version=0.0.4") {
+		t.Errorf("got Content-Type %q", ct)
+	}
+	if rec.Body.String() != want {
+		t.Errorf("served a different exposition")
+	}
+}
+
+func TestSetRecorder(t *testing.T) {
+	Inc(Discoveries)
+	p := NewPrometheus()
+	SetRecorder(p)
+	defer SetRecorder(nil)
+	if !Enabled() {
+		t.Fatal("Enabled() = false after SetRecorder")
+	}
+	Inc(SubscriptionRenewals, Result(nil))
+
+	var buf bytes.Buffer
+	p.WriteTo(&buf)
+	if got := buf.String(); !strings.Contains(got, `goupnp_gena_subscription_renewals_total{result="ok"} 1`) ||
+		strings.Contains(got, Discoveries) {
+		t.Errorf("got:\n%s", got)
+	}
+
+	SetRecorder(nil)
+	if Enabled() {
+		t.Error("Enabled() = true after SetRecorder(nil)")
+	}
+}
@@ -0,0 +1,131 @@
+package goupnp
+
+import (
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/huin/goupnp/httpu"
+	"github.com/huin/goupnp/internal/transport"
+	"github.com/huin/goupnp/store"
+)
+
+// The defaults of the options of discovery.
+const (
+	DefaultSearchWaitSeconds  = 2
+	DefaultSearchSends        = 3
+	DefaultDescriptionTimeout = 3 * time.Second
+)
+
+// discoveryConfig is the configuration of discovery and description fetches.
+type discoveryConfig struct {
+	searchWait         int
+	searchSends        int
+	descriptionTimeout time.Duration
+	transport          http.RoundTripper
+	httpuOptions       []httpu.ClientOption
+	dualStack          bool
+	descriptionStore   store.Store
+	descriptionTTL     time.Duration
+	fetcher            *Fetcher
+	locationRaceDelay  time.Duration
+}
+
+// DiscoveryOption configures the discovery of devices and the fetching of
+// their descriptions, by DiscoverDevices, DeviceByURL, NewServiceClients and
+// their variants.
+type DiscoveryOption func(*discoveryConfig)
+
+func newDiscoveryConfig(opts []DiscoveryOption) *discoveryConfig {
+	c := &discoveryConfig{
+		searchWait:         DefaultSearchWaitSeconds,
+		searchSends:        DefaultSearchSends,
+		descriptionTimeout: DefaultDescriptionTimeout,
+		locationRaceDelay:  DefaultLocationRaceDelay,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithSearchWait sets the MX of SSDP searches, how long devices may wait
+// before responding, in seconds of at least 1. Searches wait for responses
+// for that long, DefaultSearchWaitSeconds by default.
+func WithSearchWait(seconds int) DiscoveryOption {
+	return func(c *discoveryConfig) { c.searchWait = seconds }
+}
+
+// WithSearchSends sets the number of times that each SSDP search request is
+// sent, to make up for lost packets, DefaultSearchSends by default.
+func WithSearchSends(n int) DiscoveryOption {
+	return func(c *discoveryConfig) { c.searchSends = n }
+}
+
+// WithDescriptionTimeout sets the time limit of fetching each device
+// description, DefaultDescriptionTimeout by default.
+func WithDescriptionTimeout(d time.Duration) DiscoveryOption {
+	return func(c *discoveryConfig) { c.descriptionTimeout = d }
+}
+
+// WithTransport sets the transport that device descriptions are fetched with,
+// by default http.DefaultTransport, dialing through the dialer and proxy set
+// with SetDialer and SetProxy.
+func WithTransport(rt http.RoundTripper) DiscoveryOption {
+	return func(c *discoveryConfig) { c.transport = rt }
+}
+
+// WithInterfaces restricts the network interfaces that SSDP searches are sent
+// on, all multicast capable interfaces by default.
+func WithInterfaces(ifs ...net.Interface) DiscoveryOption {
+	return WithHTTPUOptions(httpu.WithInterfaces(ifs...))
+}
+
+// WithInterfaceFilter sets the filter of the network interfaces that SSDP
+// searches are sent on, httpu.DefaultInterfaceFilter by default, which skips
+// virtual adapters on Windows. httpu.AllInterfaces disables filtering.
+func WithInterfaceFilter(f httpu.InterfaceFilter) DiscoveryOption {
+	return WithHTTPUOptions(httpu.WithInterfaceFilter(f))
+}
+
+// WithHTTPUOptions sets options of the HTTPU client that SSDP searches are
+// sent with, such as its logger and the size of the largest response.
+func WithHTTPUOptions(opts ...httpu.ClientOption) DiscoveryOption {
+	return func(c *discoveryConfig) { c.httpuOptions = append(c.httpuOptions, opts...) }
+}
+
+// WithDualStack makes DiscoverDevices search over IPv6 as well as IPv4, in
+// parallel, and merge the responses of each device by UDN, so that each
+// device is returned once whichever families it responded over. IPv4 search
+// only by default.
+func WithDualStack() DiscoveryOption {
+	return func(c *discoveryConfig) { c.dualStack = true }
+}
+
+// WithDescriptionStore keeps the device descriptions fetched in s for ttl,
+// without expiry if zero, so that they are decoded from s rather than
+// fetched again, including after a restart if s is persistent. Descriptions
+// are fetched every time by default. Keys are store.PrefixDescription followed
+// by the location URL.
+func WithDescriptionStore(s store.Store, ttl time.Duration) DiscoveryOption {
+	return func(c *discoveryConfig) { c.descriptionStore, c.descriptionTTL = s, ttl }
+}
+
+// WithFetcher sets the Fetcher that DiscoverDevices fetches the descriptions
+// of the discovered devices through, DefaultFetcher by default.
+func WithFetcher(f *Fetcher) DiscoveryOption {
+	return func(c *discoveryConfig) { c.fetcher = f }
+}
+
+// fetcherOrDefault returns the Fetcher of discovery.
+func (c *discoveryConfig) fetcherOrDefault() *Fetcher {
+	if c.fetcher == nil {
+		return DefaultFetcher
+	}
+	return c.fetcher
+}
+
+// httpClient returns the client that descriptions are fetched with.
+func (c *discoveryConfig) httpClient() *http.Client {
+	return transport.Client(http.Client{Timeout: c.descriptionTimeout, Transport: c.transport})
+}
@@ -0,0 +1,87 @@
+package goupnp
+
+import (
+	"bytes"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/huin/goupnp/store"
+)
+
+type descriptionTransport struct {
+	server   string // The SERVER header of the responses.
+	requests int
+}
+
+func (dt *descriptionTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	dt.requests++
+	return &http.Response{
+		StatusCode: 200,
+		Header:     http.Header{"Server": {dt.server}},
+		Body: ioutil.NopCloser(bytes.NewBufferString(`<?xml version="1.0"?>
+<root xmlns="urn:schemas-upnp-org:device-1-0">
+	<specVersion><major>1</major><minor>0</minor></specVersion>
+	<device>
+		<deviceType>urn:schemas-upnp-org:device:Basic:1</deviceType>
+		<friendlyName>Test</friendlyName>
+		<UDN>uuid:test</UDN>
+	</device>
+</root>`)),
+		Request: req,
+	}, nil
+}
+
+func TestDiscoveryOptions(t *testing.T) {
+	cfg := newDiscoveryConfig(nil)
+	if cfg.searchWait != DefaultSearchWaitSeconds || cfg.searchSends != DefaultSearchSends ||
+		cfg.httpClient().Timeout != DefaultDescriptionTimeout {
+		t.Errorf("bad default configuration %+v", cfg)
+	}
+
+	dt := &descriptionTransport{}
+	cfg = newDiscoveryConfig([]DiscoveryOption{
+		WithSearchWait(5), WithSearchSends(1), WithDescriptionTimeout(time.Second), WithTransport(dt),
+	})
+	if cfg.searchWait != 5 || cfg.searchSends != 1 || cfg.httpClient().Timeout != time.Second {
+		t.Errorf("options not applied: %+v", cfg)
+	}
+
+	loc, _ := url.Parse("http://192.0.2.1:49000/desc.xml")
+	root, err := DeviceByURL(loc, WithTransport(dt))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if root.Device.UDN != "uuid:test" || dt.requests != 1 {
+		t.Errorf("got device %q after %d requests, want uuid:test after 1", root.Device.UDN, dt.requests)
+	}
+}
+
+func TestWithDescriptionStore(t *testing.T) {
+	RegisterQuirks(QuirkRule{Server: "storedos/1.0", Quirks: Quirks{MPost: true}})
+	dt := &descriptionTransport{server: "StoredOS/1.0 UPnP/1.0 test/1"}
+	st := store.NewMemory()
+	loc, _ := url.Parse("http://192.0.2.1:49000/desc.xml")
+	for i := 0; i < 2; i++ {
+		root, err := DeviceByURL(loc, WithTransport(dt), WithDescriptionStore(st, time.Hour))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if root.Device.UDN != "uuid:test" {
+			t.Errorf("got UDN %q, want uuid:test", root.Device.UDN)
+		}
+		// The quirks matching the SERVER header apply to stored descriptions
+		// too.
+		if !root.Quirks().MPost {
+			t.Errorf("request %d: got quirks %+v, want those of the SERVER header", i, root.Quirks())
+		}
+	}
+	if dt.requests != 1 {
+		t.Errorf("got %d description requests, want 1", dt.requests)
+	}
+	if _, ok, _ := st.Get(store.PrefixDescription + loc.String()); !ok {
+		t.Error("description is not stored")
+	}
+}
@@ -0,0 +1,121 @@
+package goupnp
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/huin/goupnp/internal/transport"
+	"github.com/huin/goupnp/upnperr"
+)
+
+// DefaultProbeTimeout is the time limit of probes, unless set with
+// WithProbeTimeout.
+const DefaultProbeTimeout = time.Second
+
+// probeConfig is the configuration of Probe and ProbeURL.
+type probeConfig struct {
+	timeout time.Duration
+	head    bool
+}
+
+// ProbeOption configures Probe and ProbeURL.
+type ProbeOption func(*probeConfig)
+
+// WithProbeTimeout sets the time limit of a probe, DefaultProbeTimeout by
+// default.
+func WithProbeTimeout(d time.Duration) ProbeOption {
+	return func(c *probeConfig) { c.timeout = d }
+}
+
+// WithProbeHEAD makes a probe also send a HEAD request of the URL, after
+// connecting, so that a device whose port is open but whose HTTP server is
+// hung is not taken to be live. Any response is accepted, whatever its
+// status, as control URLs commonly reject HEAD.
+func WithProbeHEAD() ProbeOption {
+	return func(c *probeConfig) { c.head = true }
+}
+
+// ProbeURL checks that the device serving u is reachable, by connecting to
+// its host over TCP, without fetching u. It is quicker than fetching the
+// description, so that stale entries of SSDP caches, e.g. the Location of an
+// ssdp.Entry, can be told apart from live devices. Connections are made
+// through the dialer set with SetDialer, and errors match upnperr.ErrUnreachable
+// or upnperr.ErrTimeout.
+func ProbeURL(ctx context.Context, u *url.URL, opts ...ProbeOption) error {
+	c := &probeConfig{timeout: DefaultProbeTimeout}
+	for _, opt := range opts {
+		opt(c)
+	}
+	addr, err := probeAddr(u)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, c.timeout)
+	defer cancel()
+	conn, err := transport.Dial(ctx, "tcp", addr)
+	if err != nil {
+		return upnperr.Transport(fmt.Sprintf("goupnp: probe of %s", addr), err)
+	}
+	conn.Close()
+	if !c.head {
+		return nil
+	}
+	req, err := http.NewRequest("HEAD", u.String(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := transport.Client(http.Client{}).Do(req.WithContext(ctx))
+	if err != nil {
+		return upnperr.Transport(fmt.Sprintf("goupnp: probe of %s", u), err)
+	}
+	resp.Body.Close()
+	return nil
+}
+
+// Probe checks that the device is reachable, with ProbeURL of the control URL
+// of its first service, or of its presentation URL if it has no services.
+func (device *Device) Probe(ctx context.Context, opts ...ProbeOption) error {
+	u := device.probeURL()
+	if u == nil {
+		return errors.New("goupnp: device has no resolved URL to probe")
+	}
+	return ProbeURL(ctx, u, opts...)
+}
+
+func (device *Device) probeURL() *url.URL {
+	var u *url.URL
+	device.VisitServices(func(srv *Service) {
+		if u == nil && srv.ControlURL.Ok {
+			u = &srv.ControlURL.URL
+		}
+	})
+	if u == nil && device.PresentationURL.Ok {
+		u = &device.PresentationURL.URL
+	}
+	return u
+}
+
+// probeAddr returns the host and port of u, the default port of its scheme
+// if it has none.
+func probeAddr(u *url.URL) (string, error) {
+	if u.Hostname() == "" {
+		return "", fmt.Errorf("goupnp: no host to probe in %q", u)
+	}
+	port := u.Port()
+	if port == "" {
+		switch u.Scheme {
+		case "http", "":
+			port = "80"
+		case "https":
+			port = "443"
+		default:
+			return "", fmt.Errorf("goupnp: cannot probe URL scheme %q", u.Scheme)
+		}
+	}
+	return net.JoinHostPort(u.Hostname(), port), nil
+}
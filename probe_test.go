@@ -0,0 +1,60 @@
+package goupnp
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/huin/goupnp/upnperr"
+)
+
+func TestProbe(t *testing.T) {
+	var heads int
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == "HEAD" {
+			heads++
+		}
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}))
+	defer ts.Close()
+
+	// An address that refuses connections, of a listener that is closed.
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	closedAddr := l.Addr().String()
+	l.Close()
+
+	device := func(base string) *Device {
+		d := &Device{Services: []Service{{ControlURL: URLField{Str: "/ctl"}}}}
+		u, _ := url.Parse(base)
+		d.SetURLBase(u)
+		return d
+	}
+
+	ctx := context.Background()
+	if err := device(ts.URL).Probe(ctx); err != nil {
+		t.Errorf("Probe of live device: %v", err)
+	}
+	if heads != 0 {
+		t.Errorf("got %d HEAD requests without WithProbeHEAD, want 0", heads)
+	}
+	if err := device(ts.URL).Probe(ctx, WithProbeHEAD()); err != nil {
+		t.Errorf("Probe with HEAD of live device: %v", err)
+	}
+	if heads != 1 {
+		t.Errorf("got %d HEAD requests, want 1", heads)
+	}
+	err = device("http://" + closedAddr).Probe(ctx)
+	if !errors.Is(err, upnperr.ErrUnreachable) {
+		t.Errorf("Probe of stale device: got %v, want ErrUnreachable", err)
+	}
+	if err := (&Device{}).Probe(ctx); err == nil {
+		t.Error("Probe of device without URLs: want error")
+	}
+}
@@ -0,0 +1,134 @@
+package goupnp
+
+import (
+	"net/url"
+	"strings"
+	"sync"
+
+	"github.com/huin/goupnp/soap"
+)
+
+// Quirks are the deviations from UPnP of a device, that the clients of its
+// descriptions and services work around. The quirks of a device are looked up
+// with LookupQuirks when its description is fetched, and enable the
+// compatibility toggles of the clients of the device. Quirks are also
+// detected without a rule: BrokenChunked if the description ends early after
+// the end of the document, and WrongURLBase if the URLBase of the description
+// is of another host than its location. SOAP clients likewise switch to M-POST
+// once a POST is rejected with 405 Method Not Allowed, and to lenient bodies
+// once a response ends early.
+type Quirks struct {
+	// MPost is for devices that need actions made with M-POST.
+	MPost bool
+	// BrokenChunked is for devices whose chunked responses end without their
+	// final chunk, which are then accepted as they are.
+	BrokenChunked bool
+	// WrongURLBase is for devices whose description has a wrong URLBase,
+	// such as one of another address of the device, in which case the URLs
+	// of the description are resolved against its location instead.
+	WrongURLBase bool
+	// TruncatesSOAP2K is for devices that truncate SOAP requests at 2KB.
+	TruncatesSOAP2K bool
+}
+
+// Or returns the quirks of both q and other.
+func (q Quirks) Or(other Quirks) Quirks {
+	return Quirks{
+		MPost:           q.MPost || other.MPost,
+		BrokenChunked:   q.BrokenChunked || other.BrokenChunked,
+		WrongURLBase:    q.WrongURLBase || other.WrongURLBase,
+		TruncatesSOAP2K: q.TruncatesSOAP2K || other.TruncatesSOAP2K,
+	}
+}
+
+// SOAPCompat returns the compatibility toggles of SOAP clients of a device
+// with the quirks q.
+func (q Quirks) SOAPCompat() soap.Compat {
+	c := soap.Compat{MPost: q.MPost, LenientBody: q.BrokenChunked}
+	if q.TruncatesSOAP2K {
+		c.MaxRequestBytes = 2048
+	}
+	return c
+}
+
+// QuirkRule gives the quirks of the devices that it matches. Each non-empty
+// field of the rule must be contained in that of a device, regardless of case,
+// for the rule to match the device: Server in the SERVER header of the
+// response with its description, and the others in its description.
+type QuirkRule struct {
+	Server       string
+	Manufacturer string
+	ModelName    string
+	ModelNumber  string
+	Quirks       Quirks
+}
+
+var (
+	quirkRulesLock sync.RWMutex // Protects quirkRules.
+	// quirkRules are the rules added with RegisterQuirks.
+	quirkRules []QuirkRule
+)
+
+// RegisterQuirks adds rules to those that LookupQuirks looks up, for the
+// devices of a network that need them. Rules without any
+// field to match are ignored, rather than matching every device.
+func RegisterQuirks(rules ...QuirkRule) {
+	quirkRulesLock.Lock()
+	defer quirkRulesLock.Unlock()
+	for _, r := range rules {
+		if r.Server == "" && r.Manufacturer == "" && r.ModelName == "" && r.ModelNumber == "" {
+			continue
+		}
+		quirkRules = append(quirkRules, r)
+	}
+}
+
+// LookupQuirks returns the quirks of all the rules matching a device, whose
+// SERVER header is server and whose description is d. Either may be empty or
+// nil if not known, e.g. d before the description is fetched, in which case
+// only the rules matching on the others apply.
+func LookupQuirks(server string, d *Device) Quirks {
+	quirkRulesLock.RLock()
+	defer quirkRulesLock.RUnlock()
+	var q Quirks
+	for i := range quirkRules {
+		if r := &quirkRules[i]; r.matches(server, d) {
+			q = q.Or(r.Quirks)
+		}
+	}
+	return q
+}
+
+func (r *QuirkRule) matches(server string, d *Device) bool {
+	if !containsFold(server, r.Server) {
+		return false
+	}
+	if r.Manufacturer == "" && r.ModelName == "" && r.ModelNumber == "" {
+		return true
+	}
+	return d != nil && containsFold(d.Manufacturer, r.Manufacturer) &&
+		containsFold(d.ModelName, r.ModelName) && containsFold(d.ModelNumber, r.ModelNumber)
+}
+
+// wrongURLBase returns whether urlBase, the URLBase of the description at loc,
+// is of another host than loc, as devices describe by mistake, e.g. with an
+// address of another of their interfaces.
+func wrongURLBase(urlBase string, loc *url.URL) bool {
+	if urlBase == "" {
+		return false
+	}
+	u, err := url.Parse(urlBase)
+	return err == nil && u.Host != "" && !strings.EqualFold(u.Hostname(), loc.Hostname())
+}
+
+// containsFold returns whether s contains substr regardless of case. Empty
+// substrs are contained in every s.
+func containsFold(s, substr string) bool {
+	return substr == "" || strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
+
+// Quirks returns the quirks of the device, as looked up when its description
+// was fetched.
+func (root *RootDevice) Quirks() Quirks {
+	return root.quirks
+}
@@ -0,0 +1,88 @@
+package goupnp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func TestQuirks(t *testing.T) {
+	RegisterQuirks(
+		QuirkRule{Server: "quirkyos/1.0", Quirks: Quirks{WrongURLBase: true}},
+		QuirkRule{Manufacturer: "Quirky Inc", ModelName: "box", Quirks: Quirks{MPost: true, TruncatesSOAP2K: true}},
+		QuirkRule{Quirks: Quirks{BrokenChunked: true}},
+	)
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("SERVER", "QuirkyOS/1.0 UPnP/1.0 box/2")
+		w.Write([]byte(`<root xmlns="urn:schemas-upnp-org:device-1-0">
+<URLBase>http://192.0.2.1:1234/</URLBase>
+<device><manufacturer>Quirky Inc.</manufacturer><modelName>Big Box</modelName><UDN>uuid:1</UDN>
+<serviceList><service><serviceType>urn:schemas-upnp-org:service:Test:1</serviceType><controlURL>/ctl</controlURL></service></serviceList>
+</device></root>`))
+	}))
+	defer ts.Close()
+	loc, _ := url.Parse(ts.URL + "/desc.xml")
+
+	root, err := DeviceByURLCtx(context.Background(), loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Quirks{MPost: true, WrongURLBase: true, TruncatesSOAP2K: true}
+	if got := root.Quirks(); got != want {
+		t.Errorf("got quirks %+v, want %+v", got, want)
+	}
+	if ctl := root.Device.Services[0].ControlURL.URL; ctl.Host != loc.Host {
+		t.Errorf("got control URL %v, want it resolved against the location", &ctl)
+	}
+	clients, err := NewServiceClientsFromRootDevice(root, loc, "urn:schemas-upnp-org:service:Test:1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c := clients[0].SOAPClient.Compat; !c.MPost || c.MaxRequestBytes != 2048 || c.LenientBody {
+		t.Errorf("got SOAP compatibility %+v", c)
+	}
+
+	if got := LookupQuirks("Linux UPnP/1.1 other/1", &Device{Manufacturer: "Quirky Inc", ModelName: "Small"}); got != (Quirks{}) {
+		t.Errorf("got quirks %+v for a device matching no rule", got)
+	}
+}
+
+func TestQuirksDetected(t *testing.T) {
+	const description = `<root xmlns="urn:schemas-upnp-org:device-1-0">
+<URLBase>http://192.0.2.1:1234/</URLBase>
+<device><UDN>uuid:1</UDN>
+<serviceList><service><serviceType>urn:schemas-upnp-org:service:Test:1</serviceType><controlURL>/ctl</controlURL></service></serviceList>
+</device></root>`
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// A chunked response without its final chunk.
+		conn, buf, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer conn.Close()
+		fmt.Fprintf(buf, "HTTP/1.1 200 OK\r\nTransfer-Encoding: chunked\r\n\r\n%x\r\n%s\r\n", len(description), description)
+		buf.Flush()
+	}))
+	defer ts.Close()
+	loc, _ := url.Parse(ts.URL + "/desc.xml")
+
+	root, err := DeviceByURLCtx(context.Background(), loc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := Quirks{BrokenChunked: true, WrongURLBase: true}
+	if got := root.Quirks(); got != want {
+		t.Errorf("got quirks %+v, want %+v", got, want)
+	}
+	if ctl := root.Device.Services[0].ControlURL.URL; ctl.Host != loc.Host {
+		t.Errorf("got control URL %v, want it resolved against the location", &ctl)
+	}
+
+	if wrongURLBase("http://"+loc.Host+"/", loc) || wrongURLBase("", loc) {
+		t.Error("URLBase of the host of the location taken to be wrong")
+	}
+}
@@ -0,0 +1,83 @@
+package goupnp
+
+import (
+	"context"
+	"net/url"
+	"time"
+)
+
+// DefaultLocationRaceDelay is how long the fetch of the description of a
+// device from one of its locations is given before the fetch from its next
+// location is started in parallel, unless set with WithLocationRaceDelay.
+const DefaultLocationRaceDelay = 250 * time.Millisecond
+
+// WithLocationRaceDelay sets how long the fetch of the description of a device
+// that responded with several locations, e.g. from several interfaces or
+// families, is given before the fetch from its next location is started, in
+// order of preference, DefaultLocationRaceDelay by default. The first
+// description fetched is used, and the others are abandoned. The fetch from
+// the next location is started at once if the previous one fails.
+func WithLocationRaceDelay(d time.Duration) DiscoveryOption {
+	return func(c *discoveryConfig) { c.locationRaceDelay = d }
+}
+
+// fetchFirst fetches the description of a device from the first of locs, in
+// order of preference, that it can be fetched from, in the manner of happy
+// eyeballs: each fetch is started once the previous one fails or has been
+// running for the location race delay of cfg. The result has the location
+// that won, so that the URLs of the device are resolved against it and its
+// actions and subscriptions are made at the address it was reached at. The
+// error of the preferred location is returned if none can be fetched.
+func fetchFirst(ctx context.Context, fetcher *Fetcher, locs []*url.URL, cfg *discoveryConfig) MaybeRootDevice {
+	if len(locs) == 1 {
+		root, err := fetcher.deviceByURL(ctx, locs[0], cfg)
+		return MaybeRootDevice{Root: root, Location: locs[0], Err: err}
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	// The fetches that lose are abandoned.
+	defer cancel()
+
+	type result struct {
+		i    int
+		root *RootDevice
+		err  error
+	}
+	results := make(chan result, len(locs))
+	start := func(i int) {
+		go func(i int, loc *url.URL) {
+			root, err := fetcher.deviceByURL(ctx, loc, cfg)
+			results <- result{i, root, err}
+		}(i, locs[i])
+	}
+	errs := make([]error, len(locs))
+	started, failed := 0, 0
+	start(started)
+	started++
+	for failed < len(locs) {
+		var next <-chan time.Time
+		var timer *time.Timer
+		if started < len(locs) {
+			timer = time.NewTimer(cfg.locationRaceDelay)
+			next = timer.C
+		}
+		select {
+		case r := <-results:
+			if timer != nil {
+				timer.Stop()
+			}
+			if r.err == nil {
+				return MaybeRootDevice{Root: r.root, Location: locs[r.i]}
+			}
+			errs[r.i] = r.err
+			failed++
+			if started < len(locs) {
+				start(started)
+				started++
+			}
+		case <-next:
+			start(started)
+			started++
+		}
+	}
+	return MaybeRootDevice{Location: locs[0], Err: errs[0]}
+}
@@ -0,0 +1,57 @@
+package goupnp
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+)
+
+func TestFetchFirst(t *testing.T) {
+	const desc = `<root xmlns="urn:schemas-upnp-org:device-1-0"><device><UDN>uuid:1</UDN><serviceList><service><controlURL>/ctl</controlURL></service></serviceList></device></root>`
+	stalled := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	defer stalled.Close()
+	failing := httptest.NewServer(http.NotFoundHandler())
+	defer failing.Close()
+	working := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(desc))
+	}))
+	defer working.Close()
+
+	parse := func(s string) *url.URL {
+		u, err := url.Parse(s + "/desc.xml")
+		if err != nil {
+			t.Fatal(err)
+		}
+		return u
+	}
+	cfg := newDiscoveryConfig([]DiscoveryOption{WithLocationRaceDelay(20 * time.Millisecond)})
+	f := NewFetcher(0)
+
+	start := time.Now()
+	got := fetchFirst(context.Background(), f, []*url.URL{parse(stalled.URL), parse(failing.URL), parse(working.URL)}, cfg)
+	if got.Err != nil {
+		t.Fatal(got.Err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("took %v, want the stalled location to be raced", elapsed)
+	}
+	if got.Location.Host != parse(working.URL).Host {
+		t.Errorf("got Location %v, want the working one", got.Location)
+	}
+	if ctl := got.Root.Device.Services[0].ControlURL.URL; ctl.Host != got.Location.Host {
+		t.Errorf("got control URL %v, want it at the winning location", &ctl)
+	}
+
+	got = fetchFirst(context.Background(), f, []*url.URL{parse(failing.URL), parse(failing.URL + "/other")}, cfg)
+	if got.Err == nil || got.Location.String() != parse(failing.URL).String() {
+		t.Errorf("got %+v, want the error of the preferred location", got)
+	}
+}
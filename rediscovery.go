@@ -0,0 +1,210 @@
+package goupnp
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/huin/goupnp/clock"
+	"github.com/huin/goupnp/internal/logging"
+	"github.com/huin/goupnp/ssdp"
+)
+
+// DefaultRediscoveryInterval is the Interval of a Rediscoverer, unless set.
+const DefaultRediscoveryInterval = 5 * time.Minute
+
+// Rediscoverer reruns discovery in the background, at an interval with
+// jitter, so that applications keep track of the devices of the network
+// without running the searches themselves. The responses feed its Registry,
+// and the devices found are passed to OnDiscover. Use Start and Stop to run
+// it, and Health to check on it.
+type Rediscoverer struct {
+	// SearchTarget is the search target of the searches, "ssdp:all" if
+	// empty.
+	SearchTarget string
+	// Interval is the time between the start of searches,
+	// DefaultRediscoveryInterval if 0.
+	Interval time.Duration
+	// Jitter is the most that Interval is shortened by, at random for each
+	// search, so that the searches of several hosts do not synchronise. A
+	// tenth of Interval if 0, and none if negative.
+	Jitter time.Duration
+	// Options configure the searches, as for DiscoverDevices, including
+	// searching over IPv6 with WithDualStack.
+	Options []DiscoveryOption
+	// Registry, if not nil, is fed the responses of each search, as with
+	// ssdp.Registry.AddSearchResponse.
+	Registry *ssdp.Registry
+	// OnDiscover, if not nil, is called with the devices found by each
+	// search, whose descriptions are then fetched as by DiscoverDevices.
+	OnDiscover func([]MaybeRootDevice)
+	// Logger logs the failures of searches, the SetLogger logger if nil.
+	Logger *slog.Logger
+	// Clock schedules the searches, clock.Real if nil.
+	Clock clock.Clock
+
+	// search performs a search, for tests to replace.
+	search func(ctx context.Context, searchTarget string, cfg *discoveryConfig) ([]*http.Response, error)
+
+	lock   sync.Mutex // Protects cancel, done and health.
+	cancel context.CancelFunc
+	done   chan struct{} // Closed once the searches started by Start end.
+	health RediscoveryHealth
+}
+
+// RediscoveryHealth is the status of a Rediscoverer.
+type RediscoveryHealth struct {
+	// Running is whether the Rediscoverer is started.
+	Running bool
+	// Runs and Failures are the numbers of searches made, and of those that
+	// failed.
+	Runs, Failures int
+	// LastRun is when the last search ended, and LastSuccess when the last
+	// one that did not fail did.
+	LastRun, LastSuccess time.Time
+	// LastErr is the error of the last search, nil if it did not fail.
+	LastErr error
+	// Responses is the number of responses to the last search that did not
+	// fail.
+	Responses int
+}
+
+// Healthy returns whether the Rediscoverer is running, and its last search,
+// if any, did not fail.
+func (h RediscoveryHealth) Healthy() bool {
+	return h.Running && h.LastErr == nil
+}
+
+// Start starts searching, at once and then at every interval, until Stop is
+// called.
+func (r *Rediscoverer) Start() error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	if r.cancel != nil {
+		return errors.New("goupnp: rediscoverer already started")
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	r.cancel = cancel
+	r.done = make(chan struct{})
+	r.health.Running = true
+	go r.loop(ctx, r.done)
+	return nil
+}
+
+// Stop stops searching, cancelling the fetches of a search in progress, and
+// waits for it to end.
+func (r *Rediscoverer) Stop() {
+	r.lock.Lock()
+	cancel, done := r.cancel, r.done
+	r.cancel, r.done = nil, nil
+	r.health.Running = false
+	r.lock.Unlock()
+	if cancel == nil {
+		return
+	}
+	cancel()
+	<-done
+}
+
+// Health returns the status of the searches.
+func (r *Rediscoverer) Health() RediscoveryHealth {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.health
+}
+
+func (r *Rediscoverer) interval() time.Duration {
+	interval := r.Interval
+	if interval <= 0 {
+		interval = DefaultRediscoveryInterval
+	}
+	jitter := r.Jitter
+	if jitter == 0 {
+		jitter = interval / 10
+	}
+	if jitter > 0 && jitter < interval {
+		interval -= time.Duration(rand.Int63n(int64(jitter)))
+	}
+	return interval
+}
+
+func (r *Rediscoverer) loop(ctx context.Context, done chan<- struct{}) {
+	defer close(done)
+	for {
+		r.run(ctx)
+		timer := clock.Or(r.Clock).NewTimer(r.interval())
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C():
+		}
+	}
+}
+
+// run performs one search, and records its result in the health of r.
+func (r *Rediscoverer) run(ctx context.Context) {
+	cfg := newDiscoveryConfig(r.Options)
+	st := r.SearchTarget
+	if st == "" {
+		st = "ssdp:all"
+	}
+	search := r.search
+	if search == nil {
+		search = rediscoverySearch
+	}
+	responses, err := search(ctx, st, cfg)
+	if ctx.Err() != nil {
+		// Searches stopped by Stop are not failures.
+		return
+	}
+
+	r.lock.Lock()
+	r.health.Runs++
+	r.health.LastRun = clock.Or(r.Clock).Now()
+	r.health.LastErr = err
+	if err != nil {
+		r.health.Failures++
+	} else {
+		r.health.LastSuccess = r.health.LastRun
+		r.health.Responses = len(responses)
+	}
+	r.lock.Unlock()
+	if err != nil {
+		logging.Or(r.Logger).Warn("goupnp: rediscovery search failed", slog.String("st", st), logging.Err(err))
+		return
+	}
+
+	if r.Registry != nil {
+		for _, resp := range responses {
+			if err := r.Registry.AddSearchResponse(resp); err != nil {
+				var peer string
+				if resp.Request != nil {
+					peer = resp.Request.RemoteAddr
+				}
+				logging.ReportMalformed(r.Logger, "goupnp", "goupnp: discarding search response", peer, err)
+			}
+		}
+	}
+	if r.OnDiscover != nil {
+		devices := fetchMerged(ctx, mergeResponses(responses, cfg.dualStack), cfg)
+		if ctx.Err() != nil {
+			return
+		}
+		defer logging.Recover(r.Logger, "goupnp: panic in rediscovery callback")
+		r.OnDiscover(devices)
+	}
+}
+
+// rediscoverySearch searches over IPv4, and IPv6 as well in parallel if cfg
+// is dual stack, in which case it only fails if both searches do.
+func rediscoverySearch(ctx context.Context, searchTarget string, cfg *discoveryConfig) ([]*http.Response, error) {
+	if !cfg.dualStack {
+		return search(ctx, searchTarget, cfg, false)
+	}
+	return searchDualStack(ctx, searchTarget, cfg)
+}
@@ -0,0 +1,118 @@
+package goupnp
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/huin/goupnp/clock"
+	"github.com/huin/goupnp/ssdp"
+)
+
+func TestRediscoverer(t *testing.T) {
+	fc := clock.NewFake(time.Unix(1000, 0))
+	searches := make(chan struct{}, 10)
+	fail := false
+	reg := ssdp.NewRegistry()
+	r := &Rediscoverer{
+		SearchTarget: "urn:schemas-upnp-org:device:InternetGatewayDevice:1",
+		Interval:     time.Minute,
+		Jitter:       -1,
+		Registry:     reg,
+		Clock:        fc,
+	}
+	r.search = func(ctx context.Context, st string, cfg *discoveryConfig) ([]*http.Response, error) {
+		defer func() { searches <- struct{}{} }()
+		if st != r.SearchTarget {
+			t.Errorf("search target = %q, want %q", st, r.SearchTarget)
+		}
+		if fail {
+			return nil, errors.New("no network")
+		}
+		header := http.Header{}
+		header.Set("CACHE-CONTROL", "max-age=1800")
+		header.Set("LOCATION", "http://192.0.2.1:1900/desc.xml")
+		header.Set("ST", st)
+		header.Set("USN", "uuid:igd::"+st)
+		return []*http.Response{{
+			Header:  header,
+			Request: &http.Request{RemoteAddr: "192.0.2.1:1900"},
+		}}, nil
+	}
+
+	if err := r.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer r.Stop()
+	if err := r.Start(); err == nil {
+		t.Error("second Start succeeded")
+	}
+	// waitTimer waits for the search to end and the next to be scheduled.
+	waitTimer := func() {
+		t.Helper()
+		<-searches
+		for deadline := time.Now().Add(5 * time.Second); fc.Pending() == 0; {
+			if time.Now().After(deadline) {
+				t.Fatal("next search not scheduled")
+			}
+			time.Sleep(time.Millisecond)
+		}
+	}
+
+	waitTimer()
+	h := r.Health()
+	if !h.Healthy() || h.Runs != 1 || h.Responses != 1 {
+		t.Errorf("health after first search = %+v, want healthy with 1 run and 1 response", h)
+	}
+	if entries := reg.GetService(r.SearchTarget); len(entries) != 1 || entries[0].RemoteAddr != "192.0.2.1:1900" {
+		t.Errorf("registry entries = %v, want the entry of the response", entries)
+	}
+
+	// No search is made before the interval.
+	fc.Advance(59 * time.Second)
+	select {
+	case <-searches:
+		t.Fatal("searched before the interval")
+	default:
+	}
+	fail = true
+	fc.Advance(time.Second)
+	waitTimer()
+	h = r.Health()
+	if h.Healthy() || h.Runs != 2 || h.Failures != 1 || h.LastErr == nil {
+		t.Errorf("health after failed search = %+v, want unhealthy with 2 runs and 1 failure", h)
+	}
+	if !h.LastSuccess.Equal(time.Unix(1000, 0)) {
+		t.Errorf("LastSuccess = %v, want time of first search", h.LastSuccess)
+	}
+
+	r.Stop()
+	if h := r.Health(); h.Running || h.Healthy() {
+		t.Errorf("health after Stop = %+v, want not running", h)
+	}
+}
+
+func TestRediscovererConcurrentStartStop(t *testing.T) {
+	r := &Rediscoverer{Clock: clock.NewFake(time.Unix(1000, 0))}
+	r.search = func(ctx context.Context, st string, cfg *discoveryConfig) ([]*http.Response, error) {
+		return nil, nil
+	}
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				r.Start()
+				r.Stop()
+			}
+		}()
+	}
+	wg.Wait()
+	if h := r.Health(); h.Running {
+		t.Errorf("health after Stop = %+v, want not running", h)
+	}
+}
@@ -0,0 +1,168 @@
+package scpd
+
+import (
+	"bufio"
+	"html/template"
+	"io"
+	"strings"
+)
+
+// WriteMarkdown writes the documentation of the service to w, as Markdown
+// with a table of the arguments of each action and one of the state
+// variables, headed by title.
+func (scpd *SCPD) WriteMarkdown(w io.Writer, title string) error {
+	bw := bufio.NewWriter(w)
+	bw.WriteString("# " + title + "\n")
+	if len(scpd.Actions) > 0 {
+		bw.WriteString("\n## Actions\n")
+	}
+	for i := range scpd.Actions {
+		action := &scpd.Actions[i]
+		bw.WriteString("\n### " + action.Name)
+		if action.IsOptional() {
+			bw.WriteString(" (optional)")
+		}
+		bw.WriteString("\n")
+		if len(action.Arguments) == 0 {
+			bw.WriteString("\nNo arguments.\n")
+			continue
+		}
+		bw.WriteString("\n| Argument | Direction | State variable | Type | Allowed values |\n|---|---|---|---|---|\n")
+		for _, arg := range scpd.arguments(action) {
+			writeMarkdownRow(bw, arg.Name, arg.Direction, arg.StateVariable, arg.DataType, arg.Allowed)
+		}
+	}
+	if len(scpd.StateVariables) > 0 {
+		bw.WriteString("\n## State variables\n\n| Name | Type | Evented | Default | Allowed values |\n|---|---|---|---|---|\n")
+	}
+	for _, v := range scpd.variables() {
+		writeMarkdownRow(bw, v.Name, v.DataType, yesNo(v.Evented), v.Default, v.Allowed)
+	}
+	return bw.Flush()
+}
+
+// writeMarkdownRow writes a row of a Markdown table, escaping the pipes of
+// its cells.
+func writeMarkdownRow(bw *bufio.Writer, cells ...string) {
+	bw.WriteString("|")
+	for _, cell := range cells {
+		bw.WriteString(" " + strings.ReplaceAll(cell, "|", `\|`) + " |")
+	}
+	bw.WriteString("\n")
+}
+
+var htmlTemplate = template.Must(template.New("scpd").Parse(`<!DOCTYPE html>
+<html>
+<head><meta charset="utf-8"><title>{{.Title}}</title></head>
+<body>
+<h1>{{.Title}}</h1>
+{{- if .Actions}}
+<h2>Actions</h2>
+{{- range .Actions}}
+<h3>{{.Name}}{{if .Optional}} (optional){{end}}</h3>
+{{- if .Arguments}}
+<table>
+<tr><th>Argument</th><th>Direction</th><th>State variable</th><th>Type</th><th>Allowed values</th></tr>
+{{- range .Arguments}}
+<tr><td>{{.Name}}</td><td>{{.Direction}}</td><td>{{.StateVariable}}</td><td>{{.DataType}}</td><td>{{.Allowed}}</td></tr>
+{{- end}}
+</table>
+{{- else}}
+<p>No arguments.</p>
+{{- end}}
+{{- end}}
+{{- end}}
+{{- if .Variables}}
+<h2>State variables</h2>
+<table>
+<tr><th>Name</th><th>Type</th><th>Evented</th><th>Default</th><th>Allowed values</th></tr>
+{{- range .Variables}}
+<tr><td>{{.Name}}</td><td>{{.DataType}}</td><td>{{if .Evented}}yes{{else}}no{{end}}</td><td>{{.Default}}</td><td>{{.Allowed}}</td></tr>
+{{- end}}
+</table>
+{{- end}}
+</body>
+</html>
+`))
+
+// WriteHTML writes the documentation of the service to w, as an HTML page
+// with the same content as WriteMarkdown.
+func (scpd *SCPD) WriteHTML(w io.Writer, title string) error {
+	type actionDoc struct {
+		Name      string
+		Optional  bool
+		Arguments []argumentDoc
+	}
+	data := struct {
+		Title     string
+		Actions   []actionDoc
+		Variables []variableDoc
+	}{Title: title, Variables: scpd.variables()}
+	for i := range scpd.Actions {
+		action := &scpd.Actions[i]
+		data.Actions = append(data.Actions, actionDoc{action.Name, action.IsOptional(), scpd.arguments(action)})
+	}
+	return htmlTemplate.Execute(w, data)
+}
+
+// argumentDoc is the documentation of an argument, with the type and allowed
+// values of its state variable.
+type argumentDoc struct {
+	Name, Direction, StateVariable, DataType, Allowed string
+}
+
+func (scpd *SCPD) arguments(action *Action) []argumentDoc {
+	docs := make([]argumentDoc, 0, len(action.Arguments))
+	for _, arg := range action.Arguments {
+		doc := argumentDoc{Name: arg.Name, Direction: arg.Direction, StateVariable: arg.RelatedStateVariable}
+		if sv := scpd.GetStateVariable(arg.RelatedStateVariable); sv != nil {
+			doc.DataType, doc.Allowed = sv.DataType.Name, allowed(sv)
+		}
+		docs = append(docs, doc)
+	}
+	return docs
+}
+
+// variableDoc is the documentation of a state variable.
+type variableDoc struct {
+	Name, DataType, Default, Allowed string
+	Evented                          bool
+}
+
+func (scpd *SCPD) variables() []variableDoc {
+	docs := make([]variableDoc, 0, len(scpd.StateVariables))
+	for i := range scpd.StateVariables {
+		sv := &scpd.StateVariables[i]
+		docs = append(docs, variableDoc{
+			Name:     sv.Name,
+			DataType: sv.DataType.Name,
+			Default:  sv.DefaultValue,
+			Allowed:  allowed(sv),
+			Evented:  sv.Evented(),
+		})
+	}
+	return docs
+}
+
+// allowed returns the allowed values of sv, or its allowed range, as text.
+func allowed(sv *StateVariable) string {
+	if len(sv.AllowedValues) > 0 {
+		return strings.Join(sv.AllowedValues, ", ")
+	}
+	r := sv.AllowedValueRange
+	if r == nil || (r.Minimum == "" && r.Maximum == "") {
+		return ""
+	}
+	s := r.Minimum + " to " + r.Maximum
+	if r.Step != "" {
+		s += " in steps of " + r.Step
+	}
+	return s
+}
+
+func yesNo(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
@@ -2,6 +2,9 @@ package scpd
 
 import (
 	"encoding/xml"
+	"fmt"
+	"math"
+	"strconv"
 	"strings"
 )
 
@@ -18,7 +21,7 @@ func cleanWhitespace(s *string) {
 // http://upnp.org/specs/arch/UPnP-arch-DeviceArchitecture-v1.1.pdf
 type SCPD struct {
 	XMLName        xml.Name        `xml:"scpd"`
-	ConfigId       string          `xml:"configId,attr"`
+	ConfigId       string          `xml:"configId,attr,omitempty"`
 	SpecVersion    SpecVersion     `xml:"specVersion"`
 	Actions        []Action        `xml:"actionList>action"`
 	StateVariables []StateVariable `xml:"serviceStateTable>stateVariable"`
@@ -67,6 +70,15 @@ type SpecVersion struct {
 type Action struct {
 	Name      string     `xml:"name"`
 	Arguments []Argument `xml:"argumentList>argument"`
+	// Extensions are the other elements of the action, such as the
+	// <Optional/> marker of the service templates.
+	Extensions []Extension `xml:",any"`
+}
+
+// IsOptional returns whether the action is marked optional, as in the
+// service templates of the UPnP forum, which devices need not implement.
+func (action *Action) IsOptional() bool {
+	return hasOptionalMarker(action.Extensions)
 }
 
 func (action *Action) clean() {
@@ -102,7 +114,7 @@ type Argument struct {
 	Name                 string `xml:"name"`
 	Direction            string `xml:"direction"`            // in|out
 	RelatedStateVariable string `xml:"relatedStateVariable"` // ?
-	Retval               string `xml:"retval"`               // ?
+	Retval               string `xml:"retval,omitempty"`     // ?
 }
 
 func (arg *Argument) clean() {
@@ -121,18 +133,42 @@ func (arg *Argument) IsOutput() bool {
 }
 
 type StateVariable struct {
-	Name              string             `xml:"name"`
-	SendEvents        string             `xml:"sendEvents,attr"` // yes|no
-	Multicast         string             `xml:"multicast,attr"`  // yes|no
+	Name       string `xml:"name"`
+	SendEvents string `xml:"sendEvents,attr"` // yes|no
+	// SendEventsElement is the sendEventsAttribute element that some
+	// devices give in place of the sendEvents attribute.
+	SendEventsElement string             `xml:"sendEventsAttribute,omitempty"` // yes|no
+	Multicast         string             `xml:"multicast,attr,omitempty"`      // yes|no
 	DataType          DataType           `xml:"dataType"`
-	DefaultValue      string             `xml:"defaultValue"`
+	DefaultValue      string             `xml:"defaultValue,omitempty"`
 	AllowedValueRange *AllowedValueRange `xml:"allowedValueRange"`
 	AllowedValues     []string           `xml:"allowedValueList>allowedValue"`
+	// Extensions are the other elements of the variable, such as the
+	// <Optional/> marker of the service templates.
+	Extensions []Extension `xml:",any"`
+}
+
+// Evented returns whether changes of the variable are sent as events, from
+// its sendEvents attribute or sendEventsAttribute element regardless of case,
+// and by default if it has neither, as UPnP 1.0 defines.
+func (v *StateVariable) Evented() bool {
+	sendEvents := strings.TrimSpace(v.SendEvents)
+	if sendEvents == "" {
+		sendEvents = strings.TrimSpace(v.SendEventsElement)
+	}
+	return !strings.EqualFold(sendEvents, "no")
+}
+
+// IsOptional returns whether the variable is marked optional, as in the
+// service templates of the UPnP forum.
+func (v *StateVariable) IsOptional() bool {
+	return hasOptionalMarker(v.Extensions)
 }
 
 func (v *StateVariable) clean() {
 	cleanWhitespace(&v.Name)
 	cleanWhitespace(&v.SendEvents)
+	cleanWhitespace(&v.SendEventsElement)
 	cleanWhitespace(&v.Multicast)
 	v.DataType.clean()
 	cleanWhitespace(&v.DefaultValue)
@@ -156,12 +192,82 @@ func (r *AllowedValueRange) clean() {
 	cleanWhitespace(&r.Step)
 }
 
+// NumericRange is an AllowedValueRange with its values parsed.
+type NumericRange struct {
+	Minimum, Maximum float64
+	// Step is 0 if the range has no step.
+	Step float64
+}
+
+// Parse returns the numeric values of the range. Missing minimums and
+// maximums are unbounded, and the range fails to parse if a value is not a
+// number, the minimum is above the maximum or the step is negative.
+func (r *AllowedValueRange) Parse() (NumericRange, error) {
+	nr := NumericRange{Minimum: math.Inf(-1), Maximum: math.Inf(1)}
+	for _, f := range []struct {
+		name  string
+		value string
+		dst   *float64
+	}{
+		{"minimum", r.Minimum, &nr.Minimum},
+		{"maximum", r.Maximum, &nr.Maximum},
+		{"step", r.Step, &nr.Step},
+	} {
+		value := strings.TrimSpace(f.value)
+		if value == "" {
+			continue
+		}
+		v, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return NumericRange{}, fmt.Errorf("scpd: allowed range %s %q is not a number", f.name, f.value)
+		}
+		*f.dst = v
+	}
+	if nr.Minimum > nr.Maximum {
+		return NumericRange{}, fmt.Errorf("scpd: allowed range minimum %s is above its maximum %s", r.Minimum, r.Maximum)
+	}
+	if nr.Step < 0 {
+		return NumericRange{}, fmt.Errorf("scpd: allowed range step %s is negative", r.Step)
+	}
+	return nr, nil
+}
+
+// Contains returns whether f is within the range, and a whole number of
+// steps from its minimum if it has both.
+func (nr NumericRange) Contains(f float64) bool {
+	if f < nr.Minimum || f > nr.Maximum {
+		return false
+	}
+	if nr.Step == 0 || math.IsInf(nr.Minimum, -1) {
+		return true
+	}
+	steps := (f - nr.Minimum) / nr.Step
+	return math.Abs(steps-math.Round(steps)) < 1e-9
+}
+
 type DataType struct {
 	Name string `xml:",chardata"`
-	Type string `xml:"type,attr"`
+	Type string `xml:"type,attr,omitempty"`
 }
 
 func (dt *DataType) clean() {
 	cleanWhitespace(&dt.Name)
 	cleanWhitespace(&dt.Type)
 }
+
+// Extension is an element of a SCPD document that is not otherwise parsed.
+type Extension struct {
+	XMLName xml.Name
+	Content string `xml:",innerxml"`
+}
+
+// hasOptionalMarker returns whether exts have an <Optional/> marker, which
+// some documents give in lower case.
+func hasOptionalMarker(exts []Extension) bool {
+	for _, ext := range exts {
+		if strings.EqualFold(ext.XMLName.Local, "optional") {
+			return true
+		}
+	}
+	return false
+}
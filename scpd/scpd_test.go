@@ -0,0 +1,94 @@
+package scpd
+
+import (
+	"bytes"
+	"encoding/xml"
+	"strings"
+	"testing"
+)
+
+const testSCPD = `<?xml version="1.0"?>
+<scpd xmlns="urn:schemas-upnp-org:service-1-0">
+  <specVersion><major>1</major><minor>0</minor></specVersion>
+  <actionList>
+    <action><name>GetVolume</name></action>
+    <action><name>SetLoudness</name><Optional/></action>
+  </actionList>
+  <serviceStateTable>
+    <stateVariable sendEvents=" NO ">
+      <name>Volume</name>
+      <dataType>ui2</dataType>
+      <defaultValue> 10 </defaultValue>
+      <allowedValueRange><minimum>0</minimum><maximum>100</maximum><step>5</step></allowedValueRange>
+    </stateVariable>
+    <stateVariable>
+      <sendEventsAttribute>no</sendEventsAttribute>
+      <name>Loudness</name>
+      <dataType>boolean</dataType>
+      <optional/>
+    </stateVariable>
+    <stateVariable><name>LastChange</name><dataType>string</dataType></stateVariable>
+  </serviceStateTable>
+</scpd>`
+
+func TestMetadata(t *testing.T) {
+	doc := new(SCPD)
+	if err := xml.Unmarshal([]byte(testSCPD), doc); err != nil {
+		t.Fatal(err)
+	}
+	doc.Clean()
+
+	if doc.GetAction("GetVolume").IsOptional() || !doc.GetAction("SetLoudness").IsOptional() {
+		t.Error("want only SetLoudness optional")
+	}
+	volume := doc.GetStateVariable("Volume")
+	if volume.Evented() || volume.IsOptional() || volume.DefaultValue != "10" {
+		t.Errorf("Volume = %+v, want not evented, not optional and default 10", volume)
+	}
+	loudness := doc.GetStateVariable("Loudness")
+	if loudness.Evented() || !loudness.IsOptional() {
+		t.Errorf("Loudness = %+v, want not evented from its element and optional", loudness)
+	}
+	if !doc.GetStateVariable("LastChange").Evented() {
+		t.Error("LastChange not evented by default")
+	}
+
+	rng, err := volume.AllowedValueRange.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rng != (NumericRange{Minimum: 0, Maximum: 100, Step: 5}) {
+		t.Errorf("got range %+v", rng)
+	}
+	for f, want := range map[float64]bool{0: true, 55: true, 100: true, 52: false, -5: false, 105: false} {
+		if got := rng.Contains(f); got != want {
+			t.Errorf("Contains(%v) = %v, want %v", f, got, want)
+		}
+	}
+	if _, err := (&AllowedValueRange{Minimum: "10", Maximum: "1"}).Parse(); err == nil {
+		t.Error("parsed range with minimum above maximum")
+	}
+}
+
+func TestWriteMarkdown(t *testing.T) {
+	doc := new(SCPD)
+	if err := xml.Unmarshal([]byte(testSCPD), doc); err != nil {
+		t.Fatal(err)
+	}
+	doc.Clean()
+	doc.StateVariables[2].AllowedValues = []string{"a|b"}
+	var buf bytes.Buffer
+	if err := doc.WriteMarkdown(&buf, "RenderingControl:1"); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"# RenderingControl:1\n",
+		"### SetLoudness (optional)\n\nNo arguments.\n",
+		"| Volume | ui2 | no | 10 | 0 to 100 in steps of 5 |\n",
+		`| LastChange | string | yes |  | a\|b |` + "\n",
+	} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("output %q does not contain %q", buf.String(), want)
+		}
+	}
+}
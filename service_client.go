@@ -1,8 +1,10 @@
 package goupnp
 
 import (
+	"context"
 	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/huin/goupnp/soap"
 )
@@ -17,14 +19,26 @@ type ServiceClient struct {
 	RootDevice *RootDevice
 	Location   *url.URL
 	Service    *Service
+	// ActionTimeouts overrides the default timeouts of the actions performed
+	// through the generated clients, keyed by action name. The timeout keyed
+	// by "" applies to the other actions. A zero or negative timeout means no
+	// timeout.
+	ActionTimeouts map[string]time.Duration
 }
 
 // NewServiceClients discovers services, and returns clients for them. err will
 // report any error with the discovery process (blocking any device/service
-// discovery), errors reports errors on a per-root-device basis.
-func NewServiceClients(searchTarget string) (clients []ServiceClient, errors []error, err error) {
+// discovery), errors reports errors on a per-root-device basis. opts
+// configure the discovery as for DiscoverDevices.
+func NewServiceClients(searchTarget string, opts ...DiscoveryOption) (clients []ServiceClient, errors []error, err error) {
+	return NewServiceClientsCtx(context.Background(), searchTarget, opts...)
+}
+
+// NewServiceClientsCtx is as NewServiceClients, discovering the services as
+// DiscoverDevicesCtx.
+func NewServiceClientsCtx(ctx context.Context, searchTarget string, opts ...DiscoveryOption) (clients []ServiceClient, errors []error, err error) {
 	var maybeRootDevices []MaybeRootDevice
-	if maybeRootDevices, err = DiscoverDevices(searchTarget); err != nil {
+	if maybeRootDevices, err = DiscoverDevicesCtx(ctx, searchTarget, opts...); err != nil {
 		return
 	}
 
@@ -48,9 +62,10 @@ func NewServiceClients(searchTarget string) (clients []ServiceClient, errors []e
 }
 
 // NewServiceClientsByURL creates client(s) for the given service URN, for a
-// root device at the given URL.
-func NewServiceClientsByURL(loc *url.URL, searchTarget string) ([]ServiceClient, error) {
-	rootDevice, err := DeviceByURL(loc)
+// root device at the given URL. opts configure the fetching of the
+// description as for DeviceByURL.
+func NewServiceClientsByURL(loc *url.URL, searchTarget string, opts ...DiscoveryOption) ([]ServiceClient, error) {
+	rootDevice, err := DeviceByURL(loc, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -71,7 +86,7 @@ func NewServiceClientsFromRootDevice(rootDevice *RootDevice, loc *url.URL, searc
 	clients := make([]ServiceClient, 0, len(srvs))
 	for _, srv := range srvs {
 		clients = append(clients, ServiceClient{
-			SOAPClient: srv.NewSOAPClient(),
+			SOAPClient: srv.NewSOAPClient(soap.WithCompat(rootDevice.Quirks().SOAPCompat())),
 			RootDevice: rootDevice,
 			Location:   loc,
 			Service:    srv,
@@ -80,6 +95,29 @@ func NewServiceClientsFromRootDevice(rootDevice *RootDevice, loc *url.URL, searc
 	return clients, nil
 }
 
+// ActionContext returns ctx limited to the timeout of the named action: the
+// one in client.ActionTimeouts if given there, otherwise the one in defaults,
+// which is keyed likewise. The generated clients call it with the default
+// timeouts of their service.
+func (client *ServiceClient) ActionContext(ctx context.Context, actionName string, defaults map[string]time.Duration) (context.Context, context.CancelFunc) {
+	timeout, ok := actionTimeout(client.ActionTimeouts, actionName)
+	if !ok {
+		timeout, _ = actionTimeout(defaults, actionName)
+	}
+	if timeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+func actionTimeout(timeouts map[string]time.Duration, actionName string) (time.Duration, bool) {
+	if timeout, ok := timeouts[actionName]; ok {
+		return timeout, true
+	}
+	timeout, ok := timeouts[""]
+	return timeout, ok
+}
+
 // GetServiceClient returns the ServiceClient itself. This is provided so that the
 // service client attributes can be accessed via an interface method on a
 // wrapping type.
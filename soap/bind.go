@@ -0,0 +1,384 @@
+package soap
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/huin/goupnp/scpd"
+)
+
+var (
+	timeType      = reflect.TypeOf(time.Time{})
+	timeOfDayType = reflect.TypeOf(TimeOfDay{})
+	urlType       = reflect.TypeOf(url.URL{})
+	urlPtrType    = reflect.TypeOf(&url.URL{})
+	bytesType     = reflect.TypeOf([]byte(nil))
+)
+
+// PerformActionSCPD is as PerformActionCtx, with the arguments of the action
+// bound to the fields of in and out by MarshalArgs and UnmarshalArgs, for
+// actions without a generated client, such as those of vendor services. out
+// may be nil if the response is not needed.
+func (client *SOAPClient) PerformActionSCPD(ctx context.Context, s *scpd.SCPD, actionNamespace, actionName string, in, out interface{}) error {
+	args, err := MarshalArgs(s, actionName, in)
+	if err != nil {
+		return err
+	}
+	result, err := client.PerformActionArgs(ctx, actionNamespace, actionName, args)
+	if err != nil || out == nil {
+		return err
+	}
+	return UnmarshalArgs(s, actionName, result, out)
+}
+
+// MarshalArgs returns the input arguments of the named action of s, in the
+// order of s, from the fields of in, a struct or a pointer to one. Each
+// argument is bound to the exported field with its name, or whose `soap` tag
+// is its name, and marshalled as the data type of its state variable: the
+// integer, float, bool, time.Time, TimeOfDay, []byte and url.URL fields that
+// the types of the soap package marshal from, or string fields, whose values
+// are sent as they are. Fields tagged `soap:"-"` are ignored. in may be nil
+// if the action has no input arguments.
+func MarshalArgs(s *scpd.SCPD, actionName string, in interface{}) ([]Arg, error) {
+	action := s.GetAction(actionName)
+	if action == nil {
+		return nil, fmt.Errorf("goupnp: SCPD has no action %q", actionName)
+	}
+	inArgs := action.InputArguments()
+	if in == nil {
+		if len(inArgs) > 0 {
+			return nil, fmt.Errorf("goupnp: action %s has input arguments, but none were given", actionName)
+		}
+		return nil, nil
+	}
+	v := reflect.Indirect(reflect.ValueOf(in))
+	fields, err := argFields(v, actionName, inArgs)
+	if err != nil {
+		return nil, err
+	}
+	args := make([]Arg, 0, len(inArgs))
+	for _, arg := range inArgs {
+		field, ok := fields[arg.Name]
+		if !ok {
+			return nil, fmt.Errorf("goupnp: no field of %v for input argument %s of action %s", v.Type(), arg.Name, actionName)
+		}
+		value, err := MarshalValue(dataTypeOf(s, arg), v.Field(field))
+		if err != nil {
+			return nil, fmt.Errorf("goupnp: input argument %s of action %s: %v", arg.Name, actionName, err)
+		}
+		args = append(args, Arg{Name: arg.Name, Value: value})
+	}
+	return args, nil
+}
+
+// UnmarshalArgs sets the fields of out, a pointer to a struct, from args,
+// the output arguments of the named action of s, bound to fields as by
+// MarshalArgs. Fields of arguments missing from args are left as they are.
+func UnmarshalArgs(s *scpd.SCPD, actionName string, args []Arg, out interface{}) error {
+	action := s.GetAction(actionName)
+	if action == nil {
+		return fmt.Errorf("goupnp: SCPD has no action %q", actionName)
+	}
+	ptr := reflect.ValueOf(out)
+	if ptr.Kind() != reflect.Ptr || ptr.IsNil() {
+		return fmt.Errorf("goupnp: out of action %s is not a pointer to a struct but of type %T", actionName, out)
+	}
+	v := ptr.Elem()
+	outArgs := action.OutputArguments()
+	fields, err := argFields(v, actionName, outArgs)
+	if err != nil {
+		return err
+	}
+	for _, a := range args {
+		field, ok := fields[a.Name]
+		if !ok {
+			continue
+		}
+		var arg *scpd.Argument
+		for _, outArg := range outArgs {
+			if outArg.Name == a.Name {
+				arg = outArg
+			}
+		}
+		if err := UnmarshalValue(dataTypeOf(s, arg), a.Value, v.Field(field)); err != nil {
+			return fmt.Errorf("goupnp: output argument %s of action %s: %v", a.Name, actionName, err)
+		}
+	}
+	return nil
+}
+
+// argFields returns the indexes of the fields of v bound to args by name. It
+// fails if v is not a struct, or a field is not bound to any of args, which
+// is most likely a misspelling.
+func argFields(v reflect.Value, actionName string, args []*scpd.Argument) (map[string]int, error) {
+	if !v.IsValid() {
+		// The indirection of a nil pointer.
+		return nil, fmt.Errorf("goupnp: arguments of action %s are a nil pointer", actionName)
+	}
+	if v.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("goupnp: arguments of action %s are not a struct but of type %v", actionName, v.Type())
+	}
+	names := make(map[string]bool, len(args))
+	for _, arg := range args {
+		names[arg.Name] = true
+	}
+	fields := make(map[string]int)
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		name := f.Name
+		if tag, ok := f.Tag.Lookup("soap"); ok {
+			name = tag
+		}
+		if f.PkgPath != "" || name == "-" {
+			continue
+		}
+		if !names[name] {
+			return nil, fmt.Errorf("goupnp: field %s of %v is not bound to an argument of action %s", f.Name, t, actionName)
+		}
+		fields[name] = i
+	}
+	return fields, nil
+}
+
+// dataTypeOf returns the data type of the state variable of arg, or "" if it
+// is not known.
+func dataTypeOf(s *scpd.SCPD, arg *scpd.Argument) string {
+	if arg == nil {
+		return ""
+	}
+	if sv := s.GetStateVariable(arg.RelatedStateVariable); sv != nil {
+		return sv.DataType.Name
+	}
+	return ""
+}
+
+// intBounds returns the bounds of the integer data type, and ok false if it
+// is not an integer type.
+func intBounds(dataType string) (min int64, max uint64, ok bool) {
+	switch dataType {
+	case "ui1":
+		return 0, 1<<8 - 1, true
+	case "ui2":
+		return 0, 1<<16 - 1, true
+	case "ui4":
+		return 0, 1<<32 - 1, true
+	case "ui8":
+		return 0, 1<<64 - 1, true
+	case "i1":
+		return -1 << 7, 1<<7 - 1, true
+	case "i2":
+		return -1 << 15, 1<<15 - 1, true
+	case "i4":
+		return -1 << 31, 1<<31 - 1, true
+	case "i8", "int":
+		return -1 << 63, 1<<63 - 1, true
+	}
+	return 0, 0, false
+}
+
+// isFloatType returns whether dataType is a floating point data type.
+func isFloatType(dataType string) bool {
+	switch dataType {
+	case "r4", "r8", "number", "float", "fixed.14.4":
+		return true
+	}
+	return false
+}
+
+// MarshalValue marshals v, a value of one of the field types supported by
+// MarshalArgs, as dataType, or as the data type matching its Go type if
+// dataType is "". It is shared by the client and device sides of actions, so
+// that both convert arguments the same way.
+func MarshalValue(dataType string, v reflect.Value) (string, error) {
+	switch t := v.Type(); {
+	case t == timeType:
+		tv := v.Interface().(time.Time)
+		switch dataType {
+		case "date":
+			return MarshalDate(tv)
+		case "dateTime.tz":
+			return MarshalDateTimeTz(tv)
+		}
+		return MarshalDateTime(tv)
+	case t == timeOfDayType:
+		if dataType == "time.tz" {
+			return MarshalTimeOfDayTz(v.Interface().(TimeOfDay))
+		}
+		return MarshalTimeOfDay(v.Interface().(TimeOfDay))
+	case t == urlType:
+		u := v.Interface().(url.URL)
+		return MarshalURI(&u)
+	case t == urlPtrType:
+		if v.IsNil() {
+			return "", nil
+		}
+		return MarshalURI(v.Interface().(*url.URL))
+	case t == bytesType:
+		if dataType == "bin.hex" {
+			return MarshalBinHex(v.Bytes())
+		}
+		return MarshalBinBase64(v.Bytes())
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		if dataType == "char" {
+			r, err := UnmarshalChar(v.String())
+			if err != nil {
+				return "", err
+			}
+			return MarshalChar(r)
+		}
+		return v.String(), nil
+	case reflect.Bool:
+		return MarshalBoolean(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if dataType == "char" && v.Kind() == reflect.Int32 {
+			return MarshalChar(rune(v.Int()))
+		}
+		if isFloatType(dataType) {
+			return MarshalValue(dataType, reflect.ValueOf(float64(v.Int())))
+		}
+		n := v.Int()
+		if min, max, ok := intBounds(dataType); ok {
+			if n < min || (n >= 0 && uint64(n) > max) {
+				return "", fmt.Errorf("value %d out of range of %s", n, dataType)
+			}
+		}
+		return strconv.FormatInt(n, 10), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		if isFloatType(dataType) {
+			return MarshalValue(dataType, reflect.ValueOf(float64(v.Uint())))
+		}
+		n := v.Uint()
+		if _, max, ok := intBounds(dataType); ok && n > max {
+			return "", fmt.Errorf("value %d out of range of %s", n, dataType)
+		}
+		return strconv.FormatUint(n, 10), nil
+	case reflect.Float32, reflect.Float64:
+		switch dataType {
+		case "fixed.14.4":
+			return MarshalFixed14_4(v.Float())
+		case "r4":
+			return strconv.FormatFloat(v.Float(), 'g', -1, 32), nil
+		}
+		return strconv.FormatFloat(v.Float(), 'g', -1, 64), nil
+	}
+	return "", fmt.Errorf("cannot marshal field of type %v as %s", v.Type(), dataType)
+}
+
+// UnmarshalValue sets v, a settable value of one of the field types supported
+// by MarshalArgs, from s, a value of dataType, or of the data type matching
+// the Go type of v if dataType is "".
+func UnmarshalValue(dataType, s string, v reflect.Value) error {
+	switch t := v.Type(); {
+	case t == timeType:
+		var tv time.Time
+		var err error
+		switch dataType {
+		case "date":
+			tv, err = UnmarshalDate(s)
+		case "dateTime.tz":
+			tv, err = UnmarshalDateTimeTz(s)
+		default:
+			tv, err = UnmarshalDateTime(s)
+		}
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(tv))
+		return nil
+	case t == timeOfDayType:
+		var tod TimeOfDay
+		var err error
+		if dataType == "time.tz" {
+			tod, err = UnmarshalTimeOfDayTz(s)
+		} else {
+			tod, err = UnmarshalTimeOfDay(s)
+		}
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(tod))
+		return nil
+	case t == urlType, t == urlPtrType:
+		u, err := UnmarshalURI(s)
+		if err != nil {
+			return err
+		}
+		if t == urlType {
+			v.Set(reflect.ValueOf(*u))
+		} else {
+			v.Set(reflect.ValueOf(u))
+		}
+		return nil
+	case t == bytesType:
+		var b []byte
+		var err error
+		if dataType == "bin.hex" {
+			b, err = UnmarshalBinHex(s)
+		} else {
+			b, err = UnmarshalBinBase64(s)
+		}
+		if err != nil {
+			return err
+		}
+		v.SetBytes(b)
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+		return nil
+	case reflect.Bool:
+		b, err := UnmarshalBoolean(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+		return nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		if dataType == "char" && v.Kind() == reflect.Int32 {
+			r, err := UnmarshalChar(s)
+			if err != nil {
+				return err
+			}
+			v.SetInt(int64(r))
+			return nil
+		}
+		n, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+		if err != nil {
+			return err
+		}
+		if v.OverflowInt(n) {
+			return fmt.Errorf("value %d overflows field of type %v", n, v.Type())
+		}
+		v.SetInt(n)
+		return nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(strings.TrimSpace(s), 10, 64)
+		if err != nil {
+			return err
+		}
+		if v.OverflowUint(n) {
+			return fmt.Errorf("value %d overflows field of type %v", n, v.Type())
+		}
+		v.SetUint(n)
+		return nil
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(strings.TrimSpace(s), v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+		return nil
+	}
+	return fmt.Errorf("cannot unmarshal %s into field of type %v", dataType, v.Type())
+}
@@ -0,0 +1,83 @@
+package soap
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/huin/goupnp/scpd"
+)
+
+var bindSCPD = &scpd.SCPD{
+	Actions: []scpd.Action{{
+		Name: "SetSchedule",
+		Arguments: []scpd.Argument{
+			{Name: "Port", Direction: "in", RelatedStateVariable: "A_ARG_TYPE_Port"},
+			{Name: "Enabled", Direction: "in", RelatedStateVariable: "A_ARG_TYPE_Enabled"},
+			{Name: "Start", Direction: "in", RelatedStateVariable: "A_ARG_TYPE_Start"},
+			{Name: "Comment", Direction: "in", RelatedStateVariable: "A_ARG_TYPE_Comment"},
+			{Name: "Level", Direction: "out", RelatedStateVariable: "A_ARG_TYPE_Level"},
+			{Name: "Payload", Direction: "out", RelatedStateVariable: "A_ARG_TYPE_Payload"},
+		},
+	}},
+	StateVariables: []scpd.StateVariable{
+		{Name: "A_ARG_TYPE_Port", DataType: scpd.DataType{Name: "ui2"}},
+		{Name: "A_ARG_TYPE_Enabled", DataType: scpd.DataType{Name: "boolean"}},
+		{Name: "A_ARG_TYPE_Start", DataType: scpd.DataType{Name: "date"}},
+		{Name: "A_ARG_TYPE_Comment", DataType: scpd.DataType{Name: "string"}},
+		{Name: "A_ARG_TYPE_Level", DataType: scpd.DataType{Name: "fixed.14.4"}},
+		{Name: "A_ARG_TYPE_Payload", DataType: scpd.DataType{Name: "bin.hex"}},
+	},
+}
+
+func TestBindArgs(t *testing.T) {
+	type request struct {
+		Comment string
+		Port    int
+		On      bool      `soap:"Enabled"`
+		Start   time.Time `soap:"Start"`
+		note    string
+	}
+	in := request{Comment: "a<b", Port: 8080, On: true, Start: time.Date(2026, 10, 14, 0, 0, 0, 0, localLoc)}
+	args, err := MarshalArgs(bindSCPD, "SetSchedule", &in)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Arg{{"Port", "8080"}, {"Enabled", "1"}, {"Start", "2026-10-14"}, {"Comment", "a<b"}}
+	if !reflect.DeepEqual(args, want) {
+		t.Errorf("got args %v, want %v", args, want)
+	}
+
+	in.Port = 70000
+	if _, err := MarshalArgs(bindSCPD, "SetSchedule", in); err == nil {
+		t.Error("marshalled port out of range of ui2")
+	}
+	if _, err := MarshalArgs(bindSCPD, "SetSchedule", struct{ Port uint16 }{}); err == nil {
+		t.Error("marshalled without all input arguments")
+	}
+	if _, err := MarshalArgs(bindSCPD, "SetSchedule", struct{ Prot uint16 }{}); err == nil {
+		t.Error("marshalled with a misspelt field")
+	}
+	if _, err := MarshalArgs(bindSCPD, "SetSchedule", (*request)(nil)); err == nil {
+		t.Error("marshalled a nil pointer")
+	}
+
+	var out struct {
+		Level   float64
+		Payload []byte
+	}
+	if err := UnmarshalArgs(bindSCPD, "SetSchedule", []Arg{{"Level", "12.5000"}, {"Payload", "cafe"}, {"Other", "x"}}, &out); err != nil {
+		t.Fatal(err)
+	}
+	if out.Level != 12.5 || string(out.Payload) != "\xca\xfe" {
+		t.Errorf("got out %+v", out)
+	}
+	var nilOut *struct{ Level float64 }
+	if err := UnmarshalArgs(bindSCPD, "SetSchedule", []Arg{{"Level", "1"}}, &nilOut); err == nil {
+		t.Error("unmarshalled into a pointer to a nil pointer")
+	}
+	var badOut struct{ Level int8 }
+	if err := UnmarshalArgs(bindSCPD, "SetSchedule", []Arg{{"Level", "1.5"}}, &badOut); err == nil {
+		t.Error("unmarshalled fraction into int8")
+	}
+}
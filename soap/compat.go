@@ -0,0 +1,52 @@
+package soap
+
+import (
+	"bytes"
+	"encoding/xml"
+	"sync/atomic"
+)
+
+// Compat are compatibility toggles of a SOAPClient, for devices that deviate
+// from UPnP. They are usually set from the quirks of a device, as listed by
+// goupnp.LookupQuirks, rather than directly.
+type Compat struct {
+	// MPost makes actions with the M-POST method and the mandatory
+	// extension headers of UPnP 1.0, for devices that reject POST. Clients
+	// also switch to M-POST by themselves once a POST is rejected with 405
+	// Method Not Allowed.
+	MPost bool
+	// LenientBody accepts responses that end early, such as chunked
+	// responses without their final chunk, and closes the connection after
+	// each action so that it is not reused. Clients also switch to it by
+	// themselves once a response ends early, retrying that action.
+	LenientBody bool
+	// MaxRequestBytes, if positive, is the size of the largest request that
+	// the device handles, for devices that truncate larger ones. Requests are
+	// then sent without their XML declaration, and actions whose requests
+	// are still larger fail rather than being sent.
+	MaxRequestBytes int
+}
+
+// WithCompat sets the compatibility toggles of the client.
+func WithCompat(c Compat) ClientOption {
+	return func(client *SOAPClient) { client.Compat = c }
+}
+
+// mpost returns whether actions are made with M-POST, as set or detected.
+func (client *SOAPClient) mpost() bool {
+	return client.Compat.MPost || atomic.LoadInt32(&client.mpostDetected) != 0
+}
+
+// lenientBody returns whether responses may end early, as set or detected.
+func (client *SOAPClient) lenientBody() bool {
+	return client.Compat.LenientBody || atomic.LoadInt32(&client.lenientDetected) != 0
+}
+
+// trimRequest returns the request data, without its XML declaration if the
+// size of requests is limited.
+func (client *SOAPClient) trimRequest(data []byte) []byte {
+	if client.Compat.MaxRequestBytes <= 0 {
+		return data
+	}
+	return bytes.TrimPrefix(data, []byte(xml.Header))
+}
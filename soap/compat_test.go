@@ -0,0 +1,112 @@
+package soap
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+const emptyResponse = `<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/"><s:Body><u:myactionResponse xmlns:u="mynamespace"/></s:Body></s:Envelope>`
+
+// mpostRoundTripper rejects POST requests as UPnP 1.0 devices requiring
+// M-POST do.
+type mpostRoundTripper struct {
+	methods []string
+	header  http.Header
+}
+
+func (rt *mpostRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.methods = append(rt.methods, req.Method)
+	rt.header = req.Header
+	if req.Method != "M-POST" {
+		return &http.Response{StatusCode: 405, Status: "405 Method Not Allowed", Body: http.NoBody}, nil
+	}
+	return &http.Response{StatusCode: 200, Status: "200 OK", Body: ioutil.NopCloser(strings.NewReader(emptyResponse))}, nil
+}
+
+func TestCompatMPost(t *testing.T) {
+	u, _ := url.Parse("http://example.com/soap")
+	rt := new(mpostRoundTripper)
+	client := NewSOAPClient(*u, WithTransport(rt))
+	for i := 0; i < 2; i++ {
+		if err := client.PerformAction("mynamespace", "myaction", nil, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if got := strings.Join(rt.methods, ","); got != "POST,M-POST,M-POST" {
+		t.Errorf("got methods %s, want POST retried and then M-POST", got)
+	}
+	if got := strings.Join(rt.header["01-SOAPACTION"], ","); got != `"mynamespace#myaction"` {
+		t.Errorf("got 01-SOAPACTION %q", got)
+	}
+}
+
+// truncatedBody ends early, as a chunked response without its final chunk.
+type truncatedBody struct{ io.Reader }
+
+func (b truncatedBody) Read(p []byte) (int, error) {
+	n, err := b.Reader.Read(p)
+	if err == io.EOF {
+		err = io.ErrUnexpectedEOF
+	}
+	return n, err
+}
+
+func (truncatedBody) Close() error { return nil }
+
+func TestCompatLenientBodyAndMaxRequestBytes(t *testing.T) {
+	u, _ := url.Parse("http://example.com/soap")
+	rt := &capturingRoundTripper{resp: &http.Response{
+		StatusCode: 200,
+		Status:     "200 OK",
+		Body:       truncatedBody{strings.NewReader(emptyResponse)},
+	}}
+	client := NewSOAPClient(*u, WithTransport(rt), WithCompat(Compat{LenientBody: true, MaxRequestBytes: 300}))
+	if err := client.PerformAction("mynamespace", "myaction", nil, nil); err != nil {
+		t.Fatal(err)
+	}
+	body, _ := ioutil.ReadAll(rt.capturedReq.Body)
+	if bytes.HasPrefix(body, []byte("<?xml")) || !rt.capturedReq.Close {
+		t.Errorf("got request %q, Close %t; want no XML declaration and the connection closed", body, rt.capturedReq.Close)
+	}
+
+	in := []Arg{{Name: "Value", Value: strings.Repeat("x", 300)}}
+	if _, err := client.PerformActionArgs(context.Background(), "mynamespace", "myaction", in); err == nil {
+		t.Error("want error for a request larger than MaxRequestBytes, got nil")
+	}
+}
+
+// truncatingRoundTripper responds to the first request with a response that
+// ends early on a connection left unusable, and to others in full.
+type truncatingRoundTripper struct {
+	closes []bool
+}
+
+func (rt *truncatingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.closes = append(rt.closes, req.Close)
+	body := io.ReadCloser(ioutil.NopCloser(strings.NewReader(emptyResponse)))
+	if len(rt.closes) == 1 {
+		body = truncatedBody{strings.NewReader(emptyResponse[:40])}
+	}
+	return &http.Response{StatusCode: 200, Status: "200 OK", Body: body}, nil
+}
+
+func TestCompatLenientBodyDetected(t *testing.T) {
+	u, _ := url.Parse("http://example.com/soap")
+	rt := new(truncatingRoundTripper)
+	client := NewSOAPClient(*u, WithTransport(rt))
+	for i := 0; i < 2; i++ {
+		if err := client.PerformAction("mynamespace", "myaction", nil, nil); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if want := []bool{false, true, true}; !reflect.DeepEqual(rt.closes, want) {
+		t.Errorf("got request Close %v, want %v: the truncated action retried and then lenient bodies", rt.closes, want)
+	}
+}
@@ -0,0 +1,77 @@
+package soap
+
+import (
+	"bytes"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+)
+
+// OutgoingRequest is the request of an action, as a SOAPClient would send it,
+// for audit logs, comparing with packet captures and reporting serialization
+// bugs.
+type OutgoingRequest struct {
+	// Method is POST, or M-POST for devices that need it.
+	Method string
+	URL    url.URL
+	Header http.Header
+	// Envelope is the body of the request, the SOAP envelope of the action.
+	Envelope []byte
+
+	close bool // Whether the connection is closed after the request.
+}
+
+// Bytes returns the request as it is written on the wire, with its request
+// line, the headers that the HTTP client adds and its envelope.
+func (r *OutgoingRequest) Bytes() []byte {
+	u := r.URL
+	req := &http.Request{
+		Method:        r.Method,
+		URL:           &u,
+		Header:        r.Header,
+		Body:          ioutil.NopCloser(bytes.NewReader(r.Envelope)),
+		ContentLength: int64(len(r.Envelope)),
+		Close:         r.close,
+	}
+	var buf bytes.Buffer
+	// Writing to a bytes.Buffer cannot fail.
+	req.Write(&buf)
+	return buf.Bytes()
+}
+
+// DryRunError is returned by the actions of a client with DryRun set, and so
+// by the generated clients using it, in place of sending their request.
+type DryRunError struct {
+	Request *OutgoingRequest
+}
+
+func (err *DryRunError) Error() string {
+	return "goupnp: SOAP action " + err.Request.Method + " to " + err.Request.URL.String() + " not sent in dry run"
+}
+
+// WithDryRun sets DryRun, making the actions of the client fail with a
+// *DryRunError holding their request, rather than sending it.
+func WithDryRun() ClientOption {
+	return func(client *SOAPClient) { client.DryRun = true }
+}
+
+// BuildRequest returns the request that PerformAction would send for the
+// action, without sending it, with the compatibility toggles of the client
+// applied.
+func (client *SOAPClient) BuildRequest(actionNamespace, actionName string, inAction interface{}) (*OutgoingRequest, error) {
+	request, err := client.newRequest(actionNamespace, actionName, inAction, client.mpost())
+	if err != nil {
+		return nil, err
+	}
+	defer request.Body.Close()
+	envelope := make([]byte, request.ContentLength)
+	io.ReadFull(request.Body, envelope)
+	return &OutgoingRequest{
+		Method:   request.Method,
+		URL:      client.EndpointURL,
+		Header:   request.Header,
+		Envelope: envelope,
+		close:    request.Close,
+	}, nil
+}
@@ -0,0 +1,33 @@
+package soap
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+)
+
+func TestDryRun(t *testing.T) {
+	u, _ := url.Parse("http://example.com/soap")
+	rt := new(mpostRoundTripper)
+	client := NewSOAPClient(*u, WithTransport(rt), WithDryRun())
+	in := &struct{ Foo string }{Foo: "a<b"}
+	err := client.PerformAction("mynamespace", "myaction", in, nil)
+	var dryRun *DryRunError
+	if !errors.As(err, &dryRun) {
+		t.Fatalf("got error %v, want *DryRunError", err)
+	}
+	if len(rt.methods) != 0 {
+		t.Errorf("request sent in dry run")
+	}
+	want := soapPrefix + `<u:myaction xmlns:u="mynamespace"><Foo>a&lt;b</Foo></u:myaction>` + soapSuffix
+	if got := string(dryRun.Request.Envelope); got != want {
+		t.Errorf("got envelope\n%s\nwant\n%s", got, want)
+	}
+	wire := string(dryRun.Request.Bytes())
+	for _, part := range []string{"POST /soap HTTP/1.1\r\n", "Host: example.com\r\n", "SOAPACTION: \"mynamespace#myaction\"\r\n", "\r\n\r\n" + want} {
+		if !strings.Contains(wire, part) {
+			t.Errorf("wire request %q does not contain %q", wire, part)
+		}
+	}
+}
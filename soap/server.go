@@ -0,0 +1,187 @@
+// Server-side SOAP support, for hosting UPnP services.
+
+package soap
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// UPnP error codes, as defined in section 3.2.2 "Control: Action Response:
+// Failure" in
+// http://upnp.org/specs/arch/UPnP-arch-DeviceArchitecture-v1.1.pdf
+const (
+	ErrCodeInvalidAction                = 401
+	ErrCodeInvalidArgs                  = 402
+	ErrCodeActionFailed                 = 501
+	ErrCodeArgumentValueInvalid         = 600
+	ErrCodeArgumentValueOutOfRange      = 601
+	ErrCodeOptionalActionNotImplemented = 602
+	ErrCodeOutOfMemory                  = 603
+	ErrCodeHumanInterventionRequired    = 604
+	ErrCodeStringArgumentTooLong        = 605
+)
+
+// UPnPError implements error, and is a UPnP-defined error returned by a
+// service for a failed action invocation. Codes 600-699 are common action
+// errors, and 700-799 are action-specific errors defined by service
+// specifications.
+type UPnPError struct {
+	Code        int    `xml:"errorCode"`
+	Description string `xml:"errorDescription"`
+}
+
+func (err *UPnPError) Error() string {
+	return fmt.Sprintf("UPnP error %d: %s", err.Code, err.Description)
+}
+
+// UPnPErrorCode implements upnperr.Coder.
+func (err *UPnPError) UPnPErrorCode() int {
+	return err.Code
+}
+
+// NewUPnPError creates a UPnPError with the given code and description.
+func NewUPnPError(code int, description string) *UPnPError {
+	return &UPnPError{Code: code, Description: description}
+}
+
+// Arg is a single named argument to or from an action.
+type Arg struct {
+	Name  string
+	Value string
+}
+
+// FindArg returns the value of the named argument within args. A *UPnPError
+// with ErrCodeInvalidArgs is returned if there is no such argument.
+func FindArg(args []Arg, name string) (string, error) {
+	for _, arg := range args {
+		if arg.Name == name {
+			return arg.Value, nil
+		}
+	}
+	return "", NewUPnPError(ErrCodeInvalidArgs, "missing argument "+name)
+}
+
+// ActionRequest is an action invocation received by a service.
+type ActionRequest struct {
+	// ServiceType is the namespace of the action (e.g
+	// "urn:schemas-upnp-org:service:WANIPConnection:1").
+	ServiceType string
+	// Action is the name of the action being invoked.
+	Action string
+	// Args contains the input arguments, in the order they were received.
+	Args []Arg
+}
+
+type actionRequestEnvelope struct {
+	XMLName xml.Name          `xml:"http://schemas.xmlsoap.org/soap/envelope/ Envelope"`
+	Body    actionRequestBody `xml:"http://schemas.xmlsoap.org/soap/envelope/ Body"`
+}
+
+type actionRequestBody struct {
+	Action actionRequestAction `xml:",any"`
+}
+
+type actionRequestAction struct {
+	XMLName xml.Name
+	Args    []actionRequestArg `xml:",any"`
+}
+
+type actionRequestArg struct {
+	XMLName xml.Name
+	Value   string `xml:",chardata"`
+}
+
+// ParseActionRequest reads and decodes a SOAP action request. The
+// SOAPACTION header must agree with the action in the body. A *UPnPError is
+// returned if the request is malformed.
+func ParseActionRequest(r *http.Request) (*ActionRequest, error) {
+	soapAction := strings.Trim(r.Header.Get("SOAPACTION"), `"`)
+	hashIndex := strings.LastIndex(soapAction, "#")
+	if hashIndex < 0 {
+		return nil, NewUPnPError(ErrCodeInvalidAction, fmt.Sprintf("bad SOAPACTION header %q", soapAction))
+	}
+	serviceType, actionName := soapAction[:hashIndex], soapAction[hashIndex+1:]
+
+	var env actionRequestEnvelope
+	if err := xml.NewDecoder(r.Body).Decode(&env); err != nil {
+		return nil, NewUPnPError(ErrCodeInvalidAction, fmt.Sprintf("error decoding request body: %v", err))
+	}
+	action := env.Body.Action
+	if action.XMLName.Local != actionName || action.XMLName.Space != serviceType {
+		return nil, NewUPnPError(ErrCodeInvalidAction, fmt.Sprintf("action %s#%s in body does not match SOAPACTION header %q",
+			action.XMLName.Space, action.XMLName.Local, soapAction))
+	}
+
+	req := &ActionRequest{
+		ServiceType: serviceType,
+		Action:      actionName,
+		Args:        make([]Arg, len(action.Args)),
+	}
+	for i, arg := range action.Args {
+		req.Args[i] = Arg{Name: arg.XMLName.Local, Value: arg.Value}
+	}
+	return req, nil
+}
+
+// WriteActionResponse writes a successful SOAP action response with the given
+// output arguments, which should be in the order given in the SCPD.
+func WriteActionResponse(w http.ResponseWriter, serviceType, actionName string, out []Arg) error {
+	buf := new(bytes.Buffer)
+	buf.WriteString(soapPrefix)
+	buf.WriteString(`<u:`)
+	xml.EscapeText(buf, []byte(actionName))
+	buf.WriteString(`Response xmlns:u="`)
+	xml.EscapeText(buf, []byte(serviceType))
+	buf.WriteString(`">`)
+	for _, arg := range out {
+		writeElement(buf, arg.Name, arg.Value)
+	}
+	buf.WriteString(`</u:`)
+	xml.EscapeText(buf, []byte(actionName))
+	buf.WriteString(`Response>`)
+	buf.WriteString(soapSuffix)
+	return writeSOAPBody(w, http.StatusOK, buf.Bytes())
+}
+
+// WriteActionFault writes a SOAP fault response for the given error. Errors
+// other than *UPnPError are reported as ErrCodeActionFailed.
+func WriteActionFault(w http.ResponseWriter, err error) error {
+	upnpErr, ok := err.(*UPnPError)
+	if !ok {
+		upnpErr = NewUPnPError(ErrCodeActionFailed, err.Error())
+	}
+	buf := new(bytes.Buffer)
+	buf.WriteString(soapPrefix)
+	buf.WriteString(`<s:Fault><faultcode>s:Client</faultcode><faultstring>UPnPError</faultstring>`)
+	buf.WriteString(`<detail><UPnPError xmlns="urn:schemas-upnp-org:control-1-0">`)
+	writeElement(buf, "errorCode", strconv.Itoa(upnpErr.Code))
+	writeElement(buf, "errorDescription", upnpErr.Description)
+	buf.WriteString(`</UPnPError></detail></s:Fault>`)
+	buf.WriteString(soapSuffix)
+	return writeSOAPBody(w, http.StatusInternalServerError, buf.Bytes())
+}
+
+func writeElement(buf *bytes.Buffer, name, value string) {
+	buf.WriteString(`<`)
+	xml.EscapeText(buf, []byte(name))
+	buf.WriteString(`>`)
+	xml.EscapeText(buf, []byte(value))
+	buf.WriteString(`</`)
+	xml.EscapeText(buf, []byte(name))
+	buf.WriteString(`>`)
+}
+
+func writeSOAPBody(w http.ResponseWriter, status int, body []byte) error {
+	w.Header().Set("Content-Type", `text/xml; charset="utf-8"`)
+	w.Header().Set("Ext", "")
+	w.Header().Set("Content-Length", strconv.Itoa(len(body)))
+	w.WriteHeader(status)
+	_, err := io.Copy(w, bytes.NewReader(body))
+	return err
+}
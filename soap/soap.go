@@ -3,13 +3,26 @@
 package soap
 
 import (
+	"bufio"
 	"bytes"
+	"context"
 	"encoding/xml"
+	"errors"
 	"fmt"
-	"io/ioutil"
+	"io"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"reflect"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/huin/goupnp/internal/logging"
+	"github.com/huin/goupnp/internal/transport"
+	"github.com/huin/goupnp/metrics"
+	"github.com/huin/goupnp/tracing"
+	"github.com/huin/goupnp/upnperr"
 )
 
 const (
@@ -21,61 +34,279 @@ const (
 type SOAPClient struct {
 	EndpointURL url.URL
 	HTTPClient  http.Client
+	// Logger logs the actions performed at the debug level, the
+	// goupnp.SetLogger logger if nil.
+	Logger *slog.Logger
+	// Compat are the compatibility toggles of the client, for devices that
+	// deviate from UPnP.
+	Compat Compat
+	// DryRun makes actions fail with a *DryRunError holding their request,
+	// rather than sending it.
+	DryRun bool
+
+	mpostDetected   int32 // Set atomically once a POST is rejected.
+	lenientDetected int32 // Set atomically once a response ends early.
+}
+
+// ClientOption configures a SOAPClient created by NewSOAPClient.
+type ClientOption func(*SOAPClient)
+
+// WithTimeout sets the time limit of each action, including reading the
+// response. There is no limit by default, other than the context of each
+// action.
+func WithTimeout(d time.Duration) ClientOption {
+	return func(c *SOAPClient) { c.HTTPClient.Timeout = d }
+}
+
+// WithTransport sets the transport that requests are made with, by default
+// http.DefaultTransport, dialing through the dialer and proxy set with
+// goupnp.SetDialer and goupnp.SetProxy.
+func WithTransport(rt http.RoundTripper) ClientOption {
+	return func(c *SOAPClient) { c.HTTPClient.Transport = rt }
+}
+
+// WithLogger sets the Logger of the client.
+func WithLogger(l *slog.Logger) ClientOption {
+	return func(c *SOAPClient) { c.Logger = l }
 }
 
-func NewSOAPClient(endpointURL url.URL) *SOAPClient {
-	return &SOAPClient{
+// NewSOAPClient returns a client of the control URL endpointURL, configured by
+// opts.
+func NewSOAPClient(endpointURL url.URL, opts ...ClientOption) *SOAPClient {
+	client := &SOAPClient{
 		EndpointURL: endpointURL,
 	}
+	for _, opt := range opts {
+		opt(client)
+	}
+	return client
 }
 
 // PerformSOAPAction makes a SOAP request, with the given action.
 // inAction and outAction must both be pointers to structs with string fields
 // only.
 func (client *SOAPClient) PerformAction(actionNamespace, actionName string, inAction interface{}, outAction interface{}) error {
-	requestBytes, err := encodeRequestAction(actionNamespace, actionName, inAction)
-	if err != nil {
-		return err
+	return client.PerformActionCtx(context.Background(), actionNamespace, actionName, inAction, outAction)
+}
+
+// PerformActionCtx is as PerformAction, making the request with the given
+// context, which can cancel the request or give it a deadline.
+func (client *SOAPClient) PerformActionCtx(ctx context.Context, actionNamespace, actionName string, inAction interface{}, outAction interface{}) (err error) {
+	if client.DryRun {
+		request, err := client.BuildRequest(actionNamespace, actionName, inAction)
+		if err != nil {
+			return err
+		}
+		return &DryRunError{Request: request}
 	}
 
-	response, err := client.HTTPClient.Do(&http.Request{
-		Method: "POST",
-		URL:    &client.EndpointURL,
-		Header: http.Header{
-			"SOAPACTION":   []string{`"` + actionNamespace + "#" + actionName + `"`},
-			"CONTENT-TYPE": []string{"text/xml; charset=\"utf-8\""},
-		},
-		Body: ioutil.NopCloser(bytes.NewBuffer(requestBytes)),
-		// Set ContentLength to avoid chunked encoding - some servers might not support it.
-		ContentLength: int64(len(requestBytes)),
-	})
+	ctx, span := tracing.Start(ctx, tracing.SpanSOAPAction, tracing.String(tracing.KeyAction, actionName),
+		tracing.String(tracing.KeyService, actionNamespace), tracing.String(tracing.KeyURL, client.EndpointURL.String()))
+	defer func() { span.End(err) }()
+	if metrics.Enabled() {
+		start := time.Now()
+		defer func() {
+			metrics.Observe(metrics.SOAPDuration, time.Since(start).Seconds(),
+				metrics.Action(actionName), metrics.Result(err))
+		}()
+	}
+
+	if logger := logging.Or(client.Logger); logger.Enabled(ctx, slog.LevelDebug) {
+		start := time.Now()
+		defer func() {
+			logger.DebugContext(ctx, "soap: performed action", slog.String(logging.KeyAction, actionName),
+				slog.String(logging.KeyService, actionNamespace), slog.String("url", client.EndpointURL.String()),
+				slog.Duration("duration", time.Since(start)), logging.Err(err))
+		}()
+	}
+
+	err = client.performAction(ctx, actionNamespace, actionName, inAction, outAction)
+	if errors.Is(err, io.ErrUnexpectedEOF) && !client.lenientBody() {
+		// Devices whose responses end early, such as chunked responses
+		// without their final chunk, may leave the connection unusable. The
+		// action is retried on a new connection, accepting a response that
+		// ends early, as the client does from then on.
+		atomic.StoreInt32(&client.lenientDetected, 1)
+		err = client.performAction(ctx, actionNamespace, actionName, inAction, outAction)
+	}
+	return err
+}
+
+// performAction sends the request of the action, and decodes its response
+// into outAction.
+func (client *SOAPClient) performAction(ctx context.Context, actionNamespace, actionName string, inAction interface{}, outAction interface{}) error {
+	response, err := client.post(ctx, actionNamespace, actionName, inAction, client.mpost())
 	if err != nil {
-		return fmt.Errorf("goupnp: error performing SOAP HTTP request: %v", err)
+		return err
+	}
+	if response.StatusCode == http.StatusMethodNotAllowed && !client.mpost() {
+		// UPnP 1.0 devices may only accept M-POST, which POST is retried
+		// with, and which the client uses from then on.
+		response.Body.Close()
+		atomic.StoreInt32(&client.mpostDetected, 1)
+		if response, err = client.post(ctx, actionNamespace, actionName, inAction, true); err != nil {
+			return err
+		}
 	}
 	defer response.Body.Close()
-	if response.StatusCode != 200 {
-		return fmt.Errorf("goupnp: SOAP request got HTTP %s", response.Status)
+	if client.lenientBody() {
+		response.Body = transport.LenientBody(response.Body)
+	}
+	// Faults are returned with a 500 status.
+	if response.StatusCode != 200 && response.StatusCode != 500 {
+		return upnperr.New(upnperr.ErrStatus, "goupnp: SOAP request got HTTP "+response.Status)
 	}
 
 	responseEnv := newSOAPEnvelope()
-	decoder := xml.NewDecoder(response.Body)
+	br := responseReaders.Get().(*bufio.Reader)
+	br.Reset(response.Body)
+	defer func() {
+		br.Reset(nil)
+		responseReaders.Put(br)
+	}()
+	decoder := xml.NewDecoder(br)
 	if err := decoder.Decode(responseEnv); err != nil {
-		return fmt.Errorf("goupnp: error decoding response body: %v", err)
+		if response.StatusCode != 200 {
+			return upnperr.New(upnperr.ErrStatus, "goupnp: SOAP request got HTTP "+response.Status)
+		}
+		return upnperr.Wrap(upnperr.ErrMalformed, "goupnp: error decoding response body", err)
 	}
 
-	if responseEnv.Body.Fault != nil {
-		return responseEnv.Body.Fault
+	if fault := responseEnv.Body.Fault; fault != nil {
+		// The UPnPError detail is decoded separately, as encoding/xml cannot
+		// decode it alongside the Detail string.
+		var detail faultDetail
+		if err := xml.Unmarshal(responseEnv.Body.RawAction, &detail); err == nil {
+			fault.UPnPError = detail.UPnPError
+		}
+		return fault
+	}
+	if response.StatusCode != 200 {
+		return upnperr.New(upnperr.ErrStatus, "goupnp: SOAP request got HTTP "+response.Status)
 	}
 
 	if outAction != nil {
 		if err := xml.Unmarshal(responseEnv.Body.RawAction, outAction); err != nil {
-			return fmt.Errorf("goupnp: error unmarshalling out action: %v, %v", err, responseEnv.Body.RawAction)
+			return upnperr.Wrap(upnperr.ErrMalformed, "goupnp: error unmarshalling out action",
+				fmt.Errorf("%v, %v", err, responseEnv.Body.RawAction))
 		}
 	}
 
 	return nil
 }
 
+// post sends the request of the action, with M-POST if mpost is true.
+func (client *SOAPClient) post(ctx context.Context, actionNamespace, actionName string, inAction interface{}, mpost bool) (*http.Response, error) {
+	request, err := client.newRequest(actionNamespace, actionName, inAction, mpost)
+	if err != nil {
+		return nil, err
+	}
+	response, err := transport.Client(client.HTTPClient).Do(request.WithContext(ctx))
+	if err != nil {
+		return nil, upnperr.Transport("goupnp: error performing SOAP HTTP request", err)
+	}
+	return response, nil
+}
+
+// newRequest returns the request of the action, with M-POST if mpost is
+// true. Its body returns its buffer for reuse once closed.
+func (client *SOAPClient) newRequest(actionNamespace, actionName string, inAction interface{}, mpost bool) (*http.Request, error) {
+	body := newRequestBody()
+	if err := encodeRequestAction(body.buf, actionNamespace, actionName, inAction); err != nil {
+		body.Close()
+		return nil, err
+	}
+	data := client.trimRequest(body.buf.Bytes())
+	if max := client.Compat.MaxRequestBytes; max > 0 && len(data) > max {
+		body.Close()
+		return nil, fmt.Errorf("goupnp: SOAP request of %d bytes is larger than the %d bytes that the device handles", len(data), max)
+	}
+	body.r.Reset(data)
+
+	soapAction := `"` + actionNamespace + "#" + actionName + `"`
+	request := &http.Request{
+		Method: "POST",
+		URL:    &client.EndpointURL,
+		Header: http.Header{
+			"SOAPACTION":   []string{soapAction},
+			"CONTENT-TYPE": []string{"text/xml; charset=\"utf-8\""},
+		},
+		Body: body,
+		// Set ContentLength to avoid chunked encoding - some servers might not support it.
+		ContentLength: int64(len(data)),
+		Close:         client.lenientBody(),
+	}
+	if mpost {
+		request.Method = "M-POST"
+		delete(request.Header, "SOAPACTION")
+		request.Header["MAN"] = []string{`"http://schemas.xmlsoap.org/soap/envelope/"; ns=01`}
+		request.Header["01-SOAPACTION"] = []string{soapAction}
+	}
+	return request, nil
+}
+
+// PerformActionArgs is as PerformActionCtx, with the arguments given and
+// returned as lists, in the order of the SCPD, for actions that are only known
+// at run time.
+func (client *SOAPClient) PerformActionArgs(ctx context.Context, actionNamespace, actionName string, in []Arg) ([]Arg, error) {
+	var out actionRequestAction
+	if err := client.PerformActionCtx(ctx, actionNamespace, actionName, in, &out); err != nil {
+		return nil, err
+	}
+	args := make([]Arg, len(out.Args))
+	for i, arg := range out.Args {
+		args[i] = Arg{Name: arg.XMLName.Local, Value: arg.Value}
+	}
+	return args, nil
+}
+
+// maxPooledRequestBytes is the capacity of the largest request buffer kept
+// for reuse, so that one large request does not hold on to its memory.
+const maxPooledRequestBytes = 64 << 10
+
+var (
+	// requestBuffers holds the buffers that requests are encoded into.
+	requestBuffers = sync.Pool{New: func() interface{} { return new(bytes.Buffer) }}
+	// responseReaders holds the readers that responses are decoded from.
+	responseReaders = sync.Pool{New: func() interface{} { return bufio.NewReaderSize(nil, 4096) }}
+)
+
+// requestBody is the body of a request, which returns its buffer to
+// requestBuffers once closed by the transport. The transport may close it
+// while still reading it from another goroutine, so reads and Close are
+// serialized, and reads after Close return io.EOF.
+type requestBody struct {
+	lock sync.Mutex // Protects all below.
+	r    bytes.Reader
+	buf  *bytes.Buffer
+}
+
+func newRequestBody() *requestBody {
+	buf := requestBuffers.Get().(*bytes.Buffer)
+	buf.Reset()
+	return &requestBody{buf: buf}
+}
+
+func (b *requestBody) Read(p []byte) (int, error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.r.Read(p)
+}
+
+func (b *requestBody) Close() error {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	if b.buf == nil {
+		return nil
+	}
+	b.r.Reset(nil)
+	if b.buf.Cap() <= maxPooledRequestBytes {
+		requestBuffers.Put(b.buf)
+	}
+	b.buf = nil
+	return nil
+}
+
 // newSOAPAction creates a soapEnvelope with the given action and arguments.
 func newSOAPEnvelope() *soapEnvelope {
 	return &soapEnvelope{
@@ -88,8 +319,7 @@ func newSOAPEnvelope() *soapEnvelope {
 // 500s for requests where the outer default xmlns is set to the SOAP
 // namespace, and then reassigning the default namespace within that to the
 // service namespace. Hand-coding the outer XML to work-around this.
-func encodeRequestAction(actionNamespace, actionName string, inAction interface{}) ([]byte, error) {
-	requestBuf := new(bytes.Buffer)
+func encodeRequestAction(requestBuf *bytes.Buffer, actionNamespace, actionName string, inAction interface{}) error {
 	requestBuf.WriteString(soapPrefix)
 	requestBuf.WriteString(`<u:`)
 	xml.EscapeText(requestBuf, []byte(actionName))
@@ -98,39 +328,54 @@ func encodeRequestAction(actionNamespace, actionName string, inAction interface{
 	requestBuf.WriteString(`">`)
 	if inAction != nil {
 		if err := encodeRequestArgs(requestBuf, inAction); err != nil {
-			return nil, err
+			return err
 		}
 	}
 	requestBuf.WriteString(`</u:`)
 	xml.EscapeText(requestBuf, []byte(actionName))
 	requestBuf.WriteString(`>`)
 	requestBuf.WriteString(soapSuffix)
-	return requestBuf.Bytes(), nil
+	return nil
 }
 
 func encodeRequestArgs(w *bytes.Buffer, inAction interface{}) error {
+	if args, ok := inAction.([]Arg); ok {
+		for _, arg := range args {
+			writeElement(w, arg.Name, arg.Value)
+		}
+		return nil
+	}
 	in := reflect.Indirect(reflect.ValueOf(inAction))
 	if in.Kind() != reflect.Struct {
 		return fmt.Errorf("goupnp: SOAP inAction is not a struct but of type %v", in.Type())
 	}
-	enc := xml.NewEncoder(w)
+	return encodeRequestFields(w, in)
+}
+
+// encodeRequestFields encodes the fields of the struct in as arguments, and
+// the fields of embedded structs in their place, so that arguments can be
+// added to a request by embedding it.
+func encodeRequestFields(w *bytes.Buffer, in reflect.Value) error {
 	nFields := in.NumField()
 	inType := in.Type()
 	for i := 0; i < nFields; i++ {
 		field := inType.Field(i)
+		value := in.Field(i)
+		if field.Anonymous && value.Kind() == reflect.Struct {
+			if err := encodeRequestFields(w, value); err != nil {
+				return err
+			}
+			continue
+		}
 		argName := field.Name
 		if nameOverride := field.Tag.Get("soap"); nameOverride != "" {
 			argName = nameOverride
 		}
-		value := in.Field(i)
 		if value.Kind() != reflect.String {
 			return fmt.Errorf("goupnp: SOAP arg %q is not of type string, but of type %v", argName, value.Type())
 		}
-		if err := enc.EncodeElement(value.Interface(), xml.StartElement{xml.Name{"", argName}, nil}); err != nil {
-			return fmt.Errorf("goupnp: error encoding SOAP arg %q: %v", argName, err)
-		}
+		writeElement(w, argName, value.String())
 	}
-	enc.Flush()
 	return nil
 }
 
@@ -150,8 +395,31 @@ type SOAPFaultError struct {
 	FaultCode   string `xml:"faultcode"`
 	FaultString string `xml:"faultstring"`
 	Detail      string `xml:"detail"`
+	// UPnPError is the UPnP error within the detail, if any.
+	UPnPError *UPnPError `xml:"-"`
+}
+
+type faultDetail struct {
+	UPnPError *UPnPError `xml:"detail>UPnPError"`
 }
 
 func (err *SOAPFaultError) Error() string {
+	if err.UPnPError != nil {
+		return fmt.Sprintf("SOAP fault: %s: %v", err.FaultString, err.UPnPError)
+	}
 	return fmt.Sprintf("SOAP fault: %s", err.FaultString)
 }
+
+// Unwrap returns the UPnPError of err, if any, so that it is found by
+// errors.As and upnperr.FaultCode.
+func (err *SOAPFaultError) Unwrap() error {
+	if err.UPnPError == nil {
+		return nil
+	}
+	return err.UPnPError
+}
+
+// Is reports whether target is upnperr.ErrFault.
+func (err *SOAPFaultError) Is(target error) bool {
+	return target == upnperr.ErrFault
+}
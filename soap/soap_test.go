@@ -2,11 +2,17 @@ package soap
 
 import (
 	"bytes"
+	"context"
+	"errors"
 	"io/ioutil"
 	"net/http"
 	"net/url"
 	"reflect"
+	"strings"
 	"testing"
+
+	"github.com/huin/goupnp/tracing"
+	"github.com/huin/goupnp/upnperr"
 )
 
 type capturingRoundTripper struct {
@@ -83,3 +89,219 @@ func TestActionInputs(t *testing.T) {
 		t.Errorf("Bad output\nwant: %+v\n got: %+v", wantOut, gotOut)
 	}
 }
+
+func TestEncodeEmbeddedArgs(t *testing.T) {
+	type In struct {
+		Foo string
+	}
+	in := struct {
+		In
+		Vendor string `soap:"vendor"`
+	}{In{"foo"}, "v"}
+	var buf bytes.Buffer
+	if err := encodeRequestAction(&buf, "mynamespace", "myaction", &in); err != nil {
+		t.Fatal(err)
+	}
+	got := buf.Bytes()
+	want := (soapPrefix +
+		`<u:myaction xmlns:u="mynamespace">` +
+		`<Foo>foo</Foo>` +
+		`<vendor>v</vendor>` +
+		`</u:myaction>` +
+		soapSuffix)
+	if string(got) != want {
+		t.Errorf("Bad request body\nwant: %q\n got: %q", want, got)
+	}
+}
+
+func TestActionFault(t *testing.T) {
+	url, err := url.Parse("http://example.com/soap")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rt := &capturingRoundTripper{
+		resp: &http.Response{
+			StatusCode: 500,
+			Status:     "500 Internal Server Error",
+			Body: ioutil.NopCloser(bytes.NewBufferString(`
+				<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/">
+					<s:Body>
+						<s:Fault>
+							<faultcode>s:Client</faultcode>
+							<faultstring>UPnPError</faultstring>
+							<detail>
+								<UPnPError xmlns="urn:schemas-upnp-org:control-1-0">
+									<errorCode>718</errorCode>
+									<errorDescription>ConflictInMappingEntry</errorDescription>
+								</UPnPError>
+							</detail>
+						</s:Fault>
+					</s:Body>
+				</s:Envelope>
+			`)),
+		},
+	}
+	client := SOAPClient{
+		EndpointURL: *url,
+		HTTPClient:  http.Client{Transport: rt},
+	}
+	err = client.PerformAction("mynamespace", "myaction", nil, nil)
+	fault, ok := err.(*SOAPFaultError)
+	if !ok {
+		t.Fatalf("want *SOAPFaultError, got %v", err)
+	}
+	want := &UPnPError{Code: 718, Description: "ConflictInMappingEntry"}
+	if !reflect.DeepEqual(fault.UPnPError, want) {
+		t.Errorf("Bad UPnPError\nwant: %+v\n got: %+v", want, fault.UPnPError)
+	}
+	if !errors.Is(err, upnperr.ErrFault) {
+		t.Errorf("errors.Is(%v, upnperr.ErrFault) = false", err)
+	}
+	if code, ok := upnperr.FaultCode(err); !ok || code != 718 {
+		t.Errorf("FaultCode = %d, %t; want 718, true", code, ok)
+	}
+}
+
+func TestActionCtx(t *testing.T) {
+	url, err := url.Parse("http://example.com/soap")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rt := &capturingRoundTripper{err: context.Canceled}
+	client := SOAPClient{
+		EndpointURL: *url,
+		HTTPClient:  http.Client{Transport: rt},
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	if err := client.PerformActionCtx(ctx, "mynamespace", "myaction", nil, nil); err == nil {
+		t.Fatal("want error from transport, got nil")
+	}
+	if rt.capturedReq.Context() != ctx {
+		t.Error("request was not made with the given context")
+	}
+}
+
+type spanKey struct{}
+
+type recordedSpan struct {
+	name  string
+	attrs map[string]interface{}
+	err   error
+	ended bool
+}
+
+func (s *recordedSpan) SetAttributes(attrs ...tracing.Attribute) {
+	for _, a := range attrs {
+		s.attrs[a.Key] = a.Value
+	}
+}
+
+func (s *recordedSpan) End(err error) {
+	s.err, s.ended = err, true
+}
+
+type recordingTracer struct {
+	spans []*recordedSpan
+}
+
+func (rt *recordingTracer) Start(ctx context.Context, name string, attrs ...tracing.Attribute) (context.Context, tracing.Span) {
+	s := &recordedSpan{name: name, attrs: make(map[string]interface{})}
+	s.SetAttributes(attrs...)
+	rt.spans = append(rt.spans, s)
+	return context.WithValue(ctx, spanKey{}, s), s
+}
+
+func TestActionTracing(t *testing.T) {
+	tracer := &recordingTracer{}
+	tracing.SetTracer(tracer)
+	defer tracing.SetTracer(nil)
+
+	rt := &capturingRoundTripper{
+		resp: &http.Response{
+			StatusCode: 500,
+			Body:       ioutil.NopCloser(bytes.NewBufferString("not a SOAP response")),
+		},
+	}
+	client := SOAPClient{
+		EndpointURL: url.URL{Scheme: "http", Host: "example.com", Path: "/soap"},
+		HTTPClient:  http.Client{Transport: rt},
+	}
+	err := client.PerformActionCtx(context.Background(), "mynamespace", "myaction", nil, nil)
+	if err == nil {
+		t.Fatal("got nil error, want failure")
+	}
+
+	if len(tracer.spans) != 1 {
+		t.Fatalf("got %d spans, want 1", len(tracer.spans))
+	}
+	span := tracer.spans[0]
+	if span.name != tracing.SpanSOAPAction || !span.ended || span.err != err {
+		t.Errorf("got span %q ended=%t err=%v, want %q ended with %v", span.name, span.ended, span.err, tracing.SpanSOAPAction, err)
+	}
+	wantAttrs := map[string]interface{}{
+		tracing.KeyAction:  "myaction",
+		tracing.KeyService: "mynamespace",
+		tracing.KeyURL:     "http://example.com/soap",
+	}
+	if !reflect.DeepEqual(span.attrs, wantAttrs) {
+		t.Errorf("got attributes %v, want %v", span.attrs, wantAttrs)
+	}
+	if got := rt.capturedReq.Context().Value(spanKey{}); got != span {
+		t.Errorf("request context does not contain the span")
+	}
+}
+
+// cannedRoundTripper responds to every request with body, reading and
+// closing the request body as a transport does.
+type cannedRoundTripper struct {
+	body string
+}
+
+func (rt cannedRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	ioutil.ReadAll(req.Body)
+	req.Body.Close()
+	return &http.Response{StatusCode: 200, Body: ioutil.NopCloser(strings.NewReader(rt.body))}, nil
+}
+
+func BenchmarkPerformAction(b *testing.B) {
+	client := NewSOAPClient(url.URL{Scheme: "http", Host: "example.com", Path: "/soap"},
+		WithTransport(cannedRoundTripper{`<s:Envelope xmlns:s="http://schemas.xmlsoap.org/soap/envelope/"><s:Body>` +
+			`<u:GetExternalIPAddressResponse xmlns:u="urn:schemas-upnp-org:service:WANIPConnection:1">` +
+			`<NewExternalIPAddress>203.0.113.1</NewExternalIPAddress>` +
+			`</u:GetExternalIPAddressResponse></s:Body></s:Envelope>`}))
+	in := struct {
+		NewRemoteHost   string
+		NewExternalPort string
+	}{"", "8080"}
+	var out struct {
+		NewExternalIPAddress string
+	}
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if err := client.PerformAction("urn:schemas-upnp-org:service:WANIPConnection:1", "GetExternalIPAddress", &in, &out); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
+
+func TestRequestBodyCloseWhileRead(t *testing.T) {
+	body := newRequestBody()
+	body.buf.WriteString(strings.Repeat("x", 1<<16))
+	body.r.Reset(body.buf.Bytes())
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		buf := make([]byte, 16)
+		for {
+			if _, err := body.Read(buf); err != nil {
+				return
+			}
+		}
+	}()
+	body.Close()
+	<-done
+	if n, err := body.Read(make([]byte, 1)); n != 0 || err == nil {
+		t.Errorf("Read() after Close = %d, %v, want EOF", n, err)
+	}
+}
@@ -0,0 +1,699 @@
+package ssdp
+
+import (
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"net"
+	"net/http"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/ipv4"
+	"golang.org/x/net/ipv6"
+
+	"github.com/huin/goupnp/clock"
+	"github.com/huin/goupnp/httpu"
+	"github.com/huin/goupnp/internal/logging"
+	"github.com/huin/goupnp/internal/netif"
+)
+
+const (
+	// DefaultMaxAge is the CACHE-CONTROL max-age advertised if none is given.
+	DefaultMaxAge = 1800
+
+	ssdpAll = "ssdp:all"
+
+	// Maximum MX value to honour, as per the UPnP 1.1 specification.
+	maxMXSeconds = 5
+)
+
+// DefaultServerHeader is the SERVER header value used by an Advertiser if
+// none is given.
+var DefaultServerHeader = runtime.GOOS + "/1.0 UPnP/1.1 goupnp/1.0"
+
+// Advertisement is a single NT/USN pair that is advertised by an Advertiser.
+// A root device has several of these: one for "upnp:rootdevice", and one each
+// for the UUID and type of every device, and the type of every service. See
+// section 1.1.2 "SSDP message header fields" in
+// http://upnp.org/specs/arch/UPnP-arch-DeviceArchitecture-v1.1.pdf
+type Advertisement struct {
+	// Notification type, e.g "upnp:rootdevice" or
+	// "urn:schemas-upnp-org:service:WANIPConnection:1".
+	NT string
+	// Unique service name, e.g
+	// "uuid:...::urn:schemas-upnp-org:service:WANIPConnection:1".
+	USN string
+	// DescriptionPath is the path to the root device description, relative to
+	// the base URL returned by Advertiser.Location.
+	DescriptionPath string
+	// ConfigID is the CONFIGID.UPNP.ORG of the root device.
+	ConfigID int32
+}
+
+var _ httpu.Handler = new(Advertiser)
+
+// Advertiser announces devices and services via SSDP NOTIFY messages, and
+// responds to M-SEARCH requests for them.
+//
+// NOTE: the interface for this is experimental and may change, or go away
+// entirely.
+type Advertiser struct {
+	// Location returns the base URL (scheme, host and port) of the HTTP server
+	// hosting the device descriptions, as reachable via the given local IP
+	// address.
+	Location func(localIP net.IP) string
+	// Server is the SERVER header value, DefaultServerHeader if empty.
+	Server string
+	// MaxAge is the CACHE-CONTROL max-age in seconds, DefaultMaxAge if 0.
+	MaxAge int
+	// BootID is the BOOTID.UPNP.ORG value. It is incremented by Start, so
+	// should be set to the value last used, which devices should persist
+	// across restarts.
+	BootID int32
+	// Interfaces restricts the network interfaces on which advertisements are
+	// sent and searches are answered. All multicast capable interfaces are
+	// used if empty.
+	Interfaces []net.Interface
+	// IPv6 enables advertising over IPv6 as well as IPv4, via the link-local
+	// scope multicast address [FF02::C]:1900. The localIP passed to Location
+	// is then an IPv6 link-local address, without a zone.
+	IPv6 bool
+	// Logger logs the failures to send advertisements and respond to
+	// searches, the goupnp.SetLogger logger if nil.
+	Logger *slog.Logger
+	// Clock schedules the re-announcements and delayed search responses, and
+	// dates the responses, clock.Real if nil.
+	Clock clock.Clock
+	// Conns, if not empty, are pre-opened UDP sockets bound to port 1900,
+	// such as those passed by systemd socket activation, on which M-SEARCH
+	// requests are received instead of on sockets opened by Start, so that
+	// the advertiser can run unprivileged. Start joins them to the multicast
+	// group of their address family on the usable interfaces, if they are
+	// not already. They are closed by Close.
+	Conns []net.PacketConn
+
+	adsLock sync.RWMutex
+	ads     []Advertisement
+
+	lock    sync.Mutex // Protects conns and stop.
+	conns   []net.PacketConn
+	stop    chan struct{}
+	stopped sync.WaitGroup
+
+	// The timers of the delayed search responses, nil unless started.
+	responsesLock sync.Mutex // Protects responses.
+	responses     map[*clock.Timer]bool
+	responding    sync.WaitGroup
+}
+
+func (a *Advertiser) logger() *slog.Logger {
+	return logging.Or(a.Logger)
+}
+
+// family is an IP address family over which SSDP messages are sent.
+type family struct {
+	network string // "udp4" or "udp6".
+	group   string // Multicast group address, and HOST header value.
+	// localIP returns the address of an interface to advertise within
+	// LOCATION, or nil if the interface has no usable address.
+	localIP func(ifc *net.Interface) net.IP
+	// join joins conn to the multicast group on the interface.
+	join func(conn net.PacketConn, ifc *net.Interface, group net.Addr) error
+	// setInterface sets the interface for outgoing multicast on conn.
+	setInterface func(conn net.PacketConn, ifc *net.Interface) error
+}
+
+var (
+	familyIPv4 = &family{
+		network: "udp4",
+		group:   ssdpUDP4Addr,
+		localIP: interfaceIPv4,
+		join: func(conn net.PacketConn, ifc *net.Interface, group net.Addr) error {
+			return ipv4.NewPacketConn(conn).JoinGroup(ifc, group)
+		},
+		setInterface: func(conn net.PacketConn, ifc *net.Interface) error {
+			return ipv4.NewPacketConn(conn).SetMulticastInterface(ifc)
+		},
+	}
+	familyIPv6 = &family{
+		network: "udp6",
+		group:   ssdpUDP6Addr,
+		localIP: interfaceIPv6LinkLocal,
+		join: func(conn net.PacketConn, ifc *net.Interface, group net.Addr) error {
+			return ipv6.NewPacketConn(conn).JoinGroup(ifc, group)
+		},
+		setInterface: func(conn net.PacketConn, ifc *net.Interface) error {
+			return ipv6.NewPacketConn(conn).SetMulticastInterface(ifc)
+		},
+	}
+)
+
+func (a *Advertiser) families() []*family {
+	if a.IPv6 {
+		return []*family{familyIPv4, familyIPv6}
+	}
+	return []*family{familyIPv4}
+}
+
+// interfaces returns the interfaces to use which are up, multicast capable,
+// and have a usable address of the family.
+func (a *Advertiser) interfaces(f *family) ([]net.Interface, error) {
+	ifs := a.Interfaces
+	if len(ifs) == 0 {
+		var err error
+		if ifs, err = netif.List(); err != nil {
+			return nil, err
+		}
+	}
+	var usable []net.Interface
+	for i := range ifs {
+		ifc := &ifs[i]
+		if ifc.Flags&net.FlagMulticast == 0 || ifc.Flags&net.FlagUp == 0 || f.localIP(ifc) == nil {
+			continue
+		}
+		usable = append(usable, *ifc)
+	}
+	return usable, nil
+}
+
+// listen listens for M-SEARCH requests to the multicast group of the family,
+// on all of its usable interfaces. It returns a nil conn if there are none.
+func (a *Advertiser) listen(f *family) (net.PacketConn, error) {
+	ifs, err := a.interfaces(f)
+	if err != nil || len(ifs) == 0 {
+		return nil, err
+	}
+	group, err := net.ResolveUDPAddr(f.network, f.group)
+	if err != nil {
+		return nil, err
+	}
+	conn, err := net.ListenMulticastUDP(f.network, &ifs[0], group)
+	if err != nil {
+		return nil, err
+	}
+	for i := range ifs[1:] {
+		ifc := &ifs[i+1]
+		if err := f.join(conn, ifc, group); err != nil {
+			a.logger().Warn("ssdp: error joining multicast group", slog.String("group", f.group),
+				slog.String(logging.KeyInterface, ifc.Name), logging.Err(err))
+		}
+	}
+	return conn, nil
+}
+
+// adopt joins each of the pre-opened conns to the multicast group of its
+// address family, on all of the usable interfaces. Failures are logged, as
+// the sockets may already have been joined by whoever opened them.
+func (a *Advertiser) adopt(conns []net.PacketConn) []net.PacketConn {
+	for _, conn := range conns {
+		f := familyIPv4
+		if addr, ok := conn.LocalAddr().(*net.UDPAddr); ok && addr.IP.To4() == nil && !addr.IP.IsUnspecified() {
+			f = familyIPv6
+		}
+		group, err := net.ResolveUDPAddr(f.network, f.group)
+		if err != nil {
+			continue
+		}
+		ifs, err := a.interfaces(f)
+		if err != nil {
+			a.logger().Warn("ssdp: error listing interfaces", logging.Err(err))
+			continue
+		}
+		for i := range ifs {
+			if err := f.join(conn, &ifs[i], group); err != nil {
+				a.logger().Debug("ssdp: error joining multicast group", slog.String("group", f.group),
+					slog.String(logging.KeyInterface, ifs[i].Name), logging.Err(err))
+			}
+		}
+	}
+	return append([]net.PacketConn(nil), conns...)
+}
+
+// SetAdvertisements replaces the set of advertisements.
+func (a *Advertiser) SetAdvertisements(ads []Advertisement) {
+	a.adsLock.Lock()
+	defer a.adsLock.Unlock()
+	a.ads = append([]Advertisement(nil), ads...)
+}
+
+// AddAdvertisements adds to the set of advertisements, and announces the new
+// advertisements if the advertiser has been started.
+func (a *Advertiser) AddAdvertisements(ads []Advertisement) error {
+	a.adsLock.Lock()
+	a.ads = append(a.ads, ads...)
+	a.adsLock.Unlock()
+	if !a.started() {
+		return nil
+	}
+	return a.notifyAds(ntsAlive, ads)
+}
+
+// RemoveAdvertisements removes the advertisements with the given USNs from
+// the set, and sends ssdp:byebye messages for them if the advertiser has been
+// started.
+func (a *Advertiser) RemoveAdvertisements(usns []string) error {
+	remove := make(map[string]bool, len(usns))
+	for _, usn := range usns {
+		remove[usn] = true
+	}
+	var removed []Advertisement
+	a.adsLock.Lock()
+	kept := a.ads[:0]
+	for _, ad := range a.ads {
+		if remove[ad.USN] {
+			removed = append(removed, ad)
+		} else {
+			kept = append(kept, ad)
+		}
+	}
+	a.ads = kept
+	a.adsLock.Unlock()
+	if !a.started() {
+		return nil
+	}
+	return a.notifyAds(ntsByebye, removed)
+}
+
+// ReplaceAdvertisements replaces the advertisements with the given USNs by
+// ads, as when the description of a device changes. If the advertiser has
+// been started, ssdp:byebye messages are sent for the removed USNs that are
+// not in ads, and ssdp:alive messages for all of ads, so that control points
+// see any new CONFIGID.UPNP.ORG.
+func (a *Advertiser) ReplaceAdvertisements(usns []string, ads []Advertisement) error {
+	replace := make(map[string]bool, len(usns))
+	for _, usn := range usns {
+		replace[usn] = true
+	}
+	current := make(map[string]bool, len(ads))
+	for _, ad := range ads {
+		current[ad.USN] = true
+	}
+	var removed []Advertisement
+	a.adsLock.Lock()
+	kept := a.ads[:0]
+	for _, ad := range a.ads {
+		if !replace[ad.USN] {
+			kept = append(kept, ad)
+		} else if !current[ad.USN] {
+			removed = append(removed, ad)
+		}
+	}
+	a.ads = append(kept, ads...)
+	a.adsLock.Unlock()
+	if !a.started() {
+		return nil
+	}
+	err := a.notifyAds(ntsByebye, removed)
+	if aliveErr := a.notifyAds(ntsAlive, ads); aliveErr != nil {
+		err = aliveErr
+	}
+	return err
+}
+
+func (a *Advertiser) started() bool {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	return a.conns != nil
+}
+
+// Advertisements returns a copy of the current set of advertisements.
+func (a *Advertiser) Advertisements() []Advertisement {
+	a.adsLock.RLock()
+	defer a.adsLock.RUnlock()
+	return append([]Advertisement(nil), a.ads...)
+}
+
+func (a *Advertiser) maxAge() int {
+	if a.MaxAge > 0 {
+		return a.MaxAge
+	}
+	return DefaultMaxAge
+}
+
+func (a *Advertiser) server() string {
+	if a.Server != "" {
+		return a.Server
+	}
+	return DefaultServerHeader
+}
+
+// Start begins listening for M-SEARCH requests, announces the advertisements,
+// and then re-announces them periodically until Close is called. BootID is
+// incremented, and ssdp:byebye messages are sent before the ssdp:alive
+// messages, so that control points discard anything cached from a previous
+// run of the device. An address family is skipped if no interface has an
+// address of that family, as is the case for IPv4 on IPv6-only hosts.
+func (a *Advertiser) Start() error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if a.conns != nil {
+		return fmt.Errorf("ssdp: advertiser already started")
+	}
+	if len(a.Conns) > 0 {
+		return a.start(a.adopt(a.Conns))
+	}
+	var conns []net.PacketConn
+	for _, f := range a.families() {
+		conn, err := a.listen(f)
+		if err != nil {
+			for _, c := range conns {
+				c.Close()
+			}
+			return err
+		}
+		if conn != nil {
+			conns = append(conns, conn)
+		}
+	}
+	if len(conns) == 0 {
+		return fmt.Errorf("ssdp: no usable multicast interfaces")
+	}
+	return a.start(conns)
+}
+
+// start serves M-SEARCH requests on conns, and starts announcing. a.lock must
+// be held.
+func (a *Advertiser) start(conns []net.PacketConn) error {
+	a.conns = conns
+	a.stop = make(chan struct{})
+	a.responsesLock.Lock()
+	a.responses = make(map[*clock.Timer]bool)
+	a.responsesLock.Unlock()
+
+	a.BootID++
+
+	for _, conn := range conns {
+		go httpu.Serve(conn, a)
+	}
+
+	if err := a.ByeBye(); err != nil {
+		a.logger().Warn("ssdp: error sending byebye notifications", logging.Err(err))
+	}
+	if err := a.Alive(); err != nil {
+		a.logger().Warn("ssdp: error sending alive notifications", logging.Err(err))
+	}
+	a.stopped.Add(1)
+	go a.notifyLoop(a.stop)
+	return nil
+}
+
+// Close sends ssdp:byebye messages for all advertisements, and stops the
+// advertiser.
+func (a *Advertiser) Close() error {
+	a.lock.Lock()
+	defer a.lock.Unlock()
+	if a.conns == nil {
+		return nil
+	}
+	close(a.stop)
+	a.stopped.Wait()
+	// Pending search responses are not sent after Close returns.
+	a.responsesLock.Lock()
+	for timer := range a.responses {
+		(*timer).Stop()
+	}
+	a.responses = nil
+	a.responsesLock.Unlock()
+	a.responding.Wait()
+	if err := a.ByeBye(); err != nil {
+		a.logger().Warn("ssdp: error sending byebye notifications", logging.Err(err))
+	}
+	var err error
+	for _, conn := range a.conns {
+		if closeErr := conn.Close(); closeErr != nil {
+			err = closeErr
+		}
+	}
+	a.conns = nil
+	return err
+}
+
+func (a *Advertiser) notifyLoop(stop <-chan struct{}) {
+	defer a.stopped.Done()
+	// Re-announce well within the max-age, with some jitter to avoid
+	// synchronising with other devices.
+	interval := time.Duration(a.maxAge()) * time.Second / 3
+	for {
+		jitter := time.Duration(rand.Int63n(int64(interval / 10)))
+		timer := clock.Or(a.Clock).NewTimer(interval - jitter)
+		select {
+		case <-stop:
+			timer.Stop()
+			return
+		case <-timer.C():
+		}
+		if err := a.Alive(); err != nil {
+			a.logger().Warn("ssdp: error sending alive notifications", logging.Err(err))
+		}
+	}
+}
+
+// Alive multicasts ssdp:alive NOTIFY messages for all advertisements.
+func (a *Advertiser) Alive() error {
+	return a.notify(ntsAlive)
+}
+
+// ByeBye multicasts ssdp:byebye NOTIFY messages for all advertisements.
+func (a *Advertiser) ByeBye() error {
+	return a.notify(ntsByebye)
+}
+
+// notify sends NOTIFY messages for all advertisements.
+func (a *Advertiser) notify(nts string) error {
+	return a.notifyAds(nts, a.Advertisements())
+}
+
+// notifyAds sends a NOTIFY message for each of ads on every usable interface
+// of every address family, with the LOCATION for that interface.
+func (a *Advertiser) notifyAds(nts string, ads []Advertisement) error {
+	if len(ads) == 0 {
+		return nil
+	}
+	var lastErr error
+	for _, f := range a.families() {
+		if err := a.notifyFamily(f, nts, ads); err != nil {
+			lastErr = err
+		}
+	}
+	return lastErr
+}
+
+func (a *Advertiser) notifyFamily(f *family, nts string, ads []Advertisement) error {
+	ifs, err := a.interfaces(f)
+	if err != nil || len(ifs) == 0 {
+		return err
+	}
+	conn, err := net.ListenPacket(f.network, ":0")
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	destAddr, err := net.ResolveUDPAddr(f.network, f.group)
+	if err != nil {
+		return err
+	}
+
+	var lastErr error
+	for i := range ifs {
+		ifc := &ifs[i]
+		ip := f.localIP(ifc)
+		if err := f.setInterface(conn, ifc); err != nil {
+			lastErr = err
+			continue
+		}
+		for _, ad := range ads {
+			msg, err := a.notifyMessage(nts, &ad, f.group, ip)
+			if err != nil {
+				lastErr = err
+				continue
+			}
+			if _, err := conn.WriteTo(msg, destAddr); err != nil {
+				lastErr = err
+			}
+		}
+	}
+	return lastErr
+}
+
+func interfaceIPv4(ifc *net.Interface) net.IP {
+	addrs, err := netif.Addrs(ifc)
+	if err != nil {
+		return nil
+	}
+	for _, addr := range addrs {
+		if ipNet, ok := addr.(*net.IPNet); ok {
+			if ip4 := ipNet.IP.To4(); ip4 != nil {
+				return ip4
+			}
+		}
+	}
+	return nil
+}
+
+// interfaceIPv6LinkLocal returns the link-local IPv6 address of the
+// interface, which UPnP requires for SSDP over link-local multicast.
+func interfaceIPv6LinkLocal(ifc *net.Interface) net.IP {
+	addrs, err := netif.Addrs(ifc)
+	if err != nil {
+		return nil
+	}
+	for _, addr := range addrs {
+		if ipNet, ok := addr.(*net.IPNet); ok {
+			if ipNet.IP.To4() == nil && ipNet.IP.IsLinkLocalUnicast() {
+				return ipNet.IP
+			}
+		}
+	}
+	return nil
+}
+
+func (a *Advertiser) notifyMessage(nts string, ad *Advertisement, host string, localIP net.IP) ([]byte, error) {
+	m := &Notify{
+		NTS:      nts,
+		Host:     host,
+		NT:       ad.NT,
+		USN:      ad.USN,
+		BootID:   a.BootID,
+		ConfigID: ad.ConfigID,
+	}
+	if nts != ntsByebye {
+		m.MaxAge = a.maxAge()
+		m.Location = a.Location(localIP) + ad.DescriptionPath
+		m.Server = a.server()
+	}
+	return m.Marshal()
+}
+
+func (a *Advertiser) searchResponse(st string, ad *Advertisement, localIP net.IP) ([]byte, error) {
+	m := &SearchResponse{
+		MaxAge:   a.maxAge(),
+		Date:     clock.Or(a.Clock).Now(),
+		Location: a.Location(localIP) + ad.DescriptionPath,
+		Server:   a.server(),
+		ST:       st,
+		USN:      ad.USN,
+		BootID:   a.BootID,
+		ConfigID: ad.ConfigID,
+	}
+	return m.Marshal()
+}
+
+// ServeMessage implements httpu.Handler, and responds to M-SEARCH requests
+// that match any of the advertisements.
+func (a *Advertiser) ServeMessage(r *http.Request) {
+	if r.Method != methodSearch || r.Header.Get("MAN") != ssdpDiscover {
+		return
+	}
+	st := r.Header.Get("ST")
+	var matches []Advertisement
+	for _, ad := range a.Advertisements() {
+		if matchesSearchTarget(st, ad.NT) {
+			matches = append(matches, ad)
+		}
+	}
+	if len(matches) == 0 {
+		return
+	}
+
+	remoteAddr, err := net.ResolveUDPAddr("udp", r.RemoteAddr)
+	if err != nil {
+		a.logger().Warn("ssdp: bad M-SEARCH remote address", slog.String(logging.KeyRemote, r.RemoteAddr), logging.Err(err))
+		return
+	}
+
+	// Responses are delayed by a random duration up to MX seconds to avoid
+	// flooding the searcher, unless MX is absent as it is for unicast
+	// searches.
+	var delay time.Duration
+	if mxStr := r.Header.Get("MX"); mxStr != "" {
+		mx, err := strconv.Atoi(mxStr)
+		if err != nil || mx < 1 {
+			logging.ReportMalformed(a.Logger, "ssdp", "ssdp: bad MX in M-SEARCH", r.RemoteAddr, nil, slog.String("mx", mxStr))
+			return
+		}
+		if mx > maxMXSeconds {
+			mx = maxMXSeconds
+		}
+		delay = time.Duration(rand.Int63n(int64(mx) * int64(time.Second)))
+	}
+
+	a.responsesLock.Lock()
+	defer a.responsesLock.Unlock()
+	if a.responses == nil {
+		// Closed.
+		return
+	}
+	timer := new(clock.Timer)
+	*timer = clock.Or(a.Clock).AfterFunc(delay, func() {
+		a.responsesLock.Lock()
+		pending := a.responses[timer]
+		delete(a.responses, timer)
+		if pending {
+			a.responding.Add(1)
+		}
+		a.responsesLock.Unlock()
+		if !pending {
+			return
+		}
+		defer a.responding.Done()
+		if err := a.respond(remoteAddr, st, matches); err != nil {
+			a.logger().Warn("ssdp: error responding to M-SEARCH", slog.String(logging.KeyRemote, remoteAddr.String()), logging.Err(err))
+		}
+	})
+	a.responses[timer] = true
+}
+
+func (a *Advertiser) respond(remoteAddr *net.UDPAddr, st string, matches []Advertisement) error {
+	// Connecting the socket selects the local address that routes to the
+	// searcher, which determines the LOCATION to respond with. The zone of a
+	// link-local remote address selects the interface.
+	conn, err := net.DialUDP("udp", nil, remoteAddr)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+	localIP := conn.LocalAddr().(*net.UDPAddr).IP
+	for _, ad := range matches {
+		respST := st
+		if st == ssdpAll {
+			respST = ad.NT
+		}
+		msg, err := a.searchResponse(respST, &ad, localIP)
+		if err != nil {
+			return err
+		}
+		if _, err := conn.Write(msg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// matchesSearchTarget returns true if an advertisement with the given NT
+// should respond to a search for st. Device and service types match if the
+// advertised version is at least the version searched for.
+func matchesSearchTarget(st, nt string) bool {
+	if st == ssdpAll || st == nt {
+		return true
+	}
+	if !strings.HasPrefix(st, "urn:") {
+		return false
+	}
+	stIndex, ntIndex := strings.LastIndex(st, ":"), strings.LastIndex(nt, ":")
+	if stIndex < 0 || ntIndex < 0 || st[:stIndex] != nt[:ntIndex] {
+		return false
+	}
+	stVersion, err := strconv.Atoi(st[stIndex+1:])
+	if err != nil {
+		return false
+	}
+	ntVersion, err := strconv.Atoi(nt[ntIndex+1:])
+	if err != nil {
+		return false
+	}
+	return ntVersion >= stVersion
+}
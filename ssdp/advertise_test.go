@@ -0,0 +1,154 @@
+package ssdp
+
+import (
+	"bufio"
+	"bytes"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/huin/goupnp/clock"
+	"github.com/huin/goupnp/internal/netif"
+)
+
+// loopbackMulticast lists the loopback interface as multicast capable, so
+// that advertisements can be received without a network, and returns it.
+func loopbackMulticast(t *testing.T) *net.Interface {
+	lo, err := net.InterfaceByName("lo")
+	if err != nil {
+		t.Skipf("no loopback interface: %v", err)
+	}
+	lo.Flags |= net.FlagMulticast | net.FlagUp
+	netif.Set(func() ([]net.Interface, error) {
+		return []net.Interface{*lo}, nil
+	}, func(*net.Interface) ([]net.Addr, error) {
+		return []net.Addr{&net.IPNet{IP: net.IPv4(127, 0, 0, 1).To4(), Mask: net.CIDRMask(8, 32)}}, nil
+	})
+	return lo
+}
+
+// readNotify reads the next NOTIFY message from conn with the USN.
+func readNotify(t *testing.T, conn net.PacketConn, usn string) *http.Request {
+	buf := make([]byte, 2048)
+	for {
+		conn.SetReadDeadline(time.Now().Add(5 * time.Second))
+		n, _, err := conn.ReadFrom(buf)
+		if err != nil {
+			t.Fatalf("reading NOTIFY: %v", err)
+		}
+		req, err := http.ReadRequest(bufio.NewReader(bytes.NewReader(buf[:n])))
+		if err != nil || req.Method != "NOTIFY" || req.Header.Get("USN") != usn {
+			// Messages of other tests on the host.
+			continue
+		}
+		return req
+	}
+}
+
+func TestAdvertiserStart(t *testing.T) {
+	lo := loopbackMulticast(t)
+	defer netif.Set(nil, nil)
+	group, err := net.ResolveUDPAddr("udp4", ssdpUDP4Addr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	recv, err := net.ListenMulticastUDP("udp4", lo, group)
+	if err != nil {
+		t.Skipf("cannot join multicast group on loopback: %v", err)
+	}
+	defer recv.Close()
+	conn, err := net.ListenPacket("udp4", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const usn = testUDN + "::upnp:rootdevice"
+	a := &Advertiser{
+		Location: func(localIP net.IP) string { return "http://" + localIP.String() + ":49152" },
+		Server:   testServer,
+		BootID:   7,
+		Clock:    clock.NewFake(time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC)),
+		Conns:    []net.PacketConn{conn},
+	}
+	a.SetAdvertisements([]Advertisement{{NT: "upnp:rootdevice", USN: usn, DescriptionPath: "/desc.xml", ConfigID: 3}})
+	if err := a.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer a.Close()
+	if a.BootID != 8 {
+		t.Errorf("BootID = %d after Start, want 8", a.BootID)
+	}
+
+	for _, want := range []string{ntsByebye, ntsAlive} {
+		req := readNotify(t, recv, usn)
+		if nts := req.Header.Get("NTS"); nts != want {
+			t.Fatalf("got NTS %q, want %q", nts, want)
+		}
+		if bootID := req.Header.Get("BOOTID.UPNP.ORG"); bootID != "8" {
+			t.Errorf("%s: got BOOTID.UPNP.ORG %q, want 8", want, bootID)
+		}
+		if want == ntsAlive {
+			if loc := req.Header.Get("LOCATION"); loc != "http://127.0.0.1:49152/desc.xml" {
+				t.Errorf("got LOCATION %q", loc)
+			}
+		}
+	}
+
+	if err := a.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if nts := readNotify(t, recv, usn).Header.Get("NTS"); nts != ntsByebye {
+		t.Errorf("got NTS %q on Close, want %q", nts, ntsByebye)
+	}
+}
+
+func TestAdvertiserCloseDelayedResponses(t *testing.T) {
+	loopbackMulticast(t)
+	defer netif.Set(nil, nil)
+	conn, err := net.ListenPacket("udp4", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	searcher, err := net.ListenPacket("udp4", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer searcher.Close()
+
+	fc := clock.NewFake(time.Date(2026, 10, 14, 0, 0, 0, 0, time.UTC))
+	a := &Advertiser{
+		Location: func(localIP net.IP) string { return "http://" + localIP.String() + ":49152" },
+		Clock:    fc,
+		Conns:    []net.PacketConn{conn},
+	}
+	a.SetAdvertisements([]Advertisement{{NT: "upnp:rootdevice", USN: testUDN + "::upnp:rootdevice", DescriptionPath: "/desc.xml"}})
+	if err := a.Start(); err != nil {
+		t.Fatal(err)
+	}
+	search := func() {
+		a.ServeMessage(&http.Request{
+			Method:     methodSearch,
+			Header:     http.Header{"Man": {ssdpDiscover}, "St": {"upnp:rootdevice"}, "Mx": {"1"}},
+			RemoteAddr: searcher.LocalAddr().String(),
+		})
+	}
+	buf := make([]byte, 2048)
+
+	search()
+	fc.Advance(time.Second)
+	searcher.SetReadDeadline(time.Now().Add(5 * time.Second))
+	if _, _, err := searcher.ReadFrom(buf); err != nil {
+		t.Fatalf("reading the search response: %v", err)
+	}
+
+	search()
+	if err := a.Close(); err != nil {
+		t.Fatal(err)
+	}
+	fc.Advance(time.Second)
+	searcher.SetReadDeadline(time.Now().Add(100 * time.Millisecond))
+	if n, _, err := searcher.ReadFrom(buf); err == nil {
+		t.Errorf("got search response %q after Close", buf[:n])
+	}
+}
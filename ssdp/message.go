@@ -0,0 +1,486 @@
+package ssdp
+
+import (
+	"bytes"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/huin/goupnp/httpu"
+	"github.com/huin/goupnp/upnperr"
+)
+
+// The NTS values of NOTIFY messages.
+const (
+	NTSAlive  = ntsAlive
+	NTSByebye = ntsByebye
+	NTSUpdate = ntsUpdate
+)
+
+// maxConfigID is the largest CONFIGID.UPNP.ORG allowed by UPnP 1.1.
+const maxConfigID = 1<<24 - 1
+
+// ValidationError lists the violations of UPnP 1.1 of an SSDP message, of
+// its mandatory headers and their value formats. It is upnperr.ErrMalformed.
+type ValidationError struct {
+	// Message is the kind of message, e.g. "M-SEARCH" or "ssdp:alive".
+	Message    string
+	Violations []string
+}
+
+func (err *ValidationError) Error() string {
+	return "ssdp: invalid " + err.Message + ": " + strings.Join(err.Violations, "; ")
+}
+
+// Is makes ValidationErrors upnperr.ErrMalformed.
+func (err *ValidationError) Is(target error) bool {
+	return target == upnperr.ErrMalformed
+}
+
+// Search is an M-SEARCH request. Its Marshal and Request methods build it
+// once it is validated.
+type Search struct {
+	// Host is the address that the search is sent to, SearchAddr or
+	// SearchAddr6 for multicast searches.
+	Host string
+	// MX is the number of seconds that devices may wait before responding,
+	// from 1 to 5, for multicast searches. It is optional, if not 0, for
+	// unicast searches, which are responded to at once.
+	MX int
+	// ST is the search target, e.g. "ssdp:all", "upnp:rootdevice" or
+	// "urn:schemas-upnp-org:device:InternetGatewayDevice:1".
+	ST string
+	// UserAgent is the optional USER-AGENT header, of the same form as
+	// SERVER.
+	UserAgent string
+}
+
+func (m *Search) header() http.Header {
+	// The header keys are set directly, rather than with Set, to keep them
+	// upper case, as some devices require.
+	h := http.Header{
+		"HOST": {m.Host},
+		"MAN":  {ssdpDiscover},
+		"ST":   {m.ST},
+	}
+	if m.MX != 0 {
+		h["MX"] = []string{strconv.Itoa(m.MX)}
+	}
+	if m.UserAgent != "" {
+		h["USER-AGENT"] = []string{m.UserAgent}
+	}
+	return h
+}
+
+// Request returns the request of m, for httpu.HTTPUClient.Do.
+func (m *Search) Request() (*http.Request, error) {
+	h := m.header()
+	if v := validateSearch(h, isMulticast(m.Host)); len(v) > 0 {
+		return nil, &ValidationError{methodSearch, v}
+	}
+	return &http.Request{
+		Method: methodSearch,
+		Host:   m.Host,
+		URL:    &url.URL{Opaque: "*"},
+		Header: h,
+	}, nil
+}
+
+// Marshal returns the message of m.
+func (m *Search) Marshal() ([]byte, error) {
+	req, err := m.Request()
+	if err != nil {
+		return nil, err
+	}
+	return marshalRequest(req), nil
+}
+
+// Notify is a NOTIFY message: an ssdp:alive, ssdp:byebye or ssdp:update of
+// an advertisement. Its Marshal method builds it once it is validated.
+type Notify struct {
+	// NTS is NTSAlive, NTSByebye or NTSUpdate.
+	NTS string
+	// Host is the multicast group that the message is sent to.
+	Host string
+	NT   string
+	USN  string
+	// Location is the URL of the root device description. It is not sent
+	// in ssdp:byebye messages.
+	Location string
+	// Server is the SERVER header, of the form "OS/version UPnP/1.1
+	// product/version". It is only sent in ssdp:alive messages.
+	Server string
+	// MaxAge is the CACHE-CONTROL max-age in seconds of ssdp:alive messages.
+	MaxAge     int
+	BootID     int32
+	ConfigID   int32
+	NextBootID int32 // Only sent in ssdp:update messages.
+	// SearchPort is the optional SEARCHPORT.UPNP.ORG, the port other than
+	// 1900 that the device responds to unicast searches on, if not 0.
+	SearchPort int
+}
+
+// Marshal returns the message of m.
+func (m *Notify) Marshal() ([]byte, error) {
+	h := http.Header{
+		"HOST":              {m.Host},
+		"NT":                {m.NT},
+		"NTS":               {m.NTS},
+		"USN":               {m.USN},
+		"BOOTID.UPNP.ORG":   {strconv.FormatInt(int64(m.BootID), 10)},
+		"CONFIGID.UPNP.ORG": {strconv.FormatInt(int64(m.ConfigID), 10)},
+	}
+	switch m.NTS {
+	case ntsAlive:
+		h["CACHE-CONTROL"] = []string{"max-age=" + strconv.Itoa(m.MaxAge)}
+		h["LOCATION"] = []string{m.Location}
+		h["SERVER"] = []string{m.Server}
+	case ntsUpdate:
+		h["LOCATION"] = []string{m.Location}
+		h["NEXTBOOTID.UPNP.ORG"] = []string{strconv.FormatInt(int64(m.NextBootID), 10)}
+	}
+	if m.SearchPort != 0 && m.NTS != ntsByebye {
+		h["SEARCHPORT.UPNP.ORG"] = []string{strconv.Itoa(m.SearchPort)}
+	}
+	if v := validateNotify(h); len(v) > 0 {
+		return nil, &ValidationError{notifyKind(m.NTS), v}
+	}
+	return marshalRequest(&http.Request{
+		Method: methodNotify,
+		Host:   m.Host,
+		URL:    &url.URL{Opaque: "*"},
+		Header: h,
+	}), nil
+}
+
+// SearchResponse is a response to an M-SEARCH request. Its Marshal method
+// builds it once it is validated.
+type SearchResponse struct {
+	// MaxAge is the CACHE-CONTROL max-age in seconds.
+	MaxAge int
+	// Date is the optional DATE header, if not zero.
+	Date     time.Time
+	Location string
+	// Server is as that of Notify.
+	Server   string
+	ST       string
+	USN      string
+	BootID   int32
+	ConfigID int32
+	// SearchPort is as that of Notify.
+	SearchPort int
+}
+
+// Marshal returns the message of m.
+func (m *SearchResponse) Marshal() ([]byte, error) {
+	h := http.Header{
+		"CACHE-CONTROL":     {"max-age=" + strconv.Itoa(m.MaxAge)},
+		"EXT":               {""},
+		"LOCATION":          {m.Location},
+		"SERVER":            {m.Server},
+		"ST":                {m.ST},
+		"USN":               {m.USN},
+		"BOOTID.UPNP.ORG":   {strconv.FormatInt(int64(m.BootID), 10)},
+		"CONFIGID.UPNP.ORG": {strconv.FormatInt(int64(m.ConfigID), 10)},
+	}
+	if !m.Date.IsZero() {
+		h["DATE"] = []string{m.Date.UTC().Format(http.TimeFormat)}
+	}
+	if m.SearchPort != 0 {
+		h["SEARCHPORT.UPNP.ORG"] = []string{strconv.Itoa(m.SearchPort)}
+	}
+	if v := validateSearchResponse(h); len(v) > 0 {
+		return nil, &ValidationError{"search response", v}
+	}
+	var buf bytes.Buffer
+	buf.WriteString("HTTP/1.1 200 OK\r\n")
+	h.Write(&buf)
+	buf.WriteString("\r\n")
+	return buf.Bytes(), nil
+}
+
+func marshalRequest(req *http.Request) []byte {
+	var buf bytes.Buffer
+	// Writing to a bytes.Buffer does not fail.
+	httpu.WriteRequest(&buf, req)
+	return buf.Bytes()
+}
+
+// ValidateSearch checks that r is an M-SEARCH request complying with UPnP
+// 1.1, returning a *ValidationError listing its violations if not. MX is
+// only required of searches sent to a multicast HOST.
+func ValidateSearch(r *http.Request) error {
+	var v []string
+	if r.Method != methodSearch {
+		v = append(v, "method is "+r.Method)
+	}
+	if r.RequestURI != "" && r.RequestURI != "*" {
+		v = append(v, "request target is "+r.RequestURI+", not *")
+	}
+	h := requestHeader(r)
+	host, _ := headerValue(h, "HOST")
+	v = append(v, validateSearch(h, isMulticast(host))...)
+	if len(v) > 0 {
+		return &ValidationError{methodSearch, v}
+	}
+	return nil
+}
+
+// ValidateNotify checks that r is a NOTIFY message complying with UPnP 1.1,
+// with the mandatory headers of its NTS, returning a *ValidationError
+// listing its violations if not.
+func ValidateNotify(r *http.Request) error {
+	var v []string
+	if r.Method != methodNotify {
+		v = append(v, "method is "+r.Method)
+	}
+	if r.RequestURI != "" && r.RequestURI != "*" {
+		v = append(v, "request target is "+r.RequestURI+", not *")
+	}
+	v = append(v, validateNotify(requestHeader(r))...)
+	if len(v) > 0 {
+		nts, _ := headerValue(r.Header, "NTS")
+		return &ValidationError{notifyKind(nts), v}
+	}
+	return nil
+}
+
+// ValidateSearchResponse checks that resp is a response to an M-SEARCH
+// request complying with UPnP 1.1, returning a *ValidationError listing its
+// violations if not.
+func ValidateSearchResponse(resp *http.Response) error {
+	var v []string
+	if resp.StatusCode != http.StatusOK {
+		v = append(v, "status is "+resp.Status)
+	}
+	v = append(v, validateSearchResponse(resp.Header)...)
+	if len(v) > 0 {
+		return &ValidationError{"search response", v}
+	}
+	return nil
+}
+
+// requestHeader returns the header of r, with the HOST that http.ReadRequest
+// moves to its Host.
+func requestHeader(r *http.Request) http.Header {
+	if _, ok := headerValue(r.Header, "HOST"); ok || r.Host == "" {
+		return r.Header
+	}
+	h := r.Header.Clone()
+	if h == nil {
+		h = make(http.Header)
+	}
+	h["HOST"] = []string{r.Host}
+	return h
+}
+
+func notifyKind(nts string) string {
+	switch nts {
+	case ntsAlive, ntsByebye, ntsUpdate:
+		return nts
+	}
+	return methodNotify
+}
+
+func validateSearch(h http.Header, multicast bool) []string {
+	var v []string
+	v = checkHost(v, h)
+	if man, _ := headerValue(h, "MAN"); man != ssdpDiscover {
+		v = append(v, "MAN is "+strconv.Quote(man)+", not "+ssdpDiscover)
+	}
+	if mx, ok := headerValue(h, "MX"); ok || multicast {
+		if n, err := strconv.Atoi(mx); err != nil || n < 1 || n > maxMXSeconds {
+			v = append(v, "MX is "+strconv.Quote(mx)+", not from 1 to 5")
+		}
+	}
+	st, _ := headerValue(h, "ST")
+	if err := checkTarget(st, true); err != "" {
+		v = append(v, "ST "+err)
+	}
+	if ua, ok := headerValue(h, "USER-AGENT"); ok {
+		if err := checkProductTokens(ua); err != "" {
+			v = append(v, "USER-AGENT "+err)
+		}
+	}
+	return v
+}
+
+func validateNotify(h http.Header) []string {
+	var v []string
+	v = checkHost(v, h)
+	nts, _ := headerValue(h, "NTS")
+	switch nts {
+	case ntsAlive:
+		v = checkMaxAge(v, h)
+		v = checkLocation(v, h)
+		v = checkServer(v, h)
+	case ntsUpdate:
+		v = checkLocation(v, h)
+		v = checkID(v, h, "NEXTBOOTID.UPNP.ORG", 1<<31-1)
+	case ntsByebye:
+	default:
+		v = append(v, "NTS is "+strconv.Quote(nts))
+	}
+	nt, _ := headerValue(h, "NT")
+	if err := checkTarget(nt, false); err != "" {
+		v = append(v, "NT "+err)
+	}
+	v = checkUSN(v, h, nt)
+	v = checkID(v, h, "BOOTID.UPNP.ORG", 1<<31-1)
+	v = checkID(v, h, "CONFIGID.UPNP.ORG", maxConfigID)
+	return checkSearchPort(v, h)
+}
+
+func validateSearchResponse(h http.Header) []string {
+	var v []string
+	v = checkMaxAge(v, h)
+	if date, ok := headerValue(h, "DATE"); ok {
+		if _, err := http.ParseTime(date); err != nil {
+			v = append(v, "DATE is "+strconv.Quote(date)+", not an HTTP date")
+		}
+	}
+	if ext, ok := headerValue(h, "EXT"); !ok || ext != "" {
+		v = append(v, "EXT is missing or not empty")
+	}
+	v = checkLocation(v, h)
+	v = checkServer(v, h)
+	st, _ := headerValue(h, "ST")
+	if err := checkTarget(st, false); err != "" {
+		v = append(v, "ST "+err)
+	}
+	v = checkUSN(v, h, st)
+	v = checkID(v, h, "BOOTID.UPNP.ORG", 1<<31-1)
+	v = checkID(v, h, "CONFIGID.UPNP.ORG", maxConfigID)
+	return checkSearchPort(v, h)
+}
+
+// headerValue returns the value of the header name, whether its key is
+// canonical, as in parsed messages, or upper case, as in built ones.
+func headerValue(h http.Header, name string) (string, bool) {
+	values, ok := h[name]
+	if !ok {
+		values, ok = h[http.CanonicalHeaderKey(name)]
+	}
+	if !ok || len(values) == 0 {
+		return "", false
+	}
+	return strings.TrimSpace(values[0]), true
+}
+
+func isMulticast(hostport string) bool {
+	host, _, err := net.SplitHostPort(hostport)
+	if err != nil {
+		return false
+	}
+	ip := net.ParseIP(host)
+	return ip != nil && ip.IsMulticast()
+}
+
+func checkHost(v []string, h http.Header) []string {
+	host, _ := headerValue(h, "HOST")
+	if _, port, err := net.SplitHostPort(host); err != nil || port == "" {
+		v = append(v, "HOST is "+strconv.Quote(host)+", not host:port")
+	}
+	return v
+}
+
+func checkMaxAge(v []string, h http.Header) []string {
+	cc, _ := headerValue(h, "CACHE-CONTROL")
+	if _, err := parseCacheControlMaxAge(cc); err != nil {
+		v = append(v, "CACHE-CONTROL is "+strconv.Quote(cc)+", not a max-age of 1 to 86400 seconds")
+	}
+	return v
+}
+
+func checkLocation(v []string, h http.Header) []string {
+	loc, _ := headerValue(h, "LOCATION")
+	if u, err := url.Parse(loc); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		v = append(v, "LOCATION is "+strconv.Quote(loc)+", not an absolute HTTP URL")
+	}
+	return v
+}
+
+func checkServer(v []string, h http.Header) []string {
+	server, _ := headerValue(h, "SERVER")
+	if err := checkProductTokens(server); err != "" {
+		v = append(v, "SERVER "+err)
+	}
+	return v
+}
+
+// checkProductTokens checks that s is of the form "OS/version UPnP/1.x
+// product/version" of SERVER and USER-AGENT headers.
+func checkProductTokens(s string) string {
+	tokens := strings.Fields(s)
+	if len(tokens) < 3 {
+		return "is " + strconv.Quote(s) + ", not \"OS/version UPnP/1.1 product/version\""
+	}
+	for _, token := range tokens {
+		if strings.HasPrefix(token, "UPnP/") {
+			return ""
+		}
+	}
+	return "is " + strconv.Quote(s) + ", without a UPnP/version token"
+}
+
+// checkTarget checks that target is a valid NT, or ST if search is true,
+// which may also be ssdp:all.
+func checkTarget(target string, search bool) string {
+	switch {
+	case target == "":
+		return "is missing"
+	case target == ssdpAll && search, target == "upnp:rootdevice":
+		return ""
+	case strings.HasPrefix(target, "uuid:") && len(target) > len("uuid:"):
+		return ""
+	case strings.HasPrefix(target, "urn:"):
+		parts := strings.Split(target, ":")
+		if len(parts) == 5 && parts[1] != "" && (parts[2] == "device" || parts[2] == "service") && parts[3] != "" {
+			if ver, err := strconv.Atoi(parts[4]); err == nil && ver >= 1 {
+				return ""
+			}
+		}
+	}
+	return "is " + strconv.Quote(target) + ", not a valid target"
+}
+
+// checkUSN checks that the USN is the UDN for an NT of the UDN, and the UDN
+// followed by "::" and the NT otherwise.
+func checkUSN(v []string, h http.Header, nt string) []string {
+	usn, _ := headerValue(h, "USN")
+	udn := usn
+	if i := strings.Index(usn, "::"); i >= 0 {
+		udn = usn[:i]
+	}
+	switch {
+	case !strings.HasPrefix(udn, "uuid:") || len(udn) == len("uuid:"):
+		v = append(v, "USN is "+strconv.Quote(usn)+", not of a UDN")
+	case strings.HasPrefix(nt, "uuid:") && usn != nt:
+		v = append(v, "USN is "+strconv.Quote(usn)+", not the UDN "+nt)
+	case !strings.HasPrefix(nt, "uuid:") && nt != "" && usn != udn+"::"+nt:
+		v = append(v, "USN is "+strconv.Quote(usn)+", not "+strconv.Quote(udn+"::"+nt))
+	}
+	return v
+}
+
+func checkID(v []string, h http.Header, name string, max int64) []string {
+	s, _ := headerValue(h, name)
+	if n, err := strconv.ParseInt(s, 10, 64); err != nil || n < 0 || n > max {
+		v = append(v, name+" is "+strconv.Quote(s)+", not from 0 to "+strconv.FormatInt(max, 10))
+	}
+	return v
+}
+
+func checkSearchPort(v []string, h http.Header) []string {
+	s, ok := headerValue(h, "SEARCHPORT.UPNP.ORG")
+	if !ok {
+		return v
+	}
+	if n, err := strconv.Atoi(s); err != nil || n < 49152 || n > 65535 {
+		v = append(v, "SEARCHPORT.UPNP.ORG is "+strconv.Quote(s)+", not from 49152 to 65535")
+	}
+	return v
+}
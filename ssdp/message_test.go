@@ -0,0 +1,83 @@
+package ssdp
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/huin/goupnp/httpu"
+	"github.com/huin/goupnp/upnperr"
+)
+
+const (
+	testUDN    = "uuid:00000000-0000-0000-0000-000000000001"
+	testServer = "Linux/5.0 UPnP/1.1 test/1.0"
+)
+
+func TestMessagesValidate(t *testing.T) {
+	search, err := (&Search{Host: ssdpUDP4Addr, MX: 2, ST: "upnp:rootdevice", UserAgent: testServer}).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if req, err := httpu.ReadRequest(bytes.NewReader(search)); err != nil {
+		t.Error(err)
+	} else if err := ValidateSearch(req); err != nil {
+		t.Error(err)
+	}
+
+	for _, m := range []*Notify{
+		{NTS: NTSAlive, Host: ssdpUDP4Addr, NT: "upnp:rootdevice", USN: testUDN + "::upnp:rootdevice", Location: "http://192.168.1.2:8080/desc.xml", Server: testServer, MaxAge: 1800, BootID: 1, ConfigID: 2},
+		{NTS: NTSByebye, Host: ssdpUDP4Addr, NT: testUDN, USN: testUDN, BootID: 1, ConfigID: 2},
+		{NTS: NTSUpdate, Host: ssdpUDP4Addr, NT: "urn:schemas-upnp-org:service:WANIPConnection:1", USN: testUDN + "::urn:schemas-upnp-org:service:WANIPConnection:1", Location: "http://192.168.1.2:8080/desc.xml", BootID: 1, NextBootID: 2, SearchPort: 49152},
+	} {
+		msg, err := m.Marshal()
+		if err != nil {
+			t.Errorf("%s: %v", m.NTS, err)
+			continue
+		}
+		if req, err := httpu.ReadRequest(bytes.NewReader(msg)); err != nil {
+			t.Error(err)
+		} else if err := ValidateNotify(req); err != nil {
+			t.Error(err)
+		}
+	}
+
+	msg, err := (&SearchResponse{MaxAge: 1800, Date: time.Now(), Location: "http://192.168.1.2:8080/desc.xml", Server: testServer, ST: "upnp:rootdevice", USN: testUDN + "::upnp:rootdevice"}).Marshal()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(msg)), nil); err != nil {
+		t.Error(err)
+	} else if err := ValidateSearchResponse(resp); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMessageViolations(t *testing.T) {
+	_, err := (&Notify{NTS: NTSAlive, Host: ssdpUDP4Addr, NT: "upnp:rootdevice", USN: testUDN, Server: "custom", ConfigID: 1 << 24}).Marshal()
+	var verr *ValidationError
+	if !errors.As(err, &verr) || !errors.Is(err, upnperr.ErrMalformed) {
+		t.Fatalf("got error %v, want a ValidationError", err)
+	}
+	want := []string{"CACHE-CONTROL", "LOCATION", "SERVER", "USN", "CONFIGID.UPNP.ORG"}
+	if len(verr.Violations) != len(want) {
+		t.Fatalf("got violations %q, want ones of %q", verr.Violations, want)
+	}
+	for i, v := range verr.Violations {
+		if !strings.HasPrefix(v, want[i]) {
+			t.Errorf("got violation %q, want one of %s", v, want[i])
+		}
+	}
+
+	req, err := httpu.ReadRequest(strings.NewReader("M-SEARCH * HTTP/1.1\r\nHOST: 239.255.255.250:1900\r\nMAN: ssdp:discover\r\nMX: 10\r\nST: urn:foo\r\n\r\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ValidateSearch(req); !errors.As(err, &verr) || len(verr.Violations) != 3 {
+		t.Errorf("got error %v, want violations of MAN, MX and ST", err)
+	}
+}
@@ -1,8 +1,9 @@
 package ssdp
 
 import (
+	"encoding/json"
 	"fmt"
-	"log"
+	"log/slog"
 	"net/http"
 	"net/url"
 	"regexp"
@@ -10,7 +11,11 @@ import (
 	"sync"
 	"time"
 
+	"github.com/huin/goupnp/clock"
 	"github.com/huin/goupnp/httpu"
+	"github.com/huin/goupnp/internal/logging"
+	"github.com/huin/goupnp/store"
+	"github.com/huin/goupnp/upnperr"
 )
 
 const (
@@ -82,16 +87,15 @@ type Entry struct {
 	CacheExpiry time.Time
 }
 
-func newEntryFromRequest(r *http.Request) (*Entry, error) {
-	now := time.Now()
+func newEntryFromRequest(r *http.Request, now time.Time) (*Entry, error) {
 	expiryDuration, err := parseCacheControlMaxAge(r.Header.Get("CACHE-CONTROL"))
 	if err != nil {
-		return nil, fmt.Errorf("ssdp: error parsing CACHE-CONTROL max age: %v", err)
+		return nil, upnperr.Wrap(upnperr.ErrMalformed, "ssdp: error parsing CACHE-CONTROL max age", err)
 	}
 
 	loc, err := url.Parse(r.Header.Get("LOCATION"))
 	if err != nil {
-		return nil, fmt.Errorf("ssdp: error parsing entry Location URL: %v", err)
+		return nil, upnperr.Wrap(upnperr.ErrMalformed, "ssdp: error parsing entry Location URL", err)
 	}
 
 	bootID, err := parseUpnpIntHeader(r.Header, "BOOTID.UPNP.ORG", -1)
@@ -108,7 +112,7 @@ func newEntryFromRequest(r *http.Request) (*Entry, error) {
 	}
 
 	if searchPort < 1 || searchPort > 65535 {
-		return nil, fmt.Errorf("ssdp: search port %d is out of range", searchPort)
+		return nil, upnperr.New(upnperr.ErrMalformed, fmt.Sprintf("ssdp: search port %d is out of range", searchPort))
 	}
 
 	return &Entry{
@@ -151,7 +155,7 @@ func parseUpnpIntHeader(headers http.Header, headerName string, def int32) (int3
 	}
 	v, err := strconv.ParseInt(s, 10, 32)
 	if err != nil {
-		return 0, fmt.Errorf("ssdp: could not parse header %s: %v", headerName, err)
+		return 0, upnperr.Wrap(upnperr.ErrMalformed, "ssdp: could not parse header "+headerName, err)
 	}
 	return int32(v), nil
 }
@@ -163,6 +167,16 @@ var _ httpu.Handler = new(Registry)
 // NOTE: the interface for this is experimental and may change, or go away
 // entirely.
 type Registry struct {
+	// Logger logs the messages that fail to be handled, the goupnp.SetLogger
+	// logger if nil.
+	Logger *slog.Logger
+	// Clock is the source of the LastUpdate and CacheExpiry of entries,
+	// clock.Real if nil.
+	Clock clock.Clock
+	// Store, if not nil, keeps the entries until their CacheExpiry, so that
+	// they can be restored with Load after a restart.
+	Store store.Store
+
 	lock  sync.Mutex
 	byUSN map[string]*Entry
 
@@ -190,6 +204,91 @@ func NewServerAndRegistry() (*httpu.Server, *Registry) {
 	return srv, reg
 }
 
+// Load adds the unexpired entries of the Store to the registry, without
+// sending updates for them.
+func (reg *Registry) Load() error {
+	if reg.Store == nil {
+		return nil
+	}
+	items, err := reg.Store.List(store.PrefixSSDP)
+	if err != nil {
+		return err
+	}
+	reg.lock.Lock()
+	defer reg.lock.Unlock()
+	for _, item := range items {
+		var r entryRecord
+		if err := json.Unmarshal(item.Value, &r); err != nil {
+			return fmt.Errorf("ssdp: error decoding stored entry %s: %v", item.Key, err)
+		}
+		entry, err := r.entry()
+		if err != nil {
+			return fmt.Errorf("ssdp: error decoding stored entry %s: %v", item.Key, err)
+		}
+		reg.byUSN[entry.USN] = entry
+	}
+	return nil
+}
+
+// entryRecord is an Entry as kept in a Store.
+type entryRecord struct {
+	RemoteAddr  string    `json:"remoteAddr"`
+	USN         string    `json:"usn"`
+	NT          string    `json:"nt"`
+	Server      string    `json:"server,omitempty"`
+	Host        string    `json:"host,omitempty"`
+	Location    string    `json:"location"`
+	BootID      int32     `json:"bootID"`
+	ConfigID    int32     `json:"configID"`
+	SearchPort  uint16    `json:"searchPort"`
+	LastUpdate  time.Time `json:"lastUpdate"`
+	CacheExpiry time.Time `json:"cacheExpiry"`
+}
+
+func (r *entryRecord) entry() (*Entry, error) {
+	loc, err := url.Parse(r.Location)
+	if err != nil {
+		return nil, err
+	}
+	return &Entry{
+		RemoteAddr:  r.RemoteAddr,
+		USN:         r.USN,
+		NT:          r.NT,
+		Server:      r.Server,
+		Host:        r.Host,
+		Location:    *loc,
+		BootID:      r.BootID,
+		ConfigID:    r.ConfigID,
+		SearchPort:  r.SearchPort,
+		LastUpdate:  r.LastUpdate,
+		CacheExpiry: r.CacheExpiry,
+	}, nil
+}
+
+// storeEntry keeps entry in the Store, if any, until its CacheExpiry.
+func (reg *Registry) storeEntry(entry *Entry) error {
+	if reg.Store == nil {
+		return nil
+	}
+	value, err := json.Marshal(&entryRecord{
+		RemoteAddr:  entry.RemoteAddr,
+		USN:         entry.USN,
+		NT:          entry.NT,
+		Server:      entry.Server,
+		Host:        entry.Host,
+		Location:    entry.Location.String(),
+		BootID:      entry.BootID,
+		ConfigID:    entry.ConfigID,
+		SearchPort:  entry.SearchPort,
+		LastUpdate:  entry.LastUpdate,
+		CacheExpiry: entry.CacheExpiry,
+	})
+	if err != nil {
+		return err
+	}
+	return reg.Store.Put(store.PrefixSSDP+entry.USN, value, entry.CacheExpiry.Sub(entry.LastUpdate))
+}
+
 func (reg *Registry) AddListener(c chan<- Update) {
 	reg.listenersLock.Lock()
 	defer reg.listenersLock.Unlock()
@@ -244,15 +343,16 @@ func (reg *Registry) ServeMessage(r *http.Request) {
 	case ntsByebye:
 		err = reg.handleNTSByebye(r)
 	default:
-		err = fmt.Errorf("unknown NTS value: %q", nts)
+		err = upnperr.New(upnperr.ErrMalformed, fmt.Sprintf("unknown NTS value: %q", nts))
 	}
 	if err != nil {
-		log.Printf("goupnp/ssdp: failed to handle %s message from %s: %v", nts, r.RemoteAddr, err)
+		logging.ReportMalformed(reg.Logger, "ssdp", "ssdp: failed to handle message", r.RemoteAddr, err,
+			slog.String("nts", nts))
 	}
 }
 
 func (reg *Registry) handleNTSAlive(r *http.Request) error {
-	entry, err := newEntryFromRequest(r)
+	entry, err := newEntryFromRequest(r, clock.Or(reg.Clock).Now())
 	if err != nil {
 		return err
 	}
@@ -260,6 +360,7 @@ func (reg *Registry) handleNTSAlive(r *http.Request) error {
 	reg.lock.Lock()
 	reg.byUSN[entry.USN] = entry
 	reg.lock.Unlock()
+	reg.storeErr(reg.storeEntry(entry))
 
 	reg.sendUpdate(Update{
 		USN:       entry.USN,
@@ -270,8 +371,23 @@ func (reg *Registry) handleNTSAlive(r *http.Request) error {
 	return nil
 }
 
+// AddSearchResponse adds the entry of resp, a response to an M-SEARCH
+// request such as those returned by SSDPRawSearch, whose ST is then the NT of
+// the entry, and sends an EventAlive update for it, as for an ssdp:alive
+// NOTIFY. This keeps the registry populated from periodic searches, for
+// devices whose announcements are not received.
+func (reg *Registry) AddSearchResponse(resp *http.Response) error {
+	header := resp.Header.Clone()
+	header.Set("NT", resp.Header.Get("ST"))
+	r := &http.Request{Header: header}
+	if resp.Request != nil {
+		r.RemoteAddr = resp.Request.RemoteAddr
+	}
+	return reg.handleNTSAlive(r)
+}
+
 func (reg *Registry) handleNTSUpdate(r *http.Request) error {
-	entry, err := newEntryFromRequest(r)
+	entry, err := newEntryFromRequest(r, clock.Or(reg.Clock).Now())
 	if err != nil {
 		return err
 	}
@@ -284,6 +400,7 @@ func (reg *Registry) handleNTSUpdate(r *http.Request) error {
 	reg.lock.Lock()
 	reg.byUSN[entry.USN] = entry
 	reg.lock.Unlock()
+	reg.storeErr(reg.storeEntry(entry))
 
 	reg.sendUpdate(Update{
 		USN:       entry.USN,
@@ -301,6 +418,9 @@ func (reg *Registry) handleNTSByebye(r *http.Request) error {
 	entry := reg.byUSN[usn]
 	delete(reg.byUSN, usn)
 	reg.lock.Unlock()
+	if reg.Store != nil {
+		reg.storeErr(reg.Store.Delete(store.PrefixSSDP + usn))
+	}
 
 	reg.sendUpdate(Update{
 		USN:       usn,
@@ -310,3 +430,11 @@ func (reg *Registry) handleNTSByebye(r *http.Request) error {
 
 	return nil
 }
+
+// storeErr logs an error of updating the Store, which does not prevent the
+// registry from being updated.
+func (reg *Registry) storeErr(err error) {
+	if err != nil {
+		logging.Or(reg.Logger).Warn("ssdp: failed to store entry", logging.Err(err))
+	}
+}
@@ -1,14 +1,16 @@
 package ssdp
 
 import (
+	"context"
 	"errors"
-	"log"
+	"log/slog"
 	"net/http"
-	"net/url"
-	"strconv"
 	"time"
 
 	"github.com/huin/goupnp/httpu"
+	"github.com/huin/goupnp/internal/logging"
+	"github.com/huin/goupnp/metrics"
+	"github.com/huin/goupnp/tracing"
 )
 
 const (
@@ -17,64 +19,112 @@ const (
 	ntsByebye      = `ssdp:byebye`
 	ntsUpdate      = `ssdp:update`
 	ssdpUDP4Addr   = "239.255.255.250:1900"
+	ssdpUDP6Addr   = "[FF02::C]:1900" // Link-local scope.
 	ssdpSearchPort = 1900
 	methodSearch   = "M-SEARCH"
 	methodNotify   = "NOTIFY"
 )
 
+// SearchAddr is the UDP address that SSDPRawSearch sends M-SEARCH requests
+// to, the SSDP multicast group by default. It may be set to a unicast
+// address, for example by the goupnptest package to direct discovery to a
+// fake device in tests. It must not be changed while searches are in
+// progress.
+var SearchAddr = ssdpUDP4Addr
+
+// SearchAddr6 is as SearchAddr, for searches with IPv6 HTTPU clients. It is
+// the SSDP link-local scope multicast group by default.
+var SearchAddr6 = ssdpUDP6Addr
+
 // SSDPRawSearch performs a fairly raw SSDP search request, and returns the
-// unique response(s) that it receives. Each response has the requested
-// searchTarget, a USN, and a valid location. maxWaitSeconds states how long to
-// wait for responses in seconds, and must be a minimum of 1 (the
+// unique response(s) that it receives, one per USN. Each response has the
+// requested searchTarget (any search target if it is "ssdp:all"), a USN, and
+// a valid location. maxWaitSeconds states
+// how long to wait for responses in seconds, and must be a minimum of 1 (the
 // implementation waits an additional 100ms for responses to arrive), 2 is a
 // reasonable value for this. numSends is the number of requests to send - 3 is
-// a reasonable value for this.
+// a reasonable value for this. The request is sent to SearchAddr, or to
+// SearchAddr6 if httpu uses IPv6.
 func SSDPRawSearch(httpu *httpu.HTTPUClient, searchTarget string, maxWaitSeconds int, numSends int) ([]*http.Response, error) {
+	return SSDPRawSearchCtx(context.Background(), httpu, searchTarget, maxWaitSeconds, numSends)
+}
+
+// SSDPRawSearchCtx is as SSDPRawSearch, tracing the search as a child of the
+// span of ctx. The search ends early with the error of ctx if ctx is done.
+func SSDPRawSearchCtx(ctx context.Context, httpu *httpu.HTTPUClient, searchTarget string, maxWaitSeconds int, numSends int) ([]*http.Response, error) {
+	return rawSearch(ctx, httpu, searchTarget, maxWaitSeconds, numSends, false)
+}
+
+// SSDPRawSearchLocations is as SSDPRawSearchCtx, but returns one response per
+// USN and location, so that a device responding with several locations, such
+// as one per address, is returned once for each of them.
+func SSDPRawSearchLocations(ctx context.Context, httpu *httpu.HTTPUClient, searchTarget string, maxWaitSeconds int, numSends int) ([]*http.Response, error) {
+	return rawSearch(ctx, httpu, searchTarget, maxWaitSeconds, numSends, true)
+}
+
+// rawSearch implements SSDPRawSearchCtx, and SSDPRawSearchLocations if
+// perLocation is true.
+func rawSearch(ctx context.Context, httpu *httpu.HTTPUClient, searchTarget string, maxWaitSeconds int, numSends int, perLocation bool) (responses []*http.Response, err error) {
+	_, span := tracing.Start(ctx, tracing.SpanSSDPSearch, tracing.String(tracing.KeySearchTarget, searchTarget))
+	defer func() {
+		span.SetAttributes(tracing.Int(tracing.KeyResponses, len(responses)))
+		span.End(err)
+		target := metrics.SearchTarget(searchTarget)
+		metrics.Inc(metrics.Discoveries, target)
+		metrics.Add(metrics.SSDPResponses, float64(len(responses)), target)
+	}()
+
 	if maxWaitSeconds < 1 {
 		return nil, errors.New("ssdp: maxWaitSeconds must be >= 1")
 	}
 
-	seenUsns := make(map[string]bool)
-	var responses []*http.Response
-	req := http.Request{
-		Method: methodSearch,
-		// TODO: Support both IPv4 and IPv6.
-		Host: ssdpUDP4Addr,
-		URL:  &url.URL{Opaque: "*"},
-		Header: http.Header{
-			// Putting headers in here avoids them being title-cased.
-			// (The UPnP discovery protocol uses case-sensitive headers)
-			"HOST": []string{ssdpUDP4Addr},
-			"MX":   []string{strconv.FormatInt(int64(maxWaitSeconds), 10)},
-			"MAN":  []string{ssdpDiscover},
-			"ST":   []string{searchTarget},
-		},
+	seen := make(map[string]bool)
+	searchAddr := SearchAddr
+	if httpu.IPv6() {
+		searchAddr = SearchAddr6
 	}
-	allResponses, err := httpu.Do(&req, time.Duration(maxWaitSeconds)*time.Second+100*time.Millisecond, numSends)
+	// UPnP 1.1 limits MX to 5 seconds, but responses are awaited for all of
+	// maxWaitSeconds.
+	mx := maxWaitSeconds
+	if mx > maxMXSeconds {
+		mx = maxMXSeconds
+	}
+	search := &Search{Host: searchAddr, MX: mx, ST: searchTarget}
+	req, err := search.Request()
 	if err != nil {
 		return nil, err
 	}
+	allResponses, err := httpu.DoCtx(ctx, req, time.Duration(maxWaitSeconds)*time.Second+100*time.Millisecond, numSends)
+	if err != nil {
+		return nil, err
+	}
+	logger := logging.Or(httpu.Logger)
 	for _, response := range allResponses {
 		if response.StatusCode != 200 {
-			log.Printf("ssdp: got response status code %q in search response", response.Status)
+			logger.Debug("ssdp: discarding search response", slog.String("status", response.Status))
 			continue
 		}
-		if st := response.Header.Get("ST"); st != searchTarget {
-			log.Printf("ssdp: got unexpected search target result %q", st)
+		if st := response.Header.Get("ST"); st != searchTarget && searchTarget != ssdpAll {
+			logger.Debug("ssdp: discarding search response of unexpected search target", slog.String("st", st))
 			continue
 		}
 		location, err := response.Location()
 		if err != nil {
-			log.Printf("ssdp: no usable location in search response (discarding): %v", err)
+			logger.Debug("ssdp: discarding search response without usable location", logging.Err(err))
 			continue
 		}
 		usn := response.Header.Get("USN")
 		if usn == "" {
-			log.Printf("ssdp: empty/missing USN in search response (using location instead): %v", err)
+			logger.Debug("ssdp: search response without USN, using location instead",
+				slog.String(logging.KeyLocation, location.String()))
 			usn = location.String()
 		}
-		if _, alreadySeen := seenUsns[usn]; !alreadySeen {
-			seenUsns[usn] = true
+		key := usn
+		if perLocation {
+			key += " " + location.String()
+		}
+		if _, alreadySeen := seen[key]; !alreadySeen {
+			seen[key] = true
 			responses = append(responses, response)
 		}
 	}
@@ -0,0 +1,66 @@
+package ssdp
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/huin/goupnp/httpu"
+)
+
+// respondFrom answers each M-SEARCH received by conn with a response from
+// each of locations, all with the same USN.
+func respondFrom(conn net.PacketConn, usn string, locations ...string) {
+	buf := make([]byte, 2048)
+	for {
+		_, addr, err := conn.ReadFrom(buf)
+		if err != nil {
+			return
+		}
+		for _, loc := range locations {
+			resp := fmt.Sprintf("HTTP/1.1 200 OK\r\nST: upnp:rootdevice\r\nUSN: %s\r\nLOCATION: %s\r\n\r\n", usn, loc)
+			conn.WriteTo([]byte(resp), addr)
+		}
+	}
+}
+
+func TestSSDPRawSearch(t *testing.T) {
+	conn, err := net.ListenPacket("udp4", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	go respondFrom(conn, testUDN+"::upnp:rootdevice", "http://127.0.0.1:49152/desc.xml", "http://127.0.0.2:49152/desc.xml")
+	defer func(addr string) { SearchAddr = addr }(SearchAddr)
+	SearchAddr = conn.LocalAddr().String()
+
+	client, err := httpu.NewHTTPUClient(httpu.WithLocalAddr("127.0.0.1:0"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer client.Close()
+	responses, err := SSDPRawSearch(client, "upnp:rootdevice", 1, 2)
+	if err != nil || len(responses) != 1 {
+		t.Errorf("SSDPRawSearch() = %d responses, %v, want 1 per USN", len(responses), err)
+	}
+	responses, err = SSDPRawSearch(client, "ssdp:all", 1, 2)
+	if err != nil || len(responses) != 1 {
+		t.Errorf("SSDPRawSearch(ssdp:all) = %d responses, %v, want 1 per USN", len(responses), err)
+	}
+	responses, err = SSDPRawSearchLocations(context.Background(), client, "upnp:rootdevice", 1, 2)
+	if err != nil || len(responses) != 2 {
+		t.Errorf("SSDPRawSearchLocations() = %d responses, %v, want 1 per location", len(responses), err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	if _, err := SSDPRawSearchCtx(ctx, client, "upnp:rootdevice", 5, 1); err != context.DeadlineExceeded {
+		t.Errorf("SSDPRawSearchCtx() with an expiring context: got error %v, want %v", err, context.DeadlineExceeded)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("SSDPRawSearchCtx() took %v after the context expired", elapsed)
+	}
+}
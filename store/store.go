@@ -0,0 +1,221 @@
+// Package store is the persistent storage of the state of goupnp and its
+// subpackages, so that embedded deployments can keep it across restarts: the
+// entries of an ssdp.Registry, the device descriptions fetched by discovery
+// (see goupnp.WithDescriptionStore) and the subscriptions of a gena.Subscriber.
+// Memory keeps the state in memory, and File in a JSON file; other storage,
+// such as a database, is used by implementing Store.
+package store
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/huin/goupnp/clock"
+)
+
+// The prefixes of the keys of each kind of state, so that a Store can be
+// shared by several components.
+const (
+	PrefixSSDP         = "ssdp/"
+	PrefixDescription  = "description/"
+	PrefixSubscription = "gena/"
+)
+
+// Store stores values by key, until they expire. Its methods must be safe for
+// concurrent use.
+type Store interface {
+	// Get returns the value of key, and whether it is set and unexpired.
+	Get(key string) (value []byte, ok bool, err error)
+	// Put sets the value of key, expiring after ttl, or never if ttl is zero.
+	Put(key string, value []byte, ttl time.Duration) error
+	// Delete removes the value of key, if set.
+	Delete(key string) error
+	// List returns the unexpired items whose keys start with prefix, in the
+	// order of their keys.
+	List(prefix string) ([]Item, error)
+}
+
+// Item is a value of a Store.
+type Item struct {
+	Key   string `json:"key"`
+	Value []byte `json:"value"`
+	// Expiry is when the item expires, the zero time if it does not.
+	Expiry time.Time `json:"expiry,omitempty"`
+}
+
+func (item *Item) expired(now time.Time) bool {
+	return !item.Expiry.IsZero() && !now.Before(item.Expiry)
+}
+
+// Memory is a Store keeping its items in memory. Its zero value is empty and
+// ready to use.
+type Memory struct {
+	// Clock is the source of the expiry of items, clock.Real if nil.
+	Clock clock.Clock
+
+	lock  sync.Mutex
+	items map[string]Item
+}
+
+var _ Store = new(Memory)
+
+// NewMemory returns an empty Memory.
+func NewMemory() *Memory {
+	return new(Memory)
+}
+
+// Get implements Store.
+func (m *Memory) Get(key string) ([]byte, bool, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	item, ok := m.items[key]
+	if !ok || item.expired(clock.Or(m.Clock).Now()) {
+		return nil, false, nil
+	}
+	return item.Value, true, nil
+}
+
+// Put implements Store.
+func (m *Memory) Put(key string, value []byte, ttl time.Duration) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.put(key, value, ttl)
+	return nil
+}
+
+func (m *Memory) put(key string, value []byte, ttl time.Duration) {
+	item := Item{Key: key, Value: append([]byte(nil), value...)}
+	if ttl > 0 {
+		item.Expiry = clock.Or(m.Clock).Now().Add(ttl)
+	}
+	if m.items == nil {
+		m.items = make(map[string]Item)
+	}
+	m.items[key] = item
+}
+
+// Delete implements Store.
+func (m *Memory) Delete(key string) error {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	delete(m.items, key)
+	return nil
+}
+
+// List implements Store. Expired items are removed.
+func (m *Memory) List(prefix string) ([]Item, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	return m.list(prefix), nil
+}
+
+func (m *Memory) list(prefix string) []Item {
+	now := clock.Or(m.Clock).Now()
+	var items []Item
+	for key, item := range m.items {
+		if item.expired(now) {
+			delete(m.items, key)
+			continue
+		}
+		if strings.HasPrefix(key, prefix) {
+			items = append(items, item)
+		}
+	}
+	sort.Slice(items, func(i, j int) bool { return items[i].Key < items[j].Key })
+	return items
+}
+
+// File is a Store keeping its items in memory, and in a JSON file that is
+// rewritten on every change, so that they are kept across restarts.
+type File struct {
+	filename string
+	mem      Memory
+}
+
+var _ Store = new(File)
+
+// OpenFile returns a File storing its items in filename, with the unexpired
+// items already in it. The file is created on the first change if it does not
+// exist. c is the source of the expiry of items, clock.Real if nil.
+func OpenFile(filename string, c clock.Clock) (*File, error) {
+	f := &File{filename: filename, mem: Memory{Clock: c}}
+	data, err := ioutil.ReadFile(filename)
+	if os.IsNotExist(err) {
+		return f, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var items []Item
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, fmt.Errorf("store: error decoding %s: %v", filename, err)
+	}
+	f.mem.items = make(map[string]Item, len(items))
+	for _, item := range items {
+		f.mem.items[item.Key] = item
+	}
+	return f, nil
+}
+
+// Get implements Store.
+func (f *File) Get(key string) ([]byte, bool, error) {
+	return f.mem.Get(key)
+}
+
+// Put implements Store.
+func (f *File) Put(key string, value []byte, ttl time.Duration) error {
+	f.mem.lock.Lock()
+	defer f.mem.lock.Unlock()
+	f.mem.put(key, value, ttl)
+	return f.save()
+}
+
+// Delete implements Store.
+func (f *File) Delete(key string) error {
+	f.mem.lock.Lock()
+	defer f.mem.lock.Unlock()
+	if _, ok := f.mem.items[key]; !ok {
+		return nil
+	}
+	delete(f.mem.items, key)
+	return f.save()
+}
+
+// List implements Store.
+func (f *File) List(prefix string) ([]Item, error) {
+	return f.mem.List(prefix)
+}
+
+// save writes the unexpired items to the file, replacing it at once so that
+// it is not left partly written. f.mem.lock must be held.
+func (f *File) save() error {
+	data, err := json.MarshalIndent(f.mem.list(""), "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(f.filename), filepath.Base(f.filename)+".tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(append(data, '\n')); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Rename(tmp.Name(), f.filename); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package store
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/huin/goupnp/clock"
+)
+
+func testStore(t *testing.T, s Store, fc *clock.Fake) {
+	t.Helper()
+	if err := s.Put("a/1", []byte("one"), time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Put("a/2", []byte("two"), 0); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Put("b/1", []byte("three"), time.Hour); err != nil {
+		t.Fatal(err)
+	}
+	if v, ok, err := s.Get("a/1"); err != nil || !ok || string(v) != "one" {
+		t.Errorf("Get(a/1) = %q, %t, %v; want one", v, ok, err)
+	}
+	items, err := s.List("a/")
+	if err != nil || len(items) != 2 || items[0].Key != "a/1" || items[1].Key != "a/2" {
+		t.Errorf("List(a/) = %+v, %v; want a/1, a/2", items, err)
+	}
+
+	fc.Advance(2 * time.Minute)
+	if _, ok, _ := s.Get("a/1"); ok {
+		t.Error("Get(a/1) after its TTL: got value")
+	}
+	if err := s.Delete("b/1"); err != nil {
+		t.Fatal(err)
+	}
+	if items, _ := s.List(""); len(items) != 1 || items[0].Key != "a/2" {
+		t.Errorf("List() = %+v; want a/2", items)
+	}
+}
+
+func TestMemory(t *testing.T) {
+	fc := clock.NewFake(time.Unix(1e9, 0))
+	testStore(t, &Memory{Clock: fc}, fc)
+}
+
+func TestFile(t *testing.T) {
+	fc := clock.NewFake(time.Unix(1e9, 0))
+	filename := filepath.Join(t.TempDir(), "state.json")
+	f, err := OpenFile(filename, fc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	testStore(t, f, fc)
+	if err := f.Put("c/1", []byte("four"), time.Minute); err != nil {
+		t.Fatal(err)
+	}
+
+	reopened, err := OpenFile(filename, fc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	items, _ := reopened.List("")
+	if len(items) != 2 || items[0].Key != "a/2" || string(items[1].Value) != "four" {
+		t.Errorf("reopened List() = %+v; want a/2, c/1", items)
+	}
+	fc.Advance(time.Hour)
+	if _, ok, _ := reopened.Get("c/1"); ok {
+		t.Error("reopened Get(c/1) after its TTL: got value")
+	}
+}
@@ -0,0 +1,117 @@
+// Package tracing traces the network operations of goupnp and its
+// subpackages: SSDP searches, description fetches, SOAP actions and GENA
+// subscriptions. Spans are started through a Tracer set with SetTracer, an
+// adapter to a tracing library such as OpenTelemetry, which goupnp does not
+// depend on. Nothing is traced if no Tracer is set.
+//
+// Spans are children of the span of the context given to the operation, e.g.
+// to PerformActionCtx of a SOAP client, so that UPnP interactions appear
+// within the traces of the callers. An OpenTelemetry adapter could be:
+//
+//	type otelTracer struct{ t trace.Tracer }
+//
+//	func (ot otelTracer) Start(ctx context.Context, name string, attrs ...tracing.Attribute) (context.Context, tracing.Span) {
+//		ctx, span := ot.t.Start(ctx, name, trace.WithSpanKind(trace.SpanKindClient))
+//		s := otelSpan{span}
+//		s.SetAttributes(attrs...)
+//		return ctx, s
+//	}
+//
+//	type otelSpan struct{ span trace.Span }
+//
+//	func (s otelSpan) SetAttributes(attrs ...tracing.Attribute) {
+//		for _, a := range attrs {
+//			s.span.SetAttributes(attribute.String(a.Key, fmt.Sprint(a.Value)))
+//		}
+//	}
+//
+//	func (s otelSpan) End(err error) {
+//		if err != nil {
+//			s.span.RecordError(err)
+//			s.span.SetStatus(codes.Error, err.Error())
+//		}
+//		s.span.End()
+//	}
+//
+//	tracing.SetTracer(otelTracer{otel.Tracer("github.com/huin/goupnp")})
+package tracing
+
+import (
+	"context"
+	"sync/atomic"
+)
+
+// The names of the spans of goupnp.
+const (
+	SpanSSDPSearch      = "ssdp.search"
+	SpanDescription     = "goupnp.description"
+	SpanSOAPAction      = "soap.action"
+	SpanGENASubscribe   = "gena.subscribe"
+	SpanGENARenew       = "gena.renew"
+	SpanGENAUnsubscribe = "gena.unsubscribe"
+)
+
+// The keys of the attributes of spans. Those also in log records are named
+// as the goupnp.LogKey attributes.
+const (
+	KeyAction       = "action"
+	KeyService      = "service"
+	KeyURL          = "url"
+	KeySearchTarget = "search_target"
+	KeySID          = "sid"
+	KeyResponses    = "responses"
+)
+
+// Attribute is an attribute of a span. Value is a string, int or bool.
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// String returns a string attribute.
+func String(key, value string) Attribute {
+	return Attribute{key, value}
+}
+
+// Int returns an int attribute.
+func Int(key string, value int) Attribute {
+	return Attribute{key, value}
+}
+
+// Tracer starts spans, typically by adapting a tracing library.
+type Tracer interface {
+	// Start starts a span of the given name as a child of any span of ctx,
+	// and returns a context containing the new span.
+	Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span)
+}
+
+// Span is an operation being traced.
+type Span interface {
+	// SetAttributes adds attributes to the span.
+	SetAttributes(attrs ...Attribute)
+	// End ends the span, with the error that the operation failed with, or
+	// nil if it succeeded.
+	End(err error)
+}
+
+var tracer atomic.Value // Of *Tracer.
+
+// SetTracer sets the Tracer of the spans of goupnp. A nil Tracer stops
+// tracing.
+func SetTracer(t Tracer) {
+	tracer.Store(&t)
+}
+
+// Start starts a span with the Tracer set with SetTracer, or returns ctx and
+// a span that does nothing if there is none.
+func Start(ctx context.Context, name string, attrs ...Attribute) (context.Context, Span) {
+	if t, _ := tracer.Load().(*Tracer); t != nil && *t != nil {
+		return (*t).Start(ctx, name, attrs...)
+	}
+	return ctx, noopSpan{}
+}
+
+type noopSpan struct{}
+
+func (noopSpan) SetAttributes(attrs ...Attribute) {}
+func (noopSpan) End(err error)                    {}
@@ -0,0 +1,117 @@
+// Package upnperr classifies the errors returned by goupnp and its
+// subpackages. Errors of the network operations of httpu, ssdp, soap, goupnp
+// and gena match one of the sentinel errors of this package with errors.Is,
+// regardless of their message:
+//
+//	if errors.Is(err, upnperr.ErrTimeout) {
+//		// Retry later.
+//	}
+//
+// and the UPnP error code of SOAP faults is returned by FaultCode.
+package upnperr
+
+import (
+	"context"
+	"errors"
+	"net"
+)
+
+// The kinds of errors. They are matched with errors.Is, and are not returned
+// themselves.
+var (
+	// ErrTimeout is the kind of errors of requests that timed out, including
+	// those whose context deadline passed.
+	ErrTimeout = errors.New("upnp: timeout")
+	// ErrUnreachable is the kind of errors of requests that could not be made
+	// or got no response, other than by timing out.
+	ErrUnreachable = errors.New("upnp: unreachable")
+	// ErrStatus is the kind of errors of requests that got an unexpected HTTP
+	// status.
+	ErrStatus = errors.New("upnp: unexpected HTTP status")
+	// ErrMalformed is the kind of errors of malformed messages from other
+	// devices, and of malformed headers and documents within them.
+	ErrMalformed = errors.New("upnp: malformed message")
+	// ErrFault is the kind of errors of SOAP actions that got a SOAP fault,
+	// whose UPnP error code is returned by FaultCode.
+	ErrFault = errors.New("upnp: SOAP fault")
+	// ErrSubscriptionExpired is the kind of errors of GENA subscriptions that
+	// have expired, or that the publisher no longer knows of.
+	ErrSubscriptionExpired = errors.New("upnp: subscription expired")
+)
+
+// Error is an error of a kind, one of the sentinel errors of this package,
+// possibly wrapping the error that caused it.
+type Error struct {
+	Kind error
+	// Msg describes the error, and is followed by the message of Err if any.
+	Msg string
+	Err error
+}
+
+// New returns an Error of kind, with the message msg and without a cause.
+func New(kind error, msg string) *Error {
+	return &Error{Kind: kind, Msg: msg}
+}
+
+// Wrap returns an Error of kind caused by err. The message is msg followed by
+// that of err.
+func Wrap(kind error, msg string, err error) *Error {
+	return &Error{Kind: kind, Msg: msg, Err: err}
+}
+
+// Transport returns an Error caused by err, an error performing a request,
+// of kind ErrTimeout if it timed out and ErrUnreachable otherwise.
+func Transport(msg string, err error) *Error {
+	kind := ErrUnreachable
+	if IsTimeout(err) {
+		kind = ErrTimeout
+	}
+	return Wrap(kind, msg, err)
+}
+
+// IsTimeout returns whether err is a timeout: a net.Error timeout or a
+// context deadline.
+func IsTimeout(err error) bool {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, ErrTimeout) {
+		return true
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr) && netErr.Timeout()
+}
+
+func (err *Error) Error() string {
+	switch {
+	case err.Err == nil:
+		return err.Msg
+	case err.Msg == "":
+		return err.Err.Error()
+	}
+	return err.Msg + ": " + err.Err.Error()
+}
+
+// Unwrap returns the cause of err.
+func (err *Error) Unwrap() error {
+	return err.Err
+}
+
+// Is reports whether target is the kind of err.
+func (err *Error) Is(target error) bool {
+	return target != nil && target == err.Kind
+}
+
+// Coder is implemented by errors carrying a UPnP error code, such as the
+// *soap.UPnPError within SOAP faults.
+type Coder interface {
+	error
+	UPnPErrorCode() int
+}
+
+// FaultCode returns the UPnP error code of the SOAP fault of err, or false if
+// err does not carry one.
+func FaultCode(err error) (int, bool) {
+	var c Coder
+	if errors.As(err, &c) {
+		return c.UPnPErrorCode(), true
+	}
+	return 0, false
+}
@@ -0,0 +1,69 @@
+package upnperr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"testing"
+)
+
+type codeError int
+
+func (c codeError) Error() string      { return fmt.Sprintf("code %d", int(c)) }
+func (c codeError) UPnPErrorCode() int { return int(c) }
+
+func TestError(t *testing.T) {
+	cause := errors.New("cause")
+	err := fmt.Errorf("context: %w", Wrap(ErrMalformed, "bad header", cause))
+	if got, want := err.Error(), "context: bad header: cause"; got != want {
+		t.Errorf("got message %q, want %q", got, want)
+	}
+	if !errors.Is(err, ErrMalformed) || !errors.Is(err, cause) {
+		t.Errorf("errors.Is does not find the kind and cause of %v", err)
+	}
+	if errors.Is(err, ErrTimeout) {
+		t.Errorf("errors.Is(%v, ErrTimeout) = true", err)
+	}
+	if got := New(ErrStatus, "got HTTP 404").Error(); got != "got HTTP 404" {
+		t.Errorf("got message %q", got)
+	}
+	if got := Wrap(ErrStatus, "", cause).Error(); got != "cause" {
+		t.Errorf("got message %q", got)
+	}
+}
+
+func TestTransport(t *testing.T) {
+	tests := []struct {
+		err  error
+		want error
+	}{
+		{context.DeadlineExceeded, ErrTimeout},
+		{&net.OpError{Op: "read", Err: timeoutError{}}, ErrTimeout},
+		{&net.OpError{Op: "dial", Err: errors.New("connection refused")}, ErrUnreachable},
+	}
+	for _, test := range tests {
+		err := Transport("request", test.err)
+		if !errors.Is(err, test.want) {
+			t.Errorf("Transport(%v) is not %v", test.err, test.want)
+		}
+		if !errors.Is(err, test.err) {
+			t.Errorf("Transport(%v) does not wrap it", test.err)
+		}
+	}
+}
+
+type timeoutError struct{}
+
+func (timeoutError) Error() string   { return "i/o timeout" }
+func (timeoutError) Timeout() bool   { return true }
+func (timeoutError) Temporary() bool { return true }
+
+func TestFaultCode(t *testing.T) {
+	if code, ok := FaultCode(fmt.Errorf("action: %w", codeError(718))); !ok || code != 718 {
+		t.Errorf("FaultCode = %d, %t; want 718, true", code, ok)
+	}
+	if _, ok := FaultCode(errors.New("not a fault")); ok {
+		t.Error("FaultCode of an error without a code returned true")
+	}
+}
@@ -0,0 +1,26 @@
+// Package goupnp is version 2 of the API of github.com/huin/goupnp. Version
+// 1, the packages at github.com/huin/goupnp, remains supported and unchanged
+// for existing users; version 2 is built on it, and its devices, services
+// and clients are those of version 1, so that the two can be mixed while
+// programs migrate.
+//
+// Version 2 differs from version 1 in that:
+//
+//   - every network operation takes a context, which is the only limit of its
+//     duration unless a timeout is given with an option: there are no fixed
+//     global timeouts, such as goupnp.DefaultDescriptionTimeout;
+//   - operations are configured by one kind of functional option, Option,
+//     whichever subsystem they belong to, rather than by each package's own
+//     options and exported fields; and
+//   - durations are time.Duration values, e.g. the search wait of
+//     WithSearchWait, rather than integer seconds.
+//
+// Discovery, description fetching, SOAP actions, eventing with Subscribe and
+// hosting with Serve are provided. Devices to host are still built with the
+// device package of version 1, and events are handled by gena.Handler
+// values.
+//
+// The tree is laid out as a major version subdirectory, so that it is
+// importable both in GOPATH mode and, once the repository has a go.mod,
+// as the module github.com/huin/goupnp/v2.
+package goupnp
@@ -0,0 +1,113 @@
+package goupnp
+
+import (
+	"context"
+	"errors"
+	"net/url"
+
+	goupnp1 "github.com/huin/goupnp"
+	"github.com/huin/goupnp/device"
+	"github.com/huin/goupnp/gena"
+	"github.com/huin/goupnp/soap"
+)
+
+// The devices, services and clients of version 1.
+type (
+	RootDevice      = goupnp1.RootDevice
+	Device          = goupnp1.Device
+	Service         = goupnp1.Service
+	MaybeRootDevice = goupnp1.MaybeRootDevice
+	ServiceClient   = goupnp1.ServiceClient
+)
+
+// Discover searches for devices of searchTarget, e.g.
+// "urn:schemas-upnp-org:device:InternetGatewayDevice:1" or "ssdp:all", and
+// fetches the description of each one that responds. An error is returned
+// only if the search could not be made; each device has either its root
+// device or the error of fetching its description.
+func Discover(ctx context.Context, searchTarget string, opts ...Option) ([]MaybeRootDevice, error) {
+	return goupnp1.DiscoverDevicesCtx(ctx, searchTarget, newConfig(opts).discoveryOptions()...)
+}
+
+// DeviceByURL fetches the description of the root device at loc.
+func DeviceByURL(ctx context.Context, loc *url.URL, opts ...Option) (*RootDevice, error) {
+	c := newConfig(opts)
+	if c.fetcher != nil {
+		return c.fetcher.DeviceByURL(ctx, loc, c.discoveryOptions()...)
+	}
+	return goupnp1.DeviceByURLCtx(ctx, loc, c.discoveryOptions()...)
+}
+
+// ServiceClients discovers the services of searchTarget, a service type, and
+// returns a client of each. The errors of the devices whose descriptions
+// could not be fetched are returned alongside, and an error only if the
+// search could not be made.
+func ServiceClients(ctx context.Context, searchTarget string, opts ...Option) ([]ServiceClient, []error, error) {
+	return goupnp1.NewServiceClientsCtx(ctx, searchTarget, newConfig(opts).discoveryOptions()...)
+}
+
+// Invoke invokes the named action of srv with the input arguments in, and
+// returns its output arguments. A SOAP fault of the device is returned as a
+// *soap.SOAPFaultError.
+func Invoke(ctx context.Context, srv *Service, action string, in []soap.Arg, opts ...Option) ([]soap.Arg, error) {
+	if !srv.ControlURL.Ok {
+		return nil, errors.New("goupnp: service has no resolved control URL")
+	}
+	client := srv.NewSOAPClient(newConfig(opts).soapOptions()...)
+	return client.PerformActionArgs(ctx, srv.ServiceType, action, in)
+}
+
+// Subscription is a subscription to the events of a service, made with
+// Subscribe. Its events are received until it is closed.
+type Subscription struct {
+	*gena.Subscription
+	subscriber *gena.Subscriber
+}
+
+// Subscribe subscribes to the events of srv, and passes them to handler. The
+// subscription is for the duration set with WithSubscriptionDuration, and
+// lasts until then unless renewed with RenewCtx. Close must be called to
+// end it and stop receiving its events.
+func Subscribe(ctx context.Context, srv *Service, handler gena.Handler, opts ...Option) (*Subscription, error) {
+	if !srv.EventSubURL.Ok {
+		return nil, errors.New("goupnp: service has no resolved event subscription URL")
+	}
+	c := newConfig(opts)
+	subscriber, err := gena.NewSubscriber(handler, c.subscriberOptions()...)
+	if err != nil {
+		return nil, err
+	}
+	sub, err := subscriber.SubscribeCtx(ctx, &srv.EventSubURL.URL, c.subscriptionDuration)
+	if err != nil {
+		subscriber.Close()
+		return nil, err
+	}
+	return &Subscription{sub, subscriber}, nil
+}
+
+// Close cancels the subscription, and stops receiving its events. They stop
+// even if cancelling fails, in which case the subscription expires at the
+// device.
+func (sub *Subscription) Close(ctx context.Context) error {
+	err := sub.UnsubscribeCtx(ctx)
+	if closeErr := sub.subscriber.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// Serve hosts the devices of srv, serving as its ListenAndServe does, until
+// ctx is done, when srv is closed. It returns the error of serving, or that of
+// ctx once closed.
+func Serve(ctx context.Context, srv *device.Server) error {
+	served := make(chan error, 1)
+	go func() { served <- srv.ListenAndServe() }()
+	select {
+	case err := <-served:
+		return err
+	case <-ctx.Done():
+	}
+	srv.Close()
+	<-served
+	return ctx.Err()
+}
@@ -0,0 +1,140 @@
+package goupnp
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/huin/goupnp/device"
+	"github.com/huin/goupnp/device/igdemu"
+	"github.com/huin/goupnp/gena"
+	"github.com/huin/goupnp/goupnptest"
+	"github.com/huin/goupnp/internal/netif"
+	"github.com/huin/goupnp/soap"
+)
+
+const testDescription = `<?xml version="1.0"?>
+<root xmlns="urn:schemas-upnp-org:device-1-0">
+  <specVersion><major>1</major><minor>0</minor></specVersion>
+  <device>
+    <deviceType>urn:schemas-upnp-org:device:WANConnectionDevice:1</deviceType>
+    <friendlyName>Fake connection</friendlyName>
+    <UDN>uuid:00000000-0000-0000-0000-000000000001</UDN>
+    <serviceList>
+      <service>
+        <serviceType>urn:schemas-upnp-org:service:WANIPConnection:1</serviceType>
+        <serviceId>urn:upnp-org:serviceId:WANIPConn1</serviceId>
+        <SCPDURL>/scpd/IPConn.xml</SCPDURL>
+        <controlURL>/ctl/IPConn</controlURL>
+        <eventSubURL>/evt/IPConn</eventSubURL>
+      </service>
+    </serviceList>
+  </device>
+</root>`
+
+func TestDeviceByURLAndInvoke(t *testing.T) {
+	d := goupnptest.NewFakeDevice(testDescription)
+	defer d.Close()
+	d.ScriptAction("/ctl/IPConn", "GetExternalIPAddress", goupnptest.Respond("NewExternalIPAddress", "203.0.113.1"))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	root, err := DeviceByURL(ctx, d.Location(), WithTimeout(time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	srvs := root.Device.FindService("urn:schemas-upnp-org:service:WANIPConnection:1")
+	if len(srvs) != 1 {
+		t.Fatalf("got %d services, want 1", len(srvs))
+	}
+	out, err := Invoke(ctx, srvs[0], "GetExternalIPAddress", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(out) != 1 || out[0] != (soap.Arg{Name: "NewExternalIPAddress", Value: "203.0.113.1"}) {
+		t.Errorf("got output %+v, want NewExternalIPAddress=203.0.113.1", out)
+	}
+
+	cancelled, cancelNow := context.WithCancel(ctx)
+	cancelNow()
+	if _, err := DeviceByURL(cancelled, d.Location()); err == nil {
+		t.Error("DeviceByURL with cancelled context: want error")
+	}
+}
+
+func TestSubscribe(t *testing.T) {
+	e, err := igdemu.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer e.Close()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	root, err := DeviceByURL(ctx, e.Location())
+	if err != nil {
+		t.Fatal(err)
+	}
+	srvs := root.Device.FindService("urn:schemas-upnp-org:service:WANIPConnection:1")
+	if len(srvs) != 1 {
+		t.Fatalf("got %d services, want 1", len(srvs))
+	}
+	events := gena.NewChanHandler(10, gena.DropOldest)
+	sub, err := Subscribe(ctx, srvs[0], events, WithSubscriptionDuration(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	select {
+	case ev := <-events.Events():
+		if _, ok := ev.Get("ExternalIPAddress"); !ok || ev.SID != sub.SID {
+			t.Errorf("got initial event %+v, want ExternalIPAddress of %s", ev, sub.SID)
+		}
+	case <-ctx.Done():
+		t.Fatal("no initial event")
+	}
+	if err := sub.Close(ctx); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestServe(t *testing.T) {
+	conn := newPacketConn(t)
+	defer netif.Set(nil, nil)
+	srv, err := device.New(device.WithAddr("127.0.0.1:0"), device.WithPacketConns(conn))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	served := make(chan error, 1)
+	go func() { served <- Serve(ctx, srv) }()
+	cancel()
+	select {
+	case err := <-served:
+		if err != context.Canceled {
+			t.Errorf("Serve() = %v, want %v", err, context.Canceled)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Serve did not return once its context was done")
+	}
+}
+
+// newPacketConn returns an SSDP socket on loopback, listed as multicast
+// capable, so that devices can be served without a network. netif.Set(nil,
+// nil) must be called once done.
+func newPacketConn(t *testing.T) net.PacketConn {
+	lo, err := net.InterfaceByName("lo")
+	if err != nil {
+		t.Skipf("no loopback interface: %v", err)
+	}
+	lo.Flags |= net.FlagMulticast | net.FlagUp
+	netif.Set(func() ([]net.Interface, error) {
+		return []net.Interface{*lo}, nil
+	}, func(*net.Interface) ([]net.Addr, error) {
+		return []net.Addr{&net.IPNet{IP: net.IPv4(127, 0, 0, 1).To4(), Mask: net.CIDRMask(8, 32)}}, nil
+	})
+	conn, err := net.ListenPacket("udp4", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return conn
+}
@@ -0,0 +1,150 @@
+package goupnp
+
+import (
+	"net"
+	"net/http"
+	"time"
+
+	goupnp1 "github.com/huin/goupnp"
+	"github.com/huin/goupnp/gena"
+	"github.com/huin/goupnp/httpu"
+	"github.com/huin/goupnp/soap"
+	"github.com/huin/goupnp/store"
+)
+
+// Option configures an operation. Each operation uses the options that apply
+// to it, and ignores the others.
+type Option func(*config)
+
+type config struct {
+	searchWait       time.Duration
+	searchSends      int
+	timeout          time.Duration
+	transport        http.RoundTripper
+	httpuOptions     []httpu.ClientOption
+	dualStack        bool
+	fetcher          *goupnp1.Fetcher
+	descriptionStore store.Store
+	descriptionTTL   time.Duration
+
+	subscriptionDuration time.Duration
+}
+
+func newConfig(opts []Option) *config {
+	c := &config{
+		searchWait:  goupnp1.DefaultSearchWaitSeconds * time.Second,
+		searchSends: goupnp1.DefaultSearchSends,
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithSearchWait sets how long devices may wait before responding to SSDP
+// searches, and so how long searches wait for responses, in whole seconds of
+// at least one, rounded up. Two seconds by default.
+func WithSearchWait(d time.Duration) Option {
+	return func(c *config) { c.searchWait = d }
+}
+
+// WithSearchSends sets the number of times that each SSDP search request is
+// sent, to make up for lost packets, three by default.
+func WithSearchSends(n int) Option {
+	return func(c *config) { c.searchSends = n }
+}
+
+// WithTimeout sets the time limit of each request, whether of a description,
+// a SOAP action or an event subscription, within that of the context.
+// Requests are only limited by the context by default.
+func WithTimeout(d time.Duration) Option {
+	return func(c *config) { c.timeout = d }
+}
+
+// WithTransport sets the transport of the HTTP requests, for descriptions,
+// SOAP actions and event subscriptions alike, by default
+// http.DefaultTransport, dialing through the dialer and proxy set with
+// goupnp.SetDialer and goupnp.SetProxy of version 1.
+func WithTransport(rt http.RoundTripper) Option {
+	return func(c *config) { c.transport = rt }
+}
+
+// WithInterfaces restricts the network interfaces that SSDP searches are sent
+// on, all multicast capable interfaces by default.
+func WithInterfaces(ifs ...net.Interface) Option {
+	return WithHTTPUOptions(httpu.WithInterfaces(ifs...))
+}
+
+// WithHTTPUOptions sets options of the HTTPU client that SSDP searches are
+// sent with.
+func WithHTTPUOptions(opts ...httpu.ClientOption) Option {
+	return func(c *config) { c.httpuOptions = append(c.httpuOptions, opts...) }
+}
+
+// WithDualStack makes discovery search over IPv6 as well as IPv4.
+func WithDualStack() Option {
+	return func(c *config) { c.dualStack = true }
+}
+
+// WithFetcher sets the Fetcher that descriptions are fetched through,
+// goupnp.DefaultFetcher of version 1 by default.
+func WithFetcher(f *goupnp1.Fetcher) Option {
+	return func(c *config) { c.fetcher = f }
+}
+
+// WithDescriptionStore keeps the descriptions fetched in s for ttl, as
+// goupnp.WithDescriptionStore of version 1 does.
+func WithDescriptionStore(s store.Store, ttl time.Duration) Option {
+	return func(c *config) { c.descriptionStore, c.descriptionTTL = s, ttl }
+}
+
+// WithSubscriptionDuration sets the duration of the event subscriptions
+// requested by Subscribe, gena.DefaultTimeout by default. The device may grant
+// a different duration.
+func WithSubscriptionDuration(d time.Duration) Option {
+	return func(c *config) { c.subscriptionDuration = d }
+}
+
+// discoveryOptions returns the version 1 options of discovery and
+// description fetching.
+func (c *config) discoveryOptions() []goupnp1.DiscoveryOption {
+	wait := int((c.searchWait + time.Second - 1) / time.Second)
+	if wait < 1 {
+		wait = 1
+	}
+	opts := []goupnp1.DiscoveryOption{
+		goupnp1.WithSearchWait(wait),
+		goupnp1.WithSearchSends(c.searchSends),
+		goupnp1.WithDescriptionTimeout(c.timeout),
+		goupnp1.WithTransport(c.transport),
+		goupnp1.WithHTTPUOptions(c.httpuOptions...),
+	}
+	if c.dualStack {
+		opts = append(opts, goupnp1.WithDualStack())
+	}
+	if c.fetcher != nil {
+		opts = append(opts, goupnp1.WithFetcher(c.fetcher))
+	}
+	if c.descriptionStore != nil {
+		opts = append(opts, goupnp1.WithDescriptionStore(c.descriptionStore, c.descriptionTTL))
+	}
+	return opts
+}
+
+// soapOptions returns the version 1 options of SOAP clients.
+func (c *config) soapOptions() []soap.ClientOption {
+	opts := []soap.ClientOption{soap.WithTimeout(c.timeout)}
+	if c.transport != nil {
+		opts = append(opts, soap.WithTransport(c.transport))
+	}
+	return opts
+}
+
+// subscriberOptions returns the version 1 options of event subscribers.
+func (c *config) subscriberOptions() []gena.SubscriberOption {
+	opts := []gena.SubscriberOption{gena.WithRequestTimeout(c.timeout)}
+	if c.transport != nil {
+		opts = append(opts, gena.WithTransport(c.transport))
+	}
+	return opts
+}